@@ -82,6 +82,7 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	ibcpacketforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
@@ -210,6 +211,7 @@ type App struct {
 
 	// IBC middleware keepers
 	PacketForwardKeeper *ibcpacketforwardkeeper.Keeper
+	RateLimitKeeper     *ratelimitkeeper.Keeper
 
 	// CosmWasm
 	WasmKeeper *wasmkeeper.Keeper
@@ -392,7 +394,7 @@ func New(
 	// Wire EVM<->CosmWasm cross-runtime plugins into the wasm keeper.
 	// EVMKeeper is available (created above); these options are applied when
 	// the wasm keeper is constructed inside registerIBCModules.
-	wasmOpts = append(wasmOpts, EVMWasmPluginOpts(app.EVMKeeper)...)
+	wasmOpts = append(wasmOpts, EVMWasmPluginOpts(app.EVMKeeper, &app.Erc20Keeper, app.PreciseBankKeeper)...)
 
 	// register legacy modules (IBC, wasm)
 	if err := app.registerIBCModules(appOpts, wasmOpts...); err != nil {
@@ -404,6 +406,10 @@ func New(
 	// adding them here fixes Bug #6 (ICS20 precompile panic).
 	app.syncEVMStoreKeys()
 
+	// Give the evmigration keeper the wasm, gov, group, ICA controller and
+	// ERC20 keepers so account claims also move state held in those modules.
+	app.wireEVMigrationKeepers()
+
 	// Enable Cosmos EVM static precompiles once IBC keepers are available.
 	app.configureEVMStaticPrecompiles()
 
@@ -499,6 +505,7 @@ func (app *App) setupUpgrades(appOpts servertypes.AppOptions) {
 		Logger:                app.Logger(),
 		ModuleManager:         app.ModuleManager,
 		Configurator:          app.Configurator(),
+		AccountKeeper:         &app.AuthKeeper,
 		ActionKeeper:          &app.ActionKeeper,
 		SupernodeKeeper:       app.SupernodeKeeper,
 		ParamsKeeper:          &app.ParamsKeeper,
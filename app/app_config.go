@@ -78,6 +78,7 @@ import (
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
@@ -127,6 +128,7 @@ var (
 		ibctransfertypes.ModuleName, // IBC transfer module
 		icatypes.ModuleName,         // IBC interchain accounts module (host and controller)
 		pfmtypes.ModuleName,         // IBC packet-forward-middleware
+		ratelimittypes.ModuleName,   // IBC transfer rate limiting
 		ibctm.ModuleName,            // IBC Tendermint light client
 		solomachine.ModuleName,      // IBC Solo Machine light client
 		// Lumera custom modules
@@ -163,7 +165,8 @@ var (
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
-		pfmtypes.ModuleName, // IBC packet-forward-middleware
+		pfmtypes.ModuleName,       // IBC packet-forward-middleware
+		ratelimittypes.ModuleName, // IBC transfer rate limiting (hourly quota resets)
 		// Lumera custom modules
 		lumeraidmoduletypes.ModuleName,
 		wasmtypes.ModuleName,
@@ -186,7 +189,8 @@ var (
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
-		pfmtypes.ModuleName, // IBC packet-forward-middleware
+		pfmtypes.ModuleName,       // IBC packet-forward-middleware
+		ratelimittypes.ModuleName, // IBC transfer rate limiting
 		// chain modules
 		lumeraidmoduletypes.ModuleName,
 		wasmtypes.ModuleName,
@@ -226,6 +230,8 @@ var (
 		{Account: wasmtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: claimmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 		{Account: supernodemoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+		{Account: supernodemoduletypes.SelfStakePoolName},
+		{Account: auditmoduletypes.ModuleName},
 		{Account: actionmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 		{Account: feemarkettypes.ModuleName},
 		{Account: precisebanktypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
@@ -241,6 +247,8 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		supernodemoduletypes.SelfStakePoolName,
+		auditmoduletypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -5,6 +5,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	actionprecompile "github.com/LumeraProtocol/lumera/precompiles/action"
+	auditprecompile "github.com/LumeraProtocol/lumera/precompiles/audit"
 	supernodeprecompile "github.com/LumeraProtocol/lumera/precompiles/supernode"
 	wasmprecompile "github.com/LumeraProtocol/lumera/precompiles/wasm"
 	precompiletypes "github.com/cosmos/evm/precompiles/types"
@@ -184,6 +185,14 @@ func (app *App) configureEVMStaticPrecompiles() {
 	)
 	precompiles[wasmPC.Address()] = wasmPC
 
+	// Register Lumera custom precompile: Audit module
+	auditPC := auditprecompile.NewPrecompile(
+		app.AuditKeeper,
+		app.PreciseBankKeeper,
+		app.AuthKeeper.AddressCodec(),
+	)
+	precompiles[auditPC.Address()] = auditPC
+
 	app.EVMKeeper.WithStaticPrecompiles(precompiles)
 }
 
@@ -2,6 +2,7 @@ package evm
 
 import (
 	actionprecompile "github.com/LumeraProtocol/lumera/precompiles/action"
+	auditprecompile "github.com/LumeraProtocol/lumera/precompiles/audit"
 	supernodeprecompile "github.com/LumeraProtocol/lumera/precompiles/supernode"
 	wasmprecompile "github.com/LumeraProtocol/lumera/precompiles/wasm"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -26,4 +27,5 @@ var LumeraActiveStaticPrecompiles = []string{
 	actionprecompile.ActionPrecompileAddress,
 	supernodeprecompile.SupernodePrecompileAddress,
 	wasmprecompile.WasmPrecompileAddress,
+	auditprecompile.AuditPrecompileAddress,
 }
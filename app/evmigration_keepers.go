@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	evmigrationtypes "github.com/LumeraProtocol/lumera/x/evmigration/types"
+)
+
+// wireEVMigrationKeepers hands the evmigration keeper the wasm, gov, group,
+// ICA controller and ERC20 keepers it needs to migrate state held in those
+// modules. Must run after registerIBCModules, which creates the wasm and ICA
+// keepers.
+func (app *App) wireEVMigrationKeepers() {
+	app.EvmigrationKeeper.SetWasmKeeper(evmigrationWasmKeeper{
+		Keeper:      app.WasmKeeper,
+		permissions: wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
+	})
+	app.EvmigrationKeeper.SetGovKeeper(evmigrationGovKeeper{keeper: app.GovKeeper})
+	app.EvmigrationKeeper.SetGroupKeeper(app.GroupKeeper)
+	app.EvmigrationKeeper.SetICAControllerKeeper(app.ICAControllerKeeper)
+	app.EvmigrationKeeper.SetERC20Keepers(app.Erc20Keeper, app.EVMKeeper)
+}
+
+// evmigrationWasmKeeper routes admin updates through the default permission
+// keeper, which only lets the current admin change it.
+type evmigrationWasmKeeper struct {
+	*wasmkeeper.Keeper
+	permissions *wasmkeeper.PermissionedKeeper
+}
+
+var _ evmigrationtypes.WasmKeeper = evmigrationWasmKeeper{}
+
+func (k evmigrationWasmKeeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error {
+	return k.permissions.UpdateContractAdmin(ctx, contractAddress, caller, newAdmin)
+}
+
+// evmigrationGovKeeper exposes the gov deposit and vote collections, which
+// the gov keeper offers no iterate-all or remove methods for.
+type evmigrationGovKeeper struct {
+	keeper *govkeeper.Keeper
+}
+
+var _ evmigrationtypes.GovKeeper = evmigrationGovKeeper{}
+
+func (k evmigrationGovKeeper) IterateAllDeposits(ctx context.Context, cb func(govv1.Deposit) (bool, error)) error {
+	return k.keeper.Deposits.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], deposit govv1.Deposit) (bool, error) {
+		return cb(deposit)
+	})
+}
+
+func (k evmigrationGovKeeper) SetDeposit(ctx context.Context, deposit govv1.Deposit) error {
+	return k.keeper.SetDeposit(ctx, deposit)
+}
+
+func (k evmigrationGovKeeper) RemoveDeposit(ctx context.Context, proposalID uint64, depositor sdk.AccAddress) error {
+	return k.keeper.Deposits.Remove(ctx, collections.Join(proposalID, depositor))
+}
+
+func (k evmigrationGovKeeper) IterateAllVotes(ctx context.Context, cb func(govv1.Vote) (bool, error)) error {
+	return k.keeper.Votes.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], vote govv1.Vote) (bool, error) {
+		return cb(vote)
+	})
+}
+
+func (k evmigrationGovKeeper) SetVote(ctx context.Context, vote govv1.Vote) error {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return err
+	}
+	return k.keeper.Votes.Set(ctx, collections.Join(vote.ProposalId, voter), vote)
+}
+
+func (k evmigrationGovKeeper) RemoveVote(ctx context.Context, proposalID uint64, voter sdk.AccAddress) error {
+	return k.keeper.Votes.Remove(ctx, collections.Join(proposalID, voter))
+}
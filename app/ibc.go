@@ -18,12 +18,18 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	lcfg "github.com/LumeraProtocol/lumera/config"
+	actionibchooks "github.com/LumeraProtocol/lumera/x/action/v1/ibchooks"
+	actionmodulekeeper "github.com/LumeraProtocol/lumera/x/action/v1/keeper"
 
 	erc20ibc "github.com/cosmos/evm/x/erc20"
 	erc20ibcv2 "github.com/cosmos/evm/x/erc20/v2"
 	pfm "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
 	pfmkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
 	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ratelimit "github.com/cosmos/ibc-apps/modules/rate-limiting/v10"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	ratelimitv2 "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/v2"
 	icamodule "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
 	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
@@ -64,6 +70,7 @@ func (app *App) registerIBCModules(
 		storetypes.NewKVStoreKey(ibctransfertypes.StoreKey),
 		storetypes.NewKVStoreKey(icahosttypes.StoreKey),
 		storetypes.NewKVStoreKey(pfmtypes.StoreKey),
+		storetypes.NewKVStoreKey(ratelimittypes.StoreKey),
 		storetypes.NewKVStoreKey(icacontrollertypes.StoreKey),
 		storetypes.NewTransientStoreKey(paramstypes.TStoreKey),
 	); err != nil {
@@ -75,6 +82,7 @@ func (app *App) registerIBCModules(
 	keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
 	app.ParamsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	app.ParamsKeeper.Subspace(pfmtypes.ModuleName)
+	app.ParamsKeeper.Subspace(ratelimittypes.ModuleName).WithKeyTable(ratelimittypes.ParamKeyTable())
 	app.ParamsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
 	app.ParamsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	app.ParamsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
@@ -90,6 +98,20 @@ func (app *App) registerIBCModules(
 		govAuthority,
 	)
 
+	// Initialize the rate-limit Keeper. It is the ICS4Wrapper closest to core IBC on
+	// the transfer stack, so every outgoing ICS-20 packet (including PFM forwards and
+	// EVM ICS20 precompile sends) is metered against its per-(channel, denom) quota.
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(ratelimittypes.StoreKey)),
+		app.GetSubspace(ratelimittypes.ModuleName),
+		govAuthority,
+		newRateLimitSupplyKeeper(app.BankKeeper, &app.Erc20Keeper, app.EVMKeeper),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+	)
+
 	// Initialize the packet forward middleware Keeper
 	// It's important to note that the PFM Keeper must be initialized before the Transfer Keeper
 	app.PacketForwardKeeper = pfmkeeper.NewKeeper(
@@ -98,7 +120,7 @@ func (app *App) registerIBCModules(
 		nil, // will be zero-value here, reference is set later on with SetTransferKeeper.
 		app.IBCKeeper.ChannelKeeper,
 		app.BankKeeper,
-		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.RateLimitKeeper, // ICS4Wrapper
 		govAuthority,
 	)
 
@@ -156,6 +178,17 @@ func (app *App) registerIBCModules(
 	var ibcv1transferStack ibcporttypes.IBCModule
 	ibcv1transferStack = ibctransfer.NewIBCModule(app.TransferKeeper)
 	ibcv1transferStack = erc20ibc.NewIBCMiddleware(app.erc20PolicyWrapper, ibcv1transferStack)
+	// action hooks create Cascade/Sense actions from an "action" ICS-20 memo; they sit below
+	// callbacks so the dest callback and the source-chain ack both observe the action result.
+	// The ack is written asynchronously through the callbacks stack once the action settles.
+	actionIBCHooks := actionibchooks.NewIBCMiddleware(
+		ibcv1transferStack,
+		actionmodulekeeper.NewMsgServerImpl(app.ActionKeeper),
+		&app.ActionKeeper,
+		app.BankKeeper,
+		app.AuthKeeper.AddressCodec(),
+	)
+	ibcv1transferStack = actionIBCHooks
 	// callbacks wraps the transfer stack as its base app, and uses PacketForwardKeeper as the ICS4Wrapper
 	// i.e. packet-forward-middleware is higher on the stack and sits between callbacks and the ibc channel keeper
 	// Since this is the lowest level middleware of the transfer stack, it should be the first entrypoint for transfer keeper's
@@ -166,8 +199,14 @@ func (app *App) registerIBCModules(
 		wasmStackIBCHandler,
 		lcfg.DefaultMaxIBCCallbackGas,
 	)
+	actionIBCHooks.SetICS4Wrapper(ibccbStack)
+	app.ActionKeeper.SetHooks(actionIBCHooks)
+	// rate limiting checks inflow before any transfer logic runs; it sits above callbacks
+	// because it does not implement PacketDataUnmarshaler, which callbacks requires
+	// from the app it wraps.
+	ibcv1transferStack = ratelimit.NewIBCMiddleware(*app.RateLimitKeeper, ibccbStack)
 	ibcv1transferStack = pfm.NewIBCMiddleware(
-		ibccbStack,
+		ibcv1transferStack,
 		app.PacketForwardKeeper,
 		0,
 		pfmkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
@@ -185,6 +224,7 @@ func (app *App) registerIBCModules(
 		lcfg.DefaultMaxIBCCallbackGas,
 	)
 	ibcv2transferStack = erc20ibcv2.NewIBCMiddleware(ibcv2transferStack, app.erc20PolicyWrapper)
+	ibcv2transferStack = ratelimitv2.NewIBCMiddleware(*app.RateLimitKeeper, ibcv2transferStack)
 	app.TransferKeeper.WithICS4Wrapper(ibccbStack)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
@@ -256,8 +296,9 @@ func (app *App) registerIBCModules(
 	if err := app.RegisterModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		pfm.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(pfmtypes.ModuleName)),
+		ratelimit.NewAppModule(app.appCodec, *app.RateLimitKeeper),
 		ibctransfer.NewAppModule(app.TransferKeeper),
-		icamodule.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		newICAAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper, app.EvmigrationKeeper.ResolveICAControllerOwner),
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(soloLightClientModule),
 	); err != nil {
@@ -275,6 +316,7 @@ func RegisterIBC(cdc codec.Codec) map[string]appmodule.AppModule {
 		ibcexported.ModuleName:      ibc.NewAppModule(&ibckeeper.Keeper{}),
 		ibctransfertypes.ModuleName: ibctransfer.NewAppModule(ibctransferkeeper.Keeper{}),
 		icatypes.ModuleName:         icamodule.NewAppModule(&icacontrollerkeeper.Keeper{}, &icahostkeeper.Keeper{}),
+		ratelimittypes.ModuleName:   ratelimit.NewAppModule(cdc, ratelimitkeeper.Keeper{}),
 		ibctm.ModuleName:            ibctm.NewAppModule(ibctm.NewLightClientModule(cdc, ibcclienttypes.StoreProvider{})),
 		solomachine.ModuleName:      solomachine.NewAppModule(solomachine.NewLightClientModule(cdc, ibcclienttypes.StoreProvider{})),
 	}
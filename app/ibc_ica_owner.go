@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	icamodule "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+)
+
+// icaOwnerResolver maps the owner of an ICS-27 controller message to the
+// owner whose controller port should be used on a connection. It fails for
+// owners that may no longer control interchain accounts.
+type icaOwnerResolver func(ctx sdk.Context, connectionID, owner string) (string, error)
+
+// icaAppModule is the ICS-27 app module with a controller msg server that
+// resolves owners first. x/evmigration cannot re-key interchain accounts
+// because the controller port embeds the owner address, so a migrated account
+// keeps driving the accounts its legacy address registered, and the legacy
+// address itself is locked out.
+type icaAppModule struct {
+	icamodule.AppModule
+
+	controllerKeeper *icacontrollerkeeper.Keeper
+	hostKeeper       *icahostkeeper.Keeper
+	resolveOwner     icaOwnerResolver
+}
+
+func newICAAppModule(controllerKeeper *icacontrollerkeeper.Keeper, hostKeeper *icahostkeeper.Keeper, resolveOwner icaOwnerResolver) icaAppModule {
+	return icaAppModule{
+		AppModule:        icamodule.NewAppModule(controllerKeeper, hostKeeper),
+		controllerKeeper: controllerKeeper,
+		hostKeeper:       hostKeeper,
+		resolveOwner:     resolveOwner,
+	}
+}
+
+// RegisterServices mirrors icamodule.AppModule.RegisterServices with the
+// controller msg server wrapped.
+func (am icaAppModule) RegisterServices(cfg module.Configurator) {
+	icacontrollertypes.RegisterMsgServer(cfg.MsgServer(), icaControllerMsgServer{
+		MsgServer:    icacontrollerkeeper.NewMsgServerImpl(am.controllerKeeper),
+		resolveOwner: am.resolveOwner,
+	})
+	icacontrollertypes.RegisterQueryServer(cfg.QueryServer(), am.controllerKeeper)
+	icahosttypes.RegisterMsgServer(cfg.MsgServer(), icahostkeeper.NewMsgServerImpl(am.hostKeeper))
+	icahosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
+
+	controllerMigrator := icacontrollerkeeper.NewMigrator(am.controllerKeeper)
+	hostMigrator := icahostkeeper.NewMigrator(am.hostKeeper)
+	if err := cfg.RegisterMigration(icatypes.ModuleName, 2, func(ctx sdk.Context) error {
+		if err := hostMigrator.MigrateParams(ctx); err != nil {
+			return err
+		}
+		return controllerMigrator.MigrateParams(ctx)
+	}); err != nil {
+		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 2 to 3 (self-managed params migration): %v", err))
+	}
+}
+
+// icaControllerMsgServer rewrites the owner of register and send messages
+// before handing them to the ICS-27 controller msg server. The message signer
+// is still checked against the original owner by the SDK.
+type icaControllerMsgServer struct {
+	icacontrollertypes.MsgServer
+	resolveOwner icaOwnerResolver
+}
+
+func (s icaControllerMsgServer) RegisterInterchainAccount(goCtx context.Context, msg *icacontrollertypes.MsgRegisterInterchainAccount) (*icacontrollertypes.MsgRegisterInterchainAccountResponse, error) {
+	owner, err := s.resolveOwner(sdk.UnwrapSDKContext(goCtx), msg.ConnectionId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *msg
+	resolved.Owner = owner
+	return s.MsgServer.RegisterInterchainAccount(goCtx, &resolved)
+}
+
+func (s icaControllerMsgServer) SendTx(goCtx context.Context, msg *icacontrollertypes.MsgSendTx) (*icacontrollertypes.MsgSendTxResponse, error) {
+	owner, err := s.resolveOwner(sdk.UnwrapSDKContext(goCtx), msg.ConnectionId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *msg
+	resolved.Owner = owner
+	return s.MsgServer.SendTx(goCtx, &resolved)
+}
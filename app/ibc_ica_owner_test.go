@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingICAControllerMsgServer records the owners it receives.
+type recordingICAControllerMsgServer struct {
+	icacontrollertypes.MsgServer
+	owners *[]string
+}
+
+func (s recordingICAControllerMsgServer) RegisterInterchainAccount(_ context.Context, msg *icacontrollertypes.MsgRegisterInterchainAccount) (*icacontrollertypes.MsgRegisterInterchainAccountResponse, error) {
+	*s.owners = append(*s.owners, msg.Owner)
+	return &icacontrollertypes.MsgRegisterInterchainAccountResponse{}, nil
+}
+
+func (s recordingICAControllerMsgServer) SendTx(_ context.Context, msg *icacontrollertypes.MsgSendTx) (*icacontrollertypes.MsgSendTxResponse, error) {
+	*s.owners = append(*s.owners, msg.Owner)
+	return &icacontrollertypes.MsgSendTxResponse{}, nil
+}
+
+func TestICAControllerMsgServerResolvesOwner(t *testing.T) {
+	key := storetypes.NewKVStoreKey("ica_owner_test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("ica_owner_test_transient"))
+
+	var owners []string
+	server := icaControllerMsgServer{
+		MsgServer: recordingICAControllerMsgServer{owners: &owners},
+		resolveOwner: func(_ sdk.Context, connectionID, owner string) (string, error) {
+			if owner == "legacy" {
+				return "", errors.New("legacy owner was migrated")
+			}
+			if connectionID == "connection-0" && owner == "new" {
+				return "legacy", nil
+			}
+			return owner, nil
+		},
+	}
+
+	sendTx := &icacontrollertypes.MsgSendTx{Owner: "new", ConnectionId: "connection-0"}
+	_, err := server.SendTx(ctx, sendTx)
+	require.NoError(t, err)
+	require.Equal(t, "new", sendTx.Owner, "caller's message must not be mutated")
+
+	_, err = server.RegisterInterchainAccount(ctx, &icacontrollertypes.MsgRegisterInterchainAccount{Owner: "new", ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	_, err = server.SendTx(ctx, &icacontrollertypes.MsgSendTx{Owner: "new", ConnectionId: "connection-1"})
+	require.NoError(t, err)
+
+	// A rejected owner never reaches the controller msg server.
+	_, err = server.SendTx(ctx, &icacontrollertypes.MsgSendTx{Owner: "legacy", ConnectionId: "connection-0"})
+	require.Error(t, err)
+	_, err = server.RegisterInterchainAccount(ctx, &icacontrollertypes.MsgRegisterInterchainAccount{Owner: "legacy", ConnectionId: "connection-0"})
+	require.Error(t, err)
+
+	require.Equal(t, []string{"legacy", "legacy", "new"}, owners)
+}
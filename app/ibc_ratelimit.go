@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lcfg "github.com/LumeraProtocol/lumera/config"
+
+	"github.com/cosmos/evm/contracts"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// erc20TokenPairKeeper is the subset of the erc20 keeper used to resolve
+// token pairs by bank denom or ERC20 contract address.
+type erc20TokenPairKeeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+}
+
+// rateLimitEVMCaller is the subset of the EVM keeper used to read ERC20 supply.
+type rateLimitEVMCaller interface {
+	statedb.Keeper
+	CallEVM(ctx sdk.Context, stateDB *statedb.StateDB, abi abi.ABI, from, contract common.Address, commit, callFromPrecompile bool, gasCap *big.Int, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// Compile-time check that rateLimitSupplyKeeper satisfies the rate-limit bank keeper.
+var _ ratelimittypes.BankKeeper = (*rateLimitSupplyKeeper)(nil)
+
+// rateLimitSupplyKeeper provides the "channel value" the rate limiter uses as
+// the denominator of its percentage quotas.
+//
+//   - LUME: ICS-20 packets carry the 6-decimal ulume bank denom. The precisebank
+//     reserve holds the integer backing of all fractional alume balances, so the
+//     bank ulume supply already is the 18-decimal supply truncated to ulume.
+//     The extended alume denom never appears in a packet, so it reports zero
+//     supply and MsgAddRateLimit rejects it instead of creating an inert limit.
+//   - Native ERC20 tokens (erc20/0x...): only the converted part of the supply
+//     exists in x/bank, so the ERC20 contract totalSupply is used instead.
+//   - Everything else (including IBC vouchers) uses the bank supply.
+type rateLimitSupplyKeeper struct {
+	bank  ratelimittypes.BankKeeper
+	erc20 erc20TokenPairKeeper
+	evm   rateLimitEVMCaller
+}
+
+func newRateLimitSupplyKeeper(bank ratelimittypes.BankKeeper, erc20 erc20TokenPairKeeper, evm rateLimitEVMCaller) *rateLimitSupplyKeeper {
+	return &rateLimitSupplyKeeper{bank: bank, erc20: erc20, evm: evm}
+}
+
+// GetSupply implements ratelimittypes.BankKeeper.
+func (k *rateLimitSupplyKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	if denom == lcfg.ChainEVMExtendedDenom {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	}
+
+	supply := k.bank.GetSupply(ctx, denom)
+	if k.erc20 == nil || k.evm == nil {
+		return supply
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	id := k.erc20.GetTokenPairID(sdkCtx, denom)
+	if len(id) == 0 {
+		return supply
+	}
+	pair, found := k.erc20.GetTokenPair(sdkCtx, id)
+	if !found || !pair.IsNativeERC20() {
+		return supply
+	}
+
+	if total, ok := k.erc20TotalSupply(sdkCtx, pair.GetERC20Contract()); ok && total.GT(supply.Amount) {
+		return sdk.NewCoin(denom, total)
+	}
+	return supply
+}
+
+// erc20TotalSupply reads totalSupply() from an ERC20 contract without committing state.
+func (k *rateLimitSupplyKeeper) erc20TotalSupply(ctx sdk.Context, contract common.Address) (sdkmath.Int, bool) {
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	stateDB := statedb.New(ctx, k.evm, statedb.NewEmptyTxConfig())
+	res, err := k.evm.CallEVM(ctx, stateDB, erc20ABI, erc20types.ModuleAddress, contract, false, false, nil, "totalSupply")
+	if err != nil || res.Failed() {
+		return sdkmath.Int{}, false
+	}
+
+	unpacked, err := erc20ABI.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return sdkmath.Int{}, false
+	}
+	total, ok := unpacked[0].(*big.Int)
+	if !ok || total.Sign() < 0 || total.BitLen() > sdkmath.MaxBitLen {
+		return sdkmath.Int{}, false
+	}
+	return sdkmath.NewIntFromBigInt(total), true
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	lcfg "github.com/LumeraProtocol/lumera/config"
+)
+
+type supplyBankStub map[string]sdkmath.Int
+
+func (b supplyBankStub) GetSupply(_ context.Context, denom string) sdk.Coin {
+	amt, ok := b[denom]
+	if !ok {
+		amt = sdkmath.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amt)
+}
+
+type tokenPairStub map[string]erc20types.TokenPair
+
+func (s tokenPairStub) GetTokenPairID(_ sdk.Context, token string) []byte {
+	if pair, ok := s[token]; ok {
+		return pair.GetID()
+	}
+	return nil
+}
+
+func (s tokenPairStub) GetTokenPair(_ sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	for _, pair := range s {
+		if string(pair.GetID()) == string(id) {
+			return pair, true
+		}
+	}
+	return erc20types.TokenPair{}, false
+}
+
+// evmTotalSupplyStub answers totalSupply() calls with a fixed value.
+type evmTotalSupplyStub struct {
+	statedb.Keeper
+	total *big.Int
+	calls int
+}
+
+func (e *evmTotalSupplyStub) CallEVM(_ sdk.Context, _ *statedb.StateDB, contractABI abi.ABI, _, _ common.Address, _, _ bool, _ *big.Int, method string, _ ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	e.calls++
+	ret, err := contractABI.Methods[method].Outputs.Pack(e.total)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}, nil
+}
+
+func TestRateLimitSupplyKeeper(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("ratelimit_supply"), storetypes.NewTransientStoreKey("transient_ratelimit_supply"))
+
+	erc20Addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	nativeERC20Denom := erc20types.CreateDenom(erc20Addr.Hex())
+	voucherDenom := ibctransfertypes.NewDenom("ufoo", ibctransfertypes.NewHop("transfer", "channel-0")).IBCDenom()
+
+	bank := supplyBankStub{
+		lcfg.ChainDenom:  sdkmath.NewInt(1_000_000),
+		nativeERC20Denom: sdkmath.NewInt(10),
+		voucherDenom:     sdkmath.NewInt(500),
+	}
+	pairs := tokenPairStub{
+		nativeERC20Denom: erc20types.NewTokenPair(erc20Addr, nativeERC20Denom, erc20types.OWNER_EXTERNAL),
+		voucherDenom:     erc20types.NewTokenPair(common.HexToAddress("0x2222222222222222222222222222222222222222"), voucherDenom, erc20types.OWNER_MODULE),
+	}
+	evm := &evmTotalSupplyStub{total: big.NewInt(7_000)}
+
+	var k ratelimittypes.BankKeeper = newRateLimitSupplyKeeper(bank, pairs, evm)
+
+	// LUME is measured in the ulume bank denom; the extended alume denom never
+	// appears in ICS-20 packets and reports zero so rate limits on it are rejected.
+	require.Equal(t, sdkmath.NewInt(1_000_000), k.GetSupply(ctx, lcfg.ChainDenom).Amount)
+	require.True(t, k.GetSupply(ctx, lcfg.ChainEVMExtendedDenom).Amount.IsZero())
+
+	// Native-coin token pairs (IBC vouchers) keep the bank supply.
+	require.Equal(t, sdkmath.NewInt(500), k.GetSupply(ctx, voucherDenom).Amount)
+	require.Zero(t, evm.calls)
+
+	// Native ERC20 tokens use the contract totalSupply.
+	require.Equal(t, sdkmath.NewInt(7_000), k.GetSupply(ctx, nativeERC20Denom).Amount)
+	require.Equal(t, 1, evm.calls)
+
+	// The bank supply wins if it is somehow larger than the reported totalSupply.
+	evm.total = big.NewInt(1)
+	require.Equal(t, sdkmath.NewInt(10), k.GetSupply(ctx, nativeERC20Denom).Amount)
+}
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -27,6 +28,7 @@ type AppUpgradeParams struct {
 	// Keepers required by custom upgrade handlers. These are populated by the app
 	// at startup (before state load) so upgrade handlers can safely perform
 	// bespoke store migrations beyond RunMigrations.
+	AccountKeeper         *authkeeper.AccountKeeper
 	ActionKeeper          *actionmodulekeeper.Keeper
 	SupernodeKeeper       sntypes.SupernodeKeeper
 	ParamsKeeper          *paramskeeper.Keeper
@@ -18,6 +18,7 @@ import (
 	upgrade_v1_12_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_12_0"
 	upgrade_v1_20_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_20_0"
 	upgrade_v1_20_1 "github.com/LumeraProtocol/lumera/app/upgrades/v1_20_1"
+	upgrade_v1_21_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_21_0"
 	upgrade_v1_6_1 "github.com/LumeraProtocol/lumera/app/upgrades/v1_6_1"
 	upgrade_v1_8_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_8_0"
 	upgrade_v1_8_4 "github.com/LumeraProtocol/lumera/app/upgrades/v1_8_4"
@@ -43,6 +44,7 @@ import (
 // | v1.12.0 | custom   | none (Everlight in supernode)     | Runs migrations; Everlight logic embedded in x/supernode
 // | v1.20.0 | custom   | non-mainnet: add feemarket, precisebank, vm, erc20 | EVM bring-up; gated to non-mainnet (mainnet runs it via v1.20.1)
 // | v1.20.1 | custom   | state-driven add-only: feemarket, precisebank, vm, erc20 | EVM bring-up when EVM absent (any network, incl. direct 1.12.0->1.20.1); migrations-only hotfix when EVM already present. Add-only store loader mounts only missing keys.
+// | v1.21.0 | custom   | add ratelimit                     | Runs action v1→v3 (expiration, metadata index backfills)/supernode v1→v2 migrations; creates self-stake pool and audit module accounts; activates audit precompile
 // =================================================================================================================================
 
 type UpgradeConfig struct {
@@ -75,6 +77,7 @@ var upgradeNames = []string{
 	upgrade_v1_12_0.UpgradeName,
 	upgrade_v1_20_0.UpgradeName,
 	upgrade_v1_20_1.UpgradeName,
+	upgrade_v1_21_0.UpgradeName,
 }
 
 var NoUpgradeConfig = UpgradeConfig{
@@ -177,6 +180,11 @@ func SetupUpgrades(upgradeName string, params appParams.AppUpgradeParams) (Upgra
 			StoreUpgrade: &upgrade_v1_20_0.StoreUpgrades,
 			Handler:      upgrade_v1_20_1.CreateUpgradeHandler(params),
 		}, true
+	case upgrade_v1_21_0.UpgradeName:
+		return UpgradeConfig{
+			StoreUpgrade: &upgrade_v1_21_0.StoreUpgrades,
+			Handler:      upgrade_v1_21_0.CreateUpgradeHandler(params),
+		}, true
 
 	// add future upgrades here
 	default:
@@ -18,6 +18,7 @@ import (
 	upgrade_v1_12_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_12_0"
 	upgrade_v1_20_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_20_0"
 	upgrade_v1_20_1 "github.com/LumeraProtocol/lumera/app/upgrades/v1_20_1"
+	upgrade_v1_21_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_21_0"
 	upgrade_v1_6_1 "github.com/LumeraProtocol/lumera/app/upgrades/v1_6_1"
 	upgrade_v1_8_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_8_0"
 	upgrade_v1_8_4 "github.com/LumeraProtocol/lumera/app/upgrades/v1_8_4"
@@ -28,6 +29,7 @@ import (
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
 )
 
 func TestUpgradeNamesOrder(t *testing.T) {
@@ -47,6 +49,7 @@ func TestUpgradeNamesOrder(t *testing.T) {
 		upgrade_v1_12_0.UpgradeName,
 		upgrade_v1_20_0.UpgradeName,
 		upgrade_v1_20_1.UpgradeName,
+		upgrade_v1_21_0.UpgradeName,
 	}
 	require.Equal(t, expected, upgradeNames, "upgradeNames should stay in ascending order")
 }
@@ -97,6 +100,9 @@ func TestSetupUpgradesAndHandlers(t *testing.T) {
 					require.Contains(t, config.StoreUpgrade.Added, evmtypes.StoreKey, "v1.20.1 should declare evm store key")
 					require.Contains(t, config.StoreUpgrade.Added, erc20types.StoreKey, "v1.20.1 should declare erc20 store key")
 				}
+				if upgradeName == upgrade_v1_21_0.UpgradeName && config.StoreUpgrade != nil {
+					require.Contains(t, config.StoreUpgrade.Added, ratelimittypes.StoreKey, "v1.21.0 should add ratelimit store key")
+				}
 
 				if config.Handler == nil {
 					continue
@@ -114,7 +120,8 @@ func TestSetupUpgradesAndHandlers(t *testing.T) {
 					upgradeName == upgrade_v1_11_1.UpgradeName ||
 					upgradeName == upgrade_v1_12_0.UpgradeName ||
 					upgradeName == upgrade_v1_20_0.UpgradeName ||
-					upgradeName == upgrade_v1_20_1.UpgradeName {
+					upgradeName == upgrade_v1_20_1.UpgradeName ||
+					upgradeName == upgrade_v1_21_0.UpgradeName {
 					continue
 				}
 
@@ -267,6 +274,8 @@ func expectStoreUpgrade(upgradeName, chainID string) bool {
 		// v1.20.1 declares the EVM store additions on every network; the add-only
 		// store loader mounts only the keys missing from committed state.
 		return true
+	case upgrade_v1_21_0.UpgradeName:
+		return true
 	default:
 		return false
 	}
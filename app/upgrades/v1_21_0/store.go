@@ -0,0 +1,13 @@
+package v1_21_0
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+)
+
+// StoreUpgrades declares store additions for v1.21.0.
+var StoreUpgrades = storetypes.StoreUpgrades{
+	Added: []string{
+		ratelimittypes.StoreKey, // added IBC transfer rate limiting store key
+	},
+}
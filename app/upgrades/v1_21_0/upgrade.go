@@ -0,0 +1,112 @@
+package v1_21_0
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	appParams "github.com/LumeraProtocol/lumera/app/upgrades/params"
+	auditprecompile "github.com/LumeraProtocol/lumera/precompiles/audit"
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// UpgradeName is the on-chain name used for this upgrade.
+const UpgradeName = "v1.21.0"
+
+// newModuleAccounts are the module accounts introduced by this release. The
+// supernode self-stake pool holds independent operators' self-stake and the
+// audit account escrows evidence dispute bonds.
+var newModuleAccounts = []string{
+	sntypes.SelfStakePoolName,
+	audittypes.ModuleName,
+}
+
+// CreateUpgradeHandler runs module migrations, materialises the module
+// accounts added in this release and activates the audit precompile.
+//
+// RunMigrations covers the state changes of this release:
+//   - x/action v1→v2 (expiration index backfill) and v2→v3 (metadata index
+//     backfill) migrations, and the x/supernode v1→v2 migration.
+//   - InitGenesis of the IBC rate-limiting module, whose store is added by
+//     StoreUpgrades.
+//
+// New static precompiles are only active at genesis through
+// LumeraActiveStaticPrecompiles; a running chain needs them added to the EVM
+// params.
+func CreateUpgradeHandler(p appParams.AppUpgradeParams) upgradetypes.UpgradeHandler {
+	return func(goCtx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		p.Logger.Info(fmt.Sprintf("Starting upgrade %s...", UpgradeName))
+
+		ctx := sdk.UnwrapSDKContext(goCtx)
+		if p.AccountKeeper == nil {
+			return nil, fmt.Errorf("%s upgrade requires account keeper to be wired", UpgradeName)
+		}
+		if p.EVMKeeper == nil {
+			return nil, fmt.Errorf("%s upgrade requires EVM keeper to be wired", UpgradeName)
+		}
+
+		p.Logger.Info("Running module migrations...")
+		newVM, err := p.ModuleManager.RunMigrations(ctx, p.Configurator, fromVM)
+		if err != nil {
+			p.Logger.Error("Failed to run migrations", "error", err)
+			return nil, fmt.Errorf("failed to run migrations: %w", err)
+		}
+		p.Logger.Info("Module migrations completed.")
+
+		for _, name := range newModuleAccounts {
+			if err := ensureModuleAccount(ctx, p, name); err != nil {
+				return nil, err
+			}
+			p.Logger.Info("Ensured module account", "name", name)
+		}
+
+		auditPrecompile := common.HexToAddress(auditprecompile.AuditPrecompileAddress)
+		if slices.Contains(p.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, auditPrecompile.Hex()) {
+			p.Logger.Info("Audit precompile already active", "address", auditPrecompile.Hex())
+		} else {
+			if err := p.EVMKeeper.EnableStaticPrecompiles(ctx, auditPrecompile); err != nil {
+				return nil, fmt.Errorf("enable audit precompile: %w", err)
+			}
+			p.Logger.Info("Enabled audit precompile", "address", auditPrecompile.Hex())
+		}
+
+		p.Logger.Info(fmt.Sprintf("Successfully completed upgrade %s", UpgradeName))
+		return newVM, nil
+	}
+}
+
+// ensureModuleAccount creates the named module account. The address of a new
+// module account may already hold a plain account (anyone can send funds to an
+// address before it is blocked); such an account is converted in place,
+// keeping its number and balance, instead of letting GetModuleAccount panic.
+func ensureModuleAccount(ctx sdk.Context, p appParams.AppUpgradeParams, name string) error {
+	addr := authtypes.NewModuleAddress(name)
+	acc := p.AccountKeeper.GetAccount(ctx, addr)
+	if acc != nil {
+		if _, ok := acc.(sdk.ModuleAccountI); ok {
+			return nil
+		}
+		baseAcc, ok := acc.(*authtypes.BaseAccount)
+		if !ok {
+			return fmt.Errorf("%s address %s holds unexpected account type %T", name, addr, acc)
+		}
+		// Module accounts carry no public key and their sequence is unused.
+		baseAcc = authtypes.NewBaseAccount(addr, nil, baseAcc.AccountNumber, 0)
+		perms := p.AccountKeeper.GetModulePermissions()[name].GetPermissions()
+		p.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAcc, name, perms...))
+		return nil
+	}
+
+	if p.AccountKeeper.GetModuleAccount(ctx, name) == nil {
+		return fmt.Errorf("%s module account is not registered in module permissions", name)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package v1_21_0_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	lumeraapp "github.com/LumeraProtocol/lumera/app"
+	appParams "github.com/LumeraProtocol/lumera/app/upgrades/params"
+	upgrade_v1_21_0 "github.com/LumeraProtocol/lumera/app/upgrades/v1_21_0"
+	auditprecompile "github.com/LumeraProtocol/lumera/precompiles/audit"
+	actionkeeper "github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func TestStoreUpgradesAddsRateLimitStore(t *testing.T) {
+	require.Equal(t, []string{ratelimittypes.StoreKey}, upgrade_v1_21_0.StoreUpgrades.Added)
+	require.Empty(t, upgrade_v1_21_0.StoreUpgrades.Deleted)
+	require.Empty(t, upgrade_v1_21_0.StoreUpgrades.Renamed)
+}
+
+func TestHandlerRequiresKeepers(t *testing.T) {
+	handler := upgrade_v1_21_0.CreateUpgradeHandler(appParams.AppUpgradeParams{Logger: log.NewNopLogger()})
+	_, err := handler(sdk.Context{}, upgradetypes.Plan{}, module.VersionMap{})
+	require.ErrorContains(t, err, "requires account keeper")
+}
+
+// TestHandlerActivatesAuditPrecompile checks that a chain whose EVM params
+// predate the audit precompile gets it activated, and that rerunning the
+// handler leaves the list unchanged.
+func TestHandlerActivatesAuditPrecompile(t *testing.T) {
+	app := lumeraapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	auditPrecompile := common.HexToAddress(auditprecompile.AuditPrecompileAddress).Hex()
+	evmParams := app.EVMKeeper.GetParams(ctx)
+	var withoutAudit []string
+	for _, addr := range evmParams.ActiveStaticPrecompiles {
+		if addr != auditPrecompile {
+			withoutAudit = append(withoutAudit, addr)
+		}
+	}
+	evmParams.ActiveStaticPrecompiles = withoutAudit
+	require.NoError(t, app.EVMKeeper.SetParams(ctx, evmParams))
+
+	params := appParams.AppUpgradeParams{
+		Logger:        log.NewNopLogger(),
+		ModuleManager: module.NewManager(),
+		Configurator:  module.NewConfigurator(nil, nil, nil),
+		AccountKeeper: &app.AuthKeeper,
+		EVMKeeper:     app.EVMKeeper,
+	}
+	handler := upgrade_v1_21_0.CreateUpgradeHandler(params)
+	_, err := handler(ctx, upgradetypes.Plan{}, module.VersionMap{})
+	require.NoError(t, err)
+
+	active := app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles
+	require.Contains(t, active, auditPrecompile)
+	require.Len(t, active, len(withoutAudit)+1)
+	require.NoError(t, evmtypes.ValidatePrecompiles(active))
+
+	_, err = handler(ctx, upgradetypes.Plan{}, module.VersionMap{})
+	require.NoError(t, err)
+	require.Equal(t, active, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles)
+}
+
+// TestHandlerCreatesModuleAccounts checks that the handler creates the new
+// module accounts, converting a plain account that already sits at a module
+// address.
+func TestHandlerCreatesModuleAccounts(t *testing.T) {
+	app := lumeraapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	// Replace the audit module account with a funded plain account, as on a
+	// chain where someone sent funds to the address before it was blocked.
+	auditAddr := authtypes.NewModuleAddress(audittypes.ModuleName)
+	if acc := app.AuthKeeper.GetAccount(ctx, auditAddr); acc != nil {
+		app.AuthKeeper.RemoveAccount(ctx, acc)
+	}
+	plain := app.AuthKeeper.NewAccountWithAddress(ctx, auditAddr)
+	app.AuthKeeper.SetAccount(ctx, plain)
+	selfStakeAddr := authtypes.NewModuleAddress(sntypes.SelfStakePoolName)
+	if acc := app.AuthKeeper.GetAccount(ctx, selfStakeAddr); acc != nil {
+		app.AuthKeeper.RemoveAccount(ctx, acc)
+	}
+
+	params := appParams.AppUpgradeParams{
+		Logger:        log.NewNopLogger(),
+		ModuleManager: module.NewManager(),
+		Configurator:  module.NewConfigurator(nil, nil, nil),
+		AccountKeeper: &app.AuthKeeper,
+		EVMKeeper:     app.EVMKeeper,
+	}
+	_, err := upgrade_v1_21_0.CreateUpgradeHandler(params)(ctx, upgradetypes.Plan{}, module.VersionMap{})
+	require.NoError(t, err)
+
+	auditAcc, ok := app.AuthKeeper.GetAccount(ctx, auditAddr).(sdk.ModuleAccountI)
+	require.True(t, ok, "audit address should hold a module account")
+	require.Equal(t, audittypes.ModuleName, auditAcc.GetName())
+	require.Equal(t, plain.GetAccountNumber(), auditAcc.GetAccountNumber())
+
+	selfStakeAcc, ok := app.AuthKeeper.GetAccount(ctx, selfStakeAddr).(sdk.ModuleAccountI)
+	require.True(t, ok, "self-stake pool address should hold a module account")
+	require.Empty(t, selfStakeAcc.GetPermissions())
+}
+
+// TestHandlerBackfillsActionIndexesFromV1 runs the handler on a chain whose
+// x/action store is at consensus version 1, without the expiration and
+// metadata indexes, and checks that both the v1→v2 and v2→v3 backfills ran.
+func TestHandlerBackfillsActionIndexesFromV1(t *testing.T) {
+	app := lumeraapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	metadata, err := gogoproto.Marshal(&actiontypes.CascadeMetadata{DataHash: "upgrade_hash", FileName: "upgrade_file"})
+	require.NoError(t, err)
+	require.NoError(t, app.ActionKeeper.SetAction(ctx, &actiontypes.Action{
+		Creator:        sdk.AccAddress("creator").String(),
+		ActionID:       "1",
+		ActionType:     actiontypes.ActionTypeCascade,
+		Metadata:       metadata,
+		Price:          "100ulume",
+		ExpirationTime: ctx.BlockTime().Unix() + 3600,
+		State:          actiontypes.ActionStatePending,
+		BlockHeight:    ctx.BlockHeight(),
+	}))
+
+	// Drop the indexes to get the store layout of x/action v1.
+	store := ctx.KVStore(app.GetKey(actiontypes.StoreKey))
+	countKeys := func(prefix string) int {
+		it := storetypes.KVStorePrefixIterator(store, []byte(prefix))
+		defer it.Close()
+		n := 0
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		return n
+	}
+	for _, prefix := range []string{actionkeeper.ActionByExpirationPrefix, actionkeeper.ActionByMetadataPrefix} {
+		it := storetypes.KVStorePrefixIterator(store, []byte(prefix))
+		var keys [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		require.NoError(t, it.Close())
+		require.NotEmpty(t, keys)
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		require.Zero(t, countKeys(prefix))
+	}
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[actiontypes.ModuleName] = 1
+
+	params := appParams.AppUpgradeParams{
+		Logger:        log.NewNopLogger(),
+		ModuleManager: app.ModuleManager,
+		Configurator:  app.Configurator(),
+		AccountKeeper: &app.AuthKeeper,
+		EVMKeeper:     app.EVMKeeper,
+	}
+	newVM, err := upgrade_v1_21_0.CreateUpgradeHandler(params)(ctx, upgradetypes.Plan{}, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, uint64(actiontypes.ConsensusVersion), newVM[actiontypes.ModuleName])
+
+	require.Equal(t, 1, countKeys(actionkeeper.ActionByExpirationPrefix), "v1→v2 should backfill the expiration index")
+	require.Equal(t, 2, countKeys(actionkeeper.ActionByMetadataPrefix), "v2→v3 should backfill the metadata index")
+}
@@ -1,12 +1,14 @@
 package app
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -14,7 +16,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/cosmos/evm/contracts"
 	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/statedb"
 
@@ -31,8 +35,12 @@ const DefaultCrossRuntimeGasCap uint64 = 3_000_000
 // ---------------------------------------------------------------------------
 
 // EVMCustomMsg is the top-level JSON envelope for CosmWasm -> EVM messages.
+// Exactly one field is expected to be set.
 type EVMCustomMsg struct {
-	EVMCall *EVMCallMsg `json:"evm_call,omitempty"`
+	EVMCall       *EVMCallMsg       `json:"evm_call,omitempty"`
+	EVMCreate     *EVMCreateMsg     `json:"evm_create,omitempty"`
+	EVMCreate2    *EVMCreate2Msg    `json:"evm_create2,omitempty"`
+	ERC20Transfer *ERC20TransferMsg `json:"erc20_transfer,omitempty"`
 }
 
 // EVMCallMsg describes a state-changing call to an EVM contract.
@@ -41,12 +49,57 @@ type EVMCallMsg struct {
 	Contract string `json:"contract"`
 	// Calldata is the hex-encoded EVM calldata (e.g. "0xa9059cbb...").
 	Calldata string `json:"calldata"`
+	// Value is the optional native amount to send, as a decimal string in the
+	// 18-decimal EVM denomination (alume). It is paid from the wasm contract's
+	// bank balance.
+	Value string `json:"value,omitempty"`
+}
+
+// EVMCreateMsg deploys an EVM contract with CREATE. The new address depends
+// on the nonce of the wasm contract's EVM identity.
+type EVMCreateMsg struct {
+	// Bytecode is the hex-encoded init code (creation bytecode + constructor args).
+	Bytecode string `json:"bytecode"`
+	// Value is the optional endowment in alume, paid from the wasm contract's bank balance.
+	Value string `json:"value,omitempty"`
+}
+
+// EVMCreate2Msg deploys an EVM contract with CREATE2 at an address derived
+// from the wasm contract's EVM identity, the salt and the init code hash.
+type EVMCreate2Msg struct {
+	// Bytecode is the hex-encoded init code (creation bytecode + constructor args).
+	Bytecode string `json:"bytecode"`
+	// Salt is a hex-encoded value of at most 32 bytes, left-padded to 32 bytes.
+	Salt string `json:"salt"`
+	// Value is the optional endowment in alume, paid from the wasm contract's bank balance.
+	Value string `json:"value,omitempty"`
+}
+
+// ERC20TransferMsg transfers an ERC20 token registered in x/erc20 from the
+// wasm contract to a recipient.
+type ERC20TransferMsg struct {
+	// Token is a bank denom with a registered token pair (e.g. "ibc/...") or
+	// the hex-encoded ERC20 contract address.
+	Token string `json:"token"`
+	// Recipient is a hex EVM address or a bech32 account address.
+	Recipient string `json:"recipient"`
+	// Amount is the amount in the token's base units, as a decimal string.
+	Amount string `json:"amount"`
+}
+
+// EVMCreateResponse is the message data returned for evm_create and evm_create2.
+type EVMCreateResponse struct {
+	// Address is the hex-encoded address of the deployed contract.
+	Address string `json:"address"`
 }
 
 // EVMCustomQuery is the top-level JSON envelope for CosmWasm -> EVM queries.
 type EVMCustomQuery struct {
-	EVMCall    *EVMCallQuery    `json:"evm_call,omitempty"`
-	EVMAccount *EVMAccountQuery `json:"evm_account,omitempty"`
+	EVMCall        *EVMCallQuery        `json:"evm_call,omitempty"`
+	EVMAccount     *EVMAccountQuery     `json:"evm_account,omitempty"`
+	EVMStorageAt   *EVMStorageAtQuery   `json:"evm_storage_at,omitempty"`
+	EVMCode        *EVMCodeQuery        `json:"evm_code,omitempty"`
+	ERC20TokenPair *ERC20TokenPairQuery `json:"erc20_token_pair,omitempty"`
 }
 
 // EVMCallQuery describes a read-only (eth_call equivalent) EVM query.
@@ -70,6 +123,46 @@ type EVMAccountResponse struct {
 	IsContract bool `json:"is_contract"`
 }
 
+// EVMStorageAtQuery reads a single storage slot of an EVM account.
+type EVMStorageAtQuery struct {
+	Address string `json:"address"`
+	// Slot is the hex-encoded storage key of at most 32 bytes, left-padded to 32 bytes.
+	Slot string `json:"slot"`
+}
+
+// EVMStorageAtResponse is returned for evm_storage_at queries.
+type EVMStorageAtResponse struct {
+	// Value is the 32-byte slot value, hex-encoded.
+	Value string `json:"value"`
+}
+
+// EVMCodeQuery reads the deployed bytecode of an EVM account.
+type EVMCodeQuery struct {
+	Address string `json:"address"`
+}
+
+// EVMCodeResponse is returned for evm_code queries.
+type EVMCodeResponse struct {
+	// Code is the hex-encoded runtime bytecode ("0x" for accounts without code).
+	Code string `json:"code"`
+	// CodeHash is the hex-encoded keccak256 of the code.
+	CodeHash string `json:"code_hash"`
+}
+
+// ERC20TokenPairQuery looks up an x/erc20 token pair by bank denom or ERC20 address.
+type ERC20TokenPairQuery struct {
+	Token string `json:"token"`
+}
+
+// ERC20TokenPairResponse is returned for erc20_token_pair queries.
+type ERC20TokenPairResponse struct {
+	ERC20Address string `json:"erc20_address"`
+	Denom        string `json:"denom"`
+	Enabled      bool   `json:"enabled"`
+	// ContractOwner is "module" for native-coin pairs and "external" for native ERC20 pairs.
+	ContractOwner string `json:"contract_owner"`
+}
+
 // ---------------------------------------------------------------------------
 // Gas cap helper
 // ---------------------------------------------------------------------------
@@ -117,20 +210,36 @@ func parseEVMAddress(s string) (common.Address, error) {
 // EVM Message Handler (state-changing calls from CosmWasm)
 // ---------------------------------------------------------------------------
 
+// evmValueSender moves native value and bank-backed tokens from a wasm
+// contract to its EVM identity. The precisebank keeper is used so 18-decimal
+// alume amounts are transferred exactly.
+type evmValueSender interface {
+	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+}
+
 // evmMessageHandler implements wasmkeeper.Messenger for EVM custom messages.
 type evmMessageHandler struct {
-	evmKeeper *keeper.Keeper
-	next      wasmkeeper.Messenger
+	evmKeeper   *keeper.Keeper
+	erc20Keeper erc20TokenPairKeeper
+	bankKeeper  evmValueSender
+	next        wasmkeeper.Messenger
 }
 
 // NewEVMMessageHandler returns a WithMessageHandlerDecorator function that
-// intercepts CosmosMsg.Custom payloads containing evm_call and routes them
-// to the EVM keeper. Non-matching messages fall through to the next handler.
-func NewEVMMessageHandler(evmKeeper *keeper.Keeper) func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+// intercepts CosmosMsg.Custom payloads containing evm_call, evm_create,
+// evm_create2 or erc20_transfer and routes them to the EVM keeper.
+// Non-matching messages fall through to the next handler.
+func NewEVMMessageHandler(
+	evmKeeper *keeper.Keeper,
+	erc20Keeper erc20TokenPairKeeper,
+	bankKeeper evmValueSender,
+) func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &evmMessageHandler{
-			evmKeeper: evmKeeper,
-			next:      old,
+			evmKeeper:   evmKeeper,
+			erc20Keeper: erc20Keeper,
+			bankKeeper:  bankKeeper,
+			next:        old,
 		}
 	}
 }
@@ -149,7 +258,7 @@ func (h *evmMessageHandler) DispatchMsg(
 	if err := json.Unmarshal(msg.Custom, &evmMsg); err != nil {
 		return h.next.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 	}
-	if evmMsg.EVMCall == nil {
+	if evmMsg.EVMCall == nil && evmMsg.EVMCreate == nil && evmMsg.EVMCreate2 == nil && evmMsg.ERC20Transfer == nil {
 		return h.next.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 	}
 
@@ -159,63 +268,199 @@ func (h *evmMessageHandler) DispatchMsg(
 		return nil, nil, nil, err
 	}
 
-	// Convert wasm contract address (the caller) to EVM address
-	callerEVMAddr := crossruntime.AccAddrToEVMAddr(contractAddr)
+	switch {
+	case evmMsg.EVMCall != nil:
+		return h.dispatchEVMCall(ctx, contractAddr, evmMsg.EVMCall)
+	case evmMsg.EVMCreate != nil:
+		return h.dispatchEVMCreate(ctx, contractAddr, evmMsg.EVMCreate)
+	case evmMsg.EVMCreate2 != nil:
+		return h.dispatchEVMCreate2(ctx, contractAddr, evmMsg.EVMCreate2)
+	default:
+		return h.dispatchERC20Transfer(ctx, contractAddr, evmMsg.ERC20Transfer)
+	}
+}
 
+// dispatchEVMCall executes evm_call. The message data is the raw EVM return data.
+func (h *evmMessageHandler) dispatchEVMCall(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	m *EVMCallMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
 	// Parse target contract address (strict validation)
-	targetAddr, err := parseEVMAddress(evmMsg.EVMCall.Contract)
+	targetAddr, err := parseEVMAddress(m.Contract)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("invalid target contract: %w", err)
 	}
 
 	// Decode calldata from hex
-	calldata, err := parseHexBytes(evmMsg.EVMCall.Calldata)
+	calldata, err := parseHexBytes(m.Calldata)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("invalid calldata hex: %w", err)
 	}
 
-	// Get nonce for the caller account
-	acct := h.evmKeeper.GetAccountOrEmpty(ctx, callerEVMAddr)
+	value, err := parseEVMValue(m.Value)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-	// Create stateDB for this call
-	txConfig := statedb.NewEmptyTxConfig()
-	evmStateDB := statedb.New(ctx, h.evmKeeper, txConfig)
+	ctx, err = crossruntime.LockContracts(ctx, contractAddr, targetAddr.Bytes())
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-	// Build EVM core message with gas cap
-	gasCap := gasCapForCall(ctx)
-	evmCoreMsg := core.Message{
-		From:       callerEVMAddr,
-		To:         &targetAddr,
-		Nonce:      acct.Nonce,
-		Value:      big.NewInt(0), // Phase 1: non-payable
-		GasLimit:   gasCap,
-		GasPrice:   big.NewInt(0),
-		GasTipCap:  big.NewInt(0),
-		GasFeeCap:  big.NewInt(0),
-		Data:       calldata,
-		AccessList: ethtypes.AccessList{},
+	res, err := h.applyEVMMessage(ctx, contractAddr, &targetAddr, calldata, value, "wasm->evm call")
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	// Execute: commit=true, callFromPrecompile=false, internal=true
-	res, err := h.evmKeeper.ApplyMessage(ctx, evmStateDB, evmCoreMsg, nil, true, false, true)
+	return evmLogEvents(res.Logs), [][]byte{res.Ret}, nil, nil
+}
+
+// dispatchEVMCreate executes evm_create. The message data is a JSON
+// EVMCreateResponse.
+func (h *evmMessageHandler) dispatchEVMCreate(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	m *EVMCreateMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	initCode, err := parseHexBytes(m.Bytecode)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("evm call failed: %w", err)
+		return nil, nil, nil, fmt.Errorf("invalid bytecode hex: %w", err)
+	}
+	if len(initCode) == 0 {
+		return nil, nil, nil, fmt.Errorf("empty bytecode")
 	}
 
-	// Charge gas (always, even on VM error — gas was consumed)
-	if res.GasUsed > 0 {
-		ctx.GasMeter().ConsumeGas(res.GasUsed, "wasm->evm call")
+	value, err := parseEVMValue(m.Value)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	// Check for EVM-level failure (revert, out-of-gas, etc.)
-	if res.Failed() {
-		if res.VmError != "" {
-			return nil, nil, nil, fmt.Errorf("evm execution reverted: %s", res.VmError)
+	ctx, err = crossruntime.LockContracts(ctx, contractAddr, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// CREATE derives the address from the sender nonce before it is bumped.
+	callerEVMAddr := crossruntime.AccAddrToEVMAddr(contractAddr)
+	created := crypto.CreateAddress(callerEVMAddr, h.evmKeeper.GetAccountOrEmpty(ctx, callerEVMAddr).Nonce)
+
+	res, err := h.applyEVMMessage(ctx, contractAddr, nil, initCode, value, "wasm->evm create")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	data, err := json.Marshal(EVMCreateResponse{Address: created.Hex()})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return evmLogEvents(res.Logs), [][]byte{data}, nil, nil
+}
+
+// dispatchEVMCreate2 executes evm_create2. The message data is a JSON
+// EVMCreateResponse.
+func (h *evmMessageHandler) dispatchEVMCreate2(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	m *EVMCreate2Msg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	initCode, err := parseHexBytes(m.Bytecode)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid bytecode hex: %w", err)
+	}
+	if len(initCode) == 0 {
+		return nil, nil, nil, fmt.Errorf("empty bytecode")
+	}
+
+	salt, err := parseHash32(m.Salt)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid salt: %w", err)
+	}
+
+	value, err := parseEVMValue(m.Value)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx, err = crossruntime.LockContracts(ctx, contractAddr, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	created, logs, err := h.applyEVMCreate2(ctx, contractAddr, initCode, salt, value)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	data, err := json.Marshal(EVMCreateResponse{Address: created.Hex()})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return evmLogEvents(logs), [][]byte{data}, nil, nil
+}
+
+// dispatchERC20Transfer executes erc20_transfer by calling transfer() on the
+// ERC20 contract of the resolved token pair. For native-coin pairs the amount
+// is first moved from the wasm contract's bank balance to its EVM identity.
+// The message data is the raw EVM return data.
+func (h *evmMessageHandler) dispatchERC20Transfer(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	m *ERC20TransferMsg,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	pair, err := resolveTokenPair(ctx, h.erc20Keeper, m.Token)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !pair.Enabled {
+		return nil, nil, nil, fmt.Errorf("token pair for %s is disabled", m.Token)
+	}
+
+	recipient, err := parseRecipient(m.Recipient)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	amount, ok := new(big.Int).SetString(m.Amount, 10)
+	if !ok || amount.Sign() <= 0 || amount.BitLen() > 256 {
+		return nil, nil, nil, fmt.Errorf("invalid amount %q: must be a positive integer", m.Amount)
+	}
+
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	calldata, err := erc20ABI.Pack("transfer", recipient, amount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	erc20Addr := pair.GetERC20Contract()
+	ctx, err = crossruntime.LockContracts(ctx, contractAddr, erc20Addr.Bytes())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if pair.IsNativeCoin() {
+		callerEVMAddr := crossruntime.AccAddrToEVMAddr(contractAddr)
+		if err := h.fundEVMIdentity(ctx, contractAddr, callerEVMAddr, sdk.NewCoin(pair.Denom, sdkmath.NewIntFromBigInt(amount))); err != nil {
+			return nil, nil, nil, err
 		}
-		return nil, nil, nil, fmt.Errorf("evm execution failed")
 	}
 
-	return nil, [][]byte{res.Ret}, nil, nil
+	res, err := h.applyEVMMessage(ctx, contractAddr, &erc20Addr, calldata, big.NewInt(0), "wasm->evm erc20 transfer")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Some tokens signal failure by returning false instead of reverting.
+	if len(res.Ret) > 0 {
+		out, err := erc20ABI.Unpack("transfer", res.Ret)
+		if err == nil && len(out) == 1 {
+			if success, ok := out[0].(bool); ok && !success {
+				return nil, nil, nil, fmt.Errorf("erc20 transfer of %s returned false", m.Token)
+			}
+		}
+	}
+
+	return evmLogEvents(res.Logs), [][]byte{res.Ret}, nil, nil
 }
 
 // ---------------------------------------------------------------------------
@@ -223,10 +468,14 @@ func (h *evmMessageHandler) DispatchMsg(
 // ---------------------------------------------------------------------------
 
 // NewEVMQueryHandlerDecorator returns a WithQueryHandlerDecorator function
-// that intercepts QueryRequest.Custom payloads containing evm_call or
-// evm_account and routes them to the EVM keeper. Non-matching queries
-// fall through to the wrapped handler.
-func NewEVMQueryHandlerDecorator(evmKeeper *keeper.Keeper) func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+// that intercepts QueryRequest.Custom payloads containing evm_call,
+// evm_account, evm_storage_at, evm_code or erc20_token_pair and routes them to
+// the EVM and erc20 keepers. Non-matching queries fall through to the wrapped
+// handler.
+func NewEVMQueryHandlerDecorator(
+	evmKeeper *keeper.Keeper,
+	erc20Keeper erc20TokenPairKeeper,
+) func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
 	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
 		return wasmkeeper.WasmVMQueryHandlerFn(
 			func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
@@ -238,25 +487,36 @@ func NewEVMQueryHandlerDecorator(evmKeeper *keeper.Keeper) func(old wasmkeeper.W
 				if err := json.Unmarshal(request.Custom, &evmQuery); err != nil {
 					return old.HandleQuery(ctx, caller, request)
 				}
+				if evmQuery.EVMCall == nil && evmQuery.EVMAccount == nil && evmQuery.EVMStorageAt == nil &&
+					evmQuery.EVMCode == nil && evmQuery.ERC20TokenPair == nil {
+					return old.HandleQuery(ctx, caller, request)
+				}
+
+				// Check reentrancy guard. Queries count toward cross-runtime depth
+				// but do not take per-contract locks: they cannot change state, so
+				// reading a contract that is mid-execution is allowed.
+				ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+				if err != nil {
+					return nil, err
+				}
 
 				switch {
 				case evmQuery.EVMCall != nil:
-					// Check reentrancy guard (queries count toward cross-runtime depth)
-					ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
-					if err != nil {
-						return nil, err
-					}
 					return handleEVMCallQuery(ctx, evmKeeper, caller, evmQuery.EVMCall)
 				case evmQuery.EVMAccount != nil:
-					// evm_account is a simple state read, no EVM execution — no reentrancy risk.
-					// But still enforce the guard for consistency with the "max depth = 1" design.
-					ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
-					if err != nil {
-						return nil, err
-					}
 					return handleEVMAccountQuery(ctx, evmKeeper, evmQuery.EVMAccount)
+				case evmQuery.EVMStorageAt != nil:
+					return runWithGasCap(ctx, "wasm->evm storage query", func(ctx sdk.Context) ([]byte, error) {
+						return handleEVMStorageAtQuery(ctx, evmKeeper, evmQuery.EVMStorageAt)
+					})
+				case evmQuery.EVMCode != nil:
+					return runWithGasCap(ctx, "wasm->evm code query", func(ctx sdk.Context) ([]byte, error) {
+						return handleEVMCodeQuery(ctx, evmKeeper, evmQuery.EVMCode)
+					})
 				default:
-					return old.HandleQuery(ctx, caller, request)
+					return runWithGasCap(ctx, "wasm->erc20 token pair query", func(ctx sdk.Context) ([]byte, error) {
+						return handleERC20TokenPairQuery(ctx, erc20Keeper, evmQuery.ERC20TokenPair)
+					})
 				}
 			})
 	}
@@ -355,13 +615,17 @@ var emptyCodeHash = common.FromHex("c5d2460186f7233c927e7db2dcc703c0e500b653ca82
 // EVMWasmPluginOpts returns wasmkeeper.Option values that wire the EVM
 // message handler and query handler decorator into the wasm keeper.
 // These must be appended to wasmOpts BEFORE the wasm keeper is created.
-func EVMWasmPluginOpts(evmKeeper *keeper.Keeper) []wasmkeeper.Option {
+func EVMWasmPluginOpts(
+	evmKeeper *keeper.Keeper,
+	erc20Keeper erc20TokenPairKeeper,
+	bankKeeper evmValueSender,
+) []wasmkeeper.Option {
 	return []wasmkeeper.Option{
 		wasmkeeper.WithMessageHandlerDecorator(
-			NewEVMMessageHandler(evmKeeper),
+			NewEVMMessageHandler(evmKeeper, erc20Keeper, bankKeeper),
 		),
 		wasmkeeper.WithQueryHandlerDecorator(
-			NewEVMQueryHandlerDecorator(evmKeeper),
+			NewEVMQueryHandlerDecorator(evmKeeper, erc20Keeper),
 		),
 	}
 }
@@ -0,0 +1,389 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/utils"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	lcfg "github.com/LumeraProtocol/lumera/config"
+	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
+)
+
+// EventTypeEVMLog is the sdk event type used to surface EVM logs emitted
+// during wasm -> EVM messages.
+const EventTypeEVMLog = "evm_log"
+
+// ---------------------------------------------------------------------------
+// Message execution helpers
+// ---------------------------------------------------------------------------
+
+// applyEVMMessage runs a call (to != nil) or CREATE (to == nil) from the wasm
+// contract's EVM identity, capped at gasCapForCall. The value is moved from
+// the wasm contract's bank balance before execution. Gas used is charged to
+// the wasm gas meter even when execution fails.
+func (h *evmMessageHandler) applyEVMMessage(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	to *common.Address,
+	data []byte,
+	value *big.Int,
+	descriptor string,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	callerEVMAddr := crossruntime.AccAddrToEVMAddr(contractAddr)
+
+	if err := h.fundEVMIdentity(ctx, contractAddr, callerEVMAddr, alumeCoin(value)); err != nil {
+		return nil, err
+	}
+
+	// Get nonce for the caller account
+	acct := h.evmKeeper.GetAccountOrEmpty(ctx, callerEVMAddr)
+
+	// Create stateDB for this call. Inside a wasm precompile call its commits
+	// are tracked so the calling EVM stateDB can be synced afterwards.
+	txConfig := statedb.NewEmptyTxConfig()
+	evmStateDB := crossruntime.NewStateDB(ctx, h.evmKeeper, txConfig)
+
+	// Build EVM core message with gas cap
+	evmCoreMsg := core.Message{
+		From:       callerEVMAddr,
+		To:         to,
+		Nonce:      acct.Nonce,
+		Value:      value,
+		GasLimit:   gasCapForCall(ctx),
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	// Execute: commit=true, callFromPrecompile=false, internal=true
+	res, err := h.evmKeeper.ApplyMessage(ctx, evmStateDB, evmCoreMsg, nil, true, false, true)
+	if err != nil {
+		return nil, fmt.Errorf("evm call failed: %w", err)
+	}
+
+	// Charge gas (always, even on VM error — gas was consumed)
+	if res.GasUsed > 0 {
+		ctx.GasMeter().ConsumeGas(res.GasUsed, descriptor)
+	}
+
+	// Check for EVM-level failure (revert, out-of-gas, etc.)
+	if res.Failed() {
+		if res.VmError != "" {
+			return nil, fmt.Errorf("evm execution reverted: %s", res.VmError)
+		}
+		return nil, fmt.Errorf("evm execution failed")
+	}
+
+	return res, nil
+}
+
+// applyEVMCreate2 deploys initCode with CREATE2 from the wasm contract's EVM
+// identity. ApplyMessage only supports CREATE, so this mirrors its gas and
+// commit handling around evm.Create2.
+func (h *evmMessageHandler) applyEVMCreate2(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	initCode []byte,
+	salt common.Hash,
+	value *big.Int,
+) (common.Address, []*evmtypes.Log, error) {
+	callerEVMAddr := crossruntime.AccAddrToEVMAddr(contractAddr)
+
+	if err := h.fundEVMIdentity(ctx, contractAddr, callerEVMAddr, alumeCoin(value)); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	endowment, err := utils.Uint256FromBigInt(value)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	cfg, err := h.evmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("evm call failed: %w", err)
+	}
+
+	acct := h.evmKeeper.GetAccountOrEmpty(ctx, callerEVMAddr)
+	gasCap := gasCapForCall(ctx)
+	evmCoreMsg := core.Message{
+		From:       callerEVMAddr,
+		Nonce:      acct.Nonce,
+		Value:      value,
+		GasLimit:   gasCap,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       initCode,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	ethCfg := evmtypes.GetEthChainConfig()
+	intrinsicGas, err := h.evmKeeper.GetEthIntrinsicGas(ctx, evmCoreMsg, ethCfg, true)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("evm call failed: %w", err)
+	}
+	if gasCap < intrinsicGas {
+		return common.Address{}, nil, fmt.Errorf("evm call failed: %w", core.ErrIntrinsicGas)
+	}
+
+	evmStateDB := crossruntime.NewStateDB(ctx, h.evmKeeper, statedb.NewEmptyTxConfig())
+	evm := h.evmKeeper.NewEVM(ctx, evmCoreMsg, cfg, nil, evmStateDB)
+	rules := ethCfg.Rules(evm.Context.BlockNumber, true, evm.Context.Time)
+	evmStateDB.Prepare(rules, callerEVMAddr, common.Address{}, nil, evm.ActivePrecompiles(), nil)
+
+	_, created, leftoverGas, vmErr := evm.Create2(
+		callerEVMAddr, initCode, gasCap-intrinsicGas, endowment, new(uint256.Int).SetBytes(salt.Bytes()),
+	)
+
+	// Internal calls get a full refund, matching ApplyMessage.
+	maxUsedGas := gasCap - leftoverGas
+	gasUsed := maxUsedGas - keeper.GasToRefund(evmStateDB.GetRefund(), maxUsedGas, 1)
+	if gasUsed > 0 {
+		ctx.GasMeter().ConsumeGas(gasUsed, "wasm->evm create2")
+	}
+
+	if vmErr != nil {
+		return common.Address{}, nil, fmt.Errorf("evm execution reverted: %s", vmErr)
+	}
+
+	if err := evmStateDB.Commit(); err != nil {
+		return common.Address{}, nil, fmt.Errorf("evm call failed: %w", err)
+	}
+
+	return created, evmtypes.NewLogsFromEth(evmStateDB.Logs()), nil
+}
+
+// fundEVMIdentity moves coin from the wasm contract's bank account to the bank
+// account backing its EVM identity. A wasm address is 32 bytes while its EVM
+// identity is the last 20, so the two are distinct accounts.
+func (h *evmMessageHandler) fundEVMIdentity(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	evmAddr common.Address,
+	coin sdk.Coin,
+) error {
+	if coin.IsZero() {
+		return nil
+	}
+	evmAccAddr := sdk.AccAddress(evmAddr.Bytes())
+	if evmAccAddr.Equals(contractAddr) {
+		return nil
+	}
+	if h.bankKeeper == nil {
+		return fmt.Errorf("value transfers are not supported")
+	}
+	// The EVM ignores balances of addresses without an auth account, and a
+	// fractional-only alume transfer does not create one.
+	if h.evmKeeper.GetAccount(ctx, evmAddr) == nil {
+		if err := h.evmKeeper.SetAccount(ctx, evmAddr, *statedb.NewEmptyAccount()); err != nil {
+			return fmt.Errorf("failed to create evm identity account: %w", err)
+		}
+	}
+	if err := h.bankKeeper.SendCoins(ctx, contractAddr, evmAccAddr, sdk.NewCoins(coin)); err != nil {
+		return fmt.Errorf("failed to fund evm identity: %w", err)
+	}
+	return nil
+}
+
+// alumeCoin wraps an EVM value in the 18-decimal extended denomination.
+func alumeCoin(value *big.Int) sdk.Coin {
+	return sdk.NewCoin(lcfg.ChainEVMExtendedDenom, sdkmath.NewIntFromBigInt(value))
+}
+
+// evmLogEvents converts EVM logs to sdk events so wasm callers and indexers
+// can observe them.
+func evmLogEvents(logs []*evmtypes.Log) []sdk.Event {
+	if len(logs) == 0 {
+		return nil
+	}
+	events := make([]sdk.Event, 0, len(logs))
+	for _, log := range logs {
+		events = append(events, sdk.NewEvent(
+			EventTypeEVMLog,
+			sdk.NewAttribute("address", log.Address),
+			sdk.NewAttribute("topics", strings.Join(log.Topics, ",")),
+			sdk.NewAttribute("data", "0x"+hex.EncodeToString(log.Data)),
+		))
+	}
+	return events
+}
+
+// ---------------------------------------------------------------------------
+// Query helpers
+// ---------------------------------------------------------------------------
+
+// runWithGasCap runs a state-reading query on a child gas meter capped like an
+// EVM call, then charges the consumed gas to the caller's meter.
+func runWithGasCap(ctx sdk.Context, descriptor string, fn func(sdk.Context) ([]byte, error)) (res []byte, err error) {
+	meter := storetypes.NewGasMeter(gasCapForCall(ctx))
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, fmt.Errorf("%s: out of gas in %s", descriptor, oog.Descriptor)
+		}
+		ctx.GasMeter().ConsumeGas(meter.GasConsumedToLimit(), descriptor)
+	}()
+	return fn(ctx.WithGasMeter(meter))
+}
+
+// handleEVMStorageAtQuery returns the value of a storage slot.
+func handleEVMStorageAtQuery(
+	ctx sdk.Context,
+	evmKeeper *keeper.Keeper,
+	q *EVMStorageAtQuery,
+) ([]byte, error) {
+	addr, err := parseEVMAddress(q.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account address: %w", err)
+	}
+	slot, err := parseHash32(q.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage slot: %w", err)
+	}
+
+	value := evmKeeper.GetState(ctx, addr, slot)
+	return json.Marshal(EVMStorageAtResponse{Value: value.Hex()})
+}
+
+// handleEVMCodeQuery returns the deployed bytecode of an EVM account.
+func handleEVMCodeQuery(
+	ctx sdk.Context,
+	evmKeeper *keeper.Keeper,
+	q *EVMCodeQuery,
+) ([]byte, error) {
+	addr, err := parseEVMAddress(q.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account address: %w", err)
+	}
+
+	acct := evmKeeper.GetAccountOrEmpty(ctx, addr)
+	codeHash := common.BytesToHash(emptyCodeHash)
+	var code []byte
+	if len(acct.CodeHash) > 0 {
+		codeHash = common.BytesToHash(acct.CodeHash)
+	}
+	if codeHash != common.BytesToHash(emptyCodeHash) {
+		code = evmKeeper.GetCode(ctx, codeHash)
+	}
+
+	return json.Marshal(EVMCodeResponse{
+		Code:     "0x" + hex.EncodeToString(code),
+		CodeHash: codeHash.Hex(),
+	})
+}
+
+// handleERC20TokenPairQuery returns the x/erc20 token pair for a denom or
+// ERC20 contract address.
+func handleERC20TokenPairQuery(
+	ctx sdk.Context,
+	erc20Keeper erc20TokenPairKeeper,
+	q *ERC20TokenPairQuery,
+) ([]byte, error) {
+	pair, err := resolveTokenPair(ctx, erc20Keeper, q.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := "external"
+	if pair.IsNativeCoin() {
+		owner = "module"
+	}
+	return json.Marshal(ERC20TokenPairResponse{
+		ERC20Address:  pair.GetERC20Contract().Hex(),
+		Denom:         pair.Denom,
+		Enabled:       pair.Enabled,
+		ContractOwner: owner,
+	})
+}
+
+// ---------------------------------------------------------------------------
+// Parsing helpers
+// ---------------------------------------------------------------------------
+
+// resolveTokenPair looks up a token pair by bank denom or hex ERC20 address.
+func resolveTokenPair(ctx sdk.Context, erc20Keeper erc20TokenPairKeeper, token string) (erc20types.TokenPair, error) {
+	if erc20Keeper == nil {
+		return erc20types.TokenPair{}, fmt.Errorf("erc20 token pairs are not supported")
+	}
+	if token == "" {
+		return erc20types.TokenPair{}, fmt.Errorf("empty token")
+	}
+	id := erc20Keeper.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return erc20types.TokenPair{}, fmt.Errorf("token pair not found for %s", token)
+	}
+	pair, found := erc20Keeper.GetTokenPair(ctx, id)
+	if !found {
+		return erc20types.TokenPair{}, fmt.Errorf("token pair not found for %s", token)
+	}
+	return pair, nil
+}
+
+// parseEVMValue parses an optional alume amount. An empty string is zero.
+func parseEVMValue(s string) (*big.Int, error) {
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok || value.Sign() < 0 || value.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid value %q: must be a non-negative integer", s)
+	}
+	return value, nil
+}
+
+// parseHash32 decodes a hex value of at most 32 bytes, left-padded to 32 bytes.
+// Odd-length input such as "0x0" is accepted as a quantity.
+func parseHash32(s string) (common.Hash, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	b, err := parseHexBytes(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("expected at most %d bytes, got %d", common.HashLength, len(b))
+	}
+	return common.BytesToHash(b), nil
+}
+
+// parseRecipient accepts a hex EVM address or a 20-byte bech32 account address.
+func parseRecipient(s string) (common.Address, error) {
+	if strings.HasPrefix(s, "0x") {
+		addr, err := parseEVMAddress(s)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("invalid recipient: %w", err)
+		}
+		return addr, nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid recipient: %w", err)
+	}
+	if len(accAddr) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid recipient: expected a 20-byte address, got %d bytes", len(accAddr))
+	}
+	return common.BytesToAddress(accAddr), nil
+}
@@ -13,6 +13,8 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
@@ -211,6 +213,13 @@ func freshSDKCtx() sdk.Context {
 	return ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
 }
 
+func maxDepthCtx(ctx sdk.Context) sdk.Context {
+	for i := 0; i < crossruntime.MaxCrossRuntimeDepth; i++ {
+		ctx = crossruntime.WithIncrementedDepth(ctx)
+	}
+	return ctx
+}
+
 // ---------------------------------------------------------------------------
 // Message handler: passthrough tests
 // ---------------------------------------------------------------------------
@@ -290,9 +299,8 @@ func TestEVMMessageHandler_EVMCallNilPassesThrough(t *testing.T) {
 func TestEVMMessageHandler_ReentrancyBlocked(t *testing.T) {
 	handler := &evmMessageHandler{evmKeeper: nil, next: &mockMessenger{}}
 
-	// Set depth to max (simulating we're already inside a cross-runtime call)
-	ctx := freshSDKCtx()
-	ctx = crossruntime.WithIncrementedDepth(ctx)
+	// Set depth to max (simulating we're already inside nested cross-runtime calls)
+	ctx := maxDepthCtx(freshSDKCtx())
 
 	msg := wasmvmtypes.CosmosMsg{
 		Custom: json.RawMessage(`{"evm_call":{"contract":"0x1234567890abcdef1234567890abcdef12345678","calldata":"0x00"}}`),
@@ -348,7 +356,7 @@ func TestEVMMessageHandler_InvalidCalldataHex(t *testing.T) {
 
 func TestEVMQueryHandler_NilCustomPassesThrough(t *testing.T) {
 	mock := &mockQueryHandler{}
-	decorator := NewEVMQueryHandlerDecorator(nil)
+	decorator := NewEVMQueryHandlerDecorator(nil, nil)
 	handler := decorator(mock)
 
 	ctx := freshSDKCtx()
@@ -367,7 +375,7 @@ func TestEVMQueryHandler_NilCustomPassesThrough(t *testing.T) {
 
 func TestEVMQueryHandler_NonEVMCustomPassesThrough(t *testing.T) {
 	mock := &mockQueryHandler{}
-	decorator := NewEVMQueryHandlerDecorator(nil)
+	decorator := NewEVMQueryHandlerDecorator(nil, nil)
 	handler := decorator(mock)
 
 	ctx := freshSDKCtx()
@@ -386,7 +394,7 @@ func TestEVMQueryHandler_NonEVMCustomPassesThrough(t *testing.T) {
 
 func TestEVMQueryHandler_MalformedJSONPassesThrough(t *testing.T) {
 	mock := &mockQueryHandler{}
-	decorator := NewEVMQueryHandlerDecorator(nil)
+	decorator := NewEVMQueryHandlerDecorator(nil, nil)
 	handler := decorator(mock)
 
 	ctx := freshSDKCtx()
@@ -407,11 +415,10 @@ func TestEVMQueryHandler_MalformedJSONPassesThrough(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestEVMQueryHandler_EVMCallReentrancyBlocked(t *testing.T) {
-	decorator := NewEVMQueryHandlerDecorator(nil)
+	decorator := NewEVMQueryHandlerDecorator(nil, nil)
 	handler := decorator(&mockQueryHandler{})
 
-	ctx := freshSDKCtx()
-	ctx = crossruntime.WithIncrementedDepth(ctx)
+	ctx := maxDepthCtx(freshSDKCtx())
 
 	req := wasmvmtypes.QueryRequest{
 		Custom: json.RawMessage(`{"evm_call":{"contract":"0x1234567890abcdef1234567890abcdef12345678","calldata":"0x00"}}`),
@@ -426,11 +433,10 @@ func TestEVMQueryHandler_EVMCallReentrancyBlocked(t *testing.T) {
 }
 
 func TestEVMQueryHandler_EVMAccountReentrancyBlocked(t *testing.T) {
-	decorator := NewEVMQueryHandlerDecorator(nil)
+	decorator := NewEVMQueryHandlerDecorator(nil, nil)
 	handler := decorator(&mockQueryHandler{})
 
-	ctx := freshSDKCtx()
-	ctx = crossruntime.WithIncrementedDepth(ctx)
+	ctx := maxDepthCtx(freshSDKCtx())
 
 	req := wasmvmtypes.QueryRequest{
 		Custom: json.RawMessage(`{"evm_account":{"address":"0x1234567890abcdef1234567890abcdef12345678"}}`),
@@ -443,3 +449,280 @@ func TestEVMQueryHandler_EVMAccountReentrancyBlocked(t *testing.T) {
 		t.Fatalf("expected ErrReentrancyNotAllowed, got: %v", err)
 	}
 }
+
+func TestEVMQueryHandler_NewQueriesReentrancyBlocked(t *testing.T) {
+	queries := map[string]string{
+		"evm_storage_at":   `{"evm_storage_at":{"address":"0x1234567890abcdef1234567890abcdef12345678","slot":"0x00"}}`,
+		"evm_code":         `{"evm_code":{"address":"0x1234567890abcdef1234567890abcdef12345678"}}`,
+		"erc20_token_pair": `{"erc20_token_pair":{"token":"ulume"}}`,
+	}
+	for name, custom := range queries {
+		t.Run(name, func(t *testing.T) {
+			handler := NewEVMQueryHandlerDecorator(nil, nil)(&mockQueryHandler{})
+			ctx := maxDepthCtx(freshSDKCtx())
+
+			_, err := handler.HandleQuery(ctx, sdk.AccAddress(make([]byte, 20)), wasmvmtypes.QueryRequest{
+				Custom: json.RawMessage(custom),
+			})
+			if !errors.Is(err, crossruntime.ErrReentrancyNotAllowed) {
+				t.Fatalf("expected ErrReentrancyNotAllowed, got: %v", err)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Message handler: evm_create, evm_create2 and erc20_transfer
+// ---------------------------------------------------------------------------
+
+func TestEVMMessageHandler_NewMessagesReentrancyBlocked(t *testing.T) {
+	msgs := map[string]string{
+		"evm_create":     `{"evm_create":{"bytecode":"0x6000"}}`,
+		"evm_create2":    `{"evm_create2":{"bytecode":"0x6000","salt":"0x01"}}`,
+		"erc20_transfer": `{"erc20_transfer":{"token":"ulume","recipient":"0x1234567890abcdef1234567890abcdef12345678","amount":"1"}}`,
+	}
+	for name, custom := range msgs {
+		t.Run(name, func(t *testing.T) {
+			mock := &mockMessenger{}
+			handler := &evmMessageHandler{evmKeeper: nil, next: mock}
+			ctx := maxDepthCtx(freshSDKCtx())
+
+			_, _, _, err := handler.DispatchMsg(ctx, sdk.AccAddress(make([]byte, 32)), "", wasmvmtypes.CosmosMsg{
+				Custom: json.RawMessage(custom),
+			})
+			if !errors.Is(err, crossruntime.ErrReentrancyNotAllowed) {
+				t.Fatalf("expected ErrReentrancyNotAllowed, got: %v", err)
+			}
+			if mock.called {
+				t.Fatal("EVM message must not fall through to the next handler")
+			}
+		})
+	}
+}
+
+func TestEVMMessageHandler_NewMessagesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		custom  string
+		wantErr string
+	}{
+		{"create bad hex", `{"evm_create":{"bytecode":"0xZZ"}}`, "invalid bytecode hex"},
+		{"create empty bytecode", `{"evm_create":{"bytecode":"0x"}}`, "empty bytecode"},
+		{"create negative value", `{"evm_create":{"bytecode":"0x6000","value":"-1"}}`, "invalid value"},
+		{"create2 empty bytecode", `{"evm_create2":{"bytecode":"","salt":"0x01"}}`, "empty bytecode"},
+		{"create2 long salt", `{"evm_create2":{"bytecode":"0x6000","salt":"0x` + strings.Repeat("11", 33) + `"}}`, "invalid salt"},
+		{"call bad value", `{"evm_call":{"contract":"0x1234567890abcdef1234567890abcdef12345678","calldata":"0x","value":"1.5"}}`, "invalid value"},
+		{"erc20 without keeper", `{"erc20_transfer":{"token":"ulume","recipient":"0x1234567890abcdef1234567890abcdef12345678","amount":"1"}}`, "not supported"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &evmMessageHandler{evmKeeper: nil, next: &mockMessenger{}}
+			_, _, _, err := handler.DispatchMsg(freshSDKCtx(), sdk.AccAddress(make([]byte, 32)), "", wasmvmtypes.CosmosMsg{
+				Custom: json.RawMessage(tc.custom),
+			})
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEVMMessageHandler_ERC20TransferValidation(t *testing.T) {
+	erc20Addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	enabled := erc20types.NewTokenPair(erc20Addr, "ibc/ABC", erc20types.OWNER_MODULE)
+	disabled := erc20types.NewTokenPair(common.HexToAddress("0x2222222222222222222222222222222222222222"), "ibc/DEF", erc20types.OWNER_MODULE)
+	disabled.Enabled = false
+	pairs := tokenPairStub{enabled.Denom: enabled, disabled.Denom: disabled}
+
+	tests := []struct {
+		name    string
+		custom  string
+		wantErr string
+	}{
+		{"unknown token", `{"erc20_transfer":{"token":"ufoo","recipient":"0x1234567890abcdef1234567890abcdef12345678","amount":"1"}}`, "token pair not found"},
+		{"disabled pair", `{"erc20_transfer":{"token":"ibc/DEF","recipient":"0x1234567890abcdef1234567890abcdef12345678","amount":"1"}}`, "disabled"},
+		{"bad recipient", `{"erc20_transfer":{"token":"ibc/ABC","recipient":"0x1234","amount":"1"}}`, "invalid recipient"},
+		{"zero amount", `{"erc20_transfer":{"token":"ibc/ABC","recipient":"0x1234567890abcdef1234567890abcdef12345678","amount":"0"}}`, "invalid amount"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &evmMessageHandler{erc20Keeper: pairs, next: &mockMessenger{}}
+			_, _, _, err := handler.DispatchMsg(freshSDKCtx(), sdk.AccAddress(make([]byte, 32)), "", wasmvmtypes.CosmosMsg{
+				Custom: json.RawMessage(tc.custom),
+			})
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Query handler: erc20_token_pair
+// ---------------------------------------------------------------------------
+
+func TestEVMQueryHandler_ERC20TokenPair(t *testing.T) {
+	erc20Addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	nativeERC20Denom := erc20types.CreateDenom(erc20Addr.Hex())
+	pair := erc20types.NewTokenPair(erc20Addr, nativeERC20Denom, erc20types.OWNER_EXTERNAL)
+	pairs := tokenPairStub{nativeERC20Denom: pair}
+	handler := NewEVMQueryHandlerDecorator(nil, pairs)(&mockQueryHandler{})
+
+	ctx := freshSDKCtx()
+	res, err := handler.HandleQuery(ctx, sdk.AccAddress(make([]byte, 32)), wasmvmtypes.QueryRequest{
+		Custom: json.RawMessage(`{"erc20_token_pair":{"token":"` + nativeERC20Denom + `"}}`),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp ERC20TokenPairResponse
+	if err := json.Unmarshal(res, &resp); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	want := ERC20TokenPairResponse{
+		ERC20Address:  erc20Addr.Hex(),
+		Denom:         nativeERC20Denom,
+		Enabled:       true,
+		ContractOwner: "external",
+	}
+	if resp != want {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	_, err = handler.HandleQuery(ctx, sdk.AccAddress(make([]byte, 32)), wasmvmtypes.QueryRequest{
+		Custom: json.RawMessage(`{"erc20_token_pair":{"token":"ufoo"}}`),
+	})
+	if err == nil || !strings.Contains(err.Error(), "token pair not found") {
+		t.Fatalf("expected not found error, got: %v", err)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Gas accounting and helpers
+// ---------------------------------------------------------------------------
+
+func TestRunWithGasCap(t *testing.T) {
+	ctx := freshSDKCtx()
+	res, err := runWithGasCap(ctx, "test query", func(ctx sdk.Context) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(1_000, "read")
+		return []byte("ok"), nil
+	})
+	if err != nil || string(res) != "ok" {
+		t.Fatalf("unexpected result %q, err %v", res, err)
+	}
+	if got := ctx.GasMeter().GasConsumed(); got != 1_000 {
+		t.Fatalf("expected 1000 gas charged, got %d", got)
+	}
+
+	// Exceeding the cross-runtime cap is reported as an error and the whole
+	// cap is charged to the caller.
+	ctx = freshSDKCtx()
+	_, err = runWithGasCap(ctx, "test query", func(ctx sdk.Context) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(DefaultCrossRuntimeGasCap+1, "read")
+		return nil, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "out of gas") {
+		t.Fatalf("expected out of gas error, got: %v", err)
+	}
+	if got := ctx.GasMeter().GasConsumed(); got != DefaultCrossRuntimeGasCap {
+		t.Fatalf("expected %d gas charged, got %d", DefaultCrossRuntimeGasCap, got)
+	}
+}
+
+func TestParseEVMValue(t *testing.T) {
+	v, err := parseEVMValue("")
+	if err != nil || v.Sign() != 0 {
+		t.Fatalf("empty value: got %v, %v", v, err)
+	}
+	v, err = parseEVMValue("1000000000000000000")
+	if err != nil || v.String() != "1000000000000000000" {
+		t.Fatalf("got %v, %v", v, err)
+	}
+	for _, bad := range []string{"-1", "0x10", "1e18", "1" + strings.Repeat("0", 78)} {
+		if _, err := parseEVMValue(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseHash32(t *testing.T) {
+	h, err := parseHash32("0x01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h != common.BigToHash(common.Big1) {
+		t.Fatalf("expected left-padded slot, got %s", h.Hex())
+	}
+	if h2, err := parseHash32("0x1"); err != nil || h2 != h {
+		t.Fatalf("odd-length slot: got %s, %v", h2.Hex(), err)
+	}
+	if _, err := parseHash32("0x" + strings.Repeat("ab", 33)); err == nil {
+		t.Fatal("expected error for 33-byte value")
+	}
+}
+
+func TestParseRecipient(t *testing.T) {
+	want := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
+
+	got, err := parseRecipient(want.Hex())
+	if err != nil || got != want {
+		t.Fatalf("hex recipient: got %s, %v", got.Hex(), err)
+	}
+	got, err = parseRecipient(sdk.AccAddress(want.Bytes()).String())
+	if err != nil || got != want {
+		t.Fatalf("bech32 recipient: got %s, %v", got.Hex(), err)
+	}
+	if _, err := parseRecipient(sdk.AccAddress(make([]byte, 32)).String()); err == nil {
+		t.Fatal("expected error for 32-byte bech32 recipient")
+	}
+}
+
+func TestEVMLogEvents(t *testing.T) {
+	events := evmLogEvents([]*evmtypes.Log{{
+		Address: "0x1111111111111111111111111111111111111111",
+		Topics:  []string{"0xaa", "0xbb"},
+		Data:    []byte{0x01, 0x02},
+	}})
+	if len(events) != 1 || events[0].Type != EventTypeEVMLog {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+	attrs := map[string]string{}
+	for _, a := range events[0].Attributes {
+		attrs[a.Key] = a.Value
+	}
+	if attrs["address"] != "0x1111111111111111111111111111111111111111" || attrs["topics"] != "0xaa,0xbb" || attrs["data"] != "0x0102" {
+		t.Fatalf("unexpected attributes: %v", attrs)
+	}
+	if evmLogEvents(nil) != nil {
+		t.Fatal("expected no events for no logs")
+	}
+}
+
+func TestEVMMessageHandler_LockedTargetRejected(t *testing.T) {
+	target := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
+	pair := erc20types.NewTokenPair(target, "ibc/ABC", erc20types.OWNER_MODULE)
+	handler := &evmMessageHandler{erc20Keeper: tokenPairStub{pair.Denom: pair}, next: &mockMessenger{}}
+	wasmContract := sdk.AccAddress(make([]byte, 32))
+
+	// The EVM contract called into wasm and is still executing.
+	ctx, err := crossruntime.LockContracts(freshSDKCtx(), target.Bytes(), wasmContract)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msgs := map[string]string{
+		"evm_call":       `{"evm_call":{"contract":"` + target.Hex() + `","calldata":"0x"}}`,
+		"erc20_transfer": `{"erc20_transfer":{"token":"ibc/ABC","recipient":"0x000000000000000000000000000000000000dEaD","amount":"1"}}`,
+	}
+	for name, custom := range msgs {
+		t.Run(name, func(t *testing.T) {
+			_, _, _, err := handler.DispatchMsg(ctx, wasmContract, "", wasmvmtypes.CosmosMsg{
+				Custom: json.RawMessage(custom),
+			})
+			if !errors.Is(err, crossruntime.ErrContractLocked) {
+				t.Fatalf("expected ErrContractLocked, got: %v", err)
+			}
+		})
+	}
+}
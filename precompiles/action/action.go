@@ -111,6 +111,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 	// Generic transaction
 	case ApproveActionMethod:
 		return p.ApproveAction(ctx, contract, stateDB, method, args)
+	case CancelActionMethod:
+		return p.CancelAction(ctx, contract, stateDB, method, args)
 	// Queries
 	case GetActionMethod:
 		return p.GetAction(ctx, method, args)
@@ -124,6 +126,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 		return p.GetActionsBySuperNode(ctx, method, args)
 	case GetParamsMethod:
 		return p.GetParams(ctx, method, args)
+	case GetFeeMultiplierMethod:
+		return p.GetFeeMultiplier(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -134,7 +138,7 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case RequestCascadeMethod, RequestSenseMethod,
 		FinalizeCascadeMethod, FinalizeSenseMethod,
-		ApproveActionMethod:
+		ApproveActionMethod, CancelActionMethod:
 		return true
 	default:
 		return false
@@ -19,6 +19,8 @@ const (
 	EventTypeActionFinalized = "ActionFinalized"
 	// EventTypeActionApproved is emitted when the creator approves an action.
 	EventTypeActionApproved = "ActionApproved"
+	// EventTypeActionCancelled is emitted when the creator cancels a pending action.
+	EventTypeActionCancelled = "ActionCancelled"
 )
 
 // EmitActionRequested emits an ActionRequested EVM log.
@@ -127,3 +129,42 @@ func (p Precompile) EmitActionApproved(
 
 	return nil
 }
+
+// EmitActionCancelled emits an ActionCancelled EVM log.
+func (p Precompile) EmitActionCancelled(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	actionId string,
+	creator common.Address,
+	refund *big.Int,
+	fee *big.Int,
+) error {
+	event := p.Events[EventTypeActionCancelled]
+
+	topics := make([]common.Hash, 3)
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(actionId)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(creator)
+	if err != nil {
+		return err
+	}
+
+	// Pack non-indexed data: refund (uint256) + fee (uint256)
+	var data []byte
+	data = append(data, cmn.PackNum(reflect.ValueOf(refund))...)
+	data = append(data, cmn.PackNum(reflect.ValueOf(fee))...)
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
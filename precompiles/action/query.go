@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -26,6 +28,8 @@ const (
 	GetActionsBySuperNodeMethod = "getActionsBySuperNode"
 	// GetParamsMethod is the ABI method name for querying module parameters.
 	GetParamsMethod = "getParams"
+	// GetFeeMultiplierMethod is the ABI method name for querying the congestion fee multiplier.
+	GetFeeMultiplierMethod = "getFeeMultiplier"
 
 	// maxQueryLimit caps paginated results to prevent gas griefing.
 	maxQueryLimit = 100
@@ -59,7 +63,8 @@ func (p Precompile) GetAction(
 	return method.Outputs.Pack(info)
 }
 
-// GetActionFee returns the fee breakdown for a given data size.
+// GetActionFee returns the fee breakdown for a given data size. The total is
+// scaled by the current congestion fee multiplier.
 func (p Precompile) GetActionFee(
 	ctx sdk.Context,
 	method *abi.Method,
@@ -75,14 +80,24 @@ func (p Precompile) GetActionFee(
 
 	baseFee := params.BaseActionFee.Amount.BigInt()
 	perKb := params.FeePerKbyte.Amount.BigInt()
-	totalFee := new(big.Int).Add(
+	scheduleFee := new(big.Int).Add(
 		baseFee,
 		new(big.Int).Mul(perKb, new(big.Int).SetUint64(dataSizeKbs)),
 	)
+	totalFee := p.actionKeeper.ApplyFeeMultiplier(ctx, sdkmath.NewIntFromBigInt(scheduleFee)).BigInt()
 
 	return method.Outputs.Pack(baseFee, perKb, totalFee)
 }
 
+// GetFeeMultiplier returns the current congestion fee multiplier as a decimal string.
+func (p Precompile) GetFeeMultiplier(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.actionKeeper.GetFeeMultiplier(ctx).String())
+}
+
 // GetActionsByCreator returns paginated actions filtered by creator address.
 func (p Precompile) GetActionsByCreator(
 	ctx sdk.Context,
@@ -14,6 +14,8 @@ import (
 const (
 	// ApproveActionMethod is the ABI method name for approving any action type.
 	ApproveActionMethod = "approveAction"
+	// CancelActionMethod is the ABI method name for cancelling a pending action.
+	CancelActionMethod = "cancelAction"
 )
 
 // ApproveAction approves a completed action (type-agnostic).
@@ -57,3 +59,55 @@ func (p Precompile) ApproveAction(
 
 	return method.Outputs.Pack(true)
 }
+
+// CancelAction cancels a pending action created by the caller (type-agnostic).
+func (p Precompile) CancelAction(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("cancelAction: expected 1 arg, got %d", len(args))
+	}
+
+	actionId := args[0].(string)
+
+	creator, err := evmAddrToBech32(p.addrCdc, contract.Caller())
+	if err != nil {
+		return nil, fmt.Errorf("invalid caller address: %w", err)
+	}
+
+	msg := &actiontypes.MsgCancelAction{
+		Creator:  creator,
+		ActionId: actionId,
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"creator", creator,
+		"action_id", actionId,
+	)
+
+	res, err := p.actionMsgSvr.CancelAction(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := parsePriceToBigInt(res.Refund)
+	if err != nil {
+		return nil, fmt.Errorf("parse refund: %w", err)
+	}
+	fee, err := parsePriceToBigInt(res.Fee)
+	if err != nil {
+		return nil, fmt.Errorf("parse cancellation fee: %w", err)
+	}
+
+	if err := p.EmitActionCancelled(ctx, stateDB, actionId, contract.Caller(), refund, fee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(refund, fee)
+}
@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auditkeeper "github.com/LumeraProtocol/lumera/x/audit/v1/keeper"
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+// AuditPrecompileAddress is the hex address of the audit precompile.
+const AuditPrecompileAddress = "0x0000000000000000000000000000000000000904"
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the audit module precompile contract.
+type Precompile struct {
+	cmn.Precompile
+	abi.ABI
+
+	auditKeeper   auditkeeper.Keeper
+	auditMsgSvr   audittypes.MsgServer
+	auditQuerySvr audittypes.QueryServer
+	addrCdc       address.Codec
+}
+
+// NewPrecompile creates a new audit precompile instance.
+func NewPrecompile(
+	auditKeeper auditkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
+	addrCdc address.Codec,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(AuditPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:           ABI,
+		auditKeeper:   auditKeeper,
+		auditMsgSvr:   auditkeeper.NewMsgServerImpl(auditKeeper),
+		auditQuerySvr: auditkeeper.NewQueryServerImpl(auditKeeper),
+		addrCdc:       addrCdc,
+	}
+}
+
+// RequiredGas returns the minimum gas needed to execute this precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run delegates to RunNativeAction for snapshot/revert management.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+// Execute dispatches to the appropriate handler based on the ABI method.
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// Transactions
+	case SubmitEvidenceMethod:
+		return p.SubmitEvidence(ctx, contract, stateDB, method, args)
+	// Queries
+	case GetCurrentEpochMethod:
+		return p.GetCurrentEpoch(ctx, method, args)
+	case GetAssignedTargetsMethod:
+		return p.GetAssignedTargets(ctx, method, args)
+	case GetNodeSuspicionStateMethod:
+		return p.GetNodeSuspicionState(ctx, method, args)
+	case GetReporterReliabilityStateMethod:
+		return p.GetReporterReliabilityState(ctx, method, args)
+	case GetTicketDeteriorationStateMethod:
+		return p.GetTicketDeteriorationState(ctx, method, args)
+	case GetHealOpMethod:
+		return p.GetHealOp(ctx, method, args)
+	case GetEvidenceByActionMethod:
+		return p.GetEvidenceByAction(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction returns true for state-changing methods.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SubmitEvidenceMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "audit")
+}
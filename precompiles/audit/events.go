@@ -0,0 +1,56 @@
+package audit
+
+import (
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeEvidenceSubmitted is emitted when evidence is submitted through the precompile.
+	EventTypeEvidenceSubmitted = "EvidenceSubmitted"
+)
+
+// EmitEvidenceSubmitted emits an EvidenceSubmitted EVM log.
+func (p Precompile) EmitEvidenceSubmitted(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	evidenceID uint64,
+	reporter common.Address,
+	subjectAddress string,
+	evidenceType uint8,
+	actionID string,
+) error {
+	event := p.Events[EventTypeEvidenceSubmitted]
+
+	topics := make([]common.Hash, 3)
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(evidenceID)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(reporter)
+	if err != nil {
+		return err
+	}
+
+	// Pack non-indexed data: subjectAddress (string) + evidenceType (uint8) + actionId (string)
+	data, err := event.Inputs.NonIndexed().Pack(subjectAddress, evidenceType, actionID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
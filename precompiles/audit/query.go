@@ -0,0 +1,185 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+const (
+	// GetCurrentEpochMethod is the ABI method name for querying the current audit epoch.
+	GetCurrentEpochMethod = "getCurrentEpoch"
+	// GetAssignedTargetsMethod is the ABI method name for querying a prober's assigned targets.
+	GetAssignedTargetsMethod = "getAssignedTargets"
+	// GetNodeSuspicionStateMethod is the ABI method name for querying a node's suspicion state.
+	GetNodeSuspicionStateMethod = "getNodeSuspicionState"
+	// GetReporterReliabilityStateMethod is the ABI method name for querying a reporter's reliability state.
+	GetReporterReliabilityStateMethod = "getReporterReliabilityState"
+	// GetTicketDeteriorationStateMethod is the ABI method name for querying a ticket's deterioration state.
+	GetTicketDeteriorationStateMethod = "getTicketDeteriorationState"
+	// GetHealOpMethod is the ABI method name for querying a heal operation.
+	GetHealOpMethod = "getHealOp"
+	// GetEvidenceByActionMethod is the ABI method name for listing evidence linked to an action.
+	GetEvidenceByActionMethod = "getEvidenceByAction"
+
+	// maxQueryLimit caps paginated results to prevent gas griefing.
+	maxQueryLimit = 100
+)
+
+// GetCurrentEpoch returns the epoch id and height bounds at the current block.
+func (p Precompile) GetCurrentEpoch(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	resp, err := p.auditQuerySvr.CurrentEpoch(ctx, &audittypes.QueryCurrentEpochRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(resp.EpochId, resp.EpochStartHeight, resp.EpochEndHeight)
+}
+
+// GetAssignedTargets returns the peers a supernode must probe in an epoch.
+// An epochId of 0 selects the current epoch.
+func (p Precompile) GetAssignedTargets(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("getAssignedTargets: expected 2 args, got %d", len(args))
+	}
+
+	supernodeAccount := args[0].(string)
+	epochID := args[1].(uint64)
+
+	resp, err := p.auditQuerySvr.AssignedTargets(ctx, &audittypes.QueryAssignedTargetsRequest{
+		SupernodeAccount: supernodeAccount,
+		EpochId:          epochID,
+		FilterByEpochId:  epochID != 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := resp.TargetSupernodeAccounts
+	if targets == nil {
+		targets = []string{}
+	}
+
+	return method.Outputs.Pack(resp.EpochId, resp.EpochStartHeight, targets)
+}
+
+// GetNodeSuspicionState returns the storage-truth suspicion snapshot of a supernode.
+// Nodes without recorded state return found=false instead of reverting.
+func (p Precompile) GetNodeSuspicionState(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("getNodeSuspicionState: expected 1 arg, got %d", len(args))
+	}
+
+	supernodeAccount := args[0].(string)
+	state, found := p.auditKeeper.GetNodeSuspicionState(ctx, supernodeAccount)
+
+	return method.Outputs.Pack(nodeSuspicionToABI(state), found)
+}
+
+// GetReporterReliabilityState returns the storage-truth reliability snapshot of a reporter.
+func (p Precompile) GetReporterReliabilityState(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("getReporterReliabilityState: expected 1 arg, got %d", len(args))
+	}
+
+	reporterAccount := args[0].(string)
+	state, found := p.auditKeeper.GetReporterReliabilityState(ctx, reporterAccount)
+
+	return method.Outputs.Pack(reporterReliabilityToABI(state), found)
+}
+
+// GetTicketDeteriorationState returns the storage-truth deterioration snapshot of a ticket.
+func (p Precompile) GetTicketDeteriorationState(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("getTicketDeteriorationState: expected 1 arg, got %d", len(args))
+	}
+
+	ticketID := args[0].(string)
+	state, found := p.auditKeeper.GetTicketDeteriorationState(ctx, ticketID)
+
+	return method.Outputs.Pack(ticketDeteriorationToABI(state), found)
+}
+
+// GetHealOp returns a heal operation and its current status.
+func (p Precompile) GetHealOp(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("getHealOp: expected 1 arg, got %d", len(args))
+	}
+
+	healOpID := args[0].(uint64)
+	healOp, found := p.auditKeeper.GetHealOp(ctx, healOpID)
+
+	return method.Outputs.Pack(healOpToABI(healOp), found)
+}
+
+// GetEvidenceByAction returns a paginated list of evidence linked to an action.
+func (p Precompile) GetEvidenceByAction(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("getEvidenceByAction: expected 3 args, got %d", len(args))
+	}
+
+	actionID := args[0].(string)
+	offset := args[1].(uint64)
+	limit := args[2].(uint64)
+
+	if limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+
+	resp, err := p.auditQuerySvr.EvidenceByAction(ctx, &audittypes.QueryEvidenceByActionRequest{
+		ActionId: actionID,
+		Pagination: &query.PageRequest{
+			Offset:     offset,
+			Limit:      limit,
+			CountTotal: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]EvidenceInfo, 0, len(resp.Evidence))
+	for _, ev := range resp.Evidence {
+		infos = append(infos, evidenceToABI(ev))
+	}
+
+	var total uint64
+	if resp.Pagination != nil {
+		total = resp.Pagination.Total
+	}
+
+	return method.Outputs.Pack(infos, total)
+}
@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+const (
+	// SubmitEvidenceMethod is the ABI method name for submitting audit evidence.
+	SubmitEvidenceMethod = "submitEvidence"
+)
+
+// SubmitEvidence records evidence against a subject with the EVM caller as reporter.
+// Evidence types reserved for the action module are rejected by the msg server.
+func (p Precompile) SubmitEvidence(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf("submitEvidence: expected 4 args, got %d", len(args))
+	}
+
+	subjectAddress := args[0].(string)
+	evidenceType := args[1].(uint8)
+	actionID := args[2].(string)
+	metadata := args[3].(string)
+
+	// Check reentrancy guard
+	ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	creator, err := p.addrCdc.BytesToString(contract.Caller().Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("invalid caller address: %w", err)
+	}
+
+	msg := &audittypes.MsgSubmitEvidence{
+		Creator:        creator,
+		SubjectAddress: subjectAddress,
+		EvidenceType:   audittypes.EvidenceType(evidenceType),
+		ActionId:       actionID,
+		Metadata:       metadata,
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"creator", creator,
+		"subject", subjectAddress,
+	)
+
+	resp, err := p.auditMsgSvr.SubmitEvidence(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitEvidenceSubmitted(ctx, stateDB, resp.EvidenceId, contract.Caller(), subjectAddress, evidenceType, actionID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(resp.EvidenceId)
+}
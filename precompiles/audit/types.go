@@ -0,0 +1,143 @@
+package audit
+
+import (
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+// NodeSuspicionInfo is the ABI-compatible view of a NodeSuspicionState.
+// Field names and types must match the ABI tuple definition exactly.
+type NodeSuspicionInfo struct {
+	SupernodeAccount         string `abi:"supernodeAccount"`
+	SuspicionScore           int64  `abi:"suspicionScore"`
+	LastUpdatedEpoch         uint64 `abi:"lastUpdatedEpoch"`
+	LastRecentFailEpoch      uint64 `abi:"lastRecentFailEpoch"`
+	LastOldFailEpoch         uint64 `abi:"lastOldFailEpoch"`
+	DistinctTicketFailWindow uint32 `abi:"distinctTicketFailWindow"`
+	ClassACountWindow        uint32 `abi:"classACountWindow"`
+	ClassBCountWindow        uint32 `abi:"classBCountWindow"`
+	CleanPassCount           uint32 `abi:"cleanPassCount"`
+	LastCleanPassEpoch       uint64 `abi:"lastCleanPassEpoch"`
+}
+
+// ReporterReliabilityInfo is the ABI-compatible view of a ReporterReliabilityState.
+type ReporterReliabilityInfo struct {
+	ReporterSupernodeAccount string `abi:"reporterSupernodeAccount"`
+	ReliabilityScore         int64  `abi:"reliabilityScore"`
+	LastUpdatedEpoch         uint64 `abi:"lastUpdatedEpoch"`
+	TrustBand                uint8  `abi:"trustBand"`
+	ContradictionCount       uint64 `abi:"contradictionCount"`
+	IneligibleUntilEpoch     uint64 `abi:"ineligibleUntilEpoch"`
+}
+
+// TicketDeteriorationInfo is the ABI-compatible view of a TicketDeteriorationState.
+type TicketDeteriorationInfo struct {
+	TicketId                   string `abi:"ticketId"`
+	DeteriorationScore         int64  `abi:"deteriorationScore"`
+	LastUpdatedEpoch           uint64 `abi:"lastUpdatedEpoch"`
+	ActiveHealOpId             uint64 `abi:"activeHealOpId"`
+	ProbationUntilEpoch        uint64 `abi:"probationUntilEpoch"`
+	LastHealEpoch              uint64 `abi:"lastHealEpoch"`
+	LastFailureEpoch           uint64 `abi:"lastFailureEpoch"`
+	RecentFailureEpochCount    uint32 `abi:"recentFailureEpochCount"`
+	DistinctHolderFailureCount uint32 `abi:"distinctHolderFailureCount"`
+}
+
+// HealOpInfo is the ABI-compatible view of a HealOp.
+type HealOpInfo struct {
+	HealOpId                  uint64   `abi:"healOpId"`
+	TicketId                  string   `abi:"ticketId"`
+	ScheduledEpochId          uint64   `abi:"scheduledEpochId"`
+	HealerSupernodeAccount    string   `abi:"healerSupernodeAccount"`
+	VerifierSupernodeAccounts []string `abi:"verifierSupernodeAccounts"`
+	Status                    uint8    `abi:"status"`
+	CreatedHeight             uint64   `abi:"createdHeight"`
+	UpdatedHeight             uint64   `abi:"updatedHeight"`
+	DeadlineEpochId           uint64   `abi:"deadlineEpochId"`
+	ResultHash                string   `abi:"resultHash"`
+}
+
+// EvidenceInfo is the ABI-compatible view of an Evidence record.
+type EvidenceInfo struct {
+	EvidenceId      uint64 `abi:"evidenceId"`
+	SubjectAddress  string `abi:"subjectAddress"`
+	ReporterAddress string `abi:"reporterAddress"`
+	ActionId        string `abi:"actionId"`
+	EvidenceType    uint8  `abi:"evidenceType"`
+	Metadata        []byte `abi:"metadata"`
+	ReportedHeight  uint64 `abi:"reportedHeight"`
+}
+
+func nodeSuspicionToABI(s audittypes.NodeSuspicionState) NodeSuspicionInfo {
+	return NodeSuspicionInfo{
+		SupernodeAccount:         s.SupernodeAccount,
+		SuspicionScore:           s.SuspicionScore,
+		LastUpdatedEpoch:         s.LastUpdatedEpoch,
+		LastRecentFailEpoch:      s.LastRecentFailEpoch,
+		LastOldFailEpoch:         s.LastOldFailEpoch,
+		DistinctTicketFailWindow: s.DistinctTicketFailWindow,
+		ClassACountWindow:        s.ClassACountWindow,
+		ClassBCountWindow:        s.ClassBCountWindow,
+		CleanPassCount:           s.CleanPassCount,
+		LastCleanPassEpoch:       s.LastCleanPassEpoch,
+	}
+}
+
+func reporterReliabilityToABI(s audittypes.ReporterReliabilityState) ReporterReliabilityInfo {
+	return ReporterReliabilityInfo{
+		ReporterSupernodeAccount: s.ReporterSupernodeAccount,
+		ReliabilityScore:         s.ReliabilityScore,
+		LastUpdatedEpoch:         s.LastUpdatedEpoch,
+		TrustBand:                uint8(s.TrustBand),
+		ContradictionCount:       s.ContradictionCount,
+		IneligibleUntilEpoch:     s.IneligibleUntilEpoch,
+	}
+}
+
+func ticketDeteriorationToABI(s audittypes.TicketDeteriorationState) TicketDeteriorationInfo {
+	return TicketDeteriorationInfo{
+		TicketId:                   s.TicketId,
+		DeteriorationScore:         s.DeteriorationScore,
+		LastUpdatedEpoch:           s.LastUpdatedEpoch,
+		ActiveHealOpId:             s.ActiveHealOpId,
+		ProbationUntilEpoch:        s.ProbationUntilEpoch,
+		LastHealEpoch:              s.LastHealEpoch,
+		LastFailureEpoch:           s.LastFailureEpoch,
+		RecentFailureEpochCount:    s.RecentFailureEpochCount,
+		DistinctHolderFailureCount: s.DistinctHolderFailureCount,
+	}
+}
+
+func healOpToABI(op audittypes.HealOp) HealOpInfo {
+	verifiers := op.VerifierSupernodeAccounts
+	if verifiers == nil {
+		verifiers = []string{}
+	}
+	return HealOpInfo{
+		HealOpId:                  op.HealOpId,
+		TicketId:                  op.TicketId,
+		ScheduledEpochId:          op.ScheduledEpochId,
+		HealerSupernodeAccount:    op.HealerSupernodeAccount,
+		VerifierSupernodeAccounts: verifiers,
+		Status:                    uint8(op.Status),
+		CreatedHeight:             op.CreatedHeight,
+		UpdatedHeight:             op.UpdatedHeight,
+		DeadlineEpochId:           op.DeadlineEpochId,
+		ResultHash:                op.ResultHash,
+	}
+}
+
+func evidenceToABI(ev audittypes.Evidence) EvidenceInfo {
+	metadata := ev.Metadata
+	if metadata == nil {
+		metadata = []byte{}
+	}
+	return EvidenceInfo{
+		EvidenceId:      ev.EvidenceId,
+		SubjectAddress:  ev.SubjectAddress,
+		ReporterAddress: ev.ReporterAddress,
+		ActionId:        ev.ActionId,
+		EvidenceType:    uint8(ev.EvidenceType),
+		Metadata:        metadata,
+		ReportedHeight:  ev.ReportedHeight,
+	}
+}
@@ -6,4 +6,8 @@ var (
 	// ErrReentrancyNotAllowed is returned when a cross-runtime call would exceed
 	// the maximum nesting depth.
 	ErrReentrancyNotAllowed = errors.New("cross-runtime reentrancy not allowed (max depth reached)")
+
+	// ErrContractLocked is returned when a cross-runtime call would re-enter a
+	// contract that is already executing further up the call stack.
+	ErrContractLocked = errors.New("cross-runtime reentrancy not allowed (contract is locked)")
 )
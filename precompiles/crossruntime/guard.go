@@ -1,6 +1,10 @@
 package crossruntime
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // crossRuntimeDepthKeyType is an unexported typed key to prevent accidental
 // context-key collisions with other packages.
@@ -8,9 +12,17 @@ type crossRuntimeDepthKeyType struct{}
 
 var crossRuntimeDepthKey = crossRuntimeDepthKeyType{}
 
+// crossRuntimeLocksKeyType is the context key for the contracts currently
+// taking part in a cross-runtime call.
+type crossRuntimeLocksKeyType struct{}
+
+var crossRuntimeLocksKey = crossRuntimeLocksKeyType{}
+
 // MaxCrossRuntimeDepth is the maximum allowed nesting depth for cross-runtime
-// calls. Phase 1 disallows any re-entry (depth > 1 requires stateDB threading).
-const MaxCrossRuntimeDepth = 1
+// calls, e.g. EVM -> wasm -> EVM -> wasm. Every boundary crossing counts once.
+// Nested EVM legs run on a fresh stateDB and are synced back into the calling
+// stateDB by StateTracker.
+const MaxCrossRuntimeDepth = 4
 
 // GetCrossRuntimeDepth returns the current cross-runtime call depth from the
 // SDK context. Returns 0 if no cross-runtime call is in progress.
@@ -37,3 +49,47 @@ func CheckAndIncrementDepth(ctx sdk.Context) (sdk.Context, error) {
 	}
 	return WithIncrementedDepth(ctx), nil
 }
+
+// lockFrame is an immutable linked list of locked contract addresses, so a
+// derived context never mutates the set seen by its parent.
+type lockFrame struct {
+	addr   string
+	parent *lockFrame
+}
+
+func (f *lockFrame) contains(addr sdk.AccAddress) bool {
+	for ; f != nil; f = f.parent {
+		if f.addr == string(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsContractLocked reports whether addr is taking part in a cross-runtime call
+// higher up the current call stack.
+func IsContractLocked(ctx sdk.Context, addr sdk.AccAddress) bool {
+	frame, _ := ctx.Value(crossRuntimeLocksKey).(*lockFrame)
+	return frame.contains(addr)
+}
+
+// LockContracts guards a state-changing cross-runtime call from caller into
+// target. It fails if target is already locked, i.e. the call would re-enter
+// a contract that is mid-execution on the other side of a runtime boundary,
+// and otherwise returns a context with both caller and target locked.
+// A nil target (contract creation) only locks the caller.
+//
+// Addresses are compared as raw bytes: EVM contracts are 20 bytes and wasm
+// contracts 32 bytes.
+func LockContracts(ctx sdk.Context, caller, target sdk.AccAddress) (sdk.Context, error) {
+	frame, _ := ctx.Value(crossRuntimeLocksKey).(*lockFrame)
+	if len(target) > 0 && frame.contains(target) {
+		return ctx, fmt.Errorf("%w: %s", ErrContractLocked, target)
+	}
+	for _, addr := range []sdk.AccAddress{caller, target} {
+		if len(addr) > 0 && !frame.contains(addr) {
+			frame = &lockFrame{addr: string(addr), parent: frame}
+		}
+	}
+	return ctx.WithValue(crossRuntimeLocksKey, frame), nil
+}
@@ -1,6 +1,8 @@
 package crossruntime
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -12,6 +14,14 @@ func freshCtx() sdk.Context {
 	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 }
 
+func ctxAtDepth(depth int) sdk.Context {
+	ctx := freshCtx()
+	for i := 0; i < depth; i++ {
+		ctx = WithIncrementedDepth(ctx)
+	}
+	return ctx
+}
+
 func TestGetCrossRuntimeDepth_ZeroByDefault(t *testing.T) {
 	ctx := freshCtx()
 	if d := GetCrossRuntimeDepth(ctx); d != 0 {
@@ -55,9 +65,20 @@ func TestCheckAndIncrementDepth_SucceedsAtZero(t *testing.T) {
 	}
 }
 
+func TestCheckAndIncrementDepth_SucceedsBelowMax(t *testing.T) {
+	ctx := ctxAtDepth(MaxCrossRuntimeDepth - 1)
+
+	newCtx, err := CheckAndIncrementDepth(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error below max depth: %v", err)
+	}
+	if d := GetCrossRuntimeDepth(newCtx); d != MaxCrossRuntimeDepth {
+		t.Fatalf("expected depth %d, got %d", MaxCrossRuntimeDepth, d)
+	}
+}
+
 func TestCheckAndIncrementDepth_FailsAtMax(t *testing.T) {
-	ctx := freshCtx()
-	ctx = WithIncrementedDepth(ctx) // depth = 1 = MaxCrossRuntimeDepth
+	ctx := ctxAtDepth(MaxCrossRuntimeDepth)
 
 	_, err := CheckAndIncrementDepth(ctx)
 	if err == nil {
@@ -69,29 +90,74 @@ func TestCheckAndIncrementDepth_FailsAtMax(t *testing.T) {
 }
 
 func TestCheckAndIncrementDepth_FailsBeyondMax(t *testing.T) {
-	ctx := freshCtx()
-	ctx = WithIncrementedDepth(ctx) // 1
-	ctx = WithIncrementedDepth(ctx) // 2
+	ctx := ctxAtDepth(MaxCrossRuntimeDepth + 1)
 
 	_, err := CheckAndIncrementDepth(ctx)
 	if err != ErrReentrancyNotAllowed {
-		t.Fatalf("expected ErrReentrancyNotAllowed at depth 2, got %v", err)
+		t.Fatalf("expected ErrReentrancyNotAllowed beyond max depth, got %v", err)
 	}
 }
 
 func TestCheckAndIncrementDepth_DoesNotMutateOnError(t *testing.T) {
-	ctx := freshCtx()
-	ctx = WithIncrementedDepth(ctx)
+	ctx := ctxAtDepth(MaxCrossRuntimeDepth)
 
 	returned, _ := CheckAndIncrementDepth(ctx)
-	// On error, returned context should still have depth 1 (the input)
-	if d := GetCrossRuntimeDepth(returned); d != 1 {
-		t.Fatalf("expected depth 1 on error path, got %d", d)
+	// On error, returned context should still have the input depth
+	if d := GetCrossRuntimeDepth(returned); d != MaxCrossRuntimeDepth {
+		t.Fatalf("expected depth %d on error path, got %d", MaxCrossRuntimeDepth, d)
+	}
+}
+
+func TestMaxCrossRuntimeDepth_IsFour(t *testing.T) {
+	if MaxCrossRuntimeDepth != 4 {
+		t.Fatalf("expected MaxCrossRuntimeDepth=4, got %d", MaxCrossRuntimeDepth)
 	}
 }
 
-func TestMaxCrossRuntimeDepth_IsOne(t *testing.T) {
-	if MaxCrossRuntimeDepth != 1 {
-		t.Fatalf("Phase 1 requires MaxCrossRuntimeDepth=1, got %d", MaxCrossRuntimeDepth)
+func TestLockContracts_RejectsReentry(t *testing.T) {
+	evmCaller := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	wasmContract := sdk.AccAddress(bytes.Repeat([]byte{0x02}, 32))
+	token := sdk.AccAddress(bytes.Repeat([]byte{0x03}, 20))
+
+	// EVM contract -> wasm contract
+	ctx, err := LockContracts(freshCtx(), evmCaller, wasmContract)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !IsContractLocked(ctx, evmCaller) || !IsContractLocked(ctx, wasmContract) {
+		t.Fatal("expected caller and target to be locked")
+	}
+
+	// wasm contract -> unrelated EVM token is allowed
+	inner, err := LockContracts(ctx, wasmContract, token)
+	if err != nil {
+		t.Fatalf("unexpected error calling an unlocked contract: %v", err)
+	}
+
+	// token -> back into the calling EVM contract is rejected
+	_, err = LockContracts(inner, token, evmCaller)
+	if !errors.Is(err, ErrContractLocked) {
+		t.Fatalf("expected ErrContractLocked, got %v", err)
+	}
+
+	// Locks are scoped to the derived context.
+	if IsContractLocked(ctx, token) {
+		t.Fatal("parent context must not see locks taken by a nested call")
+	}
+}
+
+func TestLockContracts_NilTargetLocksCaller(t *testing.T) {
+	caller := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 32))
+
+	ctx, err := LockContracts(freshCtx(), caller, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !IsContractLocked(ctx, caller) {
+		t.Fatal("expected caller to be locked")
+	}
+	// A locked caller may start further calls.
+	if _, err := LockContracts(ctx, caller, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
 }
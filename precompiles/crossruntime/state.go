@@ -0,0 +1,191 @@
+package crossruntime
+
+import (
+	"fmt"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"cosmossdk.io/store/cachemulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// stateTrackerKeyType is the context key for the innermost StateTracker.
+type stateTrackerKeyType struct{}
+
+var stateTrackerKey = stateTrackerKeyType{}
+
+// StateTracker records the EVM accounts and storage slots written by nested
+// EVM legs of a cross-runtime call.
+//
+// A stateDB reads accounts and storage from the context it was created with
+// and keeps them cached for the whole transaction. When an EVM contract calls
+// into wasm and wasm calls back into the EVM, the inner leg runs on a fresh
+// stateDB over the precompile cache context and commits there, so the outer
+// stateDB would keep serving (and later commit) stale values. The wasm
+// precompile installs a tracker before handing control to wasm and calls
+// Rehydrate afterwards to pull the touched state back into its stateDB.
+//
+// Balances are not tracked: the precompile balance handler already mirrors
+// bank events into the calling stateDB.
+type StateTracker struct {
+	parent   *StateTracker
+	accounts map[common.Address]map[common.Hash]struct{}
+	order    []common.Address
+}
+
+// WithStateTracker returns a context carrying a new tracker. Writes recorded
+// on it are also recorded on any tracker further up the call stack, since
+// every enclosing stateDB needs the same state pulled back in.
+func WithStateTracker(ctx sdk.Context) (sdk.Context, *StateTracker) {
+	parent, _ := ctx.Value(stateTrackerKey).(*StateTracker)
+	t := &StateTracker{
+		parent:   parent,
+		accounts: make(map[common.Address]map[common.Hash]struct{}),
+	}
+	return ctx.WithValue(stateTrackerKey, t), t
+}
+
+// StateTrackerFromContext returns the innermost tracker, or nil when no
+// cross-runtime call is in progress.
+func StateTrackerFromContext(ctx sdk.Context) *StateTracker {
+	t, _ := ctx.Value(stateTrackerKey).(*StateTracker)
+	return t
+}
+
+// TouchAccount records that the account (nonce, code) of addr was written.
+func (t *StateTracker) TouchAccount(addr common.Address) {
+	for ; t != nil; t = t.parent {
+		t.touch(addr)
+	}
+}
+
+// TouchSlot records that storage slot key of addr was written.
+func (t *StateTracker) TouchSlot(addr common.Address, key common.Hash) {
+	for ; t != nil; t = t.parent {
+		t.touch(addr)[key] = struct{}{}
+	}
+}
+
+func (t *StateTracker) touch(addr common.Address) map[common.Hash]struct{} {
+	slots, ok := t.accounts[addr]
+	if !ok {
+		slots = make(map[common.Hash]struct{})
+		t.accounts[addr] = slots
+		t.order = append(t.order, addr)
+	}
+	return slots
+}
+
+// Rehydrate re-reads every touched account and slot from ctx and writes the
+// values that differ into stateDB. The writes go through the stateDB journal,
+// so they are reverted together with the precompile call if the outer EVM
+// reverts. Accounts deleted by a nested leg are left untouched.
+func (t *StateTracker) Rehydrate(ctx sdk.Context, stateDB vm.StateDB) error {
+	if len(t.order) == 0 {
+		return nil
+	}
+	db, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return fmt.Errorf("cannot sync cross-runtime state into %T", stateDB)
+	}
+	keeper := db.Keeper()
+
+	for _, addr := range t.order {
+		acct := keeper.GetAccount(ctx, addr)
+		if acct == nil {
+			continue
+		}
+		codeHash := common.BytesToHash(acct.CodeHash)
+		if codeHash != ethtypes.EmptyCodeHash && db.GetCodeHash(addr) != codeHash {
+			db.SetCode(addr, keeper.GetCode(ctx, codeHash))
+		}
+		if db.GetNonce(addr) != acct.Nonce {
+			db.SetNonce(addr, acct.Nonce, tracing.NonceChangeUnspecified)
+		}
+		for _, key := range sortedSlots(t.accounts[addr]) {
+			if value := keeper.GetState(ctx, addr, key); db.GetState(addr, key) != value {
+				db.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// BranchContext returns ctx on a fresh cache over every store known to
+// stateDB, and a function that writes the branch back into ctx.
+//
+// Precompiles run on the stateDB snapshot store, whose CacheMultiStore only
+// pushes another snapshot and whose Write flattens all of them. wasmd branches
+// and commits a sub-context for every submessage, which would flatten the
+// snapshot the precompile reverts to if the EVM call fails later. Running wasm
+// on a real branch keeps those commits inside it.
+func BranchContext(ctx sdk.Context, stateDB vm.StateDB) (sdk.Context, func(), error) {
+	db, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return ctx, nil, fmt.Errorf("cannot branch cross-runtime state of %T", stateDB)
+	}
+	parent := ctx.MultiStore()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper)
+	keys := make(map[string]storetypes.StoreKey)
+	for name, key := range db.Keeper().KVStoreKeys() {
+		stores[key] = parent.GetKVStore(key)
+		keys[name] = key
+	}
+	cms := cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil)
+	return ctx.WithMultiStore(cms), cms.Write, nil
+}
+
+func sortedSlots(slots map[common.Hash]struct{}) []common.Hash {
+	keys := make([]common.Hash, 0, len(slots))
+	for key := range slots {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
+	return keys
+}
+
+// trackingKeeper records every account and slot a stateDB commits.
+type trackingKeeper struct {
+	statedb.Keeper
+	tracker *StateTracker
+}
+
+func (k trackingKeeper) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
+	k.tracker.TouchAccount(addr)
+	return k.Keeper.SetAccount(ctx, addr, account)
+}
+
+func (k trackingKeeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	k.tracker.TouchSlot(addr, key)
+	k.Keeper.SetState(ctx, addr, key, value)
+}
+
+func (k trackingKeeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
+	k.tracker.TouchSlot(addr, key)
+	k.Keeper.DeleteState(ctx, addr, key)
+}
+
+func (k trackingKeeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
+	k.tracker.TouchAccount(addr)
+	return k.Keeper.DeleteAccount(ctx, addr)
+}
+
+// NewStateDB returns a stateDB for an EVM leg started from wasm. When the leg
+// is nested inside a wasm precompile call, its commits are recorded on the
+// enclosing StateTracker.
+func NewStateDB(ctx sdk.Context, keeper statedb.Keeper, txConfig statedb.TxConfig) *statedb.StateDB {
+	if t := StateTrackerFromContext(ctx); t != nil {
+		keeper = trackingKeeper{Keeper: keeper, tracker: t}
+	}
+	return statedb.New(ctx, keeper, txConfig)
+}
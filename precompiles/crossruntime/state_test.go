@@ -0,0 +1,55 @@
+package crossruntime
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestStateTracker_NestedTouchesPropagateToParent(t *testing.T) {
+	ctx, outer := WithStateTracker(freshCtx())
+	ctx, inner := WithStateTracker(ctx)
+
+	if StateTrackerFromContext(ctx) != inner {
+		t.Fatal("expected the innermost tracker in context")
+	}
+
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	slot := common.HexToHash("0x01")
+	inner.TouchSlot(addr, slot)
+	inner.TouchAccount(common.HexToAddress("0x2222222222222222222222222222222222222222"))
+
+	for name, tr := range map[string]*StateTracker{"inner": inner, "outer": outer} {
+		if len(tr.order) != 2 {
+			t.Fatalf("%s: expected 2 touched accounts, got %d", name, len(tr.order))
+		}
+		if _, ok := tr.accounts[addr][slot]; !ok {
+			t.Fatalf("%s: expected slot to be tracked", name)
+		}
+	}
+
+	outer.TouchAccount(common.HexToAddress("0x3333333333333333333333333333333333333333"))
+	if len(inner.order) != 2 {
+		t.Fatal("touches on a parent must not leak into a nested tracker")
+	}
+}
+
+func TestStateTracker_NoTrackerOutsideCrossRuntimeCall(t *testing.T) {
+	if StateTrackerFromContext(freshCtx()) != nil {
+		t.Fatal("expected no tracker on a fresh context")
+	}
+}
+
+func TestSortedSlots(t *testing.T) {
+	slots := map[common.Hash]struct{}{
+		common.HexToHash("0x03"): {},
+		common.HexToHash("0x01"): {},
+		common.HexToHash("0x02"): {},
+	}
+	got := sortedSlots(slots)
+	for i, want := range []string{"0x01", "0x02", "0x03"} {
+		if got[i] != common.HexToHash(want) {
+			t.Fatalf("slot %d: expected %s, got %s", i, want, got[i].Hex())
+		}
+	}
+}
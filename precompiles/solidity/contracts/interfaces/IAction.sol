@@ -59,6 +59,13 @@ interface IAction {
         address indexed creator
     );
 
+    event ActionCancelled(
+        string indexed actionId,
+        address indexed creator,
+        uint256 refund,
+        uint256 fee
+    );
+
     // -----------------------------------------------------------------------
     // Transactions
     // -----------------------------------------------------------------------
@@ -128,6 +135,15 @@ interface IAction {
         string calldata actionId
     ) external returns (bool success);
 
+    /// @notice Cancel a pending action before any supernode has started processing it.
+    /// @dev Only the action creator may cancel. The cancellation fee is retained.
+    /// @param actionId The action to cancel
+    /// @return refund  Amount returned to the creator (ulume)
+    /// @return fee     Cancellation fee retained by the chain (ulume)
+    function cancelAction(
+        string calldata actionId
+    ) external returns (uint256 refund, uint256 fee);
+
     // -----------------------------------------------------------------------
     // Queries
     // -----------------------------------------------------------------------
@@ -141,11 +157,15 @@ interface IAction {
     /// @param dataSizeKbs Data size in kilobytes
     /// @return baseFee    The fixed base fee component
     /// @return perKbFee   The per-kilobyte fee rate
-    /// @return totalFee   baseFee + perKbFee * dataSizeKbs
+    /// @return totalFee   (baseFee + perKbFee * dataSizeKbs) * fee multiplier, rounded up
     function getActionFee(
         uint64 dataSizeKbs
     ) external view returns (uint256 baseFee, uint256 perKbFee, uint256 totalFee);
 
+    /// @notice Current congestion fee multiplier applied to action fees.
+    /// @return multiplier Decimal string, "1.000000000000000000" when uncongested
+    function getFeeMultiplier() external view returns (string memory multiplier);
+
     /// @notice List actions created by a specific address.
     function getActionsByCreator(
         address creator,
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// @title IAudit — Lumera Audit Module Precompile Interface
+/// @notice Precompile at address 0x0000000000000000000000000000000000000904
+/// @dev Call this interface to read on-chain audit truth from Solidity —
+///      epochs, probe assignments, storage-truth scores, heal operations and
+///      evidence — and to submit evidence against a subject. Contracts that
+///      pay supernodes for storage can gate payouts on these signals.
+interface IAudit {
+    // -----------------------------------------------------------------------
+    // Structs
+    // -----------------------------------------------------------------------
+
+    /// @notice Storage-truth suspicion snapshot of a supernode.
+    struct NodeSuspicionInfo {
+        string supernodeAccount;          // Bech32 lumera... account address
+        int64 suspicionScore;             // Higher = more suspicious
+        uint64 lastUpdatedEpoch;
+        uint64 lastRecentFailEpoch;
+        uint64 lastOldFailEpoch;
+        uint32 distinctTicketFailWindow;  // Distinct failing tickets in the window
+        uint32 classACountWindow;
+        uint32 classBCountWindow;
+        uint32 cleanPassCount;
+        uint64 lastCleanPassEpoch;
+    }
+
+    /// @notice Storage-truth reliability snapshot of a reporting supernode.
+    struct ReporterReliabilityInfo {
+        string reporterSupernodeAccount;
+        int64 reliabilityScore;
+        uint64 lastUpdatedEpoch;
+        uint8 trustBand;                  // 1=Normal, 2=LowTrust, 3=ChallengerIneligible, 4=Degraded
+        uint64 contradictionCount;
+        uint64 ineligibleUntilEpoch;
+    }
+
+    /// @notice Storage-truth deterioration snapshot of a cascade ticket.
+    struct TicketDeteriorationInfo {
+        string ticketId;
+        int64 deteriorationScore;
+        uint64 lastUpdatedEpoch;
+        uint64 activeHealOpId;            // 0 when no heal is in flight
+        uint64 probationUntilEpoch;
+        uint64 lastHealEpoch;
+        uint64 lastFailureEpoch;
+        uint32 recentFailureEpochCount;
+        uint32 distinctHolderFailureCount;
+    }
+
+    /// @notice A scheduled self-heal operation and its status.
+    struct HealOpInfo {
+        uint64 healOpId;
+        string ticketId;
+        uint64 scheduledEpochId;
+        string healerSupernodeAccount;
+        string[] verifierSupernodeAccounts;
+        uint8 status;                     // 1=Scheduled, 2=InProgress, 3=HealerReported, 4=Verified, 5=Failed, 6=Expired
+        uint64 createdHeight;
+        uint64 updatedHeight;
+        uint64 deadlineEpochId;
+        string resultHash;
+    }
+
+    /// @notice An on-chain evidence record.
+    struct EvidenceInfo {
+        uint64 evidenceId;
+        string subjectAddress;
+        string reporterAddress;
+        string actionId;
+        uint8 evidenceType;               // See x/audit EvidenceType enum
+        bytes metadata;                   // Protobuf-encoded type-specific metadata
+        uint64 reportedHeight;
+    }
+
+    // -----------------------------------------------------------------------
+    // Events
+    // -----------------------------------------------------------------------
+
+    /// @notice Emitted when evidence is submitted through the precompile.
+    event EvidenceSubmitted(
+        uint64 indexed evidenceId,
+        address indexed reporter,
+        string subjectAddress,
+        uint8 evidenceType,
+        string actionId
+    );
+
+    // -----------------------------------------------------------------------
+    // Transactions
+    // -----------------------------------------------------------------------
+
+    /// @notice Submit evidence against a subject, with the caller as reporter.
+    /// @dev Evidence types reserved for the action module are rejected.
+    ///      Guarded against cross-runtime reentrancy.
+    /// @param subjectAddress Bech32 address of the audited subject
+    /// @param evidenceType   x/audit EvidenceType value
+    /// @param actionId       Related action id (may be empty for some types)
+    /// @param metadata       JSON of the type-specific metadata message
+    /// @return evidenceId    Chain-assigned evidence id
+    function submitEvidence(
+        string calldata subjectAddress,
+        uint8 evidenceType,
+        string calldata actionId,
+        string calldata metadata
+    ) external returns (uint64 evidenceId);
+
+    // -----------------------------------------------------------------------
+    // Queries
+    // -----------------------------------------------------------------------
+
+    /// @notice Get the audit epoch at the current block.
+    function getCurrentEpoch() external view returns (
+        uint64 epochId,
+        int64 epochStartHeight,
+        int64 epochEndHeight
+    );
+
+    /// @notice Get the peers a supernode must probe in an epoch.
+    /// @param supernodeAccount Bech32 account of the probing supernode
+    /// @param epochId          Epoch to query (0 = current epoch)
+    function getAssignedTargets(
+        string calldata supernodeAccount,
+        uint64 epochId
+    ) external view returns (
+        uint64 resolvedEpochId,
+        int64 epochStartHeight,
+        string[] memory targets
+    );
+
+    /// @notice Get the suspicion snapshot of a supernode.
+    /// @return state Snapshot (zero-valued when not found)
+    /// @return found False if the node has no recorded state
+    function getNodeSuspicionState(
+        string calldata supernodeAccount
+    ) external view returns (NodeSuspicionInfo memory state, bool found);
+
+    /// @notice Get the reliability snapshot of a reporting supernode.
+    function getReporterReliabilityState(
+        string calldata reporterSupernodeAccount
+    ) external view returns (ReporterReliabilityInfo memory state, bool found);
+
+    /// @notice Get the deterioration snapshot of a ticket.
+    function getTicketDeteriorationState(
+        string calldata ticketId
+    ) external view returns (TicketDeteriorationInfo memory state, bool found);
+
+    /// @notice Get a heal operation by id.
+    function getHealOp(
+        uint64 healOpId
+    ) external view returns (HealOpInfo memory healOp, bool found);
+
+    /// @notice List evidence linked to an action with pagination.
+    /// @param limit Max results to return (capped at 100)
+    function getEvidenceByAction(
+        string calldata actionId,
+        uint64 offset,
+        uint64 limit
+    ) external view returns (EvidenceInfo[] memory evidence, uint64 total);
+}
@@ -6,8 +6,21 @@ pragma solidity ^0.8.20;
 /// @dev Call this interface to interact with CosmWasm contracts directly
 ///      from Solidity. Enables EVM contracts to execute and query CosmWasm
 ///      contracts on Lumera's dual-runtime chain.
-///      Phase 1: non-payable execute, query, contractInfo, rawQuery.
+///      Execute, query, contractInfo, rawQuery, plus contract lifecycle
+///      management: instantiate, instantiate2, migrate, updateAdmin,
+///      clearAdmin. All state-changing methods act as msg.sender.
 interface IWasm {
+    // -----------------------------------------------------------------------
+    // Structs
+    // -----------------------------------------------------------------------
+
+    /// @notice A Cosmos SDK coin. Funds are taken from msg.sender's bank
+    ///         balance; native LUME uses the "ulume" denom (6 decimals).
+    struct Coin {
+        string denom;
+        uint256 amount;
+    }
+
     // -----------------------------------------------------------------------
     // Events
     // -----------------------------------------------------------------------
@@ -22,6 +35,40 @@ interface IWasm {
         bytes response
     );
 
+    /// @notice Emitted when a CosmWasm contract is instantiated (instantiate or instantiate2).
+    /// @param caller The EVM address of the calling contract/account (the wasm creator).
+    /// @param contractAddr The bech32 address of the new CosmWasm contract.
+    /// @param codeId The code ID the contract was instantiated from.
+    /// @param data The raw data returned by the contract's instantiate entry point.
+    event WasmInstantiated(
+        address indexed caller,
+        string contractAddr,
+        uint64 codeId,
+        bytes data
+    );
+
+    /// @notice Emitted when a CosmWasm contract is migrated to a new code ID.
+    /// @param caller The EVM address of the calling contract/account (the wasm admin).
+    /// @param contractAddr The bech32 address of the migrated CosmWasm contract.
+    /// @param codeId The new code ID.
+    /// @param data The raw data returned by the contract's migrate entry point.
+    event WasmMigrated(
+        address indexed caller,
+        string contractAddr,
+        uint64 codeId,
+        bytes data
+    );
+
+    /// @notice Emitted when a CosmWasm contract admin is changed or cleared.
+    /// @param caller The EVM address of the calling contract/account (the previous admin).
+    /// @param contractAddr The bech32 address of the CosmWasm contract.
+    /// @param newAdmin The bech32 address of the new admin (empty when cleared).
+    event WasmAdminUpdated(
+        address indexed caller,
+        string contractAddr,
+        string newAdmin
+    );
+
     // -----------------------------------------------------------------------
     // State-changing methods
     // -----------------------------------------------------------------------
@@ -35,6 +82,71 @@ interface IWasm {
         bytes calldata msg
     ) external returns (bytes memory response);
 
+    /// @notice Instantiate a CosmWasm contract with the classic sequence-based address.
+    /// @param codeId The stored code ID to instantiate.
+    /// @param admin The bech32 address allowed to migrate the contract (empty for none).
+    /// @param msg The JSON-encoded instantiate message.
+    /// @param label A human-readable label for the contract.
+    /// @param funds Coins sent from msg.sender to the new contract.
+    /// @return contractAddr The bech32 address of the new contract.
+    /// @return data The raw data returned by the contract's instantiate entry point.
+    function instantiate(
+        uint64 codeId,
+        string calldata admin,
+        bytes calldata msg,
+        string calldata label,
+        Coin[] calldata funds
+    ) external returns (string memory contractAddr, bytes memory data);
+
+    /// @notice Instantiate a CosmWasm contract at a predictable address derived
+    ///         from the code checksum, msg.sender and salt (and msg if fixMsg).
+    /// @param codeId The stored code ID to instantiate.
+    /// @param admin The bech32 address allowed to migrate the contract (empty for none).
+    /// @param msg The JSON-encoded instantiate message.
+    /// @param label A human-readable label for the contract.
+    /// @param salt Caller-chosen salt (1-64 bytes).
+    /// @param fixMsg Whether the instantiate message is included in the address derivation.
+    /// @param funds Coins sent from msg.sender to the new contract.
+    /// @return contractAddr The bech32 address of the new contract.
+    /// @return data The raw data returned by the contract's instantiate entry point.
+    function instantiate2(
+        uint64 codeId,
+        string calldata admin,
+        bytes calldata msg,
+        string calldata label,
+        bytes calldata salt,
+        bool fixMsg,
+        Coin[] calldata funds
+    ) external returns (string memory contractAddr, bytes memory data);
+
+    /// @notice Migrate a CosmWasm contract to a new code ID. msg.sender must be the contract admin.
+    /// @param contractAddr The bech32 address of the target CosmWasm contract.
+    /// @param newCodeId The code ID to migrate to.
+    /// @param msg The JSON-encoded migrate message.
+    /// @return data The raw data returned by the contract's migrate entry point.
+    function migrate(
+        string calldata contractAddr,
+        uint64 newCodeId,
+        bytes calldata msg
+    ) external returns (bytes memory data);
+
+    /// @notice Set a new admin for a CosmWasm contract. msg.sender must be the current admin.
+    /// @param contractAddr The bech32 address of the target CosmWasm contract.
+    /// @param newAdmin The bech32 address of the new admin.
+    /// @return success True if the admin was updated.
+    function updateAdmin(
+        string calldata contractAddr,
+        string calldata newAdmin
+    ) external returns (bool success);
+
+    /// @notice Remove the admin of a CosmWasm contract, making it immutable.
+    ///         msg.sender must be the current admin.
+    /// @param contractAddr The bech32 address of the target CosmWasm contract.
+    /// @return success True if the admin was cleared.
+    function clearAdmin(
+        string calldata contractAddr
+    ) external returns (bool success);
+
     // -----------------------------------------------------------------------
     // Read-only query methods
     // -----------------------------------------------------------------------
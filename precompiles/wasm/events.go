@@ -12,6 +12,12 @@ import (
 const (
 	// EventTypeWasmExecuted is emitted when a CosmWasm contract is successfully executed.
 	EventTypeWasmExecuted = "WasmExecuted"
+	// EventTypeWasmInstantiated is emitted when a CosmWasm contract is instantiated.
+	EventTypeWasmInstantiated = "WasmInstantiated"
+	// EventTypeWasmMigrated is emitted when a CosmWasm contract is migrated to a new code ID.
+	EventTypeWasmMigrated = "WasmMigrated"
+	// EventTypeWasmAdminUpdated is emitted when a CosmWasm contract admin is changed or cleared.
+	EventTypeWasmAdminUpdated = "WasmAdminUpdated"
 )
 
 // EmitWasmExecuted emits a WasmExecuted EVM log.
@@ -22,7 +28,55 @@ func (p Precompile) EmitWasmExecuted(
 	contractAddr string,
 	response []byte,
 ) error {
-	event := p.Events[EventTypeWasmExecuted]
+	return p.emitCallerEvent(ctx, stateDB, EventTypeWasmExecuted, caller, contractAddr, response)
+}
+
+// EmitWasmInstantiated emits a WasmInstantiated EVM log.
+func (p Precompile) EmitWasmInstantiated(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	caller common.Address,
+	contractAddr string,
+	codeID uint64,
+	data []byte,
+) error {
+	return p.emitCallerEvent(ctx, stateDB, EventTypeWasmInstantiated, caller, contractAddr, codeID, data)
+}
+
+// EmitWasmMigrated emits a WasmMigrated EVM log.
+func (p Precompile) EmitWasmMigrated(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	caller common.Address,
+	contractAddr string,
+	codeID uint64,
+	data []byte,
+) error {
+	return p.emitCallerEvent(ctx, stateDB, EventTypeWasmMigrated, caller, contractAddr, codeID, data)
+}
+
+// EmitWasmAdminUpdated emits a WasmAdminUpdated EVM log. newAdmin is empty
+// when the admin was cleared.
+func (p Precompile) EmitWasmAdminUpdated(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	caller common.Address,
+	contractAddr string,
+	newAdmin string,
+) error {
+	return p.emitCallerEvent(ctx, stateDB, EventTypeWasmAdminUpdated, caller, contractAddr, newAdmin)
+}
+
+// emitCallerEvent emits an EVM log whose only indexed field is the caller,
+// packing the remaining values as non-indexed data.
+func (p Precompile) emitCallerEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventName string,
+	caller common.Address,
+	values ...interface{},
+) error {
+	event := p.Events[eventName]
 
 	topics := make([]common.Hash, 2)
 	topics[0] = event.ID
@@ -33,8 +87,7 @@ func (p Precompile) EmitWasmExecuted(
 		return err
 	}
 
-	// Pack non-indexed data: contractAddr (string) + response (bytes)
-	data, err := p.ABI.Events[EventTypeWasmExecuted].Inputs.NonIndexed().Pack(contractAddr, response)
+	data, err := event.Inputs.NonIndexed().Pack(values...)
 	if err != nil {
 		return err
 	}
@@ -11,7 +11,7 @@ import (
 	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
 )
 
-// executeWasm executes a CosmWasm contract from EVM. Non-payable in Phase 1.
+// executeWasm executes a CosmWasm contract from EVM. Non-payable.
 func (p Precompile) executeWasm(
 	ctx sdk.Context,
 	contract *vm.Contract,
@@ -41,6 +41,13 @@ func (p Precompile) executeWasm(
 		return nil, fmt.Errorf("invalid wasm contract address %q: %w", contractAddr, err)
 	}
 
+	// Lock the caller and reject re-entry into a wasm contract that is
+	// already executing further up the cross-runtime call stack.
+	ctx, err = crossruntime.LockContracts(ctx, callerAddr, wasmAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	p.Logger(ctx).Debug(
 		"tx called",
 		"method", method.Name,
@@ -0,0 +1,290 @@
+package wasm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
+)
+
+// instantiateWasm instantiates a stored CosmWasm code with the classic
+// sequence-based address generator. The EVM caller is the wasm creator and
+// funds are sent from its bank balance.
+func (p Precompile) instantiateWasm(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("instantiate: expected 5 args, got %d", len(args))
+	}
+
+	codeID := args[0].(uint64)
+	adminStr := args[1].(string)
+	msgBytes := args[2].([]byte)
+	label := args[3].(string)
+
+	ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := parseOptionalAddress("admin", adminStr)
+	if err != nil {
+		return nil, err
+	}
+	funds, err := parseFunds(args[4])
+	if err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.AccAddress(contract.Caller().Bytes())
+
+	// The new contract cannot be executing yet; only the caller is locked.
+	ctx, err = crossruntime.LockContracts(ctx, callerAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"caller", callerAddr.String(),
+		"code_id", codeID,
+		"funds", funds.String(),
+	)
+
+	contractAddr, data, err := p.wasmPermKeeper.Instantiate(ctx, codeID, callerAddr, admin, msgBytes, label, funds)
+	if err != nil {
+		return nil, fmt.Errorf("wasm instantiate failed: %w", err)
+	}
+
+	if err := p.EmitWasmInstantiated(ctx, stateDB, contract.Caller(), contractAddr.String(), codeID, data); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(contractAddr.String(), data)
+}
+
+// instantiate2Wasm instantiates a stored CosmWasm code at a predictable
+// address derived from the code checksum, the EVM caller, the salt and,
+// when fixMsg is set, the instantiate message.
+func (p Precompile) instantiate2Wasm(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf("instantiate2: expected 7 args, got %d", len(args))
+	}
+
+	codeID := args[0].(uint64)
+	adminStr := args[1].(string)
+	msgBytes := args[2].([]byte)
+	label := args[3].(string)
+	salt := args[4].([]byte)
+	fixMsg := args[5].(bool)
+
+	ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := parseOptionalAddress("admin", adminStr)
+	if err != nil {
+		return nil, err
+	}
+	funds, err := parseFunds(args[6])
+	if err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.AccAddress(contract.Caller().Bytes())
+
+	// The new contract cannot be executing yet; only the caller is locked.
+	ctx, err = crossruntime.LockContracts(ctx, callerAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"caller", callerAddr.String(),
+		"code_id", codeID,
+		"funds", funds.String(),
+	)
+
+	contractAddr, data, err := p.wasmPermKeeper.Instantiate2(ctx, codeID, callerAddr, admin, msgBytes, label, funds, salt, fixMsg)
+	if err != nil {
+		return nil, fmt.Errorf("wasm instantiate2 failed: %w", err)
+	}
+
+	if err := p.EmitWasmInstantiated(ctx, stateDB, contract.Caller(), contractAddr.String(), codeID, data); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(contractAddr.String(), data)
+}
+
+// migrateWasm migrates a CosmWasm contract to a new code ID. The wasm keeper
+// rejects the call unless the EVM caller is the contract admin.
+func (p Precompile) migrateWasm(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("migrate: expected 3 args, got %d", len(args))
+	}
+
+	contractAddr := args[0].(string)
+	newCodeID := args[1].(uint64)
+	msgBytes := args[2].([]byte)
+
+	ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmAddr, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wasm contract address %q: %w", contractAddr, err)
+	}
+
+	callerAddr := sdk.AccAddress(contract.Caller().Bytes())
+
+	// Migration runs the contract's migrate entry point, so it is guarded
+	// like execute.
+	ctx, err = crossruntime.LockContracts(ctx, callerAddr, wasmAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"caller", callerAddr.String(),
+		"wasm_contract", contractAddr,
+		"new_code_id", newCodeID,
+	)
+
+	data, err := p.wasmPermKeeper.Migrate(ctx, wasmAddr, callerAddr, newCodeID, msgBytes)
+	if err != nil {
+		return nil, fmt.Errorf("wasm migrate failed: %w", err)
+	}
+
+	if err := p.EmitWasmMigrated(ctx, stateDB, contract.Caller(), contractAddr, newCodeID, data); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(data)
+}
+
+// updateAdminWasm sets a new admin on a CosmWasm contract. The wasm keeper
+// rejects the call unless the EVM caller is the current admin.
+func (p Precompile) updateAdminWasm(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("updateAdmin: expected 2 args, got %d", len(args))
+	}
+
+	contractAddr := args[0].(string)
+	newAdminStr := args[1].(string)
+
+	ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmAddr, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wasm contract address %q: %w", contractAddr, err)
+	}
+	// An empty new admin would clear the admin; callers must use clearAdmin for that.
+	newAdmin, err := sdk.AccAddressFromBech32(newAdminStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid new admin address %q: %w", newAdminStr, err)
+	}
+
+	callerAddr := sdk.AccAddress(contract.Caller().Bytes())
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"caller", callerAddr.String(),
+		"wasm_contract", contractAddr,
+		"new_admin", newAdmin.String(),
+	)
+
+	if err := p.wasmPermKeeper.UpdateContractAdmin(ctx, wasmAddr, callerAddr, newAdmin); err != nil {
+		return nil, fmt.Errorf("wasm update admin failed: %w", err)
+	}
+
+	if err := p.EmitWasmAdminUpdated(ctx, stateDB, contract.Caller(), contractAddr, newAdmin.String()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// clearAdminWasm removes the admin of a CosmWasm contract, making it
+// immutable. The wasm keeper rejects the call unless the EVM caller is the
+// current admin.
+func (p Precompile) clearAdminWasm(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("clearAdmin: expected 1 arg, got %d", len(args))
+	}
+
+	contractAddr := args[0].(string)
+
+	ctx, err := crossruntime.CheckAndIncrementDepth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmAddr, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wasm contract address %q: %w", contractAddr, err)
+	}
+
+	callerAddr := sdk.AccAddress(contract.Caller().Bytes())
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"caller", callerAddr.String(),
+		"wasm_contract", contractAddr,
+	)
+
+	if err := p.wasmPermKeeper.ClearContractAdmin(ctx, wasmAddr, callerAddr); err != nil {
+		return nil, fmt.Errorf("wasm clear admin failed: %w", err)
+	}
+
+	if err := p.EmitWasmAdminUpdated(ctx, stateDB, contract.Caller(), contractAddr, ""); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -1,12 +1,59 @@
 package wasm
 
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// WasmPrecompileAddress is the hex address of the CosmWasm precompile.
 	WasmPrecompileAddress = "0x0000000000000000000000000000000000000903"
 
 	// Method names matching the Solidity interface IWasm.sol.
 	ExecuteMethod      = "execute"
+	InstantiateMethod  = "instantiate"
+	Instantiate2Method = "instantiate2"
+	MigrateMethod      = "migrate"
+	UpdateAdminMethod  = "updateAdmin"
+	ClearAdminMethod   = "clearAdmin"
 	QueryMethod        = "query"
 	ContractInfoMethod = "contractInfo"
 	RawQueryMethod     = "rawQuery"
 )
+
+// parseFunds converts an ABI-decoded IWasm.Coin[] argument into validated sdk.Coins.
+// Zero-amount entries are rejected rather than silently dropped.
+func parseFunds(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid funds: %w", err)
+	}
+	if len(coins) == 0 {
+		return sdk.Coins{}, nil
+	}
+
+	funds, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf("invalid funds: %w", err)
+	}
+	if err := funds.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid funds: %w", err)
+	}
+	return funds, nil
+}
+
+// parseOptionalAddress parses a bech32 address argument, treating the empty
+// string as "no address" (e.g. no contract admin).
+func parseOptionalAddress(field, addr string) (sdk.AccAddress, error) {
+	if addr == "" {
+		return nil, nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s address %q: %w", field, addr, err)
+	}
+	return accAddr, nil
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -86,16 +88,58 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]by
 }
 
 // Execute dispatches to the appropriate handler based on the ABI method.
+//
+// State-changing methods run on a branch of ctx that is written back only on
+// success. They may re-enter the EVM through the CosmWasm plugin; those nested
+// EVM legs commit to the branch on their own stateDB, so the touched accounts
+// and slots are synced back into stateDB once the handler returns.
 func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
 	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
+	if !p.IsTransaction(method) {
+		return p.dispatch(ctx, stateDB, contract, method, args)
+	}
+
+	branchCtx, write, err := crossruntime.BranchContext(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+	branchCtx, tracker := crossruntime.WithStateTracker(branchCtx)
+	bz, err := p.dispatch(branchCtx, stateDB, contract, method, args)
+	if err != nil {
+		return nil, err
+	}
+	write()
+	if err := tracker.Rehydrate(ctx, stateDB); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+func (p Precompile) dispatch(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
 	switch method.Name {
 	// State-changing
 	case ExecuteMethod:
 		return p.executeWasm(ctx, contract, stateDB, method, args)
+	case InstantiateMethod:
+		return p.instantiateWasm(ctx, contract, stateDB, method, args)
+	case Instantiate2Method:
+		return p.instantiate2Wasm(ctx, contract, stateDB, method, args)
+	case MigrateMethod:
+		return p.migrateWasm(ctx, contract, stateDB, method, args)
+	case UpdateAdminMethod:
+		return p.updateAdminWasm(ctx, contract, stateDB, method, args)
+	case ClearAdminMethod:
+		return p.clearAdminWasm(ctx, contract, stateDB, method, args)
 	// Queries
 	case QueryMethod:
 		return p.queryWasm(ctx, method, args)
@@ -111,7 +155,12 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 // IsTransaction returns true for state-changing methods.
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case ExecuteMethod:
+	case ExecuteMethod,
+		InstantiateMethod,
+		Instantiate2Method,
+		MigrateMethod,
+		UpdateAdminMethod,
+		ClearAdminMethod:
 		return true
 	default:
 		return false
@@ -37,10 +37,11 @@ import (
 type KeeperIntegrationSuite struct {
 	suite.Suite
 
-	ctx    sdk.Context
-	keeper auditkeeper.Keeper
-	snMock *supernodemocks.MockSupernodeKeeper
-	ctrl   *gomock.Controller
+	ctx      sdk.Context
+	keeper   auditkeeper.Keeper
+	snMock   *supernodemocks.MockSupernodeKeeper
+	bankMock *supernodemocks.MockBankKeeper
+	ctrl     *gomock.Controller
 }
 
 func TestAuditKeeperIntegrationSuite(t *testing.T) {
@@ -51,6 +52,7 @@ func TestAuditKeeperIntegrationSuite(t *testing.T) {
 func (s *KeeperIntegrationSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.snMock = supernodemocks.NewMockSupernodeKeeper(s.ctrl)
+	s.bankMock = supernodemocks.NewMockBankKeeper(s.ctrl)
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(auditmodule.AppModuleBasic{})
 	addrCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -72,6 +74,7 @@ func (s *KeeperIntegrationSuite) SetupTest() {
 		log.NewNopLogger(),
 		authority,
 		s.snMock,
+		s.bankMock,
 	)
 	require.NoError(s.T(), s.keeper.SetParams(s.ctx, types.DefaultParams()))
 }
@@ -332,6 +335,7 @@ func (s *KeeperIntegrationSuite) TestGenesisRoundTrip() {
 		log.NewNopLogger(),
 		authority,
 		s.snMock,
+		s.bankMock,
 	)
 	require.NoError(s.T(), freshKeeper.InitGenesis(freshCtx, *gs))
 
@@ -0,0 +1,150 @@
+//go:build test
+// +build test
+
+package crossruntime_test
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// echoContractWasm returns a minimal CosmWasm contract whose instantiate and
+// execute entry points return the raw message they were called with. The
+// caller therefore supplies the full ContractResult<Response> JSON, which
+// lets tests emit arbitrary custom messages without a Rust toolchain.
+//
+// Equivalent WAT:
+//
+//	(module
+//	  (memory (export "memory") 16)
+//	  (global $heap (mut i32) (i32.const 1024))
+//	  (func (export "allocate") (param $size i32) (result i32) ...bump allocator...)
+//	  (func (export "deallocate") (param i32))
+//	  (func (export "interface_version_8"))
+//	  (func $echo (param i32 i32 i32) (result i32) (local.get 2))
+//	  (export "instantiate" (func $echo))
+//	  (export "execute" (func $echo)))
+func echoContractWasm() []byte {
+	const (
+		i32      = 0x7f
+		funcType = 0x60
+	)
+	types := vec(
+		[]byte{funcType, 1, i32, 1, i32},           // allocate
+		[]byte{funcType, 1, i32, 0},                // deallocate
+		[]byte{funcType, 0, 0},                     // interface_version_8
+		[]byte{funcType, 3, i32, i32, i32, 1, i32}, // instantiate, execute
+	)
+	funcs := []byte{4, 0, 1, 2, 3}
+	memory := []byte{1, 0x00, 16}                        // one memory, 16 pages, no maximum
+	globals := []byte{1, i32, 1, 0x41, 0x80, 0x08, 0x0b} // mut i32 = 1024
+	exports := vec(
+		export("memory", 0x02, 0),
+		export("allocate", 0x00, 0),
+		export("deallocate", 0x00, 1),
+		export("interface_version_8", 0x00, 2),
+		export("instantiate", 0x00, 3),
+		export("execute", 0x00, 3),
+	)
+	allocate := []byte{
+		1, 1, i32, // one i32 local: the region pointer
+		0x23, 0x00, 0x21, 0x01, // ptr = heap
+		0x20, 0x01, 0x20, 0x01, 0x41, 0x0c, 0x6a, 0x36, 0x02, 0x00, // region.offset = ptr + 12
+		0x20, 0x01, 0x20, 0x00, 0x36, 0x02, 0x04, // region.capacity = size
+		0x20, 0x01, 0x41, 0x00, 0x36, 0x02, 0x08, // region.length = 0
+		0x20, 0x01, 0x41, 0x13, 0x6a, 0x20, 0x00, 0x6a, 0x41, 0x78, 0x71, 0x24, 0x00, // heap = (ptr + 12 + size + 7) &^ 7
+		0x23, 0x00, 0x3f, 0x00, 0x41, 0x10, 0x74, 0x4b, 0x04, 0x40, // if heap > memory.size << 16
+		0x23, 0x00, 0x41, 0x10, 0x76, 0x41, 0x01, 0x6a, 0x3f, 0x00, 0x6b, 0x40, 0x00, 0x1a, // memory.grow(heap>>16 + 1 - memory.size)
+		0x0b,
+		0x20, 0x01, 0x0b, // return ptr
+	}
+	noop := []byte{0, 0x0b}
+	echo := []byte{0, 0x20, 0x02, 0x0b}
+	code := vec(withSize(allocate), withSize(noop), withSize(noop), withSize(echo))
+
+	var out bytes.Buffer
+	out.Write([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00})
+	for _, s := range []struct {
+		id      byte
+		payload []byte
+	}{{1, types}, {3, funcs}, {5, memory}, {6, globals}, {7, exports}, {10, code}} {
+		out.WriteByte(s.id)
+		out.Write(withSize(s.payload))
+	}
+	return out.Bytes()
+}
+
+func vec(items ...[]byte) []byte {
+	out := uleb(uint64(len(items)))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func export(name string, kind, index byte) []byte {
+	out := withSize([]byte(name))
+	return append(out, kind, index)
+}
+
+func withSize(b []byte) []byte {
+	return append(uleb(uint64(len(b))), b...)
+}
+
+func uleb(v uint64) []byte {
+	return binary.AppendUvarint(nil, v)
+}
+
+// counterRuntime is EVM runtime code that increments storage slot 0 when
+// called without calldata and returns slot 0 otherwise.
+func counterRuntime() []byte {
+	return []byte{
+		0x36, 0x60, 0x0e, 0x57, // if calldatasize: jump to get
+		0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x00, // slot0 += 1; stop
+		0x5b, 0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3, // get: return slot0
+	}
+}
+
+// revertRuntime is EVM runtime code that always reverts.
+func revertRuntime() []byte {
+	return []byte{0x60, 0x00, 0x60, 0x00, 0xfd}
+}
+
+// driverRuntime is EVM runtime code that:
+//  1. increments the counter,
+//  2. forwards its own calldata to the wasm precompile,
+//  3. reads the counter back and stores the value in its own slot 0.
+//
+// Any failed call reverts with the callee's revert data.
+func driverRuntime(counter, precompile common.Address) []byte {
+	build := func(revertAt uint16) []byte {
+		fail := []byte{0x15, 0x61, byte(revertAt >> 8), byte(revertAt), 0x57} // iszero; jumpi revert
+		var code []byte
+		// counter.inc()
+		code = append(code, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00)
+		code = append(code, push20(counter)...)
+		code = append(code, 0x5a, 0xf1)
+		code = append(code, fail...)
+		// precompile.call(msg.data)
+		code = append(code, 0x36, 0x60, 0x00, 0x60, 0x00, 0x37)
+		code = append(code, 0x60, 0x00, 0x60, 0x00, 0x36, 0x60, 0x00, 0x60, 0x00)
+		code = append(code, push20(precompile)...)
+		code = append(code, 0x5a, 0xf1)
+		code = append(code, fail...)
+		// slot0 = counter.get()
+		code = append(code, 0x60, 0x20, 0x60, 0x00, 0x60, 0x01, 0x60, 0x00, 0x60, 0x00)
+		code = append(code, push20(counter)...)
+		code = append(code, 0x5a, 0xf1)
+		code = append(code, fail...)
+		code = append(code, 0x60, 0x00, 0x51, 0x60, 0x00, 0x55, 0x00)
+		// revert: bubble up the return data
+		return append(code, 0x5b, 0x3d, 0x60, 0x00, 0x60, 0x00, 0x3e, 0x3d, 0x60, 0x00, 0xfd)
+	}
+	return build(uint16(len(build(0)) - 11))
+}
+
+func push20(addr common.Address) []byte {
+	return append([]byte{0x73}, addr.Bytes()...)
+}
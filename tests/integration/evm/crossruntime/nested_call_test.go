@@ -0,0 +1,162 @@
+//go:build test
+// +build test
+
+package crossruntime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/precompiles/crossruntime"
+	wasmprecompile "github.com/LumeraProtocol/lumera/precompiles/wasm"
+	"github.com/LumeraProtocol/lumera/tests/ibctesting"
+)
+
+var (
+	counterAddr  = common.HexToAddress("0x00000000000000000000000000000000000c0de1")
+	driverAddr   = common.HexToAddress("0x00000000000000000000000000000000000c0de2")
+	reverterAddr = common.HexToAddress("0x00000000000000000000000000000000000c0de3")
+)
+
+// nestedFixture is a single chain with the echo wasm contract and the
+// counter, driver and reverter EVM contracts deployed.
+type nestedFixture struct {
+	chain *ibctesting.TestChain
+	echo  sdk.AccAddress
+}
+
+func setupNestedFixture(t *testing.T) nestedFixture {
+	t.Helper()
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+
+	codeID := chain.StoreCode(echoContractWasm()).CodeID
+	echo := chain.InstantiateContract(codeID, echoResponse(t))
+
+	app := chain.GetLumeraApp()
+	ctx := chain.GetContext()
+	db := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	db.SetCode(counterAddr, counterRuntime())
+	db.SetCode(driverAddr, driverRuntime(counterAddr, common.HexToAddress(wasmprecompile.WasmPrecompileAddress)))
+	db.SetCode(reverterAddr, revertRuntime())
+	require.NoError(t, db.Commit())
+
+	return nestedFixture{chain: chain, echo: echo}
+}
+
+// echoResponse builds the ContractResult<Response> JSON the echo contract
+// returns, dispatching each custom message with reply_on "never".
+func echoResponse(t *testing.T, customMsgs ...any) []byte {
+	t.Helper()
+	messages := make([]any, 0, len(customMsgs))
+	for _, msg := range customMsgs {
+		messages = append(messages, map[string]any{
+			"id":       0,
+			"payload":  "",
+			"msg":      map[string]any{"custom": msg},
+			"reply_on": "never",
+		})
+	}
+	bz, err := json.Marshal(map[string]any{
+		"ok": map[string]any{
+			"messages":   messages,
+			"attributes": []any{},
+			"events":     []any{},
+			"data":       nil,
+		},
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func evmCallMsg(target common.Address, calldata []byte) map[string]any {
+	return map[string]any{
+		"evm_call": map[string]any{
+			"contract": target.Hex(),
+			"calldata": hexutil.Encode(calldata),
+		},
+	}
+}
+
+// runDriver calls the driver contract with calldata asking the wasm
+// precompile to execute the echo contract with echoMsg.
+func (f nestedFixture) runDriver(t *testing.T, echoMsg []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	t.Helper()
+	input, err := wasmprecompile.ABI.Pack("execute", f.echo.String(), echoMsg)
+	require.NoError(t, err)
+
+	app := f.chain.GetLumeraApp()
+	ctx := f.chain.GetContext()
+	from := common.BytesToAddress(f.chain.SenderAccount.GetAddress())
+	db := statedb.New(ctx, app.EVMKeeper, statedb.NewEmptyTxConfig())
+	return app.EVMKeeper.CallEVMWithData(ctx, db, from, &driverAddr, input, true, false, nil)
+}
+
+func (f nestedFixture) slot0(addr common.Address) common.Hash {
+	return f.chain.GetLumeraApp().EVMKeeper.GetState(f.chain.GetContext(), addr, common.Hash{})
+}
+
+// testNestedCallSeesInnerWrites runs EVM -> wasm -> EVM where both EVM legs
+// increment the same counter. The outer leg must observe the inner write and
+// must not clobber it on commit.
+func testNestedCallSeesInnerWrites(t *testing.T) {
+	f := setupNestedFixture(t)
+
+	_, err := f.runDriver(t, echoResponse(t, evmCallMsg(counterAddr, nil)))
+	require.NoError(t, err)
+
+	require.Equal(t, common.BigToHash(common.Big2), f.slot0(counterAddr), "counter keeps both increments")
+	require.Equal(t, common.BigToHash(common.Big2), f.slot0(driverAddr), "outer leg reads the inner write")
+}
+
+// testNestedRevertRollsBackAllLegs makes a second inner EVM leg revert after a
+// first one succeeded. The wasm execute fails, the driver bubbles the revert
+// and no leg's writes persist.
+func testNestedRevertRollsBackAllLegs(t *testing.T) {
+	f := setupNestedFixture(t)
+
+	res, err := f.runDriver(t, echoResponse(t, evmCallMsg(counterAddr, nil), evmCallMsg(reverterAddr, nil)))
+	require.Error(t, err)
+	require.Contains(t, string(res.Ret), "evm execution reverted")
+
+	require.Equal(t, common.Hash{}, f.slot0(counterAddr))
+	require.Equal(t, common.Hash{}, f.slot0(driverAddr))
+}
+
+// testNestedReentryIntoLockedContractFails routes the inner EVM leg back into
+// the driver, which calls the echo contract again while it is still on the
+// stack.
+func testNestedReentryIntoLockedContractFails(t *testing.T) {
+	f := setupNestedFixture(t)
+
+	reentry, err := wasmprecompile.ABI.Pack("execute", f.echo.String(), echoResponse(t))
+	require.NoError(t, err)
+
+	res, err := f.runDriver(t, echoResponse(t, evmCallMsg(driverAddr, reentry)))
+	require.Error(t, err)
+	require.Contains(t, string(res.Ret), crossruntime.ErrContractLocked.Error())
+
+	require.Equal(t, common.Hash{}, f.slot0(counterAddr))
+}
+
+// testNestedGasIsChargedToOuterCall checks that the inner EVM leg's gas is
+// part of the outer transaction's gas usage.
+func testNestedGasIsChargedToOuterCall(t *testing.T) {
+	f := setupNestedFixture(t)
+
+	plain, err := f.runDriver(t, echoResponse(t))
+	require.NoError(t, err)
+	nested, err := f.runDriver(t, echoResponse(t, evmCallMsg(counterAddr, nil)))
+	require.NoError(t, err)
+
+	require.Greater(t, nested.GasUsed, plain.GasUsed)
+}
@@ -0,0 +1,23 @@
+//go:build test
+// +build test
+
+package crossruntime_test
+
+import "testing"
+
+// TestNestedCrossRuntimeSuite groups EVM -> wasm -> EVM call checks. Each
+// subtest provisions its own chain so state stays isolated.
+func TestNestedCrossRuntimeSuite(t *testing.T) {
+	t.Run("SeesInnerWrites", func(t *testing.T) {
+		testNestedCallSeesInnerWrites(t)
+	})
+	t.Run("RevertRollsBackAllLegs", func(t *testing.T) {
+		testNestedRevertRollsBackAllLegs(t)
+	})
+	t.Run("ReentryIntoLockedContractFails", func(t *testing.T) {
+		testNestedReentryIntoLockedContractFails(t)
+	})
+	t.Run("GasIsChargedToOuterCall", func(t *testing.T) {
+		testNestedGasIsChargedToOuterCall(t)
+	})
+}
@@ -0,0 +1,191 @@
+//go:build test
+// +build test
+
+package ibc_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	lcfg "github.com/LumeraProtocol/lumera/config"
+	"github.com/LumeraProtocol/lumera/tests/ibctesting"
+	"github.com/LumeraProtocol/lumera/x/action/v1/ibchooks"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctst "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIBCActionHooksSuite groups ICS-20 memo action request checks.
+func TestIBCActionHooksSuite(t *testing.T) {
+	t.Run("CreatesActionFromMemo", func(t *testing.T) {
+		testIBCActionHooksCreatesActionFromMemo(t)
+	})
+	t.Run("UnderfundedMemoRefundsSender", func(t *testing.T) {
+		testIBCActionHooksUnderfundedMemoRefundsSender(t)
+	})
+}
+
+// testIBCActionHooksCreatesActionFromMemo returns ulume vouchers from chainB to
+// chainA with an action memo and verifies chainA registers a Cascade action
+// owned by the derived intermediary, paid from the transfer. The excess goes
+// to the refund address, and the packet is acknowledged once the refund
+// address cancels the action.
+func testIBCActionHooksCreatesActionFromMemo(t *testing.T) {
+	_, chainA, chainB, path := setupActionHooksPath(t)
+	senderB := chainB.SenderAccount.GetAddress()
+	refundAddr := chainA.SenderAccount.GetAddress()
+
+	appKey := secp256k1.GenPrivKey()
+	price := sdk.NewCoin(lcfg.ChainDenom, sdkmath.NewInt(100_000))
+	memo := cascadeActionMemo(t, chainA, appKey, price, refundAddr)
+
+	refundBefore := chainA.Balance(refundAddr, lcfg.ChainDenom)
+	packet := sendVoucherBackWithMemo(t, chainB, path, sdkmath.NewInt(150_000), memo)
+	// The acknowledgement is written asynchronously, when the action settles.
+	require.NoError(t, path.RelayPacketWithoutAck(packet))
+
+	intermediary := ibchooks.DeriveIntermediaryAccount(path.EndpointA.ChannelID, senderB.String())
+	appA := chainA.GetLumeraApp()
+	actions, err := appA.ActionKeeper.GetActionsByCreator(chainA.GetContext(), intermediary.String())
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, actiontypes.ActionTypeCascade, actions[0].ActionType)
+	require.Equal(t, appKey.PubKey().Bytes(), actions[0].AppPubkey)
+	actionID := actions[0].ActionID
+
+	// The excess over the price was paid to the refund address.
+	require.True(t, chainA.Balance(intermediary, lcfg.ChainDenom).Amount.IsZero())
+	refundAfter := chainA.Balance(refundAddr, lcfg.ChainDenom)
+	require.True(t, refundAfter.Amount.Sub(refundBefore.Amount).Equal(sdkmath.NewInt(50_000)),
+		"want +50000, got %s -> %s", refundBefore, refundAfter)
+
+	// The refund address cancels the action; the cancellation refund goes to
+	// it and the packet is acknowledged with the final state.
+	res, err := chainA.SendMsgs(actiontypes.NewMsgCancelAction(refundAddr.String(), actionID))
+	require.NoError(t, err)
+	require.True(t, chainA.Balance(intermediary, lcfg.ChainDenom).Amount.IsZero())
+
+	ackBz, err := ibctst.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.True(t, ack.Success())
+	var result ibchooks.AckResult
+	require.NoError(t, json.Unmarshal(ack.GetResult(), &result))
+	require.Equal(t, actionID, result.ActionID)
+	require.Equal(t, actiontypes.ActionStateCancelled.String(), result.Status)
+	require.Equal(t, intermediary.String(), result.Intermediary)
+	require.Equal(t, refundAddr.String(), result.RefundAddress)
+
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.NoError(t, path.EndpointB.AcknowledgePacket(packet, ackBz))
+	_, pending := appA.ActionKeeper.GetPendingIBCAck(chainA.GetContext(), actionID)
+	require.False(t, pending)
+}
+
+// testIBCActionHooksUnderfundedMemoRefundsSender verifies that a transfer that
+// does not cover the memo price is error-acked and refunded on chainB.
+func testIBCActionHooksUnderfundedMemoRefundsSender(t *testing.T) {
+	_, chainA, chainB, path := setupActionHooksPath(t)
+	senderB := chainB.SenderAccount.GetAddress()
+	voucher := transferDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, lcfg.ChainDenom)
+	before := chainB.Balance(senderB, voucher)
+
+	price := sdk.NewCoin(lcfg.ChainDenom, sdkmath.NewInt(100_000))
+	memo := cascadeActionMemo(t, chainA, secp256k1.GenPrivKey(), price, chainA.SenderAccount.GetAddress())
+
+	packet := sendVoucherBackWithMemo(t, chainB, path, sdkmath.NewInt(99_999), memo)
+	require.NoError(t, path.RelayPacket(packet))
+
+	intermediary := ibchooks.DeriveIntermediaryAccount(path.EndpointA.ChannelID, senderB.String())
+	actions, err := chainA.GetLumeraApp().ActionKeeper.GetActionsByCreator(chainA.GetContext(), intermediary.String())
+	require.NoError(t, err)
+	require.Empty(t, actions)
+	require.True(t, chainA.Balance(intermediary, lcfg.ChainDenom).Amount.IsZero())
+
+	after := chainB.Balance(senderB, voucher)
+	require.True(t, after.Amount.Equal(before.Amount), "want %s, got %s", before, after)
+}
+
+// setupActionHooksPath opens a transfer path and funds chainB's sender with
+// ulume vouchers originating on chainA, so a transfer back unwraps to ulume.
+func setupActionHooksPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.TestChain, *ibctesting.TestChain, *ibctesting.Path) {
+	t.Helper()
+	coord, chainA, chainB, path := setupERC20MiddlewarePath(t)
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoin(lcfg.ChainDenom, sdkmath.NewInt(1_000_000)),
+		chainA.SenderAccount.GetAddress().String(),
+		chainB.SenderAccount.GetAddress().String(),
+		chainA.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	_, err := chainA.SendMsgs(msg)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayAndAckPendingPackets())
+	return coord, chainA, chainB, path
+}
+
+// sendVoucherBackWithMemo sends ulume vouchers from chainB back to chainA and
+// returns the packet, leaving it for the caller to relay.
+func sendVoucherBackWithMemo(t *testing.T, chainB *ibctesting.TestChain, path *ibctesting.Path, amount sdkmath.Int, memo string) channeltypes.Packet {
+	t.Helper()
+	voucher := transferDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, lcfg.ChainDenom)
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		sdk.NewCoin(voucher, amount),
+		chainB.SenderAccount.GetAddress().String(),
+		"lumera1ignored",
+		chainB.GetTimeoutHeight(),
+		0,
+		memo,
+	)
+	_, err := chainB.SendMsgs(msg)
+	require.NoError(t, err)
+	require.Len(t, *chainB.PendingSendPackets, 1)
+	packet := (*chainB.PendingSendPackets)[0]
+	*chainB.PendingSendPackets = nil
+	return packet
+}
+
+// cascadeActionMemo builds an action memo whose Cascade signatures are made
+// with appKey, which is also supplied as app_pubkey.
+func cascadeActionMemo(t *testing.T, chainA *ibctesting.TestChain, appKey *secp256k1.PrivKey, price sdk.Coin, refundAddr sdk.AccAddress) string {
+	t.Helper()
+	dataB64 := base64.StdEncoding.EncodeToString([]byte("rqid-1"))
+	sig, err := appKey.Sign([]byte(dataB64))
+	require.NoError(t, err)
+
+	expiration := chainA.GetContext().BlockTime().Add(48 * time.Hour).Unix()
+	bz, err := json.Marshal(map[string]any{
+		ibchooks.MemoKey: map[string]any{
+			"action_type": "CASCADE",
+			"metadata": map[string]any{
+				"data_hash":  "ibc_hook_hash",
+				"file_name":  "ibc.bin",
+				"rq_ids_ic":  1,
+				"signatures": fmt.Sprintf("%s.%s", dataB64, base64.StdEncoding.EncodeToString(sig)),
+			},
+			"price":           price.String(),
+			"expiration_time": strconv.FormatInt(expiration, 10),
+			"file_size_kbs":   "1",
+			"app_pubkey":      appKey.PubKey().Bytes(),
+			"refund_address":  refundAddr.String(),
+		},
+	})
+	require.NoError(t, err)
+	return string(bz)
+}
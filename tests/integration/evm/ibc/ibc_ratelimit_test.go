@@ -0,0 +1,169 @@
+//go:build test
+// +build test
+
+package ibc_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	lcfg "github.com/LumeraProtocol/lumera/config"
+	"github.com/LumeraProtocol/lumera/tests/ibctesting"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIBCRateLimitSuite groups per-channel IBC rate limiting checks.
+func TestIBCRateLimitSuite(t *testing.T) {
+	t.Run("InflowQuotaAndReset", func(t *testing.T) {
+		testIBCRateLimitInflowQuotaAndReset(t)
+	})
+	t.Run("OutflowQuota", func(t *testing.T) {
+		testIBCRateLimitOutflowQuota(t)
+	})
+	t.Run("WhitelistBypassesQuota", func(t *testing.T) {
+		testIBCRateLimitWhitelistBypassesQuota(t)
+	})
+}
+
+// testIBCRateLimitInflowQuotaAndReset verifies that inflow beyond the quota is
+// error-acked (and refunded), that usage is queryable, and that
+// MsgResetRateLimit clears the window.
+func testIBCRateLimitInflowQuotaAndReset(t *testing.T) {
+	_, chainA, chainB, path, voucher := setupRateLimitPath(t)
+	senderB := chainB.SenderAccount.GetAddress()
+	receiverA := chainA.SenderAccount.GetAddress()
+
+	// 10% of the 1000 voucher supply may flow in per window.
+	addRateLimit(t, chainA, voucher, path.EndpointA.ChannelID, 100, 10)
+
+	transferToA(t, chainB, path, 50)
+	require.True(t, chainA.Balance(receiverA, voucher).Amount.Equal(sdkmath.NewInt(1050)))
+
+	beforeB := chainB.Balance(senderB, lcfg.ChainDenom)
+	transferToA(t, chainB, path, 60)
+	require.True(t, chainA.Balance(receiverA, voucher).Amount.Equal(sdkmath.NewInt(1050)),
+		"packet over quota must not be credited")
+	require.True(t, chainB.Balance(senderB, lcfg.ChainDenom).Amount.Equal(beforeB.Amount),
+		"packet over quota must be refunded")
+
+	rl := queryRateLimit(t, chainA, voucher, path.EndpointA.ChannelID)
+	require.True(t, rl.Flow.Inflow.Equal(sdkmath.NewInt(50)), "inflow: %s", rl.Flow.Inflow)
+
+	_, err := ratelimitkeeper.NewMsgServerImpl(*chainA.GetLumeraApp().RateLimitKeeper).ResetRateLimit(chainA.GetContext(), &ratelimittypes.MsgResetRateLimit{
+		Authority:         govAuthority(),
+		Denom:             voucher,
+		ChannelOrClientId: path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+	require.True(t, queryRateLimit(t, chainA, voucher, path.EndpointA.ChannelID).Flow.Inflow.IsZero())
+
+	transferToA(t, chainB, path, 60)
+	require.True(t, chainA.Balance(receiverA, voucher).Amount.Equal(sdkmath.NewInt(1110)))
+}
+
+// testIBCRateLimitOutflowQuota verifies that sends beyond the outflow quota
+// are rejected on the sending chain.
+func testIBCRateLimitOutflowQuota(t *testing.T) {
+	_, chainA, _, path, voucher := setupRateLimitPath(t)
+
+	addRateLimit(t, chainA, voucher, path.EndpointA.ChannelID, 10, 100)
+
+	send := func(amount int64) error {
+		msg := transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			sdk.NewCoin(voucher, sdkmath.NewInt(amount)),
+			chainA.SenderAccount.GetAddress().String(),
+			path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+			chainA.GetTimeoutHeight(),
+			0,
+			"",
+		)
+		_, err := chainA.SendMsgs(msg)
+		return err
+	}
+
+	require.NoError(t, send(100))
+	require.NoError(t, path.RelayAndAckPendingPackets())
+	require.Error(t, send(1))
+}
+
+// testIBCRateLimitWhitelistBypassesQuota verifies that whitelisted
+// sender/receiver pairs are not counted against the quota.
+func testIBCRateLimitWhitelistBypassesQuota(t *testing.T) {
+	_, chainA, chainB, path, voucher := setupRateLimitPath(t)
+	receiverA := chainA.SenderAccount.GetAddress()
+
+	addRateLimit(t, chainA, voucher, path.EndpointA.ChannelID, 10, 10)
+	chainA.GetLumeraApp().RateLimitKeeper.SetWhitelistedAddressPair(chainA.GetContext(), ratelimittypes.WhitelistedAddressPair{
+		Sender:   chainB.SenderAccount.GetAddress().String(),
+		Receiver: receiverA.String(),
+	})
+
+	transferToA(t, chainB, path, 500)
+	require.True(t, chainA.Balance(receiverA, voucher).Amount.Equal(sdkmath.NewInt(1500)))
+	require.True(t, queryRateLimit(t, chainA, voucher, path.EndpointA.ChannelID).Flow.Inflow.IsZero())
+}
+
+// setupRateLimitPath opens a transfer path and mints 1000 ulume vouchers on
+// chainA, which become the channel value for voucher quotas.
+func setupRateLimitPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.TestChain, *ibctesting.TestChain, *ibctesting.Path, string) {
+	t.Helper()
+	coord, chainA, chainB, path := setupERC20MiddlewarePath(t)
+	transferToA(t, chainB, path, 1000)
+	voucher := transferDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, lcfg.ChainDenom)
+	return coord, chainA, chainB, path, voucher
+}
+
+func transferToA(t *testing.T, chainB *ibctesting.TestChain, path *ibctesting.Path, amount int64) {
+	t.Helper()
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		sdk.NewCoin(lcfg.ChainDenom, sdkmath.NewInt(amount)),
+		chainB.SenderAccount.GetAddress().String(),
+		path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		chainB.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	_, err := chainB.SendMsgs(msg)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayAndAckPendingPackets())
+}
+
+// addRateLimit installs a 24h quota of maxSend/maxRecv percent of the channel value.
+func addRateLimit(t *testing.T, chain *ibctesting.TestChain, denom, channelID string, maxSend, maxRecv int64) {
+	t.Helper()
+	_, err := ratelimitkeeper.NewMsgServerImpl(*chain.GetLumeraApp().RateLimitKeeper).AddRateLimit(chain.GetContext(), &ratelimittypes.MsgAddRateLimit{
+		Authority:         govAuthority(),
+		Denom:             denom,
+		ChannelOrClientId: channelID,
+		MaxPercentSend:    sdkmath.NewInt(maxSend),
+		MaxPercentRecv:    sdkmath.NewInt(maxRecv),
+		DurationHours:     24,
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+}
+
+func queryRateLimit(t *testing.T, chain *ibctesting.TestChain, denom, channelID string) *ratelimittypes.RateLimit {
+	t.Helper()
+	resp, err := chain.GetLumeraApp().RateLimitKeeper.RateLimit(chain.GetContext(), &ratelimittypes.QueryRateLimitRequest{
+		Denom:             denom,
+		ChannelOrClientId: channelID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.RateLimit)
+	return resp.RateLimit
+}
+
+func govAuthority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
@@ -143,6 +143,36 @@ func testActionPrecompileGetActionFeeViaEthCall(t *testing.T, node *evmtest.Node
 	}
 }
 
+// testActionPrecompileGetFeeMultiplierViaEthCall verifies the action precompile
+// `getFeeMultiplier()` query reports the neutral multiplier on an idle chain.
+func testActionPrecompileGetFeeMultiplierViaEthCall(t *testing.T, node *evmtest.Node) {
+	t.Helper()
+	node.WaitForBlockNumberAtLeast(t, 1, 20*time.Second)
+
+	input, err := actionprecompile.ABI.Pack(actionprecompile.GetFeeMultiplierMethod)
+	if err != nil {
+		t.Fatalf("pack getFeeMultiplier input: %v", err)
+	}
+
+	result := mustEthCallPrecompile(t, node, actionprecompile.ActionPrecompileAddress, input)
+	out, err := actionprecompile.ABI.Unpack(actionprecompile.GetFeeMultiplierMethod, result)
+	if err != nil {
+		t.Fatalf("unpack getFeeMultiplier output: %v", err)
+	}
+
+	if len(out) != 1 {
+		t.Fatalf("expected 1 return value from getFeeMultiplier, got %d", len(out))
+	}
+
+	multiplier, ok := out[0].(string)
+	if !ok {
+		t.Fatalf("unexpected multiplier type: %#v", out[0])
+	}
+	if multiplier != "1.000000000000000000" {
+		t.Fatalf("expected neutral fee multiplier on idle chain, got %s", multiplier)
+	}
+}
+
 // testActionPrecompileGetActionsByStateViaEthCall verifies the action precompile
 // `getActionsByState(uint8,uint64,uint64)` query returns empty list when no actions exist.
 func testActionPrecompileGetActionsByStateViaEthCall(t *testing.T, node *evmtest.Node) {
@@ -0,0 +1,108 @@
+//go:build integration
+// +build integration
+
+package precompiles_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	auditprecompile "github.com/LumeraProtocol/lumera/precompiles/audit"
+	evmtest "github.com/LumeraProtocol/lumera/tests/integration/evmtest"
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+// testAuditPrecompileGetCurrentEpochViaEthCall verifies the audit precompile
+// `getCurrentEpoch()` query returns a well-formed epoch window via eth_call.
+func testAuditPrecompileGetCurrentEpochViaEthCall(t *testing.T, node *evmtest.Node) {
+	t.Helper()
+	node.WaitForBlockNumberAtLeast(t, 1, 20*time.Second)
+
+	input, err := auditprecompile.ABI.Pack(auditprecompile.GetCurrentEpochMethod)
+	if err != nil {
+		t.Fatalf("pack getCurrentEpoch input: %v", err)
+	}
+
+	result := mustEthCallPrecompile(t, node, auditprecompile.AuditPrecompileAddress, input)
+	out, err := auditprecompile.ABI.Unpack(auditprecompile.GetCurrentEpochMethod, result)
+	if err != nil {
+		t.Fatalf("unpack getCurrentEpoch output: %v", err)
+	}
+
+	if len(out) != 3 {
+		t.Fatalf("expected 3 return values from getCurrentEpoch, got %d", len(out))
+	}
+
+	start, ok := out[1].(int64)
+	if !ok {
+		t.Fatalf("unexpected epochStartHeight type: %#v", out[1])
+	}
+	end, ok := out[2].(int64)
+	if !ok {
+		t.Fatalf("unexpected epochEndHeight type: %#v", out[2])
+	}
+	if end < start {
+		t.Fatalf("expected epochEndHeight >= epochStartHeight, got %d < %d", end, start)
+	}
+}
+
+// testAuditPrecompileGetNodeSuspicionStateNotFoundViaEthCall verifies that a
+// supernode without recorded storage-truth state returns found=false instead
+// of reverting.
+func testAuditPrecompileGetNodeSuspicionStateNotFoundViaEthCall(t *testing.T, node *evmtest.Node) {
+	t.Helper()
+	node.WaitForBlockNumberAtLeast(t, 1, 20*time.Second)
+
+	input, err := auditprecompile.ABI.Pack(
+		auditprecompile.GetNodeSuspicionStateMethod,
+		node.KeyInfo().Address,
+	)
+	if err != nil {
+		t.Fatalf("pack getNodeSuspicionState input: %v", err)
+	}
+
+	result := mustEthCallPrecompile(t, node, auditprecompile.AuditPrecompileAddress, input)
+	out, err := auditprecompile.ABI.Unpack(auditprecompile.GetNodeSuspicionStateMethod, result)
+	if err != nil {
+		t.Fatalf("unpack getNodeSuspicionState output: %v", err)
+	}
+
+	if len(out) != 2 {
+		t.Fatalf("expected 2 return values from getNodeSuspicionState, got %d", len(out))
+	}
+	found, ok := out[1].(bool)
+	if !ok {
+		t.Fatalf("unexpected found type: %#v", out[1])
+	}
+	if found {
+		t.Fatalf("expected no suspicion state on a fresh chain")
+	}
+}
+
+// testAuditSubmitEvidenceTxPathFailsForReservedType verifies that the audit
+// precompile rejects evidence types reserved for the action module.
+func testAuditSubmitEvidenceTxPathFailsForReservedType(t *testing.T, node *evmtest.Node) {
+	t.Helper()
+	node.WaitForBlockNumberAtLeast(t, 1, 20*time.Second)
+
+	input, err := auditprecompile.ABI.Pack(
+		auditprecompile.SubmitEvidenceMethod,
+		node.KeyInfo().Address,
+		uint8(audittypes.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED),
+		"non-existent-action-id-12345",
+		`{"top_10_validator_addresses":[]}`,
+	)
+	if err != nil {
+		t.Fatalf("pack submitEvidence input: %v", err)
+	}
+
+	txHash := sendPrecompileLegacyTx(t, node, auditprecompile.AuditPrecompileAddress, input, 500_000)
+	receipt := node.WaitForReceipt(t, txHash, 45*time.Second)
+	evmtest.AssertReceiptMatchesTxHash(t, receipt, txHash)
+
+	status := evmtest.MustStringField(t, receipt, "status")
+	if !strings.EqualFold(status, "0x0") {
+		t.Fatalf("expected FAILED submitEvidence for reserved evidence type, got status %q", status)
+	}
+}
@@ -77,6 +77,9 @@ func TestPrecompilesSuite(t *testing.T) {
 	t.Run("ActionPrecompileGetActionFeeViaEthCall", func(t *testing.T) {
 		testActionPrecompileGetActionFeeViaEthCall(t, node)
 	})
+	t.Run("ActionPrecompileGetFeeMultiplierViaEthCall", func(t *testing.T) {
+		testActionPrecompileGetFeeMultiplierViaEthCall(t, node)
+	})
 	t.Run("ActionPrecompileGetActionsByStateViaEthCall", func(t *testing.T) {
 		testActionPrecompileGetActionsByStateViaEthCall(t, node)
 	})
@@ -95,6 +98,17 @@ func TestPrecompilesSuite(t *testing.T) {
 		testSupernodePrecompileGetTopSuperNodesForBlockViaEthCall(t, node)
 	})
 
+	// Audit precompile tests
+	t.Run("AuditPrecompileGetCurrentEpochViaEthCall", func(t *testing.T) {
+		testAuditPrecompileGetCurrentEpochViaEthCall(t, node)
+	})
+	t.Run("AuditPrecompileGetNodeSuspicionStateNotFoundViaEthCall", func(t *testing.T) {
+		testAuditPrecompileGetNodeSuspicionStateNotFoundViaEthCall(t, node)
+	})
+	t.Run("AuditSubmitEvidenceTxPathFailsForReservedType", func(t *testing.T) {
+		testAuditSubmitEvidenceTxPathFailsForReservedType(t, node)
+	})
+
 	// Supernode precompile tx-path tests (ordered: register must precede report)
 	t.Run("SupernodeRegisterTxPath", func(t *testing.T) {
 		testSupernodeRegisterTxPath(t, node)
@@ -186,6 +200,24 @@ func TestPrecompilesSuite(t *testing.T) {
 		}
 		testWasmPrecompileExecuteRejectedInEthCall(t, node, wasmInfo)
 	})
+	t.Run("WasmPrecompileInstantiateWithFunds", func(t *testing.T) {
+		if wasmInfo.Addr == "" {
+			t.Skip("wasm contract not deployed")
+		}
+		testWasmPrecompileInstantiateWithFunds(t, node, wasmInfo)
+	})
+	t.Run("WasmPrecompileInstantiate2Predictable", func(t *testing.T) {
+		if wasmInfo.Addr == "" {
+			t.Skip("wasm contract not deployed")
+		}
+		testWasmPrecompileInstantiate2Predictable(t, node, wasmInfo)
+	})
+	t.Run("WasmPrecompileMigrateAndAdmin", func(t *testing.T) {
+		if wasmInfo.Addr == "" {
+			t.Skip("wasm contract not deployed")
+		}
+		testWasmPrecompileMigrateAndAdmin(t, node, wasmInfo)
+	})
 	t.Run("WasmPrecompileQueryInvalidContract", func(t *testing.T) {
 		testWasmPrecompileQueryInvalidContract(t, node)
 	})
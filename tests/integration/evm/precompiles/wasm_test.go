@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math/big"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -17,6 +18,8 @@ import (
 	evmtest "github.com/LumeraProtocol/lumera/tests/integration/evmtest"
 	testaccounts "github.com/LumeraProtocol/lumera/testutil/accounts"
 	testjsonrpc "github.com/LumeraProtocol/lumera/testutil/jsonrpc"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -222,6 +225,38 @@ func mustQueryContractAddr(t *testing.T, node *evmtest.Node, codeID string) stri
 	return addr
 }
 
+// mustQueryBankBalance returns the bank balance amount of addr for denom.
+func mustQueryBankBalance(t *testing.T, node *evmtest.Node, addr, denom string) string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	out, err := evmtest.RunCommand(ctx, node.RepoRoot(), node.BinPath(),
+		"query", "bank", "balance", addr, denom,
+		"--node", node.CometRPCURL(),
+		"--output", "json",
+	)
+	if err != nil {
+		t.Fatalf("query balance of %s: %v\n%s", addr, err, out)
+	}
+
+	idx := strings.Index(out, "{")
+	if idx >= 0 {
+		out = out[idx:]
+	}
+
+	var resp struct {
+		Balance struct {
+			Amount string `json:"amount"`
+		} `json:"balance"`
+	}
+	if err := json.Unmarshal([]byte(out), &resp); err != nil {
+		t.Fatalf("decode balance: %v", err)
+	}
+	return resp.Balance.Amount
+}
+
 // ---------------------------------------------------------------------------
 // EVM -> CosmWasm precompile tests
 // ---------------------------------------------------------------------------
@@ -620,6 +655,234 @@ func testWasmPrecompileExecuteRejectedInEthCall(t *testing.T, node *evmtest.Node
 	}
 }
 
+// ---------------------------------------------------------------------------
+// Contract lifecycle (instantiate / migrate / admin) tests
+// ---------------------------------------------------------------------------
+
+// hackatomInitMsg returns an instantiate message naming the test account as
+// both verifier and beneficiary.
+func hackatomInitMsg(node *evmtest.Node) []byte {
+	addr := node.KeyInfo().Address
+	return []byte(fmt.Sprintf(`{"verifier":"%s","beneficiary":"%s"}`, addr, addr))
+}
+
+// mustParseCodeID converts the CLI code ID string into the uint64 ABI argument.
+func mustParseCodeID(t *testing.T, codeID string) uint64 {
+	t.Helper()
+
+	id, err := strconv.ParseUint(codeID, 10, 64)
+	if err != nil {
+		t.Fatalf("parse code id %q: %v", codeID, err)
+	}
+	return id
+}
+
+// sendWasmLifecycleTx sends a wasm precompile tx and returns its receipt
+// status together with the decoded non-indexed values of the first log
+// matching eventName (nil if the tx failed or emitted no such log).
+func sendWasmLifecycleTx(t *testing.T, node *evmtest.Node, eventName, method string, args ...any) (string, []any) {
+	t.Helper()
+
+	input, err := wasmprecompile.ABI.Pack(method, args...)
+	if err != nil {
+		t.Fatalf("pack %s input: %v", method, err)
+	}
+
+	txHash := sendPrecompileLegacyTx(t, node, wasmprecompile.WasmPrecompileAddress, input, 2_000_000)
+	receipt := node.WaitForReceipt(t, txHash, 45*time.Second)
+	evmtest.AssertReceiptMatchesTxHash(t, receipt, txHash)
+
+	status := evmtest.MustStringField(t, receipt, "status")
+	if !strings.EqualFold(status, "0x1") {
+		return status, nil
+	}
+
+	event := wasmprecompile.ABI.Events[eventName]
+	logs, _ := receipt["logs"].([]any)
+	for _, raw := range logs {
+		entry, _ := raw.(map[string]any)
+		topics, _ := entry["topics"].([]any)
+		if len(topics) < 2 {
+			continue
+		}
+		topic0, _ := topics[0].(string)
+		if !strings.EqualFold(topic0, event.ID.Hex()) {
+			continue
+		}
+
+		// The indexed caller must be the sending EOA.
+		fromAddr := testaccounts.MustAccountAddressFromTestKeyInfo(t, node.KeyInfo())
+		topic1, _ := topics[1].(string)
+		if !strings.EqualFold(topic1, common.BytesToHash(fromAddr.Bytes()).Hex()) {
+			t.Fatalf("%s caller topic mismatch: got %s want %s", eventName, topic1, fromAddr.Hex())
+		}
+
+		dataHex, _ := entry["data"].(string)
+		data, err := hexutil.Decode(dataHex)
+		if err != nil {
+			t.Fatalf("decode %s log data: %v", eventName, err)
+		}
+		values, err := event.Inputs.NonIndexed().Unpack(data)
+		if err != nil {
+			t.Fatalf("unpack %s log data: %v", eventName, err)
+		}
+		return status, values
+	}
+
+	t.Fatalf("no %s log in receipt for %s", eventName, method)
+	return status, nil
+}
+
+// mustQueryWasmContractInfo returns (admin, label) for a wasm contract via
+// the precompile's contractInfo view.
+func mustQueryWasmContractInfo(t *testing.T, node *evmtest.Node, contractAddr string) (string, string) {
+	t.Helper()
+
+	input, err := wasmprecompile.ABI.Pack(wasmprecompile.ContractInfoMethod, contractAddr)
+	if err != nil {
+		t.Fatalf("pack contractInfo input: %v", err)
+	}
+	out, err := wasmprecompile.ABI.Unpack(wasmprecompile.ContractInfoMethod,
+		mustEthCallPrecompile(t, node, wasmprecompile.WasmPrecompileAddress, input))
+	if err != nil {
+		t.Fatalf("unpack contractInfo output: %v", err)
+	}
+	return out[2].(string), out[3].(string)
+}
+
+// testWasmPrecompileInstantiateWithFunds instantiates hackatom through the
+// precompile with a ulume deposit and verifies the WasmInstantiated event,
+// the creator/admin, and the contract bank balance.
+func testWasmPrecompileInstantiateWithFunds(t *testing.T, node *evmtest.Node, info wasmContractInfo) {
+	t.Helper()
+
+	codeID := mustParseCodeID(t, info.CodeID)
+	creator := node.KeyInfo().Address
+	funds := []cmn.Coin{{Denom: "ulume", Amount: big.NewInt(1_000)}}
+
+	status, values := sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmInstantiated,
+		wasmprecompile.InstantiateMethod, codeID, creator, hackatomInitMsg(node), "evm-instantiated", funds)
+	if !strings.EqualFold(status, "0x1") {
+		t.Fatalf("instantiate tx failed: status=%s", status)
+	}
+
+	contractAddr, _ := values[0].(string)
+	if contractAddr == "" {
+		t.Fatalf("empty contract address in WasmInstantiated event: %#v", values)
+	}
+	if gotCodeID, _ := values[1].(uint64); gotCodeID != codeID {
+		t.Fatalf("event code id mismatch: got %d want %d", gotCodeID, codeID)
+	}
+
+	admin, label := mustQueryWasmContractInfo(t, node, contractAddr)
+	if admin != creator {
+		t.Fatalf("admin mismatch: got %q want %q", admin, creator)
+	}
+	if label != "evm-instantiated" {
+		t.Fatalf("label mismatch: got %q", label)
+	}
+
+	balance := mustQueryBankBalance(t, node, contractAddr, "ulume")
+	if balance != "1000" {
+		t.Fatalf("contract balance mismatch: got %s want 1000", balance)
+	}
+}
+
+// testWasmPrecompileInstantiate2Predictable verifies that instantiate2 is
+// deterministic: reusing the same salt collides with the existing address.
+func testWasmPrecompileInstantiate2Predictable(t *testing.T, node *evmtest.Node, info wasmContractInfo) {
+	t.Helper()
+
+	codeID := mustParseCodeID(t, info.CodeID)
+	salt := []byte(fmt.Sprintf("evm-salt-%d", time.Now().UnixNano()))
+
+	status, values := sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmInstantiated,
+		wasmprecompile.Instantiate2Method, codeID, "", hackatomInitMsg(node), "evm-instantiate2", salt, false, []cmn.Coin{})
+	if !strings.EqualFold(status, "0x1") {
+		t.Fatalf("instantiate2 tx failed: status=%s", status)
+	}
+	contractAddr, _ := values[0].(string)
+	if admin, _ := mustQueryWasmContractInfo(t, node, contractAddr); admin != "" {
+		t.Fatalf("expected no admin, got %q", admin)
+	}
+
+	status, _ = sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmInstantiated,
+		wasmprecompile.Instantiate2Method, codeID, "", hackatomInitMsg(node), "evm-instantiate2-dup", salt, false, []cmn.Coin{})
+	if !strings.EqualFold(status, "0x0") {
+		t.Fatalf("expected instantiate2 with reused salt to fail, got status=%s", status)
+	}
+}
+
+// testWasmPrecompileMigrateAndAdmin instantiates a contract with the caller
+// as admin, migrates it, hands the admin to another address, checks that the
+// former admin can no longer clear it, and finally clears the admin of a
+// second contract.
+func testWasmPrecompileMigrateAndAdmin(t *testing.T, node *evmtest.Node, info wasmContractInfo) {
+	t.Helper()
+
+	codeID := mustParseCodeID(t, info.CodeID)
+	self := node.KeyInfo().Address
+
+	instantiate := func(label string) string {
+		status, values := sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmInstantiated,
+			wasmprecompile.InstantiateMethod, codeID, self, hackatomInitMsg(node), label, []cmn.Coin{})
+		if !strings.EqualFold(status, "0x1") {
+			t.Fatalf("instantiate %s failed: status=%s", label, status)
+		}
+		return values[0].(string)
+	}
+
+	contractAddr := instantiate("evm-migrate")
+
+	migrateMsg := []byte(fmt.Sprintf(`{"verifier":"%s"}`, self))
+	status, values := sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmMigrated,
+		wasmprecompile.MigrateMethod, contractAddr, codeID, migrateMsg)
+	if !strings.EqualFold(status, "0x1") {
+		t.Fatalf("migrate tx failed: status=%s", status)
+	}
+	if values[0].(string) != contractAddr {
+		t.Fatalf("WasmMigrated contract mismatch: got %v want %s", values[0], contractAddr)
+	}
+
+	// Hand the admin to another contract address (any valid account works).
+	newAdmin := instantiate("evm-new-admin")
+	status, values = sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmAdminUpdated,
+		wasmprecompile.UpdateAdminMethod, contractAddr, newAdmin)
+	if !strings.EqualFold(status, "0x1") {
+		t.Fatalf("updateAdmin tx failed: status=%s", status)
+	}
+	if values[1].(string) != newAdmin {
+		t.Fatalf("WasmAdminUpdated new admin mismatch: got %v want %s", values[1], newAdmin)
+	}
+	if admin, _ := mustQueryWasmContractInfo(t, node, contractAddr); admin != newAdmin {
+		t.Fatalf("admin not updated: got %q want %q", admin, newAdmin)
+	}
+
+	// The caller is no longer admin, so neither migrate nor clearAdmin is allowed.
+	status, _ = sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmMigrated,
+		wasmprecompile.MigrateMethod, contractAddr, codeID, migrateMsg)
+	if !strings.EqualFold(status, "0x0") {
+		t.Fatalf("expected migrate by former admin to fail, got status=%s", status)
+	}
+	status, _ = sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmAdminUpdated,
+		wasmprecompile.ClearAdminMethod, contractAddr)
+	if !strings.EqualFold(status, "0x0") {
+		t.Fatalf("expected clearAdmin by former admin to fail, got status=%s", status)
+	}
+
+	status, values = sendWasmLifecycleTx(t, node, wasmprecompile.EventTypeWasmAdminUpdated,
+		wasmprecompile.ClearAdminMethod, newAdmin)
+	if !strings.EqualFold(status, "0x1") {
+		t.Fatalf("clearAdmin tx failed: status=%s", status)
+	}
+	if values[1].(string) != "" {
+		t.Fatalf("expected empty new admin in WasmAdminUpdated, got %v", values[1])
+	}
+	if admin, _ := mustQueryWasmContractInfo(t, node, newAdmin); admin != "" {
+		t.Fatalf("admin not cleared: got %q", admin)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Negative tests
 // ---------------------------------------------------------------------------
@@ -20,6 +20,7 @@ import (
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	evmcryptotypes "github.com/cosmos/evm/crypto/ethsecp256k1"
@@ -291,6 +292,45 @@ func (s *MigrationIntegrationSuite) TestClaimLegacyAccount_MigratesAndRevokesFee
 	s.Require().Equal(incomingAllowance.SpendLimit, newIncoming.(*feegrant.BasicAllowance).SpendLimit)
 }
 
+// TestClaimLegacyAccount_MigratesGroupMembershipAndAdmin verifies that group
+// membership and group admin rights move to the migrated address through the
+// concrete SDK group keeper.
+func (s *MigrationIntegrationSuite) TestClaimLegacyAccount_MigratesGroupMembershipAndAdmin() {
+	s.enableMigration()
+
+	legacyPrivKey, legacyAddr := s.createFundedLegacyAccount(sdk.NewCoins(sdk.NewInt64Coin("ulume", 1_000_000)))
+	_, otherMember := s.createFundedLegacyAccount(sdk.NewCoins(sdk.NewInt64Coin("ulume", 100)))
+	newPrivKey, newAddr := createNewEVMAddress(s.T())
+
+	created, err := s.app.GroupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin: legacyAddr.String(),
+		Members: []group.MemberRequest{
+			{Address: legacyAddr.String(), Weight: "2"},
+			{Address: otherMember.String(), Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+
+	msg := newClaimMsg(s.T(), legacyPrivKey, legacyAddr, newPrivKey, newAddr)
+	_, err = s.msgServer.ClaimLegacyAccount(s.ctx, msg)
+	s.Require().NoError(err)
+
+	info, err := s.app.GroupKeeper.GroupInfo(s.ctx, &group.QueryGroupInfoRequest{GroupId: created.GroupId})
+	s.Require().NoError(err)
+	s.Require().Equal(newAddr.String(), info.Info.Admin)
+
+	members, err := s.app.GroupKeeper.GroupMembers(s.ctx, &group.QueryGroupMembersRequest{GroupId: created.GroupId})
+	s.Require().NoError(err)
+	weights := make(map[string]string, len(members.Members))
+	for _, m := range members.Members {
+		weights[m.Member.Address] = m.Member.Weight
+	}
+	s.Require().Equal(map[string]string{
+		newAddr.String():     "2",
+		otherMember.String(): "1",
+	}, weights)
+}
+
 // TestClaimLegacyAccount_MigrationDisabled verifies rejection when migrations are disabled.
 func (s *MigrationIntegrationSuite) TestClaimLegacyAccount_MigrationDisabled() {
 	params := types.NewParams(false, 0, 50, 2000, 20)
@@ -53,6 +53,7 @@ func (suite *KeeperIntegrationSuite) SetupSuite() {
 		suite.app.AuthKeeper,
 		suite.app.SlashingKeeper,
 		nil,
+		suite.app.DistrKeeper,
 	)
 	suite.keeper = k
 	suite.queryServer = keeper.NewQueryServerImpl(k)
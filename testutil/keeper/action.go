@@ -8,6 +8,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"go.uber.org/mock/gomock"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
@@ -33,6 +34,8 @@ import (
 	actionmodulev1 "github.com/LumeraProtocol/lumera/x/action/v1/module"
 	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
 	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+	lumeraidtypes "github.com/LumeraProtocol/lumera/x/lumeraid/types"
 	supernodemocks "github.com/LumeraProtocol/lumera/x/supernode/v1/mocks"
 	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
 )
@@ -155,6 +158,7 @@ type MockAuditKeeper struct {
 	nextEvidenceID       uint64
 	CreateCalls          []MockAuditKeeperCreateEvidenceCall
 	TicketArtifactCounts map[string]MockAuditKeeperTicketArtifactCount
+	EpochID              uint64
 }
 
 type MockAuditKeeperCreateEvidenceCall struct {
@@ -216,6 +220,40 @@ func (m *MockAuditKeeper) SetStorageTruthTicketArtifactCounts(
 	return nil
 }
 
+func (m *MockAuditKeeper) GetCurrentEpochInfo(ctx sdk.Context) (uint64, int64, int64, error) {
+	return m.EpochID, 0, 0, nil
+}
+
+// MockLumeraIDKeeper is an in-memory PQ key registry. Signatures are verified
+// with the real LegRoast implementation and charge VerifyGas like x/lumeraid.
+type MockLumeraIDKeeper struct {
+	PQKeys    map[string][]byte
+	VerifyGas uint64
+}
+
+func NewMockLumeraIDKeeper() *MockLumeraIDKeeper {
+	return &MockLumeraIDKeeper{
+		PQKeys:    make(map[string][]byte),
+		VerifyGas: lumeraidtypes.DefaultLegroastVerifyGas,
+	}
+}
+
+func (m *MockLumeraIDKeeper) ActivePQKey(_ sdk.Context, address string) (lumeraidtypes.PQKey, error) {
+	pubKey, ok := m.PQKeys[address]
+	if !ok {
+		return lumeraidtypes.PQKey{}, errorsmod.Wrap(lumeraidtypes.ErrPQKeyNotFound, address)
+	}
+	return lumeraidtypes.PQKey{Address: address, PublicKey: pubKey, Version: 1}, nil
+}
+
+func (m *MockLumeraIDKeeper) VerifyLegRoastSignature(ctx sdk.Context, payload, pubKey, signature []byte) error {
+	ctx.GasMeter().ConsumeGas(m.VerifyGas, "legroast signature verification")
+	if err := legroast.Verify(payload, pubKey, signature); err != nil {
+		return errorsmod.Wrap(lumeraidtypes.ErrInvalidSignature, err.Error())
+	}
+	return nil
+}
+
 type AccountPair struct {
 	Address sdk.AccAddress
 	PubKey  cryptotypes.PubKey
@@ -294,6 +332,7 @@ func ActionKeeperWithRewardDistribution(
 			return ibckeeper.NewKeeper(encCfg.Codec, storeService, newMockIbcParams(), mockUpgradeKeeper, authority.String())
 		},
 		rewardDistKeeper,
+		NewMockLumeraIDKeeper(),
 	)
 
 	params := actiontypes.DefaultParams()
@@ -307,6 +346,17 @@ func ActionKeeperWithRewardDistribution(
 }
 
 func ActionKeeperWithAddress(t testing.TB, ctrl *gomock.Controller, accounts []AccountPair) (keeper.Keeper, sdk.Context) {
+	return ActionKeeperWithLumeraID(t, ctrl, accounts, NewMockLumeraIDKeeper())
+}
+
+// ActionKeeperWithLumeraID returns an action keeper wired with the supplied PQ key
+// registry so tests can register LegRoast keys for metadata signers.
+func ActionKeeperWithLumeraID(
+	t testing.TB,
+	ctrl *gomock.Controller,
+	accounts []AccountPair,
+	lumeraidKeeper actiontypes.LumeraIDKeeper,
+) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(actiontypes.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -366,6 +416,7 @@ func ActionKeeperWithAddress(t testing.TB, ctrl *gomock.Controller, accounts []A
 			return ibckeeper.NewKeeper(encCfg.Codec, storeService, newMockIbcParams(), mockUpgradeKeeper, authority.String())
 		},
 		&MockRewardDistributionKeeper{Bps: 0}, // Per CP-R3 C-F4 — exercise reward-routing branch as no-op, not nil short-circuit.
+		lumeraidKeeper,
 	)
 
 	// Initialize params
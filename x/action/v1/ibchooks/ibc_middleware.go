@@ -0,0 +1,316 @@
+// Package ibchooks implements an ICS-20 transfer middleware that lets
+// accounts on other chains request Lumera actions with a single transfer.
+//
+// The transfer memo carries an "action" object (see ActionMemo). The
+// transferred funds are credited to an intermediary account derived from the
+// destination channel and the source-chain sender, and that account becomes
+// the action creator. Because nobody holds a key for the intermediary, the
+// memo names a refund address: the excess over the action price is paid to it
+// at once, and cancellation and expiration refunds are paid to it later.
+//
+// The packet is acknowledged asynchronously when the action settles, with the
+// action ID and its final state, so the sender learns the outcome through its
+// own IBC (callbacks) ack handling. Any failure while creating the action
+// produces an error acknowledgement instead, which reverts the receive and
+// refunds the sender on the source chain.
+package ibchooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/evm/ibc"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	actionkeeper "github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+const (
+	// EventTypeIBCActionRequested is emitted when an action is created from an ICS-20 memo.
+	EventTypeIBCActionRequested = "ibc_action_requested"
+	// EventTypeIBCActionAcknowledged is emitted when the packet that created an
+	// action is acknowledged after the action settled.
+	EventTypeIBCActionAcknowledged = "ibc_action_acknowledged"
+
+	AttributeKeyIntermediary  = "intermediary"
+	AttributeKeySender        = "sender"
+	AttributeKeyChannel       = "channel"
+	AttributeKeyRefundAddress = "refund_address"
+	AttributeKeyState         = "state"
+)
+
+var (
+	_ porttypes.IBCModule             = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+	_ actiontypes.ActionHooks         = &IBCMiddleware{}
+)
+
+// ActionRequester is the subset of the action msg server used by the middleware.
+type ActionRequester interface {
+	RequestAction(ctx context.Context, msg *actiontypes.MsgRequestAction) (*actiontypes.MsgRequestActionResponse, error)
+}
+
+// ActionKeeper is the subset of the action keeper used by the middleware.
+type ActionKeeper interface {
+	SetActionRefundAddress(ctx sdk.Context, actionID string, refundAddress string) error
+	GetActionRefundAddress(ctx sdk.Context, actionID string) (string, bool)
+	SetPendingIBCAck(ctx sdk.Context, actionID string, packet channeltypes.Packet) error
+	GetPendingIBCAck(ctx sdk.Context, actionID string) (channeltypes.Packet, bool)
+	DeletePendingIBCAck(ctx sdk.Context, actionID string) error
+}
+
+// BankKeeper is the subset of the bank keeper used by the middleware.
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// AckResult is the JSON body of the acknowledgement written once the action
+// created by a packet settles. Status is the final action state.
+type AckResult struct {
+	ActionID      string `json:"action_id"`
+	Status        string `json:"status"`
+	Intermediary  string `json:"intermediary"`
+	RefundAddress string `json:"refund_address"`
+}
+
+// IBCMiddleware creates actions from ICS-20 packets carrying an action memo.
+// Packets without one are passed through unchanged.
+type IBCMiddleware struct {
+	*ibc.Module
+	requester    ActionRequester
+	actionKeeper ActionKeeper
+	bankKeeper   BankKeeper
+	addrCdc      address.Codec
+	ics4Wrapper  porttypes.ICS4Wrapper
+}
+
+// NewIBCMiddleware wraps the transfer stack app with the action hook.
+// SetICS4Wrapper must be called before any action created by the hook settles.
+func NewIBCMiddleware(
+	app porttypes.IBCModule,
+	requester ActionRequester,
+	actionKeeper ActionKeeper,
+	bankKeeper BankKeeper,
+	addrCdc address.Codec,
+) *IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if requester == nil {
+		panic(errors.New("action requester cannot be nil"))
+	}
+	if actionKeeper == nil {
+		panic(errors.New("action keeper cannot be nil"))
+	}
+	if bankKeeper == nil {
+		panic(errors.New("bank keeper cannot be nil"))
+	}
+	if addrCdc == nil {
+		panic(errors.New("address codec cannot be nil"))
+	}
+
+	return &IBCMiddleware{
+		Module:       ibc.NewModule(app),
+		requester:    requester,
+		actionKeeper: actionKeeper,
+		bankKeeper:   bankKeeper,
+		addrCdc:      addrCdc,
+	}
+}
+
+// SetICS4Wrapper sets the wrapper used to write the asynchronous
+// acknowledgements. It should be the outer stack (e.g. IBC callbacks) so that
+// the sender's destination callbacks observe the final result.
+func (im *IBCMiddleware) SetICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	if wrapper == nil {
+		panic(errors.New("ICS4Wrapper cannot be nil"))
+	}
+	im.ics4Wrapper = wrapper
+}
+
+// OnRecvPacket implements the IBCModule interface.
+//
+// A packet that creates an action is acknowledged asynchronously: no
+// acknowledgement is returned here, and AfterActionSettled writes it once the
+// action is done, expired, cancelled or failed.
+func (im *IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	memo, err := ParseMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(actiontypes.ErrInvalidMetadata, err.Error()))
+	}
+	if memo == nil {
+		return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	refundAddr, err := im.addrCdc.StringToBytes(memo.RefundAddress)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund_address: %s", err))
+	}
+	// Module accounts cannot receive the later cancellation and expiration
+	// refunds, so reject them before anything is credited.
+	if im.bankKeeper.BlockedAddr(refundAddr) {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "refund_address %s is not allowed to receive funds", memo.RefundAddress))
+	}
+
+	intermediary := DeriveIntermediaryAccount(packet.DestinationChannel, data.Sender)
+	creator, err := im.addrCdc.BytesToString(intermediary)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// Credit the transfer to the intermediary regardless of the receiver the
+	// sender picked, so funds can only ever back actions of that sender.
+	origPacket := packet
+	data.Receiver = creator
+	packet.Data = data.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	actionID, err := im.requestAction(ctx, packet, data, memo, intermediary, refundAddr)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.actionKeeper.SetPendingIBCAck(ctx, actionID, origPacket); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeIBCActionRequested,
+			sdk.NewAttribute(actiontypes.AttributeKeyActionID, actionID),
+			sdk.NewAttribute(AttributeKeyIntermediary, creator),
+			sdk.NewAttribute(AttributeKeySender, data.Sender),
+			sdk.NewAttribute(AttributeKeyChannel, packet.DestinationChannel),
+			sdk.NewAttribute(AttributeKeyRefundAddress, memo.RefundAddress),
+		),
+	)
+
+	return nil
+}
+
+// requestAction checks that the received funds cover the action price,
+// creates the action on behalf of the intermediary and pays the excess over
+// the price to the refund address.
+func (im *IBCMiddleware) requestAction(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	memo *ActionMemo,
+	intermediary sdk.AccAddress,
+	refundAddr sdk.AccAddress,
+) (string, error) {
+	msg := memo.ToMsg(data.Receiver)
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	price, err := sdk.ParseCoinNormalized(msg.Price)
+	if err != nil {
+		return "", errorsmod.Wrapf(actiontypes.ErrInvalidPrice, "invalid price format: %s", err)
+	}
+
+	token := transfertypes.Token{
+		Denom:  transfertypes.ExtractDenomFromPath(data.Denom),
+		Amount: data.Amount,
+	}
+	received := ibc.GetReceivedCoin(packet, token)
+	if received.Denom != price.Denom {
+		return "", errorsmod.Wrapf(actiontypes.ErrInvalidPrice,
+			"transferred denom %s does not match price denom %s", received.Denom, price.Denom)
+	}
+	if received.Amount.LT(price.Amount) {
+		return "", errorsmod.Wrapf(actiontypes.ErrInvalidPrice,
+			"transferred amount %s does not cover price %s", received, price)
+	}
+
+	resp, err := im.requester.RequestAction(actionkeeper.WithRemoteCreator(ctx), msg)
+	if err != nil {
+		return "", fmt.Errorf("request action: %w", err)
+	}
+
+	if err := im.actionKeeper.SetActionRefundAddress(ctx, resp.ActionId, memo.RefundAddress); err != nil {
+		return "", err
+	}
+
+	if excess := received.Sub(price); excess.IsPositive() {
+		if err := im.bankKeeper.SendCoins(ctx, intermediary, refundAddr, sdk.NewCoins(excess)); err != nil {
+			return "", errorsmod.Wrap(err, "failed to refund excess to refund address")
+		}
+	}
+
+	return resp.ActionId, nil
+}
+
+// AfterActionSettled implements actiontypes.ActionHooks. It writes the
+// acknowledgement of the packet that created the action, if any.
+//
+// The acknowledgement is always a result: by now the transferred funds were
+// spent on the action or refunded to the refund address, so an error
+// acknowledgement would refund the sender a second time on the source chain.
+func (im *IBCMiddleware) AfterActionSettled(ctx sdk.Context, action *actiontypes.Action) error {
+	packet, found := im.actionKeeper.GetPendingIBCAck(ctx, action.ActionID)
+	if !found {
+		return nil
+	}
+	if im.ics4Wrapper == nil {
+		return errors.New("action IBC hooks ICS4Wrapper is not set")
+	}
+
+	refundAddress, _ := im.actionKeeper.GetActionRefundAddress(ctx, action.ActionID)
+	result := AckResult{
+		ActionID:      action.ActionID,
+		Status:        action.State.String(),
+		Intermediary:  action.Creator,
+		RefundAddress: refundAddress,
+	}
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	if err := im.ics4Wrapper.WriteAcknowledgement(ctx, packet, channeltypes.NewResultAcknowledgement(bz)); err != nil {
+		return errorsmod.Wrapf(err, "write acknowledgement for action %s", action.ActionID)
+	}
+	if err := im.actionKeeper.DeletePendingIBCAck(ctx, action.ActionID); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeIBCActionAcknowledged,
+			sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
+			sdk.NewAttribute(AttributeKeyState, result.Status),
+			sdk.NewAttribute(AttributeKeyChannel, packet.DestinationChannel),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,375 @@
+package ibchooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/address"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/LumeraProtocol/lumera/x/action/v1/ibchooks"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+const (
+	testSender  = "cosmos1sender"
+	testChannel = "channel-7"
+)
+
+// transferAppStub records the packet handed down the stack and returns a fixed ack.
+type transferAppStub struct {
+	porttypes.IBCModule
+	received *channeltypes.Packet
+	ack      exported.Acknowledgement
+}
+
+func (a *transferAppStub) OnRecvPacket(_ sdk.Context, _ string, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	a.received = &packet
+	return a.ack
+}
+
+// requesterStub records the RequestAction call.
+type requesterStub struct {
+	msg *actiontypes.MsgRequestAction
+	err error
+}
+
+func (r *requesterStub) RequestAction(_ context.Context, msg *actiontypes.MsgRequestAction) (*actiontypes.MsgRequestActionResponse, error) {
+	r.msg = msg
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &actiontypes.MsgRequestActionResponse{ActionId: "42", Status: actiontypes.ActionStatePending.String()}, nil
+}
+
+// actionKeeperStub keeps refund addresses and pending packets in memory.
+type actionKeeperStub struct {
+	refundAddresses map[string]string
+	pending         map[string]channeltypes.Packet
+}
+
+func (k *actionKeeperStub) SetActionRefundAddress(_ sdk.Context, actionID string, refundAddress string) error {
+	k.refundAddresses[actionID] = refundAddress
+	return nil
+}
+
+func (k *actionKeeperStub) GetActionRefundAddress(_ sdk.Context, actionID string) (string, bool) {
+	addr, ok := k.refundAddresses[actionID]
+	return addr, ok
+}
+
+func (k *actionKeeperStub) SetPendingIBCAck(_ sdk.Context, actionID string, packet channeltypes.Packet) error {
+	k.pending[actionID] = packet
+	return nil
+}
+
+func (k *actionKeeperStub) GetPendingIBCAck(_ sdk.Context, actionID string) (channeltypes.Packet, bool) {
+	packet, ok := k.pending[actionID]
+	return packet, ok
+}
+
+func (k *actionKeeperStub) DeletePendingIBCAck(_ sdk.Context, actionID string) error {
+	delete(k.pending, actionID)
+	return nil
+}
+
+// bankStub records SendCoins calls.
+type bankStub struct {
+	from, to sdk.AccAddress
+	amt      sdk.Coins
+	blocked  map[string]bool
+}
+
+func (b *bankStub) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	b.from, b.to, b.amt = fromAddr, toAddr, amt
+	return nil
+}
+
+func (b *bankStub) BlockedAddr(addr sdk.AccAddress) bool {
+	return b.blocked[addr.String()]
+}
+
+// ics4Stub records written acknowledgements.
+type ics4Stub struct {
+	porttypes.ICS4Wrapper
+	packet exported.PacketI
+	ack    exported.Acknowledgement
+}
+
+func (w *ics4Stub) WriteAcknowledgement(_ sdk.Context, packet exported.PacketI, ack exported.Acknowledgement) error {
+	w.packet, w.ack = packet, ack
+	return nil
+}
+
+type fixture struct {
+	ctx    sdk.Context
+	app    *transferAppStub
+	req    *requesterStub
+	keeper *actionKeeperStub
+	bank   *bankStub
+	ics4   *ics4Stub
+	mw     *ibchooks.IBCMiddleware
+}
+
+// testAddrCodec matches the global bech32 prefix used by MsgRequestAction.ValidateBasic.
+func testAddrCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+func testRefundAddress(t *testing.T) string {
+	t.Helper()
+	addr, err := testAddrCodec().BytesToString(sdk.AccAddress("refund______________"))
+	require.NoError(t, err)
+	return addr
+}
+
+func setup(t *testing.T) *fixture {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("ibchooks")
+	f := &fixture{
+		ctx:    testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_ibchooks")),
+		app:    &transferAppStub{ack: channeltypes.NewResultAcknowledgement([]byte{1})},
+		req:    &requesterStub{},
+		keeper: &actionKeeperStub{refundAddresses: map[string]string{}, pending: map[string]channeltypes.Packet{}},
+		bank:   &bankStub{},
+		ics4:   &ics4Stub{},
+	}
+	f.mw = ibchooks.NewIBCMiddleware(f.app, f.req, f.keeper, f.bank, testAddrCodec())
+	f.mw.SetICS4Wrapper(f.ics4)
+	return f
+}
+
+func actionMemo(t *testing.T, price string) string {
+	t.Helper()
+	bz, err := json.Marshal(map[string]any{
+		"action": map[string]any{
+			"action_type":     "CASCADE",
+			"metadata":        map[string]any{"data_hash": "abc", "file_name": "f.bin", "rq_ids_ic": 3, "signatures": "sig"},
+			"price":           price,
+			"expiration_time": "1900000000",
+			"app_pubkey":      []byte{1, 2, 3},
+			"refund_address":  testRefundAddress(t),
+		},
+	})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+// packetFor builds a packet carrying ulume back to its origin chain, so the
+// received coin is the native ulume denom.
+func packetFor(amount, memo string) channeltypes.Packet {
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "transfer/channel-3/ulume",
+		Amount:   amount,
+		Sender:   testSender,
+		Receiver: "lumera1anything",
+		Memo:     memo,
+	}
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-3",
+		DestinationPort:    "transfer",
+		DestinationChannel: testChannel,
+		Data:               data.GetBytes(),
+	}
+}
+
+func TestParseMemo(t *testing.T) {
+	for _, memo := range []string{"", "hello", `{"forward":{}}`, `{"dest_callback":{"address":"x"}}`} {
+		am, err := ibchooks.ParseMemo(memo)
+		require.NoError(t, err, memo)
+		require.Nil(t, am, memo)
+	}
+
+	_, err := ibchooks.ParseMemo(`{"action":{"action_type":"CASCADE","metadata":"x","price":"1ulume"}}`)
+	require.ErrorContains(t, err, "metadata must be a JSON object")
+
+	_, err = ibchooks.ParseMemo(`{"action":{"unknown":1}}`)
+	require.Error(t, err)
+
+	_, err = ibchooks.ParseMemo(`{"action":{"metadata":{}},"forward":{}}`)
+	require.ErrorContains(t, err, "cannot be combined")
+
+	_, err = ibchooks.ParseMemo(`{"action":{"action_type":"SENSE","metadata":{"a":1},"price":"5ulume","app_pubkey":"AQI="}}`)
+	require.ErrorContains(t, err, "refund_address is required")
+
+	am, err := ibchooks.ParseMemo(`{"action":{"action_type":"SENSE","metadata":{"a":1},"price":"5ulume","app_pubkey":"AQI=","refund_address":"lumera1refund"}}`)
+	require.NoError(t, err)
+	msg := am.ToMsg("lumera1creator")
+	require.Equal(t, "SENSE", msg.ActionType)
+	require.Equal(t, `{"a":1}`, msg.Metadata)
+	require.Equal(t, []byte{1, 2}, msg.AppPubkey)
+	require.Equal(t, "lumera1creator", msg.Creator)
+	require.Equal(t, "lumera1refund", am.RefundAddress)
+}
+
+func TestDeriveIntermediaryAccount(t *testing.T) {
+	a := ibchooks.DeriveIntermediaryAccount(testChannel, testSender)
+	require.Len(t, a, 32)
+	require.Equal(t, a, ibchooks.DeriveIntermediaryAccount(testChannel, testSender))
+	require.NotEqual(t, a, ibchooks.DeriveIntermediaryAccount("channel-8", testSender))
+	require.NotEqual(t, a, ibchooks.DeriveIntermediaryAccount(testChannel, "cosmos1other"))
+}
+
+func TestOnRecvPacket_PassesThroughWithoutActionMemo(t *testing.T) {
+	f := setup(t)
+
+	packet := packetFor("100", `{"dest_callback":{"address":"x"}}`)
+	ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packet, nil)
+
+	require.True(t, ack.Success())
+	require.Equal(t, f.app.ack, ack)
+	require.Equal(t, packet, *f.app.received)
+	require.Nil(t, f.req.msg)
+}
+
+func TestOnRecvPacket_CreatesAction(t *testing.T) {
+	f := setup(t)
+
+	packet := packetFor("150", actionMemo(t, "100ulume"))
+	ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packet, nil)
+	// The acknowledgement is written once the action settles.
+	require.Nil(t, ack)
+
+	intermediaryAddr := ibchooks.DeriveIntermediaryAccount(testChannel, testSender)
+	intermediary, err := testAddrCodec().BytesToString(intermediaryAddr)
+	require.NoError(t, err)
+
+	// Funds were credited to the intermediary, not the sender-chosen receiver.
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(f.app.received.Data, &data))
+	require.Equal(t, intermediary, data.Receiver)
+
+	require.NotNil(t, f.req.msg)
+	require.Equal(t, intermediary, f.req.msg.Creator)
+	require.Equal(t, "100ulume", f.req.msg.Price)
+
+	// The excess over the price went to the refund address, which is also
+	// recorded for later cancellation and expiration refunds.
+	refundAddress := testRefundAddress(t)
+	require.Equal(t, intermediaryAddr, f.bank.from)
+	require.Equal(t, sdk.AccAddress("refund______________"), f.bank.to)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulume", 50)), f.bank.amt)
+	require.Equal(t, refundAddress, f.keeper.refundAddresses["42"])
+
+	// The original packet waits for its acknowledgement.
+	require.Equal(t, packet, f.keeper.pending["42"])
+
+	var found bool
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == ibchooks.EventTypeIBCActionRequested {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestOnRecvPacket_ExactPriceSendsNoExcess(t *testing.T) {
+	f := setup(t)
+
+	ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("100", actionMemo(t, "100ulume")), nil)
+	require.Nil(t, ack)
+	require.Nil(t, f.bank.amt)
+}
+
+func TestAfterActionSettled_WritesAck(t *testing.T) {
+	f := setup(t)
+
+	packet := packetFor("100", actionMemo(t, "100ulume"))
+	require.Nil(t, f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packet, nil))
+
+	intermediary, err := testAddrCodec().BytesToString(
+		ibchooks.DeriveIntermediaryAccount(testChannel, testSender))
+	require.NoError(t, err)
+
+	action := &actiontypes.Action{ActionID: "42", Creator: intermediary, State: actiontypes.ActionStateExpired}
+	require.NoError(t, f.mw.AfterActionSettled(f.ctx, action))
+
+	require.Equal(t, packet, f.ics4.packet)
+	// Settled actions are always acknowledged with a result: their funds have
+	// already been spent or refunded on Lumera.
+	require.True(t, f.ics4.ack.Success())
+	resultAck, ok := f.ics4.ack.(channeltypes.Acknowledgement)
+	require.True(t, ok)
+	var res ibchooks.AckResult
+	require.NoError(t, json.Unmarshal(resultAck.GetResult(), &res))
+	require.Equal(t, "42", res.ActionID)
+	require.Equal(t, actiontypes.ActionStateExpired.String(), res.Status)
+	require.Equal(t, intermediary, res.Intermediary)
+	require.Equal(t, testRefundAddress(t), res.RefundAddress)
+
+	_, pending := f.keeper.pending["42"]
+	require.False(t, pending)
+
+	// Actions not created over IBC are ignored.
+	f.ics4.ack = nil
+	require.NoError(t, f.mw.AfterActionSettled(f.ctx, &actiontypes.Action{ActionID: "7", State: actiontypes.ActionStateDone}))
+	require.Nil(t, f.ics4.ack)
+}
+
+func TestOnRecvPacket_ErrorAcks(t *testing.T) {
+	t.Run("price not covered", func(t *testing.T) {
+		f := setup(t)
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("99", actionMemo(t, "100ulume")), nil)
+		require.False(t, ack.Success())
+		require.Nil(t, f.req.msg)
+	})
+
+	t.Run("price denom mismatch", func(t *testing.T) {
+		f := setup(t)
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("150", actionMemo(t, "100stake")), nil)
+		require.False(t, ack.Success())
+		require.Nil(t, f.req.msg)
+	})
+
+	t.Run("malformed memo", func(t *testing.T) {
+		f := setup(t)
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("150", `{"action":{"metadata":"x"}}`), nil)
+		require.False(t, ack.Success())
+		require.Nil(t, f.app.received)
+	})
+
+	t.Run("invalid refund address", func(t *testing.T) {
+		f := setup(t)
+		memo := `{"action":{"action_type":"CASCADE","metadata":{},"price":"100ulume","refund_address":"cosmos1nope"}}`
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("150", memo), nil)
+		require.False(t, ack.Success())
+		require.Nil(t, f.app.received)
+	})
+
+	t.Run("refund address is a module account", func(t *testing.T) {
+		f := setup(t)
+		f.bank.blocked = map[string]bool{testRefundAddress(t): true}
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("150", actionMemo(t, "100ulume")), nil)
+		require.False(t, ack.Success())
+		require.Nil(t, f.app.received)
+		require.Nil(t, f.req.msg)
+	})
+
+	t.Run("request action fails", func(t *testing.T) {
+		f := setup(t)
+		f.req.err = errors.New("boom")
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("150", actionMemo(t, "100ulume")), nil)
+		require.False(t, ack.Success())
+	})
+
+	t.Run("transfer fails", func(t *testing.T) {
+		f := setup(t)
+		f.app.ack = channeltypes.NewErrorAcknowledgement(errors.New("transfer failed"))
+		ack := f.mw.OnRecvPacket(f.ctx, transfertypes.V1, packetFor("150", actionMemo(t, "100ulume")), nil)
+		require.False(t, ack.Success())
+		require.Nil(t, f.req.msg)
+	})
+}
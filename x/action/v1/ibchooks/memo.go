@@ -0,0 +1,106 @@
+package ibchooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+const (
+	// MemoKey is the top-level ICS-20 memo key holding an action request.
+	MemoKey = "action"
+
+	// forwardMemoKey is the packet-forward-middleware memo key. Forwarded
+	// packets never reach the action hook, so combining both is rejected.
+	forwardMemoKey = "forward"
+
+	// IntermediaryModuleName is the module name used to derive intermediary
+	// creator accounts.
+	IntermediaryModuleName = "action-ibc-hooks"
+)
+
+// ActionMemo is the structured action request carried under MemoKey.
+//
+// Metadata is the JSON metadata object for the action type, exactly as it
+// would appear in MsgRequestAction.metadata. AppPubkey is base64-encoded in
+// JSON and is mandatory: the intermediary creator has no key of its own, so
+// metadata signatures are verified against it. RefundAddress is mandatory for
+// the same reason: it is a Lumera account controlled by the sender that
+// receives the transferred excess over the price and any cancellation or
+// expiration refund, and that may cancel the action.
+type ActionMemo struct {
+	ActionType             string          `json:"action_type"`
+	RefundAddress          string          `json:"refund_address"`
+	Metadata               json.RawMessage `json:"metadata"`
+	Price                  string          `json:"price"`
+	ExpirationTime         string          `json:"expiration_time,omitempty"`
+	FileSizeKbs            string          `json:"file_size_kbs,omitempty"`
+	AppPubkey              []byte          `json:"app_pubkey"`
+	FinalizationRedundancy uint32          `json:"finalization_redundancy,omitempty"`
+}
+
+// ParseMemo extracts the action request from an ICS-20 memo.
+// It returns (nil, nil) when the memo does not carry an action request, so
+// that ordinary transfers and memos meant for other middleware pass through.
+func ParseMemo(memo string) (*ActionMemo, error) {
+	if len(memo) == 0 {
+		return nil, nil
+	}
+
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &top); err != nil {
+		// Not a JSON object: not ours.
+		return nil, nil
+	}
+
+	raw, ok := top[MemoKey]
+	if !ok {
+		return nil, nil
+	}
+	if _, hasForward := top[forwardMemoKey]; hasForward {
+		return nil, fmt.Errorf("%q memo cannot be combined with %q", MemoKey, forwardMemoKey)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var am ActionMemo
+	if err := dec.Decode(&am); err != nil {
+		return nil, fmt.Errorf("invalid %q memo: %w", MemoKey, err)
+	}
+	if len(am.Metadata) == 0 || am.Metadata[0] != '{' {
+		return nil, fmt.Errorf("invalid %q memo: metadata must be a JSON object", MemoKey)
+	}
+	if am.RefundAddress == "" {
+		return nil, fmt.Errorf("invalid %q memo: refund_address is required", MemoKey)
+	}
+
+	return &am, nil
+}
+
+// ToMsg builds the MsgRequestAction for this memo on behalf of creator.
+func (am *ActionMemo) ToMsg(creator string) *actiontypes.MsgRequestAction {
+	return &actiontypes.MsgRequestAction{
+		Creator:                creator,
+		ActionType:             am.ActionType,
+		Metadata:               string(am.Metadata),
+		Price:                  am.Price,
+		ExpirationTime:         am.ExpirationTime,
+		FileSizeKbs:            am.FileSizeKbs,
+		AppPubkey:              am.AppPubkey,
+		FinalizationRedundancy: am.FinalizationRedundancy,
+	}
+}
+
+// DeriveIntermediaryAccount returns the account that acts as action creator
+// for a given destination channel and source-chain sender. The address is
+// derived like a module sub-account, so nobody holds a key for it and it is
+// stable across packets from the same channel/sender pair.
+func DeriveIntermediaryAccount(channelID, sender string) sdk.AccAddress {
+	return address.Module(IntermediaryModuleName, []byte(channelID+"/"+sender))
+}
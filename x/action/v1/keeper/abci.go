@@ -15,5 +15,9 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 	k.CheckExpiration(sdkCtx)
 
+	if err := k.UpdateFeeMultiplier(sdkCtx); err != nil {
+		k.Logger().Error("failed to update action fee multiplier", "error", err)
+	}
+
 	return nil
 }
@@ -28,6 +28,12 @@ const (
 	ActionByTypePrefix        = "Action/type/"
 	ActionByBlockHeightPrefix = "Action/block/"
 	ActionBySuperNodePrefix   = "Action/supernode/"
+	ActionByExpirationPrefix  = "Action/expiration/"
+	ActionByMetadataPrefix    = "Action/metadata/"
+	FeeMultiplierKey          = "Action/feeMultiplier/"
+	FreeStorageKey            = "Action/freeStorage/"
+	ActionRefundAddressPrefix = "Action/refundAddress/"
+	ActionPendingIBCAckPrefix = "Action/pendingIBCAck/"
 )
 
 // RegisterAction creates and configures a new action with default parameters
@@ -47,7 +53,7 @@ func (k *Keeper) RegisterAction(ctx sdk.Context, action *actiontypes.Action) (st
 	if err != nil {
 		return "", errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid price %s: %s", action.Price, err)
 	}
-	if err := k.validatePrice(ctx, &parsedPrice); err != nil {
+	if err := k.validatePrice(ctx, action.ActionType, &parsedPrice); err != nil {
 		return "", err
 	}
 
@@ -146,7 +152,7 @@ func (k *Keeper) RegisterAction(ctx sdk.Context, action *actiontypes.Action) (st
 				actiontypes.EventTypeActionRegistered,
 				sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
 				sdk.NewAttribute(actiontypes.AttributeKeyCreator, action.Creator),
-				sdk.NewAttribute(actiontypes.AttributeKeyActionType, action.ActionType.String()),
+				sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(action.ActionType)),
 				sdk.NewAttribute(actiontypes.AttributeKeyFee, parsedPrice.String()),
 			),
 		)
@@ -179,6 +185,17 @@ func (k *Keeper) FinalizeAction(ctx sdk.Context, actionID string, superNodeAccou
 		)
 	}
 
+	// Multi-supernode actions accept at most FinalizationRedundancy results
+	if requiresFinalizationQuorum(existingAction) &&
+		len(existingAction.FinalizationSubmissions) >= int(existingAction.FinalizationRedundancy) {
+		return errors.Wrapf(
+			actiontypes.ErrInvalidActionState,
+			"action %s already received %d finalizations without reaching quorum",
+			actionID,
+			len(existingAction.FinalizationSubmissions),
+		)
+	}
+
 	// Verify reporting superNode -
 	// it must be in the top-10 supernodes for the (existing) action's block height
 	// and not already in the (existing) action's SuperNodes list
@@ -204,6 +221,9 @@ func (k *Keeper) FinalizeAction(ctx sdk.Context, actionID string, superNodeAccou
 		return errors.Wrap(actiontypes.ErrInvalidActionType, err.Error())
 	}
 
+	// Keep the registered metadata: handlers may rewrite it while validating
+	registeredMetadata := existingAction.Metadata
+
 	// Validate and determine state changes
 	newState, err := handler.FinalizeAction(ctx, existingAction, superNodeAccount, newMetadata)
 	if err != nil {
@@ -212,14 +232,25 @@ func (k *Keeper) FinalizeAction(ctx sdk.Context, actionID string, superNodeAccou
 
 	// Apply state changes if a new state is recommended
 	if newState != actiontypes.ActionStateUnspecified {
-		existingAction.State = newState
-		existingAction.Metadata, err = handler.GetUpdatedMetadata(ctx, existingAction.Metadata, newMetadata)
+		updatedMetadata, err := handler.GetUpdatedMetadata(ctx, existingAction.Metadata, newMetadata)
 		if err != nil {
 			return err
 		}
 
-		// Add supernode to the list
-		existingAction.SuperNodes = append(existingAction.SuperNodes, superNodeAccount)
+		if newState == actiontypes.ActionStateDone && requiresFinalizationQuorum(existingAction) {
+			// Hold the result until a quorum of finalizers agrees on it
+			existingAction.Metadata = registeredMetadata
+			if err := k.submitFinalizationResult(ctx, existingAction, superNodeAccount,
+				finalizationResultHash(newMetadata), updatedMetadata, top10ValidatorAddresses); err != nil {
+				return err
+			}
+		} else {
+			existingAction.State = newState
+			existingAction.Metadata = updatedMetadata
+
+			// Add supernode to the list
+			existingAction.SuperNodes = append(existingAction.SuperNodes, superNodeAccount)
+		}
 	}
 
 	// Save the updated action
@@ -228,14 +259,14 @@ func (k *Keeper) FinalizeAction(ctx sdk.Context, actionID string, superNodeAccou
 		return errors.Wrap(actiontypes.ErrInternalError, fmt.Sprintf("failed to update action: %v", err))
 	}
 
-	if existingAction.State == actiontypes.ActionStateFailed {
-		// If the action failed, we should emit an event and return early
+	if newState == actiontypes.ActionStateFailed {
+		// If the handler failed the action, we should emit an event and return early
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				actiontypes.EventTypeActionFailed,
 				sdk.NewAttribute(actiontypes.AttributeKeyActionID, existingAction.ActionID),
 				sdk.NewAttribute(actiontypes.AttributeKeyCreator, existingAction.Creator),
-				sdk.NewAttribute(actiontypes.AttributeKeyActionType, existingAction.ActionType.String()),
+				sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(existingAction.ActionType)),
 				sdk.NewAttribute(actiontypes.AttributeKeyError, "finalization failed"),
 				sdk.NewAttribute(actiontypes.AttributeKeySuperNodes, strings.Join(existingAction.SuperNodes, ",")),
 			),
@@ -269,7 +300,7 @@ func (k *Keeper) FinalizeAction(ctx sdk.Context, actionID string, superNodeAccou
 				actiontypes.EventTypeActionFinalized,
 				sdk.NewAttribute(actiontypes.AttributeKeyActionID, existingAction.ActionID),
 				sdk.NewAttribute(actiontypes.AttributeKeyCreator, existingAction.Creator),
-				sdk.NewAttribute(actiontypes.AttributeKeyActionType, existingAction.ActionType.String()),
+				sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(existingAction.ActionType)),
 				sdk.NewAttribute(actiontypes.AttributeKeySuperNodes, strings.Join(existingAction.SuperNodes, ",")),
 			),
 		)
@@ -341,7 +372,7 @@ func (k *Keeper) ApproveAction(ctx sdk.Context, actionID string, creator string)
 			actiontypes.EventTypeActionApproved,
 			sdk.NewAttribute(actiontypes.AttributeKeyActionID, existingAction.ActionID),
 			sdk.NewAttribute(actiontypes.AttributeKeyCreator, existingAction.Creator),
-			sdk.NewAttribute(actiontypes.AttributeKeyActionType, existingAction.ActionType.String()),
+			sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(existingAction.ActionType)),
 		),
 	)
 
@@ -423,6 +454,16 @@ func (k *Keeper) SetAction(ctx sdk.Context, action *actiontypes.Action) error {
 		return err
 	}
 
+	// Index by expiration time (only while the action can still expire)
+	if err := k.updateExpirationIndex(store, existingAction, action); err != nil {
+		return err
+	}
+
+	// Index by searchable metadata fields declared by the action type handler
+	if err := k.updateMetadataIndex(store, existingAction, action); err != nil {
+		return err
+	}
+
 	// Index by supernodes
 	existingSN := make(map[string]struct{})
 	if found {
@@ -453,6 +494,10 @@ func (k *Keeper) SetAction(ctx sdk.Context, action *actiontypes.Action) error {
 			return err
 		}
 	}
+
+	if found && existingAction.State != action.State && actiontypes.IsSettledActionState(action.State) {
+		k.afterActionSettled(ctx, action)
+	}
 	return nil
 }
 
@@ -598,8 +643,8 @@ func (k *Keeper) IterateActionsByState(ctx sdk.Context, state actiontypes.Action
 // - (false, "", nil, err) for hard failures (e.g. duplicates)
 func (k *Keeper) checkFinalizerEligibility(ctx sdk.Context, action *actiontypes.Action, superNodeAccount string) (bool, string, []string, error) {
 	// If SuperNode already in the list, return an error
-	if len(action.SuperNodes) > 0 {
-		if slices.Contains(action.SuperNodes, superNodeAccount) {
+	if len(action.SuperNodes) > 0 || len(action.FinalizationSubmissions) > 0 {
+		if slices.Contains(action.SuperNodes, superNodeAccount) || hasSubmittedFinalization(action, superNodeAccount) {
 			return false, "", nil, errors.Wrapf(
 				actiontypes.ErrUnauthorizedSN,
 				"supernode %s is already in the SuperNodes list for action %s",
@@ -795,97 +840,6 @@ func (k *Keeper) DistributeFees(ctx sdk.Context, actionID string) error {
 	return nil
 }
 
-// CheckExpiration checks for expired actions in PENDING and PROCESSING states
-func (k *Keeper) CheckExpiration(ctx sdk.Context) {
-	currentTime := ctx.BlockTime().Unix()
-	expiredCount := 0
-
-	pendingErr := k.processExpiredActionsInState(ctx, actiontypes.ActionStatePending, currentTime, &expiredCount)
-	if pendingErr != nil {
-		k.Logger().Error("Error checking pending actions for expiration", "error", pendingErr.Error())
-	}
-
-	processingErr := k.processExpiredActionsInState(ctx, actiontypes.ActionStateProcessing, currentTime, &expiredCount)
-	if processingErr != nil {
-		k.Logger().Error("Error checking processing actions for expiration", "error", processingErr.Error())
-	}
-
-	if expiredCount > 0 {
-		k.Logger().Info("Expired actions checked",
-			"expired_count", expiredCount,
-			"block_height", ctx.BlockHeight(),
-			"block_time", ctx.BlockTime(),
-		)
-	}
-}
-
-// processExpiredActionsInState iterates through actions in a specific state and marks expired ones
-func (k *Keeper) processExpiredActionsInState(ctx sdk.Context, state actiontypes.ActionState, now int64, expiredCount *int) error {
-	return k.IterateActionsByState(ctx, state, func(action *actiontypes.Action) bool {
-		// Check if action is expired
-		if action.ExpirationTime != 0 && action.ExpirationTime <= now {
-			// refund action fee to creator before updating state
-			if fee, err := sdk.ParseCoinNormalized(action.Price); err == nil && !fee.IsZero() {
-				creatorAddr, err := k.addressCodec.StringToBytes(action.Creator)
-				if err != nil {
-					k.Logger().Error("Failed to decode action creator address for refund",
-						"action_id", action.ActionID,
-						"creator", action.Creator,
-						"error", err.Error(),
-					)
-					return false // continue iteration, retry next block
-				}
-
-				// Refund the action fee to the creator
-				if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-					ctx,
-					actiontypes.ModuleName,
-					creatorAddr,
-					sdk.NewCoins(fee),
-				); err != nil {
-					k.Logger().Error("Failed to refund action fee",
-						"action_id", action.ActionID,
-						"creator", action.Creator,
-						"fee", fee.String(),
-						"error", err.Error(),
-					)
-					return false // continue iteration, retry next block
-				}
-			}
-
-			// Update action state to EXPIRED
-			action.State = actiontypes.ActionStateExpired
-
-			// Save updated action
-			err := k.SetAction(ctx, action)
-			if err != nil {
-				k.Logger().Error("Failed to update expired action",
-					"action_id", action.ActionID,
-					"error", err.Error(),
-				)
-				return false // Continue iteration
-			}
-
-			k.RecordActionExpired(ctx, action)
-
-			// Increment counter
-			*expiredCount++
-
-			// Emit event
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					actiontypes.EventTypeActionExpired,
-					sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
-					sdk.NewAttribute(actiontypes.AttributeKeyCreator, action.Creator),
-					sdk.NewAttribute(actiontypes.AttributeKeyActionType, action.ActionType.String()),
-				),
-			)
-		}
-
-		return false // Continue iteration
-	})
-}
-
 // getLastActionID retrieves the last used action ID counter
 func (k *Keeper) getLastActionID(ctx sdk.Context) (uint64, error) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -902,15 +856,15 @@ func (k *Keeper) getLastActionID(ctx sdk.Context) (uint64, error) {
 	return binary.BigEndian.Uint64(bz), nil
 }
 
-func (k *Keeper) validatePrice(ctx context.Context, price *sdk.Coin) error {
-	params := k.GetParams(ctx)
+func (k *Keeper) validatePrice(ctx context.Context, actionType actiontypes.ActionType, price *sdk.Coin) error {
+	baseFee, feePerKbyte := k.GetParams(ctx).FeeSchedule(actionType)
 
-	minFeeAmount := params.FeePerKbyte.Amount.Add(params.BaseActionFee.Amount)
+	minFeeAmount := k.ApplyFeeMultiplier(ctx, feePerKbyte.Amount.Add(baseFee.Amount))
 
 	if price == nil {
 		return errors.Wrapf(
 			sdkerrors.ErrInvalidRequest,
-			"price is not specified: must be at least %s (base + per-byte)",
+			"price is not specified: must be at least %s ((base + per-byte) * fee multiplier)",
 			minFeeAmount.String(),
 		)
 	}
@@ -918,7 +872,7 @@ func (k *Keeper) validatePrice(ctx context.Context, price *sdk.Coin) error {
 	if price.Amount.LT(minFeeAmount) {
 		return errors.Wrapf(
 			sdkerrors.ErrInvalidRequest,
-			"invalid price amount %s: must be at least %s (base + per-byte)",
+			"invalid price amount %s: must be at least %s ((base + per-byte) * fee multiplier)",
 			price.Amount.String(),
 			minFeeAmount.String(),
 		)
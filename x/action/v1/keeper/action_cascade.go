@@ -136,6 +136,9 @@ func (h CascadeActionHandler) Process(metadataBytes []byte, msgType common.Messa
 		if metadata.Signatures == "" {
 			return nil, fmt.Errorf("signatures field is required for cascade metadata")
 		}
+		if err := validateSignatureScheme(metadata.SignatureScheme); err != nil {
+			return nil, err
+		}
 		if params == nil {
 			return nil, fmt.Errorf("params field is required for cascade metadata")
 		}
@@ -219,12 +222,13 @@ func (h CascadeActionHandler) RegisterAction(ctx sdk.Context, action *actiontype
 		return errors.Wrap(actiontypes.ErrInvalidMetadata, "invalid signature format")
 	}
 
-	// Use VerifySignature from crypto.go to validate the signature in signatureParts[1] over the data in signatureParts[0].
+	// Use VerifyMetadataSignature from crypto.go to validate the signature in signatureParts[1] over the
+	// data in signatureParts[0], under the scheme declared by the metadata.
 	dataToVerify := signatureParts[0]
-	creatorSignature := signatureParts[1] // the signature is Base64 encoded, VerifySignature will decode it
+	creatorSignature := signatureParts[1] // the signature is Base64 encoded, VerifyMetadataSignature will decode it
 
 	// Reuse already-fetched creator account when possible to avoid duplicate lookups.
-	if err := h.keeper.VerifySignature(ctx, dataToVerify, creatorSignature, action.Creator); err != nil {
+	if err := h.keeper.VerifyMetadataSignature(ctx, cascadeMeta.SignatureScheme, dataToVerify, creatorSignature, action.Creator); err != nil {
 		return errors.Wrap(actiontypes.ErrInvalidMetadata, fmt.Sprintf("failed to verify creator's signature: %v", err))
 	}
 	return nil
@@ -325,6 +329,7 @@ func (h CascadeActionHandler) GetUpdatedMetadata(ctx sdk.Context, existingMetada
 		ChunkProofs:            newMetadata.GetChunkProofs(),
 		IndexArtifactCount:     newMetadata.GetIndexArtifactCount(),
 		SymbolArtifactCount:    newMetadata.GetSymbolArtifactCount(),
+		SignatureScheme:        existingMetadata.GetSignatureScheme(),
 	}
 	indexCount, symbolCount, err := actiontypes.CascadeArtifactCountsWithFallbackStrict(updatedMetadata)
 	if err != nil {
@@ -334,3 +339,20 @@ func (h CascadeActionHandler) GetUpdatedMetadata(ctx sdk.Context, existingMetada
 
 	return gogoproto.Marshal(updatedMetadata)
 }
+
+// SearchableFieldNames returns the Cascade metadata fields that are indexed for search
+func (h CascadeActionHandler) SearchableFieldNames() []string {
+	return []string{"file_name", "data_hash"}
+}
+
+// SearchableFields returns the Cascade metadata fields supported by QueryActionByMetadata
+func (h CascadeActionHandler) SearchableFields(metadataBytes []byte) (map[string]string, error) {
+	var metadata actiontypes.CascadeMetadata
+	if err := gogoproto.Unmarshal(metadataBytes, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cascade metadata: %w", err)
+	}
+	return map[string]string{
+		"file_name": metadata.FileName,
+		"data_hash": metadata.DataHash,
+	}, nil
+}
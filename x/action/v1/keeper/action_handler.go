@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"bytes"
+	"fmt"
 	"reflect"
+	"sort"
 
 	"cosmossdk.io/errors"
 	"github.com/LumeraProtocol/lumera/x/action/v1/common"
 	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 )
 
 // ActionHandler defines the interface for processing action-specific operations
@@ -37,35 +42,97 @@ type ActionHandler interface {
 	GetUpdatedMetadata(ctx sdk.Context, existingMetadata, newMetadata []byte) ([]byte, error)
 }
 
-// ActionRegistry maintains a registry of handlers for different action types
+// SearchableMetadataHandler is an optional hook for action handlers whose
+// metadata exposes fields that can be searched with QueryActionByMetadata.
+type SearchableMetadataHandler interface {
+	// SearchableFieldNames returns the names of the fields that can be searched.
+	SearchableFieldNames() []string
+
+	// SearchableFields returns the searchable field names and their values for
+	// the given protobuf-encoded metadata.
+	SearchableFields(metadataBytes []byte) (map[string]string, error)
+}
+
+// ActionPlugin describes an action type that can be registered with the
+// action module from app wiring. Sense and Cascade are the built-in plugins.
+// Additional types are keyed by TypeName and stored on the wire with the code
+// derived by actiontypes.PluginActionTypeCode, which stays valid because
+// proto3 enums are open.
+type ActionPlugin struct {
+	// TypeName is the canonical action type name or type URL, e.g.
+	// "ACTION_TYPE_INFERENCE" or "/lumera.inference.v1.InferenceMetadata".
+	TypeName string
+	// Aliases are additional case-insensitive names accepted in messages.
+	Aliases []string
+	// Validator performs stateless metadata checks in ValidateBasic.
+	Validator actiontypes.ActionTypeValidator
+	// NewHandler builds the keeper-side handler for the action type.
+	NewHandler func(k *Keeper) ActionHandler
+}
+
+// ActionRegistry maintains a registry of handlers for different action types,
+// keyed by canonical action type name.
 type ActionRegistry struct {
-	handlers map[actiontypes.ActionType]ActionHandler
+	handlers map[string]ActionHandler
 	keeper   *Keeper // Reference to the keeper for logger and other services
 }
 
 // NewActionRegistry creates a new action registry
 func NewActionRegistry(k *Keeper) *ActionRegistry {
 	return &ActionRegistry{
-		handlers: make(map[actiontypes.ActionType]ActionHandler),
+		handlers: make(map[string]ActionHandler),
 		keeper:   k,
 	}
 }
 
+// RegisterPlugin registers an action type plugin: its type name and validator
+// with the types package and its handler with this registry.
+func (r *ActionRegistry) RegisterPlugin(plugin ActionPlugin) error {
+	if plugin.Validator == nil || plugin.NewHandler == nil {
+		return errors.Wrapf(actiontypes.ErrInvalidActionType, "plugin %s must provide a validator and a handler", plugin.TypeName)
+	}
+	code, err := actiontypes.RegisterActionType(plugin.TypeName, plugin.Validator, plugin.Aliases...)
+	if err != nil {
+		return errors.Wrap(actiontypes.ErrInvalidActionType, err.Error())
+	}
+	r.RegisterHandler(code, plugin.NewHandler(r.keeper))
+	return nil
+}
+
 // RegisterHandler registers a handler for a specific action type
 func (r *ActionRegistry) RegisterHandler(actionType actiontypes.ActionType, handler ActionHandler) {
-	r.handlers[actionType] = handler
+	r.handlers[actiontypes.ActionTypeName(actionType)] = handler
 }
 
 // GetHandler retrieves the handler for a specific action type
 func (r *ActionRegistry) GetHandler(actionType actiontypes.ActionType) (ActionHandler, error) {
-	handler, ok := r.handlers[actionType]
+	return r.GetHandlerByName(actiontypes.ActionTypeName(actionType))
+}
+
+// GetHandlerByName retrieves the handler for a canonical action type name.
+func (r *ActionRegistry) GetHandlerByName(typeName string) (ActionHandler, error) {
+	handler, ok := r.handlers[typeName]
 	if !ok {
-		return nil, ErrNoHandlerForActionType(actionType)
+		return nil, errors.Wrapf(
+			actiontypes.ErrInvalidActionType,
+			"no handler registered for action type %s",
+			typeName,
+		)
 	}
 
 	return handler, nil
 }
 
+// RegisteredTypes returns the canonical names of all registered action types, sorted.
+func (r *ActionRegistry) RegisteredTypes() []string {
+	names := make([]string, 0, len(r.handlers))
+	for name := range r.handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // ErrNoHandlerForActionType returns a formatted error for missing handlers
 func ErrNoHandlerForActionType(actionType actiontypes.ActionType) error {
 	return errors.Wrapf(
@@ -74,3 +141,56 @@ func ErrNoHandlerForActionType(actionType actiontypes.ActionType) error {
 		actionType.String(),
 	)
 }
+
+// ProtoMetadataCodec implements the JSON/protobuf conversion methods of
+// ActionHandler for a handler-declared metadata message. Plugin handlers can
+// embed it instead of writing the conversions by hand.
+type ProtoMetadataCodec struct {
+	newMessage func() gogoproto.Message
+}
+
+// NewProtoMetadataCodec creates a codec for the metadata message produced by newMessage.
+func NewProtoMetadataCodec(newMessage func() gogoproto.Message) ProtoMetadataCodec {
+	return ProtoMetadataCodec{newMessage: newMessage}
+}
+
+// GetProtoMessageType returns the reflect.Type of the metadata message.
+func (c ProtoMetadataCodec) GetProtoMessageType() reflect.Type {
+	return reflect.TypeOf(c.newMessage()).Elem()
+}
+
+// Unmarshal decodes protobuf-encoded metadata.
+func (c ProtoMetadataCodec) Unmarshal(protobufData []byte) (gogoproto.Message, error) {
+	msg := c.newMessage()
+	if err := gogoproto.Unmarshal(protobufData, msg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s from protobuf: %w", gogoproto.MessageName(msg), err)
+	}
+	return msg, nil
+}
+
+// ConvertJSONToProtobuf converts JSON metadata to protobuf binary format.
+func (c ProtoMetadataCodec) ConvertJSONToProtobuf(jsonData []byte) ([]byte, error) {
+	msg := c.newMessage()
+	unmarshaller := &jsonpb.Unmarshaler{}
+	if err := unmarshaller.Unmarshal(bytes.NewReader(jsonData), msg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s from JSON: %w", gogoproto.MessageName(msg), err)
+	}
+	return gogoproto.Marshal(msg)
+}
+
+// ConvertProtobufToJSON converts protobuf binary metadata to JSON format.
+func (c ProtoMetadataCodec) ConvertProtobufToJSON(protobufData []byte) ([]byte, error) {
+	msg, err := c.Unmarshal(protobufData)
+	if err != nil {
+		return nil, err
+	}
+	marshaler := &jsonpb.Marshaler{
+		EmitDefaults: true,
+		EnumsAsInts:  true,
+	}
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, fmt.Errorf("failed to marshal %s to JSON: %w", gogoproto.MessageName(msg), err)
+	}
+	return buf.Bytes(), nil
+}
@@ -1,16 +1,50 @@
 package keeper
 
 import (
+	"fmt"
+
 	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
 )
 
-// InitializeActionRegistry sets up the action registry with default handlers
+// SensePlugin returns the built-in plugin for Sense actions.
+func SensePlugin() ActionPlugin {
+	return ActionPlugin{
+		TypeName:  actiontypes.ActionTypeSense.String(),
+		Aliases:   []string{"SENSE"},
+		Validator: &actiontypes.SenseValidator{},
+		NewHandler: func(k *Keeper) ActionHandler {
+			return NewSenseActionHandler(k)
+		},
+	}
+}
+
+// CascadePlugin returns the built-in plugin for Cascade actions.
+func CascadePlugin() ActionPlugin {
+	return ActionPlugin{
+		TypeName:  actiontypes.ActionTypeCascade.String(),
+		Aliases:   []string{"CASCADE"},
+		Validator: &actiontypes.CascadeValidator{},
+		NewHandler: func(k *Keeper) ActionHandler {
+			return NewCascadeActionHandler(k)
+		},
+	}
+}
+
+// InitializeActionRegistry sets up the action registry with the built-in plugins
 func (k *Keeper) InitializeActionRegistry() *ActionRegistry {
 	registry := NewActionRegistry(k)
 
-	// Register handlers for existing action types
-	registry.RegisterHandler(actiontypes.ActionTypeSense, NewSenseActionHandler(k))
-	registry.RegisterHandler(actiontypes.ActionTypeCascade, NewCascadeActionHandler(k))
+	for _, plugin := range []ActionPlugin{SensePlugin(), CascadePlugin()} {
+		if err := registry.RegisterPlugin(plugin); err != nil {
+			panic(fmt.Sprintf("failed to register built-in action plugin %s: %s", plugin.TypeName, err))
+		}
+	}
 
 	return registry
 }
+
+// RegisterActionPlugin registers an additional action type with the module.
+// It is intended to be called from app wiring right after the keeper is built.
+func (k *Keeper) RegisterActionPlugin(plugin ActionPlugin) error {
+	return k.actionRegistry.RegisterPlugin(plugin)
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	keepertest "github.com/LumeraProtocol/lumera/testutil/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/common"
+	"github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// inferenceActionType is the code derived for the inference plugin.
+var inferenceActionType = types.PluginActionTypeCode("ACTION_TYPE_INFERENCE")
+
+type inferenceValidator struct{}
+
+func (inferenceValidator) ValidateBasic(metadataStr string, _ common.MessageType) error {
+	var metadata map[string]any
+	if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
+		return fmt.Errorf("failed to unmarshal inference metadata: %w", err)
+	}
+	return nil
+}
+
+// inferenceHandler reuses CascadeMetadata as its metadata message; a real
+// plugin would declare its own proto type.
+type inferenceHandler struct {
+	keeper.ProtoMetadataCodec
+}
+
+func newInferenceHandler(_ *keeper.Keeper) keeper.ActionHandler {
+	return inferenceHandler{
+		ProtoMetadataCodec: keeper.NewProtoMetadataCodec(func() gogoproto.Message { return &types.CascadeMetadata{} }),
+	}
+}
+
+func (h inferenceHandler) Process(metadataBytes []byte, _ common.MessageType, _ *types.Params) ([]byte, error) {
+	return h.ConvertJSONToProtobuf(metadataBytes)
+}
+
+func (inferenceHandler) RegisterAction(sdk.Context, *types.Action) error { return nil }
+
+func (inferenceHandler) FinalizeAction(sdk.Context, *types.Action, string, []byte) (types.ActionState, error) {
+	return types.ActionStateDone, nil
+}
+
+func (inferenceHandler) ValidateApproval(sdk.Context, *types.Action) error { return nil }
+
+func (inferenceHandler) GetUpdatedMetadata(_ sdk.Context, _, newMetadata []byte) ([]byte, error) {
+	return newMetadata, nil
+}
+
+func (inferenceHandler) SearchableFieldNames() []string { return []string{"model"} }
+
+func (h inferenceHandler) SearchableFields(metadataBytes []byte) (map[string]string, error) {
+	msg, err := h.Unmarshal(metadataBytes)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"model": msg.(*types.CascadeMetadata).FileName}, nil
+}
+
+// registerInferencePlugin registers the inference plugin and removes it from
+// the global action type registry when the test ends.
+func registerInferencePlugin(t *testing.T, k keeper.Keeper) {
+	t.Helper()
+	t.Cleanup(func() { types.DeregisterActionType("ACTION_TYPE_INFERENCE") })
+	require.NoError(t, k.RegisterActionPlugin(inferencePlugin()))
+}
+
+func inferencePlugin() keeper.ActionPlugin {
+	return keeper.ActionPlugin{
+		TypeName:   "ACTION_TYPE_INFERENCE",
+		Aliases:    []string{"INFERENCE"},
+		Validator:  inferenceValidator{},
+		NewHandler: newInferenceHandler,
+	}
+}
+
+func TestRegisterActionPlugin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, ctx := keepertest.ActionKeeper(t, ctrl)
+	registerInferencePlugin(t, k)
+	// Registering the same plugin again (e.g. a second keeper) is allowed.
+	require.NoError(t, k.RegisterActionPlugin(inferencePlugin()))
+
+	// The plugin is keyed by name; its code is derived and never enters the
+	// generated enum maps.
+	require.Equal(t, "ACTION_TYPE_INFERENCE", types.ActionTypeName(inferenceActionType))
+	require.GreaterOrEqual(t, int32(inferenceActionType), int32(1<<16))
+	_, inEnum := types.ActionType_name[int32(inferenceActionType)]
+	require.False(t, inEnum)
+	parsed, err := types.ParseActionType("inference")
+	require.NoError(t, err)
+	require.Equal(t, inferenceActionType, parsed)
+	require.NoError(t, types.DoActionValidation(`{"file_name":"llama"}`, "INFERENCE", common.MsgRequestAction))
+
+	registry := k.GetActionRegistry()
+	require.Equal(t, []string{"ACTION_TYPE_CASCADE", "ACTION_TYPE_INFERENCE", "ACTION_TYPE_SENSE"}, registry.RegisteredTypes())
+	handler, err := registry.GetHandler(inferenceActionType)
+	require.NoError(t, err)
+
+	// Handler-declared metadata type drives JSON <-> proto conversion.
+	protoBz, err := handler.ConvertJSONToProtobuf([]byte(`{"file_name":"llama"}`))
+	require.NoError(t, err)
+	jsonBz, err := handler.ConvertProtobufToJSON(protoBz)
+	require.NoError(t, err)
+	require.Contains(t, string(jsonBz), `"file_name":"llama"`)
+
+	// Plugin actions are indexed by type and searchable through the handler hook.
+	require.NoError(t, k.SetAction(ctx, &types.Action{
+		Creator:    "creator1",
+		ActionID:   "1",
+		ActionType: inferenceActionType,
+		Metadata:   protoBz,
+		Price:      "100ulume",
+		State:      types.ActionStatePending,
+	}))
+	resp, err := keeper.NewQueryServerImpl(k).QueryActionByMetadata(ctx, &types.QueryActionByMetadataRequest{
+		ActionType:    inferenceActionType,
+		MetadataQuery: "model=llama",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Actions, 1)
+	require.Equal(t, "1", resp.Actions[0].ActionID)
+}
+
+func TestRegisterActionPluginConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, _ := keepertest.ActionKeeper(t, ctrl)
+	registerInferencePlugin(t, k)
+
+	// A different type cannot take over a registered alias.
+	clash := inferencePlugin()
+	clash.TypeName = "ACTION_TYPE_NFT_MINT"
+	clash.Aliases = []string{"INFERENCE"}
+	require.ErrorIs(t, k.RegisterActionPlugin(clash), types.ErrInvalidActionType)
+	_, err := types.ParseActionType("ACTION_TYPE_NFT_MINT")
+	require.Error(t, err)
+
+	// Built-in types cannot be rebound to another validator.
+	senseClash := inferencePlugin()
+	senseClash.TypeName = types.ActionTypeSense.String()
+	senseClash.Aliases = nil
+	require.ErrorIs(t, k.RegisterActionPlugin(senseClash), types.ErrInvalidActionType)
+
+	// Type URLs work as type names.
+	urlPlugin := inferencePlugin()
+	urlPlugin.TypeName = "/lumera.inference.v1.InferenceMetadata"
+	urlPlugin.Aliases = nil
+	t.Cleanup(func() { types.DeregisterActionType(urlPlugin.TypeName) })
+	require.NoError(t, k.RegisterActionPlugin(urlPlugin))
+	parsed, err := types.ParseActionType(urlPlugin.TypeName)
+	require.NoError(t, err)
+	require.Equal(t, types.PluginActionTypeCode(urlPlugin.TypeName), parsed)
+
+	// Plugins must provide both a validator and a handler.
+	require.ErrorIs(t, k.RegisterActionPlugin(keeper.ActionPlugin{TypeName: "ACTION_TYPE_EMPTY"}), types.ErrInvalidActionType)
+}
@@ -57,6 +57,9 @@ func (h SenseActionHandler) Process(metadataBytes []byte, msgType common.Message
 		if metadata.Signatures == "" {
 			return nil, fmt.Errorf("signatures is required for sense metadata")
 		}
+		if err := validateSignatureScheme(metadata.SignatureScheme); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unsupported message type: %s", msgType)
 	}
@@ -170,15 +173,15 @@ func (h SenseActionHandler) FinalizeAction(ctx sdk.Context, action *actiontypes.
 			errors.Wrap(actiontypes.ErrInvalidMetadata, "invalid signature format")
 	}
 
-	// Use VerifySignature from crypto.go to validate the signature in either
+	// Use VerifyMetadataSignature from crypto.go to validate the signature in either
 	// of signatureParts[1,2,3] over the data in signatureParts[0].
 	dataToVerify := signatureParts[0]
 	var verifyErr error
 	for i := 1; i < 4; i++ {
-		superNodeSignature := signatureParts[i] // the signature is Base64 encoded, VerifySignature will decode it
+		superNodeSignature := signatureParts[i] // the signature is Base64 encoded, VerifyMetadataSignature will decode it
 
 		// Verify that superNode's signature is valid for the fingerprint data
-		verifyErr = h.keeper.VerifySignature(ctx, dataToVerify, superNodeSignature, superNodeAccount)
+		verifyErr = h.keeper.VerifyMetadataSignature(ctx, newSenseMeta.SignatureScheme, dataToVerify, superNodeSignature, superNodeAccount)
 		if verifyErr == nil {
 			break
 		}
@@ -197,6 +200,7 @@ func (h SenseActionHandler) FinalizeAction(ctx sdk.Context, action *actiontypes.
 	}
 
 	existingSenseMeta.Signatures = newSenseMeta.Signatures
+	existingSenseMeta.SignatureScheme = newSenseMeta.SignatureScheme
 	updatedMetadataBytes, err := gogoproto.Marshal(&existingSenseMeta)
 	if err != nil {
 		return actiontypes.ActionStateUnspecified,
@@ -217,7 +221,46 @@ func (h SenseActionHandler) ValidateApproval(ctx sdk.Context, action *actiontype
 	return nil
 }
 
-func (h SenseActionHandler) GetUpdatedMetadata(ctx sdk.Context, existingMetadata, newMetadata []byte) ([]byte, error) {
-	// Empty implementation - will be filled in later
-	return nil, nil
+// GetUpdatedMetadata merges the finalization fields of the new Sense metadata
+// into the registered metadata.
+func (h SenseActionHandler) GetUpdatedMetadata(ctx sdk.Context, existingMetadataBytes, newMetadataBytes []byte) ([]byte, error) {
+	var existingMetadata, newMetadata actiontypes.SenseMetadata
+
+	if err := gogoproto.Unmarshal(existingMetadataBytes, &existingMetadata); err != nil {
+		return nil, errors.Wrap(actiontypes.ErrInternalError, fmt.Sprintf("failed to unmarshal existing metadata: %v", err))
+	}
+	if err := gogoproto.Unmarshal(newMetadataBytes, &newMetadata); err != nil {
+		return nil, errors.Wrap(actiontypes.ErrInternalError, fmt.Sprintf("failed to unmarshal new metadata: %v", err))
+	}
+
+	updatedMetadata := &actiontypes.SenseMetadata{
+		DataHash:             existingMetadata.GetDataHash(),
+		DdAndFingerprintsIc:  existingMetadata.GetDdAndFingerprintsIc(),
+		CollectionId:         existingMetadata.GetCollectionId(),
+		GroupId:              existingMetadata.GetGroupId(),
+		DdAndFingerprintsMax: existingMetadata.GetDdAndFingerprintsMax(),
+		DdAndFingerprintsIds: newMetadata.GetDdAndFingerprintsIds(),
+		Signatures:           newMetadata.GetSignatures(),
+		SignatureScheme:      newMetadata.GetSignatureScheme(),
+	}
+
+	return gogoproto.Marshal(updatedMetadata)
+}
+
+// SearchableFieldNames returns the Sense metadata fields that are indexed for search
+func (h SenseActionHandler) SearchableFieldNames() []string {
+	return []string{"collection_id", "group_id", "data_hash"}
+}
+
+// SearchableFields returns the Sense metadata fields supported by QueryActionByMetadata
+func (h SenseActionHandler) SearchableFields(metadataBytes []byte) (map[string]string, error) {
+	var metadata actiontypes.SenseMetadata
+	if err := gogoproto.Unmarshal(metadataBytes, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sense metadata: %w", err)
+	}
+	return map[string]string{
+		"collection_id": metadata.CollectionId,
+		"group_id":      metadata.GroupId,
+		"data_hash":     metadata.DataHash,
+	}, nil
 }
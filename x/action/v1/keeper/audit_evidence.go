@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -12,6 +13,13 @@ import (
 
 type ctxKeyTop10ValidatorAddresses struct{}
 
+// finalizationResultHashes carries the quorum and submitted result hashes for
+// result-mismatch evidence metadata.
+type finalizationResultHashes struct {
+	quorum    []byte
+	submitted []byte
+}
+
 func top10ValidatorAddressesFromContext(ctx sdk.Context) []string {
 	v := ctx.Value(ctxKeyTop10ValidatorAddresses{})
 	if v == nil {
@@ -30,6 +38,7 @@ func (k *Keeper) recordFinalizationEvidence(
 	subjectAddress string,
 	top10ValidatorAddresses []string,
 	evidenceType audittypes.EvidenceType,
+	resultHashes *finalizationResultHashes,
 ) (uint64, error) {
 	if k.auditKeeper == nil {
 		return 0, fmt.Errorf("audit keeper is not configured")
@@ -61,6 +70,19 @@ func (k *Keeper) recordFinalizationEvidence(
 			return 0, fmt.Errorf("marshal evidence metadata: %w", err)
 		}
 		metadataJSON = string(metaJSON)
+	case audittypes.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH:
+		if resultHashes == nil {
+			return 0, fmt.Errorf("result hashes are required for %s", evidenceType.String())
+		}
+		metaJSON, err := json.Marshal(audittypes.ActionFinalizationResultMismatchEvidenceMetadata{
+			Top_10ValidatorAddresses: top10ValidatorAddresses,
+			QuorumResultHash:         hex.EncodeToString(resultHashes.quorum),
+			SubmittedResultHash:      hex.EncodeToString(resultHashes.submitted),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("marshal evidence metadata: %w", err)
+		}
+		metadataJSON = string(metaJSON)
 	default:
 		return 0, fmt.Errorf("unsupported finalization evidence type: %s", evidenceType.String())
 	}
@@ -82,6 +104,7 @@ func (k *Keeper) recordFinalizationRejection(
 	reason string,
 	top10ValidatorAddresses []string,
 	evidenceType audittypes.EvidenceType,
+	resultHashes *finalizationResultHashes,
 ) uint64 {
 	if action == nil {
 		return 0
@@ -97,6 +120,7 @@ func (k *Keeper) recordFinalizationRejection(
 		subjectAddress,
 		top10ValidatorAddresses,
 		evidenceType,
+		resultHashes,
 	); err != nil {
 		k.Logger().Error(
 			"failed to record finalization evidence",
@@ -112,7 +136,7 @@ func (k *Keeper) recordFinalizationRejection(
 		sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
 		sdk.NewAttribute(actiontypes.AttributeKeyCreator, action.Creator),
 		sdk.NewAttribute(actiontypes.AttributeKeyFinalizer, attemptedFinalizerAddress),
-		sdk.NewAttribute(actiontypes.AttributeKeyActionType, action.ActionType.String()),
+		sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(action.ActionType)),
 		sdk.NewAttribute(actiontypes.AttributeKeyError, reason),
 	}
 	if evidenceID != 0 {
@@ -140,6 +164,7 @@ func (k *Keeper) RecordFinalizationSignatureFailure(
 		reason,
 		top10ValidatorAddresses,
 		audittypes.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE,
+		nil,
 	)
 }
 
@@ -157,6 +182,28 @@ func (k *Keeper) RecordFinalizationNotInTop10(
 		reason,
 		top10ValidatorAddresses,
 		audittypes.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10,
+		nil,
+	)
+}
+
+// RecordFinalizationResultMismatch records evidence against a supernode whose
+// finalization result disagreed with the quorum of a multi-supernode action.
+func (k *Keeper) RecordFinalizationResultMismatch(
+	ctx sdk.Context,
+	action *actiontypes.Action,
+	finalizerAddress string,
+	top10ValidatorAddresses []string,
+	quorumResultHash []byte,
+	submittedResultHash []byte,
+) uint64 {
+	return k.recordFinalizationRejection(
+		ctx,
+		action,
+		finalizerAddress,
+		fmt.Sprintf("finalization result %X does not match quorum result %X", submittedResultHash, quorumResultHash),
+		top10ValidatorAddresses,
+		audittypes.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH,
+		&finalizationResultHashes{quorum: quorumResultHash, submitted: submittedResultHash},
 	)
 }
 
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// isCancellable reports whether an action can still be withdrawn by its creator.
+// Only PENDING actions qualify, and only while no supernode has submitted a
+// finalization for them; once work has started the fee belongs to the network.
+func isCancellable(action *actiontypes.Action) bool {
+	return action.State == actiontypes.ActionStatePending && len(action.SuperNodes) == 0
+}
+
+// splitCancellationFee divides an action's escrowed price into the part refunded
+// to the creator and the cancellation fee retained by the chain. The fee is
+// capped at the price, and is only charged when its denom matches the price.
+func splitCancellationFee(price sdk.Coin, cancellationFee sdk.Coin) (refund sdk.Coin, fee sdk.Coin) {
+	fee = sdk.NewCoin(price.Denom, math.ZeroInt())
+	if cancellationFee.Denom == price.Denom && cancellationFee.Amount.IsPositive() {
+		fee.Amount = math.MinInt(cancellationFee.Amount, price.Amount)
+	}
+	return price.Sub(fee), fee
+}
+
+// CancelAction withdraws a PENDING action on behalf of its creator, or of its
+// refund address when one is recorded (the creator of an action requested over
+// IBC is keyless). The escrowed price minus Params.CancellationFee is refunded
+// to the refund recipient, the retained fee is sent to the community pool, and
+// the action is moved to the CANCELLED state. It returns the refunded amount
+// and the retained fee.
+func (k *Keeper) CancelAction(ctx sdk.Context, actionID string, creator string) (sdk.Coin, sdk.Coin, error) {
+	action, found := k.GetActionByID(ctx, actionID)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, errors.Wrapf(actiontypes.ErrActionNotFound, "action %s not found", actionID)
+	}
+
+	refundAddress, hasRefundAddress := k.GetActionRefundAddress(ctx, actionID)
+	if action.Creator != creator && (!hasRefundAddress || refundAddress != creator) {
+		return sdk.Coin{}, sdk.Coin{}, errors.Wrapf(
+			actiontypes.ErrUnauthorizedCreator,
+			"only the creator %s can cancel action %s",
+			action.Creator,
+			actionID,
+		)
+	}
+
+	if !isCancellable(action) {
+		return sdk.Coin{}, sdk.Coin{}, errors.Wrapf(
+			actiontypes.ErrInvalidActionState,
+			"action %s cannot be cancelled: current state %s with %d supernode result(s)",
+			actionID,
+			action.State.String(),
+			len(action.SuperNodes),
+		)
+	}
+
+	price, err := sdk.ParseCoinNormalized(action.Price)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, errors.Wrapf(actiontypes.ErrInvalidPrice, "action %s has invalid price %q: %s", actionID, action.Price, err)
+	}
+
+	refund, fee := splitCancellationFee(price, k.GetParams(ctx).CancellationFee)
+
+	if refund.IsPositive() {
+		recipient, err := k.refundRecipient(ctx, action)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, actiontypes.ModuleName, recipient, sdk.NewCoins(refund)); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, errors.Wrap(err, "failed to refund cancelled action")
+		}
+	}
+
+	if fee.IsPositive() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), actiontypes.ModuleAccountAddress); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, errors.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to send cancellation fee: %s", err)
+		}
+	}
+
+	// Update action state to CANCELLED; SetAction drops the expiration index entry
+	action.State = actiontypes.ActionStateCancelled
+	if err := k.SetAction(ctx, action); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			actiontypes.EventTypeActionCancelled,
+			sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
+			sdk.NewAttribute(actiontypes.AttributeKeyCreator, action.Creator),
+			sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(action.ActionType)),
+			sdk.NewAttribute(actiontypes.AttributeKeyRefund, refund.String()),
+			sdk.NewAttribute(actiontypes.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return refund, fee, nil
+}
@@ -35,8 +35,8 @@ var highCompressSem = semaphore.NewWeighted(maxParallelHighCompressCalls)
 // VerifySignature verifies that a signature is valid for given data and signer.
 //
 // Flow:
-//   - Try to get a pubkey from the context cache (creatorAccountCtxKey). For ICA creators
-//     this uses the app-level pubkey provided on the message; for non-ICA creators it uses
+//   - Try to get a pubkey from the context cache (creatorAccountCtxKey). For ICA and remote
+//     creators this uses the app-level pubkey provided on the message; for non-ICA creators it uses
 //     the cached account pubkey when present.
 //   - If no cached key is found, resolve the account and pubkey from auth keeper + address codec.
 //   - Decode the base64 signature, coerce to r||s format, and verify.
@@ -57,7 +57,7 @@ func (k *Keeper) VerifySignature(ctx sdk.Context, dataB64 string, signature stri
 
 	// 1. Try to get pubkey from context cache (creatorAccountCtxKey)
 	if info, ok := ctx.Value(creatorAccountCtxKey).(*creatorAccountInfo); ok {
-		if info.isICA {
+		if info.requiresAppPubkey() {
 			if len(info.appPubkey) == 0 {
 				return errorsmod.Wrap(actiontypes.ErrInvalidSignature, "app pubkey required for interchain account signature")
 			}
@@ -114,6 +114,64 @@ func (k *Keeper) VerifySignature(ctx sdk.Context, dataB64 string, signature stri
 	return errorsmod.Wrap(actiontypes.ErrInvalidSignature, "signature verification failed")
 }
 
+// VerifyMetadataSignature verifies a metadata signature under the scheme
+// declared by the metadata. SIGNATURE_SCHEME_UNSPECIFIED is verified as
+// secp256k1 so tickets registered before the scheme was declared keep working.
+func (k *Keeper) VerifyMetadataSignature(ctx sdk.Context, scheme actiontypes.SignatureScheme, dataB64 string, signature string, signerAddress string) error {
+	switch scheme {
+	case actiontypes.SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED, actiontypes.SignatureScheme_SIGNATURE_SCHEME_SECP256K1:
+		return k.VerifySignature(ctx, dataB64, signature, signerAddress)
+	case actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST:
+		return k.VerifyLegRoastSignature(ctx, dataB64, signature, signerAddress)
+	default:
+		return errorsmod.Wrapf(actiontypes.ErrInvalidSignature, "unsupported signature scheme: %s", scheme)
+	}
+}
+
+// VerifyLegRoastSignature verifies a base64-encoded LegRoast signature over dataB64.
+//
+// ICA and remote creators sign with the LegRoast key supplied as app_pubkey on the
+// request; every other signer must have an active key in the x/lumeraid PQ key
+// registry. Verification gas is charged by x/lumeraid (legroast_verify_gas) before
+// the signature is checked.
+func (k *Keeper) VerifyLegRoastSignature(ctx sdk.Context, dataB64 string, signature string, signerAddress string) error {
+	if k.lumeraidKeeper == nil {
+		return errorsmod.Wrap(actiontypes.ErrInvalidSignature, "LegRoast signatures are not supported without a PQ key registry")
+	}
+
+	var pubKey []byte
+	if info, ok := ctx.Value(creatorAccountCtxKey).(*creatorAccountInfo); ok && info.requiresAppPubkey() {
+		if len(info.appPubkey) == 0 {
+			return errorsmod.Wrap(actiontypes.ErrInvalidSignature, "app pubkey required for interchain account signature")
+		}
+		pubKey = info.appPubkey
+	} else {
+		pqKey, err := k.lumeraidKeeper.ActivePQKey(ctx, signerAddress)
+		if err != nil {
+			return errorsmod.Wrapf(actiontypes.ErrInvalidSignature, "no active PQ key: %s", err)
+		}
+		pubKey = pqKey.PublicKey
+	}
+
+	sigRaw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return errorsmod.Wrapf(actiontypes.ErrInvalidSignature,
+			"failed to decode signature: %s", err)
+	}
+	if err := k.lumeraidKeeper.VerifyLegRoastSignature(ctx, []byte(dataB64), pubKey, sigRaw); err != nil {
+		return errorsmod.Wrapf(actiontypes.ErrInvalidSignature, "LegRoast signature verification failed: %s", err)
+	}
+	return nil
+}
+
+// validateSignatureScheme rejects signature schemes unknown to this version.
+func validateSignatureScheme(scheme actiontypes.SignatureScheme) error {
+	if _, ok := actiontypes.SignatureScheme_name[int32(scheme)]; !ok {
+		return fmt.Errorf("unsupported signature_scheme %d", scheme)
+	}
+	return nil
+}
+
 // VerifyKademliaIDs verifies that a Kademlia ID matches the expected format and content.
 //
 // Cascade ID Format is `Base58(BLAKE3(zstd_compressed(Base64(rq_ids).creators_signature.counter)))`
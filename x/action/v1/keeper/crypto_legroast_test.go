@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/jsonpb"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	cryptotestutils "github.com/LumeraProtocol/lumera/testutil/crypto"
+	keepertest "github.com/LumeraProtocol/lumera/testutil/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+)
+
+// newTestLegRoastKey returns a LegRoast key pair using the fastest variant.
+func newTestLegRoastKey(t *testing.T, seed string) legroast.LegRoastInterface {
+	t.Helper()
+	lr := legroast.NewLegRoast(legroast.PowerMiddle)
+	require.NoError(t, lr.Keygen([]byte(seed)))
+	return lr
+}
+
+func signLegRoast(t *testing.T, lr legroast.LegRoastInterface, dataB64 string) string {
+	t.Helper()
+	sig, err := lr.Sign([]byte(dataB64))
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestVerifyMetadataSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, address := cryptotestutils.KeyAndAddress()
+	pairs := []keepertest.AccountPair{{Address: address, PubKey: key.PubKey()}}
+	registry := keepertest.NewMockLumeraIDKeeper()
+	k, ctx := keepertest.ActionKeeperWithLumeraID(t, ctrl, pairs, registry)
+
+	data := base64.StdEncoding.EncodeToString([]byte("test_data"))
+	secpSignature, err := cryptotestutils.SignString(key, data)
+	require.NoError(t, err)
+	lr := newTestLegRoastKey(t, "0000000000000001")
+	legroastSignature := signLegRoast(t, lr, data)
+
+	// Without a registered PQ key the signer cannot use LegRoast.
+	err = k.VerifyMetadataSignature(ctx, actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST, data, legroastSignature, address.String())
+	require.ErrorIs(t, err, actiontypes.ErrInvalidSignature)
+	require.ErrorContains(t, err, "no active PQ key")
+
+	registry.PQKeys[address.String()] = lr.PublicKey()
+
+	testCases := []struct {
+		name      string
+		scheme    actiontypes.SignatureScheme
+		data      string
+		signature string
+		expectErr bool
+	}{
+		{
+			name:      "unspecified scheme falls back to secp256k1",
+			scheme:    actiontypes.SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED,
+			data:      data,
+			signature: secpSignature,
+		},
+		{
+			name:      "explicit secp256k1",
+			scheme:    actiontypes.SignatureScheme_SIGNATURE_SCHEME_SECP256K1,
+			data:      data,
+			signature: secpSignature,
+		},
+		{
+			name:      "legroast against the registry key",
+			scheme:    actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST,
+			data:      data,
+			signature: legroastSignature,
+		},
+		{
+			name:      "legroast signature declared as secp256k1",
+			scheme:    actiontypes.SignatureScheme_SIGNATURE_SCHEME_SECP256K1,
+			data:      data,
+			signature: legroastSignature,
+			expectErr: true,
+		},
+		{
+			name:      "secp256k1 signature declared as legroast",
+			scheme:    actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST,
+			data:      data,
+			signature: secpSignature,
+			expectErr: true,
+		},
+		{
+			name:      "legroast over different data",
+			scheme:    actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST,
+			data:      base64.StdEncoding.EncodeToString([]byte("other_data")),
+			signature: legroastSignature,
+			expectErr: true,
+		},
+		{
+			name:      "unknown scheme",
+			scheme:    actiontypes.SignatureScheme(99),
+			data:      data,
+			signature: secpSignature,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := k.VerifyMetadataSignature(ctx, tc.scheme, tc.data, tc.signature, address.String())
+			if tc.expectErr {
+				require.ErrorIs(t, err, actiontypes.ErrInvalidSignature)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// LegRoast verification is charged the registry's verify gas.
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.NoError(t, k.VerifyMetadataSignature(ctx, actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST, data, legroastSignature, address.String()))
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, registry.VerifyGas)
+}
+
+func cascadeRequestMetadata(t *testing.T, signatures string, scheme actiontypes.SignatureScheme) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&buf, &actiontypes.CascadeMetadata{
+		DataHash:        "test_hash",
+		FileName:        "test_file",
+		RqIdsIc:         20,
+		Signatures:      signatures,
+		SignatureScheme: scheme,
+	}))
+	return buf.String()
+}
+
+func TestMsgRequestActionCascadeLegRoastSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, address := cryptotestutils.KeyAndAddress()
+	pairs := []keepertest.AccountPair{{Address: address, PubKey: key.PubKey()}}
+	registry := keepertest.NewMockLumeraIDKeeper()
+	k, ctx := keepertest.ActionKeeperWithLumeraID(t, ctrl, pairs, registry)
+	ctx = ctx.WithBlockHeight(1)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	lr := newTestLegRoastKey(t, "0000000000000001")
+	data := base64.StdEncoding.EncodeToString([]byte("rq_ids"))
+	signatures := data + "." + signLegRoast(t, lr, data)
+
+	newMsg := func(scheme actiontypes.SignatureScheme, appPubkey []byte) *actiontypes.MsgRequestAction {
+		return &actiontypes.MsgRequestAction{
+			Creator:     address.String(),
+			ActionType:  actiontypes.ActionTypeCascade.String(),
+			Price:       "100000ulume",
+			Metadata:    cascadeRequestMetadata(t, signatures, scheme),
+			FileSizeKbs: "123",
+			AppPubkey:   appPubkey,
+		}
+	}
+
+	// A LegRoast signature is not accepted as the legacy secp256k1 scheme.
+	_, err := msgServer.RequestAction(ctx, newMsg(actiontypes.SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED, nil))
+	require.ErrorIs(t, err, actiontypes.ErrInvalidMetadata)
+
+	// The creator has no registered PQ key yet.
+	_, err = msgServer.RequestAction(ctx, newMsg(actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST, nil))
+	require.ErrorIs(t, err, actiontypes.ErrInvalidMetadata)
+
+	registry.PQKeys[address.String()] = lr.PublicKey()
+	res, err := msgServer.RequestAction(ctx, newMsg(actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST, nil))
+	require.NoError(t, err)
+
+	action, found := k.GetActionByID(ctx, res.ActionId)
+	require.True(t, found)
+	var stored actiontypes.CascadeMetadata
+	require.NoError(t, stored.Unmarshal(action.Metadata))
+	require.Equal(t, actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST, stored.SignatureScheme)
+
+	// Unknown schemes are rejected when the metadata is processed.
+	_, err = msgServer.RequestAction(ctx, newMsg(actiontypes.SignatureScheme(99), nil))
+	require.ErrorIs(t, err, actiontypes.ErrInvalidMetadata)
+
+	// Interchain accounts sign with the LegRoast key supplied as app_pubkey,
+	// even when the registry holds a different key for the address.
+	ica := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(address), "owner")
+	k.GetAuthKeeper().SetAccount(ctx, ica)
+	registry.PQKeys[address.String()] = newTestLegRoastKey(t, "0000000000000002").PublicKey()
+
+	_, err = msgServer.RequestAction(ctx, newMsg(actiontypes.SignatureScheme_SIGNATURE_SCHEME_LEGROAST, lr.PublicKey()))
+	require.NoError(t, err)
+}
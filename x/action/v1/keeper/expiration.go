@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// expirationSignBit flips the sign bit of encoded expiration times so that the
+// big-endian byte order matches signed integer order.
+const expirationSignBit = uint64(1) << 63
+
+// expirationRetryDelay is how far, in seconds, an action whose expiration
+// failed is pushed back in the expiration index before it is retried.
+const expirationRetryDelay int64 = 600
+
+// expirationQueueKey builds the expiration index key for an action.
+// The key format is ActionByExpirationPrefix + bigEndian(expirationTime ^ signBit) + actionID,
+// so a forward iteration visits actions in (expirationTime, actionID) order even
+// for timestamps before the Unix epoch.
+func expirationQueueKey(expirationTime int64, actionID string) []byte {
+	key := make([]byte, 0, len(ActionByExpirationPrefix)+8+len(actionID))
+	key = append(key, ActionByExpirationPrefix...)
+	key = binary.BigEndian.AppendUint64(key, uint64(expirationTime)^expirationSignBit)
+	return append(key, actionID...)
+}
+
+// parseExpirationQueueKey splits an expiration index key into its expiration
+// time and action ID components.
+func parseExpirationQueueKey(key []byte) (int64, string, bool) {
+	if len(key) < len(ActionByExpirationPrefix)+8 {
+		return 0, "", false
+	}
+	rest := key[len(ActionByExpirationPrefix):]
+	return int64(binary.BigEndian.Uint64(rest[:8]) ^ expirationSignBit), string(rest[8:]), true
+}
+
+// isExpirable reports whether an action belongs in the expiration index:
+// it must carry a deadline and still be in a state CheckExpiration acts on.
+func isExpirable(action *actiontypes.Action) bool {
+	if action == nil || action.ExpirationTime == 0 {
+		return false
+	}
+	return action.State == actiontypes.ActionStatePending || action.State == actiontypes.ActionStateProcessing
+}
+
+// updateExpirationIndex keeps the expiration index in sync with an action write.
+// existing is the previously stored version of the action, or nil for new actions.
+func (k *Keeper) updateExpirationIndex(store corestore.KVStore, existing, action *actiontypes.Action) error {
+	if isExpirable(existing) && (!isExpirable(action) || existing.ExpirationTime != action.ExpirationTime) {
+		if err := store.Delete(expirationQueueKey(existing.ExpirationTime, existing.ActionID)); err != nil {
+			return err
+		}
+	}
+	if isExpirable(action) {
+		if err := store.Set(expirationQueueKey(action.ExpirationTime, action.ActionID), []byte{1}); err != nil { // Just a marker
+			return err
+		}
+	}
+	return nil
+}
+
+// BackfillExpirationIndex rebuilds expiration index entries for every action in
+// the PENDING and PROCESSING states. It is used by the v1->v2 store migration.
+func (k *Keeper) BackfillExpirationIndex(ctx sdk.Context) (int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	indexed := 0
+	for _, state := range []actiontypes.ActionState{actiontypes.ActionStatePending, actiontypes.ActionStateProcessing} {
+		var actions []*actiontypes.Action
+		if err := k.IterateActionsByState(ctx, state, func(action *actiontypes.Action) bool {
+			actions = append(actions, action)
+			return false
+		}); err != nil {
+			return indexed, err
+		}
+		for _, action := range actions {
+			if !isExpirable(action) {
+				continue
+			}
+			if err := store.Set(expirationQueueKey(action.ExpirationTime, action.ActionID), []byte{1}); err != nil {
+				return indexed, errors.Wrapf(err, "failed to index expiration for action %s", action.ActionID)
+			}
+			indexed++
+		}
+	}
+	return indexed, nil
+}
+
+// expirationEntry is a single (expirationTime, actionID) entry of the expiration index.
+type expirationEntry struct {
+	expirationTime int64
+	actionID       string
+}
+
+// dueExpirations returns up to limit index entries whose expiration time is at
+// or before now, in (expirationTime, actionID) order.
+func (k *Keeper) dueExpirations(ctx sdk.Context, now int64, limit uint64) ([]expirationEntry, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	start := []byte(ActionByExpirationPrefix)
+	end := storetypes.PrefixEndBytes(expirationQueueKey(now, ""))
+	iter, err := store.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create iterator for expiration index")
+	}
+	defer func() { _ = iter.Close() }()
+
+	var entries []expirationEntry
+	for ; iter.Valid() && uint64(len(entries)) < limit; iter.Next() {
+		expirationTime, actionID, ok := parseExpirationQueueKey(iter.Key())
+		if !ok {
+			continue
+		}
+		entries = append(entries, expirationEntry{expirationTime: expirationTime, actionID: actionID})
+	}
+	return entries, nil
+}
+
+// CheckExpiration expires PENDING and PROCESSING actions whose deadline has passed.
+// Only entries that are due are read from the expiration index, and at most
+// Params.MaxExpirationsPerBlock actions are processed per call; the rest stay
+// queued for the following blocks.
+func (k *Keeper) CheckExpiration(ctx sdk.Context) {
+	currentTime := ctx.BlockTime().Unix()
+	limit := k.GetParams(ctx).MaxExpirationsPerBlock
+
+	entries, err := k.dueExpirations(ctx, currentTime, limit)
+	if err != nil {
+		k.Logger().Error("Error reading expiration index", "error", err.Error())
+		return
+	}
+
+	expiredCount := 0
+	for _, entry := range entries {
+		if k.expireAction(ctx, entry) {
+			expiredCount++
+		}
+	}
+
+	if expiredCount > 0 {
+		k.Logger().Info("Expired actions checked",
+			"expired_count", expiredCount,
+			"block_height", ctx.BlockHeight(),
+			"block_time", ctx.BlockTime(),
+		)
+	}
+}
+
+// deferExpiration moves a failed index entry expirationRetryDelay seconds past
+// the current block time, so it no longer sits at the head of the queue and
+// cannot starve the expirations behind it.
+func (k *Keeper) deferExpiration(ctx sdk.Context, entry expirationEntry) {
+	store := k.storeService.OpenKVStore(ctx)
+	retryAt := ctx.BlockTime().Unix() + expirationRetryDelay
+	if err := store.Delete(expirationQueueKey(entry.expirationTime, entry.actionID)); err != nil {
+		k.Logger().Error("Failed to delete expiration index entry", "action_id", entry.actionID, "error", err.Error())
+		return
+	}
+	if err := store.Set(expirationQueueKey(retryAt, entry.actionID), []byte{1}); err != nil {
+		k.Logger().Error("Failed to defer expiration index entry", "action_id", entry.actionID, "error", err.Error())
+	}
+}
+
+// expireAction refunds and marks a single due action as EXPIRED.
+// It returns true when the action transitioned to EXPIRED. On a refund or
+// store failure the index entry is deferred with deferExpiration and the
+// action is retried later.
+func (k *Keeper) expireAction(ctx sdk.Context, entry expirationEntry) bool {
+	action, found := k.GetActionByID(ctx, entry.actionID)
+	// A deferred entry is keyed after the action's own expiration time.
+	if !found || !isExpirable(action) || entry.expirationTime < action.ExpirationTime {
+		// Stale index entry; drop it so it does not consume future budget.
+		k.Logger().Debug("Removing stale expiration index entry", "action_id", entry.actionID)
+		store := k.storeService.OpenKVStore(ctx)
+		if err := store.Delete(expirationQueueKey(entry.expirationTime, entry.actionID)); err != nil {
+			k.Logger().Error("Failed to delete stale expiration index entry", "action_id", entry.actionID, "error", err.Error())
+		}
+		return false
+	}
+
+	// Refund and state change are written together, so a failure after the
+	// refund cannot lead to a second refund on retry.
+	cacheCtx, write := ctx.CacheContext()
+
+	// refund action fee to creator (or its refund address) before updating state
+	if fee, err := sdk.ParseCoinNormalized(action.Price); err == nil && !fee.IsZero() {
+		creatorAddr, err := k.refundRecipient(cacheCtx, action)
+		if err != nil {
+			k.Logger().Error("Failed to decode action refund address",
+				"action_id", action.ActionID,
+				"creator", action.Creator,
+				"error", err.Error(),
+			)
+			k.deferExpiration(ctx, entry)
+			return false
+		}
+
+		// Refund the action fee to the creator
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			cacheCtx,
+			actiontypes.ModuleName,
+			creatorAddr,
+			sdk.NewCoins(fee),
+		); err != nil {
+			k.Logger().Error("Failed to refund action fee",
+				"action_id", action.ActionID,
+				"creator", action.Creator,
+				"fee", fee.String(),
+				"error", err.Error(),
+			)
+			k.deferExpiration(ctx, entry)
+			return false
+		}
+	}
+
+	// Update action state to EXPIRED; SetAction drops the expiration index entry
+	// keyed by the action's expiration time, and a deferred entry is dropped here.
+	action.State = actiontypes.ActionStateExpired
+
+	// Save updated action
+	if err := k.SetAction(cacheCtx, action); err != nil {
+		k.Logger().Error("Failed to update expired action",
+			"action_id", action.ActionID,
+			"error", err.Error(),
+		)
+		k.deferExpiration(ctx, entry)
+		return false
+	}
+	if entry.expirationTime != action.ExpirationTime {
+		if err := k.storeService.OpenKVStore(cacheCtx).Delete(expirationQueueKey(entry.expirationTime, entry.actionID)); err != nil {
+			k.Logger().Error("Failed to delete deferred expiration index entry", "action_id", entry.actionID, "error", err.Error())
+			k.deferExpiration(ctx, entry)
+			return false
+		}
+	}
+
+	k.RecordActionExpired(cacheCtx, action)
+
+	// Emit event
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			actiontypes.EventTypeActionExpired,
+			sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
+			sdk.NewAttribute(actiontypes.AttributeKeyCreator, action.Creator),
+			sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(action.ActionType)),
+		),
+	)
+
+	write()
+	return true
+}
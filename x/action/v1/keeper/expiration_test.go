@@ -367,6 +367,151 @@ func (suite *ExpirationTestSuite) TestExpiredActionEvents() {
 	}
 }
 
+// registerExpiringCascade registers a cascade action with the given expiration time.
+func (suite *ExpirationTestSuite) registerExpiringCascade(ctx sdk.Context, tag string, expirationTime int64) string {
+	params := suite.keeper.GetParams(ctx)
+	price := sdk.NewCoin(params.BaseActionFee.Denom, params.BaseActionFee.Amount.Add(params.FeePerKbyte.Amount))
+
+	metadataBytes, err := suite.keeper.GetCodec().Marshal(&actiontypes.CascadeMetadata{
+		DataHash:   "queue-hash-" + tag,
+		FileName:   "queue-file-" + tag,
+		RqIdsIc:    1,
+		RqIdsMax:   2,
+		Signatures: suite.signature,
+	})
+	suite.Require().NoError(err)
+
+	actionID, err := suite.keeper.RegisterAction(ctx, &actiontypes.Action{
+		Creator:        suite.testAddr.String(),
+		ActionType:     actiontypes.ActionTypeCascade,
+		Price:          price.String(),
+		ExpirationTime: expirationTime,
+		Metadata:       metadataBytes,
+	})
+	suite.Require().NoError(err)
+	return actionID
+}
+
+func (suite *ExpirationTestSuite) requireState(actionID string, state actiontypes.ActionState) {
+	action, found := suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Require().Equal(state, action.State, "action %s", actionID)
+}
+
+// TestCheckExpirationRespectsBudget ensures at most MaxExpirationsPerBlock actions
+// expire per call, earliest deadline first, and the rest carry over.
+func (suite *ExpirationTestSuite) TestCheckExpirationRespectsBudget() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxExpirationsPerBlock = 2
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	now := suite.blockTime.Unix()
+	latest := suite.registerExpiringCascade(suite.ctx, "latest", now-10)
+	earliest := suite.registerExpiringCascade(suite.ctx, "earliest", now-300)
+	middle := suite.registerExpiringCascade(suite.ctx, "middle", now-200)
+	future := suite.registerExpiringCascade(suite.ctx, "future", now+600)
+
+	suite.setupExpectationsGetTopSuperNodesForExpiration(3)
+
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(earliest, actiontypes.ActionStateExpired)
+	suite.requireState(middle, actiontypes.ActionStateExpired)
+	suite.requireState(latest, actiontypes.ActionStatePending)
+	suite.requireState(future, actiontypes.ActionStatePending)
+
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(latest, actiontypes.ActionStateExpired)
+	suite.requireState(future, actiontypes.ActionStatePending)
+}
+
+// TestFailingExpirationDoesNotBlockQueue ensures an action whose refund keeps
+// failing is moved off the head of the expiration index instead of consuming
+// the per-block budget forever, and is retried after the retry delay.
+func (suite *ExpirationTestSuite) TestFailingExpirationDoesNotBlockQueue() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxExpirationsPerBlock = 1
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	now := suite.blockTime.Unix()
+	failing := suite.registerExpiringCascade(suite.ctx, "failing", now-300)
+	healthy := suite.registerExpiringCascade(suite.ctx, "healthy", now-100)
+	suite.Require().NoError(keeper.SetRawRefundAddressForTest(suite.keeper, suite.ctx, failing, "not-an-address"))
+
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(failing, actiontypes.ActionStatePending)
+	suite.requireState(healthy, actiontypes.ActionStatePending)
+
+	suite.setupExpectationsGetTopSuperNodesForExpiration(1)
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(healthy, actiontypes.ActionStateExpired)
+	suite.requireState(failing, actiontypes.ActionStatePending)
+
+	// Still failing after the retry delay: deferred again.
+	suite.ctx = suite.ctx.WithBlockTime(suite.blockTime.Add(11 * time.Minute))
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(failing, actiontypes.ActionStatePending)
+
+	suite.Require().NoError(suite.keeper.SetActionRefundAddress(suite.ctx, failing, suite.testAddr.String()))
+	suite.setupExpectationsGetTopSuperNodesForExpiration(1)
+	suite.ctx = suite.ctx.WithBlockTime(suite.blockTime.Add(22 * time.Minute))
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(failing, actiontypes.ActionStateExpired)
+
+	// The deferred entry was removed together with the expiration.
+	suite.ctx = suite.ctx.WithBlockTime(suite.blockTime.Add(time.Hour))
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(failing, actiontypes.ActionStateExpired)
+}
+
+// TestExpirationIndexFollowsStateTransitions ensures actions leaving the
+// expirable states, or moving their deadline, are not expired from stale entries.
+func (suite *ExpirationTestSuite) TestExpirationIndexFollowsStateTransitions() {
+	now := suite.blockTime.Unix()
+	done := suite.registerExpiringCascade(suite.ctx, "done", now-100)
+	extended := suite.registerExpiringCascade(suite.ctx, "extended", now-50)
+
+	stored, found := suite.keeper.GetActionByID(suite.ctx, done)
+	suite.Require().True(found)
+	stored.State = actiontypes.ActionStateDone
+	suite.Require().NoError(suite.keeper.SetAction(suite.ctx, stored))
+
+	stored, found = suite.keeper.GetActionByID(suite.ctx, extended)
+	suite.Require().True(found)
+	stored.ExpirationTime = now + 3600
+	suite.Require().NoError(suite.keeper.SetAction(suite.ctx, stored))
+
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(done, actiontypes.ActionStateDone)
+	suite.requireState(extended, actiontypes.ActionStatePending)
+
+	suite.setupExpectationsGetTopSuperNodesForExpiration(1)
+	suite.ctx = suite.ctx.WithBlockTime(suite.blockTime.Add(2 * time.Hour))
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(extended, actiontypes.ActionStateExpired)
+}
+
+// TestBackfillExpirationIndex ensures the v1->v2 backfill indexes open actions
+// whose index entries are missing.
+func (suite *ExpirationTestSuite) TestBackfillExpirationIndex() {
+	now := suite.blockTime.Unix()
+	pending := suite.registerExpiringCascade(suite.ctx, "pending", now-100)
+	suite.registerExpiringCascade(suite.ctx, "no-deadline", 0)
+
+	// Simulate pre-migration state by dropping the index entry.
+	suite.Require().NoError(keeper.DeleteExpirationIndexEntryForTest(suite.keeper, suite.ctx, now-100, pending))
+
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(pending, actiontypes.ActionStatePending)
+
+	indexed, err := suite.keeper.BackfillExpirationIndex(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, indexed)
+
+	suite.setupExpectationsGetTopSuperNodesForExpiration(1)
+	suite.keeper.CheckExpiration(suite.ctx)
+	suite.requireState(pending, actiontypes.ActionStateExpired)
+}
+
 // Run the test suite
 func TestExpirationTestSuite(t *testing.T) {
 	suite.Run(t, new(ExpirationTestSuite))
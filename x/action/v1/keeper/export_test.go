@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// DeleteExpirationIndexEntryForTest removes an action's expiration index entry
+// so external (keeper_test) test packages can simulate pre-migration state.
+var DeleteExpirationIndexEntryForTest = func(k Keeper, ctx sdk.Context, expirationTime int64, actionID string) error {
+	return k.storeService.OpenKVStore(ctx).Delete(expirationQueueKey(expirationTime, actionID))
+}
+
+// DeleteMetadataIndexEntryForTest removes a single metadata index entry so
+// external test packages can simulate pre-migration state.
+var DeleteMetadataIndexEntryForTest = func(k Keeper, ctx sdk.Context, actionType actiontypes.ActionType, field, value, actionID string) error {
+	return k.storeService.OpenKVStore(ctx).Delete(metadataIndexKey(actionType, field, value, actionID))
+}
+
+// SetRawRefundAddressForTest stores a refund address without validating it,
+// so external test packages can simulate a refund that always fails.
+var SetRawRefundAddressForTest = func(k Keeper, ctx sdk.Context, actionID, refundAddress string) error {
+	return k.storeService.OpenKVStore(ctx).Set([]byte(ActionRefundAddressPrefix+actionID), []byte(refundAddress))
+}
+
+// AggregateFreeStorageGbForTest exposes the free storage aggregate used by fee pricing.
+var AggregateFreeStorageGbForTest = func(k Keeper, ctx sdk.Context) uint64 {
+	return k.aggregateFreeStorageGb(ctx)
+}
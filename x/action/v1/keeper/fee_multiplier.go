@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	stdmath "math"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// maxFeePressure caps the congestion signal so that a single block moves the
+// multiplier by at most Params.FeeMultiplierMaxChange in either direction.
+var maxFeePressure = math.LegacyNewDec(2)
+
+// maxSupernodeFreeStorageGb caps the free disk space counted for a single
+// supernode (1 PiB), so one report cannot dominate the aggregate.
+const maxSupernodeFreeStorageGb uint64 = 1 << 20
+
+// GetFeeMultiplier returns the current congestion fee multiplier. Chains that
+// have not yet run an adjustment report the neutral multiplier of 1.
+func (k *Keeper) GetFeeMultiplier(ctx context.Context) math.LegacyDec {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get([]byte(FeeMultiplierKey))
+	if err != nil || bz == nil {
+		return math.LegacyOneDec()
+	}
+
+	multiplier, err := math.LegacyNewDecFromStr(string(bz))
+	if err != nil || multiplier.LT(math.LegacyOneDec()) {
+		k.Logger().Error("invalid stored fee multiplier, using 1", "value", string(bz))
+		return math.LegacyOneDec()
+	}
+
+	return multiplier
+}
+
+// SetFeeMultiplier stores the congestion fee multiplier.
+func (k *Keeper) SetFeeMultiplier(ctx context.Context, multiplier math.LegacyDec) error {
+	if multiplier.LT(math.LegacyOneDec()) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "fee multiplier must be at least 1, got %s", multiplier)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set([]byte(FeeMultiplierKey), []byte(multiplier.String()))
+}
+
+// ApplyFeeMultiplier scales an amount from the static fee schedule by the
+// current fee multiplier, rounding up so quotes are never below the minimum.
+func (k *Keeper) ApplyFeeMultiplier(ctx context.Context, amount math.Int) math.Int {
+	multiplier := k.GetFeeMultiplier(ctx)
+	if multiplier.Equal(math.LegacyOneDec()) {
+		return amount
+	}
+	return amount.ToLegacyDec().Mul(multiplier).Ceil().TruncateInt()
+}
+
+// UpdateFeeMultiplier moves the fee multiplier towards current demand, in the
+// style of EIP-1559. The congestion pressure is the larger of
+//   - pending actions relative to Params.TargetPendingActions, and
+//   - Params.MinFreeStorageGb relative to the free storage reported by active
+//     supernodes, sampled once per audit epoch (only when MinFreeStorageGb is
+//     set),
+//
+// capped at 2. A pressure of 1 keeps the multiplier; above or below it the
+// multiplier changes by up to Params.FeeMultiplierMaxChange per block. The
+// result is bounded to [1, Params.MaxFeeMultiplier].
+func (k *Keeper) UpdateFeeMultiplier(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	maxChange, err := math.LegacyNewDecFromStr(params.FeeMultiplierMaxChange)
+	if err != nil {
+		return errors.Wrapf(actiontypes.ErrInternalError, "invalid fee multiplier max change: %s", err)
+	}
+	maxMultiplier, err := math.LegacyNewDecFromStr(params.MaxFeeMultiplier)
+	if err != nil {
+		return errors.Wrapf(actiontypes.ErrInternalError, "invalid max fee multiplier: %s", err)
+	}
+
+	pressure := math.LegacyZeroDec()
+	var pending uint64
+	if params.TargetPendingActions > 0 {
+		// Counting beyond twice the target cannot change the capped pressure.
+		pending, err = k.countPendingActions(ctx, 2*params.TargetPendingActions)
+		if err != nil {
+			return err
+		}
+		pressure = math.LegacyNewDecFromInt(math.NewIntFromUint64(pending)).
+			Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetPendingActions)))
+	}
+	if params.MinFreeStorageGb > 0 {
+		storagePressure := maxFeePressure
+		if freeGb := k.epochFreeStorageGb(ctx); freeGb > 0 {
+			storagePressure = math.LegacyNewDecFromInt(math.NewIntFromUint64(params.MinFreeStorageGb)).
+				Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(freeGb)))
+		}
+		pressure = math.LegacyMaxDec(pressure, storagePressure)
+	}
+	pressure = math.LegacyMinDec(pressure, maxFeePressure)
+
+	current := k.GetFeeMultiplier(ctx)
+	factor := math.LegacyOneDec().Add(maxChange.Mul(pressure.Sub(math.LegacyOneDec())))
+	next := current.Mul(factor)
+	next = math.LegacyMaxDec(next, math.LegacyOneDec())
+	next = math.LegacyMinDec(next, maxMultiplier)
+
+	if next.Equal(current) {
+		return nil
+	}
+	if err := k.SetFeeMultiplier(ctx, next); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			actiontypes.EventTypeFeeMultiplierUpdated,
+			sdk.NewAttribute(actiontypes.AttributeKeyFeeMultiplier, next.String()),
+			sdk.NewAttribute(actiontypes.AttributeKeyPendingActions, strconv.FormatUint(pending, 10)),
+		),
+	)
+
+	return nil
+}
+
+// countPendingActions counts PENDING actions through the state index, stopping
+// once limit entries have been seen.
+func (k *Keeper) countPendingActions(ctx sdk.Context, limit uint64) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	statePrefix := []byte(ActionByStatePrefix + actiontypes.ActionStatePending.String() + "/")
+	iter, err := store.Iterator(statePrefix, storetypes.PrefixEndBytes(statePrefix))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create iterator for pending actions")
+	}
+	defer func() { _ = iter.Close() }()
+
+	var count uint64
+	for ; iter.Valid() && count < limit; iter.Next() {
+		count++
+	}
+
+	return count, nil
+}
+
+// epochFreeStorageGb returns the aggregate free supernode storage sampled at
+// the first fee update of the current audit epoch. Supernodes report metrics
+// once per epoch, so re-reading every supernode each block gains nothing.
+// Without an epoch source the aggregate is computed directly.
+func (k *Keeper) epochFreeStorageGb(ctx sdk.Context) uint64 {
+	if k.auditKeeper == nil {
+		return k.aggregateFreeStorageGb(ctx)
+	}
+	epochID, _, _, err := k.auditKeeper.GetCurrentEpochInfo(ctx)
+	if err != nil {
+		return k.aggregateFreeStorageGb(ctx)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(FreeStorageKey))
+	if err == nil && len(bz) == 16 && binary.BigEndian.Uint64(bz[:8]) == epochID {
+		return binary.BigEndian.Uint64(bz[8:])
+	}
+
+	total := k.aggregateFreeStorageGb(ctx)
+	bz = binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, epochID), total)
+	if err := store.Set([]byte(FreeStorageKey), bz); err != nil {
+		k.Logger().Error("failed to cache free supernode storage", "epoch_id", epochID, "error", err)
+	}
+	return total
+}
+
+// aggregateFreeStorageGb sums the free disk space, in whole GB, last reported
+// by every active supernode.
+func (k *Keeper) aggregateFreeStorageGb(ctx sdk.Context) uint64 {
+	supernodes, err := k.supernodeKeeper.GetAllSuperNodes(ctx, sntypes.SuperNodeStateActive)
+	if err != nil {
+		k.Logger().Error("failed to list active supernodes for fee pricing", "error", err)
+		return 0
+	}
+
+	var total uint64
+	for _, sn := range supernodes {
+		valAddr, err := sdk.ValAddressFromBech32(sn.ValidatorAddress)
+		if err != nil {
+			continue
+		}
+		state, found := k.supernodeKeeper.GetMetricsState(ctx, valAddr)
+		if !found || state.Metrics == nil {
+			continue
+		}
+		freeGb := clampFreeStorageGb(state.Metrics.DiskFreeGb)
+		if total > stdmath.MaxUint64-freeGb {
+			return stdmath.MaxUint64
+		}
+		total += freeGb
+	}
+
+	return total
+}
+
+// clampFreeStorageGb converts a reported free disk size to whole GB, mapping
+// NaN and non-positive values to 0 and capping at maxSupernodeFreeStorageGb.
+// The cap is applied before the conversion because converting an
+// out-of-range float to uint64 is platform dependent.
+func clampFreeStorageGb(f float64) uint64 {
+	if stdmath.IsNaN(f) || !(f > 0) {
+		return 0
+	}
+	if f >= float64(maxSupernodeFreeStorageGb) {
+		return maxSupernodeFreeStorageGb
+	}
+	return uint64(f)
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"fmt"
+	stdmath "math"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+
+	keepertest "github.com/LumeraProtocol/lumera/testutil/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func (suite *KeeperTestSuite) setPendingActions(n int) {
+	for i := 0; i < n; i++ {
+		suite.Require().NoError(suite.keeper.SetAction(suite.ctx, &actiontypes.Action{
+			ActionID:   fmt.Sprintf("pending-%d", i),
+			State:      actiontypes.ActionStatePending,
+			Creator:    suite.creatorAddress.String(),
+			ActionType: actiontypes.ActionTypeSense,
+		}))
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeMultiplier_FollowsPendingActions() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.TargetPendingActions = 4
+	params.FeeMultiplierMaxChange = "0.125"
+	params.MaxFeeMultiplier = "1.2"
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// No demand: the multiplier never drops below 1.
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	suite.Equal(math.LegacyOneDec(), suite.keeper.GetFeeMultiplier(suite.ctx))
+
+	// Pending at the target keeps the multiplier.
+	suite.setPendingActions(4)
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	suite.Equal(math.LegacyOneDec(), suite.keeper.GetFeeMultiplier(suite.ctx))
+
+	// Far above the target the per-block change is bounded by FeeMultiplierMaxChange.
+	suite.setPendingActions(40)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	suite.Equal(math.LegacyMustNewDecFromStr("1.125"), suite.keeper.GetFeeMultiplier(suite.ctx))
+
+	foundEvent := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != actiontypes.EventTypeFeeMultiplierUpdated {
+			continue
+		}
+		foundEvent = true
+		for _, attr := range event.Attributes {
+			if attr.Key == actiontypes.AttributeKeyPendingActions {
+				suite.Equal("8", attr.Value) // counting stops at twice the target
+			}
+		}
+	}
+	suite.True(foundEvent, "fee multiplier event not found")
+
+	// The multiplier is capped at MaxFeeMultiplier.
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	suite.Equal(math.LegacyMustNewDecFromStr("1.2"), suite.keeper.GetFeeMultiplier(suite.ctx))
+
+	// Prices are quoted against the multiplier, rounding up.
+	suite.Equal(math.NewInt(12), suite.keeper.ApplyFeeMultiplier(suite.ctx, math.NewInt(10)))
+	suite.Equal(math.NewInt(2), suite.keeper.ApplyFeeMultiplier(suite.ctx, math.NewInt(1)))
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeMultiplier_DecaysWithoutDemand() {
+	suite.Require().NoError(suite.keeper.SetFeeMultiplier(suite.ctx, math.LegacyMustNewDecFromStr("2")))
+
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	suite.Equal(math.LegacyMustNewDecFromStr("1.75"), suite.keeper.GetFeeMultiplier(suite.ctx))
+
+	for i := 0; i < 10; i++ {
+		suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	}
+	suite.Equal(math.LegacyOneDec(), suite.keeper.GetFeeMultiplier(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeMultiplier_StoragePressure() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MinFreeStorageGb = 300
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	valAddr := sdk.ValAddress(suite.creatorAddress)
+	suite.mockKeeper.EXPECT().
+		GetAllSuperNodes(gomock.Any(), sntypes.SuperNodeStateActive).
+		Return([]sntypes.SuperNode{{ValidatorAddress: valAddr.String()}}, nil).
+		Times(1)
+	suite.mockKeeper.EXPECT().
+		GetMetricsState(gomock.Any(), valAddr).
+		Return(sntypes.SupernodeMetricsState{
+			ValidatorAddress: valAddr.String(),
+			Metrics:          &sntypes.SupernodeMetrics{DiskFreeGb: 200.7},
+		}, true).
+		Times(1)
+
+	// 300 GB wanted, 200 GB free: pressure 1.5 raises the multiplier by half the max change.
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+	suite.Equal(math.LegacyMustNewDecFromStr("1.0625"), suite.keeper.GetFeeMultiplier(suite.ctx))
+
+	// Later blocks of the same epoch reuse the sampled aggregate.
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+
+	// A new epoch samples the supernodes again.
+	auditKeeper := suite.keeper.GetAuditKeeper().(*keepertest.MockAuditKeeper)
+	auditKeeper.EpochID++
+	suite.mockKeeper.EXPECT().
+		GetAllSuperNodes(gomock.Any(), sntypes.SuperNodeStateActive).
+		Return(nil, nil).
+		Times(1)
+	suite.Require().NoError(suite.keeper.UpdateFeeMultiplier(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestAggregateFreeStorageGb_ClampsReports() {
+	reports := []float64{1e30, stdmath.Inf(1), stdmath.MaxFloat64, stdmath.NaN(), -5, 100.9}
+	supernodes := make([]sntypes.SuperNode, len(reports))
+	for i, freeGb := range reports {
+		valAddr := sdk.ValAddress(fmt.Sprintf("free-storage-val-%02d", i))
+		supernodes[i] = sntypes.SuperNode{ValidatorAddress: valAddr.String()}
+		suite.mockKeeper.EXPECT().
+			GetMetricsState(gomock.Any(), valAddr).
+			Return(sntypes.SupernodeMetricsState{
+				ValidatorAddress: valAddr.String(),
+				Metrics:          &sntypes.SupernodeMetrics{DiskFreeGb: freeGb},
+			}, true).
+			Times(1)
+	}
+	suite.mockKeeper.EXPECT().
+		GetAllSuperNodes(gomock.Any(), sntypes.SuperNodeStateActive).
+		Return(supernodes, nil).
+		Times(1)
+
+	// Each out-of-range report counts as the 1 PiB cap; NaN and negatives count as 0.
+	suite.Equal(uint64(3*(1<<20)+100), keeper.AggregateFreeStorageGbForTest(suite.keeper, suite.ctx))
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// requiresFinalizationQuorum reports whether an action was requested with more
+// than one independent finalization.
+func requiresFinalizationQuorum(action *actiontypes.Action) bool {
+	return action.FinalizationRedundancy > 1
+}
+
+// finalizationQuorum returns the number of matching results needed to finalize
+// an action: a strict majority of the requested finalizations.
+func finalizationQuorum(redundancy uint32) int {
+	return int(redundancy)/2 + 1
+}
+
+// finalizationResultHash hashes the protobuf-encoded finalization metadata
+// submitted by a supernode. Finalizers that computed the same result submit
+// byte-identical metadata and therefore produce the same hash.
+func finalizationResultHash(metadata []byte) []byte {
+	sum := sha256.Sum256(metadata)
+	return sum[:]
+}
+
+// hasSubmittedFinalization reports whether a supernode already submitted a
+// result for a multi-supernode action.
+func hasSubmittedFinalization(action *actiontypes.Action, superNodeAccount string) bool {
+	return slices.ContainsFunc(action.FinalizationSubmissions, func(s actiontypes.FinalizationSubmission) bool {
+		return s.Supernode == superNodeAccount
+	})
+}
+
+// submitFinalizationResult records a supernode's result for a multi-supernode
+// action and moves it to PROCESSING. Once a quorum of matching results is
+// reached the action moves to DONE with the agreed metadata, the agreeing
+// finalizers become its SuperNodes (and so share the fee), and every finalizer
+// that submitted a different result gets mismatch evidence. When the last
+// accepted submission fills FinalizationRedundancy without any result reaching
+// the quorum, the action is resolved with failFinalizationWithoutQuorum.
+func (k *Keeper) submitFinalizationResult(
+	ctx sdk.Context,
+	action *actiontypes.Action,
+	superNodeAccount string,
+	resultHash []byte,
+	updatedMetadata []byte,
+	top10ValidatorAddresses []string,
+) error {
+	matching := 1
+	for _, prev := range action.FinalizationSubmissions {
+		if bytes.Equal(prev.ResultHash, resultHash) {
+			matching++
+		}
+	}
+
+	submission := actiontypes.FinalizationSubmission{
+		Supernode:  superNodeAccount,
+		ResultHash: resultHash,
+	}
+	if matching == 1 {
+		// Only the first submission of a result keeps the metadata it produces.
+		submission.Metadata = updatedMetadata
+	}
+	action.FinalizationSubmissions = append(action.FinalizationSubmissions, submission)
+	action.State = actiontypes.ActionStateProcessing
+
+	quorum := finalizationQuorum(action.FinalizationRedundancy)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			actiontypes.EventTypeActionFinalizationAccepted,
+			sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
+			sdk.NewAttribute(actiontypes.AttributeKeyFinalizer, superNodeAccount),
+			sdk.NewAttribute(actiontypes.AttributeKeyResultHash, hex.EncodeToString(resultHash)),
+			sdk.NewAttribute(actiontypes.AttributeKeyMatchingResults, strconv.Itoa(matching)),
+			sdk.NewAttribute(actiontypes.AttributeKeyQuorum, strconv.Itoa(quorum)),
+		),
+	)
+
+	if matching < quorum {
+		if len(action.FinalizationSubmissions) < int(action.FinalizationRedundancy) {
+			return nil
+		}
+		return k.failFinalizationWithoutQuorum(ctx, action)
+	}
+
+	var (
+		agreeing []string
+		metadata []byte
+		found    bool
+	)
+	for i := range action.FinalizationSubmissions {
+		s := &action.FinalizationSubmissions[i]
+		if bytes.Equal(s.ResultHash, resultHash) {
+			if !found {
+				metadata, found = s.Metadata, true
+			}
+			agreeing = append(agreeing, s.Supernode)
+		} else {
+			k.RecordFinalizationResultMismatch(ctx, action, s.Supernode, top10ValidatorAddresses, resultHash, s.ResultHash)
+		}
+		s.Metadata = nil
+	}
+
+	action.State = actiontypes.ActionStateDone
+	action.Metadata = metadata
+	action.SuperNodes = append(action.SuperNodes, agreeing...)
+
+	k.Logger().Info("Finalization quorum reached",
+		"action_id", action.ActionID,
+		"quorum", quorum,
+		"finalizers", len(agreeing),
+		"submissions", len(action.FinalizationSubmissions),
+	)
+
+	return nil
+}
+
+// failFinalizationWithoutQuorum resolves a multi-supernode action whose
+// FinalizationRedundancy submissions are all in and disagree: no further result
+// can be accepted, so the action moves to FAILED and its fee is refunded to the
+// creator (or its refund address). No finalizer is paid and no mismatch
+// evidence is recorded, since there is no agreed result to compare against.
+func (k *Keeper) failFinalizationWithoutQuorum(ctx sdk.Context, action *actiontypes.Action) error {
+	refund, err := sdk.ParseCoinNormalized(action.Price)
+	if err != nil {
+		return errors.Wrapf(actiontypes.ErrInvalidPrice, "action %s has invalid price %q: %s", action.ActionID, action.Price, err)
+	}
+
+	if refund.IsPositive() {
+		recipient, err := k.refundRecipient(ctx, action)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, actiontypes.ModuleName, recipient, sdk.NewCoins(refund)); err != nil {
+			return errors.Wrap(err, "failed to refund action without finalization quorum")
+		}
+	}
+
+	for i := range action.FinalizationSubmissions {
+		action.FinalizationSubmissions[i].Metadata = nil
+	}
+	action.State = actiontypes.ActionStateFailed
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			actiontypes.EventTypeActionFailed,
+			sdk.NewAttribute(actiontypes.AttributeKeyActionID, action.ActionID),
+			sdk.NewAttribute(actiontypes.AttributeKeyCreator, action.Creator),
+			sdk.NewAttribute(actiontypes.AttributeKeyActionType, actiontypes.ActionTypeName(action.ActionType)),
+			sdk.NewAttribute(actiontypes.AttributeKeyError, "no finalization quorum"),
+			sdk.NewAttribute(actiontypes.AttributeKeyRefund, refund.String()),
+		),
+	)
+
+	k.Logger().Info("Finalization quorum not reached",
+		"action_id", action.ActionID,
+		"quorum", finalizationQuorum(action.FinalizationRedundancy),
+		"submissions", len(action.FinalizationSubmissions),
+	)
+
+	return nil
+}
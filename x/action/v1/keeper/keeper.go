@@ -37,9 +37,11 @@ type (
 		auditKeeper              actiontypes.AuditKeeper
 		ibcKeeperFn              func() *ibckeeper.Keeper
 		rewardDistributionKeeper actiontypes.RewardDistributionKeeper
+		lumeraidKeeper           actiontypes.LumeraIDKeeper
 
 		// Action handling
 		actionRegistry *ActionRegistry
+		hooks          *actionHooks
 	}
 )
 
@@ -59,6 +61,7 @@ func NewKeeper(
 	auditKeeper actiontypes.AuditKeeper,
 	ibcKeeperFn func() *ibckeeper.Keeper,
 	rewardDistributionKeeper actiontypes.RewardDistributionKeeper,
+	lumeraidKeeper actiontypes.LumeraIDKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -88,6 +91,8 @@ func NewKeeper(
 		auditKeeper:              auditKeeper,
 		ibcKeeperFn:              ibcKeeperFn,
 		rewardDistributionKeeper: rewardDistributionKeeper,
+		lumeraidKeeper:           lumeraidKeeper,
+		hooks:                    &actionHooks{},
 
 		Port: collections.NewItem(sb, actiontypes.PortKey, "port", collections.StringValue),
 	}
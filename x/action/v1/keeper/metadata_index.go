@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// metadataIndexSeparator terminates the value component of a metadata index
+// key so that exact lookups do not match longer values sharing a prefix.
+const metadataIndexSeparator = byte(0)
+
+// metadataIndexFieldPrefix returns the index prefix for one searchable field.
+// The key format is ActionByMetadataPrefix + type + "/" + field + "/" + value + 0x00 + actionID.
+func metadataIndexFieldPrefix(actionType actiontypes.ActionType, field string) []byte {
+	return []byte(ActionByMetadataPrefix + actionType.String() + "/" + field + "/")
+}
+
+// metadataIndexValuePrefix returns the prefix that matches every action whose
+// field equals value exactly.
+func metadataIndexValuePrefix(actionType actiontypes.ActionType, field, value string) []byte {
+	key := metadataIndexFieldPrefix(actionType, field)
+	key = append(key, value...)
+	return append(key, metadataIndexSeparator)
+}
+
+// metadataIndexKey builds the metadata index key for a single field value of an action.
+func metadataIndexKey(actionType actiontypes.ActionType, field, value, actionID string) []byte {
+	return append(metadataIndexValuePrefix(actionType, field, value), actionID...)
+}
+
+// actionIDFromMetadataIndexRemainder extracts the action ID from an index key
+// with the field prefix stripped (value + 0x00 + actionID).
+func actionIDFromMetadataIndexRemainder(rest []byte) (string, bool) {
+	i := bytes.LastIndexByte(rest, metadataIndexSeparator)
+	if i < 0 {
+		return "", false
+	}
+	return string(rest[i+1:]), true
+}
+
+// isIndexableMetadataValue reports whether a field value can be stored in the index.
+func isIndexableMetadataValue(value string) bool {
+	return value != "" && strings.IndexByte(value, metadataIndexSeparator) < 0
+}
+
+// sortedFieldNames returns the keys of fields in sorted order so index writes
+// are deterministic.
+func sortedFieldNames(fields map[string]string) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// searchableHandler returns the search hook of the handler for actionType, if any.
+func (k *Keeper) searchableHandler(actionType actiontypes.ActionType) (SearchableMetadataHandler, bool) {
+	if k.actionRegistry == nil {
+		return nil, false
+	}
+	handler, err := k.actionRegistry.GetHandler(actionType)
+	if err != nil {
+		return nil, false
+	}
+	searchable, ok := handler.(SearchableMetadataHandler)
+	return searchable, ok
+}
+
+// indexedMetadataFields returns the indexable searchable fields of an action.
+// Actions whose type declares no searchable fields, or whose metadata cannot
+// be decoded, are not indexed.
+func (k *Keeper) indexedMetadataFields(action *actiontypes.Action) map[string]string {
+	if action == nil || len(action.Metadata) == 0 {
+		return nil
+	}
+	searchable, ok := k.searchableHandler(action.ActionType)
+	if !ok {
+		return nil
+	}
+	fields, err := searchable.SearchableFields(action.Metadata)
+	if err != nil {
+		k.Logger().Error("Failed to extract searchable metadata fields",
+			"action_id", action.ActionID,
+			"action_type", actiontypes.ActionTypeName(action.ActionType),
+			"error", err.Error(),
+		)
+		return nil
+	}
+	for field, value := range fields {
+		if !isIndexableMetadataValue(value) {
+			delete(fields, field)
+		}
+	}
+	return fields
+}
+
+// updateMetadataIndex keeps the metadata index in sync with an action write.
+// existing is the previously stored version of the action, or nil for new actions.
+func (k *Keeper) updateMetadataIndex(store corestore.KVStore, existing, action *actiontypes.Action) error {
+	newFields := k.indexedMetadataFields(action)
+
+	if existing != nil {
+		oldFields := k.indexedMetadataFields(existing)
+		for _, field := range sortedFieldNames(oldFields) {
+			value := oldFields[field]
+			if existing.ActionType == action.ActionType && newFields[field] == value {
+				continue
+			}
+			if err := store.Delete(metadataIndexKey(existing.ActionType, field, value, existing.ActionID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, field := range sortedFieldNames(newFields) {
+		value := newFields[field]
+		if err := store.Set(metadataIndexKey(action.ActionType, field, value, action.ActionID), []byte{1}); err != nil { // Just a marker
+			return err
+		}
+	}
+	return nil
+}
+
+// BackfillMetadataIndex writes metadata index entries for every stored action.
+// It is used by the v2->v3 store migration.
+func (k *Keeper) BackfillMetadataIndex(ctx sdk.Context) (int, error) {
+	var actions []*actiontypes.Action
+	if err := k.IterateActions(ctx, func(action *actiontypes.Action) bool {
+		actions = append(actions, action)
+		return false
+	}); err != nil {
+		return 0, err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	indexed := 0
+	for _, action := range actions {
+		fields := k.indexedMetadataFields(action)
+		for _, field := range sortedFieldNames(fields) {
+			value := fields[field]
+			if err := store.Set(metadataIndexKey(action.ActionType, field, value, action.ActionID), []byte{1}); err != nil {
+				return indexed, errors.Wrapf(err, "failed to index metadata for action %s", action.ActionID)
+			}
+		}
+		if len(fields) > 0 {
+			indexed++
+		}
+	}
+	return indexed, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/LumeraProtocol/lumera/testutil/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+func setCascadeAction(t *testing.T, k keeper.Keeper, ctx sdk.Context, id, fileName, dataHash string) *types.Action {
+	t.Helper()
+	metadata, err := gogoproto.Marshal(&types.CascadeMetadata{FileName: fileName, DataHash: dataHash})
+	require.NoError(t, err)
+	action := &types.Action{
+		Creator:    "creator1",
+		ActionID:   id,
+		ActionType: types.ActionTypeCascade,
+		Metadata:   metadata,
+		Price:      "100ulume",
+		State:      types.ActionStateApproved,
+	}
+	require.NoError(t, k.SetAction(ctx, action))
+	return action
+}
+
+func queryActionIDs(t *testing.T, q types.QueryServer, ctx sdk.Context, req *types.QueryActionByMetadataRequest) []string {
+	t.Helper()
+	req.ActionType = types.ActionTypeCascade
+	resp, err := q.QueryActionByMetadata(ctx, req)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(resp.Actions))
+	for _, a := range resp.Actions {
+		ids = append(ids, a.ActionID)
+	}
+	return ids
+}
+
+func TestQueryActionByMetadataIndexedPredicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, ctx := keepertest.ActionKeeper(t, ctrl)
+	q := keeper.NewQueryServerImpl(k)
+
+	setCascadeAction(t, k, ctx, "1", "scan_001.png", "hash-a")
+	setCascadeAction(t, k, ctx, "2", "scan_002.png", "hash-b")
+	setCascadeAction(t, k, ctx, "3", "photo.png", "hash-a")
+	setCascadeAction(t, k, ctx, "4", "scan", "hash-a")
+
+	// Exact match.
+	require.Equal(t, []string{"1", "3", "4"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "data_hash=hash-a"}))
+	// Exact matches do not leak into longer values sharing a prefix.
+	require.Equal(t, []string{"4"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "file_name=scan"}))
+	// Prefix match, ordered by value.
+	require.Equal(t, []string{"4", "1", "2"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "file_name^=scan"}))
+	// AND-ed predicates from the query string and the structured list.
+	require.Equal(t, []string{"1", "4"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{
+		MetadataQuery: "file_name^=scan",
+		Predicates:    []*types.MetadataPredicate{{Field: "data_hash", Value: "hash-a"}},
+	}))
+	require.Equal(t, []string{"1"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "file_name^=scan_&data_hash=hash-a"}))
+
+	// Pagination over the driving index.
+	resp, err := q.QueryActionByMetadata(ctx, &types.QueryActionByMetadataRequest{
+		ActionType:    types.ActionTypeCascade,
+		MetadataQuery: "data_hash=hash-a",
+		Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Actions, 2)
+	require.Equal(t, uint64(3), resp.Total)
+	require.NotEmpty(t, resp.Pagination.NextKey)
+	require.Equal(t, []string{"4"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{
+		MetadataQuery: "data_hash=hash-a",
+		Pagination:    &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	}))
+}
+
+func TestQueryActionByMetadataRejectsUnknownFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, ctx := keepertest.ActionKeeper(t, ctrl)
+	q := keeper.NewQueryServerImpl(k)
+
+	for _, metadataQuery := range []string{"collection_id=c1", "file_name", "=value", "^=value"} {
+		_, err := q.QueryActionByMetadata(ctx, &types.QueryActionByMetadataRequest{
+			ActionType:    types.ActionTypeCascade,
+			MetadataQuery: metadataQuery,
+		})
+		require.Error(t, err, metadataQuery)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), metadataQuery)
+	}
+}
+
+func TestMetadataIndexFollowsMetadataUpdates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, ctx := keepertest.ActionKeeper(t, ctrl)
+	q := keeper.NewQueryServerImpl(k)
+
+	action := setCascadeAction(t, k, ctx, "1", "old.bin", "hash-a")
+
+	updated, err := gogoproto.Marshal(&types.CascadeMetadata{FileName: "new.bin", DataHash: "hash-a"})
+	require.NoError(t, err)
+	action.Metadata = updated
+	require.NoError(t, k.SetAction(ctx, action))
+
+	require.Empty(t, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "file_name=old.bin"}))
+	require.Equal(t, []string{"1"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "file_name=new.bin"}))
+	require.Equal(t, []string{"1"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "data_hash=hash-a"}))
+}
+
+func TestBackfillMetadataIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, ctx := keepertest.ActionKeeper(t, ctrl)
+	q := keeper.NewQueryServerImpl(k)
+
+	setCascadeAction(t, k, ctx, "1", "a.bin", "hash-a")
+	setCascadeAction(t, k, ctx, "2", "b.bin", "hash-b")
+
+	// Simulate actions written before the metadata index existed.
+	for _, entry := range [][3]string{{"file_name", "a.bin", "1"}, {"data_hash", "hash-a", "1"}, {"file_name", "b.bin", "2"}, {"data_hash", "hash-b", "2"}} {
+		require.NoError(t, keeper.DeleteMetadataIndexEntryForTest(k, ctx, types.ActionTypeCascade, entry[0], entry[1], entry[2]))
+	}
+	require.Empty(t, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "data_hash=hash-a"}))
+
+	indexed, err := k.BackfillMetadataIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, indexed)
+
+	require.Equal(t, []string{"1"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "data_hash=hash-a"}))
+	require.Equal(t, []string{"2"}, queryActionIDs(t, q, ctx, &types.QueryActionByMetadataRequest{MetadataQuery: "file_name^=b"}))
+}
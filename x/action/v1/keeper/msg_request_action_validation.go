@@ -11,13 +11,32 @@ import (
 
 var creatorAccountCtxKey = struct{}{}
 
+type remoteCreatorCtxKeyType struct{}
+
+var remoteCreatorCtxKey = remoteCreatorCtxKeyType{}
+
+// WithRemoteCreator marks ctx so that RequestAction treats the creator as a
+// keyless account controlled from another chain (e.g. an IBC-hooks
+// intermediary). Like interchain accounts, such creators must supply an
+// app_pubkey that metadata signatures are verified against.
+func WithRemoteCreator(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(remoteCreatorCtxKey, true)
+}
+
 type creatorAccountInfo struct {
 	account   sdk.AccountI
 	isICA     bool
+	isRemote  bool   // keyless creator marked via WithRemoteCreator
 	owner     string // ICA account_owner; empty otherwise
 	appPubkey []byte
 }
 
+// requiresAppPubkey reports whether metadata signatures must be checked
+// against the message app_pubkey instead of the account pubkey.
+func (info *creatorAccountInfo) requiresAppPubkey() bool {
+	return info.isICA || info.isRemote
+}
+
 func (k Keeper) getCreatorAccountInfo(ctx context.Context, msg *types.MsgRequestAction) (*creatorAccountInfo, error) {
 	creatorAddrBz, err := k.addressCodec.StringToBytes(msg.Creator)
 	if err != nil {
@@ -30,9 +49,11 @@ func (k Keeper) getCreatorAccountInfo(ctx context.Context, msg *types.MsgRequest
 	}
 
 	ica, creatorIsICA := creatorAcct.(*icatypes.InterchainAccount)
+	isRemote, _ := ctx.Value(remoteCreatorCtxKey).(bool)
 	info := &creatorAccountInfo{
 		account:   creatorAcct,
 		isICA:     creatorIsICA,
+		isRemote:  isRemote,
 		appPubkey: msg.AppPubkey,
 	}
 	if creatorIsICA {
@@ -43,11 +64,14 @@ func (k Keeper) getCreatorAccountInfo(ctx context.Context, msg *types.MsgRequest
 }
 
 func (info *creatorAccountInfo) validateAppPubKey() error {
-	// ICA accounts have no auth module pubkey and can't sign metadata using the account key.
-	// When the creator is an ICA account, callers must provide an application-level pubkey.
-	// For non-ICA creators, app_pubkey must be empty to avoid ambiguity about the signing scheme.
-	if info.isICA {
+	// ICA and remote (IBC-hooks) accounts have no auth module pubkey and can't sign metadata
+	// using the account key. For those creators, callers must provide an application-level pubkey.
+	// For all other creators, app_pubkey must be empty to avoid ambiguity about the signing scheme.
+	if info.requiresAppPubkey() {
 		if len(info.appPubkey) == 0 {
+			if info.isRemote {
+				return errorsmod.Wrap(types.ErrInvalidAppPubKey, "app_pubkey is required for remote creators")
+			}
 			return errorsmod.Wrap(types.ErrInvalidAppPubKey, "app_pubkey is required for interchain account creators")
 		}
 	} else {
@@ -101,3 +101,30 @@ func TestValidateRequestActionAppPubKey_ICAWithPubKeyAllowed(t *testing.T) {
 	err = info.validateAppPubKey()
 	require.NoError(t, err)
 }
+
+func TestValidateRequestActionAppPubKey_RemoteCreatorRequiresNonEmpty(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("lumera")
+	ak := newAuthKeeperStub(ac)
+
+	creatorAddr := sdk.AccAddress([]byte("creator_address_12345"))
+	creator, err := ac.BytesToString(creatorAddr)
+	require.NoError(t, err)
+	ak.SetAccount(context.Background(), authtypes.NewBaseAccountWithAddress(creatorAddr))
+
+	k := Keeper{addressCodec: ac, authKeeper: ak}
+	ctx := WithRemoteCreator(sdk.Context{}.WithContext(context.Background()))
+
+	info, err := k.getCreatorAccountInfo(ctx, &actiontypes.MsgRequestAction{Creator: creator})
+	require.NoError(t, err)
+	require.True(t, info.requiresAppPubkey())
+
+	err = info.validateAppPubKey()
+	require.ErrorIs(t, err, actiontypes.ErrInvalidAppPubKey)
+
+	info, err = k.getCreatorAccountInfo(ctx, &actiontypes.MsgRequestAction{
+		Creator:   creator,
+		AppPubkey: []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+	require.NoError(t, info.validateAppPubKey())
+}
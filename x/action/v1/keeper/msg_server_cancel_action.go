@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/LumeraProtocol/lumera/x/action/v1/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CancelAction is the message handler for MsgCancelAction
+// This handles the RPC call and delegates to the keeper method
+func (k msgServer) CancelAction(goCtx context.Context, msg *types.MsgCancelAction) (*types.MsgCancelActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	refund, fee, err := k.Keeper.CancelAction(ctx, msg.ActionId, msg.Creator)
+	if err != nil {
+		// Wrap with appropriate error type if not already wrapped
+		if !errorsmod.IsOf(err, types.ErrActionNotFound, types.ErrInvalidActionState,
+			types.ErrUnauthorizedCreator, types.ErrInvalidPrice) {
+			err = errorsmod.Wrap(types.ErrInternalError, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.MsgCancelActionResponse{
+		ActionId: msg.ActionId,
+		Status:   types.ActionStateCancelled.String(),
+		Refund:   refund.String(),
+		Fee:      fee.String(),
+	}, nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	keepertest "github.com/LumeraProtocol/lumera/testutil/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/types"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *MsgServerTestSuite) TestMsgCancelActionRefundsPriceMinusFee() {
+	bankKeeper, ok := suite.keeper.GetBankKeeper().(*keepertest.ActionBankKeeper)
+	suite.Require().True(ok)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CancellationFee = sdk.NewInt64Coin("ulume", 2500)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	actionID := suite.registerCascadeAction()
+	balanceBefore := bankKeeper.GetAccountCoins(suite.creatorAddress)
+	moduleBefore := bankKeeper.GetModuleBalance(actiontypes.ModuleName)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := suite.msgServer.CancelAction(suite.ctx, types.NewMsgCancelAction(suite.creatorAddress.String(), actionID))
+	suite.Require().NoError(err)
+	suite.Equal(actionID, res.ActionId)
+	suite.Equal(actiontypes.ActionStateCancelled.String(), res.Status)
+	suite.Equal("97500ulume", res.Refund)
+	suite.Equal("2500ulume", res.Fee)
+
+	action, found := suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStateCancelled, action.State)
+
+	refund := sdk.NewInt64Coin("ulume", 97500)
+	suite.Equal(balanceBefore.Add(refund), bankKeeper.GetAccountCoins(suite.creatorAddress))
+	suite.Equal(moduleBefore.Sub(refund), bankKeeper.GetModuleBalance(actiontypes.ModuleName))
+
+	foundCancelEvent := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeActionCancelled {
+			continue
+		}
+		foundCancelEvent = true
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeyRefund:
+				suite.Equal("97500ulume", attr.Value)
+			case types.AttributeKeyFee:
+				suite.Equal("2500ulume", attr.Value)
+			}
+		}
+	}
+	suite.True(foundCancelEvent, "action_cancelled event not found")
+
+	// A cancelled action is no longer expirable.
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(params.ExpirationDuration * 2))
+	suite.keeper.CheckExpiration(suite.ctx)
+	action, found = suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStateCancelled, action.State)
+}
+
+func (suite *MsgServerTestSuite) TestMsgCancelActionFeeCappedAtPrice() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CancellationFee = sdk.NewInt64Coin("ulume", 1_000_000)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	actionID := suite.registerCascadeAction()
+
+	res, err := suite.msgServer.CancelAction(suite.ctx, types.NewMsgCancelAction(suite.creatorAddress.String(), actionID))
+	suite.Require().NoError(err)
+	suite.Equal("0ulume", res.Refund)
+	suite.Equal("100000ulume", res.Fee)
+}
+
+func (suite *MsgServerTestSuite) TestMsgCancelActionErrors() {
+	suite.setupExpectationsGetAllTopSNs(1)
+
+	actionIDDone := suite.registerCascadeAction()
+	suite.finalizeCascadeAction(actionIDDone)
+
+	actionIDProcessing := suite.registerCascadeAction()
+	processing, found := suite.keeper.GetActionByID(suite.ctx, actionIDProcessing)
+	suite.Require().True(found)
+	processing.State = actiontypes.ActionStateProcessing
+	processing.SuperNodes = []string{suite.supernodes[0].SupernodeAccount}
+	suite.Require().NoError(suite.keeper.SetAction(suite.ctx, processing))
+
+	actionIDPending := suite.registerCascadeAction()
+
+	actionIDCancelled := suite.registerCascadeAction()
+	_, err := suite.msgServer.CancelAction(suite.ctx, types.NewMsgCancelAction(suite.creatorAddress.String(), actionIDCancelled))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		creator  string
+		actionId string
+		err      error
+	}{
+		{
+			name:     "Non-existent action ID",
+			creator:  suite.creatorAddress.String(),
+			actionId: "non_existent_id",
+			err:      types.ErrActionNotFound,
+		},
+		{
+			name:     "Different creator than action",
+			creator:  suite.imposterAddress.String(),
+			actionId: actionIDPending,
+			err:      types.ErrUnauthorizedCreator,
+		},
+		{
+			name:     "Action already processing",
+			creator:  suite.creatorAddress.String(),
+			actionId: actionIDProcessing,
+			err:      types.ErrInvalidActionState,
+		},
+		{
+			name:     "Action already done",
+			creator:  suite.creatorAddress.String(),
+			actionId: actionIDDone,
+			err:      types.ErrInvalidActionState,
+		},
+		{
+			name:     "Action already cancelled",
+			creator:  suite.creatorAddress.String(),
+			actionId: actionIDCancelled,
+			err:      types.ErrInvalidActionState,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.CancelAction(suite.ctx, types.NewMsgCancelAction(tc.creator, tc.actionId))
+			suite.Require().Error(err)
+			suite.ErrorIs(err, tc.err)
+		})
+	}
+
+	pending, found := suite.keeper.GetActionByID(suite.ctx, actionIDPending)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStatePending, pending.State)
+}
+
+type recordingActionHooks struct {
+	settled []*actiontypes.Action
+}
+
+func (h *recordingActionHooks) AfterActionSettled(_ sdk.Context, action *actiontypes.Action) error {
+	h.settled = append(h.settled, action)
+	return nil
+}
+
+func (suite *MsgServerTestSuite) TestMsgCancelActionByRefundAddress() {
+	bankKeeper, ok := suite.keeper.GetBankKeeper().(*keepertest.ActionBankKeeper)
+	suite.Require().True(ok)
+
+	hooks := &recordingActionHooks{}
+	suite.keeper.SetHooks(hooks)
+	defer suite.keeper.SetHooks(nil)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CancellationFee = sdk.NewInt64Coin("ulume", 2500)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	actionID := suite.registerCascadeAction()
+	refundAddr := sdk.AccAddress("refund______________")
+	suite.Require().NoError(suite.keeper.SetActionRefundAddress(suite.ctx, actionID, refundAddr.String()))
+	creatorBefore := bankKeeper.GetAccountCoins(suite.creatorAddress)
+
+	_, err := suite.msgServer.CancelAction(suite.ctx, types.NewMsgCancelAction(refundAddr.String(), actionID))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("ulume", 97500)), bankKeeper.GetAccountCoins(refundAddr))
+	suite.Equal(creatorBefore, bankKeeper.GetAccountCoins(suite.creatorAddress))
+
+	suite.Require().Len(hooks.settled, 1)
+	suite.Equal(actionID, hooks.settled[0].ActionID)
+	suite.Equal(actiontypes.ActionStateCancelled, hooks.settled[0].State)
+}
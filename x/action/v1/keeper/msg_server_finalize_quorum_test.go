@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+
+	keepertest "github.com/LumeraProtocol/lumera/testutil/keeper"
+	"github.com/LumeraProtocol/lumera/x/action/v1/types"
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *MsgServerTestSuite) registerRedundantCascadeAction(redundancy uint32) (string, error) {
+	cascadeMetadata := types.CascadeMetadata{
+		DataHash:   "test_hash",
+		FileName:   "test_file",
+		RqIdsIc:    20,
+		Signatures: suite.signatureCascade,
+	}
+
+	var cascadeMetadataBytes bytes.Buffer
+	marshaler := &jsonpb.Marshaler{}
+	suite.Require().NoError(marshaler.Marshal(&cascadeMetadataBytes, &cascadeMetadata))
+
+	res, err := suite.msgServer.RequestAction(suite.ctx, &types.MsgRequestAction{
+		Creator:                suite.creatorAddress.String(),
+		ActionType:             "CASCADE",
+		Price:                  "100000ulume",
+		Metadata:               cascadeMetadataBytes.String(),
+		FileSizeKbs:            "123",
+		FinalizationRedundancy: redundancy,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.ActionId, nil
+}
+
+// makeDissentingCascadeFinalization builds a valid finalization whose result
+// differs from makeFinalizeCascadeActionMessage in its reported artifact count.
+func (suite *MsgServerTestSuite) makeDissentingCascadeFinalization(actionID string, superNode string) types.MsgFinalizeAction {
+	msg := suite.makeFinalizeCascadeActionMessage(actionID, "CASCADE", superNode, "", false, false)
+
+	var meta actiontypes.CascadeMetadata
+	suite.Require().NoError(jsonpb.UnmarshalString(msg.Metadata, &meta))
+	meta.IndexArtifactCount = 7
+
+	var buf bytes.Buffer
+	suite.Require().NoError((&jsonpb.Marshaler{}).Marshal(&buf, &meta))
+	msg.Metadata = buf.String()
+	return msg
+}
+
+func (suite *MsgServerTestSuite) TestMsgFinalizeActionQuorum() {
+	bankKeeper, ok := suite.keeper.GetBankKeeper().(*keepertest.ActionBankKeeper)
+	suite.Require().True(ok)
+
+	suite.setupExpectationsGetAllTopSNs(3)
+
+	actionID, err := suite.registerRedundantCascadeAction(3)
+	suite.Require().NoError(err)
+
+	balancesBefore := make([]sdk.Coins, 3)
+	for i := range balancesBefore {
+		balancesBefore[i] = bankKeeper.GetAccountCoins(suite.accountPairs[i].Address)
+	}
+
+	// First result: recorded, action waits for quorum.
+	msg := suite.makeFinalizeCascadeActionMessage(actionID, "CASCADE", suite.supernodes[0].SupernodeAccount, "", false, false)
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	action, found := suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStateProcessing, action.State)
+	suite.Empty(action.SuperNodes)
+	suite.Len(action.FinalizationSubmissions, 1)
+
+	// Processing has started, so the creator can no longer cancel.
+	_, err = suite.msgServer.CancelAction(suite.ctx, types.NewMsgCancelAction(suite.creatorAddress.String(), actionID))
+	suite.ErrorIs(err, types.ErrInvalidActionState)
+
+	// Dissenting result: still short of quorum.
+	msg = suite.makeDissentingCascadeFinalization(actionID, suite.supernodes[1].SupernodeAccount)
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	action, found = suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStateProcessing, action.State)
+	suite.Len(action.FinalizationSubmissions, 2)
+
+	// Second matching result reaches the 2-of-3 quorum.
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	msg = suite.makeFinalizeCascadeActionMessage(actionID, "CASCADE", suite.supernodes[2].SupernodeAccount, "", false, false)
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	action, found = suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStateDone, action.State)
+	suite.Equal([]string{suite.supernodes[0].SupernodeAccount, suite.supernodes[2].SupernodeAccount}, action.SuperNodes)
+	for _, s := range action.FinalizationSubmissions {
+		suite.Nil(s.Metadata)
+	}
+
+	var finalMeta actiontypes.CascadeMetadata
+	suite.Require().NoError(suite.keeper.GetCodec().Unmarshal(action.Metadata, &finalMeta))
+	suite.Equal("test_file", finalMeta.FileName)
+	suite.NotEmpty(finalMeta.RqIdsIds)
+	suite.NotEqual(uint32(7), finalMeta.IndexArtifactCount)
+
+	// The dissenting finalizer gets result-mismatch evidence.
+	var mismatches []keepertest.MockAuditKeeperCreateEvidenceCall
+	for _, call := range suite.mockAuditKeeper.CreateCalls {
+		if call.EvidenceType == audittypes.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH {
+			mismatches = append(mismatches, call)
+		}
+	}
+	suite.Require().Len(mismatches, 1)
+	suite.Equal(suite.supernodes[1].SupernodeAccount, mismatches[0].SubjectAddress)
+	suite.Equal(actionID, mismatches[0].ActionID)
+	var mismatchMeta audittypes.ActionFinalizationResultMismatchEvidenceMetadata
+	suite.Require().NoError(json.Unmarshal([]byte(mismatches[0].MetadataJSON), &mismatchMeta))
+	suite.Equal(hex.EncodeToString(action.FinalizationSubmissions[0].ResultHash), mismatchMeta.QuorumResultHash)
+	suite.Equal(hex.EncodeToString(action.FinalizationSubmissions[1].ResultHash), mismatchMeta.SubmittedResultHash)
+
+	foundFinalized := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeActionFinalized {
+			foundFinalized = true
+		}
+	}
+	suite.True(foundFinalized, "action_finalized event not found")
+
+	// The supernode share of the fee is split evenly across the agreeing finalizers only.
+	share0 := bankKeeper.GetAccountCoins(suite.accountPairs[0].Address).Sub(balancesBefore[0]...)
+	share2 := bankKeeper.GetAccountCoins(suite.accountPairs[2].Address).Sub(balancesBefore[2]...)
+	suite.True(share0.IsAllPositive())
+	suite.Equal(share0, share2)
+	suite.Equal(balancesBefore[1], bankKeeper.GetAccountCoins(suite.accountPairs[1].Address))
+}
+
+func (suite *MsgServerTestSuite) TestMsgFinalizeActionQuorumErrors() {
+	// Redundancy above the governance limit is rejected at request time.
+	_, err := suite.registerRedundantCascadeAction(types.DefaultMaxFinalizationRedundancy + 1)
+	suite.ErrorIs(err, types.ErrInvalidRedundancy)
+
+	suite.setupExpectationsGetAllTopSNs(2)
+
+	actionID, err := suite.registerRedundantCascadeAction(2)
+	suite.Require().NoError(err)
+
+	msg := suite.makeFinalizeCascadeActionMessage(actionID, "CASCADE", suite.supernodes[0].SupernodeAccount, "", false, false)
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	// The same supernode cannot submit twice.
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.ErrorIs(err, types.ErrUnauthorizedSN)
+
+	bankKeeper, ok := suite.keeper.GetBankKeeper().(*keepertest.ActionBankKeeper)
+	suite.Require().True(ok)
+	creatorBefore := bankKeeper.GetAccountCoins(suite.creatorAddress)
+
+	// 2-of-2 quorum: a dissenting result fills the requested finalizations
+	// without quorum, so the action fails and the creator is refunded.
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	msg = suite.makeDissentingCascadeFinalization(actionID, suite.supernodes[1].SupernodeAccount)
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	action, found := suite.keeper.GetActionByID(suite.ctx, actionID)
+	suite.Require().True(found)
+	suite.Equal(actiontypes.ActionStateFailed, action.State)
+	suite.Empty(action.SuperNodes)
+	suite.Len(action.FinalizationSubmissions, 2)
+	for _, s := range action.FinalizationSubmissions {
+		suite.Nil(s.Metadata)
+	}
+	suite.Equal(creatorBefore.Add(sdk.NewInt64Coin("ulume", 100000)), bankKeeper.GetAccountCoins(suite.creatorAddress))
+
+	foundFailed := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeActionFailed {
+			foundFailed = true
+		}
+	}
+	suite.True(foundFailed, "action_failed event not found")
+
+	// No further results are accepted once the action is resolved.
+	msg = suite.makeFinalizeCascadeActionMessage(actionID, "CASCADE", suite.supernodes[2].SupernodeAccount, "", false, false)
+	_, err = suite.msgServer.FinalizeAction(suite.ctx, &msg)
+	suite.ErrorIs(err, types.ErrInvalidActionState)
+}
+
+func (suite *MsgServerTestSuite) TestMsgFinalizeActionQuorumSense() {
+	suite.setupExpectationsGetAllTopSNs(2)
+
+	senseMetadata := types.SenseMetadata{
+		DataHash:            "test_hash",
+		DdAndFingerprintsIc: suite.ic,
+		CollectionId:        "test_collection",
+	}
+	var senseMetadataBytes bytes.Buffer
+	suite.Require().NoError((&jsonpb.Marshaler{}).Marshal(&senseMetadataBytes, &senseMetadata))
+
+	res, err := suite.msgServer.RequestAction(suite.ctx, &types.MsgRequestAction{
+		Creator:                suite.creatorAddress.String(),
+		ActionType:             "SENSE",
+		Price:                  "100000ulume",
+		Metadata:               senseMetadataBytes.String(),
+		FileSizeKbs:            "456",
+		FinalizationRedundancy: 2,
+	})
+	suite.Require().NoError(err)
+
+	suite.finalizeSenseAction(res.ActionId, suite.supernodes[0].SupernodeAccount, actiontypes.ActionStateProcessing)
+	suite.finalizeSenseAction(res.ActionId, suite.supernodes[1].SupernodeAccount, actiontypes.ActionStateDone)
+
+	action, found := suite.keeper.GetActionByID(suite.ctx, res.ActionId)
+	suite.Require().True(found)
+	suite.Equal([]string{suite.supernodes[0].SupernodeAccount, suite.supernodes[1].SupernodeAccount}, action.SuperNodes)
+
+	// The agreed result is merged into the registered metadata.
+	var finalMeta actiontypes.SenseMetadata
+	suite.Require().NoError(suite.keeper.GetCodec().Unmarshal(action.Metadata, &finalMeta))
+	suite.Equal("test_hash", finalMeta.DataHash)
+	suite.Equal("test_collection", finalMeta.CollectionId)
+	suite.Equal(suite.signatureSense, finalMeta.Signatures)
+	suite.Len(finalMeta.DdAndFingerprintsIds, 50)
+}
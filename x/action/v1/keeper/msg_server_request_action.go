@@ -83,6 +83,13 @@ func (k msgServer) RequestAction(goCtx context.Context, msg *types.MsgRequestAct
 		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "invalid price format: %s", err)
 	}
 
+	if msg.FinalizationRedundancy > params.MaxFinalizationRedundancy {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRedundancy,
+			"requested %d finalizations but at most %d are allowed",
+			msg.FinalizationRedundancy, params.MaxFinalizationRedundancy,
+		)
+	}
+
 	// Create a new action with metadata embedded directly
 	action := &types.Action{
 		Creator:        msg.Creator,
@@ -93,6 +100,8 @@ func (k msgServer) RequestAction(goCtx context.Context, msg *types.MsgRequestAct
 		State:          types.ActionStatePending,
 		FileSizeKbs:    fileSizeKbs,
 		AppPubkey:      msg.AppPubkey,
+
+		FinalizationRedundancy: msg.FinalizationRedundancy,
 	}
 
 	// Save the action (this generates the action ID)
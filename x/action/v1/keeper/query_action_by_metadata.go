@@ -5,141 +5,172 @@ import (
 	"strings"
 
 	"github.com/LumeraProtocol/lumera/x/action/v1/types"
-	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
 
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
-	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-// QueryActionByMetadata returns actions filtered by metadata field and value
+// maxMetadataPredicates bounds the number of AND-ed predicates in one query.
+const maxMetadataPredicates = 8
+
+// QueryActionByMetadata returns actions whose searchable metadata fields match
+// all requested predicates. Matching actions are read from the metadata index;
+// the most selective predicate (an exact match when available) drives the
+// paginated index scan and the remaining predicates filter its results.
 func (q queryServer) QueryActionByMetadata(goCtx context.Context, req *types.QueryActionByMetadataRequest) (*types.QueryActionByMetadataResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	if req.ActionType == types.ActionTypeUnspecified || req.MetadataQuery == "" {
+	if req.ActionType == types.ActionTypeUnspecified || (req.MetadataQuery == "" && len(req.Predicates) == 0) {
 		return nil, status.Error(codes.InvalidArgument, "action type and metadata query required")
 	}
 
-	metadataParts := strings.SplitN(req.MetadataQuery, "=", 2)
-	if len(metadataParts) != 2 {
-		return nil, status.Error(codes.InvalidArgument, "invalid metadata query format, expected 'field=value'")
+	predicates, err := parseMetadataQuery(req.MetadataQuery)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range req.Predicates {
+		if p == nil {
+			continue
+		}
+		predicates = append(predicates, *p)
+	}
+	if len(predicates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "action type and metadata query required")
+	}
+	if len(predicates) > maxMetadataPredicates {
+		return nil, status.Errorf(codes.InvalidArgument, "too many metadata predicates: %d (max %d)", len(predicates), maxMetadataPredicates)
 	}
-	metadataKey := metadataParts[0]
-	metadataValue := metadataParts[1]
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	store := q.k.storeService.OpenKVStore(ctx)
-	storeAdapter := runtime.KVStoreAdapter(store)
+	// Searchable fields are declared by the action type's handler.
+	searchable, ok := q.k.searchableHandler(req.ActionType)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "action type %s does not support metadata queries", req.ActionType)
+	}
+	declared := make(map[string]struct{})
+	for _, field := range searchable.SearchableFieldNames() {
+		declared[field] = struct{}{}
+	}
+	for _, p := range predicates {
+		if _, ok := declared[p.Field]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %q is not searchable for action type %s", p.Field, req.ActionType)
+		}
+		if !p.Prefix && !isIndexableMetadataValue(p.Value) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid value for field %q", p.Field)
+		}
+	}
 
-	// Use the type index to avoid scanning all actions; we still filter by
-	// the requested metadata field/value.
-	typePrefix := []byte(ActionByTypePrefix + req.ActionType.String() + "/")
-	actionStore := prefix.NewStore(storeAdapter, typePrefix)
+	driver := 0
+	for i, p := range predicates {
+		if !p.Prefix {
+			driver = i
+			break
+		}
+	}
+	drivingPredicate := predicates[driver]
+	filters := append(append([]types.MetadataPredicate{}, predicates[:driver]...), predicates[driver+1:]...)
+
+	var scanPrefix []byte
+	if drivingPredicate.Prefix {
+		scanPrefix = append(metadataIndexFieldPrefix(req.ActionType, drivingPredicate.Field), drivingPredicate.Value...)
+	} else {
+		scanPrefix = metadataIndexValuePrefix(req.ActionType, drivingPredicate.Field, drivingPredicate.Value)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(q.k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, scanPrefix)
 
 	var actions []*types.Action
 
-	appendAction := func(act *actiontypes.Action, price string) {
-		actions = append(actions, &types.Action{
-			Creator:        act.Creator,
-			ActionID:       act.ActionID,
-			ActionType:     types.ActionType(act.ActionType),
-			Metadata:       act.Metadata,
-			Price:          price,
-			ExpirationTime: act.ExpirationTime,
-			State:          types.ActionState(act.State),
-			BlockHeight:    act.BlockHeight,
-			SuperNodes:     act.SuperNodes,
-			FileSizeKbs:    act.FileSizeKbs,
-			AppPubkey:      act.AppPubkey,
-		})
-	}
+	onResult := func(key, _ []byte, accumulate bool) (bool, error) {
+		var actionID string
+		if drivingPredicate.Prefix {
+			id, ok := actionIDFromMetadataIndexRemainder(key)
+			if !ok {
+				return false, nil
+			}
+			actionID = id
+		} else {
+			actionID = string(key)
+		}
 
-	onResult := func(key, value []byte, accumulate bool) (bool, error) {
-		actionID := string(key)
 		act, found := q.k.GetActionByID(ctx, actionID)
 		if !found {
 			// Stale index entry; skip
 			return false, nil
 		}
 
-		price := act.Price
-
-		switch req.ActionType {
-		case types.ActionTypeSense:
-			var senseMetadata actiontypes.SenseMetadata
-			err := gogoproto.Unmarshal(act.Metadata, &senseMetadata)
-			if err != nil {
-				q.k.Logger().Error("failed to unmarshal sense metadata", "action_id", act.ActionID, "error", err)
-				return false, err
-			}
-
-			switch metadataKey {
-			case "collection_id":
-				if senseMetadata.CollectionId == metadataValue {
-					if accumulate {
-						appendAction(act, price)
-					}
-					return true, nil
-				}
-			case "group_id":
-				if senseMetadata.GroupId == metadataValue {
-					if accumulate {
-						appendAction(act, price)
-					}
-					return true, nil
-				}
-			case "data_hash":
-				if senseMetadata.DataHash == metadataValue {
-					if accumulate {
-						appendAction(act, price)
-					}
-					return true, nil
-				}
-			}
-
-		case types.ActionTypeCascade:
-			var cascadeMetadata actiontypes.CascadeMetadata
-			err := gogoproto.Unmarshal(act.Metadata, &cascadeMetadata)
-			if err != nil {
-				q.k.Logger().Error("failed to unmarshal cascade metadata", "action_id", act.ActionID, "error", err)
-				return false, err
-			}
-
-			switch metadataKey {
-			case "file_name":
-				if cascadeMetadata.FileName == metadataValue {
-					if accumulate {
-						appendAction(act, price)
-					}
-					return true, nil
-				}
-			case "data_hash":
-				if cascadeMetadata.DataHash == metadataValue {
-					if accumulate {
-						appendAction(act, price)
-					}
-					return true, nil
+		if len(filters) > 0 {
+			fields := q.k.indexedMetadataFields(act)
+			for _, f := range filters {
+				if !metadataPredicateMatches(f, fields) {
+					return false, nil
 				}
 			}
 		}
 
-		return false, nil
+		if accumulate {
+			actions = append(actions, act)
+		}
+		return true, nil
 	}
 
-	pageRes, err := query.FilteredPaginate(actionStore, req.Pagination, onResult)
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, onResult)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to paginate actions: %v", err)
 	}
 
+	var total uint64
+	if pageRes != nil {
+		total = pageRes.Total
+	}
+
 	return &types.QueryActionByMetadataResponse{
 		Actions:    actions,
 		Pagination: pageRes,
+		Total:      total,
 	}, nil
 }
+
+// parseMetadataQuery parses "&"-joined "field=value" (exact) and
+// "field^=value" (prefix) predicates.
+func parseMetadataQuery(metadataQuery string) ([]types.MetadataPredicate, error) {
+	if metadataQuery == "" {
+		return nil, nil
+	}
+
+	terms := strings.Split(metadataQuery, "&")
+	predicates := make([]types.MetadataPredicate, 0, len(terms))
+	for _, term := range terms {
+		parts := strings.SplitN(term, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[0] == "^" {
+			return nil, status.Error(codes.InvalidArgument, "invalid metadata query format, expected 'field=value'")
+		}
+		field, isPrefix := strings.CutSuffix(parts[0], "^")
+		predicates = append(predicates, types.MetadataPredicate{
+			Field:  field,
+			Value:  parts[1],
+			Prefix: isPrefix,
+		})
+	}
+	return predicates, nil
+}
+
+// metadataPredicateMatches evaluates a predicate against an action's indexed fields.
+func metadataPredicateMatches(p types.MetadataPredicate, fields map[string]string) bool {
+	value, ok := fields[p.Field]
+	if !ok {
+		return false
+	}
+	if p.Prefix {
+		return strings.HasPrefix(value, p.Value)
+	}
+	return value == p.Value
+}
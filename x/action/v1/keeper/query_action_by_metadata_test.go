@@ -117,6 +117,14 @@ func TestQueryActionByMetadata(t *testing.T) {
 			req:         &types.QueryActionByMetadataRequest{},
 			expectedErr: status.Error(codes.InvalidArgument, "action type and metadata query required"),
 		},
+		{
+			name: "only nil predicates",
+			req: &types.QueryActionByMetadataRequest{
+				ActionType: types.ActionTypeSense,
+				Predicates: []*types.MetadataPredicate{nil},
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "action type and metadata query required"),
+		},
 		{
 			name: "invalid metadata query format",
 			req: &types.QueryActionByMetadataRequest{
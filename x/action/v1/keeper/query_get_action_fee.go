@@ -22,11 +22,24 @@ func (q queryServer) GetActionFee(goCtx context.Context, req *types.QueryGetActi
 		return nil, status.Errorf(codes.InvalidArgument, "invalid data_size: %v", err)
 	}
 
-	params := q.k.GetParams(ctx)
+	actionType := types.ActionTypeUnspecified
+	if req.ActionType != "" {
+		actionType, err = types.ParseActionType(req.ActionType)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid action_type: %v", err)
+		}
+	}
+
+	baseFee, feePerKbyte := q.k.GetParams(ctx).FeeSchedule(actionType)
 
-	// Calculate: FeePerKbyte * DataSize + BaseActionFee
-	perByteCost := params.FeePerKbyte.Amount.MulRaw(dataSize)
-	totalAmount := perByteCost.Add(params.BaseActionFee.Amount)
+	// Calculate: (FeePerKbyte * DataSize + BaseActionFee) * FeeMultiplier
+	perByteCost := feePerKbyte.Amount.MulRaw(dataSize)
+	baseAmount := perByteCost.Add(baseFee.Amount)
+	totalAmount := q.k.ApplyFeeMultiplier(ctx, baseAmount)
 
-	return &types.QueryGetActionFeeResponse{Amount: totalAmount.String()}, nil
+	return &types.QueryGetActionFeeResponse{
+		Amount:        totalAmount.String(),
+		BaseAmount:    baseAmount.String(),
+		FeeMultiplier: q.k.GetFeeMultiplier(ctx).String(),
+	}, nil
 }
@@ -55,6 +55,55 @@ func TestKeeper_GetActionFee(t *testing.T) {
 			},
 			expectedFee: "30000", // 100 * 200 + 10000
 		},
+		{
+			name: "per-type fee schedule overrides module fees",
+			req:  &types.QueryGetActionFeeRequest{DataSize: "200", ActionType: "cascade"},
+			setupParams: func(k keeper.Keeper, ctx sdk.Context) {
+				params := types.DefaultParams()
+				params.BaseActionFee = sdk.NewCoin("ulume", math.NewInt(10000))
+				params.FeePerKbyte = sdk.NewCoin("ulume", math.NewInt(100))
+				params.ActionTypeFees = []types.ActionTypeFee{{
+					ActionType:    types.ActionTypeCascade.String(),
+					BaseActionFee: sdk.NewCoin("ulume", math.NewInt(500)),
+					FeePerKbyte:   sdk.NewCoin("ulume", math.NewInt(5)),
+				}}
+				require.NoError(t, k.SetParams(ctx, params))
+			},
+			expectedFee: "1500", // 5 * 200 + 500
+		},
+		{
+			name: "types without an override use module fees",
+			req:  &types.QueryGetActionFeeRequest{DataSize: "200", ActionType: "sense"},
+			setupParams: func(k keeper.Keeper, ctx sdk.Context) {
+				params := types.DefaultParams()
+				params.BaseActionFee = sdk.NewCoin("ulume", math.NewInt(10000))
+				params.FeePerKbyte = sdk.NewCoin("ulume", math.NewInt(100))
+				params.ActionTypeFees = []types.ActionTypeFee{{
+					ActionType:    types.ActionTypeCascade.String(),
+					BaseActionFee: sdk.NewCoin("ulume", math.NewInt(500)),
+					FeePerKbyte:   sdk.NewCoin("ulume", math.NewInt(5)),
+				}}
+				require.NoError(t, k.SetParams(ctx, params))
+			},
+			expectedFee: "30000",
+		},
+		{
+			name: "fee multiplier scales the quoted fee",
+			req:  &types.QueryGetActionFeeRequest{DataSize: "200"},
+			setupParams: func(k keeper.Keeper, ctx sdk.Context) {
+				params := types.DefaultParams()
+				params.BaseActionFee = sdk.NewCoin("ulume", math.NewInt(10000))
+				params.FeePerKbyte = sdk.NewCoin("ulume", math.NewInt(100))
+				require.NoError(t, k.SetParams(ctx, params))
+				require.NoError(t, k.SetFeeMultiplier(ctx, math.LegacyMustNewDecFromStr("1.5")))
+			},
+			expectedFee: "45000", // (100 * 200 + 10000) * 1.5
+		},
+		{
+			name:        "unknown action type",
+			req:         &types.QueryGetActionFeeRequest{DataSize: "200", ActionType: "bogus"},
+			expectedErr: status.Error(codes.InvalidArgument, "invalid action_type: unknown action type: bogus"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+// actionHooks holds the registered ActionHooks. It is shared by every copy of
+// the keeper, so hooks registered once the IBC stack is built also reach the
+// copies handed out by depinject.
+type actionHooks struct {
+	hooks actiontypes.ActionHooks
+}
+
+// SetHooks registers the hooks notified when an action settles.
+func (k *Keeper) SetHooks(hooks actiontypes.ActionHooks) {
+	if k.hooks == nil {
+		panic("action keeper hooks are not initialized")
+	}
+	k.hooks.hooks = hooks
+}
+
+// afterActionSettled notifies the registered hooks. Hook failures are logged:
+// they must not undo the state transition that settled the action.
+func (k *Keeper) afterActionSettled(ctx sdk.Context, action *actiontypes.Action) {
+	if k.hooks == nil || k.hooks.hooks == nil {
+		return
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.hooks.hooks.AfterActionSettled(cacheCtx, action); err != nil {
+		k.Logger().Error("action settled hook failed", "action_id", action.ActionID, "state", action.State.String(), "error", err)
+		return
+	}
+	write()
+}
+
+// SetActionRefundAddress records the account that receives an action's
+// refunds instead of its creator. Keyless creators such as IBC-hooks
+// intermediaries use it so refunds reach an account the sender controls.
+func (k *Keeper) SetActionRefundAddress(ctx sdk.Context, actionID string, refundAddress string) error {
+	if _, err := k.addressCodec.StringToBytes(refundAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund address: %s", err)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set([]byte(ActionRefundAddressPrefix+actionID), []byte(refundAddress))
+}
+
+// GetActionRefundAddress returns the refund address recorded for an action.
+func (k *Keeper) GetActionRefundAddress(ctx sdk.Context, actionID string) (string, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(ActionRefundAddressPrefix + actionID))
+	if err != nil || bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// refundRecipient returns the account an action's refunds are paid to: its
+// refund address when one is recorded, otherwise its creator.
+func (k *Keeper) refundRecipient(ctx sdk.Context, action *actiontypes.Action) (sdk.AccAddress, error) {
+	recipient := action.Creator
+	if refundAddress, found := k.GetActionRefundAddress(ctx, action.ActionID); found {
+		recipient = refundAddress
+	}
+	bz, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid refund recipient %s: %s", recipient, err)
+	}
+	return bz, nil
+}
+
+// SetPendingIBCAck stores the packet that created an action over IBC. Its
+// acknowledgement is written once the action settles.
+func (k *Keeper) SetPendingIBCAck(ctx sdk.Context, actionID string, packet channeltypes.Packet) error {
+	bz, err := k.cdc.Marshal(&packet)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set([]byte(ActionPendingIBCAckPrefix+actionID), bz)
+}
+
+// GetPendingIBCAck returns the packet awaiting an acknowledgement for an action.
+func (k *Keeper) GetPendingIBCAck(ctx sdk.Context, actionID string) (channeltypes.Packet, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(ActionPendingIBCAckPrefix + actionID))
+	if err != nil || bz == nil {
+		return channeltypes.Packet{}, false
+	}
+	var packet channeltypes.Packet
+	if err := k.cdc.Unmarshal(bz, &packet); err != nil {
+		k.Logger().Error("failed to unmarshal pending IBC ack packet", "action_id", actionID, "error", err)
+		return channeltypes.Packet{}, false
+	}
+	return packet, true
+}
+
+// DeletePendingIBCAck removes the packet stored for an action.
+func (k *Keeper) DeletePendingIBCAck(ctx sdk.Context, actionID string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete([]byte(ActionPendingIBCAckPrefix + actionID))
+}
@@ -17,11 +17,13 @@ import (
 	reflect "reflect"
 
 	address "cosmossdk.io/core/address"
-	types "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
-	types0 "github.com/cosmos/cosmos-sdk/types"
-	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
-	types2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
-	types3 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	types "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	types0 "github.com/LumeraProtocol/lumera/x/lumeraid/types"
+	types1 "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
+	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types4 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	types5 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -64,10 +66,10 @@ func (mr *MockAuthKeeperMockRecorder) AddressCodec() *gomock.Call {
 }
 
 // GetAccount mocks base method.
-func (m *MockAuthKeeper) GetAccount(arg0 context.Context, arg1 types0.AccAddress) types0.AccountI {
+func (m *MockAuthKeeper) GetAccount(arg0 context.Context, arg1 types2.AccAddress) types2.AccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(types0.AccountI)
+	ret0, _ := ret[0].(types2.AccountI)
 	return ret0
 }
 
@@ -78,10 +80,10 @@ func (mr *MockAuthKeeperMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
 }
 
 // GetModuleAccount mocks base method.
-func (m *MockAuthKeeper) GetModuleAccount(ctx context.Context, moduleName string) types0.ModuleAccountI {
+func (m *MockAuthKeeper) GetModuleAccount(ctx context.Context, moduleName string) types2.ModuleAccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetModuleAccount", ctx, moduleName)
-	ret0, _ := ret[0].(types0.ModuleAccountI)
+	ret0, _ := ret[0].(types2.ModuleAccountI)
 	return ret0
 }
 
@@ -92,7 +94,7 @@ func (mr *MockAuthKeeperMockRecorder) GetModuleAccount(ctx, moduleName any) *gom
 }
 
 // SetAccount mocks base method.
-func (m *MockAuthKeeper) SetAccount(arg0 context.Context, arg1 types0.AccountI) {
+func (m *MockAuthKeeper) SetAccount(arg0 context.Context, arg1 types2.AccountI) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetAccount", arg0, arg1)
 }
@@ -104,7 +106,7 @@ func (mr *MockAuthKeeperMockRecorder) SetAccount(arg0, arg1 any) *gomock.Call {
 }
 
 // SetModuleAccount mocks base method.
-func (m *MockAuthKeeper) SetModuleAccount(ctx context.Context, macc types0.ModuleAccountI) {
+func (m *MockAuthKeeper) SetModuleAccount(ctx context.Context, macc types2.ModuleAccountI) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetModuleAccount", ctx, macc)
 }
@@ -140,10 +142,10 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // GetBalance mocks base method.
-func (m *MockBankKeeper) GetBalance(ctx context.Context, addr types0.AccAddress, denom string) types0.Coin {
+func (m *MockBankKeeper) GetBalance(ctx context.Context, addr types2.AccAddress, denom string) types2.Coin {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBalance", ctx, addr, denom)
-	ret0, _ := ret[0].(types0.Coin)
+	ret0, _ := ret[0].(types2.Coin)
 	return ret0
 }
 
@@ -154,7 +156,7 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom any) *gomock.C
 }
 
 // SendCoinsFromAccountToModule mocks base method.
-func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types0.AccAddress, recipientModule string, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types2.AccAddress, recipientModule string, amt types2.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
 	ret0, _ := ret[0].(error)
@@ -168,7 +170,7 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAd
 }
 
 // SendCoinsFromModuleToAccount mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types2.AccAddress, amt types2.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -181,11 +183,25 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
 // SpendableCoins mocks base method.
-func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types0.AccAddress) types0.Coins {
+func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types2.AccAddress) types2.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SpendableCoins", ctx, addr)
-	ret0, _ := ret[0].(types0.Coins)
+	ret0, _ := ret[0].(types2.Coins)
 	return ret0
 }
 
@@ -220,10 +236,10 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 }
 
 // GetValidator mocks base method.
-func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types0.ValAddress) (types1.Validator, error) {
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types2.ValAddress) (types3.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
-	ret0, _ := ret[0].(types1.Validator)
+	ret0, _ := ret[0].(types3.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -235,10 +251,10 @@ func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr any) *gomock.Cal
 }
 
 // Validator mocks base method.
-func (m *MockStakingKeeper) Validator(arg0 context.Context, arg1 types0.ValAddress) (types1.ValidatorI, error) {
+func (m *MockStakingKeeper) Validator(arg0 context.Context, arg1 types2.ValAddress) (types3.ValidatorI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Validator", arg0, arg1)
-	ret0, _ := ret[0].(types1.ValidatorI)
+	ret0, _ := ret[0].(types3.ValidatorI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -273,11 +289,46 @@ func (m *MockSupernodeKeeper) EXPECT() *MockSupernodeKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetAllSuperNodes mocks base method.
+func (m *MockSupernodeKeeper) GetAllSuperNodes(ctx types2.Context, stateFilters ...types1.SuperNodeState) ([]types1.SuperNode, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range stateFilters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAllSuperNodes", varargs...)
+	ret0, _ := ret[0].([]types1.SuperNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSuperNodes indicates an expected call of GetAllSuperNodes.
+func (mr *MockSupernodeKeeperMockRecorder) GetAllSuperNodes(ctx any, stateFilters ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, stateFilters...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSuperNodes", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetAllSuperNodes), varargs...)
+}
+
+// GetMetricsState mocks base method.
+func (m *MockSupernodeKeeper) GetMetricsState(ctx types2.Context, valAddr types2.ValAddress) (types1.SupernodeMetricsState, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricsState", ctx, valAddr)
+	ret0, _ := ret[0].(types1.SupernodeMetricsState)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetMetricsState indicates an expected call of GetMetricsState.
+func (mr *MockSupernodeKeeperMockRecorder) GetMetricsState(ctx, valAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsState", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetMetricsState), ctx, valAddr)
+}
+
 // GetSuperNodeByAccount mocks base method.
-func (m *MockSupernodeKeeper) GetSuperNodeByAccount(ctx types0.Context, supernodeAccount string) (types.SuperNode, bool, error) {
+func (m *MockSupernodeKeeper) GetSuperNodeByAccount(ctx types2.Context, supernodeAccount string) (types1.SuperNode, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSuperNodeByAccount", ctx, supernodeAccount)
-	ret0, _ := ret[0].(types.SuperNode)
+	ret0, _ := ret[0].(types1.SuperNode)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -290,7 +341,7 @@ func (mr *MockSupernodeKeeperMockRecorder) GetSuperNodeByAccount(ctx, supernodeA
 }
 
 // IsSuperNodeActive mocks base method.
-func (m *MockSupernodeKeeper) IsSuperNodeActive(ctx types0.Context, valAddr types0.ValAddress) bool {
+func (m *MockSupernodeKeeper) IsSuperNodeActive(ctx types2.Context, valAddr types2.ValAddress) bool {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IsSuperNodeActive", ctx, valAddr)
 	ret0, _ := ret[0].(bool)
@@ -304,10 +355,10 @@ func (mr *MockSupernodeKeeperMockRecorder) IsSuperNodeActive(ctx, valAddr any) *
 }
 
 // QuerySuperNode mocks base method.
-func (m *MockSupernodeKeeper) QuerySuperNode(ctx types0.Context, valOperAddr types0.ValAddress) (types.SuperNode, bool) {
+func (m *MockSupernodeKeeper) QuerySuperNode(ctx types2.Context, valOperAddr types2.ValAddress) (types1.SuperNode, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QuerySuperNode", ctx, valOperAddr)
-	ret0, _ := ret[0].(types.SuperNode)
+	ret0, _ := ret[0].(types1.SuperNode)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -319,7 +370,7 @@ func (mr *MockSupernodeKeeperMockRecorder) QuerySuperNode(ctx, valOperAddr any)
 }
 
 // SetSuperNode mocks base method.
-func (m *MockSupernodeKeeper) SetSuperNode(ctx types0.Context, supernode types.SuperNode) error {
+func (m *MockSupernodeKeeper) SetSuperNode(ctx types2.Context, supernode types1.SuperNode) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetSuperNode", ctx, supernode)
 	ret0, _ := ret[0].(error)
@@ -357,10 +408,10 @@ func (m *MockSupernodeQueryServer) EXPECT() *MockSupernodeQueryServerMockRecorde
 }
 
 // GetTopSuperNodesForBlock mocks base method.
-func (m *MockSupernodeQueryServer) GetTopSuperNodesForBlock(ctx context.Context, req *types.QueryGetTopSuperNodesForBlockRequest) (*types.QueryGetTopSuperNodesForBlockResponse, error) {
+func (m *MockSupernodeQueryServer) GetTopSuperNodesForBlock(ctx context.Context, req *types1.QueryGetTopSuperNodesForBlockRequest) (*types1.QueryGetTopSuperNodesForBlockResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTopSuperNodesForBlock", ctx, req)
-	ret0, _ := ret[0].(*types.QueryGetTopSuperNodesForBlockResponse)
+	ret0, _ := ret[0].(*types1.QueryGetTopSuperNodesForBlockResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -396,7 +447,7 @@ func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
 }
 
 // FundCommunityPool mocks base method.
-func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount types0.Coins, sender types0.AccAddress) error {
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount types2.Coins, sender types2.AccAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
 	ret0, _ := ret[0].(error)
@@ -409,6 +460,167 @@ func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sen
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
 }
 
+// MockAuditKeeper is a mock of AuditKeeper interface.
+type MockAuditKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditKeeperMockRecorder is the mock recorder for MockAuditKeeper.
+type MockAuditKeeperMockRecorder struct {
+	mock *MockAuditKeeper
+}
+
+// NewMockAuditKeeper creates a new mock instance.
+func NewMockAuditKeeper(ctrl *gomock.Controller) *MockAuditKeeper {
+	mock := &MockAuditKeeper{ctrl: ctrl}
+	mock.recorder = &MockAuditKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditKeeper) EXPECT() *MockAuditKeeperMockRecorder {
+	return m.recorder
+}
+
+// CreateEvidence mocks base method.
+func (m *MockAuditKeeper) CreateEvidence(ctx context.Context, reporterAddress, subjectAddress, actionID string, evidenceType types.EvidenceType, metadataJSON string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvidence", ctx, reporterAddress, subjectAddress, actionID, evidenceType, metadataJSON)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvidence indicates an expected call of CreateEvidence.
+func (mr *MockAuditKeeperMockRecorder) CreateEvidence(ctx, reporterAddress, subjectAddress, actionID, evidenceType, metadataJSON any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvidence", reflect.TypeOf((*MockAuditKeeper)(nil).CreateEvidence), ctx, reporterAddress, subjectAddress, actionID, evidenceType, metadataJSON)
+}
+
+// GetCurrentEpochInfo mocks base method.
+func (m *MockAuditKeeper) GetCurrentEpochInfo(ctx types2.Context) (uint64, int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentEpochInfo", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(int64)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetCurrentEpochInfo indicates an expected call of GetCurrentEpochInfo.
+func (mr *MockAuditKeeperMockRecorder) GetCurrentEpochInfo(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentEpochInfo", reflect.TypeOf((*MockAuditKeeper)(nil).GetCurrentEpochInfo), ctx)
+}
+
+// SetStorageTruthTicketArtifactCounts mocks base method.
+func (m *MockAuditKeeper) SetStorageTruthTicketArtifactCounts(ctx context.Context, ticketID string, indexArtifactCount, symbolArtifactCount uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageTruthTicketArtifactCounts", ctx, ticketID, indexArtifactCount, symbolArtifactCount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStorageTruthTicketArtifactCounts indicates an expected call of SetStorageTruthTicketArtifactCounts.
+func (mr *MockAuditKeeperMockRecorder) SetStorageTruthTicketArtifactCounts(ctx, ticketID, indexArtifactCount, symbolArtifactCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageTruthTicketArtifactCounts", reflect.TypeOf((*MockAuditKeeper)(nil).SetStorageTruthTicketArtifactCounts), ctx, ticketID, indexArtifactCount, symbolArtifactCount)
+}
+
+// MockRewardDistributionKeeper is a mock of RewardDistributionKeeper interface.
+type MockRewardDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockRewardDistributionKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockRewardDistributionKeeperMockRecorder is the mock recorder for MockRewardDistributionKeeper.
+type MockRewardDistributionKeeperMockRecorder struct {
+	mock *MockRewardDistributionKeeper
+}
+
+// NewMockRewardDistributionKeeper creates a new mock instance.
+func NewMockRewardDistributionKeeper(ctrl *gomock.Controller) *MockRewardDistributionKeeper {
+	mock := &MockRewardDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockRewardDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRewardDistributionKeeper) EXPECT() *MockRewardDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetRegistrationFeeShareBps mocks base method.
+func (m *MockRewardDistributionKeeper) GetRegistrationFeeShareBps(ctx types2.Context) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationFeeShareBps", ctx)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetRegistrationFeeShareBps indicates an expected call of GetRegistrationFeeShareBps.
+func (mr *MockRewardDistributionKeeperMockRecorder) GetRegistrationFeeShareBps(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationFeeShareBps", reflect.TypeOf((*MockRewardDistributionKeeper)(nil).GetRegistrationFeeShareBps), ctx)
+}
+
+// MockLumeraIDKeeper is a mock of LumeraIDKeeper interface.
+type MockLumeraIDKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockLumeraIDKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockLumeraIDKeeperMockRecorder is the mock recorder for MockLumeraIDKeeper.
+type MockLumeraIDKeeperMockRecorder struct {
+	mock *MockLumeraIDKeeper
+}
+
+// NewMockLumeraIDKeeper creates a new mock instance.
+func NewMockLumeraIDKeeper(ctrl *gomock.Controller) *MockLumeraIDKeeper {
+	mock := &MockLumeraIDKeeper{ctrl: ctrl}
+	mock.recorder = &MockLumeraIDKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLumeraIDKeeper) EXPECT() *MockLumeraIDKeeperMockRecorder {
+	return m.recorder
+}
+
+// ActivePQKey mocks base method.
+func (m *MockLumeraIDKeeper) ActivePQKey(ctx types2.Context, arg1 string) (types0.PQKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivePQKey", ctx, arg1)
+	ret0, _ := ret[0].(types0.PQKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivePQKey indicates an expected call of ActivePQKey.
+func (mr *MockLumeraIDKeeperMockRecorder) ActivePQKey(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivePQKey", reflect.TypeOf((*MockLumeraIDKeeper)(nil).ActivePQKey), ctx, arg1)
+}
+
+// VerifyLegRoastSignature mocks base method.
+func (m *MockLumeraIDKeeper) VerifyLegRoastSignature(ctx types2.Context, payload, pubKey, signature []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLegRoastSignature", ctx, payload, pubKey, signature)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyLegRoastSignature indicates an expected call of VerifyLegRoastSignature.
+func (mr *MockLumeraIDKeeperMockRecorder) VerifyLegRoastSignature(ctx, payload, pubKey, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLegRoastSignature", reflect.TypeOf((*MockLumeraIDKeeper)(nil).VerifyLegRoastSignature), ctx, payload, pubKey, signature)
+}
+
 // MockParamSubspace is a mock of ParamSubspace interface.
 type MockParamSubspace struct {
 	ctrl     *gomock.Controller
@@ -496,10 +708,10 @@ func (mr *MockChannelKeeperMockRecorder) ChanCloseInit(ctx, portID, channelID an
 }
 
 // GetChannel mocks base method.
-func (m *MockChannelKeeper) GetChannel(ctx context.Context, portID, channelID string) (types3.Channel, bool) {
+func (m *MockChannelKeeper) GetChannel(ctx context.Context, portID, channelID string) (types5.Channel, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannel", ctx, portID, channelID)
-	ret0, _ := ret[0].(types3.Channel)
+	ret0, _ := ret[0].(types5.Channel)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -526,7 +738,7 @@ func (mr *MockChannelKeeperMockRecorder) GetNextSequenceSend(ctx, portID, channe
 }
 
 // SendPacket mocks base method.
-func (m *MockChannelKeeper) SendPacket(ctx context.Context, sourcePort, sourceChannel string, timeoutHeight types2.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+func (m *MockChannelKeeper) SendPacket(ctx context.Context, sourcePort, sourceChannel string, timeoutHeight types4.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendPacket", ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	ret0, _ := ret[0].(uint64)
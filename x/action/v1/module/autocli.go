@@ -76,7 +76,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "QueryActionByMetadata",
 					Use:       "query-action-by-metadata [action-type] [metadata-query]",
-					Short:     "Query actions by type and metadata (e.g. \"collection_id=123\")",
+					Short:     "Query actions by type and metadata (e.g. \"collection_id=123\" or \"file_name^=scan_&data_hash=abc\")",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "actionType"},
 						{ProtoField: "metadataQuery"},
@@ -112,6 +112,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a approve-action tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "actionId"}},
 				},
+				{
+					RpcMethod:      "CancelAction",
+					Use:            "cancel-action [action-id]",
+					Short:          "Cancel a pending action and refund its price minus the cancellation fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "actionId"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -49,6 +49,7 @@ type ModuleInputs struct {
 	DistributionKeeper types.DistributionKeeper
 	SupernodeKeeper    sntypes.SupernodeKeeper
 	AuditKeeper        types.AuditKeeper
+	LumeraIDKeeper     types.LumeraIDKeeper
 	IBCKeeperFn        func() *ibckeeper.Keeper `optional:"true"`
 }
 
@@ -83,6 +84,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AuditKeeper,
 		in.IBCKeeperFn,
 		in.SupernodeKeeper,
+		in.LumeraIDKeeper,
 	)
 
 	m := NewAppModule(
@@ -0,0 +1,36 @@
+package action
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/action/v1/keeper"
+)
+
+// NewMigrateV1ToV2 returns the v1→v2 module migration handler.
+// v2 introduces the (expirationTime, actionID) expiration index read by
+// CheckExpiration; the handler backfills it from the PENDING and PROCESSING
+// state indices so actions created before the upgrade still expire.
+func NewMigrateV1ToV2(k keeper.Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		indexed, err := k.BackfillExpirationIndex(ctx)
+		if err != nil {
+			return err
+		}
+		k.Logger().Info("Backfilled action expiration index", "indexed", indexed)
+		return nil
+	}
+}
+
+// NewMigrateV2ToV3 returns the v2→v3 module migration handler.
+// v3 introduces the searchable metadata index read by QueryActionByMetadata;
+// the handler backfills it for every stored action.
+func NewMigrateV2ToV3(k keeper.Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		indexed, err := k.BackfillMetadataIndex(ctx)
+		if err != nil {
+			return err
+		}
+		k.Logger().Info("Backfilled action metadata index", "indexed", indexed)
+		return nil
+	}
+}
@@ -126,6 +126,12 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+	if err := cfg.RegisterMigration(types.ModuleName, 1, NewMigrateV1ToV2(am.keeper)); err != nil {
+		panic(fmt.Sprintf("failed to register action v1->v2 migration: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, NewMigrateV2ToV3(am.keeper)); err != nil {
+		panic(fmt.Sprintf("failed to register action v2->v3 migration: %v", err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -28,7 +28,7 @@ func registerSenseAction(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account,
 	params := k.GetParams(ctx)
 
 	// 1. Determine fee amount (within valid range)
-	feeAmount := generateRandomFee(r, ctx, params.BaseActionFee.Add(params.FeePerKbyte))
+	feeAmount := generateRandomFee(r, ctx, minActionFee(ctx, k, params))
 
 	// 2. Select random account with enough spendable balance for the fee
 	simAccount, ok := selectRandomAccountWithSufficientFunds(r, ctx, accs, bk, ak, feeAmount, []string{""})
@@ -71,7 +71,7 @@ func registerCascadeAction(r *rand.Rand, ctx sdk.Context, accs []simtypes.Accoun
 	params := k.GetParams(ctx)
 
 	// 1. Determine fee amount (within valid range)
-	feeAmount := generateRandomFee(r, ctx, params.BaseActionFee.Add(params.FeePerKbyte))
+	feeAmount := generateRandomFee(r, ctx, minActionFee(ctx, k, params))
 
 	// 2. Select random account with enough spendable balance for the fee
 	simAccount, ok := selectRandomAccountWithSufficientFunds(r, ctx, accs, bk, ak, feeAmount, []string{""})
@@ -377,6 +377,13 @@ func getRandomExpirationTime(ctx sdk.Context, r *rand.Rand, params types.Params)
 	return ctx.BlockTime().Add(expirationDuration).Unix()
 }
 
+// minActionFee returns the lowest price a request currently accepts: the base
+// plus per-kbyte fee, scaled by the congestion fee multiplier.
+func minActionFee(ctx sdk.Context, k keeper.Keeper, params types.Params) sdk.Coin {
+	minFee := params.BaseActionFee.Add(params.FeePerKbyte)
+	return sdk.NewCoin(minFee.Denom, k.ApplyFeeMultiplier(ctx, minFee.Amount))
+}
+
 // generateRandomFee generates a random fee amount within the valid range
 func generateRandomFee(r *rand.Rand, ctx sdk.Context, minFee sdk.Coin) sdk.Coin {
 	// Get a random amount between min fee and min fee + 1000000
@@ -60,7 +60,7 @@ func SimulateMsgCascadeWithSVCFlow(
 		challengeIndices := generateUniqueIndices(r, numChunks, m)
 
 		// Select a funded account and register the action.
-		feeAmount := generateRandomFee(r, ctx, params.BaseActionFee.Add(params.FeePerKbyte))
+		feeAmount := generateRandomFee(r, ctx, minActionFee(ctx, k, params))
 		simAccount, ok := selectRandomAccountWithSufficientFunds(r, ctx, accs, bk, ak, feeAmount, []string{""})
 		if !ok {
 			return simtypes.NoOpMsg(types.ModuleName, "MsgRequestAction", "no account with sufficient funds"), nil, nil
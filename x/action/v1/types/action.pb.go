@@ -37,6 +37,12 @@ type Action struct {
 	SuperNodes     []string    `protobuf:"bytes,9,rep,name=superNodes,proto3" json:"superNodes,omitempty"`
 	FileSizeKbs    int64       `protobuf:"varint,10,opt,name=fileSizeKbs,proto3" json:"fileSizeKbs,omitempty"`
 	AppPubkey      []byte      `protobuf:"bytes,11,opt,name=app_pubkey,json=appPubkey,proto3" json:"app_pubkey,omitempty"`
+	// Number of independent finalizations requested by the creator. Values of 0 or 1
+	// keep single-finalizer behaviour; larger values require a majority quorum of
+	// matching result hashes before the action reaches DONE.
+	FinalizationRedundancy uint32 `protobuf:"varint,12,opt,name=finalization_redundancy,json=finalizationRedundancy,proto3" json:"finalization_redundancy,omitempty"`
+	// Finalization results submitted while waiting for quorum.
+	FinalizationSubmissions []FinalizationSubmission `protobuf:"bytes,13,rep,name=finalization_submissions,json=finalizationSubmissions,proto3" json:"finalization_submissions"`
 }
 
 func (m *Action) Reset()         { *m = Action{} }
@@ -149,40 +155,128 @@ func (m *Action) GetAppPubkey() []byte {
 	return nil
 }
 
+func (m *Action) GetFinalizationRedundancy() uint32 {
+	if m != nil {
+		return m.FinalizationRedundancy
+	}
+	return 0
+}
+
+func (m *Action) GetFinalizationSubmissions() []FinalizationSubmission {
+	if m != nil {
+		return m.FinalizationSubmissions
+	}
+	return nil
+}
+
+// FinalizationSubmission records one supernode's finalization result for a
+// multi-supernode action.
+type FinalizationSubmission struct {
+	Supernode string `protobuf:"bytes,1,opt,name=supernode,proto3" json:"supernode,omitempty"`
+	// SHA-256 of the submitted protobuf-encoded finalization metadata.
+	ResultHash []byte `protobuf:"bytes,2,opt,name=result_hash,json=resultHash,proto3" json:"result_hash,omitempty"`
+	// Updated action metadata for this result. Only kept on the first submission
+	// of each result hash, and cleared once the action reaches DONE.
+	Metadata []byte `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *FinalizationSubmission) Reset()         { *m = FinalizationSubmission{} }
+func (m *FinalizationSubmission) String() string { return proto.CompactTextString(m) }
+func (*FinalizationSubmission) ProtoMessage()    {}
+func (*FinalizationSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3f0f18df833b98, []int{1}
+}
+func (m *FinalizationSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizationSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizationSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizationSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizationSubmission.Merge(m, src)
+}
+func (m *FinalizationSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizationSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizationSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizationSubmission proto.InternalMessageInfo
+
+func (m *FinalizationSubmission) GetSupernode() string {
+	if m != nil {
+		return m.Supernode
+	}
+	return ""
+}
+
+func (m *FinalizationSubmission) GetResultHash() []byte {
+	if m != nil {
+		return m.ResultHash
+	}
+	return nil
+}
+
+func (m *FinalizationSubmission) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Action)(nil), "lumera.action.v1.Action")
+	proto.RegisterType((*FinalizationSubmission)(nil), "lumera.action.v1.FinalizationSubmission")
 }
 
 func init() { proto.RegisterFile("lumera/action/v1/action.proto", fileDescriptor_0e3f0f18df833b98) }
 
 var fileDescriptor_0e3f0f18df833b98 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x18, 0xc7, 0x3b, 0xc6, 0x76, 0x37, 0xb3, 0xb2, 0xe8, 0xd0, 0xc3, 0x58, 0x4c, 0x88, 0x2b, 0x48,
-	0x40, 0x36, 0x65, 0xb7, 0x57, 0x2f, 0x29, 0x1e, 0x14, 0x41, 0x24, 0x15, 0x0f, 0x5e, 0xca, 0x24,
-	0x19, 0xe3, 0xd0, 0xa4, 0x33, 0xcc, 0x4c, 0x4b, 0xeb, 0x53, 0xf8, 0x30, 0x7d, 0x08, 0xc1, 0x4b,
-	0xe9, 0xc9, 0xa3, 0xb4, 0x2f, 0x22, 0x99, 0x89, 0x25, 0x16, 0x7a, 0xfb, 0xbe, 0xff, 0xf7, 0x9b,
-	0xef, 0x3f, 0xf9, 0x67, 0xa0, 0x57, 0x2e, 0x2a, 0x2a, 0xc9, 0x90, 0x64, 0x9a, 0xf1, 0xf9, 0x70,
-	0x79, 0xd7, 0x54, 0x91, 0x90, 0x5c, 0x73, 0xf4, 0xd8, 0x8e, 0xa3, 0x46, 0x5c, 0xde, 0x0d, 0xfa,
-	0x05, 0x2f, 0xb8, 0x19, 0x0e, 0xeb, 0xca, 0x72, 0x83, 0xa7, 0x19, 0x57, 0x15, 0x57, 0x53, 0x3b,
-	0xb0, 0x4d, 0x33, 0x7a, 0x71, 0xc6, 0x61, 0xaa, 0x34, 0xd1, 0xb4, 0x81, 0x6e, 0xce, 0x41, 0x7a,
-	0x2d, 0x1a, 0xe6, 0xe6, 0x97, 0x03, 0x7b, 0xb1, 0x51, 0xd1, 0x3d, 0xbc, 0xc8, 0x24, 0x25, 0x9a,
-	0x4b, 0x0c, 0x02, 0x10, 0xba, 0x63, 0xbc, 0xdb, 0xdc, 0xf6, 0x1b, 0xdb, 0x38, 0xcf, 0x25, 0x55,
-	0x6a, 0xa2, 0x25, 0x9b, 0x17, 0xc9, 0x3f, 0x10, 0x0d, 0xe0, 0xa5, 0xdd, 0xf9, 0xee, 0x0d, 0x7e,
-	0x50, 0x1f, 0x4a, 0x8e, 0x3d, 0x7a, 0x0d, 0xa1, 0xad, 0x3f, 0xad, 0x05, 0xc5, 0x4e, 0x00, 0xc2,
-	0xeb, 0xfb, 0x67, 0xd1, 0xe9, 0xb7, 0x47, 0xf1, 0x91, 0x49, 0x5a, 0x7c, 0xbd, 0xb9, 0xa2, 0x9a,
-	0xe4, 0x44, 0x13, 0xfc, 0x30, 0x00, 0xe1, 0xa3, 0xe4, 0xd8, 0xa3, 0x3e, 0xec, 0x0a, 0xc9, 0x32,
-	0x8a, 0xbb, 0xc6, 0xd2, 0x36, 0xe8, 0x25, 0xbc, 0xa6, 0x2b, 0xc1, 0x24, 0x31, 0x3b, 0x58, 0x45,
-	0x71, 0x2f, 0x00, 0xa1, 0x93, 0x9c, 0xa8, 0x68, 0x04, 0xbb, 0x26, 0x25, 0x7c, 0x61, 0xae, 0xe4,
-	0x9d, 0xbb, 0xd2, 0xa4, 0x86, 0x12, 0xcb, 0xa2, 0x00, 0x5e, 0xa5, 0x25, 0xcf, 0x66, 0x6f, 0x29,
-	0x2b, 0xbe, 0x69, 0x7c, 0x69, 0x36, 0xb7, 0x25, 0x14, 0x43, 0xa8, 0x16, 0x82, 0xca, 0x0f, 0x3c,
-	0xa7, 0x0a, 0xbb, 0x81, 0x13, 0xba, 0xe3, 0xe7, 0xbb, 0xcd, 0xad, 0xd7, 0x24, 0xf8, 0x99, 0x94,
-	0x2c, 0xaf, 0x43, 0xfb, 0x3f, 0xca, 0xd6, 0xa1, 0xda, 0xe4, 0x2b, 0x2b, 0xe9, 0x84, 0x7d, 0xa7,
-	0xef, 0x53, 0x85, 0xa1, 0x35, 0x69, 0x49, 0xc8, 0x83, 0x90, 0x08, 0x31, 0x15, 0x8b, 0x74, 0x46,
-	0xd7, 0xf8, 0xca, 0xe4, 0xe2, 0x12, 0x21, 0x3e, 0x1a, 0x61, 0xfc, 0xea, 0xe7, 0xde, 0x07, 0xdb,
-	0xbd, 0x0f, 0xfe, 0xec, 0x7d, 0xf0, 0xe3, 0xe0, 0x77, 0xb6, 0x07, 0xbf, 0xf3, 0xfb, 0xe0, 0x77,
-	0xbe, 0x3c, 0x59, 0xb5, 0x9e, 0x41, 0xfd, 0xff, 0x55, 0xda, 0x33, 0x2f, 0x60, 0xf4, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x40, 0x60, 0x07, 0xc4, 0xae, 0x02, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0x9a, 0x26, 0x6d, 0xbe, 0xa4, 0x45, 0x87, 0xd0, 0x8e, 0xc1, 0x6c, 0xd7, 0x08, 0xb2,
+	0x20, 0x4d, 0x68, 0x0a, 0x7a, 0xf1, 0x92, 0x20, 0x52, 0x11, 0x44, 0x36, 0xe2, 0xc1, 0x4b, 0x98,
+	0xec, 0x4e, 0x92, 0xa1, 0x9b, 0x9d, 0x61, 0x66, 0xb6, 0x34, 0x7d, 0x07, 0xc1, 0x87, 0xe9, 0x43,
+	0xf4, 0x58, 0x7a, 0xf2, 0x24, 0x92, 0x3c, 0x80, 0xaf, 0x20, 0x3b, 0xbb, 0xc6, 0x4d, 0x6d, 0xf0,
+	0x36, 0xdf, 0xef, 0xcf, 0xf7, 0xcd, 0xfc, 0xf8, 0x06, 0x9a, 0x61, 0x3c, 0xa3, 0x92, 0x74, 0x88,
+	0xaf, 0x19, 0x8f, 0x3a, 0xe7, 0xc7, 0xd9, 0xa9, 0x2d, 0x24, 0xd7, 0x1c, 0x3d, 0x4c, 0xe9, 0x76,
+	0x06, 0x9e, 0x1f, 0x37, 0xea, 0x13, 0x3e, 0xe1, 0x86, 0xec, 0x24, 0xa7, 0x54, 0xd7, 0x78, 0xec,
+	0x73, 0x35, 0xe3, 0x6a, 0x98, 0x12, 0x69, 0x91, 0x51, 0xcf, 0x36, 0x4c, 0x18, 0x2a, 0x4d, 0x34,
+	0xcd, 0x44, 0xad, 0x4d, 0x22, 0x3d, 0x17, 0x99, 0xa6, 0xf5, 0x6b, 0x0b, 0xca, 0x3d, 0x83, 0xa2,
+	0x2e, 0x6c, 0xfb, 0x92, 0x12, 0xcd, 0x25, 0xb6, 0x1c, 0xcb, 0xad, 0xf4, 0xf1, 0xed, 0xd5, 0x51,
+	0x3d, 0x1b, 0xdb, 0x0b, 0x02, 0x49, 0x95, 0x1a, 0x68, 0xc9, 0xa2, 0x89, 0xf7, 0x47, 0x88, 0x1a,
+	0xb0, 0x93, 0xf6, 0x7c, 0xf7, 0x06, 0x3f, 0x48, 0x4c, 0xde, 0xaa, 0x46, 0xaf, 0x01, 0xd2, 0xf3,
+	0xa7, 0xb9, 0xa0, 0xb8, 0xe8, 0x58, 0xee, 0x5e, 0xf7, 0x49, 0xfb, 0xee, 0xdb, 0xdb, 0xbd, 0x95,
+	0xc6, 0xcb, 0xe9, 0x93, 0xce, 0x33, 0xaa, 0x49, 0x40, 0x34, 0xc1, 0x5b, 0x8e, 0xe5, 0xd6, 0xbc,
+	0x55, 0x8d, 0xea, 0x50, 0x12, 0x92, 0xf9, 0x14, 0x97, 0xcc, 0xc8, 0xb4, 0x40, 0xcf, 0x61, 0x8f,
+	0x5e, 0x08, 0x26, 0x89, 0xe9, 0xc1, 0x66, 0x14, 0x97, 0x1d, 0xcb, 0x2d, 0x7a, 0x77, 0x50, 0x74,
+	0x02, 0x25, 0x93, 0x12, 0xde, 0x36, 0x57, 0x6a, 0x6e, 0xba, 0xd2, 0x20, 0x11, 0x79, 0xa9, 0x16,
+	0x39, 0x50, 0x1d, 0x85, 0xdc, 0x3f, 0x3b, 0xa5, 0x6c, 0x32, 0xd5, 0x78, 0xc7, 0x74, 0xce, 0x43,
+	0xa8, 0x07, 0xa0, 0x62, 0x41, 0xe5, 0x07, 0x1e, 0x50, 0x85, 0x2b, 0x4e, 0xd1, 0xad, 0xf4, 0x9f,
+	0xde, 0x5e, 0x1d, 0x35, 0xb3, 0x04, 0x3f, 0x93, 0x90, 0x05, 0x49, 0x68, 0xeb, 0x51, 0xe6, 0x4c,
+	0xc9, 0x90, 0x31, 0x0b, 0xe9, 0x80, 0x5d, 0xd2, 0xf7, 0x23, 0x85, 0x21, 0x1d, 0x92, 0x83, 0x50,
+	0x13, 0x80, 0x08, 0x31, 0x14, 0xf1, 0xe8, 0x8c, 0xce, 0x71, 0xd5, 0xe4, 0x52, 0x21, 0x42, 0x7c,
+	0x34, 0x00, 0x7a, 0x05, 0x07, 0x63, 0x16, 0x91, 0x90, 0x5d, 0x9a, 0xe7, 0x0e, 0x25, 0x0d, 0xe2,
+	0x28, 0x20, 0x91, 0x3f, 0xc7, 0x35, 0xc7, 0x72, 0x77, 0xbd, 0xfd, 0x3c, 0xed, 0xad, 0x58, 0xc4,
+	0x00, 0xaf, 0x19, 0x55, 0x3c, 0x9a, 0x31, 0xa5, 0x18, 0x8f, 0x14, 0xde, 0x75, 0x8a, 0x6e, 0xb5,
+	0xeb, 0xfe, 0x1b, 0xd3, 0xdb, 0x9c, 0x63, 0xb0, 0x32, 0xf4, 0xb7, 0xae, 0x7f, 0x1c, 0x16, 0xbc,
+	0x83, 0xf1, 0xbd, 0xac, 0x6a, 0x7d, 0xb5, 0x60, 0xff, 0x7e, 0x27, 0x7a, 0x09, 0x15, 0x93, 0x46,
+	0xc4, 0x03, 0xfa, 0xdf, 0x1d, 0xfc, 0x2b, 0x45, 0x87, 0x50, 0x95, 0x54, 0xc5, 0xa1, 0x1e, 0x4e,
+	0x89, 0x9a, 0x9a, 0x45, 0xac, 0x79, 0x90, 0x42, 0xa7, 0x44, 0x4d, 0xd7, 0x96, 0xa9, 0xb8, 0xbe,
+	0x4c, 0xfd, 0x17, 0xd7, 0x0b, 0xdb, 0xba, 0x59, 0xd8, 0xd6, 0xcf, 0x85, 0x6d, 0x7d, 0x5b, 0xda,
+	0x85, 0x9b, 0xa5, 0x5d, 0xf8, 0xbe, 0xb4, 0x0b, 0x5f, 0x1e, 0x5d, 0xe4, 0xbe, 0x4e, 0xf2, 0x67,
+	0xd4, 0xa8, 0x6c, 0x7e, 0xcd, 0xc9, 0xef, 0x01, 0x00, 0xec, 0xa0, 0x0d, 0xef, 0xe2, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Action) Marshal() (dAtA []byte, err error) {
@@ -205,6 +299,25 @@ func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalizationSubmissions) > 0 {
+		for iNdEx := len(m.FinalizationSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.FinalizationRedundancy != 0 {
+		i = encodeVarintAction(dAtA, i, uint64(m.FinalizationRedundancy))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.AppPubkey) > 0 {
 		i -= len(m.AppPubkey)
 		copy(dAtA[i:], m.AppPubkey)
@@ -277,6 +390,50 @@ func (m *Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalizationSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizationSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizationSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ResultHash) > 0 {
+		i -= len(m.ResultHash)
+		copy(dAtA[i:], m.ResultHash)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.ResultHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Supernode) > 0 {
+		i -= len(m.Supernode)
+		copy(dAtA[i:], m.Supernode)
+		i = encodeVarintAction(dAtA, i, uint64(len(m.Supernode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAction(v)
 	base := offset
@@ -335,6 +492,36 @@ func (m *Action) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAction(uint64(l))
 	}
+	if m.FinalizationRedundancy != 0 {
+		n += 1 + sovAction(uint64(m.FinalizationRedundancy))
+	}
+	if len(m.FinalizationSubmissions) > 0 {
+		for _, e := range m.FinalizationSubmissions {
+			l = e.Size()
+			n += 1 + l + sovAction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizationSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Supernode)
+	if l > 0 {
+		n += 1 + l + sovAction(uint64(l))
+	}
+	l = len(m.ResultHash)
+	if l > 0 {
+		n += 1 + l + sovAction(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovAction(uint64(l))
+	}
 	return n
 }
 
@@ -664,6 +851,209 @@ func (m *Action) Unmarshal(dAtA []byte) error {
 				m.AppPubkey = []byte{}
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationRedundancy", wireType)
+			}
+			m.FinalizationRedundancy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizationRedundancy |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationSubmissions = append(m.FinalizationSubmissions, FinalizationSubmission{})
+			if err := m.FinalizationSubmissions[len(m.FinalizationSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizationSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizationSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizationSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supernode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supernode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultHash = append(m.ResultHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResultHash == nil {
+				m.ResultHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAction(dAtA[iNdEx:])
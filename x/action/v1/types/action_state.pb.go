@@ -41,6 +41,8 @@ const (
 	ActionStateFailed ActionState = 6
 	// The action has expired and is no longer valid.
 	ActionStateExpired ActionState = 7
+	// The action was cancelled by its creator before processing started.
+	ActionStateCancelled ActionState = 8
 )
 
 var ActionState_name = map[int32]string{
@@ -52,6 +54,7 @@ var ActionState_name = map[int32]string{
 	5: "ACTION_STATE_REJECTED",
 	6: "ACTION_STATE_FAILED",
 	7: "ACTION_STATE_EXPIRED",
+	8: "ACTION_STATE_CANCELLED",
 }
 
 var ActionState_value = map[string]int32{
@@ -63,6 +66,7 @@ var ActionState_value = map[string]int32{
 	"ACTION_STATE_REJECTED":    5,
 	"ACTION_STATE_FAILED":      6,
 	"ACTION_STATE_EXPIRED":     7,
+	"ACTION_STATE_CANCELLED":   8,
 }
 
 func (x ActionState) String() string {
@@ -82,29 +86,30 @@ func init() {
 }
 
 var fileDescriptor_db0bbc79af153c92 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd2, 0xbf, 0x6e, 0x9b, 0x40,
-	0x1c, 0xc0, 0x71, 0xa8, 0x5b, 0xb7, 0xba, 0x0e, 0x3d, 0xe3, 0x7f, 0xed, 0x0d, 0x27, 0xa4, 0x6e,
-	0xae, 0x84, 0xeb, 0x56, 0xaa, 0xba, 0x52, 0xee, 0x5c, 0x11, 0x45, 0x80, 0x00, 0x47, 0x51, 0x16,
-	0x8b, 0xc0, 0xc5, 0x22, 0x72, 0x38, 0x04, 0xc4, 0x72, 0xde, 0x20, 0x62, 0xca, 0x0b, 0x30, 0x25,
-	0x43, 0xd6, 0xbc, 0x45, 0x46, 0x8f, 0x19, 0x23, 0xfb, 0x45, 0x22, 0xec, 0x0c, 0x20, 0x67, 0xfb,
-	0x49, 0xbf, 0xef, 0x67, 0xf9, 0xdd, 0x81, 0xef, 0xf3, 0xcb, 0x0b, 0x96, 0x78, 0x43, 0xcf, 0xcf,
-	0x42, 0x1e, 0x0d, 0x17, 0xa3, 0xd7, 0x69, 0x9a, 0x66, 0x5e, 0xc6, 0x94, 0x38, 0xe1, 0x19, 0x97,
-	0xe0, 0x2e, 0x52, 0x76, 0x2b, 0x65, 0x31, 0x42, 0x9d, 0x19, 0x9f, 0xf1, 0xed, 0x72, 0x58, 0x4e,
-	0xbb, 0x6e, 0xf0, 0xd0, 0x00, 0x9f, 0xd5, 0x6d, 0xe3, 0x94, 0x5a, 0xfa, 0x0b, 0xbe, 0xaa, 0x9a,
-	0xab, 0x9b, 0xc6, 0xd4, 0x71, 0x55, 0x97, 0x4e, 0x27, 0x86, 0x63, 0x51, 0x4d, 0x1f, 0xeb, 0x94,
-	0x40, 0x01, 0xa1, 0xbc, 0x90, 0x7b, 0x95, 0x7c, 0x12, 0xa5, 0x31, 0xf3, 0xc3, 0xb3, 0x90, 0x05,
-	0xd2, 0x4f, 0xd0, 0xa9, 0x49, 0x8b, 0x1a, 0x44, 0x37, 0xfe, 0x43, 0x11, 0xf5, 0xf2, 0x42, 0x96,
-	0x2a, 0xca, 0x62, 0x51, 0x10, 0x46, 0x33, 0xe9, 0x0f, 0xe8, 0xd7, 0x85, 0x6d, 0x6a, 0xd4, 0x71,
-	0x4a, 0xf4, 0x0e, 0x7d, 0xcb, 0x0b, 0xb9, 0x5b, 0x45, 0x09, 0xf7, 0x59, 0x9a, 0x96, 0x6e, 0x00,
-	0x5a, 0x35, 0x47, 0x4c, 0x83, 0xc2, 0x06, 0x6a, 0xe7, 0x85, 0xfc, 0xa5, 0x22, 0x08, 0x8f, 0x98,
-	0xf4, 0x0b, 0x74, 0x6b, 0xad, 0x6a, 0x59, 0xb6, 0x79, 0x44, 0x09, 0x7c, 0x8f, 0xfa, 0x79, 0x21,
-	0xb7, 0x2b, 0xbd, 0x1a, 0xc7, 0x09, 0x5f, 0xb0, 0x60, 0xcf, 0xd8, 0xf4, 0x80, 0x6a, 0x2e, 0x25,
-	0xf0, 0xc3, 0x9e, 0xb1, 0xd9, 0x39, 0xf3, 0x33, 0x16, 0x48, 0x0a, 0x68, 0xd7, 0xcc, 0x58, 0xd5,
-	0x0f, 0x29, 0x81, 0x4d, 0xd4, 0xcd, 0x0b, 0xb9, 0x55, 0x11, 0x63, 0x2f, 0x9c, 0xbf, 0x71, 0x2d,
-	0x7a, 0x6c, 0xe9, 0x36, 0x25, 0xf0, 0xe3, 0xde, 0xb5, 0xe8, 0x32, 0x0e, 0x13, 0x16, 0xa0, 0x4f,
-	0xd7, 0xb7, 0x58, 0xb8, 0xbf, 0xc3, 0xe2, 0xbf, 0x1f, 0x8f, 0x6b, 0x2c, 0xae, 0xd6, 0x58, 0x7c,
-	0x5e, 0x63, 0xf1, 0x66, 0x83, 0x85, 0xd5, 0x06, 0x0b, 0x4f, 0x1b, 0x2c, 0x9c, 0xb4, 0x96, 0x95,
-	0x5f, 0x91, 0x5d, 0xc5, 0x2c, 0x3d, 0x6d, 0x6e, 0xdf, 0xf9, 0xf7, 0x4b, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0x72, 0xcd, 0x54, 0x36, 0x02, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd2, 0xcd, 0xaa, 0xd3, 0x40,
+	0x14, 0xc0, 0xf1, 0xc4, 0xab, 0xd7, 0xcb, 0xb8, 0x70, 0x3a, 0xfd, 0xb8, 0x75, 0x16, 0x43, 0xc0,
+	0x5d, 0x85, 0xd4, 0xaa, 0x88, 0xdb, 0x98, 0x99, 0x4a, 0xa4, 0x24, 0x21, 0x49, 0x45, 0xdc, 0x94,
+	0x98, 0x8c, 0x25, 0x12, 0x33, 0x21, 0x89, 0xa5, 0xbe, 0x81, 0x64, 0xe5, 0x0b, 0x64, 0xa5, 0x0b,
+	0x1f, 0xc5, 0x65, 0x97, 0x2e, 0xa5, 0x5d, 0xf8, 0x1a, 0x92, 0xd6, 0xc5, 0x84, 0xde, 0xdd, 0xc0,
+	0xf9, 0xff, 0x18, 0x38, 0x1c, 0xf0, 0x30, 0xfd, 0xfc, 0x89, 0x17, 0xe1, 0x34, 0x8c, 0xaa, 0x44,
+	0x64, 0xd3, 0xcd, 0xec, 0xff, 0x6b, 0x55, 0x56, 0x61, 0xc5, 0xf5, 0xbc, 0x10, 0x95, 0x40, 0xf0,
+	0x14, 0xe9, 0xa7, 0x91, 0xbe, 0x99, 0xe1, 0xc1, 0x5a, 0xac, 0xc5, 0x71, 0x38, 0x6d, 0x5f, 0xa7,
+	0x6e, 0xf2, 0xf7, 0x02, 0xdc, 0x33, 0x8e, 0x8d, 0xdf, 0x6a, 0xf4, 0x02, 0x8c, 0x0d, 0x33, 0xb0,
+	0x1c, 0x7b, 0xe5, 0x07, 0x46, 0xc0, 0x56, 0x4b, 0xdb, 0x77, 0x99, 0x69, 0xcd, 0x2d, 0x46, 0xa1,
+	0x82, 0x71, 0xdd, 0x68, 0x23, 0x29, 0x5f, 0x66, 0x65, 0xce, 0xa3, 0xe4, 0x43, 0xc2, 0x63, 0xf4,
+	0x18, 0x0c, 0x3a, 0xd2, 0x65, 0x36, 0xb5, 0xec, 0x57, 0x50, 0xc5, 0xa3, 0xba, 0xd1, 0x90, 0xa4,
+	0x5c, 0x9e, 0xc5, 0x49, 0xb6, 0x46, 0xcf, 0xc1, 0x75, 0x57, 0x78, 0x8e, 0xc9, 0x7c, 0xbf, 0x45,
+	0xb7, 0xf0, 0x83, 0xba, 0xd1, 0x86, 0x32, 0x2a, 0x44, 0xc4, 0xcb, 0xb2, 0x75, 0x13, 0xd0, 0xeb,
+	0x38, 0xea, 0xd8, 0x0c, 0x5e, 0xe0, 0x7e, 0xdd, 0x68, 0xf7, 0x25, 0x41, 0x45, 0xc6, 0xd1, 0x13,
+	0x30, 0xec, 0xb4, 0x86, 0xeb, 0x7a, 0xce, 0x1b, 0x46, 0xe1, 0x6d, 0x7c, 0x5d, 0x37, 0x5a, 0x5f,
+	0xea, 0x8d, 0x3c, 0x2f, 0xc4, 0x86, 0xc7, 0x67, 0xc6, 0x63, 0xaf, 0x99, 0x19, 0x30, 0x0a, 0xef,
+	0x9c, 0x19, 0x8f, 0x7f, 0xe4, 0x51, 0xc5, 0x63, 0xa4, 0x83, 0x7e, 0xc7, 0xcc, 0x0d, 0x6b, 0xc1,
+	0x28, 0xbc, 0xc4, 0xc3, 0xba, 0xd1, 0x7a, 0x92, 0x98, 0x87, 0x49, 0x7a, 0xc3, 0xb6, 0xd8, 0x5b,
+	0xd7, 0xf2, 0x18, 0x85, 0x77, 0xcf, 0xb6, 0xc5, 0xb6, 0x79, 0x52, 0xf0, 0x18, 0x3d, 0x03, 0xa3,
+	0x8e, 0x30, 0x0d, 0xdb, 0x64, 0x8b, 0xf6, 0x93, 0x2b, 0x3c, 0xae, 0x1b, 0x6d, 0x20, 0x19, 0x33,
+	0xcc, 0x22, 0x9e, 0xa6, 0x3c, 0xc6, 0x57, 0x5f, 0xbf, 0x13, 0xe5, 0xe7, 0x0f, 0xa2, 0xbe, 0x7c,
+	0xf4, 0x6b, 0x4f, 0xd4, 0xdd, 0x9e, 0xa8, 0x7f, 0xf6, 0x44, 0xfd, 0x76, 0x20, 0xca, 0xee, 0x40,
+	0x94, 0xdf, 0x07, 0xa2, 0xbc, 0xeb, 0x6d, 0xa5, 0x5b, 0xaa, 0xbe, 0xe4, 0xbc, 0x7c, 0x7f, 0x79,
+	0xbc, 0x8e, 0xa7, 0xff, 0x06, 0x00, 0x5e, 0x69, 0x36, 0x84, 0x6c, 0x02, 0x00, 0x00,
 }
@@ -1,31 +1,178 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
-	"github.com/LumeraProtocol/lumera/x/action/v1/common"
+	"math"
+	"reflect"
 	"strings"
+	"sync"
+
+	"github.com/LumeraProtocol/lumera/x/action/v1/common"
 )
 
+// ActionTypeValidator performs the stateless metadata checks of an action type.
 type ActionTypeValidator interface {
-	// ActionType returns the enum constant this validator corresponds to.
-	ActionType() ActionType
-
 	// ValidateBasic handles any checks specific to this action type.
 	// Return an error if validation fails; otherwise nil.
 	ValidateBasic(metadataStr string, msgType common.MessageType) error
 }
 
-var validatorRegistry = make(map[string]ActionTypeValidator)
+// firstPluginActionTypeCode is the lowest code derived for plugin action
+// types. Codes below it are reserved for the ActionType proto enum.
+const firstPluginActionTypeCode = 1 << 16
+
+// registeredActionType is an action type known to the registry.
+type registeredActionType struct {
+	name      string // canonical name or type URL
+	code      ActionType
+	validator ActionTypeValidator
+}
+
+// actionTypeRegistry maps action type names, aliases and codes to registered
+// action types. It is kept apart from the generated ActionType enum maps,
+// which stay limited to the types declared in proto.
+type actionTypeRegistry struct {
+	mu     sync.RWMutex
+	byName map[string]*registeredActionType // upper-cased names and aliases
+	byCode map[ActionType]*registeredActionType
+}
+
+var actionTypes = &actionTypeRegistry{
+	byName: make(map[string]*registeredActionType),
+	byCode: make(map[ActionType]*registeredActionType),
+}
+
+func normalizeActionTypeName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+// PluginActionTypeCode returns the code stored on the wire for a plugin action
+// type. It is derived from the type name, so every node assigns the same code
+// without plugins having to pick one.
+func PluginActionTypeCode(name string) ActionType {
+	sum := sha256.Sum256([]byte(normalizeActionTypeName(name)))
+	code := binary.BigEndian.Uint32(sum[:4]) & math.MaxInt32
+	if code < firstPluginActionTypeCode {
+		code += firstPluginActionTypeCode
+	}
+	return ActionType(code)
+}
+
+// registerBuiltinActionType registers an action type declared in the
+// ActionType proto enum under its enum name and aliases.
+func registerBuiltinActionType(code ActionType, v ActionTypeValidator, aliases ...string) {
+	if _, err := actionTypes.register(code.String(), code, v, aliases); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterActionType registers an action type keyed by name or type URL, e.g.
+// "ACTION_TYPE_INFERENCE" or "/lumera.inference.v1.InferenceMetadata". Names
+// of ActionType enum values keep their enum code; other names get the code
+// returned by PluginActionTypeCode. Registering the same name again with a
+// validator of the same type is a no-op; a different validator is rejected.
+func RegisterActionType(name string, v ActionTypeValidator, aliases ...string) (ActionType, error) {
+	if v == nil {
+		return ActionTypeUnspecified, fmt.Errorf("validator is required for action type %s", name)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ActionTypeUnspecified, fmt.Errorf("action type name cannot be empty")
+	}
+
+	code := PluginActionTypeCode(name)
+	if enumCode, ok := ActionType_value[normalizeActionTypeName(name)]; ok {
+		code = ActionType(enumCode)
+		name = code.String()
+	}
+	if code <= ActionTypeUnspecified {
+		return ActionTypeUnspecified, fmt.Errorf("action type %s cannot be unspecified", name)
+	}
+	return actionTypes.register(name, code, v, aliases)
+}
+
+func (r *actionTypeRegistry) register(name string, code ActionType, v ActionTypeValidator, aliases []string) (ActionType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.byCode[code]; ok && normalizeActionTypeName(existing.name) != normalizeActionTypeName(name) {
+		return ActionTypeUnspecified, fmt.Errorf("action type %s has code %d, already used by %s", name, code, existing.name)
+	}
+	keys := append([]string{name}, aliases...)
+	for _, key := range keys {
+		if prev, ok := r.byName[normalizeActionTypeName(key)]; ok && prev.code != code {
+			return ActionTypeUnspecified, fmt.Errorf("action type name %s already used by %s", key, prev.name)
+		}
+	}
+
+	entry, ok := r.byCode[code]
+	if ok && reflect.TypeOf(entry.validator) != reflect.TypeOf(v) {
+		return ActionTypeUnspecified, fmt.Errorf("action type %s is already registered with validator %T", name, entry.validator)
+	}
+	if !ok {
+		entry = &registeredActionType{name: name, code: code, validator: v}
+	}
+	r.byCode[code] = entry
+	for _, key := range keys {
+		r.byName[normalizeActionTypeName(key)] = entry
+	}
+	return code, nil
+}
+
+// DeregisterActionType removes a plugin action type and its aliases. It lets
+// tests that register plugins clean up after themselves.
+func DeregisterActionType(name string) {
+	actionTypes.mu.Lock()
+	defer actionTypes.mu.Unlock()
 
-func RegisterValidator(v ActionTypeValidator, aliases ...string) {
-	for _, alias := range aliases {
-		key := strings.ToUpper(alias)
-		validatorRegistry[key] = v
+	entry, ok := actionTypes.byName[normalizeActionTypeName(name)]
+	if !ok {
+		return
 	}
+	if _, builtin := ActionType_name[int32(entry.code)]; builtin {
+		return
+	}
+	delete(actionTypes.byCode, entry.code)
+	for key, e := range actionTypes.byName {
+		if e == entry {
+			delete(actionTypes.byName, key)
+		}
+	}
+}
+
+func (r *actionTypeRegistry) lookupName(name string) (*registeredActionType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry, ok := r.byName[normalizeActionTypeName(name)]
+	return entry, ok
+}
+
+func (r *actionTypeRegistry) lookupCode(code ActionType) (*registeredActionType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry, ok := r.byCode[code]
+	return entry, ok
 }
 
-func init() {
-	// Use init() in the files for each action type to register their validators.
+// IsRegisteredActionType reports whether a validator is registered for the
+// given action type code.
+func IsRegisteredActionType(actionType ActionType) bool {
+	if actionType == ActionTypeUnspecified {
+		return false
+	}
+	_, ok := actionTypes.lookupCode(actionType)
+	return ok
+}
+
+// ActionTypeName returns the canonical name of a registered action type, or
+// the enum string (the bare code for unknown plugin codes) otherwise.
+func ActionTypeName(actionType ActionType) string {
+	if entry, ok := actionTypes.lookupCode(actionType); ok {
+		return entry.name
+	}
+	return actionType.String()
 }
 
 func DoActionValidation(metadata string, actionTypeStr string, msgType common.MessageType) error {
@@ -34,12 +181,12 @@ func DoActionValidation(metadata string, actionTypeStr string, msgType common.Me
 		return err
 	}
 
-	validator := validatorRegistry[actionType.String()]
-	if validator == nil {
-		return fmt.Errorf("no validator registered for action type: %s", actionType.String())
+	entry, ok := actionTypes.lookupCode(actionType)
+	if !ok {
+		return fmt.Errorf("no validator registered for action type: %s", ActionTypeName(actionType))
 	}
 
-	return validator.ValidateBasic(metadata, msgType)
+	return entry.validator.ValidateBasic(metadata, msgType)
 }
 
 // ParseActionType converts a string action type to ActionType enum in a case-insensitive way
@@ -48,13 +195,9 @@ func ParseActionType(actionTypeStr string) (ActionType, error) {
 		return ActionTypeUnspecified, fmt.Errorf("action type cannot be empty")
 	}
 
-	v := validatorRegistry[strings.ToUpper(actionTypeStr)]
-	if v == nil {
+	entry, ok := actionTypes.lookupName(actionTypeStr)
+	if !ok {
 		return ActionTypeUnspecified, fmt.Errorf("unknown action type: %s", actionTypeStr)
 	}
-	// If the validator’s ActionType is UNSPECIFIED, treat it as an error
-	if v.ActionType() == ActionTypeUnspecified {
-		return v.ActionType(), fmt.Errorf("action type cannot be unspecified")
-	}
-	return v.ActionType(), nil
+	return entry.code, nil
 }
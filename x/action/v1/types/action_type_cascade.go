@@ -9,11 +9,8 @@ import (
 type CascadeValidator struct{}
 
 func init() {
-	// Register the Sense validator with multiple string aliases:
-	RegisterValidator(&CascadeValidator{},
-		"CASCADE",
-		"ACTION_TYPE_CASCADE",
-	)
+	// Register the Cascade validator under its enum name and alias:
+	registerBuiltinActionType(ActionTypeCascade, &CascadeValidator{}, "CASCADE")
 }
 
 func (v *CascadeValidator) ActionType() ActionType {
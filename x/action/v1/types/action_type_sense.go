@@ -9,11 +9,8 @@ import (
 type SenseValidator struct{}
 
 func init() {
-	// Register the Sense validator with multiple string aliases:
-	RegisterValidator(&SenseValidator{},
-		"SENSE",
-		"ACTION_TYPE_SENSE",
-	)
+	// Register the Sense validator under its enum name and alias:
+	registerBuiltinActionType(ActionTypeSense, &SenseValidator{}, "SENSE")
 }
 
 func (v *SenseValidator) ActionType() ActionType {
@@ -24,6 +24,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgApproveAction{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelAction{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
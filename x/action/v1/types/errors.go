@@ -25,6 +25,8 @@ var (
 	ErrWrongProofCount      = errorsmod.Register(ModuleName, 17, "wrong chunk proof count")
 	ErrWrongChallengeIndex  = errorsmod.Register(ModuleName, 18, "wrong challenge index")
 	ErrInvalidMerkleProof   = errorsmod.Register(ModuleName, 19, "invalid merkle proof")
+	ErrUnauthorizedCreator  = errorsmod.Register(ModuleName, 20, "unauthorized creator")
+	ErrInvalidRedundancy    = errorsmod.Register(ModuleName, 21, "invalid finalization redundancy")
 	ErrInvalidSigner        = errorsmod.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidPacketTimeout = errorsmod.Register(ModuleName, 1500, "invalid packet timeout")
 	ErrInvalidVersion       = errorsmod.Register(ModuleName, 1501, "invalid version")
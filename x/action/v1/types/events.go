@@ -8,6 +8,9 @@ const (
 	EventTypeActionApproved             = "action_approved"
 	EventTypeActionFailed               = "action_failed"
 	EventTypeActionExpired              = "action_expired"
+	EventTypeActionCancelled            = "action_cancelled"
+	EventTypeActionFinalizationAccepted = "action_finalization_accepted"
+	EventTypeFeeMultiplierUpdated       = "action_fee_multiplier_updated"
 	EventTypeSVCEvidence                = "svc_verification_failed_evidence"
 	EventTypeSVCVerificationPassed      = "svc_verification_passed"
 
@@ -19,6 +22,12 @@ const (
 	AttributeKeyActionType         = "action_type"
 	AttributeKeyResults            = "results"
 	AttributeKeyFee                = "fee"
+	AttributeKeyRefund             = "refund"
+	AttributeKeyResultHash         = "result_hash"
+	AttributeKeyMatchingResults    = "matching_results"
+	AttributeKeyQuorum             = "quorum"
+	AttributeKeyFeeMultiplier      = "fee_multiplier"
+	AttributeKeyPendingActions     = "pending_actions"
 	AttributeKeyError              = "error"
 	AttributeKeyEvidenceID         = "evidence_id"
 	AttributeKeyProofIndex         = "proof_index"
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	audittypes "github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	lumeraidtypes "github.com/LumeraProtocol/lumera/x/lumeraid/types"
 	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -45,6 +46,8 @@ type SupernodeKeeper interface {
 	IsSuperNodeActive(ctx sdk.Context, valAddr sdk.ValAddress) bool
 	QuerySuperNode(ctx sdk.Context, valOperAddr sdk.ValAddress) (sn sntypes.SuperNode, exists bool)
 	GetSuperNodeByAccount(ctx sdk.Context, supernodeAccount string) (sntypes.SuperNode, bool, error)
+	GetAllSuperNodes(ctx sdk.Context, stateFilters ...sntypes.SuperNodeState) ([]sntypes.SuperNode, error)
+	GetMetricsState(ctx sdk.Context, valAddr sdk.ValAddress) (sntypes.SupernodeMetricsState, bool)
 
 	SetSuperNode(ctx sdk.Context, supernode sntypes.SuperNode) error
 }
@@ -67,6 +70,7 @@ type AuditKeeper interface {
 		metadataJSON string,
 	) (uint64, error)
 	SetStorageTruthTicketArtifactCounts(ctx context.Context, ticketID string, indexArtifactCount uint32, symbolArtifactCount uint32) error
+	GetCurrentEpochInfo(ctx sdk.Context) (epochID uint64, startHeight int64, endHeight int64, err error)
 }
 
 // RewardDistributionKeeper defines the fee-share interface implemented by x/supernode.
@@ -74,6 +78,12 @@ type RewardDistributionKeeper interface {
 	GetRegistrationFeeShareBps(ctx sdk.Context) uint64
 }
 
+// LumeraIDKeeper defines the PQ key registry interface implemented by x/lumeraid.
+type LumeraIDKeeper interface {
+	ActivePQKey(ctx sdk.Context, address string) (lumeraidtypes.PQKey, error)
+	VerifyLegRoastSignature(ctx sdk.Context, payload, pubKey, signature []byte) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -2,7 +2,7 @@ package types
 
 const (
 	// ConsensusVersion is a sequence number for state-breaking change of the module.
-	ConsensusVersion = 1
+	ConsensusVersion = 3
 
 	// DefaultIndex is the default global index
 	DefaultIndex uint64 = 1
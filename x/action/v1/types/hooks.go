@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ActionHooks is notified about action lifecycle transitions.
+type ActionHooks interface {
+	// AfterActionSettled is called once an action leaves the PENDING and
+	// PROCESSING states for DONE, EXPIRED, CANCELLED or FAILED.
+	AfterActionSettled(ctx sdk.Context, action *Action) error
+}
+
+// IsSettledActionState reports whether state ends an action's processing.
+// APPROVED follows DONE and is not reported again.
+func IsSettledActionState(state ActionState) bool {
+	switch state {
+	case ActionStateDone, ActionStateExpired, ActionStateCancelled, ActionStateFailed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCancelAction{}
+
+func NewMsgCancelAction(creator string, actionId string) *MsgCancelAction {
+	return &MsgCancelAction{
+		Creator:  creator,
+		ActionId: actionId,
+	}
+}
+
+func (msg *MsgCancelAction) ValidateBasic() error {
+	// Validate creator address
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// Validate action ID
+	if msg.ActionId == "" {
+		return errorsmod.Wrap(ErrInvalidID, "action ID cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/LumeraProtocol/lumera/testutil/crypto"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelAction_ValidateBasic(t *testing.T) {
+	validAddress := cryptotestutils.AccAddress()
+	validActionID := "action-123"
+
+	tests := []struct {
+		name string
+		msg  MsgCancelAction
+		err  error
+	}{
+		// Valid test case
+		{
+			name: "valid cancel action message",
+			msg: MsgCancelAction{
+				Creator:  validAddress,
+				ActionId: validActionID,
+			},
+			err: nil,
+		},
+
+		// Test cases for creator address validation
+		{
+			name: "invalid creator address",
+			msg: MsgCancelAction{
+				Creator:  "invalid_address",
+				ActionId: validActionID,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+
+		// Test cases for action ID validation
+		{
+			name: "empty action ID",
+			msg: MsgCancelAction{
+				Creator:  validAddress,
+				ActionId: "",
+			},
+			err: ErrInvalidID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
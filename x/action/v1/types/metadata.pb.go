@@ -56,6 +56,43 @@ func (HashAlgo) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_05a11a06dcddaaa2, []int{0}
 }
 
+// SignatureScheme enumerates the signature schemes accepted for the
+// `signatures` field of action metadata.
+type SignatureScheme int32
+
+const (
+	// SIGNATURE_SCHEME_UNSPECIFIED keeps the legacy behaviour and is verified
+	// as SIGNATURE_SCHEME_SECP256K1, so existing tickets stay valid.
+	SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED SignatureScheme = 0
+	// SIGNATURE_SCHEME_SECP256K1 verifies against the signer's account key
+	// (or the app_pubkey for interchain and remote creators).
+	SignatureScheme_SIGNATURE_SCHEME_SECP256K1 SignatureScheme = 1
+	// SIGNATURE_SCHEME_LEGROAST verifies a LegRoast post-quantum signature
+	// against the signer's active key in the lumeraid PQ key registry (or the
+	// app_pubkey for interchain and remote creators).
+	SignatureScheme_SIGNATURE_SCHEME_LEGROAST SignatureScheme = 2
+)
+
+var SignatureScheme_name = map[int32]string{
+	0: "SIGNATURE_SCHEME_UNSPECIFIED",
+	1: "SIGNATURE_SCHEME_SECP256K1",
+	2: "SIGNATURE_SCHEME_LEGROAST",
+}
+
+var SignatureScheme_value = map[string]int32{
+	"SIGNATURE_SCHEME_UNSPECIFIED": 0,
+	"SIGNATURE_SCHEME_SECP256K1":   1,
+	"SIGNATURE_SCHEME_LEGROAST":    2,
+}
+
+func (x SignatureScheme) String() string {
+	return proto.EnumName(SignatureScheme_name, int32(x))
+}
+
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_05a11a06dcddaaa2, []int{1}
+}
+
 // SenseMetadata contains information for Sense actions.
 // This metadata is directly embedded in the Action.metadata field.
 // For RequestAction:
@@ -67,6 +104,7 @@ func (HashAlgo) EnumDescriptor() ([]byte, []int) {
 //
 // For FinalizeAction:
 //   - Required: dd_and_fingerprints_ids, signatures
+//   - Optional: signature_scheme
 type SenseMetadata struct {
 	// RequestAction required fields
 	DataHash            string `protobuf:"bytes,1,opt,name=data_hash,proto3" json:"data_hash,omitempty"`
@@ -79,6 +117,8 @@ type SenseMetadata struct {
 	// FinalizeAction fields
 	DdAndFingerprintsIds []string `protobuf:"bytes,6,rep,name=dd_and_fingerprints_ids,proto3" json:"dd_and_fingerprints_ids,omitempty"`
 	Signatures           string   `protobuf:"bytes,7,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	// Scheme of the supernode signatures in `signatures`.
+	SignatureScheme SignatureScheme `protobuf:"varint,8,opt,name=signature_scheme,proto3,enum=lumera.action.v1.SignatureScheme" json:"signature_scheme,omitempty"`
 }
 
 func (m *SenseMetadata) Reset()         { *m = SenseMetadata{} }
@@ -163,6 +203,13 @@ func (m *SenseMetadata) GetSignatures() string {
 	return ""
 }
 
+func (m *SenseMetadata) GetSignatureScheme() SignatureScheme {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
 // AvailabilityCommitment is the LEP-5 on-chain file commitment included
 // during Cascade registration.
 type AvailabilityCommitment struct {
@@ -334,6 +381,7 @@ func (m *ChunkProof) GetPathDirections() []bool {
 // This metadata is directly embedded in the Action.metadata field.
 // For RequestAction:
 //   - Required: data_hash, file_name, rq_ids_ic, signatures
+//   - Optional: signature_scheme
 //
 // Keeper will add:
 //   - rq_ids_max (from module params)
@@ -362,6 +410,8 @@ type CascadeMetadata struct {
 	// These values anchor deterministic artifact ordinal selection on-chain.
 	IndexArtifactCount  uint32 `protobuf:"varint,10,opt,name=index_artifact_count,proto3" json:"index_artifact_count,omitempty"`
 	SymbolArtifactCount uint32 `protobuf:"varint,11,opt,name=symbol_artifact_count,proto3" json:"symbol_artifact_count,omitempty"`
+	// Scheme of the creator signature in `signatures`.
+	SignatureScheme SignatureScheme `protobuf:"varint,12,opt,name=signature_scheme,proto3,enum=lumera.action.v1.SignatureScheme" json:"signature_scheme,omitempty"`
 }
 
 func (m *CascadeMetadata) Reset()         { *m = CascadeMetadata{} }
@@ -474,8 +524,16 @@ func (m *CascadeMetadata) GetSymbolArtifactCount() uint32 {
 	return 0
 }
 
+func (m *CascadeMetadata) GetSignatureScheme() SignatureScheme {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return SignatureScheme_SIGNATURE_SCHEME_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterEnum("lumera.action.v1.HashAlgo", HashAlgo_name, HashAlgo_value)
+	proto.RegisterEnum("lumera.action.v1.SignatureScheme", SignatureScheme_name, SignatureScheme_value)
 	proto.RegisterType((*SenseMetadata)(nil), "lumera.action.v1.SenseMetadata")
 	proto.RegisterType((*AvailabilityCommitment)(nil), "lumera.action.v1.AvailabilityCommitment")
 	proto.RegisterType((*ChunkProof)(nil), "lumera.action.v1.ChunkProof")
@@ -485,52 +543,58 @@ func init() {
 func init() { proto.RegisterFile("lumera/action/v1/metadata.proto", fileDescriptor_05a11a06dcddaaa2) }
 
 var fileDescriptor_05a11a06dcddaaa2 = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0xe3, 0x38, 0xe4, 0x26, 0x13, 0x72, 0x09, 0x23, 0xfe, 0xf8, 0x22, 0x14, 0xac, 0x88,
-	0x85, 0x75, 0xef, 0x55, 0x22, 0x42, 0x8b, 0x58, 0x36, 0xa4, 0xb4, 0x41, 0xa5, 0x2d, 0x9d, 0xa8,
-	0x9b, 0x6e, 0x46, 0x13, 0x7b, 0xe2, 0x8c, 0x6a, 0x7b, 0x52, 0xcf, 0x04, 0x41, 0x9f, 0xa2, 0xcb,
-	0xae, 0xfa, 0x22, 0x7d, 0x01, 0x96, 0x2c, 0xbb, 0xaa, 0x2a, 0x78, 0x8a, 0xee, 0xaa, 0x19, 0xa7,
-	0xd8, 0x84, 0x44, 0xea, 0xca, 0x3e, 0xdf, 0x77, 0xce, 0x78, 0xf4, 0xcd, 0x6f, 0x0c, 0x76, 0x82,
-	0x49, 0x48, 0x63, 0xd2, 0x22, 0xae, 0x64, 0x3c, 0x6a, 0x9d, 0xef, 0xb5, 0x42, 0x2a, 0x89, 0x47,
-	0x24, 0x69, 0x8e, 0x63, 0x2e, 0x39, 0xac, 0x25, 0x0d, 0xcd, 0xa4, 0xa1, 0x79, 0xbe, 0xb7, 0xb5,
-	0xe6, 0x73, 0x9f, 0x6b, 0xb3, 0xa5, 0xde, 0x92, 0xbe, 0xc6, 0xd7, 0x3c, 0xa8, 0xf6, 0x69, 0x24,
-	0xe8, 0xcb, 0xe9, 0x3c, 0xdc, 0x06, 0x65, 0xf5, 0xc4, 0x23, 0x22, 0x46, 0x96, 0x61, 0x1b, 0x4e,
-	0x19, 0xa5, 0x02, 0x3c, 0x00, 0x1b, 0x9e, 0x87, 0x49, 0xe4, 0xe1, 0x21, 0x8b, 0x7c, 0x1a, 0x8f,
-	0x63, 0x16, 0x49, 0x81, 0x99, 0x6b, 0xe5, 0x6d, 0xc3, 0x29, 0xa0, 0x05, 0x2e, 0xdc, 0x05, 0x55,
-	0x97, 0x07, 0x01, 0xd5, 0xdb, 0xc1, 0xcc, 0xb3, 0x4c, 0xbd, 0xf2, 0x7d, 0x11, 0x6e, 0x81, 0x92,
-	0x1f, 0xf3, 0xc9, 0x58, 0x35, 0x14, 0x74, 0xc3, 0x5d, 0x0d, 0x0f, 0xc1, 0xe6, 0xbc, 0xb5, 0x43,
-	0x72, 0x61, 0x2d, 0xe9, 0x4f, 0x2f, 0xb2, 0x17, 0x4d, 0x32, 0x4f, 0x58, 0x45, 0xdb, 0x74, 0xca,
-	0x68, 0x91, 0x0d, 0xeb, 0x00, 0x08, 0xe6, 0x47, 0x44, 0x4e, 0x62, 0x2a, 0xac, 0xbf, 0xf4, 0x8e,
-	0x32, 0x4a, 0xe3, 0x4b, 0x1e, 0x6c, 0x74, 0xce, 0x09, 0x0b, 0xc8, 0x80, 0x05, 0x4c, 0x5e, 0x76,
-	0x79, 0x18, 0x32, 0x19, 0xd2, 0x48, 0x42, 0x07, 0xac, 0xb8, 0x77, 0x15, 0x96, 0x97, 0x63, 0x3a,
-	0x0d, 0x73, 0x56, 0x86, 0x87, 0xa0, 0xac, 0xa2, 0xc5, 0x24, 0xf0, 0xb9, 0x4e, 0xf1, 0xef, 0xf6,
-	0x56, 0x73, 0xf6, 0xf8, 0x9a, 0x3d, 0x22, 0x46, 0x9d, 0xc0, 0xe7, 0x28, 0x6d, 0x56, 0xdb, 0x73,
-	0x47, 0x93, 0xe8, 0x3d, 0x16, 0xec, 0x23, 0xd5, 0x89, 0x56, 0x51, 0x46, 0x51, 0xbe, 0xe4, 0x92,
-	0x04, 0x89, 0x5f, 0xd0, 0x29, 0x65, 0x14, 0xe5, 0x47, 0x93, 0x10, 0xeb, 0x09, 0xa1, 0x53, 0xac,
-	0xa2, 0x8c, 0x02, 0x21, 0x28, 0xc4, 0x9c, 0x4b, 0xab, 0x68, 0x1b, 0xce, 0x32, 0xd2, 0xef, 0xf0,
-	0x7f, 0xb0, 0xea, 0x8e, 0x48, 0x10, 0xd0, 0xc8, 0xa7, 0x98, 0x45, 0x1e, 0x73, 0x75, 0x32, 0xa6,
-	0x53, 0x45, 0x0f, 0x8d, 0xc6, 0x67, 0x03, 0x80, 0xae, 0x5a, 0xec, 0x2c, 0xe6, 0x7c, 0x08, 0x6d,
-	0x50, 0x49, 0xb6, 0xc7, 0x22, 0x8f, 0x5e, 0xe8, 0x40, 0xaa, 0x28, 0x2b, 0x29, 0xfa, 0x02, 0x4a,
-	0x86, 0x09, 0x7d, 0x79, 0xfd, 0xdd, 0x54, 0x50, 0xf3, 0x63, 0x22, 0x47, 0xba, 0xa0, 0xc2, 0x32,
-	0x6d, 0xd3, 0x59, 0x46, 0x59, 0x49, 0xc5, 0xae, 0x4b, 0x8f, 0xc5, 0x09, 0x56, 0xc2, 0x2a, 0xd8,
-	0xa6, 0x53, 0x42, 0xb3, 0x72, 0xe3, 0xa7, 0x09, 0x56, 0xba, 0x44, 0xb8, 0xc4, 0xfb, 0x53, 0xf6,
-	0xb7, 0x41, 0x79, 0xc8, 0x02, 0x8a, 0x23, 0x12, 0x52, 0xbd, 0xb7, 0x32, 0x4a, 0x05, 0xe5, 0xc6,
-	0x1f, 0x14, 0x35, 0xea, 0x32, 0x98, 0x3a, 0xeb, 0x54, 0x50, 0x51, 0x4f, 0x0b, 0x05, 0xec, 0xf4,
-	0x28, 0x52, 0x05, 0xee, 0xde, 0xf9, 0x0a, 0xcb, 0x25, 0x85, 0xe5, 0x51, 0xe1, 0xea, 0xfb, 0x8e,
-	0x81, 0x32, 0xfa, 0x0c, 0x8f, 0xc5, 0x59, 0x1e, 0xe1, 0x06, 0x28, 0x8e, 0x27, 0x83, 0x80, 0xb9,
-	0x9a, 0xd5, 0x12, 0x9a, 0x56, 0x70, 0x00, 0x36, 0x49, 0x06, 0x53, 0x9c, 0x22, 0x68, 0x95, 0x6c,
-	0xc3, 0xa9, 0xb4, 0x9d, 0x87, 0xc0, 0xcd, 0xe7, 0x1a, 0x2d, 0x5a, 0x08, 0x3e, 0x01, 0xcb, 0xc9,
-	0x41, 0x8e, 0xd5, 0x51, 0x0b, 0xab, 0x6c, 0x9b, 0x4e, 0xa5, 0xbd, 0xfd, 0x70, 0xe1, 0x94, 0x07,
-	0x74, 0x6f, 0x02, 0xb6, 0xc1, 0x9a, 0x86, 0x00, 0x93, 0x58, 0xb2, 0x21, 0x71, 0x25, 0x76, 0xf9,
-	0x24, 0x92, 0x16, 0xd0, 0x98, 0xcc, 0xf5, 0xe0, 0x23, 0xb0, 0x2e, 0x2e, 0xc3, 0x01, 0x0f, 0x66,
-	0x87, 0x2a, 0x7a, 0x68, 0xbe, 0xf9, 0xef, 0x1b, 0x50, 0xfa, 0x7d, 0x9f, 0xe0, 0x3f, 0x60, 0xbd,
-	0xd7, 0xe9, 0xf7, 0x70, 0xe7, 0xf4, 0xf9, 0x6b, 0xfc, 0xf6, 0x55, 0xff, 0xec, 0xb8, 0x7b, 0xf2,
-	0xec, 0xe4, 0xf8, 0x69, 0x2d, 0x07, 0xd7, 0x40, 0x2d, 0xb5, 0x8e, 0x4e, 0x3b, 0x2f, 0x8e, 0xf7,
-	0x6b, 0xc6, 0x7d, 0xb5, 0xdf, 0xeb, 0xb4, 0x1f, 0x1f, 0xd4, 0xf2, 0x47, 0xff, 0x5d, 0xdd, 0xd4,
-	0x8d, 0xeb, 0x9b, 0xba, 0xf1, 0xe3, 0xa6, 0x6e, 0x7c, 0xba, 0xad, 0xe7, 0xae, 0x6f, 0xeb, 0xb9,
-	0x6f, 0xb7, 0xf5, 0xdc, 0xbb, 0xd5, 0x8b, 0xcc, 0x6f, 0x5a, 0xdd, 0x78, 0x31, 0x28, 0xea, 0x9f,
-	0xef, 0xfe, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xa5, 0x14, 0x26, 0xc7, 0x05, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x35, 0x45, 0x45, 0x95, 0xc6, 0x56, 0xac, 0x2c, 0x1c, 0x87, 0x31, 0x5c, 0x85, 0x35, 0x72,
+	0x20, 0xd2, 0x42, 0x86, 0x95, 0xc6, 0xc8, 0xb1, 0xb2, 0xaa, 0x5a, 0x46, 0xec, 0xc4, 0x5d, 0x26,
+	0x97, 0x5e, 0x16, 0x2b, 0x72, 0x25, 0x2d, 0x4a, 0x72, 0x55, 0xee, 0xca, 0xb0, 0xfb, 0x09, 0x3d,
+	0xf5, 0xd8, 0x53, 0xbf, 0xc7, 0xc7, 0x1c, 0x7b, 0x2a, 0x0a, 0xfb, 0x47, 0x8a, 0x5d, 0xc9, 0x26,
+	0x2d, 0x4a, 0x40, 0xd1, 0x93, 0x38, 0xef, 0xcd, 0xcc, 0x8e, 0xe6, 0x3d, 0x2e, 0xe1, 0x45, 0x34,
+	0x8d, 0x59, 0x4a, 0xf7, 0x69, 0xa0, 0xb8, 0x48, 0xf6, 0x2f, 0x0e, 0xf6, 0x63, 0xa6, 0x68, 0x48,
+	0x15, 0x6d, 0x4d, 0x52, 0xa1, 0x04, 0x6a, 0xcc, 0x12, 0x5a, 0xb3, 0x84, 0xd6, 0xc5, 0xc1, 0xce,
+	0xd6, 0x48, 0x8c, 0x84, 0x21, 0xf7, 0xf5, 0xd3, 0x2c, 0x6f, 0xef, 0x37, 0x1b, 0xea, 0x3e, 0x4b,
+	0x24, 0x3b, 0x9b, 0xd7, 0xa3, 0x5d, 0xa8, 0xe9, 0x5f, 0x32, 0xa6, 0x72, 0xec, 0x58, 0xae, 0xe5,
+	0xd5, 0x70, 0x06, 0xa0, 0x43, 0xd8, 0x0e, 0x43, 0x42, 0x93, 0x90, 0x0c, 0x79, 0x32, 0x62, 0xe9,
+	0x24, 0xe5, 0x89, 0x92, 0x84, 0x07, 0x4e, 0xc9, 0xb5, 0xbc, 0x32, 0x5e, 0xc1, 0xa2, 0x97, 0x50,
+	0x0f, 0x44, 0x14, 0x31, 0x33, 0x0e, 0xe1, 0xa1, 0x63, 0x9b, 0xce, 0x0f, 0x41, 0xb4, 0x03, 0xd5,
+	0x51, 0x2a, 0xa6, 0x13, 0x9d, 0x50, 0x36, 0x09, 0xf7, 0x31, 0x7a, 0x0b, 0xcf, 0x96, 0xf5, 0x8e,
+	0xe9, 0xa5, 0xf3, 0xc8, 0x1c, 0xbd, 0x8a, 0x5e, 0x55, 0xc9, 0x43, 0xe9, 0x54, 0x5c, 0xdb, 0xab,
+	0xe1, 0x55, 0x34, 0x6a, 0x02, 0x48, 0x3e, 0x4a, 0xa8, 0x9a, 0xa6, 0x4c, 0x3a, 0x5f, 0x98, 0x89,
+	0x72, 0x08, 0x3a, 0x83, 0xc6, 0x7d, 0x44, 0x64, 0x30, 0x66, 0x31, 0x73, 0xaa, 0xae, 0xe5, 0x3d,
+	0x6e, 0x7f, 0xd5, 0x5a, 0x14, 0xa0, 0xe5, 0xdf, 0x65, 0xfa, 0x26, 0x11, 0x17, 0x4a, 0xf7, 0xfe,
+	0x2c, 0xc1, 0x76, 0xe7, 0x82, 0xf2, 0x88, 0x0e, 0x78, 0xc4, 0xd5, 0x55, 0x57, 0xc4, 0x31, 0x57,
+	0x31, 0x4b, 0x14, 0xf2, 0x60, 0x33, 0xb8, 0x8f, 0x88, 0xba, 0x9a, 0xb0, 0xb9, 0x36, 0x8b, 0x30,
+	0x7a, 0x0b, 0x35, 0xad, 0x14, 0xa1, 0xd1, 0x48, 0x18, 0x51, 0x1e, 0xb7, 0x77, 0x8a, 0xc3, 0xf4,
+	0xa9, 0x1c, 0x77, 0xa2, 0x91, 0xc0, 0x59, 0xb2, 0xfe, 0xb7, 0xc1, 0x78, 0x9a, 0xfc, 0x4c, 0x24,
+	0xff, 0x95, 0x19, 0x81, 0xea, 0x38, 0x87, 0x68, 0x5e, 0x09, 0x45, 0xa3, 0x19, 0x5f, 0x36, 0x4b,
+	0xcf, 0x21, 0x9a, 0x4f, 0xa6, 0x31, 0x31, 0x15, 0xd2, 0x88, 0x52, 0xc7, 0x39, 0x04, 0x21, 0x28,
+	0xa7, 0x42, 0x28, 0xa7, 0xe2, 0x5a, 0xde, 0x06, 0x36, 0xcf, 0xe8, 0x1b, 0x78, 0x12, 0x8c, 0x69,
+	0x14, 0xb1, 0x64, 0xc4, 0x08, 0x4f, 0x42, 0x1e, 0x98, 0x45, 0xdb, 0x5e, 0x1d, 0x17, 0x89, 0xbd,
+	0x3f, 0x2c, 0x80, 0xae, 0x6e, 0x76, 0x9e, 0x0a, 0x31, 0x44, 0x2e, 0xac, 0xcf, 0xc6, 0xe3, 0x49,
+	0xc8, 0x2e, 0xcd, 0x42, 0xea, 0x38, 0x0f, 0x69, 0x33, 0x47, 0x8c, 0x0e, 0x67, 0x66, 0x2e, 0x99,
+	0x73, 0x33, 0x40, 0xd7, 0x4f, 0xa8, 0x1a, 0x9b, 0x80, 0x49, 0xc7, 0x76, 0x6d, 0x6f, 0x03, 0xe7,
+	0x21, 0xbd, 0x76, 0x13, 0x86, 0x3c, 0x9d, 0xb9, 0x54, 0x3a, 0x65, 0xd7, 0xf6, 0xaa, 0x78, 0x11,
+	0xde, 0xbb, 0x2e, 0xc3, 0x66, 0x97, 0xca, 0x80, 0x86, 0xff, 0xf5, 0x55, 0xda, 0x85, 0xda, 0x90,
+	0x47, 0x8c, 0x24, 0x34, 0x66, 0x66, 0xb6, 0x1a, 0xce, 0x00, 0xcd, 0xa6, 0xbf, 0x68, 0x13, 0xea,
+	0x77, 0xcb, 0x36, 0xbb, 0xce, 0x00, 0xbd, 0xea, 0x79, 0xa0, 0xfd, 0x3f, 0x97, 0x22, 0x43, 0xd0,
+	0xcb, 0x7b, 0x5e, 0xbb, 0xfc, 0x91, 0x76, 0xf9, 0x51, 0xf9, 0xfa, 0xef, 0x17, 0x16, 0xce, 0xe1,
+	0x0b, 0xf6, 0xae, 0x14, 0xec, 0xbd, 0x0d, 0x95, 0xc9, 0x74, 0x10, 0xf1, 0xc0, 0x58, 0xbf, 0x8a,
+	0xe7, 0x11, 0x1a, 0xc0, 0x33, 0x9a, 0xb3, 0x29, 0xc9, 0x2c, 0x68, 0xdc, 0xbf, 0xde, 0xf6, 0x8a,
+	0x86, 0x5b, 0xee, 0x6b, 0xbc, 0xaa, 0x11, 0xfa, 0x0e, 0x36, 0x66, 0x42, 0x4e, 0xb4, 0xd4, 0xd2,
+	0xa9, 0xb9, 0xb6, 0xb7, 0xde, 0xde, 0x2d, 0x36, 0xce, 0xfc, 0x80, 0x1f, 0x54, 0xa0, 0x36, 0x6c,
+	0x19, 0x13, 0x10, 0x9a, 0x2a, 0x3e, 0xa4, 0x81, 0x22, 0x81, 0x98, 0x26, 0xca, 0x01, 0x63, 0x93,
+	0xa5, 0x1c, 0xfa, 0x16, 0x9e, 0xca, 0xab, 0x78, 0x20, 0xa2, 0xc5, 0xa2, 0x75, 0x53, 0xb4, 0x9c,
+	0x5c, 0x7a, 0x0d, 0x6c, 0xfc, 0xef, 0x6b, 0xe0, 0xd5, 0x8f, 0x50, 0xbd, 0x7b, 0x3d, 0xd1, 0x73,
+	0x78, 0xda, 0xef, 0xf8, 0x7d, 0xd2, 0x39, 0x3d, 0xfe, 0x40, 0x3e, 0xbd, 0xf7, 0xcf, 0x7b, 0xdd,
+	0x93, 0x1f, 0x4e, 0x7a, 0xdf, 0x37, 0xd6, 0xd0, 0x16, 0x34, 0x32, 0xea, 0xe8, 0xb4, 0xf3, 0xae,
+	0xf7, 0xba, 0x61, 0x3d, 0x44, 0xfd, 0x7e, 0xa7, 0xfd, 0xe6, 0xb0, 0x51, 0x7a, 0x95, 0xc2, 0xe6,
+	0xc2, 0xb9, 0xc8, 0x85, 0x5d, 0xff, 0xe4, 0xf8, 0x7d, 0xe7, 0xe3, 0x27, 0xdc, 0x23, 0x7e, 0xb7,
+	0xdf, 0x3b, 0xeb, 0x2d, 0x1c, 0xd0, 0x84, 0x9d, 0x42, 0x86, 0xdf, 0xeb, 0x9e, 0xb7, 0xdf, 0x1c,
+	0xbe, 0x3b, 0x68, 0x58, 0xe8, 0x4b, 0x78, 0x5e, 0xe0, 0x4f, 0x7b, 0xc7, 0xf8, 0x43, 0xc7, 0xff,
+	0xd8, 0x28, 0x1d, 0x7d, 0x7d, 0x7d, 0xd3, 0xb4, 0x3e, 0xdf, 0x34, 0xad, 0x7f, 0x6e, 0x9a, 0xd6,
+	0xef, 0xb7, 0xcd, 0xb5, 0xcf, 0xb7, 0xcd, 0xb5, 0xbf, 0x6e, 0x9b, 0x6b, 0x3f, 0x3d, 0xb9, 0xcc,
+	0x7d, 0xb8, 0xf4, 0xa5, 0x25, 0x07, 0x15, 0xf3, 0x39, 0x7a, 0xfd, 0xef, 0x00, 0xf8, 0xe3, 0x41,
+	0x83, 0xd9, 0x06, 0x00, 0x00,
 }
 
 func (m *SenseMetadata) Marshal() (dAtA []byte, err error) {
@@ -553,6 +617,11 @@ func (m *SenseMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignatureScheme != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.SignatureScheme))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Signatures) > 0 {
 		i -= len(m.Signatures)
 		copy(dAtA[i:], m.Signatures)
@@ -755,6 +824,11 @@ func (m *CascadeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignatureScheme != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.SignatureScheme))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.SymbolArtifactCount != 0 {
 		i = encodeVarintMetadata(dAtA, i, uint64(m.SymbolArtifactCount))
 		i--
@@ -889,6 +963,9 @@ func (m *SenseMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	if m.SignatureScheme != 0 {
+		n += 1 + sovMetadata(uint64(m.SignatureScheme))
+	}
 	return n
 }
 
@@ -1002,6 +1079,9 @@ func (m *CascadeMetadata) Size() (n int) {
 	if m.SymbolArtifactCount != 0 {
 		n += 1 + sovMetadata(uint64(m.SymbolArtifactCount))
 	}
+	if m.SignatureScheme != 0 {
+		n += 1 + sovMetadata(uint64(m.SignatureScheme))
+	}
 	return n
 }
 
@@ -1238,6 +1318,25 @@ func (m *SenseMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Signatures = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			m.SignatureScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureScheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -2055,6 +2154,25 @@ func (m *CascadeMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			m.SignatureScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureScheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -13,38 +14,57 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 
 // Parameter keys
 var (
-	KeyBaseActionFee            = []byte("BaseActionFee")
-	KeyFeePerKbyte              = []byte("FeePerKbyte")
-	KeyMaxActionsPerBlock       = []byte("MaxActionsPerBlock")
-	KeyMinSuperNodes            = []byte("MinSuperNodes")
-	KeyMaxDdAndFingerprints     = []byte("MaxDdAndFingerprints")
-	KeyMaxRaptorQSymbols        = []byte("MaxRaptorQSymbols")
-	KeyExpirationDuration       = []byte("ExpirationDuration")
-	KeyMinProcessingTime        = []byte("MinProcessingTime")
-	KeyMaxProcessingTime        = []byte("MaxProcessingTime")
-	KeySuperNodeFeeShare        = []byte("SuperNodeFeeShare")
-	KeyFoundationFeeShare       = []byte("FoundationFeeShare")
-	KeySVCChallengeCount        = []byte("SVCChallengeCount")
-	KeySVCMinChunksForChallenge = []byte("SVCMinChunksForChallenge")
+	KeyBaseActionFee             = []byte("BaseActionFee")
+	KeyFeePerKbyte               = []byte("FeePerKbyte")
+	KeyMaxActionsPerBlock        = []byte("MaxActionsPerBlock")
+	KeyMinSuperNodes             = []byte("MinSuperNodes")
+	KeyMaxDdAndFingerprints      = []byte("MaxDdAndFingerprints")
+	KeyMaxRaptorQSymbols         = []byte("MaxRaptorQSymbols")
+	KeyExpirationDuration        = []byte("ExpirationDuration")
+	KeyMinProcessingTime         = []byte("MinProcessingTime")
+	KeyMaxProcessingTime         = []byte("MaxProcessingTime")
+	KeySuperNodeFeeShare         = []byte("SuperNodeFeeShare")
+	KeyFoundationFeeShare        = []byte("FoundationFeeShare")
+	KeySVCChallengeCount         = []byte("SVCChallengeCount")
+	KeySVCMinChunksForChallenge  = []byte("SVCMinChunksForChallenge")
+	KeyMaxExpirationsPerBlock    = []byte("MaxExpirationsPerBlock")
+	KeyActionTypeFees            = []byte("ActionTypeFees")
+	KeyCancellationFee           = []byte("CancellationFee")
+	KeyMaxFinalizationRedundancy = []byte("MaxFinalizationRedundancy")
+	KeyFeeMultiplierMaxChange    = []byte("FeeMultiplierMaxChange")
+	KeyMaxFeeMultiplier          = []byte("MaxFeeMultiplier")
+	KeyMinFreeStorageGb          = []byte("MinFreeStorageGb")
+	KeyTargetPendingActions      = []byte("TargetPendingActions")
 )
 
 // Default parameter values
 var (
-	DefaultBaseActionFee            = sdk.NewCoin("ulume", math.NewInt(10000)) // 0.01 LUME
-	DefaultFeePerKbyte              = sdk.NewCoin("ulume", math.NewInt(10))    // 0.00001 LUME per kbyte
-	DefaultMaxActionsPerBlock       = uint64(10)                               // 100 actions per block
-	DefaultMinSuperNodes            = uint64(3)                                // Minimum 3 super nodes
-	DefaultMaxDdAndFingerprints     = uint64(50)                               // Maximum 1000 DDs and fingerprints
-	DefaultMaxRaptorQSymbols        = uint64(50)                               // Maximum 10000 RaptorQ symbols
-	DefaultExpirationDuration       = 24 * time.Hour                           // 24 hour expiration
-	DefaultMinProcessingTime        = 1 * time.Minute                          // 1 minute minimum processing time
-	DefaultMaxProcessingTime        = 1 * time.Hour                            // 1 hour maximum processing time
-	DefaultSuperNodeFeeShare        = "1.000000000000000000"                   // 1.0 (100%)
-	DefaultFoundationFeeShare       = "0.000000000000000000"                   // 0.0 (0%)
-	DefaultSVCChallengeCount        = uint32(8)                                // LEP-5: number of chunks to challenge
-	DefaultSVCMinChunksForChallenge = uint32(4)                                // LEP-5: minimum chunks required for SVC
+	DefaultBaseActionFee             = sdk.NewCoin("ulume", math.NewInt(10000)) // 0.01 LUME
+	DefaultFeePerKbyte               = sdk.NewCoin("ulume", math.NewInt(10))    // 0.00001 LUME per kbyte
+	DefaultMaxActionsPerBlock        = uint64(10)                               // 100 actions per block
+	DefaultMinSuperNodes             = uint64(3)                                // Minimum 3 super nodes
+	DefaultMaxDdAndFingerprints      = uint64(50)                               // Maximum 1000 DDs and fingerprints
+	DefaultMaxRaptorQSymbols         = uint64(50)                               // Maximum 10000 RaptorQ symbols
+	DefaultExpirationDuration        = 24 * time.Hour                           // 24 hour expiration
+	DefaultMinProcessingTime         = 1 * time.Minute                          // 1 minute minimum processing time
+	DefaultMaxProcessingTime         = 1 * time.Hour                            // 1 hour maximum processing time
+	DefaultSuperNodeFeeShare         = "1.000000000000000000"                   // 1.0 (100%)
+	DefaultFoundationFeeShare        = "0.000000000000000000"                   // 0.0 (0%)
+	DefaultSVCChallengeCount         = uint32(8)                                // LEP-5: number of chunks to challenge
+	DefaultSVCMinChunksForChallenge  = uint32(4)                                // LEP-5: minimum chunks required for SVC
+	DefaultMaxExpirationsPerBlock    = uint64(100)                              // Upper bound on actions expired per EndBlocker
+	DefaultCancellationFee           = sdk.NewCoin("ulume", math.NewInt(1000))  // 0.001 LUME retained on cancellation
+	DefaultMaxFinalizationRedundancy = uint32(5)                                // Up to 5 independent finalizations per action
+	DefaultFeeMultiplierMaxChange    = "0.125000000000000000"                   // At most 12.5% multiplier change per block
+	DefaultMaxFeeMultiplier          = "10.000000000000000000"                  // Fees rise to at most 10x the static schedule
+	DefaultMinFreeStorageGb          = uint64(0)                                // Storage pressure disabled
+	DefaultTargetPendingActions      = uint64(1000)                             // Backlog at which fees hold steady
 )
 
+// MaxFinalizationRedundancyLimit bounds max_finalization_redundancy: finalizers
+// must be distinct members of the top-10 supernodes for the action's block.
+const MaxFinalizationRedundancyLimit = uint32(10)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -65,21 +85,37 @@ func NewParams(
 	foundationFeeShare string,
 	svcChallengeCount uint32,
 	svcMinChunksForChallenge uint32,
+	maxExpirationsPerBlock uint64,
+	actionTypeFees []ActionTypeFee,
+	cancellationFee sdk.Coin,
+	maxFinalizationRedundancy uint32,
+	feeMultiplierMaxChange string,
+	maxFeeMultiplier string,
+	minFreeStorageGb uint64,
+	targetPendingActions uint64,
 ) Params {
 	return Params{
-		BaseActionFee:            baseActionFee,
-		FeePerKbyte:              feePerKbyte,
-		MaxActionsPerBlock:       maxActionsPerBlock,
-		MinSuperNodes:            minSuperNodes,
-		MaxDdAndFingerprints:     maxDdAndFingerprints,
-		MaxRaptorQSymbols:        maxRaptorQSymbols,
-		ExpirationDuration:       expirationDuration,
-		MinProcessingTime:        minProcessingTime,
-		MaxProcessingTime:        maxProcessingTime,
-		SuperNodeFeeShare:        superNodeFeeShare,
-		FoundationFeeShare:       foundationFeeShare,
-		SvcChallengeCount:        svcChallengeCount,
-		SvcMinChunksForChallenge: svcMinChunksForChallenge,
+		BaseActionFee:             baseActionFee,
+		FeePerKbyte:               feePerKbyte,
+		MaxActionsPerBlock:        maxActionsPerBlock,
+		MinSuperNodes:             minSuperNodes,
+		MaxDdAndFingerprints:      maxDdAndFingerprints,
+		MaxRaptorQSymbols:         maxRaptorQSymbols,
+		ExpirationDuration:        expirationDuration,
+		MinProcessingTime:         minProcessingTime,
+		MaxProcessingTime:         maxProcessingTime,
+		SuperNodeFeeShare:         superNodeFeeShare,
+		FoundationFeeShare:        foundationFeeShare,
+		SvcChallengeCount:         svcChallengeCount,
+		SvcMinChunksForChallenge:  svcMinChunksForChallenge,
+		MaxExpirationsPerBlock:    maxExpirationsPerBlock,
+		ActionTypeFees:            actionTypeFees,
+		CancellationFee:           cancellationFee,
+		MaxFinalizationRedundancy: maxFinalizationRedundancy,
+		FeeMultiplierMaxChange:    feeMultiplierMaxChange,
+		MaxFeeMultiplier:          maxFeeMultiplier,
+		MinFreeStorageGb:          minFreeStorageGb,
+		TargetPendingActions:      targetPendingActions,
 	}
 }
 
@@ -99,6 +135,14 @@ func DefaultParams() Params {
 		DefaultFoundationFeeShare,
 		DefaultSVCChallengeCount,
 		DefaultSVCMinChunksForChallenge,
+		DefaultMaxExpirationsPerBlock,
+		nil,
+		DefaultCancellationFee,
+		DefaultMaxFinalizationRedundancy,
+		DefaultFeeMultiplierMaxChange,
+		DefaultMaxFeeMultiplier,
+		DefaultMinFreeStorageGb,
+		DefaultTargetPendingActions,
 	)
 }
 
@@ -118,6 +162,24 @@ func (p Params) WithDefaults() Params {
 	if p.SvcMinChunksForChallenge == 0 {
 		p.SvcMinChunksForChallenge = DefaultSVCMinChunksForChallenge
 	}
+	if p.MaxExpirationsPerBlock == 0 {
+		p.MaxExpirationsPerBlock = DefaultMaxExpirationsPerBlock
+	}
+	if p.CancellationFee.Denom == "" {
+		p.CancellationFee = DefaultCancellationFee
+	}
+	if p.MaxFinalizationRedundancy == 0 {
+		p.MaxFinalizationRedundancy = DefaultMaxFinalizationRedundancy
+	}
+	if p.FeeMultiplierMaxChange == "" {
+		p.FeeMultiplierMaxChange = DefaultFeeMultiplierMaxChange
+	}
+	if p.MaxFeeMultiplier == "" {
+		p.MaxFeeMultiplier = DefaultMaxFeeMultiplier
+	}
+	if p.TargetPendingActions == 0 {
+		p.TargetPendingActions = DefaultTargetPendingActions
+	}
 	return p
 }
 
@@ -137,6 +199,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyFoundationFeeShare, &p.FoundationFeeShare, validateDecString),
 		paramtypes.NewParamSetPair(KeySVCChallengeCount, &p.SvcChallengeCount, validateUint32),
 		paramtypes.NewParamSetPair(KeySVCMinChunksForChallenge, &p.SvcMinChunksForChallenge, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxExpirationsPerBlock, &p.MaxExpirationsPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyActionTypeFees, &p.ActionTypeFees, validateActionTypeFees),
+		paramtypes.NewParamSetPair(KeyCancellationFee, &p.CancellationFee, validateCoin),
+		paramtypes.NewParamSetPair(KeyMaxFinalizationRedundancy, &p.MaxFinalizationRedundancy, validateMaxFinalizationRedundancy),
+		paramtypes.NewParamSetPair(KeyFeeMultiplierMaxChange, &p.FeeMultiplierMaxChange, validateFeeMultiplierMaxChange),
+		paramtypes.NewParamSetPair(KeyMaxFeeMultiplier, &p.MaxFeeMultiplier, validateMaxFeeMultiplier),
+		paramtypes.NewParamSetPair(KeyMinFreeStorageGb, &p.MinFreeStorageGb, validateUint64),
+		paramtypes.NewParamSetPair(KeyTargetPendingActions, &p.TargetPendingActions, validateUint64),
 	}
 }
 
@@ -194,6 +264,43 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateUint64(p.MaxExpirationsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateActionTypeFees(p.ActionTypeFees); err != nil {
+		return err
+	}
+	for _, fee := range p.ActionTypeFees {
+		if fee.BaseActionFee.Denom != p.BaseActionFee.Denom {
+			return fmt.Errorf("action type %s fees must be in %s, got %s", fee.ActionType, p.BaseActionFee.Denom, fee.BaseActionFee.Denom)
+		}
+	}
+
+	if err := validateCoin(p.CancellationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxFinalizationRedundancy(p.MaxFinalizationRedundancy); err != nil {
+		return err
+	}
+
+	if err := validateFeeMultiplierMaxChange(p.FeeMultiplierMaxChange); err != nil {
+		return err
+	}
+
+	if err := validateMaxFeeMultiplier(p.MaxFeeMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.MinFreeStorageGb); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.TargetPendingActions); err != nil {
+		return err
+	}
+
 	// Additional validation rules
 	if p.MinProcessingTime >= p.MaxProcessingTime {
 		return fmt.Errorf("min processing time must be less than max processing time")
@@ -218,6 +325,18 @@ func (p Params) Validate() error {
 	return nil
 }
 
+// FeeSchedule returns the base fee and per-kbyte fee for an action type,
+// falling back to the module-wide schedule when no override is configured.
+func (p Params) FeeSchedule(actionType ActionType) (baseFee sdk.Coin, feePerKbyte sdk.Coin) {
+	name := ActionTypeName(actionType)
+	for _, fee := range p.ActionTypeFees {
+		if strings.EqualFold(fee.ActionType, name) {
+			return fee.BaseActionFee, fee.FeePerKbyte
+		}
+	}
+	return p.BaseActionFee, p.FeePerKbyte
+}
+
 // Validation functions
 
 func validateCoin(v interface{}) error {
@@ -280,3 +399,79 @@ func validateDecString(v interface{}) error {
 
 	return nil
 }
+
+func validateMaxFinalizationRedundancy(v interface{}) error {
+	redundancy, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if redundancy > MaxFinalizationRedundancyLimit {
+		return fmt.Errorf("max finalization redundancy must be at most %d, got %d", MaxFinalizationRedundancyLimit, redundancy)
+	}
+
+	return nil
+}
+
+func validateFeeMultiplierMaxChange(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	dec, err := math.LegacyNewDecFromStr(str)
+	if err != nil {
+		return fmt.Errorf("invalid fee multiplier max change: %s", err)
+	}
+	if dec.IsNegative() || dec.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("fee multiplier max change must be in [0, 1), got %s", dec)
+	}
+
+	return nil
+}
+
+func validateMaxFeeMultiplier(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	dec, err := math.LegacyNewDecFromStr(str)
+	if err != nil {
+		return fmt.Errorf("invalid max fee multiplier: %s", err)
+	}
+	if dec.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("max fee multiplier must be at least 1, got %s", dec)
+	}
+
+	return nil
+}
+
+func validateActionTypeFees(v interface{}) error {
+	fees, ok := v.([]ActionTypeFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(fees))
+	for _, fee := range fees {
+		name := strings.ToUpper(strings.TrimSpace(fee.ActionType))
+		if name == "" || name == ActionTypeUnspecified.String() {
+			return fmt.Errorf("action type fee entry must name an action type")
+		}
+		if _, dup := seen[name]; dup {
+			return fmt.Errorf("duplicate fee schedule for action type %s", name)
+		}
+		seen[name] = struct{}{}
+		if err := validateCoin(fee.BaseActionFee); err != nil {
+			return fmt.Errorf("action type %s base fee: %w", name, err)
+		}
+		if err := validateCoin(fee.FeePerKbyte); err != nil {
+			return fmt.Errorf("action type %s fee per kbyte: %w", name, err)
+		}
+		if fee.BaseActionFee.Denom != fee.FeePerKbyte.Denom {
+			return fmt.Errorf("action type %s base fee denom %s differs from fee per kbyte denom %s", name, fee.BaseActionFee.Denom, fee.FeePerKbyte.Denom)
+		}
+	}
+	return nil
+}
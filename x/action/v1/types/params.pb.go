@@ -50,6 +50,20 @@ type Params struct {
 	// LEP-5: Storage Verification Challenge parameters
 	SvcChallengeCount        uint32 `protobuf:"varint,12,opt,name=svc_challenge_count,json=svcChallengeCount,proto3" json:"svc_challenge_count,omitempty"`
 	SvcMinChunksForChallenge uint32 `protobuf:"varint,13,opt,name=svc_min_chunks_for_challenge,json=svcMinChunksForChallenge,proto3" json:"svc_min_chunks_for_challenge,omitempty"`
+	// Expiration
+	MaxExpirationsPerBlock uint64 `protobuf:"varint,14,opt,name=max_expirations_per_block,json=maxExpirationsPerBlock,proto3" json:"max_expirations_per_block,omitempty"`
+	// Per-type fee schedules. Action types without an entry use base_action_fee and fee_per_kbyte.
+	ActionTypeFees []ActionTypeFee `protobuf:"bytes,15,rep,name=action_type_fees,json=actionTypeFees,proto3" json:"action_type_fees"`
+	// Cancellation
+	CancellationFee types.Coin `protobuf:"bytes,16,opt,name=cancellation_fee,json=cancellationFee,proto3" json:"cancellation_fee"`
+	// Multi-supernode finalization
+	MaxFinalizationRedundancy uint32 `protobuf:"varint,17,opt,name=max_finalization_redundancy,json=maxFinalizationRedundancy,proto3" json:"max_finalization_redundancy,omitempty"`
+	// Congestion pricing. Action fees are scaled by a fee multiplier that the
+	// EndBlocker moves towards demand, EIP-1559 style.
+	FeeMultiplierMaxChange string `protobuf:"bytes,18,opt,name=fee_multiplier_max_change,json=feeMultiplierMaxChange,proto3" json:"fee_multiplier_max_change,omitempty"`
+	MaxFeeMultiplier       string `protobuf:"bytes,19,opt,name=max_fee_multiplier,json=maxFeeMultiplier,proto3" json:"max_fee_multiplier,omitempty"`
+	MinFreeStorageGb       uint64 `protobuf:"varint,20,opt,name=min_free_storage_gb,json=minFreeStorageGb,proto3" json:"min_free_storage_gb,omitempty"`
+	TargetPendingActions   uint64 `protobuf:"varint,21,opt,name=target_pending_actions,json=targetPendingActions,proto3" json:"target_pending_actions,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -176,54 +190,189 @@ func (m *Params) GetSvcMinChunksForChallenge() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxExpirationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpirationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetActionTypeFees() []ActionTypeFee {
+	if m != nil {
+		return m.ActionTypeFees
+	}
+	return nil
+}
+
+func (m *Params) GetCancellationFee() types.Coin {
+	if m != nil {
+		return m.CancellationFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxFinalizationRedundancy() uint32 {
+	if m != nil {
+		return m.MaxFinalizationRedundancy
+	}
+	return 0
+}
+
+func (m *Params) GetFeeMultiplierMaxChange() string {
+	if m != nil {
+		return m.FeeMultiplierMaxChange
+	}
+	return ""
+}
+
+func (m *Params) GetMaxFeeMultiplier() string {
+	if m != nil {
+		return m.MaxFeeMultiplier
+	}
+	return ""
+}
+
+func (m *Params) GetMinFreeStorageGb() uint64 {
+	if m != nil {
+		return m.MinFreeStorageGb
+	}
+	return 0
+}
+
+func (m *Params) GetTargetPendingActions() uint64 {
+	if m != nil {
+		return m.TargetPendingActions
+	}
+	return 0
+}
+
+// ActionTypeFee overrides the module-wide fee schedule for a single action type.
+type ActionTypeFee struct {
+	// Canonical action type name, e.g. "ACTION_TYPE_CASCADE".
+	ActionType    string     `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	BaseActionFee types.Coin `protobuf:"bytes,2,opt,name=base_action_fee,json=baseActionFee,proto3" json:"base_action_fee"`
+	FeePerKbyte   types.Coin `protobuf:"bytes,3,opt,name=fee_per_kbyte,json=feePerKbyte,proto3" json:"fee_per_kbyte"`
+}
+
+func (m *ActionTypeFee) Reset()         { *m = ActionTypeFee{} }
+func (m *ActionTypeFee) String() string { return proto.CompactTextString(m) }
+func (*ActionTypeFee) ProtoMessage()    {}
+func (*ActionTypeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f412eae394529c22, []int{1}
+}
+func (m *ActionTypeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionTypeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionTypeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionTypeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionTypeFee.Merge(m, src)
+}
+func (m *ActionTypeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionTypeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionTypeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionTypeFee proto.InternalMessageInfo
+
+func (m *ActionTypeFee) GetActionType() string {
+	if m != nil {
+		return m.ActionType
+	}
+	return ""
+}
+
+func (m *ActionTypeFee) GetBaseActionFee() types.Coin {
+	if m != nil {
+		return m.BaseActionFee
+	}
+	return types.Coin{}
+}
+
+func (m *ActionTypeFee) GetFeePerKbyte() types.Coin {
+	if m != nil {
+		return m.FeePerKbyte
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lumera.action.v1.Params")
+	proto.RegisterType((*ActionTypeFee)(nil), "lumera.action.v1.ActionTypeFee")
 }
 
 func init() { proto.RegisterFile("lumera/action/v1/params.proto", fileDescriptor_f412eae394529c22) }
 
 var fileDescriptor_f412eae394529c22 = []byte{
-	// 638 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0x13, 0x3b,
-	0x14, 0xc6, 0x33, 0xf7, 0xf6, 0xe6, 0xb6, 0x2e, 0xa1, 0xc4, 0x09, 0x62, 0x5a, 0x41, 0x12, 0xb1,
-	0x40, 0x11, 0x88, 0x19, 0x05, 0xc4, 0x86, 0x05, 0xd0, 0xa4, 0x8a, 0x90, 0xf8, 0xa3, 0x90, 0x74,
-	0xc5, 0xc6, 0x72, 0x66, 0x4e, 0x52, 0xd3, 0xd8, 0x1e, 0xec, 0x99, 0x68, 0xfa, 0x16, 0x2c, 0x59,
-	0xb2, 0x64, 0xc9, 0x82, 0x87, 0xe8, 0xb2, 0x62, 0xc5, 0xaa, 0xa0, 0x76, 0x01, 0x62, 0xcb, 0x0b,
-	0x20, 0x7b, 0x66, 0x9a, 0xaa, 0xaa, 0x04, 0x6c, 0xa2, 0xd8, 0xbf, 0xef, 0xfb, 0xce, 0xb1, 0x8f,
-	0x35, 0xe8, 0xda, 0x2c, 0xe1, 0xa0, 0xa8, 0x4f, 0x83, 0x98, 0x49, 0xe1, 0xcf, 0x3b, 0x7e, 0x44,
-	0x15, 0xe5, 0xda, 0x8b, 0x94, 0x8c, 0x25, 0xbe, 0x94, 0x61, 0x2f, 0xc3, 0xde, 0xbc, 0xb3, 0xb1,
-	0x1e, 0x48, 0xcd, 0xa5, 0x26, 0x96, 0xfb, 0xd9, 0x22, 0x13, 0x6f, 0x34, 0xb2, 0x95, 0x3f, 0xa6,
-	0x1a, 0xfc, 0x79, 0x67, 0x0c, 0x31, 0xed, 0xf8, 0x81, 0x64, 0x22, 0xe7, 0xf5, 0xa9, 0x9c, 0xca,
-	0xcc, 0x67, 0xfe, 0x15, 0xae, 0xa9, 0x94, 0xd3, 0x19, 0xf8, 0x76, 0x35, 0x4e, 0x26, 0x7e, 0x98,
-	0x28, 0x6a, 0xab, 0x65, 0xbc, 0x4a, 0x39, 0x13, 0xd2, 0xb7, 0xbf, 0xd9, 0xd6, 0xf5, 0x9f, 0x65,
-	0x54, 0x1e, 0xd8, 0x36, 0xf1, 0x53, 0xb4, 0x66, 0xca, 0x91, 0xac, 0x41, 0x32, 0x01, 0x70, 0x9d,
-	0x96, 0xd3, 0x5e, 0xbd, 0xb3, 0xee, 0xe5, 0xbd, 0x19, 0xec, 0xe5, 0xdd, 0x78, 0x3d, 0xc9, 0x44,
-	0x77, 0x65, 0xff, 0xb0, 0x59, 0x7a, 0xff, 0xed, 0xc3, 0x4d, 0x67, 0x58, 0x31, 0x74, 0xd3, 0x7a,
-	0xfb, 0x00, 0xf8, 0x31, 0xaa, 0x4c, 0x00, 0x48, 0x04, 0x8a, 0xec, 0x8e, 0xf7, 0x62, 0x70, 0xff,
-	0xf9, 0x8b, 0xac, 0xd5, 0x09, 0xc0, 0x00, 0xd4, 0x13, 0x63, 0xc4, 0x1d, 0x74, 0x99, 0xd3, 0x34,
-	0x6f, 0x4b, 0xdb, 0xc4, 0xf1, 0x4c, 0x06, 0xbb, 0xee, 0xbf, 0x2d, 0xa7, 0xbd, 0x34, 0xc4, 0x9c,
-	0xa6, 0x59, 0x59, 0x3d, 0x00, 0xd5, 0x35, 0x04, 0xdf, 0x40, 0x6b, 0x9c, 0x09, 0xa2, 0x13, 0x23,
-	0x16, 0x32, 0x04, 0xed, 0x2e, 0x59, 0x71, 0x85, 0x33, 0x31, 0x32, 0xbb, 0xcf, 0xcd, 0x26, 0xbe,
-	0x87, 0xae, 0x98, 0xe8, 0x30, 0x24, 0x54, 0x84, 0x64, 0xc2, 0xc4, 0x14, 0x54, 0xa4, 0x98, 0x88,
-	0xb5, 0xfb, 0x9f, 0xd5, 0xd7, 0x39, 0x4d, 0xb7, 0xc2, 0x4d, 0x11, 0xf6, 0x4f, 0x31, 0xec, 0x23,
-	0xb3, 0x4f, 0x14, 0x8d, 0x62, 0xa9, 0xc8, 0x6b, 0xa2, 0xf7, 0xf8, 0x58, 0xce, 0xb4, 0x5b, 0xb6,
-	0x9e, 0x2a, 0xa7, 0xe9, 0xd0, 0xa2, 0x17, 0xa3, 0x0c, 0xe0, 0x6d, 0x54, 0x83, 0x34, 0x62, 0xd9,
-	0x30, 0x48, 0x31, 0x15, 0xf7, 0xff, 0xfc, 0x4a, 0xb2, 0xb1, 0x79, 0xc5, 0xd8, 0xbc, 0xad, 0x5c,
-	0xd0, 0x5d, 0x36, 0x57, 0xf2, 0xf6, 0x4b, 0xd3, 0x19, 0xe2, 0x85, 0xbf, 0xa0, 0x78, 0x84, 0x6a,
-	0xe6, 0x94, 0x91, 0x92, 0x01, 0x68, 0xcd, 0xc4, 0x94, 0xc4, 0x8c, 0x83, 0xbb, 0xfc, 0xe7, 0xa9,
-	0x55, 0xce, 0xc4, 0xe0, 0xc4, 0xbe, 0xcd, 0x38, 0xe0, 0x57, 0xa8, 0x66, 0xce, 0x76, 0x36, 0x74,
-	0xe5, 0x77, 0xa1, 0x4d, 0x13, 0xfa, 0xe3, 0xb0, 0x79, 0x9e, 0x3b, 0xaf, 0x45, 0xd3, 0x33, 0xb5,
-	0x1e, 0xa2, 0xfa, 0x62, 0x44, 0xe6, 0xc1, 0x11, 0xbd, 0x43, 0x15, 0xb8, 0xa8, 0xe5, 0xb4, 0x57,
-	0xba, 0x17, 0x3f, 0x7d, 0xbc, 0x8d, 0xf2, 0xd7, 0xb2, 0x05, 0xc1, 0xb0, 0xaa, 0x8b, 0xc1, 0xf5,
-	0x01, 0x46, 0x46, 0x88, 0x1f, 0xa1, 0xfa, 0x44, 0x26, 0x22, 0xa4, 0xc5, 0x8b, 0xcd, 0x03, 0x56,
-	0xcf, 0x0d, 0xc0, 0x0b, 0xed, 0x49, 0x82, 0x87, 0x6a, 0x7a, 0x1e, 0x90, 0x60, 0x87, 0xce, 0x66,
-	0x20, 0xa6, 0x40, 0x02, 0x99, 0x88, 0xd8, 0xbd, 0xd0, 0x72, 0xda, 0x95, 0x61, 0x55, 0xcf, 0x83,
-	0x5e, 0x41, 0x7a, 0x06, 0xe0, 0x07, 0xe8, 0xaa, 0xd1, 0x9b, 0x7b, 0x0f, 0x76, 0x12, 0xb1, 0xab,
-	0xc9, 0x44, 0xaa, 0x85, 0xdd, 0xad, 0x58, 0xa3, 0xab, 0xe7, 0xc1, 0x33, 0x26, 0x7a, 0x56, 0xd1,
-	0x97, 0xea, 0x24, 0xe4, 0xfe, 0xd2, 0xf7, 0x77, 0x4d, 0xa7, 0x7b, 0x6b, 0xff, 0xa8, 0xe1, 0x1c,
-	0x1c, 0x35, 0x9c, 0xaf, 0x47, 0x0d, 0xe7, 0xcd, 0x71, 0xa3, 0x74, 0x70, 0xdc, 0x28, 0x7d, 0x3e,
-	0x6e, 0x94, 0x5e, 0x56, 0xd3, 0x53, 0xdf, 0x8f, 0x78, 0x2f, 0x02, 0x3d, 0x2e, 0xdb, 0xcb, 0xbe,
-	0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xc4, 0xa0, 0x60, 0x60, 0x04, 0x00, 0x00,
+	// 884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x31, 0x6f, 0x23, 0x45,
+	0x14, 0xce, 0x5e, 0x42, 0xb8, 0x8c, 0x71, 0x62, 0x4f, 0x7c, 0x61, 0x73, 0x80, 0x6d, 0x5d, 0x81,
+	0x2c, 0xd0, 0xed, 0xca, 0x07, 0x14, 0x20, 0x74, 0x70, 0x76, 0x30, 0x20, 0x08, 0x18, 0x3b, 0x15,
+	0xcd, 0x68, 0xbc, 0xfb, 0x76, 0x33, 0x64, 0x77, 0x66, 0x99, 0x59, 0x5b, 0x36, 0x2d, 0x7f, 0x80,
+	0x92, 0x92, 0x92, 0x92, 0x82, 0x1f, 0x71, 0x15, 0x3a, 0x51, 0x51, 0x1d, 0x28, 0x29, 0x40, 0xfc,
+	0x0a, 0x34, 0x33, 0x6b, 0x7b, 0x2f, 0xb2, 0x04, 0x87, 0xae, 0x89, 0xb2, 0xf3, 0x7d, 0xef, 0x9b,
+	0x37, 0xef, 0x7b, 0xef, 0x19, 0xbd, 0x92, 0x4c, 0x53, 0x90, 0xd4, 0xa7, 0x41, 0xce, 0x04, 0xf7,
+	0x67, 0x5d, 0x3f, 0xa3, 0x92, 0xa6, 0xca, 0xcb, 0xa4, 0xc8, 0x05, 0xae, 0x59, 0xd8, 0xb3, 0xb0,
+	0x37, 0xeb, 0xde, 0x3e, 0x0e, 0x84, 0x4a, 0x85, 0x22, 0x06, 0xf7, 0xed, 0x87, 0x25, 0xdf, 0x6e,
+	0xda, 0x2f, 0x7f, 0x42, 0x15, 0xf8, 0xb3, 0xee, 0x04, 0x72, 0xda, 0xf5, 0x03, 0xc1, 0x78, 0x81,
+	0x37, 0x62, 0x11, 0x0b, 0x1b, 0xa7, 0xff, 0x5b, 0x46, 0xc5, 0x42, 0xc4, 0x09, 0xf8, 0xe6, 0x6b,
+	0x32, 0x8d, 0xfc, 0x70, 0x2a, 0xa9, 0xb9, 0xcd, 0xe2, 0x75, 0x9a, 0x32, 0x2e, 0x7c, 0xf3, 0xd7,
+	0x1e, 0xdd, 0xf9, 0xb6, 0x82, 0x76, 0x87, 0x26, 0x4d, 0xfc, 0x29, 0x3a, 0xd0, 0xd7, 0x11, 0x9b,
+	0x20, 0x89, 0x00, 0x5c, 0xa7, 0xed, 0x74, 0x2a, 0xf7, 0x8e, 0xbd, 0x22, 0x37, 0x0d, 0x7b, 0x45,
+	0x36, 0x5e, 0x5f, 0x30, 0xde, 0xdb, 0x7b, 0xf8, 0xb8, 0xb5, 0xf5, 0xe3, 0x9f, 0x3f, 0xbd, 0xe6,
+	0x8c, 0xaa, 0x1a, 0x7d, 0x60, 0x62, 0x07, 0x00, 0xf8, 0x23, 0x54, 0x8d, 0x00, 0x48, 0x06, 0x92,
+	0x5c, 0x4c, 0x16, 0x39, 0xb8, 0x37, 0x9e, 0x42, 0xab, 0x12, 0x01, 0x0c, 0x41, 0x7e, 0xa2, 0x03,
+	0x71, 0x17, 0xdd, 0x4a, 0xe9, 0xbc, 0x48, 0x4b, 0x19, 0xc5, 0x49, 0x22, 0x82, 0x0b, 0x77, 0xbb,
+	0xed, 0x74, 0x76, 0x46, 0x38, 0xa5, 0x73, 0x7b, 0xad, 0x1a, 0x82, 0xec, 0x69, 0x04, 0xbf, 0x8a,
+	0x0e, 0x52, 0xc6, 0x89, 0x9a, 0x6a, 0x32, 0x17, 0x21, 0x28, 0x77, 0xc7, 0x90, 0xab, 0x29, 0xe3,
+	0x63, 0x7d, 0xfa, 0x99, 0x3e, 0xc4, 0x6f, 0xa1, 0x17, 0xb5, 0x74, 0x18, 0x12, 0xca, 0x43, 0x12,
+	0x31, 0x1e, 0x83, 0xcc, 0x24, 0xe3, 0xb9, 0x72, 0x9f, 0x33, 0xfc, 0x46, 0x4a, 0xe7, 0x27, 0xe1,
+	0x03, 0x1e, 0x0e, 0x4a, 0x18, 0xf6, 0x91, 0x3e, 0x27, 0x92, 0x66, 0xb9, 0x90, 0xe4, 0x6b, 0xa2,
+	0x16, 0xe9, 0x44, 0x24, 0xca, 0xdd, 0x35, 0x31, 0xf5, 0x94, 0xce, 0x47, 0x06, 0xfa, 0x62, 0x6c,
+	0x01, 0x7c, 0x86, 0x0e, 0x61, 0x9e, 0x31, 0x6b, 0x06, 0x59, 0xba, 0xe2, 0x3e, 0x5f, 0x94, 0xc4,
+	0xda, 0xe6, 0x2d, 0x6d, 0xf3, 0x4e, 0x0a, 0x42, 0xef, 0xa6, 0x2e, 0xc9, 0xf7, 0xbf, 0xb7, 0x9c,
+	0x11, 0x5e, 0xc7, 0x2f, 0x51, 0x3c, 0x46, 0x87, 0xfa, 0x95, 0x99, 0x14, 0x01, 0x28, 0xc5, 0x78,
+	0x4c, 0x72, 0x96, 0x82, 0x7b, 0xf3, 0xbf, 0xab, 0xd6, 0x53, 0xc6, 0x87, 0xab, 0xf0, 0x33, 0x96,
+	0x02, 0xfe, 0x0a, 0x1d, 0xea, 0xb7, 0x5d, 0x17, 0xdd, 0xfb, 0x37, 0xd1, 0x96, 0x16, 0xfd, 0xfb,
+	0x71, 0x6b, 0x53, 0x74, 0x71, 0x17, 0x9d, 0x5f, 0xbb, 0xeb, 0x3d, 0xd4, 0x58, 0x5b, 0xa4, 0x1b,
+	0x8e, 0xa8, 0x73, 0x2a, 0xc1, 0x45, 0x6d, 0xa7, 0xb3, 0xd7, 0xdb, 0xff, 0xf5, 0xe7, 0xbb, 0xa8,
+	0xe8, 0x96, 0x13, 0x08, 0x46, 0x75, 0xb5, 0x34, 0x6e, 0x00, 0x30, 0xd6, 0x44, 0xfc, 0x3e, 0x6a,
+	0x44, 0x62, 0xca, 0x43, 0xba, 0xec, 0xd8, 0x42, 0xa0, 0xb2, 0x51, 0x00, 0xaf, 0xb9, 0x2b, 0x05,
+	0x0f, 0x1d, 0xaa, 0x59, 0x40, 0x82, 0x73, 0x9a, 0x24, 0xc0, 0x63, 0x20, 0x81, 0x98, 0xf2, 0xdc,
+	0x7d, 0xa1, 0xed, 0x74, 0xaa, 0xa3, 0xba, 0x9a, 0x05, 0xfd, 0x25, 0xd2, 0xd7, 0x00, 0xbe, 0x8f,
+	0x5e, 0xd6, 0x7c, 0x5d, 0xf7, 0xe0, 0x7c, 0xca, 0x2f, 0x14, 0x89, 0x84, 0x5c, 0x87, 0xbb, 0x55,
+	0x13, 0xe8, 0xaa, 0x59, 0x70, 0xca, 0x78, 0xdf, 0x30, 0x06, 0x42, 0xae, 0x44, 0xf0, 0xdb, 0xe8,
+	0x58, 0x17, 0x68, 0xed, 0x66, 0xb9, 0xa1, 0xf7, 0x4d, 0xff, 0x1c, 0xa5, 0x74, 0xfe, 0xc1, 0x1a,
+	0x5f, 0x35, 0xf5, 0x19, 0xaa, 0x15, 0xa3, 0x99, 0x2f, 0x32, 0x53, 0x2e, 0xe5, 0x1e, 0xb4, 0xb7,
+	0x3b, 0x95, 0x7b, 0x2d, 0xef, 0xfa, 0x6e, 0xf1, 0xec, 0x44, 0x9c, 0x2d, 0x32, 0x5d, 0xac, 0xf2,
+	0x68, 0xed, 0xd3, 0x32, 0xa2, 0xf0, 0xe7, 0xa8, 0x16, 0x50, 0x1e, 0x40, 0x92, 0xac, 0x8a, 0xe8,
+	0xd6, 0x9e, 0x62, 0x54, 0x0f, 0xca, 0xd1, 0x7a, 0xf0, 0xef, 0xa3, 0x97, 0xf4, 0x0b, 0x23, 0xc6,
+	0x69, 0xc2, 0xbe, 0xb1, 0xa2, 0x12, 0x42, 0x5d, 0x79, 0x1e, 0x2c, 0xdc, 0xba, 0x29, 0x90, 0x2e,
+	0xc2, 0xa0, 0xc4, 0x18, 0xad, 0x08, 0xf8, 0x63, 0x74, 0xac, 0x8d, 0x4c, 0xa7, 0x49, 0xce, 0xb2,
+	0x84, 0x81, 0x24, 0x5a, 0x2e, 0x38, 0xa7, 0xba, 0xbc, 0x78, 0xa3, 0xb1, 0x47, 0x11, 0xc0, 0xe9,
+	0x8a, 0x7f, 0x4a, 0xe7, 0x7d, 0xc3, 0xc6, 0xef, 0x22, 0x6c, 0x52, 0x79, 0x42, 0xce, 0x3d, 0xdc,
+	0xa8, 0x51, 0xd3, 0x19, 0x95, 0x65, 0xf0, 0x5d, 0x3b, 0x5e, 0x91, 0xd4, 0x6d, 0x95, 0x0b, 0x49,
+	0x63, 0x20, 0xf1, 0xc4, 0x6d, 0x18, 0x93, 0x6a, 0x29, 0xe3, 0x03, 0x09, 0x30, 0xb6, 0xc0, 0x87,
+	0x13, 0xfc, 0x26, 0x3a, 0xca, 0xa9, 0x8c, 0x21, 0x27, 0x19, 0xf0, 0x50, 0xb7, 0x7e, 0xb1, 0xb1,
+	0xdc, 0x5b, 0x76, 0x95, 0x58, 0x74, 0x68, 0xc1, 0x62, 0x63, 0xbd, 0xb3, 0xf3, 0xd7, 0x0f, 0x2d,
+	0xe7, 0xce, 0x2f, 0x0e, 0xaa, 0x3e, 0xe1, 0x18, 0x6e, 0xa1, 0x4a, 0xc9, 0x6c, 0xb3, 0x88, 0xf7,
+	0x46, 0x68, 0xed, 0xdd, 0xa6, 0x6d, 0x7d, 0xe3, 0x19, 0x6e, 0xeb, 0xed, 0xff, 0xb9, 0xad, 0xed,
+	0x83, 0x7a, 0xaf, 0x3f, 0xbc, 0x6c, 0x3a, 0x8f, 0x2e, 0x9b, 0xce, 0x1f, 0x97, 0x4d, 0xe7, 0xbb,
+	0xab, 0xe6, 0xd6, 0xa3, 0xab, 0xe6, 0xd6, 0x6f, 0x57, 0xcd, 0xad, 0x2f, 0xeb, 0xf3, 0xd2, 0x0f,
+	0xa4, 0x7e, 0x9b, 0x9a, 0xec, 0x9a, 0x6d, 0xf2, 0xc6, 0x3f, 0x03, 0x00, 0x44, 0x75, 0xfd, 0xc8,
+	0x41, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -284,6 +433,65 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SvcMinChunksForChallenge != that1.SvcMinChunksForChallenge {
 		return false
 	}
+	if this.MaxExpirationsPerBlock != that1.MaxExpirationsPerBlock {
+		return false
+	}
+	if len(this.ActionTypeFees) != len(that1.ActionTypeFees) {
+		return false
+	}
+	for i := range this.ActionTypeFees {
+		if !this.ActionTypeFees[i].Equal(&that1.ActionTypeFees[i]) {
+			return false
+		}
+	}
+	if !this.CancellationFee.Equal(&that1.CancellationFee) {
+		return false
+	}
+	if this.MaxFinalizationRedundancy != that1.MaxFinalizationRedundancy {
+		return false
+	}
+	if this.FeeMultiplierMaxChange != that1.FeeMultiplierMaxChange {
+		return false
+	}
+	if this.MaxFeeMultiplier != that1.MaxFeeMultiplier {
+		return false
+	}
+	if this.MinFreeStorageGb != that1.MinFreeStorageGb {
+		return false
+	}
+	if this.TargetPendingActions != that1.TargetPendingActions {
+		return false
+	}
+	return true
+}
+func (this *ActionTypeFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActionTypeFee)
+	if !ok {
+		that2, ok := that.(ActionTypeFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ActionType != that1.ActionType {
+		return false
+	}
+	if !this.BaseActionFee.Equal(&that1.BaseActionFee) {
+		return false
+	}
+	if !this.FeePerKbyte.Equal(&that1.FeePerKbyte) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -306,6 +514,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetPendingActions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TargetPendingActions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MinFreeStorageGb != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinFreeStorageGb))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.MaxFeeMultiplier) > 0 {
+		i -= len(m.MaxFeeMultiplier)
+		copy(dAtA[i:], m.MaxFeeMultiplier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxFeeMultiplier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FeeMultiplierMaxChange) > 0 {
+		i -= len(m.FeeMultiplierMaxChange)
+		copy(dAtA[i:], m.FeeMultiplierMaxChange)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeMultiplierMaxChange)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MaxFinalizationRedundancy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFinalizationRedundancy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size, err := m.CancellationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.ActionTypeFees) > 0 {
+		for iNdEx := len(m.ActionTypeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionTypeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpirationsPerBlock))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.SvcMinChunksForChallenge != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SvcMinChunksForChallenge))
 		i--
@@ -330,29 +608,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxProcessingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxProcessingTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x4a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinProcessingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinProcessingTime):])
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxProcessingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxProcessingTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x42
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpirationDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpirationDuration):])
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinProcessingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinProcessingTime):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpirationDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpirationDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x3a
 	if m.MaxRaptorQSymbols != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxRaptorQSymbols))
@@ -397,6 +675,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ActionTypeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionTypeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionTypeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePerKbyte.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BaseActionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ActionType) > 0 {
+		i -= len(m.ActionType)
+		copy(dAtA[i:], m.ActionType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ActionType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -450,6 +778,51 @@ func (m *Params) Size() (n int) {
 	if m.SvcMinChunksForChallenge != 0 {
 		n += 1 + sovParams(uint64(m.SvcMinChunksForChallenge))
 	}
+	if m.MaxExpirationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpirationsPerBlock))
+	}
+	if len(m.ActionTypeFees) > 0 {
+		for _, e := range m.ActionTypeFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CancellationFee.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxFinalizationRedundancy != 0 {
+		n += 2 + sovParams(uint64(m.MaxFinalizationRedundancy))
+	}
+	l = len(m.FeeMultiplierMaxChange)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxFeeMultiplier)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MinFreeStorageGb != 0 {
+		n += 2 + sovParams(uint64(m.MinFreeStorageGb))
+	}
+	if m.TargetPendingActions != 0 {
+		n += 2 + sovParams(uint64(m.TargetPendingActions))
+	}
+	return n
+}
+
+func (m *ActionTypeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActionType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.BaseActionFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeePerKbyte.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -831,6 +1204,361 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpirationsPerBlock", wireType)
+			}
+			m.MaxExpirationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpirationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionTypeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionTypeFees = append(m.ActionTypeFees, ActionTypeFee{})
+			if err := m.ActionTypeFees[len(m.ActionTypeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CancellationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFinalizationRedundancy", wireType)
+			}
+			m.MaxFinalizationRedundancy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFinalizationRedundancy |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplierMaxChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeMultiplierMaxChange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFeeMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFreeStorageGb", wireType)
+			}
+			m.MinFreeStorageGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFreeStorageGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPendingActions", wireType)
+			}
+			m.TargetPendingActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPendingActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActionTypeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionTypeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionTypeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseActionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseActionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerKbyte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePerKbyte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
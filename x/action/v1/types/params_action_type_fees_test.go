@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LumeraProtocol/lumera/x/action/v1/types"
+)
+
+func TestParamsFeeSchedule(t *testing.T) {
+	params := types.DefaultParams()
+	params.ActionTypeFees = []types.ActionTypeFee{{
+		ActionType:    "action_type_cascade",
+		BaseActionFee: sdk.NewCoin("ulume", math.NewInt(1)),
+		FeePerKbyte:   sdk.NewCoin("ulume", math.NewInt(2)),
+	}}
+	require.NoError(t, params.Validate())
+
+	base, perKb := params.FeeSchedule(types.ActionTypeCascade)
+	require.Equal(t, int64(1), base.Amount.Int64())
+	require.Equal(t, int64(2), perKb.Amount.Int64())
+
+	base, perKb = params.FeeSchedule(types.ActionTypeSense)
+	require.Equal(t, params.BaseActionFee, base)
+	require.Equal(t, params.FeePerKbyte, perKb)
+}
+
+func TestParamsValidateActionTypeFees(t *testing.T) {
+	fee := types.ActionTypeFee{
+		ActionType:    types.ActionTypeSense.String(),
+		BaseActionFee: sdk.NewCoin("ulume", math.NewInt(1)),
+		FeePerKbyte:   sdk.NewCoin("ulume", math.NewInt(2)),
+	}
+
+	params := types.DefaultParams()
+	params.ActionTypeFees = []types.ActionTypeFee{fee, fee}
+	require.ErrorContains(t, params.Validate(), "duplicate fee schedule")
+
+	params.ActionTypeFees = []types.ActionTypeFee{{BaseActionFee: fee.BaseActionFee, FeePerKbyte: fee.FeePerKbyte}}
+	require.ErrorContains(t, params.Validate(), "must name an action type")
+
+	invalid := fee
+	invalid.FeePerKbyte = sdk.Coin{Denom: "ulume"}
+	params.ActionTypeFees = []types.ActionTypeFee{invalid}
+	require.Error(t, params.Validate())
+
+	mixed := fee
+	mixed.FeePerKbyte = sdk.NewCoin("uatom", math.NewInt(2))
+	params.ActionTypeFees = []types.ActionTypeFee{mixed}
+	require.ErrorContains(t, params.Validate(), "differs from fee per kbyte denom")
+
+	otherDenom := fee
+	otherDenom.BaseActionFee = sdk.NewCoin("uatom", math.NewInt(1))
+	otherDenom.FeePerKbyte = sdk.NewCoin("uatom", math.NewInt(2))
+	params.ActionTypeFees = []types.ActionTypeFee{otherDenom}
+	require.ErrorContains(t, params.Validate(), "fees must be in ulume")
+}
@@ -19,6 +19,8 @@ func TestParamsWithDefaults_FillsZeroSVCFields(t *testing.T) {
 		"WithDefaults must populate SvcChallengeCount when zero")
 	require.Equal(t, types.DefaultSVCMinChunksForChallenge, out.SvcMinChunksForChallenge,
 		"WithDefaults must populate SvcMinChunksForChallenge when zero")
+	require.Equal(t, types.DefaultMaxExpirationsPerBlock, out.MaxExpirationsPerBlock,
+		"WithDefaults must populate MaxExpirationsPerBlock when zero")
 }
 
 // TestParamsWithDefaults_PreservesNonZero ensures explicit non-zero values are
@@ -207,6 +207,8 @@ func (m *QueryGetActionResponse) GetAction() *Action {
 // QueryGetActionFeeRequest is a request type to get action fee based on data size
 type QueryGetActionFeeRequest struct {
 	DataSize string `protobuf:"bytes,1,opt,name=dataSize,proto3" json:"dataSize,omitempty"`
+	// Optional action type; selects the type's fee schedule when one is configured.
+	ActionType string `protobuf:"bytes,2,opt,name=actionType,proto3" json:"actionType,omitempty"`
 }
 
 func (m *QueryGetActionFeeRequest) Reset()         { *m = QueryGetActionFeeRequest{} }
@@ -249,9 +251,21 @@ func (m *QueryGetActionFeeRequest) GetDataSize() string {
 	return ""
 }
 
+func (m *QueryGetActionFeeRequest) GetActionType() string {
+	if m != nil {
+		return m.ActionType
+	}
+	return ""
+}
+
 // QueryGetActionFeeResponse is a response type to get action fee
 type QueryGetActionFeeResponse struct {
+	// Fee to pay: baseAmount scaled by the current fee multiplier, rounded up.
 	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Fee from the static schedule, before congestion pricing.
+	BaseAmount string `protobuf:"bytes,2,opt,name=baseAmount,proto3" json:"baseAmount,omitempty"`
+	// Current congestion fee multiplier (1 when the network is not congested).
+	FeeMultiplier string `protobuf:"bytes,3,opt,name=feeMultiplier,proto3" json:"feeMultiplier,omitempty"`
 }
 
 func (m *QueryGetActionFeeResponse) Reset()         { *m = QueryGetActionFeeResponse{} }
@@ -294,6 +308,20 @@ func (m *QueryGetActionFeeResponse) GetAmount() string {
 	return ""
 }
 
+func (m *QueryGetActionFeeResponse) GetBaseAmount() string {
+	if m != nil {
+		return m.BaseAmount
+	}
+	return ""
+}
+
+func (m *QueryGetActionFeeResponse) GetFeeMultiplier() string {
+	if m != nil {
+		return m.FeeMultiplier
+	}
+	return ""
+}
+
 // List actions with optional type and state filters
 type QueryListActionsRequest struct {
 	ActionType  ActionType         `protobuf:"varint,1,opt,name=actionType,proto3,enum=lumera.action.v1.ActionType" json:"actionType,omitempty"`
@@ -866,9 +894,13 @@ func (m *QueryListExpiredActionsResponse) GetTotal() uint64 {
 
 // QueryActionByMetadataRequest is a request type to query actions by metadata
 type QueryActionByMetadataRequest struct {
-	ActionType    ActionType         `protobuf:"varint,1,opt,name=actionType,proto3,enum=lumera.action.v1.ActionType" json:"actionType,omitempty"`
+	ActionType ActionType `protobuf:"varint,1,opt,name=actionType,proto3,enum=lumera.action.v1.ActionType" json:"actionType,omitempty"`
+	// AND-ed predicates joined by "&": "field=value" for exact and "field^=value"
+	// for prefix matches, e.g. "file_name^=scan_&data_hash=abc".
 	MetadataQuery string             `protobuf:"bytes,2,opt,name=metadataQuery,proto3" json:"metadataQuery,omitempty"`
 	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Additional AND-ed predicates, combined with those in metadataQuery.
+	Predicates []*MetadataPredicate `protobuf:"bytes,4,rep,name=predicates,proto3" json:"predicates,omitempty"`
 }
 
 func (m *QueryActionByMetadataRequest) Reset()         { *m = QueryActionByMetadataRequest{} }
@@ -925,6 +957,75 @@ func (m *QueryActionByMetadataRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryActionByMetadataRequest) GetPredicates() []*MetadataPredicate {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+// MetadataPredicate matches a searchable metadata field of an action.
+type MetadataPredicate struct {
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// When true, value is matched as a prefix instead of exactly.
+	Prefix bool `protobuf:"varint,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *MetadataPredicate) Reset()         { *m = MetadataPredicate{} }
+func (m *MetadataPredicate) String() string { return proto.CompactTextString(m) }
+func (*MetadataPredicate) ProtoMessage()    {}
+func (*MetadataPredicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_623fc14e9df78de8, []int{17}
+}
+func (m *MetadataPredicate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataPredicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataPredicate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataPredicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataPredicate.Merge(m, src)
+}
+func (m *MetadataPredicate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataPredicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataPredicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataPredicate proto.InternalMessageInfo
+
+func (m *MetadataPredicate) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MetadataPredicate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MetadataPredicate) GetPrefix() bool {
+	if m != nil {
+		return m.Prefix
+	}
+	return false
+}
+
 // QueryActionByMetadataResponse is a response type to query actions by metadata
 type QueryActionByMetadataResponse struct {
 	Actions    []*Action           `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
@@ -936,7 +1037,7 @@ func (m *QueryActionByMetadataResponse) Reset()         { *m = QueryActionByMeta
 func (m *QueryActionByMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActionByMetadataResponse) ProtoMessage()    {}
 func (*QueryActionByMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_623fc14e9df78de8, []int{17}
+	return fileDescriptor_623fc14e9df78de8, []int{18}
 }
 func (m *QueryActionByMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,80 +1105,87 @@ func init() {
 	proto.RegisterType((*QueryListExpiredActionsRequest)(nil), "lumera.action.v1.QueryListExpiredActionsRequest")
 	proto.RegisterType((*QueryListExpiredActionsResponse)(nil), "lumera.action.v1.QueryListExpiredActionsResponse")
 	proto.RegisterType((*QueryActionByMetadataRequest)(nil), "lumera.action.v1.QueryActionByMetadataRequest")
+	proto.RegisterType((*MetadataPredicate)(nil), "lumera.action.v1.MetadataPredicate")
 	proto.RegisterType((*QueryActionByMetadataResponse)(nil), "lumera.action.v1.QueryActionByMetadataResponse")
 }
 
 func init() { proto.RegisterFile("lumera/action/v1/query.proto", fileDescriptor_623fc14e9df78de8) }
 
 var fileDescriptor_623fc14e9df78de8 = []byte{
-	// 1063 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x09, 0x75, 0xc9, 0x0b, 0x45, 0xed, 0x10, 0x82, 0x59, 0x25, 0x6e, 0xd8, 0xa6,
-	0xa4, 0x75, 0xc5, 0x4e, 0xed, 0x90, 0x14, 0x68, 0x01, 0xc5, 0x94, 0x16, 0x4a, 0x03, 0x61, 0x03,
-	0x17, 0x2e, 0xd6, 0xd8, 0x1e, 0x1c, 0x0b, 0xdb, 0xb3, 0xf5, 0x8e, 0xa3, 0x1a, 0xcb, 0x17, 0x84,
-	0x7a, 0xae, 0xc4, 0x05, 0x09, 0x89, 0x33, 0x12, 0x42, 0x42, 0x08, 0xa1, 0x0a, 0xbe, 0x40, 0x05,
-	0x97, 0x4a, 0x5c, 0x38, 0x20, 0x14, 0x25, 0x48, 0x7c, 0x0d, 0xb4, 0x33, 0xb3, 0xce, 0x7a, 0x37,
-	0x9b, 0xb5, 0xab, 0x1c, 0x7c, 0x89, 0x76, 0x66, 0xde, 0x9b, 0xf7, 0x7b, 0x6f, 0x66, 0xf7, 0xfd,
-	0x63, 0x98, 0xaf, 0xb7, 0x1b, 0xac, 0x45, 0x09, 0x2d, 0x8b, 0x1a, 0x6f, 0x92, 0x9d, 0x1c, 0xb9,
-	0xd3, 0x66, 0xad, 0x8e, 0xe5, 0xb4, 0xb8, 0xe0, 0xf8, 0xb4, 0x5a, 0xb5, 0xd4, 0xaa, 0xb5, 0x93,
-	0x33, 0xce, 0xd0, 0x46, 0xad, 0xc9, 0x89, 0xfc, 0xab, 0x8c, 0x8c, 0xd9, 0x2a, 0xaf, 0x72, 0xf9,
-	0x48, 0xbc, 0x27, 0x3d, 0x3b, 0x5f, 0xe5, 0xbc, 0x5a, 0x67, 0x84, 0x3a, 0x35, 0x42, 0x9b, 0x4d,
-	0x2e, 0xa8, 0xb7, 0x85, 0xab, 0x57, 0xb3, 0x65, 0xee, 0x36, 0xb8, 0x4b, 0x4a, 0xd4, 0x65, 0x2a,
-	0x22, 0xd9, 0xc9, 0x95, 0x98, 0xa0, 0x39, 0xe2, 0xd0, 0x6a, 0xad, 0x29, 0x8d, 0xb5, 0xed, 0x42,
-	0x04, 0xd1, 0xa1, 0x2d, 0xda, 0xf0, 0xb7, 0x32, 0x23, 0xcb, 0xea, 0xa9, 0x28, 0x3a, 0x0e, 0xd3,
-	0x36, 0xe7, 0xe2, 0x6c, 0x5c, 0x41, 0x05, 0x8b, 0x8d, 0xa3, 0xd3, 0x96, 0xcb, 0xe6, 0x2c, 0xe0,
-	0x0f, 0x3d, 0xd0, 0x4d, 0x19, 0xdc, 0x66, 0x77, 0xda, 0xcc, 0x15, 0xa6, 0x0d, 0xcf, 0x0c, 0xcc,
-	0xba, 0x0e, 0x6f, 0xba, 0x0c, 0x5f, 0x85, 0x94, 0x82, 0x4c, 0xa3, 0x45, 0x74, 0x61, 0x26, 0x9f,
-	0xb6, 0xc2, 0x95, 0xb4, 0x94, 0x47, 0x61, 0xfa, 0xe1, 0x3f, 0x67, 0x27, 0xbe, 0xfb, 0xef, 0xc7,
-	0x2c, 0xb2, 0xb5, 0x8b, 0xb9, 0x02, 0xcf, 0xca, 0x3d, 0x6f, 0x32, 0xb1, 0x2e, 0xcd, 0x75, 0x30,
-	0x6c, 0xc0, 0x93, 0xca, 0xff, 0xdd, 0xeb, 0x72, 0xdf, 0x69, 0xbb, 0x3f, 0x36, 0x6f, 0xc1, 0x5c,
-	0xd8, 0x49, 0xb3, 0x5c, 0x86, 0x94, 0xb2, 0x8a, 0x67, 0xd1, 0x1e, 0xda, 0xce, 0x5c, 0x83, 0xf4,
-	0xe0, 0x5e, 0x37, 0x18, 0x0b, 0x30, 0x54, 0xa8, 0xa0, 0x5b, 0xb5, 0xcf, 0x99, 0xcf, 0xe0, 0x8f,
-	0xcd, 0x15, 0x78, 0xfe, 0x10, 0x3f, 0x8d, 0x31, 0x07, 0x29, 0xda, 0xe0, 0xed, 0xa6, 0xd0, 0x6e,
-	0x7a, 0x64, 0xee, 0x22, 0x78, 0x4e, 0x7a, 0xdd, 0xae, 0xb9, 0xda, 0xcd, 0xaf, 0x2e, 0xbe, 0x06,
-	0xa0, 0x90, 0x3e, 0xea, 0x38, 0x2a, 0xdc, 0xd3, 0xf9, 0xf9, 0x38, 0x7c, 0xcf, 0xc6, 0x0e, 0xd8,
-	0xe3, 0x37, 0x61, 0x46, 0x8d, 0xb6, 0xbc, 0x53, 0x4e, 0x4f, 0x4a, 0xf7, 0x85, 0x38, 0x77, 0x69,
-	0x64, 0x07, 0x3d, 0xf0, 0x0d, 0x80, 0x83, 0xdb, 0x98, 0x9e, 0x92, 0xd5, 0x7b, 0xd1, 0x52, 0x57,
-	0xd7, 0xf2, 0xae, 0xae, 0xa5, 0x5e, 0x16, 0x7d, 0x75, 0xad, 0x4d, 0x5a, 0xf5, 0xeb, 0x64, 0x07,
-	0x3c, 0xcd, 0x1f, 0x90, 0x2e, 0xe8, 0x40, 0x8a, 0xba, 0x2e, 0x79, 0x38, 0xa9, 0x62, 0x7a, 0x77,
-	0x65, 0xea, 0xc8, 0xf3, 0xf1, 0x0d, 0xf1, 0xcd, 0x01, 0xb0, 0x49, 0x09, 0xb6, 0x9c, 0x08, 0xa6,
-	0x02, 0x06, 0xc9, 0xf0, 0x2c, 0x9c, 0x10, 0x5c, 0xd0, 0xba, 0x4c, 0xee, 0x09, 0x5b, 0x0d, 0xcc,
-	0x2f, 0x11, 0x2c, 0x86, 0x79, 0x0b, 0x9d, 0xb7, 0x5a, 0x8c, 0x0a, 0xde, 0xf2, 0xcf, 0x26, 0x0d,
-	0x27, 0xcb, 0x6a, 0x46, 0x1f, 0xa8, 0x3f, 0x0c, 0x95, 0x6d, 0xf2, 0xb1, 0xcb, 0xf6, 0x0b, 0x82,
-	0x17, 0x8e, 0xc0, 0x18, 0xdf, 0xfa, 0x7d, 0x8d, 0xc0, 0x8c, 0x82, 0x6f, 0xb5, 0x1d, 0xd6, 0x7a,
-	0x9f, 0x57, 0xfa, 0xaf, 0x52, 0x16, 0x4e, 0xbb, 0xfe, 0xdc, 0x7a, 0xa5, 0xd2, 0x62, 0xae, 0xab,
-	0x4b, 0x19, 0x99, 0x3f, 0xb6, 0x9a, 0x3e, 0x40, 0x70, 0xee, 0x48, 0xb4, 0xf1, 0xad, 0xea, 0x7d,
-	0x04, 0x4b, 0x51, 0xf4, 0x42, 0x9d, 0x97, 0x3f, 0x7b, 0x87, 0xd5, 0xaa, 0xdb, 0xc2, 0xaf, 0xeb,
-	0x22, 0xcc, 0x94, 0x0e, 0x66, 0x65, 0x49, 0xa7, 0xec, 0xe0, 0xd4, 0xb1, 0x55, 0xf3, 0x57, 0x04,
-	0xe7, 0x13, 0x90, 0xc6, 0xb7, 0x9e, 0xdb, 0x90, 0xe9, 0xb3, 0xbf, 0x7d, 0xd7, 0xa9, 0xb5, 0x58,
-	0x25, 0xf4, 0xf9, 0x1d, 0x2c, 0x13, 0x7a, 0xec, 0x32, 0xfd, 0x8c, 0xe0, 0x6c, 0x6c, 0xa8, 0xf1,
-	0x2d, 0xd0, 0xef, 0x08, 0xe6, 0x25, 0xb6, 0x8a, 0x5b, 0xe8, 0x6c, 0x30, 0x41, 0xbd, 0x66, 0x77,
-	0x3c, 0xed, 0x69, 0x09, 0x4e, 0x35, 0xf4, 0x86, 0x32, 0x8a, 0x4c, 0x60, 0xda, 0x1e, 0x9c, 0x3c,
-	0xb6, 0x1e, 0xf4, 0x13, 0x82, 0x85, 0x98, 0x64, 0xc6, 0xf6, 0x04, 0xf2, 0xf7, 0x4e, 0xc1, 0x09,
-	0x55, 0x86, 0x7b, 0x08, 0x52, 0x4a, 0x31, 0xe1, 0xa5, 0x28, 0x56, 0x54, 0x98, 0x19, 0xe7, 0x13,
-	0xac, 0x14, 0x83, 0x49, 0xbe, 0xf8, 0xf3, 0xdf, 0xaf, 0x26, 0x2f, 0xe2, 0x65, 0x72, 0x5b, 0x9a,
-	0x6f, 0x7a, 0x5a, 0xaf, 0xcc, 0xeb, 0x24, 0x46, 0x74, 0xe2, 0x6f, 0x11, 0x4c, 0xf7, 0xf5, 0x0d,
-	0x5e, 0x8e, 0x89, 0x12, 0x96, 0x6e, 0xc6, 0x85, 0x64, 0x43, 0x4d, 0xf4, 0x86, 0x24, 0x7a, 0x05,
-	0xaf, 0x25, 0x12, 0x55, 0x99, 0x28, 0xea, 0x51, 0xd7, 0xd7, 0x81, 0x3d, 0xfc, 0x3d, 0x82, 0xa7,
-	0x82, 0x02, 0x0c, 0x67, 0x93, 0x42, 0x1f, 0xa8, 0x3b, 0xe3, 0xd2, 0x50, 0xb6, 0x9a, 0xb4, 0x20,
-	0x49, 0xaf, 0xe1, 0xd7, 0x46, 0x20, 0x2d, 0x7e, 0xca, 0x18, 0xe9, 0xfa, 0x8a, 0xb1, 0x87, 0xbf,
-	0x41, 0x30, 0x13, 0xf8, 0x78, 0xe2, 0x8b, 0x31, 0x00, 0x51, 0x71, 0x68, 0x64, 0x87, 0x31, 0xd5,
-	0xa8, 0xab, 0x12, 0x95, 0xe0, 0x97, 0x12, 0x51, 0xeb, 0x35, 0xd7, 0x67, 0x75, 0xf1, 0x1f, 0x08,
-	0x66, 0x0f, 0x13, 0x1f, 0x38, 0x9f, 0x1c, 0x3b, 0x2c, 0x98, 0x8c, 0x95, 0x91, 0x7c, 0x34, 0xf8,
-	0x2d, 0x09, 0x7e, 0x1d, 0x17, 0x46, 0x02, 0x2f, 0x96, 0x3a, 0x45, 0xad, 0xc6, 0x48, 0x57, 0x3f,
-	0xf4, 0xf0, 0xdf, 0x08, 0xe6, 0x0e, 0x6f, 0xfb, 0xf8, 0xe5, 0x61, 0xd8, 0xc2, 0x02, 0xc6, 0x58,
-	0x1d, 0xd1, 0x4b, 0xe7, 0xf4, 0xb1, 0xcc, 0xe9, 0x03, 0xbc, 0x31, 0x72, 0x4e, 0x52, 0x16, 0x35,
-	0x79, 0x85, 0x91, 0x6e, 0x58, 0x21, 0xf5, 0xf0, 0x2e, 0x82, 0x74, 0x5c, 0x1f, 0xc6, 0x6b, 0xc3,
-	0xa0, 0x46, 0xb5, 0x84, 0x71, 0x65, 0x64, 0x3f, 0x9d, 0xe4, 0x96, 0x4c, 0x72, 0x03, 0xbf, 0x37,
-	0x72, 0x92, 0x52, 0xa8, 0x14, 0xb7, 0xe5, 0x76, 0xa4, 0x1b, 0x90, 0x2d, 0x3d, 0xfc, 0x00, 0x01,
-	0x8e, 0xf6, 0x50, 0x7c, 0xf9, 0x08, 0xc8, 0x43, 0x3b, 0xbb, 0x91, 0x1b, 0xc1, 0x43, 0x27, 0xf4,
-	0xba, 0x4c, 0xe8, 0x0a, 0x5e, 0x1d, 0x2e, 0x21, 0xa6, 0x76, 0xe9, 0xbf, 0x4a, 0xbf, 0x21, 0xfd,
-	0x5f, 0x6d, 0xb8, 0xff, 0x60, 0x2b, 0x86, 0x25, 0xa6, 0xeb, 0x1a, 0x64, 0x68, 0x7b, 0x4d, 0xbe,
-	0x2e, 0xc9, 0xaf, 0xe2, 0x57, 0x13, 0xc9, 0x65, 0xb3, 0xf2, 0xbf, 0x54, 0xa5, 0x4e, 0xd1, 0x6f,
-	0xc5, 0x85, 0x4b, 0x0f, 0xf7, 0x32, 0xe8, 0xd1, 0x5e, 0x06, 0xed, 0xee, 0x65, 0xd0, 0xfd, 0xfd,
-	0xcc, 0xc4, 0xa3, 0xfd, 0xcc, 0xc4, 0x5f, 0xfb, 0x99, 0x89, 0x4f, 0xce, 0xdc, 0x0d, 0xf8, 0x8b,
-	0x8e, 0xc3, 0xdc, 0x52, 0x4a, 0xfe, 0x60, 0xb0, 0xf2, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x53,
-	0x51, 0x21, 0x86, 0x5c, 0x11, 0x00, 0x00,
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x33, 0x49, 0x9b, 0x36, 0x4f, 0x7e, 0xa9, 0x9a, 0xf9, 0x85, 0x60, 0x56, 0x89, 0x1b,
+	0x36, 0x29, 0x69, 0x53, 0xb1, 0xdb, 0x38, 0xa4, 0x05, 0x5a, 0x40, 0x71, 0x4b, 0x0a, 0xa5, 0x81,
+	0xb0, 0xe1, 0x8f, 0xc4, 0xc5, 0x1a, 0xdb, 0x13, 0x67, 0xc5, 0xda, 0xb3, 0xdd, 0x1d, 0x47, 0x31,
+	0x96, 0x2f, 0x08, 0xf5, 0x4a, 0x25, 0x2e, 0x48, 0x48, 0x9c, 0x91, 0x10, 0x12, 0x42, 0x08, 0x55,
+	0xf0, 0x06, 0x2a, 0x71, 0xa9, 0xc4, 0x85, 0x03, 0x42, 0x51, 0x82, 0xc4, 0xdb, 0x40, 0x3b, 0x33,
+	0xeb, 0xac, 0xbd, 0xde, 0xd8, 0xae, 0x72, 0xf0, 0x25, 0xda, 0x79, 0xf6, 0xf9, 0xf3, 0x79, 0x9e,
+	0x9d, 0xdd, 0xf9, 0xc6, 0x30, 0xe3, 0x54, 0xcb, 0xd4, 0x23, 0x26, 0x29, 0x70, 0x9b, 0x55, 0xcc,
+	0xdd, 0x65, 0xf3, 0x7e, 0x95, 0x7a, 0x35, 0xc3, 0xf5, 0x18, 0x67, 0xf8, 0xbc, 0xbc, 0x6b, 0xc8,
+	0xbb, 0xc6, 0xee, 0xb2, 0x36, 0x49, 0xca, 0x76, 0x85, 0x99, 0xe2, 0xaf, 0x74, 0xd2, 0xa6, 0x4a,
+	0xac, 0xc4, 0xc4, 0xa5, 0x19, 0x5c, 0x29, 0xeb, 0x4c, 0x89, 0xb1, 0x92, 0x43, 0x4d, 0xe2, 0xda,
+	0x26, 0xa9, 0x54, 0x18, 0x27, 0x41, 0x0a, 0x5f, 0xdd, 0x5d, 0x2a, 0x30, 0xbf, 0xcc, 0x7c, 0x33,
+	0x4f, 0x7c, 0x2a, 0x2b, 0x9a, 0xbb, 0xcb, 0x79, 0xca, 0xc9, 0xb2, 0xe9, 0x92, 0x92, 0x5d, 0x11,
+	0xce, 0xca, 0x77, 0x36, 0x86, 0xe8, 0x12, 0x8f, 0x94, 0xc3, 0x54, 0x7a, 0xec, 0xb6, 0xbc, 0xca,
+	0xf1, 0x9a, 0x4b, 0x95, 0xcf, 0x7c, 0x92, 0x8f, 0xcf, 0x09, 0xa7, 0x89, 0x75, 0x54, 0xdb, 0xe2,
+	0xb6, 0x3e, 0x05, 0xf8, 0xfd, 0x00, 0x74, 0x53, 0x14, 0xb7, 0xe8, 0xfd, 0x2a, 0xf5, 0xb9, 0x6e,
+	0xc1, 0xff, 0x5b, 0xac, 0xbe, 0xcb, 0x2a, 0x3e, 0xc5, 0x37, 0x60, 0x54, 0x42, 0xa6, 0xd0, 0x1c,
+	0xba, 0x34, 0x9e, 0x49, 0x19, 0xed, 0x93, 0x34, 0x64, 0x44, 0x76, 0xec, 0xf1, 0xdf, 0x17, 0x86,
+	0xbe, 0xfb, 0xf7, 0xc7, 0x25, 0x64, 0xa9, 0x10, 0x7d, 0x05, 0x9e, 0x11, 0x39, 0xef, 0x50, 0xbe,
+	0x26, 0xdc, 0x55, 0x31, 0xac, 0xc1, 0x59, 0x19, 0xff, 0xf6, 0x6d, 0x91, 0x77, 0xcc, 0x6a, 0xae,
+	0xf5, 0xbb, 0x30, 0xdd, 0x1e, 0xa4, 0x58, 0xae, 0xc2, 0xa8, 0xf4, 0x4a, 0x66, 0x51, 0x11, 0xca,
+	0x4f, 0xff, 0x08, 0x52, 0xad, 0xb9, 0xd6, 0x29, 0x8d, 0x30, 0x14, 0x09, 0x27, 0x5b, 0xf6, 0x67,
+	0x34, 0x64, 0x08, 0xd7, 0x38, 0x0d, 0x20, 0x33, 0x7c, 0x50, 0x73, 0x69, 0x6a, 0x58, 0xdc, 0x8d,
+	0x58, 0xf4, 0x1a, 0x3c, 0xd7, 0x21, 0xaf, 0xc2, 0x9c, 0x86, 0x51, 0x52, 0x66, 0xd5, 0x0a, 0x57,
+	0x69, 0xd5, 0x2a, 0x48, 0x1a, 0xec, 0x92, 0x35, 0x79, 0x4f, 0x25, 0x3d, 0xb2, 0xe0, 0x05, 0x98,
+	0xd8, 0xa6, 0x74, 0xa3, 0xea, 0x70, 0xdb, 0x75, 0x6c, 0xea, 0xa5, 0x46, 0x84, 0x4b, 0xab, 0x51,
+	0xdf, 0x47, 0xf0, 0xac, 0xa8, 0x7d, 0xcf, 0xf6, 0x55, 0xf1, 0xf0, 0x19, 0xe2, 0x9b, 0x2d, 0xd8,
+	0x41, 0xf5, 0x73, 0x99, 0x99, 0xa4, 0x21, 0x05, 0x3e, 0xd1, 0xa6, 0xf0, 0x1b, 0x30, 0x2e, 0x57,
+	0x5b, 0xc1, 0x5e, 0x12, 0x80, 0xe7, 0x32, 0xb3, 0x49, 0xe1, 0xc2, 0xc9, 0x8a, 0x46, 0xe0, 0x75,
+	0x80, 0xa3, 0x3d, 0x2f, 0xe8, 0xc7, 0x33, 0x2f, 0x18, 0xf2, 0x05, 0x31, 0x82, 0x46, 0x0d, 0xf9,
+	0x4a, 0xaa, 0x17, 0xc4, 0xd8, 0x24, 0xa5, 0xf0, 0x69, 0x58, 0x91, 0x48, 0xfd, 0x07, 0x04, 0xa9,
+	0x78, 0x8b, 0x6a, 0xba, 0x19, 0x38, 0x23, 0x6b, 0x06, 0x3b, 0x72, 0xe4, 0xd8, 0x5d, 0x10, 0x3a,
+	0xe2, 0x3b, 0x2d, 0x60, 0xc3, 0x02, 0x6c, 0xb1, 0x2b, 0x98, 0x2c, 0x18, 0x25, 0xc3, 0x53, 0x70,
+	0x9a, 0x33, 0x4e, 0x1c, 0xd1, 0xdc, 0x29, 0x4b, 0x2e, 0xf4, 0x2f, 0x10, 0xcc, 0xb5, 0xf3, 0x66,
+	0x6b, 0xb7, 0x3c, 0x4a, 0x38, 0xf3, 0xc2, 0x67, 0x93, 0x82, 0x33, 0x05, 0x69, 0x51, 0xdb, 0x22,
+	0x5c, 0xe2, 0xf5, 0x0e, 0x74, 0x4f, 0x33, 0xb6, 0x5f, 0x10, 0x3c, 0x7f, 0x0c, 0xc6, 0xe0, 0xce,
+	0xef, 0x6b, 0x04, 0x7a, 0x1c, 0x7c, 0xab, 0xea, 0x52, 0xef, 0x5d, 0x56, 0x6c, 0xbe, 0xb0, 0x4b,
+	0x70, 0xde, 0x0f, 0x6d, 0x6b, 0xc5, 0xa2, 0x47, 0x7d, 0x5f, 0x8d, 0x32, 0x66, 0x3f, 0xb1, 0x99,
+	0x3e, 0x42, 0x30, 0x7f, 0x2c, 0xda, 0xe0, 0x4e, 0xf5, 0x21, 0x82, 0x85, 0x38, 0x7a, 0xd6, 0x61,
+	0x85, 0x4f, 0xdf, 0xa2, 0x76, 0x69, 0x87, 0x87, 0x73, 0x9d, 0x83, 0xf1, 0xfc, 0x91, 0x55, 0x8c,
+	0x74, 0xc4, 0x8a, 0x9a, 0x4e, 0x6c, 0x9a, 0xbf, 0x22, 0xb8, 0xd8, 0x05, 0x69, 0x70, 0xe7, 0xb9,
+	0x03, 0xe9, 0x26, 0xfb, 0x9b, 0x7b, 0xae, 0xed, 0xd1, 0x62, 0xdb, 0xe7, 0xb7, 0x75, 0x4c, 0xe8,
+	0xa9, 0xc7, 0xf4, 0x33, 0x82, 0x0b, 0x89, 0xa5, 0x06, 0x77, 0x40, 0x5f, 0x0e, 0xc3, 0x8c, 0xc0,
+	0x96, 0x75, 0xb3, 0xb5, 0x0d, 0xca, 0x49, 0x70, 0xa4, 0x9e, 0xcc, 0xf1, 0xb4, 0x00, 0x13, 0x65,
+	0x95, 0x50, 0x54, 0x51, 0x27, 0x68, 0xab, 0xf1, 0xa4, 0xce, 0x20, 0x7c, 0x0b, 0xc0, 0xf5, 0x68,
+	0xd1, 0x2e, 0x10, 0x4e, 0xfd, 0xd4, 0x29, 0x31, 0xe2, 0xf9, 0x38, 0x6b, 0xd8, 0xe2, 0x66, 0xe8,
+	0x6b, 0x45, 0xc2, 0xf4, 0x8f, 0x61, 0x32, 0xe6, 0x10, 0x0c, 0x6f, 0xdb, 0xa6, 0x4e, 0x51, 0x7d,
+	0xbb, 0xe4, 0x22, 0xb0, 0xee, 0x12, 0xa7, 0x1a, 0x8a, 0x0d, 0xb9, 0x08, 0xa4, 0x84, 0xeb, 0xd1,
+	0x6d, 0x7b, 0x4f, 0x74, 0x72, 0xd6, 0x52, 0x2b, 0xfd, 0x27, 0x04, 0xb3, 0x09, 0xa3, 0x1e, 0xd8,
+	0xfd, 0x91, 0x79, 0x30, 0x01, 0xa7, 0xe5, 0x43, 0x7a, 0x80, 0x60, 0x54, 0xaa, 0x46, 0xbc, 0x10,
+	0xc7, 0x8a, 0x8b, 0x53, 0xed, 0x62, 0x17, 0x2f, 0xc9, 0xa0, 0x9b, 0x9f, 0xff, 0xf1, 0xcf, 0x57,
+	0xc3, 0x97, 0xf1, 0xa2, 0x79, 0x4f, 0xb8, 0x6f, 0x7a, 0x8c, 0xb3, 0x02, 0x73, 0xcc, 0x04, 0xe1,
+	0x8d, 0xbf, 0x45, 0x30, 0xd6, 0xd4, 0x70, 0x78, 0x31, 0xa1, 0x4a, 0xbb, 0x7c, 0xd5, 0x2e, 0x75,
+	0x77, 0x54, 0x44, 0xaf, 0x0b, 0xa2, 0x97, 0xf1, 0xb5, 0xae, 0x44, 0x25, 0xca, 0x73, 0x6a, 0x55,
+	0x0f, 0xb5, 0x70, 0x03, 0x7f, 0x8f, 0xe0, 0x7f, 0x51, 0x91, 0x89, 0x97, 0xba, 0x95, 0x3e, 0x52,
+	0xb8, 0xda, 0x95, 0x9e, 0x7c, 0x15, 0x69, 0x56, 0x90, 0xde, 0xc4, 0xaf, 0xf6, 0x41, 0x9a, 0xdb,
+	0xa6, 0xd4, 0xac, 0x87, 0xaa, 0xb9, 0x81, 0xbf, 0x41, 0x30, 0x1e, 0xf9, 0xb4, 0xe3, 0xcb, 0x09,
+	0x00, 0x71, 0xe9, 0xaa, 0x2d, 0xf5, 0xe2, 0xaa, 0x50, 0x57, 0x05, 0xaa, 0x89, 0x5f, 0xec, 0x8a,
+	0xea, 0xd8, 0x7e, 0xc8, 0xea, 0xe3, 0xdf, 0x11, 0x4c, 0x75, 0x92, 0x46, 0x38, 0xd3, 0xbd, 0x76,
+	0xbb, 0x9c, 0xd3, 0x56, 0xfa, 0x8a, 0x51, 0xe0, 0x77, 0x05, 0xf8, 0x6d, 0x9c, 0xed, 0x0b, 0x3c,
+	0x97, 0xaf, 0xe5, 0x94, 0x56, 0x34, 0xeb, 0xea, 0xa2, 0x81, 0xff, 0x42, 0x30, 0xdd, 0x59, 0x94,
+	0xe0, 0x97, 0x7a, 0x61, 0x6b, 0x97, 0x57, 0xda, 0x6a, 0x9f, 0x51, 0xaa, 0xa7, 0x0f, 0x45, 0x4f,
+	0xef, 0xe1, 0x8d, 0xbe, 0x7b, 0x12, 0xa2, 0xad, 0xc2, 0x8a, 0xd4, 0xac, 0xb7, 0xeb, 0xb7, 0x06,
+	0xde, 0x47, 0x90, 0x4a, 0x52, 0x09, 0xf8, 0x5a, 0x2f, 0xa8, 0x71, 0xa5, 0xa3, 0x5d, 0xef, 0x3b,
+	0x4e, 0x35, 0xb9, 0x25, 0x9a, 0xdc, 0xc0, 0xef, 0xf4, 0xdd, 0xa4, 0x90, 0x51, 0xb9, 0x1d, 0x91,
+	0xce, 0xac, 0x47, 0x44, 0x55, 0x03, 0x3f, 0x42, 0x80, 0xe3, 0x27, 0x3c, 0xbe, 0x7a, 0x0c, 0x64,
+	0x47, 0xdd, 0xa1, 0x2d, 0xf7, 0x11, 0xa1, 0x1a, 0x7a, 0x4d, 0x34, 0x74, 0x1d, 0xaf, 0xf6, 0xd6,
+	0x10, 0x95, 0x59, 0x9a, 0xaf, 0xd2, 0x6f, 0x48, 0xfd, 0x67, 0xdf, 0x7e, 0xfe, 0x60, 0x23, 0x81,
+	0x25, 0x41, 0x13, 0x68, 0x66, 0xcf, 0xfe, 0x8a, 0x7c, 0x4d, 0x90, 0xdf, 0xc0, 0xaf, 0x74, 0x25,
+	0x17, 0x87, 0x55, 0xf8, 0xa5, 0xca, 0xd7, 0x72, 0xa1, 0x50, 0xc8, 0x5e, 0x79, 0x7c, 0x90, 0x46,
+	0x4f, 0x0e, 0xd2, 0x68, 0xff, 0x20, 0x8d, 0x1e, 0x1e, 0xa6, 0x87, 0x9e, 0x1c, 0xa6, 0x87, 0xfe,
+	0x3c, 0x4c, 0x0f, 0x7d, 0x32, 0xb9, 0x17, 0x89, 0x0f, 0x7e, 0x76, 0xf1, 0xf3, 0xa3, 0xe2, 0x47,
+	0x93, 0x95, 0xff, 0x06, 0x00, 0x10, 0xb1, 0xe9, 0xa9, 0x60, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1608,6 +1716,13 @@ func (m *QueryGetActionFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActionType) > 0 {
+		i -= len(m.ActionType)
+		copy(dAtA[i:], m.ActionType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ActionType)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.DataSize) > 0 {
 		i -= len(m.DataSize)
 		copy(dAtA[i:], m.DataSize)
@@ -1638,6 +1753,20 @@ func (m *QueryGetActionFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeMultiplier) > 0 {
+		i -= len(m.FeeMultiplier)
+		copy(dAtA[i:], m.FeeMultiplier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeMultiplier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAmount) > 0 {
+		i -= len(m.BaseAmount)
+		copy(dAtA[i:], m.BaseAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -2142,6 +2271,20 @@ func (m *QueryActionByMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predicates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2169,6 +2312,53 @@ func (m *QueryActionByMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MetadataPredicate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataPredicate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataPredicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prefix {
+		i--
+		if m.Prefix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryActionByMetadataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2290,6 +2480,10 @@ func (m *QueryGetActionFeeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ActionType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2303,6 +2497,14 @@ func (m *QueryGetActionFeeResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.BaseAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeeMultiplier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2515,6 +2717,32 @@ func (m *QueryActionByMetadataRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Predicates) > 0 {
+		for _, e := range m.Predicates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MetadataPredicate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Prefix {
+		n += 2
+	}
 	return n
 }
 
@@ -2908,6 +3136,38 @@ func (m *QueryGetActionFeeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DataSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2990,6 +3250,70 @@ func (m *QueryGetActionFeeResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4373,6 +4697,174 @@ func (m *QueryActionByMetadataRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, &MetadataPredicate{})
+			if err := m.Predicates[len(m.Predicates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataPredicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataPredicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataPredicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prefix = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -133,6 +133,8 @@ type MsgRequestAction struct {
 	ExpirationTime string `protobuf:"bytes,5,opt,name=expirationTime,proto3" json:"expirationTime,omitempty"`
 	FileSizeKbs    string `protobuf:"bytes,6,opt,name=fileSizeKbs,proto3" json:"fileSizeKbs,omitempty"`
 	AppPubkey      []byte `protobuf:"bytes,7,opt,name=app_pubkey,json=appPubkey,proto3" json:"app_pubkey,omitempty"`
+	// Number of independent supernode finalizations to require (0 or 1 = single finalizer).
+	FinalizationRedundancy uint32 `protobuf:"varint,8,opt,name=finalization_redundancy,json=finalizationRedundancy,proto3" json:"finalization_redundancy,omitempty"`
 }
 
 func (m *MsgRequestAction) Reset()         { *m = MsgRequestAction{} }
@@ -217,6 +219,13 @@ func (m *MsgRequestAction) GetAppPubkey() []byte {
 	return nil
 }
 
+func (m *MsgRequestAction) GetFinalizationRedundancy() uint32 {
+	if m != nil {
+		return m.FinalizationRedundancy
+	}
+	return 0
+}
+
 // MsgRequestActionResponse defines the response structure for executing a MsgRequestAction
 type MsgRequestActionResponse struct {
 	ActionId string `protobuf:"bytes,1,opt,name=actionId,proto3" json:"actionId,omitempty"`
@@ -482,6 +491,128 @@ func (m *MsgApproveActionResponse) GetStatus() string {
 	return ""
 }
 
+// MsgCancelAction is the Msg/CancelAction request type.
+type MsgCancelAction struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ActionId string `protobuf:"bytes,2,opt,name=actionId,proto3" json:"actionId,omitempty"`
+}
+
+func (m *MsgCancelAction) Reset()         { *m = MsgCancelAction{} }
+func (m *MsgCancelAction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAction) ProtoMessage()    {}
+func (*MsgCancelAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3cb9cc9b6dca75e, []int{8}
+}
+func (m *MsgCancelAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAction.Merge(m, src)
+}
+func (m *MsgCancelAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAction proto.InternalMessageInfo
+
+func (m *MsgCancelAction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelAction) GetActionId() string {
+	if m != nil {
+		return m.ActionId
+	}
+	return ""
+}
+
+// MsgCancelActionResponse defines the response structure for executing a MsgCancelAction
+type MsgCancelActionResponse struct {
+	ActionId string `protobuf:"bytes,1,opt,name=actionId,proto3" json:"actionId,omitempty"`
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Refund   string `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	Fee      string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *MsgCancelActionResponse) Reset()         { *m = MsgCancelActionResponse{} }
+func (m *MsgCancelActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelActionResponse) ProtoMessage()    {}
+func (*MsgCancelActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3cb9cc9b6dca75e, []int{9}
+}
+func (m *MsgCancelActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelActionResponse.Merge(m, src)
+}
+func (m *MsgCancelActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelActionResponse proto.InternalMessageInfo
+
+func (m *MsgCancelActionResponse) GetActionId() string {
+	if m != nil {
+		return m.ActionId
+	}
+	return ""
+}
+
+func (m *MsgCancelActionResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MsgCancelActionResponse) GetRefund() string {
+	if m != nil {
+		return m.Refund
+	}
+	return ""
+}
+
+func (m *MsgCancelActionResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "lumera.action.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumera.action.v1.MsgUpdateParamsResponse")
@@ -491,51 +622,58 @@ func init() {
 	proto.RegisterType((*MsgFinalizeActionResponse)(nil), "lumera.action.v1.MsgFinalizeActionResponse")
 	proto.RegisterType((*MsgApproveAction)(nil), "lumera.action.v1.MsgApproveAction")
 	proto.RegisterType((*MsgApproveActionResponse)(nil), "lumera.action.v1.MsgApproveActionResponse")
+	proto.RegisterType((*MsgCancelAction)(nil), "lumera.action.v1.MsgCancelAction")
+	proto.RegisterType((*MsgCancelActionResponse)(nil), "lumera.action.v1.MsgCancelActionResponse")
 }
 
 func init() { proto.RegisterFile("lumera/action/v1/tx.proto", fileDescriptor_b3cb9cc9b6dca75e) }
 
 var fileDescriptor_b3cb9cc9b6dca75e = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x9b, 0x36, 0x25, 0xaf, 0xa5, 0xb4, 0xa7, 0x8a, 0x3a, 0x46, 0x35, 0xc1, 0x48, 0xa8,
-	0xa4, 0x22, 0x56, 0x8b, 0x60, 0x28, 0x53, 0x33, 0x20, 0x21, 0x14, 0x54, 0xb9, 0xc0, 0x80, 0x90,
-	0xa2, 0x4b, 0x72, 0x18, 0x8b, 0x38, 0x77, 0xf8, 0x2e, 0x51, 0xd2, 0x09, 0x31, 0x32, 0x55, 0xe2,
-	0x9f, 0x60, 0xcc, 0xc0, 0xca, 0xde, 0xb1, 0x62, 0x62, 0x42, 0x28, 0x19, 0xf2, 0x17, 0xb0, 0xa3,
-	0xdc, 0x39, 0xa9, 0xed, 0x04, 0x82, 0xd4, 0xc5, 0xf2, 0x7b, 0xdf, 0x77, 0xef, 0xbd, 0xef, 0xbb,
-	0x1f, 0x90, 0x6b, 0xb4, 0x7c, 0x12, 0x60, 0x1b, 0xd7, 0x84, 0x47, 0x9b, 0x76, 0x7b, 0xcf, 0x16,
-	0x9d, 0x22, 0x0b, 0xa8, 0xa0, 0x68, 0x5d, 0x41, 0x45, 0x05, 0x15, 0xdb, 0x7b, 0xc6, 0x06, 0xf6,
-	0xbd, 0x26, 0xb5, 0xe5, 0x57, 0x91, 0x8c, 0x4d, 0x97, 0xba, 0x54, 0xfe, 0xda, 0xa3, 0xbf, 0x30,
-	0xbb, 0x55, 0xa3, 0xdc, 0xa7, 0xdc, 0xf6, 0xb9, 0x3b, 0x2a, 0xe9, 0x73, 0x37, 0x04, 0x72, 0x0a,
-	0xa8, 0xa8, 0x15, 0x2a, 0x08, 0xa1, 0xed, 0xa9, 0x49, 0x18, 0x0e, 0xb0, 0x1f, 0xc2, 0xd6, 0x37,
-	0x0d, 0xae, 0x95, 0xb9, 0xfb, 0x82, 0xd5, 0xb1, 0x20, 0x47, 0x12, 0x41, 0x0f, 0x21, 0x8b, 0x5b,
-	0xe2, 0x2d, 0x0d, 0x3c, 0xd1, 0xd5, 0xb5, 0xbc, 0xb6, 0x93, 0x2d, 0xe9, 0xdf, 0xbf, 0xde, 0xdb,
-	0x0c, 0xeb, 0x1e, 0xd6, 0xeb, 0x01, 0xe1, 0xfc, 0x58, 0x04, 0x5e, 0xd3, 0x75, 0x2e, 0xa8, 0xe8,
-	0x11, 0x64, 0x54, 0x6d, 0x7d, 0x21, 0xaf, 0xed, 0xac, 0xec, 0xeb, 0xc5, 0xa4, 0xd4, 0xa2, 0xea,
-	0x50, 0xca, 0x9e, 0xfd, 0xbc, 0x99, 0xfa, 0x32, 0xec, 0x15, 0x34, 0x27, 0x5c, 0x72, 0xf0, 0xe0,
-	0xe3, 0xb0, 0x57, 0xb8, 0x28, 0xf6, 0x69, 0xd8, 0x2b, 0x58, 0xe1, 0xe8, 0x9d, 0xc8, 0xf0, 0x89,
-	0x59, 0xad, 0x1c, 0x6c, 0x25, 0x52, 0x0e, 0xe1, 0x8c, 0x36, 0x39, 0xb1, 0x7e, 0x6b, 0xb0, 0x5e,
-	0xe6, 0xae, 0x43, 0xde, 0xb7, 0x08, 0x17, 0x87, 0xb2, 0x04, 0xd2, 0x61, 0xb9, 0x16, 0x10, 0x2c,
-	0x68, 0xa0, 0x94, 0x39, 0xe3, 0x10, 0x99, 0x00, 0xaa, 0xcd, 0xf3, 0x2e, 0x23, 0x52, 0x41, 0xd6,
-	0x89, 0x64, 0x90, 0x01, 0x57, 0x7c, 0x22, 0x70, 0x1d, 0x0b, 0xac, 0xa7, 0x25, 0x3a, 0x89, 0xd1,
-	0x26, 0x2c, 0xb1, 0xc0, 0xab, 0x11, 0x7d, 0x51, 0x02, 0x2a, 0x40, 0x77, 0x60, 0x8d, 0x74, 0x98,
-	0x17, 0x60, 0x59, 0xc3, 0xf3, 0x89, 0xbe, 0x24, 0xe1, 0x44, 0x16, 0xe5, 0x61, 0xe5, 0x8d, 0xd7,
-	0x20, 0xc7, 0xde, 0x09, 0x79, 0x5a, 0xe5, 0x7a, 0x46, 0x92, 0xa2, 0x29, 0xb4, 0x0d, 0x80, 0x19,
-	0xab, 0xb0, 0x56, 0xf5, 0x1d, 0xe9, 0xea, 0xcb, 0x79, 0x6d, 0x67, 0xd5, 0xc9, 0x62, 0xc6, 0x8e,
-	0x64, 0xe2, 0x60, 0x75, 0xe4, 0xdd, 0x58, 0x88, 0xf5, 0x0c, 0xf4, 0xa4, 0xec, 0xb1, 0x27, 0x23,
-	0x11, 0x4a, 0xd2, 0x93, 0x7a, 0xa8, 0x7f, 0x12, 0xa3, 0xeb, 0x90, 0xe1, 0x02, 0x8b, 0x16, 0x0f,
-	0xc5, 0x87, 0x91, 0xf5, 0x59, 0x83, 0x8d, 0x32, 0x77, 0x1f, 0x7b, 0x4d, 0xdc, 0xf0, 0x4e, 0xc8,
-	0x5c, 0x23, 0xa3, 0x3d, 0x16, 0x12, 0x3d, 0xe2, 0x26, 0xa7, 0xff, 0x69, 0xf2, 0x62, 0xdc, 0xe4,
-	0x84, 0xca, 0x1b, 0x90, 0x9b, 0x1a, 0x6a, 0xb2, 0xf5, 0x2f, 0xe5, 0xce, 0x1f, 0x32, 0x16, 0xd0,
-	0xf6, 0xa5, 0x06, 0x9e, 0x69, 0x6d, 0xac, 0xee, 0x65, 0xac, 0xdd, 0x3f, 0x4d, 0x43, 0xba, 0xcc,
-	0x5d, 0xf4, 0x1a, 0x56, 0x63, 0x37, 0xf0, 0xd6, 0xf4, 0xcd, 0x49, 0x9c, 0x72, 0xe3, 0xee, 0x5c,
-	0xca, 0x64, 0xb2, 0x0a, 0x5c, 0x8d, 0x5f, 0x02, 0x6b, 0xe6, 0xda, 0x18, 0xc7, 0x28, 0xcc, 0xe7,
-	0x4c, 0x1a, 0x54, 0x61, 0x2d, 0x71, 0x3a, 0x6e, 0xcf, 0x5c, 0x1d, 0x27, 0x19, 0xbb, 0xff, 0x41,
-	0x8a, 0x8a, 0x88, 0xef, 0xe7, 0x6c, 0x11, 0x31, 0xce, 0x5f, 0x44, 0xcc, 0xdc, 0x3f, 0x63, 0xe9,
-	0xc3, 0xe8, 0x3d, 0x2a, 0xed, 0x9e, 0xf5, 0x4d, 0xed, 0xbc, 0x6f, 0x6a, 0xbf, 0xfa, 0xa6, 0x76,
-	0x3a, 0x30, 0x53, 0xe7, 0x03, 0x33, 0xf5, 0x63, 0x60, 0xa6, 0x5e, 0x6d, 0x44, 0xdf, 0x21, 0xd1,
-	0x65, 0x84, 0x57, 0x33, 0xf2, 0x11, 0xbd, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x73, 0x5e, 0x7e,
-	0x7d, 0xef, 0x05, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0x8e, 0x9b, 0x36, 0x6d, 0xde, 0xa6, 0xfd, 0xda, 0x53, 0xd5, 0x38, 0xfe, 0x54, 0x7f, 0xf9,
+	0x8c, 0x84, 0xd2, 0x54, 0x24, 0x6a, 0x11, 0x20, 0x95, 0xa9, 0x45, 0x42, 0x42, 0x28, 0xa8, 0x72,
+	0x29, 0x03, 0xaa, 0x14, 0x5d, 0xe2, 0x8b, 0xb1, 0x88, 0xed, 0xc3, 0x77, 0x2e, 0x4d, 0x27, 0xc4,
+	0xc8, 0x84, 0xc4, 0x3f, 0xc1, 0x84, 0x3a, 0xb0, 0xb2, 0x77, 0xac, 0x98, 0x98, 0x10, 0x6a, 0x87,
+	0x6e, 0xfc, 0x0d, 0xc8, 0x67, 0xc7, 0xb5, 0xdd, 0x88, 0x20, 0x95, 0x25, 0xf2, 0xfb, 0x3e, 0xcf,
+	0x3d, 0xef, 0xaf, 0xbb, 0x37, 0x50, 0xe9, 0xfb, 0x36, 0xf1, 0x70, 0x13, 0x77, 0xb9, 0xe5, 0x3a,
+	0xcd, 0x83, 0xf5, 0x26, 0x3f, 0x6c, 0x50, 0xcf, 0xe5, 0x2e, 0x5a, 0x08, 0xa1, 0x46, 0x08, 0x35,
+	0x0e, 0xd6, 0x95, 0x45, 0x6c, 0x5b, 0x8e, 0xdb, 0x14, 0xbf, 0x21, 0x49, 0x59, 0x32, 0x5d, 0xd3,
+	0x15, 0x9f, 0xcd, 0xe0, 0x2b, 0xf2, 0x96, 0xbb, 0x2e, 0xb3, 0x5d, 0xd6, 0xb4, 0x99, 0x19, 0x48,
+	0xda, 0xcc, 0x8c, 0x80, 0x4a, 0x08, 0xb4, 0xc3, 0x13, 0xa1, 0x11, 0x41, 0x2b, 0x57, 0x32, 0xa1,
+	0xd8, 0xc3, 0x76, 0x04, 0x6b, 0x5f, 0x24, 0xf8, 0xa7, 0xc5, 0xcc, 0x3d, 0x6a, 0x60, 0x4e, 0x76,
+	0x04, 0x82, 0xee, 0x42, 0x11, 0xfb, 0xfc, 0x85, 0xeb, 0x59, 0x7c, 0x20, 0x4b, 0x55, 0xa9, 0x56,
+	0xdc, 0x96, 0xbf, 0x7e, 0xbe, 0xb5, 0x14, 0xe9, 0x6e, 0x19, 0x86, 0x47, 0x18, 0xdb, 0xe5, 0x9e,
+	0xe5, 0x98, 0xfa, 0x25, 0x15, 0xdd, 0x87, 0x42, 0xa8, 0x2d, 0x4f, 0x54, 0xa5, 0xda, 0xec, 0x86,
+	0xdc, 0xc8, 0x96, 0xda, 0x08, 0x23, 0x6c, 0x17, 0x4f, 0xbe, 0xff, 0x97, 0xfb, 0x78, 0x71, 0x5c,
+	0x97, 0xf4, 0xe8, 0xc8, 0xe6, 0x9d, 0xb7, 0x17, 0xc7, 0xf5, 0x4b, 0xb1, 0x77, 0x17, 0xc7, 0x75,
+	0x2d, 0x4a, 0xfd, 0x30, 0x91, 0x7c, 0x26, 0x57, 0xad, 0x02, 0xe5, 0x8c, 0x4b, 0x27, 0x8c, 0xba,
+	0x0e, 0x23, 0xda, 0xa7, 0x09, 0x58, 0x68, 0x31, 0x53, 0x27, 0xaf, 0x7c, 0xc2, 0xf8, 0x96, 0x90,
+	0x40, 0x32, 0x4c, 0x77, 0x3d, 0x82, 0xb9, 0xeb, 0x85, 0x95, 0xe9, 0x43, 0x13, 0xa9, 0x00, 0x61,
+	0x98, 0xa7, 0x03, 0x4a, 0x44, 0x05, 0x45, 0x3d, 0xe1, 0x41, 0x0a, 0xcc, 0xd8, 0x84, 0x63, 0x03,
+	0x73, 0x2c, 0xe7, 0x05, 0x1a, 0xdb, 0x68, 0x09, 0xa6, 0xa8, 0x67, 0x75, 0x89, 0x3c, 0x29, 0x80,
+	0xd0, 0x40, 0x37, 0x61, 0x9e, 0x1c, 0x52, 0xcb, 0xc3, 0x42, 0xc3, 0xb2, 0x89, 0x3c, 0x25, 0xe0,
+	0x8c, 0x17, 0x55, 0x61, 0xb6, 0x67, 0xf5, 0xc9, 0xae, 0x75, 0x44, 0x1e, 0x77, 0x98, 0x5c, 0x10,
+	0xa4, 0xa4, 0x0b, 0xad, 0x00, 0x60, 0x4a, 0xdb, 0xd4, 0xef, 0xbc, 0x24, 0x03, 0x79, 0xba, 0x2a,
+	0xd5, 0x4a, 0x7a, 0x11, 0x53, 0xba, 0x23, 0x1c, 0xe8, 0x1e, 0x94, 0x7b, 0x96, 0x83, 0xfb, 0xd6,
+	0x91, 0x10, 0x6d, 0x7b, 0xc4, 0xf0, 0x1d, 0x03, 0x3b, 0xdd, 0x81, 0x3c, 0x53, 0x95, 0x6a, 0x73,
+	0xfa, 0x72, 0x12, 0xd6, 0x63, 0x74, 0xb3, 0x14, 0x34, 0x7d, 0xd8, 0x01, 0xed, 0x09, 0xc8, 0xd9,
+	0x7e, 0x0d, 0x9b, 0x19, 0x54, 0x1f, 0xf6, 0xe2, 0x91, 0x11, 0x35, 0x2e, 0xb6, 0xd1, 0x32, 0x14,
+	0x18, 0xc7, 0xdc, 0x67, 0x51, 0xd7, 0x22, 0x4b, 0xfb, 0x20, 0xc1, 0x62, 0x8b, 0x99, 0x0f, 0xc3,
+	0xd8, 0x64, 0xec, 0x04, 0x92, 0x31, 0x26, 0x32, 0x31, 0xd2, 0xd3, 0xc9, 0xff, 0x76, 0x3a, 0x93,
+	0xe9, 0xe9, 0x64, 0xaa, 0xfc, 0x17, 0x2a, 0x57, 0x92, 0x8a, 0xef, 0xcc, 0x33, 0x71, 0x65, 0xb6,
+	0x28, 0xf5, 0xdc, 0x83, 0x6b, 0x25, 0x3c, 0xb2, 0xb5, 0x29, 0xdd, 0x6b, 0xb5, 0x76, 0x4f, 0xbc,
+	0xda, 0x07, 0xd8, 0xe9, 0x92, 0xfe, 0x5f, 0x4c, 0xf3, 0x35, 0x94, 0x33, 0xb2, 0xd7, 0xc9, 0x32,
+	0xf0, 0x7b, 0xa4, 0xe7, 0x3b, 0x46, 0x34, 0xb0, 0xc8, 0x42, 0x0b, 0x90, 0xef, 0x91, 0xe1, 0x63,
+	0x09, 0x3e, 0x37, 0x7e, 0xe6, 0x21, 0xdf, 0x62, 0x26, 0xda, 0x87, 0x52, 0x6a, 0x15, 0xfd, 0x7f,
+	0x75, 0x85, 0x64, 0x9e, 0xbb, 0xb2, 0x3a, 0x96, 0x12, 0xd7, 0xd0, 0x86, 0xb9, 0xf4, 0x36, 0xd0,
+	0x46, 0x9e, 0x4d, 0x71, 0x94, 0xfa, 0x78, 0x4e, 0x1c, 0xa0, 0x03, 0xf3, 0x99, 0xdb, 0x7e, 0x63,
+	0xe4, 0xe9, 0x34, 0x49, 0x59, 0xfb, 0x03, 0x52, 0xb2, 0x88, 0xf4, 0xfd, 0x1c, 0x5d, 0x44, 0x8a,
+	0xa3, 0xd4, 0xc7, 0x73, 0xe2, 0x00, 0xfb, 0x50, 0x4a, 0x5d, 0xac, 0xd1, 0x33, 0x48, 0x52, 0x94,
+	0xd5, 0xb1, 0x94, 0xa1, 0xba, 0x32, 0xf5, 0x26, 0x58, 0xfb, 0xdb, 0x6b, 0x27, 0x67, 0xaa, 0x74,
+	0x7a, 0xa6, 0x4a, 0x3f, 0xce, 0x54, 0xe9, 0xfd, 0xb9, 0x9a, 0x3b, 0x3d, 0x57, 0x73, 0xdf, 0xce,
+	0xd5, 0xdc, 0xf3, 0xc5, 0xe4, 0xba, 0xe7, 0x03, 0x4a, 0x58, 0xa7, 0x20, 0xfe, 0xab, 0x6e, 0xff,
+	0x1a, 0x00, 0x3f, 0x75, 0x79, 0xcd, 0x56, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -559,6 +697,8 @@ type MsgClient interface {
 	FinalizeAction(ctx context.Context, in *MsgFinalizeAction, opts ...grpc.CallOption) (*MsgFinalizeActionResponse, error)
 	// ApproveAction defines a message for approving an action.
 	ApproveAction(ctx context.Context, in *MsgApproveAction, opts ...grpc.CallOption) (*MsgApproveActionResponse, error)
+	// CancelAction defines a message for the creator to cancel a pending action.
+	CancelAction(ctx context.Context, in *MsgCancelAction, opts ...grpc.CallOption) (*MsgCancelActionResponse, error)
 }
 
 type msgClient struct {
@@ -605,6 +745,15 @@ func (c *msgClient) ApproveAction(ctx context.Context, in *MsgApproveAction, opt
 	return out, nil
 }
 
+func (c *msgClient) CancelAction(ctx context.Context, in *MsgCancelAction, opts ...grpc.CallOption) (*MsgCancelActionResponse, error) {
+	out := new(MsgCancelActionResponse)
+	err := c.cc.Invoke(ctx, "/lumera.action.v1.Msg/CancelAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -616,6 +765,8 @@ type MsgServer interface {
 	FinalizeAction(context.Context, *MsgFinalizeAction) (*MsgFinalizeActionResponse, error)
 	// ApproveAction defines a message for approving an action.
 	ApproveAction(context.Context, *MsgApproveAction) (*MsgApproveActionResponse, error)
+	// CancelAction defines a message for the creator to cancel a pending action.
+	CancelAction(context.Context, *MsgCancelAction) (*MsgCancelActionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -634,6 +785,9 @@ func (*UnimplementedMsgServer) FinalizeAction(ctx context.Context, req *MsgFinal
 func (*UnimplementedMsgServer) ApproveAction(ctx context.Context, req *MsgApproveAction) (*MsgApproveActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveAction not implemented")
 }
+func (*UnimplementedMsgServer) CancelAction(ctx context.Context, req *MsgCancelAction) (*MsgCancelActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -711,6 +865,24 @@ func _Msg_ApproveAction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.action.v1.Msg/CancelAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAction(ctx, req.(*MsgCancelAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lumera.action.v1.Msg",
@@ -732,6 +904,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveAction",
 			Handler:    _Msg_ApproveAction_Handler,
 		},
+		{
+			MethodName: "CancelAction",
+			Handler:    _Msg_CancelAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lumera/action/v1/tx.proto",
@@ -820,6 +996,11 @@ func (m *MsgRequestAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalizationRedundancy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FinalizationRedundancy))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.AppPubkey) > 0 {
 		i -= len(m.AppPubkey)
 		copy(dAtA[i:], m.AppPubkey)
@@ -1057,6 +1238,94 @@ func (m *MsgApproveActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActionId) > 0 {
+		i -= len(m.ActionId)
+		copy(dAtA[i:], m.ActionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ActionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Refund) > 0 {
+		i -= len(m.Refund)
+		copy(dAtA[i:], m.Refund)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Refund)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActionId) > 0 {
+		i -= len(m.ActionId)
+		copy(dAtA[i:], m.ActionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ActionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1126,6 +1395,9 @@ func (m *MsgRequestAction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.FinalizationRedundancy != 0 {
+		n += 1 + sovTx(uint64(m.FinalizationRedundancy))
+	}
 	return n
 }
 
@@ -1214,6 +1486,48 @@ func (m *MsgApproveActionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ActionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Refund)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1640,6 +1954,25 @@ func (m *MsgRequestAction) Unmarshal(dAtA []byte) error {
 				m.AppPubkey = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationRedundancy", wireType)
+			}
+			m.FinalizationRedundancy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizationRedundancy |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2231,6 +2564,298 @@ func (m *MsgApproveActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
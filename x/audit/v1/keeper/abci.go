@@ -24,6 +24,16 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		return nil
 	}
 
+	// While the previous epoch is still being finalized, the anchor would freeze supernode sets
+	// that epoch-end enforcement has not settled yet. Capture the seed now and let finalization
+	// create the anchor once it completes.
+	if k.epochFinalizationPending(sdkCtx) {
+		if _, found := k.GetEpochAnchor(sdkCtx, epoch.EpochID); !found {
+			return k.deferEpochAnchor(sdkCtx, epoch.EpochID, epoch.StartHeight)
+		}
+		return nil
+	}
+
 	if err := k.CreateEpochAnchorIfNeeded(sdkCtx, epoch.EpochID, epoch.StartHeight, epoch.EndHeight, params); err != nil {
 		return err
 	}
@@ -33,38 +43,30 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 func (k Keeper) EndBlocker(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// EndBlocker does two things:
-	// 1) epoch-end audit enforcement + pruning (only at epoch end height).
+	// EndBlocker drives epoch-end audit enforcement + pruning. The work is queued at the epoch
+	// end height and, when epoch_finalization_blocks > 0, processed in bounded batches over the
+	// first blocks of the next epoch.
 
 	params := k.GetParams(ctx).WithDefaults()
 
+	if err := k.ContinueEpochFinalization(sdkCtx); err != nil {
+		return err
+	}
+
 	epoch, err := deriveEpochAtHeight(sdkCtx.BlockHeight(), params)
 	if err != nil {
 		return err
 	}
 
-	// Only enforce and prune exactly at the epoch end height.
+	// Only queue epoch-end work exactly at the epoch end height.
 	if sdkCtx.BlockHeight() != epoch.EndHeight {
 		return nil
 	}
 
-	if err := k.EnforceEpochEnd(sdkCtx, epoch.EpochID, params); err != nil {
-		return err
-	}
-
-	if err := k.ApplyReporterDivergenceAtEpochEnd(sdkCtx, epoch.EpochID, params); err != nil {
-		return err
-	}
-
-	// Per NEW-A-18 — per-EPOCH reporter clean-recovery (-4 once on >=5 PASS,
-	// no overturned-fail). Per-result PASS/TIMEOUT reporter deltas are 0.
-	if err := k.ApplyReporterCleanEpochRecoveryAtEpochEnd(sdkCtx, epoch.EpochID, params); err != nil {
-		return err
-	}
-
-	if err := k.ProcessStorageTruthHealOpsAtEpochEnd(sdkCtx, epoch.EpochID, params); err != nil {
+	// Disputes still pending at their deadline expire before the epoch's evidence is evaluated.
+	if err := k.expireEvidenceDisputes(sdkCtx, epoch.EpochID, params); err != nil {
 		return err
 	}
 
-	return k.PruneOldEpochs(sdkCtx, epoch.EpochID, params)
+	return k.StartEpochFinalization(sdkCtx, epoch.EpochID, params)
 }
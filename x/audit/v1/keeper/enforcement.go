@@ -34,73 +34,94 @@ func (k Keeper) EnforceEpochEnd(ctx sdk.Context, epochID uint64, params types.Pa
 
 	// Postpone ACTIVE supernodes that fail criteria.
 	for _, sn := range active {
-		if sn.SupernodeAccount == "" {
-			continue
+		if err := k.enforceActiveSupernodeAtEpochEnd(ctx, sn, epochID, params); err != nil {
+			return err
 		}
+	}
 
-		// Emit storage-truth band events (all modes >= SHADOW) and postpone if mode >= SOFT.
-		if err := k.applyStorageTruthBandAtEpochEnd(ctx, sn, epochID, params); err != nil {
+	// Recover POSTPONED supernodes that meet recovery criteria.
+	for _, sn := range postponed {
+		if err := k.recoverPostponedSupernodeAtEpochEnd(ctx, sn, epochID, params); err != nil {
 			return err
 		}
+	}
 
-		// Skip legacy postpone checks if already postponed by storage-truth enforcement above.
-		if _, alreadyStorageTruthPostponed := k.getStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount); alreadyStorageTruthPostponed {
-			continue
-		}
+	return nil
+}
 
-		// Avoid stale action-finalization postponement state if the supernode is ACTIVE.
-		k.clearActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount)
+// enforceActiveSupernodeAtEpochEnd applies the storage-truth band and the postponement criteria
+// to a supernode that was ACTIVE at epoch end.
+func (k Keeper) enforceActiveSupernodeAtEpochEnd(ctx sdk.Context, sn sntypes.SuperNode, epochID uint64, params types.Params) error {
+	if sn.SupernodeAccount == "" {
+		return nil
+	}
 
-		shouldPostpone, reason, err := k.shouldPostponeAtEpochEnd(ctx, sn.SupernodeAccount, epochID, params)
-		if err != nil {
-			return err
-		}
-		if !shouldPostpone {
-			continue
-		}
+	// Emit storage-truth band events (all modes >= SHADOW) and postpone if mode >= SOFT.
+	if err := k.applyStorageTruthBandAtEpochEnd(ctx, sn, epochID, params); err != nil {
+		return err
+	}
 
-		if err := k.setSupernodePostponed(ctx, sn, reason); err != nil {
-			return err
-		}
-		switch reason {
-		case postponeReasonActionFinalizationSignatureFailure, postponeReasonActionFinalizationNotInTop10:
-			k.setActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount, epochID)
-		default:
-			k.clearActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount)
-		}
+	// Skip legacy postpone checks if already postponed by storage-truth enforcement above.
+	if _, alreadyStorageTruthPostponed := k.getStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount); alreadyStorageTruthPostponed {
+		return nil
 	}
 
-	// Recover POSTPONED supernodes that meet recovery criteria.
-	for _, sn := range postponed {
-		if sn.SupernodeAccount == "" {
-			continue
-		}
-		_, storageTruthPostponed := k.getStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount)
+	// Avoid stale action-finalization postponement state if the supernode is ACTIVE.
+	k.clearActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount)
 
-		shouldRecover, err := k.shouldRecoverAtEpochEnd(ctx, sn.SupernodeAccount, epochID, params)
-		if err != nil {
-			return err
-		}
-		if !shouldRecover {
-			continue
-		}
+	shouldPostpone, reason, err := k.shouldPostponeAtEpochEnd(ctx, sn.SupernodeAccount, epochID, params)
+	if err != nil {
+		return err
+	}
+	if !shouldPostpone {
+		return nil
+	}
 
-		if err := k.recoverSupernodeFromPostponed(ctx, sn, epochID); err != nil {
-			return err
+	if err := k.setSupernodePostponed(ctx, sn, reason); err != nil {
+		return err
+	}
+	switch reason {
+	case postponeReasonActionFinalizationSignatureFailure, postponeReasonActionFinalizationNotInTop10:
+		k.setActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount, epochID)
+		if reason == postponeReasonActionFinalizationSignatureFailure {
+			k.reportSlashableOffense(ctx, sn, sntypes.SlashOffense_SLASH_OFFENSE_ACTION_FINALIZATION_SIGNATURE_FAILURE, epochEvidenceRef(epochID))
 		}
+	default:
 		k.clearActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount)
-		k.clearStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount)
+	}
+	return nil
+}
 
-		if storageTruthPostponed {
-			ctx.EventManager().EmitEvent(sdk.NewEvent(
-				types.EventTypeStorageTruthRecovered,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyTargetSupernodeAccount, sn.SupernodeAccount),
-				sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
-			))
-		}
+// recoverPostponedSupernodeAtEpochEnd recovers a supernode that was POSTPONED at epoch end if it
+// meets the recovery criteria.
+func (k Keeper) recoverPostponedSupernodeAtEpochEnd(ctx sdk.Context, sn sntypes.SuperNode, epochID uint64, params types.Params) error {
+	if sn.SupernodeAccount == "" {
+		return nil
 	}
+	_, storageTruthPostponed := k.getStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount)
 
+	shouldRecover, err := k.shouldRecoverAtEpochEnd(ctx, sn.SupernodeAccount, epochID, params)
+	if err != nil {
+		return err
+	}
+	if !shouldRecover {
+		return nil
+	}
+
+	if err := k.recoverSupernodeFromPostponed(ctx, sn, epochID); err != nil {
+		return err
+	}
+	k.clearActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount)
+	k.clearStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount)
+
+	if storageTruthPostponed {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeStorageTruthRecovered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyTargetSupernodeAccount, sn.SupernodeAccount),
+			sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
+		))
+	}
 	return nil
 }
 
@@ -166,6 +187,9 @@ func (k Keeper) applyStorageTruthBandAtEpochEnd(ctx sdk.Context, sn sntypes.Supe
 	k.setStorageTruthPostponedAtEpochID(ctx, sn.SupernodeAccount, epochID)
 	if band == storageTruthBandStrongPostpone {
 		k.setStorageTruthStrongPostponeMarker(ctx, sn.SupernodeAccount)
+		if slashesStorageTruthOffenses(params) {
+			k.reportSlashableOffense(ctx, sn, sntypes.SlashOffense_SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE, epochEvidenceRef(epochID))
+		}
 	}
 	k.clearActionFinalizationPostponedAtEpochID(ctx, sn.SupernodeAccount)
 
@@ -398,6 +422,7 @@ func (k Keeper) shouldRecoverFromActionFinalizationPostponement(
 	for e := startEpochID; e <= epochID; e++ {
 		totalBad += k.getEvidenceEpochCount(ctx, e, supernodeAccount, types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE)
 		totalBad += k.getEvidenceEpochCount(ctx, e, supernodeAccount, types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10)
+		totalBad += k.getEvidenceEpochCount(ctx, e, supernodeAccount, types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH)
 	}
 
 	maxTotal := params.ActionFinalizationRecoveryMaxTotalBadEvidences
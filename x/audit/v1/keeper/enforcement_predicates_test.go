@@ -122,6 +122,9 @@ func TestApplyStorageTruthBandAtEpochEnd_StrongPostponeOnIndexFail(t *testing.T)
 	f.supernodeKeeper.EXPECT().
 		SetSuperNodePostponed(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(valAddr), "audit_storage_truth_strong_suspicion").
 		Return(nil).Times(1)
+	f.supernodeKeeper.EXPECT().
+		ReportSlashableOffense(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(valAddr), sntypes.SlashOffense_SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE, "epoch:0").
+		Return(uint64(1), nil).Times(1)
 
 	require.NoError(t, f.keeper.EnforceEpochEnd(f.ctx, 0, params))
 }
@@ -160,6 +160,10 @@ func TestEnforceEpochEnd_EmitsStorageTruthRecoveredEvent(t *testing.T) {
 		SetSuperNodePostponed(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(postponedVal), "audit_storage_truth_strong_suspicion").
 		Return(nil).
 		Times(1)
+	f.supernodeKeeper.EXPECT().
+		ReportSlashableOffense(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(postponedVal), sntypes.SlashOffense_SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE, "epoch:5").
+		Return(uint64(1), nil).
+		Times(1)
 
 	require.NoError(t, f.keeper.EnforceEpochEnd(f.ctx, 5, params))
 
@@ -49,6 +49,16 @@ func (k Keeper) CreateEpochAnchorIfNeeded(ctx sdk.Context, epochID uint64, epoch
 		return fmt.Errorf("epoch anchor must be created at epoch start height: want=%d got=%d", epochStartHeight, ctx.BlockHeight())
 	}
 
+	seed, err := deriveEpochSeed(ctx, epochID, epochStartHeight)
+	if err != nil {
+		return err
+	}
+	return k.createEpochAnchor(ctx, epochID, epochStartHeight, epochEndHeight, seed, params)
+}
+
+// createEpochAnchor persists the anchor of an epoch with the given seed, freezing the eligible
+// supernode sets as of the current block.
+func (k Keeper) createEpochAnchor(ctx sdk.Context, epochID uint64, epochStartHeight, epochEndHeight int64, seed []byte, params types.Params) error {
 	active, err := k.supernodeKeeper.GetAllSuperNodes(ctx, sntypes.SuperNodeStateActive, sntypes.SuperNodeStateStorageFull)
 	if err != nil {
 		return err
@@ -70,11 +80,6 @@ func (k Keeper) CreateEpochAnchorIfNeeded(ctx sdk.Context, epochID uint64, epoch
 	sort.Strings(activeAccounts)
 	sort.Strings(targetAccounts)
 
-	seed, err := deriveEpochSeed(ctx, epochID, epochStartHeight)
-	if err != nil {
-		return err
-	}
-
 	params = params.WithDefaults()
 	paramsBz := k.cdc.MustMarshal(&params)
 	paramsCommit := blake3.Sum256(paramsBz)
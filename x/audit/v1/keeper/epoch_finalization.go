@@ -0,0 +1,513 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// Work lists queued by epoch finalization under "ef/w/".
+const (
+	epochFinalizationListActive uint8 = iota + 1
+	epochFinalizationListPostponed
+	epochFinalizationListDivergence
+	epochFinalizationListOutliers
+	epochFinalizationListCleanRecovery
+	epochFinalizationListHealCandidates
+	epochFinalizationListHealers
+)
+
+func (k Keeper) GetEpochFinalizationProgress(ctx sdk.Context) (types.EpochFinalizationProgress, bool) {
+	bz := k.kvStore(ctx).Get(types.EpochFinalizationProgressKey())
+	if bz == nil {
+		return types.EpochFinalizationProgress{}, false
+	}
+	var progress types.EpochFinalizationProgress
+	k.cdc.MustUnmarshal(bz, &progress)
+	return progress, true
+}
+
+func (k Keeper) SetEpochFinalizationProgress(ctx sdk.Context, progress types.EpochFinalizationProgress) error {
+	bz, err := k.cdc.Marshal(&progress)
+	if err != nil {
+		return err
+	}
+	k.kvStore(ctx).Set(types.EpochFinalizationProgressKey(), bz)
+	return nil
+}
+
+// epochFinalizationPending reports whether epoch-end processing of the previous epoch is still
+// being worked through.
+func (k Keeper) epochFinalizationPending(ctx sdk.Context) bool {
+	progress, found := k.GetEpochFinalizationProgress(ctx)
+	return found && progress.Phase != types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE
+}
+
+// StartEpochFinalization queues the epoch-end processing of epochID. It must be called at the
+// epoch end height. With epoch_finalization_blocks == 0 all work runs in this block; otherwise it
+// is spread over the first epoch_finalization_blocks blocks of the next epoch.
+//
+// The supernode sets are snapshotted here, so the outcome does not depend on how the work is
+// split across blocks.
+func (k Keeper) StartEpochFinalization(ctx sdk.Context, epochID uint64, params types.Params) error {
+	params = params.WithDefaults()
+
+	// Never leave a previous epoch half-finalized.
+	if prev, found := k.GetEpochFinalizationProgress(ctx); found && prev.Phase != types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE {
+		if err := k.runEpochFinalization(ctx, &prev, 0); err != nil {
+			return err
+		}
+	}
+	k.clearEpochFinalizationWorkItems(ctx)
+
+	active, err := k.supernodeKeeper.GetAllSuperNodes(ctx, sntypes.SuperNodeStateActive)
+	if err != nil {
+		return err
+	}
+	postponed, err := k.supernodeKeeper.GetAllSuperNodes(ctx, sntypes.SuperNodeStatePostponed)
+	if err != nil {
+		return err
+	}
+	for i := range active {
+		if err := k.setEpochFinalizationWorkItem(ctx, epochFinalizationListActive, uint64(i), &active[i]); err != nil {
+			return err
+		}
+	}
+	for i := range postponed {
+		if err := k.setEpochFinalizationWorkItem(ctx, epochFinalizationListPostponed, uint64(i), &postponed[i]); err != nil {
+			return err
+		}
+	}
+
+	progress := types.EpochFinalizationProgress{
+		EpochId:         epochID,
+		Phase:           types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_ENFORCE_ACTIVE,
+		StartedHeight:   ctx.BlockHeight(),
+		DeadlineHeight:  ctx.BlockHeight() + int64(params.EpochFinalizationBlocks),
+		PhaseItemsTotal: uint64(len(active)),
+		Params:          params,
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEpochFinalizationStarted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
+		sdk.NewAttribute(types.AttributeKeyDeadlineHeight, strconv.FormatInt(progress.DeadlineHeight, 10)),
+	))
+
+	if params.EpochFinalizationBlocks == 0 {
+		return k.runEpochFinalization(ctx, &progress, 0)
+	}
+	return k.SetEpochFinalizationProgress(ctx, progress)
+}
+
+// ContinueEpochFinalization processes the next batch of pending epoch-end work. The deadline
+// block processes everything that is left.
+func (k Keeper) ContinueEpochFinalization(ctx sdk.Context) error {
+	progress, found := k.GetEpochFinalizationProgress(ctx)
+	if !found || progress.Phase == types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE {
+		return nil
+	}
+	if ctx.BlockHeight() <= progress.StartedHeight {
+		return nil
+	}
+
+	budget := progress.Params.EpochFinalizationBatchSize
+	if ctx.BlockHeight() >= progress.DeadlineHeight {
+		budget = 0
+	}
+	return k.runEpochFinalization(ctx, &progress, budget)
+}
+
+// runEpochFinalization works through phases until the budget of work items is used up; a zero
+// budget runs to completion. Progress is persisted before returning.
+func (k Keeper) runEpochFinalization(ctx sdk.Context, progress *types.EpochFinalizationProgress, budget uint64) error {
+	var used uint64
+	for progress.Phase != types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE {
+		var limit uint64
+		if budget > 0 {
+			if used >= budget {
+				break
+			}
+			limit = budget - used
+		}
+
+		n, done, err := k.runEpochFinalizationPhase(ctx, progress, limit)
+		if err != nil {
+			return err
+		}
+		used += n
+		progress.ItemsProcessed += n
+		progress.PhaseItemsProcessed += n
+		if !done {
+			continue
+		}
+		if err := k.enterNextEpochFinalizationPhase(ctx, progress); err != nil {
+			return err
+		}
+	}
+	return k.SetEpochFinalizationProgress(ctx, *progress)
+}
+
+// runEpochFinalizationPhase processes up to limit items (0 = unbounded) of the current phase and
+// reports whether the phase is done.
+func (k Keeper) runEpochFinalizationPhase(ctx sdk.Context, progress *types.EpochFinalizationProgress, limit uint64) (uint64, bool, error) {
+	epochID := progress.EpochId
+	params := progress.Params
+
+	switch progress.Phase {
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_ENFORCE_ACTIVE:
+		return k.runEpochFinalizationList(ctx, epochFinalizationListActive, progress.PhaseItemsProcessed, limit, func(bz []byte) error {
+			var sn sntypes.SuperNode
+			k.cdc.MustUnmarshal(bz, &sn)
+			return k.enforceActiveSupernodeAtEpochEnd(ctx, sn, epochID, params)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_RECOVER_POSTPONED:
+		return k.runEpochFinalizationList(ctx, epochFinalizationListPostponed, progress.PhaseItemsProcessed, limit, func(bz []byte) error {
+			var sn sntypes.SuperNode
+			k.cdc.MustUnmarshal(bz, &sn)
+			return k.recoverPostponedSupernodeAtEpochEnd(ctx, sn, epochID, params)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_SCAN:
+		return k.runEpochFinalizationScan(ctx, progress, types.ReporterReliabilityStatePrefix(), limit, func(bz []byte) error {
+			var state types.ReporterReliabilityState
+			k.cdc.MustUnmarshal(bz, &state)
+			entry, ok, err := k.reporterDivergenceEntryAtEpochEnd(ctx, state.ReporterSupernodeAccount, epochID, params)
+			if err != nil || !ok {
+				return err
+			}
+			return k.appendEpochFinalizationWorkItem(ctx, progress, epochFinalizationListDivergence, entry)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_RANK:
+		var qualifying []reporterDivergenceEntry
+		if err := k.loadEpochFinalizationJSONList(ctx, epochFinalizationListDivergence, &qualifying); err != nil {
+			return 0, false, err
+		}
+		for i, outlier := range rankReporterDivergenceOutliers(qualifying) {
+			if err := k.setEpochFinalizationJSONWorkItem(ctx, epochFinalizationListOutliers, uint64(i), outlier); err != nil {
+				return 0, false, err
+			}
+		}
+		return uint64(len(qualifying)), true, nil
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_APPLY:
+		return k.runEpochFinalizationList(ctx, epochFinalizationListOutliers, progress.PhaseItemsProcessed, limit, func(bz []byte) error {
+			var entry reporterDivergenceEntry
+			if err := json.Unmarshal(bz, &entry); err != nil {
+				return err
+			}
+			return k.applyReporterDivergencePenalty(ctx, entry, epochID, params)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_CLEAN_RECOVERY:
+		// Per NEW-A-18 — per-EPOCH reporter clean-recovery (-4 once on >=5 PASS,
+		// no overturned-fail). Per-result PASS/TIMEOUT reporter deltas are 0.
+		return k.runEpochFinalizationList(ctx, epochFinalizationListCleanRecovery, progress.PhaseItemsProcessed, limit, func(bz []byte) error {
+			return k.applyReporterCleanEpochRecovery(ctx, string(bz), epochID, params)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_EXPIRE:
+		return k.runEpochFinalizationScan(ctx, progress, types.HealOpPrefix(), limit, func(bz []byte) error {
+			var healOp types.HealOp
+			k.cdc.MustUnmarshal(bz, &healOp)
+			return k.expireStorageTruthHealOpAtEpochEnd(ctx, healOp, epochID, params)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_SCAN:
+		nonFinalByID, openByTicket, err := k.storageTruthOpenHealOps(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		return k.runEpochFinalizationScan(ctx, progress, types.TicketDeteriorationStatePrefix(), limit, func(bz []byte) error {
+			var state types.TicketDeteriorationState
+			k.cdc.MustUnmarshal(bz, &state)
+			cand, ok, err := k.storageTruthHealCandidateAtEpochEnd(ctx, state, epochID, params, nonFinalByID, openByTicket)
+			if err != nil || !ok {
+				return err
+			}
+			return k.appendEpochFinalizationWorkItem(ctx, progress, epochFinalizationListHealCandidates, cand)
+		})
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_SCHEDULE:
+		var activeAccounts []string
+		if _, _, err := k.runEpochFinalizationList(ctx, epochFinalizationListHealers, 0, 0, func(bz []byte) error {
+			activeAccounts = append(activeAccounts, string(bz))
+			return nil
+		}); err != nil {
+			return 0, false, err
+		}
+		var candidates []storageTruthHealCandidate
+		if err := k.loadEpochFinalizationJSONList(ctx, epochFinalizationListHealCandidates, &candidates); err != nil {
+			return 0, false, err
+		}
+		if err := k.scheduleStorageTruthHealCandidates(ctx, candidates, activeAccounts, epochID, params); err != nil {
+			return 0, false, err
+		}
+		return uint64(len(candidates)), true, nil
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_PRUNE:
+		return k.runEpochFinalizationPrune(ctx, progress, limit)
+
+	default:
+		return 0, false, fmt.Errorf("unexpected epoch finalization phase %s", progress.Phase)
+	}
+}
+
+// enterNextEpochFinalizationPhase advances to the next phase and prepares its work list.
+func (k Keeper) enterNextEpochFinalizationPhase(ctx sdk.Context, progress *types.EpochFinalizationProgress) error {
+	progress.Phase++
+	progress.PhaseItemsProcessed = 0
+	progress.PhaseItemsTotal = 0
+	progress.Cursor = nil
+	collected := progress.Collected
+	progress.Collected = 0
+
+	switch progress.Phase {
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_RECOVER_POSTPONED:
+		progress.PhaseItemsTotal = k.countEpochFinalizationWorkItems(ctx, epochFinalizationListPostponed)
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_RANK,
+		types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_SCHEDULE:
+		progress.PhaseItemsTotal = collected
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_APPLY:
+		progress.PhaseItemsTotal = k.countEpochFinalizationWorkItems(ctx, epochFinalizationListOutliers)
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_CLEAN_RECOVERY:
+		reporters, err := k.reporterCleanRecoveryAccounts(ctx, progress.EpochId)
+		if err != nil {
+			return err
+		}
+		k.setEpochFinalizationStringList(ctx, epochFinalizationListCleanRecovery, reporters)
+		progress.PhaseItemsTotal = uint64(len(reporters))
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_SCAN:
+		activeAccounts, enabled, err := k.storageTruthHealSchedulingAccounts(ctx, progress.EpochId, progress.Params)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			// Scheduling is off this epoch: skip the scan and the schedule.
+			progress.Phase = types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_PRUNE
+			return nil
+		}
+		k.setEpochFinalizationStringList(ctx, epochFinalizationListHealers, activeAccounts)
+
+	case types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE:
+		return k.completeEpochFinalization(ctx, progress)
+	}
+	return nil
+}
+
+// completeEpochFinalization drops the work lists and creates the next epoch's anchor if it was
+// deferred by BeginBlocker.
+func (k Keeper) completeEpochFinalization(ctx sdk.Context, progress *types.EpochFinalizationProgress) error {
+	k.clearEpochFinalizationWorkItems(ctx)
+	progress.PruneStep = 0
+	progress.CompletedHeight = ctx.BlockHeight()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEpochFinalizationCompleted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(progress.EpochId, 10)),
+		sdk.NewAttribute(types.AttributeKeyItemsProcessed, strconv.FormatUint(progress.ItemsProcessed, 10)),
+	))
+
+	if len(progress.NextEpochSeed) == 0 {
+		return nil
+	}
+	seed := progress.NextEpochSeed
+	progress.NextEpochSeed = nil
+
+	params := k.GetParams(ctx).WithDefaults()
+	next, err := deriveEpochAtHeight(progress.StartedHeight+1, params)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeight() < next.StartHeight || ctx.BlockHeight() > next.EndHeight {
+		return fmt.Errorf("deferred epoch anchor for epoch %d created outside the epoch at height %d", next.EpochID, ctx.BlockHeight())
+	}
+	if _, found := k.GetEpochAnchor(ctx, next.EpochID); found {
+		return nil
+	}
+	return k.createEpochAnchor(ctx, next.EpochID, next.StartHeight, next.EndHeight, seed, params)
+}
+
+// deferEpochAnchor records the seed of an epoch whose anchor has to wait for the previous epoch's
+// finalization, so the anchor matches the one that would have been created at epoch start.
+func (k Keeper) deferEpochAnchor(ctx sdk.Context, epochID uint64, epochStartHeight int64) error {
+	progress, found := k.GetEpochFinalizationProgress(ctx)
+	if !found {
+		return fmt.Errorf("epoch finalization progress not found while deferring anchor of epoch %d", epochID)
+	}
+	seed, err := deriveEpochSeed(ctx, epochID, epochStartHeight)
+	if err != nil {
+		return err
+	}
+	progress.NextEpochSeed = seed
+	return k.SetEpochFinalizationProgress(ctx, progress)
+}
+
+// runEpochFinalizationList processes a queued work list from index start.
+func (k Keeper) runEpochFinalizationList(ctx sdk.Context, list uint8, start, limit uint64, fn func(bz []byte) error) (uint64, bool, error) {
+	store := k.kvStore(ctx)
+	var n uint64
+	for idx := start; limit == 0 || n < limit; idx++ {
+		bz := store.Get(types.EpochFinalizationWorkItemKey(list, idx))
+		if bz == nil {
+			return n, true, nil
+		}
+		if err := fn(bz); err != nil {
+			return n, false, err
+		}
+		n++
+	}
+	return n, store.Get(types.EpochFinalizationWorkItemKey(list, start+n)) == nil, nil
+}
+
+// runEpochFinalizationScan processes store entries under prefix in key order, resuming from
+// progress.Cursor. Entries are read before any is processed, so writes made by fn never affect
+// the scan.
+func (k Keeper) runEpochFinalizationScan(ctx sdk.Context, progress *types.EpochFinalizationProgress, prefix []byte, limit uint64, fn func(bz []byte) error) (uint64, bool, error) {
+	start := prefix
+	if len(progress.Cursor) > 0 {
+		start = progress.Cursor
+	}
+	it := k.kvStore(ctx).Iterator(start, storetypes.PrefixEndBytes(prefix))
+	var (
+		values [][]byte
+		next   []byte
+	)
+	for ; it.Valid(); it.Next() {
+		if limit > 0 && uint64(len(values)) >= limit {
+			next = append([]byte(nil), it.Key()...)
+			break
+		}
+		values = append(values, append([]byte(nil), it.Value()...))
+	}
+	if err := it.Close(); err != nil {
+		return 0, false, err
+	}
+
+	for _, bz := range values {
+		if err := fn(bz); err != nil {
+			return 0, false, err
+		}
+	}
+	progress.Cursor = next
+	return uint64(len(values)), next == nil, nil
+}
+
+// runEpochFinalizationPrune runs the PruneOldEpochs steps, resuming from progress.PruneStep and
+// progress.Cursor.
+func (k Keeper) runEpochFinalizationPrune(ctx sdk.Context, progress *types.EpochFinalizationProgress, limit uint64) (uint64, bool, error) {
+	store := k.kvStore(ctx)
+	steps := k.pruneSteps(ctx, progress.EpochId, progress.Params)
+
+	var n uint64
+	for int(progress.PruneStep) < len(steps) {
+		var stepLimit uint64
+		if limit > 0 {
+			if n >= limit {
+				return n, false, nil
+			}
+			stepLimit = limit - n
+		}
+		next, scanned, err := runPruneStep(store, steps[progress.PruneStep], progress.Cursor, stepLimit)
+		if err != nil {
+			return n, false, err
+		}
+		n += scanned
+		progress.Cursor = next
+		if next == nil {
+			progress.PruneStep++
+		}
+	}
+	return n, true, nil
+}
+
+func (k Keeper) setEpochFinalizationWorkItem(ctx sdk.Context, list uint8, index uint64, sn *sntypes.SuperNode) error {
+	bz, err := k.cdc.Marshal(sn)
+	if err != nil {
+		return err
+	}
+	k.kvStore(ctx).Set(types.EpochFinalizationWorkItemKey(list, index), bz)
+	return nil
+}
+
+func (k Keeper) setEpochFinalizationJSONWorkItem(ctx sdk.Context, list uint8, index uint64, item any) error {
+	bz, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	k.kvStore(ctx).Set(types.EpochFinalizationWorkItemKey(list, index), bz)
+	return nil
+}
+
+func (k Keeper) setEpochFinalizationStringList(ctx sdk.Context, list uint8, items []string) {
+	store := k.kvStore(ctx)
+	for i, item := range items {
+		store.Set(types.EpochFinalizationWorkItemKey(list, uint64(i)), []byte(item))
+	}
+}
+
+// appendEpochFinalizationWorkItem queues an item collected by a scan phase for the next phase.
+func (k Keeper) appendEpochFinalizationWorkItem(ctx sdk.Context, progress *types.EpochFinalizationProgress, list uint8, item any) error {
+	if err := k.setEpochFinalizationJSONWorkItem(ctx, list, progress.Collected, item); err != nil {
+		return err
+	}
+	progress.Collected++
+	return nil
+}
+
+// loadEpochFinalizationJSONList decodes a whole work list into out, a pointer to a slice.
+func (k Keeper) loadEpochFinalizationJSONList(ctx sdk.Context, list uint8, out any) error {
+	prefix := append(append([]byte(nil), types.EpochFinalizationWorkItemPrefix()...), list)
+	it := k.kvStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer func() { _ = it.Close() }()
+
+	raw := make([]json.RawMessage, 0)
+	for ; it.Valid(); it.Next() {
+		raw = append(raw, append([]byte(nil), it.Value()...))
+	}
+	bz, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, out)
+}
+
+func (k Keeper) countEpochFinalizationWorkItems(ctx sdk.Context, list uint8) uint64 {
+	prefix := append(append([]byte(nil), types.EpochFinalizationWorkItemPrefix()...), list)
+	it := k.kvStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer func() { _ = it.Close() }()
+
+	var n uint64
+	for ; it.Valid(); it.Next() {
+		n++
+	}
+	return n
+}
+
+func (k Keeper) clearEpochFinalizationWorkItems(ctx sdk.Context) {
+	store := k.kvStore(ctx)
+	prefix := types.EpochFinalizationWorkItemPrefix()
+	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	_ = it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+// Epoch 25 spans heights 10001..10400 with the default epoch length and zero height.
+const (
+	finalizationTestEpochID   = uint64(25)
+	finalizationTestEndHeight = int64(10400)
+)
+
+// seedDivergenceRecordsForEpoch stores negCount HASH_MISMATCH and posCount PASS results for
+// reporterAccount in epochID.
+func seedDivergenceRecordsForEpoch(t *testing.T, f *fixture, reporterAccount string, epochID uint64, negCount, posCount int) {
+	t.Helper()
+	for i := 0; i < negCount+posCount; i++ {
+		class := types.StorageProofResultClass_STORAGE_PROOF_RESULT_CLASS_PASS
+		if i < negCount {
+			class = types.StorageProofResultClass_STORAGE_PROOF_RESULT_CLASS_HASH_MISMATCH
+		}
+		result := &types.StorageProofResult{
+			TicketId:               fmt.Sprintf("%s-div-%d", reporterAccount, i),
+			TargetSupernodeAccount: fmt.Sprintf("target-div-%s-%d", reporterAccount, i),
+			ResultClass:            class,
+			BucketType:             types.StorageProofBucketType_STORAGE_PROOF_BUCKET_TYPE_RECENT,
+		}
+		require.NoError(t, keeper.SetStorageTruthReporterResultForTest(f.keeper, f.ctx, epochID, reporterAccount, result))
+	}
+}
+
+// seedEpochFinalizationScenario seeds state that exercises every finalization phase: divergence
+// outliers, clean recovery, an expiring heal op, heal scheduling and pruning.
+func seedEpochFinalizationScenario(t *testing.T, f *fixture, finalizationBlocks, batchSize uint64) {
+	t.Helper()
+
+	f.supernodeKeeper.EXPECT().GetAllSuperNodes(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	f.supernodeKeeper.EXPECT().GetAllSuperNodes(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	f.supernodeKeeper.EXPECT().GetAllSuperNodes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	params := f.keeper.GetParams(f.ctx).WithDefaults()
+	params.KeepLastEpochEntries = 21
+	params.StorageTruthReporterMinReportsForDivergence = 5
+	params.StorageTruthTicketDeteriorationHealThreshold = 40
+	params.StorageTruthMaxSelfHealOpsPerEpoch = 2
+	params.EpochFinalizationBlocks = finalizationBlocks
+	params.EpochFinalizationBatchSize = batchSize
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	seedEpochAnchorForReportTest(t, f, 0, []string{"sn-old"}, []string{"sn-old"})
+	activeAccounts := []string{"sn-aaa", "sn-bbb", "sn-ccc", "sn-ddd"}
+	seedEpochAnchorForReportTest(t, f, finalizationTestEpochID, activeAccounts, activeAccounts)
+
+	for _, reporter := range []string{"reporter-a", "reporter-b", "reporter-c"} {
+		require.NoError(t, f.keeper.SetReporterReliabilityState(f.ctx, types.ReporterReliabilityState{
+			ReporterSupernodeAccount: reporter,
+		}))
+	}
+	seedDivergenceRecordsForEpoch(t, f, "reporter-a", finalizationTestEpochID, 2, 8)
+	seedDivergenceRecordsForEpoch(t, f, "reporter-b", finalizationTestEpochID, 2, 8)
+	seedDivergenceRecordsForEpoch(t, f, "reporter-c", finalizationTestEpochID, 9, 1)
+	seedReporterPassResultsForEpoch(t, f, "reporter-d", finalizationTestEpochID, 5)
+
+	require.NoError(t, f.keeper.SetHealOp(f.ctx, types.HealOp{
+		HealOpId:                  7,
+		TicketId:                  "ticket-expiring",
+		ScheduledEpochId:          finalizationTestEpochID - 3,
+		HealerSupernodeAccount:    "sn-aaa",
+		VerifierSupernodeAccounts: []string{"sn-bbb"},
+		Status:                    types.HealOpStatus_HEAL_OP_STATUS_SCHEDULED,
+		DeadlineEpochId:           finalizationTestEpochID,
+	}))
+	require.NoError(t, f.keeper.SetTicketDeteriorationState(f.ctx, types.TicketDeteriorationState{
+		TicketId:           "ticket-expiring",
+		DeteriorationScore: 60,
+		ActiveHealOpId:     7,
+	}))
+	for _, state := range []types.TicketDeteriorationState{
+		{TicketId: "ticket-high", DeteriorationScore: 90, DistinctHolderFailureCount: 2},
+		{TicketId: "ticket-mid", DeteriorationScore: 50, LastIndexFailureEpoch: 20},
+		{TicketId: "ticket-tie", DeteriorationScore: 50, RecentFailureEpochCount: 2},
+		{TicketId: "ticket-stale", DeteriorationScore: 70, DistinctHolderFailureCount: 2, ActiveHealOpId: 99},
+		{TicketId: "ticket-low", DeteriorationScore: 10},
+	} {
+		require.NoError(t, f.keeper.SetTicketDeteriorationState(f.ctx, state))
+	}
+	f.keeper.SetNextHealOpID(f.ctx, 100)
+}
+
+// finalizedEpochState is the state epoch-end processing is expected to produce, with block
+// heights stripped from heal ops and the params commitment stripped from the next anchor.
+type finalizedEpochState struct {
+	reporters    []types.ReporterReliabilityState
+	tickets      []types.TicketDeteriorationState
+	healOps      []types.HealOp
+	nextHealOpID uint64
+	oldAnchor    bool
+	nextAnchor   types.EpochAnchor
+}
+
+func collectFinalizedEpochState(t *testing.T, f *fixture) finalizedEpochState {
+	t.Helper()
+
+	reporters, err := f.keeper.GetAllReporterReliabilityStates(f.ctx)
+	require.NoError(t, err)
+	tickets, err := f.keeper.GetAllTicketDeteriorationStates(f.ctx)
+	require.NoError(t, err)
+	healOps, err := f.keeper.GetAllHealOps(f.ctx)
+	require.NoError(t, err)
+	for i := range healOps {
+		healOps[i].CreatedHeight = 0
+		healOps[i].UpdatedHeight = 0
+	}
+	_, oldAnchor := f.keeper.GetEpochAnchor(f.ctx, 0)
+	nextAnchor, found := f.keeper.GetEpochAnchor(f.ctx, finalizationTestEpochID+1)
+	require.True(t, found, "next epoch anchor must exist once finalization is complete")
+	// The finalization params themselves differ between the compared runs.
+	nextAnchor.ParamsCommitment = nil
+
+	return finalizedEpochState{
+		reporters:    reporters,
+		tickets:      tickets,
+		healOps:      healOps,
+		nextHealOpID: f.keeper.GetNextHealOpID(f.ctx),
+		oldAnchor:    oldAnchor,
+		nextAnchor:   nextAnchor,
+	}
+}
+
+func runEpochFinalizationBlock(t *testing.T, f *fixture, height int64) {
+	t.Helper()
+	f.ctx = f.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.BeginBlocker(f.ctx))
+	require.NoError(t, f.keeper.EndBlocker(f.ctx))
+}
+
+func TestEpochFinalization_BatchedMatchesSingleBlock(t *testing.T) {
+	single := initFixture(t)
+	seedEpochFinalizationScenario(t, single, 0, 200)
+	runEpochFinalizationBlock(t, single, finalizationTestEndHeight)
+
+	progress, found := single.keeper.GetEpochFinalizationProgress(single.ctx)
+	require.True(t, found)
+	require.Equal(t, types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE, progress.Phase)
+	require.Equal(t, finalizationTestEndHeight, progress.CompletedHeight)
+
+	runEpochFinalizationBlock(t, single, finalizationTestEndHeight+1)
+	want := collectFinalizedEpochState(t, single)
+
+	// Sanity-check the scenario actually exercised every phase.
+	reporterC, found := single.keeper.GetReporterReliabilityState(single.ctx, "reporter-c")
+	require.True(t, found)
+	require.Equal(t, int64(8), reporterC.ReliabilityScore)
+	_, found = single.keeper.GetReporterReliabilityState(single.ctx, "reporter-d")
+	require.True(t, found)
+	expired, found := single.keeper.GetHealOp(single.ctx, 7)
+	require.True(t, found)
+	require.Equal(t, types.HealOpStatus_HEAL_OP_STATUS_EXPIRED, expired.Status)
+	require.Equal(t, uint64(102), want.nextHealOpID)
+	require.False(t, want.oldAnchor)
+
+	batched := initFixture(t)
+	seedEpochFinalizationScenario(t, batched, 10, 1)
+	runEpochFinalizationBlock(t, batched, finalizationTestEndHeight)
+
+	progress, found = batched.keeper.GetEpochFinalizationProgress(batched.ctx)
+	require.True(t, found)
+	require.Equal(t, types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_ENFORCE_ACTIVE, progress.Phase)
+	require.Equal(t, finalizationTestEndHeight+10, progress.DeadlineHeight)
+
+	var lastItems uint64
+	height := finalizationTestEndHeight + 1
+	for ; ; height++ {
+		runEpochFinalizationBlock(t, batched, height)
+		progress, found = batched.keeper.GetEpochFinalizationProgress(batched.ctx)
+		require.True(t, found)
+		require.GreaterOrEqual(t, progress.ItemsProcessed, lastItems)
+		lastItems = progress.ItemsProcessed
+		if progress.Phase == types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE {
+			break
+		}
+		_, found = batched.keeper.GetEpochAnchor(batched.ctx, finalizationTestEpochID+1)
+		require.False(t, found, "next epoch anchor must wait for finalization")
+		require.Less(t, height, progress.DeadlineHeight)
+	}
+	require.Equal(t, height, progress.CompletedHeight)
+	require.Greater(t, height, finalizationTestEndHeight+1, "work must be spread across several blocks")
+
+	require.Equal(t, want, collectFinalizedEpochState(t, batched))
+}
+
+func TestEpochFinalization_DeadlineBlockDrainsRemainingWork(t *testing.T) {
+	f := initFixture(t)
+	seedEpochFinalizationScenario(t, f, 2, 1)
+	runEpochFinalizationBlock(t, f, finalizationTestEndHeight)
+
+	runEpochFinalizationBlock(t, f, finalizationTestEndHeight+1)
+	progress, found := f.keeper.GetEpochFinalizationProgress(f.ctx)
+	require.True(t, found)
+	require.NotEqual(t, types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE, progress.Phase)
+
+	runEpochFinalizationBlock(t, f, finalizationTestEndHeight+2)
+	progress, found = f.keeper.GetEpochFinalizationProgress(f.ctx)
+	require.True(t, found)
+	require.Equal(t, types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE, progress.Phase)
+	require.Equal(t, finalizationTestEndHeight+2, progress.CompletedHeight)
+
+	_, found = f.keeper.GetEpochAnchor(f.ctx, finalizationTestEpochID+1)
+	require.True(t, found)
+}
+
+func TestEpochFinalization_ProgressQueryAndMessageGuard(t *testing.T) {
+	f := initFixture(t)
+	seedEpochFinalizationScenario(t, f, 5, 1)
+	q := keeper.NewQueryServerImpl(f.keeper)
+
+	resp, err := q.EpochFinalizationProgress(f.ctx, &types.QueryEpochFinalizationProgressRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.Found)
+	require.False(t, resp.InProgress)
+
+	runEpochFinalizationBlock(t, f, finalizationTestEndHeight)
+	runEpochFinalizationBlock(t, f, finalizationTestEndHeight+1)
+
+	resp, err = q.EpochFinalizationProgress(f.ctx, &types.QueryEpochFinalizationProgressRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Found)
+	require.True(t, resp.InProgress)
+	require.Equal(t, finalizationTestEpochID, resp.Progress.EpochId)
+	require.Equal(t, uint64(1), resp.Progress.ItemsProcessed)
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	_, err = ms.ClaimHealComplete(f.ctx, &types.MsgClaimHealComplete{
+		Creator:          "sn-aaa",
+		HealOpId:         7,
+		TicketId:         "ticket-expiring",
+		HealManifestHash: "manifest",
+	})
+	require.ErrorIs(t, err, types.ErrEpochFinalizationInProgress)
+
+	_, err = q.EpochFinalizationProgress(f.ctx, nil)
+	require.Error(t, err)
+}
@@ -42,7 +42,8 @@ func (k Keeper) CreateEvidence(
 	switch evidenceType {
 	case types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED,
 		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE,
-		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10:
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10,
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH:
 		expectedReporter, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress("action"))
 		if err != nil {
 			return 0, errorsmod.Wrap(types.ErrInvalidReporter, err.Error())
@@ -62,7 +63,8 @@ func (k Keeper) CreateEvidence(
 		switch evidenceType {
 		case types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED,
 			types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE,
-			types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10:
+			types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10,
+			types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH:
 			return 0, types.ErrInvalidActionID
 		}
 	}
@@ -78,7 +80,8 @@ func (k Keeper) CreateEvidence(
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if evidenceType == types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED ||
 		evidenceType == types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE ||
-		evidenceType == types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10 {
+		evidenceType == types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10 ||
+		evidenceType == types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH {
 		params := k.GetParams(ctx).WithDefaults()
 		epoch, err := deriveEpochAtHeight(sdkCtx.BlockHeight(), params)
 		if err != nil {
@@ -196,6 +199,13 @@ func marshalEvidenceMetadataJSON(evidenceType types.EvidenceType, metadataJSON s
 		}
 		return gogoproto.Marshal(&m)
 
+	case types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH:
+		var m types.ActionFinalizationResultMismatchEvidenceMetadata
+		if err := u.Unmarshal(strings.NewReader(metadataJSON), &m); err != nil {
+			return nil, fmt.Errorf("unmarshal ActionFinalizationResultMismatchEvidenceMetadata: %w", err)
+		}
+		return gogoproto.Marshal(&m)
+
 	case types.EvidenceType_EVIDENCE_TYPE_STORAGE_CHALLENGE_FAILURE:
 		var m types.StorageChallengeFailureEvidenceMetadata
 		if err := u.Unmarshal(strings.NewReader(metadataJSON), &m); err != nil {
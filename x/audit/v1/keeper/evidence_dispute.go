@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func (k Keeper) GetNextEvidenceDisputeID(ctx sdk.Context) uint64 {
+	store := k.kvStore(ctx)
+	bz := store.Get(types.NextEvidenceDisputeIDKey())
+	if len(bz) != 8 {
+		// Match GetNextEvidenceID: a missing or malformed counter must not risk ID reuse.
+		return k.deriveNextEvidenceDisputeID(ctx)
+	}
+	id := binary.BigEndian.Uint64(bz)
+	if id == 0 {
+		return k.deriveNextEvidenceDisputeID(ctx)
+	}
+	return id
+}
+
+func (k Keeper) deriveNextEvidenceDisputeID(ctx sdk.Context) uint64 {
+	prefix := types.EvidenceDisputePrefix()
+	it := k.kvStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer func() { _ = it.Close() }()
+
+	var maxID uint64
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+8 {
+			continue
+		}
+		if id := binary.BigEndian.Uint64(key[len(prefix):]); id > maxID {
+			maxID = id
+		}
+	}
+	return maxID + 1
+}
+
+func (k Keeper) SetNextEvidenceDisputeID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	k.kvStore(ctx).Set(types.NextEvidenceDisputeIDKey(), bz)
+}
+
+func (k Keeper) GetEvidenceDispute(ctx sdk.Context, disputeID uint64) (types.EvidenceDispute, bool) {
+	bz := k.kvStore(ctx).Get(types.EvidenceDisputeKey(disputeID))
+	if bz == nil {
+		return types.EvidenceDispute{}, false
+	}
+	var dispute types.EvidenceDispute
+	k.cdc.MustUnmarshal(bz, &dispute)
+	return dispute, true
+}
+
+// GetEvidenceDisputeByEvidence returns the dispute filed against evidenceID, if any.
+func (k Keeper) GetEvidenceDisputeByEvidence(ctx sdk.Context, evidenceID uint64) (types.EvidenceDispute, bool) {
+	bz := k.kvStore(ctx).Get(types.EvidenceDisputeByEvidenceIndexKey(evidenceID))
+	if len(bz) != 8 {
+		return types.EvidenceDispute{}, false
+	}
+	return k.GetEvidenceDispute(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// SetEvidenceDispute writes the dispute record and keeps the evidence and pending
+// indexes in sync with its status.
+func (k Keeper) SetEvidenceDispute(ctx sdk.Context, dispute types.EvidenceDispute) error {
+	store := k.kvStore(ctx)
+
+	if existing, found := k.GetEvidenceDispute(ctx, dispute.DisputeId); found {
+		store.Delete(types.PendingEvidenceDisputeIndexKey(existing.DeadlineEpochId, existing.DisputeId))
+	}
+
+	bz, err := k.cdc.Marshal(&dispute)
+	if err != nil {
+		return err
+	}
+	store.Set(types.EvidenceDisputeKey(dispute.DisputeId), bz)
+
+	idBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, dispute.DisputeId)
+	store.Set(types.EvidenceDisputeByEvidenceIndexKey(dispute.EvidenceId), idBz)
+
+	if dispute.Status == types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING {
+		store.Set(types.PendingEvidenceDisputeIndexKey(dispute.DeadlineEpochId, dispute.DisputeId), []byte{1})
+	}
+	return nil
+}
+
+func (k Keeper) GetAllEvidenceDisputes(ctx sdk.Context) ([]types.EvidenceDispute, error) {
+	prefix := types.EvidenceDisputePrefix()
+	it := k.kvStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer func() { _ = it.Close() }()
+
+	disputes := make([]types.EvidenceDispute, 0)
+	for ; it.Valid(); it.Next() {
+		var dispute types.EvidenceDispute
+		k.cdc.MustUnmarshal(it.Value(), &dispute)
+		disputes = append(disputes, dispute)
+	}
+	return disputes, nil
+}
+
+// evidenceDisputePanelTarget domain-separates dispute panels from storage-challenge
+// challenger selection so the same seed never yields correlated sets.
+func evidenceDisputePanelTarget(seed []byte, disputeID uint64) string {
+	return "audit:dispute_panel:" + hex.EncodeToString(seed) + ":" + strconv.FormatUint(disputeID, 10)
+}
+
+// selectEvidenceDisputePanel picks the dispute panel from the anchored ACTIVE set, excluding
+// the subject and the reporter of the disputed evidence.
+func selectEvidenceDisputePanel(anchor types.EpochAnchor, disputeID uint64, ev types.Evidence, panelSize uint32) []string {
+	candidates := make([]string, 0, len(anchor.ActiveSupernodeAccounts))
+	for _, acct := range anchor.ActiveSupernodeAccounts {
+		if acct == ev.SubjectAddress || acct == ev.ReporterAddress {
+			continue
+		}
+		candidates = append(candidates, acct)
+	}
+	return selectTopByXORDistance(candidates, evidenceDisputePanelTarget(anchor.Seed, disputeID), int(panelSize))
+}
+
+// countsTowardEvidenceThresholds reports whether evidenceType feeds the per-epoch
+// aggregates read by evidenceMeetsConsecutiveEpochsThreshold.
+func countsTowardEvidenceThresholds(evidenceType types.EvidenceType) bool {
+	switch evidenceType {
+	case types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED,
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE,
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10,
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH:
+		return true
+	}
+	return false
+}
+
+// invalidateEvidence removes an upheld evidence record from the per-epoch aggregates so it
+// stops counting toward postponement and recovery decisions. The record itself is kept for
+// auditability; its dispute marks it invalid.
+func (k Keeper) invalidateEvidence(ctx sdk.Context, ev types.Evidence, params types.Params) error {
+	if !countsTowardEvidenceThresholds(ev.EvidenceType) {
+		return nil
+	}
+	epoch, err := deriveEpochAtHeight(int64(ev.ReportedHeight), params)
+	if err != nil {
+		return err
+	}
+	count := k.getEvidenceEpochCount(ctx, epoch.EpochID, ev.SubjectAddress, ev.EvidenceType)
+	if count == 0 {
+		// Already pruned or never counted.
+		return nil
+	}
+	k.setEvidenceEpochCount(ctx, epoch.EpochID, ev.SubjectAddress, ev.EvidenceType, count-1)
+	return nil
+}
+
+// IsEvidenceInvalidated reports whether evidenceID was invalidated by an upheld dispute.
+func (k Keeper) IsEvidenceInvalidated(ctx sdk.Context, evidenceID uint64) bool {
+	dispute, found := k.GetEvidenceDisputeByEvidence(ctx, evidenceID)
+	return found && dispute.Status == types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UPHELD
+}
+
+// tallyEvidenceDispute returns the resolved status once a strict majority of the panel agrees,
+// or PENDING otherwise.
+func tallyEvidenceDispute(dispute types.EvidenceDispute) types.EvidenceDisputeStatus {
+	majority := len(dispute.Panel)/2 + 1
+	upheld, rejected := 0, 0
+	for _, v := range dispute.Votes {
+		if v.Uphold {
+			upheld++
+		} else {
+			rejected++
+		}
+	}
+	switch {
+	case upheld >= majority:
+		return types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UPHELD
+	case rejected >= majority:
+		return types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_REJECTED
+	}
+	return types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING
+}
+
+// resolveEvidenceDispute settles the bond for a terminal status and persists the dispute.
+// UPHELD invalidates the evidence and refunds the bond, REJECTED forfeits the bond to the
+// supernode module account (the Everlight pool), EXPIRED refunds the bond and lets the
+// evidence stand.
+func (k Keeper) resolveEvidenceDispute(ctx sdk.Context, dispute types.EvidenceDispute, status types.EvidenceDisputeStatus, params types.Params) error {
+	subject, err := k.addressCodec.StringToBytes(dispute.SubjectAddress)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSubject, err.Error())
+	}
+	bond := sdk.NewCoins(dispute.Bond)
+
+	switch status {
+	case types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UPHELD:
+		ev, found := k.GetEvidence(ctx, dispute.EvidenceId)
+		if !found {
+			return errorsmod.Wrapf(types.ErrEvidenceNotFound, "evidence %d", dispute.EvidenceId)
+		}
+		if err := k.invalidateEvidence(ctx, ev, params); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subject, bond); err != nil {
+			return err
+		}
+	case types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_REJECTED:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, sntypes.ModuleName, bond); err != nil {
+			return err
+		}
+	case types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_EXPIRED:
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subject, bond); err != nil {
+			return err
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidEvidenceDispute, "cannot resolve dispute to %s", status.String())
+	}
+
+	dispute.Status = status
+	dispute.ResolvedHeight = uint64(ctx.BlockHeight())
+	if err := k.SetEvidenceDispute(ctx, dispute); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEvidenceDisputeResolved,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDisputeID, strconv.FormatUint(dispute.DisputeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyEvidenceID, strconv.FormatUint(dispute.EvidenceId, 10)),
+		sdk.NewAttribute(types.AttributeKeySubjectAddress, dispute.SubjectAddress),
+		sdk.NewAttribute(types.AttributeKeyDisputeStatus, status.String()),
+		sdk.NewAttribute(types.AttributeKeyBond, dispute.Bond.String()),
+	))
+	return nil
+}
+
+// expireEvidenceDisputes expires every pending dispute whose voting deadline is at or before
+// epochID. It is called at the epoch end height.
+func (k Keeper) expireEvidenceDisputes(ctx sdk.Context, epochID uint64, params types.Params) error {
+	prefix := types.PendingEvidenceDisputeIndexPrefix()
+	end := types.PendingEvidenceDisputeIndexKey(epochID+1, 0)
+	if epochID == ^uint64(0) {
+		end = storetypes.PrefixEndBytes(prefix)
+	}
+
+	// Collect first: resolving rewrites the index being iterated.
+	var expired []uint64
+	it := k.kvStore(ctx).Iterator(prefix, end)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != len(prefix)+16 {
+			continue
+		}
+		expired = append(expired, binary.BigEndian.Uint64(key[len(prefix)+8:]))
+	}
+	_ = it.Close()
+
+	for _, disputeID := range expired {
+		dispute, found := k.GetEvidenceDispute(ctx, disputeID)
+		if !found || dispute.Status != types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING {
+			continue
+		}
+		if err := k.resolveEvidenceDispute(ctx, dispute, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_EXPIRED, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+type disputeTestSetup struct {
+	subject    string
+	active     []string
+	evidenceID uint64
+	bond       sdk.Coin
+}
+
+// seedDisputableEvidence anchors epoch 0 with the subject plus six other supernodes and records
+// one ACTION_EXPIRED evidence about the subject.
+func seedDisputableEvidence(t *testing.T, f *fixture) disputeTestSetup {
+	t.Helper()
+
+	f.ctx = f.ctx.WithBlockHeight(10)
+
+	active := make([]string, 0, 7)
+	for i := 1; i <= 7; i++ {
+		addr, err := f.addressCodec.BytesToString(bytes.Repeat([]byte{byte(i)}, 20))
+		require.NoError(t, err)
+		active = append(active, addr)
+	}
+	subject := active[0]
+	seedEpochAnchorForReportTest(t, f, 0, active, active)
+
+	reporter, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress("action"))
+	require.NoError(t, err)
+	metaBz, err := json.Marshal(types.ActionExpiredEvidenceMetadata{
+		Top_10ValidatorAddresses: []string{sdk.ValAddress([]byte("validator_address_20")).String()},
+	})
+	require.NoError(t, err)
+	evidenceID, err := f.keeper.CreateEvidence(f.ctx, reporter, subject, "action-1", types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED, string(metaBz))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), keeper.GetEvidenceEpochCountForTest(f.keeper, f.ctx, 0, subject, types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED))
+
+	return disputeTestSetup{
+		subject:    subject,
+		active:     active,
+		evidenceID: evidenceID,
+		bond:       types.DefaultEvidenceDisputeMinBond,
+	}
+}
+
+func openDispute(t *testing.T, f *fixture, s disputeTestSetup) types.EvidenceDispute {
+	t.Helper()
+
+	f.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(s.subject), types.ModuleName, sdk.NewCoins(s.bond)).
+		Return(nil)
+
+	resp, err := keeper.NewMsgServerImpl(f.keeper).DisputeEvidence(f.ctx, &types.MsgDisputeEvidence{
+		Creator:          s.subject,
+		EvidenceId:       s.evidenceID,
+		Bond:             s.bond,
+		CounterProofType: types.EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_SIGNED_FINALIZATION,
+		CounterProof:     "signed-finalization-payload",
+	})
+	require.NoError(t, err)
+
+	dispute, found := f.keeper.GetEvidenceDispute(f.ctx, resp.DisputeId)
+	require.True(t, found)
+	return dispute
+}
+
+func TestDisputeEvidence_UpheldInvalidatesEvidenceAndRefundsBond(t *testing.T) {
+	f := initFixture(t)
+	s := seedDisputableEvidence(t, f)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	dispute := openDispute(t, f, s)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING, dispute.Status)
+	require.Len(t, dispute.Panel, int(types.DefaultEvidenceDisputePanelSize))
+	require.NotContains(t, dispute.Panel, s.subject)
+	require.Equal(t, uint64(types.DefaultEvidenceDisputeVotingEpochs), dispute.DeadlineEpochId)
+
+	// One dispute per evidence record.
+	_, err := ms.DisputeEvidence(f.ctx, &types.MsgDisputeEvidence{
+		Creator:          s.subject,
+		EvidenceId:       s.evidenceID,
+		Bond:             s.bond,
+		CounterProofType: types.EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_OTHER,
+		CounterProof:     "again",
+	})
+	require.ErrorIs(t, err, types.ErrEvidenceDisputeExists)
+
+	// Only panelists vote, and only once.
+	_, err = ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: s.subject, DisputeId: dispute.DisputeId, Uphold: true})
+	require.ErrorIs(t, err, types.ErrEvidenceDisputeUnauthorized)
+
+	resp, err := ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[0], DisputeId: dispute.DisputeId, Uphold: true})
+	require.NoError(t, err)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING, resp.Status)
+	_, err = ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[0], DisputeId: dispute.DisputeId, Uphold: true})
+	require.ErrorIs(t, err, types.ErrEvidenceDisputeVoteExists)
+
+	_, err = ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[1], DisputeId: dispute.DisputeId, Uphold: false})
+	require.NoError(t, err)
+	_, err = ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[2], DisputeId: dispute.DisputeId, Uphold: true})
+	require.NoError(t, err)
+
+	f.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(s.subject), sdk.NewCoins(s.bond)).
+		Return(nil)
+	resp, err = ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[3], DisputeId: dispute.DisputeId, Uphold: true})
+	require.NoError(t, err)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UPHELD, resp.Status)
+
+	require.True(t, f.keeper.IsEvidenceInvalidated(f.ctx, s.evidenceID))
+	require.Zero(t, keeper.GetEvidenceEpochCountForTest(f.keeper, f.ctx, 0, s.subject, types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED))
+
+	got, err := keeper.NewQueryServerImpl(f.keeper).EvidenceDisputeByEvidence(f.ctx, &types.QueryEvidenceDisputeByEvidenceRequest{EvidenceId: s.evidenceID})
+	require.NoError(t, err)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UPHELD, got.Dispute.Status)
+	require.Len(t, got.Dispute.Votes, 4)
+	require.Equal(t, uint64(10), got.Dispute.ResolvedHeight)
+
+	// Resolved disputes accept no further votes.
+	_, err = ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[4], DisputeId: dispute.DisputeId, Uphold: true})
+	require.ErrorIs(t, err, types.ErrEvidenceDisputeClosed)
+}
+
+func TestDisputeEvidence_RejectedForfeitsBond(t *testing.T) {
+	f := initFixture(t)
+	s := seedDisputableEvidence(t, f)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	dispute := openDispute(t, f, s)
+
+	for i := 0; i < 2; i++ {
+		_, err := ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[i], DisputeId: dispute.DisputeId})
+		require.NoError(t, err)
+	}
+
+	f.bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, sntypes.ModuleName, sdk.NewCoins(s.bond)).
+		Return(nil)
+	resp, err := ms.VoteEvidenceDispute(f.ctx, &types.MsgVoteEvidenceDispute{Creator: dispute.Panel[2], DisputeId: dispute.DisputeId})
+	require.NoError(t, err)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_REJECTED, resp.Status)
+
+	require.False(t, f.keeper.IsEvidenceInvalidated(f.ctx, s.evidenceID))
+	require.Equal(t, uint64(1), keeper.GetEvidenceEpochCountForTest(f.keeper, f.ctx, 0, s.subject, types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED))
+}
+
+func TestDisputeEvidence_ExpiresAtDeadlineAndRefundsBond(t *testing.T) {
+	f := initFixture(t)
+	s := seedDisputableEvidence(t, f)
+
+	dispute := openDispute(t, f, s)
+
+	// Nothing expires before the deadline epoch ends.
+	require.NoError(t, keeper.ExpireEvidenceDisputesForTest(f.keeper, f.ctx, dispute.DeadlineEpochId-1))
+	got, _ := f.keeper.GetEvidenceDispute(f.ctx, dispute.DisputeId)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING, got.Status)
+
+	f.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(s.subject), sdk.NewCoins(s.bond)).
+		Return(nil)
+	require.NoError(t, keeper.ExpireEvidenceDisputesForTest(f.keeper, f.ctx, dispute.DeadlineEpochId))
+
+	got, _ = f.keeper.GetEvidenceDispute(f.ctx, dispute.DisputeId)
+	require.Equal(t, types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_EXPIRED, got.Status)
+	require.False(t, f.keeper.IsEvidenceInvalidated(f.ctx, s.evidenceID))
+	require.Equal(t, uint64(1), keeper.GetEvidenceEpochCountForTest(f.keeper, f.ctx, 0, s.subject, types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED))
+}
+
+func TestDisputeEvidence_Validation(t *testing.T) {
+	f := initFixture(t)
+	s := seedDisputableEvidence(t, f)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	valid := func() *types.MsgDisputeEvidence {
+		return &types.MsgDisputeEvidence{
+			Creator:          s.subject,
+			EvidenceId:       s.evidenceID,
+			Bond:             s.bond,
+			CounterProofType: types.EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_STORAGE_TRANSCRIPT_HASH,
+			CounterProof:     "transcript-hash",
+		}
+	}
+
+	msg := valid()
+	msg.Creator = s.active[1]
+	_, err := ms.DisputeEvidence(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSubject)
+
+	msg = valid()
+	msg.Bond = sdk.NewInt64Coin(s.bond.Denom, s.bond.Amount.Int64()-1)
+	_, err = ms.DisputeEvidence(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInsufficientEvidenceDisputeBond)
+
+	msg = valid()
+	msg.Bond = sdk.NewCoin("uother", s.bond.Amount)
+	_, err = ms.DisputeEvidence(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInsufficientEvidenceDisputeBond)
+
+	msg = valid()
+	msg.CounterProofType = types.EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED
+	_, err = ms.DisputeEvidence(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidEvidenceDispute)
+
+	msg = valid()
+	msg.CounterProof = " "
+	_, err = ms.DisputeEvidence(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidEvidenceDispute)
+
+	msg = valid()
+	msg.EvidenceId = 99
+	_, err = ms.DisputeEvidence(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrEvidenceNotFound)
+
+	// No eligible panelists besides the subject.
+	seedEpochAnchorForReportTest(t, f, 0, []string{s.subject}, []string{s.subject})
+	_, err = ms.DisputeEvidence(f.ctx, valid())
+	require.ErrorIs(t, err, types.ErrEvidenceDisputeNoPanel)
+
+	// Evidence from epoch 0 can no longer be disputed in epoch window+1.
+	lateEpoch := uint64(types.DefaultEvidenceDisputeWindowEpochs) + 1
+	f.ctx = f.ctx.WithBlockHeight(int64(lateEpoch*types.DefaultEpochLengthBlocks) + 1)
+	seedEpochAnchorForReportTest(t, f, lateEpoch, s.active, s.active)
+	_, err = ms.DisputeEvidence(f.ctx, valid())
+	require.ErrorIs(t, err, types.ErrEvidenceDisputeWindowElapsed)
+}
+
+func TestEvidenceDisputeGenesisRoundTrip(t *testing.T) {
+	f := initFixture(t)
+	s := seedDisputableEvidence(t, f)
+	dispute := openDispute(t, f, s)
+
+	gs, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Len(t, gs.EvidenceDisputes, 1)
+	require.Equal(t, dispute.DisputeId+1, gs.NextEvidenceDisputeId)
+	require.NoError(t, gs.Validate())
+
+	f2 := initFixture(t)
+	f2.ctx = f2.ctx.WithBlockHeight(10)
+	require.NoError(t, f2.keeper.InitGenesis(f2.ctx, *gs))
+
+	got, found := f2.keeper.GetEvidenceDisputeByEvidence(f2.ctx, s.evidenceID)
+	require.True(t, found)
+	require.Equal(t, dispute, got)
+	require.Equal(t, dispute.DisputeId+1, f2.keeper.GetNextEvidenceDisputeID(f2.ctx))
+}
@@ -170,6 +170,58 @@ func TestCreateEvidence_CascadeClientFailure_InvalidMetadata(t *testing.T) {
 	require.Contains(t, err.Error(), types.ErrInvalidMetadata.Error())
 }
 
+func TestCreateEvidence_ActionFinalizationResultMismatch(t *testing.T) {
+	f := initFixture(t)
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	actionReporter, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress("action"))
+	require.NoError(t, err)
+	otherReporter, err := f.addressCodec.BytesToString(bytes.Repeat([]byte{0x11}, 20))
+	require.NoError(t, err)
+	subject, err := f.addressCodec.BytesToString(bytes.Repeat([]byte{0x22}, 20))
+	require.NoError(t, err)
+
+	meta := types.ActionFinalizationResultMismatchEvidenceMetadata{
+		Top_10ValidatorAddresses: []string{sdk.ValAddress([]byte("validator_address_20")).String()},
+		QuorumResultHash:         "aa",
+		SubmittedResultHash:      "bb",
+	}
+	metaBz, err := json.Marshal(meta)
+	require.NoError(t, err)
+
+	_, err = ms.SubmitEvidence(f.ctx, &types.MsgSubmitEvidence{
+		Creator:        otherReporter,
+		SubjectAddress: subject,
+		EvidenceType:   types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH,
+		ActionId:       "action-123",
+		Metadata:       string(metaBz),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "evidence type is reserved for the action module")
+
+	_, err = f.keeper.CreateEvidence(f.ctx, otherReporter, subject, "action-123",
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH, string(metaBz))
+	require.ErrorIs(t, err, types.ErrInvalidReporter)
+
+	_, err = f.keeper.CreateEvidence(f.ctx, actionReporter, subject, "",
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH, string(metaBz))
+	require.ErrorIs(t, err, types.ErrInvalidActionID)
+
+	respID, err := f.keeper.CreateEvidence(f.ctx, actionReporter, subject, "action-123",
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH, string(metaBz))
+	require.NoError(t, err)
+
+	gotByID, err := qs.EvidenceById(f.ctx, &types.QueryEvidenceByIdRequest{EvidenceId: respID})
+	require.NoError(t, err)
+	require.Equal(t, types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH, gotByID.Evidence.EvidenceType)
+
+	var gotMeta types.ActionFinalizationResultMismatchEvidenceMetadata
+	require.NoError(t, gogoproto.Unmarshal(gotByID.Evidence.Metadata, &gotMeta))
+	require.Equal(t, meta, gotMeta)
+}
+
 func TestCreateEvidence_StorageChallengeFailure_ValidStructuredMetadata(t *testing.T) {
 	f := initFixture(t)
 
@@ -69,3 +69,21 @@ var HasIndependentReporterPassInWindowForTest = func(k Keeper, ctx sdk.Context,
 var HasCleanRecheckInWindowForTest = func(k Keeper, ctx sdk.Context, ticketID string, targetAccount string, startEpoch uint64, endEpoch uint64) (bool, error) {
 	return k.hasCleanRecheckInWindow(ctx, ticketID, targetAccount, startEpoch, endEpoch)
 }
+
+// SetEvidenceEpochCountForTest seeds the per-epoch evidence counter used by
+// action-finalization postponement.
+var SetEvidenceEpochCountForTest = func(k Keeper, ctx sdk.Context, epochID uint64, subjectAddress string, evidenceType types.EvidenceType, count uint64) {
+	k.setEvidenceEpochCount(ctx, epochID, subjectAddress, evidenceType, count)
+}
+
+// GetEvidenceEpochCountForTest reads the per-epoch evidence counter used by
+// action-finalization postponement.
+var GetEvidenceEpochCountForTest = func(k Keeper, ctx sdk.Context, epochID uint64, subjectAddress string, evidenceType types.EvidenceType) uint64 {
+	return k.getEvidenceEpochCount(ctx, epochID, subjectAddress, evidenceType)
+}
+
+// ExpireEvidenceDisputesForTest runs the epoch-end dispute expiry without the
+// rest of epoch finalization.
+var ExpireEvidenceDisputesForTest = func(k Keeper, ctx sdk.Context, epochID uint64) error {
+	return k.expireEvidenceDisputes(ctx, epochID, k.GetParams(ctx).WithDefaults())
+}
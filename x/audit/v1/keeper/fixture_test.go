@@ -27,6 +27,7 @@ type fixture struct {
 	addressCodec address.Codec
 
 	supernodeKeeper *supernodemocks.MockSupernodeKeeper
+	bankKeeper      *supernodemocks.MockBankKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -45,6 +46,7 @@ func initFixture(t *testing.T) *fixture {
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
 	snKeeper := supernodemocks.NewMockSupernodeKeeper(ctrl)
+	bankKeeper := supernodemocks.NewMockBankKeeper(ctrl)
 
 	k := keeper.NewKeeper(
 		encCfg.Codec,
@@ -53,6 +55,7 @@ func initFixture(t *testing.T) *fixture {
 		log.NewNopLogger(),
 		authority,
 		snKeeper,
+		bankKeeper,
 	)
 
 	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
@@ -64,5 +67,6 @@ func initFixture(t *testing.T) *fixture {
 		keeper:          k,
 		addressCodec:    addressCodec,
 		supernodeKeeper: snKeeper,
+		bankKeeper:      bankKeeper,
 	}
 }
@@ -95,6 +95,20 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	}
 	k.SetNextHealOpID(sdkCtx, nextHealOpID)
 
+	nextEvidenceDisputeID := uint64(1)
+	if genState.NextEvidenceDisputeId != 0 {
+		nextEvidenceDisputeID = genState.NextEvidenceDisputeId
+	}
+	for _, dispute := range genState.EvidenceDisputes {
+		if err := k.SetEvidenceDispute(sdkCtx, dispute); err != nil {
+			return err
+		}
+		if dispute.DisputeId >= nextEvidenceDisputeID {
+			nextEvidenceDisputeID = dispute.DisputeId + 1
+		}
+	}
+	k.SetNextEvidenceDisputeID(sdkCtx, nextEvidenceDisputeID)
+
 	// Per 121-F7 — restore storage-truth postponement markers on chain restart.
 	// Per NEW-B-6 / NEW-B-9 — cross-validate against supernode state so genesis
 	// cannot encode a phantom postponement (audit marker but supernode not in
@@ -222,6 +236,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, errors.New("invalid next heal op id")
 	}
 
+	evidenceDisputes, err := k.GetAllEvidenceDisputes(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.EvidenceDisputes = evidenceDisputes
+	genesis.NextEvidenceDisputeId = k.GetNextEvidenceDisputeID(sdkCtx)
+
 	// Per 121-F7 — export storage-truth postponement markers.
 	genesis.StorageTruthPostponements = k.GetAllStorageTruthPostponements(sdkCtx)
 
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
 	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
 )
 
@@ -24,6 +25,9 @@ type Keeper struct {
 	// supernodeKeeper is used to snapshot eligible supernodes at epoch start (for anchoring)
 	// and to apply postpone/recovery transitions at epoch end.
 	supernodeKeeper sntypes.SupernodeKeeper
+
+	// bankKeeper escrows evidence dispute bonds in the audit module account.
+	bankKeeper types.BankKeeper
 }
 
 func NewKeeper(
@@ -33,6 +37,7 @@ func NewKeeper(
 	logger log.Logger,
 	authority []byte,
 	supernodeKeeper sntypes.SupernodeKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	// Keeper construction is consensus-critical: authority address validity is verified once
 	// so later param updates can't panic due to malformed config.
@@ -47,6 +52,7 @@ func NewKeeper(
 		logger:          logger,
 		authority:       authority,
 		supernodeKeeper: supernodeKeeper,
+		bankKeeper:      bankKeeper,
 	}
 }
 
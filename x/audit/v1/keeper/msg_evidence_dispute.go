@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+const (
+	maxEvidenceCounterProofBytes  = 4 * 1024 // 4 KiB
+	maxEvidenceDisputeDetailBytes = 4 * 1024 // 4 KiB
+)
+
+func (m msgServer) DisputeEvidence(ctx context.Context, req *types.MsgDisputeEvidence) (*types.MsgDisputeEvidenceResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "empty request")
+	}
+	if req.Creator == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "creator is required")
+	}
+	creator, err := m.addressCodec.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
+	}
+	if req.CounterProofType == types.EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceDispute, "counter_proof_type is required")
+	}
+	if _, ok := types.EvidenceCounterProofType_name[int32(req.CounterProofType)]; !ok {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceDispute, "counter_proof_type is invalid")
+	}
+	if strings.TrimSpace(req.CounterProof) == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceDispute, "counter_proof is required")
+	}
+	if len(req.CounterProof) > maxEvidenceCounterProofBytes {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceDispute, "counter_proof is too large")
+	}
+	if len(req.Details) > maxEvidenceDisputeDetailBytes {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceDispute, "details is too large")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if m.epochFinalizationPending(sdkCtx) {
+		return nil, errorsmod.Wrap(types.ErrEpochFinalizationInProgress, "previous epoch is still being finalized")
+	}
+	params := m.GetParams(ctx).WithDefaults()
+
+	if err := req.Bond.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInsufficientEvidenceDisputeBond, err.Error())
+	}
+	minBond := params.EvidenceDisputeMinBond
+	if req.Bond.Denom != minBond.Denom {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientEvidenceDisputeBond, "bond denom must be %s", minBond.Denom)
+	}
+	if req.Bond.IsLT(minBond) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientEvidenceDisputeBond, "bond %s is below minimum %s", req.Bond, minBond)
+	}
+
+	ev, found := m.GetEvidence(sdkCtx, req.EvidenceId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEvidenceNotFound, "evidence %d not found", req.EvidenceId)
+	}
+	if ev.SubjectAddress != req.Creator {
+		return nil, errorsmod.Wrap(types.ErrInvalidSubject, "only the evidence subject can dispute it")
+	}
+	if _, exists := m.GetEvidenceDisputeByEvidence(sdkCtx, req.EvidenceId); exists {
+		return nil, errorsmod.Wrapf(types.ErrEvidenceDisputeExists, "evidence %d", req.EvidenceId)
+	}
+
+	currentEpoch, err := deriveEpochAtHeight(sdkCtx.BlockHeight(), params)
+	if err != nil {
+		return nil, err
+	}
+	evidenceEpoch, err := deriveEpochAtHeight(int64(ev.ReportedHeight), params)
+	if err != nil {
+		return nil, err
+	}
+	if currentEpoch.EpochID > evidenceEpoch.EpochID+uint64(params.EvidenceDisputeWindowEpochs) {
+		return nil, errorsmod.Wrapf(types.ErrEvidenceDisputeWindowElapsed,
+			"evidence reported in epoch %d can only be disputed until epoch %d", evidenceEpoch.EpochID, evidenceEpoch.EpochID+uint64(params.EvidenceDisputeWindowEpochs))
+	}
+
+	anchor, found := m.GetEpochAnchor(sdkCtx, currentEpoch.EpochID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpochID, "epoch anchor not found for epoch_id %d", currentEpoch.EpochID)
+	}
+	disputeID := m.GetNextEvidenceDisputeID(sdkCtx)
+	panel := selectEvidenceDisputePanel(anchor, disputeID, ev, params.EvidenceDisputePanelSize)
+	if len(panel) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrEvidenceDisputeNoPanel, "epoch %d has no eligible panelists", currentEpoch.EpochID)
+	}
+
+	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(req.Bond)); err != nil {
+		return nil, err
+	}
+
+	dispute := types.EvidenceDispute{
+		DisputeId:        disputeID,
+		EvidenceId:       ev.EvidenceId,
+		SubjectAddress:   ev.SubjectAddress,
+		Bond:             req.Bond,
+		CounterProofType: req.CounterProofType,
+		CounterProof:     req.CounterProof,
+		Details:          req.Details,
+		Panel:            panel,
+		Status:           types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING,
+		EpochId:          currentEpoch.EpochID,
+		DeadlineEpochId:  currentEpoch.EpochID + uint64(params.EvidenceDisputeVotingEpochs),
+		CreatedHeight:    uint64(sdkCtx.BlockHeight()),
+	}
+	m.SetNextEvidenceDisputeID(sdkCtx, disputeID+1)
+	if err := m.SetEvidenceDispute(sdkCtx, dispute); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEvidenceDisputed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDisputeID, strconv.FormatUint(disputeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyEvidenceID, strconv.FormatUint(ev.EvidenceId, 10)),
+		sdk.NewAttribute(types.AttributeKeySubjectAddress, ev.SubjectAddress),
+		sdk.NewAttribute(types.AttributeKeyBond, req.Bond.String()),
+		sdk.NewAttribute(types.AttributeKeyDeadlineEpochID, strconv.FormatUint(dispute.DeadlineEpochId, 10)),
+	))
+
+	return &types.MsgDisputeEvidenceResponse{DisputeId: disputeID}, nil
+}
+
+func (m msgServer) VoteEvidenceDispute(ctx context.Context, req *types.MsgVoteEvidenceDispute) (*types.MsgVoteEvidenceDisputeResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "empty request")
+	}
+	if req.Creator == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigner, "creator is required")
+	}
+	if req.DisputeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrEvidenceDisputeNotFound, "dispute_id is required")
+	}
+	if len(req.Details) > maxEvidenceDisputeDetailBytes {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceDispute, "details is too large")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if m.epochFinalizationPending(sdkCtx) {
+		return nil, errorsmod.Wrap(types.ErrEpochFinalizationInProgress, "previous epoch is still being finalized")
+	}
+	dispute, found := m.GetEvidenceDispute(sdkCtx, req.DisputeId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEvidenceDisputeNotFound, "dispute %d not found", req.DisputeId)
+	}
+	if dispute.Status != types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(types.ErrEvidenceDisputeClosed, "dispute status is %s", dispute.Status.String())
+	}
+	if !containsString(dispute.Panel, req.Creator) {
+		return nil, errorsmod.Wrap(types.ErrEvidenceDisputeUnauthorized, "creator is not on the dispute panel")
+	}
+	for _, v := range dispute.Votes {
+		if v.PanelistSupernodeAccount == req.Creator {
+			return nil, errorsmod.Wrap(types.ErrEvidenceDisputeVoteExists, "vote already submitted by creator")
+		}
+	}
+
+	dispute.Votes = append(dispute.Votes, types.EvidenceDisputeVote{
+		PanelistSupernodeAccount: req.Creator,
+		Uphold:                   req.Uphold,
+		Height:                   uint64(sdkCtx.BlockHeight()),
+		Details:                  req.Details,
+	})
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEvidenceDisputeVote,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDisputeID, strconv.FormatUint(dispute.DisputeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPanelist, req.Creator),
+		sdk.NewAttribute(types.AttributeKeyUphold, strconv.FormatBool(req.Uphold)),
+	))
+
+	status := tallyEvidenceDispute(dispute)
+	if status == types.EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING {
+		if err := m.SetEvidenceDispute(sdkCtx, dispute); err != nil {
+			return nil, err
+		}
+		return &types.MsgVoteEvidenceDisputeResponse{Status: status}, nil
+	}
+
+	params := m.GetParams(ctx).WithDefaults()
+	if err := m.resolveEvidenceDispute(sdkCtx, dispute, status, params); err != nil {
+		return nil, err
+	}
+	return &types.MsgVoteEvidenceDisputeResponse{Status: status}, nil
+}
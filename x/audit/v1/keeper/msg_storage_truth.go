@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
 )
 
 func (m msgServer) SubmitStorageRecheckEvidence(ctx context.Context, req *types.MsgSubmitStorageRecheckEvidence) (*types.MsgSubmitStorageRecheckEvidenceResponse, error) {
@@ -36,6 +37,9 @@ func (m msgServer) SubmitStorageRecheckEvidence(ctx context.Context, req *types.
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if m.epochFinalizationPending(sdkCtx) {
+		return nil, errorsmod.Wrap(types.ErrEpochFinalizationInProgress, "previous epoch is still being finalized")
+	}
 	if _, found := m.GetEpochAnchor(sdkCtx, req.EpochId); !found {
 		return nil, errorsmod.Wrapf(types.ErrInvalidEpochID, "epoch anchor not found for epoch_id %d", req.EpochId)
 	}
@@ -217,6 +221,9 @@ func (m msgServer) ClaimHealComplete(ctx context.Context, req *types.MsgClaimHea
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if m.epochFinalizationPending(sdkCtx) {
+		return nil, errorsmod.Wrap(types.ErrEpochFinalizationInProgress, "previous epoch is still being finalized")
+	}
 	healOp, found := m.GetHealOp(sdkCtx, req.HealOpId)
 	if !found {
 		return nil, errorsmod.Wrapf(types.ErrHealOpNotFound, "heal op %d not found", req.HealOpId)
@@ -273,6 +280,9 @@ func (m msgServer) SubmitHealVerification(ctx context.Context, req *types.MsgSub
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if m.epochFinalizationPending(sdkCtx) {
+		return nil, errorsmod.Wrap(types.ErrEpochFinalizationInProgress, "previous epoch is still being finalized")
+	}
 	healOp, found := m.GetHealOp(sdkCtx, req.HealOpId)
 	if !found {
 		return nil, errorsmod.Wrapf(types.ErrHealOpNotFound, "heal op %d not found", req.HealOpId)
@@ -378,6 +388,11 @@ func (m msgServer) finalizeHealOp(
 		return err
 	}
 
+	params := m.GetParams(ctx).WithDefaults()
+	if !verified && slashesStorageTruthOffenses(params) && healOp.HealerSupernodeAccount != "" {
+		m.reportSlashableOffenseByAccount(ctx, healOp.HealerSupernodeAccount, sntypes.SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE, healOpEvidenceRef(healOp.HealOpId))
+	}
+
 	ticketState, found := m.GetTicketDeteriorationState(ctx, healOp.TicketId)
 	if !found {
 		return nil
@@ -386,7 +401,6 @@ func (m msgServer) finalizeHealOp(
 		ticketState.ActiveHealOpId = 0
 	}
 
-	params := m.GetParams(ctx).WithDefaults()
 	currentEpoch, err := deriveEpochAtHeight(ctx.BlockHeight(), params)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"math"
+	"slices"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
@@ -26,6 +27,9 @@ func (m msgServer) SubmitEpochReport(ctx context.Context, req *types.MsgSubmitEp
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if m.epochFinalizationPending(sdkCtx) {
+		return nil, errorsmod.Wrap(types.ErrEpochFinalizationInProgress, "previous epoch is still being finalized")
+	}
 	params := m.GetParams(ctx).WithDefaults()
 
 	// Validate epoch_id acceptance: only the current epoch_id is accepted at the current height.
@@ -128,6 +132,25 @@ func (m msgServer) SubmitEpochReport(ctx context.Context, req *types.MsgSubmitEp
 			}
 			seenTargets[target] = struct{}{}
 
+			probes, explicitEndpoints, err := m.probeEndpointsForTarget(sdkCtx, target, assignParams.RequiredOpenPorts)
+			if err != nil {
+				return nil, err
+			}
+			if len(obs.EndpointObservations) > 0 {
+				derived, err := deriveEndpointPortStates(probes, obs.EndpointObservations, assignParams.RequiredOpenPorts)
+				if err != nil {
+					return nil, err
+				}
+				if len(obs.PortStates) == 0 {
+					obs.PortStates = derived
+				} else if !slices.Equal(obs.PortStates, derived) {
+					return nil, errorsmod.Wrapf(types.ErrInvalidPeerObservations, "port_states for target %q disagree with endpoint observations", target)
+				}
+			} else if explicitEndpoints && len(probes) > 0 {
+				// Legacy port_states cannot show which of the advertised
+				// endpoints were probed, so they only cover legacy targets.
+				return nil, errorsmod.Wrapf(types.ErrInvalidPeerObservations, "target %q advertises endpoints; endpoint_observations for all %d probe endpoints are required", target, len(probes))
+			}
 			if requiredPortsLen != 0 && len(obs.PortStates) != requiredPortsLen {
 				return nil, errorsmod.Wrapf(types.ErrInvalidPortStatesLength, "port_states length %d does not match required_open_ports length %d", len(obs.PortStates), requiredPortsLen)
 			}
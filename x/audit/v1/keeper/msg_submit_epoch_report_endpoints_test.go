@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func TestSubmitEpochReport_ProbesEachAdvertisedEndpoint(t *testing.T) {
+	f := initFixture(t)
+	f.ctx = f.ctx.WithBlockHeight(1)
+
+	params := types.DefaultParams().WithDefaults()
+	params.StorageTruthEnforcementMode = types.StorageTruthEnforcementMode_STORAGE_TRUTH_ENFORCEMENT_MODE_UNSPECIFIED
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	reporter := "sn-aaa-reporter"
+	dualStack := "sn-bbb-target"
+	legacy := "sn-ccc-target"
+	other := "sn-ddd-target"
+	activeAndTargets := []string{reporter, dualStack, legacy, other}
+
+	dualStackNode := sntypes.SuperNode{}
+	dualStackNode.ApplyEndpoints([]*sntypes.SupernodeEndpoint{
+		{
+			AddressType: sntypes.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4,
+			Address:     "192.168.1.10",
+			Protocol:    sntypes.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+			Port:        4445,
+			Purpose:     sntypes.EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+		},
+		{
+			AddressType: sntypes.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6,
+			Address:     "2001:db8::10",
+			Protocol:    sntypes.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+			Port:        9090,
+			Purpose:     sntypes.EndpointPurpose_ENDPOINT_PURPOSE_GRPC,
+		},
+	}, 1)
+
+	f.supernodeKeeper.EXPECT().GetSuperNodeByAccount(gomock.Any(), reporter).Return(sntypes.SuperNode{}, true, nil).AnyTimes()
+	f.supernodeKeeper.EXPECT().GetSuperNodeByAccount(gomock.Any(), dualStack).Return(dualStackNode, true, nil).AnyTimes()
+	f.supernodeKeeper.EXPECT().GetSuperNodeByAccount(gomock.Any(), legacy).Return(sntypes.SuperNode{
+		PrevIpAddresses: []*sntypes.IPAddressHistory{{Address: "10.0.0.7", Height: 1}},
+	}, true, nil).AnyTimes()
+	f.supernodeKeeper.EXPECT().GetSuperNodeByAccount(gomock.Any(), other).Return(sntypes.SuperNode{}, false, nil).AnyTimes()
+
+	seedEpochAnchorForReportTest(t, f, 0, activeAndTargets, activeAndTargets)
+
+	resp, err := keeper.NewQueryServerImpl(f.keeper).AssignedTargets(f.ctx, &types.QueryAssignedTargetsRequest{SupernodeAccount: reporter})
+	require.NoError(t, err)
+	require.Len(t, resp.TargetEndpoints, len(resp.TargetSupernodeAccounts))
+
+	probesByTarget := make(map[string][]types.ProbeEndpoint)
+	for _, te := range resp.TargetEndpoints {
+		probesByTarget[te.TargetSupernodeAccount] = te.Endpoints
+	}
+	// Every required port on both addresses, plus the extra advertised gRPC port.
+	require.Equal(t, []types.ProbeEndpoint{
+		{Address: "192.168.1.10", Port: 4444},
+		{Address: "192.168.1.10", Port: 4445},
+		{Address: "192.168.1.10", Port: 8002},
+		{Address: "2001:db8::10", Port: 4444},
+		{Address: "2001:db8::10", Port: 4445},
+		{Address: "2001:db8::10", Port: 8002},
+		{Address: "2001:db8::10", Port: 9090},
+	}, probesByTarget[dualStack])
+	require.Equal(t, []types.ProbeEndpoint{
+		{Address: "10.0.0.7", Port: 4444},
+		{Address: "10.0.0.7", Port: 4445},
+		{Address: "10.0.0.7", Port: 8002},
+	}, probesByTarget[legacy])
+	require.Empty(t, probesByTarget[other])
+
+	observe := func(target string, closed map[types.ProbeEndpoint]bool) []*types.EndpointObservation {
+		var out []*types.EndpointObservation
+		for _, p := range probesByTarget[target] {
+			state := types.PortState_PORT_STATE_OPEN
+			if closed[p] {
+				state = types.PortState_PORT_STATE_CLOSED
+			}
+			out = append(out, &types.EndpointObservation{Address: p.Address, Port: p.Port, PortState: state})
+		}
+		return out
+	}
+	allOpen := []types.PortState{types.PortState_PORT_STATE_OPEN, types.PortState_PORT_STATE_OPEN, types.PortState_PORT_STATE_OPEN}
+
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// An observation for an endpoint the target does not advertise is rejected.
+	stray := observe(dualStack, nil)
+	stray[0].Address = "192.168.1.11"
+	_, err = ms.SubmitEpochReport(f.ctx, &types.MsgSubmitEpochReport{
+		Creator: reporter,
+		EpochId: 0,
+		StorageChallengeObservations: []*types.StorageChallengeObservation{
+			{TargetSupernodeAccount: dualStack, EndpointObservations: stray},
+			{TargetSupernodeAccount: legacy, PortStates: allOpen},
+			{TargetSupernodeAccount: other, PortStates: allOpen},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPeerObservations)
+
+	// Explicit port_states that contradict the endpoint observations are rejected.
+	ipv6Closed := map[types.ProbeEndpoint]bool{{Address: "2001:db8::10", Port: 4445}: true}
+	_, err = ms.SubmitEpochReport(f.ctx, &types.MsgSubmitEpochReport{
+		Creator: reporter,
+		EpochId: 0,
+		StorageChallengeObservations: []*types.StorageChallengeObservation{
+			{TargetSupernodeAccount: dualStack, PortStates: allOpen, EndpointObservations: observe(dualStack, ipv6Closed)},
+			{TargetSupernodeAccount: legacy, PortStates: allOpen},
+			{TargetSupernodeAccount: other, PortStates: allOpen},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPeerObservations)
+
+	// A target with an explicit endpoint set cannot be reported with legacy port_states only.
+	_, err = ms.SubmitEpochReport(f.ctx, &types.MsgSubmitEpochReport{
+		Creator: reporter,
+		EpochId: 0,
+		StorageChallengeObservations: []*types.StorageChallengeObservation{
+			{TargetSupernodeAccount: dualStack, PortStates: allOpen},
+			{TargetSupernodeAccount: legacy, PortStates: allOpen},
+			{TargetSupernodeAccount: other, PortStates: allOpen},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPeerObservations)
+
+	// A port closed on the IPv6 address only is recorded as closed for the target.
+	_, err = ms.SubmitEpochReport(f.ctx, &types.MsgSubmitEpochReport{
+		Creator: reporter,
+		EpochId: 0,
+		StorageChallengeObservations: []*types.StorageChallengeObservation{
+			{TargetSupernodeAccount: dualStack, EndpointObservations: observe(dualStack, ipv6Closed)},
+			{TargetSupernodeAccount: legacy, EndpointObservations: observe(legacy, nil)},
+			{TargetSupernodeAccount: other, PortStates: allOpen},
+		},
+	})
+	require.NoError(t, err)
+
+	report, found := f.keeper.GetReport(f.ctx, 0, reporter)
+	require.True(t, found)
+	statesByTarget := make(map[string][]types.PortState)
+	for _, obs := range report.StorageChallengeObservations {
+		statesByTarget[obs.TargetSupernodeAccount] = obs.PortStates
+	}
+	require.Equal(t, []types.PortState{
+		types.PortState_PORT_STATE_OPEN,
+		types.PortState_PORT_STATE_CLOSED,
+		types.PortState_PORT_STATE_OPEN,
+	}, statesByTarget[dualStack])
+	require.Equal(t, allOpen, statesByTarget[legacy])
+}
@@ -137,6 +137,7 @@ func TestSubmitEpochReport_StorageTruthScoresByResultClass(t *testing.T) {
 				GetSuperNodeByAccount(gomock.Any(), reporter).
 				Return(sntypes.SuperNode{}, true, nil).
 				AnyTimes()
+			expectTargetsWithoutEndpoints(f)
 
 			seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 
@@ -242,6 +243,7 @@ func TestSubmitEpochReport_StorageTruthScoresApplyDecay(t *testing.T) {
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 3, []string{reporter, target}, []string{reporter, target})
 
@@ -314,6 +316,7 @@ func TestSubmitEpochReport_TimeoutOnIndexArtifactIsClassBAndUnscaled(t *testing.
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 	result := baseStorageProofResult(types.StorageProofResultClass_STORAGE_PROOF_RESULT_CLASS_TIMEOUT_OR_NO_RESPONSE)
@@ -363,6 +366,7 @@ func TestSubmitEpochReport_StorageTruthScoreEventsAreEmitted(t *testing.T) {
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 	result := baseStorageProofResult(types.StorageProofResultClass_STORAGE_PROOF_RESULT_CLASS_PASS)
@@ -432,6 +436,7 @@ func TestSubmitEpochReport_NoStorageProofResults_DoesNotCreateStorageTruthStates
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 
@@ -478,6 +483,7 @@ func TestSubmitEpochReport_LowTrustReporterScalesNodeAndTicketDeltas(t *testing.
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	// Set reporter score to +20 = LOW_TRUST in positive-penalty model.
 	require.NoError(t, f.keeper.SetReporterReliabilityState(f.ctx, types.ReporterReliabilityState{
@@ -536,6 +542,7 @@ func TestSubmitEpochReport_RepeatedDistinctTicketFailuresEscalate(t *testing.T)
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	require.NoError(t, f.keeper.SetTicketDeteriorationState(f.ctx, types.TicketDeteriorationState{
 		TicketId:                     "ticket-1",
@@ -590,6 +597,7 @@ func TestSubmitEpochReport_DistinctTicketFailuresEscalateNodeSuspicion(t *testin
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	f.ctx = f.ctx.WithBlockHeight(401).WithEventManager(sdk.NewEventManager()) // epoch_id = 1
 	seedEpochAnchorForReportTest(t, f, 1, []string{reporter, target}, []string{reporter, target})
@@ -653,6 +661,7 @@ func TestSubmitEpochReport_EpochZeroFailureWindowCarriesIntoNextEpoch(t *testing
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	require.NoError(t, f.keeper.SetTicketDeteriorationState(f.ctx, types.TicketDeteriorationState{
 		TicketId:                     "ticket-epoch-zero",
@@ -715,6 +724,7 @@ func TestSubmitEpochReport_ContradictionsPenalizeBothReportersAndTrackState(t *t
 		GetSuperNodeByAccount(gomock.Any(), independentReporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	require.NoError(t, f.keeper.SetReporterReliabilityState(f.ctx, types.ReporterReliabilityState{
 		ReporterSupernodeAccount: previousReporter,
@@ -30,6 +30,16 @@ func seedEpochAnchorForReportTest(t *testing.T, f *fixture, epochID uint64, acti
 	require.NoError(t, err)
 }
 
+// expectTargetsWithoutEndpoints lets SubmitEpochReport look up peer targets
+// that are not otherwise mocked; they are treated as unregistered, so their
+// legacy port_states are accepted. Register it after any specific lookups.
+func expectTargetsWithoutEndpoints(f *fixture) {
+	f.supernodeKeeper.EXPECT().
+		GetSuperNodeByAccount(gomock.Any(), gomock.Any()).
+		Return(sntypes.SuperNode{}, false, nil).
+		AnyTimes()
+}
+
 func seedTicketArtifactCountsForResults(t *testing.T, f *fixture, results ...*types.StorageProofResult) {
 	t.Helper()
 
@@ -152,6 +162,7 @@ func TestSubmitEpochReport_AcceptsPartialStorageChallengeObservationsAndPenalize
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, activeAndTargets, activeAndTargets)
 
@@ -221,6 +232,7 @@ func TestSubmitEpochReport_FullStorageChallengeCoverageDoesNotPenalizeReporter(t
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, activeAndTargets, activeAndTargets)
 
@@ -316,6 +328,7 @@ func TestSubmitEpochReport_StillRejectsInvalidStorageChallengeObservations(t *te
 				GetSuperNodeByAccount(gomock.Any(), reporter).
 				Return(sntypes.SuperNode{}, true, nil).
 				AnyTimes()
+			expectTargetsWithoutEndpoints(f)
 
 			seedEpochAnchorForReportTest(t, f, 0, activeAndTargets, activeAndTargets)
 
@@ -352,6 +365,7 @@ func TestSubmitEpochReport_PersistsStorageProofResults(t *testing.T) {
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 
@@ -570,6 +584,7 @@ func TestSubmitEpochReport_RejectsMalformedStorageProofResults(t *testing.T) {
 				GetSuperNodeByAccount(gomock.Any(), reporter).
 				Return(sntypes.SuperNode{}, true, nil).
 				AnyTimes()
+			expectTargetsWithoutEndpoints(f)
 
 			seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 
@@ -628,6 +643,7 @@ func TestSubmitEpochReport_FullModeRequiresRecentAndOldStorageProofs(t *testing.
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 
@@ -15,6 +15,7 @@ func (k msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvide
 	switch msg.EvidenceType {
 	case types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE,
 		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_NOT_IN_TOP_10,
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH,
 		types.EvidenceType_EVIDENCE_TYPE_ACTION_EXPIRED,
 		types.EvidenceType_EVIDENCE_TYPE_CASCADE_CLIENT_FAILURE:
 		return nil, errorsmod.Wrap(types.ErrInvalidEvidenceType, "evidence type is reserved for the action module")
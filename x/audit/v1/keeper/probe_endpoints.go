@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// probeEndpointsForTarget returns the ordered endpoints a prober must check on
+// target: every required open port on each distinct advertised address, plus
+// any additional TCP port the target advertises. Supernodes without an
+// explicit endpoint set are probed at their latest IP address only; explicit
+// reports whether the target has such a set.
+func (k Keeper) probeEndpointsForTarget(ctx sdk.Context, target string, requiredPorts []uint32) (probes []types.ProbeEndpoint, explicit bool, err error) {
+	sn, found, err := k.supernodeKeeper.GetSuperNodeByAccount(ctx, target)
+	if err != nil {
+		return nil, false, err
+	}
+	if !found {
+		return nil, false, nil
+	}
+
+	return buildProbeEndpoints(sn.EffectiveEndpoints(), requiredPorts), len(sn.Endpoints) > 0, nil
+}
+
+func buildProbeEndpoints(endpoints []sntypes.SupernodeEndpoint, requiredPorts []uint32) []types.ProbeEndpoint {
+	var addresses []string
+	seenAddresses := make(map[string]struct{}, len(endpoints))
+	for _, e := range endpoints {
+		if _, ok := seenAddresses[e.Address]; ok {
+			continue
+		}
+		seenAddresses[e.Address] = struct{}{}
+		addresses = append(addresses, e.Address)
+	}
+
+	var probes []types.ProbeEndpoint
+	seen := make(map[string]struct{})
+	add := func(address string, port uint32) {
+		key := probeEndpointKey(address, port)
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		probes = append(probes, types.ProbeEndpoint{Address: address, Port: port})
+	}
+
+	for _, address := range addresses {
+		for _, port := range requiredPorts {
+			add(address, port)
+		}
+	}
+	for _, e := range endpoints {
+		if e.Protocol == sntypes.EndpointProtocol_ENDPOINT_PROTOCOL_TCP {
+			add(e.Address, e.Port)
+		}
+	}
+
+	return probes
+}
+
+// deriveEndpointPortStates validates a prober's per-endpoint observations
+// against the probe set of the target and folds them into one state per
+// required open port. A port is CLOSED when any address reports it closed,
+// OPEN when at least one address reports it open and none closed, and UNKNOWN
+// otherwise (e.g. a prober without IPv6 connectivity).
+func deriveEndpointPortStates(probes []types.ProbeEndpoint, observations []*types.EndpointObservation, requiredPorts []uint32) ([]types.PortState, error) {
+	expected := make(map[string]struct{}, len(probes))
+	for _, p := range probes {
+		expected[probeEndpointKey(p.Address, p.Port)] = struct{}{}
+	}
+
+	observed := make(map[string]struct{}, len(observations))
+	for _, obs := range observations {
+		if obs == nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidPeerObservations, "nil endpoint observation")
+		}
+		if _, ok := types.PortState_name[int32(obs.PortState)]; !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPeerObservations, "invalid port state %d", obs.PortState)
+		}
+		key := probeEndpointKey(obs.Address, obs.Port)
+		if _, ok := expected[key]; !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPeerObservations, "endpoint %s is not a probe endpoint of the target", key)
+		}
+		if _, dup := observed[key]; dup {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPeerObservations, "duplicate endpoint observation for %s", key)
+		}
+		observed[key] = struct{}{}
+	}
+	if len(observed) != len(expected) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPeerObservations,
+			"endpoint observations cover %d of %d probe endpoints", len(observed), len(expected))
+	}
+
+	states := make([]types.PortState, len(requiredPorts))
+	for i, port := range requiredPorts {
+		for _, obs := range observations {
+			if obs.Port != port {
+				continue
+			}
+			switch obs.PortState {
+			case types.PortState_PORT_STATE_CLOSED:
+				states[i] = types.PortState_PORT_STATE_CLOSED
+			case types.PortState_PORT_STATE_OPEN:
+				if states[i] == types.PortState_PORT_STATE_UNKNOWN {
+					states[i] = types.PortState_PORT_STATE_OPEN
+				}
+			}
+		}
+	}
+
+	return states, nil
+}
+
+func probeEndpointKey(address string, port uint32) string {
+	if addr, err := netip.ParseAddr(address); err == nil {
+		address = addr.String()
+	} else {
+		address = strings.ToLower(address)
+	}
+	return address + "|" + strconv.FormatUint(uint64(port), 10)
+}
@@ -13,6 +13,28 @@ import (
 // PruneOldEpochs bounds audit storage by epoch_id, keeping only the last params.keep_last_epoch_entries epochs
 // (including the provided currentEpochID).
 func (k Keeper) PruneOldEpochs(ctx sdk.Context, currentEpochID uint64, params types.Params) error {
+	store := k.kvStore(ctx)
+	for _, step := range k.pruneSteps(ctx, currentEpochID, params) {
+		if _, _, err := runPruneStep(store, step, nil, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneStep is a single pruning pass over one store prefix. Steps are independent of each
+// other's progress so they can run in a single call or be resumed key by key.
+type pruneStep struct {
+	prefix []byte
+	// match reports whether the entry should be deleted, and whether the scan can stop because
+	// no later key under the prefix can match.
+	match func(key, value []byte) (del, stop bool)
+	// delete removes a matched entry; nil deletes the key itself.
+	delete func(store storetypes.KVStore, key, value []byte)
+}
+
+// pruneSteps returns the pruning passes of PruneOldEpochs in execution order.
+func (k Keeper) pruneSteps(ctx sdk.Context, currentEpochID uint64, params types.Params) []pruneStep {
 	params = params.WithDefaults()
 	keepLastEpochEntries := params.KeepLastEpochEntries
 
@@ -24,211 +46,194 @@ func (k Keeper) PruneOldEpochs(ctx sdk.Context, currentEpochID uint64, params ty
 		minKeepEpochID = 0
 	}
 
-	store := k.kvStore(ctx)
-
-	// Epoch anchors: ea/<u64be(epoch_id)>
-	if err := prunePrefixByWindowIDLeadingU64(store, types.EpochAnchorPrefix(), minKeepEpochID); err != nil {
-		return err
-	}
-
-	// Epoch params snapshots: eps/<u64be(epoch_id)>
-	if err := prunePrefixByWindowIDLeadingU64(store, types.EpochParamsSnapshotPrefix(), minKeepEpochID); err != nil {
-		return err
-	}
-
-	// Reports: r/<u64be(epoch_id)><reporter>
-	if err := prunePrefixByWindowIDLeadingU64(store, types.ReportPrefix(), minKeepEpochID); err != nil {
-		return err
+	steps := []pruneStep{
+		// Epoch anchors: ea/<u64be(epoch_id)>
+		pruneStepByWindowIDLeadingU64(types.EpochAnchorPrefix(), minKeepEpochID),
+		// Epoch params snapshots: eps/<u64be(epoch_id)>
+		pruneStepByWindowIDLeadingU64(types.EpochParamsSnapshotPrefix(), minKeepEpochID),
+		// Reports: r/<u64be(epoch_id)><reporter>
+		pruneStepByWindowIDLeadingU64(types.ReportPrefix(), minKeepEpochID),
+
+		// Indices:
+		// - ri/<reporter>/<u64be(epoch_id)>
+		// - hr/<reporter>/<u64be(epoch_id)>
+		// - sc/<supernode>/<u64be(epoch_id)>/<reporter>
+		pruneStepReporterTrailingWindowID(types.ReportIndexRootPrefix(), minKeepEpochID),
+		pruneStepReporterTrailingWindowID(types.HostReportIndexRootPrefix(), minKeepEpochID),
+		pruneStepSupernodeWindowReporter(types.StorageChallengeReportIndexRootPrefix(), minKeepEpochID),
+
+		// Evidence epoch counts: eve/<u64be(epoch_id)>/...
+		pruneStepByWindowIDLeadingU64(types.EvidenceEpochCountPrefix(), minKeepEpochID),
+
+		// Recheck evidence dedup: st/rce/<u64be(epoch_id)>/... (epoch-leading, 121-F6)
+		pruneStepByWindowIDLeadingU64([]byte("st/rce/"), minKeepEpochID),
+
+		// Storage-truth fact indexes (121-F6): all keyed as <prefix><account>/<u64be(epoch_id)>/...
+		// Node failure records: st/nf/<supernode_account>/<u64be(epoch_id)>/...
+		pruneStepSupernodeWindowReporter([]byte("st/nf/"), minKeepEpochID),
+		// Reporter result records: st/rrs/<reporter_account>/<u64be(epoch_id)>/...
+		pruneStepSupernodeWindowReporter([]byte("st/rrs/"), minKeepEpochID),
+		// Failed heal records: st/fh/<supernode_account>/<u64be(epoch_id)>/...
+		pruneStepSupernodeWindowReporter([]byte("st/fh/"), minKeepEpochID),
+
+		// CP3.5 secondary indexes for indexed contradiction-check lookups.
+		// Reporter result by target index: st/rrs-tt/<target>/<u64be(epoch_id)>/<ticket_id>0x00<reporter>
+		// Same shape as st/rrs/ (account-then-epoch), reuse helper.
+		pruneStepSupernodeWindowReporter([]byte("st/rrs-tt/"), minKeepEpochID),
+		// Reporter result by epoch index: st/rrs-e/<u64be(epoch_id)>/<reporter>
+		pruneStepByWindowIDLeadingU64(types.ReporterStorageTruthResultByEpochRootPrefix(), minKeepEpochID),
+		// Transcript by target/bucket/epoch index: st/spt-tbe/<target>/<u32be(bucket)>/<u64be(epoch_id)>/<transcript_hash>
+		pruneStepTargetBucketEpoch([]byte("st/spt-tbe/"), minKeepEpochID),
+		// Primary transcript store: st/spt/<transcript_hash> -> JSON{epoch_id, ...}.
+		// Records are not epoch-keyed, so decode value to filter.
+		k.pruneStepStorageProofTranscripts([]byte("st/spt/"), minKeepEpochID),
 	}
 
-	// Indices:
-	// - ri/<reporter>/<u64be(epoch_id)>
-	// - hr/<reporter>/<u64be(epoch_id)>
-	// - sc/<supernode>/<u64be(epoch_id)>/<reporter>
-	pruneReporterTrailingWindowID(store, types.ReportIndexRootPrefix(), minKeepEpochID)
-	pruneReporterTrailingWindowID(store, types.HostReportIndexRootPrefix(), minKeepEpochID)
-	pruneSupernodeWindowReporter(store, types.StorageChallengeReportIndexRootPrefix(), minKeepEpochID)
-
-	// Evidence epoch counts: eve/<u64be(epoch_id)>/...
-	if err := prunePrefixByWindowIDLeadingU64(store, types.EvidenceEpochCountPrefix(), minKeepEpochID); err != nil {
-		return err
-	}
-
-	// Recheck evidence dedup: st/rce/<u64be(epoch_id)>/... (epoch-leading, 121-F6)
-	if err := prunePrefixByWindowIDLeadingU64(store, []byte("st/rce/"), minKeepEpochID); err != nil {
-		return err
-	}
-
-	// Storage-truth fact indexes (121-F6): all keyed as <prefix><account>/<u64be(epoch_id)>/...
-	// Node failure records: st/nf/<supernode_account>/<u64be(epoch_id)>/...
-	pruneSupernodeWindowReporter(store, []byte("st/nf/"), minKeepEpochID)
-	// Reporter result records: st/rrs/<reporter_account>/<u64be(epoch_id)>/...
-	pruneSupernodeWindowReporter(store, []byte("st/rrs/"), minKeepEpochID)
-	// Failed heal records: st/fh/<supernode_account>/<u64be(epoch_id)>/...
-	pruneSupernodeWindowReporter(store, []byte("st/fh/"), minKeepEpochID)
-
-	// CP3.5 secondary indexes for indexed contradiction-check lookups.
-	// Reporter result by target index: st/rrs-tt/<target>/<u64be(epoch_id)>/<ticket_id>0x00<reporter>
-	// Same shape as st/rrs/ (account-then-epoch), reuse helper.
-	pruneSupernodeWindowReporter(store, []byte("st/rrs-tt/"), minKeepEpochID)
-	// Reporter result by epoch index: st/rrs-e/<u64be(epoch_id)>/<reporter>
-	if err := prunePrefixByWindowIDLeadingU64(store, types.ReporterStorageTruthResultByEpochRootPrefix(), minKeepEpochID); err != nil {
-		return err
-	}
-	// Transcript by target/bucket/epoch index: st/spt-tbe/<target>/<u32be(bucket)>/<u64be(epoch_id)>/<transcript_hash>
-	pruneTargetBucketEpoch(store, []byte("st/spt-tbe/"), minKeepEpochID)
-	// Primary transcript store: st/spt/<transcript_hash> -> JSON{epoch_id, ...}.
-	// Records are not epoch-keyed, so decode value to filter.
-	pruneStorageProofTranscripts(ctx, k, store, []byte("st/spt/"), minKeepEpochID)
-
 	// F-C1 — TicketDeteriorationState is keyed by ticket_id (not epoch),
 	// but is scanned at every epoch end for heal scheduling. Prune only
 	// semantically inert rows: old, zero-score, and without an active heal-op.
-	if err := k.pruneInactiveTicketDeteriorationStates(ctx, currentEpochID, keepLastEpochEntries); err != nil {
-		return err
+	if step, ok := k.pruneStepInactiveTicketDeteriorationStates(currentEpochID, keepLastEpochEntries); ok {
+		steps = append(steps, step)
 	}
 
 	// Per 120-F3 — terminal heal-ops pruned to bound chain state growth.
-	if err := k.pruneTerminalHealOps(ctx, currentEpochID, keepLastEpochEntries); err != nil {
-		return err
-	}
+	steps = append(steps, k.pruneStepTerminalHealOps(currentEpochID, keepLastEpochEntries))
 
-	return nil
+	return steps
 }
 
-func (k Keeper) pruneInactiveTicketDeteriorationStates(ctx sdk.Context, currentEpochID, keepLastEpochEntries uint64) error {
-	if keepLastEpochEntries == 0 || currentEpochID <= keepLastEpochEntries {
-		return nil
+// runPruneStep scans step.prefix from cursor (nil starts at the beginning) and deletes matching
+// entries. A non-zero limit bounds the number of keys scanned; the returned cursor is the key to
+// resume from, or nil once the step is done.
+func runPruneStep(store storetypes.KVStore, step pruneStep, cursor []byte, limit uint64) ([]byte, uint64, error) {
+	start := step.prefix
+	if len(cursor) > 0 {
+		start = cursor
 	}
-	pruneBeforeEpoch := currentEpochID - keepLastEpochEntries
+	it := store.Iterator(start, storetypes.PrefixEndBytes(step.prefix))
 
-	store := k.kvStore(ctx)
-	prefix := types.TicketDeteriorationStatePrefix()
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
-
-	var toDelete [][]byte
+	type entry struct {
+		key   []byte
+		value []byte
+	}
+	var (
+		toDelete []entry
+		scanned  uint64
+		next     []byte
+	)
 	for ; it.Valid(); it.Next() {
-		var state types.TicketDeteriorationState
-		k.cdc.MustUnmarshal(it.Value(), &state)
-
-		// Strictly match Zee's final-gate criterion:
-		// LastUpdatedEpoch + KeepLastEpochEntries < currentEpochID.
-		// Written as LastUpdatedEpoch < currentEpochID-KeepLastEpochEntries
-		// to avoid uint64 overflow.
-		if state.LastUpdatedEpoch >= pruneBeforeEpoch {
-			continue
+		if limit > 0 && scanned >= limit {
+			next = append([]byte(nil), it.Key()...)
+			break
 		}
-		if state.DeteriorationScore != 0 || state.ActiveHealOpId != 0 {
-			continue
+		scanned++
+		del, stop := step.match(it.Key(), it.Value())
+		if del {
+			// Copy key before iterator advances.
+			toDelete = append(toDelete, entry{
+				key:   append([]byte(nil), it.Key()...),
+				value: append([]byte(nil), it.Value()...),
+			})
+		}
+		if stop {
+			break
 		}
-
-		kc := make([]byte, len(it.Key()))
-		copy(kc, it.Key())
-		toDelete = append(toDelete, kc)
 	}
-
-	for _, key := range toDelete {
-		store.Delete(key)
+	if err := it.Close(); err != nil {
+		return nil, scanned, err
 	}
-	return nil
-}
-
-func prunePrefixByWindowIDLeadingU64(store storetypes.KVStore, prefix []byte, minKeepEpochID uint64) error {
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
 
-	var toDelete [][]byte
-
-	for ; it.Valid(); it.Next() {
-		key := it.Key()
-		if len(key) < len(prefix)+8 {
-			// Malformed; skip.
+	for _, e := range toDelete {
+		if step.delete != nil {
+			step.delete(store, e.key, e.value)
 			continue
 		}
-		epochID := binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8])
-		if epochID >= minKeepEpochID {
-			// Keys are ordered by leading u64be(epoch_id); we can stop.
-			break
-		}
-		// Copy key before iterator advances.
-		kc := make([]byte, len(key))
-		copy(kc, key)
-		toDelete = append(toDelete, kc)
+		store.Delete(e.key)
 	}
+	return next, scanned, nil
+}
 
-	for _, k := range toDelete {
-		store.Delete(k)
+func pruneStepByWindowIDLeadingU64(prefix []byte, minKeepEpochID uint64) pruneStep {
+	return pruneStep{
+		prefix: prefix,
+		match: func(key, _ []byte) (bool, bool) {
+			if len(key) < len(prefix)+8 {
+				// Malformed; skip.
+				return false, false
+			}
+			epochID := binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8])
+			if epochID >= minKeepEpochID {
+				// Keys are ordered by leading u64be(epoch_id); we can stop.
+				return false, true
+			}
+			return true, false
+		},
 	}
-	return nil
 }
 
-// pruneReporterTrailingWindowID prunes keys shaped like:
+// pruneStepReporterTrailingWindowID prunes keys shaped like:
 //
 //	<prefix><account>"/"<u64be(epoch_id)>
 //
 // by parsing the final 8 bytes as the epoch id.
-func pruneReporterTrailingWindowID(store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
-
-	var toDelete [][]byte
-
-	for ; it.Valid(); it.Next() {
-		key := it.Key()
-		if len(key) < len(prefix)+1+8 {
-			continue
-		}
-		epochID := binary.BigEndian.Uint64(key[len(key)-8:])
-		if epochID >= minKeepWindowID {
-			continue
-		}
-		kc := make([]byte, len(key))
-		copy(kc, key)
-		toDelete = append(toDelete, kc)
-	}
-
-	for _, k := range toDelete {
-		store.Delete(k)
+func pruneStepReporterTrailingWindowID(prefix []byte, minKeepWindowID uint64) pruneStep {
+	return pruneStep{
+		prefix: prefix,
+		match: func(key, _ []byte) (bool, bool) {
+			if len(key) < len(prefix)+1+8 {
+				return false, false
+			}
+			epochID := binary.BigEndian.Uint64(key[len(key)-8:])
+			return epochID < minKeepWindowID, false
+		},
 	}
 }
 
-// pruneSupernodeWindowReporter prunes keys shaped like:
+// pruneStepSupernodeWindowReporter prunes keys shaped like:
 //
 //	sc/<supernode>"/"<u64be(epoch_id)>"/"<reporter>
-func pruneSupernodeWindowReporter(store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
+func pruneStepSupernodeWindowReporter(prefix []byte, minKeepWindowID uint64) pruneStep {
+	return pruneStep{
+		prefix: prefix,
+		match: func(key, _ []byte) (bool, bool) {
+			if len(key) < len(prefix)+1+8+1+1 {
+				return false, false
+			}
+			rest := key[len(prefix):]
+			sep := bytesIndexByte(rest, '/')
+			if sep <= 0 {
+				return false, false
+			}
+			if len(rest) < sep+1+8+1 {
+				return false, false
+			}
+			epochIDStart := sep + 1
+			epochIDEnd := epochIDStart + 8
+			epochID := binary.BigEndian.Uint64(rest[epochIDStart:epochIDEnd])
+			return epochID < minKeepWindowID, false
+		},
+	}
+}
 
-	var toDelete [][]byte
+// prunePrefixByWindowIDLeadingU64 runs pruneStepByWindowIDLeadingU64 to completion.
+func prunePrefixByWindowIDLeadingU64(store storetypes.KVStore, prefix []byte, minKeepEpochID uint64) error {
+	_, _, err := runPruneStep(store, pruneStepByWindowIDLeadingU64(prefix, minKeepEpochID), nil, 0)
+	return err
+}
 
-	for ; it.Valid(); it.Next() {
-		key := it.Key()
-		if len(key) < len(prefix)+1+8+1+1 {
-			continue
-		}
-		rest := key[len(prefix):]
-		sep := bytesIndexByte(rest, '/')
-		if sep <= 0 {
-			continue
-		}
-		if len(rest) < sep+1+8+1 {
-			continue
-		}
-		epochIDStart := sep + 1
-		epochIDEnd := epochIDStart + 8
-		epochID := binary.BigEndian.Uint64(rest[epochIDStart:epochIDEnd])
-		if epochID >= minKeepWindowID {
-			continue
-		}
-		kc := make([]byte, len(key))
-		copy(kc, key)
-		toDelete = append(toDelete, kc)
-	}
+// pruneSupernodeWindowReporter runs pruneStepSupernodeWindowReporter to completion.
+func pruneSupernodeWindowReporter(store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
+	_, _, _ = runPruneStep(store, pruneStepSupernodeWindowReporter(prefix, minKeepWindowID), nil, 0)
+}
 
-	for _, k := range toDelete {
-		store.Delete(k)
-	}
+// pruneTargetBucketEpoch runs pruneStepTargetBucketEpoch to completion.
+func pruneTargetBucketEpoch(store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
+	_, _, _ = runPruneStep(store, pruneStepTargetBucketEpoch(prefix, minKeepWindowID), nil, 0)
+}
+
+// pruneStorageProofTranscripts runs pruneStepStorageProofTranscripts to completion.
+func pruneStorageProofTranscripts(_ sdk.Context, k Keeper, store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
+	_, _, _ = runPruneStep(store, k.pruneStepStorageProofTranscripts(prefix, minKeepWindowID), nil, 0)
 }
 
 func bytesIndexByte(b []byte, c byte) int {
@@ -240,46 +245,34 @@ func bytesIndexByte(b []byte, c byte) int {
 	return -1
 }
 
-// pruneTargetBucketEpoch prunes keys shaped like:
+// pruneStepTargetBucketEpoch prunes keys shaped like:
 //
 //	<prefix><target>"/"<u32be(bucket)>"/"<u64be(epoch_id)>"/"<transcript_hash>
 //
 // The 8-byte epoch sits after target + '/' + 4-byte bucket + '/'.
-func pruneTargetBucketEpoch(store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
-
-	var toDelete [][]byte
-
-	for ; it.Valid(); it.Next() {
-		key := it.Key()
-		rest := key[len(prefix):]
-		// rest = <target> '/' <u32be bucket> '/' <u64be epoch> '/' <hash>
-		sep := bytesIndexByte(rest, '/')
-		if sep <= 0 {
-			continue
-		}
-		// after first '/': 4-byte bucket + '/' + 8-byte epoch + '/' + hash >= 14
-		if len(rest) < sep+1+4+1+8+1 {
-			continue
-		}
-		epochStart := sep + 1 + 4 + 1
-		epochEnd := epochStart + 8
-		epochID := binary.BigEndian.Uint64(rest[epochStart:epochEnd])
-		if epochID >= minKeepWindowID {
-			continue
-		}
-		kc := make([]byte, len(key))
-		copy(kc, key)
-		toDelete = append(toDelete, kc)
-	}
-
-	for _, k := range toDelete {
-		store.Delete(k)
+func pruneStepTargetBucketEpoch(prefix []byte, minKeepWindowID uint64) pruneStep {
+	return pruneStep{
+		prefix: prefix,
+		match: func(key, _ []byte) (bool, bool) {
+			rest := key[len(prefix):]
+			// rest = <target> '/' <u32be bucket> '/' <u64be epoch> '/' <hash>
+			sep := bytesIndexByte(rest, '/')
+			if sep <= 0 {
+				return false, false
+			}
+			// after first '/': 4-byte bucket + '/' + 8-byte epoch + '/' + hash >= 14
+			if len(rest) < sep+1+4+1+8+1 {
+				return false, false
+			}
+			epochStart := sep + 1 + 4 + 1
+			epochEnd := epochStart + 8
+			epochID := binary.BigEndian.Uint64(rest[epochStart:epochEnd])
+			return epochID < minKeepWindowID, false
+		},
 	}
 }
 
-// pruneStorageProofTranscripts prunes the primary transcript store st/spt/<hash> -> JSON
+// pruneStepStorageProofTranscripts prunes the primary transcript store st/spt/<hash> -> JSON
 // by decoding the embedded epoch_id field. Records older than minKeepWindowID are deleted.
 // Per roomote 122 review — bounds long-term state growth.
 //
@@ -287,84 +280,95 @@ func pruneTargetBucketEpoch(store storetypes.KVStore, prefix []byte, minKeepWind
 // the keeper logger so silent state corruption is observable. Records remain in
 // place (pruning must not lose data on parse error) but the operator gets a
 // signal to investigate.
-func pruneStorageProofTranscripts(ctx sdk.Context, k Keeper, store storetypes.KVStore, prefix []byte, minKeepWindowID uint64) {
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
-
+func (k Keeper) pruneStepStorageProofTranscripts(prefix []byte, minKeepWindowID uint64) pruneStep {
 	// Minimal struct to decode just the epoch_id field; tolerant of unknown fields.
 	type epochProbe struct {
 		EpochID uint64 `json:"epoch_id"`
 	}
 
-	var toDelete [][]byte
-	for ; it.Valid(); it.Next() {
-		var rec epochProbe
-		if err := json.Unmarshal(it.Value(), &rec); err != nil {
-			// Malformed record — leave in place; pruning must not lose data on parse error.
-			// Per NEW-C-4/NEW-A-19 — surface as warning so silent corruption is observable.
-			k.Logger().Error(
-				"audit: pruneStorageProofTranscripts skipped malformed record",
-				"prefix", string(prefix),
-				"key", it.Key(),
-				"err", err,
-			)
-			continue
-		}
-		if rec.EpochID >= minKeepWindowID {
-			continue
-		}
-		kc := make([]byte, len(it.Key()))
-		copy(kc, it.Key())
-		toDelete = append(toDelete, kc)
+	return pruneStep{
+		prefix: prefix,
+		match: func(key, value []byte) (bool, bool) {
+			var rec epochProbe
+			if err := json.Unmarshal(value, &rec); err != nil {
+				// Malformed record — leave in place; pruning must not lose data on parse error.
+				// Per NEW-C-4/NEW-A-19 — surface as warning so silent corruption is observable.
+				k.Logger().Error(
+					"audit: pruneStorageProofTranscripts skipped malformed record",
+					"prefix", string(prefix),
+					"key", key,
+					"err", err,
+				)
+				return false, false
+			}
+			return rec.EpochID < minKeepWindowID, false
+		},
 	}
+}
 
-	for _, k := range toDelete {
-		store.Delete(k)
+// pruneStepInactiveTicketDeteriorationStates prunes ticket deterioration states that are old,
+// zero-score and without an active heal-op. ok is false when nothing can be pruned yet.
+func (k Keeper) pruneStepInactiveTicketDeteriorationStates(currentEpochID, keepLastEpochEntries uint64) (pruneStep, bool) {
+	if keepLastEpochEntries == 0 || currentEpochID <= keepLastEpochEntries {
+		return pruneStep{}, false
 	}
+	pruneBeforeEpoch := currentEpochID - keepLastEpochEntries
+
+	return pruneStep{
+		prefix: types.TicketDeteriorationStatePrefix(),
+		match: func(_, value []byte) (bool, bool) {
+			var state types.TicketDeteriorationState
+			k.cdc.MustUnmarshal(value, &state)
+
+			// Strictly match Zee's final-gate criterion:
+			// LastUpdatedEpoch + KeepLastEpochEntries < currentEpochID.
+			// Written as LastUpdatedEpoch < currentEpochID-KeepLastEpochEntries
+			// to avoid uint64 overflow.
+			if state.LastUpdatedEpoch >= pruneBeforeEpoch {
+				return false, false
+			}
+			return state.DeteriorationScore == 0 && state.ActiveHealOpId == 0, false
+		},
+	}, true
 }
 
-// pruneTerminalHealOps deletes heal-ops that have reached a terminal status
+// pruneStepTerminalHealOps deletes heal-ops that have reached a terminal status
 // (VERIFIED, FAILED, EXPIRED) and whose scheduled epoch is old enough to be
 // outside the keep window. All associated index entries are also removed.
 // Per 120-F3 — terminal heal-ops pruned to bound chain state growth.
-func (k Keeper) pruneTerminalHealOps(ctx sdk.Context, currentEpochID, keepLastEpochEntries uint64) error {
-	store := k.kvStore(ctx)
-	prefix := types.HealOpPrefix()
-	it := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
-	defer func() { _ = it.Close() }()
-
-	var toDelete []types.HealOp
-	for ; it.Valid(); it.Next() {
-		var healOp types.HealOp
-		k.cdc.MustUnmarshal(it.Value(), &healOp)
-		if !isHealOpFinalStatus(healOp.Status) {
-			continue
-		}
-		cutoffEpoch := healOp.ScheduledEpochId + keepLastEpochEntries
-		if cutoffEpoch >= currentEpochID {
-			continue
-		}
-		toDelete = append(toDelete, healOp)
-	}
-
-	for _, healOp := range toDelete {
-		store.Delete(types.HealOpKey(healOp.HealOpId))
-		store.Delete(types.HealOpByTicketIndexKey(healOp.TicketId, healOp.HealOpId))
-		store.Delete(types.HealOpByStatusIndexKey(healOp.Status, healOp.HealOpId))
-
-		// Remove all verification sub-keys for this heal op.
-		verPrefix := types.HealOpVerificationPrefix(healOp.HealOpId)
-		vit := store.Iterator(verPrefix, storetypes.PrefixEndBytes(verPrefix))
-		var verKeys [][]byte
-		for ; vit.Valid(); vit.Next() {
-			kc := make([]byte, len(vit.Key()))
-			copy(kc, vit.Key())
-			verKeys = append(verKeys, kc)
-		}
-		_ = vit.Close()
-		for _, vk := range verKeys {
-			store.Delete(vk)
-		}
+func (k Keeper) pruneStepTerminalHealOps(currentEpochID, keepLastEpochEntries uint64) pruneStep {
+	return pruneStep{
+		prefix: types.HealOpPrefix(),
+		match: func(_, value []byte) (bool, bool) {
+			var healOp types.HealOp
+			k.cdc.MustUnmarshal(value, &healOp)
+			if !isHealOpFinalStatus(healOp.Status) {
+				return false, false
+			}
+			cutoffEpoch := healOp.ScheduledEpochId + keepLastEpochEntries
+			return cutoffEpoch < currentEpochID, false
+		},
+		delete: func(store storetypes.KVStore, _, value []byte) {
+			var healOp types.HealOp
+			k.cdc.MustUnmarshal(value, &healOp)
+
+			store.Delete(types.HealOpKey(healOp.HealOpId))
+			store.Delete(types.HealOpByTicketIndexKey(healOp.TicketId, healOp.HealOpId))
+			store.Delete(types.HealOpByStatusIndexKey(healOp.Status, healOp.HealOpId))
+
+			// Remove all verification sub-keys for this heal op.
+			verPrefix := types.HealOpVerificationPrefix(healOp.HealOpId)
+			vit := store.Iterator(verPrefix, storetypes.PrefixEndBytes(verPrefix))
+			var verKeys [][]byte
+			for ; vit.Valid(); vit.Next() {
+				kc := make([]byte, len(vit.Key()))
+				copy(kc, vit.Key())
+				verKeys = append(verKeys, kc)
+			}
+			_ = vit.Close()
+			for _, vk := range verKeys {
+				store.Delete(vk)
+			}
+		},
 	}
-	return nil
 }
@@ -66,10 +66,23 @@ func (q queryServer) AssignedTargets(ctx context.Context, req *types.QueryAssign
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	targetEndpoints := make([]types.TargetProbeEndpoints, 0, len(targets))
+	for _, target := range targets {
+		probes, _, err := q.k.probeEndpointsForTarget(sdkCtx, target, assignParams.RequiredOpenPorts)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		targetEndpoints = append(targetEndpoints, types.TargetProbeEndpoints{
+			TargetSupernodeAccount: target,
+			Endpoints:              probes,
+		})
+	}
+
 	return &types.QueryAssignedTargetsResponse{
 		EpochId:                 epochID,
 		EpochStartHeight:        epochStart,
 		RequiredOpenPorts:       append([]uint32(nil), assignParams.RequiredOpenPorts...),
 		TargetSupernodeAccounts: targets,
+		TargetEndpoints:         targetEndpoints,
 	}, nil
 }
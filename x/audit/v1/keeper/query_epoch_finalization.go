@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+func (q queryServer) EpochFinalizationProgress(ctx context.Context, req *types.QueryEpochFinalizationProgressRequest) (*types.QueryEpochFinalizationProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	progress, found := q.k.GetEpochFinalizationProgress(sdkCtx)
+	return &types.QueryEpochFinalizationProgressResponse{
+		InProgress: found && progress.Phase != types.EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE,
+		Found:      found,
+		Progress:   progress,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+func (q queryServer) EvidenceDispute(ctx context.Context, req *types.QueryEvidenceDisputeRequest) (*types.QueryEvidenceDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	dispute, found := q.k.GetEvidenceDispute(sdkCtx, req.DisputeId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "evidence dispute not found")
+	}
+
+	return &types.QueryEvidenceDisputeResponse{Dispute: dispute}, nil
+}
+
+func (q queryServer) EvidenceDisputeByEvidence(ctx context.Context, req *types.QueryEvidenceDisputeByEvidenceRequest) (*types.QueryEvidenceDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	dispute, found := q.k.GetEvidenceDisputeByEvidence(sdkCtx, req.EvidenceId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "evidence dispute not found")
+	}
+
+	return &types.QueryEvidenceDisputeResponse{Dispute: dispute}, nil
+}
@@ -66,6 +66,7 @@ func (q queryServer) StorageChallengeReports(ctx context.Context, req *types.Que
 		}
 
 		var portStates []types.PortState
+		var endpointObservations []types.EndpointObservation
 		for _, obs := range r.StorageChallengeObservations {
 			if obs == nil {
 				continue
@@ -76,6 +77,11 @@ func (q queryServer) StorageChallengeReports(ctx context.Context, req *types.Que
 			for _, ps := range obs.PortStates {
 				portStates = append(portStates, ps)
 			}
+			for _, eo := range obs.EndpointObservations {
+				if eo != nil {
+					endpointObservations = append(endpointObservations, *eo)
+				}
+			}
 			break
 		}
 		if len(portStates) == 0 {
@@ -87,6 +93,7 @@ func (q queryServer) StorageChallengeReports(ctx context.Context, req *types.Que
 			EpochId:                  r.EpochId,
 			ReportHeight:             r.ReportHeight,
 			PortStates:               portStates,
+			EndpointObservations:     endpointObservations,
 		})
 		return nil
 	})
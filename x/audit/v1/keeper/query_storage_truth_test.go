@@ -147,6 +147,7 @@ func TestStorageTruthQueries_ReflectScoredReportIngestion(t *testing.T) {
 		GetSuperNodeByAccount(gomock.Any(), reporter).
 		Return(sntypes.SuperNode{}, true, nil).
 		AnyTimes()
+	expectTargetsWithoutEndpoints(f)
 
 	seedEpochAnchorForReportTest(t, f, 0, []string{reporter, target}, []string{reporter, target})
 
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// reportSlashableOffense forwards a confirmed audit outcome to the supernode
+// module's slashing pipeline. Failures are logged rather than returned so a
+// slashing problem never blocks epoch-end processing.
+func (k Keeper) reportSlashableOffense(ctx sdk.Context, sn sntypes.SuperNode, offense sntypes.SlashOffense, evidenceRef string) {
+	valAddr, err := sdk.ValAddressFromBech32(sn.ValidatorAddress)
+	if err != nil {
+		k.Logger().Error("cannot report slashable offense: invalid validator address",
+			"supernode", sn.SupernodeAccount, "offense", offense.String(), "err", err)
+		return
+	}
+	if _, err := k.supernodeKeeper.ReportSlashableOffense(ctx, valAddr, offense, evidenceRef); err != nil {
+		k.Logger().Error("failed to report slashable offense",
+			"supernode", sn.SupernodeAccount, "offense", offense.String(), "evidence_ref", evidenceRef, "err", err)
+	}
+}
+
+// reportSlashableOffenseByAccount is reportSlashableOffense for callers that
+// only know the supernode account.
+func (k Keeper) reportSlashableOffenseByAccount(ctx sdk.Context, supernodeAccount string, offense sntypes.SlashOffense, evidenceRef string) {
+	sn, found, err := k.supernodeKeeper.GetSuperNodeByAccount(ctx, supernodeAccount)
+	if err != nil || !found {
+		k.Logger().Error("cannot report slashable offense: supernode not found",
+			"supernode", supernodeAccount, "offense", offense.String(), "err", err)
+		return
+	}
+	k.reportSlashableOffense(ctx, sn, offense, evidenceRef)
+}
+
+func epochEvidenceRef(epochID uint64) string {
+	return "epoch:" + strconv.FormatUint(epochID, 10)
+}
+
+func healOpEvidenceRef(healOpID uint64) string {
+	return "heal_op:" + strconv.FormatUint(healOpID, 10)
+}
+
+// slashesStorageTruthOffenses reports whether storage-truth outcomes are
+// forwarded to the slashing pipeline. Only FULL enforcement slashes.
+func slashesStorageTruthOffenses(params types.Params) bool {
+	return params.StorageTruthEnforcementMode == types.StorageTruthEnforcementMode_STORAGE_TRUTH_ENFORCEMENT_MODE_FULL
+}
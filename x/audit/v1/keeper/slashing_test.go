@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func TestEnforceEpochEnd_ReportsActionFinalizationSignatureFailureOffense(t *testing.T) {
+	f := initFixture(t)
+	sn, _, valAddr := makeActiveSupernode(t)
+
+	params := types.DefaultParams().WithDefaults()
+	keeper.SetEvidenceEpochCountForTest(f.keeper, f.ctx, 2, sn.SupernodeAccount,
+		types.EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_SIGNATURE_FAILURE, uint64(params.ActionFinalizationSignatureFailureEvidencesPerEpoch))
+
+	f.supernodeKeeper.EXPECT().
+		GetAllSuperNodes(gomock.AssignableToTypeOf(f.ctx), sntypes.SuperNodeStateActive).
+		Return([]sntypes.SuperNode{sn}, nil)
+	f.supernodeKeeper.EXPECT().
+		GetAllSuperNodes(gomock.AssignableToTypeOf(f.ctx), sntypes.SuperNodeStatePostponed).
+		Return([]sntypes.SuperNode{}, nil)
+	f.supernodeKeeper.EXPECT().
+		SetSuperNodePostponed(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(valAddr), "audit_action_finalization_signature_failure").
+		Return(nil).Times(1)
+	f.supernodeKeeper.EXPECT().
+		ReportSlashableOffense(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(valAddr), sntypes.SlashOffense_SLASH_OFFENSE_ACTION_FINALIZATION_SIGNATURE_FAILURE, "epoch:2").
+		Return(uint64(1), nil).Times(1)
+
+	require.NoError(t, f.keeper.EnforceEpochEnd(f.ctx, 2, params))
+}
+
+func TestExpireHealOps_ReportsHealerOffenseInFullMode(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		mode    types.StorageTruthEnforcementMode
+		reports bool
+	}{
+		{name: "soft", mode: types.StorageTruthEnforcementMode_STORAGE_TRUTH_ENFORCEMENT_MODE_SOFT},
+		{name: "full", mode: types.StorageTruthEnforcementMode_STORAGE_TRUTH_ENFORCEMENT_MODE_FULL, reports: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			f.ctx = f.ctx.WithBlockHeight(1600).WithEventManager(sdk.NewEventManager())
+			healer, _, valAddr := makeActiveSupernode(t)
+
+			params := f.keeper.GetParams(f.ctx).WithDefaults()
+			params.StorageTruthEnforcementMode = tc.mode
+			params.StorageTruthMaxSelfHealOpsPerEpoch = 0 // expire-only
+			require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+			require.NoError(t, f.keeper.SetHealOp(f.ctx, types.HealOp{
+				HealOpId:               900,
+				TicketId:               "ticket-slash",
+				ScheduledEpochId:       1,
+				HealerSupernodeAccount: healer.SupernodeAccount,
+				Status:                 types.HealOpStatus_HEAL_OP_STATUS_SCHEDULED,
+				DeadlineEpochId:        3,
+			}))
+
+			if tc.reports {
+				f.supernodeKeeper.EXPECT().
+					GetSuperNodeByAccount(gomock.AssignableToTypeOf(f.ctx), healer.SupernodeAccount).
+					Return(healer, true, nil)
+				f.supernodeKeeper.EXPECT().
+					ReportSlashableOffense(gomock.AssignableToTypeOf(f.ctx), sdk.ValAddress(valAddr), sntypes.SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE, "heal_op:900").
+					Return(uint64(1), nil).Times(1)
+			}
+
+			require.NoError(t, f.keeper.ProcessStorageTruthHealOpsAtEpochEnd(f.ctx, 3, params))
+
+			expired, found := f.keeper.GetHealOp(f.ctx, 900)
+			require.True(t, found)
+			require.Equal(t, types.HealOpStatus_HEAL_OP_STATUS_EXPIRED, expired.Status)
+		})
+	}
+}
@@ -19,11 +19,6 @@ import (
 // All ratio comparisons use integer cross-multiplication to eliminate float64
 // non-determinism across validators (121-F16).
 func (k Keeper) ApplyReporterDivergenceAtEpochEnd(ctx sdk.Context, epochID uint64, params types.Params) error {
-	minReports := params.StorageTruthReporterMinReportsForDivergence
-	if minReports == 0 {
-		minReports = 5
-	}
-
 	states, err := k.GetAllReporterReliabilityStates(ctx)
 	if err != nil {
 		return err
@@ -32,31 +27,63 @@ func (k Keeper) ApplyReporterDivergenceAtEpochEnd(ctx sdk.Context, epochID uint6
 		return nil
 	}
 
-	type reporterEntry struct {
-		account            string
-		negative           uint64
-		total              uint64
-		confirmedNegatives uint64
-	}
-
-	qualifying := make([]reporterEntry, 0, len(states))
-	startEpoch := storageTruthWindowStart(epochID, uint64(params.StorageTruthDivergenceWindowEpochs))
+	qualifying := make([]reporterDivergenceEntry, 0, len(states))
 	for _, state := range states {
-		stats, err := k.storageTruthReporterDivergenceStats(ctx, state.ReporterSupernodeAccount, startEpoch, epochID)
+		entry, ok, err := k.reporterDivergenceEntryAtEpochEnd(ctx, state.ReporterSupernodeAccount, epochID, params)
 		if err != nil {
 			return err
 		}
-		if stats.total < uint64(minReports) {
-			continue
+		if ok {
+			qualifying = append(qualifying, entry)
 		}
-		qualifying = append(qualifying, reporterEntry{
-			account:            state.ReporterSupernodeAccount,
-			negative:           stats.negative,
-			total:              stats.total,
-			confirmedNegatives: stats.confirmedNegative,
-		})
 	}
 
+	for _, outlier := range rankReporterDivergenceOutliers(qualifying) {
+		if err := k.applyReporterDivergencePenalty(ctx, outlier, epochID, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reporterDivergenceEntry is a reporter's negative-result rate over the divergence window.
+// Median fields are set once the reporter has been ranked as an outlier.
+type reporterDivergenceEntry struct {
+	Account            string `json:"account"`
+	Negative           uint64 `json:"negative"`
+	Total              uint64 `json:"total"`
+	ConfirmedNegatives uint64 `json:"confirmed_negatives"`
+	MedianNegative     uint64 `json:"median_negative,omitempty"`
+	MedianTotal        uint64 `json:"median_total,omitempty"`
+}
+
+// reporterDivergenceEntryAtEpochEnd returns the divergence entry of a reporter and whether the
+// reporter has enough volume in the rolling window to be ranked.
+func (k Keeper) reporterDivergenceEntryAtEpochEnd(ctx sdk.Context, reporterAccount string, epochID uint64, params types.Params) (reporterDivergenceEntry, bool, error) {
+	minReports := params.StorageTruthReporterMinReportsForDivergence
+	if minReports == 0 {
+		minReports = 5
+	}
+
+	startEpoch := storageTruthWindowStart(epochID, uint64(params.StorageTruthDivergenceWindowEpochs))
+	stats, err := k.storageTruthReporterDivergenceStats(ctx, reporterAccount, startEpoch, epochID)
+	if err != nil {
+		return reporterDivergenceEntry{}, false, err
+	}
+	if stats.total < uint64(minReports) {
+		return reporterDivergenceEntry{}, false, nil
+	}
+	return reporterDivergenceEntry{
+		Account:            reporterAccount,
+		Negative:           stats.negative,
+		Total:              stats.total,
+		ConfirmedNegatives: stats.confirmedNegative,
+	}, true, nil
+}
+
+// rankReporterDivergenceOutliers returns, in ranking order, the qualifying reporters whose
+// negative rate exceeds 2x the network median and that are not mostly confirmed by recheck.
+func rankReporterDivergenceOutliers(qualifying []reporterDivergenceEntry) []reporterDivergenceEntry {
 	if len(qualifying) == 0 {
 		return nil
 	}
@@ -66,8 +93,8 @@ func (k Keeper) ApplyReporterDivergenceAtEpochEnd(ctx sdk.Context, epochID uint6
 	// or exceed 2^32 (CP-NEW-A-13).
 	// a.negative/a.total < b.negative/b.total  ⟺  a.negative*b.total < b.negative*a.total
 	sort.Slice(qualifying, func(i, j int) bool {
-		lhs := new(big.Int).Mul(new(big.Int).SetUint64(qualifying[i].negative), new(big.Int).SetUint64(qualifying[j].total))
-		rhs := new(big.Int).Mul(new(big.Int).SetUint64(qualifying[j].negative), new(big.Int).SetUint64(qualifying[i].total))
+		lhs := new(big.Int).Mul(new(big.Int).SetUint64(qualifying[i].Negative), new(big.Int).SetUint64(qualifying[j].Total))
+		rhs := new(big.Int).Mul(new(big.Int).SetUint64(qualifying[j].Negative), new(big.Int).SetUint64(qualifying[i].Total))
 		return lhs.Cmp(rhs) < 0
 	})
 
@@ -77,8 +104,8 @@ func (k Keeper) ApplyReporterDivergenceAtEpochEnd(ctx sdk.Context, epochID uint6
 	// as a divergence outlier. For odd-length slices index `mid` is the unique median;
 	// for even-length slices index `mid` is the upper of the two middle elements.
 	mid := len(qualifying) / 2
-	medianNeg := qualifying[mid].negative
-	medianTotal := qualifying[mid].total
+	medianNeg := qualifying[mid].Negative
+	medianTotal := qualifying[mid].Total
 
 	if medianTotal == 0 {
 		return nil
@@ -90,45 +117,51 @@ func (k Keeper) ApplyReporterDivergenceAtEpochEnd(ctx sdk.Context, epochID uint6
 	// *big.Int cross-multiply protects against uint64 overflow (CP-NEW-A-13).
 	bigMedianTotal := new(big.Int).SetUint64(medianTotal)
 	bigMedianNegX2 := new(big.Int).Mul(new(big.Int).SetUint64(medianNeg), big.NewInt(2))
+	var outliers []reporterDivergenceEntry
 	for _, entry := range qualifying {
-		if entry.total == 0 {
+		if entry.Total == 0 {
 			continue
 		}
-		lhs := new(big.Int).Mul(new(big.Int).SetUint64(entry.negative), bigMedianTotal)
-		rhs := new(big.Int).Mul(bigMedianNegX2, new(big.Int).SetUint64(entry.total))
+		lhs := new(big.Int).Mul(new(big.Int).SetUint64(entry.Negative), bigMedianTotal)
+		rhs := new(big.Int).Mul(bigMedianNegX2, new(big.Int).SetUint64(entry.Total))
 		if lhs.Cmp(rhs) <= 0 {
 			continue
 		}
-		if entry.negative != 0 && entry.confirmedNegatives*2 >= entry.negative {
+		if entry.Negative != 0 && entry.ConfirmedNegatives*2 >= entry.Negative {
 			continue
 		}
+		entry.MedianNegative = medianNeg
+		entry.MedianTotal = medianTotal
+		outliers = append(outliers, entry)
+	}
+	return outliers
+}
 
-		// Apply +8 divergence penalty.
-		if _, _, err := k.applyReporterReliabilityDelta(
-			ctx,
-			epochID,
-			entry.account,
-			8,
-			params.StorageTruthReporterReliabilityDecayPerEpoch,
-			0,
-			params,
-		); err != nil {
-			return err
-		}
-
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeStorageTruthScoreUpdated,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
-			sdk.NewAttribute(types.AttributeKeyReporterSupernodeAccount, entry.account),
-			sdk.NewAttribute("divergence_penalty", "8"),
-			sdk.NewAttribute("reporter_neg_count", strconv.FormatUint(entry.negative, 10)),
-			sdk.NewAttribute("reporter_total_count", strconv.FormatUint(entry.total, 10)),
-			sdk.NewAttribute("median_neg_count", strconv.FormatUint(medianNeg, 10)),
-			sdk.NewAttribute("median_total_count", strconv.FormatUint(medianTotal, 10)),
-		))
+// applyReporterDivergencePenalty applies the +8 divergence penalty to a ranked outlier.
+func (k Keeper) applyReporterDivergencePenalty(ctx sdk.Context, entry reporterDivergenceEntry, epochID uint64, params types.Params) error {
+	if _, _, err := k.applyReporterReliabilityDelta(
+		ctx,
+		epochID,
+		entry.Account,
+		8,
+		params.StorageTruthReporterReliabilityDecayPerEpoch,
+		0,
+		params,
+	); err != nil {
+		return err
 	}
 
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStorageTruthScoreUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
+		sdk.NewAttribute(types.AttributeKeyReporterSupernodeAccount, entry.Account),
+		sdk.NewAttribute("divergence_penalty", "8"),
+		sdk.NewAttribute("reporter_neg_count", strconv.FormatUint(entry.Negative, 10)),
+		sdk.NewAttribute("reporter_total_count", strconv.FormatUint(entry.Total, 10)),
+		sdk.NewAttribute("median_neg_count", strconv.FormatUint(entry.MedianNegative, 10)),
+		sdk.NewAttribute("median_total_count", strconv.FormatUint(entry.MedianTotal, 10)),
+	))
 	return nil
 }
 
@@ -148,11 +181,24 @@ type storageTruthDivergenceStats struct {
 // The PASS-count threshold is intentionally hardcoded to 5 (Pitfall #13 —
 // const-now/param-later); promote to a Param if governance ever needs tuning.
 func (k Keeper) ApplyReporterCleanEpochRecoveryAtEpochEnd(ctx sdk.Context, epochID uint64, params types.Params) error {
-	const cleanPassesRequired = 5
+	reporters, err := k.reporterCleanRecoveryAccounts(ctx, epochID)
+	if err != nil {
+		return err
+	}
+	for _, reporterAccount := range reporters {
+		if err := k.applyReporterCleanEpochRecovery(ctx, reporterAccount, epochID, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// reporterCleanRecoveryAccounts returns the sorted set of reporters considered for the per-epoch
+// clean recovery: every tracked reporter plus every reporter of the epoch.
+func (k Keeper) reporterCleanRecoveryAccounts(ctx sdk.Context, epochID uint64) ([]string, error) {
 	states, err := k.GetAllReporterReliabilityStates(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	reporterSet := make(map[string]struct{}, len(states))
 	for _, state := range states {
@@ -162,50 +208,52 @@ func (k Keeper) ApplyReporterCleanEpochRecoveryAtEpochEnd(ctx sdk.Context, epoch
 	}
 	epochReporters, err := k.storageTruthReporterAccountsForEpoch(ctx, epochID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	for _, reporter := range epochReporters {
 		reporterSet[reporter] = struct{}{}
 	}
-	if len(reporterSet) == 0 {
-		return nil
-	}
 	reporters := make([]string, 0, len(reporterSet))
 	for reporter := range reporterSet {
 		reporters = append(reporters, reporter)
 	}
 	sort.Strings(reporters)
+	return reporters, nil
+}
 
-	for _, reporterAccount := range reporters {
-		passes, overturned, err := k.storageTruthReporterEpochPassStats(ctx, reporterAccount, epochID)
-		if err != nil {
-			return err
-		}
-		if overturned || passes < cleanPassesRequired {
-			continue
-		}
+// applyReporterCleanEpochRecovery applies the per-epoch -4 recovery to a single reporter if it
+// qualifies.
+func (k Keeper) applyReporterCleanEpochRecovery(ctx sdk.Context, reporterAccount string, epochID uint64, params types.Params) error {
+	const cleanPassesRequired = 5
 
-		if _, _, err := k.applyReporterReliabilityDelta(
-			ctx,
-			epochID,
-			reporterAccount,
-			-4,
-			params.StorageTruthReporterReliabilityDecayPerEpoch,
-			0,
-			params,
-		); err != nil {
-			return err
-		}
+	passes, overturned, err := k.storageTruthReporterEpochPassStats(ctx, reporterAccount, epochID)
+	if err != nil {
+		return err
+	}
+	if overturned || passes < cleanPassesRequired {
+		return nil
+	}
 
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeStorageTruthScoreUpdated,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
-			sdk.NewAttribute(types.AttributeKeyReporterSupernodeAccount, reporterAccount),
-			sdk.NewAttribute("clean_epoch_recovery_delta", "-4"),
-			sdk.NewAttribute("epoch_pass_count", strconv.FormatUint(passes, 10)),
-		))
+	if _, _, err := k.applyReporterReliabilityDelta(
+		ctx,
+		epochID,
+		reporterAccount,
+		-4,
+		params.StorageTruthReporterReliabilityDecayPerEpoch,
+		0,
+		params,
+	); err != nil {
+		return err
 	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStorageTruthScoreUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
+		sdk.NewAttribute(types.AttributeKeyReporterSupernodeAccount, reporterAccount),
+		sdk.NewAttribute("clean_epoch_recovery_delta", "-4"),
+		sdk.NewAttribute("epoch_pass_count", strconv.FormatUint(passes, 10)),
+	))
 	return nil
 }
 
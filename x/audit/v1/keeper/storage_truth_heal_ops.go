@@ -26,71 +26,116 @@ func (k Keeper) expireStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID uin
 	}
 
 	for _, healOp := range healOps {
-		if isHealOpFinalStatus(healOp.Status) {
-			continue
-		}
-		if healOp.DeadlineEpochId == 0 || healOp.DeadlineEpochId > epochID {
-			continue
+		if err := k.expireStorageTruthHealOpAtEpochEnd(ctx, healOp, epochID, params); err != nil {
+			return err
 		}
+	}
 
-		healOp.Status = types.HealOpStatus_HEAL_OP_STATUS_EXPIRED
-		healOp.UpdatedHeight = uint64(ctx.BlockHeight())
-		if err := k.SetHealOp(ctx, healOp); err != nil {
+	return nil
+}
+
+// expireStorageTruthHealOpAtEpochEnd expires a non-final heal op whose deadline epoch has been
+// reached.
+func (k Keeper) expireStorageTruthHealOpAtEpochEnd(ctx sdk.Context, healOp types.HealOp, epochID uint64, params types.Params) error {
+	if isHealOpFinalStatus(healOp.Status) {
+		return nil
+	}
+	if healOp.DeadlineEpochId == 0 || healOp.DeadlineEpochId > epochID {
+		return nil
+	}
+
+	healOp.Status = types.HealOpStatus_HEAL_OP_STATUS_EXPIRED
+	healOp.UpdatedHeight = uint64(ctx.BlockHeight())
+	if err := k.SetHealOp(ctx, healOp); err != nil {
+		return err
+	}
+
+	ticketState, found := k.GetTicketDeteriorationState(ctx, healOp.TicketId)
+	if found {
+		// NEW-B-1 — apply §20 no-show cooldown to EXPIRED heal-op (mirror FAILED branch).
+		ticketState.DeteriorationScore = addInt64Saturated(ticketState.DeteriorationScore, 15)
+		cooldownUntil := epochID + uint64(params.StorageTruthProbationEpochs)
+		if ticketState.ProbationUntilEpoch < cooldownUntil {
+			ticketState.ProbationUntilEpoch = cooldownUntil
+		}
+		if ticketState.ActiveHealOpId == healOp.HealOpId {
+			ticketState.ActiveHealOpId = 0
+		}
+		if err := k.SetTicketDeteriorationState(ctx, ticketState); err != nil {
 			return err
 		}
-
-		ticketState, found := k.GetTicketDeteriorationState(ctx, healOp.TicketId)
-		if found {
-			// NEW-B-1 — apply §20 no-show cooldown to EXPIRED heal-op (mirror FAILED branch).
-			ticketState.DeteriorationScore = addInt64Saturated(ticketState.DeteriorationScore, 15)
-			cooldownUntil := epochID + uint64(params.StorageTruthProbationEpochs)
-			if ticketState.ProbationUntilEpoch < cooldownUntil {
-				ticketState.ProbationUntilEpoch = cooldownUntil
-			}
-			if ticketState.ActiveHealOpId == healOp.HealOpId {
-				ticketState.ActiveHealOpId = 0
-			}
-			if err := k.SetTicketDeteriorationState(ctx, ticketState); err != nil {
-				return err
-			}
-			if err := k.setStorageTruthFailedHeal(ctx, healOp.HealerSupernodeAccount, epochID, healOp.TicketId); err != nil {
-				return err
-			}
+		if err := k.setStorageTruthFailedHeal(ctx, healOp.HealerSupernodeAccount, epochID, healOp.TicketId); err != nil {
+			return err
 		}
-
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeHealOpExpired,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
-				sdk.NewAttribute(types.AttributeKeyHealOpID, strconv.FormatUint(healOp.HealOpId, 10)),
-				sdk.NewAttribute(types.AttributeKeyTicketID, healOp.TicketId),
-			),
-		)
+	}
+	if slashesStorageTruthOffenses(params) && healOp.HealerSupernodeAccount != "" {
+		k.reportSlashableOffenseByAccount(ctx, healOp.HealerSupernodeAccount, sntypes.SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE, healOpEvidenceRef(healOp.HealOpId))
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHealOpExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
+			sdk.NewAttribute(types.AttributeKeyHealOpID, strconv.FormatUint(healOp.HealOpId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTicketID, healOp.TicketId),
+		),
+	)
 	return nil
 }
 
 func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID uint64, params types.Params) error {
+	activeAccounts, enabled, err := k.storageTruthHealSchedulingAccounts(ctx, epochID, params)
+	if err != nil || !enabled {
+		return err
+	}
+
+	nonFinalByID, openByTicket, err := k.storageTruthOpenHealOps(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticketStates, err := k.GetAllTicketDeteriorationStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]storageTruthHealCandidate, 0, len(ticketStates))
+	for _, state := range ticketStates {
+		cand, ok, err := k.storageTruthHealCandidateAtEpochEnd(ctx, state, epochID, params, nonFinalByID, openByTicket)
+		if err != nil {
+			return err
+		}
+		if ok {
+			candidates = append(candidates, cand)
+		}
+	}
+
+	return k.scheduleStorageTruthHealCandidates(ctx, candidates, activeAccounts, epochID, params)
+}
+
+// storageTruthHealSchedulingAccounts returns the healer pool for heal-op scheduling, and whether
+// scheduling runs at all this epoch.
+func (k Keeper) storageTruthHealSchedulingAccounts(ctx sdk.Context, epochID uint64, params types.Params) ([]string, bool, error) {
 	if params.StorageTruthEnforcementMode == types.StorageTruthEnforcementMode_STORAGE_TRUTH_ENFORCEMENT_MODE_UNSPECIFIED {
-		return nil
+		return nil, false, nil
 	}
 	if params.StorageTruthMaxSelfHealOpsPerEpoch == 0 {
-		return nil
+		return nil, false, nil
 	}
 
 	activeAccounts, err := k.storageTruthSchedulerAccounts(ctx, epochID)
 	if err != nil {
-		return err
-	}
-	if len(activeAccounts) == 0 {
-		return nil
+		return nil, false, err
 	}
+	return activeAccounts, len(activeAccounts) > 0, nil
+}
 
+// storageTruthOpenHealOps indexes non-final heal ops by id and by ticket.
+func (k Keeper) storageTruthOpenHealOps(ctx sdk.Context) (map[uint64]types.HealOp, map[string]types.HealOp, error) {
 	healOps, err := k.GetAllHealOps(ctx)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	nonFinalByID := make(map[uint64]types.HealOp, len(healOps))
 	openByTicket := make(map[string]types.HealOp, len(healOps))
@@ -101,12 +146,76 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 		nonFinalByID[healOp.HealOpId] = healOp
 		openByTicket[healOp.TicketId] = healOp
 	}
+	return nonFinalByID, openByTicket, nil
+}
 
-	ticketStates, err := k.GetAllTicketDeteriorationStates(ctx)
-	if err != nil {
-		return err
+// storageTruthHealCandidate is a ticket eligible for a new heal op.
+type storageTruthHealCandidate struct {
+	TicketID                   string `json:"ticket_id"`
+	Score                      int64  `json:"score"`
+	HasIndexFailure            bool   `json:"has_index_failure"`
+	DistinctHolderFailureCount uint32 `json:"distinct_holder_failure_count"`
+	LastFailureEpoch           uint64 `json:"last_failure_epoch"`
+	LastTargetSupernodeAccount string `json:"last_target_supernode_account"`
+}
+
+// storageTruthHealCandidateAtEpochEnd returns the heal candidate for a ticket, if the ticket is
+// eligible. Stale active heal-op pointers are cleared on the way.
+func (k Keeper) storageTruthHealCandidateAtEpochEnd(
+	ctx sdk.Context,
+	state types.TicketDeteriorationState,
+	epochID uint64,
+	params types.Params,
+	nonFinalByID map[uint64]types.HealOp,
+	openByTicket map[string]types.HealOp,
+) (storageTruthHealCandidate, bool, error) {
+	if state.TicketId == "" {
+		return storageTruthHealCandidate{}, false, nil
+	}
+	if state.DeteriorationScore < params.StorageTruthTicketDeteriorationHealThreshold {
+		return storageTruthHealCandidate{}, false, nil
+	}
+	if state.ProbationUntilEpoch > epochID {
+		return storageTruthHealCandidate{}, false, nil
+	}
+
+	if state.ActiveHealOpId != 0 {
+		if activeOp, found := nonFinalByID[state.ActiveHealOpId]; found {
+			openByTicket[state.TicketId] = activeOp
+			return storageTruthHealCandidate{}, false, nil
+		}
+		// Clear stale pointer to a non-existing/finalized op to keep state self-consistent.
+		state.ActiveHealOpId = 0
+		if err := k.SetTicketDeteriorationState(ctx, state); err != nil {
+			return storageTruthHealCandidate{}, false, err
+		}
+	}
+
+	if _, hasOpen := openByTicket[state.TicketId]; hasOpen {
+		return storageTruthHealCandidate{}, false, nil
+	}
+
+	// Eligibility predicate: must have holder diversity, index failure, or repeated failures.
+	isHealEligible := (state.DistinctHolderFailureCount >= 2) ||
+		(state.LastIndexFailureEpoch > 0) ||
+		(state.RecentFailureEpochCount >= 2)
+	if !isHealEligible {
+		return storageTruthHealCandidate{}, false, nil
 	}
 
+	return storageTruthHealCandidate{
+		TicketID:                   state.TicketId,
+		Score:                      state.DeteriorationScore,
+		HasIndexFailure:            state.LastIndexFailureEpoch > 0,
+		DistinctHolderFailureCount: state.DistinctHolderFailureCount,
+		LastFailureEpoch:           state.LastFailureEpoch,
+		LastTargetSupernodeAccount: state.LastTargetSupernodeAccount,
+	}, true, nil
+}
+
+// scheduleStorageTruthHealCandidates schedules heal ops for the highest-priority candidates, up
+// to StorageTruthMaxSelfHealOpsPerEpoch.
+func (k Keeper) scheduleStorageTruthHealCandidates(ctx sdk.Context, candidates []storageTruthHealCandidate, activeAccounts []string, epochID uint64, params types.Params) error {
 	// Per 121-F11 — exclude failing target, postponed nodes, and recent offenders from healer pool.
 	// Build global ineligible-healer set once.
 	ineligibleHealers := make(map[string]struct{})
@@ -128,80 +237,25 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 		}
 	}
 
-	type candidate struct {
-		ticketID                   string
-		score                      int64
-		hasIndexFailure            bool
-		distinctHolderFailureCount uint32
-		lastFailureEpoch           uint64
-		lastTargetSupernodeAccount string
-	}
-	candidates := make([]candidate, 0, len(ticketStates))
-
-	for _, state := range ticketStates {
-		if state.TicketId == "" {
-			continue
-		}
-		if state.DeteriorationScore < params.StorageTruthTicketDeteriorationHealThreshold {
-			continue
-		}
-		if state.ProbationUntilEpoch > epochID {
-			continue
-		}
-
-		if state.ActiveHealOpId != 0 {
-			if activeOp, found := nonFinalByID[state.ActiveHealOpId]; found {
-				openByTicket[state.TicketId] = activeOp
-				continue
-			}
-			// Clear stale pointer to a non-existing/finalized op to keep state self-consistent.
-			state.ActiveHealOpId = 0
-			if err := k.SetTicketDeteriorationState(ctx, state); err != nil {
-				return err
-			}
-		}
-
-		if _, hasOpen := openByTicket[state.TicketId]; hasOpen {
-			continue
-		}
-
-		// Eligibility predicate: must have holder diversity, index failure, or repeated failures.
-		isHealEligible := (state.DistinctHolderFailureCount >= 2) ||
-			(state.LastIndexFailureEpoch > 0) ||
-			(state.RecentFailureEpochCount >= 2)
-		if !isHealEligible {
-			continue
-		}
-
-		candidates = append(candidates, candidate{
-			ticketID:                   state.TicketId,
-			score:                      state.DeteriorationScore,
-			hasIndexFailure:            state.LastIndexFailureEpoch > 0,
-			distinctHolderFailureCount: state.DistinctHolderFailureCount,
-			lastFailureEpoch:           state.LastFailureEpoch,
-			lastTargetSupernodeAccount: state.LastTargetSupernodeAccount,
-		})
-	}
-
 	// Priority sort per spec:
 	// 1. Score descending
 	// 2. last_index_failure_epoch != 0 (true first)
 	// 3. distinct_holder_failure_count descending
 	// 4. last_failure_epoch ascending (oldest first)
 	sort.Slice(candidates, func(i, j int) bool {
-		if candidates[i].score != candidates[j].score {
-			return candidates[i].score > candidates[j].score
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
 		}
-		if candidates[i].hasIndexFailure != candidates[j].hasIndexFailure {
-			return candidates[i].hasIndexFailure // true first
+		if candidates[i].HasIndexFailure != candidates[j].HasIndexFailure {
+			return candidates[i].HasIndexFailure // true first
 		}
-		if candidates[i].distinctHolderFailureCount != candidates[j].distinctHolderFailureCount {
-			return candidates[i].distinctHolderFailureCount > candidates[j].distinctHolderFailureCount
+		if candidates[i].DistinctHolderFailureCount != candidates[j].DistinctHolderFailureCount {
+			return candidates[i].DistinctHolderFailureCount > candidates[j].DistinctHolderFailureCount
 		}
-		if candidates[i].lastFailureEpoch != candidates[j].lastFailureEpoch {
-			return candidates[i].lastFailureEpoch < candidates[j].lastFailureEpoch // oldest first
+		if candidates[i].LastFailureEpoch != candidates[j].LastFailureEpoch {
+			return candidates[i].LastFailureEpoch < candidates[j].LastFailureEpoch // oldest first
 		}
-		return candidates[i].ticketID < candidates[j].ticketID
+		return candidates[i].TicketID < candidates[j].TicketID
 	})
 
 	scheduled := uint32(0)
@@ -216,7 +270,7 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 			if _, bad := ineligibleHealers[acc]; bad {
 				continue
 			}
-			if acc == cand.lastTargetSupernodeAccount {
+			if acc == cand.LastTargetSupernodeAccount {
 				continue
 			}
 			eligibleHealers = append(eligibleHealers, acc)
@@ -225,13 +279,13 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 			ctx.EventManager().EmitEvent(sdk.NewEvent(
 				types.EventTypeHealOpInsufficientHealers,
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyTicketID, cand.ticketID),
+				sdk.NewAttribute(types.AttributeKeyTicketID, cand.TicketID),
 				sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
 			))
 			continue
 		}
 
-		healer, verifiers := assignStorageTruthHealParticipants(eligibleHealers, cand.ticketID, epochID, params)
+		healer, verifiers := assignStorageTruthHealParticipants(eligibleHealers, cand.TicketID, epochID, params)
 		if len(verifiers) == 0 {
 			// Per NEW-B-4 — sibling-symmetry with InsufficientHealers: emit
 			// observability event when verifier pool is empty so operators can
@@ -239,7 +293,7 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 			ctx.EventManager().EmitEvent(sdk.NewEvent(
 				types.EventTypeHealOpInsufficientVerifiers,
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyTicketID, cand.ticketID),
+				sdk.NewAttribute(types.AttributeKeyTicketID, cand.TicketID),
 				sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
 			))
 			continue
@@ -251,7 +305,7 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 		}
 		healOp := types.HealOp{
 			HealOpId:                  healOpID,
-			TicketId:                  cand.ticketID,
+			TicketId:                  cand.TicketID,
 			ScheduledEpochId:          epochID,
 			HealerSupernodeAccount:    healer,
 			VerifierSupernodeAccounts: verifiers,
@@ -266,9 +320,9 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 		}
 		k.SetNextHealOpID(ctx, healOpID+1)
 
-		ticketState, found := k.GetTicketDeteriorationState(ctx, cand.ticketID)
+		ticketState, found := k.GetTicketDeteriorationState(ctx, cand.TicketID)
 		if !found {
-			return fmt.Errorf("ticket deterioration state not found for ticket %q while scheduling heal op", cand.ticketID)
+			return fmt.Errorf("ticket deterioration state not found for ticket %q while scheduling heal op", cand.TicketID)
 		}
 		ticketState.ActiveHealOpId = healOpID
 		if err := k.SetTicketDeteriorationState(ctx, ticketState); err != nil {
@@ -281,7 +335,7 @@ func (k Keeper) scheduleStorageTruthHealOpsAtEpochEnd(ctx sdk.Context, epochID u
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 				sdk.NewAttribute(types.AttributeKeyEpochID, strconv.FormatUint(epochID, 10)),
 				sdk.NewAttribute(types.AttributeKeyHealOpID, strconv.FormatUint(healOpID, 10)),
-				sdk.NewAttribute(types.AttributeKeyTicketID, cand.ticketID),
+				sdk.NewAttribute(types.AttributeKeyTicketID, cand.TicketID),
 				sdk.NewAttribute(types.AttributeKeyHealerSupernodeAccount, healer),
 				sdk.NewAttribute(types.AttributeKeyDeadlineEpochID, strconv.FormatUint(healOp.DeadlineEpochId, 10)),
 			),
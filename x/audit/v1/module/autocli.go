@@ -35,6 +35,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "List evidence records by action id",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "action_id"}},
 				},
+				{
+					RpcMethod:      "EvidenceDispute",
+					Use:            "evidence-dispute [dispute-id]",
+					Short:          "Query an evidence dispute by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}},
+				},
+				{
+					RpcMethod:      "EvidenceDisputeByEvidence",
+					Use:            "evidence-dispute-by-evidence [evidence-id]",
+					Short:          "Query the dispute filed against an evidence record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "evidence_id"}},
+				},
 				{
 					RpcMethod: "CurrentEpoch",
 					Use:       "current-epoch",
@@ -51,6 +63,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "current-epoch-anchor",
 					Short:     "Query the anchor for the current epoch",
 				},
+				{
+					RpcMethod: "EpochFinalizationProgress",
+					Use:       "epoch-finalization-progress",
+					Short:     "Query progress of the previous epoch's end-of-epoch processing",
+				},
 				{
 					RpcMethod:      "AssignedTargets",
 					Use:            "assigned-targets [supernode-account]",
@@ -152,6 +169,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Submit verifier decision for a storage-truth heal op",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "heal_op_id"}, {ProtoField: "verified"}, {ProtoField: "verification_hash"}},
 				},
+				{
+					RpcMethod:      "DisputeEvidence",
+					Use:            "dispute-evidence [evidence-id] [bond] [counter-proof-type] [counter-proof]",
+					Short:          "Dispute evidence about yourself by posting a bond and a counter-proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "evidence_id"}, {ProtoField: "bond"}, {ProtoField: "counter_proof_type"}, {ProtoField: "counter_proof"}},
+				},
+				{
+					RpcMethod:      "VoteEvidenceDispute",
+					Use:            "vote-evidence-dispute [dispute-id] [uphold]",
+					Short:          "Vote on an evidence dispute as a panel member",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "uphold"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -61,6 +61,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Logger,
 		authority,
 		in.SupernodeKeeper,
+		in.BankKeeper,
 	)
 
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
@@ -32,6 +32,10 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		defaultWeightMsgClaimHealComplete       int = 15
 		opWeightMsgSubmitHealVerification           = "op_weight_msg_submit_heal_verification"
 		defaultWeightMsgSubmitHealVerification  int = 15
+		opWeightMsgDisputeEvidence                  = "op_weight_msg_dispute_evidence"
+		defaultWeightMsgDisputeEvidence         int = 10
+		opWeightMsgVoteEvidenceDispute              = "op_weight_msg_vote_evidence_dispute"
+		defaultWeightMsgVoteEvidenceDispute     int = 10
 	)
 
 	var weightMsgSubmitEvidence int
@@ -54,6 +58,16 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		func(_ *rand.Rand) { weightMsgHealVerification = defaultWeightMsgSubmitHealVerification },
 	)
 
+	var weightMsgDisputeEvidence int
+	simState.AppParams.GetOrGenerate(opWeightMsgDisputeEvidence, &weightMsgDisputeEvidence, nil,
+		func(_ *rand.Rand) { weightMsgDisputeEvidence = defaultWeightMsgDisputeEvidence },
+	)
+
+	var weightMsgVoteEvidenceDispute int
+	simState.AppParams.GetOrGenerate(opWeightMsgVoteEvidenceDispute, &weightMsgVoteEvidenceDispute, nil,
+		func(_ *rand.Rand) { weightMsgVoteEvidenceDispute = defaultWeightMsgVoteEvidenceDispute },
+	)
+
 	operations = append(operations,
 		simulation.NewWeightedOperation(
 			weightMsgSubmitEvidence,
@@ -71,6 +85,14 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgHealVerification,
 			auditsimulation.SimulateMsgSubmitHealVerification(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgDisputeEvidence,
+			auditsimulation.SimulateMsgDisputeEvidence(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteEvidenceDispute,
+			auditsimulation.SimulateMsgVoteEvidenceDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		),
 	)
 
 	return operations
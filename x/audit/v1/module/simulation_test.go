@@ -20,7 +20,7 @@ func TestWeightedOperationsIncludesSubmitEvidence(t *testing.T) {
 	}
 
 	ops := am.WeightedOperations(simState)
-	require.Len(t, ops, 4)
+	require.Len(t, ops, 6)
 
 	msg, futureOps, err := ops[0].Op()(rand.New(rand.NewSource(1)), nil, sdk.Context{}, []simtypes.Account{}, "testing")
 	require.NoError(t, err)
@@ -38,13 +38,15 @@ func TestWeightedOperationsIncludesStorageTruthOps(t *testing.T) {
 	}
 
 	ops := am.WeightedOperations(simState)
-	require.Len(t, ops, 4)
+	require.Len(t, ops, 6)
 
 	wantRoutes := []string{
 		sdk.MsgTypeURL(&audittypes.MsgSubmitEvidence{}),
 		sdk.MsgTypeURL(&audittypes.MsgSubmitStorageRecheckEvidence{}),
 		sdk.MsgTypeURL(&audittypes.MsgClaimHealComplete{}),
 		sdk.MsgTypeURL(&audittypes.MsgSubmitHealVerification{}),
+		sdk.MsgTypeURL(&audittypes.MsgDisputeEvidence{}),
+		sdk.MsgTypeURL(&audittypes.MsgVoteEvidenceDispute{}),
 	}
 
 	for i, want := range wantRoutes {
@@ -0,0 +1,54 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/LumeraProtocol/lumera/x/audit/v1/keeper"
+	"github.com/LumeraProtocol/lumera/x/audit/v1/types"
+)
+
+// SimulateMsgDisputeEvidence is a no-op simulation for MsgDisputeEvidence.
+//
+// Executing a valid dispute requires evidence whose subject is the caller, a live
+// epoch anchor with at least one eligible panelist, and a funded bond. Simulated
+// evidence subjects are not registered supernodes, so no panel can be formed.
+func SimulateMsgDisputeEvidence(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		return simtypes.NoOpMsg(
+			types.ModuleName,
+			sdk.MsgTypeURL(&types.MsgDisputeEvidence{}),
+			"DisputeEvidence requires evidence about the caller and an anchored dispute panel",
+		), nil, nil
+	}
+}
+
+// SimulateMsgVoteEvidenceDispute is a no-op simulation for MsgVoteEvidenceDispute.
+//
+// Executing a valid vote requires a PENDING dispute with the caller on its panel,
+// which only exists after a DisputeEvidence has been processed.
+func SimulateMsgVoteEvidenceDispute(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		return simtypes.NoOpMsg(
+			types.ModuleName,
+			sdk.MsgTypeURL(&types.MsgVoteEvidenceDispute{}),
+			"VoteEvidenceDispute requires a PENDING dispute with the caller on its panel",
+		), nil, nil
+	}
+}
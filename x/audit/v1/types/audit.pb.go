@@ -329,7 +329,12 @@ func (m *HostReport) GetCascadeKademliaDbBytes() float64 {
 type StorageChallengeObservation struct {
 	TargetSupernodeAccount string `protobuf:"bytes,1,opt,name=target_supernode_account,json=targetSupernodeAccount,proto3" json:"target_supernode_account,omitempty"`
 	// port_states[i] refers to required_open_ports[i] for the epoch.
+	// When endpoint_observations are supplied, port_states may be left empty and
+	// is derived from them on submission.
 	PortStates []PortState `protobuf:"varint,2,rep,packed,name=port_states,json=portStates,proto3,enum=lumera.audit.v1.PortState" json:"port_states,omitempty"`
+	// endpoint_observations carries one entry per probe endpoint of the target
+	// (see QueryAssignedTargetsResponse.target_endpoints).
+	EndpointObservations []*EndpointObservation `protobuf:"bytes,3,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
 }
 
 func (m *StorageChallengeObservation) Reset()         { *m = StorageChallengeObservation{} }
@@ -379,6 +384,127 @@ func (m *StorageChallengeObservation) GetPortStates() []PortState {
 	return nil
 }
 
+func (m *StorageChallengeObservation) GetEndpointObservations() []*EndpointObservation {
+	if m != nil {
+		return m.EndpointObservations
+	}
+	return nil
+}
+
+// ProbeEndpoint is a single address/port pair a prober must check on a target.
+type ProbeEndpoint struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Port    uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (m *ProbeEndpoint) Reset()         { *m = ProbeEndpoint{} }
+func (m *ProbeEndpoint) String() string { return proto.CompactTextString(m) }
+func (*ProbeEndpoint) ProtoMessage()    {}
+func (*ProbeEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0613fff850c07858, []int{2}
+}
+func (m *ProbeEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProbeEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProbeEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeEndpoint.Merge(m, src)
+}
+func (m *ProbeEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeEndpoint proto.InternalMessageInfo
+
+func (m *ProbeEndpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProbeEndpoint) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+// EndpointObservation is a prober's reachability observation about one probe endpoint.
+type EndpointObservation struct {
+	Address   string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Port      uint32    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	PortState PortState `protobuf:"varint,3,opt,name=port_state,json=portState,proto3,enum=lumera.audit.v1.PortState" json:"port_state,omitempty"`
+}
+
+func (m *EndpointObservation) Reset()         { *m = EndpointObservation{} }
+func (m *EndpointObservation) String() string { return proto.CompactTextString(m) }
+func (*EndpointObservation) ProtoMessage()    {}
+func (*EndpointObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0613fff850c07858, []int{3}
+}
+func (m *EndpointObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndpointObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndpointObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndpointObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointObservation.Merge(m, src)
+}
+func (m *EndpointObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndpointObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointObservation proto.InternalMessageInfo
+
+func (m *EndpointObservation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EndpointObservation) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *EndpointObservation) GetPortState() PortState {
+	if m != nil {
+		return m.PortState
+	}
+	return PortState_PORT_STATE_UNKNOWN
+}
+
 // StorageProofResult captures one storage-truth storage-proof check outcome.
 //
 // NOTE: StorageProofResult stores transcript_hash plus a compact deterministic
@@ -414,7 +540,7 @@ func (m *StorageProofResult) Reset()         { *m = StorageProofResult{} }
 func (m *StorageProofResult) String() string { return proto.CompactTextString(m) }
 func (*StorageProofResult) ProtoMessage()    {}
 func (*StorageProofResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{2}
+	return fileDescriptor_0613fff850c07858, []int{4}
 }
 func (m *StorageProofResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +691,7 @@ func (m *NodeSuspicionState) Reset()         { *m = NodeSuspicionState{} }
 func (m *NodeSuspicionState) String() string { return proto.CompactTextString(m) }
 func (*NodeSuspicionState) ProtoMessage()    {}
 func (*NodeSuspicionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{3}
+	return fileDescriptor_0613fff850c07858, []int{5}
 }
 func (m *NodeSuspicionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +842,7 @@ func (m *ReporterReliabilityState) Reset()         { *m = ReporterReliabilitySta
 func (m *ReporterReliabilityState) String() string { return proto.CompactTextString(m) }
 func (*ReporterReliabilityState) ProtoMessage()    {}
 func (*ReporterReliabilityState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{4}
+	return fileDescriptor_0613fff850c07858, []int{6}
 }
 func (m *ReporterReliabilityState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +959,7 @@ func (m *TicketDeteriorationState) Reset()         { *m = TicketDeteriorationSta
 func (m *TicketDeteriorationState) String() string { return proto.CompactTextString(m) }
 func (*TicketDeteriorationState) ProtoMessage()    {}
 func (*TicketDeteriorationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{5}
+	return fileDescriptor_0613fff850c07858, []int{7}
 }
 func (m *TicketDeteriorationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,7 +1119,7 @@ func (m *TicketArtifactCountState) Reset()         { *m = TicketArtifactCountSta
 func (m *TicketArtifactCountState) String() string { return proto.CompactTextString(m) }
 func (*TicketArtifactCountState) ProtoMessage()    {}
 func (*TicketArtifactCountState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{6}
+	return fileDescriptor_0613fff850c07858, []int{8}
 }
 func (m *TicketArtifactCountState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1188,7 @@ func (m *HealOp) Reset()         { *m = HealOp{} }
 func (m *HealOp) String() string { return proto.CompactTextString(m) }
 func (*HealOp) ProtoMessage()    {}
 func (*HealOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{7}
+	return fileDescriptor_0613fff850c07858, []int{9}
 }
 func (m *HealOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,7 +1308,7 @@ func (m *EpochReport) Reset()         { *m = EpochReport{} }
 func (m *EpochReport) String() string { return proto.CompactTextString(m) }
 func (*EpochReport) ProtoMessage()    {}
 func (*EpochReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0613fff850c07858, []int{8}
+	return fileDescriptor_0613fff850c07858, []int{10}
 }
 func (m *EpochReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,6 +1388,8 @@ func init() {
 	proto.RegisterEnum("lumera.audit.v1.HealOpStatus", HealOpStatus_name, HealOpStatus_value)
 	proto.RegisterType((*HostReport)(nil), "lumera.audit.v1.HostReport")
 	proto.RegisterType((*StorageChallengeObservation)(nil), "lumera.audit.v1.StorageChallengeObservation")
+	proto.RegisterType((*ProbeEndpoint)(nil), "lumera.audit.v1.ProbeEndpoint")
+	proto.RegisterType((*EndpointObservation)(nil), "lumera.audit.v1.EndpointObservation")
 	proto.RegisterType((*StorageProofResult)(nil), "lumera.audit.v1.StorageProofResult")
 	proto.RegisterType((*NodeSuspicionState)(nil), "lumera.audit.v1.NodeSuspicionState")
 	proto.RegisterType((*ReporterReliabilityState)(nil), "lumera.audit.v1.ReporterReliabilityState")
@@ -1274,154 +1402,159 @@ func init() {
 func init() { proto.RegisterFile("lumera/audit/v1/audit.proto", fileDescriptor_0613fff850c07858) }
 
 var fileDescriptor_0613fff850c07858 = []byte{
-	// 2341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdb, 0x6e, 0xdb, 0xc8,
-	0xf9, 0xb7, 0x0e, 0xf1, 0xe1, 0xb3, 0x2d, 0x53, 0xe3, 0x43, 0xe4, 0x43, 0x1c, 0xaf, 0xf2, 0xdf,
-	0x8d, 0xa3, 0x4d, 0xe2, 0xb5, 0xf7, 0xbf, 0x58, 0x14, 0x8b, 0x2d, 0x40, 0x49, 0x74, 0xa4, 0x5a,
-	0x16, 0xb5, 0x43, 0x29, 0xd9, 0xb4, 0x68, 0x07, 0x14, 0x39, 0xb1, 0x88, 0xd0, 0xa4, 0x40, 0x52,
-	0xde, 0xf5, 0x43, 0x14, 0x58, 0xf4, 0xb2, 0x40, 0x2f, 0x7a, 0xd7, 0xdb, 0x02, 0xbd, 0xe8, 0x23,
-	0x2c, 0xd0, 0x5e, 0x2c, 0x7a, 0xd5, 0x8b, 0xa2, 0x28, 0x92, 0xbe, 0x41, 0x5f, 0xa0, 0x98, 0x19,
-	0x92, 0xa2, 0x4e, 0x76, 0x1a, 0xb4, 0x37, 0x86, 0xf8, 0xfd, 0x7e, 0xdf, 0x61, 0xe6, 0x3b, 0x70,
-	0x86, 0x86, 0x5d, 0x7b, 0x70, 0x49, 0x3d, 0xfd, 0x48, 0x1f, 0x98, 0x56, 0x70, 0x74, 0x75, 0x2c,
-	0x7e, 0x3c, 0xed, 0x7b, 0x6e, 0xe0, 0xa2, 0x35, 0x01, 0x3e, 0x15, 0xb2, 0xab, 0xe3, 0x9d, 0xbc,
-	0x7e, 0x69, 0x39, 0xee, 0x11, 0xff, 0x2b, 0x38, 0x3b, 0xdb, 0x86, 0xeb, 0x5f, 0xba, 0x3e, 0xe1,
-	0x4f, 0x47, 0xe2, 0x21, 0x84, 0x36, 0x2e, 0xdc, 0x0b, 0x57, 0xc8, 0xd9, 0x2f, 0x21, 0x2d, 0xfe,
-	0x29, 0x0d, 0x50, 0x73, 0xfd, 0x00, 0xd3, 0xbe, 0xeb, 0x05, 0xa8, 0x04, 0x79, 0xa3, 0x3f, 0x20,
-	0x03, 0x5f, 0xbf, 0xa0, 0xa4, 0x4f, 0x3d, 0x83, 0x3a, 0x41, 0x21, 0x75, 0x90, 0x3a, 0x4c, 0xe1,
-	0x35, 0xa3, 0x3f, 0xe8, 0x30, 0x79, 0x4b, 0x88, 0x19, 0xf7, 0x92, 0x5e, 0x8e, 0x71, 0xd3, 0x82,
-	0x7b, 0x49, 0x2f, 0x47, 0xb8, 0x8f, 0x01, 0x99, 0x96, 0xff, 0x7a, 0x8c, 0x9c, 0xe1, 0x64, 0x89,
-	0x21, 0x23, 0xec, 0x9f, 0xc0, 0xba, 0xe5, 0x74, 0xdd, 0x81, 0x63, 0x12, 0x16, 0x15, 0xf1, 0x03,
-	0x3d, 0xa0, 0x7e, 0x21, 0x7b, 0x90, 0x39, 0xcc, 0x9d, 0xec, 0x3c, 0x1d, 0xdb, 0x87, 0xa7, 0x2d,
-	0xd7, 0x0b, 0x34, 0x46, 0xc1, 0xf9, 0x50, 0x2d, 0x96, 0xf8, 0xe8, 0x13, 0xd8, 0x78, 0xa5, 0x5b,
-	0x36, 0x35, 0x89, 0x6e, 0x04, 0x96, 0xeb, 0xf8, 0xc4, 0x70, 0x07, 0x4e, 0x50, 0xb8, 0x73, 0x90,
-	0x3a, 0x5c, 0xc5, 0x48, 0x60, 0xb2, 0x80, 0x2a, 0x0c, 0x41, 0x3f, 0x82, 0x6d, 0x43, 0xf7, 0x0d,
-	0xdd, 0xa4, 0xe4, 0xb5, 0x6e, 0xd2, 0x4b, 0xdb, 0xd2, 0x89, 0xd9, 0x25, 0xdd, 0x6b, 0x16, 0xc3,
-	0x3c, 0x0f, 0x79, 0x2b, 0x24, 0x9c, 0x85, 0x78, 0xb5, 0x5b, 0x66, 0x68, 0xf1, 0xf7, 0x29, 0xd8,
-	0xd5, 0x02, 0xd7, 0xd3, 0x2f, 0x68, 0xa5, 0xa7, 0xdb, 0x36, 0x75, 0x2e, 0xa8, 0xda, 0xf5, 0xa9,
-	0x77, 0xa5, 0x33, 0x07, 0xa8, 0x03, 0x85, 0x40, 0xf7, 0x2e, 0x68, 0x40, 0xfc, 0x41, 0x9f, 0x7a,
-	0x8e, 0x6b, 0x52, 0xa2, 0x1b, 0x22, 0x20, 0xb6, 0xcb, 0x4b, 0xe5, 0xdd, 0xbf, 0xfc, 0xe1, 0xc9,
-	0xdd, 0x30, 0x6f, 0xb2, 0x61, 0xc8, 0xa6, 0xe9, 0x51, 0xdf, 0xd7, 0x02, 0xcf, 0x72, 0x2e, 0xf0,
-	0x96, 0x50, 0xd6, 0x22, 0x5d, 0x59, 0xa8, 0xa2, 0x2f, 0x60, 0x39, 0xb9, 0x4f, 0xe9, 0x5b, 0xf7,
-	0x09, 0xfa, 0xf1, 0x06, 0x15, 0xff, 0x38, 0x0f, 0x28, 0x8c, 0xb9, 0xe5, 0xb9, 0xee, 0x2b, 0x4c,
-	0xfd, 0x81, 0x1d, 0xfc, 0xaf, 0x42, 0xfd, 0x39, 0xec, 0x19, 0xd1, 0xce, 0x78, 0x53, 0x4c, 0xa7,
-	0x6f, 0x37, 0xbd, 0x33, 0x34, 0x30, 0x61, 0x7e, 0x17, 0x96, 0x02, 0xcb, 0x78, 0x4d, 0x03, 0x62,
-	0x99, 0xbc, 0xbc, 0x96, 0xf0, 0xa2, 0x10, 0xd4, 0x4d, 0x54, 0x83, 0xe5, 0xee, 0x80, 0x83, 0xc1,
-	0x75, 0x9f, 0x16, 0xb2, 0x07, 0xa9, 0xc3, 0xdc, 0xc9, 0xc3, 0x89, 0x6d, 0x4a, 0x6e, 0x46, 0x99,
-	0xf3, 0xdb, 0xd7, 0x7d, 0x8a, 0xa1, 0x1b, 0xff, 0x46, 0x5f, 0x41, 0x4e, 0xf7, 0x02, 0xeb, 0x95,
-	0x6e, 0x04, 0xc4, 0xb0, 0x75, 0xdf, 0xe7, 0xe5, 0x94, 0x3b, 0x29, 0xdd, 0x68, 0x4c, 0x0e, 0x55,
-	0x2a, 0x4c, 0x03, 0xaf, 0xea, 0xc9, 0x47, 0xf4, 0x08, 0xa4, 0xd8, 0xa4, 0xeb, 0x99, 0x96, 0xa3,
-	0xdb, 0xbc, 0xd8, 0x56, 0xf1, 0x5a, 0x24, 0x57, 0x85, 0x18, 0x7d, 0x00, 0x2b, 0x31, 0xf5, 0x35,
-	0xbd, 0x2e, 0x2c, 0xf0, 0x75, 0x2e, 0x47, 0xb2, 0x33, 0x7a, 0x8d, 0xce, 0x60, 0xc5, 0xe3, 0x79,
-	0x0c, 0xc3, 0x5b, 0xe4, 0xe1, 0x1d, 0xde, 0x18, 0x9e, 0x48, 0xbc, 0x08, 0x6e, 0xd9, 0x1b, 0x3e,
-	0xa0, 0x87, 0xb0, 0x16, 0x78, 0xba, 0xe3, 0x1b, 0x9e, 0xd5, 0x0f, 0x48, 0x4f, 0xf7, 0x7b, 0x85,
-	0x25, 0xee, 0x32, 0x37, 0x14, 0xd7, 0x74, 0xbf, 0x87, 0x0a, 0xb0, 0x60, 0xd2, 0x40, 0xb7, 0x6c,
-	0xbf, 0x00, 0x9c, 0x10, 0x3d, 0xa2, 0x0f, 0x93, 0x1b, 0xc6, 0x13, 0xbd, 0xcc, 0xd7, 0x36, 0xdc,
-	0x04, 0x9e, 0xbe, 0x13, 0xd8, 0x34, 0xa9, 0x67, 0x89, 0x6e, 0x21, 0x96, 0xd3, 0x1f, 0x84, 0xfe,
-	0x56, 0xb8, 0xb9, 0xf5, 0x21, 0x58, 0x67, 0x18, 0x77, 0x7a, 0x0c, 0x1b, 0xc9, 0x8a, 0xb2, 0x2e,
-	0x1c, 0x3d, 0x18, 0x78, 0xb4, 0xb0, 0x2a, 0x54, 0x12, 0xc5, 0x12, 0x41, 0xe8, 0x14, 0xee, 0xbb,
-	0xbc, 0x2b, 0xa9, 0x47, 0xf4, 0x20, 0xa0, 0xac, 0x6f, 0x98, 0xc3, 0x58, 0xd9, 0x2f, 0xe4, 0x0e,
-	0x32, 0x87, 0x4b, 0xf8, 0x5e, 0x44, 0x93, 0x87, 0xac, 0xd8, 0x8c, 0x5f, 0xfc, 0xd5, 0x3c, 0xa0,
-	0xa6, 0x6b, 0x52, 0x6d, 0xe0, 0xf7, 0x2d, 0x83, 0x61, 0xac, 0xa5, 0x50, 0x0d, 0xf2, 0xef, 0xd5,
-	0x33, 0x92, 0x3f, 0x5e, 0xce, 0x0f, 0x61, 0xcd, 0x8f, 0x6c, 0x13, 0xdf, 0x70, 0x3d, 0xca, 0x1b,
-	0x24, 0x83, 0x73, 0xb1, 0x58, 0x63, 0x52, 0x36, 0x5f, 0x6d, 0xdd, 0x0f, 0xc8, 0xa0, 0x6f, 0xea,
-	0x01, 0x35, 0x09, 0xed, 0xbb, 0x46, 0x8f, 0x37, 0x40, 0x16, 0x4b, 0x0c, 0xe9, 0x08, 0x40, 0x61,
-	0x72, 0xf4, 0x29, 0x6c, 0x71, 0xb6, 0x47, 0xd9, 0xb8, 0x25, 0x6c, 0x06, 0x86, 0x1a, 0x59, 0xae,
-	0xb1, 0xce, 0x50, 0xcc, 0xc1, 0x53, 0xdd, 0xb2, 0x85, 0xd2, 0x13, 0xe0, 0x62, 0xe2, 0xda, 0x66,
-	0x52, 0xe3, 0xce, 0xd0, 0x87, 0x6a, 0x9b, 0x43, 0xfa, 0x97, 0xb0, 0x6b, 0x5a, 0x7e, 0x60, 0x39,
-	0x46, 0x40, 0xc2, 0x96, 0xe4, 0x5a, 0xdf, 0x58, 0x8e, 0xe9, 0x7e, 0x13, 0x96, 0x76, 0x21, 0xa2,
-	0xb4, 0x39, 0x83, 0x69, 0xbf, 0xe0, 0x38, 0x5b, 0x90, 0x60, 0xb2, 0xa1, 0xe6, 0x05, 0xa1, 0xb3,
-	0x05, 0xe1, 0x4c, 0x20, 0x1a, 0x03, 0x84, 0xb3, 0x23, 0xd8, 0xe0, 0x75, 0x4e, 0x74, 0x51, 0x5d,
-	0x91, 0x97, 0x45, 0xee, 0x25, 0xcf, 0x31, 0x99, 0x97, 0x58, 0x68, 0xfe, 0xe3, 0x70, 0xbf, 0x22,
-	0x2d, 0x61, 0x7e, 0x89, 0x9b, 0x5f, 0x63, 0x08, 0xaf, 0x7c, 0x79, 0xcc, 0x7a, 0x77, 0xd4, 0x3a,
-	0x24, 0xac, 0x97, 0x67, 0x5b, 0xef, 0x86, 0xd6, 0x97, 0xc7, 0xac, 0x97, 0x85, 0xf5, 0x43, 0x90,
-	0x0c, 0x9b, 0xea, 0x0e, 0xe9, 0x33, 0xb2, 0x28, 0x96, 0x15, 0x6e, 0x39, 0xc7, 0xe5, 0x2d, 0xdd,
-	0x0f, 0x5f, 0x4c, 0xc7, 0xb0, 0x19, 0x9a, 0x8d, 0xe9, 0xc2, 0xf2, 0x2a, 0xb7, 0x8c, 0x84, 0xe5,
-	0x50, 0x45, 0x18, 0x8f, 0x54, 0x2c, 0xc7, 0xa4, 0xdf, 0x26, 0xd3, 0x96, 0x1b, 0xaa, 0xd4, 0x19,
-	0x36, 0x4c, 0xdc, 0x8f, 0x61, 0x6f, 0x3c, 0x1e, 0xa2, 0x07, 0xa4, 0xef, 0xfa, 0x41, 0xdf, 0x75,
-	0x68, 0x61, 0x4d, 0x64, 0x6e, 0x34, 0x36, 0x39, 0x68, 0x85, 0x78, 0xf1, 0xbb, 0x2c, 0x14, 0xc4,
-	0x69, 0x82, 0x7a, 0x98, 0xda, 0x96, 0xde, 0xb5, 0x6c, 0x2b, 0xb8, 0x16, 0xad, 0xf1, 0x12, 0x76,
-	0xbc, 0x10, 0x7b, 0xbf, 0xf7, 0x4a, 0x21, 0x52, 0x9f, 0x18, 0xfd, 0x1f, 0x43, 0xde, 0x1b, 0xba,
-	0x1b, 0xe9, 0x16, 0x29, 0x01, 0xbc, 0x4f, 0xbf, 0xc8, 0x00, 0x81, 0x37, 0xf0, 0x03, 0xd2, 0xd5,
-	0x1d, 0x33, 0x7c, 0x6f, 0x14, 0x27, 0x66, 0x69, 0xb4, 0xe8, 0x36, 0xa3, 0x96, 0x75, 0xc7, 0xc4,
-	0x4b, 0x41, 0xf4, 0x13, 0x1d, 0xc1, 0xba, 0xe1, 0x3a, 0x81, 0xa7, 0x9b, 0x16, 0x3f, 0x6b, 0x24,
-	0x4e, 0x21, 0x59, 0x8c, 0x46, 0x20, 0x91, 0xec, 0xff, 0x87, 0x2d, 0xcb, 0xa1, 0xb6, 0x75, 0x61,
-	0x75, 0x6d, 0x4a, 0x06, 0x4e, 0x10, 0xa7, 0x6e, 0x9e, 0xeb, 0x6c, 0x0c, 0xd1, 0x0e, 0x03, 0x45,
-	0xa4, 0x27, 0xb0, 0x19, 0xb6, 0x4d, 0xdf, 0xf5, 0xad, 0xc0, 0xba, 0xa2, 0xa1, 0xa3, 0x05, 0x9e,
-	0xb5, 0x75, 0x01, 0xb6, 0x42, 0x2c, 0x1e, 0xba, 0xa1, 0x8e, 0x43, 0x2f, 0xf4, 0x84, 0xce, 0x62,
-	0x52, 0xa7, 0x19, 0x62, 0x42, 0x67, 0x7a, 0x7b, 0x2e, 0x4d, 0x6f, 0xcf, 0xe2, 0xbf, 0x16, 0xa0,
-	0x20, 0x3a, 0xbc, 0x4a, 0x03, 0xea, 0x59, 0xae, 0x27, 0x26, 0x29, 0x2f, 0x89, 0x91, 0x57, 0x76,
-	0x6a, 0xec, 0x95, 0x7d, 0x04, 0xeb, 0x66, 0x52, 0x65, 0x24, 0xad, 0x68, 0x04, 0x7a, 0x9f, 0xc4,
-	0x3e, 0x82, 0x3c, 0x3b, 0x15, 0x5e, 0x51, 0xd2, 0xa3, 0xba, 0x4d, 0xdc, 0x3e, 0x8b, 0x41, 0xcc,
-	0xc0, 0x9c, 0x00, 0x6a, 0x54, 0xb7, 0xd5, 0x7e, 0xdd, 0x64, 0xbb, 0xd4, 0xf7, 0xdc, 0xae, 0x88,
-	0x22, 0x99, 0x0e, 0x91, 0xc2, 0xf5, 0x18, 0x4c, 0x64, 0xe3, 0x23, 0xe0, 0xdd, 0x2e, 0x8c, 0x27,
-	0x93, 0xb7, 0xca, 0xc4, 0xcc, 0xb4, 0xe0, 0x45, 0x41, 0xb3, 0xfe, 0x1c, 0x78, 0x74, 0x74, 0xd8,
-	0x31, 0xe4, 0x54, 0x00, 0x82, 0xfd, 0x05, 0xeb, 0xa1, 0x78, 0x70, 0xc7, 0xfc, 0x91, 0xa4, 0xdd,
-	0xf5, 0xe2, 0xe9, 0x1d, 0xe9, 0x89, 0xc4, 0xcd, 0xa8, 0xc3, 0xa5, 0x99, 0x75, 0xf8, 0x0b, 0xb8,
-	0xc7, 0x63, 0x9b, 0x79, 0x18, 0x84, 0x77, 0x38, 0xb1, 0x31, 0x0b, 0xed, 0xe9, 0x07, 0xc2, 0x2e,
-	0xdc, 0x0f, 0xdf, 0x45, 0x33, 0xc7, 0xc2, 0xf2, 0xed, 0x1e, 0xf6, 0xc4, 0x1b, 0x6b, 0xc6, 0x68,
-	0x68, 0x43, 0x3e, 0xf4, 0x91, 0x38, 0x12, 0xad, 0xfc, 0x87, 0x47, 0xa2, 0x35, 0xe1, 0x62, 0x78,
-	0x2c, 0x2a, 0x8d, 0x5a, 0x4d, 0x8e, 0xe2, 0x04, 0x37, 0x9a, 0x20, 0xf7, 0xe2, 0xb7, 0x61, 0xcf,
-	0xb5, 0x4d, 0xea, 0xc5, 0xc9, 0x13, 0x6b, 0xcc, 0xf1, 0xb4, 0xed, 0x44, 0xa4, 0x1a, 0xe7, 0x84,
-	0xe9, 0x13, 0x89, 0xf8, 0x1c, 0x0a, 0x63, 0xa3, 0x7c, 0x58, 0x2a, 0x6b, 0xdc, 0xeb, 0xe6, 0xc8,
-	0x34, 0x8f, 0xeb, 0xe5, 0x4b, 0xd8, 0x0d, 0xeb, 0x25, 0x3c, 0xfd, 0x8e, 0xea, 0x4a, 0x5c, 0xb7,
-	0x20, 0x28, 0xe2, 0xbc, 0x3b, 0xa2, 0xfe, 0x39, 0x14, 0xd8, 0x2b, 0x7f, 0xaa, 0x6e, 0x5e, 0xf8,
-	0x75, 0x6d, 0x73, 0x52, 0xb1, 0xf8, 0x9b, 0x54, 0xd4, 0xf5, 0x72, 0xf2, 0x90, 0xf7, 0x0e, 0x5d,
-	0xff, 0x09, 0x6c, 0x88, 0x55, 0x8e, 0x9d, 0x19, 0xd3, 0xe2, 0xce, 0xc6, 0x31, 0x79, 0xfc, 0xe0,
-	0xe8, 0x5f, 0x5f, 0x76, 0x5d, 0x7b, 0x5c, 0x25, 0x23, 0x66, 0x98, 0x00, 0x47, 0x74, 0x8a, 0xbf,
-	0xcc, 0xc2, 0xbc, 0x68, 0x6f, 0xb4, 0x07, 0x90, 0x18, 0x00, 0x29, 0xbe, 0xaa, 0xc5, 0x5e, 0xd4,
-	0xfa, 0x23, 0xb1, 0xa6, 0xc7, 0x62, 0x7d, 0x0c, 0xc8, 0x37, 0x7a, 0xd4, 0x1c, 0xd8, 0xd1, 0xb4,
-	0x89, 0xae, 0x1e, 0x59, 0x2c, 0xc5, 0x08, 0xdf, 0x91, 0xba, 0xc9, 0x6e, 0x55, 0xcc, 0xec, 0xd4,
-	0x32, 0xcf, 0xbe, 0xc3, 0xad, 0x4a, 0x28, 0x4f, 0x14, 0xf8, 0xcf, 0x60, 0xf7, 0x8a, 0x7a, 0xd6,
-	0x2b, 0x6b, 0x9a, 0x61, 0x76, 0x39, 0xc9, 0xdc, 0x66, 0x79, 0x3b, 0xd2, 0x1f, 0xb7, 0xed, 0xa3,
-	0xcf, 0x60, 0x9e, 0x1d, 0x7d, 0x07, 0xe2, 0xf2, 0x9b, 0x3b, 0xb9, 0x37, 0xd1, 0x32, 0x62, 0x17,
-	0x35, 0x4e, 0xc2, 0x21, 0x99, 0x1d, 0xf9, 0x0d, 0x8f, 0xf2, 0x21, 0xdc, 0xa3, 0xd6, 0x45, 0x2f,
-	0x08, 0x07, 0xda, 0x6a, 0x28, 0xad, 0x71, 0x21, 0xa3, 0x45, 0xb3, 0x3a, 0xa4, 0x2d, 0x0a, 0x5a,
-	0x28, 0x0d, 0x69, 0x25, 0xc8, 0x9b, 0x54, 0x37, 0x6d, 0xcb, 0xa1, 0xc3, 0x5d, 0x0e, 0xcf, 0x6b,
-	0x11, 0x10, 0x6d, 0xf2, 0x7d, 0x08, 0xaf, 0x2f, 0xe2, 0xee, 0x20, 0xae, 0x22, 0x20, 0x44, 0xfc,
-	0xca, 0xb0, 0x01, 0x77, 0x1c, 0x97, 0xdd, 0x94, 0xf9, 0x64, 0xc1, 0xe2, 0xa1, 0xf8, 0xbb, 0x0c,
-	0x2c, 0x73, 0x13, 0xe1, 0xb7, 0x90, 0xff, 0xde, 0x31, 0x7e, 0x1b, 0x16, 0xe3, 0x98, 0xd3, 0x3c,
-	0xe6, 0x05, 0x1a, 0xc6, 0xfa, 0x00, 0x56, 0xc5, 0xe4, 0x8b, 0x56, 0x9f, 0xe1, 0xaf, 0xb6, 0x15,
-	0x21, 0x0c, 0x17, 0x5f, 0x86, 0xe5, 0x9e, 0x1b, 0xcf, 0x48, 0x5e, 0x28, 0xcb, 0x27, 0xbb, 0x93,
-	0x69, 0x88, 0xbf, 0xe3, 0x94, 0xb3, 0xdf, 0xff, 0xfd, 0xfe, 0x1c, 0x86, 0xde, 0xf0, 0xcb, 0x8e,
-	0x07, 0xfb, 0xbe, 0x98, 0x6c, 0x24, 0xbe, 0x12, 0x11, 0x77, 0xf8, 0x6d, 0x42, 0x54, 0xc9, 0xf2,
-	0xc9, 0xe3, 0x59, 0x03, 0x71, 0xda, 0x07, 0x0d, 0xbc, 0xe7, 0xcf, 0x06, 0x7d, 0xf4, 0x02, 0x36,
-	0x23, 0x9f, 0x7d, 0x36, 0x4e, 0xc3, 0x51, 0xc9, 0x0a, 0x89, 0xb9, 0x7a, 0xf0, 0x0e, 0xb3, 0x17,
-	0xaf, 0xfb, 0x13, 0x32, 0xbf, 0xa4, 0xc2, 0x52, 0xfc, 0x31, 0x03, 0x6d, 0x01, 0x6a, 0xa9, 0xb8,
-	0x4d, 0xb4, 0xb6, 0xdc, 0x56, 0x48, 0xa7, 0x79, 0xd6, 0x54, 0x5f, 0x34, 0xa5, 0x39, 0xb4, 0x0e,
-	0x6b, 0x09, 0xb9, 0xda, 0x52, 0x9a, 0x52, 0x0a, 0x6d, 0x42, 0x3e, 0x21, 0xac, 0x34, 0x54, 0x4d,
-	0xa9, 0x4a, 0xe9, 0xd2, 0xdf, 0x52, 0xb0, 0x35, 0xfd, 0xde, 0x8f, 0x1e, 0xc1, 0x87, 0x5a, 0x5b,
-	0xc5, 0xf2, 0x33, 0x85, 0xb4, 0xb0, 0xaa, 0x9e, 0x92, 0x72, 0xa7, 0x72, 0xa6, 0xb4, 0x49, 0xfb,
-	0x65, 0x8b, 0x79, 0xd3, 0x5a, 0x4a, 0xa5, 0x7e, 0x5a, 0x57, 0xaa, 0xd2, 0x1c, 0xfa, 0x3f, 0x38,
-	0x98, 0x4d, 0xc5, 0x4a, 0x45, 0x69, 0xb6, 0xa5, 0x14, 0xfa, 0x00, 0xee, 0xcd, 0x66, 0xa9, 0x8d,
-	0xaa, 0x94, 0x46, 0x0f, 0xe1, 0xc1, 0x6c, 0x4a, 0x0b, 0xab, 0x65, 0xb9, 0x5d, 0x57, 0x9b, 0x52,
-	0x06, 0x7d, 0x08, 0x1f, 0xdc, 0xe8, 0xb1, 0xa6, 0x54, 0xce, 0xa4, 0x6c, 0xe9, 0xd7, 0x29, 0xd8,
-	0x9e, 0xf9, 0x25, 0x02, 0x3d, 0x86, 0xc3, 0x51, 0x23, 0x32, 0x6e, 0xd7, 0x4f, 0xe5, 0x4a, 0x9b,
-	0x54, 0x1a, 0xb2, 0xa6, 0x8d, 0x2d, 0xf2, 0x23, 0x28, 0xde, 0xc8, 0xae, 0x37, 0xab, 0xca, 0xd7,
-	0x52, 0x6a, 0x72, 0x0d, 0x63, 0x3c, 0xed, 0xe5, 0x79, 0x59, 0x6d, 0x48, 0xe9, 0xd2, 0x6f, 0x33,
-	0x70, 0x77, 0xc6, 0x4b, 0x17, 0x95, 0xe0, 0xa3, 0x51, 0x23, 0x58, 0xd1, 0x3a, 0x8d, 0xe9, 0x81,
-	0x3d, 0x80, 0xfb, 0x37, 0x70, 0x5b, 0xb2, 0xa6, 0x49, 0xa9, 0xc9, 0xb5, 0x8e, 0x90, 0x6a, 0xb2,
-	0x56, 0x23, 0xe7, 0x75, 0xed, 0x5c, 0x6e, 0x57, 0x6a, 0x52, 0x1a, 0x7d, 0x06, 0xc7, 0x37, 0xb0,
-	0xdb, 0xf5, 0x73, 0x45, 0xed, 0xb4, 0x89, 0x8a, 0x49, 0x53, 0x65, 0x50, 0x4b, 0x6d, 0x6a, 0x8a,
-	0x94, 0x41, 0x9f, 0xc2, 0xd1, 0x0d, 0x6a, 0x6a, 0x59, 0x53, 0xf0, 0x73, 0x05, 0x93, 0xaf, 0x3a,
-	0x2a, 0xee, 0x9c, 0x93, 0x53, 0xb9, 0xde, 0x90, 0xb2, 0xe8, 0x18, 0x9e, 0xdc, 0xa0, 0xd4, 0x54,
-	0x89, 0xd2, 0xa8, 0x3f, 0xab, 0x97, 0x1b, 0x0a, 0x69, 0xd7, 0x59, 0x8e, 0xa5, 0x3b, 0xb7, 0xa8,
-	0xd4, 0x9b, 0xcf, 0xe5, 0x46, 0xbd, 0x4a, 0xda, 0x58, 0x6e, 0x6a, 0x15, 0x5c, 0x6f, 0xb5, 0xa5,
-	0xf9, 0x5b, 0x56, 0x14, 0x56, 0x0c, 0xa9, 0xa8, 0xcd, 0xd3, 0x3a, 0x3e, 0x57, 0xaa, 0x22, 0xb8,
-	0x85, 0xd2, 0x9f, 0x53, 0x90, 0x9f, 0xb8, 0xdf, 0xb0, 0x1d, 0xc7, 0x0a, 0x6b, 0x27, 0x05, 0x93,
-	0x36, 0xee, 0x68, 0x6d, 0x52, 0x96, 0x9b, 0xd5, 0xb1, 0xb4, 0xec, 0xc3, 0xce, 0x34, 0x52, 0x53,
-	0xc5, 0xe7, 0x72, 0x43, 0xb4, 0xc3, 0x34, 0xbc, 0xa1, 0xbe, 0x10, 0x8f, 0x52, 0x1a, 0x3d, 0x81,
-	0x47, 0xd3, 0x28, 0x95, 0x9a, 0xdc, 0x68, 0x28, 0xcd, 0x67, 0x0a, 0x26, 0xf5, 0x66, 0xb4, 0x3b,
-	0x52, 0x06, 0x1d, 0xc0, 0xde, 0x34, 0x7a, 0x55, 0x79, 0x86, 0xe5, 0xaa, 0x52, 0x95, 0xb2, 0xa5,
-	0x7f, 0xa6, 0x60, 0x25, 0xf9, 0xd2, 0x62, 0x41, 0xd6, 0x14, 0xb9, 0x41, 0xd4, 0x16, 0x9f, 0x0c,
-	0x9d, 0xf1, 0xda, 0xda, 0x83, 0xc2, 0x18, 0xae, 0x55, 0x6a, 0x4a, 0xb5, 0xd3, 0x50, 0xaa, 0x52,
-	0x6a, 0x8a, 0x76, 0xbd, 0xc9, 0xb6, 0xf7, 0x19, 0x56, 0x34, 0x4d, 0x4a, 0xa3, 0x22, 0xec, 0x8f,
-	0xe1, 0xec, 0x51, 0xc1, 0x24, 0x0c, 0xb3, 0x2a, 0x65, 0xd0, 0x2e, 0xdc, 0x1d, 0xe3, 0x3c, 0x57,
-	0xb0, 0x70, 0x9f, 0x45, 0xdb, 0xb0, 0x39, 0x06, 0xb2, 0xbc, 0x28, 0x55, 0xe9, 0x0e, 0xda, 0x81,
-	0xad, 0x31, 0x48, 0xf9, 0xba, 0x55, 0xc7, 0x4a, 0x55, 0x9a, 0x2f, 0x97, 0xbe, 0x7f, 0xb3, 0x9f,
-	0xfa, 0xe1, 0xcd, 0x7e, 0xea, 0x1f, 0x6f, 0xf6, 0x53, 0xdf, 0xbd, 0xdd, 0x9f, 0xfb, 0xe1, 0xed,
-	0xfe, 0xdc, 0x5f, 0xdf, 0xee, 0xcf, 0xfd, 0x54, 0xfa, 0x76, 0xf8, 0x3f, 0x86, 0xe0, 0xba, 0x4f,
-	0xfd, 0xee, 0x3c, 0xff, 0x7f, 0xc0, 0xa7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x0d, 0x4d,
-	0x25, 0x83, 0x18, 0x00, 0x00,
+	// 2419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcb, 0x6e, 0x1b, 0xd7,
+	0xf9, 0x37, 0x2f, 0xd6, 0xe5, 0xd3, 0x6d, 0x78, 0x74, 0x31, 0x2d, 0xc9, 0xb2, 0x42, 0x27, 0xb1,
+	0xac, 0xd8, 0x56, 0xac, 0xfc, 0x83, 0x20, 0x08, 0xf2, 0x07, 0x86, 0xe4, 0xc8, 0x64, 0x4d, 0x71,
+	0x98, 0x33, 0x64, 0x1c, 0xb7, 0x68, 0x0f, 0x86, 0x33, 0xc7, 0xe2, 0xc0, 0xa3, 0x19, 0x62, 0x66,
+	0xa8, 0x44, 0x9b, 0x3e, 0x41, 0x0b, 0x04, 0x5d, 0x16, 0xe8, 0xa2, 0xbb, 0x3e, 0x40, 0x17, 0x7d,
+	0x84, 0x00, 0xed, 0x22, 0xe8, 0xaa, 0x8b, 0xa2, 0x28, 0xe2, 0xbe, 0x41, 0x5f, 0xa0, 0x38, 0x97,
+	0x19, 0x0e, 0x6f, 0x92, 0x63, 0xb4, 0x1b, 0x81, 0xf3, 0xfd, 0x7e, 0xdf, 0xe5, 0x9c, 0xef, 0x72,
+	0xce, 0x8c, 0x60, 0xc7, 0x1d, 0x9c, 0xd3, 0xc0, 0x3c, 0x32, 0x07, 0xb6, 0x13, 0x1d, 0x5d, 0x3c,
+	0x11, 0x3f, 0x1e, 0xf7, 0x03, 0x3f, 0xf2, 0xd1, 0x9a, 0x00, 0x1f, 0x0b, 0xd9, 0xc5, 0x93, 0xed,
+	0x82, 0x79, 0xee, 0x78, 0xfe, 0x11, 0xff, 0x2b, 0x38, 0xdb, 0xb7, 0x2d, 0x3f, 0x3c, 0xf7, 0x43,
+	0xc2, 0x9f, 0x8e, 0xc4, 0x83, 0x84, 0x36, 0xce, 0xfc, 0x33, 0x5f, 0xc8, 0xd9, 0x2f, 0x21, 0x2d,
+	0xfd, 0x39, 0x0b, 0x50, 0xf3, 0xc3, 0x08, 0xd3, 0xbe, 0x1f, 0x44, 0xe8, 0x10, 0x0a, 0x56, 0x7f,
+	0x40, 0x06, 0xa1, 0x79, 0x46, 0x49, 0x9f, 0x06, 0x16, 0xf5, 0xa2, 0x62, 0x66, 0x3f, 0x73, 0x90,
+	0xc1, 0x6b, 0x56, 0x7f, 0xd0, 0x61, 0xf2, 0x96, 0x10, 0x33, 0xee, 0x39, 0x3d, 0x1f, 0xe3, 0x66,
+	0x05, 0xf7, 0x9c, 0x9e, 0x8f, 0x70, 0x1f, 0x02, 0xb2, 0x9d, 0xf0, 0xd5, 0x18, 0x39, 0xc7, 0xc9,
+	0x0a, 0x43, 0x46, 0xd8, 0x3f, 0x81, 0x75, 0xc7, 0xeb, 0xfa, 0x03, 0xcf, 0x26, 0x2c, 0x2a, 0x12,
+	0x46, 0x66, 0x44, 0xc3, 0x62, 0x7e, 0x3f, 0x77, 0xb0, 0x7a, 0xbc, 0xfd, 0x78, 0x6c, 0x1f, 0x1e,
+	0xb7, 0xfc, 0x20, 0x32, 0x18, 0x05, 0x17, 0xa4, 0x5a, 0x22, 0x09, 0xd1, 0x87, 0xb0, 0xf1, 0xd2,
+	0x74, 0x5c, 0x6a, 0x13, 0xd3, 0x8a, 0x1c, 0xdf, 0x0b, 0x89, 0xe5, 0x0f, 0xbc, 0xa8, 0x78, 0x73,
+	0x3f, 0x73, 0xb0, 0x82, 0x91, 0xc0, 0x54, 0x01, 0x55, 0x18, 0x82, 0x3e, 0x85, 0xdb, 0x96, 0x19,
+	0x5a, 0xa6, 0x4d, 0xc9, 0x2b, 0xd3, 0xa6, 0xe7, 0xae, 0x63, 0x12, 0xbb, 0x4b, 0xba, 0x97, 0x2c,
+	0x86, 0x39, 0x1e, 0xf2, 0x96, 0x24, 0x3c, 0x93, 0x78, 0xb5, 0x5b, 0x66, 0x68, 0xe9, 0x57, 0x59,
+	0xd8, 0x31, 0x22, 0x3f, 0x30, 0xcf, 0x68, 0xa5, 0x67, 0xba, 0x2e, 0xf5, 0xce, 0xa8, 0xde, 0x0d,
+	0x69, 0x70, 0x61, 0x32, 0x07, 0xa8, 0x03, 0xc5, 0xc8, 0x0c, 0xce, 0x68, 0x44, 0xc2, 0x41, 0x9f,
+	0x06, 0x9e, 0x6f, 0x53, 0x62, 0x5a, 0x22, 0x20, 0xb6, 0xcb, 0x8b, 0xe5, 0x9d, 0xbf, 0xfe, 0xf1,
+	0xd1, 0x2d, 0x99, 0x37, 0xd5, 0xb2, 0x54, 0xdb, 0x0e, 0x68, 0x18, 0x1a, 0x51, 0xe0, 0x78, 0x67,
+	0x78, 0x4b, 0x28, 0x1b, 0xb1, 0xae, 0x2a, 0x54, 0xd1, 0x67, 0xb0, 0x94, 0xde, 0xa7, 0xec, 0xb5,
+	0xfb, 0x04, 0xfd, 0xe1, 0x06, 0xbd, 0x80, 0x4d, 0xea, 0xd9, 0x7d, 0xdf, 0xf1, 0x22, 0xe2, 0x0f,
+	0x63, 0x0d, 0x8b, 0xb9, 0xfd, 0xdc, 0xc1, 0xd2, 0xf1, 0xbb, 0x13, 0x66, 0x34, 0xc9, 0x4e, 0x2d,
+	0x0c, 0x6f, 0xd0, 0x49, 0x61, 0x58, 0xfa, 0x1c, 0x56, 0x5a, 0x81, 0xdf, 0xa5, 0xb1, 0x06, 0x2a,
+	0xc2, 0xbc, 0x29, 0x56, 0x24, 0x96, 0x8b, 0xe3, 0x47, 0x84, 0x20, 0xcf, 0x62, 0xe2, 0xf5, 0xb3,
+	0x82, 0xf9, 0xef, 0xd2, 0x2f, 0x61, 0x7d, 0x8a, 0xaf, 0x1f, 0x67, 0x04, 0x7d, 0x0a, 0x30, 0xdc,
+	0x1b, 0x5e, 0x71, 0x57, 0x6f, 0xcd, 0x62, 0xb2, 0x35, 0xa5, 0x3f, 0xcd, 0x01, 0x92, 0xd9, 0x6c,
+	0x05, 0xbe, 0xff, 0x12, 0xd3, 0x70, 0xe0, 0x46, 0xff, 0xab, 0x24, 0xfe, 0x1c, 0x76, 0xad, 0xb8,
+	0x66, 0x82, 0x29, 0xa6, 0xb3, 0xd7, 0x9b, 0xde, 0x1e, 0x1a, 0x98, 0x30, 0xbf, 0x03, 0x8b, 0x91,
+	0x63, 0xbd, 0xa2, 0x11, 0x71, 0x6c, 0xbe, 0x0d, 0x8b, 0x78, 0x41, 0x08, 0xea, 0x36, 0xaa, 0xc1,
+	0x52, 0x77, 0xc0, 0xc1, 0xe8, 0xb2, 0x4f, 0x8b, 0x79, 0xbe, 0x4b, 0xf7, 0x27, 0x76, 0x29, 0xbd,
+	0x19, 0x65, 0xce, 0x6f, 0x5f, 0xf6, 0x29, 0x86, 0x6e, 0xf2, 0x1b, 0x7d, 0x01, 0xab, 0x66, 0x10,
+	0x39, 0x2f, 0x4d, 0x2b, 0x22, 0x96, 0x6b, 0x86, 0x21, 0x6f, 0xb4, 0xd5, 0xe3, 0xc3, 0x2b, 0x8d,
+	0xa9, 0x52, 0xa5, 0xc2, 0x34, 0xf0, 0x8a, 0x99, 0x7e, 0x44, 0x0f, 0x40, 0x49, 0x4c, 0xfa, 0x81,
+	0xed, 0x78, 0xa6, 0xcb, 0xdb, 0x70, 0x05, 0xaf, 0xc5, 0x72, 0x5d, 0x88, 0xd1, 0x3b, 0xb0, 0x9c,
+	0x50, 0x5f, 0xd1, 0xcb, 0xe2, 0x3c, 0x5f, 0xe7, 0x52, 0x2c, 0x7b, 0x46, 0x2f, 0xd1, 0x33, 0x58,
+	0x0e, 0x78, 0x1e, 0x65, 0x78, 0x0b, 0x3c, 0xbc, 0x83, 0x2b, 0xc3, 0x13, 0x89, 0x17, 0xc1, 0x2d,
+	0x05, 0xc3, 0x07, 0x74, 0x1f, 0xd6, 0xa2, 0xc0, 0xf4, 0x42, 0x2b, 0x70, 0xfa, 0x11, 0xe9, 0x99,
+	0x61, 0xaf, 0xb8, 0xc8, 0x5d, 0xae, 0x0e, 0xc5, 0x35, 0x33, 0xec, 0xb1, 0x9a, 0xb5, 0x69, 0x64,
+	0x3a, 0x6e, 0x58, 0x04, 0x51, 0xb3, 0xf2, 0x11, 0xbd, 0x97, 0xde, 0x30, 0x9e, 0xe8, 0x25, 0xbe,
+	0xb6, 0xe1, 0x26, 0xf0, 0xf4, 0x1d, 0xc3, 0xa6, 0x4d, 0x03, 0x47, 0xb4, 0x00, 0x71, 0xbc, 0xfe,
+	0x40, 0xfa, 0x5b, 0xe6, 0xe6, 0xd6, 0x87, 0x60, 0x9d, 0x61, 0xdc, 0xe9, 0x13, 0xd8, 0x48, 0x57,
+	0x94, 0x73, 0xe6, 0x99, 0xd1, 0x20, 0xa0, 0xc5, 0x15, 0xa1, 0x32, 0xc4, 0x8c, 0x18, 0x42, 0x27,
+	0x70, 0x57, 0xcc, 0x00, 0x1a, 0x10, 0x33, 0x8a, 0x68, 0x18, 0x09, 0x87, 0x89, 0x72, 0x58, 0x5c,
+	0xdd, 0xcf, 0x1d, 0x2c, 0xe2, 0x3b, 0x31, 0x4d, 0x1d, 0xb2, 0x12, 0x33, 0x61, 0xe9, 0x37, 0x73,
+	0x80, 0x9a, 0xbe, 0x4d, 0x8d, 0x41, 0xd8, 0x77, 0x2c, 0x86, 0xb1, 0x8e, 0x42, 0x35, 0x28, 0xbc,
+	0x55, 0xcf, 0x28, 0xe1, 0x78, 0x39, 0xdf, 0x87, 0xb5, 0x30, 0xb6, 0x4d, 0x42, 0xcb, 0x0f, 0x28,
+	0x6f, 0x90, 0x1c, 0x5e, 0x4d, 0xc4, 0x06, 0x93, 0xb2, 0x93, 0xc7, 0x35, 0xc3, 0x88, 0x0c, 0xfa,
+	0xb6, 0x19, 0x51, 0x9b, 0xd0, 0xbe, 0x6f, 0xf5, 0x78, 0x03, 0xe4, 0xb1, 0xc2, 0x90, 0x8e, 0x00,
+	0x34, 0x26, 0x47, 0x1f, 0xc1, 0x16, 0x67, 0x07, 0x94, 0x1d, 0x44, 0x84, 0x9d, 0x0e, 0x52, 0x23,
+	0xcf, 0x35, 0xd6, 0x19, 0x8a, 0x39, 0x78, 0x62, 0x3a, 0xae, 0x50, 0x7a, 0x04, 0x5c, 0x4c, 0x7c,
+	0xd7, 0x4e, 0x6b, 0xdc, 0x1c, 0xfa, 0xd0, 0x5d, 0x7b, 0x48, 0xff, 0x1c, 0x76, 0x6c, 0x27, 0x8c,
+	0x1c, 0xcf, 0x8a, 0x88, 0x6c, 0x49, 0xae, 0xf5, 0xb5, 0xe3, 0xd9, 0xfe, 0xd7, 0xb2, 0xb4, 0x8b,
+	0x31, 0xa5, 0xcd, 0x19, 0x4c, 0xfb, 0x39, 0xc7, 0xd9, 0x82, 0x04, 0x93, 0x8d, 0xb4, 0x20, 0x92,
+	0xce, 0xe6, 0x85, 0x33, 0x81, 0x18, 0x0c, 0x10, 0xce, 0x8e, 0x60, 0x83, 0xd7, 0x39, 0x31, 0x45,
+	0x75, 0xc5, 0x5e, 0x16, 0xb8, 0x97, 0x02, 0xc7, 0x54, 0x5e, 0x62, 0xd2, 0xfc, 0x07, 0x72, 0xbf,
+	0x62, 0x2d, 0x61, 0x7e, 0x91, 0x9b, 0x5f, 0x63, 0x08, 0xaf, 0x7c, 0x75, 0xcc, 0x7a, 0x77, 0xd4,
+	0x3a, 0xa4, 0xac, 0x97, 0x67, 0x5b, 0xef, 0x4a, 0xeb, 0x4b, 0x63, 0xd6, 0xcb, 0xc2, 0xfa, 0x01,
+	0x28, 0x96, 0x4b, 0x4d, 0x8f, 0xf4, 0x19, 0x59, 0x14, 0xcb, 0x32, 0xb7, 0xbc, 0xca, 0xe5, 0x2d,
+	0x33, 0x94, 0x47, 0xf6, 0x13, 0xd8, 0x94, 0x66, 0x13, 0xba, 0xb0, 0xbc, 0xc2, 0x2d, 0x23, 0x61,
+	0x59, 0xaa, 0x08, 0xe3, 0xb1, 0x8a, 0xe3, 0xd9, 0xf4, 0x9b, 0x74, 0xda, 0x56, 0x87, 0x2a, 0x75,
+	0x86, 0x0d, 0x13, 0xf7, 0xff, 0xb0, 0x3b, 0x1e, 0x0f, 0x31, 0x23, 0xd2, 0xf7, 0xc3, 0xa8, 0xef,
+	0x7b, 0xb4, 0xb8, 0x26, 0x32, 0x37, 0x1a, 0x9b, 0x1a, 0xb5, 0x24, 0x5e, 0xfa, 0x36, 0x0f, 0x45,
+	0x71, 0xcf, 0xa2, 0x01, 0xa6, 0xae, 0x63, 0x76, 0x1d, 0xd7, 0x89, 0x2e, 0x45, 0x6b, 0xbc, 0x80,
+	0xed, 0x40, 0x62, 0x6f, 0x77, 0xae, 0x14, 0x63, 0xf5, 0x89, 0xd1, 0xff, 0x01, 0x14, 0x82, 0xa1,
+	0xbb, 0x91, 0x6e, 0x51, 0x52, 0xc0, 0xdb, 0xf4, 0x8b, 0x0a, 0x10, 0x05, 0x83, 0x30, 0x22, 0x5d,
+	0xd3, 0xb3, 0xe5, 0xb9, 0x51, 0x9a, 0x98, 0xa5, 0xf1, 0xa2, 0xdb, 0x8c, 0x5a, 0x36, 0x3d, 0x1b,
+	0x2f, 0x46, 0xf1, 0x4f, 0x74, 0x04, 0xeb, 0x96, 0xef, 0x45, 0x81, 0x69, 0x3b, 0xfc, 0x16, 0x96,
+	0xba, 0x9f, 0xe5, 0x31, 0x1a, 0x81, 0x44, 0xb2, 0xff, 0x0f, 0xb6, 0x1c, 0x8f, 0xba, 0xce, 0x99,
+	0xd3, 0x75, 0x29, 0x19, 0x78, 0x51, 0x92, 0xba, 0x39, 0xae, 0xb3, 0x31, 0x44, 0x3b, 0x0c, 0x14,
+	0x91, 0x1e, 0xc3, 0xa6, 0x6c, 0x9b, 0xbe, 0x1f, 0x3a, 0x91, 0x73, 0x41, 0xa5, 0xa3, 0x79, 0x9e,
+	0xb5, 0x75, 0x01, 0xb6, 0x24, 0x96, 0x0c, 0x5d, 0xa9, 0xe3, 0xd1, 0x33, 0x33, 0xa5, 0xb3, 0x90,
+	0xd6, 0x69, 0x4a, 0x4c, 0xe8, 0x4c, 0x6f, 0xcf, 0xc5, 0xe9, 0xed, 0x59, 0xfa, 0xf7, 0x3c, 0x14,
+	0x45, 0x87, 0x57, 0x69, 0x44, 0x03, 0xc7, 0x0f, 0xc4, 0x24, 0xe5, 0x25, 0x31, 0x72, 0x64, 0x67,
+	0xc6, 0x8e, 0xec, 0x23, 0x58, 0xb7, 0xd3, 0x2a, 0x23, 0x69, 0x45, 0x23, 0xd0, 0xdb, 0x24, 0xf6,
+	0x01, 0x14, 0xd8, 0x7d, 0xf9, 0x82, 0x92, 0x1e, 0x35, 0x5d, 0xe2, 0xf7, 0x89, 0x23, 0xf2, 0x9b,
+	0xc7, 0xab, 0x02, 0xa8, 0x51, 0xd3, 0xd5, 0xfb, 0x75, 0x9b, 0xed, 0x52, 0x3f, 0xf0, 0xbb, 0x22,
+	0x8a, 0x74, 0x3a, 0x44, 0x0a, 0xd7, 0x13, 0x30, 0x95, 0x8d, 0xf7, 0x81, 0x77, 0xbb, 0x30, 0x9e,
+	0x4e, 0xde, 0x0a, 0x13, 0x33, 0xd3, 0x82, 0x17, 0x07, 0xcd, 0xfa, 0x73, 0x10, 0xd0, 0xd1, 0x61,
+	0xc7, 0x90, 0x13, 0x01, 0x08, 0xf6, 0x67, 0xac, 0x87, 0x92, 0xc1, 0x9d, 0xf0, 0x47, 0x92, 0x76,
+	0x2b, 0x48, 0xa6, 0x77, 0xac, 0x27, 0x12, 0x37, 0xa3, 0x0e, 0x17, 0x67, 0xd6, 0xe1, 0x2f, 0xe0,
+	0x0e, 0x8f, 0x6d, 0xe6, 0x65, 0x10, 0xde, 0xe0, 0xc6, 0xc6, 0x2c, 0xb4, 0xa7, 0x5f, 0x08, 0xbb,
+	0x70, 0x57, 0x9e, 0x45, 0x33, 0xc7, 0xc2, 0xd2, 0xf5, 0x1e, 0x76, 0xc5, 0x89, 0x35, 0x63, 0x34,
+	0xb4, 0xa1, 0x20, 0x7d, 0xa4, 0xae, 0x44, 0xcb, 0x3f, 0xf2, 0x4a, 0xb4, 0x26, 0x5c, 0x24, 0x02,
+	0xf6, 0x66, 0x98, 0xb6, 0x9a, 0x1e, 0xc5, 0x29, 0x6e, 0x3c, 0x41, 0xee, 0x24, 0xa7, 0x61, 0xcf,
+	0x77, 0x6d, 0x1a, 0x24, 0xc9, 0x13, 0x6b, 0x5c, 0xe5, 0x69, 0xdb, 0x8e, 0x49, 0x35, 0xce, 0x91,
+	0xe9, 0x13, 0x89, 0xf8, 0x04, 0x8a, 0x63, 0xa3, 0x7c, 0x58, 0x2a, 0x6b, 0xdc, 0xeb, 0xe6, 0xc8,
+	0x34, 0x4f, 0xea, 0xe5, 0x73, 0xd8, 0x91, 0xf5, 0x22, 0x6f, 0xbf, 0xa3, 0xba, 0x0a, 0xd7, 0x2d,
+	0x0a, 0x8a, 0xb8, 0xef, 0x8e, 0xa8, 0x7f, 0x02, 0x45, 0x76, 0xe4, 0x4f, 0xd5, 0x2d, 0x08, 0xbf,
+	0xbe, 0x6b, 0x4f, 0x2a, 0x96, 0x7e, 0x97, 0x89, 0xbb, 0x5e, 0x4d, 0x5f, 0xf2, 0xde, 0xa0, 0xeb,
+	0x3f, 0x84, 0x0d, 0xb1, 0xca, 0xb1, 0x3b, 0xa3, 0x78, 0xe3, 0x41, 0x1c, 0x53, 0xc7, 0x2f, 0x8e,
+	0xe1, 0xe5, 0x79, 0xd7, 0x77, 0xc7, 0x55, 0x72, 0x62, 0x86, 0x09, 0x70, 0x44, 0xa7, 0xf4, 0xeb,
+	0x3c, 0xcc, 0x89, 0xf6, 0x46, 0xbb, 0x00, 0xa9, 0x01, 0x90, 0xe1, 0xab, 0x5a, 0xe8, 0xc5, 0xad,
+	0x3f, 0x12, 0x6b, 0x76, 0x2c, 0xd6, 0x87, 0x80, 0x42, 0xab, 0x47, 0xed, 0x81, 0x1b, 0x4f, 0x9b,
+	0xf8, 0xd5, 0x23, 0x8f, 0x95, 0x04, 0xe1, 0x3b, 0x52, 0xb7, 0xd9, 0x5b, 0x15, 0x33, 0x3b, 0xb5,
+	0xcc, 0xf3, 0x6f, 0xf0, 0x56, 0x25, 0x94, 0x27, 0x0a, 0xfc, 0x67, 0xb0, 0x73, 0x41, 0x03, 0xe7,
+	0xa5, 0x33, 0xcd, 0x30, 0x7b, 0x39, 0xc9, 0x5d, 0x67, 0xf9, 0x76, 0xac, 0x3f, 0x6e, 0x3b, 0x44,
+	0x1f, 0xc3, 0x5c, 0x18, 0x99, 0xd1, 0x40, 0x7c, 0x16, 0x58, 0x3d, 0xbe, 0x33, 0xd1, 0x32, 0x62,
+	0x17, 0x0d, 0x4e, 0xc2, 0x92, 0xcc, 0xae, 0xfc, 0x56, 0x40, 0xf9, 0x10, 0xee, 0x51, 0xe7, 0xac,
+	0x17, 0xc9, 0x81, 0xb6, 0x22, 0xa5, 0x35, 0x2e, 0x64, 0xb4, 0x78, 0x56, 0x4b, 0xda, 0x82, 0xa0,
+	0x49, 0xa9, 0xa4, 0x1d, 0x42, 0xc1, 0xa6, 0xa6, 0xed, 0x3a, 0x1e, 0x1d, 0xee, 0xb2, 0xbc, 0xaf,
+	0xc5, 0x40, 0xbc, 0xc9, 0x77, 0x41, 0xbe, 0xbe, 0x88, 0x77, 0x07, 0xf1, 0x2a, 0x02, 0x42, 0xc4,
+	0x5f, 0x19, 0x36, 0xe0, 0xa6, 0xe7, 0xb3, 0x6f, 0x08, 0x7c, 0xb2, 0x60, 0xf1, 0x50, 0xfa, 0x43,
+	0x0e, 0x96, 0xb8, 0x09, 0xf9, 0x95, 0xe8, 0xbf, 0x77, 0x8d, 0xbf, 0x0d, 0x0b, 0x49, 0xcc, 0x59,
+	0x1e, 0xf3, 0x3c, 0x95, 0xb1, 0xde, 0x83, 0x15, 0x31, 0xf9, 0xe2, 0xd5, 0xe7, 0xf8, 0xd1, 0xb6,
+	0x2c, 0x84, 0x72, 0xf1, 0x65, 0x58, 0xea, 0xf9, 0xc9, 0x8c, 0xe4, 0x85, 0xb2, 0x74, 0xbc, 0x33,
+	0x99, 0x86, 0xe4, 0x0b, 0x57, 0x39, 0xff, 0xdd, 0x3f, 0xee, 0xde, 0xc0, 0xd0, 0x4b, 0x24, 0x28,
+	0x80, 0xbd, 0x50, 0x4c, 0x36, 0x92, 0xbc, 0x12, 0x8d, 0x7e, 0x09, 0xb9, 0xc9, 0xbf, 0x84, 0x3c,
+	0x9c, 0x35, 0x10, 0xa7, 0x7d, 0xea, 0xc1, 0xbb, 0xe1, 0x6c, 0x30, 0x44, 0xcf, 0x61, 0x33, 0xf6,
+	0xd9, 0x67, 0xe3, 0x54, 0x8e, 0x4a, 0x56, 0x48, 0xcc, 0xd5, 0xbd, 0x37, 0x98, 0xbd, 0x78, 0x3d,
+	0x9c, 0x90, 0x85, 0x87, 0x3a, 0x2c, 0x26, 0xdf, 0x32, 0xd0, 0x16, 0xa0, 0x96, 0x8e, 0xdb, 0xc4,
+	0x68, 0xab, 0x6d, 0x8d, 0x74, 0x9a, 0xcf, 0x9a, 0xfa, 0xf3, 0xa6, 0x72, 0x03, 0xad, 0xc3, 0x5a,
+	0x4a, 0xae, 0xb7, 0xb4, 0xa6, 0x92, 0x41, 0x9b, 0x50, 0x48, 0x09, 0x2b, 0x0d, 0xdd, 0xd0, 0xaa,
+	0x4a, 0xf6, 0xf0, 0xef, 0x19, 0xd8, 0x9a, 0xfe, 0xde, 0x8f, 0x1e, 0xc0, 0x7b, 0x46, 0x5b, 0xc7,
+	0xea, 0x53, 0x8d, 0xb4, 0xb0, 0xae, 0x9f, 0x90, 0x72, 0xa7, 0xf2, 0x4c, 0x6b, 0x93, 0xf6, 0x8b,
+	0x16, 0xf3, 0x66, 0xb4, 0xb4, 0x4a, 0xfd, 0xa4, 0xae, 0x55, 0x95, 0x1b, 0xe8, 0x5d, 0xd8, 0x9f,
+	0x4d, 0xc5, 0x5a, 0x45, 0x6b, 0xb6, 0x95, 0x0c, 0x7a, 0x07, 0xee, 0xcc, 0x66, 0xe9, 0x8d, 0xaa,
+	0x92, 0x45, 0xf7, 0xe1, 0xde, 0x6c, 0x4a, 0x0b, 0xeb, 0x65, 0xb5, 0x5d, 0xd7, 0x9b, 0x4a, 0x0e,
+	0xbd, 0x07, 0xef, 0x5c, 0xe9, 0xb1, 0xa6, 0x55, 0x9e, 0x29, 0xf9, 0xc3, 0xdf, 0x66, 0xe0, 0xf6,
+	0xcc, 0x2f, 0x11, 0xe8, 0x21, 0x1c, 0x8c, 0x1a, 0x51, 0x71, 0xbb, 0x7e, 0xa2, 0x56, 0xda, 0xa4,
+	0xd2, 0x50, 0x0d, 0x63, 0x6c, 0x91, 0xef, 0x43, 0xe9, 0x4a, 0x76, 0xbd, 0x59, 0xd5, 0xbe, 0x52,
+	0x32, 0x93, 0x6b, 0x18, 0xe3, 0x19, 0x2f, 0x4e, 0xcb, 0x7a, 0x43, 0xc9, 0x1e, 0xfe, 0x3e, 0x07,
+	0xb7, 0x66, 0x1c, 0xba, 0xe8, 0x10, 0xde, 0x1f, 0x35, 0x82, 0x35, 0xa3, 0xd3, 0x98, 0x1e, 0xd8,
+	0x3d, 0xb8, 0x7b, 0x05, 0xb7, 0xa5, 0x1a, 0x86, 0x92, 0x99, 0x5c, 0xeb, 0x08, 0xa9, 0xa6, 0x1a,
+	0x35, 0x72, 0x5a, 0x37, 0x4e, 0xd5, 0x76, 0xa5, 0xa6, 0x64, 0xd1, 0xc7, 0xf0, 0xe4, 0x0a, 0x76,
+	0xbb, 0x7e, 0xaa, 0xe9, 0x9d, 0x36, 0xd1, 0x31, 0x69, 0xea, 0x0c, 0x6a, 0xe9, 0x4d, 0x43, 0x53,
+	0x72, 0xe8, 0x23, 0x38, 0xba, 0x42, 0x4d, 0x2f, 0x1b, 0x1a, 0xfe, 0x52, 0xc3, 0xe4, 0x8b, 0x8e,
+	0x8e, 0x3b, 0xa7, 0xe4, 0x44, 0xad, 0x37, 0x94, 0x3c, 0x7a, 0x02, 0x8f, 0xae, 0x50, 0x6a, 0xea,
+	0x44, 0x6b, 0xd4, 0x9f, 0xd6, 0xcb, 0x0d, 0x8d, 0xb4, 0xeb, 0x2c, 0xc7, 0xca, 0xcd, 0x6b, 0x54,
+	0xea, 0xcd, 0x2f, 0xd5, 0x46, 0xbd, 0x4a, 0xda, 0x58, 0x6d, 0x1a, 0x15, 0x5c, 0x6f, 0xb5, 0x95,
+	0xb9, 0x6b, 0x56, 0x24, 0x2b, 0x86, 0x54, 0xf4, 0xe6, 0x49, 0x1d, 0x9f, 0x6a, 0x55, 0x11, 0xdc,
+	0xfc, 0xe1, 0x5f, 0x32, 0x50, 0x98, 0x78, 0xbf, 0x61, 0x3b, 0x8e, 0x35, 0xd6, 0x4e, 0x1a, 0x26,
+	0x6d, 0xdc, 0x31, 0xda, 0xa4, 0xac, 0x36, 0xab, 0x63, 0x69, 0xd9, 0x83, 0xed, 0x69, 0xa4, 0xa6,
+	0x8e, 0x4f, 0xd5, 0x86, 0x68, 0x87, 0x69, 0x78, 0x43, 0x7f, 0x2e, 0x1e, 0x95, 0x2c, 0x7a, 0x04,
+	0x0f, 0xa6, 0x51, 0x2a, 0x35, 0xb5, 0xd1, 0xd0, 0x9a, 0x4f, 0x35, 0x4c, 0xea, 0xcd, 0x78, 0x77,
+	0x94, 0x1c, 0xda, 0x87, 0xdd, 0x69, 0xf4, 0xaa, 0xf6, 0x14, 0xab, 0x55, 0xad, 0xaa, 0xe4, 0x0f,
+	0xff, 0x95, 0x81, 0xe5, 0xf4, 0xa1, 0xc5, 0x82, 0xac, 0x69, 0x6a, 0x83, 0xe8, 0x2d, 0x3e, 0x19,
+	0x3a, 0xe3, 0xb5, 0xb5, 0x0b, 0xc5, 0x31, 0xdc, 0xa8, 0xd4, 0xb4, 0x6a, 0xa7, 0xa1, 0x55, 0x95,
+	0xcc, 0x14, 0xed, 0x7a, 0x93, 0x6d, 0xef, 0x53, 0xac, 0x19, 0x86, 0x92, 0x45, 0x25, 0xd8, 0x1b,
+	0xc3, 0xd9, 0xa3, 0x86, 0x89, 0x0c, 0xb3, 0xaa, 0xe4, 0xd0, 0x0e, 0xdc, 0x1a, 0xe3, 0x7c, 0xa9,
+	0x61, 0xe1, 0x3e, 0x8f, 0x6e, 0xc3, 0xe6, 0x18, 0xc8, 0xf2, 0xa2, 0x55, 0x95, 0x9b, 0x68, 0x1b,
+	0xb6, 0xc6, 0x20, 0xed, 0xab, 0x56, 0x1d, 0x6b, 0x55, 0x65, 0xae, 0x7c, 0xf8, 0xdd, 0x0f, 0x7b,
+	0x99, 0xef, 0x7f, 0xd8, 0xcb, 0xfc, 0xf3, 0x87, 0xbd, 0xcc, 0xb7, 0xaf, 0xf7, 0x6e, 0x7c, 0xff,
+	0x7a, 0xef, 0xc6, 0xdf, 0x5e, 0xef, 0xdd, 0xf8, 0xa9, 0xf2, 0xcd, 0xf0, 0xbf, 0x2f, 0xec, 0x63,
+	0x68, 0xd8, 0x9d, 0xe3, 0xff, 0x29, 0xf9, 0xe8, 0x3f, 0x03, 0x00, 0xe8, 0xde, 0xb4, 0xbe, 0x9d,
+	0x19, 0x00, 0x00,
 }
 
 func (m *HostReport) Marshal() (dAtA []byte, err error) {
@@ -1514,6 +1647,20 @@ func (m *StorageChallengeObservation) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.EndpointObservations) > 0 {
+		for iNdEx := len(m.EndpointObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndpointObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAudit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PortStates) > 0 {
 		dAtA4 := make([]byte, len(m.PortStates)*10)
 		var j3 int
@@ -1542,6 +1689,81 @@ func (m *StorageChallengeObservation) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ProbeEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Port != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndpointObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndpointObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndpointObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PortState != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.PortState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Port != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StorageProofResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2210,6 +2432,47 @@ func (m *StorageChallengeObservation) Size() (n int) {
 		}
 		n += 1 + sovAudit(uint64(l)) + l
 	}
+	if len(m.EndpointObservations) > 0 {
+		for _, e := range m.EndpointObservations {
+			l = e.Size()
+			n += 1 + l + sovAudit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProbeEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovAudit(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *EndpointObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovAudit(uint64(m.Port))
+	}
+	if m.PortState != 0 {
+		n += 1 + sovAudit(uint64(m.PortState))
+	}
 	return n
 }
 
@@ -2849,6 +3112,261 @@ func (m *StorageChallengeObservation) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PortStates", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointObservations = append(m.EndpointObservations, &EndpointObservation{})
+			if err := m.EndpointObservations[len(m.EndpointObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbeEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndpointObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndpointObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndpointObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortState", wireType)
+			}
+			m.PortState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PortState |= PortState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAudit(dAtA[iNdEx:])
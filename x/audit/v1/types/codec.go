@@ -14,6 +14,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSubmitStorageRecheckEvidence{},
 		&MsgClaimHealComplete{},
 		&MsgSubmitHealVerification{},
+		&MsgDisputeEvidence{},
+		&MsgVoteEvidenceDispute{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &Msg_serviceDesc)
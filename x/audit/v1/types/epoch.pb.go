@@ -26,6 +26,74 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EpochFinalizationPhase is a step of the epoch-end processing pipeline, in
+// execution order.
+type EpochFinalizationPhase int32
+
+const (
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_UNSPECIFIED EpochFinalizationPhase = 0
+	// Postponement checks over the ACTIVE supernodes snapshotted at epoch end.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_ENFORCE_ACTIVE EpochFinalizationPhase = 1
+	// Recovery checks over the POSTPONED supernodes snapshotted at epoch end.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_RECOVER_POSTPONED EpochFinalizationPhase = 2
+	// Collects reporter divergence statistics.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_SCAN EpochFinalizationPhase = 3
+	// Ranks the collected reporters against the median.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_RANK EpochFinalizationPhase = 4
+	// Applies divergence penalties to outlier reporters.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_APPLY EpochFinalizationPhase = 5
+	// Per-epoch reporter clean recovery.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_REPORTER_CLEAN_RECOVERY EpochFinalizationPhase = 6
+	// Expires heal ops past their deadline.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_EXPIRE EpochFinalizationPhase = 7
+	// Collects tickets eligible for a new heal op.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_SCAN EpochFinalizationPhase = 8
+	// Schedules heal ops for the highest-priority tickets.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_HEAL_OP_SCHEDULE EpochFinalizationPhase = 9
+	// Prunes epoch-scoped state outside the retention window.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_PRUNE EpochFinalizationPhase = 10
+	// All steps are done.
+	EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_COMPLETE EpochFinalizationPhase = 11
+)
+
+var EpochFinalizationPhase_name = map[int32]string{
+	0:  "EPOCH_FINALIZATION_PHASE_UNSPECIFIED",
+	1:  "EPOCH_FINALIZATION_PHASE_ENFORCE_ACTIVE",
+	2:  "EPOCH_FINALIZATION_PHASE_RECOVER_POSTPONED",
+	3:  "EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_SCAN",
+	4:  "EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_RANK",
+	5:  "EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_APPLY",
+	6:  "EPOCH_FINALIZATION_PHASE_REPORTER_CLEAN_RECOVERY",
+	7:  "EPOCH_FINALIZATION_PHASE_HEAL_OP_EXPIRE",
+	8:  "EPOCH_FINALIZATION_PHASE_HEAL_OP_SCAN",
+	9:  "EPOCH_FINALIZATION_PHASE_HEAL_OP_SCHEDULE",
+	10: "EPOCH_FINALIZATION_PHASE_PRUNE",
+	11: "EPOCH_FINALIZATION_PHASE_COMPLETE",
+}
+
+var EpochFinalizationPhase_value = map[string]int32{
+	"EPOCH_FINALIZATION_PHASE_UNSPECIFIED":               0,
+	"EPOCH_FINALIZATION_PHASE_ENFORCE_ACTIVE":            1,
+	"EPOCH_FINALIZATION_PHASE_RECOVER_POSTPONED":         2,
+	"EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_SCAN":  3,
+	"EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_RANK":  4,
+	"EPOCH_FINALIZATION_PHASE_REPORTER_DIVERGENCE_APPLY": 5,
+	"EPOCH_FINALIZATION_PHASE_REPORTER_CLEAN_RECOVERY":   6,
+	"EPOCH_FINALIZATION_PHASE_HEAL_OP_EXPIRE":            7,
+	"EPOCH_FINALIZATION_PHASE_HEAL_OP_SCAN":              8,
+	"EPOCH_FINALIZATION_PHASE_HEAL_OP_SCHEDULE":          9,
+	"EPOCH_FINALIZATION_PHASE_PRUNE":                     10,
+	"EPOCH_FINALIZATION_PHASE_COMPLETE":                  11,
+}
+
+func (x EpochFinalizationPhase) String() string {
+	return proto.EnumName(EpochFinalizationPhase_name, int32(x))
+}
+
+func (EpochFinalizationPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b89552b0d1ccb55f, []int{0}
+}
+
 // EpochAnchor is a minimal per-epoch on-chain anchor that freezes the deterministic seed
 // and the eligible supernode sets used for deterministic selection off-chain.
 type EpochAnchor struct {
@@ -149,41 +217,226 @@ func (m *EpochAnchor) GetTargetsSetCommitment() []byte {
 	return nil
 }
 
+// EpochFinalizationProgress tracks epoch-end processing that is spread across
+// the first blocks of the next epoch.
+type EpochFinalizationProgress struct {
+	EpochId uint64                 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	Phase   EpochFinalizationPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=lumera.audit.v1.EpochFinalizationPhase" json:"phase,omitempty"`
+	// started_height is the last block of the epoch, where the work was queued.
+	StartedHeight int64 `protobuf:"varint,3,opt,name=started_height,json=startedHeight,proto3" json:"started_height,omitempty"`
+	// deadline_height is the block that processes any remaining work.
+	DeadlineHeight int64 `protobuf:"varint,4,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	// completed_height is set once phase is COMPLETE.
+	CompletedHeight int64 `protobuf:"varint,5,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+	// items_processed counts work items across all phases.
+	ItemsProcessed uint64 `protobuf:"varint,6,opt,name=items_processed,json=itemsProcessed,proto3" json:"items_processed,omitempty"`
+	// phase_items_processed counts work items of the current phase.
+	PhaseItemsProcessed uint64 `protobuf:"varint,7,opt,name=phase_items_processed,json=phaseItemsProcessed,proto3" json:"phase_items_processed,omitempty"`
+	// phase_items_total is the size of the current phase's work list, when known upfront.
+	PhaseItemsTotal uint64 `protobuf:"varint,8,opt,name=phase_items_total,json=phaseItemsTotal,proto3" json:"phase_items_total,omitempty"`
+	// prune_step is the index of the current pruning step.
+	PruneStep uint32 `protobuf:"varint,9,opt,name=prune_step,json=pruneStep,proto3" json:"prune_step,omitempty"`
+	// cursor is the next store key of the current keyed scan; empty starts from the beginning.
+	Cursor []byte `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// collected counts items queued by the current scan phase for the next phase.
+	Collected uint64 `protobuf:"varint,11,opt,name=collected,proto3" json:"collected,omitempty"`
+	// params are the module params at epoch end; every phase uses them.
+	Params Params `protobuf:"bytes,12,opt,name=params,proto3" json:"params"`
+	// next_epoch_seed is the seed derived at the next epoch's start block. The next
+	// epoch's anchor is deferred until finalization completes and uses this seed.
+	NextEpochSeed []byte `protobuf:"bytes,13,opt,name=next_epoch_seed,json=nextEpochSeed,proto3" json:"next_epoch_seed,omitempty"`
+}
+
+func (m *EpochFinalizationProgress) Reset()         { *m = EpochFinalizationProgress{} }
+func (m *EpochFinalizationProgress) String() string { return proto.CompactTextString(m) }
+func (*EpochFinalizationProgress) ProtoMessage()    {}
+func (*EpochFinalizationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89552b0d1ccb55f, []int{1}
+}
+func (m *EpochFinalizationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFinalizationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFinalizationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochFinalizationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFinalizationProgress.Merge(m, src)
+}
+func (m *EpochFinalizationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFinalizationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFinalizationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFinalizationProgress proto.InternalMessageInfo
+
+func (m *EpochFinalizationProgress) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetPhase() EpochFinalizationPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return EpochFinalizationPhase_EPOCH_FINALIZATION_PHASE_UNSPECIFIED
+}
+
+func (m *EpochFinalizationProgress) GetStartedHeight() int64 {
+	if m != nil {
+		return m.StartedHeight
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetItemsProcessed() uint64 {
+	if m != nil {
+		return m.ItemsProcessed
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetPhaseItemsProcessed() uint64 {
+	if m != nil {
+		return m.PhaseItemsProcessed
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetPhaseItemsTotal() uint64 {
+	if m != nil {
+		return m.PhaseItemsTotal
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetPruneStep() uint32 {
+	if m != nil {
+		return m.PruneStep
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *EpochFinalizationProgress) GetCollected() uint64 {
+	if m != nil {
+		return m.Collected
+	}
+	return 0
+}
+
+func (m *EpochFinalizationProgress) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *EpochFinalizationProgress) GetNextEpochSeed() []byte {
+	if m != nil {
+		return m.NextEpochSeed
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("lumera.audit.v1.EpochFinalizationPhase", EpochFinalizationPhase_name, EpochFinalizationPhase_value)
 	proto.RegisterType((*EpochAnchor)(nil), "lumera.audit.v1.EpochAnchor")
+	proto.RegisterType((*EpochFinalizationProgress)(nil), "lumera.audit.v1.EpochFinalizationProgress")
 }
 
 func init() { proto.RegisterFile("lumera/audit/v1/epoch.proto", fileDescriptor_b89552b0d1ccb55f) }
 
 var fileDescriptor_b89552b0d1ccb55f = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xc7, 0x1b, 0x1a, 0xf6, 0xc3, 0x20, 0x68, 0xbd, 0x0b, 0x9b, 0xee, 0x4a, 0xa1, 0xe2, 0x14,
-	0xf1, 0xd1, 0x68, 0x81, 0x13, 0xb7, 0x16, 0xad, 0x04, 0x12, 0xa7, 0xf6, 0x80, 0xc4, 0xc5, 0xf2,
-	0xda, 0xa3, 0x24, 0xa2, 0xb1, 0x23, 0x7b, 0x5a, 0xc1, 0x5b, 0x20, 0xf1, 0x02, 0x3c, 0x04, 0x0f,
-	0xc1, 0x71, 0xc5, 0x89, 0x23, 0x6a, 0x2f, 0x3c, 0x06, 0xea, 0x38, 0x85, 0xe5, 0xe3, 0xc0, 0x25,
-	0xb2, 0xe7, 0xf7, 0xff, 0xcd, 0x64, 0x24, 0xb3, 0x93, 0xf9, 0xa2, 0x06, 0x27, 0x73, 0xb9, 0xd0,
-	0x15, 0xe6, 0xcb, 0xd3, 0x1c, 0x1a, 0xab, 0xca, 0x51, 0xe3, 0x2c, 0x5a, 0x7e, 0x33, 0xc0, 0x11,
-	0xc1, 0xd1, 0xf2, 0xf4, 0xb8, 0x2f, 0xeb, 0xca, 0xd8, 0x9c, 0xbe, 0x21, 0x73, 0x7c, 0x58, 0xd8,
-	0xc2, 0xd2, 0x31, 0xdf, 0x9c, 0xda, 0xea, 0x40, 0x59, 0x5f, 0x5b, 0x2f, 0x02, 0x08, 0x97, 0x80,
-	0xee, 0x7e, 0x88, 0xd9, 0xb5, 0xb3, 0xcd, 0x90, 0xb1, 0x51, 0xa5, 0x75, 0x7c, 0xc0, 0xf6, 0x68,
-	0xa6, 0xa8, 0x74, 0x12, 0x0d, 0xa3, 0x2c, 0x9e, 0xee, 0xd2, 0xfd, 0x85, 0xe6, 0x0f, 0x18, 0x0f,
-	0xc8, 0xa3, 0x74, 0x28, 0x4a, 0xa8, 0x8a, 0x12, 0x93, 0x2b, 0xc3, 0x28, 0xeb, 0x4e, 0x7b, 0x44,
-	0x66, 0x1b, 0xf0, 0x9c, 0xea, 0x3c, 0x63, 0xa1, 0x26, 0xc0, 0xe8, 0x6d, 0xb6, 0x4b, 0xd9, 0x1b,
-	0x54, 0x3f, 0x33, 0xba, 0x4d, 0x8e, 0xd8, 0x41, 0x48, 0xce, 0xc1, 0x14, 0x58, 0x8a, 0xf3, 0xb9,
-	0x55, 0x6f, 0x7c, 0x12, 0xd3, 0xf4, 0x3e, 0xa1, 0x97, 0x44, 0x26, 0x04, 0x38, 0x67, 0xb1, 0x07,
-	0xd0, 0xc9, 0xd5, 0x61, 0x94, 0x5d, 0x9f, 0xd2, 0x99, 0xbf, 0x62, 0x03, 0xa9, 0xb0, 0x5a, 0x82,
-	0xf0, 0x8b, 0x06, 0x9c, 0xb1, 0x1a, 0x84, 0x54, 0xca, 0x2e, 0x0c, 0xfa, 0x64, 0x67, 0xd8, 0xcd,
-	0xf6, 0x27, 0x27, 0x5f, 0x3e, 0x3d, 0x3c, 0x6a, 0x77, 0x1f, 0x2b, 0x35, 0xd6, 0xda, 0x81, 0xf7,
-	0x33, 0x74, 0x95, 0x29, 0xa6, 0x47, 0xc1, 0x9e, 0x6d, 0xe5, 0x71, 0xeb, 0x6e, 0x1a, 0xa3, 0x74,
-	0x05, 0xe0, 0xbf, 0x1a, 0xef, 0xfe, 0x47, 0xe3, 0x60, 0xff, 0xdd, 0xf8, 0x3e, 0xeb, 0x37, 0xd2,
-	0xc9, 0xda, 0x0b, 0x65, 0xeb, 0xba, 0xc2, 0x1a, 0x0c, 0x26, 0x7b, 0xb4, 0x52, 0x2f, 0x80, 0x67,
-	0x3f, 0xeb, 0xfc, 0x11, 0xbb, 0xb5, 0x5d, 0x0f, 0xf0, 0xb2, 0xb0, 0x4f, 0xc2, 0x41, 0xfb, 0xf7,
-	0x80, 0x97, 0x9c, 0x27, 0xec, 0x76, 0x98, 0xed, 0xff, 0x94, 0x18, 0x49, 0x87, 0x2d, 0xfd, 0xcd,
-	0x7a, 0x1a, 0x7f, 0xff, 0x78, 0x27, 0x9a, 0xdc, 0xfb, 0xbc, 0x4a, 0xa3, 0x8b, 0x55, 0x1a, 0x7d,
-	0x5b, 0xa5, 0xd1, 0xfb, 0x75, 0xda, 0xb9, 0x58, 0xa7, 0x9d, 0xaf, 0xeb, 0xb4, 0xf3, 0xba, 0xf7,
-	0xf6, 0xd7, 0xe3, 0xc4, 0x77, 0x0d, 0xf8, 0xf3, 0x1d, 0x7a, 0x48, 0x8f, 0x7f, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xf3, 0x45, 0xb5, 0xc0, 0xbc, 0x02, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0x57, 0x37, 0x6d, 0x4e, 0xd7, 0xc6, 0xbd, 0x1d, 0x6d, 0xba, 0x8d, 0x2c, 0x54, 0x94,
+	0xa6, 0x19, 0x4b, 0x68, 0x19, 0x3c, 0x4c, 0xe2, 0xc1, 0x75, 0x6f, 0x89, 0x45, 0x70, 0x2c, 0x27,
+	0x2d, 0x6c, 0x2f, 0x57, 0x9e, 0x7d, 0x95, 0x58, 0x24, 0xbe, 0x96, 0x7d, 0x53, 0x0d, 0x3e, 0x05,
+	0x12, 0x5f, 0x80, 0x47, 0x1e, 0x11, 0xe2, 0x43, 0xec, 0x05, 0x69, 0xe2, 0x89, 0x27, 0x84, 0xda,
+	0x07, 0xf8, 0x18, 0xc8, 0xc7, 0xce, 0xda, 0xa5, 0x6b, 0xb7, 0xbd, 0x44, 0xf7, 0xfe, 0xfe, 0x9c,
+	0x7b, 0x4f, 0xce, 0xef, 0xca, 0x70, 0x67, 0x38, 0x1e, 0xf1, 0xd8, 0x6d, 0xba, 0x63, 0x3f, 0x90,
+	0xcd, 0x93, 0xdd, 0x26, 0x8f, 0x84, 0x37, 0x68, 0x44, 0xb1, 0x90, 0x82, 0x94, 0x32, 0xb2, 0x81,
+	0x64, 0xe3, 0x64, 0xf7, 0xf6, 0x8a, 0x3b, 0x0a, 0x42, 0xd1, 0xc4, 0xdf, 0x4c, 0x73, 0xfb, 0x56,
+	0x5f, 0xf4, 0x05, 0x2e, 0x9b, 0xe9, 0x2a, 0x47, 0x37, 0x3c, 0x91, 0x8c, 0x44, 0xc2, 0x32, 0x22,
+	0xdb, 0xe4, 0xd4, 0xdd, 0xe9, 0x13, 0x23, 0x37, 0x76, 0x47, 0x39, 0xbb, 0xf9, 0x93, 0x0a, 0x8b,
+	0x34, 0xbd, 0x82, 0x1e, 0x7a, 0x03, 0x11, 0x93, 0x0d, 0x58, 0xc0, 0x1b, 0xb1, 0xc0, 0x2f, 0x2b,
+	0x55, 0xa5, 0xa6, 0x3a, 0xf3, 0xb8, 0x37, 0x7d, 0xf2, 0x31, 0x90, 0x8c, 0x4a, 0xa4, 0x1b, 0x4b,
+	0x36, 0xe0, 0x41, 0x7f, 0x20, 0xcb, 0x37, 0xaa, 0x4a, 0x6d, 0xd6, 0xd1, 0x90, 0xe9, 0xa6, 0x44,
+	0x0b, 0x71, 0x52, 0x83, 0x0c, 0x63, 0x3c, 0xf4, 0x27, 0xda, 0x59, 0xd4, 0x2e, 0x23, 0x4e, 0x43,
+	0x3f, 0x57, 0x36, 0x60, 0x35, 0x53, 0x0e, 0x79, 0xd8, 0x97, 0x03, 0xf6, 0x74, 0x28, 0xbc, 0xef,
+	0x92, 0xb2, 0x8a, 0xa7, 0xaf, 0x20, 0xd5, 0x46, 0x66, 0x1f, 0x09, 0x42, 0x40, 0x4d, 0x38, 0xf7,
+	0xcb, 0x73, 0x55, 0xa5, 0x76, 0xd3, 0xc1, 0x35, 0xf9, 0x06, 0x36, 0x5c, 0x4f, 0x06, 0x27, 0x9c,
+	0x25, 0xe3, 0x88, 0xc7, 0xa1, 0xf0, 0x39, 0x73, 0x3d, 0x4f, 0x8c, 0x43, 0x99, 0x94, 0x0b, 0xd5,
+	0xd9, 0x5a, 0x71, 0xff, 0xce, 0x9f, 0xbf, 0x3f, 0x58, 0xcf, 0xff, 0x19, 0xdd, 0xf3, 0x74, 0xdf,
+	0x8f, 0x79, 0x92, 0x74, 0x65, 0x1c, 0x84, 0x7d, 0x67, 0x3d, 0x73, 0x77, 0x27, 0x66, 0x3d, 0xf7,
+	0xa6, 0x85, 0xa5, 0x1b, 0xf7, 0xb9, 0x7c, 0x5d, 0xe1, 0xf9, 0xb7, 0x28, 0x9c, 0xb9, 0x2f, 0x17,
+	0xbe, 0x0f, 0x2b, 0xd9, 0x20, 0x98, 0x27, 0x46, 0xa3, 0x40, 0x8e, 0x78, 0x28, 0xcb, 0x0b, 0xd8,
+	0x92, 0x96, 0x11, 0xc6, 0x4b, 0x9c, 0xec, 0xc1, 0x7b, 0x93, 0xf6, 0xb8, 0xbc, 0x68, 0x28, 0xa2,
+	0x61, 0x35, 0xbf, 0x3d, 0x97, 0x17, 0x3c, 0x0f, 0x61, 0x2d, 0x3b, 0x3b, 0x99, 0x36, 0x01, 0x9a,
+	0x6e, 0xe5, 0xec, 0x2b, 0xae, 0x47, 0xea, 0x7f, 0x3f, 0xdf, 0x53, 0x36, 0x7f, 0x53, 0x61, 0x03,
+	0x53, 0x71, 0x18, 0x84, 0xee, 0x30, 0xf8, 0xc1, 0x95, 0x81, 0x08, 0xed, 0x58, 0xf4, 0xd3, 0xce,
+	0xae, 0xcb, 0xc8, 0x17, 0x30, 0x17, 0x0d, 0xdc, 0x84, 0x63, 0x2c, 0x96, 0xf7, 0xb6, 0x1b, 0x53,
+	0x89, 0x6e, 0x5c, 0xae, 0x9a, 0xca, 0x9d, 0xcc, 0x45, 0xb6, 0x60, 0x19, 0xc3, 0xc5, 0xa7, 0x22,
+	0xb3, 0x94, 0xa3, 0x79, 0x62, 0xb6, 0xa1, 0xe4, 0x73, 0xd7, 0x1f, 0x06, 0x21, 0x9f, 0xe8, 0xd4,
+	0x2c, 0x5a, 0x13, 0x38, 0x17, 0xee, 0x80, 0xe6, 0x89, 0x51, 0x34, 0xe4, 0x17, 0x2a, 0xce, 0xa1,
+	0xb2, 0xf4, 0x12, 0x3f, 0xaf, 0x19, 0x48, 0x3e, 0xc2, 0x27, 0xe4, 0xf1, 0x24, 0xe1, 0x7e, 0xb9,
+	0x80, 0xbd, 0x2d, 0x23, 0x6c, 0x4f, 0xd0, 0x74, 0x16, 0x78, 0x59, 0x36, 0x2d, 0x9f, 0x47, 0xf9,
+	0x2a, 0x92, 0xe6, 0xab, 0x9e, 0x3a, 0xac, 0x5c, 0xf4, 0x48, 0x21, 0xdd, 0x21, 0x0e, 0x5b, 0x75,
+	0x4a, 0xe7, 0xfa, 0x5e, 0x0a, 0x93, 0xf7, 0x01, 0xa2, 0x78, 0x1c, 0x72, 0x96, 0x48, 0x1e, 0xe1,
+	0x80, 0x97, 0x9c, 0x22, 0x22, 0x5d, 0xc9, 0x23, 0xb2, 0x06, 0x05, 0x6f, 0x1c, 0x27, 0x22, 0xce,
+	0xc7, 0x98, 0xef, 0xc8, 0x5d, 0x28, 0x7a, 0x62, 0x38, 0xe4, 0x9e, 0xe4, 0x7e, 0x79, 0x11, 0x4b,
+	0x9f, 0x03, 0xe4, 0x11, 0x14, 0xb2, 0x50, 0x95, 0x6f, 0x56, 0x95, 0xda, 0xe2, 0xde, 0xfa, 0xa5,
+	0xc1, 0xd8, 0x48, 0xef, 0x17, 0x9f, 0xff, 0x7d, 0x6f, 0xe6, 0x97, 0x7f, 0x7f, 0xad, 0x2b, 0x4e,
+	0xee, 0x20, 0x1f, 0x41, 0x29, 0xe4, 0xcf, 0x24, 0xcb, 0x1f, 0x7f, 0xfa, 0xf4, 0x96, 0xf0, 0xe8,
+	0xa5, 0x14, 0xc6, 0x81, 0x76, 0x39, 0xf7, 0xeb, 0x7f, 0xa8, 0xb0, 0xf6, 0xfa, 0xf1, 0x92, 0x1a,
+	0x7c, 0x48, 0xed, 0x8e, 0xd1, 0x62, 0x87, 0xa6, 0xa5, 0xb7, 0xcd, 0x27, 0x7a, 0xcf, 0xec, 0x58,
+	0xcc, 0x6e, 0xe9, 0x5d, 0xca, 0x8e, 0xac, 0xae, 0x4d, 0x0d, 0xf3, 0xd0, 0xa4, 0x07, 0xda, 0x0c,
+	0xb9, 0x0f, 0xdb, 0x57, 0x2a, 0xa9, 0x75, 0xd8, 0x71, 0x0c, 0xca, 0x74, 0xa3, 0x67, 0x1e, 0x53,
+	0x4d, 0x21, 0x0d, 0xa8, 0x5f, 0x29, 0x76, 0xa8, 0xd1, 0x39, 0xa6, 0x0e, 0xb3, 0x3b, 0xdd, 0x9e,
+	0xdd, 0xb1, 0xe8, 0x81, 0x76, 0x83, 0x7c, 0x06, 0xbb, 0xd7, 0xe8, 0xed, 0x8e, 0xd3, 0xa3, 0x0e,
+	0x3b, 0x30, 0x8f, 0xa9, 0xf3, 0x25, 0xb5, 0x0c, 0xca, 0xba, 0x86, 0x6e, 0x69, 0xb3, 0xef, 0x6c,
+	0x73, 0x74, 0xeb, 0x2b, 0x4d, 0x25, 0x9f, 0xc3, 0xde, 0x3b, 0xd9, 0x74, 0xdb, 0x6e, 0x3f, 0xd6,
+	0xe6, 0xc8, 0x43, 0xf8, 0xe4, 0xcd, 0x3e, 0xa3, 0x4d, 0x75, 0x6b, 0xd2, 0xe4, 0x63, 0xad, 0x70,
+	0xed, 0x1f, 0xd7, 0xa2, 0x7a, 0x9b, 0x75, 0x6c, 0x46, 0xbf, 0xb5, 0x4d, 0x87, 0x6a, 0xf3, 0x64,
+	0x07, 0xb6, 0xde, 0x28, 0xc6, 0xe6, 0x17, 0xc8, 0x03, 0xd8, 0x79, 0x0b, 0x69, 0x8b, 0x1e, 0x1c,
+	0xb5, 0xa9, 0x56, 0x24, 0x9b, 0x50, 0xb9, 0x52, 0x6e, 0x3b, 0x47, 0x16, 0xd5, 0x80, 0x6c, 0xc1,
+	0x07, 0x57, 0x6a, 0x8c, 0xce, 0xd7, 0x76, 0x9b, 0xf6, 0xa8, 0xb6, 0xb8, 0x5f, 0x7f, 0x7e, 0x5a,
+	0x51, 0x5e, 0x9c, 0x56, 0x94, 0x7f, 0x4e, 0x2b, 0xca, 0x8f, 0x67, 0x95, 0x99, 0x17, 0x67, 0x95,
+	0x99, 0xbf, 0xce, 0x2a, 0x33, 0x4f, 0xb4, 0x67, 0xe7, 0x5f, 0x33, 0xf9, 0x7d, 0xc4, 0x93, 0xa7,
+	0x05, 0xfc, 0x9a, 0x7d, 0xfa, 0xff, 0x00, 0xd9, 0x15, 0x7b, 0xa9, 0x5f, 0x07, 0x00, 0x00,
 }
 
 func (this *EpochAnchor) Equal(that interface{}) bool {
@@ -336,6 +589,103 @@ func (m *EpochAnchor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochFinalizationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFinalizationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFinalizationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextEpochSeed) > 0 {
+		i -= len(m.NextEpochSeed)
+		copy(dAtA[i:], m.NextEpochSeed)
+		i = encodeVarintEpoch(dAtA, i, uint64(len(m.NextEpochSeed)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEpoch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Collected != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Collected))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintEpoch(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PruneStep != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.PruneStep))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PhaseItemsTotal != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.PhaseItemsTotal))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PhaseItemsProcessed != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.PhaseItemsProcessed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ItemsProcessed != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.ItemsProcessed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CompletedHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartedHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.StartedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Phase != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEpoch(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEpoch(v)
 	base := offset
@@ -396,6 +746,55 @@ func (m *EpochAnchor) Size() (n int) {
 	return n
 }
 
+func (m *EpochFinalizationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEpoch(uint64(m.EpochId))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovEpoch(uint64(m.Phase))
+	}
+	if m.StartedHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.StartedHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.DeadlineHeight))
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.CompletedHeight))
+	}
+	if m.ItemsProcessed != 0 {
+		n += 1 + sovEpoch(uint64(m.ItemsProcessed))
+	}
+	if m.PhaseItemsProcessed != 0 {
+		n += 1 + sovEpoch(uint64(m.PhaseItemsProcessed))
+	}
+	if m.PhaseItemsTotal != 0 {
+		n += 1 + sovEpoch(uint64(m.PhaseItemsTotal))
+	}
+	if m.PruneStep != 0 {
+		n += 1 + sovEpoch(uint64(m.PruneStep))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovEpoch(uint64(l))
+	}
+	if m.Collected != 0 {
+		n += 1 + sovEpoch(uint64(m.Collected))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovEpoch(uint64(l))
+	l = len(m.NextEpochSeed)
+	if l > 0 {
+		n += 1 + l + sovEpoch(uint64(l))
+	}
+	return n
+}
+
 func sovEpoch(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -728,6 +1127,347 @@ func (m *EpochAnchor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochFinalizationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochFinalizationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochFinalizationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= EpochFinalizationPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedHeight", wireType)
+			}
+			m.StartedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemsProcessed", wireType)
+			}
+			m.ItemsProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemsProcessed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseItemsProcessed", wireType)
+			}
+			m.PhaseItemsProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseItemsProcessed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseItemsTotal", wireType)
+			}
+			m.PhaseItemsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseItemsTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneStep", wireType)
+			}
+			m.PruneStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneStep |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			m.Collected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Collected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextEpochSeed = append(m.NextEpochSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextEpochSeed == nil {
+				m.NextEpochSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEpoch(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,23 +5,24 @@ import (
 )
 
 var (
-	ErrInvalidSigner           = errorsmod.Register(ModuleName, 1, "invalid signer")
-	ErrInvalidEpochID          = errorsmod.Register(ModuleName, 2, "invalid epoch id")
-	ErrDuplicateReport         = errorsmod.Register(ModuleName, 4, "duplicate report")
-	ErrInvalidPeerObservations = errorsmod.Register(ModuleName, 5, "invalid peer observations")
-	ErrInvalidPortStatesLength = errorsmod.Register(ModuleName, 6, "invalid port states length")
-	ErrReporterNotFound        = errorsmod.Register(ModuleName, 7, "reporter supernode not found")
-	ErrInvalidReporterState    = errorsmod.Register(ModuleName, 8, "invalid reporter state")
-	ErrInvalidStorageProofs    = errorsmod.Register(ModuleName, 9, "invalid storage proof results")
-	ErrInvalidRecheckEvidence  = errorsmod.Register(ModuleName, 10, "invalid storage recheck evidence")
-	ErrHealOpNotFound          = errorsmod.Register(ModuleName, 11, "heal op not found")
-	ErrHealOpUnauthorized      = errorsmod.Register(ModuleName, 12, "heal op unauthorized actor")
-	ErrHealOpInvalidState      = errorsmod.Register(ModuleName, 13, "heal op invalid state transition")
-	ErrHealOpTicketMismatch    = errorsmod.Register(ModuleName, 14, "heal op ticket mismatch")
-	ErrHealVerificationExists  = errorsmod.Register(ModuleName, 15, "heal verification already submitted")
-	ErrTicketArtifactMismatch  = errorsmod.Register(ModuleName, 16, "ticket artifact count mismatch")
-	ErrInvalidHealVerification = errorsmod.Register(ModuleName, 17, "invalid heal verification")
-	ErrInvalidHostMetric       = errorsmod.Register(ModuleName, 18, "invalid host metric")
+	ErrInvalidSigner               = errorsmod.Register(ModuleName, 1, "invalid signer")
+	ErrInvalidEpochID              = errorsmod.Register(ModuleName, 2, "invalid epoch id")
+	ErrDuplicateReport             = errorsmod.Register(ModuleName, 4, "duplicate report")
+	ErrInvalidPeerObservations     = errorsmod.Register(ModuleName, 5, "invalid peer observations")
+	ErrInvalidPortStatesLength     = errorsmod.Register(ModuleName, 6, "invalid port states length")
+	ErrReporterNotFound            = errorsmod.Register(ModuleName, 7, "reporter supernode not found")
+	ErrInvalidReporterState        = errorsmod.Register(ModuleName, 8, "invalid reporter state")
+	ErrInvalidStorageProofs        = errorsmod.Register(ModuleName, 9, "invalid storage proof results")
+	ErrInvalidRecheckEvidence      = errorsmod.Register(ModuleName, 10, "invalid storage recheck evidence")
+	ErrHealOpNotFound              = errorsmod.Register(ModuleName, 11, "heal op not found")
+	ErrHealOpUnauthorized          = errorsmod.Register(ModuleName, 12, "heal op unauthorized actor")
+	ErrHealOpInvalidState          = errorsmod.Register(ModuleName, 13, "heal op invalid state transition")
+	ErrHealOpTicketMismatch        = errorsmod.Register(ModuleName, 14, "heal op ticket mismatch")
+	ErrHealVerificationExists      = errorsmod.Register(ModuleName, 15, "heal verification already submitted")
+	ErrTicketArtifactMismatch      = errorsmod.Register(ModuleName, 16, "ticket artifact count mismatch")
+	ErrInvalidHealVerification     = errorsmod.Register(ModuleName, 17, "invalid heal verification")
+	ErrInvalidHostMetric           = errorsmod.Register(ModuleName, 18, "invalid host metric")
+	ErrEpochFinalizationInProgress = errorsmod.Register(ModuleName, 19, "epoch finalization in progress")
 
 	ErrInvalidEvidenceType = errorsmod.Register(ModuleName, 1101, "invalid evidence type")
 	ErrInvalidMetadata     = errorsmod.Register(ModuleName, 1102, "invalid evidence metadata")
@@ -29,5 +30,16 @@ var (
 	ErrInvalidReporter     = errorsmod.Register(ModuleName, 1104, "invalid reporter address")
 	ErrInvalidActionID     = errorsmod.Register(ModuleName, 1105, "invalid action id")
 
+	ErrEvidenceNotFound                = errorsmod.Register(ModuleName, 1106, "evidence not found")
+	ErrInvalidEvidenceDispute          = errorsmod.Register(ModuleName, 1107, "invalid evidence dispute")
+	ErrEvidenceDisputeExists           = errorsmod.Register(ModuleName, 1108, "evidence already disputed")
+	ErrEvidenceDisputeNotFound         = errorsmod.Register(ModuleName, 1109, "evidence dispute not found")
+	ErrEvidenceDisputeClosed           = errorsmod.Register(ModuleName, 1110, "evidence dispute is not pending")
+	ErrEvidenceDisputeUnauthorized     = errorsmod.Register(ModuleName, 1111, "not an evidence dispute panelist")
+	ErrEvidenceDisputeVoteExists       = errorsmod.Register(ModuleName, 1112, "evidence dispute vote already submitted")
+	ErrEvidenceDisputeNoPanel          = errorsmod.Register(ModuleName, 1113, "no eligible evidence dispute panel")
+	ErrEvidenceDisputeWindowElapsed    = errorsmod.Register(ModuleName, 1114, "evidence dispute window elapsed")
+	ErrInsufficientEvidenceDisputeBond = errorsmod.Register(ModuleName, 1115, "insufficient evidence dispute bond")
+
 	ErrNotImplemented = errorsmod.Register(ModuleName, 1200, "not implemented")
 )
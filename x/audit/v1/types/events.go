@@ -20,8 +20,24 @@ const (
 	// Per 121-F11 — heal scheduler cannot find sufficient eligible healers.
 	EventTypeHealOpInsufficientHealers   = "storage_truth_heal_op_insufficient_healers"
 	EventTypeHealOpInsufficientVerifiers = "storage_truth_heal_op_insufficient_verifiers"
+	// Epoch-end processing spread over the first blocks of the next epoch.
+	EventTypeEpochFinalizationStarted   = "epoch_finalization_started"
+	EventTypeEpochFinalizationCompleted = "epoch_finalization_completed"
+	// Subject disputes of evidence records.
+	EventTypeEvidenceDisputed        = "evidence_disputed"
+	EventTypeEvidenceDisputeVote     = "evidence_dispute_vote"
+	EventTypeEvidenceDisputeResolved = "evidence_dispute_resolved"
 
 	AttributeKeyEpochID                  = "epoch_id"
+	AttributeKeyEvidenceID               = "evidence_id"
+	AttributeKeyDisputeID                = "dispute_id"
+	AttributeKeyDisputeStatus            = "dispute_status"
+	AttributeKeySubjectAddress           = "subject_address"
+	AttributeKeyBond                     = "bond"
+	AttributeKeyPanelist                 = "panelist"
+	AttributeKeyUphold                   = "uphold"
+	AttributeKeyDeadlineHeight           = "deadline_height"
+	AttributeKeyItemsProcessed           = "items_processed"
 	AttributeKeyReporterSupernodeAccount = "reporter_supernode_account"
 	AttributeKeyTargetSupernodeAccount   = "target_supernode_account"
 	AttributeKeyTicketID                 = "ticket_id"
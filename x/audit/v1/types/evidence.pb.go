@@ -36,6 +36,8 @@ const (
 	EvidenceType_EVIDENCE_TYPE_STORAGE_CHALLENGE_FAILURE EvidenceType = 4
 	// client-observed cascade flow failure (upload/download).
 	EvidenceType_EVIDENCE_TYPE_CASCADE_CLIENT_FAILURE EvidenceType = 5
+	// action finalization result disagreed with the quorum of a multi-supernode action.
+	EvidenceType_EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH EvidenceType = 6
 )
 
 var EvidenceType_name = map[int32]string{
@@ -45,6 +47,7 @@ var EvidenceType_name = map[int32]string{
 	3: "EVIDENCE_TYPE_ACTION_EXPIRED",
 	4: "EVIDENCE_TYPE_STORAGE_CHALLENGE_FAILURE",
 	5: "EVIDENCE_TYPE_CASCADE_CLIENT_FAILURE",
+	6: "EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH",
 }
 
 var EvidenceType_value = map[string]int32{
@@ -54,6 +57,7 @@ var EvidenceType_value = map[string]int32{
 	"EVIDENCE_TYPE_ACTION_EXPIRED":                        3,
 	"EVIDENCE_TYPE_STORAGE_CHALLENGE_FAILURE":             4,
 	"EVIDENCE_TYPE_CASCADE_CLIENT_FAILURE":                5,
+	"EVIDENCE_TYPE_ACTION_FINALIZATION_RESULT_MISMATCH":   6,
 }
 
 func (x EvidenceType) String() string {
@@ -173,37 +177,38 @@ func init() {
 func init() { proto.RegisterFile("lumera/audit/v1/evidence.proto", fileDescriptor_449f638e48abdbaa) }
 
 var fileDescriptor_449f638e48abdbaa = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x6f, 0xba, 0x6e, 0x74, 0xa6, 0xb4, 0x91, 0xc5, 0x21, 0x1b, 0x2c, 0x44, 0x08, 0x69, 0xd1,
-	0x10, 0x09, 0xa5, 0x07, 0xce, 0x6e, 0xea, 0x76, 0x96, 0xa2, 0xb4, 0x72, 0x52, 0x04, 0xbb, 0x58,
-	0x59, 0x6d, 0x6d, 0x41, 0xb4, 0xa9, 0x12, 0xb7, 0x62, 0x6f, 0xc1, 0x81, 0x47, 0xe1, 0x21, 0x38,
-	0x4e, 0x9c, 0xe0, 0x86, 0xda, 0x17, 0x41, 0x4d, 0x9a, 0x6e, 0x45, 0x93, 0x7a, 0xfc, 0xfd, 0xcd,
-	0xe7, 0x2f, 0x1f, 0xd0, 0xbf, 0xcc, 0xc6, 0x22, 0x09, 0xed, 0x70, 0xc6, 0x23, 0x69, 0xcf, 0x9b,
-	0xb6, 0x98, 0x47, 0x5c, 0x4c, 0x46, 0xc2, 0x9a, 0x26, 0xb1, 0x8c, 0x61, 0x23, 0xd7, 0xad, 0x4c,
-	0xb7, 0xe6, 0xcd, 0xe3, 0xa3, 0x51, 0x9c, 0x8e, 0xe3, 0x94, 0x65, 0xb2, 0x9d, 0x83, 0xdc, 0xfb,
-	0xf2, 0x4f, 0x19, 0x54, 0xf1, 0x3a, 0x0e, 0x5f, 0x80, 0xc7, 0x45, 0x15, 0x8b, 0xb8, 0xa6, 0x18,
-	0x8a, 0x59, 0xa1, 0xa0, 0xa0, 0x08, 0x87, 0x08, 0x34, 0xd2, 0xd9, 0xe5, 0x67, 0x31, 0x92, 0x2c,
-	0xe4, 0x3c, 0x11, 0x69, 0xaa, 0x95, 0x0d, 0xc5, 0x3c, 0x6c, 0x6b, 0xbf, 0x7e, 0xbc, 0x79, 0xba,
-	0x2e, 0x46, 0xb9, 0xe2, 0xcb, 0x24, 0x9a, 0x5c, 0xd1, 0xfa, 0x3a, 0xb0, 0x66, 0xa1, 0x03, 0xd4,
-	0x44, 0x4c, 0xe3, 0x44, 0x8a, 0x64, 0xd3, 0xb1, 0xb7, 0xa3, 0xa3, 0x51, 0x24, 0x8a, 0x92, 0x67,
-	0xe0, 0x30, 0x1c, 0xc9, 0x28, 0x9e, 0xac, 0xc6, 0xac, 0xac, 0xd2, 0xb4, 0x9a, 0x13, 0x84, 0xc3,
-	0x36, 0x78, 0xb2, 0x79, 0x85, 0xbc, 0x99, 0x0a, 0x6d, 0xdf, 0x50, 0xcc, 0xfa, 0xbb, 0x13, 0xeb,
-	0xbf, 0xb5, 0x58, 0xc5, 0xbb, 0x83, 0x9b, 0xa9, 0xa0, 0x35, 0x71, 0x0f, 0xc1, 0x63, 0x50, 0x1d,
-	0x0b, 0x19, 0xf2, 0x50, 0x86, 0xda, 0x81, 0xa1, 0x98, 0x35, 0xba, 0xc1, 0xf0, 0x14, 0x14, 0xf3,
-	0x70, 0x76, 0x2d, 0xa2, 0xab, 0x6b, 0xa9, 0x3d, 0xca, 0x36, 0x55, 0x2f, 0xe8, 0xf3, 0x8c, 0x3d,
-	0xfb, 0x5e, 0x06, 0xb5, 0xfb, 0xdf, 0x80, 0x27, 0xe0, 0x08, 0x7f, 0x20, 0x1d, 0xec, 0x39, 0x98,
-	0x05, 0x9f, 0x06, 0x98, 0x0d, 0x3d, 0x7f, 0x80, 0x1d, 0xd2, 0x25, 0xb8, 0xa3, 0x96, 0xe0, 0x7b,
-	0xd0, 0xda, 0x96, 0x91, 0x13, 0x90, 0xbe, 0xc7, 0xba, 0xc4, 0x43, 0x2e, 0xb9, 0x40, 0x19, 0xf0,
-	0x49, 0xcf, 0x43, 0xc1, 0x90, 0x62, 0xd6, 0x45, 0xc4, 0x1d, 0x52, 0xac, 0x2a, 0xb0, 0x05, 0xec,
-	0xdd, 0x41, 0xaf, 0x1f, 0x30, 0xe2, 0xb1, 0xa0, 0x3f, 0x60, 0xcd, 0xb7, 0x6a, 0x19, 0x1a, 0xe0,
-	0xf9, 0x83, 0x21, 0xfc, 0x71, 0x40, 0x28, 0xee, 0xa8, 0x7b, 0xf0, 0x35, 0x38, 0xdd, 0x76, 0xf8,
-	0x41, 0x9f, 0xa2, 0x1e, 0x66, 0xce, 0x39, 0x72, 0x5d, 0xec, 0xf5, 0xee, 0x66, 0xa8, 0x40, 0x13,
-	0xbc, 0xda, 0x36, 0x3b, 0xc8, 0x77, 0x50, 0x07, 0x33, 0xc7, 0x25, 0xd8, 0x0b, 0x36, 0xce, 0xfd,
-	0xf6, 0xd9, 0xcf, 0x85, 0xae, 0xdc, 0x2e, 0x74, 0xe5, 0xef, 0x42, 0x57, 0xbe, 0x2d, 0xf5, 0xd2,
-	0xed, 0x52, 0x2f, 0xfd, 0x5e, 0xea, 0xa5, 0x0b, 0xf5, 0xeb, 0xdd, 0x4d, 0xaf, 0xfe, 0x5c, 0x7a,
-	0x79, 0x90, 0x5d, 0x69, 0xeb, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x48, 0x43, 0x20, 0xf3,
-	0x02, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x5f, 0x6f, 0xd2, 0x40,
+	0x00, 0xa7, 0x6c, 0x43, 0x76, 0x22, 0x34, 0x17, 0x1f, 0xba, 0xe9, 0x2a, 0x31, 0x26, 0x6b, 0x66,
+	0x6c, 0x45, 0x62, 0x7c, 0x3e, 0xca, 0x01, 0x97, 0x74, 0x85, 0x5c, 0x0f, 0xa3, 0x7b, 0xb9, 0x74,
+	0xf4, 0xb2, 0xd5, 0x08, 0x25, 0xed, 0x41, 0xdc, 0xb7, 0xf0, 0xc3, 0xf8, 0x21, 0x4c, 0x7c, 0x59,
+	0x7c, 0xd2, 0x37, 0x03, 0x5f, 0xc4, 0xd0, 0x52, 0x36, 0x8c, 0x09, 0x8f, 0xbf, 0xbf, 0xfd, 0xdd,
+	0xb5, 0x05, 0xfa, 0xe7, 0xd9, 0x58, 0xc4, 0xbe, 0xe5, 0xcf, 0x82, 0x50, 0x5a, 0xf3, 0x86, 0x25,
+	0xe6, 0x61, 0x20, 0x26, 0x23, 0x61, 0x4e, 0xe3, 0x48, 0x46, 0xb0, 0x96, 0xe9, 0x66, 0xaa, 0x9b,
+	0xf3, 0xc6, 0xf1, 0xd1, 0x28, 0x4a, 0xc6, 0x51, 0xc2, 0x53, 0xd9, 0xca, 0x40, 0xe6, 0x7d, 0xfe,
+	0xbb, 0x08, 0xca, 0x78, 0x1d, 0x87, 0xcf, 0xc0, 0xc3, 0xbc, 0x8a, 0x87, 0x81, 0xa6, 0xd4, 0x15,
+	0x63, 0x9f, 0x82, 0x9c, 0x22, 0x01, 0x44, 0xa0, 0x96, 0xcc, 0x2e, 0x3f, 0x89, 0x91, 0xe4, 0x7e,
+	0x10, 0xc4, 0x22, 0x49, 0xb4, 0x62, 0x5d, 0x31, 0x0e, 0x5b, 0xda, 0xcf, 0x6f, 0xaf, 0x1e, 0xaf,
+	0x8b, 0x51, 0xa6, 0x78, 0x32, 0x0e, 0x27, 0x57, 0xb4, 0xba, 0x0e, 0xac, 0x59, 0x68, 0x03, 0x35,
+	0x16, 0xd3, 0x28, 0x96, 0x22, 0xde, 0x74, 0xec, 0xed, 0xe8, 0xa8, 0xe5, 0x89, 0xbc, 0xe4, 0x09,
+	0x38, 0xf4, 0x47, 0x32, 0x8c, 0x26, 0xab, 0x99, 0xfb, 0xab, 0x34, 0x2d, 0x67, 0x04, 0x09, 0x60,
+	0x0b, 0x3c, 0xda, 0x9c, 0x42, 0xde, 0x4c, 0x85, 0x76, 0x50, 0x57, 0x8c, 0xea, 0x9b, 0x13, 0xf3,
+	0x9f, 0x6b, 0x31, 0xf3, 0x73, 0xb3, 0x9b, 0xa9, 0xa0, 0x15, 0x71, 0x0f, 0xc1, 0x63, 0x50, 0x1e,
+	0x0b, 0xe9, 0x07, 0xbe, 0xf4, 0xb5, 0x52, 0x5d, 0x31, 0x2a, 0x74, 0x83, 0xe1, 0x29, 0xc8, 0xf7,
+	0x04, 0xfc, 0x5a, 0x84, 0x57, 0xd7, 0x52, 0x7b, 0x90, 0xde, 0x54, 0x35, 0xa7, 0x7b, 0x29, 0x7b,
+	0xf6, 0xa3, 0x08, 0x2a, 0xf7, 0x9f, 0x01, 0x4f, 0xc0, 0x11, 0x7e, 0x4f, 0xda, 0xd8, 0xb5, 0x31,
+	0x67, 0x1f, 0x07, 0x98, 0x0f, 0x5d, 0x6f, 0x80, 0x6d, 0xd2, 0x21, 0xb8, 0xad, 0x16, 0xe0, 0x3b,
+	0xd0, 0xdc, 0x96, 0x91, 0xcd, 0x48, 0xdf, 0xe5, 0x1d, 0xe2, 0x22, 0x87, 0x5c, 0xa0, 0x14, 0x78,
+	0xa4, 0xeb, 0x22, 0x36, 0xa4, 0x98, 0x77, 0x10, 0x71, 0x86, 0x14, 0xab, 0x0a, 0x6c, 0x02, 0x6b,
+	0x77, 0xd0, 0xed, 0x33, 0x4e, 0x5c, 0xce, 0xfa, 0x03, 0xde, 0x78, 0xad, 0x16, 0x61, 0x1d, 0x3c,
+	0xfd, 0x6f, 0x08, 0x7f, 0x18, 0x10, 0x8a, 0xdb, 0xea, 0x1e, 0x7c, 0x09, 0x4e, 0xb7, 0x1d, 0x1e,
+	0xeb, 0x53, 0xd4, 0xc5, 0xdc, 0xee, 0x21, 0xc7, 0xc1, 0x6e, 0xf7, 0x6e, 0xc3, 0x3e, 0x34, 0xc0,
+	0x8b, 0x6d, 0xb3, 0x8d, 0x3c, 0x1b, 0xb5, 0x31, 0xb7, 0x1d, 0x82, 0x5d, 0xb6, 0x71, 0x1e, 0xc0,
+	0xb7, 0xa0, 0xb1, 0x7b, 0x2d, 0xc5, 0xde, 0xd0, 0x61, 0xfc, 0x9c, 0x78, 0xe7, 0x88, 0xd9, 0x3d,
+	0xb5, 0xd4, 0x3a, 0xfb, 0xbe, 0xd0, 0x95, 0xdb, 0x85, 0xae, 0xfc, 0x59, 0xe8, 0xca, 0xd7, 0xa5,
+	0x5e, 0xb8, 0x5d, 0xea, 0x85, 0x5f, 0x4b, 0xbd, 0x70, 0xa1, 0x7e, 0xb9, 0xfb, 0x15, 0x56, 0x2f,
+	0x3c, 0xb9, 0x2c, 0xa5, 0x1f, 0x77, 0xf3, 0xef, 0x00, 0xa6, 0x65, 0x4f, 0x90, 0x2a, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
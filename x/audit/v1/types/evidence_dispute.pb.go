@@ -0,0 +1,1292 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lumera/audit/v1/evidence_dispute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EvidenceCounterProofType describes what the accused supernode submits to contest evidence.
+type EvidenceCounterProofType int32
+
+const (
+	EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED EvidenceCounterProofType = 0
+	// hash of a storage-proof transcript showing the challenged data was served.
+	EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_STORAGE_TRANSCRIPT_HASH EvidenceCounterProofType = 1
+	// a finalization payload signed by the supernode showing it finalized correctly.
+	EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_SIGNED_FINALIZATION EvidenceCounterProofType = 2
+	// any other off-chain artifact the panel can check (free-form reference).
+	EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_OTHER EvidenceCounterProofType = 3
+)
+
+var EvidenceCounterProofType_name = map[int32]string{
+	0: "EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED",
+	1: "EVIDENCE_COUNTER_PROOF_TYPE_STORAGE_TRANSCRIPT_HASH",
+	2: "EVIDENCE_COUNTER_PROOF_TYPE_SIGNED_FINALIZATION",
+	3: "EVIDENCE_COUNTER_PROOF_TYPE_OTHER",
+}
+
+var EvidenceCounterProofType_value = map[string]int32{
+	"EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED":             0,
+	"EVIDENCE_COUNTER_PROOF_TYPE_STORAGE_TRANSCRIPT_HASH": 1,
+	"EVIDENCE_COUNTER_PROOF_TYPE_SIGNED_FINALIZATION":     2,
+	"EVIDENCE_COUNTER_PROOF_TYPE_OTHER":                   3,
+}
+
+func (x EvidenceCounterProofType) String() string {
+	return proto.EnumName(EvidenceCounterProofType_name, int32(x))
+}
+
+func (EvidenceCounterProofType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f116126bc80d1e4c, []int{0}
+}
+
+type EvidenceDisputeStatus int32
+
+const (
+	EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UNSPECIFIED EvidenceDisputeStatus = 0
+	// the panel is still voting.
+	EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_PENDING EvidenceDisputeStatus = 1
+	// a panel majority sided with the subject: the evidence is invalidated and the bond refunded.
+	EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UPHELD EvidenceDisputeStatus = 2
+	// a panel majority sided with the evidence: the bond is forfeited.
+	EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_REJECTED EvidenceDisputeStatus = 3
+	// no majority before the deadline: the evidence stands and the bond is refunded.
+	EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_EXPIRED EvidenceDisputeStatus = 4
+)
+
+var EvidenceDisputeStatus_name = map[int32]string{
+	0: "EVIDENCE_DISPUTE_STATUS_UNSPECIFIED",
+	1: "EVIDENCE_DISPUTE_STATUS_PENDING",
+	2: "EVIDENCE_DISPUTE_STATUS_UPHELD",
+	3: "EVIDENCE_DISPUTE_STATUS_REJECTED",
+	4: "EVIDENCE_DISPUTE_STATUS_EXPIRED",
+}
+
+var EvidenceDisputeStatus_value = map[string]int32{
+	"EVIDENCE_DISPUTE_STATUS_UNSPECIFIED": 0,
+	"EVIDENCE_DISPUTE_STATUS_PENDING":     1,
+	"EVIDENCE_DISPUTE_STATUS_UPHELD":      2,
+	"EVIDENCE_DISPUTE_STATUS_REJECTED":    3,
+	"EVIDENCE_DISPUTE_STATUS_EXPIRED":     4,
+}
+
+func (x EvidenceDisputeStatus) String() string {
+	return proto.EnumName(EvidenceDisputeStatus_name, int32(x))
+}
+
+func (EvidenceDisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f116126bc80d1e4c, []int{1}
+}
+
+// EvidenceDisputeVote is a single panelist's vote on a dispute.
+type EvidenceDisputeVote struct {
+	PanelistSupernodeAccount string `protobuf:"bytes,1,opt,name=panelist_supernode_account,json=panelistSupernodeAccount,proto3" json:"panelist_supernode_account,omitempty"`
+	// uphold is true when the panelist accepts the counter-proof.
+	Uphold  bool   `protobuf:"varint,2,opt,name=uphold,proto3" json:"uphold,omitempty"`
+	Height  uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Details string `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *EvidenceDisputeVote) Reset()         { *m = EvidenceDisputeVote{} }
+func (m *EvidenceDisputeVote) String() string { return proto.CompactTextString(m) }
+func (*EvidenceDisputeVote) ProtoMessage()    {}
+func (*EvidenceDisputeVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f116126bc80d1e4c, []int{0}
+}
+func (m *EvidenceDisputeVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceDisputeVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceDisputeVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvidenceDisputeVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceDisputeVote.Merge(m, src)
+}
+func (m *EvidenceDisputeVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceDisputeVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceDisputeVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceDisputeVote proto.InternalMessageInfo
+
+func (m *EvidenceDisputeVote) GetPanelistSupernodeAccount() string {
+	if m != nil {
+		return m.PanelistSupernodeAccount
+	}
+	return ""
+}
+
+func (m *EvidenceDisputeVote) GetUphold() bool {
+	if m != nil {
+		return m.Uphold
+	}
+	return false
+}
+
+func (m *EvidenceDisputeVote) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EvidenceDisputeVote) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+// EvidenceDispute is the on-chain record of the subject contesting an Evidence record.
+type EvidenceDispute struct {
+	DisputeId      uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	EvidenceId     uint64 `protobuf:"varint,2,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
+	SubjectAddress string `protobuf:"bytes,3,opt,name=subject_address,json=subjectAddress,proto3" json:"subject_address,omitempty"`
+	// bond is held by the audit module until the dispute is resolved.
+	Bond             types.Coin               `protobuf:"bytes,4,opt,name=bond,proto3" json:"bond"`
+	CounterProofType EvidenceCounterProofType `protobuf:"varint,5,opt,name=counter_proof_type,json=counterProofType,proto3,enum=lumera.audit.v1.EvidenceCounterProofType" json:"counter_proof_type,omitempty"`
+	CounterProof     string                   `protobuf:"bytes,6,opt,name=counter_proof,json=counterProof,proto3" json:"counter_proof,omitempty"`
+	Details          string                   `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	// panel is the deterministically selected set of supernodes allowed to vote.
+	Panel  []string              `protobuf:"bytes,8,rep,name=panel,proto3" json:"panel,omitempty"`
+	Votes  []EvidenceDisputeVote `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes"`
+	Status EvidenceDisputeStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lumera.audit.v1.EvidenceDisputeStatus" json:"status,omitempty"`
+	// epoch_id is the epoch whose anchor seeded the panel selection.
+	EpochId uint64 `protobuf:"varint,11,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// deadline_epoch_id is the last epoch in which panel votes are accepted.
+	DeadlineEpochId uint64 `protobuf:"varint,12,opt,name=deadline_epoch_id,json=deadlineEpochId,proto3" json:"deadline_epoch_id,omitempty"`
+	CreatedHeight   uint64 `protobuf:"varint,13,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	ResolvedHeight  uint64 `protobuf:"varint,14,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+}
+
+func (m *EvidenceDispute) Reset()         { *m = EvidenceDispute{} }
+func (m *EvidenceDispute) String() string { return proto.CompactTextString(m) }
+func (*EvidenceDispute) ProtoMessage()    {}
+func (*EvidenceDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f116126bc80d1e4c, []int{1}
+}
+func (m *EvidenceDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvidenceDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceDispute.Merge(m, src)
+}
+func (m *EvidenceDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceDispute proto.InternalMessageInfo
+
+func (m *EvidenceDispute) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
+}
+
+func (m *EvidenceDispute) GetEvidenceId() uint64 {
+	if m != nil {
+		return m.EvidenceId
+	}
+	return 0
+}
+
+func (m *EvidenceDispute) GetSubjectAddress() string {
+	if m != nil {
+		return m.SubjectAddress
+	}
+	return ""
+}
+
+func (m *EvidenceDispute) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *EvidenceDispute) GetCounterProofType() EvidenceCounterProofType {
+	if m != nil {
+		return m.CounterProofType
+	}
+	return EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED
+}
+
+func (m *EvidenceDispute) GetCounterProof() string {
+	if m != nil {
+		return m.CounterProof
+	}
+	return ""
+}
+
+func (m *EvidenceDispute) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+func (m *EvidenceDispute) GetPanel() []string {
+	if m != nil {
+		return m.Panel
+	}
+	return nil
+}
+
+func (m *EvidenceDispute) GetVotes() []EvidenceDisputeVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *EvidenceDispute) GetStatus() EvidenceDisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (m *EvidenceDispute) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EvidenceDispute) GetDeadlineEpochId() uint64 {
+	if m != nil {
+		return m.DeadlineEpochId
+	}
+	return 0
+}
+
+func (m *EvidenceDispute) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *EvidenceDispute) GetResolvedHeight() uint64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("lumera.audit.v1.EvidenceCounterProofType", EvidenceCounterProofType_name, EvidenceCounterProofType_value)
+	proto.RegisterEnum("lumera.audit.v1.EvidenceDisputeStatus", EvidenceDisputeStatus_name, EvidenceDisputeStatus_value)
+	proto.RegisterType((*EvidenceDisputeVote)(nil), "lumera.audit.v1.EvidenceDisputeVote")
+	proto.RegisterType((*EvidenceDispute)(nil), "lumera.audit.v1.EvidenceDispute")
+}
+
+func init() {
+	proto.RegisterFile("lumera/audit/v1/evidence_dispute.proto", fileDescriptor_f116126bc80d1e4c)
+}
+
+var fileDescriptor_f116126bc80d1e4c = []byte{
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0x8d, 0x9b, 0xf4, 0x4f, 0x7e, 0xdd, 0x26, 0xde, 0x61, 0x01, 0xb7, 0x88, 0x34, 0xb4, 0xbb,
+	0xdb, 0x10, 0xb4, 0xb1, 0xd2, 0x1e, 0xe0, 0x84, 0x70, 0xe2, 0x69, 0x63, 0xb4, 0x72, 0xac, 0xb1,
+	0xbb, 0xb0, 0x7b, 0xb1, 0x1c, 0x7b, 0x68, 0x8d, 0x52, 0x4f, 0x14, 0x4f, 0x22, 0xf6, 0x5b, 0xf0,
+	0x31, 0x38, 0x22, 0xb4, 0x27, 0x3e, 0xc1, 0x1e, 0x57, 0x2b, 0x0e, 0x1c, 0x10, 0x42, 0xed, 0x81,
+	0xaf, 0x81, 0x3c, 0x1e, 0x77, 0xdb, 0x8a, 0x64, 0x2f, 0x91, 0x7f, 0xbf, 0x79, 0x6f, 0xe6, 0xfd,
+	0xde, 0x9b, 0x0c, 0x3c, 0x1e, 0xcf, 0x2e, 0xe8, 0x34, 0xd0, 0x83, 0x59, 0x14, 0x73, 0x7d, 0xde,
+	0xd5, 0xe9, 0x3c, 0x8e, 0x68, 0x12, 0x52, 0x3f, 0x8a, 0xd3, 0xc9, 0x8c, 0xd3, 0xce, 0x64, 0xca,
+	0x38, 0x43, 0xf5, 0x1c, 0xd7, 0x11, 0xb8, 0xce, 0xbc, 0xbb, 0x73, 0x3f, 0xb8, 0x88, 0x13, 0xa6,
+	0x8b, 0xdf, 0x1c, 0xb3, 0xf3, 0xe0, 0x8c, 0x9d, 0x31, 0xf1, 0xa9, 0x67, 0x5f, 0xb2, 0xbb, 0x1d,
+	0xb2, 0xf4, 0x82, 0xa5, 0x7e, 0xbe, 0x90, 0x17, 0x72, 0xa9, 0x91, 0x57, 0xfa, 0x28, 0x48, 0xa9,
+	0x3e, 0xef, 0x8e, 0x28, 0x0f, 0xba, 0x7a, 0xc8, 0xe2, 0x24, 0x5f, 0xdf, 0xfb, 0x5d, 0x81, 0x0f,
+	0xb0, 0xd4, 0x63, 0xe6, 0x72, 0x9e, 0x31, 0x4e, 0xd1, 0x73, 0xd8, 0x99, 0x04, 0x09, 0x1d, 0xc7,
+	0x29, 0xf7, 0xd3, 0xd9, 0x84, 0x4e, 0x13, 0x16, 0x51, 0x3f, 0x08, 0x43, 0x36, 0x4b, 0xb8, 0xa6,
+	0x34, 0x95, 0x56, 0xb5, 0xf7, 0xc9, 0xdb, 0x57, 0x4f, 0x3e, 0x96, 0xa7, 0x19, 0x61, 0x68, 0x44,
+	0xd1, 0x94, 0xa6, 0xa9, 0xcb, 0xa7, 0x71, 0x72, 0x46, 0xb4, 0x82, 0xee, 0x16, 0x6c, 0x23, 0x27,
+	0xa3, 0x8f, 0x60, 0x6d, 0x36, 0x39, 0x67, 0xe3, 0x48, 0x5b, 0x69, 0x2a, 0xad, 0x0d, 0x22, 0xab,
+	0xac, 0x7f, 0x4e, 0xe3, 0xb3, 0x73, 0xae, 0x95, 0x9b, 0x4a, 0xab, 0x42, 0x64, 0x85, 0x34, 0x58,
+	0x8f, 0x28, 0x0f, 0xe2, 0x71, 0xaa, 0x55, 0xb2, 0x73, 0x49, 0x51, 0xee, 0xfd, 0xb6, 0x0a, 0xf5,
+	0x3b, 0xe2, 0xd1, 0xa7, 0x00, 0xd2, 0x56, 0x3f, 0x8e, 0x84, 0xd0, 0x0a, 0xa9, 0xca, 0x8e, 0x15,
+	0xa1, 0x5d, 0xd8, 0xbc, 0xb6, 0x3f, 0xce, 0x15, 0x54, 0x08, 0x14, 0x2d, 0x2b, 0x42, 0x06, 0xd4,
+	0xd3, 0xd9, 0xe8, 0x47, 0x1a, 0x72, 0x3f, 0xc8, 0x07, 0x12, 0x72, 0xaa, 0x3d, 0xed, 0xed, 0xab,
+	0x27, 0x0f, 0x8a, 0x69, 0x6f, 0x8d, 0x5a, 0x93, 0x04, 0xd9, 0x45, 0x5f, 0x41, 0x65, 0xc4, 0x92,
+	0x48, 0xa8, 0xdd, 0x3c, 0xdc, 0xee, 0x48, 0x52, 0x16, 0x41, 0x47, 0x46, 0xd0, 0xe9, 0xb3, 0x38,
+	0xe9, 0x55, 0x5f, 0xff, 0xbd, 0x5b, 0xfa, 0xe5, 0xdf, 0x5f, 0xdb, 0x0a, 0x11, 0x0c, 0xf4, 0x1d,
+	0x20, 0xe1, 0x11, 0x9d, 0x66, 0x59, 0xb2, 0x1f, 0x7c, 0xfe, 0x72, 0x42, 0xb5, 0xd5, 0xa6, 0xd2,
+	0xaa, 0x1d, 0x7e, 0xde, 0xb9, 0x73, 0x3f, 0x3a, 0xc5, 0xe8, 0xfd, 0x9c, 0xe2, 0x64, 0x0c, 0xef,
+	0xe5, 0x84, 0x12, 0x35, 0xbc, 0xd3, 0x41, 0xfb, 0xb0, 0x75, 0x6b, 0x63, 0x6d, 0x4d, 0x38, 0x79,
+	0xef, 0x26, 0xf0, 0xa6, 0xd1, 0xeb, 0xb7, 0x8c, 0x46, 0x5d, 0x58, 0x15, 0x71, 0x6a, 0x1b, 0xcd,
+	0xf2, 0xfb, 0x82, 0xcf, 0x91, 0xe8, 0x1b, 0x58, 0x9d, 0x33, 0x4e, 0x53, 0xad, 0xda, 0x2c, 0xb7,
+	0x36, 0x0f, 0x1f, 0x2e, 0x54, 0x7f, 0xe3, 0xd6, 0xf5, 0x2a, 0x99, 0x21, 0x24, 0x27, 0xa2, 0xaf,
+	0x61, 0x2d, 0xe5, 0x01, 0x9f, 0xa5, 0x1a, 0x08, 0x03, 0x1e, 0xbf, 0x6f, 0x0b, 0x57, 0xa0, 0x89,
+	0x64, 0xa1, 0x6d, 0xd8, 0xa0, 0x13, 0x16, 0x9e, 0x67, 0x39, 0x6f, 0x8a, 0x9c, 0xd7, 0x45, 0x6d,
+	0x45, 0xa8, 0x0d, 0xf7, 0x23, 0x1a, 0x44, 0xe3, 0x38, 0xa1, 0xfe, 0x35, 0xe6, 0x9e, 0xc0, 0xd4,
+	0x8b, 0x05, 0x2c, 0xb1, 0x8f, 0xa0, 0x16, 0x4e, 0x69, 0xc0, 0x69, 0xe4, 0xcb, 0xeb, 0xb9, 0x25,
+	0x80, 0x5b, 0xb2, 0x3b, 0xc8, 0x6f, 0xe9, 0x01, 0xd4, 0xa7, 0x34, 0x65, 0xe3, 0xf9, 0x3b, 0x5c,
+	0x4d, 0xe0, 0x6a, 0x45, 0x3b, 0x07, 0xb6, 0xff, 0x52, 0x40, 0x5b, 0x94, 0x1c, 0xfa, 0x02, 0x0e,
+	0xf0, 0x33, 0xcb, 0xc4, 0x76, 0x1f, 0xfb, 0xfd, 0xe1, 0xa9, 0xed, 0x61, 0xe2, 0x3b, 0x64, 0x38,
+	0x3c, 0xf6, 0xbd, 0xe7, 0x0e, 0xf6, 0x4f, 0x6d, 0xd7, 0xc1, 0x7d, 0xeb, 0xd8, 0xc2, 0xa6, 0x5a,
+	0x42, 0x5f, 0xc2, 0xd1, 0x32, 0xb0, 0xeb, 0x0d, 0x89, 0x71, 0x82, 0x7d, 0x8f, 0x18, 0xb6, 0xdb,
+	0x27, 0x96, 0xe3, 0xf9, 0x03, 0xc3, 0x1d, 0xa8, 0x0a, 0x3a, 0x02, 0x7d, 0x29, 0xd1, 0x3a, 0xb1,
+	0xb1, 0xe9, 0x1f, 0x5b, 0xb6, 0xf1, 0xd4, 0x7a, 0x61, 0x78, 0xd6, 0xd0, 0x56, 0x57, 0xd0, 0x23,
+	0xf8, 0x6c, 0x19, 0x69, 0xe8, 0x0d, 0x30, 0x51, 0xcb, 0xed, 0x3f, 0x14, 0xf8, 0xf0, 0x7f, 0x73,
+	0x41, 0x07, 0xb0, 0x7f, 0xbd, 0x81, 0x69, 0xb9, 0xce, 0xa9, 0x97, 0x69, 0x34, 0xbc, 0x53, 0xf7,
+	0xce, 0x5c, 0xfb, 0xb0, 0xbb, 0x08, 0xe8, 0x60, 0xdb, 0xb4, 0xec, 0x13, 0x55, 0x41, 0x7b, 0xd0,
+	0x58, 0xb8, 0x9b, 0x33, 0xc0, 0x4f, 0x4d, 0x75, 0x05, 0x3d, 0x84, 0xe6, 0x22, 0x0c, 0xc1, 0xdf,
+	0xe2, 0xbe, 0x87, 0x4d, 0xb5, 0xbc, 0xec, 0x38, 0xfc, 0xbd, 0x63, 0x11, 0x6c, 0xaa, 0x95, 0x5e,
+	0xfb, 0xf5, 0x65, 0x43, 0x79, 0x73, 0xd9, 0x50, 0xfe, 0xb9, 0x6c, 0x28, 0x3f, 0x5f, 0x35, 0x4a,
+	0x6f, 0xae, 0x1a, 0xa5, 0x3f, 0xaf, 0x1a, 0xa5, 0x17, 0xea, 0x4f, 0xef, 0x5e, 0xf6, 0xec, 0xef,
+	0x9a, 0x8e, 0xd6, 0xc4, 0xd3, 0x7a, 0xf4, 0xdf, 0x00, 0xcf, 0x76, 0xa9, 0x79, 0xf9, 0x05, 0x00,
+	0x00,
+}
+
+func (m *EvidenceDisputeVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceDisputeVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceDisputeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Uphold {
+		i--
+		if m.Uphold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PanelistSupernodeAccount) > 0 {
+		i -= len(m.PanelistSupernodeAccount)
+		copy(dAtA[i:], m.PanelistSupernodeAccount)
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(len(m.PanelistSupernodeAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DeadlineEpochId != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.DeadlineEpochId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidenceDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Panel) > 0 {
+		for iNdEx := len(m.Panel) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Panel[iNdEx])
+			copy(dAtA[i:], m.Panel[iNdEx])
+			i = encodeVarintEvidenceDispute(dAtA, i, uint64(len(m.Panel[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CounterProof) > 0 {
+		i -= len(m.CounterProof)
+		copy(dAtA[i:], m.CounterProof)
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(len(m.CounterProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CounterProofType != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.CounterProofType))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SubjectAddress) > 0 {
+		i -= len(m.SubjectAddress)
+		copy(dAtA[i:], m.SubjectAddress)
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(len(m.SubjectAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EvidenceId != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.EvidenceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintEvidenceDispute(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidenceDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidenceDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EvidenceDisputeVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PanelistSupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovEvidenceDispute(uint64(l))
+	}
+	if m.Uphold {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.Height))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovEvidenceDispute(uint64(l))
+	}
+	return n
+}
+
+func (m *EvidenceDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.DisputeId))
+	}
+	if m.EvidenceId != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.EvidenceId))
+	}
+	l = len(m.SubjectAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidenceDispute(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovEvidenceDispute(uint64(l))
+	if m.CounterProofType != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.CounterProofType))
+	}
+	l = len(m.CounterProof)
+	if l > 0 {
+		n += 1 + l + sovEvidenceDispute(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovEvidenceDispute(uint64(l))
+	}
+	if len(m.Panel) > 0 {
+		for _, s := range m.Panel {
+			l = len(s)
+			n += 1 + l + sovEvidenceDispute(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovEvidenceDispute(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.Status))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.EpochId))
+	}
+	if m.DeadlineEpochId != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.DeadlineEpochId))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.CreatedHeight))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovEvidenceDispute(uint64(m.ResolvedHeight))
+	}
+	return n
+}
+
+func sovEvidenceDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidenceDispute(x uint64) (n int) {
+	return sovEvidenceDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EvidenceDisputeVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidenceDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceDisputeVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceDisputeVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PanelistSupernodeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PanelistSupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uphold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Uphold = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidenceDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidenceDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceId", wireType)
+			}
+			m.EvidenceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterProofType", wireType)
+			}
+			m.CounterProofType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterProofType |= EvidenceCounterProofType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Panel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Panel = append(m.Panel, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, EvidenceDisputeVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EvidenceDisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineEpochId", wireType)
+			}
+			m.DeadlineEpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineEpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidenceDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidenceDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidenceDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidenceDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidenceDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidenceDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidenceDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidenceDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidenceDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidenceDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidenceDispute = fmt.Errorf("proto: unexpected end of group")
+)
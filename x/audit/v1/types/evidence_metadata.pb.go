@@ -201,6 +201,75 @@ func (m *ActionFinalizationNotInTop10EvidenceMetadata) GetTop_10ValidatorAddress
 	return nil
 }
 
+// ActionFinalizationResultMismatchEvidenceMetadata is metadata for evidence about a finalization
+// result that disagreed with the quorum result of a multi-supernode action.
+type ActionFinalizationResultMismatchEvidenceMetadata struct {
+	// top_10_validator_addresses is the expected validator set for the action's block height.
+	Top_10ValidatorAddresses []string `protobuf:"bytes,1,rep,name=top_10_validator_addresses,json=top10ValidatorAddresses,proto3" json:"top_10_validator_addresses,omitempty"`
+	// quorum_result_hash is the hex-encoded result hash agreed by the quorum.
+	QuorumResultHash string `protobuf:"bytes,2,opt,name=quorum_result_hash,json=quorumResultHash,proto3" json:"quorum_result_hash,omitempty"`
+	// submitted_result_hash is the hex-encoded result hash submitted by the subject.
+	SubmittedResultHash string `protobuf:"bytes,3,opt,name=submitted_result_hash,json=submittedResultHash,proto3" json:"submitted_result_hash,omitempty"`
+}
+
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) Reset() {
+	*m = ActionFinalizationResultMismatchEvidenceMetadata{}
+}
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ActionFinalizationResultMismatchEvidenceMetadata) ProtoMessage() {}
+func (*ActionFinalizationResultMismatchEvidenceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b57e4c2349ab91, []int{3}
+}
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionFinalizationResultMismatchEvidenceMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionFinalizationResultMismatchEvidenceMetadata.Merge(m, src)
+}
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionFinalizationResultMismatchEvidenceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionFinalizationResultMismatchEvidenceMetadata proto.InternalMessageInfo
+
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) GetTop_10ValidatorAddresses() []string {
+	if m != nil {
+		return m.Top_10ValidatorAddresses
+	}
+	return nil
+}
+
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) GetQuorumResultHash() string {
+	if m != nil {
+		return m.QuorumResultHash
+	}
+	return ""
+}
+
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) GetSubmittedResultHash() string {
+	if m != nil {
+		return m.SubmittedResultHash
+	}
+	return ""
+}
+
 // StorageChallengeFailureEvidenceMetadata is metadata for a storage challenge failure submitted by a challenger.
 type StorageChallengeFailureEvidenceMetadata struct {
 	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
@@ -225,7 +294,7 @@ func (m *StorageChallengeFailureEvidenceMetadata) Reset() {
 func (m *StorageChallengeFailureEvidenceMetadata) String() string { return proto.CompactTextString(m) }
 func (*StorageChallengeFailureEvidenceMetadata) ProtoMessage()    {}
 func (*StorageChallengeFailureEvidenceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09b57e4c2349ab91, []int{3}
+	return fileDescriptor_09b57e4c2349ab91, []int{4}
 }
 func (m *StorageChallengeFailureEvidenceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +393,7 @@ func (m *CascadeClientFailureDetails) Reset()         { *m = CascadeClientFailur
 func (m *CascadeClientFailureDetails) String() string { return proto.CompactTextString(m) }
 func (*CascadeClientFailureDetails) ProtoMessage()    {}
 func (*CascadeClientFailureDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09b57e4c2349ab91, []int{4}
+	return fileDescriptor_09b57e4c2349ab91, []int{5}
 }
 func (m *CascadeClientFailureDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +485,7 @@ func (m *CascadeClientFailureEvidenceMetadata) Reset()         { *m = CascadeCli
 func (m *CascadeClientFailureEvidenceMetadata) String() string { return proto.CompactTextString(m) }
 func (*CascadeClientFailureEvidenceMetadata) ProtoMessage()    {}
 func (*CascadeClientFailureEvidenceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09b57e4c2349ab91, []int{5}
+	return fileDescriptor_09b57e4c2349ab91, []int{6}
 }
 func (m *CascadeClientFailureEvidenceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,6 +540,7 @@ func init() {
 	proto.RegisterType((*ActionExpiredEvidenceMetadata)(nil), "lumera.audit.v1.ActionExpiredEvidenceMetadata")
 	proto.RegisterType((*ActionFinalizationSignatureFailureEvidenceMetadata)(nil), "lumera.audit.v1.ActionFinalizationSignatureFailureEvidenceMetadata")
 	proto.RegisterType((*ActionFinalizationNotInTop10EvidenceMetadata)(nil), "lumera.audit.v1.ActionFinalizationNotInTop10EvidenceMetadata")
+	proto.RegisterType((*ActionFinalizationResultMismatchEvidenceMetadata)(nil), "lumera.audit.v1.ActionFinalizationResultMismatchEvidenceMetadata")
 	proto.RegisterType((*StorageChallengeFailureEvidenceMetadata)(nil), "lumera.audit.v1.StorageChallengeFailureEvidenceMetadata")
 	proto.RegisterType((*CascadeClientFailureDetails)(nil), "lumera.audit.v1.CascadeClientFailureDetails")
 	proto.RegisterType((*CascadeClientFailureEvidenceMetadata)(nil), "lumera.audit.v1.CascadeClientFailureEvidenceMetadata")
@@ -481,55 +551,59 @@ func init() {
 }
 
 var fileDescriptor_09b57e4c2349ab91 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0x1a, 0x47,
-	0x14, 0x66, 0xc1, 0x31, 0x61, 0x52, 0x25, 0x78, 0x54, 0x09, 0x6c, 0x27, 0xc8, 0x41, 0xad, 0x6c,
-	0x45, 0x31, 0x18, 0xf7, 0x4f, 0x6d, 0xaf, 0xd6, 0xcb, 0xd2, 0x6c, 0x93, 0x00, 0xda, 0x25, 0xb9,
-	0xa8, 0xd4, 0x8e, 0xa6, 0x3b, 0x27, 0x30, 0xca, 0xb2, 0xb3, 0x9a, 0x19, 0x50, 0xe8, 0x4d, 0x1f,
-	0xa0, 0x55, 0xd5, 0x4a, 0xbd, 0xee, 0x53, 0xe4, 0x21, 0x7a, 0x19, 0xf9, 0xaa, 0x97, 0x95, 0xfd,
-	0x22, 0xd5, 0xfe, 0x00, 0x32, 0xb8, 0x8a, 0x7d, 0xe3, 0xbb, 0x3d, 0xe7, 0x3b, 0xdf, 0xa7, 0x33,
-	0xdf, 0x39, 0xb3, 0x83, 0xf6, 0x83, 0xc9, 0x18, 0x24, 0x6d, 0xd2, 0x09, 0xe3, 0xba, 0x39, 0x6d,
-	0x35, 0x61, 0xca, 0x19, 0x84, 0x3e, 0x90, 0x31, 0x68, 0xca, 0xa8, 0xa6, 0x8d, 0x48, 0x0a, 0x2d,
-	0xf0, 0xbd, 0xb4, 0xb0, 0x91, 0x14, 0x36, 0xa6, 0xad, 0x9d, 0x6d, 0x5f, 0xa8, 0xb1, 0x50, 0x24,
-	0x81, 0x9b, 0x69, 0x90, 0xd6, 0xd6, 0x7f, 0x46, 0x0f, 0x4c, 0x5f, 0x73, 0x11, 0xda, 0x6f, 0x22,
-	0x2e, 0x81, 0xd9, 0x99, 0xe6, 0xf3, 0x4c, 0x12, 0xff, 0x80, 0x76, 0xb4, 0x88, 0x48, 0xeb, 0x88,
-	0x4c, 0x69, 0xc0, 0x19, 0xd5, 0x42, 0x12, 0xca, 0x98, 0x04, 0xa5, 0x40, 0x55, 0x8d, 0xbd, 0xc2,
-	0x41, 0xe9, 0xe4, 0xe1, 0xe9, 0xdb, 0xc3, 0x07, 0x99, 0xec, 0xcb, 0x79, 0x95, 0x99, 0x16, 0x79,
-	0x5a, 0xf2, 0x70, 0xe8, 0x56, 0xb4, 0x88, 0x5a, 0x47, 0xab, 0x20, 0xa8, 0xfa, 0x9f, 0x06, 0x3a,
-	0x4e, 0x3b, 0xe8, 0xf0, 0x90, 0x06, 0xfc, 0x27, 0x1a, 0x7f, 0x7b, 0x7c, 0x18, 0x52, 0x3d, 0x91,
-	0xd0, 0xa1, 0x3c, 0x98, 0x48, 0xb8, 0xf1, 0xb6, 0x7e, 0x33, 0xd0, 0xe3, 0xf5, 0xb6, 0xba, 0x42,
-	0x3b, 0xe1, 0x20, 0xa6, 0xdc, 0x78, 0x43, 0xbf, 0x16, 0xd0, 0xbe, 0xa7, 0x85, 0xa4, 0x43, 0xb0,
-	0x46, 0x34, 0x08, 0x20, 0x1c, 0xfe, 0xaf, 0x39, 0xdb, 0xe8, 0x36, 0x44, 0xc2, 0x1f, 0x11, 0xce,
-	0xaa, 0xc6, 0x9e, 0x71, 0xb0, 0xe1, 0x16, 0x93, 0xd8, 0x61, 0xf8, 0x7b, 0x74, 0xdf, 0x9f, 0xd3,
-	0x25, 0x51, 0x93, 0x08, 0x64, 0x28, 0x18, 0x10, 0xea, 0xfb, 0x62, 0x12, 0xea, 0x6a, 0x7e, 0xcf,
-	0x38, 0x28, 0x9d, 0xec, 0x9e, 0xbe, 0x3d, 0xac, 0x64, 0x8d, 0x9a, 0xbe, 0x7f, 0xb1, 0xc5, 0x9d,
-	0xa5, 0x80, 0x37, 0xe7, 0x9b, 0x29, 0xfd, 0x82, 0x3c, 0xbb, 0x44, 0xbe, 0x70, 0x1d, 0x79, 0xb6,
-	0x26, 0xff, 0x10, 0x7d, 0xb0, 0x40, 0xe3, 0xc3, 0x6d, 0xc4, 0x72, 0xee, 0x9d, 0x45, 0xce, 0x61,
-	0xf1, 0xd9, 0x5f, 0xf1, 0x00, 0xc8, 0x6b, 0x98, 0x55, 0x6f, 0x25, 0x70, 0x31, 0x8e, 0x9f, 0xc2,
-	0x2c, 0x66, 0xbf, 0x4a, 0x1d, 0x23, 0x7a, 0x16, 0x41, 0x75, 0x33, 0x65, 0x67, 0xb9, 0xc1, 0x2c,
-	0x02, 0xbc, 0x8f, 0xee, 0x69, 0x49, 0x43, 0xe5, 0x4b, 0x1e, 0x69, 0x32, 0xa2, 0x6a, 0x54, 0x2d,
-	0x26, 0x55, 0x77, 0x97, 0xe9, 0x27, 0x54, 0x8d, 0xea, 0x7f, 0xe5, 0xd1, 0xae, 0x45, 0x95, 0x4f,
-	0x19, 0x58, 0x01, 0x87, 0x50, 0x67, 0xb3, 0x68, 0x83, 0xa6, 0x3c, 0x50, 0xf8, 0x3e, 0x2a, 0x89,
-	0x08, 0x64, 0xb2, 0x35, 0xc9, 0x0c, 0x4a, 0xee, 0x32, 0x11, 0xa3, 0x5c, 0xcf, 0xd1, 0x7c, 0x8a,
-	0x2e, 0x12, 0xf8, 0x10, 0xe1, 0xa5, 0x73, 0x10, 0xb2, 0x48, 0xf0, 0xb9, 0x75, 0xee, 0xd6, 0x02,
-	0xb1, 0x33, 0x00, 0x3f, 0x41, 0x5b, 0xeb, 0x46, 0x6f, 0xbc, 0xdf, 0xe8, 0xb2, 0x5a, 0xb5, 0xb7,
-	0x82, 0x8a, 0x9a, 0xaa, 0xd7, 0xb1, 0xb3, 0xa9, 0x75, 0x9b, 0x71, 0xe8, 0x30, 0xfc, 0x21, 0xba,
-	0x05, 0x52, 0x0a, 0x99, 0x59, 0x96, 0x06, 0x78, 0x17, 0x95, 0x68, 0x72, 0x45, 0x62, 0x42, 0x6a,
-	0xd3, 0xed, 0x34, 0xe1, 0xb0, 0xfa, 0x1f, 0x79, 0xf4, 0xd1, 0x65, 0x06, 0xad, 0x2d, 0x2b, 0x20,
-	0x2c, 0x21, 0x12, 0x52, 0x83, 0x24, 0xbe, 0x18, 0x47, 0x22, 0x84, 0x50, 0x27, 0x96, 0xdd, 0x3d,
-	0xfe, 0xbc, 0xb1, 0xf2, 0x2b, 0x6b, 0x5c, 0x26, 0xe9, 0x66, 0x74, 0x6b, 0xce, 0x76, 0xb7, 0xe4,
-	0x6a, 0x0a, 0x7f, 0x85, 0xb6, 0x35, 0x95, 0x43, 0xd0, 0xeb, 0x5b, 0xa9, 0xaa, 0xf9, 0xf8, 0x7a,
-	0xba, 0x95, 0xb4, 0x60, 0x75, 0xeb, 0x14, 0xee, 0xa0, 0x22, 0x4b, 0xe7, 0x9a, 0x4c, 0xe1, 0xce,
-	0xf1, 0xe3, 0x2b, 0xf5, 0x95, 0xed, 0x82, 0x3b, 0x27, 0x3f, 0xfa, 0x25, 0x8f, 0x3e, 0xbe, 0xd2,
-	0x01, 0xf0, 0x97, 0xe8, 0x33, 0xcb, 0xf4, 0x2c, 0xb3, 0x6d, 0x13, 0xeb, 0x99, 0x63, 0x77, 0x07,
-	0xa4, 0x63, 0x3a, 0xcf, 0x5e, 0xb8, 0x36, 0x71, 0xed, 0x7e, 0xcf, 0x1d, 0xd8, 0x2e, 0xb1, 0x7a,
-	0xcf, 0xfb, 0xbd, 0x6e, 0x0c, 0xbd, 0xe8, 0x7a, 0x7d, 0xdb, 0x72, 0x3a, 0x8e, 0xdd, 0x2e, 0xe7,
-	0xf0, 0xd7, 0xe8, 0x8b, 0xab, 0x53, 0xbd, 0x2e, 0x31, 0xfb, 0x0e, 0xf1, 0x6c, 0xf7, 0xa5, 0xed,
-	0x96, 0x0d, 0xfc, 0x29, 0x3a, 0xba, 0x06, 0xb9, 0xfd, 0x94, 0x7c, 0xd3, 0x2b, 0xe7, 0xaf, 0xcf,
-	0xfa, 0xd6, 0x2b, 0x17, 0x4e, 0x1e, 0xfd, 0x7d, 0x56, 0x33, 0xde, 0x9d, 0xd5, 0x8c, 0x7f, 0xcf,
-	0x6a, 0xc6, 0xef, 0xe7, 0xb5, 0xdc, 0xbb, 0xf3, 0x5a, 0xee, 0x9f, 0xf3, 0x5a, 0xee, 0xbb, 0xf2,
-	0x9b, 0xe5, 0x23, 0x17, 0xdf, 0x54, 0xf5, 0xe3, 0x66, 0xf2, 0x5a, 0x7d, 0xf2, 0x5f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x4b, 0x3b, 0x3e, 0xf2, 0x04, 0x07, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x35, 0x25, 0xc7, 0x8a, 0x26, 0x45, 0x22, 0x4f, 0x5b, 0x58, 0xb6, 0x13, 0xc1, 0x31, 0x5a,
+	0xd8, 0x08, 0x6c, 0xf9, 0xd1, 0x17, 0xda, 0xae, 0x68, 0x9a, 0x6a, 0xd8, 0xc4, 0xb2, 0x31, 0x74,
+	0xb2, 0x28, 0xd0, 0x0e, 0x26, 0x9c, 0x1b, 0x69, 0x10, 0x8a, 0xc3, 0xce, 0x0c, 0x8d, 0xb8, 0x9b,
+	0x7e, 0x40, 0x8b, 0xa2, 0x05, 0xba, 0xee, 0x57, 0xe4, 0x23, 0xba, 0x0c, 0xbc, 0xea, 0xb2, 0xb0,
+	0x7f, 0xa1, 0x1f, 0x50, 0x90, 0x43, 0x59, 0xb0, 0xe4, 0xa2, 0xf6, 0xc6, 0x3b, 0xce, 0x3d, 0xf7,
+	0x1c, 0x9c, 0x39, 0xf7, 0x92, 0x44, 0x2b, 0x71, 0x36, 0x00, 0xc5, 0x36, 0x58, 0xc6, 0x85, 0xd9,
+	0x38, 0xda, 0xda, 0x80, 0x23, 0xc1, 0x21, 0x89, 0x80, 0x0e, 0xc0, 0x30, 0xce, 0x0c, 0x6b, 0xa7,
+	0x4a, 0x1a, 0x89, 0xef, 0xd9, 0xc6, 0x76, 0xd1, 0xd8, 0x3e, 0xda, 0x5a, 0x98, 0x8f, 0xa4, 0x1e,
+	0x48, 0x4d, 0x0b, 0x78, 0xc3, 0x1e, 0x6c, 0xef, 0xf2, 0x8f, 0xe8, 0x81, 0x1b, 0x19, 0x21, 0x13,
+	0xff, 0x75, 0x2a, 0x14, 0x70, 0xbf, 0xd4, 0xdc, 0x2b, 0x25, 0xf1, 0x77, 0x68, 0xc1, 0xc8, 0x94,
+	0x6e, 0x6d, 0xd2, 0x23, 0x16, 0x0b, 0xce, 0x8c, 0x54, 0x94, 0x71, 0xae, 0x40, 0x6b, 0xd0, 0x4d,
+	0x67, 0xa9, 0xba, 0x5a, 0xdf, 0x79, 0x78, 0xf2, 0x66, 0xfd, 0x41, 0x29, 0xfb, 0x7c, 0xd8, 0xe5,
+	0xda, 0xa6, 0xd0, 0x28, 0x91, 0xf4, 0xc8, 0x9c, 0x91, 0xe9, 0xd6, 0xe6, 0x38, 0x08, 0x7a, 0xf9,
+	0x77, 0x07, 0x6d, 0x5b, 0x07, 0x1d, 0x91, 0xb0, 0x58, 0xfc, 0xc0, 0xf2, 0xe7, 0x50, 0xf4, 0x12,
+	0x66, 0x32, 0x05, 0x1d, 0x26, 0xe2, 0x4c, 0xc1, 0x8d, 0xdb, 0xfa, 0xc5, 0x41, 0x6b, 0x93, 0xb6,
+	0xba, 0xd2, 0x04, 0xc9, 0x61, 0x4e, 0xb9, 0x71, 0x43, 0xff, 0x38, 0x68, 0x73, 0xd2, 0x10, 0x01,
+	0x9d, 0xc5, 0x66, 0x4f, 0xe8, 0x01, 0x33, 0x51, 0xff, 0xa6, 0x4d, 0xe1, 0x35, 0x84, 0xbf, 0xcf,
+	0xa4, 0xca, 0x06, 0x54, 0x15, 0x46, 0x68, 0x9f, 0xe9, 0x7e, 0xb3, 0xb2, 0xe4, 0xac, 0xd6, 0x49,
+	0xc3, 0x22, 0xd6, 0xe1, 0x63, 0xa6, 0xfb, 0x78, 0x1b, 0xbd, 0xaf, 0xb3, 0x17, 0x03, 0x61, 0x0c,
+	0xf0, 0x0b, 0x84, 0x6a, 0x41, 0x78, 0xf7, 0x1c, 0x1c, 0x71, 0x96, 0x7f, 0xae, 0xa2, 0x95, 0xd0,
+	0x48, 0xc5, 0x7a, 0xe0, 0xf5, 0x59, 0x1c, 0x43, 0xd2, 0xfb, 0xcf, 0x9d, 0x98, 0x47, 0xb7, 0x21,
+	0x95, 0x51, 0x9f, 0x0a, 0xde, 0x74, 0x96, 0x9c, 0xd5, 0x69, 0x52, 0x2b, 0xce, 0x01, 0xc7, 0xdf,
+	0xa2, 0xfb, 0xd1, 0x90, 0xae, 0xa8, 0xce, 0x52, 0x50, 0x89, 0xe4, 0x40, 0x59, 0x14, 0xc9, 0x2c,
+	0x31, 0xd6, 0xf2, 0xce, 0xe2, 0xc9, 0x9b, 0xf5, 0xb9, 0x32, 0x0a, 0x37, 0x8a, 0x2e, 0x86, 0xb0,
+	0x30, 0x12, 0x08, 0x87, 0x7c, 0xd7, 0xd2, 0x2f, 0xc8, 0xf3, 0x4b, 0xe4, 0xab, 0xd7, 0x91, 0xe7,
+	0x13, 0xf2, 0x0f, 0xd1, 0x3b, 0xe7, 0x68, 0x7e, 0xb9, 0xe9, 0x22, 0xaf, 0x3b, 0xe7, 0xb5, 0x80,
+	0xe7, 0x77, 0x7f, 0x29, 0x62, 0xa0, 0xaf, 0xe0, 0xb8, 0x79, 0xab, 0x80, 0x6b, 0xf9, 0xf9, 0x09,
+	0x1c, 0xe7, 0xec, 0x97, 0x36, 0x31, 0x6a, 0x8e, 0x53, 0x68, 0xce, 0x58, 0x76, 0x59, 0x3b, 0x3c,
+	0x4e, 0x01, 0xaf, 0xa0, 0x7b, 0x46, 0xb1, 0x44, 0x47, 0x4a, 0xa4, 0xe5, 0x4c, 0x6a, 0x45, 0xd7,
+	0xdd, 0x51, 0xb9, 0x18, 0xc7, 0x1f, 0x15, 0xb4, 0xe8, 0x31, 0x1d, 0x31, 0x0e, 0x5e, 0x2c, 0x20,
+	0x31, 0xe5, 0x2c, 0x76, 0xc1, 0x30, 0x11, 0x6b, 0x7c, 0x1f, 0xd5, 0x65, 0x0a, 0xaa, 0xd8, 0xcd,
+	0x62, 0x06, 0x75, 0x32, 0x2a, 0xe4, 0xa8, 0x30, 0x43, 0xd4, 0x6e, 0xc9, 0xa8, 0x80, 0xd7, 0x11,
+	0x1e, 0x25, 0x07, 0x09, 0x4f, 0xa5, 0x18, 0x46, 0x47, 0x66, 0xcf, 0x11, 0xbf, 0x04, 0xf0, 0x63,
+	0x34, 0x3b, 0x19, 0xf4, 0xf4, 0xff, 0x07, 0xdd, 0xd0, 0xe3, 0xf1, 0xce, 0xa1, 0x9a, 0x61, 0xfa,
+	0x55, 0x9e, 0xac, 0x8d, 0x6e, 0x26, 0x3f, 0x06, 0x1c, 0xbf, 0x87, 0x6e, 0x81, 0x52, 0x52, 0x95,
+	0x91, 0xd9, 0x03, 0x5e, 0x44, 0x75, 0x56, 0xbc, 0x88, 0x39, 0xc1, 0xc6, 0x74, 0xdb, 0x16, 0x02,
+	0xbe, 0xfc, 0x5b, 0x05, 0x7d, 0x70, 0x59, 0x40, 0x13, 0xcb, 0x0a, 0x08, 0x2b, 0x48, 0xa5, 0x32,
+	0xa0, 0x68, 0x24, 0x07, 0xa9, 0x4c, 0x20, 0x31, 0x45, 0x64, 0x77, 0xb7, 0x3f, 0x6d, 0x8f, 0x7d,
+	0xc1, 0xdb, 0x97, 0x49, 0x92, 0x92, 0xee, 0x0d, 0xd9, 0x64, 0x56, 0x8d, 0x97, 0xf0, 0x17, 0x68,
+	0xde, 0x30, 0xd5, 0x03, 0x33, 0xb9, 0x95, 0xba, 0x59, 0xc9, 0x3f, 0x00, 0x64, 0xce, 0x36, 0x8c,
+	0x6f, 0x9d, 0xc6, 0x1d, 0x54, 0xe3, 0x76, 0xae, 0xc5, 0x14, 0xee, 0x6c, 0xaf, 0x5d, 0xc9, 0x57,
+	0xb9, 0x0b, 0x64, 0x48, 0x7e, 0xf4, 0x53, 0x05, 0x7d, 0x78, 0xa5, 0x0b, 0xe0, 0xcf, 0xd1, 0x27,
+	0x9e, 0x1b, 0x7a, 0xee, 0xae, 0x4f, 0xbd, 0xa7, 0x81, 0xdf, 0x3d, 0xa4, 0x1d, 0x37, 0x78, 0xfa,
+	0x8c, 0xf8, 0x94, 0xf8, 0x07, 0xfb, 0xe4, 0xd0, 0x27, 0xd4, 0xdb, 0xdf, 0x3b, 0xd8, 0xef, 0xe6,
+	0xd0, 0xb3, 0x6e, 0x78, 0xe0, 0x7b, 0x41, 0x27, 0xf0, 0x77, 0x1b, 0x53, 0xf8, 0x4b, 0xf4, 0xd9,
+	0xd5, 0xa9, 0x61, 0x97, 0xba, 0x07, 0x01, 0x0d, 0x7d, 0xf2, 0xdc, 0x27, 0x0d, 0x07, 0x7f, 0x8c,
+	0x36, 0xaf, 0x41, 0xde, 0x7d, 0x42, 0xbf, 0xda, 0x6f, 0x54, 0xae, 0xcf, 0xfa, 0x3a, 0x6c, 0x54,
+	0x77, 0x1e, 0xfd, 0x79, 0xda, 0x72, 0xde, 0x9e, 0xb6, 0x9c, 0xbf, 0x4f, 0x5b, 0xce, 0xaf, 0x67,
+	0xad, 0xa9, 0xb7, 0x67, 0xad, 0xa9, 0xbf, 0xce, 0x5a, 0x53, 0xdf, 0x34, 0x5e, 0x8f, 0xfe, 0xed,
+	0xf9, 0x9b, 0xaa, 0x5f, 0xcc, 0x14, 0x3f, 0xe9, 0x8f, 0xfe, 0x1d, 0x00, 0xf4, 0x15, 0xfb, 0xf6,
+	0xfb, 0x07, 0x00, 0x00,
 }
 
 func (m *ActionExpiredEvidenceMetadata) Marshal() (dAtA []byte, err error) {
@@ -628,6 +702,52 @@ func (m *ActionFinalizationNotInTop10EvidenceMetadata) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubmittedResultHash) > 0 {
+		i -= len(m.SubmittedResultHash)
+		copy(dAtA[i:], m.SubmittedResultHash)
+		i = encodeVarintEvidenceMetadata(dAtA, i, uint64(len(m.SubmittedResultHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuorumResultHash) > 0 {
+		i -= len(m.QuorumResultHash)
+		copy(dAtA[i:], m.QuorumResultHash)
+		i = encodeVarintEvidenceMetadata(dAtA, i, uint64(len(m.QuorumResultHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Top_10ValidatorAddresses) > 0 {
+		for iNdEx := len(m.Top_10ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Top_10ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.Top_10ValidatorAddresses[iNdEx])
+			i = encodeVarintEvidenceMetadata(dAtA, i, uint64(len(m.Top_10ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StorageChallengeFailureEvidenceMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -875,6 +995,29 @@ func (m *ActionFinalizationNotInTop10EvidenceMetadata) Size() (n int) {
 	return n
 }
 
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Top_10ValidatorAddresses) > 0 {
+		for _, s := range m.Top_10ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovEvidenceMetadata(uint64(l))
+		}
+	}
+	l = len(m.QuorumResultHash)
+	if l > 0 {
+		n += 1 + l + sovEvidenceMetadata(uint64(l))
+	}
+	l = len(m.SubmittedResultHash)
+	if l > 0 {
+		n += 1 + l + sovEvidenceMetadata(uint64(l))
+	}
+	return n
+}
+
 func (m *StorageChallengeFailureEvidenceMetadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1222,6 +1365,152 @@ func (m *ActionFinalizationNotInTop10EvidenceMetadata) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
+func (m *ActionFinalizationResultMismatchEvidenceMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidenceMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionFinalizationResultMismatchEvidenceMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionFinalizationResultMismatchEvidenceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_10ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Top_10ValidatorAddresses = append(m.Top_10ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumResultHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumResultHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedResultHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidenceMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmittedResultHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidenceMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidenceMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StorageChallengeFailureEvidenceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,8 +14,12 @@ type AuthKeeper interface {
 	SetAccount(context.Context, sdk.AccountI)
 }
 
-// BankKeeper defines the expected interface for the Bank module (simulation only).
+// BankKeeper defines the expected interface for the Bank module. The keeper uses it to
+// escrow evidence dispute bonds; simulation uses the balance getters.
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
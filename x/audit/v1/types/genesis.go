@@ -12,6 +12,8 @@ func DefaultGenesis() *GenesisState {
 		Params:         DefaultParams(),
 		NextEvidenceId: 1,
 		NextHealOpId:   1,
+
+		NextEvidenceDisputeId: 1,
 	}
 }
 
@@ -36,5 +38,18 @@ func (gs GenesisState) Validate() error {
 		seenHealOpIDs[healOp.HealOpId] = struct{}{}
 	}
 
+	seenDisputeIDs := make(map[uint64]struct{}, len(gs.EvidenceDisputes))
+	disputedEvidenceIDs := make(map[uint64]struct{}, len(gs.EvidenceDisputes))
+	for _, dispute := range gs.EvidenceDisputes {
+		if _, found := seenDisputeIDs[dispute.DisputeId]; found {
+			return fmt.Errorf("duplicate evidence dispute_id %d in genesis", dispute.DisputeId)
+		}
+		seenDisputeIDs[dispute.DisputeId] = struct{}{}
+		if _, found := disputedEvidenceIDs[dispute.EvidenceId]; found {
+			return fmt.Errorf("evidence_id %d is disputed more than once in genesis", dispute.EvidenceId)
+		}
+		disputedEvidenceIDs[dispute.EvidenceId] = struct{}{}
+	}
+
 	return nil
 }
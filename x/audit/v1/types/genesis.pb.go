@@ -60,6 +60,10 @@ type GenesisState struct {
 	// Per final-gate F-B4 — per-verifier heal-op votes must survive
 	// export/import workflows.
 	HealOpVerifications []GenesisHealOpVerification `protobuf:"bytes,22,rep,name=heal_op_verifications,json=healOpVerifications,proto3" json:"heal_op_verifications"`
+	// evidence_disputes holds every dispute record; indexes are rebuilt on import.
+	EvidenceDisputes []EvidenceDispute `protobuf:"bytes,23,rep,name=evidence_disputes,json=evidenceDisputes,proto3" json:"evidence_disputes"`
+	// next_evidence_dispute_id is the next id to use for evidence disputes.
+	NextEvidenceDisputeId uint64 `protobuf:"varint,24,opt,name=next_evidence_dispute_id,json=nextEvidenceDisputeId,proto3" json:"next_evidence_dispute_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -249,6 +253,20 @@ func (m *GenesisState) GetHealOpVerifications() []GenesisHealOpVerification {
 	return nil
 }
 
+func (m *GenesisState) GetEvidenceDisputes() []EvidenceDispute {
+	if m != nil {
+		return m.EvidenceDisputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextEvidenceDisputeId() uint64 {
+	if m != nil {
+		return m.NextEvidenceDisputeId
+	}
+	return 0
+}
+
 // StorageTruthPostponement records a supernode's storage-truth postponement state
 // for genesis export/import. Per 121-F7.
 type StorageTruthPostponement struct {
@@ -1014,87 +1032,90 @@ func init() {
 func init() { proto.RegisterFile("lumera/audit/v1/genesis.proto", fileDescriptor_a433cb4f206fdbad) }
 
 var fileDescriptor_a433cb4f206fdbad = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x4f, 0x24, 0x45,
-	0x14, 0xa7, 0x17, 0x76, 0x99, 0x29, 0x86, 0x99, 0xa1, 0x80, 0xa5, 0xf9, 0x37, 0xe0, 0x18, 0xb2,
-	0x2c, 0xc6, 0x21, 0x60, 0x62, 0x8c, 0x7a, 0x81, 0x55, 0x16, 0x4c, 0x54, 0xd2, 0x10, 0x63, 0x34,
-	0x6b, 0xa7, 0xe8, 0xae, 0x99, 0xee, 0x65, 0xa6, 0xab, 0xad, 0xaa, 0x21, 0xe0, 0xc1, 0xab, 0x1e,
-	0x4d, 0xfc, 0x08, 0x7a, 0xf0, 0xe8, 0xd5, 0x2f, 0x60, 0xd6, 0xdb, 0x1e, 0x3d, 0x19, 0x03, 0x87,
-	0xfd, 0x1a, 0xa6, 0xeb, 0x4f, 0x4f, 0x4f, 0xf7, 0x74, 0x8b, 0xc6, 0xf5, 0x02, 0xd3, 0xef, 0xd5,
-	0xef, 0xfd, 0xde, 0xbf, 0x7a, 0xaf, 0x1b, 0xac, 0x76, 0xfb, 0x3d, 0x4c, 0xd1, 0x36, 0xea, 0xbb,
-	0x3e, 0xdf, 0xbe, 0xd8, 0xd9, 0xee, 0xe0, 0x00, 0x33, 0x9f, 0xb5, 0x42, 0x4a, 0x38, 0x81, 0x35,
-	0xa9, 0x6e, 0x09, 0x75, 0xeb, 0x62, 0x67, 0x69, 0x06, 0xf5, 0xfc, 0x80, 0x6c, 0x8b, 0xbf, 0xf2,
-	0xcc, 0xd2, 0x5c, 0x87, 0x74, 0x88, 0xf8, 0xb9, 0x1d, 0xfd, 0x52, 0xd2, 0x95, 0xb4, 0xe1, 0x10,
-	0x51, 0xd4, 0x53, 0x76, 0x97, 0x1a, 0x69, 0x2d, 0xbe, 0xf0, 0x5d, 0x1c, 0x38, 0x58, 0xe9, 0x97,
-	0xd3, 0x7a, 0xe9, 0x80, 0x50, 0x36, 0x5f, 0xd4, 0x40, 0xe5, 0xb1, 0x74, 0xf3, 0x84, 0x23, 0x8e,
-	0xe1, 0xdb, 0xe0, 0x9e, 0xb4, 0x6e, 0x1a, 0xeb, 0xc6, 0xe6, 0xd4, 0xee, 0x42, 0x2b, 0xe5, 0x76,
-	0xeb, 0x58, 0xa8, 0xf7, 0xcb, 0xcf, 0xfe, 0x58, 0x1b, 0xfb, 0xe9, 0xc5, 0xcf, 0x5b, 0x86, 0xa5,
-	0x10, 0xf0, 0x1d, 0x50, 0xd2, 0xdc, 0xe6, 0x9d, 0xf5, 0xf1, 0xcd, 0xa9, 0xdd, 0xc5, 0x0c, 0xfa,
-	0x7d, 0x75, 0x60, 0x7f, 0x22, 0xc2, 0x5b, 0x31, 0x00, 0x6e, 0x82, 0x7a, 0x80, 0x2f, 0xb9, 0xad,
-	0x05, 0xb6, 0xef, 0x9a, 0xe3, 0xeb, 0xc6, 0xe6, 0x84, 0x55, 0x8d, 0xe4, 0x1a, 0x77, 0xe4, 0xc2,
-	0x27, 0x60, 0x3e, 0x20, 0x2e, 0xb6, 0x59, 0x9f, 0x85, 0xbe, 0xe3, 0x93, 0xc0, 0x66, 0x91, 0xeb,
-	0xcc, 0x9c, 0x10, 0x9c, 0xaf, 0x66, 0x38, 0x3f, 0x22, 0x2e, 0x3e, 0xd1, 0x87, 0x45, 0x98, 0x8a,
-	0x7d, 0x36, 0xc8, 0x68, 0x18, 0x24, 0x60, 0x99, 0xe2, 0x90, 0x50, 0x8e, 0xa9, 0x4d, 0x71, 0xd7,
-	0x47, 0x67, 0x7e, 0xd7, 0xe7, 0x57, 0x9a, 0xe4, 0xae, 0x20, 0x79, 0x98, 0x21, 0xb1, 0x14, 0xc6,
-	0x1a, 0x40, 0x92, 0x54, 0x8b, 0x34, 0x47, 0x2f, 0x08, 0xb9, 0xef, 0x9c, 0x63, 0x6e, 0xbb, 0x98,
-	0x63, 0xea, 0x13, 0x8a, 0x78, 0x22, 0xaa, 0x7b, 0x39, 0x84, 0xa7, 0x02, 0xf3, 0x5e, 0x12, 0x32,
-	0x44, 0xc8, 0x73, 0xf4, 0x0c, 0xbe, 0x05, 0x4a, 0x1e, 0x46, 0x5d, 0x9b, 0x84, 0xcc, 0x9c, 0x14,
-	0xd6, 0xb3, 0x55, 0x3e, 0xc4, 0xa8, 0xfb, 0x71, 0xa8, 0x6c, 0x4d, 0x7a, 0xe2, 0x89, 0xc1, 0x0d,
-	0x50, 0x13, 0x45, 0x52, 0xf0, 0xa8, 0x46, 0x25, 0x51, 0xa3, 0x4a, 0x24, 0x96, 0x98, 0x23, 0x17,
-	0x86, 0x60, 0x45, 0x45, 0x84, 0x28, 0xf7, 0xdb, 0xc8, 0xe1, 0xb6, 0x43, 0xfa, 0x01, 0xd7, 0x21,
-	0x95, 0x0b, 0x43, 0xda, 0x53, 0x98, 0x47, 0x11, 0x64, 0x44, 0x48, 0x59, 0xbd, 0xc8, 0x21, 0xe3,
-	0x84, 0xa2, 0x0e, 0xb6, 0x39, 0xed, 0x73, 0xcf, 0x0e, 0x09, 0xe3, 0x21, 0x09, 0x70, 0x0f, 0x07,
-	0x9c, 0x99, 0x20, 0x87, 0xf0, 0x44, 0x62, 0x4e, 0x23, 0xc8, 0x71, 0x02, 0xa1, 0x09, 0x59, 0x8e,
-	0x9e, 0xc1, 0x4f, 0x41, 0x9d, 0x62, 0xc7, 0xc3, 0xce, 0x79, 0xdc, 0xb1, 0xe6, 0x94, 0x60, 0x79,
-	0x90, 0x61, 0x51, 0x17, 0xcc, 0x92, 0xe7, 0x53, 0x37, 0xa0, 0x46, 0x87, 0xc5, 0x30, 0x04, 0x9a,
-	0xd6, 0x0e, 0x29, 0x21, 0x6d, 0x9b, 0x53, 0x14, 0x30, 0x87, 0xfa, 0x21, 0x67, 0x66, 0x45, 0x50,
-	0xb4, 0xf2, 0x28, 0x54, 0x3c, 0xc7, 0x11, 0xee, 0x34, 0x86, 0x29, 0xa6, 0x05, 0x36, 0x52, 0xcb,
-	0xe0, 0xe7, 0x00, 0x8a, 0x0b, 0xd5, 0x46, 0x7e, 0xb7, 0x4f, 0xa3, 0xff, 0x0e, 0x67, 0xe6, 0x74,
-	0x71, 0x34, 0xd1, 0xa5, 0x3a, 0x90, 0x80, 0x03, 0xe4, 0x68, 0x8e, 0x7a, 0x30, 0x2c, 0x66, 0xd0,
-	0x05, 0xf3, 0x89, 0xeb, 0xc4, 0xfa, 0x5d, 0xae, 0xec, 0x57, 0x85, 0xfd, 0xad, 0xfc, 0x6c, 0xe9,
-	0xfb, 0x12, 0x61, 0x12, 0x14, 0xb3, 0x34, 0xa3, 0x61, 0xf0, 0x0b, 0x30, 0x1b, 0x79, 0x8f, 0x5d,
-	0xd9, 0x9a, 0x3d, 0x44, 0xcf, 0x31, 0x65, 0x66, 0x4d, 0x70, 0x6c, 0xe6, 0x71, 0x1c, 0x08, 0x48,
-	0xd4, 0xb6, 0x1f, 0x0a, 0x80, 0x62, 0x98, 0x69, 0xa7, 0xe4, 0x0c, 0x3e, 0x06, 0xd3, 0x38, 0x24,
-	0x8e, 0x67, 0x4b, 0x72, 0x66, 0xd6, 0x85, 0xe5, 0x95, 0xec, 0x7c, 0x8b, 0x4e, 0x49, 0xdf, 0x95,
-	0xb5, 0x0a, 0x1e, 0x88, 0x18, 0x3c, 0x06, 0x55, 0x69, 0xc2, 0xf6, 0x03, 0xd7, 0x77, 0x30, 0x33,
-	0x67, 0x72, 0xa6, 0xd6, 0x50, 0x1e, 0x8e, 0x02, 0x17, 0x5f, 0x2a, 0x83, 0xd3, 0x54, 0x8b, 0x22,
-	0x3c, 0x7c, 0x02, 0x66, 0x3d, 0xc2, 0xb8, 0x9d, 0x32, 0x0b, 0x8b, 0xcb, 0x77, 0x48, 0x18, 0xcf,
-	0x9a, 0x9e, 0xf1, 0x92, 0x62, 0x61, 0x9e, 0x0c, 0xda, 0xd1, 0xf1, 0x50, 0xb7, 0x8b, 0x83, 0x0e,
-	0x8e, 0x49, 0x66, 0x05, 0xc9, 0xeb, 0x7f, 0xd3, 0x8e, 0x8f, 0x34, 0x2e, 0x49, 0xa5, 0xbb, 0x31,
-	0xa9, 0x14, 0x84, 0xdf, 0x1a, 0xe0, 0x15, 0xe4, 0x88, 0x09, 0xd8, 0xf6, 0x03, 0xd4, 0xf5, 0xbf,
-	0x92, 0xe3, 0x70, 0xf8, 0x46, 0xcf, 0x09, 0xe6, 0x37, 0xf3, 0x98, 0xf7, 0x84, 0x81, 0x83, 0x04,
-	0x7e, 0xc4, 0xf5, 0x5e, 0x43, 0x85, 0xa7, 0x44, 0xef, 0xc6, 0xeb, 0x48, 0x96, 0x5f, 0x8c, 0x31,
-	0x66, 0xce, 0x17, 0xf7, 0xae, 0xbe, 0xcb, 0xa2, 0x19, 0xc4, 0x98, 0xd2, 0xbd, 0x8b, 0x33, 0x1a,
-	0xc1, 0xa2, 0xe7, 0xe9, 0x05, 0xa6, 0x7e, 0xdb, 0x77, 0x84, 0x2b, 0xcc, 0xbc, 0x5f, 0xcc, 0x22,
-	0xc7, 0xed, 0x27, 0x09, 0x88, 0x66, 0xf1, 0x32, 0x1a, 0xd6, 0xfc, 0xc1, 0x00, 0x66, 0xde, 0xb8,
-	0x83, 0xaf, 0x81, 0x19, 0xd6, 0x0f, 0x31, 0x15, 0x63, 0x00, 0x39, 0x22, 0x4a, 0xf1, 0x02, 0x50,
-	0xb6, 0xea, 0xb1, 0x62, 0x4f, 0xca, 0xe1, 0x0e, 0x98, 0xd7, 0xb5, 0x70, 0x6d, 0xc4, 0x55, 0x66,
-	0x7c, 0xd7, 0xbc, 0x23, 0x56, 0x01, 0x8c, 0x95, 0x7b, 0x5c, 0x84, 0x79, 0xe4, 0xc2, 0x07, 0xa0,
-	0xc6, 0x38, 0x25, 0x41, 0x27, 0xae, 0xa2, 0xd8, 0xed, 0x25, 0xab, 0x2a, 0xc5, 0xda, 0x99, 0xe6,
-	0x37, 0x06, 0xd8, 0xb8, 0x55, 0x09, 0x5f, 0xb6, 0xcb, 0xcd, 0x5f, 0x0c, 0xb0, 0x98, 0x5b, 0x4e,
-	0xb8, 0x08, 0x4a, 0xb1, 0x0d, 0x43, 0xd8, 0x98, 0xc4, 0x89, 0x58, 0xfb, 0x67, 0x4f, 0xb1, 0xc3,
-	0x6d, 0xe4, 0xba, 0x14, 0x33, 0x26, 0x58, 0xca, 0x56, 0x55, 0x89, 0xf7, 0xa4, 0x14, 0xee, 0x83,
-	0xe9, 0xb8, 0xbb, 0xf8, 0x55, 0x28, 0x53, 0x52, 0xdd, 0x5d, 0xcd, 0x7d, 0x67, 0x3a, 0xbd, 0x0a,
-	0xb1, 0x55, 0xc1, 0x89, 0x27, 0x38, 0x07, 0xee, 0xca, 0xc8, 0x27, 0x84, 0x13, 0xf2, 0xa1, 0xf9,
-	0xfd, 0xc0, 0xf7, 0x6c, 0x93, 0xc0, 0x15, 0x00, 0x12, 0xfb, 0x5b, 0x7a, 0x5f, 0xf2, 0xf4, 0xee,
-	0x7e, 0x17, 0x2c, 0xc9, 0x2e, 0xc4, 0xd4, 0xce, 0x26, 0x58, 0x46, 0x62, 0xea, 0x13, 0x27, 0xe9,
-	0x44, 0x2f, 0x81, 0x92, 0xd2, 0xb9, 0xaa, 0xc2, 0xf1, 0x73, 0xf3, 0x0a, 0xdc, 0x1f, 0xbd, 0x09,
-	0x8b, 0xb2, 0xb9, 0x0c, 0xca, 0xea, 0x55, 0x42, 0x55, 0xab, 0x6c, 0x95, 0xa4, 0x40, 0xa6, 0xda,
-	0xa1, 0x18, 0x71, 0x42, 0x63, 0x07, 0xc7, 0x65, 0xaa, 0x95, 0x58, 0xb9, 0xd5, 0xf4, 0xc1, 0x6a,
-	0xe1, 0x86, 0x8c, 0x2c, 0x0d, 0xd6, 0xac, 0xed, 0x21, 0xe6, 0xa9, 0x5e, 0xaa, 0x0e, 0xc4, 0x87,
-	0x88, 0x79, 0x70, 0x0d, 0x4c, 0x51, 0xec, 0x10, 0xea, 0xda, 0x4f, 0x19, 0x09, 0x84, 0x47, 0x15,
-	0x0b, 0x48, 0xd1, 0x07, 0x8c, 0x04, 0xcd, 0xdf, 0x8c, 0x38, 0xcc, 0xd4, 0x8a, 0xfc, 0x67, 0x2d,
-	0x9b, 0xcc, 0xc9, 0x9d, 0x82, 0x9c, 0x8c, 0xa7, 0x72, 0xf2, 0x30, 0x7a, 0x31, 0x51, 0xfb, 0x56,
-	0x73, 0x4c, 0x88, 0x33, 0x35, 0x2d, 0xd7, 0x14, 0xa9, 0x58, 0xee, 0x66, 0x62, 0xf9, 0x75, 0xd0,
-	0x47, 0xd9, 0x75, 0x3c, 0x92, 0xc9, 0x18, 0xcd, 0xf4, 0x6f, 0x83, 0xd9, 0x00, 0x55, 0x8e, 0x68,
-	0x27, 0x7a, 0x91, 0x1c, 0x0a, 0x65, 0x5a, 0x4a, 0x6f, 0x1d, 0xc8, 0xd7, 0x60, 0x21, 0x67, 0xe5,
-	0xff, 0x2f, 0x45, 0x69, 0xf6, 0x00, 0xcc, 0xae, 0xf3, 0xe8, 0xaa, 0xc5, 0x09, 0xcc, 0xf3, 0xc1,
-	0xd4, 0x27, 0x4e, 0x6e, 0xef, 0x4b, 0xf3, 0xcb, 0xb8, 0x05, 0x53, 0x6b, 0xfe, 0xe5, 0x51, 0xfe,
-	0x68, 0x80, 0x95, 0xa2, 0xad, 0xff, 0x9f, 0xe5, 0xb9, 0x38, 0x82, 0xf1, 0xe2, 0x08, 0xf6, 0xb7,
-	0x9e, 0x5d, 0x37, 0x8c, 0xe7, 0xd7, 0x0d, 0xe3, 0xcf, 0xeb, 0x86, 0xf1, 0xdd, 0x4d, 0x63, 0xec,
-	0xf9, 0x4d, 0x63, 0xec, 0xf7, 0x9b, 0xc6, 0xd8, 0x67, 0xf5, 0xcb, 0xc1, 0x17, 0x72, 0x34, 0x8d,
-	0xd9, 0xd9, 0x3d, 0xf1, 0x89, 0xfc, 0xc6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x77, 0x2c, 0x92,
-	0x60, 0xd8, 0x0f, 0x00, 0x00,
+	// 1325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xd6, 0x69, 0x6b, 0x4f, 0x1c, 0xff, 0x98, 0x34, 0xc9, 0x26, 0x4d, 0x9d, 0x7c, 0xfd,
+	0x55, 0x68, 0x1a, 0x84, 0xa3, 0x04, 0x09, 0x10, 0x70, 0x49, 0x5a, 0xd2, 0x04, 0x09, 0x88, 0xd6,
+	0x11, 0x42, 0xa0, 0xb2, 0x9a, 0xec, 0x8e, 0xbd, 0xd3, 0xd8, 0x3b, 0xcb, 0xcc, 0x38, 0x4a, 0x38,
+	0x70, 0x85, 0x23, 0x12, 0x7f, 0x02, 0x1c, 0x38, 0x72, 0xe5, 0x1f, 0x40, 0xe5, 0xd6, 0x23, 0x27,
+	0x84, 0x92, 0x03, 0x7f, 0x01, 0x77, 0xb4, 0x33, 0xb3, 0xeb, 0xb5, 0xd7, 0xbb, 0x04, 0x44, 0xb9,
+	0xb4, 0xd9, 0xf7, 0xe6, 0xf3, 0x3e, 0xef, 0xbd, 0x79, 0x6f, 0xde, 0x33, 0xb8, 0xd7, 0x1b, 0xf4,
+	0x31, 0x43, 0x5b, 0x68, 0xe0, 0x12, 0xb1, 0x75, 0xb6, 0xbd, 0xd5, 0xc5, 0x3e, 0xe6, 0x84, 0xb7,
+	0x02, 0x46, 0x05, 0x85, 0x55, 0xa5, 0x6e, 0x49, 0x75, 0xeb, 0x6c, 0x7b, 0xb9, 0x8e, 0xfa, 0xc4,
+	0xa7, 0x5b, 0xf2, 0x5f, 0x75, 0x66, 0xf9, 0x4e, 0x97, 0x76, 0xa9, 0xfc, 0x73, 0x2b, 0xfc, 0x4b,
+	0x4b, 0x57, 0xc6, 0x0d, 0x07, 0x88, 0xa1, 0xbe, 0xb6, 0xbb, 0xdc, 0x18, 0xd7, 0xe2, 0x33, 0xe2,
+	0x62, 0xdf, 0xc1, 0x5a, 0x7f, 0x77, 0x5c, 0xaf, 0x1c, 0x50, 0xca, 0x97, 0xb2, 0xc0, 0xb6, 0x4b,
+	0x78, 0x30, 0x10, 0xda, 0x48, 0xf3, 0x8f, 0x1a, 0x28, 0x3f, 0x56, 0xe1, 0xb4, 0x05, 0x12, 0x18,
+	0xbe, 0x09, 0x6e, 0x29, 0x2f, 0x4c, 0x63, 0xcd, 0xd8, 0x98, 0xd9, 0x59, 0x6c, 0x8d, 0x85, 0xd7,
+	0x3a, 0x92, 0xea, 0xbd, 0xd2, 0xb3, 0x5f, 0x57, 0xa7, 0xbe, 0xff, 0xfd, 0x87, 0x4d, 0xc3, 0xd2,
+	0x08, 0xf8, 0x16, 0x28, 0x46, 0x34, 0xe6, 0x8d, 0xb5, 0xc2, 0xc6, 0xcc, 0xce, 0x52, 0x0a, 0xfd,
+	0x8e, 0x3e, 0xb0, 0x37, 0x1d, 0xe2, 0xad, 0x18, 0x00, 0x37, 0x40, 0xcd, 0xc7, 0xe7, 0xc2, 0x8e,
+	0x1d, 0x25, 0xae, 0x59, 0x58, 0x33, 0x36, 0xa6, 0xad, 0x4a, 0x28, 0x8f, 0x70, 0x87, 0x2e, 0x7c,
+	0x02, 0xe6, 0x7d, 0xea, 0x62, 0x9b, 0x0f, 0x78, 0x40, 0x1c, 0x42, 0x7d, 0x9b, 0x87, 0xae, 0x73,
+	0x73, 0x5a, 0x72, 0xfe, 0x3f, 0xc5, 0xf9, 0x3e, 0x75, 0x71, 0x3b, 0x3a, 0x2c, 0xc3, 0xd4, 0xec,
+	0x73, 0x7e, 0x4a, 0xc3, 0x21, 0x05, 0x77, 0x19, 0x0e, 0x28, 0x13, 0x98, 0xd9, 0x0c, 0xf7, 0x08,
+	0x3a, 0x21, 0x3d, 0x22, 0x2e, 0x22, 0x92, 0x9b, 0x92, 0xe4, 0x41, 0x8a, 0xc4, 0xd2, 0x18, 0x6b,
+	0x08, 0x49, 0x52, 0x2d, 0xb1, 0x0c, 0xbd, 0x24, 0x14, 0xc4, 0x39, 0xc5, 0xc2, 0x76, 0xb1, 0xc0,
+	0x8c, 0x50, 0x86, 0x44, 0x22, 0xaa, 0x5b, 0x19, 0x84, 0xc7, 0x12, 0xf3, 0x28, 0x09, 0x19, 0x21,
+	0x14, 0x19, 0x7a, 0x0e, 0xdf, 0x00, 0x45, 0x0f, 0xa3, 0x9e, 0x4d, 0x03, 0x6e, 0xde, 0x5e, 0x2b,
+	0x4c, 0xbc, 0xe5, 0x03, 0x8c, 0x7a, 0x1f, 0x04, 0xda, 0xd6, 0x6d, 0x4f, 0x7e, 0x71, 0xb8, 0x0e,
+	0xaa, 0xf2, 0x92, 0x34, 0x3c, 0xbc, 0xa3, 0xa2, 0xbc, 0xa3, 0x72, 0x28, 0x56, 0x98, 0x43, 0x17,
+	0x06, 0x60, 0x45, 0x47, 0x84, 0x98, 0x20, 0x1d, 0xe4, 0x08, 0xdb, 0xa1, 0x03, 0x5f, 0x44, 0x21,
+	0x95, 0x72, 0x43, 0xda, 0xd5, 0x98, 0x87, 0x21, 0x64, 0x42, 0x48, 0x69, 0xbd, 0xcc, 0x21, 0x17,
+	0x94, 0xa1, 0x2e, 0xb6, 0x05, 0x1b, 0x08, 0xcf, 0x0e, 0x28, 0x17, 0x01, 0xf5, 0x71, 0x1f, 0xfb,
+	0x82, 0x9b, 0x20, 0x83, 0xb0, 0xad, 0x30, 0xc7, 0x21, 0xe4, 0x28, 0x81, 0x88, 0x08, 0x79, 0x86,
+	0x9e, 0xc3, 0x8f, 0x40, 0x8d, 0x61, 0xc7, 0xc3, 0xce, 0x69, 0x5c, 0xb1, 0xe6, 0x8c, 0x64, 0xb9,
+	0x9f, 0x62, 0xd1, 0x0d, 0x66, 0xa9, 0xf3, 0x63, 0x1d, 0x50, 0x65, 0xa3, 0x62, 0x18, 0x80, 0x88,
+	0xd6, 0x0e, 0x18, 0xa5, 0x1d, 0x5b, 0x30, 0xe4, 0x73, 0x87, 0x91, 0x40, 0x70, 0xb3, 0x2c, 0x29,
+	0x5a, 0x59, 0x14, 0x3a, 0x9e, 0xa3, 0x10, 0x77, 0x1c, 0xc3, 0x34, 0xd3, 0x22, 0x9f, 0xa8, 0xe5,
+	0xf0, 0x13, 0x00, 0x65, 0x43, 0x75, 0x10, 0xe9, 0x0d, 0x58, 0xf8, 0xbf, 0x23, 0xb8, 0x39, 0x9b,
+	0x1f, 0x4d, 0xd8, 0x54, 0xfb, 0x0a, 0xb0, 0x8f, 0x9c, 0x88, 0xa3, 0xe6, 0x8f, 0x8a, 0x39, 0x74,
+	0xc1, 0x7c, 0xa2, 0x9d, 0xf8, 0xa0, 0x27, 0xb4, 0xfd, 0x8a, 0xb4, 0xbf, 0x99, 0x9d, 0xad, 0xa8,
+	0x5f, 0x42, 0x4c, 0x82, 0x62, 0x8e, 0xa5, 0x34, 0x1c, 0x7e, 0x0a, 0xe6, 0x42, 0xef, 0xb1, 0xab,
+	0x4a, 0xb3, 0x8f, 0xd8, 0x29, 0x66, 0xdc, 0xac, 0x4a, 0x8e, 0x8d, 0x2c, 0x8e, 0x7d, 0x09, 0x09,
+	0xcb, 0xf6, 0x3d, 0x09, 0xd0, 0x0c, 0xf5, 0xce, 0x98, 0x9c, 0xc3, 0xc7, 0x60, 0x16, 0x07, 0xd4,
+	0xf1, 0x6c, 0x45, 0xce, 0xcd, 0x9a, 0xb4, 0xbc, 0x92, 0x7e, 0xdf, 0xc2, 0x53, 0xca, 0x77, 0x6d,
+	0xad, 0x8c, 0x87, 0x22, 0x0e, 0x8f, 0x40, 0x45, 0x99, 0xb0, 0x89, 0xef, 0x12, 0x07, 0x73, 0xb3,
+	0x9e, 0xf1, 0x6a, 0x8d, 0xe4, 0xe1, 0xd0, 0x77, 0xf1, 0xb9, 0x36, 0x38, 0xcb, 0x22, 0x51, 0x88,
+	0x87, 0x4f, 0xc0, 0x9c, 0x47, 0xb9, 0xb0, 0xc7, 0xcc, 0xc2, 0xfc, 0xeb, 0x3b, 0xa0, 0x5c, 0xa4,
+	0x4d, 0xd7, 0xbd, 0xa4, 0x58, 0x9a, 0xa7, 0xc3, 0x72, 0x74, 0x3c, 0xd4, 0xeb, 0x61, 0xbf, 0x8b,
+	0x63, 0x92, 0x39, 0x49, 0xf2, 0xca, 0x5f, 0x94, 0xe3, 0xc3, 0x08, 0x97, 0xa4, 0x5a, 0xe4, 0x69,
+	0xa5, 0x24, 0xfc, 0xca, 0x00, 0xff, 0x43, 0x8e, 0x7c, 0x01, 0x3b, 0xc4, 0x47, 0x3d, 0xf2, 0xb9,
+	0x7a, 0x0e, 0x47, 0x3b, 0xfa, 0x8e, 0x64, 0x7e, 0x2d, 0x8b, 0x79, 0x57, 0x1a, 0xd8, 0x4f, 0xe0,
+	0x27, 0xb4, 0xf7, 0x2a, 0xca, 0x3d, 0x25, 0x6b, 0x37, 0x1e, 0x47, 0xea, 0xfa, 0xe5, 0x33, 0xc6,
+	0xcd, 0xf9, 0xfc, 0xda, 0x8d, 0x7a, 0x59, 0x16, 0x83, 0x7c, 0xa6, 0xa2, 0xda, 0xc5, 0x29, 0x8d,
+	0x64, 0x89, 0xde, 0xd3, 0x33, 0xcc, 0x48, 0x87, 0x38, 0xd2, 0x15, 0x6e, 0x2e, 0xe4, 0xb3, 0xa8,
+	0xe7, 0xf6, 0xc3, 0x04, 0x24, 0x62, 0xf1, 0x52, 0x1a, 0x0e, 0xdb, 0xa0, 0x3e, 0xbe, 0x03, 0x70,
+	0x73, 0x51, 0x32, 0xac, 0x65, 0x4e, 0xe9, 0x47, 0xea, 0x60, 0xd4, 0xdc, 0x78, 0x54, 0xcc, 0xe1,
+	0xeb, 0xc0, 0x1c, 0x1d, 0xda, 0xda, 0x72, 0x38, 0x18, 0x4c, 0x39, 0x18, 0xe6, 0x93, 0xc3, 0x5b,
+	0xe3, 0x0e, 0xdd, 0xe6, 0xb7, 0x06, 0x30, 0xb3, 0x1e, 0x5f, 0xf8, 0x32, 0xa8, 0xf3, 0x41, 0x80,
+	0x99, 0x7c, 0x94, 0x90, 0x23, 0x73, 0x2e, 0xd7, 0x91, 0x92, 0x55, 0x8b, 0x15, 0xbb, 0x4a, 0x0e,
+	0xb7, 0xc1, 0x7c, 0x54, 0x19, 0xae, 0x8d, 0x84, 0xbe, 0x27, 0xe2, 0x9a, 0x37, 0x24, 0x3f, 0x8c,
+	0x95, 0xbb, 0x42, 0x26, 0xfd, 0xd0, 0x85, 0xf7, 0x41, 0x95, 0x0b, 0x46, 0xfd, 0x6e, 0x5c, 0x53,
+	0x72, 0xd3, 0x28, 0x5a, 0x15, 0x25, 0x8e, 0x9c, 0x69, 0x7e, 0x69, 0x80, 0xf5, 0x6b, 0x15, 0xd4,
+	0x8b, 0x76, 0xb9, 0xf9, 0xa3, 0x01, 0x96, 0x32, 0x8b, 0x0b, 0x2e, 0x81, 0x62, 0x6c, 0xc3, 0x90,
+	0x36, 0x6e, 0xe3, 0x44, 0xac, 0x83, 0x93, 0xa7, 0xd8, 0x11, 0x36, 0x72, 0x5d, 0x86, 0x39, 0x97,
+	0x2c, 0x25, 0xab, 0xa2, 0xc5, 0xbb, 0x4a, 0x0a, 0xf7, 0xc0, 0x6c, 0x7c, 0x8b, 0xe2, 0x22, 0x50,
+	0x29, 0xa9, 0xec, 0xdc, 0xcb, 0xac, 0x8d, 0xe3, 0x8b, 0x00, 0x5b, 0x65, 0x9c, 0xf8, 0x82, 0x77,
+	0xc0, 0x4d, 0x15, 0xf9, 0xb4, 0x74, 0x42, 0x7d, 0x34, 0xbf, 0x19, 0xfa, 0x9e, 0x2e, 0x59, 0xb8,
+	0x02, 0x40, 0x62, 0x9b, 0x50, 0xde, 0x17, 0xbd, 0x68, 0x93, 0x78, 0x1b, 0x2c, 0xab, 0x9e, 0xc0,
+	0xcc, 0x4e, 0x27, 0x58, 0x45, 0x62, 0x46, 0x27, 0xda, 0xe3, 0x89, 0x5e, 0x06, 0x45, 0xad, 0x73,
+	0xf5, 0x0d, 0xc7, 0xdf, 0xcd, 0x0b, 0xb0, 0x30, 0x79, 0x2e, 0xe7, 0x65, 0xf3, 0x2e, 0x28, 0xe9,
+	0xc5, 0x46, 0xdf, 0x56, 0xc9, 0x2a, 0x2a, 0x81, 0x4a, 0xb5, 0xc3, 0x30, 0x12, 0x94, 0xc5, 0x0e,
+	0x16, 0x54, 0xaa, 0xb5, 0x58, 0xbb, 0xd5, 0x24, 0xe0, 0x5e, 0xee, 0xbc, 0x0e, 0x2d, 0x0d, 0x87,
+	0xbe, 0xed, 0x21, 0xee, 0xe9, 0x5a, 0xaa, 0x0c, 0xc5, 0x07, 0x88, 0x7b, 0x70, 0x15, 0xcc, 0x30,
+	0xec, 0x50, 0xe6, 0xda, 0x4f, 0x39, 0xf5, 0xa5, 0x47, 0x65, 0x0b, 0x28, 0xd1, 0xbb, 0x9c, 0xfa,
+	0xcd, 0x9f, 0x0d, 0xb0, 0x30, 0x79, 0x60, 0xff, 0xbd, 0x92, 0x4d, 0xe6, 0xe4, 0x46, 0x4e, 0x4e,
+	0x0a, 0x63, 0x39, 0x79, 0x10, 0xae, 0x49, 0x7a, 0xfa, 0x23, 0x67, 0x58, 0x1c, 0x25, 0xab, 0x1a,
+	0xc9, 0x23, 0x8a, 0xb1, 0x58, 0x6e, 0xa6, 0x62, 0xf9, 0x69, 0x58, 0x47, 0xe9, 0xe5, 0x60, 0x22,
+	0x93, 0x31, 0x99, 0xe9, 0x9f, 0x06, 0xb3, 0x0e, 0x2a, 0x02, 0xb1, 0x6e, 0xb8, 0xd6, 0x8e, 0x84,
+	0x32, 0xab, 0xa4, 0xd7, 0x0e, 0xe4, 0x0b, 0xb0, 0x98, 0xb1, 0x80, 0xfc, 0x27, 0x97, 0xd2, 0xec,
+	0x03, 0x98, 0x5e, 0x2e, 0xc2, 0x56, 0x8b, 0x13, 0x98, 0xe5, 0x83, 0x19, 0x9d, 0x68, 0x5f, 0xdf,
+	0x97, 0xe6, 0x67, 0x60, 0x61, 0xf2, 0xd2, 0xf1, 0xe2, 0x28, 0xbf, 0x33, 0xc0, 0x4a, 0xde, 0x0e,
+	0xf2, 0xaf, 0xe5, 0x39, 0x3f, 0x82, 0x42, 0x7e, 0x04, 0x7b, 0x9b, 0xcf, 0x2e, 0x1b, 0xc6, 0xf3,
+	0xcb, 0x86, 0xf1, 0xdb, 0x65, 0xc3, 0xf8, 0xfa, 0xaa, 0x31, 0xf5, 0xfc, 0xaa, 0x31, 0xf5, 0xcb,
+	0x55, 0x63, 0xea, 0xe3, 0xda, 0xf9, 0xf0, 0xa7, 0x7b, 0xf8, 0x1a, 0xf3, 0x93, 0x5b, 0xf2, 0x07,
+	0xfb, 0xab, 0x7f, 0x0e, 0x00, 0xf9, 0x88, 0xf5, 0xb6, 0x8e, 0x10, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -1117,6 +1138,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextEvidenceDisputeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextEvidenceDisputeId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.EvidenceDisputes) > 0 {
+		for iNdEx := len(m.EvidenceDisputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvidenceDisputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
 	if len(m.HealOpVerifications) > 0 {
 		for iNdEx := len(m.HealOpVerifications) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2074,6 +2118,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EvidenceDisputes) > 0 {
+		for _, e := range m.EvidenceDisputes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextEvidenceDisputeId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextEvidenceDisputeId))
+	}
 	return n
 }
 
@@ -3070,6 +3123,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDisputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceDisputes = append(m.EvidenceDisputes, EvidenceDispute{})
+			if err := m.EvidenceDisputes[len(m.EvidenceDisputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEvidenceDisputeId", wireType)
+			}
+			m.NextEvidenceDisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEvidenceDisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -74,6 +74,16 @@ var (
 
 	evidenceEpochCountPrefix = []byte("eve/")
 
+	// Evidence disputes:
+	// - NextEvidenceDisputeIDKey:           "evd/next_id" -> 8 bytes u64be(next_dispute_id)
+	// - EvidenceDisputeKey:                 "evd/r/" + u64be(dispute_id) -> EvidenceDispute bytes
+	// - EvidenceDisputeByEvidenceIndexKey:  "evd/e/" + u64be(evidence_id) -> 8 bytes u64be(dispute_id)
+	// - PendingEvidenceDisputeIndexKey:     "evd/p/" + u64be(deadline_epoch_id) + u64be(dispute_id) -> empty
+	nextEvidenceDisputeIDKey          = []byte("evd/next_id")
+	evidenceDisputePrefix             = []byte("evd/r/")
+	evidenceDisputeByEvidencePrefix   = []byte("evd/e/")
+	pendingEvidenceDisputeIndexPrefix = []byte("evd/p/")
+
 	actionFinalizationPostponementPrefix = []byte("ap/af/")
 
 	// Storage-truth postponement state:
@@ -129,8 +139,34 @@ var (
 	// Secondary index: transcript keyed by (target, bucket, epoch, transcriptHash).
 	// Format: "st/spt-tbe/" + target + "/" + u32be(bucket) + "/" + u64be(epoch) + "/" + transcriptHash
 	transcriptByTargetBucketEpochPrefix = []byte("st/spt-tbe/")
+
+	// Epoch finalization:
+	// - EpochFinalizationProgressKey: "ef/p" -> EpochFinalizationProgress bytes
+	// - EpochFinalizationWorkItemKey: "ef/w/" + u8(list) + u64be(index) -> work item bytes
+	epochFinalizationProgressKey    = []byte("ef/p")
+	epochFinalizationWorkItemPrefix = []byte("ef/w/")
 )
 
+// EpochFinalizationProgressKey returns the store key of the epoch finalization progress record.
+func EpochFinalizationProgressKey() []byte {
+	return epochFinalizationProgressKey
+}
+
+// EpochFinalizationWorkItemPrefix returns the prefix of all work lists queued by epoch finalization.
+func EpochFinalizationWorkItemPrefix() []byte {
+	return epochFinalizationWorkItemPrefix
+}
+
+// EpochFinalizationWorkItemKey returns the store key of the index-th item of a work list queued
+// by epoch finalization.
+func EpochFinalizationWorkItemKey(list uint8, index uint64) []byte {
+	key := make([]byte, 0, len(epochFinalizationWorkItemPrefix)+1+8) // "ef/w/" + u8(list) + u64be(index)
+	key = append(key, epochFinalizationWorkItemPrefix...)
+	key = append(key, list)
+	key = binary.BigEndian.AppendUint64(key, index)
+	return key
+}
+
 // EpochAnchorKey returns the store key for the EpochAnchor identified by epochID.
 func EpochAnchorKey(epochID uint64) []byte {
 	key := make([]byte, 0, len(epochAnchorPrefix)+8) // "ea/" + u64be(epoch_id)
@@ -314,6 +350,40 @@ func EvidenceByActionIndexPrefix(actionID string) []byte {
 	return key
 }
 
+func NextEvidenceDisputeIDKey() []byte {
+	return nextEvidenceDisputeIDKey
+}
+
+func EvidenceDisputeKey(disputeID uint64) []byte {
+	key := make([]byte, 0, len(evidenceDisputePrefix)+8) // "evd/r/" + u64be(dispute_id)
+	key = append(key, evidenceDisputePrefix...)
+	key = binary.BigEndian.AppendUint64(key, disputeID)
+	return key
+}
+
+func EvidenceDisputePrefix() []byte {
+	return evidenceDisputePrefix
+}
+
+func EvidenceDisputeByEvidenceIndexKey(evidenceID uint64) []byte {
+	key := make([]byte, 0, len(evidenceDisputeByEvidencePrefix)+8) // "evd/e/" + u64be(evidence_id)
+	key = append(key, evidenceDisputeByEvidencePrefix...)
+	key = binary.BigEndian.AppendUint64(key, evidenceID)
+	return key
+}
+
+func PendingEvidenceDisputeIndexKey(deadlineEpochID, disputeID uint64) []byte {
+	key := make([]byte, 0, len(pendingEvidenceDisputeIndexPrefix)+8+8) // "evd/p/" + u64be(deadline_epoch_id) + u64be(dispute_id)
+	key = append(key, pendingEvidenceDisputeIndexPrefix...)
+	key = binary.BigEndian.AppendUint64(key, deadlineEpochID)
+	key = binary.BigEndian.AppendUint64(key, disputeID)
+	return key
+}
+
+func PendingEvidenceDisputeIndexPrefix() []byte {
+	return pendingEvidenceDisputeIndexPrefix
+}
+
 func EvidenceEpochCountKey(epochID uint64, subjectAddress string, evidenceType EvidenceType) []byte {
 	key := make([]byte, 0, len(evidenceEpochCountPrefix)+8+1+len(subjectAddress)+1+4) // "eve/" + u64be(epoch_id) + "/" + subject + "/" + u32be(evidence_type)
 	key = append(key, evidenceEpochCountPrefix...)
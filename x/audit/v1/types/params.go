@@ -5,6 +5,7 @@ import (
 	"math"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -68,6 +69,12 @@ var (
 	KeyStorageTruthOldClassAFaultWindow                 = []byte("StorageTruthOldClassAFaultWindow")
 	KeyStorageTruthContradictionWindowEpochs            = []byte("StorageTruthContradictionWindowEpochs")
 	KeyStorageTruthReporterIneligibleDurationEpochs     = []byte("StorageTruthReporterIneligibleDurationEpochs")
+	KeyEpochFinalizationBlocks                          = []byte("EpochFinalizationBlocks")
+	KeyEpochFinalizationBatchSize                       = []byte("EpochFinalizationBatchSize")
+	KeyEvidenceDisputeMinBond                           = []byte("EvidenceDisputeMinBond")
+	KeyEvidenceDisputePanelSize                         = []byte("EvidenceDisputePanelSize")
+	KeyEvidenceDisputeVotingEpochs                      = []byte("EvidenceDisputeVotingEpochs")
+	KeyEvidenceDisputeWindowEpochs                      = []byte("EvidenceDisputeWindowEpochs")
 )
 
 const (
@@ -174,6 +181,17 @@ var (
 	DefaultStorageTruthOldClassAFaultWindow                 = uint32(21)
 	DefaultStorageTruthContradictionWindowEpochs            = uint32(7)
 	DefaultStorageTruthReporterIneligibleDurationEpochs     = uint32(7)
+
+	// Epoch-end processing runs in the epoch's last block unless governance
+	// spreads it over the first blocks of the next epoch.
+	DefaultEpochFinalizationBlocks    = uint64(0)
+	DefaultEpochFinalizationBatchSize = uint64(200)
+
+	// DefaultEvidenceDispute* configure bonded evidence disputes resolved by a supernode panel.
+	DefaultEvidenceDisputeMinBond      = sdk.NewInt64Coin("ulume", 10_000_000)
+	DefaultEvidenceDisputePanelSize    = uint32(5)
+	DefaultEvidenceDisputeVotingEpochs = uint32(2)
+	DefaultEvidenceDisputeWindowEpochs = uint32(7)
 )
 
 // Params notes
@@ -191,6 +209,7 @@ var (
 // - peer_port_postpone_threshold_percent: percent of peers that must report CLOSED to treat a port as CLOSED.
 // - keep_last_epoch_entries: how many epochs of epoch-scoped state to keep (pruning at epoch end).
 // - action_finalization_*: postponement + recovery windows for action-finalization evidence types.
+// - evidence_dispute_*: bond, panel size and timing for subject disputes of evidence records.
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -270,6 +289,12 @@ func NewParams(
 	storageTruthReporterIneligibleDurationEpochs uint32,
 	storageTruthStrongRecoveryCleanPassCount uint32,
 	storageTruthHealVerifierCount uint32,
+	epochFinalizationBlocks uint64,
+	epochFinalizationBatchSize uint64,
+	evidenceDisputeMinBond sdk.Coin,
+	evidenceDisputePanelSize uint32,
+	evidenceDisputeVotingEpochs uint32,
+	evidenceDisputeWindowEpochs uint32,
 ) Params {
 	return Params{
 		EpochLengthBlocks:                epochLengthBlocks,
@@ -328,6 +353,12 @@ func NewParams(
 		StorageTruthReporterIneligibleDurationEpochs:     storageTruthReporterIneligibleDurationEpochs,
 		StorageTruthStrongRecoveryCleanPassCount:         storageTruthStrongRecoveryCleanPassCount,
 		StorageTruthHealVerifierCount:                    storageTruthHealVerifierCount,
+		EpochFinalizationBlocks:                          epochFinalizationBlocks,
+		EpochFinalizationBatchSize:                       epochFinalizationBatchSize,
+		EvidenceDisputeMinBond:                           evidenceDisputeMinBond,
+		EvidenceDisputePanelSize:                         evidenceDisputePanelSize,
+		EvidenceDisputeVotingEpochs:                      evidenceDisputeVotingEpochs,
+		EvidenceDisputeWindowEpochs:                      evidenceDisputeWindowEpochs,
 	}
 }
 
@@ -384,6 +415,12 @@ func DefaultParams() Params {
 		DefaultStorageTruthReporterIneligibleDurationEpochs,
 		DefaultStorageTruthStrongRecoveryCleanPassCount,
 		DefaultStorageTruthHealVerifierCount,
+		DefaultEpochFinalizationBlocks,
+		DefaultEpochFinalizationBatchSize,
+		DefaultEvidenceDisputeMinBond,
+		DefaultEvidenceDisputePanelSize,
+		DefaultEvidenceDisputeVotingEpochs,
+		DefaultEvidenceDisputeWindowEpochs,
 	)
 }
 
@@ -523,6 +560,21 @@ func (p Params) WithDefaults() Params {
 	if p.StorageTruthReporterIneligibleDurationEpochs == 0 {
 		p.StorageTruthReporterIneligibleDurationEpochs = DefaultStorageTruthReporterIneligibleDurationEpochs
 	}
+	if p.EpochFinalizationBatchSize == 0 {
+		p.EpochFinalizationBatchSize = DefaultEpochFinalizationBatchSize
+	}
+	if p.EvidenceDisputeMinBond.Denom == "" && p.EvidenceDisputeMinBond.Amount.IsNil() {
+		p.EvidenceDisputeMinBond = DefaultEvidenceDisputeMinBond
+	}
+	if p.EvidenceDisputePanelSize == 0 {
+		p.EvidenceDisputePanelSize = DefaultEvidenceDisputePanelSize
+	}
+	if p.EvidenceDisputeVotingEpochs == 0 {
+		p.EvidenceDisputeVotingEpochs = DefaultEvidenceDisputeVotingEpochs
+	}
+	if p.EvidenceDisputeWindowEpochs == 0 {
+		p.EvidenceDisputeWindowEpochs = DefaultEvidenceDisputeWindowEpochs
+	}
 	// UNSPECIFIED is a valid no-op mode; WithDefaults does not promote it to SHADOW.
 	return p
 }
@@ -583,6 +635,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyStorageTruthOldClassAFaultWindow, &p.StorageTruthOldClassAFaultWindow, validateUint32),
 		paramtypes.NewParamSetPair(KeyStorageTruthContradictionWindowEpochs, &p.StorageTruthContradictionWindowEpochs, validateUint32),
 		paramtypes.NewParamSetPair(KeyStorageTruthReporterIneligibleDurationEpochs, &p.StorageTruthReporterIneligibleDurationEpochs, validateUint32),
+		paramtypes.NewParamSetPair(KeyEpochFinalizationBlocks, &p.EpochFinalizationBlocks, validateUint64),
+		paramtypes.NewParamSetPair(KeyEpochFinalizationBatchSize, &p.EpochFinalizationBatchSize, validateUint64),
+		paramtypes.NewParamSetPair(KeyEvidenceDisputeMinBond, &p.EvidenceDisputeMinBond, validateCoin),
+		paramtypes.NewParamSetPair(KeyEvidenceDisputePanelSize, &p.EvidenceDisputePanelSize, validateUint32),
+		paramtypes.NewParamSetPair(KeyEvidenceDisputeVotingEpochs, &p.EvidenceDisputeVotingEpochs, validateUint32),
+		paramtypes.NewParamSetPair(KeyEvidenceDisputeWindowEpochs, &p.EvidenceDisputeWindowEpochs, validateUint32),
 	}
 }
 
@@ -832,6 +890,30 @@ func (p Params) Validate() error {
 	if p.StorageTruthHealVerifierCount == 0 || p.StorageTruthHealVerifierCount > 32 {
 		return fmt.Errorf("storage_truth_heal_verifier_count must be within 1..32")
 	}
+	// Spread epoch-end processing must finish before the next epoch ends.
+	if p.EpochFinalizationBlocks >= p.EpochLengthBlocks {
+		return fmt.Errorf("epoch_finalization_blocks must be < epoch_length_blocks")
+	}
+	if p.EpochFinalizationBatchSize == 0 {
+		return fmt.Errorf("epoch_finalization_batch_size must be > 0")
+	}
+	if err := p.EvidenceDisputeMinBond.Validate(); err != nil {
+		return fmt.Errorf("evidence_dispute_min_bond is invalid: %w", err)
+	}
+	if !p.EvidenceDisputeMinBond.IsPositive() {
+		return fmt.Errorf("evidence_dispute_min_bond must be > 0")
+	}
+	// Panel members are picked from the anchored ACTIVE set; keep the bound in
+	// line with storage_truth_heal_verifier_count.
+	if p.EvidenceDisputePanelSize == 0 || p.EvidenceDisputePanelSize > 32 {
+		return fmt.Errorf("evidence_dispute_panel_size must be within 1..32")
+	}
+	if p.EvidenceDisputeVotingEpochs == 0 {
+		return fmt.Errorf("evidence_dispute_voting_epochs must be > 0")
+	}
+	if p.EvidenceDisputeWindowEpochs == 0 {
+		return fmt.Errorf("evidence_dispute_window_epochs must be > 0")
+	}
 	if p.StorageTruthClassBFaultWindow == 0 {
 		return fmt.Errorf("storage_truth_class_b_fault_window must be > 0")
 	}
@@ -896,6 +978,14 @@ func validateUint32Slice(v interface{}) error {
 	return nil
 }
 
+func validateCoin(v interface{}) error {
+	_, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
 func validateBool(v interface{}) error {
 	_, ok := v.(bool)
 	if !ok {
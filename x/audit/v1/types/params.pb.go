@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -153,6 +154,22 @@ type Params struct {
 	// Verifiers cross-check the healer's recovery; making this a Param allows
 	// governance to tune redundancy if heal volume / failure rate shifts.
 	StorageTruthHealVerifierCount uint32 `protobuf:"varint,51,opt,name=storage_truth_heal_verifier_count,json=storageTruthHealVerifierCount,proto3" json:"storage_truth_heal_verifier_count,omitempty"`
+	// Number of blocks at the start of the next epoch over which epoch-end
+	// processing is spread. 0 runs it entirely in the epoch's last block. Must
+	// be smaller than epoch_length_blocks.
+	EpochFinalizationBlocks uint64 `protobuf:"varint,52,opt,name=epoch_finalization_blocks,json=epochFinalizationBlocks,proto3" json:"epoch_finalization_blocks,omitempty"`
+	// Work items processed per block while epoch-end processing is spread
+	// (default 200). The last block of the window processes whatever is left.
+	EpochFinalizationBatchSize uint64 `protobuf:"varint,53,opt,name=epoch_finalization_batch_size,json=epochFinalizationBatchSize,proto3" json:"epoch_finalization_batch_size,omitempty"`
+	// Minimum bond the subject must post to dispute an evidence record. A
+	// rejected dispute forfeits the bond to the supernode module account.
+	EvidenceDisputeMinBond types.Coin `protobuf:"bytes,54,opt,name=evidence_dispute_min_bond,json=evidenceDisputeMinBond,proto3" json:"evidence_dispute_min_bond"`
+	// Number of supernodes on an evidence dispute panel (default 5).
+	EvidenceDisputePanelSize uint32 `protobuf:"varint,55,opt,name=evidence_dispute_panel_size,json=evidenceDisputePanelSize,proto3" json:"evidence_dispute_panel_size,omitempty"`
+	// Epochs after the dispute epoch during which the panel may vote (default 2).
+	EvidenceDisputeVotingEpochs uint32 `protobuf:"varint,56,opt,name=evidence_dispute_voting_epochs,json=evidenceDisputeVotingEpochs,proto3" json:"evidence_dispute_voting_epochs,omitempty"`
+	// Evidence can only be disputed within this many epochs of being reported (default 7).
+	EvidenceDisputeWindowEpochs uint32 `protobuf:"varint,57,opt,name=evidence_dispute_window_epochs,json=evidenceDisputeWindowEpochs,proto3" json:"evidence_dispute_window_epochs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -545,6 +562,48 @@ func (m *Params) GetStorageTruthHealVerifierCount() uint32 {
 	return 0
 }
 
+func (m *Params) GetEpochFinalizationBlocks() uint64 {
+	if m != nil {
+		return m.EpochFinalizationBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEpochFinalizationBatchSize() uint64 {
+	if m != nil {
+		return m.EpochFinalizationBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetEvidenceDisputeMinBond() types.Coin {
+	if m != nil {
+		return m.EvidenceDisputeMinBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetEvidenceDisputePanelSize() uint32 {
+	if m != nil {
+		return m.EvidenceDisputePanelSize
+	}
+	return 0
+}
+
+func (m *Params) GetEvidenceDisputeVotingEpochs() uint32 {
+	if m != nil {
+		return m.EvidenceDisputeVotingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetEvidenceDisputeWindowEpochs() uint32 {
+	if m != nil {
+		return m.EvidenceDisputeWindowEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("lumera.audit.v1.StorageTruthEnforcementMode", StorageTruthEnforcementMode_name, StorageTruthEnforcementMode_value)
 	proto.RegisterType((*Params)(nil), "lumera.audit.v1.Params")
@@ -553,109 +612,120 @@ func init() {
 func init() { proto.RegisterFile("lumera/audit/v1/params.proto", fileDescriptor_3788ca0fc7eb9d86) }
 
 var fileDescriptor_3788ca0fc7eb9d86 = []byte{
-	// 1620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x5b, 0x77, 0x1b, 0x39,
-	0x1d, 0xaf, 0xb7, 0xa5, 0xec, 0x0a, 0x76, 0x9b, 0x4e, 0x5b, 0x3a, 0xb4, 0x5b, 0xaf, 0xd3, 0x6c,
-	0xdb, 0x34, 0xed, 0xda, 0xb9, 0xb0, 0x2c, 0x70, 0x78, 0x49, 0x7c, 0x21, 0x81, 0x38, 0xf6, 0xda,
-	0x0e, 0x85, 0x3d, 0x70, 0x84, 0x3c, 0xf3, 0xb7, 0x2d, 0x32, 0x96, 0xa6, 0x92, 0xc6, 0x49, 0xf6,
-	0x53, 0xf0, 0x11, 0xf8, 0x38, 0x1c, 0x5e, 0xe8, 0x23, 0x8f, 0x9c, 0xf6, 0x85, 0x8f, 0xc1, 0x91,
-	0xe6, 0xe2, 0xb9, 0xd8, 0xd8, 0x2f, 0x6d, 0xe2, 0xff, 0xef, 0x22, 0xe9, 0x7f, 0x91, 0x62, 0xf4,
-	0xb9, 0x17, 0x4c, 0x41, 0x90, 0x1a, 0x09, 0x5c, 0xaa, 0x6a, 0xb3, 0xbd, 0x9a, 0x4f, 0x04, 0x99,
-	0xca, 0xaa, 0x2f, 0xb8, 0xe2, 0xd6, 0x9d, 0x30, 0x5a, 0x35, 0xd1, 0xea, 0x6c, 0xef, 0xd1, 0x5d,
-	0x32, 0xa5, 0x8c, 0xd7, 0xcc, 0xbf, 0x21, 0xe6, 0xd1, 0xfd, 0x31, 0x1f, 0x73, 0xf3, 0x63, 0x4d,
-	0xff, 0x14, 0x7e, 0xfa, 0xf4, 0x5f, 0x9b, 0xe8, 0x76, 0xd7, 0x48, 0x59, 0x55, 0x74, 0x0f, 0x7c,
-	0xee, 0x4c, 0xb0, 0x07, 0x6c, 0xac, 0x26, 0x78, 0xe8, 0x71, 0xe7, 0x42, 0xda, 0xa5, 0x4a, 0x69,
-	0xfb, 0x56, 0xef, 0xae, 0x09, 0x9d, 0x9a, 0xc8, 0x91, 0x09, 0x58, 0x3b, 0x28, 0xfc, 0x10, 0x7f,
-	0x0f, 0x82, 0xe3, 0x09, 0xd0, 0xf1, 0x44, 0xd9, 0x1f, 0x19, 0xf4, 0x1d, 0x13, 0xf8, 0x0e, 0x04,
-	0x3f, 0x36, 0x1f, 0x6b, 0x6d, 0x1f, 0x40, 0xe0, 0xb7, 0x01, 0x17, 0xc1, 0x14, 0x0b, 0xf0, 0xb9,
-	0x50, 0xd2, 0xbe, 0x59, 0x29, 0x6d, 0x7f, 0xda, 0xbb, 0xab, 0x43, 0xdf, 0x9a, 0x48, 0x2f, 0x0c,
-	0x58, 0xbf, 0x46, 0x8f, 0xa7, 0x94, 0x61, 0x5f, 0xf0, 0x21, 0x60, 0x45, 0xc4, 0x18, 0x94, 0xc4,
-	0x3e, 0x08, 0x6c, 0x84, 0xed, 0x5b, 0x86, 0xf7, 0x70, 0x4a, 0x59, 0x57, 0x23, 0x06, 0x21, 0xa0,
-	0x0b, 0xa2, 0xa9, 0xc3, 0x86, 0x4d, 0xae, 0x96, 0xb2, 0x7f, 0x10, 0xb1, 0xc9, 0xd5, 0x42, 0x76,
-	0x15, 0xdd, 0x13, 0xf0, 0x36, 0xa0, 0x02, 0x5c, 0xcc, 0x7d, 0x60, 0x38, 0x5c, 0xeb, 0xed, 0xca,
-	0x4d, 0xbd, 0xd6, 0x38, 0xd4, 0xf1, 0x81, 0x75, 0xcd, 0x5a, 0x6b, 0xe8, 0xbe, 0x5e, 0xab, 0xe3,
-	0x07, 0x78, 0x24, 0x00, 0xb4, 0x91, 0x03, 0x4c, 0xd9, 0x3f, 0x0c, 0x37, 0x37, 0xa5, 0xac, 0xee,
-	0x07, 0x2d, 0x01, 0xd0, 0x0d, 0x03, 0x31, 0x61, 0x0a, 0xd3, 0x2c, 0xe1, 0xe3, 0x84, 0xd0, 0x86,
-	0x69, 0x9a, 0xb0, 0x87, 0x1e, 0x68, 0x82, 0x4b, 0xe5, 0x45, 0x96, 0xf1, 0x89, 0x61, 0x58, 0x53,
-	0xca, 0x1a, 0x54, 0x5e, 0xa4, 0x29, 0x75, 0x54, 0x76, 0x38, 0x93, 0xe0, 0x04, 0x8a, 0xce, 0x20,
-	0xdc, 0xb8, 0xc4, 0x8a, 0x63, 0x9f, 0x4b, 0xe5, 0x73, 0x06, 0x36, 0x32, 0xdc, 0xc7, 0x29, 0x94,
-	0xd9, 0xbe, 0x1c, 0xf0, 0x6e, 0x04, 0xb1, 0xbe, 0x46, 0x0f, 0x2f, 0x00, 0x7c, 0xec, 0x11, 0xa9,
-	0x42, 0x09, 0x0c, 0x4c, 0x09, 0x0a, 0xd2, 0xfe, 0x91, 0xc9, 0xf3, 0x7d, 0x1d, 0x3e, 0x25, 0x52,
-	0x19, 0x6a, 0x33, 0x8c, 0x59, 0x67, 0xe8, 0x4b, 0x93, 0x6c, 0x7d, 0x6e, 0x89, 0x1f, 0x56, 0x13,
-	0x01, 0x72, 0xc2, 0x3d, 0x37, 0x59, 0xfd, 0x8f, 0xcd, 0x0a, 0x2a, 0x1a, 0xab, 0x4f, 0x32, 0xb6,
-	0x1d, 0xc4, 0xc0, 0x78, 0x2f, 0x33, 0xf4, 0x4b, 0xe2, 0x28, 0xca, 0x19, 0x1e, 0x51, 0x46, 0x3c,
-	0xfa, 0x3d, 0x31, 0xbf, 0x48, 0x3a, 0x66, 0x44, 0x05, 0x02, 0xf0, 0x88, 0x50, 0x4f, 0xff, 0x0f,
-	0x33, 0xea, 0x02, 0x73, 0x20, 0x9d, 0xec, 0x4f, 0x8d, 0xc9, 0x41, 0x28, 0xd0, 0x4a, 0xf1, 0xfb,
-	0x31, 0xbd, 0x15, 0xb2, 0x9b, 0x31, 0x39, 0x29, 0x84, 0x00, 0xfd, 0x62, 0x3d, 0xdf, 0xe2, 0x49,
-	0xdb, 0x9f, 0xad, 0x6b, 0x5b, 0xcf, 0x9f, 0xbf, 0x75, 0x81, 0x7e, 0xb6, 0xc8, 0x96, 0x71, 0x85,
-	0x29, 0xc3, 0x8a, 0xfb, 0x7b, 0xbb, 0x0b, 0x77, 0x7a, 0xc7, 0x58, 0x7e, 0x55, 0xb4, 0x3c, 0xe3,
-	0xea, 0x84, 0x0d, 0x34, 0xaf, 0xb8, 0xc7, 0xbf, 0xa2, 0x83, 0x95, 0x66, 0x0b, 0xb6, 0xb7, 0xb1,
-	0xda, 0xab, 0xb8, 0xb1, 0x36, 0xda, 0x5a, 0xe4, 0x25, 0xc0, 0xe1, 0x33, 0x10, 0xd7, 0xb1, 0xf6,
-	0xdd, 0xb0, 0x2c, 0x8a, 0xda, 0xbd, 0x08, 0x18, 0xc9, 0x79, 0x8b, 0xcf, 0x29, 0x91, 0xd3, 0x23,
-	0x40, 0x71, 0x45, 0x3c, 0x3c, 0x24, 0xee, 0xfc, 0xc4, 0x6c, 0xcb, 0xe8, 0x57, 0x97, 0xeb, 0xb7,
-	0xc9, 0xd5, 0x40, 0xf3, 0x8e, 0x88, 0x9b, 0x1c, 0x98, 0xf5, 0x04, 0x21, 0xe9, 0x60, 0x60, 0x64,
-	0xe8, 0x81, 0x6b, 0xdf, 0xab, 0x94, 0xb6, 0x3f, 0xee, 0x7d, 0x22, 0x9d, 0x66, 0xf8, 0x81, 0xf5,
-	0x0d, 0xb2, 0xa5, 0x83, 0x9d, 0x09, 0xf1, 0xf4, 0xf4, 0x04, 0x91, 0x4e, 0xcc, 0x7d, 0x63, 0xf8,
-	0x40, 0x3a, 0xf5, 0x79, 0x38, 0x49, 0xc0, 0xb7, 0xe8, 0xb9, 0x54, 0x5c, 0x90, 0x31, 0x60, 0x25,
-	0x02, 0x35, 0xd1, 0xeb, 0x07, 0xa6, 0xf0, 0x30, 0x70, 0x2e, 0x40, 0x99, 0x4d, 0x44, 0x83, 0xf8,
-	0x81, 0x69, 0xb9, 0xcd, 0x08, 0x3d, 0xd0, 0xe0, 0x9e, 0xc1, 0x1e, 0x19, 0x68, 0x9b, 0x5c, 0x45,
-	0x83, 0xf9, 0x77, 0x68, 0x2b, 0x2b, 0xa9, 0x9b, 0x2e, 0xd6, 0xa3, 0x2c, 0xd6, 0xfb, 0x89, 0xd1,
-	0x2b, 0xa7, 0xf5, 0x3a, 0x9e, 0x1b, 0x89, 0x51, 0x16, 0x89, 0xf5, 0xf2, 0xeb, 0x4b, 0xf6, 0x18,
-	0x4d, 0x56, 0xec, 0xd2, 0x19, 0x95, 0x5c, 0xd8, 0x0f, 0xcd, 0x36, 0x9f, 0xa6, 0xf5, 0x92, 0x0d,
-	0x87, 0x33, 0xb6, 0x11, 0x22, 0xad, 0x3f, 0xa2, 0x9d, 0x9c, 0x26, 0x9f, 0xfa, 0x3c, 0x60, 0x2e,
-	0x16, 0x84, 0x8d, 0xa3, 0xa2, 0x26, 0x42, 0xd1, 0x11, 0x71, 0x94, 0x6d, 0x1b, 0xdd, 0x67, 0x19,
-	0xdd, 0x08, 0xdf, 0x33, 0xf0, 0x2e, 0x88, 0xc3, 0x08, 0x5c, 0x3c, 0xce, 0xac, 0xb4, 0xbe, 0xdb,
-	0xf0, 0xf0, 0x5a, 0x81, 0xb4, 0x7f, 0x6a, 0x64, 0x37, 0x97, 0xca, 0x9e, 0x02, 0x3b, 0xd2, 0x40,
-	0xeb, 0x1c, 0xbd, 0xcc, 0x4a, 0xea, 0x9c, 0x48, 0xf0, 0x46, 0x78, 0x02, 0xc4, 0xc3, 0xdc, 0x4f,
-	0xe7, 0xfa, 0x51, 0xf1, 0x10, 0xda, 0xe4, 0xaa, 0x0f, 0xde, 0xe8, 0x18, 0x88, 0xd7, 0xf1, 0xe7,
-	0x89, 0xaf, 0xa3, 0x72, 0x56, 0x56, 0x5f, 0x57, 0x61, 0x09, 0x47, 0x8d, 0xf0, 0x38, 0x9c, 0xd0,
-	0x69, 0xad, 0x6e, 0x8c, 0x89, 0x7a, 0xe0, 0x4f, 0xe8, 0x75, 0x56, 0x84, 0x71, 0x17, 0xb0, 0x0c,
-	0xa4, 0x4f, 0x1d, 0xad, 0xe4, 0x82, 0x43, 0xae, 0x53, 0xcb, 0xfb, 0xbc, 0x52, 0xda, 0xbe, 0xd9,
-	0x7b, 0x9e, 0x96, 0x3c, 0xe3, 0x2e, 0xf4, 0x63, 0x42, 0x43, 0xe3, 0x93, 0x25, 0x4e, 0xd0, 0x7e,
-	0xbe, 0x36, 0xf5, 0x4c, 0x07, 0x81, 0x05, 0x78, 0x94, 0x0c, 0xa9, 0x47, 0xd5, 0x75, 0xc1, 0xe3,
-	0x89, 0xf1, 0x78, 0x9d, 0xad, 0xd3, 0x90, 0xd7, 0x9b, 0xd3, 0x56, 0x38, 0x29, 0x6a, 0xca, 0xd5,
-	0x05, 0x05, 0x82, 0x72, 0x11, 0x9e, 0x4b, 0xde, 0xa9, 0x5c, 0x74, 0x1a, 0x18, 0x5e, 0x23, 0x4d,
-	0xcb, 0x3a, 0xfd, 0x79, 0xc5, 0x89, 0xcd, 0xaf, 0xa9, 0x4b, 0xa2, 0x9c, 0x89, 0xfd, 0x85, 0xf1,
-	0x78, 0xb1, 0xf4, 0xc4, 0x92, 0xdb, 0xea, 0x8d, 0x86, 0x5b, 0x80, 0x76, 0xd7, 0x94, 0x4f, 0xd2,
-	0x6d, 0x57, 0x8c, 0xc5, 0xab, 0xd5, 0x16, 0x49, 0xf6, 0x2d, 0x07, 0xd5, 0xd6, 0xb5, 0x89, 0xef,
-	0xfb, 0x4d, 0xe3, 0xb2, 0xb3, 0x86, 0x4b, 0x7c, 0xfd, 0xfb, 0xe8, 0xe7, 0x6b, 0xa4, 0xdf, 0xe3,
-	0x97, 0x3a, 0x2c, 0xd5, 0xdc, 0xd4, 0x7e, 0x6a, 0xbc, 0x76, 0x57, 0x94, 0xc0, 0x29, 0xbf, 0x1c,
-	0x68, 0x62, 0xe2, 0x6c, 0x49, 0xf4, 0xcd, 0x1a, 0x8e, 0x94, 0x81, 0x47, 0xc7, 0x74, 0xe8, 0xa5,
-	0x1e, 0x15, 0xf6, 0x96, 0xb1, 0xdc, 0x5f, 0x61, 0x79, 0x92, 0x50, 0xe7, 0xa6, 0x63, 0xb4, 0xb7,
-	0x46, 0xed, 0x99, 0x5e, 0x9f, 0xdb, 0x7d, 0xb9, 0x56, 0xe9, 0xe9, 0x9e, 0x9f, 0x1b, 0xbd, 0xcd,
-	0x77, 0x3c, 0xb0, 0x11, 0x17, 0x0e, 0x4c, 0xf5, 0xbc, 0x9f, 0x72, 0x17, 0xec, 0x67, 0x95, 0xd2,
-	0xf6, 0x67, 0xfb, 0xaf, 0xab, 0xb9, 0xe7, 0x7c, 0xb5, 0x9f, 0xb2, 0x69, 0xce, 0x49, 0x6d, 0xee,
-	0x42, 0x76, 0x3e, 0xe4, 0x82, 0x16, 0x47, 0x5f, 0xaf, 0xd5, 0xc1, 0x63, 0x41, 0x5c, 0x70, 0x53,
-	0xfb, 0x7b, 0xbe, 0x56, 0x06, 0x1b, 0x11, 0x71, 0xbe, 0xc7, 0x01, 0x7a, 0x91, 0x9b, 0x6a, 0x44,
-	0x29, 0x10, 0x0c, 0x83, 0x74, 0x88, 0x17, 0x1e, 0xe5, 0x25, 0x65, 0x2e, 0xbf, 0xb4, 0x5f, 0x98,
-	0xf1, 0xb6, 0x95, 0x19, 0x6f, 0x21, 0xb8, 0x99, 0x60, 0xdf, 0x18, 0x68, 0xf1, 0x12, 0x72, 0xe9,
-	0x0c, 0xc4, 0x58, 0xdf, 0xcc, 0x91, 0x5a, 0x3c, 0x33, 0xb7, 0x8b, 0xf3, 0xb7, 0x91, 0x60, 0x43,
-	0xb5, 0x68, 0x74, 0x8e, 0xf2, 0x69, 0x4f, 0x8e, 0x46, 0xdf, 0x91, 0xd1, 0x5f, 0x28, 0x78, 0xc4,
-	0x45, 0xca, 0xcc, 0x7e, 0x69, 0xe4, 0x5f, 0x2d, 0x3a, 0x96, 0x36, 0x65, 0xd1, 0x5f, 0x2f, 0x2d,
-	0x2e, 0xe6, 0x9e, 0xc5, 0x2e, 0x5a, 0xda, 0xaa, 0x52, 0x09, 0xce, 0xc6, 0xf3, 0x8e, 0xdd, 0x29,
-	0xe6, 0x60, 0x71, 0xc7, 0xf6, 0x0d, 0x31, 0xe9, 0xdb, 0x7e, 0x3e, 0x07, 0xc9, 0x93, 0xc8, 0xf1,
-	0x80, 0x30, 0xec, 0x13, 0x29, 0xb1, 0xc3, 0x03, 0xa6, 0xec, 0x57, 0xc5, 0xe3, 0x8a, 0x5f, 0x41,
-	0x75, 0x8d, 0xed, 0x12, 0x29, 0xeb, 0x1a, 0x69, 0x9d, 0xa0, 0xa7, 0xb9, 0x8b, 0xd5, 0xd3, 0x32,
-	0x04, 0x8f, 0x48, 0xe0, 0xa9, 0x38, 0xa7, 0xaf, 0x8d, 0xde, 0x93, 0xcc, 0xa5, 0xaa, 0x71, 0x87,
-	0x2d, 0x8d, 0x8a, 0xb2, 0xb9, 0x44, 0x6a, 0x98, 0x95, 0xfa, 0x6a, 0x89, 0xd4, 0x51, 0x5a, 0xea,
-	0xb7, 0x79, 0x29, 0xd3, 0xa6, 0x2e, 0x10, 0xd7, 0xa3, 0x2c, 0x79, 0xad, 0x56, 0x8d, 0x54, 0xe6,
-	0xa5, 0xa3, 0x3b, 0xb3, 0x11, 0xc1, 0xa2, 0x82, 0xe8, 0xe6, 0x8b, 0x4c, 0xe7, 0x64, 0xe1, 0x2e,
-	0x6b, 0xe1, 0x0b, 0x35, 0xf7, 0x72, 0x2a, 0x6e, 0xf4, 0x0f, 0xf9, 0x97, 0x83, 0xc3, 0x99, 0x12,
-	0xc4, 0xa5, 0x4e, 0xaa, 0x0f, 0xe2, 0x45, 0xee, 0x2e, 0x7a, 0xe6, 0xa4, 0xe0, 0x99, 0xe2, 0x1d,
-	0x2f, 0x2d, 0xde, 0xd4, 0x70, 0x74, 0x03, 0x91, 0x79, 0x4f, 0xec, 0x19, 0x87, 0x85, 0x17, 0xf3,
-	0x7c, 0x2e, 0x36, 0x22, 0x52, 0x64, 0xf4, 0x17, 0x54, 0xcd, 0x1a, 0x45, 0x45, 0xba, 0xbc, 0xa4,
-	0xf6, 0x8d, 0xcb, 0x76, 0xda, 0x25, 0xac, 0xcf, 0x25, 0x85, 0x75, 0x8c, 0x36, 0x17, 0xa4, 0x70,
-	0x06, 0x82, 0x8e, 0x28, 0x88, 0x48, 0xf4, 0xa0, 0x58, 0x0c, 0x3a, 0x83, 0xbf, 0x8f, 0x50, 0x46,
-	0xe9, 0x57, 0xb7, 0xfe, 0xfb, 0xf7, 0x2f, 0x4a, 0x3b, 0xff, 0x2c, 0xa1, 0xc7, 0xff, 0x67, 0x5e,
-	0x5a, 0x55, 0xb4, 0xd3, 0x1f, 0x74, 0x7a, 0x87, 0xbf, 0x69, 0xe2, 0x41, 0xef, 0x7c, 0x70, 0x8c,
-	0x9b, 0x67, 0xad, 0x4e, 0xaf, 0xde, 0x6c, 0x37, 0xcf, 0x06, 0xb8, 0xdd, 0x69, 0x34, 0xf1, 0xf9,
-	0x59, 0xbf, 0xdb, 0xac, 0x9f, 0xb4, 0x4e, 0x9a, 0x8d, 0x8d, 0x1b, 0xd6, 0x4b, 0xf4, 0x6c, 0x05,
-	0xbe, 0x7f, 0x7c, 0xd8, 0xe8, 0xbc, 0xd9, 0x28, 0x59, 0x2f, 0xd0, 0xd6, 0x2a, 0x68, 0xa7, 0x35,
-	0xd8, 0xf8, 0x68, 0x0d, 0x60, 0xeb, 0xfc, 0xf4, 0x74, 0xe3, 0xe6, 0xd1, 0xce, 0x3f, 0xde, 0x97,
-	0x4b, 0xef, 0xde, 0x97, 0x4b, 0xff, 0x79, 0x5f, 0x2e, 0xfd, 0xed, 0x43, 0xf9, 0xc6, 0xbb, 0x0f,
-	0xe5, 0x1b, 0xff, 0xfe, 0x50, 0xbe, 0xf1, 0xdd, 0xc6, 0xd5, 0xfc, 0xbb, 0x20, 0x75, 0xed, 0x83,
-	0x1c, 0xde, 0x36, 0xdf, 0xe8, 0x1c, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xf8, 0xb5, 0x55,
-	0x2b, 0x12, 0x00, 0x00,
+	// 1804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcd, 0x76, 0x1b, 0xb7,
+	0x15, 0x16, 0x63, 0xd7, 0x8d, 0x91, 0x26, 0x96, 0xc7, 0x76, 0x3c, 0xb6, 0x62, 0x5a, 0xb6, 0xfc,
+	0x23, 0xcb, 0x0e, 0x69, 0xca, 0x76, 0x9c, 0xe4, 0xb4, 0x0b, 0x89, 0x3f, 0x95, 0x5a, 0x51, 0x62,
+	0x48, 0x3a, 0x6e, 0x73, 0xda, 0x83, 0x82, 0x33, 0x97, 0x24, 0xaa, 0x21, 0x30, 0x06, 0x30, 0x94,
+	0xe4, 0xa7, 0xe8, 0x23, 0x74, 0xd9, 0x65, 0x1f, 0x23, 0xa7, 0xab, 0x2c, 0xbb, 0xea, 0xe9, 0xb1,
+	0x17, 0xed, 0x23, 0x74, 0xd9, 0x03, 0xcc, 0x0f, 0x67, 0x38, 0xa4, 0xc9, 0x8d, 0x44, 0x0e, 0xbe,
+	0x1f, 0x00, 0xf7, 0xe2, 0xe2, 0x72, 0xd0, 0x17, 0x5e, 0x30, 0x02, 0x41, 0xca, 0x24, 0x70, 0xa9,
+	0x2a, 0x8f, 0x2b, 0x65, 0x9f, 0x08, 0x32, 0x92, 0x25, 0x5f, 0x70, 0xc5, 0xad, 0x4b, 0xe1, 0x68,
+	0xc9, 0x8c, 0x96, 0xc6, 0x95, 0x9b, 0x97, 0xc9, 0x88, 0x32, 0x5e, 0x36, 0x7f, 0x43, 0xcc, 0xcd,
+	0xab, 0x03, 0x3e, 0xe0, 0xe6, 0x63, 0x59, 0x7f, 0x8a, 0x9e, 0x16, 0x1d, 0x2e, 0x47, 0x5c, 0x96,
+	0x7b, 0x44, 0x42, 0x79, 0x5c, 0xe9, 0x81, 0x22, 0x95, 0xb2, 0xc3, 0x29, 0x0b, 0xc7, 0xef, 0xfe,
+	0xef, 0x1e, 0xba, 0xd0, 0x32, 0x56, 0x56, 0x09, 0x5d, 0x01, 0x9f, 0x3b, 0x43, 0xec, 0x01, 0x1b,
+	0xa8, 0x21, 0xee, 0x79, 0xdc, 0x39, 0x96, 0x76, 0x61, 0xbd, 0xb0, 0x79, 0xbe, 0x7d, 0xd9, 0x0c,
+	0x1d, 0x98, 0x91, 0x5d, 0x33, 0x60, 0x6d, 0xa1, 0xf0, 0x21, 0x7e, 0x0b, 0x82, 0xe3, 0x21, 0xd0,
+	0xc1, 0x50, 0xd9, 0x1f, 0x19, 0xf4, 0x25, 0x33, 0xf0, 0x03, 0x08, 0xbe, 0x67, 0x1e, 0x6b, 0x6d,
+	0x1f, 0x40, 0xe0, 0x37, 0x01, 0x17, 0xc1, 0x08, 0x0b, 0xf0, 0xb9, 0x50, 0xd2, 0x3e, 0xb7, 0x5e,
+	0xd8, 0xfc, 0xb4, 0x7d, 0x59, 0x0f, 0x7d, 0x67, 0x46, 0xda, 0xe1, 0x80, 0xf5, 0x4b, 0xb4, 0x36,
+	0xa2, 0x0c, 0xfb, 0x82, 0xf7, 0x00, 0x2b, 0x22, 0x06, 0xa0, 0x24, 0xf6, 0x41, 0x60, 0x23, 0x6c,
+	0x9f, 0x37, 0xbc, 0xeb, 0x23, 0xca, 0x5a, 0x1a, 0xd1, 0x0d, 0x01, 0x2d, 0x10, 0x75, 0x3d, 0x6c,
+	0xd8, 0xe4, 0x74, 0x2e, 0xfb, 0x67, 0x11, 0x9b, 0x9c, 0xce, 0x64, 0x97, 0xd0, 0x15, 0x01, 0x6f,
+	0x02, 0x2a, 0xc0, 0xc5, 0xdc, 0x07, 0x86, 0xc3, 0xb9, 0x5e, 0x58, 0x3f, 0xa7, 0xe7, 0x1a, 0x0f,
+	0x1d, 0xf9, 0xc0, 0x5a, 0x66, 0xae, 0x65, 0x74, 0x55, 0xcf, 0xd5, 0xf1, 0x03, 0xdc, 0x17, 0x00,
+	0xda, 0xc8, 0x01, 0xa6, 0xec, 0x9f, 0x87, 0x8b, 0x1b, 0x51, 0x56, 0xf5, 0x83, 0x86, 0x00, 0x68,
+	0x85, 0x03, 0x31, 0x61, 0x04, 0xa3, 0x2c, 0xe1, 0xe3, 0x84, 0xd0, 0x84, 0x51, 0x9a, 0x50, 0x41,
+	0xd7, 0x34, 0xc1, 0xa5, 0xf2, 0x38, 0xcb, 0xb8, 0x68, 0x18, 0xd6, 0x88, 0xb2, 0x1a, 0x95, 0xc7,
+	0x69, 0x4a, 0x15, 0x15, 0x1d, 0xce, 0x24, 0x38, 0x81, 0xa2, 0x63, 0x08, 0x17, 0x2e, 0xb1, 0xe2,
+	0xd8, 0xe7, 0x52, 0xf9, 0x9c, 0x81, 0x8d, 0x0c, 0x77, 0x2d, 0x85, 0x32, 0xcb, 0x97, 0x5d, 0xde,
+	0x8a, 0x20, 0xd6, 0x0b, 0x74, 0xfd, 0x18, 0xc0, 0xc7, 0x1e, 0x91, 0x2a, 0x94, 0xc0, 0xc0, 0x94,
+	0xa0, 0x20, 0xed, 0x4f, 0x4c, 0x9c, 0xaf, 0xea, 0xe1, 0x03, 0x22, 0x95, 0xa1, 0xd6, 0xc3, 0x31,
+	0xeb, 0x10, 0xdd, 0x33, 0xc1, 0xd6, 0xfb, 0x96, 0xf8, 0x61, 0x35, 0x14, 0x20, 0x87, 0xdc, 0x73,
+	0x93, 0xd9, 0xff, 0xc2, 0xcc, 0x60, 0x5d, 0x63, 0xf5, 0x4e, 0xc6, 0xb6, 0xdd, 0x18, 0x18, 0xaf,
+	0x65, 0x8c, 0xbe, 0x21, 0x8e, 0xa2, 0x9c, 0xe1, 0x3e, 0x65, 0xc4, 0xa3, 0x6f, 0x89, 0xf9, 0x22,
+	0xe9, 0x80, 0x11, 0x15, 0x08, 0xc0, 0x7d, 0x42, 0x3d, 0xfd, 0x1f, 0xc6, 0xd4, 0x05, 0xe6, 0x40,
+	0x3a, 0xd8, 0x9f, 0x1a, 0x93, 0x67, 0xa1, 0x40, 0x23, 0xc5, 0xef, 0xc4, 0xf4, 0x46, 0xc8, 0xae,
+	0xc7, 0xe4, 0x24, 0x11, 0x02, 0xf4, 0xf5, 0x72, 0xbe, 0xf9, 0x9d, 0xb6, 0x3f, 0x5b, 0xd6, 0xb6,
+	0x3a, 0xbd, 0xff, 0xd6, 0x31, 0x7a, 0x3e, 0xcb, 0x96, 0x71, 0x85, 0x29, 0xc3, 0x8a, 0xfb, 0x95,
+	0xa7, 0x33, 0x57, 0x7a, 0xc9, 0x58, 0x7e, 0x99, 0xb7, 0x3c, 0xe4, 0x6a, 0x9f, 0x75, 0x35, 0x2f,
+	0xbf, 0xc6, 0x3f, 0xa3, 0x67, 0x0b, 0xcd, 0x66, 0x2c, 0x6f, 0x75, 0xb1, 0x57, 0x7e, 0x61, 0x4d,
+	0xb4, 0x31, 0xcb, 0x4b, 0x80, 0xc3, 0xc7, 0x20, 0xce, 0x62, 0xed, 0xcb, 0x61, 0x5a, 0xe4, 0xb5,
+	0xdb, 0x11, 0x30, 0x92, 0xf3, 0xd0, 0xf3, 0x0f, 0xca, 0xe9, 0x12, 0xa0, 0xb8, 0x22, 0x1e, 0xee,
+	0x11, 0x77, 0xb2, 0x63, 0xb6, 0x65, 0xf4, 0x4b, 0xf3, 0xf5, 0x9b, 0xe4, 0xb4, 0xab, 0x79, 0xbb,
+	0xc4, 0x4d, 0x36, 0xcc, 0xba, 0x85, 0x90, 0x74, 0x30, 0x30, 0xd2, 0xf3, 0xc0, 0xb5, 0xaf, 0xac,
+	0x17, 0x36, 0x3f, 0x6e, 0x5f, 0x94, 0x4e, 0x3d, 0x7c, 0x60, 0xbd, 0x44, 0xb6, 0x74, 0xb0, 0x33,
+	0x24, 0x9e, 0xae, 0x9e, 0x20, 0xd2, 0x81, 0xb9, 0x6a, 0x0c, 0xaf, 0x49, 0xa7, 0x3a, 0x19, 0x4e,
+	0x02, 0xf0, 0x1d, 0x7a, 0x20, 0x15, 0x17, 0x64, 0x00, 0x58, 0x89, 0x40, 0x0d, 0xf5, 0xfc, 0x81,
+	0x29, 0xdc, 0x0b, 0x9c, 0x63, 0x50, 0x66, 0x11, 0x51, 0x21, 0xbe, 0x66, 0x8e, 0xdc, 0x9d, 0x08,
+	0xdd, 0xd5, 0xe0, 0xb6, 0xc1, 0xee, 0x1a, 0x68, 0x93, 0x9c, 0x46, 0x85, 0xf9, 0xb7, 0x68, 0x23,
+	0x2b, 0xa9, 0x0f, 0x5d, 0xac, 0x47, 0x59, 0xac, 0xf7, 0xb9, 0xd1, 0x2b, 0xa6, 0xf5, 0x8e, 0x3c,
+	0x37, 0x12, 0xa3, 0x2c, 0x12, 0x6b, 0x4f, 0xcf, 0x2f, 0x59, 0x63, 0x54, 0x59, 0xb1, 0x4b, 0xc7,
+	0x54, 0x72, 0x61, 0x5f, 0x37, 0xcb, 0xbc, 0x9b, 0xd6, 0x4b, 0x16, 0x1c, 0xd6, 0xd8, 0x5a, 0x88,
+	0xb4, 0x7e, 0x8f, 0xb6, 0xa6, 0x34, 0xf9, 0xc8, 0xe7, 0x01, 0x73, 0xb1, 0x20, 0x6c, 0x10, 0x25,
+	0x35, 0x11, 0x8a, 0xf6, 0x89, 0xa3, 0x6c, 0xdb, 0xe8, 0xde, 0xcf, 0xe8, 0x46, 0xf8, 0xb6, 0x81,
+	0xb7, 0x40, 0xec, 0x44, 0xe0, 0xfc, 0x76, 0x66, 0xa5, 0xf5, 0xdd, 0x86, 0x7b, 0x67, 0x0a, 0xa4,
+	0x7d, 0xc3, 0xc8, 0xde, 0x99, 0x2b, 0x7b, 0x00, 0x6c, 0x57, 0x03, 0xad, 0x57, 0xe8, 0x51, 0x56,
+	0x52, 0xc7, 0x44, 0x82, 0xd7, 0xc7, 0x43, 0x20, 0x1e, 0xe6, 0x7e, 0x3a, 0xd6, 0x37, 0xf3, 0x9b,
+	0xd0, 0x24, 0xa7, 0x1d, 0xf0, 0xfa, 0x7b, 0x40, 0xbc, 0x23, 0x7f, 0x12, 0xf8, 0x2a, 0x2a, 0x66,
+	0x65, 0xf5, 0x75, 0x15, 0xa6, 0x70, 0x74, 0x10, 0xd6, 0xc2, 0x0a, 0x9d, 0xd6, 0x6a, 0xc5, 0x98,
+	0xe8, 0x0c, 0xfc, 0x01, 0x3d, 0xc9, 0x8a, 0x30, 0xee, 0x02, 0x96, 0x81, 0xf4, 0xa9, 0xa3, 0x95,
+	0x5c, 0x70, 0xc8, 0x59, 0x6a, 0x7a, 0x5f, 0xac, 0x17, 0x36, 0xcf, 0xb5, 0x1f, 0xa4, 0x25, 0x0f,
+	0xb9, 0x0b, 0x9d, 0x98, 0x50, 0xd3, 0xf8, 0x64, 0x8a, 0x43, 0xb4, 0x3d, 0x9d, 0x9b, 0xba, 0xa6,
+	0x83, 0xc0, 0x02, 0x3c, 0x4a, 0x7a, 0xd4, 0xa3, 0xea, 0x2c, 0xe7, 0x71, 0xcb, 0x78, 0x3c, 0xc9,
+	0xe6, 0x69, 0xc8, 0x6b, 0x4f, 0x68, 0x0b, 0x9c, 0x14, 0x35, 0xe9, 0xea, 0x82, 0x02, 0x41, 0xb9,
+	0x08, 0xf7, 0x65, 0xda, 0xa9, 0x98, 0x77, 0xea, 0x1a, 0x5e, 0x2d, 0x4d, 0xcb, 0x3a, 0xfd, 0x71,
+	0xc1, 0x8e, 0x4d, 0xae, 0xa9, 0x13, 0xa2, 0x9c, 0xa1, 0x7d, 0xdb, 0x78, 0x3c, 0x9c, 0xbb, 0x63,
+	0xc9, 0x6d, 0xf5, 0x5a, 0xc3, 0x2d, 0x40, 0x4f, 0x97, 0x94, 0x4f, 0xc2, 0x6d, 0xaf, 0x1b, 0x8b,
+	0xc7, 0x8b, 0x2d, 0x92, 0xe8, 0x5b, 0x0e, 0x2a, 0x2f, 0x6b, 0x13, 0xdf, 0xf7, 0x77, 0x8c, 0xcb,
+	0xd6, 0x12, 0x2e, 0xf1, 0xf5, 0xef, 0xa3, 0xaf, 0x96, 0x08, 0xbf, 0xc7, 0x4f, 0xf4, 0xb0, 0x54,
+	0x13, 0x53, 0xfb, 0xae, 0xf1, 0x7a, 0xba, 0x20, 0x05, 0x0e, 0xf8, 0x49, 0x57, 0x13, 0x13, 0x67,
+	0x4b, 0xa2, 0x97, 0x4b, 0x38, 0x52, 0x06, 0x1e, 0x1d, 0xd0, 0x9e, 0x97, 0x6a, 0x2a, 0xec, 0x0d,
+	0x63, 0xb9, 0xbd, 0xc0, 0x72, 0x3f, 0xa1, 0x4e, 0x4c, 0x07, 0xa8, 0xb2, 0x44, 0xee, 0x99, 0xb3,
+	0x3e, 0xb1, 0xbb, 0xb7, 0x54, 0xea, 0xe9, 0x33, 0x3f, 0x31, 0x7a, 0x33, 0x7d, 0xe2, 0x81, 0xf5,
+	0xb9, 0x70, 0x60, 0xa4, 0xeb, 0xfd, 0x88, 0xbb, 0x60, 0xdf, 0x5f, 0x2f, 0x6c, 0x7e, 0xb6, 0xfd,
+	0xa4, 0x34, 0xd5, 0xee, 0x97, 0x3a, 0x29, 0x9b, 0xfa, 0x84, 0xd4, 0xe4, 0x2e, 0x64, 0xeb, 0xc3,
+	0xd4, 0xa0, 0xc5, 0xd1, 0x8b, 0xa5, 0x4e, 0xf0, 0x40, 0x10, 0x17, 0xdc, 0xd4, 0xfa, 0x1e, 0x2c,
+	0x15, 0xc1, 0x5a, 0x44, 0x9c, 0xac, 0xb1, 0x8b, 0x1e, 0x4e, 0x55, 0x35, 0xa2, 0x14, 0x08, 0x86,
+	0x41, 0x3a, 0xc4, 0x0b, 0xb7, 0xf2, 0x84, 0x32, 0x97, 0x9f, 0xd8, 0x0f, 0x4d, 0x79, 0xdb, 0xc8,
+	0x94, 0xb7, 0x10, 0x5c, 0x4f, 0xb0, 0xaf, 0x0d, 0x34, 0x7f, 0x09, 0xb9, 0x74, 0x0c, 0x62, 0xa0,
+	0x6f, 0xe6, 0x48, 0x2d, 0xae, 0x99, 0x9b, 0xf9, 0xfa, 0x5b, 0x4b, 0xb0, 0xa1, 0x5a, 0x54, 0x3a,
+	0xfb, 0xa8, 0x32, 0x67, 0x6b, 0xf4, 0x1d, 0x19, 0x7e, 0x91, 0xb8, 0xcf, 0x45, 0xca, 0xcc, 0x7e,
+	0x64, 0xe4, 0x1f, 0xcf, 0xda, 0x96, 0x26, 0x65, 0xe1, 0x47, 0xd9, 0xe0, 0x62, 0xe2, 0x99, 0x3f,
+	0x45, 0x73, 0x8f, 0xaa, 0x54, 0x82, 0xb3, 0xc1, 0xe4, 0xc4, 0x6e, 0xe5, 0x63, 0x30, 0xfb, 0xc4,
+	0x76, 0x0c, 0x31, 0x39, 0xb7, 0x9d, 0xe9, 0x18, 0x24, 0x2d, 0x91, 0xe3, 0x01, 0x61, 0xd8, 0x27,
+	0x52, 0x62, 0x87, 0x07, 0x4c, 0xd9, 0x8f, 0xf3, 0xdb, 0x15, 0x77, 0x41, 0x55, 0x8d, 0x6d, 0x11,
+	0x29, 0xab, 0x1a, 0x69, 0xed, 0xa3, 0xbb, 0x53, 0x17, 0xab, 0xa7, 0x65, 0x08, 0xee, 0x93, 0xc0,
+	0x53, 0x71, 0x4c, 0x9f, 0x18, 0xbd, 0x5b, 0x99, 0x4b, 0x55, 0xe3, 0x76, 0x1a, 0x1a, 0x15, 0x45,
+	0x73, 0x8e, 0x54, 0x2f, 0x2b, 0xf5, 0xe5, 0x1c, 0xa9, 0xdd, 0xb4, 0xd4, 0x6f, 0xa6, 0xa5, 0xcc,
+	0x31, 0x75, 0x81, 0xb8, 0x1e, 0x65, 0x49, 0xb7, 0x5a, 0x32, 0x52, 0x99, 0x4e, 0x47, 0x9f, 0xcc,
+	0x5a, 0x04, 0x8b, 0x12, 0xa2, 0x35, 0x9d, 0x64, 0x3a, 0x26, 0x33, 0x57, 0x59, 0x0e, 0x3b, 0xd4,
+	0xa9, 0xce, 0x29, 0xbf, 0xd0, 0xdf, 0x4d, 0x77, 0x0e, 0x0e, 0x67, 0x4a, 0x10, 0x97, 0x3a, 0xa9,
+	0x73, 0x10, 0x4f, 0xf2, 0xe9, 0xac, 0x36, 0x27, 0x05, 0xcf, 0x24, 0xef, 0x60, 0x6e, 0xf2, 0xa6,
+	0x8a, 0xa3, 0x1b, 0x88, 0x4c, 0x3f, 0x51, 0x31, 0x0e, 0x33, 0x2f, 0xe6, 0x49, 0x5d, 0xac, 0x45,
+	0xa4, 0xc8, 0xe8, 0x4f, 0xa8, 0x94, 0x35, 0x8a, 0x92, 0x74, 0x7e, 0x4a, 0x6d, 0x1b, 0x97, 0xcd,
+	0xb4, 0x4b, 0x98, 0x9f, 0x73, 0x12, 0x6b, 0x0f, 0xdd, 0x99, 0x11, 0xc2, 0x31, 0x08, 0xda, 0xa7,
+	0x20, 0x22, 0xd1, 0x67, 0xf9, 0x64, 0xd0, 0x11, 0xfc, 0x3e, 0x42, 0x85, 0x4a, 0xdf, 0xa2, 0x1b,
+	0x66, 0xa5, 0xd9, 0xdf, 0x03, 0x51, 0xb7, 0xfb, 0xdc, 0x74, 0xbb, 0xd7, 0x0d, 0x20, 0xdd, 0xf4,
+	0x47, 0x6d, 0xee, 0x0e, 0xba, 0x35, 0x8b, 0xab, 0xef, 0x74, 0x2c, 0xe9, 0x5b, 0xb0, 0x5f, 0x18,
+	0xfe, 0xcd, 0x3c, 0x5f, 0x43, 0x3a, 0xf4, 0x2d, 0x58, 0x18, 0xdd, 0x88, 0x7f, 0x64, 0xe8, 0x9f,
+	0xea, 0x7e, 0xa0, 0x20, 0x6c, 0xb7, 0x39, 0x73, 0xed, 0xaf, 0xd6, 0x0b, 0x9b, 0x9f, 0x6c, 0xdf,
+	0x28, 0x85, 0xaf, 0x63, 0x4a, 0xfa, 0x75, 0x4c, 0x29, 0x7a, 0x1d, 0x53, 0xaa, 0x72, 0xca, 0x76,
+	0x2f, 0xfe, 0xf8, 0xaf, 0xdb, 0x2b, 0x7f, 0xfb, 0xcf, 0xdf, 0xb7, 0x0a, 0xed, 0xcf, 0x63, 0x99,
+	0x5a, 0xa8, 0xa2, 0x9b, 0x71, 0xce, 0x5c, 0xeb, 0x57, 0x68, 0x2d, 0x67, 0xe0, 0x13, 0x06, 0x5e,
+	0x38, 0xc3, 0x97, 0x66, 0x8f, 0xec, 0x29, 0x72, 0x4b, 0x03, 0xcc, 0xfc, 0xaa, 0xa8, 0x98, 0xa3,
+	0x8f, 0xb9, 0xa2, 0x6c, 0x10, 0x27, 0xc8, 0xd7, 0x61, 0xc3, 0x39, 0xa5, 0xf0, 0xbd, 0xc1, 0x44,
+	0xf9, 0x30, 0x4b, 0x24, 0x9b, 0xc7, 0xdf, 0xcc, 0x14, 0x49, 0x67, 0xef, 0xb7, 0xe7, 0xff, 0xfb,
+	0xd7, 0xdb, 0x85, 0xad, 0x7f, 0x14, 0xd0, 0xda, 0x07, 0x2e, 0x36, 0xab, 0x84, 0xb6, 0x3a, 0xdd,
+	0xa3, 0xf6, 0xce, 0xaf, 0xeb, 0xb8, 0xdb, 0x7e, 0xd5, 0xdd, 0xc3, 0xf5, 0xc3, 0xc6, 0x51, 0xbb,
+	0x5a, 0x6f, 0xd6, 0x0f, 0xbb, 0xb8, 0x79, 0x54, 0xab, 0xe3, 0x57, 0x87, 0x9d, 0x56, 0xbd, 0xba,
+	0xdf, 0xd8, 0xaf, 0xd7, 0x56, 0x57, 0xac, 0x47, 0xe8, 0xfe, 0x02, 0x7c, 0x67, 0x6f, 0xa7, 0x76,
+	0xf4, 0x7a, 0xb5, 0x60, 0x3d, 0x44, 0x1b, 0x8b, 0xa0, 0x47, 0x8d, 0xee, 0xea, 0x47, 0x4b, 0x00,
+	0x1b, 0xaf, 0x0e, 0x0e, 0x56, 0xcf, 0xed, 0x6e, 0xfd, 0xf8, 0xae, 0x58, 0xf8, 0xe9, 0x5d, 0xb1,
+	0xf0, 0xef, 0x77, 0xc5, 0xc2, 0x5f, 0xde, 0x17, 0x57, 0x7e, 0x7a, 0x5f, 0x5c, 0xf9, 0xe7, 0xfb,
+	0xe2, 0xca, 0x0f, 0xab, 0xa7, 0x93, 0x97, 0x7a, 0xea, 0xcc, 0x07, 0xd9, 0xbb, 0x60, 0x5e, 0xbd,
+	0x3d, 0xfb, 0xff, 0x00, 0x7b, 0x76, 0x9f, 0x5e, 0xf4, 0x13, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -835,6 +905,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.StorageTruthHealVerifierCount != that1.StorageTruthHealVerifierCount {
 		return false
 	}
+	if this.EpochFinalizationBlocks != that1.EpochFinalizationBlocks {
+		return false
+	}
+	if this.EpochFinalizationBatchSize != that1.EpochFinalizationBatchSize {
+		return false
+	}
+	if !this.EvidenceDisputeMinBond.Equal(&that1.EvidenceDisputeMinBond) {
+		return false
+	}
+	if this.EvidenceDisputePanelSize != that1.EvidenceDisputePanelSize {
+		return false
+	}
+	if this.EvidenceDisputeVotingEpochs != that1.EvidenceDisputeVotingEpochs {
+		return false
+	}
+	if this.EvidenceDisputeWindowEpochs != that1.EvidenceDisputeWindowEpochs {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -857,6 +945,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EvidenceDisputeWindowEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvidenceDisputeWindowEpochs))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.EvidenceDisputeVotingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvidenceDisputeVotingEpochs))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.EvidenceDisputePanelSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvidenceDisputePanelSize))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	{
+		size, err := m.EvidenceDisputeMinBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xb2
+	if m.EpochFinalizationBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochFinalizationBatchSize))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.EpochFinalizationBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochFinalizationBlocks))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.StorageTruthHealVerifierCount != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.StorageTruthHealVerifierCount))
 		i--
@@ -1160,20 +1295,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if len(m.RequiredOpenPorts) > 0 {
-		dAtA2 := make([]byte, len(m.RequiredOpenPorts)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.RequiredOpenPorts)*10)
+		var j2 int
 		for _, num := range m.RequiredOpenPorts {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintParams(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1379,6 +1514,23 @@ func (m *Params) Size() (n int) {
 	if m.StorageTruthHealVerifierCount != 0 {
 		n += 2 + sovParams(uint64(m.StorageTruthHealVerifierCount))
 	}
+	if m.EpochFinalizationBlocks != 0 {
+		n += 2 + sovParams(uint64(m.EpochFinalizationBlocks))
+	}
+	if m.EpochFinalizationBatchSize != 0 {
+		n += 2 + sovParams(uint64(m.EpochFinalizationBatchSize))
+	}
+	l = m.EvidenceDisputeMinBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.EvidenceDisputePanelSize != 0 {
+		n += 2 + sovParams(uint64(m.EvidenceDisputePanelSize))
+	}
+	if m.EvidenceDisputeVotingEpochs != 0 {
+		n += 2 + sovParams(uint64(m.EvidenceDisputeVotingEpochs))
+	}
+	if m.EvidenceDisputeWindowEpochs != 0 {
+		n += 2 + sovParams(uint64(m.EvidenceDisputeWindowEpochs))
+	}
 	return n
 }
 
@@ -2444,6 +2596,134 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFinalizationBlocks", wireType)
+			}
+			m.EpochFinalizationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochFinalizationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFinalizationBatchSize", wireType)
+			}
+			m.EpochFinalizationBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochFinalizationBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDisputeMinBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvidenceDisputeMinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDisputePanelSize", wireType)
+			}
+			m.EvidenceDisputePanelSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceDisputePanelSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDisputeVotingEpochs", wireType)
+			}
+			m.EvidenceDisputeVotingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceDisputeVotingEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDisputeWindowEpochs", wireType)
+			}
+			m.EvidenceDisputeWindowEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceDisputeWindowEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
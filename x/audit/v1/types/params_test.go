@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -100,3 +101,50 @@ func TestParamsWithDefaults_DerivesBucketThresholdsFromEpochLength(t *testing.T)
 	require.Equal(t, uint64(3000), p.StorageTruthOldBucketMinBlocks)
 	require.NoError(t, p.Validate())
 }
+
+func TestParamsValidateEpochFinalization(t *testing.T) {
+	base := DefaultParams()
+	require.Equal(t, DefaultEpochFinalizationBlocks, base.EpochFinalizationBlocks)
+	require.Equal(t, DefaultEpochFinalizationBatchSize, base.EpochFinalizationBatchSize)
+
+	p1 := base
+	p1.EpochFinalizationBlocks = p1.EpochLengthBlocks
+	require.ErrorContains(t, p1.Validate(), "epoch_finalization_blocks must be < epoch_length_blocks")
+
+	p2 := base
+	p2.EpochFinalizationBatchSize = 0
+	require.ErrorContains(t, p2.Validate(), "epoch_finalization_batch_size must be > 0")
+	require.Equal(t, DefaultEpochFinalizationBatchSize, p2.WithDefaults().EpochFinalizationBatchSize)
+
+	p3 := base
+	p3.EpochFinalizationBlocks = p3.EpochLengthBlocks - 1
+	require.NoError(t, p3.Validate())
+}
+
+func TestParamsValidateEvidenceDispute(t *testing.T) {
+	base := DefaultParams()
+	require.Equal(t, DefaultEvidenceDisputeMinBond, base.EvidenceDisputeMinBond)
+	require.Equal(t, DefaultEvidenceDisputePanelSize, base.EvidenceDisputePanelSize)
+
+	p1 := base
+	p1.EvidenceDisputeMinBond = sdk.Coin{}
+	require.ErrorContains(t, p1.Validate(), "evidence_dispute_min_bond is invalid")
+	require.Equal(t, DefaultEvidenceDisputeMinBond, p1.WithDefaults().EvidenceDisputeMinBond)
+
+	p2 := base
+	p2.EvidenceDisputeMinBond = sdk.NewInt64Coin("ulume", 0)
+	require.ErrorContains(t, p2.Validate(), "evidence_dispute_min_bond must be > 0")
+
+	p3 := base
+	p3.EvidenceDisputePanelSize = 33
+	require.ErrorContains(t, p3.Validate(), "evidence_dispute_panel_size must be within 1..32")
+
+	p4 := base
+	p4.EvidenceDisputeVotingEpochs = 0
+	require.ErrorContains(t, p4.Validate(), "evidence_dispute_voting_epochs must be > 0")
+	require.Equal(t, DefaultEvidenceDisputeVotingEpochs, p4.WithDefaults().EvidenceDisputeVotingEpochs)
+
+	p5 := base
+	p5.EvidenceDisputeWindowEpochs = 0
+	require.ErrorContains(t, p5.Validate(), "evidence_dispute_window_epochs must be > 0")
+}
@@ -200,6 +200,138 @@ func (m *QueryEvidenceByIdResponse) GetEvidence() Evidence {
 	return Evidence{}
 }
 
+type QueryEvidenceDisputeRequest struct {
+	DisputeId uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+}
+
+func (m *QueryEvidenceDisputeRequest) Reset()         { *m = QueryEvidenceDisputeRequest{} }
+func (m *QueryEvidenceDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceDisputeRequest) ProtoMessage()    {}
+func (*QueryEvidenceDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98945621bbc9485, []int{4}
+}
+func (m *QueryEvidenceDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceDisputeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceDisputeRequest.Merge(m, src)
+}
+func (m *QueryEvidenceDisputeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceDisputeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceDisputeRequest proto.InternalMessageInfo
+
+func (m *QueryEvidenceDisputeRequest) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
+}
+
+type QueryEvidenceDisputeByEvidenceRequest struct {
+	EvidenceId uint64 `protobuf:"varint,1,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
+}
+
+func (m *QueryEvidenceDisputeByEvidenceRequest) Reset()         { *m = QueryEvidenceDisputeByEvidenceRequest{} }
+func (m *QueryEvidenceDisputeByEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceDisputeByEvidenceRequest) ProtoMessage()    {}
+func (*QueryEvidenceDisputeByEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98945621bbc9485, []int{5}
+}
+func (m *QueryEvidenceDisputeByEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceDisputeByEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceDisputeByEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceDisputeByEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceDisputeByEvidenceRequest.Merge(m, src)
+}
+func (m *QueryEvidenceDisputeByEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceDisputeByEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceDisputeByEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceDisputeByEvidenceRequest proto.InternalMessageInfo
+
+func (m *QueryEvidenceDisputeByEvidenceRequest) GetEvidenceId() uint64 {
+	if m != nil {
+		return m.EvidenceId
+	}
+	return 0
+}
+
+type QueryEvidenceDisputeResponse struct {
+	Dispute EvidenceDispute `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute"`
+}
+
+func (m *QueryEvidenceDisputeResponse) Reset()         { *m = QueryEvidenceDisputeResponse{} }
+func (m *QueryEvidenceDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceDisputeResponse) ProtoMessage()    {}
+func (*QueryEvidenceDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98945621bbc9485, []int{6}
+}
+func (m *QueryEvidenceDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceDisputeResponse.Merge(m, src)
+}
+func (m *QueryEvidenceDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceDisputeResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceDisputeResponse) GetDispute() EvidenceDispute {
+	if m != nil {
+		return m.Dispute
+	}
+	return EvidenceDispute{}
+}
+
 type QueryEvidenceBySubjectRequest struct {
 	SubjectAddress string             `protobuf:"bytes,1,opt,name=subject_address,json=subjectAddress,proto3" json:"subject_address,omitempty"`
 	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -209,7 +341,7 @@ func (m *QueryEvidenceBySubjectRequest) Reset()         { *m = QueryEvidenceBySu
 func (m *QueryEvidenceBySubjectRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceBySubjectRequest) ProtoMessage()    {}
 func (*QueryEvidenceBySubjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{4}
+	return fileDescriptor_e98945621bbc9485, []int{7}
 }
 func (m *QueryEvidenceBySubjectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +393,7 @@ func (m *QueryEvidenceBySubjectResponse) Reset()         { *m = QueryEvidenceByS
 func (m *QueryEvidenceBySubjectResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceBySubjectResponse) ProtoMessage()    {}
 func (*QueryEvidenceBySubjectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{5}
+	return fileDescriptor_e98945621bbc9485, []int{8}
 }
 func (m *QueryEvidenceBySubjectResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +445,7 @@ func (m *QueryEvidenceByActionRequest) Reset()         { *m = QueryEvidenceByAct
 func (m *QueryEvidenceByActionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceByActionRequest) ProtoMessage()    {}
 func (*QueryEvidenceByActionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{6}
+	return fileDescriptor_e98945621bbc9485, []int{9}
 }
 func (m *QueryEvidenceByActionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +497,7 @@ func (m *QueryEvidenceByActionResponse) Reset()         { *m = QueryEvidenceByAc
 func (m *QueryEvidenceByActionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceByActionResponse) ProtoMessage()    {}
 func (*QueryEvidenceByActionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{7}
+	return fileDescriptor_e98945621bbc9485, []int{10}
 }
 func (m *QueryEvidenceByActionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +547,7 @@ func (m *QueryCurrentEpochRequest) Reset()         { *m = QueryCurrentEpochReque
 func (m *QueryCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{8}
+	return fileDescriptor_e98945621bbc9485, []int{11}
 }
 func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +586,7 @@ func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResp
 func (m *QueryCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{9}
+	return fileDescriptor_e98945621bbc9485, []int{12}
 }
 func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +644,7 @@ func (m *QueryEpochAnchorRequest) Reset()         { *m = QueryEpochAnchorRequest
 func (m *QueryEpochAnchorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochAnchorRequest) ProtoMessage()    {}
 func (*QueryEpochAnchorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{10}
+	return fileDescriptor_e98945621bbc9485, []int{13}
 }
 func (m *QueryEpochAnchorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +688,7 @@ func (m *QueryEpochAnchorResponse) Reset()         { *m = QueryEpochAnchorRespon
 func (m *QueryEpochAnchorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochAnchorResponse) ProtoMessage()    {}
 func (*QueryEpochAnchorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{11}
+	return fileDescriptor_e98945621bbc9485, []int{14}
 }
 func (m *QueryEpochAnchorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +731,7 @@ func (m *QueryCurrentEpochAnchorRequest) Reset()         { *m = QueryCurrentEpoc
 func (m *QueryCurrentEpochAnchorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochAnchorRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochAnchorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{12}
+	return fileDescriptor_e98945621bbc9485, []int{15}
 }
 func (m *QueryCurrentEpochAnchorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +768,7 @@ func (m *QueryCurrentEpochAnchorResponse) Reset()         { *m = QueryCurrentEpo
 func (m *QueryCurrentEpochAnchorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochAnchorResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochAnchorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{13}
+	return fileDescriptor_e98945621bbc9485, []int{16}
 }
 func (m *QueryCurrentEpochAnchorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,7 +814,7 @@ func (m *QueryAssignedTargetsRequest) Reset()         { *m = QueryAssignedTarget
 func (m *QueryAssignedTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAssignedTargetsRequest) ProtoMessage()    {}
 func (*QueryAssignedTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{14}
+	return fileDescriptor_e98945621bbc9485, []int{17}
 }
 func (m *QueryAssignedTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,13 +869,15 @@ type QueryAssignedTargetsResponse struct {
 	EpochStartHeight        int64    `protobuf:"varint,2,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
 	RequiredOpenPorts       []uint32 `protobuf:"varint,3,rep,packed,name=required_open_ports,json=requiredOpenPorts,proto3" json:"required_open_ports,omitempty"`
 	TargetSupernodeAccounts []string `protobuf:"bytes,4,rep,name=target_supernode_accounts,json=targetSupernodeAccounts,proto3" json:"target_supernode_accounts,omitempty"`
+	// target_endpoints[i] lists the probe endpoints of target_supernode_accounts[i].
+	TargetEndpoints []TargetProbeEndpoints `protobuf:"bytes,5,rep,name=target_endpoints,json=targetEndpoints,proto3" json:"target_endpoints"`
 }
 
 func (m *QueryAssignedTargetsResponse) Reset()         { *m = QueryAssignedTargetsResponse{} }
 func (m *QueryAssignedTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAssignedTargetsResponse) ProtoMessage()    {}
 func (*QueryAssignedTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{15}
+	return fileDescriptor_e98945621bbc9485, []int{18}
 }
 func (m *QueryAssignedTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,6 +934,66 @@ func (m *QueryAssignedTargetsResponse) GetTargetSupernodeAccounts() []string {
 	return nil
 }
 
+func (m *QueryAssignedTargetsResponse) GetTargetEndpoints() []TargetProbeEndpoints {
+	if m != nil {
+		return m.TargetEndpoints
+	}
+	return nil
+}
+
+// TargetProbeEndpoints lists every endpoint a prober must check on one target.
+type TargetProbeEndpoints struct {
+	TargetSupernodeAccount string          `protobuf:"bytes,1,opt,name=target_supernode_account,json=targetSupernodeAccount,proto3" json:"target_supernode_account,omitempty"`
+	Endpoints              []ProbeEndpoint `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints"`
+}
+
+func (m *TargetProbeEndpoints) Reset()         { *m = TargetProbeEndpoints{} }
+func (m *TargetProbeEndpoints) String() string { return proto.CompactTextString(m) }
+func (*TargetProbeEndpoints) ProtoMessage()    {}
+func (*TargetProbeEndpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98945621bbc9485, []int{19}
+}
+func (m *TargetProbeEndpoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TargetProbeEndpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TargetProbeEndpoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TargetProbeEndpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TargetProbeEndpoints.Merge(m, src)
+}
+func (m *TargetProbeEndpoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *TargetProbeEndpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_TargetProbeEndpoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TargetProbeEndpoints proto.InternalMessageInfo
+
+func (m *TargetProbeEndpoints) GetTargetSupernodeAccount() string {
+	if m != nil {
+		return m.TargetSupernodeAccount
+	}
+	return ""
+}
+
+func (m *TargetProbeEndpoints) GetEndpoints() []ProbeEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
 type QueryEpochReportRequest struct {
 	EpochId          uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
 	SupernodeAccount string `protobuf:"bytes,2,opt,name=supernode_account,json=supernodeAccount,proto3" json:"supernode_account,omitempty"`
@@ -809,7 +1003,7 @@ func (m *QueryEpochReportRequest) Reset()         { *m = QueryEpochReportRequest
 func (m *QueryEpochReportRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochReportRequest) ProtoMessage()    {}
 func (*QueryEpochReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{16}
+	return fileDescriptor_e98945621bbc9485, []int{20}
 }
 func (m *QueryEpochReportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,7 +1054,7 @@ func (m *QueryEpochReportResponse) Reset()         { *m = QueryEpochReportRespon
 func (m *QueryEpochReportResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochReportResponse) ProtoMessage()    {}
 func (*QueryEpochReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{17}
+	return fileDescriptor_e98945621bbc9485, []int{21}
 }
 func (m *QueryEpochReportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1101,7 @@ func (m *QueryEpochReportsByReporterRequest) Reset()         { *m = QueryEpochRe
 func (m *QueryEpochReportsByReporterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochReportsByReporterRequest) ProtoMessage()    {}
 func (*QueryEpochReportsByReporterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{18}
+	return fileDescriptor_e98945621bbc9485, []int{22}
 }
 func (m *QueryEpochReportsByReporterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1167,7 @@ func (m *QueryEpochReportsByReporterResponse) Reset()         { *m = QueryEpochR
 func (m *QueryEpochReportsByReporterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochReportsByReporterResponse) ProtoMessage()    {}
 func (*QueryEpochReportsByReporterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{19}
+	return fileDescriptor_e98945621bbc9485, []int{23}
 }
 func (m *QueryEpochReportsByReporterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1027,7 +1221,7 @@ func (m *QueryStorageChallengeReportsRequest) Reset()         { *m = QueryStorag
 func (m *QueryStorageChallengeReportsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageChallengeReportsRequest) ProtoMessage()    {}
 func (*QueryStorageChallengeReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{20}
+	return fileDescriptor_e98945621bbc9485, []int{24}
 }
 func (m *QueryStorageChallengeReportsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,17 +1279,18 @@ func (m *QueryStorageChallengeReportsRequest) GetFilterByEpochId() bool {
 }
 
 type StorageChallengeReport struct {
-	ReporterSupernodeAccount string      `protobuf:"bytes,1,opt,name=reporter_supernode_account,json=reporterSupernodeAccount,proto3" json:"reporter_supernode_account,omitempty"`
-	EpochId                  uint64      `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
-	ReportHeight             int64       `protobuf:"varint,3,opt,name=report_height,json=reportHeight,proto3" json:"report_height,omitempty"`
-	PortStates               []PortState `protobuf:"varint,4,rep,packed,name=port_states,json=portStates,proto3,enum=lumera.audit.v1.PortState" json:"port_states,omitempty"`
+	ReporterSupernodeAccount string                `protobuf:"bytes,1,opt,name=reporter_supernode_account,json=reporterSupernodeAccount,proto3" json:"reporter_supernode_account,omitempty"`
+	EpochId                  uint64                `protobuf:"varint,2,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	ReportHeight             int64                 `protobuf:"varint,3,opt,name=report_height,json=reportHeight,proto3" json:"report_height,omitempty"`
+	PortStates               []PortState           `protobuf:"varint,4,rep,packed,name=port_states,json=portStates,proto3,enum=lumera.audit.v1.PortState" json:"port_states,omitempty"`
+	EndpointObservations     []EndpointObservation `protobuf:"bytes,5,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations"`
 }
 
 func (m *StorageChallengeReport) Reset()         { *m = StorageChallengeReport{} }
 func (m *StorageChallengeReport) String() string { return proto.CompactTextString(m) }
 func (*StorageChallengeReport) ProtoMessage()    {}
 func (*StorageChallengeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{21}
+	return fileDescriptor_e98945621bbc9485, []int{25}
 }
 func (m *StorageChallengeReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1152,6 +1347,13 @@ func (m *StorageChallengeReport) GetPortStates() []PortState {
 	return nil
 }
 
+func (m *StorageChallengeReport) GetEndpointObservations() []EndpointObservation {
+	if m != nil {
+		return m.EndpointObservations
+	}
+	return nil
+}
+
 type QueryStorageChallengeReportsResponse struct {
 	Reports    []StorageChallengeReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports"`
 	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1161,7 +1363,7 @@ func (m *QueryStorageChallengeReportsResponse) Reset()         { *m = QueryStora
 func (m *QueryStorageChallengeReportsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageChallengeReportsResponse) ProtoMessage()    {}
 func (*QueryStorageChallengeReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{22}
+	return fileDescriptor_e98945621bbc9485, []int{26}
 }
 func (m *QueryStorageChallengeReportsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1417,7 @@ func (m *QueryHostReportsRequest) Reset()         { *m = QueryHostReportsRequest
 func (m *QueryHostReportsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHostReportsRequest) ProtoMessage()    {}
 func (*QueryHostReportsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{23}
+	return fileDescriptor_e98945621bbc9485, []int{27}
 }
 func (m *QueryHostReportsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1484,7 @@ func (m *HostReportEntry) Reset()         { *m = HostReportEntry{} }
 func (m *HostReportEntry) String() string { return proto.CompactTextString(m) }
 func (*HostReportEntry) ProtoMessage()    {}
 func (*HostReportEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{24}
+	return fileDescriptor_e98945621bbc9485, []int{28}
 }
 func (m *HostReportEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1543,7 @@ func (m *QueryHostReportsResponse) Reset()         { *m = QueryHostReportsRespon
 func (m *QueryHostReportsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHostReportsResponse) ProtoMessage()    {}
 func (*QueryHostReportsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{25}
+	return fileDescriptor_e98945621bbc9485, []int{29}
 }
 func (m *QueryHostReportsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1392,7 +1594,7 @@ func (m *QueryNodeSuspicionStateRequest) Reset()         { *m = QueryNodeSuspici
 func (m *QueryNodeSuspicionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeSuspicionStateRequest) ProtoMessage()    {}
 func (*QueryNodeSuspicionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{26}
+	return fileDescriptor_e98945621bbc9485, []int{30}
 }
 func (m *QueryNodeSuspicionStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1638,7 @@ func (m *QueryNodeSuspicionStateResponse) Reset()         { *m = QueryNodeSuspic
 func (m *QueryNodeSuspicionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeSuspicionStateResponse) ProtoMessage()    {}
 func (*QueryNodeSuspicionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{27}
+	return fileDescriptor_e98945621bbc9485, []int{31}
 }
 func (m *QueryNodeSuspicionStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1480,7 +1682,7 @@ func (m *QueryReporterReliabilityStateRequest) Reset()         { *m = QueryRepor
 func (m *QueryReporterReliabilityStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReporterReliabilityStateRequest) ProtoMessage()    {}
 func (*QueryReporterReliabilityStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{28}
+	return fileDescriptor_e98945621bbc9485, []int{32}
 }
 func (m *QueryReporterReliabilityStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1524,7 +1726,7 @@ func (m *QueryReporterReliabilityStateResponse) Reset()         { *m = QueryRepo
 func (m *QueryReporterReliabilityStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReporterReliabilityStateResponse) ProtoMessage()    {}
 func (*QueryReporterReliabilityStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{29}
+	return fileDescriptor_e98945621bbc9485, []int{33}
 }
 func (m *QueryReporterReliabilityStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1770,7 @@ func (m *QueryTicketDeteriorationStateRequest) Reset()         { *m = QueryTicke
 func (m *QueryTicketDeteriorationStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTicketDeteriorationStateRequest) ProtoMessage()    {}
 func (*QueryTicketDeteriorationStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{30}
+	return fileDescriptor_e98945621bbc9485, []int{34}
 }
 func (m *QueryTicketDeteriorationStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1814,7 @@ func (m *QueryTicketDeteriorationStateResponse) Reset()         { *m = QueryTick
 func (m *QueryTicketDeteriorationStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTicketDeteriorationStateResponse) ProtoMessage()    {}
 func (*QueryTicketDeteriorationStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{31}
+	return fileDescriptor_e98945621bbc9485, []int{35}
 }
 func (m *QueryTicketDeteriorationStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1656,7 +1858,7 @@ func (m *QueryHealOpRequest) Reset()         { *m = QueryHealOpRequest{} }
 func (m *QueryHealOpRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHealOpRequest) ProtoMessage()    {}
 func (*QueryHealOpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{32}
+	return fileDescriptor_e98945621bbc9485, []int{36}
 }
 func (m *QueryHealOpRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1700,7 +1902,7 @@ func (m *QueryHealOpResponse) Reset()         { *m = QueryHealOpResponse{} }
 func (m *QueryHealOpResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHealOpResponse) ProtoMessage()    {}
 func (*QueryHealOpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{33}
+	return fileDescriptor_e98945621bbc9485, []int{37}
 }
 func (m *QueryHealOpResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1745,7 +1947,7 @@ func (m *QueryHealOpsByTicketRequest) Reset()         { *m = QueryHealOpsByTicke
 func (m *QueryHealOpsByTicketRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHealOpsByTicketRequest) ProtoMessage()    {}
 func (*QueryHealOpsByTicketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{34}
+	return fileDescriptor_e98945621bbc9485, []int{38}
 }
 func (m *QueryHealOpsByTicketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,7 +1999,7 @@ func (m *QueryHealOpsByTicketResponse) Reset()         { *m = QueryHealOpsByTick
 func (m *QueryHealOpsByTicketResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHealOpsByTicketResponse) ProtoMessage()    {}
 func (*QueryHealOpsByTicketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{35}
+	return fileDescriptor_e98945621bbc9485, []int{39}
 }
 func (m *QueryHealOpsByTicketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,7 +2051,7 @@ func (m *QueryHealOpsByStatusRequest) Reset()         { *m = QueryHealOpsByStatu
 func (m *QueryHealOpsByStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHealOpsByStatusRequest) ProtoMessage()    {}
 func (*QueryHealOpsByStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{36}
+	return fileDescriptor_e98945621bbc9485, []int{40}
 }
 func (m *QueryHealOpsByStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1901,7 +2103,7 @@ func (m *QueryHealOpsByStatusResponse) Reset()         { *m = QueryHealOpsByStat
 func (m *QueryHealOpsByStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHealOpsByStatusResponse) ProtoMessage()    {}
 func (*QueryHealOpsByStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e98945621bbc9485, []int{37}
+	return fileDescriptor_e98945621bbc9485, []int{41}
 }
 func (m *QueryHealOpsByStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,11 +2146,114 @@ func (m *QueryHealOpsByStatusResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryEpochFinalizationProgressRequest struct {
+}
+
+func (m *QueryEpochFinalizationProgressRequest) Reset()         { *m = QueryEpochFinalizationProgressRequest{} }
+func (m *QueryEpochFinalizationProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochFinalizationProgressRequest) ProtoMessage()    {}
+func (*QueryEpochFinalizationProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98945621bbc9485, []int{42}
+}
+func (m *QueryEpochFinalizationProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochFinalizationProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochFinalizationProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochFinalizationProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochFinalizationProgressRequest.Merge(m, src)
+}
+func (m *QueryEpochFinalizationProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochFinalizationProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochFinalizationProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochFinalizationProgressRequest proto.InternalMessageInfo
+
+type QueryEpochFinalizationProgressResponse struct {
+	// in_progress is true while epoch-end processing is still running.
+	InProgress bool `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	// found is false until the first epoch has been finalized.
+	Found    bool                      `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Progress EpochFinalizationProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress"`
+}
+
+func (m *QueryEpochFinalizationProgressResponse) Reset() {
+	*m = QueryEpochFinalizationProgressResponse{}
+}
+func (m *QueryEpochFinalizationProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochFinalizationProgressResponse) ProtoMessage()    {}
+func (*QueryEpochFinalizationProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e98945621bbc9485, []int{43}
+}
+func (m *QueryEpochFinalizationProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochFinalizationProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochFinalizationProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochFinalizationProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochFinalizationProgressResponse.Merge(m, src)
+}
+func (m *QueryEpochFinalizationProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochFinalizationProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochFinalizationProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochFinalizationProgressResponse proto.InternalMessageInfo
+
+func (m *QueryEpochFinalizationProgressResponse) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
+func (m *QueryEpochFinalizationProgressResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *QueryEpochFinalizationProgressResponse) GetProgress() EpochFinalizationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return EpochFinalizationProgress{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lumera.audit.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lumera.audit.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryEvidenceByIdRequest)(nil), "lumera.audit.v1.QueryEvidenceByIdRequest")
 	proto.RegisterType((*QueryEvidenceByIdResponse)(nil), "lumera.audit.v1.QueryEvidenceByIdResponse")
+	proto.RegisterType((*QueryEvidenceDisputeRequest)(nil), "lumera.audit.v1.QueryEvidenceDisputeRequest")
+	proto.RegisterType((*QueryEvidenceDisputeByEvidenceRequest)(nil), "lumera.audit.v1.QueryEvidenceDisputeByEvidenceRequest")
+	proto.RegisterType((*QueryEvidenceDisputeResponse)(nil), "lumera.audit.v1.QueryEvidenceDisputeResponse")
 	proto.RegisterType((*QueryEvidenceBySubjectRequest)(nil), "lumera.audit.v1.QueryEvidenceBySubjectRequest")
 	proto.RegisterType((*QueryEvidenceBySubjectResponse)(nil), "lumera.audit.v1.QueryEvidenceBySubjectResponse")
 	proto.RegisterType((*QueryEvidenceByActionRequest)(nil), "lumera.audit.v1.QueryEvidenceByActionRequest")
@@ -1961,6 +2266,7 @@ func init() {
 	proto.RegisterType((*QueryCurrentEpochAnchorResponse)(nil), "lumera.audit.v1.QueryCurrentEpochAnchorResponse")
 	proto.RegisterType((*QueryAssignedTargetsRequest)(nil), "lumera.audit.v1.QueryAssignedTargetsRequest")
 	proto.RegisterType((*QueryAssignedTargetsResponse)(nil), "lumera.audit.v1.QueryAssignedTargetsResponse")
+	proto.RegisterType((*TargetProbeEndpoints)(nil), "lumera.audit.v1.TargetProbeEndpoints")
 	proto.RegisterType((*QueryEpochReportRequest)(nil), "lumera.audit.v1.QueryEpochReportRequest")
 	proto.RegisterType((*QueryEpochReportResponse)(nil), "lumera.audit.v1.QueryEpochReportResponse")
 	proto.RegisterType((*QueryEpochReportsByReporterRequest)(nil), "lumera.audit.v1.QueryEpochReportsByReporterRequest")
@@ -1983,135 +2289,157 @@ func init() {
 	proto.RegisterType((*QueryHealOpsByTicketResponse)(nil), "lumera.audit.v1.QueryHealOpsByTicketResponse")
 	proto.RegisterType((*QueryHealOpsByStatusRequest)(nil), "lumera.audit.v1.QueryHealOpsByStatusRequest")
 	proto.RegisterType((*QueryHealOpsByStatusResponse)(nil), "lumera.audit.v1.QueryHealOpsByStatusResponse")
+	proto.RegisterType((*QueryEpochFinalizationProgressRequest)(nil), "lumera.audit.v1.QueryEpochFinalizationProgressRequest")
+	proto.RegisterType((*QueryEpochFinalizationProgressResponse)(nil), "lumera.audit.v1.QueryEpochFinalizationProgressResponse")
 }
 
 func init() { proto.RegisterFile("lumera/audit/v1/query.proto", fileDescriptor_e98945621bbc9485) }
 
 var fileDescriptor_e98945621bbc9485 = []byte{
-	// 1957 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5d, 0x6c, 0x14, 0xd7,
-	0x15, 0xf6, 0xb5, 0x8d, 0x7f, 0xae, 0xc1, 0x3f, 0x17, 0x84, 0xd7, 0x6b, 0xb3, 0x58, 0x03, 0x05,
-	0xe3, 0xe2, 0x9d, 0xda, 0x80, 0x4b, 0x6b, 0x0a, 0x78, 0x8d, 0x8d, 0xdd, 0x62, 0x30, 0xbb, 0xb4,
-	0x88, 0x4a, 0x68, 0x34, 0xbb, 0x73, 0xbb, 0x3b, 0x74, 0x3d, 0xb3, 0xcc, 0xcc, 0x5a, 0x5d, 0x59,
-	0x5b, 0xa9, 0xf0, 0x5e, 0xb5, 0xaa, 0xfa, 0xc6, 0x4b, 0x55, 0x51, 0x55, 0x15, 0x52, 0x5b, 0x95,
-	0xe6, 0x47, 0xca, 0x5b, 0x5e, 0xc8, 0x1b, 0x22, 0x2f, 0x49, 0xa4, 0x44, 0x11, 0x44, 0x8a, 0x94,
-	0x27, 0xa4, 0xbc, 0x27, 0xd1, 0xdc, 0x9f, 0xdd, 0x99, 0x9d, 0xb9, 0x3b, 0xbb, 0xc8, 0x8e, 0x50,
-	0xf2, 0x92, 0xac, 0xef, 0x3d, 0xe7, 0xdc, 0xef, 0x3b, 0xe7, 0xdc, 0x33, 0xf7, 0x1c, 0x01, 0xc7,
-	0x8b, 0xe5, 0x4d, 0x6c, 0xa9, 0xb2, 0x5a, 0xd6, 0x74, 0x47, 0xde, 0x9a, 0x95, 0xef, 0x96, 0xb1,
-	0x55, 0x49, 0x96, 0x2c, 0xd3, 0x31, 0xd1, 0x10, 0xdd, 0x4c, 0x92, 0xcd, 0xe4, 0xd6, 0x6c, 0x7c,
-	0x44, 0xdd, 0xd4, 0x0d, 0x53, 0x26, 0xff, 0xa5, 0x32, 0xf1, 0x03, 0x79, 0x33, 0x6f, 0x92, 0x9f,
-	0xb2, 0xfb, 0x8b, 0xad, 0x4e, 0xe4, 0x4d, 0x33, 0x5f, 0xc4, 0xb2, 0x5a, 0xd2, 0x65, 0xd5, 0x30,
-	0x4c, 0x47, 0x75, 0x74, 0xd3, 0xb0, 0xd9, 0xee, 0x58, 0xce, 0xb4, 0x37, 0x4d, 0x5b, 0xa1, 0x6a,
-	0xf4, 0x0f, 0xb6, 0x35, 0x4d, 0xff, 0x92, 0xb3, 0xaa, 0x8d, 0x29, 0x16, 0x79, 0x6b, 0x36, 0x8b,
-	0x1d, 0x75, 0x56, 0x2e, 0xa9, 0x79, 0xdd, 0x20, 0x76, 0xf8, 0x21, 0x8d, 0xd8, 0x4b, 0xaa, 0xa5,
-	0x6e, 0x72, 0x4b, 0x01, 0x66, 0x94, 0x05, 0xdd, 0x4c, 0x34, 0x6e, 0xe2, 0x2d, 0x5d, 0xc3, 0x46,
-	0x0e, 0x8b, 0x94, 0x71, 0xc9, 0xcc, 0x15, 0xe8, 0xa6, 0x74, 0x00, 0xa2, 0xeb, 0x2e, 0xb2, 0x0d,
-	0x72, 0x5c, 0x1a, 0xdf, 0x2d, 0x63, 0xdb, 0x91, 0xae, 0xc3, 0xfd, 0xbe, 0x55, 0xbb, 0x64, 0x1a,
-	0x36, 0x46, 0x3f, 0x85, 0x3d, 0x14, 0x56, 0x0c, 0x4c, 0x82, 0xa9, 0x81, 0xb9, 0xd1, 0x64, 0x83,
-	0x53, 0x93, 0x54, 0x21, 0xd5, 0xff, 0xe4, 0x93, 0xc3, 0x1d, 0xff, 0xfc, 0xfc, 0x3f, 0xd3, 0x20,
-	0xcd, 0x34, 0xa4, 0x05, 0x18, 0x23, 0x26, 0x97, 0x19, 0xb8, 0x54, 0x65, 0x4d, 0x63, 0xc7, 0xa1,
-	0xc3, 0x70, 0x80, 0x63, 0x56, 0x74, 0x8d, 0x18, 0xef, 0x4e, 0x43, 0xbe, 0xb4, 0xa6, 0x49, 0xb7,
-	0xe1, 0x58, 0x88, 0x32, 0x43, 0x75, 0x11, 0xf6, 0x71, 0x51, 0x86, 0x6b, 0x2c, 0x80, 0xab, 0xa6,
-	0xe8, 0x41, 0x56, 0xd3, 0x92, 0xfe, 0x05, 0xe0, 0xa1, 0x06, 0xfb, 0x99, 0x72, 0xf6, 0x0e, 0xce,
-	0x39, 0x1c, 0xe1, 0x22, 0x1c, 0xb2, 0xe9, 0x8a, 0xa2, 0x6a, 0x9a, 0x85, 0x6d, 0xea, 0x82, 0xfe,
-	0x54, 0xec, 0xd9, 0xe3, 0x99, 0x03, 0x2c, 0xea, 0x8b, 0x74, 0x27, 0xe3, 0x58, 0xba, 0x91, 0x4f,
-	0x0f, 0x32, 0x05, 0xb6, 0x8a, 0x56, 0x20, 0xac, 0x47, 0x3d, 0xd6, 0x49, 0x80, 0x1e, 0x4b, 0x32,
-	0x55, 0x37, 0x45, 0x92, 0x34, 0x5d, 0x59, 0x8a, 0x24, 0x37, 0xd4, 0x3c, 0x66, 0xc7, 0xa7, 0x3d,
-	0x9a, 0xd2, 0x3f, 0x00, 0x4c, 0x88, 0xc0, 0x32, 0x8f, 0x2c, 0xf8, 0x3c, 0xd2, 0xd5, 0xdc, 0x23,
-	0xdd, 0xae, 0x47, 0xea, 0xce, 0x40, 0x97, 0x43, 0x70, 0x1e, 0x8f, 0xc4, 0x49, 0x4f, 0xf6, 0x01,
-	0xbd, 0x0f, 0xe0, 0x44, 0x03, 0xd0, 0xc5, 0x9c, 0xbb, 0xc3, 0x9d, 0x3a, 0x0e, 0xfb, 0x55, 0xb2,
-	0xc0, 0x83, 0xde, 0x9f, 0xee, 0xa3, 0x0b, 0x6b, 0xda, 0x8e, 0xb9, 0xeb, 0x61, 0x30, 0xb6, 0x1c,
-	0xc5, 0x6b, 0xe5, 0xad, 0x38, 0xbb, 0x1f, 0x4b, 0x65, 0xcb, 0xc2, 0x86, 0xb3, 0xec, 0xde, 0x51,
-	0x7e, 0x1d, 0xff, 0x08, 0x58, 0xfe, 0xfb, 0x37, 0x19, 0xfe, 0x31, 0xd8, 0x47, 0x6e, 0x74, 0xfd,
-	0xea, 0xf4, 0x92, 0xbf, 0xd7, 0x34, 0x74, 0x12, 0x22, 0xba, 0x65, 0x3b, 0xaa, 0xe5, 0x28, 0x05,
-	0xac, 0xe7, 0x0b, 0x0e, 0x41, 0xd9, 0x95, 0x1e, 0x26, 0x3b, 0x19, 0x77, 0x63, 0x95, 0xac, 0xa3,
-	0x29, 0x48, 0xd7, 0x14, 0x6c, 0x68, 0x5c, 0xb6, 0x8b, 0xc8, 0x0e, 0x92, 0xf5, 0x65, 0x43, 0xa3,
-	0x92, 0xd2, 0x69, 0x38, 0x4a, 0x7d, 0xea, 0x2e, 0x2f, 0x1a, 0xb9, 0x82, 0x69, 0xf1, 0xa0, 0x8a,
-	0xd1, 0x48, 0xbf, 0xe2, 0x25, 0xc0, 0xab, 0x55, 0x2f, 0x2d, 0x2a, 0x59, 0x61, 0x57, 0x78, 0x22,
-	0x18, 0x82, 0xba, 0x16, 0x8b, 0x02, 0xd3, 0x90, 0x26, 0xd9, 0x85, 0xf0, 0x7a, 0xc7, 0x07, 0x4a,
-	0xba, 0x0d, 0x0f, 0x0b, 0x25, 0x76, 0x00, 0xc0, 0xbf, 0x01, 0x1c, 0x27, 0xf6, 0x17, 0x6d, 0x5b,
-	0xcf, 0x1b, 0x58, 0xbb, 0xa1, 0x5a, 0x79, 0xec, 0xf0, 0x72, 0x8a, 0x56, 0xe1, 0x88, 0x5d, 0x2e,
-	0x61, 0xcb, 0x30, 0x35, 0xac, 0xa8, 0xb9, 0x9c, 0x59, 0x36, 0x1c, 0x56, 0x3f, 0xc6, 0x9f, 0x3d,
-	0x9e, 0x19, 0xe5, 0xf5, 0x23, 0x97, 0xf3, 0x97, 0x90, 0xe1, 0x9a, 0xd6, 0x22, 0x55, 0xf2, 0x79,
-	0xb7, 0xd3, 0x1f, 0xeb, 0x1f, 0x42, 0xf4, 0x1b, 0xbd, 0xe8, 0x60, 0x4b, 0xc9, 0x56, 0x94, 0x9a,
-	0x90, 0x1b, 0xbf, 0xbe, 0xf4, 0x10, 0xdd, 0x49, 0x51, 0xd7, 0xaf, 0x69, 0xd2, 0x4b, 0x7e, 0x37,
-	0x03, 0x88, 0x77, 0x3a, 0xa9, 0x92, 0x70, 0xbf, 0x85, 0xef, 0x96, 0x75, 0x0b, 0x6b, 0x8a, 0x59,
-	0xc2, 0x86, 0x52, 0x32, 0x2d, 0xc7, 0x8e, 0x75, 0x4d, 0x76, 0x4d, 0xed, 0x4b, 0x8f, 0xf0, 0xad,
-	0x6b, 0x25, 0x6c, 0x6c, 0xb8, 0x1b, 0xe8, 0x26, 0x1c, 0x73, 0x08, 0x16, 0x25, 0xe0, 0x32, 0x3b,
-	0xd6, 0x3d, 0xd9, 0x15, 0xe5, 0xb3, 0x51, 0xaa, 0x9d, 0x69, 0xf0, 0x9c, 0x2d, 0xfd, 0xde, 0x9b,
-	0xb3, 0x69, 0xec, 0xc2, 0x88, 0xce, 0xd9, 0xf0, 0xd0, 0x75, 0xbe, 0x42, 0xe8, 0xfc, 0xd9, 0xcf,
-	0xcf, 0xaf, 0x27, 0x9f, 0x45, 0x56, 0x9a, 0x27, 0x1f, 0xd5, 0xe2, 0xc9, 0x47, 0x35, 0xa4, 0xaf,
-	0x00, 0x94, 0x1a, 0x0d, 0xdb, 0xa9, 0x0a, 0xfd, 0x81, 0xad, 0x6f, 0x35, 0x07, 0xfd, 0x45, 0xbb,
-	0xeb, 0x55, 0x8b, 0xb6, 0x20, 0x97, 0xbb, 0xc3, 0x73, 0xf9, 0x11, 0x80, 0x47, 0x9a, 0x3a, 0x80,
-	0x39, 0xf9, 0x1c, 0xec, 0xa5, 0x2e, 0xb3, 0x59, 0x99, 0x6f, 0xc5, 0xcb, 0x5c, 0x65, 0xe7, 0x0a,
-	0xfd, 0xd7, 0x1c, 0x6e, 0xc6, 0x31, 0x2d, 0x35, 0x8f, 0x97, 0x0a, 0x6a, 0xb1, 0x88, 0x0d, 0x57,
-	0x9a, 0x9c, 0xf4, 0xdd, 0x0f, 0xd8, 0x4b, 0x00, 0x0f, 0x86, 0x93, 0x47, 0xb7, 0x60, 0xdc, 0x62,
-	0x71, 0x53, 0x5e, 0x89, 0x7d, 0x8c, 0xab, 0x67, 0xda, 0xf0, 0xc2, 0x11, 0xb8, 0x8f, 0xaa, 0xf9,
-	0xbf, 0x7a, 0x7b, 0xe9, 0x22, 0x2b, 0x64, 0x0b, 0x70, 0x80, 0x88, 0xd8, 0x8e, 0xea, 0x60, 0x5a,
-	0x8a, 0x06, 0xe7, 0xe2, 0xc1, 0x17, 0xb0, 0x69, 0x39, 0x19, 0x57, 0x24, 0x0d, 0x4b, 0xfc, 0xa7,
-	0x2d, 0xbd, 0x05, 0xe0, 0xd1, 0xe6, 0x41, 0x67, 0x49, 0x7a, 0xb9, 0x31, 0x49, 0x8f, 0x07, 0x4e,
-	0x08, 0x37, 0xb1, 0x6b, 0xf9, 0xfa, 0x25, 0x60, 0x85, 0x73, 0xd5, 0xb4, 0x9d, 0xef, 0x4d, 0x8e,
-	0xfe, 0x15, 0xc0, 0xa1, 0x3a, 0xe1, 0x65, 0xc3, 0xb1, 0x2a, 0xcd, 0x3e, 0x13, 0x81, 0x0c, 0xea,
-	0x0c, 0xc9, 0xa0, 0x14, 0x1c, 0x28, 0x98, 0xb6, 0xa3, 0xb0, 0x52, 0x4f, 0x99, 0x8c, 0x07, 0xe2,
-	0x5b, 0x3f, 0x96, 0xc5, 0x14, 0x16, 0x6a, 0x2b, 0xee, 0x73, 0x36, 0x16, 0x8c, 0x46, 0xad, 0x13,
-	0x6a, 0x48, 0x9e, 0xc9, 0x26, 0xc6, 0x09, 0xa7, 0x5d, 0xcb, 0x9a, 0x3b, 0xec, 0x4d, 0x76, 0xd5,
-	0xd4, 0x70, 0xa6, 0x6c, 0x97, 0xf4, 0x9c, 0x6e, 0x1a, 0xf4, 0x5e, 0xec, 0x74, 0xee, 0x48, 0x59,
-	0xf6, 0xba, 0x0b, 0x3b, 0x8b, 0x79, 0xe6, 0x02, 0xdc, 0x43, 0xee, 0x2d, 0xfb, 0xbe, 0x1e, 0x09,
-	0xf8, 0x25, 0xa8, 0xcb, 0x5c, 0x43, 0xf5, 0xa4, 0x3f, 0xf0, 0x0b, 0x5c, 0xff, 0xac, 0x14, 0x75,
-	0x35, 0xab, 0x17, 0x75, 0xa7, 0xe2, 0xa3, 0xb5, 0x7b, 0x15, 0x4c, 0x32, 0xe0, 0x0f, 0x22, 0x20,
-	0x30, 0xb6, 0xcb, 0x7e, 0xb6, 0x27, 0x02, 0x6c, 0x45, 0x16, 0xfc, 0x9c, 0x97, 0x18, 0xe5, 0x1b,
-	0x7a, 0xee, 0xb7, 0xd8, 0xb9, 0x84, 0x1d, 0x6c, 0xe9, 0xa6, 0x45, 0xe2, 0xeb, 0xa3, 0x3c, 0x0e,
-	0xfb, 0x1d, 0x22, 0xe2, 0xe9, 0xe3, 0xe8, 0xc2, 0x9a, 0x56, 0x03, 0x2d, 0x36, 0xd2, 0x2a, 0x68,
-	0x91, 0x05, 0x3f, 0xe8, 0x39, 0x36, 0xd0, 0x58, 0xc5, 0x6a, 0xf1, 0x5a, 0x89, 0x43, 0x9c, 0x80,
-	0xb0, 0x80, 0xd5, 0xa2, 0x62, 0x96, 0xea, 0x97, 0xb7, 0xaf, 0x40, 0x44, 0xd6, 0x34, 0x69, 0x9d,
-	0x8d, 0x3b, 0xb8, 0x0e, 0x43, 0x34, 0x0f, 0x7b, 0x99, 0x92, 0x70, 0xde, 0x41, 0x35, 0xf8, 0x8b,
-	0x8c, 0x1a, 0x94, 0xee, 0xf1, 0x76, 0x80, 0xee, 0xda, 0x29, 0x46, 0xbe, 0x15, 0x7f, 0xed, 0x58,
-	0xdf, 0xfb, 0x37, 0xfe, 0xc2, 0x0f, 0x80, 0x60, 0xec, 0xce, 0xc2, 0x3e, 0xc6, 0x8e, 0x57, 0x8b,
-	0x08, 0x7a, 0xbd, 0x94, 0xde, 0x0e, 0x16, 0x89, 0x07, 0x01, 0x47, 0xb9, 0x01, 0x2d, 0xd7, 0x3e,
-	0x2f, 0x67, 0x60, 0x8f, 0x4d, 0x16, 0x88, 0x97, 0x06, 0xe7, 0x0e, 0x09, 0x00, 0x32, 0x2d, 0x26,
-	0xbc, 0x8b, 0x2e, 0xe4, 0xf0, 0x5e, 0x1b, 0x17, 0xce, 0xfd, 0x37, 0x0e, 0xf7, 0x10, 0x8c, 0xe8,
-	0x3e, 0x80, 0x3d, 0x74, 0xfc, 0x86, 0x82, 0xe5, 0x2d, 0x38, 0xe3, 0x8b, 0x1f, 0x6d, 0x2e, 0x44,
-	0xcf, 0x92, 0x92, 0xf7, 0xde, 0xff, 0xec, 0x2f, 0x9d, 0x53, 0xe8, 0x98, 0x7c, 0x85, 0x48, 0x6f,
-	0x58, 0xa6, 0x63, 0xe6, 0xcc, 0xa2, 0x1c, 0x3e, 0xaf, 0x44, 0x0f, 0x01, 0xdc, 0xeb, 0x9d, 0xd2,
-	0xa1, 0x13, 0xe1, 0xc7, 0x84, 0x8c, 0x01, 0xe3, 0xd3, 0xad, 0x88, 0x32, 0x5c, 0xe7, 0x09, 0xae,
-	0xb3, 0x68, 0x3e, 0x0a, 0x17, 0x9f, 0xd4, 0xc8, 0xdb, 0x9e, 0x11, 0x63, 0x15, 0xbd, 0x0b, 0xe0,
-	0x48, 0x60, 0x80, 0x86, 0x92, 0x51, 0x08, 0xfc, 0x63, 0xc1, 0xb8, 0xdc, 0xb2, 0x3c, 0x83, 0xbd,
-	0x4e, 0x60, 0x5f, 0x46, 0xcb, 0x2d, 0xc3, 0xce, 0x56, 0x14, 0x36, 0x48, 0x94, 0xb7, 0x1b, 0x46,
-	0x90, 0x55, 0xf4, 0x36, 0x80, 0xc3, 0x8d, 0x73, 0x2d, 0x34, 0x13, 0x05, 0xca, 0x37, 0x85, 0x8b,
-	0x27, 0x5b, 0x15, 0x67, 0x14, 0x56, 0x08, 0x85, 0x8b, 0xe8, 0x7c, 0x3b, 0x14, 0xe8, 0x58, 0x4f,
-	0xde, 0xae, 0xcd, 0xfb, 0xaa, 0xe8, 0x01, 0x80, 0x7b, 0xbd, 0xf3, 0x18, 0x51, 0xa6, 0x84, 0x0c,
-	0xc4, 0x44, 0x99, 0x12, 0x36, 0x1e, 0x93, 0xce, 0x10, 0xbc, 0x32, 0x9a, 0x89, 0xc2, 0x9b, 0xa3,
-	0xda, 0xf4, 0x69, 0x88, 0xfe, 0x0e, 0xe0, 0x80, 0x67, 0xe2, 0x83, 0xa6, 0x04, 0x6e, 0x0a, 0x0c,
-	0x9b, 0xe2, 0x27, 0x5a, 0x90, 0x64, 0xd8, 0x2e, 0x10, 0x6c, 0x3f, 0x41, 0x3f, 0x8e, 0xf4, 0x25,
-	0x79, 0x77, 0xd2, 0x71, 0x93, 0xbc, 0xcd, 0x5f, 0xa1, 0x55, 0xf4, 0x7f, 0x00, 0x51, 0x70, 0xa8,
-	0x85, 0xe4, 0x68, 0xff, 0xf8, 0x31, 0xff, 0xa8, 0x75, 0x05, 0x06, 0xfd, 0x1c, 0x81, 0x3e, 0x8f,
-	0x4e, 0xb7, 0xe5, 0x56, 0x46, 0x01, 0xbd, 0x03, 0xe0, 0x50, 0xc3, 0xe8, 0x09, 0x9d, 0x0c, 0xc7,
-	0x10, 0x3e, 0x53, 0x8b, 0xcf, 0xb4, 0x28, 0xcd, 0xe0, 0x5e, 0x21, 0x70, 0x57, 0xd0, 0xa5, 0x28,
-	0xb8, 0x2a, 0x33, 0xa0, 0xd0, 0x39, 0x92, 0xed, 0xde, 0xba, 0x86, 0x57, 0x5c, 0x15, 0xbd, 0xc9,
-	0x93, 0x83, 0xb5, 0xad, 0xcd, 0x92, 0xc3, 0x37, 0x6a, 0x6a, 0x9a, 0x1c, 0xfe, 0xa1, 0x90, 0x94,
-	0x21, 0x90, 0xd7, 0xd1, 0x2f, 0x5a, 0x4b, 0x0e, 0xfa, 0x6c, 0xf4, 0x24, 0x47, 0x28, 0xf2, 0x8f,
-	0x00, 0x3c, 0x18, 0x3e, 0x27, 0x41, 0xa7, 0x22, 0xa1, 0x05, 0xc7, 0x4a, 0xf1, 0xd3, 0xed, 0x29,
-	0x31, 0x6a, 0xbf, 0x24, 0xd4, 0xae, 0xa1, 0xf5, 0x76, 0xa8, 0xd9, 0x6e, 0xdf, 0xc6, 0x1f, 0xc7,
-	0xa1, 0xe4, 0x3e, 0x06, 0x70, 0x54, 0xd0, 0x60, 0x23, 0x01, 0xd0, 0xe6, 0x43, 0x98, 0xf8, 0x99,
-	0x36, 0xb5, 0xda, 0xe5, 0x67, 0x53, 0x43, 0x4a, 0x8e, 0x5b, 0xe2, 0x5c, 0x43, 0xf9, 0x3d, 0x02,
-	0x70, 0xc0, 0xd3, 0xf7, 0x89, 0xd2, 0x2e, 0xd8, 0xa8, 0x8b, 0xd2, 0x2e, 0xa4, 0x89, 0x94, 0x56,
-	0x09, 0xf6, 0x14, 0xba, 0x18, 0x85, 0xdd, 0xd3, 0xcb, 0x86, 0xc3, 0x7d, 0x0f, 0x40, 0x14, 0xec,
-	0xab, 0x44, 0xc5, 0x49, 0xd8, 0x29, 0x8a, 0x8a, 0x93, 0xb8, 0xdd, 0x93, 0x36, 0x08, 0x87, 0x9f,
-	0xa3, 0xd5, 0x28, 0x0e, 0x04, 0xb0, 0xcd, 0x8d, 0xd0, 0xd9, 0x4e, 0x28, 0x97, 0x2f, 0x00, 0x8c,
-	0x89, 0xba, 0x26, 0x24, 0xc8, 0x92, 0x88, 0x56, 0x31, 0x3e, 0xdf, 0xae, 0x1a, 0x63, 0xa7, 0x10,
-	0x76, 0xb7, 0xd0, 0xcd, 0x28, 0x76, 0xb5, 0x46, 0xd4, 0xaa, 0x9b, 0xe2, 0x1c, 0xc5, 0x4d, 0x6a,
-	0x15, 0x7d, 0x08, 0x60, 0x4c, 0xd4, 0x6d, 0x89, 0xc8, 0x46, 0x34, 0x89, 0x22, 0xb2, 0x51, 0x6d,
-	0xa1, 0x74, 0x95, 0x90, 0x5d, 0x45, 0x2b, 0x51, 0x64, 0x59, 0x4b, 0xa5, 0x79, 0x4d, 0x71, 0xb2,
-	0xb5, 0x76, 0xab, 0x8a, 0xfe, 0x0c, 0x60, 0x0f, 0x7d, 0x93, 0x8b, 0x9e, 0xc9, 0xbe, 0xce, 0x51,
-	0xf4, 0x4c, 0xf6, 0xb7, 0x8a, 0xad, 0x7f, 0x0d, 0x59, 0xbf, 0x20, 0x6f, 0xd7, 0xdb, 0xd1, 0x2a,
-	0x7a, 0x03, 0xc0, 0xa1, 0x86, 0x36, 0x4d, 0xf4, 0x35, 0x0c, 0x6f, 0x29, 0x45, 0x5f, 0x43, 0x41,
-	0xef, 0xd7, 0xfa, 0x1b, 0x8e, 0xb7, 0x37, 0xee, 0x1b, 0x8e, 0x3a, 0xd1, 0xe7, 0xcc, 0xff, 0x79,
-	0x81, 0xd3, 0xe6, 0x28, 0x12, 0xb8, 0xaf, 0xc5, 0x8b, 0x04, 0xee, 0xef, 0xb8, 0xa4, 0x25, 0x02,
-	0xfc, 0x67, 0x68, 0xa1, 0x1d, 0xe0, 0xb4, 0x2d, 0x94, 0xb7, 0xe9, 0xff, 0xab, 0xa9, 0xe9, 0x27,
-	0xcf, 0x13, 0xe0, 0xe9, 0xf3, 0x04, 0xf8, 0xf4, 0x79, 0x02, 0xfc, 0xe9, 0x45, 0xa2, 0xe3, 0xe9,
-	0x8b, 0x44, 0xc7, 0x07, 0x2f, 0x12, 0x1d, 0xbf, 0x1e, 0xfe, 0x9d, 0x27, 0x9f, 0x2a, 0x25, 0x6c,
-	0x67, 0x7b, 0xc8, 0x3f, 0x93, 0x38, 0xf5, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xa8, 0x22,
-	0x87, 0x5c, 0x22, 0x00, 0x00,
+	// 2278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xcf, 0xd8, 0x89, 0x3f, 0x8e, 0xd3, 0xd8, 0xb9, 0xf1, 0x3f, 0x5e, 0x8f, 0x9d, 0xb5, 0x35,
+	0xf9, 0xb2, 0xfd, 0xaf, 0x77, 0x1a, 0x37, 0x71, 0x0b, 0x49, 0x9b, 0x78, 0x13, 0x3b, 0x76, 0x49,
+	0x1a, 0x77, 0xdd, 0x0f, 0x15, 0xa9, 0x1a, 0xcd, 0xee, 0xdc, 0xac, 0xa7, 0x6c, 0x66, 0x36, 0x33,
+	0xb3, 0x16, 0xc6, 0x32, 0x12, 0xed, 0x3b, 0x02, 0x21, 0x78, 0xea, 0x0b, 0x42, 0x45, 0x08, 0x15,
+	0x01, 0xa2, 0x7c, 0x49, 0xbc, 0xf1, 0x12, 0xde, 0xda, 0x22, 0x21, 0x40, 0x02, 0xa1, 0x04, 0x09,
+	0x89, 0x57, 0xde, 0x01, 0xcd, 0xbd, 0xe7, 0xce, 0xec, 0xec, 0xcc, 0xec, 0xcc, 0x46, 0x0e, 0xaa,
+	0xe0, 0x25, 0xd9, 0xbd, 0xf7, 0x9c, 0x33, 0xbf, 0xdf, 0xb9, 0xf7, 0x9c, 0x3b, 0xf7, 0xb7, 0x86,
+	0xa9, 0x46, 0xeb, 0x1e, 0x75, 0x74, 0x55, 0x6f, 0x19, 0xa6, 0xa7, 0xee, 0x5c, 0x50, 0xef, 0xb7,
+	0xa8, 0xb3, 0x5b, 0x6a, 0x3a, 0xb6, 0x67, 0x93, 0x51, 0x3e, 0x59, 0x62, 0x93, 0xa5, 0x9d, 0x0b,
+	0xf2, 0x71, 0xfd, 0x9e, 0x69, 0xd9, 0x2a, 0xfb, 0x97, 0xdb, 0xc8, 0xe3, 0x75, 0xbb, 0x6e, 0xb3,
+	0x8f, 0xaa, 0xff, 0x09, 0x47, 0xa7, 0xeb, 0xb6, 0x5d, 0x6f, 0x50, 0x55, 0x6f, 0x9a, 0xaa, 0x6e,
+	0x59, 0xb6, 0xa7, 0x7b, 0xa6, 0x6d, 0xb9, 0x38, 0x3b, 0x59, 0xb3, 0xdd, 0x7b, 0xb6, 0xab, 0x71,
+	0x37, 0xfe, 0x05, 0xa7, 0x16, 0xf8, 0x37, 0xb5, 0xaa, 0xbb, 0x94, 0x63, 0x51, 0x77, 0x2e, 0x54,
+	0xa9, 0xa7, 0x5f, 0x50, 0x9b, 0x7a, 0xdd, 0xb4, 0x58, 0x1c, 0xf1, 0x90, 0x4e, 0xec, 0x4d, 0xdd,
+	0xd1, 0xef, 0x89, 0x48, 0x31, 0x66, 0x9c, 0x05, 0x9f, 0x2c, 0x76, 0x4e, 0xd2, 0x1d, 0xd3, 0xa0,
+	0x56, 0x8d, 0xe2, 0xfc, 0xb9, 0xb4, 0x79, 0xcd, 0x30, 0xdd, 0x66, 0xcb, 0xa3, 0x69, 0x0f, 0xa1,
+	0x4d, 0xbb, 0xb6, 0xcd, 0x27, 0x95, 0x71, 0x20, 0xaf, 0xf8, 0x0c, 0x36, 0x19, 0xac, 0x0a, 0xbd,
+	0xdf, 0xa2, 0xae, 0xa7, 0xbc, 0x02, 0x27, 0x22, 0xa3, 0x6e, 0xd3, 0xb6, 0x5c, 0x4a, 0x3e, 0x0b,
+	0x03, 0x1c, 0x7e, 0x41, 0x9a, 0x95, 0xe6, 0x46, 0x96, 0x26, 0x4a, 0x1d, 0xc9, 0x2f, 0x71, 0x87,
+	0xf2, 0xf0, 0x83, 0x3f, 0xcf, 0x1c, 0xfa, 0xde, 0xdf, 0x7e, 0xb4, 0x20, 0x55, 0xd0, 0x43, 0xb9,
+	0x0c, 0x05, 0x16, 0x72, 0x15, 0x41, 0x96, 0x77, 0x37, 0x0c, 0x7c, 0x1c, 0x99, 0x81, 0x91, 0x00,
+	0xbb, 0x69, 0xb0, 0xe0, 0x87, 0x2b, 0x20, 0x86, 0x36, 0x0c, 0xe5, 0x2d, 0x98, 0x4c, 0x70, 0x46,
+	0x54, 0xd7, 0x60, 0x48, 0x98, 0x22, 0xae, 0xc9, 0x18, 0xae, 0xc0, 0xb1, 0x0d, 0x59, 0xe0, 0xa5,
+	0x5c, 0x81, 0xa9, 0x48, 0xf8, 0x1b, 0x3c, 0x7f, 0x02, 0xde, 0x29, 0x00, 0xcc, 0x68, 0x88, 0x6e,
+	0x18, 0x47, 0x36, 0x0c, 0x65, 0x1d, 0xce, 0x26, 0x79, 0x97, 0x83, 0x81, 0xdc, 0x34, 0x29, 0x4c,
+	0x27, 0xe3, 0x40, 0xa6, 0xab, 0x30, 0x88, 0x8f, 0x45, 0xa2, 0xb3, 0xa9, 0x44, 0x05, 0x88, 0x36,
+	0xbe, 0xc2, 0x57, 0xf9, 0xbe, 0x04, 0xa7, 0x3a, 0xd2, 0xb9, 0xd5, 0xaa, 0xbe, 0x4d, 0x6b, 0x9e,
+	0x40, 0xba, 0x02, 0xa3, 0x2e, 0x1f, 0xd1, 0x74, 0xc3, 0x70, 0xa8, 0xcb, 0x57, 0x7c, 0xb8, 0x5c,
+	0xf8, 0xe4, 0xc3, 0xc5, 0x71, 0x2c, 0x86, 0x15, 0x3e, 0xb3, 0xe5, 0x39, 0xa6, 0x55, 0xaf, 0x1c,
+	0x43, 0x07, 0x1c, 0x25, 0x6b, 0x00, 0x61, 0x31, 0x14, 0xfa, 0x18, 0xdc, 0x73, 0x25, 0x74, 0xf5,
+	0x2b, 0xa7, 0xc4, 0xab, 0x18, 0x2b, 0xa7, 0xb4, 0xa9, 0xd7, 0x45, 0xa2, 0x2a, 0x6d, 0x9e, 0xca,
+	0x77, 0x25, 0x28, 0xa6, 0x81, 0xc5, 0xb4, 0x5c, 0x8e, 0x6c, 0x80, 0xfe, 0xee, 0x1b, 0xe0, 0xb0,
+	0x9f, 0x90, 0x70, 0xed, 0xc9, 0xcd, 0x04, 0x9c, 0xe7, 0x33, 0x71, 0xf2, 0x27, 0x47, 0x80, 0xbe,
+	0x2b, 0x75, 0xac, 0x5e, 0x79, 0x77, 0xa5, 0xe6, 0xcf, 0x88, 0xa4, 0x4e, 0xc1, 0xb0, 0xce, 0x06,
+	0xc4, 0xe2, 0x0f, 0x57, 0x86, 0xf8, 0xc0, 0x86, 0x71, 0x60, 0xe9, 0x7a, 0x3f, 0xbe, 0xb6, 0x02,
+	0xc5, 0xa7, 0x2a, 0x5b, 0x32, 0xb6, 0x83, 0xeb, 0x2d, 0xc7, 0xa1, 0x96, 0xb7, 0xea, 0xb7, 0x24,
+	0xd1, 0x7d, 0xbe, 0x2a, 0xc1, 0x64, 0xc2, 0x24, 0xe2, 0x9f, 0x84, 0x21, 0xd6, 0xc0, 0xc2, 0x12,
+	0x1a, 0x64, 0xdf, 0x37, 0x0c, 0xf2, 0x34, 0x10, 0x3e, 0xe5, 0x7a, 0xba, 0xe3, 0x69, 0xdb, 0xd4,
+	0xac, 0x6f, 0x7b, 0x0c, 0x65, 0x7f, 0x65, 0x8c, 0xcd, 0x6c, 0xf9, 0x13, 0xeb, 0x6c, 0x9c, 0xcc,
+	0x01, 0x1f, 0xd3, 0xa8, 0x65, 0x08, 0xdb, 0x7e, 0x66, 0x7b, 0x8c, 0x8d, 0xaf, 0x5a, 0x06, 0xb7,
+	0x54, 0x2e, 0xc2, 0x04, 0xcf, 0xa9, 0x3f, 0xbc, 0x62, 0xd5, 0xb6, 0x6d, 0x47, 0x2c, 0x6a, 0x3a,
+	0x1a, 0xe5, 0x75, 0xd1, 0xf1, 0xda, 0xbd, 0xc2, 0x4e, 0xaa, 0xb3, 0x11, 0x2c, 0xe4, 0xe9, 0xf8,
+	0x12, 0x84, 0x5e, 0xb8, 0x0a, 0xe8, 0xa1, 0xcc, 0x42, 0x31, 0x96, 0x9d, 0x08, 0x28, 0xe5, 0x2d,
+	0x98, 0x49, 0xb5, 0x38, 0x00, 0x00, 0x3f, 0x94, 0xb0, 0x5f, 0xae, 0xb8, 0xae, 0x59, 0xb7, 0xa8,
+	0xf1, 0xaa, 0xee, 0xd4, 0xa9, 0x27, 0x4e, 0x0f, 0xb2, 0x0e, 0xc7, 0xdd, 0x56, 0x93, 0x3a, 0x96,
+	0x6d, 0x50, 0x4d, 0xaf, 0xd5, 0xec, 0x96, 0xe5, 0x61, 0xff, 0x98, 0xfa, 0xe4, 0xc3, 0xc5, 0x09,
+	0xd1, 0x3f, 0x6a, 0xb5, 0x68, 0x0b, 0x19, 0x0b, 0xbc, 0x56, 0xb8, 0x53, 0x24, 0xbb, 0x7d, 0xd1,
+	0xb5, 0xfe, 0x7f, 0x20, 0x77, 0xcd, 0x86, 0x47, 0x1d, 0xad, 0xba, 0xab, 0x05, 0x46, 0xfe, 0xfa,
+	0x0d, 0x55, 0x46, 0xf9, 0x4c, 0x99, 0xa7, 0x7e, 0xc3, 0x50, 0x1e, 0xf4, 0xc1, 0x74, 0x32, 0xe2,
+	0x83, 0xde, 0x54, 0x25, 0x38, 0xe1, 0xd0, 0xfb, 0x2d, 0xd3, 0xa1, 0x86, 0x66, 0x37, 0xa9, 0xa5,
+	0x35, 0x6d, 0xc7, 0x73, 0x0b, 0xfd, 0xb3, 0xfd, 0x73, 0x4f, 0x55, 0x8e, 0x8b, 0xa9, 0x3b, 0x4d,
+	0x6a, 0x6d, 0xfa, 0x13, 0xe4, 0x0d, 0x98, 0xf4, 0x18, 0x16, 0x2d, 0x96, 0x32, 0xb7, 0x70, 0x78,
+	0xb6, 0x3f, 0x2b, 0x67, 0x13, 0xdc, 0x7b, 0xab, 0x23, 0x73, 0x2e, 0x79, 0x1d, 0xc6, 0x30, 0x30,
+	0xb5, 0x8c, 0xa6, 0x6d, 0xfa, 0xf1, 0x8e, 0xb0, 0x72, 0x3f, 0x1b, 0x5b, 0x6a, 0x9e, 0x8d, 0x4d,
+	0xc7, 0xae, 0xd2, 0x55, 0x61, 0x8c, 0x6b, 0x3e, 0xca, 0x83, 0x04, 0xc3, 0xca, 0x8f, 0x25, 0x18,
+	0x4f, 0xb2, 0x27, 0xaf, 0x41, 0x21, 0x8d, 0x49, 0x9e, 0xc5, 0x3f, 0x99, 0x4c, 0x84, 0x94, 0x61,
+	0x38, 0x24, 0xd0, 0xc7, 0x08, 0x14, 0xe3, 0xaf, 0x1d, 0xed, 0x50, 0x10, 0x79, 0xe8, 0xa6, 0x7c,
+	0xb9, 0xbd, 0x7e, 0x2b, 0xd4, 0x5f, 0x92, 0xec, 0xfa, 0x4d, 0xde, 0xc6, 0x7d, 0x8f, 0xb1, 0x8d,
+	0xa3, 0x9d, 0x40, 0x3c, 0x3f, 0x2c, 0x44, 0x87, 0x8d, 0x74, 0x2f, 0x44, 0xee, 0x25, 0x0a, 0x91,
+	0x7b, 0x28, 0xff, 0x94, 0x40, 0xe9, 0x0c, 0xec, 0x96, 0x77, 0xf9, 0x07, 0xea, 0xfc, 0x47, 0xeb,
+	0x31, 0x7a, 0x80, 0xf5, 0x3f, 0xee, 0x01, 0x96, 0x52, 0xd7, 0x87, 0x93, 0xeb, 0xfa, 0x03, 0x09,
+	0x4e, 0x77, 0x4d, 0x00, 0x26, 0xf9, 0x0a, 0x0c, 0xf2, 0x94, 0xb9, 0x78, 0xe4, 0xe5, 0xc9, 0xb2,
+	0x70, 0x39, 0xb8, 0x43, 0xef, 0x5f, 0x02, 0xee, 0x96, 0x67, 0x3b, 0x7a, 0x9d, 0x5e, 0xdf, 0xd6,
+	0x1b, 0x0d, 0x6a, 0xf9, 0xd6, 0xec, 0x49, 0xff, 0xfd, 0x0b, 0xf6, 0x71, 0x1f, 0x9c, 0x4c, 0x26,
+	0x4f, 0xde, 0x04, 0xd9, 0xc1, 0x75, 0x7b, 0xbc, 0x0e, 0x52, 0x10, 0xee, 0x5b, 0x3d, 0x64, 0xe1,
+	0x34, 0x3c, 0xc5, 0xdd, 0xa2, 0x6f, 0x00, 0x47, 0xf9, 0x20, 0x36, 0xf5, 0xcb, 0x30, 0xc2, 0x4c,
+	0x5c, 0x4f, 0xf7, 0x28, 0x6f, 0xcb, 0xc7, 0x96, 0xe4, 0x78, 0x17, 0xb2, 0x1d, 0x6f, 0xcb, 0x37,
+	0xa9, 0x40, 0x53, 0x7c, 0x74, 0x89, 0x06, 0xff, 0x27, 0x3a, 0x91, 0x66, 0x57, 0x5d, 0xea, 0xec,
+	0xf0, 0x7b, 0x26, 0x76, 0xe3, 0x33, 0xf1, 0x9d, 0x88, 0xd6, 0x77, 0x42, 0x63, 0xdc, 0x91, 0xe3,
+	0x34, 0x3e, 0xe5, 0x2a, 0xbf, 0x90, 0xe0, 0x4c, 0xf7, 0x5d, 0x85, 0x55, 0x70, 0xb3, 0xb3, 0x0a,
+	0xce, 0xc7, 0x9e, 0x9d, 0x1c, 0xe2, 0x89, 0x15, 0xc4, 0x3f, 0x24, 0xec, 0xcc, 0xeb, 0xb6, 0xeb,
+	0xfd, 0xcf, 0x14, 0xc1, 0x37, 0x25, 0x18, 0x0d, 0x09, 0xaf, 0x5a, 0x9e, 0xb3, 0xdb, 0xed, 0x1c,
+	0x8a, 0x6d, 0xd1, 0xbe, 0x84, 0x2d, 0x5a, 0x86, 0x91, 0x6d, 0xdb, 0xf5, 0x34, 0x3c, 0x4b, 0x38,
+	0x93, 0xa9, 0xd8, 0xfa, 0x86, 0x8f, 0xc5, 0x35, 0x85, 0xed, 0x60, 0xc4, 0xbf, 0x3b, 0x14, 0xe2,
+	0xab, 0x11, 0xdc, 0xb2, 0x3b, 0x36, 0xcf, 0x6c, 0x97, 0xe0, 0x8c, 0xd3, 0x13, 0xdb, 0x35, 0x6f,
+	0xe3, 0x0b, 0xf0, 0xcb, 0xb6, 0x41, 0xb7, 0x5a, 0x6e, 0xd3, 0xac, 0x99, 0xb6, 0xc5, 0x0b, 0xef,
+	0xa0, 0xf7, 0x8e, 0x52, 0x85, 0x99, 0xd4, 0x67, 0x61, 0x66, 0xae, 0xc2, 0x11, 0xd6, 0x18, 0xf0,
+	0x00, 0x3f, 0x1d, 0xcb, 0x4b, 0xdc, 0x17, 0x53, 0xc3, 0xfd, 0x94, 0xaf, 0x88, 0x02, 0x0e, 0xcf,
+	0xad, 0x86, 0xa9, 0x57, 0xcd, 0x86, 0xe9, 0xed, 0x46, 0x68, 0x3d, 0xb9, 0x16, 0xa9, 0x58, 0x70,
+	0x36, 0x03, 0x42, 0xa0, 0x41, 0x44, 0xd8, 0xce, 0xc7, 0xd8, 0xa6, 0x45, 0x88, 0x72, 0xbe, 0x8e,
+	0x94, 0x5f, 0x35, 0x6b, 0x5f, 0xa0, 0xde, 0x0d, 0xea, 0x51, 0xc7, 0xb4, 0x1d, 0xb6, 0xbe, 0x11,
+	0xca, 0x53, 0x30, 0xec, 0x31, 0x93, 0xb6, 0x4b, 0x33, 0x1f, 0xd8, 0x30, 0x02, 0xd0, 0xe9, 0x41,
+	0xf2, 0x82, 0x4e, 0x8b, 0x10, 0x05, 0xbd, 0x84, 0x62, 0xd9, 0x3a, 0xd5, 0x1b, 0x77, 0x9a, 0x02,
+	0xe2, 0x34, 0xc0, 0x36, 0xd5, 0x1b, 0x9a, 0xdd, 0x0c, 0x8b, 0x77, 0x68, 0x9b, 0x99, 0x6c, 0x18,
+	0xca, 0x6d, 0x38, 0x11, 0xf1, 0x41, 0x44, 0xcb, 0x30, 0x88, 0x4e, 0xa9, 0x5a, 0x1a, 0xf7, 0x10,
+	0xaf, 0x7c, 0x3c, 0xa0, 0xf2, 0x8e, 0xb8, 0x7b, 0xf1, 0x59, 0xb7, 0x8c, 0xe4, 0xf3, 0xe4, 0xeb,
+	0xc0, 0x44, 0x86, 0x6f, 0x0b, 0xa9, 0x23, 0x06, 0x02, 0xd9, 0x3d, 0x0f, 0x43, 0xc8, 0x4e, 0x74,
+	0x8b, 0x0c, 0x7a, 0x83, 0x9c, 0xde, 0x01, 0x36, 0x89, 0xf7, 0x62, 0x89, 0xf2, 0x17, 0xb4, 0x15,
+	0x1c, 0x2f, 0x97, 0x60, 0xc0, 0x65, 0x03, 0x2c, 0x4b, 0xc7, 0x96, 0x4e, 0xa5, 0x00, 0x44, 0x2f,
+	0x34, 0x7e, 0x82, 0x29, 0x14, 0xf0, 0x3e, 0x3d, 0x29, 0x3c, 0x2f, 0x84, 0x4d, 0xff, 0x20, 0x5a,
+	0x33, 0x2d, 0xbd, 0x61, 0x7e, 0x89, 0xcd, 0x6c, 0x3a, 0x76, 0xdd, 0xef, 0x2a, 0x42, 0x6f, 0xf8,
+	0x81, 0x04, 0xe7, 0xb2, 0x2c, 0x91, 0xd6, 0x0c, 0x8c, 0x98, 0x96, 0xd6, 0xc4, 0x61, 0x96, 0xfb,
+	0xa1, 0x0a, 0x98, 0x81, 0x21, 0x19, 0x87, 0x23, 0x77, 0xed, 0x96, 0xc5, 0x4f, 0xea, 0xa1, 0x0a,
+	0xff, 0x42, 0x6e, 0xc1, 0x50, 0xe0, 0xc3, 0xcf, 0xb6, 0x85, 0xe4, 0x37, 0xf8, 0xa4, 0x87, 0x0b,
+	0x15, 0x4b, 0x44, 0x58, 0xfa, 0xd6, 0x0c, 0x1c, 0x61, 0x78, 0xc9, 0xbb, 0x12, 0x0c, 0x70, 0xcd,
+	0x9a, 0xc4, 0xfb, 0x76, 0x5c, 0x18, 0x97, 0xcf, 0x74, 0x37, 0xe2, 0x24, 0x95, 0xd2, 0x3b, 0xbf,
+	0xfd, 0xeb, 0x37, 0xfa, 0xe6, 0xc8, 0x39, 0xf5, 0x16, 0xb3, 0xde, 0x74, 0x6c, 0xcf, 0xae, 0xd9,
+	0x0d, 0x35, 0xf9, 0xc7, 0x00, 0xf2, 0xbe, 0x04, 0x47, 0xdb, 0xa5, 0x6d, 0x32, 0x9f, 0xfc, 0x98,
+	0x04, 0xed, 0x5c, 0x5e, 0xc8, 0x63, 0x8a, 0xb8, 0x5e, 0x64, 0xb8, 0x9e, 0x27, 0xcb, 0x59, 0xb8,
+	0x84, 0xde, 0xa7, 0xee, 0xb5, 0x09, 0xd6, 0xfb, 0xe4, 0xd7, 0x12, 0x1c, 0x8f, 0xc9, 0xb0, 0xa4,
+	0x94, 0x85, 0x20, 0x2a, 0x2e, 0xcb, 0x6a, 0x6e, 0x7b, 0x84, 0x7d, 0x9b, 0xc1, 0xbe, 0x49, 0x56,
+	0x73, 0xc3, 0xae, 0xee, 0x6a, 0x28, 0x47, 0xab, 0x7b, 0x1d, 0x42, 0xf6, 0x3e, 0xf9, 0xa5, 0x04,
+	0x63, 0x9d, 0xea, 0x28, 0x59, 0xcc, 0x02, 0x15, 0xd1, 0x72, 0xe5, 0x52, 0x5e, 0x73, 0xa4, 0xb0,
+	0xc6, 0x28, 0x5c, 0x23, 0x2f, 0xf6, 0x42, 0x81, 0x8b, 0xc3, 0xea, 0x5e, 0xa0, 0x1a, 0xef, 0x93,
+	0x9f, 0x4a, 0x30, 0xda, 0x21, 0xf1, 0x93, 0xa7, 0xbb, 0x63, 0x89, 0xfe, 0x98, 0x21, 0x2f, 0xe6,
+	0xb4, 0x46, 0xe0, 0xab, 0x0c, 0xf8, 0x55, 0xf2, 0x42, 0x5e, 0xe0, 0xe2, 0xc7, 0x27, 0x75, 0x2f,
+	0xfc, 0xcd, 0x64, 0x9f, 0xfc, 0x4e, 0x82, 0xc9, 0xd4, 0xdf, 0x47, 0xc8, 0x72, 0x2e, 0x4c, 0xb1,
+	0x1f, 0x54, 0x7a, 0xe5, 0x52, 0x61, 0x5c, 0x6e, 0x91, 0x97, 0x7a, 0xe6, 0xe2, 0xbf, 0xd4, 0x27,
+	0x97, 0xc4, 0x7b, 0x12, 0x1c, 0x6d, 0x97, 0x59, 0xd3, 0x4a, 0x37, 0x41, 0xe7, 0x96, 0x17, 0xf2,
+	0x98, 0x22, 0xf6, 0x4b, 0x0c, 0xbb, 0x4a, 0x16, 0xb3, 0xb0, 0xd7, 0xb8, 0x37, 0xbf, 0x84, 0x90,
+	0xef, 0x48, 0x30, 0xd2, 0x26, 0xe4, 0x92, 0xb9, 0x94, 0x8c, 0xc5, 0x34, 0x64, 0x79, 0x3e, 0x87,
+	0x25, 0x62, 0xbb, 0xca, 0xb0, 0x7d, 0x86, 0x3c, 0x97, 0x99, 0x57, 0x76, 0xc3, 0xe1, 0x2a, 0xb2,
+	0xba, 0x27, 0xee, 0x3b, 0x6c, 0x57, 0x93, 0xb8, 0x56, 0x4d, 0xd4, 0xec, 0xfc, 0x44, 0x31, 0x3f,
+	0x93, 0xdf, 0x01, 0xa1, 0x5f, 0x61, 0xd0, 0x97, 0xc9, 0xc5, 0x9e, 0xd2, 0x8a, 0x14, 0xc8, 0xaf,
+	0x24, 0x18, 0xed, 0x50, 0x94, 0xd3, 0xaa, 0x31, 0x59, 0x2a, 0x97, 0x17, 0x73, 0x5a, 0x23, 0xdc,
+	0x5b, 0x0c, 0xee, 0x1a, 0xb9, 0x91, 0x05, 0x57, 0xc7, 0x00, 0x1a, 0x57, 0x55, 0x5d, 0xbf, 0x0d,
+	0x76, 0xdc, 0x17, 0xf6, 0xc9, 0xcf, 0xc5, 0xe6, 0x40, 0x05, 0xa6, 0xdb, 0xe6, 0x88, 0xa8, 0xa6,
+	0xf2, 0x7c, 0x0e, 0x4b, 0x84, 0xbc, 0xc5, 0x20, 0xdf, 0x26, 0x9f, 0xcb, 0xb7, 0x39, 0xf8, 0x05,
+	0xa5, 0x6d, 0x73, 0x24, 0x22, 0xff, 0xa3, 0x04, 0x27, 0x93, 0x25, 0x3f, 0xf2, 0x6c, 0x26, 0xb4,
+	0xb8, 0x42, 0x2a, 0x5f, 0xec, 0xcd, 0x09, 0xa9, 0xbd, 0xc6, 0xa8, 0xdd, 0x21, 0xb7, 0x7b, 0xa1,
+	0xe6, 0xfa, 0x0a, 0x81, 0x83, 0xa1, 0x12, 0xc9, 0xfd, 0x49, 0x82, 0x89, 0x14, 0x29, 0x87, 0xa4,
+	0x00, 0xed, 0xae, 0x27, 0xca, 0x97, 0x7a, 0xf4, 0xea, 0x95, 0x9f, 0xcb, 0x03, 0x69, 0x35, 0x11,
+	0x49, 0x70, 0x4d, 0xe4, 0xf7, 0x81, 0x04, 0x23, 0x6d, 0x0a, 0x43, 0xda, 0xb6, 0x8b, 0x4b, 0x42,
+	0xf2, 0x7c, 0x0e, 0x4b, 0xc4, 0xbe, 0xce, 0xb0, 0x97, 0xc9, 0xb5, 0x2c, 0xec, 0x6d, 0xaa, 0x49,
+	0x32, 0xdc, 0xdf, 0x48, 0x40, 0xe2, 0x37, 0xf8, 0xb4, 0xe6, 0x94, 0xaa, 0x49, 0xc8, 0xcf, 0xe4,
+	0x77, 0x40, 0x0e, 0x9b, 0x8c, 0xc3, 0x4b, 0x64, 0x3d, 0x8b, 0x03, 0x03, 0xec, 0x8a, 0x20, 0x5c,
+	0xa6, 0x4c, 0xe4, 0xf2, 0x77, 0x09, 0x0a, 0x69, 0xf7, 0x73, 0x92, 0xb2, 0x4b, 0x32, 0x44, 0x09,
+	0x79, 0xb9, 0x57, 0x37, 0x64, 0xa7, 0x31, 0x76, 0x6f, 0x92, 0x37, 0xb2, 0xd8, 0x05, 0x92, 0x87,
+	0x13, 0x86, 0x12, 0x1c, 0xd3, 0xe5, 0x90, 0x7d, 0xf2, 0x07, 0x09, 0x0a, 0x69, 0xf7, 0xfa, 0x34,
+	0xb2, 0x19, 0x72, 0x84, 0xbc, 0xdc, 0xab, 0x1b, 0x92, 0x7d, 0x99, 0x91, 0x5d, 0x27, 0x6b, 0x59,
+	0x64, 0xf1, 0xf2, 0x6e, 0xb4, 0x87, 0x12, 0x64, 0x83, 0x8b, 0xfd, 0x3e, 0xf9, 0xba, 0x04, 0x03,
+	0xfc, 0xf6, 0x97, 0x76, 0x6f, 0x89, 0x68, 0x14, 0xf2, 0x99, 0xee, 0x46, 0xbd, 0x9e, 0x86, 0x78,
+	0x33, 0x55, 0xf7, 0x42, 0xe1, 0x63, 0x9f, 0xfc, 0xcc, 0x97, 0x35, 0xa3, 0x82, 0x40, 0xda, 0x69,
+	0x98, 0x2c, 0x5e, 0xc8, 0x8b, 0x39, 0xad, 0x7b, 0x7d, 0xa9, 0x16, 0x17, 0x69, 0xff, 0x3d, 0x8e,
+	0x27, 0x31, 0x92, 0xcc, 0x9f, 0xb4, 0x03, 0xe7, 0xd7, 0xf0, 0x4c, 0xe0, 0x11, 0x31, 0x41, 0x5e,
+	0xcc, 0x69, 0x8d, 0xc0, 0xaf, 0x33, 0xe0, 0x2f, 0x90, 0xcb, 0xbd, 0x00, 0xe7, 0x02, 0x84, 0xba,
+	0xc7, 0xff, 0xdf, 0x27, 0x1f, 0xfb, 0xaf, 0xd4, 0x69, 0x57, 0xde, 0xd4, 0x57, 0xea, 0x8c, 0xab,
+	0xbc, 0xfc, 0x5c, 0xcf, 0x7e, 0xbd, 0x72, 0xe2, 0x87, 0xe1, 0xdd, 0xb6, 0x58, 0x81, 0x1c, 0x50,
+	0x5e, 0x78, 0xf0, 0xb0, 0x28, 0x7d, 0xf4, 0xb0, 0x28, 0xfd, 0xe5, 0x61, 0x51, 0xfa, 0xda, 0xa3,
+	0xe2, 0xa1, 0x8f, 0x1e, 0x15, 0x0f, 0xfd, 0xfe, 0x51, 0xf1, 0xd0, 0xe7, 0xc7, 0xbe, 0x18, 0x46,
+	0xf0, 0x76, 0x9b, 0xd4, 0xad, 0x0e, 0xb0, 0x3f, 0x60, 0x7b, 0xf6, 0xdf, 0x03, 0x00, 0x1b, 0x3d,
+	0xeb, 0xe4, 0x1e, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2134,6 +2462,10 @@ type QueryClient interface {
 	EvidenceBySubject(ctx context.Context, in *QueryEvidenceBySubjectRequest, opts ...grpc.CallOption) (*QueryEvidenceBySubjectResponse, error)
 	// EvidenceByAction queries evidence records by action id.
 	EvidenceByAction(ctx context.Context, in *QueryEvidenceByActionRequest, opts ...grpc.CallOption) (*QueryEvidenceByActionResponse, error)
+	// EvidenceDispute queries a single evidence dispute by id.
+	EvidenceDispute(ctx context.Context, in *QueryEvidenceDisputeRequest, opts ...grpc.CallOption) (*QueryEvidenceDisputeResponse, error)
+	// EvidenceDisputeByEvidence queries the dispute filed against an evidence record.
+	EvidenceDisputeByEvidence(ctx context.Context, in *QueryEvidenceDisputeByEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceDisputeResponse, error)
 	// CurrentEpoch returns the current derived epoch boundaries at the current chain height.
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
 	// EpochAnchor returns the persisted epoch anchor for the given epoch_id.
@@ -2163,6 +2495,8 @@ type QueryClient interface {
 	HealOpsByTicket(ctx context.Context, in *QueryHealOpsByTicketRequest, opts ...grpc.CallOption) (*QueryHealOpsByTicketResponse, error)
 	// HealOpsByStatus returns storage-truth heal operations filtered by status.
 	HealOpsByStatus(ctx context.Context, in *QueryHealOpsByStatusRequest, opts ...grpc.CallOption) (*QueryHealOpsByStatusResponse, error)
+	// EpochFinalizationProgress returns the progress of the most recent epoch-end processing.
+	EpochFinalizationProgress(ctx context.Context, in *QueryEpochFinalizationProgressRequest, opts ...grpc.CallOption) (*QueryEpochFinalizationProgressResponse, error)
 }
 
 type queryClient struct {
@@ -2209,29 +2543,47 @@ func (c *queryClient) EvidenceByAction(ctx context.Context, in *QueryEvidenceByA
 	return out, nil
 }
 
-func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
-	out := new(QueryCurrentEpochResponse)
-	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/CurrentEpoch", in, out, opts...)
+func (c *queryClient) EvidenceDispute(ctx context.Context, in *QueryEvidenceDisputeRequest, opts ...grpc.CallOption) (*QueryEvidenceDisputeResponse, error) {
+	out := new(QueryEvidenceDisputeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/EvidenceDispute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) EpochAnchor(ctx context.Context, in *QueryEpochAnchorRequest, opts ...grpc.CallOption) (*QueryEpochAnchorResponse, error) {
-	out := new(QueryEpochAnchorResponse)
-	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/EpochAnchor", in, out, opts...)
+func (c *queryClient) EvidenceDisputeByEvidence(ctx context.Context, in *QueryEvidenceDisputeByEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceDisputeResponse, error) {
+	out := new(QueryEvidenceDisputeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/EvidenceDisputeByEvidence", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) CurrentEpochAnchor(ctx context.Context, in *QueryCurrentEpochAnchorRequest, opts ...grpc.CallOption) (*QueryCurrentEpochAnchorResponse, error) {
-	out := new(QueryCurrentEpochAnchorResponse)
-	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/CurrentEpochAnchor", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochAnchor(ctx context.Context, in *QueryEpochAnchorRequest, opts ...grpc.CallOption) (*QueryEpochAnchorResponse, error) {
+	out := new(QueryEpochAnchorResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/EpochAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpochAnchor(ctx context.Context, in *QueryCurrentEpochAnchorRequest, opts ...grpc.CallOption) (*QueryCurrentEpochAnchorResponse, error) {
+	out := new(QueryCurrentEpochAnchorResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/CurrentEpochAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
 	return out, nil
 }
@@ -2335,6 +2687,15 @@ func (c *queryClient) HealOpsByStatus(ctx context.Context, in *QueryHealOpsBySta
 	return out, nil
 }
 
+func (c *queryClient) EpochFinalizationProgress(ctx context.Context, in *QueryEpochFinalizationProgressRequest, opts ...grpc.CallOption) (*QueryEpochFinalizationProgressResponse, error) {
+	out := new(QueryEpochFinalizationProgressResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Query/EpochFinalizationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -2345,6 +2706,10 @@ type QueryServer interface {
 	EvidenceBySubject(context.Context, *QueryEvidenceBySubjectRequest) (*QueryEvidenceBySubjectResponse, error)
 	// EvidenceByAction queries evidence records by action id.
 	EvidenceByAction(context.Context, *QueryEvidenceByActionRequest) (*QueryEvidenceByActionResponse, error)
+	// EvidenceDispute queries a single evidence dispute by id.
+	EvidenceDispute(context.Context, *QueryEvidenceDisputeRequest) (*QueryEvidenceDisputeResponse, error)
+	// EvidenceDisputeByEvidence queries the dispute filed against an evidence record.
+	EvidenceDisputeByEvidence(context.Context, *QueryEvidenceDisputeByEvidenceRequest) (*QueryEvidenceDisputeResponse, error)
 	// CurrentEpoch returns the current derived epoch boundaries at the current chain height.
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
 	// EpochAnchor returns the persisted epoch anchor for the given epoch_id.
@@ -2374,6 +2739,8 @@ type QueryServer interface {
 	HealOpsByTicket(context.Context, *QueryHealOpsByTicketRequest) (*QueryHealOpsByTicketResponse, error)
 	// HealOpsByStatus returns storage-truth heal operations filtered by status.
 	HealOpsByStatus(context.Context, *QueryHealOpsByStatusRequest) (*QueryHealOpsByStatusResponse, error)
+	// EpochFinalizationProgress returns the progress of the most recent epoch-end processing.
+	EpochFinalizationProgress(context.Context, *QueryEpochFinalizationProgressRequest) (*QueryEpochFinalizationProgressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2392,6 +2759,12 @@ func (*UnimplementedQueryServer) EvidenceBySubject(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) EvidenceByAction(ctx context.Context, req *QueryEvidenceByActionRequest) (*QueryEvidenceByActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EvidenceByAction not implemented")
 }
+func (*UnimplementedQueryServer) EvidenceDispute(ctx context.Context, req *QueryEvidenceDisputeRequest) (*QueryEvidenceDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceDispute not implemented")
+}
+func (*UnimplementedQueryServer) EvidenceDisputeByEvidence(ctx context.Context, req *QueryEvidenceDisputeByEvidenceRequest) (*QueryEvidenceDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceDisputeByEvidence not implemented")
+}
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
@@ -2434,6 +2807,9 @@ func (*UnimplementedQueryServer) HealOpsByTicket(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) HealOpsByStatus(ctx context.Context, req *QueryHealOpsByStatusRequest) (*QueryHealOpsByStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealOpsByStatus not implemented")
 }
+func (*UnimplementedQueryServer) EpochFinalizationProgress(ctx context.Context, req *QueryEpochFinalizationProgressRequest) (*QueryEpochFinalizationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochFinalizationProgress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2511,6 +2887,42 @@ func _Query_EvidenceByAction_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.audit.v1.Query/EvidenceDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceDispute(ctx, req.(*QueryEvidenceDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvidenceDisputeByEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceDisputeByEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceDisputeByEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.audit.v1.Query/EvidenceDisputeByEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceDisputeByEvidence(ctx, req.(*QueryEvidenceDisputeByEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCurrentEpochRequest)
 	if err := dec(in); err != nil {
@@ -2763,6 +3175,24 @@ func _Query_HealOpsByStatus_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochFinalizationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochFinalizationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochFinalizationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.audit.v1.Query/EpochFinalizationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochFinalizationProgress(ctx, req.(*QueryEpochFinalizationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lumera.audit.v1.Query",
@@ -2784,6 +3214,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EvidenceByAction",
 			Handler:    _Query_EvidenceByAction_Handler,
 		},
+		{
+			MethodName: "EvidenceDispute",
+			Handler:    _Query_EvidenceDispute_Handler,
+		},
+		{
+			MethodName: "EvidenceDisputeByEvidence",
+			Handler:    _Query_EvidenceDisputeByEvidence_Handler,
+		},
 		{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
@@ -2840,6 +3278,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HealOpsByStatus",
 			Handler:    _Query_HealOpsByStatus_Handler,
 		},
+		{
+			MethodName: "EpochFinalizationProgress",
+			Handler:    _Query_EpochFinalizationProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lumera/audit/v1/query.proto",
@@ -2962,6 +3404,95 @@ func (m *QueryEvidenceByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEvidenceDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceDisputeByEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceDisputeByEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceDisputeByEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EvidenceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEvidenceBySubjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3387,6 +3918,20 @@ func (m *QueryAssignedTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetEndpoints) > 0 {
+		for iNdEx := len(m.TargetEndpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetEndpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TargetSupernodeAccounts) > 0 {
 		for iNdEx := len(m.TargetSupernodeAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.TargetSupernodeAccounts[iNdEx])
@@ -3397,20 +3942,20 @@ func (m *QueryAssignedTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		}
 	}
 	if len(m.RequiredOpenPorts) > 0 {
-		dAtA10 := make([]byte, len(m.RequiredOpenPorts)*10)
-		var j9 int
+		dAtA11 := make([]byte, len(m.RequiredOpenPorts)*10)
+		var j10 int
 		for _, num := range m.RequiredOpenPorts {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j10++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3427,6 +3972,50 @@ func (m *QueryAssignedTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *TargetProbeEndpoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetProbeEndpoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetProbeEndpoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TargetSupernodeAccount) > 0 {
+		i -= len(m.TargetSupernodeAccount)
+		copy(dAtA[i:], m.TargetSupernodeAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetSupernodeAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEpochReportRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3678,21 +4267,35 @@ func (m *StorageChallengeReport) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EndpointObservations) > 0 {
+		for iNdEx := len(m.EndpointObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndpointObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PortStates) > 0 {
-		dAtA16 := make([]byte, len(m.PortStates)*10)
-		var j15 int
+		dAtA17 := make([]byte, len(m.PortStates)*10)
+		var j16 int
 		for _, num := range m.PortStates {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j16++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintQuery(dAtA, i, uint64(j15))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -4344,53 +4947,129 @@ func (m *QueryHealOpsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEpochFinalizationProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEpochFinalizationProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEvidenceByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEpochFinalizationProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EvidenceId != 0 {
-		n += 1 + sovQuery(uint64(m.EvidenceId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEvidenceByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEpochFinalizationProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochFinalizationProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochFinalizationProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InProgress {
+		i--
+		if m.InProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEvidenceByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		n += 1 + sovQuery(uint64(m.EvidenceId))
+	}
+	return n
+}
+
+func (m *QueryEvidenceByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Evidence.Size()
@@ -4398,6 +5077,41 @@ func (m *QueryEvidenceByIdResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEvidenceDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovQuery(uint64(m.DisputeId))
+	}
+	return n
+}
+
+func (m *QueryEvidenceDisputeByEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvidenceId != 0 {
+		n += 1 + sovQuery(uint64(m.EvidenceId))
+	}
+	return n
+}
+
+func (m *QueryEvidenceDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dispute.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryEvidenceBySubjectRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4584,6 +5298,31 @@ func (m *QueryAssignedTargetsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.TargetEndpoints) > 0 {
+		for _, e := range m.TargetEndpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TargetProbeEndpoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetSupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4702,6 +5441,12 @@ func (m *StorageChallengeReport) Size() (n int) {
 		}
 		n += 1 + sovQuery(uint64(l)) + l
 	}
+	if len(m.EndpointObservations) > 0 {
+		for _, e := range m.EndpointObservations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4949,6 +5694,32 @@ func (m *QueryHealOpsByStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochFinalizationProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochFinalizationProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InProgress {
+		n += 2
+	}
+	if m.Found {
+		n += 2
+	}
+	l = m.Progress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5240,6 +6011,227 @@ func (m *QueryEvidenceByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvidenceDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceDisputeByEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceDisputeByEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceDisputeByEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceId", wireType)
+			}
+			m.EvidenceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryEvidenceBySubjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6422,9 +7414,125 @@ func (m *QueryAssignedTargetsResponse) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequiredOpenPorts", wireType)
 			}
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupernodeAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetSupernodeAccounts = append(m.TargetSupernodeAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetEndpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetEndpoints = append(m.TargetEndpoints, TargetProbeEndpoints{})
+			if err := m.TargetEndpoints[len(m.TargetEndpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TargetProbeEndpoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetProbeEndpoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetProbeEndpoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupernodeAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupernodeAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6452,7 +7560,41 @@ func (m *QueryAssignedTargetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetSupernodeAccounts = append(m.TargetSupernodeAccounts, string(dAtA[iNdEx:postIndex]))
+			m.TargetSupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, ProbeEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7261,6 +8403,40 @@ func (m *StorageChallengeReport) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PortStates", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointObservations = append(m.EndpointObservations, EndpointObservation{})
+			if err := m.EndpointObservations[len(m.EndpointObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8910,6 +10086,179 @@ func (m *QueryHealOpsByStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochFinalizationProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochFinalizationProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochFinalizationProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochFinalizationProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochFinalizationProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochFinalizationProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProgress = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
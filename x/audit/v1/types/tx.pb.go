@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -716,6 +717,249 @@ func (m *MsgSubmitHealVerificationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitHealVerificationResponse proto.InternalMessageInfo
 
+type MsgDisputeEvidence struct {
+	// creator must be the subject of the disputed evidence.
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EvidenceId uint64 `protobuf:"varint,2,opt,name=evidence_id,json=evidenceId,proto3" json:"evidence_id,omitempty"`
+	// bond must be at least params.evidence_dispute_min_bond (same denom).
+	Bond             types.Coin               `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond"`
+	CounterProofType EvidenceCounterProofType `protobuf:"varint,4,opt,name=counter_proof_type,json=counterProofType,proto3,enum=lumera.audit.v1.EvidenceCounterProofType" json:"counter_proof_type,omitempty"`
+	CounterProof     string                   `protobuf:"bytes,5,opt,name=counter_proof,json=counterProof,proto3" json:"counter_proof,omitempty"`
+	Details          string                   `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *MsgDisputeEvidence) Reset()         { *m = MsgDisputeEvidence{} }
+func (m *MsgDisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeEvidence) ProtoMessage()    {}
+func (*MsgDisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b5ba410ad359f63, []int{12}
+}
+func (m *MsgDisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeEvidence.Merge(m, src)
+}
+func (m *MsgDisputeEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeEvidence proto.InternalMessageInfo
+
+func (m *MsgDisputeEvidence) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgDisputeEvidence) GetEvidenceId() uint64 {
+	if m != nil {
+		return m.EvidenceId
+	}
+	return 0
+}
+
+func (m *MsgDisputeEvidence) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *MsgDisputeEvidence) GetCounterProofType() EvidenceCounterProofType {
+	if m != nil {
+		return m.CounterProofType
+	}
+	return EvidenceCounterProofType_EVIDENCE_COUNTER_PROOF_TYPE_UNSPECIFIED
+}
+
+func (m *MsgDisputeEvidence) GetCounterProof() string {
+	if m != nil {
+		return m.CounterProof
+	}
+	return ""
+}
+
+func (m *MsgDisputeEvidence) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type MsgDisputeEvidenceResponse struct {
+	DisputeId uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+}
+
+func (m *MsgDisputeEvidenceResponse) Reset()         { *m = MsgDisputeEvidenceResponse{} }
+func (m *MsgDisputeEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeEvidenceResponse) ProtoMessage()    {}
+func (*MsgDisputeEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b5ba410ad359f63, []int{13}
+}
+func (m *MsgDisputeEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeEvidenceResponse.Merge(m, src)
+}
+func (m *MsgDisputeEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeEvidenceResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeEvidenceResponse) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
+}
+
+type MsgVoteEvidenceDispute struct {
+	// creator must be a member of the dispute panel.
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	Uphold    bool   `protobuf:"varint,3,opt,name=uphold,proto3" json:"uphold,omitempty"`
+	Details   string `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (m *MsgVoteEvidenceDispute) Reset()         { *m = MsgVoteEvidenceDispute{} }
+func (m *MsgVoteEvidenceDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteEvidenceDispute) ProtoMessage()    {}
+func (*MsgVoteEvidenceDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b5ba410ad359f63, []int{14}
+}
+func (m *MsgVoteEvidenceDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteEvidenceDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteEvidenceDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteEvidenceDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteEvidenceDispute.Merge(m, src)
+}
+func (m *MsgVoteEvidenceDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteEvidenceDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteEvidenceDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteEvidenceDispute proto.InternalMessageInfo
+
+func (m *MsgVoteEvidenceDispute) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgVoteEvidenceDispute) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
+}
+
+func (m *MsgVoteEvidenceDispute) GetUphold() bool {
+	if m != nil {
+		return m.Uphold
+	}
+	return false
+}
+
+func (m *MsgVoteEvidenceDispute) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type MsgVoteEvidenceDisputeResponse struct {
+	Status EvidenceDisputeStatus `protobuf:"varint,1,opt,name=status,proto3,enum=lumera.audit.v1.EvidenceDisputeStatus" json:"status,omitempty"`
+}
+
+func (m *MsgVoteEvidenceDisputeResponse) Reset()         { *m = MsgVoteEvidenceDisputeResponse{} }
+func (m *MsgVoteEvidenceDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteEvidenceDisputeResponse) ProtoMessage()    {}
+func (*MsgVoteEvidenceDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b5ba410ad359f63, []int{15}
+}
+func (m *MsgVoteEvidenceDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteEvidenceDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteEvidenceDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteEvidenceDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteEvidenceDisputeResponse.Merge(m, src)
+}
+func (m *MsgVoteEvidenceDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteEvidenceDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteEvidenceDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteEvidenceDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgVoteEvidenceDisputeResponse) GetStatus() EvidenceDisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EvidenceDisputeStatus_EVIDENCE_DISPUTE_STATUS_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "lumera.audit.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumera.audit.v1.MsgUpdateParamsResponse")
@@ -729,78 +973,96 @@ func init() {
 	proto.RegisterType((*MsgClaimHealCompleteResponse)(nil), "lumera.audit.v1.MsgClaimHealCompleteResponse")
 	proto.RegisterType((*MsgSubmitHealVerification)(nil), "lumera.audit.v1.MsgSubmitHealVerification")
 	proto.RegisterType((*MsgSubmitHealVerificationResponse)(nil), "lumera.audit.v1.MsgSubmitHealVerificationResponse")
+	proto.RegisterType((*MsgDisputeEvidence)(nil), "lumera.audit.v1.MsgDisputeEvidence")
+	proto.RegisterType((*MsgDisputeEvidenceResponse)(nil), "lumera.audit.v1.MsgDisputeEvidenceResponse")
+	proto.RegisterType((*MsgVoteEvidenceDispute)(nil), "lumera.audit.v1.MsgVoteEvidenceDispute")
+	proto.RegisterType((*MsgVoteEvidenceDisputeResponse)(nil), "lumera.audit.v1.MsgVoteEvidenceDisputeResponse")
 }
 
 func init() { proto.RegisterFile("lumera/audit/v1/tx.proto", fileDescriptor_4b5ba410ad359f63) }
 
 var fileDescriptor_4b5ba410ad359f63 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x23, 0xff, 0x91, 0xc7, 0xfe, 0xd9, 0xf1, 0xfe, 0x9c, 0x9a, 0xa6, 0x55, 0xd9, 0x56,
-	0x50, 0xd4, 0x75, 0x63, 0xab, 0x51, 0x8b, 0xa0, 0x30, 0x7a, 0xb1, 0x8c, 0x02, 0xd6, 0xc1, 0x48,
-	0x40, 0xa7, 0x2d, 0x60, 0xa0, 0x60, 0xd7, 0xe4, 0x5a, 0x62, 0x43, 0x72, 0x89, 0xdd, 0x95, 0x60,
-	0xdf, 0x8a, 0xa2, 0xa7, 0x9c, 0x7a, 0xe9, 0x33, 0xb4, 0x47, 0x1f, 0x72, 0xeb, 0x0b, 0xe4, 0x18,
-	0xe4, 0x54, 0xa0, 0x40, 0x50, 0xd8, 0x07, 0x3f, 0x40, 0x5f, 0xa0, 0xe0, 0x2e, 0x49, 0xd1, 0xa4,
-	0x24, 0x3b, 0x29, 0x7a, 0x11, 0xb4, 0x33, 0xdf, 0x37, 0x33, 0xfb, 0xcd, 0xec, 0x2e, 0x41, 0xf7,
-	0xba, 0x3e, 0x61, 0xb8, 0x8e, 0xbb, 0x8e, 0x2b, 0xea, 0xbd, 0x87, 0x75, 0x71, 0xba, 0x1d, 0x32,
-	0x2a, 0x28, 0x9a, 0x57, 0x9e, 0x6d, 0xe9, 0xd9, 0xee, 0x3d, 0x34, 0x16, 0xb0, 0xef, 0x06, 0xb4,
-	0x2e, 0x7f, 0x15, 0xc6, 0x58, 0x6c, 0xd3, 0x36, 0x95, 0x7f, 0xeb, 0xd1, 0xbf, 0xd8, 0xba, 0x64,
-	0x53, 0xee, 0x53, 0x5e, 0xf7, 0x79, 0x3b, 0x8a, 0xe8, 0xf3, 0x76, 0xec, 0x58, 0x56, 0x0e, 0x4b,
-	0x31, 0xd4, 0x22, 0x76, 0xad, 0xe4, 0xeb, 0x50, 0x69, 0x95, 0xb3, 0x92, 0x77, 0x86, 0x98, 0x61,
-	0x3f, 0xa1, 0x56, 0xf3, 0x5e, 0xd2, 0x73, 0x1d, 0x12, 0xd8, 0x44, 0xf9, 0x6b, 0xbf, 0x6b, 0x30,
-	0x7f, 0xc0, 0xdb, 0x5f, 0x85, 0x0e, 0x16, 0xe4, 0x89, 0x64, 0xa2, 0x47, 0x30, 0x8d, 0xbb, 0xa2,
-	0x43, 0x99, 0x2b, 0xce, 0x74, 0x6d, 0x4d, 0xdb, 0x98, 0x6e, 0xea, 0xaf, 0x5f, 0x6c, 0x2d, 0xc6,
-	0x35, 0xed, 0x3a, 0x0e, 0x23, 0x9c, 0x1f, 0x0a, 0xe6, 0x06, 0x6d, 0xb3, 0x0f, 0x45, 0x3b, 0x30,
-	0xa9, 0x72, 0xeb, 0x77, 0xd6, 0xb4, 0x8d, 0x99, 0xc6, 0xd2, 0x76, 0x4e, 0xa5, 0x6d, 0x95, 0xa0,
-	0x39, 0xfd, 0xf2, 0xcd, 0xea, 0xd8, 0x6f, 0x57, 0xe7, 0x9b, 0x9a, 0x19, 0x33, 0x76, 0x3e, 0xfb,
-	0xf1, 0xea, 0x7c, 0xb3, 0x1f, 0xeb, 0xf9, 0xd5, 0xf9, 0xe6, 0x7a, 0x5c, 0xfa, 0x69, 0xbf, 0xf8,
-	0x5c, 0xa5, 0xb5, 0x65, 0x58, 0xca, 0x99, 0x4c, 0xc2, 0x43, 0x1a, 0x70, 0x52, 0xfb, 0xa9, 0x04,
-	0x8b, 0x07, 0xbc, 0x7d, 0xd8, 0x3d, 0xf6, 0x5d, 0xf1, 0x65, 0x48, 0xed, 0x8e, 0x49, 0x42, 0xca,
-	0x04, 0x6a, 0xc0, 0x94, 0xcd, 0x08, 0x16, 0x94, 0xdd, 0xb8, 0xb7, 0x04, 0x88, 0x96, 0xa1, 0x4c,
-	0xa2, 0x10, 0x96, 0xeb, 0xc8, 0xbd, 0x8d, 0x9b, 0x53, 0x72, 0xdd, 0x72, 0x50, 0x13, 0x66, 0x3a,
-	0x94, 0x0b, 0x8b, 0xc9, 0xe8, 0x7a, 0x49, 0xee, 0x7c, 0xa5, 0xb0, 0xf3, 0x7d, 0xca, 0x85, 0x2a,
-	0xa0, 0x39, 0x1e, 0xed, 0xde, 0x84, 0x4e, 0x6a, 0x41, 0x0c, 0xaa, 0x5c, 0x50, 0x86, 0xdb, 0xc4,
-	0xb2, 0x3b, 0xd8, 0xf3, 0x48, 0xd0, 0x26, 0x16, 0x3d, 0xe6, 0x84, 0xf5, 0xb0, 0x70, 0x69, 0xc0,
-	0xf5, 0xf1, 0xb5, 0xd2, 0xc6, 0x4c, 0xe3, 0x41, 0x21, 0xec, 0xa1, 0xa2, 0xed, 0x25, 0xac, 0xc7,
-	0x7d, 0x92, 0x59, 0xe1, 0xc3, 0x9d, 0x1c, 0x7d, 0x03, 0xf7, 0x92, 0x9c, 0x21, 0xa3, 0xf4, 0xc4,
-	0x62, 0x84, 0x77, 0x3d, 0xc1, 0xf5, 0x09, 0x99, 0xea, 0xfe, 0xb0, 0x54, 0x4f, 0x22, 0xb0, 0x29,
-	0xb1, 0xe6, 0xff, 0x79, 0xc1, 0xc6, 0x77, 0x66, 0xa3, 0x4e, 0x26, 0xca, 0xd5, 0xaa, 0x50, 0x19,
-	0xd4, 0x85, 0xb4, 0x4d, 0xbf, 0xdc, 0x81, 0x85, 0x3e, 0x20, 0x9e, 0xcd, 0x77, 0xea, 0xd1, 0x2e,
-	0xcc, 0xf3, 0xee, 0xf1, 0xf7, 0xc4, 0x16, 0x16, 0x56, 0x08, 0xd9, 0xaa, 0x51, 0xdc, 0xb9, 0x98,
-	0x10, 0x5b, 0x51, 0x13, 0xfe, 0x97, 0x1c, 0x0f, 0x4b, 0x9c, 0x85, 0x44, 0x76, 0x73, 0xae, 0xf1,
-	0x7e, 0x41, 0x8b, 0xa4, 0xd0, 0xa7, 0x67, 0x21, 0x31, 0x67, 0x49, 0x66, 0x85, 0x56, 0x60, 0x1a,
-	0xdb, 0x91, 0xc4, 0xd1, 0xac, 0x8c, 0x47, 0x05, 0x98, 0x65, 0x65, 0x68, 0x39, 0xc8, 0x80, 0xb2,
-	0x4f, 0x04, 0x76, 0xb0, 0xc0, 0xfa, 0x84, 0xf2, 0x25, 0xeb, 0x9c, 0x6e, 0x5f, 0xc0, 0x72, 0x41,
-	0x96, 0x44, 0x34, 0xb4, 0x0a, 0x33, 0x69, 0x9d, 0xae, 0x23, 0x25, 0x1a, 0x37, 0x21, 0x31, 0xb5,
-	0x9c, 0xda, 0xdf, 0x25, 0x58, 0x4d, 0xe9, 0x71, 0xe3, 0x4c, 0x62, 0x77, 0x88, 0xfd, 0xec, 0x5f,
-	0x69, 0x3c, 0xe2, 0x1c, 0x7c, 0x0b, 0x95, 0x74, 0x76, 0x1d, 0x8b, 0x77, 0x43, 0xc2, 0x02, 0xea,
-	0x10, 0x0b, 0xdb, 0x36, 0xed, 0x06, 0xea, 0x60, 0x4c, 0x37, 0x57, 0x5e, 0xbf, 0xd8, 0x5a, 0x4a,
-	0x72, 0xd8, 0xf6, 0xf5, 0x34, 0x46, 0x3f, 0xc0, 0x61, 0xc2, 0xdf, 0x55, 0xf4, 0x48, 0x56, 0xe1,
-	0xda, 0xcf, 0x88, 0xc8, 0xc8, 0xaa, 0x0c, 0x2d, 0x07, 0xb5, 0x60, 0x3d, 0x93, 0x5b, 0x0d, 0xb2,
-	0x25, 0x18, 0x0e, 0xb8, 0xcd, 0xdc, 0x50, 0x58, 0x1d, 0xcc, 0x3b, 0xb1, 0xde, 0xd5, 0x3e, 0x50,
-	0x0d, 0xec, 0xd3, 0x14, 0xb6, 0x8f, 0x79, 0x07, 0x3d, 0x82, 0x25, 0xa6, 0x84, 0x2a, 0x04, 0x98,
-	0x94, 0x01, 0xee, 0xc5, 0xee, 0x1c, 0xef, 0x08, 0x16, 0x13, 0x5e, 0x9c, 0xdf, 0xf6, 0x30, 0xe7,
-	0xfa, 0x94, 0x9c, 0xa0, 0x8d, 0x5b, 0x9c, 0xa6, 0xbd, 0x08, 0x6f, 0xa2, 0x38, 0x4a, 0xc6, 0x86,
-	0x74, 0x98, 0x72, 0x88, 0xc0, 0xae, 0xc7, 0xf5, 0xb2, 0xac, 0x21, 0x59, 0xe6, 0x66, 0xe6, 0x23,
-	0xf8, 0xf0, 0x86, 0xa6, 0xa7, 0xc7, 0xee, 0x4f, 0x4d, 0xde, 0x8e, 0x7b, 0x1e, 0x76, 0xfd, 0x7d,
-	0x82, 0xbd, 0x3d, 0xea, 0x87, 0x1e, 0x11, 0xef, 0x36, 0x15, 0x15, 0x80, 0x0e, 0xc1, 0x9e, 0x45,
-	0xc3, 0xfe, 0x5c, 0x94, 0x23, 0xcb, 0xe3, 0xb0, 0xe5, 0x5c, 0xef, 0x5c, 0x29, 0xd7, 0xb9, 0x07,
-	0x80, 0x24, 0xd5, 0xc7, 0x81, 0x7b, 0x42, 0x78, 0xac, 0xb4, 0xea, 0xef, 0xdd, 0xc8, 0x73, 0x10,
-	0x3b, 0xa4, 0xc8, 0x19, 0x21, 0x26, 0x46, 0x09, 0xa1, 0x2e, 0x9d, 0xc2, 0xe6, 0xd2, 0xdd, 0xbf,
-	0xd1, 0x32, 0xa7, 0x2b, 0x42, 0x7c, 0x4d, 0x98, 0x7b, 0xe2, 0xda, 0xf2, 0x6a, 0xfc, 0x0f, 0x24,
-	0x30, 0xa0, 0xdc, 0x93, 0x19, 0x88, 0x52, 0xa0, 0x6c, 0xa6, 0x6b, 0xf4, 0x31, 0x2c, 0xf4, 0x32,
-	0xd9, 0xaf, 0x09, 0x90, 0x75, 0xbc, 0x95, 0x00, 0xf7, 0x61, 0x7d, 0xe8, 0xfe, 0x12, 0x15, 0x1a,
-	0xbf, 0x4e, 0x40, 0xe9, 0x80, 0xb7, 0xd1, 0x11, 0xcc, 0x5e, 0x7b, 0xfe, 0xd7, 0x0a, 0xc3, 0x9a,
-	0x7b, 0x63, 0x8d, 0x8d, 0x9b, 0x10, 0xe9, 0x4d, 0xe5, 0xc2, 0x42, 0xf1, 0x05, 0xfe, 0x60, 0x10,
-	0xbd, 0x00, 0x33, 0xb6, 0x6e, 0x05, 0x4b, 0x53, 0x7d, 0x07, 0x73, 0xb9, 0x57, 0xa4, 0x36, 0x22,
-	0x40, 0x8c, 0x31, 0x36, 0x6f, 0xc6, 0xa4, 0x19, 0x9e, 0x6b, 0x50, 0x19, 0x79, 0xa5, 0x7e, 0x32,
-	0x3c, 0xd8, 0x60, 0x86, 0xf1, 0xf9, 0xdb, 0x32, 0xb2, 0xca, 0x16, 0x4f, 0xef, 0x40, 0x65, 0x0b,
-	0xb0, 0xc1, 0xca, 0x0e, 0x3d, 0x2e, 0xe8, 0x14, 0xde, 0x1b, 0x72, 0x54, 0x46, 0xa8, 0x97, 0xc7,
-	0x1a, 0x8d, 0xdb, 0x63, 0x93, 0xcc, 0xc6, 0xc4, 0x0f, 0xd1, 0x47, 0x62, 0x73, 0xf3, 0xe5, 0x45,
-	0x55, 0x7b, 0x75, 0x51, 0xd5, 0xfe, 0xba, 0xa8, 0x6a, 0x3f, 0x5f, 0x56, 0xc7, 0x5e, 0x5d, 0x56,
-	0xc7, 0xfe, 0xb8, 0xac, 0x8e, 0x1d, 0xdd, 0xcd, 0x7c, 0x1c, 0x46, 0x2f, 0x36, 0x3f, 0x9e, 0x94,
-	0xdf, 0xb5, 0x9f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xda, 0x00, 0xd5, 0xbc, 0x0b, 0x00,
-	0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0x8f, 0x13, 0xc7, 0x71, 0x5e, 0x42, 0x42, 0x86, 0x40, 0x36, 0x9b, 0xb0, 0x04, 0x47, 0x85,
+	0x10, 0x88, 0xdd, 0xb8, 0x15, 0x42, 0xb4, 0xaa, 0x84, 0xd3, 0x4a, 0xf8, 0x10, 0x81, 0x36, 0x14,
+	0x24, 0xa4, 0x6a, 0x19, 0xef, 0x0e, 0xeb, 0x2d, 0xf6, 0xce, 0x6a, 0x67, 0x6c, 0xc1, 0xad, 0xaa,
+	0x7a, 0xe2, 0xd4, 0x4b, 0xbf, 0x43, 0xa5, 0x5e, 0x38, 0x70, 0xeb, 0x17, 0xa0, 0x37, 0xc4, 0xa9,
+	0x52, 0x25, 0x54, 0xc1, 0x81, 0x0f, 0xd0, 0x73, 0xa5, 0x6a, 0x67, 0x66, 0xd7, 0x9b, 0x5d, 0xdb,
+	0x09, 0xa0, 0x5e, 0xa2, 0xcc, 0xbc, 0xdf, 0xef, 0xfd, 0xf9, 0xbd, 0x99, 0xb7, 0x63, 0xd0, 0x3a,
+	0xbd, 0x2e, 0x09, 0x71, 0x0d, 0xf7, 0x1c, 0x8f, 0xd7, 0xfa, 0xbb, 0x35, 0xfe, 0xb8, 0x1a, 0x84,
+	0x94, 0x53, 0xb4, 0x28, 0x2d, 0x55, 0x61, 0xa9, 0xf6, 0x77, 0xf5, 0x25, 0xdc, 0xf5, 0x7c, 0x5a,
+	0x13, 0x7f, 0x25, 0x46, 0x5f, 0x76, 0xa9, 0x4b, 0xc5, 0xbf, 0xb5, 0xe8, 0x3f, 0xb5, 0xbb, 0x62,
+	0x53, 0xd6, 0xa5, 0xac, 0xd6, 0x65, 0x6e, 0xe4, 0xb1, 0xcb, 0x5c, 0x65, 0x58, 0x95, 0x06, 0x4b,
+	0x32, 0xe4, 0x42, 0x99, 0xd6, 0xb2, 0x79, 0xc8, 0xb0, 0xd2, 0xb8, 0x9e, 0x35, 0x06, 0x38, 0xc4,
+	0xdd, 0x98, 0x6a, 0x64, 0xad, 0xa4, 0xef, 0x39, 0xc4, 0xb7, 0x89, 0xb2, 0x5f, 0x18, 0x65, 0xb7,
+	0x1c, 0x8f, 0x05, 0x3d, 0x1e, 0xe3, 0x0c, 0x95, 0x76, 0x0b, 0x33, 0x52, 0xeb, 0xef, 0xb6, 0x08,
+	0xc7, 0xbb, 0x35, 0x9b, 0x7a, 0xbe, 0xb4, 0x57, 0x7e, 0x2f, 0xc0, 0xe2, 0x3e, 0x73, 0xbf, 0x0d,
+	0x1c, 0xcc, 0xc9, 0x6d, 0x91, 0x01, 0xba, 0x0a, 0xb3, 0xb8, 0xc7, 0xdb, 0x34, 0xf4, 0xf8, 0x13,
+	0xad, 0xb0, 0x51, 0xd8, 0x9a, 0x6d, 0x68, 0xaf, 0x9e, 0xef, 0x2c, 0xab, 0xda, 0x6e, 0x38, 0x4e,
+	0x48, 0x18, 0x3b, 0xe0, 0xa1, 0xe7, 0xbb, 0xe6, 0x00, 0x8a, 0xae, 0x43, 0x49, 0xd6, 0xa0, 0x4d,
+	0x6e, 0x14, 0xb6, 0xe6, 0xea, 0x2b, 0xd5, 0x8c, 0xda, 0x55, 0x19, 0xa0, 0x31, 0xfb, 0xe2, 0xf5,
+	0xb9, 0x89, 0x5f, 0xdf, 0x3d, 0xdb, 0x2e, 0x98, 0x8a, 0x71, 0xfd, 0xf3, 0x1f, 0xdf, 0x3d, 0xdb,
+	0x1e, 0xf8, 0x7a, 0xfa, 0xee, 0xd9, 0xf6, 0x79, 0x55, 0xe2, 0xe3, 0x41, 0x91, 0x99, 0x4c, 0x2b,
+	0xab, 0xb0, 0x92, 0xd9, 0x32, 0x09, 0x0b, 0xa8, 0xcf, 0x48, 0xe5, 0xa7, 0x29, 0x58, 0xde, 0x67,
+	0xee, 0x41, 0xaf, 0xd5, 0xf5, 0xf8, 0x37, 0x01, 0xb5, 0xdb, 0x26, 0x09, 0x68, 0xc8, 0x51, 0x1d,
+	0x66, 0xec, 0x90, 0x60, 0x4e, 0xc3, 0x23, 0x6b, 0x8b, 0x81, 0x68, 0x15, 0xca, 0x24, 0x72, 0x61,
+	0x79, 0x8e, 0xa8, 0xad, 0x68, 0xce, 0x88, 0x75, 0xd3, 0x41, 0x0d, 0x98, 0x6b, 0x53, 0xc6, 0xad,
+	0x50, 0x78, 0xd7, 0xa6, 0x44, 0xe5, 0x6b, 0xb9, 0xca, 0x6f, 0x52, 0xc6, 0x65, 0x02, 0x8d, 0x62,
+	0x54, 0xbd, 0x09, 0xed, 0x64, 0x07, 0x85, 0x60, 0x30, 0x4e, 0x43, 0xec, 0x12, 0xcb, 0x6e, 0xe3,
+	0x4e, 0x87, 0xf8, 0x2e, 0xb1, 0x68, 0x8b, 0x91, 0xb0, 0x8f, 0xb9, 0x47, 0x7d, 0xa6, 0x15, 0x37,
+	0xa6, 0xb6, 0xe6, 0xea, 0x57, 0x72, 0x6e, 0x0f, 0x24, 0x6d, 0x2f, 0x66, 0xdd, 0x1a, 0x90, 0xcc,
+	0x75, 0x36, 0xda, 0xc8, 0xd0, 0x3d, 0x38, 0x1d, 0xc7, 0x0c, 0x42, 0x4a, 0x1f, 0x5a, 0x21, 0x61,
+	0xbd, 0x0e, 0x67, 0xda, 0xb4, 0x08, 0xb5, 0x39, 0x2a, 0xd4, 0xed, 0x08, 0x6c, 0x0a, 0xac, 0x79,
+	0x8a, 0xe5, 0xf6, 0xd8, 0xf5, 0xf9, 0xa8, 0x93, 0xb1, 0x72, 0x15, 0x03, 0xd6, 0x87, 0x75, 0x21,
+	0x69, 0xd3, 0x2f, 0x93, 0xb0, 0x34, 0x00, 0xa8, 0x33, 0xfc, 0x41, 0x3d, 0xba, 0x01, 0x8b, 0xac,
+	0xd7, 0xfa, 0x9e, 0xd8, 0xdc, 0xc2, 0x12, 0xa1, 0x4d, 0x1e, 0xc1, 0x5d, 0x50, 0x04, 0xb5, 0x8b,
+	0x1a, 0x70, 0x22, 0xb9, 0x46, 0xfc, 0x49, 0x40, 0x44, 0x37, 0x17, 0xea, 0x67, 0x73, 0x5a, 0xc4,
+	0x89, 0xde, 0x79, 0x12, 0x10, 0x73, 0x9e, 0xa4, 0x56, 0x68, 0x0d, 0x66, 0xb1, 0x1d, 0x49, 0x1c,
+	0x9d, 0x95, 0x62, 0x94, 0x80, 0x59, 0x96, 0x1b, 0x4d, 0x07, 0xe9, 0x50, 0xee, 0x12, 0x8e, 0x1d,
+	0xcc, 0xb1, 0x36, 0x2d, 0x6d, 0xf1, 0x3a, 0xa3, 0xdb, 0x97, 0xb0, 0x9a, 0x93, 0x25, 0x16, 0x0d,
+	0x9d, 0x83, 0xb9, 0x24, 0x4f, 0xcf, 0x11, 0x12, 0x15, 0x4d, 0x88, 0xb7, 0x9a, 0x4e, 0xe5, 0x9f,
+	0x29, 0x38, 0x97, 0xd0, 0x55, 0xe3, 0x4c, 0x62, 0xb7, 0x89, 0xfd, 0xe8, 0xa3, 0x34, 0x1e, 0x73,
+	0x0f, 0xbe, 0x83, 0xf5, 0xe4, 0xec, 0x3a, 0x16, 0xeb, 0x05, 0x24, 0xf4, 0xa9, 0x43, 0x2c, 0x6c,
+	0xdb, 0xb4, 0xe7, 0xcb, 0x8b, 0x31, 0xdb, 0x58, 0x7b, 0xf5, 0x7c, 0x67, 0x25, 0x8e, 0x61, 0xdb,
+	0x87, 0xc3, 0xe8, 0x03, 0x07, 0x07, 0x31, 0xff, 0x86, 0xa4, 0x47, 0xb2, 0x72, 0xcf, 0x7e, 0x44,
+	0x78, 0x4a, 0x56, 0xb9, 0xd1, 0x74, 0x50, 0x13, 0xce, 0xa7, 0x62, 0xcb, 0x83, 0x6c, 0xf1, 0x10,
+	0xfb, 0xcc, 0x0e, 0xbd, 0x80, 0x5b, 0x6d, 0xcc, 0xda, 0x4a, 0x6f, 0x63, 0x00, 0x94, 0x07, 0xf6,
+	0x4e, 0x02, 0xbb, 0x89, 0x59, 0x1b, 0x5d, 0x85, 0x95, 0x50, 0x0a, 0x95, 0x73, 0x50, 0x12, 0x0e,
+	0x4e, 0x2b, 0x73, 0x86, 0x77, 0x1f, 0x96, 0x63, 0x9e, 0x8a, 0x6f, 0x77, 0x30, 0x63, 0xda, 0x8c,
+	0x38, 0x41, 0x5b, 0xc7, 0xb8, 0x4d, 0x7b, 0x11, 0xde, 0x44, 0xca, 0x4b, 0x6a, 0x0f, 0x69, 0x30,
+	0xe3, 0x10, 0x8e, 0xbd, 0x0e, 0xd3, 0xca, 0x22, 0x87, 0x78, 0x99, 0x39, 0x33, 0x97, 0xe0, 0xe2,
+	0x11, 0x4d, 0x4f, 0xae, 0xdd, 0x5f, 0x05, 0x31, 0x1d, 0xf7, 0x3a, 0xd8, 0xeb, 0xde, 0x24, 0xb8,
+	0xb3, 0x47, 0xbb, 0x41, 0x87, 0xf0, 0x0f, 0x3b, 0x15, 0xeb, 0x00, 0x6d, 0x82, 0x3b, 0x16, 0x0d,
+	0x06, 0xe7, 0xa2, 0x1c, 0xed, 0xdc, 0x0a, 0x9a, 0xce, 0xe1, 0xce, 0x4d, 0x65, 0x3a, 0x77, 0x05,
+	0x90, 0xa0, 0x76, 0xb1, 0xef, 0x3d, 0x24, 0x4c, 0x29, 0x2d, 0xfb, 0x7b, 0x32, 0xb2, 0xec, 0x2b,
+	0x83, 0x10, 0x39, 0x25, 0xc4, 0xf4, 0x38, 0x21, 0xe4, 0xd0, 0xc9, 0x15, 0x97, 0x54, 0xff, 0xba,
+	0x90, 0xba, 0x5d, 0x11, 0xe2, 0x2e, 0x09, 0xbd, 0x87, 0x9e, 0x2d, 0x46, 0xe3, 0xff, 0x20, 0x81,
+	0x0e, 0xe5, 0xbe, 0x88, 0x40, 0xa4, 0x02, 0x65, 0x33, 0x59, 0xa3, 0xcb, 0xb0, 0xd4, 0x4f, 0x45,
+	0x3f, 0x24, 0x40, 0xda, 0xf0, 0x5e, 0x02, 0x6c, 0xc2, 0xf9, 0x91, 0xf5, 0x25, 0x2a, 0xfc, 0x31,
+	0x09, 0x68, 0x9f, 0xb9, 0x5f, 0xcb, 0xf7, 0xc2, 0x47, 0xcd, 0x85, 0xcc, 0x40, 0x9a, 0xcc, 0x0e,
+	0x24, 0x74, 0x0d, 0x8a, 0x2d, 0xea, 0x3b, 0xea, 0xf3, 0xb8, 0x5a, 0x55, 0xee, 0xa2, 0x57, 0x49,
+	0x55, 0xbd, 0x4a, 0xaa, 0x7b, 0xd4, 0xf3, 0xd3, 0x4f, 0x03, 0xc1, 0x40, 0xf7, 0x00, 0x89, 0x09,
+	0x40, 0x42, 0xf5, 0x9d, 0x12, 0x83, 0xb9, 0x28, 0xae, 0xd5, 0xa5, 0x91, 0x83, 0x79, 0x4f, 0x52,
+	0xc4, 0xf5, 0x12, 0x43, 0xfa, 0xa4, 0x9d, 0xd9, 0x41, 0x9b, 0x70, 0xe2, 0x90, 0x63, 0xa5, 0xe8,
+	0x7c, 0x1a, 0x98, 0x16, 0xbc, 0x34, 0x4e, 0xf0, 0x2f, 0x40, 0xcf, 0x4b, 0x99, 0xcc, 0xeb, 0xb3,
+	0x00, 0xea, 0x55, 0x36, 0x18, 0xd7, 0xb3, 0x6a, 0xa7, 0xe9, 0x54, 0x7e, 0x2b, 0xc0, 0x99, 0x7d,
+	0xe6, 0xde, 0xa5, 0x03, 0xaa, 0xf2, 0xf4, 0x41, 0xcd, 0x38, 0x1c, 0x6d, 0x32, 0x13, 0x0d, 0x9d,
+	0x81, 0x52, 0x2f, 0x68, 0xd3, 0x4e, 0x7c, 0x14, 0xd5, 0x2a, 0x5d, 0x6a, 0x71, 0x5c, 0xa9, 0x0f,
+	0xc0, 0x18, 0x9e, 0x6c, 0x52, 0xee, 0x57, 0x50, 0x62, 0x1c, 0xf3, 0x1e, 0x13, 0x39, 0x2f, 0xd4,
+	0x2f, 0x8c, 0x6c, 0x93, 0x62, 0x1e, 0x08, 0xb4, 0xa9, 0x58, 0xf5, 0x7f, 0x4b, 0x30, 0xb5, 0xcf,
+	0x5c, 0x74, 0x1f, 0xe6, 0x0f, 0xbd, 0x4b, 0x37, 0x72, 0x7e, 0x32, 0x8f, 0x3f, 0x7d, 0xeb, 0x28,
+	0x44, 0x92, 0xa3, 0x07, 0x4b, 0xf9, 0xa7, 0xe1, 0x27, 0xc3, 0xe8, 0x39, 0x98, 0xbe, 0x73, 0x2c,
+	0x58, 0x12, 0xea, 0x01, 0x2c, 0x64, 0x9e, 0x37, 0x95, 0x31, 0x0e, 0x14, 0x46, 0xdf, 0x3e, 0x1a,
+	0x93, 0x44, 0x78, 0x5a, 0x80, 0xf5, 0xb1, 0xdf, 0xfa, 0x4f, 0x47, 0x3b, 0x1b, 0xce, 0xd0, 0xaf,
+	0xbd, 0x2f, 0x23, 0xad, 0x6c, 0xfe, 0xb3, 0x32, 0x54, 0xd9, 0x1c, 0x4c, 0xdf, 0x39, 0x16, 0x2c,
+	0x09, 0xf5, 0x18, 0xce, 0x8c, 0x98, 0xe1, 0x63, 0xd4, 0xcb, 0x62, 0xf5, 0xfa, 0xf1, 0xb1, 0x49,
+	0x64, 0x1b, 0x16, 0xb3, 0x73, 0x73, 0x73, 0x98, 0x9b, 0x0c, 0x48, 0xbf, 0x7c, 0x0c, 0x50, 0x12,
+	0x84, 0xc2, 0xa9, 0x61, 0x33, 0xe1, 0xe2, 0x30, 0x1f, 0x43, 0x80, 0x7a, 0xed, 0x98, 0xc0, 0x38,
+	0xa0, 0x3e, 0xfd, 0x43, 0x34, 0x78, 0x1b, 0xdb, 0x2f, 0xde, 0x18, 0x85, 0x97, 0x6f, 0x8c, 0xc2,
+	0xdf, 0x6f, 0x8c, 0xc2, 0xcf, 0x6f, 0x8d, 0x89, 0x97, 0x6f, 0x8d, 0x89, 0x3f, 0xdf, 0x1a, 0x13,
+	0xf7, 0x4f, 0xa6, 0x7e, 0x8b, 0x45, 0x73, 0x98, 0xb5, 0x4a, 0xe2, 0x67, 0xe4, 0x67, 0xff, 0x0d,
+	0x00, 0xd9, 0xb0, 0x31, 0x5a, 0x73, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -827,6 +1089,10 @@ type MsgClient interface {
 	ClaimHealComplete(ctx context.Context, in *MsgClaimHealComplete, opts ...grpc.CallOption) (*MsgClaimHealCompleteResponse, error)
 	// SubmitHealVerification defines the verifier submission path for a chain-tracked heal op.
 	SubmitHealVerification(ctx context.Context, in *MsgSubmitHealVerification, opts ...grpc.CallOption) (*MsgSubmitHealVerificationResponse, error)
+	// DisputeEvidence lets the subject of an evidence record contest it by posting a bond.
+	DisputeEvidence(ctx context.Context, in *MsgDisputeEvidence, opts ...grpc.CallOption) (*MsgDisputeEvidenceResponse, error)
+	// VoteEvidenceDispute records a panelist's vote on a pending evidence dispute.
+	VoteEvidenceDispute(ctx context.Context, in *MsgVoteEvidenceDispute, opts ...grpc.CallOption) (*MsgVoteEvidenceDisputeResponse, error)
 }
 
 type msgClient struct {
@@ -891,6 +1157,24 @@ func (c *msgClient) SubmitHealVerification(ctx context.Context, in *MsgSubmitHea
 	return out, nil
 }
 
+func (c *msgClient) DisputeEvidence(ctx context.Context, in *MsgDisputeEvidence, opts ...grpc.CallOption) (*MsgDisputeEvidenceResponse, error) {
+	out := new(MsgDisputeEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Msg/DisputeEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteEvidenceDispute(ctx context.Context, in *MsgVoteEvidenceDispute, opts ...grpc.CallOption) (*MsgVoteEvidenceDisputeResponse, error) {
+	out := new(MsgVoteEvidenceDisputeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.audit.v1.Msg/VoteEvidenceDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -905,6 +1189,10 @@ type MsgServer interface {
 	ClaimHealComplete(context.Context, *MsgClaimHealComplete) (*MsgClaimHealCompleteResponse, error)
 	// SubmitHealVerification defines the verifier submission path for a chain-tracked heal op.
 	SubmitHealVerification(context.Context, *MsgSubmitHealVerification) (*MsgSubmitHealVerificationResponse, error)
+	// DisputeEvidence lets the subject of an evidence record contest it by posting a bond.
+	DisputeEvidence(context.Context, *MsgDisputeEvidence) (*MsgDisputeEvidenceResponse, error)
+	// VoteEvidenceDispute records a panelist's vote on a pending evidence dispute.
+	VoteEvidenceDispute(context.Context, *MsgVoteEvidenceDispute) (*MsgVoteEvidenceDisputeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -929,6 +1217,12 @@ func (*UnimplementedMsgServer) ClaimHealComplete(ctx context.Context, req *MsgCl
 func (*UnimplementedMsgServer) SubmitHealVerification(ctx context.Context, req *MsgSubmitHealVerification) (*MsgSubmitHealVerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitHealVerification not implemented")
 }
+func (*UnimplementedMsgServer) DisputeEvidence(ctx context.Context, req *MsgDisputeEvidence) (*MsgDisputeEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeEvidence not implemented")
+}
+func (*UnimplementedMsgServer) VoteEvidenceDispute(ctx context.Context, req *MsgVoteEvidenceDispute) (*MsgVoteEvidenceDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteEvidenceDispute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1042,6 +1336,42 @@ func _Msg_SubmitHealVerification_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DisputeEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.audit.v1.Msg/DisputeEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeEvidence(ctx, req.(*MsgDisputeEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteEvidenceDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteEvidenceDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteEvidenceDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.audit.v1.Msg/VoteEvidenceDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteEvidenceDispute(ctx, req.(*MsgVoteEvidenceDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lumera.audit.v1.Msg",
@@ -1071,6 +1401,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitHealVerification",
 			Handler:    _Msg_SubmitHealVerification_Handler,
 		},
+		{
+			MethodName: "DisputeEvidence",
+			Handler:    _Msg_DisputeEvidence_Handler,
+		},
+		{
+			MethodName: "VoteEvidenceDispute",
+			Handler:    _Msg_VoteEvidenceDispute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lumera/audit/v1/tx.proto",
@@ -1578,63 +1916,235 @@ func (m *MsgSubmitHealVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDisputeEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDisputeEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitEpochReport) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDisputeEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.EpochId != 0 {
-		n += 1 + sovTx(uint64(m.EpochId))
+	if len(m.CounterProof) > 0 {
+		i -= len(m.CounterProof)
+		copy(dAtA[i:], m.CounterProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterProof)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.HostReport.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.StorageChallengeObservations) > 0 {
-		for _, e := range m.StorageChallengeObservations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.CounterProofType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterProofType))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.StorageProofResults) > 0 {
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EvidenceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvidenceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteEvidenceDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteEvidenceDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteEvidenceDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Uphold {
+		i--
+		if m.Uphold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteEvidenceDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteEvidenceDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteEvidenceDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEpochReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovTx(uint64(m.EpochId))
+	}
+	l = m.HostReport.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.StorageChallengeObservations) > 0 {
+		for _, e := range m.StorageChallengeObservations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.StorageProofResults) > 0 {
 		for _, e := range m.StorageProofResults {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
@@ -1813,6 +2323,82 @@ func (m *MsgSubmitHealVerificationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDisputeEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EvidenceId != 0 {
+		n += 1 + sovTx(uint64(m.EvidenceId))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CounterProofType != 0 {
+		n += 1 + sovTx(uint64(m.CounterProofType))
+	}
+	l = len(m.CounterProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	return n
+}
+
+func (m *MsgVoteEvidenceDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	if m.Uphold {
+		n += 2
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteEvidenceDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3314,6 +3900,514 @@ func (m *MsgSubmitHealVerificationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDisputeEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceId", wireType)
+			}
+			m.EvidenceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvidenceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterProofType", wireType)
+			}
+			m.CounterProofType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterProofType |= EvidenceCounterProofType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteEvidenceDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteEvidenceDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteEvidenceDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uphold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Uphold = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteEvidenceDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteEvidenceDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteEvidenceDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EvidenceDisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,20 @@ type distributionStoreHandle struct {
 	svc corestore.KVStoreService
 }
 
+// migrationKeepers holds keepers of modules that are built outside depinject
+// (wasm, IBC, EVM) or whose state is only reachable through adapters (gov,
+// group). Populated post-build via the Set*Keeper methods and shared across
+// Keeper copies like the store handles above. A nil keeper skips the matching
+// migration step and estimate count.
+type migrationKeepers struct {
+	wasm          types.WasmKeeper
+	gov           types.GovKeeper
+	group         types.GroupKeeper
+	icaController types.ICAControllerKeeper
+	erc20         types.Erc20Keeper
+	evm           types.EVMKeeper
+}
+
 type Keeper struct {
 	storeService corestore.KVStoreService
 	cdc          codec.Codec
@@ -45,6 +59,9 @@ type Keeper struct {
 	// distributionStoreHandle grants this keeper migration-only raw read access
 	// to x/distribution's KV namespace for validator-scoped iteration.
 	distributionStoreHandle *distributionStoreHandle
+	// migrationKeepers grants access to wasm, gov, group, ICA controller and
+	// ERC20 state. Wired post-build in app.go.
+	migrationKeepers *migrationKeepers
 
 	// MigrationRecords stores completed migration records keyed by legacy address.
 	MigrationRecords collections.Map[string, types.MigrationRecord]
@@ -118,6 +135,7 @@ func NewKeeper(
 		// the staking store service into it post-build.
 		stakingStoreHandle:      &stakingStoreHandle{},
 		distributionStoreHandle: &distributionStoreHandle{},
+		migrationKeepers:        &migrationKeepers{},
 	}
 
 	schema, err := sb.Build()
@@ -155,3 +173,32 @@ func (k *Keeper) SetStakingStoreService(svc corestore.KVStoreService) {
 func (k *Keeper) SetDistributionStoreService(svc corestore.KVStoreService) {
 	k.distributionStoreHandle.svc = svc
 }
+
+// SetWasmKeeper wires the CosmWasm keeper used to move contract admin rights.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.migrationKeepers.wasm = wasmKeeper
+}
+
+// SetGovKeeper wires the gov adapter used to re-key deposits and votes.
+func (k *Keeper) SetGovKeeper(govKeeper types.GovKeeper) {
+	k.migrationKeepers.gov = govKeeper
+}
+
+// SetGroupKeeper wires the group keeper used to move memberships and admin
+// rights.
+func (k *Keeper) SetGroupKeeper(groupKeeper types.GroupKeeper) {
+	k.migrationKeepers.group = groupKeeper
+}
+
+// SetICAControllerKeeper wires the ICS-27 controller keeper used to hand
+// legacy interchain accounts over to the new owner.
+func (k *Keeper) SetICAControllerKeeper(icaControllerKeeper types.ICAControllerKeeper) {
+	k.migrationKeepers.icaController = icaControllerKeeper
+}
+
+// SetERC20Keepers wires the ERC20 and EVM keepers used to move balances of
+// ERC20-native token pairs.
+func (k *Keeper) SetERC20Keepers(erc20Keeper types.Erc20Keeper, evmKeeper types.EVMKeeper) {
+	k.migrationKeepers.erc20 = erc20Keeper
+	k.migrationKeepers.evm = evmKeeper
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateWasm moves the admin of every CosmWasm contract administered by
+// legacyAddr to newAddr. Contract code and storage are untouched; only the
+// right to migrate the contract or change its admin moves.
+func (k Keeper) MigrateWasm(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddress) error {
+	wasmKeeper := k.migrationKeepers.wasm
+	if wasmKeeper == nil {
+		return nil
+	}
+
+	for _, contract := range k.wasmContractsAdministeredBy(ctx, legacyAddr) {
+		if err := wasmKeeper.UpdateContractAdmin(ctx, contract, legacyAddr, newAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wasmContractsAdministeredBy returns the contracts whose admin is addr.
+// wasmd keeps no admin index, so this walks all contract infos.
+func (k Keeper) wasmContractsAdministeredBy(ctx sdk.Context, addr sdk.AccAddress) []sdk.AccAddress {
+	wasmKeeper := k.migrationKeepers.wasm
+	if wasmKeeper == nil {
+		return nil
+	}
+
+	admin := addr.String()
+	var contracts []sdk.AccAddress
+	wasmKeeper.IterateContractInfo(ctx, func(contract sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+		if info.Admin == admin {
+			contracts = append(contracts, contract)
+		}
+		return false
+	})
+	return contracts
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/LumeraProtocol/lumera/x/evmigration/types"
+)
+
+// erc20MigrationGasLimit bounds the gas of a single balanceOf or transfer call,
+// both as the EVM gas limit of the call and as the cosmos gas it may charge to
+// the migration. Token contracts are arbitrary code: without a bound, a token
+// that burns gas or reverts could make every claim of its holders fail.
+const erc20MigrationGasLimit = 300_000
+
+// maxERC20PairsPerMigration caps the ERC20-native token pairs whose balances
+// are checked by a single claim, so the EVM work of a claim stays bounded as
+// pairs are registered.
+const maxERC20PairsPerMigration = 20
+
+// erc20Balance is a positive balance held in an ERC20-native token pair.
+type erc20Balance struct {
+	contract common.Address
+	amount   *big.Int
+}
+
+// MigrateERC20 transfers the legacy address's balance of every enabled
+// ERC20-native token pair to the new address. Balances of coin-native pairs
+// live in x/bank and are moved by MigrateBank.
+//
+// The transfers are sent from the legacy EVM address, so this must run before
+// MigrateAuth removes the legacy account. A transfer that fails (reverts,
+// returns false or exceeds erc20MigrationGasLimit) is skipped and reported in
+// an erc20_balance_skipped event instead of aborting the claim. So is every
+// pair beyond maxERC20PairsPerMigration, without an amount.
+func (k Keeper) MigrateERC20(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddress) error {
+	from := common.BytesToAddress(legacyAddr)
+	to := common.BytesToAddress(newAddr)
+
+	balances, unscanned := k.erc20BalancesOf(ctx, legacyAddr)
+	for _, contract := range unscanned {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeERC20Skipped,
+				sdk.NewAttribute(types.AttributeKeyLegacyAddress, legacyAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyNewAddress, newAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+				sdk.NewAttribute(types.AttributeKeyReason, fmt.Sprintf("more than %d ERC20 token pairs", maxERC20PairsPerMigration)),
+			),
+		)
+	}
+	for _, balance := range balances {
+		err := k.transferERC20(ctx, balance.contract, from, to, balance.amount)
+		if err == nil {
+			continue
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeERC20Skipped,
+				sdk.NewAttribute(types.AttributeKeyLegacyAddress, legacyAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyNewAddress, newAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyContract, balance.contract.Hex()),
+				sdk.NewAttribute(types.AttributeKeyAmount, balance.amount.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+	}
+	return nil
+}
+
+// erc20BalancesOf returns the positive balances addr holds in the first
+// maxERC20PairsPerMigration enabled ERC20-native token pairs, and the
+// contracts of the pairs beyond that limit, which are not checked. Tokens
+// whose balanceOf fails are left out.
+func (k Keeper) erc20BalancesOf(ctx sdk.Context, addr sdk.AccAddress) (balances []erc20Balance, unscanned []common.Address) {
+	if k.migrationKeepers.erc20 == nil || k.migrationKeepers.evm == nil {
+		return nil, nil
+	}
+	account := common.BytesToAddress(addr)
+
+	scanned := 0
+	for _, pair := range k.migrationKeepers.erc20.GetTokenPairs(ctx) {
+		if !pair.Enabled || !pair.IsNativeERC20() {
+			continue
+		}
+		contract := pair.GetERC20Contract()
+		if scanned == maxERC20PairsPerMigration {
+			unscanned = append(unscanned, contract)
+			continue
+		}
+		scanned++
+		res, err := k.callERC20(ctx, contract, account, false, "balanceOf", account)
+		if err != nil {
+			continue
+		}
+		out, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Unpack("balanceOf", res.Ret)
+		if err != nil || len(out) == 0 {
+			continue
+		}
+		amount, ok := out[0].(*big.Int)
+		if !ok || amount.Sign() <= 0 {
+			continue
+		}
+		balances = append(balances, erc20Balance{contract: contract, amount: amount})
+	}
+	return balances, unscanned
+}
+
+// transferERC20 calls transfer(to, amount) on contract from the from address.
+func (k Keeper) transferERC20(ctx sdk.Context, contract, from, to common.Address, amount *big.Int) error {
+	res, err := k.callERC20(ctx, contract, from, true, "transfer", to, amount)
+	if err != nil {
+		return err
+	}
+	// Tokens that return nothing are accepted, matching common ERC20 wrappers.
+	if len(res.Ret) == 0 {
+		return nil
+	}
+	out, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Unpack("transfer", res.Ret)
+	if err != nil {
+		return fmt.Errorf("decode transfer result: %w", err)
+	}
+	if ok, _ := out[0].(bool); !ok {
+		return fmt.Errorf("transfer returned false")
+	}
+	return nil
+}
+
+// callERC20 runs an ERC20 method on a branch of ctx. The EVM call runs with
+// an erc20MigrationGasLimit gas limit and the branch has its own gas meter
+// with the same cap. The gas used is charged to ctx, and the branch is
+// written back only when commit is set and the call succeeded.
+func (k Keeper) callERC20(ctx sdk.Context, contract, from common.Address, commit bool, method string, args ...any) (res *evmtypes.MsgEthereumTxResponse, err error) {
+	evmKeeper := k.migrationKeepers.evm
+	data, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	meter := storetypes.NewGasMeter(erc20MigrationGasLimit)
+	callCtx, write := ctx.WithGasMeter(meter).CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			res, err = nil, fmt.Errorf("%s exceeded gas limit %d", method, erc20MigrationGasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(meter.GasConsumedToLimit(), "evmigration erc20 "+method)
+	}()
+
+	// The message is built here rather than through CallEVM, which runs with
+	// the EVM default gas cap whatever the cosmos gas meter allows.
+	stateDB := statedb.New(callCtx, evmKeeper, statedb.NewEmptyTxConfig())
+	msg := core.Message{
+		From:       from,
+		To:         &contract,
+		Nonce:      stateDB.GetNonce(from),
+		Value:      big.NewInt(0),
+		GasLimit:   erc20MigrationGasLimit,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}
+	res, err = evmKeeper.ApplyMessage(callCtx, stateDB, msg, nil, commit, false, true)
+	if err != nil {
+		return nil, err
+	}
+	meter.ConsumeGas(res.GasUsed, "apply evm message")
+	if res.Failed() {
+		return nil, errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+	if commit {
+		write()
+	}
+	return res, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateGov re-keys deposits and votes of legacyAddr on proposals that are
+// still in their deposit or voting period. x/gov deletes both once a proposal
+// ends, so only pending state is affected.
+//
+// Deposits must move so the refund goes to the new address. Votes must move
+// because the tally weighs each voter by its delegations, which MigrateStaking
+// has already re-keyed to newAddr; a vote left at legacyAddr would count with
+// zero power.
+func (k Keeper) MigrateGov(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddress) error {
+	govKeeper := k.migrationKeepers.gov
+	if govKeeper == nil {
+		return nil
+	}
+
+	deposits, err := k.govDepositsOf(ctx, legacyAddr)
+	if err != nil {
+		return err
+	}
+	newDeposits, err := k.govDepositsOf(ctx, newAddr)
+	if err != nil {
+		return err
+	}
+	newAmounts := make(map[uint64]sdk.Coins, len(newDeposits))
+	for _, deposit := range newDeposits {
+		newAmounts[deposit.ProposalId] = deposit.Amount
+	}
+	for _, deposit := range deposits {
+		if err := govKeeper.RemoveDeposit(ctx, deposit.ProposalId, legacyAddr); err != nil {
+			return err
+		}
+		// Merge with a deposit newAddr already made on the same proposal.
+		amount := sdk.NewCoins(deposit.Amount...).Add(newAmounts[deposit.ProposalId]...)
+		if err := govKeeper.SetDeposit(ctx, govv1.NewDeposit(deposit.ProposalId, newAddr, amount)); err != nil {
+			return err
+		}
+	}
+
+	votes, err := k.govVotesOf(ctx, legacyAddr)
+	if err != nil {
+		return err
+	}
+	newVoted, err := k.govVotedProposals(ctx, newAddr)
+	if err != nil {
+		return err
+	}
+	for _, vote := range votes {
+		if err := govKeeper.RemoveVote(ctx, vote.ProposalId, legacyAddr); err != nil {
+			return err
+		}
+		// A vote already cast by newAddr is the more recent choice; keep it.
+		if _, voted := newVoted[vote.ProposalId]; voted {
+			continue
+		}
+		vote.Voter = newAddr.String()
+		if err := govKeeper.SetVote(ctx, vote); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// govDepositsOf returns the pending deposits made by addr.
+func (k Keeper) govDepositsOf(ctx sdk.Context, addr sdk.AccAddress) ([]govv1.Deposit, error) {
+	govKeeper := k.migrationKeepers.gov
+	if govKeeper == nil {
+		return nil, nil
+	}
+
+	depositor := addr.String()
+	var deposits []govv1.Deposit
+	err := govKeeper.IterateAllDeposits(ctx, func(deposit govv1.Deposit) (bool, error) {
+		if deposit.Depositor == depositor {
+			deposits = append(deposits, deposit)
+		}
+		return false, nil
+	})
+	return deposits, err
+}
+
+// govVotesOf returns the votes addr cast on proposals in their voting period.
+func (k Keeper) govVotesOf(ctx sdk.Context, addr sdk.AccAddress) ([]govv1.Vote, error) {
+	govKeeper := k.migrationKeepers.gov
+	if govKeeper == nil {
+		return nil, nil
+	}
+
+	voter := addr.String()
+	var votes []govv1.Vote
+	err := govKeeper.IterateAllVotes(ctx, func(vote govv1.Vote) (bool, error) {
+		if vote.Voter == voter {
+			votes = append(votes, vote)
+		}
+		return false, nil
+	})
+	return votes, err
+}
+
+// govVotedProposals returns the IDs of proposals addr has voted on.
+func (k Keeper) govVotedProposals(ctx sdk.Context, addr sdk.AccAddress) (map[uint64]struct{}, error) {
+	votes, err := k.govVotesOf(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	voted := make(map[uint64]struct{}, len(votes))
+	for _, vote := range votes {
+		voted[vote.ProposalId] = struct{}{}
+	}
+	return voted, nil
+}
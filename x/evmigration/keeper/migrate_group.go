@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// groupPageLimit bounds each page of group queries walked during migration.
+const groupPageLimit = 100
+
+// MigrateGroup moves x/group state of legacyAddr to newAddr:
+//   - memberships: the legacy member is removed and newAddr is added with the
+//     same weight and metadata (weights are summed when newAddr is already a
+//     member);
+//   - group admin rights;
+//   - group policy admin rights.
+//
+// Memberships are updated before admin rights, since only the current admin
+// may update members. Every membership or admin change bumps the group
+// version, which makes x/group abort proposals still pending on that group.
+func (k Keeper) MigrateGroup(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddress) error {
+	groupKeeper := k.migrationKeepers.group
+	if groupKeeper == nil {
+		return nil
+	}
+	legacy, next := legacyAddr.String(), newAddr.String()
+
+	groups, err := k.groupsByMember(ctx, legacyAddr)
+	if err != nil {
+		return err
+	}
+	for _, info := range groups {
+		members, err := k.groupMembers(ctx, info.Id, legacy, next)
+		if err != nil {
+			return err
+		}
+		legacyMember, ok := members[legacy]
+		if !ok {
+			return fmt.Errorf("group %d: member %s not found", info.Id, legacy)
+		}
+		weight := legacyMember.Weight
+		metadata := legacyMember.Metadata
+		if existing, ok := members[next]; ok {
+			weight, err = addGroupWeights(weight, existing.Weight)
+			if err != nil {
+				return fmt.Errorf("group %d: %w", info.Id, err)
+			}
+			metadata = existing.Metadata
+		}
+		if _, err := groupKeeper.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+			Admin:   info.Admin,
+			GroupId: info.Id,
+			MemberUpdates: []group.MemberRequest{
+				{Address: legacy, Weight: "0"},
+				{Address: next, Weight: weight, Metadata: metadata},
+			},
+		}); err != nil {
+			return fmt.Errorf("group %d: update members: %w", info.Id, err)
+		}
+	}
+
+	adminGroups, err := k.groupsByAdmin(ctx, legacyAddr)
+	if err != nil {
+		return err
+	}
+	for _, info := range adminGroups {
+		if _, err := groupKeeper.UpdateGroupAdmin(ctx, &group.MsgUpdateGroupAdmin{
+			Admin:    legacy,
+			GroupId:  info.Id,
+			NewAdmin: next,
+		}); err != nil {
+			return fmt.Errorf("group %d: update admin: %w", info.Id, err)
+		}
+	}
+
+	policies, err := k.groupPoliciesByAdmin(ctx, legacyAddr)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		if _, err := groupKeeper.UpdateGroupPolicyAdmin(ctx, &group.MsgUpdateGroupPolicyAdmin{
+			Admin:              legacy,
+			GroupPolicyAddress: policy.Address,
+			NewAdmin:           next,
+		}); err != nil {
+			return fmt.Errorf("group policy %s: update admin: %w", policy.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// groupsByMember returns every group addr is a member of.
+func (k Keeper) groupsByMember(ctx sdk.Context, addr sdk.AccAddress) ([]*group.GroupInfo, error) {
+	groupKeeper := k.migrationKeepers.group
+	if groupKeeper == nil {
+		return nil, nil
+	}
+	var groups []*group.GroupInfo
+	err := walkGroupPages(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := groupKeeper.GroupsByMember(ctx, &group.QueryGroupsByMemberRequest{Address: addr.String(), Pagination: page})
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, res.Groups...)
+		return res.Pagination, nil
+	})
+	return groups, err
+}
+
+// groupsByAdmin returns every group administered by addr.
+func (k Keeper) groupsByAdmin(ctx sdk.Context, addr sdk.AccAddress) ([]*group.GroupInfo, error) {
+	groupKeeper := k.migrationKeepers.group
+	if groupKeeper == nil {
+		return nil, nil
+	}
+	var groups []*group.GroupInfo
+	err := walkGroupPages(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := groupKeeper.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{Admin: addr.String(), Pagination: page})
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, res.Groups...)
+		return res.Pagination, nil
+	})
+	return groups, err
+}
+
+// groupPoliciesByAdmin returns every group policy administered by addr.
+func (k Keeper) groupPoliciesByAdmin(ctx sdk.Context, addr sdk.AccAddress) ([]*group.GroupPolicyInfo, error) {
+	groupKeeper := k.migrationKeepers.group
+	if groupKeeper == nil {
+		return nil, nil
+	}
+	var policies []*group.GroupPolicyInfo
+	err := walkGroupPages(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := groupKeeper.GroupPoliciesByAdmin(ctx, &group.QueryGroupPoliciesByAdminRequest{Admin: addr.String(), Pagination: page})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, res.GroupPolicies...)
+		return res.Pagination, nil
+	})
+	return policies, err
+}
+
+// groupMembers returns the members of groupID whose address is one of addrs.
+func (k Keeper) groupMembers(ctx sdk.Context, groupID uint64, addrs ...string) (map[string]*group.Member, error) {
+	wanted := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		wanted[addr] = struct{}{}
+	}
+	members := make(map[string]*group.Member, len(addrs))
+	err := walkGroupPages(func(page *query.PageRequest) (*query.PageResponse, error) {
+		res, err := k.migrationKeepers.group.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID, Pagination: page})
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range res.Members {
+			if _, ok := wanted[member.Member.Address]; ok {
+				members[member.Member.Address] = member.Member
+			}
+		}
+		return res.Pagination, nil
+	})
+	return members, err
+}
+
+// walkGroupPages calls fetch with successive page requests until the
+// response carries no next key.
+func walkGroupPages(fetch func(page *query.PageRequest) (*query.PageResponse, error)) error {
+	page := &query.PageRequest{Limit: groupPageLimit}
+	for {
+		res, err := fetch(page)
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		page = &query.PageRequest{Key: res.NextKey, Limit: groupPageLimit}
+	}
+}
+
+// addGroupWeights sums two x/group member weights.
+func addGroupWeights(a, b string) (string, error) {
+	x, err := sdkmath.LegacyNewDecFromStr(a)
+	if err != nil {
+		return "", fmt.Errorf("invalid member weight %q: %w", a, err)
+	}
+	y, err := sdkmath.LegacyNewDecFromStr(b)
+	if err != nil {
+		return "", fmt.Errorf("invalid member weight %q: %w", b, err)
+	}
+	return x.Add(y).String(), nil
+}
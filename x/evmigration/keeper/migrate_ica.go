@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	"github.com/LumeraProtocol/lumera/x/evmigration/types"
+)
+
+// MigrateICAController hands the interchain accounts registered by legacyAddr
+// over to newAddr.
+//
+// ICS-27 binds each interchain account to the controller port
+// "icacontroller-<owner>", and the host chain records that port on its end of
+// the channel, so the registration cannot be re-keyed. Instead the app's
+// controller msg server maps newAddr back to the legacy owner through
+// ResolveICAControllerOwner once the migration record exists. This step only
+// emits one event per account handed over.
+func (k Keeper) MigrateICAController(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddress) error {
+	for _, account := range k.icaAccountsOwnedBy(ctx, legacyAddr) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeICAHandover,
+				sdk.NewAttribute(types.AttributeKeyLegacyAddress, legacyAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyNewAddress, newAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyConnectionID, account.ConnectionId),
+				sdk.NewAttribute(types.AttributeKeyPortID, account.PortId),
+				sdk.NewAttribute(types.AttributeKeyICAAddress, account.AccountAddress),
+			),
+		)
+	}
+	return nil
+}
+
+// ResolveICAControllerOwner returns the owner whose controller port a
+// MsgRegisterInterchainAccount or MsgSendTx signed by owner should use on
+// connectionID. A migrated account keeps driving the interchain account its
+// legacy address registered, unless it has registered its own account on that
+// connection. A legacy owner that has been migrated is rejected: its
+// interchain accounts now belong to the new address. Any other owner is
+// returned unchanged.
+func (k Keeper) ResolveICAControllerOwner(ctx sdk.Context, connectionID, owner string) (string, error) {
+	migrated, err := k.MigrationRecords.Has(ctx, owner)
+	if err != nil {
+		return "", err
+	}
+	if migrated {
+		return "", errorsmod.Wrapf(types.ErrAlreadyMigrated,
+			"%s cannot control interchain accounts; sign with its migrated address", owner)
+	}
+
+	icaKeeper := k.migrationKeepers.icaController
+	if icaKeeper == nil {
+		return owner, nil
+	}
+	legacyOwner, err := k.MigrationRecordByNewAddress.Get(ctx, owner)
+	if err != nil {
+		return owner, nil
+	}
+	if portID, err := icatypes.NewControllerPortID(owner); err == nil {
+		if _, found := icaKeeper.GetInterchainAccountAddress(ctx, connectionID, portID); found {
+			return owner, nil
+		}
+	}
+	portID, err := icatypes.NewControllerPortID(legacyOwner)
+	if err != nil {
+		return owner, nil
+	}
+	if _, found := icaKeeper.GetInterchainAccountAddress(ctx, connectionID, portID); found {
+		return legacyOwner, nil
+	}
+	return owner, nil
+}
+
+// icaAccountsOwnedBy returns the interchain accounts registered with addr as
+// the controller owner.
+func (k Keeper) icaAccountsOwnedBy(ctx sdk.Context, addr sdk.AccAddress) []icagenesistypes.RegisteredInterchainAccount {
+	icaKeeper := k.migrationKeepers.icaController
+	if icaKeeper == nil {
+		return nil
+	}
+	portID, err := icatypes.NewControllerPortID(addr.String())
+	if err != nil {
+		return nil
+	}
+
+	var accounts []icagenesistypes.RegisteredInterchainAccount
+	for _, account := range icaKeeper.GetAllInterchainAccounts(ctx) {
+		if account.PortId == portID {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
@@ -0,0 +1,430 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/evm/contracts"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	evmigrationmocks "github.com/LumeraProtocol/lumera/x/evmigration/mocks"
+	"github.com/LumeraProtocol/lumera/x/evmigration/types"
+)
+
+// migrationKeeperMocks are the mocks behind the post-build Set*Keeper wiring.
+type migrationKeeperMocks struct {
+	wasm  *evmigrationmocks.MockWasmKeeper
+	gov   *evmigrationmocks.MockGovKeeper
+	group *evmigrationmocks.MockGroupKeeper
+	ica   *evmigrationmocks.MockICAControllerKeeper
+	erc20 *evmigrationmocks.MockErc20Keeper
+	evm   *evmigrationmocks.MockEVMKeeper
+}
+
+func (f *mockFixture) wireMigrationKeepers(t *testing.T) migrationKeeperMocks {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := migrationKeeperMocks{
+		wasm:  evmigrationmocks.NewMockWasmKeeper(ctrl),
+		gov:   evmigrationmocks.NewMockGovKeeper(ctrl),
+		group: evmigrationmocks.NewMockGroupKeeper(ctrl),
+		ica:   evmigrationmocks.NewMockICAControllerKeeper(ctrl),
+		erc20: evmigrationmocks.NewMockErc20Keeper(ctrl),
+		evm:   evmigrationmocks.NewMockEVMKeeper(ctrl),
+	}
+	f.keeper.SetWasmKeeper(m.wasm)
+	f.keeper.SetGovKeeper(m.gov)
+	f.keeper.SetGroupKeeper(m.group)
+	f.keeper.SetICAControllerKeeper(m.ica)
+	f.keeper.SetERC20Keepers(m.erc20, m.evm)
+	// ERC20 calls read the sender nonce through the state DB.
+	m.evm.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return m
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// --- MigrateWasm tests ---
+
+// TestMigrateWasm_UpdatesAdministeredContracts verifies that only contracts
+// administered by the legacy address get a new admin, with legacy as caller.
+func TestMigrateWasm_UpdatesAdministeredContracts(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+	owned := testAccAddr()
+	other := testAccAddr()
+
+	m.wasm.EXPECT().IterateContractInfo(gomock.Any(), gomock.Any()).
+		Do(func(_ any, cb func(sdk.AccAddress, wasmtypes.ContractInfo) bool) {
+			cb(owned, wasmtypes.ContractInfo{Admin: legacy.String()})
+			cb(other, wasmtypes.ContractInfo{Admin: testAccAddr().String()})
+		})
+	m.wasm.EXPECT().UpdateContractAdmin(gomock.Any(), owned, legacy, newAddr).Return(nil)
+
+	require.NoError(t, f.keeper.MigrateWasm(f.ctx, legacy, newAddr))
+}
+
+// TestMigrateWasm_NotWired verifies the step is skipped without a wasm keeper.
+func TestMigrateWasm_NotWired(t *testing.T) {
+	f := initMockFixture(t)
+	require.NoError(t, f.keeper.MigrateWasm(f.ctx, testAccAddr(), testAccAddr()))
+}
+
+// --- MigrateGov tests ---
+
+// TestMigrateGov_RekeysDepositsAndVotes verifies that deposits move (merging
+// with an existing deposit of the new address) and that votes move unless the
+// new address already voted on the same proposal.
+func TestMigrateGov_RekeysDepositsAndVotes(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+
+	deposits := []govv1.Deposit{
+		govv1.NewDeposit(1, legacy, sdk.NewCoins(sdk.NewInt64Coin("ulume", 100))),
+		govv1.NewDeposit(2, legacy, sdk.NewCoins(sdk.NewInt64Coin("ulume", 50))),
+		govv1.NewDeposit(2, newAddr, sdk.NewCoins(sdk.NewInt64Coin("ulume", 25))),
+		govv1.NewDeposit(3, testAccAddr(), sdk.NewCoins(sdk.NewInt64Coin("ulume", 10))),
+	}
+	m.gov.EXPECT().IterateAllDeposits(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ any, cb func(govv1.Deposit) (bool, error)) error {
+			for _, d := range deposits {
+				if stop, err := cb(d); stop || err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	m.gov.EXPECT().RemoveDeposit(gomock.Any(), uint64(1), legacy).Return(nil)
+	m.gov.EXPECT().RemoveDeposit(gomock.Any(), uint64(2), legacy).Return(nil)
+	m.gov.EXPECT().SetDeposit(gomock.Any(), govv1.NewDeposit(1, newAddr, sdk.NewCoins(sdk.NewInt64Coin("ulume", 100)))).Return(nil)
+	m.gov.EXPECT().SetDeposit(gomock.Any(), govv1.NewDeposit(2, newAddr, sdk.NewCoins(sdk.NewInt64Coin("ulume", 75)))).Return(nil)
+
+	yes := govv1.NewNonSplitVoteOption(govv1.OptionYes)
+	no := govv1.NewNonSplitVoteOption(govv1.OptionNo)
+	votes := []govv1.Vote{
+		govv1.NewVote(1, legacy, yes, "legacy"),
+		govv1.NewVote(2, legacy, yes, ""),
+		govv1.NewVote(2, newAddr, no, ""),
+	}
+	m.gov.EXPECT().IterateAllVotes(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(_ any, cb func(govv1.Vote) (bool, error)) error {
+			for _, v := range votes {
+				if stop, err := cb(v); stop || err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	m.gov.EXPECT().RemoveVote(gomock.Any(), uint64(1), legacy).Return(nil)
+	m.gov.EXPECT().RemoveVote(gomock.Any(), uint64(2), legacy).Return(nil)
+	m.gov.EXPECT().SetVote(gomock.Any(), govv1.NewVote(1, newAddr, yes, "legacy")).Return(nil)
+
+	require.NoError(t, f.keeper.MigrateGov(f.ctx, legacy, newAddr))
+}
+
+// --- MigrateGroup tests ---
+
+// TestMigrateGroup_MembershipAndAdmin verifies that a membership is swapped in
+// one UpdateGroupMembers call signed by the group admin, and that group and
+// group policy admin rights move afterwards.
+func TestMigrateGroup_MembershipAndAdmin(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+	admin := testAccAddr()
+	policy := testAccAddr()
+
+	m.group.EXPECT().GroupsByMember(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupsByMemberResponse{Groups: []*group.GroupInfo{{Id: 7, Admin: admin.String()}}}, nil)
+	m.group.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupMembersResponse{Members: []*group.GroupMember{
+			{GroupId: 7, Member: &group.Member{Address: legacy.String(), Weight: "2", Metadata: "ops"}},
+			{GroupId: 7, Member: &group.Member{Address: admin.String(), Weight: "1"}},
+		}}, nil)
+	m.group.EXPECT().UpdateGroupMembers(gomock.Any(), &group.MsgUpdateGroupMembers{
+		Admin:   admin.String(),
+		GroupId: 7,
+		MemberUpdates: []group.MemberRequest{
+			{Address: legacy.String(), Weight: "0"},
+			{Address: newAddr.String(), Weight: "2", Metadata: "ops"},
+		},
+	}).Return(&group.MsgUpdateGroupMembersResponse{}, nil)
+
+	m.group.EXPECT().GroupsByAdmin(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupsByAdminResponse{Groups: []*group.GroupInfo{{Id: 9, Admin: legacy.String()}}}, nil)
+	m.group.EXPECT().UpdateGroupAdmin(gomock.Any(), &group.MsgUpdateGroupAdmin{
+		Admin: legacy.String(), GroupId: 9, NewAdmin: newAddr.String(),
+	}).Return(&group.MsgUpdateGroupAdminResponse{}, nil)
+
+	m.group.EXPECT().GroupPoliciesByAdmin(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupPoliciesByAdminResponse{GroupPolicies: []*group.GroupPolicyInfo{{Address: policy.String(), Admin: legacy.String()}}}, nil)
+	m.group.EXPECT().UpdateGroupPolicyAdmin(gomock.Any(), &group.MsgUpdateGroupPolicyAdmin{
+		Admin: legacy.String(), GroupPolicyAddress: policy.String(), NewAdmin: newAddr.String(),
+	}).Return(&group.MsgUpdateGroupPolicyAdminResponse{}, nil)
+
+	require.NoError(t, f.keeper.MigrateGroup(f.ctx, legacy, newAddr))
+}
+
+// TestMigrateGroup_SumsWeightsWhenAlreadyMember verifies that the new address
+// keeps its metadata and receives the legacy weight on top of its own, and
+// that member pages are walked until the next key is empty.
+func TestMigrateGroup_SumsWeightsWhenAlreadyMember(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+	admin := testAccAddr()
+
+	m.group.EXPECT().GroupsByMember(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupsByMemberResponse{Groups: []*group.GroupInfo{{Id: 1, Admin: admin.String()}}}, nil)
+	first := m.group.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupMembersResponse{
+			Members:    []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: legacy.String(), Weight: "1.5"}}},
+			Pagination: &query.PageResponse{NextKey: []byte("next")},
+		}, nil)
+	m.group.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).After(first).
+		DoAndReturn(func(_ any, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+			require.Equal(t, []byte("next"), req.Pagination.Key)
+			return &group.QueryGroupMembersResponse{
+				Members: []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: newAddr.String(), Weight: "2", Metadata: "evm"}}},
+			}, nil
+		})
+	m.group.EXPECT().UpdateGroupMembers(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, msg *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error) {
+			require.Len(t, msg.MemberUpdates, 2)
+			require.Equal(t, "0", msg.MemberUpdates[0].Weight)
+			require.Equal(t, newAddr.String(), msg.MemberUpdates[1].Address)
+			require.Equal(t, "3.500000000000000000", msg.MemberUpdates[1].Weight)
+			require.Equal(t, "evm", msg.MemberUpdates[1].Metadata)
+			return &group.MsgUpdateGroupMembersResponse{}, nil
+		})
+	m.group.EXPECT().GroupsByAdmin(gomock.Any(), gomock.Any()).Return(&group.QueryGroupsByAdminResponse{}, nil)
+	m.group.EXPECT().GroupPoliciesByAdmin(gomock.Any(), gomock.Any()).Return(&group.QueryGroupPoliciesByAdminResponse{}, nil)
+
+	require.NoError(t, f.keeper.MigrateGroup(f.ctx, legacy, newAddr))
+}
+
+// --- MigrateICAController tests ---
+
+// TestMigrateICAController_EmitsHandoverEvents verifies that each interchain
+// account of the legacy owner is reported and that others are ignored.
+func TestMigrateICAController_EmitsHandoverEvents(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+
+	legacyPort, err := icatypes.NewControllerPortID(legacy.String())
+	require.NoError(t, err)
+	otherPort, err := icatypes.NewControllerPortID(testAccAddr().String())
+	require.NoError(t, err)
+	m.ica.EXPECT().GetAllInterchainAccounts(gomock.Any()).Return([]icagenesistypes.RegisteredInterchainAccount{
+		{ConnectionId: "connection-0", PortId: legacyPort, AccountAddress: "host1"},
+		{ConnectionId: "connection-0", PortId: otherPort, AccountAddress: "host2"},
+	})
+
+	require.NoError(t, f.keeper.MigrateICAController(f.ctx, legacy, newAddr))
+
+	var handovers int
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeICAHandover {
+			continue
+		}
+		handovers++
+		attr, ok := ev.GetAttribute(types.AttributeKeyICAAddress)
+		require.True(t, ok)
+		require.Equal(t, "host1", attr.Value)
+	}
+	require.Equal(t, 1, handovers)
+}
+
+// TestResolveICAControllerOwner verifies that a migrated owner resolves to its
+// legacy owner only where the legacy owner has an interchain account and the
+// new owner has none, and that the migrated legacy owner is rejected.
+func TestResolveICAControllerOwner(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr().String()
+	newOwner := testAccAddr().String()
+	stranger := testAccAddr().String()
+	require.NoError(t, f.keeper.MigrationRecordByNewAddress.Set(f.ctx, newOwner, legacy))
+	require.NoError(t, f.keeper.MigrationRecords.Set(f.ctx, legacy, types.MigrationRecord{LegacyAddress: legacy, NewAddress: newOwner}))
+
+	legacyPort, err := icatypes.NewControllerPortID(legacy)
+	require.NoError(t, err)
+	newPort, err := icatypes.NewControllerPortID(newOwner)
+	require.NoError(t, err)
+
+	m.ica.EXPECT().GetInterchainAccountAddress(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ sdk.Context, connectionID, portID string) (string, bool) {
+			switch {
+			case connectionID == "connection-0" && portID == legacyPort:
+				return "host-legacy", true
+			case connectionID == "connection-1" && (portID == legacyPort || portID == newPort):
+				return "host", true
+			}
+			return "", false
+		})
+
+	resolve := func(connectionID, owner string) string {
+		t.Helper()
+		resolved, err := f.keeper.ResolveICAControllerOwner(f.ctx, connectionID, owner)
+		require.NoError(t, err)
+		return resolved
+	}
+	require.Equal(t, legacy, resolve("connection-0", newOwner))
+	require.Equal(t, newOwner, resolve("connection-1", newOwner), "own account wins")
+	require.Equal(t, newOwner, resolve("connection-2", newOwner), "no legacy account")
+	require.Equal(t, stranger, resolve("connection-0", stranger), "not migrated")
+
+	_, err = f.keeper.ResolveICAControllerOwner(f.ctx, "connection-0", legacy)
+	require.ErrorIs(t, err, types.ErrAlreadyMigrated, "migrated legacy owner is locked out")
+}
+
+// --- MigrateERC20 tests ---
+
+func erc20Return(t *testing.T, method string, values ...any) *evmtypes.MsgEthereumTxResponse {
+	t.Helper()
+	ret, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Methods[method].Outputs.Pack(values...)
+	require.NoError(t, err)
+	return &evmtypes.MsgEthereumTxResponse{Ret: ret}
+}
+
+// erc20CallMatcher matches the EVM message of an ERC20 call: its target, its
+// sender, its calldata and the migration gas limit.
+type erc20CallMatcher struct {
+	from, to common.Address
+	data     []byte
+}
+
+func erc20Call(t *testing.T, from, to common.Address, method string, args ...any) gomock.Matcher {
+	t.Helper()
+	data, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(method, args...)
+	require.NoError(t, err)
+	return erc20CallMatcher{from: from, to: to, data: data}
+}
+
+func (m erc20CallMatcher) Matches(x any) bool {
+	msg, ok := x.(core.Message)
+	return ok && msg.From == m.from && msg.To != nil && *msg.To == m.to &&
+		bytes.Equal(msg.Data, m.data) && msg.GasLimit == 300_000
+}
+
+func (m erc20CallMatcher) String() string {
+	return fmt.Sprintf("ERC20 call from %s to %s with data %x", m.from, m.to, m.data)
+}
+
+// TestMigrateERC20_TransfersNativeERC20Balances verifies that positive
+// balances of enabled ERC20-native pairs are transferred from the legacy EVM
+// address, while coin-native and disabled pairs are left to other steps.
+func TestMigrateERC20_TransfersNativeERC20Balances(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+	from := common.BytesToAddress(legacy)
+	to := common.BytesToAddress(newAddr)
+	token := common.HexToAddress("0x00000000000000000000000000000000000e2c20")
+	empty := common.HexToAddress("0x00000000000000000000000000000000000e2c21")
+
+	m.erc20.EXPECT().GetTokenPairs(gomock.Any()).Return([]erc20types.TokenPair{
+		{Erc20Address: token.Hex(), Denom: "erc20/token", Enabled: true, ContractOwner: erc20types.OWNER_EXTERNAL},
+		{Erc20Address: empty.Hex(), Denom: "erc20/empty", Enabled: true, ContractOwner: erc20types.OWNER_EXTERNAL},
+		{Erc20Address: testAccAddrHex(), Denom: "ulume", Enabled: true, ContractOwner: erc20types.OWNER_MODULE},
+		{Erc20Address: testAccAddrHex(), Denom: "erc20/off", Enabled: false, ContractOwner: erc20types.OWNER_EXTERNAL},
+	})
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), erc20Call(t, from, token, "balanceOf", from), gomock.Any(), false, false, true).
+		Return(erc20Return(t, "balanceOf", big.NewInt(42)), nil)
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), erc20Call(t, from, empty, "balanceOf", from), gomock.Any(), false, false, true).
+		Return(erc20Return(t, "balanceOf", big.NewInt(0)), nil)
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), erc20Call(t, from, token, "transfer", to, big.NewInt(42)), gomock.Any(), true, false, true).
+		Return(erc20Return(t, "transfer", true), nil)
+
+	require.NoError(t, f.keeper.MigrateERC20(f.ctx, legacy, newAddr))
+	require.False(t, hasEvent(f.ctx, types.EventTypeERC20Skipped))
+}
+
+// TestMigrateERC20_SkipsFailedTransfer verifies that a token whose transfer
+// fails does not abort the claim and is reported in an event instead.
+func TestMigrateERC20_SkipsFailedTransfer(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+	from := common.BytesToAddress(legacy)
+	to := common.BytesToAddress(newAddr)
+	token := common.HexToAddress("0x00000000000000000000000000000000000e2c20")
+
+	m.erc20.EXPECT().GetTokenPairs(gomock.Any()).Return([]erc20types.TokenPair{
+		{Erc20Address: token.Hex(), Denom: "erc20/token", Enabled: true, ContractOwner: erc20types.OWNER_EXTERNAL},
+	})
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), erc20Call(t, from, token, "balanceOf", from), gomock.Any(), false, false, true).
+		Return(erc20Return(t, "balanceOf", big.NewInt(7)), nil)
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), erc20Call(t, from, token, "transfer", to, big.NewInt(7)), gomock.Any(), true, false, true).
+		Return(&evmtypes.MsgEthereumTxResponse{GasUsed: 300_000, VmError: "execution reverted"}, nil)
+
+	require.NoError(t, f.keeper.MigrateERC20(f.ctx, legacy, newAddr))
+	require.True(t, hasEvent(f.ctx, types.EventTypeERC20Skipped))
+}
+
+// TestMigrateERC20_CapsScannedPairs verifies that a claim checks at most 20
+// ERC20-native pairs and reports the pairs beyond the cap as skipped.
+func TestMigrateERC20_CapsScannedPairs(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	legacy := testAccAddr()
+	newAddr := testAccAddr()
+
+	pairs := make([]erc20types.TokenPair, 21)
+	for i := range pairs {
+		pairs[i] = erc20types.TokenPair{Erc20Address: testAccAddrHex(), Denom: fmt.Sprintf("erc20/token%d", i), Enabled: true, ContractOwner: erc20types.OWNER_EXTERNAL}
+	}
+	m.erc20.EXPECT().GetTokenPairs(gomock.Any()).Return(pairs)
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), false, false, true).
+		Return(erc20Return(t, "balanceOf", big.NewInt(0)), nil).
+		Times(20)
+
+	require.NoError(t, f.keeper.MigrateERC20(f.ctx, legacy, newAddr))
+	var skipped []string
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypeERC20Skipped {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if attr.Key == types.AttributeKeyContract {
+				skipped = append(skipped, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{pairs[20].Erc20Address}, skipped)
+}
+
+func testAccAddrHex() string {
+	return common.BytesToAddress(testAccAddr()).Hex()
+}
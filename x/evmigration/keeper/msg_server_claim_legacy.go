@@ -170,7 +170,7 @@ func (ms msgServer) preChecks(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddres
 }
 
 // migrateAccount performs the account-level migration steps shared by both
-// ClaimLegacyAccount and MigrateValidator (Steps 1-8 from the plan).
+// ClaimLegacyAccount and MigrateValidator (Steps 1-11 from the plan).
 func (ms msgServer) migrateAccount(ctx sdk.Context, legacyAddr, newAddr sdk.AccAddress, destProof *types.MigrationProof) error {
 	// Snapshot the original withdraw address before MigrateDistribution
 	// may temporarily redirect it to self (see redirectWithdrawAddrIfMigrated).
@@ -186,6 +186,12 @@ func (ms msgServer) migrateAccount(ctx sdk.Context, legacyAddr, newAddr sdk.AccA
 		return fmt.Errorf("migrate staking: %w", err)
 	}
 
+	// Step 2b: Transfer ERC20-native token balances. The transfers are sent
+	// from the legacy EVM address, which needs the legacy account to exist.
+	if err := ms.MigrateERC20(ctx, legacyAddr, newAddr); err != nil {
+		return fmt.Errorf("migrate erc20: %w", err)
+	}
+
 	// Step 3a: Migrate auth account (vesting-aware: remove lock before bank transfer).
 	vestingInfo, err := ms.MigrateAuth(ctx, legacyAddr, newAddr, destProof)
 	if err != nil {
@@ -224,6 +230,26 @@ func (ms msgServer) migrateAccount(ctx sdk.Context, legacyAddr, newAddr sdk.AccA
 		return fmt.Errorf("migrate actions: %w", err)
 	}
 
+	// Step 8: Move CosmWasm contract admin rights.
+	if err := ms.MigrateWasm(ctx, legacyAddr, newAddr); err != nil {
+		return fmt.Errorf("migrate wasm: %w", err)
+	}
+
+	// Step 9: Re-key pending gov deposits and votes.
+	if err := ms.MigrateGov(ctx, legacyAddr, newAddr); err != nil {
+		return fmt.Errorf("migrate gov: %w", err)
+	}
+
+	// Step 10: Move group memberships and admin rights.
+	if err := ms.MigrateGroup(ctx, legacyAddr, newAddr); err != nil {
+		return fmt.Errorf("migrate group: %w", err)
+	}
+
+	// Step 11: Hand ICA controller registrations over to the new owner.
+	if err := ms.MigrateICAController(ctx, legacyAddr, newAddr); err != nil {
+		return fmt.Errorf("migrate ica controller: %w", err)
+	}
+
 	return nil
 }
 
@@ -281,6 +281,28 @@ func (qs queryServer) MigrationEstimate(goCtx context.Context, req *types.QueryM
 		return false
 	})
 
+	// Count state held in modules wired post-build. Each count is zero when
+	// the corresponding keeper is not wired.
+	resp.WasmAdminCount = uint64(len(qs.k.wasmContractsAdministeredBy(ctx, addr)))
+	if deposits, err := qs.k.govDepositsOf(ctx, addr); err == nil {
+		resp.GovDepositCount = uint64(len(deposits))
+	}
+	if votes, err := qs.k.govVotesOf(ctx, addr); err == nil {
+		resp.GovVoteCount = uint64(len(votes))
+	}
+	if groups, err := qs.k.groupsByMember(ctx, addr); err == nil {
+		resp.GroupMembershipCount = uint64(len(groups))
+	}
+	if groups, err := qs.k.groupsByAdmin(ctx, addr); err == nil {
+		resp.GroupAdminCount += uint64(len(groups))
+	}
+	if policies, err := qs.k.groupPoliciesByAdmin(ctx, addr); err == nil {
+		resp.GroupAdminCount += uint64(len(policies))
+	}
+	resp.IcaControllerCount = uint64(len(qs.k.icaAccountsOwnedBy(ctx, addr)))
+	erc20Balances, _ := qs.k.erc20BalancesOf(ctx, addr)
+	resp.Erc20BalanceCount = uint64(len(erc20Balances))
+
 	// Balance summary.
 	balances := qs.k.bankKeeper.GetAllBalances(ctx, addr)
 	if !balances.IsZero() {
@@ -294,7 +316,10 @@ func (qs queryServer) MigrationEstimate(goCtx context.Context, req *types.QueryM
 
 	resp.TotalTouched = resp.DelegationCount + resp.UnbondingCount + resp.RedelegationCount +
 		resp.AuthzGrantCount + resp.FeegrantCount + resp.ActionCount +
-		resp.ValDelegationCount + resp.ValUnbondingCount + resp.ValRedelegationCount
+		resp.ValDelegationCount + resp.ValUnbondingCount + resp.ValRedelegationCount +
+		resp.WasmAdminCount + resp.GovDepositCount + resp.GovVoteCount +
+		resp.GroupMembershipCount + resp.GroupAdminCount + resp.IcaControllerCount +
+		resp.Erc20BalanceCount
 
 	// Check if already migrated.
 	if has, _ := qs.k.MigrationRecords.Has(ctx, req.LegacyAddress); has {
@@ -1,8 +1,17 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"cosmossdk.io/math"
 	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
 	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
@@ -270,6 +279,73 @@ func TestQueryMigrationEstimate_NonValidator(t *testing.T) {
 	require.False(t, resp.HasSupernode)
 }
 
+// TestQueryMigrationEstimate_CountsModuleState verifies that wasm, gov, group,
+// ICA controller and ERC20 state is counted and included in total_touched.
+func TestQueryMigrationEstimate_CountsModuleState(t *testing.T) {
+	f := initMockFixture(t)
+	m := f.wireMigrationKeepers(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	addr := testAccAddr()
+	f.stakingKeeper.EXPECT().GetValidator(gomock.Any(), sdk.ValAddress(addr)).Return(
+		stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound,
+	)
+	f.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), addr, ^uint16(0)).Return(nil, nil)
+	f.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), addr, ^uint16(0)).Return(nil, nil)
+	f.stakingKeeper.EXPECT().GetRedelegations(gomock.Any(), addr, ^uint16(0)).Return(nil, nil)
+	f.authzKeeper.EXPECT().IterateGrants(gomock.Any(), gomock.Any())
+	f.feegrantKeeper.EXPECT().IterateAllFeeAllowances(gomock.Any(), gomock.Any()).Return(nil)
+	f.actionKeeper.EXPECT().IterateActions(gomock.Any(), gomock.Any()).Return(nil)
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins())
+	f.supernodeKeeper.EXPECT().QuerySuperNode(gomock.Any(), sdk.ValAddress(addr)).Return(sntypes.SuperNode{}, false)
+	f.accountKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(nil)
+
+	m.wasm.EXPECT().IterateContractInfo(gomock.Any(), gomock.Any()).
+		Do(func(_ any, cb func(sdk.AccAddress, wasmtypes.ContractInfo) bool) {
+			cb(testAccAddr(), wasmtypes.ContractInfo{Admin: addr.String()})
+			cb(testAccAddr(), wasmtypes.ContractInfo{Admin: addr.String()})
+		})
+	m.gov.EXPECT().IterateAllDeposits(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, cb func(govv1.Deposit) (bool, error)) error {
+			_, err := cb(govv1.NewDeposit(1, addr, sdk.NewCoins(sdk.NewInt64Coin("ulume", 1))))
+			return err
+		})
+	m.gov.EXPECT().IterateAllVotes(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, cb func(govv1.Vote) (bool, error)) error {
+			_, err := cb(govv1.NewVote(1, addr, govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+			return err
+		})
+	m.group.EXPECT().GroupsByMember(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupsByMemberResponse{Groups: []*group.GroupInfo{{Id: 1}}}, nil)
+	m.group.EXPECT().GroupsByAdmin(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupsByAdminResponse{Groups: []*group.GroupInfo{{Id: 1}}}, nil)
+	m.group.EXPECT().GroupPoliciesByAdmin(gomock.Any(), gomock.Any()).
+		Return(&group.QueryGroupPoliciesByAdminResponse{GroupPolicies: []*group.GroupPolicyInfo{{}}}, nil)
+	portID, err := icatypes.NewControllerPortID(addr.String())
+	require.NoError(t, err)
+	m.ica.EXPECT().GetAllInterchainAccounts(gomock.Any()).Return([]icagenesistypes.RegisteredInterchainAccount{
+		{ConnectionId: "connection-0", PortId: portID, AccountAddress: "host"},
+	})
+	token := common.HexToAddress("0x00000000000000000000000000000000000e2c20")
+	m.erc20.EXPECT().GetTokenPairs(gomock.Any()).Return([]erc20types.TokenPair{
+		{Erc20Address: token.Hex(), Denom: "erc20/token", Enabled: true, ContractOwner: erc20types.OWNER_EXTERNAL},
+	})
+	m.evm.EXPECT().ApplyMessage(gomock.Any(), gomock.Any(), erc20Call(t, common.BytesToAddress(addr), token, "balanceOf", common.BytesToAddress(addr)), gomock.Any(), false, false, true).
+		Return(erc20Return(t, "balanceOf", big.NewInt(5)), nil)
+
+	resp, err := qs.MigrationEstimate(f.ctx, &types.QueryMigrationEstimateRequest{LegacyAddress: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.WasmAdminCount)
+	require.Equal(t, uint64(1), resp.GovDepositCount)
+	require.Equal(t, uint64(1), resp.GovVoteCount)
+	require.Equal(t, uint64(1), resp.GroupMembershipCount)
+	require.Equal(t, uint64(2), resp.GroupAdminCount)
+	require.Equal(t, uint64(1), resp.IcaControllerCount)
+	require.Equal(t, uint64(1), resp.Erc20BalanceCount)
+	require.Equal(t, uint64(9), resp.TotalTouched)
+	require.True(t, resp.WouldSucceed)
+}
+
 // TestQueryMigrationEstimate_AlreadyMigrated verifies that already-migrated addresses
 // are reported as would_succeed=false.
 func TestQueryMigrationEstimate_AlreadyMigrated(t *testing.T) {
@@ -18,13 +18,24 @@ import (
 	time "time"
 
 	address "cosmossdk.io/core/address"
+	types "cosmossdk.io/store/types"
 	feegrant "cosmossdk.io/x/feegrant"
-	types "github.com/LumeraProtocol/lumera/x/action/v1/types"
-	types0 "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
-	types1 "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/CosmWasm/wasmd/x/wasm/types"
+	types1 "github.com/LumeraProtocol/lumera/x/action/v1/types"
+	types2 "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+	types3 "github.com/cosmos/cosmos-sdk/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
-	types2 "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types4 "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	group "github.com/cosmos/cosmos-sdk/x/group"
+	types5 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types6 "github.com/cosmos/evm/x/erc20/types"
+	statedb "github.com/cosmos/evm/x/vm/statedb"
+	types7 "github.com/cosmos/evm/x/vm/types"
+	types8 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	common "github.com/ethereum/go-ethereum/common"
+	core "github.com/ethereum/go-ethereum/core"
+	tracing "github.com/ethereum/go-ethereum/core/tracing"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -67,10 +78,10 @@ func (mr *MockAccountKeeperMockRecorder) AddressCodec() *gomock.Call {
 }
 
 // GetAccount mocks base method.
-func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types1.AccAddress) types1.AccountI {
+func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types3.AccAddress) types3.AccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
-	ret0, _ := ret[0].(types1.AccountI)
+	ret0, _ := ret[0].(types3.AccountI)
 	return ret0
 }
 
@@ -81,7 +92,7 @@ func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr any) *gomock.Call
 }
 
 // IterateAccounts mocks base method.
-func (m *MockAccountKeeper) IterateAccounts(ctx context.Context, cb func(types1.AccountI) bool) {
+func (m *MockAccountKeeper) IterateAccounts(ctx context.Context, cb func(types3.AccountI) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateAccounts", ctx, cb)
 }
@@ -93,10 +104,10 @@ func (mr *MockAccountKeeperMockRecorder) IterateAccounts(ctx, cb any) *gomock.Ca
 }
 
 // NewAccountWithAddress mocks base method.
-func (m *MockAccountKeeper) NewAccountWithAddress(ctx context.Context, addr types1.AccAddress) types1.AccountI {
+func (m *MockAccountKeeper) NewAccountWithAddress(ctx context.Context, addr types3.AccAddress) types3.AccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewAccountWithAddress", ctx, addr)
-	ret0, _ := ret[0].(types1.AccountI)
+	ret0, _ := ret[0].(types3.AccountI)
 	return ret0
 }
 
@@ -107,7 +118,7 @@ func (mr *MockAccountKeeperMockRecorder) NewAccountWithAddress(ctx, addr any) *g
 }
 
 // RemoveAccount mocks base method.
-func (m *MockAccountKeeper) RemoveAccount(ctx context.Context, acc types1.AccountI) {
+func (m *MockAccountKeeper) RemoveAccount(ctx context.Context, acc types3.AccountI) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "RemoveAccount", ctx, acc)
 }
@@ -119,7 +130,7 @@ func (mr *MockAccountKeeperMockRecorder) RemoveAccount(ctx, acc any) *gomock.Cal
 }
 
 // SetAccount mocks base method.
-func (m *MockAccountKeeper) SetAccount(ctx context.Context, acc types1.AccountI) {
+func (m *MockAccountKeeper) SetAccount(ctx context.Context, acc types3.AccountI) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetAccount", ctx, acc)
 }
@@ -155,7 +166,7 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // BlockedAddr mocks base method.
-func (m *MockBankKeeper) BlockedAddr(addr types1.AccAddress) bool {
+func (m *MockBankKeeper) BlockedAddr(addr types3.AccAddress) bool {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BlockedAddr", addr)
 	ret0, _ := ret[0].(bool)
@@ -169,10 +180,10 @@ func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr any) *gomock.Call {
 }
 
 // GetAllBalances mocks base method.
-func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types1.AccAddress) types1.Coins {
+func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types3.AccAddress) types3.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
-	ret0, _ := ret[0].(types1.Coins)
+	ret0, _ := ret[0].(types3.Coins)
 	return ret0
 }
 
@@ -183,7 +194,7 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call
 }
 
 // SendCoins mocks base method.
-func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types1.AccAddress, amt types1.Coins) error {
+func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types3.AccAddress, amt types3.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoins", ctx, fromAddr, toAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -236,7 +247,7 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx any) *gomock.Call {
 }
 
 // DeleteLastValidatorPower mocks base method.
-func (m *MockStakingKeeper) DeleteLastValidatorPower(ctx context.Context, operator types1.ValAddress) error {
+func (m *MockStakingKeeper) DeleteLastValidatorPower(ctx context.Context, operator types3.ValAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteLastValidatorPower", ctx, operator)
 	ret0, _ := ret[0].(error)
@@ -250,7 +261,7 @@ func (mr *MockStakingKeeperMockRecorder) DeleteLastValidatorPower(ctx, operator
 }
 
 // DeleteValidatorByPowerIndex mocks base method.
-func (m *MockStakingKeeper) DeleteValidatorByPowerIndex(ctx context.Context, validator types3.Validator) error {
+func (m *MockStakingKeeper) DeleteValidatorByPowerIndex(ctx context.Context, validator types5.Validator) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteValidatorByPowerIndex", ctx, validator)
 	ret0, _ := ret[0].(error)
@@ -264,10 +275,10 @@ func (mr *MockStakingKeeperMockRecorder) DeleteValidatorByPowerIndex(ctx, valida
 }
 
 // GetDelegatorDelegations mocks base method.
-func (m *MockStakingKeeper) GetDelegatorDelegations(ctx context.Context, delegator types1.AccAddress, maxRetrieve uint16) ([]types3.Delegation, error) {
+func (m *MockStakingKeeper) GetDelegatorDelegations(ctx context.Context, delegator types3.AccAddress, maxRetrieve uint16) ([]types5.Delegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDelegatorDelegations", ctx, delegator, maxRetrieve)
-	ret0, _ := ret[0].([]types3.Delegation)
+	ret0, _ := ret[0].([]types5.Delegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -279,7 +290,7 @@ func (mr *MockStakingKeeperMockRecorder) GetDelegatorDelegations(ctx, delegator,
 }
 
 // GetLastValidatorPower mocks base method.
-func (m *MockStakingKeeper) GetLastValidatorPower(ctx context.Context, operator types1.ValAddress) (int64, error) {
+func (m *MockStakingKeeper) GetLastValidatorPower(ctx context.Context, operator types3.ValAddress) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLastValidatorPower", ctx, operator)
 	ret0, _ := ret[0].(int64)
@@ -294,10 +305,10 @@ func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator any
 }
 
 // GetRedelegations mocks base method.
-func (m *MockStakingKeeper) GetRedelegations(ctx context.Context, delegator types1.AccAddress, maxRetrieve uint16) ([]types3.Redelegation, error) {
+func (m *MockStakingKeeper) GetRedelegations(ctx context.Context, delegator types3.AccAddress, maxRetrieve uint16) ([]types5.Redelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRedelegations", ctx, delegator, maxRetrieve)
-	ret0, _ := ret[0].([]types3.Redelegation)
+	ret0, _ := ret[0].([]types5.Redelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -309,10 +320,10 @@ func (mr *MockStakingKeeperMockRecorder) GetRedelegations(ctx, delegator, maxRet
 }
 
 // GetRedelegationsFromSrcValidator mocks base method.
-func (m *MockStakingKeeper) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr types1.ValAddress) ([]types3.Redelegation, error) {
+func (m *MockStakingKeeper) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr types3.ValAddress) ([]types5.Redelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRedelegationsFromSrcValidator", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.Redelegation)
+	ret0, _ := ret[0].([]types5.Redelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -324,10 +335,10 @@ func (mr *MockStakingKeeperMockRecorder) GetRedelegationsFromSrcValidator(ctx, v
 }
 
 // GetUnbondingDelegation mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegation(ctx context.Context, delAddr types1.AccAddress, valAddr types1.ValAddress) (types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegation(ctx context.Context, delAddr types3.AccAddress, valAddr types3.ValAddress) (types5.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegation", ctx, delAddr, valAddr)
-	ret0, _ := ret[0].(types3.UnbondingDelegation)
+	ret0, _ := ret[0].(types5.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -339,10 +350,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegation(ctx, delAddr, va
 }
 
 // GetUnbondingDelegations mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegations(ctx context.Context, delegator types1.AccAddress, maxRetrieve uint16) ([]types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegations(ctx context.Context, delegator types3.AccAddress, maxRetrieve uint16) ([]types5.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegations", ctx, delegator, maxRetrieve)
-	ret0, _ := ret[0].([]types3.UnbondingDelegation)
+	ret0, _ := ret[0].([]types5.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -354,10 +365,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegations(ctx, delegator,
 }
 
 // GetUnbondingDelegationsFromValidator mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr types1.ValAddress) ([]types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr types3.ValAddress) ([]types5.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegationsFromValidator", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.UnbondingDelegation)
+	ret0, _ := ret[0].([]types5.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -369,10 +380,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegationsFromValidator(ct
 }
 
 // GetValidator mocks base method.
-func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types3.ValAddress) (types5.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types5.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -384,10 +395,10 @@ func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr any) *gomock.Cal
 }
 
 // GetValidatorDelegations mocks base method.
-func (m *MockStakingKeeper) GetValidatorDelegations(ctx context.Context, valAddr types1.ValAddress) ([]types3.Delegation, error) {
+func (m *MockStakingKeeper) GetValidatorDelegations(ctx context.Context, valAddr types3.ValAddress) ([]types5.Delegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorDelegations", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.Delegation)
+	ret0, _ := ret[0].([]types5.Delegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -399,7 +410,7 @@ func (mr *MockStakingKeeperMockRecorder) GetValidatorDelegations(ctx, valAddr an
 }
 
 // InsertRedelegationQueue mocks base method.
-func (m *MockStakingKeeper) InsertRedelegationQueue(ctx context.Context, red types3.Redelegation, completionTime time.Time) error {
+func (m *MockStakingKeeper) InsertRedelegationQueue(ctx context.Context, red types5.Redelegation, completionTime time.Time) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertRedelegationQueue", ctx, red, completionTime)
 	ret0, _ := ret[0].(error)
@@ -413,7 +424,7 @@ func (mr *MockStakingKeeperMockRecorder) InsertRedelegationQueue(ctx, red, compl
 }
 
 // InsertUBDQueue mocks base method.
-func (m *MockStakingKeeper) InsertUBDQueue(ctx context.Context, ubd types3.UnbondingDelegation, completionTime time.Time) error {
+func (m *MockStakingKeeper) InsertUBDQueue(ctx context.Context, ubd types5.UnbondingDelegation, completionTime time.Time) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertUBDQueue", ctx, ubd, completionTime)
 	ret0, _ := ret[0].(error)
@@ -427,7 +438,7 @@ func (mr *MockStakingKeeperMockRecorder) InsertUBDQueue(ctx, ubd, completionTime
 }
 
 // IterateRedelegations mocks base method.
-func (m *MockStakingKeeper) IterateRedelegations(ctx context.Context, fn func(int64, types3.Redelegation) bool) error {
+func (m *MockStakingKeeper) IterateRedelegations(ctx context.Context, fn func(int64, types5.Redelegation) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateRedelegations", ctx, fn)
 	ret0, _ := ret[0].(error)
@@ -441,7 +452,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateRedelegations(ctx, fn any) *gomo
 }
 
 // RemoveDelegation mocks base method.
-func (m *MockStakingKeeper) RemoveDelegation(ctx context.Context, delegation types3.Delegation) error {
+func (m *MockStakingKeeper) RemoveDelegation(ctx context.Context, delegation types5.Delegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveDelegation", ctx, delegation)
 	ret0, _ := ret[0].(error)
@@ -455,7 +466,7 @@ func (mr *MockStakingKeeperMockRecorder) RemoveDelegation(ctx, delegation any) *
 }
 
 // RemoveRedelegation mocks base method.
-func (m *MockStakingKeeper) RemoveRedelegation(ctx context.Context, red types3.Redelegation) error {
+func (m *MockStakingKeeper) RemoveRedelegation(ctx context.Context, red types5.Redelegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveRedelegation", ctx, red)
 	ret0, _ := ret[0].(error)
@@ -469,7 +480,7 @@ func (mr *MockStakingKeeperMockRecorder) RemoveRedelegation(ctx, red any) *gomoc
 }
 
 // RemoveUnbondingDelegation mocks base method.
-func (m *MockStakingKeeper) RemoveUnbondingDelegation(ctx context.Context, ubd types3.UnbondingDelegation) error {
+func (m *MockStakingKeeper) RemoveUnbondingDelegation(ctx context.Context, ubd types5.UnbondingDelegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveUnbondingDelegation", ctx, ubd)
 	ret0, _ := ret[0].(error)
@@ -483,7 +494,7 @@ func (mr *MockStakingKeeperMockRecorder) RemoveUnbondingDelegation(ctx, ubd any)
 }
 
 // SetDelegation mocks base method.
-func (m *MockStakingKeeper) SetDelegation(ctx context.Context, delegation types3.Delegation) error {
+func (m *MockStakingKeeper) SetDelegation(ctx context.Context, delegation types5.Delegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetDelegation", ctx, delegation)
 	ret0, _ := ret[0].(error)
@@ -497,7 +508,7 @@ func (mr *MockStakingKeeperMockRecorder) SetDelegation(ctx, delegation any) *gom
 }
 
 // SetLastValidatorPower mocks base method.
-func (m *MockStakingKeeper) SetLastValidatorPower(ctx context.Context, operator types1.ValAddress, power int64) error {
+func (m *MockStakingKeeper) SetLastValidatorPower(ctx context.Context, operator types3.ValAddress, power int64) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetLastValidatorPower", ctx, operator, power)
 	ret0, _ := ret[0].(error)
@@ -511,7 +522,7 @@ func (mr *MockStakingKeeperMockRecorder) SetLastValidatorPower(ctx, operator, po
 }
 
 // SetRedelegation mocks base method.
-func (m *MockStakingKeeper) SetRedelegation(ctx context.Context, red types3.Redelegation) error {
+func (m *MockStakingKeeper) SetRedelegation(ctx context.Context, red types5.Redelegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetRedelegation", ctx, red)
 	ret0, _ := ret[0].(error)
@@ -525,7 +536,7 @@ func (mr *MockStakingKeeperMockRecorder) SetRedelegation(ctx, red any) *gomock.C
 }
 
 // SetRedelegationByUnbondingID mocks base method.
-func (m *MockStakingKeeper) SetRedelegationByUnbondingID(ctx context.Context, red types3.Redelegation, id uint64) error {
+func (m *MockStakingKeeper) SetRedelegationByUnbondingID(ctx context.Context, red types5.Redelegation, id uint64) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetRedelegationByUnbondingID", ctx, red, id)
 	ret0, _ := ret[0].(error)
@@ -539,7 +550,7 @@ func (mr *MockStakingKeeperMockRecorder) SetRedelegationByUnbondingID(ctx, red,
 }
 
 // SetUnbondingDelegation mocks base method.
-func (m *MockStakingKeeper) SetUnbondingDelegation(ctx context.Context, ubd types3.UnbondingDelegation) error {
+func (m *MockStakingKeeper) SetUnbondingDelegation(ctx context.Context, ubd types5.UnbondingDelegation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetUnbondingDelegation", ctx, ubd)
 	ret0, _ := ret[0].(error)
@@ -553,7 +564,7 @@ func (mr *MockStakingKeeperMockRecorder) SetUnbondingDelegation(ctx, ubd any) *g
 }
 
 // SetUnbondingDelegationByUnbondingID mocks base method.
-func (m *MockStakingKeeper) SetUnbondingDelegationByUnbondingID(ctx context.Context, ubd types3.UnbondingDelegation, id uint64) error {
+func (m *MockStakingKeeper) SetUnbondingDelegationByUnbondingID(ctx context.Context, ubd types5.UnbondingDelegation, id uint64) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetUnbondingDelegationByUnbondingID", ctx, ubd, id)
 	ret0, _ := ret[0].(error)
@@ -567,7 +578,7 @@ func (mr *MockStakingKeeperMockRecorder) SetUnbondingDelegationByUnbondingID(ctx
 }
 
 // SetValidator mocks base method.
-func (m *MockStakingKeeper) SetValidator(ctx context.Context, validator types3.Validator) error {
+func (m *MockStakingKeeper) SetValidator(ctx context.Context, validator types5.Validator) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidator", ctx, validator)
 	ret0, _ := ret[0].(error)
@@ -581,7 +592,7 @@ func (mr *MockStakingKeeperMockRecorder) SetValidator(ctx, validator any) *gomoc
 }
 
 // SetValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) SetValidatorByConsAddr(ctx context.Context, validator types3.Validator) error {
+func (m *MockStakingKeeper) SetValidatorByConsAddr(ctx context.Context, validator types5.Validator) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorByConsAddr", ctx, validator)
 	ret0, _ := ret[0].(error)
@@ -595,7 +606,7 @@ func (mr *MockStakingKeeperMockRecorder) SetValidatorByConsAddr(ctx, validator a
 }
 
 // SetValidatorByPowerIndex mocks base method.
-func (m *MockStakingKeeper) SetValidatorByPowerIndex(ctx context.Context, validator types3.Validator) error {
+func (m *MockStakingKeeper) SetValidatorByPowerIndex(ctx context.Context, validator types5.Validator) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorByPowerIndex", ctx, validator)
 	ret0, _ := ret[0].(error)
@@ -609,10 +620,10 @@ func (mr *MockStakingKeeperMockRecorder) SetValidatorByPowerIndex(ctx, validator
 }
 
 // ValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types3.ValidatorI, error) {
+func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types3.ConsAddress) (types5.ValidatorI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
-	ret0, _ := ret[0].(types3.ValidatorI)
+	ret0, _ := ret[0].(types5.ValidatorI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -648,7 +659,7 @@ func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
 }
 
 // DeleteDelegatorStartingInfo mocks base method.
-func (m *MockDistributionKeeper) DeleteDelegatorStartingInfo(ctx context.Context, val types1.ValAddress, del types1.AccAddress) error {
+func (m *MockDistributionKeeper) DeleteDelegatorStartingInfo(ctx context.Context, val types3.ValAddress, del types3.AccAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteDelegatorStartingInfo", ctx, val, del)
 	ret0, _ := ret[0].(error)
@@ -662,7 +673,7 @@ func (mr *MockDistributionKeeperMockRecorder) DeleteDelegatorStartingInfo(ctx, v
 }
 
 // DeleteValidatorAccumulatedCommission mocks base method.
-func (m *MockDistributionKeeper) DeleteValidatorAccumulatedCommission(ctx context.Context, val types1.ValAddress) error {
+func (m *MockDistributionKeeper) DeleteValidatorAccumulatedCommission(ctx context.Context, val types3.ValAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteValidatorAccumulatedCommission", ctx, val)
 	ret0, _ := ret[0].(error)
@@ -676,7 +687,7 @@ func (mr *MockDistributionKeeperMockRecorder) DeleteValidatorAccumulatedCommissi
 }
 
 // DeleteValidatorCurrentRewards mocks base method.
-func (m *MockDistributionKeeper) DeleteValidatorCurrentRewards(ctx context.Context, val types1.ValAddress) error {
+func (m *MockDistributionKeeper) DeleteValidatorCurrentRewards(ctx context.Context, val types3.ValAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteValidatorCurrentRewards", ctx, val)
 	ret0, _ := ret[0].(error)
@@ -690,7 +701,7 @@ func (mr *MockDistributionKeeperMockRecorder) DeleteValidatorCurrentRewards(ctx,
 }
 
 // DeleteValidatorHistoricalRewards mocks base method.
-func (m *MockDistributionKeeper) DeleteValidatorHistoricalRewards(ctx context.Context, val types1.ValAddress) {
+func (m *MockDistributionKeeper) DeleteValidatorHistoricalRewards(ctx context.Context, val types3.ValAddress) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "DeleteValidatorHistoricalRewards", ctx, val)
 }
@@ -702,7 +713,7 @@ func (mr *MockDistributionKeeperMockRecorder) DeleteValidatorHistoricalRewards(c
 }
 
 // DeleteValidatorOutstandingRewards mocks base method.
-func (m *MockDistributionKeeper) DeleteValidatorOutstandingRewards(ctx context.Context, val types1.ValAddress) error {
+func (m *MockDistributionKeeper) DeleteValidatorOutstandingRewards(ctx context.Context, val types3.ValAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteValidatorOutstandingRewards", ctx, val)
 	ret0, _ := ret[0].(error)
@@ -716,7 +727,7 @@ func (mr *MockDistributionKeeperMockRecorder) DeleteValidatorOutstandingRewards(
 }
 
 // DeleteValidatorSlashEvents mocks base method.
-func (m *MockDistributionKeeper) DeleteValidatorSlashEvents(ctx context.Context, val types1.ValAddress) {
+func (m *MockDistributionKeeper) DeleteValidatorSlashEvents(ctx context.Context, val types3.ValAddress) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "DeleteValidatorSlashEvents", ctx, val)
 }
@@ -728,10 +739,10 @@ func (mr *MockDistributionKeeperMockRecorder) DeleteValidatorSlashEvents(ctx, va
 }
 
 // GetDelegatorStartingInfo mocks base method.
-func (m *MockDistributionKeeper) GetDelegatorStartingInfo(ctx context.Context, val types1.ValAddress, del types1.AccAddress) (types2.DelegatorStartingInfo, error) {
+func (m *MockDistributionKeeper) GetDelegatorStartingInfo(ctx context.Context, val types3.ValAddress, del types3.AccAddress) (types4.DelegatorStartingInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDelegatorStartingInfo", ctx, val, del)
-	ret0, _ := ret[0].(types2.DelegatorStartingInfo)
+	ret0, _ := ret[0].(types4.DelegatorStartingInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -743,10 +754,10 @@ func (mr *MockDistributionKeeperMockRecorder) GetDelegatorStartingInfo(ctx, val,
 }
 
 // GetDelegatorWithdrawAddr mocks base method.
-func (m *MockDistributionKeeper) GetDelegatorWithdrawAddr(ctx context.Context, delAddr types1.AccAddress) (types1.AccAddress, error) {
+func (m *MockDistributionKeeper) GetDelegatorWithdrawAddr(ctx context.Context, delAddr types3.AccAddress) (types3.AccAddress, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDelegatorWithdrawAddr", ctx, delAddr)
-	ret0, _ := ret[0].(types1.AccAddress)
+	ret0, _ := ret[0].(types3.AccAddress)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -758,10 +769,10 @@ func (mr *MockDistributionKeeperMockRecorder) GetDelegatorWithdrawAddr(ctx, delA
 }
 
 // GetValidatorAccumulatedCommission mocks base method.
-func (m *MockDistributionKeeper) GetValidatorAccumulatedCommission(ctx context.Context, val types1.ValAddress) (types2.ValidatorAccumulatedCommission, error) {
+func (m *MockDistributionKeeper) GetValidatorAccumulatedCommission(ctx context.Context, val types3.ValAddress) (types4.ValidatorAccumulatedCommission, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorAccumulatedCommission", ctx, val)
-	ret0, _ := ret[0].(types2.ValidatorAccumulatedCommission)
+	ret0, _ := ret[0].(types4.ValidatorAccumulatedCommission)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -773,10 +784,10 @@ func (mr *MockDistributionKeeperMockRecorder) GetValidatorAccumulatedCommission(
 }
 
 // GetValidatorCurrentRewards mocks base method.
-func (m *MockDistributionKeeper) GetValidatorCurrentRewards(ctx context.Context, val types1.ValAddress) (types2.ValidatorCurrentRewards, error) {
+func (m *MockDistributionKeeper) GetValidatorCurrentRewards(ctx context.Context, val types3.ValAddress) (types4.ValidatorCurrentRewards, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorCurrentRewards", ctx, val)
-	ret0, _ := ret[0].(types2.ValidatorCurrentRewards)
+	ret0, _ := ret[0].(types4.ValidatorCurrentRewards)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -788,10 +799,10 @@ func (mr *MockDistributionKeeperMockRecorder) GetValidatorCurrentRewards(ctx, va
 }
 
 // GetValidatorOutstandingRewards mocks base method.
-func (m *MockDistributionKeeper) GetValidatorOutstandingRewards(ctx context.Context, val types1.ValAddress) (types2.ValidatorOutstandingRewards, error) {
+func (m *MockDistributionKeeper) GetValidatorOutstandingRewards(ctx context.Context, val types3.ValAddress) (types4.ValidatorOutstandingRewards, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorOutstandingRewards", ctx, val)
-	ret0, _ := ret[0].(types2.ValidatorOutstandingRewards)
+	ret0, _ := ret[0].(types4.ValidatorOutstandingRewards)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -803,7 +814,7 @@ func (mr *MockDistributionKeeperMockRecorder) GetValidatorOutstandingRewards(ctx
 }
 
 // IterateValidatorHistoricalRewards mocks base method.
-func (m *MockDistributionKeeper) IterateValidatorHistoricalRewards(ctx context.Context, handler func(types1.ValAddress, uint64, types2.ValidatorHistoricalRewards) bool) {
+func (m *MockDistributionKeeper) IterateValidatorHistoricalRewards(ctx context.Context, handler func(types3.ValAddress, uint64, types4.ValidatorHistoricalRewards) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateValidatorHistoricalRewards", ctx, handler)
 }
@@ -815,7 +826,7 @@ func (mr *MockDistributionKeeperMockRecorder) IterateValidatorHistoricalRewards(
 }
 
 // IterateValidatorSlashEvents mocks base method.
-func (m *MockDistributionKeeper) IterateValidatorSlashEvents(ctx context.Context, handler func(types1.ValAddress, uint64, types2.ValidatorSlashEvent) bool) {
+func (m *MockDistributionKeeper) IterateValidatorSlashEvents(ctx context.Context, handler func(types3.ValAddress, uint64, types4.ValidatorSlashEvent) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateValidatorSlashEvents", ctx, handler)
 }
@@ -827,7 +838,7 @@ func (mr *MockDistributionKeeperMockRecorder) IterateValidatorSlashEvents(ctx, h
 }
 
 // SetDelegatorStartingInfo mocks base method.
-func (m *MockDistributionKeeper) SetDelegatorStartingInfo(ctx context.Context, val types1.ValAddress, del types1.AccAddress, period types2.DelegatorStartingInfo) error {
+func (m *MockDistributionKeeper) SetDelegatorStartingInfo(ctx context.Context, val types3.ValAddress, del types3.AccAddress, period types4.DelegatorStartingInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetDelegatorStartingInfo", ctx, val, del, period)
 	ret0, _ := ret[0].(error)
@@ -841,7 +852,7 @@ func (mr *MockDistributionKeeperMockRecorder) SetDelegatorStartingInfo(ctx, val,
 }
 
 // SetDelegatorWithdrawAddr mocks base method.
-func (m *MockDistributionKeeper) SetDelegatorWithdrawAddr(ctx context.Context, delAddr, withdrawAddr types1.AccAddress) error {
+func (m *MockDistributionKeeper) SetDelegatorWithdrawAddr(ctx context.Context, delAddr, withdrawAddr types3.AccAddress) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetDelegatorWithdrawAddr", ctx, delAddr, withdrawAddr)
 	ret0, _ := ret[0].(error)
@@ -855,7 +866,7 @@ func (mr *MockDistributionKeeperMockRecorder) SetDelegatorWithdrawAddr(ctx, delA
 }
 
 // SetValidatorAccumulatedCommission mocks base method.
-func (m *MockDistributionKeeper) SetValidatorAccumulatedCommission(ctx context.Context, val types1.ValAddress, commission types2.ValidatorAccumulatedCommission) error {
+func (m *MockDistributionKeeper) SetValidatorAccumulatedCommission(ctx context.Context, val types3.ValAddress, commission types4.ValidatorAccumulatedCommission) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorAccumulatedCommission", ctx, val, commission)
 	ret0, _ := ret[0].(error)
@@ -869,7 +880,7 @@ func (mr *MockDistributionKeeperMockRecorder) SetValidatorAccumulatedCommission(
 }
 
 // SetValidatorCurrentRewards mocks base method.
-func (m *MockDistributionKeeper) SetValidatorCurrentRewards(ctx context.Context, val types1.ValAddress, rewards types2.ValidatorCurrentRewards) error {
+func (m *MockDistributionKeeper) SetValidatorCurrentRewards(ctx context.Context, val types3.ValAddress, rewards types4.ValidatorCurrentRewards) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorCurrentRewards", ctx, val, rewards)
 	ret0, _ := ret[0].(error)
@@ -883,7 +894,7 @@ func (mr *MockDistributionKeeperMockRecorder) SetValidatorCurrentRewards(ctx, va
 }
 
 // SetValidatorHistoricalRewards mocks base method.
-func (m *MockDistributionKeeper) SetValidatorHistoricalRewards(ctx context.Context, val types1.ValAddress, period uint64, rewards types2.ValidatorHistoricalRewards) error {
+func (m *MockDistributionKeeper) SetValidatorHistoricalRewards(ctx context.Context, val types3.ValAddress, period uint64, rewards types4.ValidatorHistoricalRewards) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorHistoricalRewards", ctx, val, period, rewards)
 	ret0, _ := ret[0].(error)
@@ -897,7 +908,7 @@ func (mr *MockDistributionKeeperMockRecorder) SetValidatorHistoricalRewards(ctx,
 }
 
 // SetValidatorOutstandingRewards mocks base method.
-func (m *MockDistributionKeeper) SetValidatorOutstandingRewards(ctx context.Context, val types1.ValAddress, rewards types2.ValidatorOutstandingRewards) error {
+func (m *MockDistributionKeeper) SetValidatorOutstandingRewards(ctx context.Context, val types3.ValAddress, rewards types4.ValidatorOutstandingRewards) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorOutstandingRewards", ctx, val, rewards)
 	ret0, _ := ret[0].(error)
@@ -911,7 +922,7 @@ func (mr *MockDistributionKeeperMockRecorder) SetValidatorOutstandingRewards(ctx
 }
 
 // SetValidatorSlashEvent mocks base method.
-func (m *MockDistributionKeeper) SetValidatorSlashEvent(ctx context.Context, val types1.ValAddress, height, period uint64, event types2.ValidatorSlashEvent) error {
+func (m *MockDistributionKeeper) SetValidatorSlashEvent(ctx context.Context, val types3.ValAddress, height, period uint64, event types4.ValidatorSlashEvent) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorSlashEvent", ctx, val, height, period, event)
 	ret0, _ := ret[0].(error)
@@ -925,10 +936,10 @@ func (mr *MockDistributionKeeperMockRecorder) SetValidatorSlashEvent(ctx, val, h
 }
 
 // WithdrawDelegationRewards mocks base method.
-func (m *MockDistributionKeeper) WithdrawDelegationRewards(ctx context.Context, delAddr types1.AccAddress, valAddr types1.ValAddress) (types1.Coins, error) {
+func (m *MockDistributionKeeper) WithdrawDelegationRewards(ctx context.Context, delAddr types3.AccAddress, valAddr types3.ValAddress) (types3.Coins, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WithdrawDelegationRewards", ctx, delAddr, valAddr)
-	ret0, _ := ret[0].(types1.Coins)
+	ret0, _ := ret[0].(types3.Coins)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -940,10 +951,10 @@ func (mr *MockDistributionKeeperMockRecorder) WithdrawDelegationRewards(ctx, del
 }
 
 // WithdrawValidatorCommission mocks base method.
-func (m *MockDistributionKeeper) WithdrawValidatorCommission(ctx context.Context, valAddr types1.ValAddress) (types1.Coins, error) {
+func (m *MockDistributionKeeper) WithdrawValidatorCommission(ctx context.Context, valAddr types3.ValAddress) (types3.Coins, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WithdrawValidatorCommission", ctx, valAddr)
-	ret0, _ := ret[0].(types1.Coins)
+	ret0, _ := ret[0].(types3.Coins)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -979,7 +990,7 @@ func (m *MockAuthzKeeper) EXPECT() *MockAuthzKeeperMockRecorder {
 }
 
 // DeleteGrant mocks base method.
-func (m *MockAuthzKeeper) DeleteGrant(ctx context.Context, grantee, granter types1.AccAddress, msgType string) error {
+func (m *MockAuthzKeeper) DeleteGrant(ctx context.Context, grantee, granter types3.AccAddress, msgType string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteGrant", ctx, grantee, granter, msgType)
 	ret0, _ := ret[0].(error)
@@ -993,7 +1004,7 @@ func (mr *MockAuthzKeeperMockRecorder) DeleteGrant(ctx, grantee, granter, msgTyp
 }
 
 // GetAuthorizations mocks base method.
-func (m *MockAuthzKeeper) GetAuthorizations(ctx context.Context, grantee, granter types1.AccAddress) ([]authz.Authorization, error) {
+func (m *MockAuthzKeeper) GetAuthorizations(ctx context.Context, grantee, granter types3.AccAddress) ([]authz.Authorization, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAuthorizations", ctx, grantee, granter)
 	ret0, _ := ret[0].([]authz.Authorization)
@@ -1008,7 +1019,7 @@ func (mr *MockAuthzKeeperMockRecorder) GetAuthorizations(ctx, grantee, granter a
 }
 
 // IterateGrants mocks base method.
-func (m *MockAuthzKeeper) IterateGrants(ctx context.Context, handler func(types1.AccAddress, types1.AccAddress, authz.Grant) bool) {
+func (m *MockAuthzKeeper) IterateGrants(ctx context.Context, handler func(types3.AccAddress, types3.AccAddress, authz.Grant) bool) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "IterateGrants", ctx, handler)
 }
@@ -1020,7 +1031,7 @@ func (mr *MockAuthzKeeperMockRecorder) IterateGrants(ctx, handler any) *gomock.C
 }
 
 // SaveGrant mocks base method.
-func (m *MockAuthzKeeper) SaveGrant(ctx context.Context, grantee, granter types1.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+func (m *MockAuthzKeeper) SaveGrant(ctx context.Context, grantee, granter types3.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveGrant", ctx, grantee, granter, authorization, expiration)
 	ret0, _ := ret[0].(error)
@@ -1058,7 +1069,7 @@ func (m *MockFeegrantKeeper) EXPECT() *MockFeegrantKeeperMockRecorder {
 }
 
 // GrantAllowance mocks base method.
-func (m *MockFeegrantKeeper) GrantAllowance(ctx context.Context, granter, grantee types1.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+func (m *MockFeegrantKeeper) GrantAllowance(ctx context.Context, granter, grantee types3.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GrantAllowance", ctx, granter, grantee, feeAllowance)
 	ret0, _ := ret[0].(error)
@@ -1110,7 +1121,7 @@ func (m *MockSupernodeKeeper) EXPECT() *MockSupernodeKeeperMockRecorder {
 }
 
 // DeleteMetricsState mocks base method.
-func (m *MockSupernodeKeeper) DeleteMetricsState(ctx types1.Context, valAddr types1.ValAddress) {
+func (m *MockSupernodeKeeper) DeleteMetricsState(ctx types3.Context, valAddr types3.ValAddress) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "DeleteMetricsState", ctx, valAddr)
 }
@@ -1122,7 +1133,7 @@ func (mr *MockSupernodeKeeperMockRecorder) DeleteMetricsState(ctx, valAddr any)
 }
 
 // DeleteSuperNode mocks base method.
-func (m *MockSupernodeKeeper) DeleteSuperNode(ctx types1.Context, valAddr types1.ValAddress) {
+func (m *MockSupernodeKeeper) DeleteSuperNode(ctx types3.Context, valAddr types3.ValAddress) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "DeleteSuperNode", ctx, valAddr)
 }
@@ -1134,10 +1145,10 @@ func (mr *MockSupernodeKeeperMockRecorder) DeleteSuperNode(ctx, valAddr any) *go
 }
 
 // GetMetricsState mocks base method.
-func (m *MockSupernodeKeeper) GetMetricsState(ctx types1.Context, valAddr types1.ValAddress) (types0.SupernodeMetricsState, bool) {
+func (m *MockSupernodeKeeper) GetMetricsState(ctx types3.Context, valAddr types3.ValAddress) (types2.SupernodeMetricsState, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetMetricsState", ctx, valAddr)
-	ret0, _ := ret[0].(types0.SupernodeMetricsState)
+	ret0, _ := ret[0].(types2.SupernodeMetricsState)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -1149,10 +1160,10 @@ func (mr *MockSupernodeKeeperMockRecorder) GetMetricsState(ctx, valAddr any) *go
 }
 
 // GetSuperNodeByAccount mocks base method.
-func (m *MockSupernodeKeeper) GetSuperNodeByAccount(ctx types1.Context, supernodeAccount string) (types0.SuperNode, bool, error) {
+func (m *MockSupernodeKeeper) GetSuperNodeByAccount(ctx types3.Context, supernodeAccount string) (types2.SuperNode, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSuperNodeByAccount", ctx, supernodeAccount)
-	ret0, _ := ret[0].(types0.SuperNode)
+	ret0, _ := ret[0].(types2.SuperNode)
 	ret1, _ := ret[1].(bool)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -1165,10 +1176,10 @@ func (mr *MockSupernodeKeeperMockRecorder) GetSuperNodeByAccount(ctx, supernodeA
 }
 
 // QuerySuperNode mocks base method.
-func (m *MockSupernodeKeeper) QuerySuperNode(ctx types1.Context, valOperAddr types1.ValAddress) (types0.SuperNode, bool) {
+func (m *MockSupernodeKeeper) QuerySuperNode(ctx types3.Context, valOperAddr types3.ValAddress) (types2.SuperNode, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QuerySuperNode", ctx, valOperAddr)
-	ret0, _ := ret[0].(types0.SuperNode)
+	ret0, _ := ret[0].(types2.SuperNode)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -1180,7 +1191,7 @@ func (mr *MockSupernodeKeeperMockRecorder) QuerySuperNode(ctx, valOperAddr any)
 }
 
 // SetMetricsState mocks base method.
-func (m *MockSupernodeKeeper) SetMetricsState(ctx types1.Context, state types0.SupernodeMetricsState) error {
+func (m *MockSupernodeKeeper) SetMetricsState(ctx types3.Context, state types2.SupernodeMetricsState) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetMetricsState", ctx, state)
 	ret0, _ := ret[0].(error)
@@ -1194,7 +1205,7 @@ func (mr *MockSupernodeKeeperMockRecorder) SetMetricsState(ctx, state any) *gomo
 }
 
 // SetSuperNode mocks base method.
-func (m *MockSupernodeKeeper) SetSuperNode(ctx types1.Context, supernode types0.SuperNode) error {
+func (m *MockSupernodeKeeper) SetSuperNode(ctx types3.Context, supernode types2.SuperNode) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetSuperNode", ctx, supernode)
 	ret0, _ := ret[0].(error)
@@ -1232,10 +1243,10 @@ func (m *MockActionKeeper) EXPECT() *MockActionKeeperMockRecorder {
 }
 
 // GetActionByID mocks base method.
-func (m *MockActionKeeper) GetActionByID(ctx types1.Context, actionID string) (*types.Action, bool) {
+func (m *MockActionKeeper) GetActionByID(ctx types3.Context, actionID string) (*types1.Action, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetActionByID", ctx, actionID)
-	ret0, _ := ret[0].(*types.Action)
+	ret0, _ := ret[0].(*types1.Action)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -1247,10 +1258,10 @@ func (mr *MockActionKeeperMockRecorder) GetActionByID(ctx, actionID any) *gomock
 }
 
 // GetActionsByCreator mocks base method.
-func (m *MockActionKeeper) GetActionsByCreator(ctx types1.Context, creator string) ([]*types.Action, error) {
+func (m *MockActionKeeper) GetActionsByCreator(ctx types3.Context, creator string) ([]*types1.Action, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetActionsByCreator", ctx, creator)
-	ret0, _ := ret[0].([]*types.Action)
+	ret0, _ := ret[0].([]*types1.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1262,10 +1273,10 @@ func (mr *MockActionKeeperMockRecorder) GetActionsByCreator(ctx, creator any) *g
 }
 
 // GetActionsBySuperNode mocks base method.
-func (m *MockActionKeeper) GetActionsBySuperNode(ctx types1.Context, supernode string) ([]*types.Action, error) {
+func (m *MockActionKeeper) GetActionsBySuperNode(ctx types3.Context, supernode string) ([]*types1.Action, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetActionsBySuperNode", ctx, supernode)
-	ret0, _ := ret[0].([]*types.Action)
+	ret0, _ := ret[0].([]*types1.Action)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1277,7 +1288,7 @@ func (mr *MockActionKeeperMockRecorder) GetActionsBySuperNode(ctx, supernode any
 }
 
 // IterateActions mocks base method.
-func (m *MockActionKeeper) IterateActions(ctx types1.Context, handler func(*types.Action) bool) error {
+func (m *MockActionKeeper) IterateActions(ctx types3.Context, handler func(*types1.Action) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateActions", ctx, handler)
 	ret0, _ := ret[0].(error)
@@ -1291,7 +1302,7 @@ func (mr *MockActionKeeperMockRecorder) IterateActions(ctx, handler any) *gomock
 }
 
 // SetAction mocks base method.
-func (m *MockActionKeeper) SetAction(ctx types1.Context, action *types.Action) error {
+func (m *MockActionKeeper) SetAction(ctx types3.Context, action *types1.Action) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetAction", ctx, action)
 	ret0, _ := ret[0].(error)
@@ -1303,3 +1314,578 @@ func (mr *MockActionKeeperMockRecorder) SetAction(ctx, action any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAction", reflect.TypeOf((*MockActionKeeper)(nil).SetAction), ctx, action)
 }
+
+// MockWasmKeeper is a mock of WasmKeeper interface.
+type MockWasmKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockWasmKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockWasmKeeperMockRecorder is the mock recorder for MockWasmKeeper.
+type MockWasmKeeperMockRecorder struct {
+	mock *MockWasmKeeper
+}
+
+// NewMockWasmKeeper creates a new mock instance.
+func NewMockWasmKeeper(ctrl *gomock.Controller) *MockWasmKeeper {
+	mock := &MockWasmKeeper{ctrl: ctrl}
+	mock.recorder = &MockWasmKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWasmKeeper) EXPECT() *MockWasmKeeperMockRecorder {
+	return m.recorder
+}
+
+// IterateContractInfo mocks base method.
+func (m *MockWasmKeeper) IterateContractInfo(ctx context.Context, cb func(types3.AccAddress, types0.ContractInfo) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateContractInfo", ctx, cb)
+}
+
+// IterateContractInfo indicates an expected call of IterateContractInfo.
+func (mr *MockWasmKeeperMockRecorder) IterateContractInfo(ctx, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateContractInfo", reflect.TypeOf((*MockWasmKeeper)(nil).IterateContractInfo), ctx, cb)
+}
+
+// UpdateContractAdmin mocks base method.
+func (m *MockWasmKeeper) UpdateContractAdmin(ctx types3.Context, contractAddress, caller, newAdmin types3.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContractAdmin", ctx, contractAddress, caller, newAdmin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateContractAdmin indicates an expected call of UpdateContractAdmin.
+func (mr *MockWasmKeeperMockRecorder) UpdateContractAdmin(ctx, contractAddress, caller, newAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContractAdmin", reflect.TypeOf((*MockWasmKeeper)(nil).UpdateContractAdmin), ctx, contractAddress, caller, newAdmin)
+}
+
+// MockGovKeeper is a mock of GovKeeper interface.
+type MockGovKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockGovKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockGovKeeperMockRecorder is the mock recorder for MockGovKeeper.
+type MockGovKeeperMockRecorder struct {
+	mock *MockGovKeeper
+}
+
+// NewMockGovKeeper creates a new mock instance.
+func NewMockGovKeeper(ctrl *gomock.Controller) *MockGovKeeper {
+	mock := &MockGovKeeper{ctrl: ctrl}
+	mock.recorder = &MockGovKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGovKeeper) EXPECT() *MockGovKeeperMockRecorder {
+	return m.recorder
+}
+
+// IterateAllDeposits mocks base method.
+func (m *MockGovKeeper) IterateAllDeposits(ctx context.Context, cb func(v1.Deposit) (bool, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateAllDeposits", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateAllDeposits indicates an expected call of IterateAllDeposits.
+func (mr *MockGovKeeperMockRecorder) IterateAllDeposits(ctx, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDeposits", reflect.TypeOf((*MockGovKeeper)(nil).IterateAllDeposits), ctx, cb)
+}
+
+// IterateAllVotes mocks base method.
+func (m *MockGovKeeper) IterateAllVotes(ctx context.Context, cb func(v1.Vote) (bool, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateAllVotes", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateAllVotes indicates an expected call of IterateAllVotes.
+func (mr *MockGovKeeperMockRecorder) IterateAllVotes(ctx, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllVotes", reflect.TypeOf((*MockGovKeeper)(nil).IterateAllVotes), ctx, cb)
+}
+
+// RemoveDeposit mocks base method.
+func (m *MockGovKeeper) RemoveDeposit(ctx context.Context, proposalID uint64, depositor types3.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDeposit", ctx, proposalID, depositor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDeposit indicates an expected call of RemoveDeposit.
+func (mr *MockGovKeeperMockRecorder) RemoveDeposit(ctx, proposalID, depositor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeposit", reflect.TypeOf((*MockGovKeeper)(nil).RemoveDeposit), ctx, proposalID, depositor)
+}
+
+// RemoveVote mocks base method.
+func (m *MockGovKeeper) RemoveVote(ctx context.Context, proposalID uint64, voter types3.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVote", ctx, proposalID, voter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVote indicates an expected call of RemoveVote.
+func (mr *MockGovKeeperMockRecorder) RemoveVote(ctx, proposalID, voter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVote", reflect.TypeOf((*MockGovKeeper)(nil).RemoveVote), ctx, proposalID, voter)
+}
+
+// SetDeposit mocks base method.
+func (m *MockGovKeeper) SetDeposit(ctx context.Context, deposit v1.Deposit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeposit", ctx, deposit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeposit indicates an expected call of SetDeposit.
+func (mr *MockGovKeeperMockRecorder) SetDeposit(ctx, deposit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeposit", reflect.TypeOf((*MockGovKeeper)(nil).SetDeposit), ctx, deposit)
+}
+
+// SetVote mocks base method.
+func (m *MockGovKeeper) SetVote(ctx context.Context, vote v1.Vote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVote", ctx, vote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVote indicates an expected call of SetVote.
+func (mr *MockGovKeeperMockRecorder) SetVote(ctx, vote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVote", reflect.TypeOf((*MockGovKeeper)(nil).SetVote), ctx, vote)
+}
+
+// MockGroupKeeper is a mock of GroupKeeper interface.
+type MockGroupKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupKeeperMockRecorder is the mock recorder for MockGroupKeeper.
+type MockGroupKeeperMockRecorder struct {
+	mock *MockGroupKeeper
+}
+
+// NewMockGroupKeeper creates a new mock instance.
+func NewMockGroupKeeper(ctrl *gomock.Controller) *MockGroupKeeper {
+	mock := &MockGroupKeeper{ctrl: ctrl}
+	mock.recorder = &MockGroupKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupKeeper) EXPECT() *MockGroupKeeperMockRecorder {
+	return m.recorder
+}
+
+// GroupMembers mocks base method.
+func (m *MockGroupKeeper) GroupMembers(ctx context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMembers", ctx, req)
+	ret0, _ := ret[0].(*group.QueryGroupMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupMembers indicates an expected call of GroupMembers.
+func (mr *MockGroupKeeperMockRecorder) GroupMembers(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMembers", reflect.TypeOf((*MockGroupKeeper)(nil).GroupMembers), ctx, req)
+}
+
+// GroupPoliciesByAdmin mocks base method.
+func (m *MockGroupKeeper) GroupPoliciesByAdmin(ctx context.Context, req *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupPoliciesByAdmin", ctx, req)
+	ret0, _ := ret[0].(*group.QueryGroupPoliciesByAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupPoliciesByAdmin indicates an expected call of GroupPoliciesByAdmin.
+func (mr *MockGroupKeeperMockRecorder) GroupPoliciesByAdmin(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupPoliciesByAdmin", reflect.TypeOf((*MockGroupKeeper)(nil).GroupPoliciesByAdmin), ctx, req)
+}
+
+// GroupsByAdmin mocks base method.
+func (m *MockGroupKeeper) GroupsByAdmin(ctx context.Context, req *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupsByAdmin", ctx, req)
+	ret0, _ := ret[0].(*group.QueryGroupsByAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupsByAdmin indicates an expected call of GroupsByAdmin.
+func (mr *MockGroupKeeperMockRecorder) GroupsByAdmin(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupsByAdmin", reflect.TypeOf((*MockGroupKeeper)(nil).GroupsByAdmin), ctx, req)
+}
+
+// GroupsByMember mocks base method.
+func (m *MockGroupKeeper) GroupsByMember(ctx context.Context, req *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupsByMember", ctx, req)
+	ret0, _ := ret[0].(*group.QueryGroupsByMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupsByMember indicates an expected call of GroupsByMember.
+func (mr *MockGroupKeeperMockRecorder) GroupsByMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupsByMember", reflect.TypeOf((*MockGroupKeeper)(nil).GroupsByMember), ctx, req)
+}
+
+// UpdateGroupAdmin mocks base method.
+func (m *MockGroupKeeper) UpdateGroupAdmin(ctx context.Context, msg *group.MsgUpdateGroupAdmin) (*group.MsgUpdateGroupAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupAdmin", ctx, msg)
+	ret0, _ := ret[0].(*group.MsgUpdateGroupAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroupAdmin indicates an expected call of UpdateGroupAdmin.
+func (mr *MockGroupKeeperMockRecorder) UpdateGroupAdmin(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupAdmin", reflect.TypeOf((*MockGroupKeeper)(nil).UpdateGroupAdmin), ctx, msg)
+}
+
+// UpdateGroupMembers mocks base method.
+func (m *MockGroupKeeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupMembers", ctx, msg)
+	ret0, _ := ret[0].(*group.MsgUpdateGroupMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroupMembers indicates an expected call of UpdateGroupMembers.
+func (mr *MockGroupKeeperMockRecorder) UpdateGroupMembers(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupMembers", reflect.TypeOf((*MockGroupKeeper)(nil).UpdateGroupMembers), ctx, msg)
+}
+
+// UpdateGroupPolicyAdmin mocks base method.
+func (m *MockGroupKeeper) UpdateGroupPolicyAdmin(ctx context.Context, msg *group.MsgUpdateGroupPolicyAdmin) (*group.MsgUpdateGroupPolicyAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupPolicyAdmin", ctx, msg)
+	ret0, _ := ret[0].(*group.MsgUpdateGroupPolicyAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroupPolicyAdmin indicates an expected call of UpdateGroupPolicyAdmin.
+func (mr *MockGroupKeeperMockRecorder) UpdateGroupPolicyAdmin(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupPolicyAdmin", reflect.TypeOf((*MockGroupKeeper)(nil).UpdateGroupPolicyAdmin), ctx, msg)
+}
+
+// MockICAControllerKeeper is a mock of ICAControllerKeeper interface.
+type MockICAControllerKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockICAControllerKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockICAControllerKeeperMockRecorder is the mock recorder for MockICAControllerKeeper.
+type MockICAControllerKeeperMockRecorder struct {
+	mock *MockICAControllerKeeper
+}
+
+// NewMockICAControllerKeeper creates a new mock instance.
+func NewMockICAControllerKeeper(ctrl *gomock.Controller) *MockICAControllerKeeper {
+	mock := &MockICAControllerKeeper{ctrl: ctrl}
+	mock.recorder = &MockICAControllerKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICAControllerKeeper) EXPECT() *MockICAControllerKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetAllInterchainAccounts mocks base method.
+func (m *MockICAControllerKeeper) GetAllInterchainAccounts(ctx types3.Context) []types8.RegisteredInterchainAccount {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllInterchainAccounts", ctx)
+	ret0, _ := ret[0].([]types8.RegisteredInterchainAccount)
+	return ret0
+}
+
+// GetAllInterchainAccounts indicates an expected call of GetAllInterchainAccounts.
+func (mr *MockICAControllerKeeperMockRecorder) GetAllInterchainAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInterchainAccounts", reflect.TypeOf((*MockICAControllerKeeper)(nil).GetAllInterchainAccounts), ctx)
+}
+
+// GetInterchainAccountAddress mocks base method.
+func (m *MockICAControllerKeeper) GetInterchainAccountAddress(ctx types3.Context, connectionID, portID string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterchainAccountAddress", ctx, connectionID, portID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetInterchainAccountAddress indicates an expected call of GetInterchainAccountAddress.
+func (mr *MockICAControllerKeeperMockRecorder) GetInterchainAccountAddress(ctx, connectionID, portID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterchainAccountAddress", reflect.TypeOf((*MockICAControllerKeeper)(nil).GetInterchainAccountAddress), ctx, connectionID, portID)
+}
+
+// MockErc20Keeper is a mock of Erc20Keeper interface.
+type MockErc20Keeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockErc20KeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockErc20KeeperMockRecorder is the mock recorder for MockErc20Keeper.
+type MockErc20KeeperMockRecorder struct {
+	mock *MockErc20Keeper
+}
+
+// NewMockErc20Keeper creates a new mock instance.
+func NewMockErc20Keeper(ctrl *gomock.Controller) *MockErc20Keeper {
+	mock := &MockErc20Keeper{ctrl: ctrl}
+	mock.recorder = &MockErc20KeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockErc20Keeper) EXPECT() *MockErc20KeeperMockRecorder {
+	return m.recorder
+}
+
+// GetTokenPairs mocks base method.
+func (m *MockErc20Keeper) GetTokenPairs(ctx types3.Context) []types6.TokenPair {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenPairs", ctx)
+	ret0, _ := ret[0].([]types6.TokenPair)
+	return ret0
+}
+
+// GetTokenPairs indicates an expected call of GetTokenPairs.
+func (mr *MockErc20KeeperMockRecorder) GetTokenPairs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenPairs", reflect.TypeOf((*MockErc20Keeper)(nil).GetTokenPairs), ctx)
+}
+
+// MockEVMKeeper is a mock of EVMKeeper interface.
+type MockEVMKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockEVMKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockEVMKeeperMockRecorder is the mock recorder for MockEVMKeeper.
+type MockEVMKeeperMockRecorder struct {
+	mock *MockEVMKeeper
+}
+
+// NewMockEVMKeeper creates a new mock instance.
+func NewMockEVMKeeper(ctrl *gomock.Controller) *MockEVMKeeper {
+	mock := &MockEVMKeeper{ctrl: ctrl}
+	mock.recorder = &MockEVMKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEVMKeeper) EXPECT() *MockEVMKeeperMockRecorder {
+	return m.recorder
+}
+
+// ApplyMessage mocks base method.
+func (m *MockEVMKeeper) ApplyMessage(ctx types3.Context, stateDB *statedb.StateDB, msg core.Message, tracer *tracing.Hooks, commit, callFromPrecompile, internal bool) (*types7.MsgEthereumTxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyMessage", ctx, stateDB, msg, tracer, commit, callFromPrecompile, internal)
+	ret0, _ := ret[0].(*types7.MsgEthereumTxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyMessage indicates an expected call of ApplyMessage.
+func (mr *MockEVMKeeperMockRecorder) ApplyMessage(ctx, stateDB, msg, tracer, commit, callFromPrecompile, internal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyMessage", reflect.TypeOf((*MockEVMKeeper)(nil).ApplyMessage), ctx, stateDB, msg, tracer, commit, callFromPrecompile, internal)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockEVMKeeper) DeleteAccount(ctx types3.Context, addr common.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockEVMKeeperMockRecorder) DeleteAccount(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockEVMKeeper)(nil).DeleteAccount), ctx, addr)
+}
+
+// DeleteCode mocks base method.
+func (m *MockEVMKeeper) DeleteCode(ctx types3.Context, codeHash []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteCode", ctx, codeHash)
+}
+
+// DeleteCode indicates an expected call of DeleteCode.
+func (mr *MockEVMKeeperMockRecorder) DeleteCode(ctx, codeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCode", reflect.TypeOf((*MockEVMKeeper)(nil).DeleteCode), ctx, codeHash)
+}
+
+// DeleteState mocks base method.
+func (m *MockEVMKeeper) DeleteState(ctx types3.Context, addr common.Address, key common.Hash) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteState", ctx, addr, key)
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockEVMKeeperMockRecorder) DeleteState(ctx, addr, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockEVMKeeper)(nil).DeleteState), ctx, addr, key)
+}
+
+// ForEachStorage mocks base method.
+func (m *MockEVMKeeper) ForEachStorage(ctx types3.Context, addr common.Address, cb func(common.Hash, common.Hash) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ForEachStorage", ctx, addr, cb)
+}
+
+// ForEachStorage indicates an expected call of ForEachStorage.
+func (mr *MockEVMKeeperMockRecorder) ForEachStorage(ctx, addr, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEachStorage", reflect.TypeOf((*MockEVMKeeper)(nil).ForEachStorage), ctx, addr, cb)
+}
+
+// GetAccount mocks base method.
+func (m *MockEVMKeeper) GetAccount(ctx types3.Context, addr common.Address) *statedb.Account {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
+	ret0, _ := ret[0].(*statedb.Account)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockEVMKeeperMockRecorder) GetAccount(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockEVMKeeper)(nil).GetAccount), ctx, addr)
+}
+
+// GetCode mocks base method.
+func (m *MockEVMKeeper) GetCode(ctx types3.Context, codeHash common.Hash) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCode", ctx, codeHash)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// GetCode indicates an expected call of GetCode.
+func (mr *MockEVMKeeperMockRecorder) GetCode(ctx, codeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCode", reflect.TypeOf((*MockEVMKeeper)(nil).GetCode), ctx, codeHash)
+}
+
+// GetCodeHash mocks base method.
+func (m *MockEVMKeeper) GetCodeHash(ctx types3.Context, addr common.Address) common.Hash {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeHash", ctx, addr)
+	ret0, _ := ret[0].(common.Hash)
+	return ret0
+}
+
+// GetCodeHash indicates an expected call of GetCodeHash.
+func (mr *MockEVMKeeperMockRecorder) GetCodeHash(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeHash", reflect.TypeOf((*MockEVMKeeper)(nil).GetCodeHash), ctx, addr)
+}
+
+// GetState mocks base method.
+func (m *MockEVMKeeper) GetState(ctx types3.Context, addr common.Address, key common.Hash) common.Hash {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState", ctx, addr, key)
+	ret0, _ := ret[0].(common.Hash)
+	return ret0
+}
+
+// GetState indicates an expected call of GetState.
+func (mr *MockEVMKeeperMockRecorder) GetState(ctx, addr, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockEVMKeeper)(nil).GetState), ctx, addr, key)
+}
+
+// KVStoreKeys mocks base method.
+func (m *MockEVMKeeper) KVStoreKeys() map[string]*types.KVStoreKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KVStoreKeys")
+	ret0, _ := ret[0].(map[string]*types.KVStoreKey)
+	return ret0
+}
+
+// KVStoreKeys indicates an expected call of KVStoreKeys.
+func (mr *MockEVMKeeperMockRecorder) KVStoreKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KVStoreKeys", reflect.TypeOf((*MockEVMKeeper)(nil).KVStoreKeys))
+}
+
+// SetAccount mocks base method.
+func (m *MockEVMKeeper) SetAccount(ctx types3.Context, addr common.Address, account statedb.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccount", ctx, addr, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccount indicates an expected call of SetAccount.
+func (mr *MockEVMKeeperMockRecorder) SetAccount(ctx, addr, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccount", reflect.TypeOf((*MockEVMKeeper)(nil).SetAccount), ctx, addr, account)
+}
+
+// SetCode mocks base method.
+func (m *MockEVMKeeper) SetCode(ctx types3.Context, codeHash, code []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCode", ctx, codeHash, code)
+}
+
+// SetCode indicates an expected call of SetCode.
+func (mr *MockEVMKeeperMockRecorder) SetCode(ctx, codeHash, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCode", reflect.TypeOf((*MockEVMKeeper)(nil).SetCode), ctx, codeHash, code)
+}
+
+// SetState mocks base method.
+func (m *MockEVMKeeper) SetState(ctx types3.Context, addr common.Address, key common.Hash, value []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetState", ctx, addr, key, value)
+}
+
+// SetState indicates an expected call of SetState.
+func (mr *MockEVMKeeperMockRecorder) SetState(ctx, addr, key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetState", reflect.TypeOf((*MockEVMKeeper)(nil).SetState), ctx, addr, key, value)
+}
@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeClaimLegacyAccount = "claim_legacy_account"
 	EventTypeMigrateValidator   = "migrate_validator"
+	EventTypeICAHandover        = "ica_controller_handover"
+	EventTypeERC20Skipped       = "erc20_balance_skipped"
 
 	AttributeKeyLegacyAddress = "legacy_address"
 	AttributeKeyNewAddress    = "new_address"
@@ -12,4 +14,10 @@ const (
 	AttributeKeyOldValAddr    = "old_val_addr"
 	AttributeKeyNewValAddr    = "new_val_addr"
 	AttributeKeyConsAddr      = "cons_addr"
+	AttributeKeyConnectionID  = "connection_id"
+	AttributeKeyPortID        = "port_id"
+	AttributeKeyICAAddress    = "ica_address"
+	AttributeKeyContract      = "contract"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyReason        = "reason"
 )
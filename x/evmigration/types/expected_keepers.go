@@ -8,10 +8,19 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/x/feegrant"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 
 	actiontypes "github.com/LumeraProtocol/lumera/x/action/v1/types"
 	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
@@ -137,3 +146,53 @@ type ActionKeeper interface {
 	SetAction(ctx sdk.Context, action *actiontypes.Action) error
 	GetActionByID(ctx sdk.Context, actionID string) (*actiontypes.Action, bool)
 }
+
+// WasmKeeper defines the expected interface for the x/wasm module. The app
+// backs UpdateContractAdmin with the default permission keeper, so the caller
+// must be the current contract admin.
+type WasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, wasmtypes.ContractInfo) bool)
+	UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress) error
+}
+
+// GovKeeper defines the expected interface for the x/gov module. x/gov only
+// exposes deposits and votes as collections, so the app provides an adapter.
+type GovKeeper interface {
+	IterateAllDeposits(ctx context.Context, cb func(deposit govv1.Deposit) (stop bool, err error)) error
+	SetDeposit(ctx context.Context, deposit govv1.Deposit) error
+	RemoveDeposit(ctx context.Context, proposalID uint64, depositor sdk.AccAddress) error
+	IterateAllVotes(ctx context.Context, cb func(vote govv1.Vote) (stop bool, err error)) error
+	SetVote(ctx context.Context, vote govv1.Vote) error
+	RemoveVote(ctx context.Context, proposalID uint64, voter sdk.AccAddress) error
+}
+
+// GroupKeeper defines the expected interface for the x/group module. The
+// migration drives the group keeper through its own query and msg server
+// methods so group invariants (total weight, versions) stay intact.
+type GroupKeeper interface {
+	GroupsByMember(ctx context.Context, req *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error)
+	GroupsByAdmin(ctx context.Context, req *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error)
+	GroupMembers(ctx context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error)
+	GroupPoliciesByAdmin(ctx context.Context, req *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error)
+	UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error)
+	UpdateGroupAdmin(ctx context.Context, msg *group.MsgUpdateGroupAdmin) (*group.MsgUpdateGroupAdminResponse, error)
+	UpdateGroupPolicyAdmin(ctx context.Context, msg *group.MsgUpdateGroupPolicyAdmin) (*group.MsgUpdateGroupPolicyAdminResponse, error)
+}
+
+// ICAControllerKeeper defines the expected interface for the ICS-27
+// controller submodule.
+type ICAControllerKeeper interface {
+	GetAllInterchainAccounts(ctx sdk.Context) []icagenesistypes.RegisteredInterchainAccount
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+}
+
+// Erc20Keeper defines the expected interface for the x/erc20 module.
+type Erc20Keeper interface {
+	GetTokenPairs(ctx sdk.Context) []erc20types.TokenPair
+}
+
+// EVMKeeper defines the expected interface for the x/vm module.
+type EVMKeeper interface {
+	statedb.Keeper
+	ApplyMessage(ctx sdk.Context, stateDB *statedb.StateDB, msg core.Message, tracer *tracing.Hooks, commit, callFromPrecompile, internal bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
@@ -506,6 +506,25 @@ type QueryMigrationEstimateResponse struct {
 	// distinguish "jailed for downtime/equivocation" (actionable: unjail
 	// after slashing window) from "voluntarily unbonded" (not actionable).
 	ValidatorJailed bool `protobuf:"varint,20,opt,name=validator_jailed,json=validatorJailed,proto3" json:"validator_jailed,omitempty"`
+	// wasm_admin_count is the number of CosmWasm contracts whose admin is this
+	// address.
+	WasmAdminCount uint64 `protobuf:"varint,21,opt,name=wasm_admin_count,json=wasmAdminCount,proto3" json:"wasm_admin_count,omitempty"`
+	// gov_deposit_count is the number of deposits on proposals still in their
+	// deposit or voting period.
+	GovDepositCount uint64 `protobuf:"varint,22,opt,name=gov_deposit_count,json=govDepositCount,proto3" json:"gov_deposit_count,omitempty"`
+	// gov_vote_count is the number of votes on proposals in their voting period.
+	GovVoteCount uint64 `protobuf:"varint,23,opt,name=gov_vote_count,json=govVoteCount,proto3" json:"gov_vote_count,omitempty"`
+	// group_membership_count is the number of groups this address is a member of.
+	GroupMembershipCount uint64 `protobuf:"varint,24,opt,name=group_membership_count,json=groupMembershipCount,proto3" json:"group_membership_count,omitempty"`
+	// group_admin_count is the number of groups and group policies administered
+	// by this address.
+	GroupAdminCount uint64 `protobuf:"varint,25,opt,name=group_admin_count,json=groupAdminCount,proto3" json:"group_admin_count,omitempty"`
+	// ica_controller_count is the number of interchain accounts registered with
+	// this address as controller owner. They are handed over, not re-keyed.
+	IcaControllerCount uint64 `protobuf:"varint,26,opt,name=ica_controller_count,json=icaControllerCount,proto3" json:"ica_controller_count,omitempty"`
+	// erc20_balance_count is the number of ERC20-native token pairs in which
+	// this address holds a positive balance.
+	Erc20BalanceCount uint64 `protobuf:"varint,27,opt,name=erc20_balance_count,json=erc20BalanceCount,proto3" json:"erc20_balance_count,omitempty"`
 }
 
 func (m *QueryMigrationEstimateResponse) Reset()         { *m = QueryMigrationEstimateResponse{} }
@@ -681,6 +700,55 @@ func (m *QueryMigrationEstimateResponse) GetValidatorJailed() bool {
 	return false
 }
 
+func (m *QueryMigrationEstimateResponse) GetWasmAdminCount() uint64 {
+	if m != nil {
+		return m.WasmAdminCount
+	}
+	return 0
+}
+
+func (m *QueryMigrationEstimateResponse) GetGovDepositCount() uint64 {
+	if m != nil {
+		return m.GovDepositCount
+	}
+	return 0
+}
+
+func (m *QueryMigrationEstimateResponse) GetGovVoteCount() uint64 {
+	if m != nil {
+		return m.GovVoteCount
+	}
+	return 0
+}
+
+func (m *QueryMigrationEstimateResponse) GetGroupMembershipCount() uint64 {
+	if m != nil {
+		return m.GroupMembershipCount
+	}
+	return 0
+}
+
+func (m *QueryMigrationEstimateResponse) GetGroupAdminCount() uint64 {
+	if m != nil {
+		return m.GroupAdminCount
+	}
+	return 0
+}
+
+func (m *QueryMigrationEstimateResponse) GetIcaControllerCount() uint64 {
+	if m != nil {
+		return m.IcaControllerCount
+	}
+	return 0
+}
+
+func (m *QueryMigrationEstimateResponse) GetErc20BalanceCount() uint64 {
+	if m != nil {
+		return m.Erc20BalanceCount
+	}
+	return 0
+}
+
 // QueryMigrationStatsRequest is the request type for the Query/MigrationStats RPC method.
 type QueryMigrationStatsRequest struct {
 }
@@ -1146,94 +1214,102 @@ func init() {
 func init() { proto.RegisterFile("lumera/evmigration/query.proto", fileDescriptor_dd243d5cc4f43af9) }
 
 var fileDescriptor_dd243d5cc4f43af9 = []byte{
-	// 1383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xa6, 0xa9, 0x93, 0xbc, 0x4e, 0x9c, 0x7a, 0x92, 0xd2, 0xad, 0x1b, 0xdc, 0xd6, 0x55,
-	0x9a, 0xb6, 0x28, 0xde, 0x36, 0x7c, 0x54, 0xa5, 0x54, 0x55, 0x53, 0xa0, 0x2a, 0xa2, 0xa8, 0xac,
-	0x0b, 0x48, 0x20, 0xb1, 0x8c, 0xbd, 0xd3, 0xf5, 0xb6, 0xeb, 0x1d, 0x77, 0x67, 0xd6, 0xc1, 0x54,
-	0xbd, 0xc0, 0x1f, 0x40, 0xe2, 0x8a, 0x38, 0x22, 0x2e, 0x08, 0x24, 0xb8, 0xf1, 0x07, 0x7a, 0x41,
-	0xaa, 0xe0, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0x3f, 0xc0, 0x0f, 0x40, 0x3b, 0x33, 0x5e, 0xdb, 0xbb,
-	0x1b, 0xdb, 0x45, 0x41, 0xe2, 0x12, 0xd9, 0xcf, 0x3c, 0xcf, 0xbc, 0xcf, 0xfb, 0xce, 0x3b, 0x1f,
-	0x0e, 0x94, 0xbd, 0xb0, 0x45, 0x02, 0x6c, 0x90, 0x4e, 0xcb, 0x75, 0x02, 0xcc, 0x5d, 0xea, 0x1b,
-	0x0f, 0x42, 0x12, 0x74, 0xab, 0xed, 0x80, 0x72, 0x8a, 0x90, 0x1c, 0xaf, 0x0e, 0x8c, 0x97, 0x8a,
-	0xb8, 0xe5, 0xfa, 0xd4, 0x10, 0x7f, 0x25, 0xad, 0x74, 0xae, 0x41, 0x59, 0x8b, 0x32, 0xa3, 0x8e,
-	0x19, 0x91, 0x7a, 0xa3, 0x73, 0xa1, 0x4e, 0x38, 0xbe, 0x60, 0xb4, 0xb1, 0xe3, 0xfa, 0x42, 0xa8,
-	0xb8, 0x47, 0x25, 0xd7, 0x12, 0xdf, 0x0c, 0xf9, 0x45, 0x0d, 0xad, 0x38, 0xd4, 0xa1, 0x12, 0x8f,
-	0x3e, 0x29, 0x74, 0xd5, 0xa1, 0xd4, 0xf1, 0x88, 0x81, 0xdb, 0xae, 0x81, 0x7d, 0x9f, 0x72, 0x31,
-	0x5b, 0x4f, 0x73, 0x3c, 0x23, 0x83, 0x36, 0x0e, 0x70, 0xab, 0x47, 0x38, 0x9b, 0x41, 0x88, 0x3f,
-	0x59, 0x01, 0x69, 0xd0, 0xc0, 0x96, 0xd4, 0xca, 0x0a, 0xa0, 0xb7, 0x23, 0xf3, 0xb7, 0x85, 0xde,
-	0x24, 0x0f, 0x42, 0xc2, 0x78, 0xe5, 0x0e, 0x2c, 0x0f, 0xa1, 0xac, 0x4d, 0x7d, 0x46, 0xd0, 0x15,
-	0xc8, 0xc9, 0x38, 0xba, 0x76, 0x42, 0x3b, 0x93, 0xdf, 0x2c, 0x55, 0xd3, 0xb5, 0xaa, 0x4a, 0xcd,
-	0xd6, 0xfc, 0xe3, 0xdf, 0x8f, 0x4f, 0x7d, 0xf3, 0xd7, 0xf7, 0xe7, 0x34, 0x53, 0x89, 0x2a, 0x1f,
-	0xc2, 0x31, 0x31, 0xeb, 0xad, 0x1e, 0xd7, 0x14, 0x4e, 0x54, 0x50, 0x74, 0x15, 0x0a, 0x1e, 0x71,
-	0x70, 0xa3, 0x6b, 0x61, 0xdb, 0x0e, 0x08, 0x93, 0x51, 0xe6, 0xb7, 0xf4, 0x5f, 0x7e, 0xdc, 0x58,
-	0x51, 0x45, 0xbb, 0x26, 0x47, 0x6a, 0x3c, 0x70, 0x7d, 0xc7, 0x5c, 0x94, 0x7c, 0x05, 0x56, 0x3e,
-	0x80, 0xd5, 0xec, 0xf9, 0x95, 0xfd, 0xcb, 0x90, 0x93, 0xb9, 0x2b, 0xfb, 0xa7, 0xb2, 0xec, 0x27,
-	0xc5, 0x4a, 0x52, 0xb1, 0x61, 0x3d, 0x6b, 0xf2, 0xad, 0xee, 0x5b, 0x64, 0x5b, 0x19, 0xe8, 0x25,
-	0x72, 0x09, 0xf2, 0x3e, 0xd9, 0x9e, 0x38, 0x0b, 0xf0, 0xe3, 0x19, 0x2a, 0x0e, 0x9c, 0x19, 0x1f,
-	0x65, 0x3f, 0xd2, 0xb9, 0x9b, 0x5d, 0xab, 0x38, 0x87, 0xd7, 0x01, 0xfa, 0x6d, 0xac, 0x02, 0x9c,
-	0xae, 0x2a, 0xff, 0x51, 0xcf, 0x57, 0xe5, 0x9e, 0x51, 0x3d, 0x5f, 0xbd, 0x8d, 0x1d, 0xa2, 0xb4,
-	0xe6, 0x80, 0xb2, 0xf2, 0xad, 0x06, 0xcf, 0xee, 0x11, 0x48, 0xa5, 0x71, 0x1d, 0x66, 0xa5, 0xa7,
-	0xa8, 0x52, 0x07, 0x26, 0xcc, 0x63, 0x6b, 0x26, 0x6a, 0x2f, 0xb3, 0xa7, 0x44, 0x37, 0x86, 0xec,
-	0x4e, 0x0b, 0xbb, 0xeb, 0x63, 0xed, 0x4a, 0x07, 0x43, 0x7e, 0x3f, 0x4a, 0xda, 0x7d, 0x8d, 0x71,
-	0xb7, 0x85, 0x39, 0xd9, 0xb7, 0x2e, 0xfd, 0x39, 0x07, 0xe5, 0xbd, 0x42, 0xa8, 0x92, 0x9c, 0x84,
-	0x05, 0x97, 0x59, 0x1d, 0xec, 0xb9, 0x36, 0xe6, 0x34, 0x10, 0x11, 0xe6, 0xcc, 0xbc, 0xcb, 0xde,
-	0xed, 0x41, 0xe8, 0x2c, 0x1c, 0xb2, 0x49, 0x34, 0xb1, 0xd8, 0xd2, 0x0d, 0x1a, 0xfa, 0x5c, 0xa4,
-	0x3d, 0x63, 0x2e, 0xf5, 0xf1, 0xeb, 0x11, 0x8c, 0xd6, 0x61, 0x29, 0xf4, 0xeb, 0xd4, 0xb7, 0x5d,
-	0xdf, 0x51, 0xcc, 0x03, 0x82, 0x59, 0x88, 0x61, 0x49, 0xdc, 0x00, 0x14, 0x90, 0xd4, 0xac, 0x33,
-	0x82, 0x5b, 0x1c, 0x1c, 0x91, 0xf4, 0x73, 0x50, 0xc4, 0x21, 0x6f, 0x7e, 0x62, 0x39, 0x01, 0xf6,
-	0xb9, 0x62, 0x1f, 0x94, 0x1e, 0xc4, 0xc0, 0x8d, 0x08, 0x97, 0xdc, 0x35, 0x28, 0xdc, 0x25, 0x64,
-	0x90, 0x98, 0x13, 0xc4, 0xc5, 0x1e, 0x2a, 0x69, 0xa7, 0x60, 0x91, 0x53, 0x8e, 0x3d, 0x8b, 0xd3,
-	0xb0, 0xd1, 0x24, 0xb6, 0x3e, 0x2b, 0x58, 0x0b, 0x02, 0xbc, 0x23, 0xb1, 0x88, 0xb4, 0x4d, 0x43,
-	0xcf, 0xb6, 0x58, 0xd8, 0x68, 0x10, 0x62, 0xeb, 0x73, 0xa2, 0x3c, 0x0b, 0x02, 0xac, 0x49, 0x2c,
-	0xaa, 0x4f, 0x40, 0xee, 0x91, 0x86, 0x3a, 0xf1, 0x30, 0xa3, 0xbe, 0x3e, 0x1f, 0x2d, 0x94, 0xb9,
-	0x14, 0xe3, 0xa6, 0x80, 0xd1, 0x79, 0x58, 0xe9, 0x60, 0xcf, 0x4a, 0x25, 0x0e, 0x22, 0x36, 0xea,
-	0x60, 0xef, 0xd5, 0x44, 0xe6, 0x55, 0x58, 0x8e, 0x14, 0xc9, 0xaa, 0xe6, 0x65, 0xa5, 0x3a, 0xd8,
-	0x7b, 0x67, 0xb8, 0xb0, 0x2f, 0xc0, 0x33, 0x11, 0x3f, 0xa3, 0xb8, 0x0b, 0x42, 0x12, 0xc5, 0x37,
-	0x53, 0xf5, 0x3d, 0x09, 0x0b, 0xb8, 0x31, 0xc0, 0x5d, 0x14, 0xdc, 0xbc, 0xc4, 0xe2, 0xa5, 0xad,
-	0x63, 0x0f, 0xfb, 0x0d, 0x62, 0xb1, 0xb0, 0xd5, 0xc2, 0x41, 0x57, 0x2f, 0x88, 0x24, 0x0b, 0x0a,
-	0xae, 0x49, 0x34, 0xaa, 0x59, 0x13, 0x33, 0x8b, 0x85, 0x6d, 0x12, 0xf8, 0xd4, 0x26, 0xfa, 0x92,
-	0xac, 0x59, 0x13, 0xb3, 0x5a, 0x0f, 0x43, 0xc7, 0x21, 0xef, 0x32, 0xab, 0x15, 0x7a, 0xdc, 0x65,
-	0xae, 0xa3, 0x1f, 0x12, 0x14, 0x70, 0xd9, 0x2d, 0x85, 0xa0, 0x55, 0x98, 0xe7, 0xcd, 0x80, 0xb0,
-	0x26, 0xf5, 0x6c, 0xbd, 0x78, 0x42, 0x3b, 0xb3, 0x68, 0xf6, 0x81, 0x48, 0xee, 0x87, 0x2d, 0x8b,
-	0xb9, 0x8e, 0x4f, 0x02, 0xa6, 0x23, 0x31, 0x0e, 0x7e, 0xd8, 0xaa, 0x49, 0x24, 0x5a, 0x93, 0xb8,
-	0xa7, 0x2d, 0xc6, 0x31, 0x0f, 0x99, 0xbe, 0x2c, 0xd7, 0x24, 0xc6, 0x6b, 0x02, 0x1e, 0xa6, 0xde,
-	0xc3, 0xae, 0x47, 0x6c, 0x7d, 0x45, 0xf8, 0xe9, 0x53, 0xdf, 0x10, 0x70, 0x65, 0x15, 0x4a, 0xc3,
-	0xdb, 0x29, 0x9a, 0x22, 0xbe, 0xc9, 0x3e, 0x3b, 0x90, 0xbc, 0x74, 0xd4, 0xb0, 0xda, 0x6a, 0x6b,
-	0x50, 0x90, 0x1d, 0x27, 0x8f, 0x1a, 0x22, 0x0f, 0xd3, 0x19, 0x53, 0xf6, 0xe1, 0x2d, 0x05, 0x46,
-	0x6b, 0x21, 0x69, 0x72, 0x2f, 0xab, 0xad, 0x96, 0x17, 0xd8, 0x9b, 0x02, 0x8a, 0x9a, 0x62, 0x90,
-	0x12, 0x25, 0x78, 0x9f, 0xd8, 0x6a, 0xab, 0x15, 0x07, 0x98, 0x35, 0x31, 0x80, 0x5e, 0x86, 0xa3,
-	0x92, 0x1f, 0x27, 0xc4, 0xfa, 0x26, 0xe4, 0xa6, 0x3b, 0x22, 0x08, 0xf1, 0xa6, 0x67, 0xb1, 0x9d,
-	0x97, 0xe0, 0x48, 0x4a, 0xab, 0x9c, 0xc9, 0x0d, 0x78, 0x38, 0xa1, 0x54, 0x1e, 0x2f, 0x82, 0x3e,
-	0xe4, 0x71, 0xdb, 0xe5, 0x4d, 0xab, 0x1d, 0xd6, 0xef, 0x93, 0xae, 0xda, 0x90, 0x87, 0x07, 0x8c,
-	0xbe, 0xe7, 0xf2, 0xe6, 0x6d, 0x31, 0x88, 0xae, 0xc0, 0xb1, 0x94, 0x90, 0x86, 0xbc, 0xa7, 0x95,
-	0xdb, 0x54, 0x4f, 0x68, 0x69, 0xc8, 0xa5, 0xbc, 0x62, 0xab, 0x35, 0x92, 0x63, 0xd7, 0x1a, 0xa2,
-	0xa1, 0xf7, 0xfd, 0xae, 0xf9, 0x4e, 0x53, 0x6b, 0x9d, 0x0c, 0xa3, 0xd6, 0xfa, 0x06, 0xcc, 0x61,
-	0x85, 0xa9, 0xab, 0x66, 0x2d, 0xeb, 0xaa, 0x19, 0x52, 0xdf, 0xf4, 0xef, 0x52, 0x75, 0xd9, 0xc4,
-	0xe2, 0xfd, 0xbb, 0x6d, 0xbe, 0x9c, 0x86, 0x62, 0x2a, 0x1c, 0xda, 0x84, 0xd9, 0x49, 0xef, 0x96,
-	0x1e, 0x31, 0xeb, 0x24, 0x98, 0xce, 0x3c, 0x09, 0xd6, 0x61, 0x29, 0x3a, 0x09, 0xfa, 0x87, 0x0d,
-	0x13, 0x2d, 0x3a, 0x67, 0x16, 0x9a, 0x98, 0xf5, 0x0f, 0x3a, 0x96, 0xba, 0x84, 0x66, 0xd2, 0x97,
-	0x50, 0xe2, 0xc0, 0x38, 0x38, 0xfa, 0xc0, 0xc8, 0x8d, 0x39, 0x30, 0x66, 0x93, 0x07, 0x46, 0xe2,
-	0x91, 0x42, 0xec, 0xff, 0xaa, 0x71, 0x12, 0x8f, 0x94, 0xc1, 0x40, 0xff, 0xc7, 0x47, 0xca, 0xe6,
-	0xdf, 0x00, 0x07, 0x85, 0x5f, 0xf4, 0x08, 0x72, 0xf2, 0xbd, 0x8d, 0x4e, 0x67, 0x19, 0x4a, 0x3f,
-	0xed, 0x4b, 0xeb, 0x63, 0x79, 0x32, 0x60, 0xa5, 0xf2, 0xe9, 0xaf, 0x7f, 0x7e, 0x31, 0xbd, 0x8a,
-	0x4a, 0xc6, 0x9e, 0x3f, 0x37, 0xd0, 0x0f, 0x1a, 0x2c, 0x25, 0x92, 0x46, 0xc6, 0x9e, 0x01, 0xb2,
-	0xdf, 0xfd, 0xa5, 0xf3, 0x93, 0x0b, 0x94, 0xb5, 0x2b, 0xc2, 0xda, 0x45, 0xf4, 0xa2, 0x31, 0xc1,
-	0x0f, 0x1d, 0xe3, 0xe1, 0xf0, 0x7b, 0xed, 0x11, 0xda, 0xd5, 0xe0, 0xd8, 0x88, 0x07, 0x36, 0xba,
-	0x3c, 0xa9, 0xa1, 0x8c, 0xc7, 0x7f, 0xe9, 0x95, 0x7f, 0x27, 0x56, 0x99, 0xdd, 0x14, 0x99, 0x5d,
-	0x47, 0xd7, 0x26, 0xc9, 0xcc, 0xaa, 0x77, 0xad, 0x81, 0x1f, 0x1a, 0xc6, 0xc3, 0x81, 0x2f, 0x8f,
-	0xd0, 0xd7, 0x1a, 0x1c, 0x4a, 0x3e, 0xba, 0xd1, 0xc4, 0xb5, 0x8e, 0xf3, 0xb9, 0xf0, 0x14, 0x0a,
-	0x95, 0xc4, 0x86, 0x48, 0x62, 0x1d, 0xad, 0x4d, 0x92, 0x04, 0x43, 0x3f, 0x69, 0x50, 0x4c, 0xbd,
-	0x85, 0xd1, 0x04, 0x71, 0x13, 0x4f, 0xf3, 0xd2, 0xe6, 0xd3, 0x48, 0x94, 0xd7, 0xab, 0xc2, 0xeb,
-	0x25, 0x74, 0x71, 0xb4, 0x57, 0xa2, 0x74, 0xe9, 0x66, 0xfa, 0x4a, 0x83, 0xc2, 0xf0, 0xdb, 0x02,
-	0x55, 0xc7, 0xfb, 0x18, 0x7c, 0xa3, 0x94, 0x8c, 0x89, 0xf9, 0xca, 0xf4, 0x73, 0xc2, 0xf4, 0x1a,
-	0x3a, 0x35, 0xda, 0x34, 0x13, 0x6e, 0x22, 0x83, 0xc3, 0x17, 0xe2, 0x08, 0x83, 0x99, 0x17, 0xf4,
-	0x08, 0x83, 0xd9, 0x37, 0xed, 0x68, 0x83, 0xbd, 0x0a, 0xf6, 0xdc, 0xf4, 0x1b, 0xb5, 0x7f, 0xf0,
-	0x8e, 0x6d, 0xd4, 0xd4, 0x65, 0x30, 0xb6, 0x51, 0xd3, 0xa7, 0xfa, 0x24, 0x8d, 0x4a, 0xec, 0xd8,
-	0xe8, 0xd6, 0xc6, 0xe3, 0x9d, 0xb2, 0xf6, 0x64, 0xa7, 0xac, 0xfd, 0xb1, 0x53, 0xd6, 0x3e, 0xdf,
-	0x2d, 0x4f, 0x3d, 0xd9, 0x2d, 0x4f, 0xfd, 0xb6, 0x5b, 0x9e, 0x7a, 0x7f, 0xf9, 0xe3, 0x21, 0x29,
-	0xef, 0xb6, 0x09, 0xab, 0xe7, 0xc4, 0x7f, 0x58, 0x9e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x87,
-	0x31, 0x2b, 0xa9, 0x71, 0x12, 0x00, 0x00,
+	// 1517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x1b, 0x8e, 0xd3, 0x34, 0x3f, 0xde, 0x24, 0x9b, 0x64, 0x92, 0xb6, 0xee, 0x26, 0xdf, 0xb6, 0xdd,
+	0x7e, 0x69, 0xd2, 0x7e, 0xca, 0x3a, 0xc9, 0x07, 0x54, 0xa5, 0x54, 0x55, 0x92, 0x42, 0x55, 0x44,
+	0x50, 0x71, 0x4a, 0x91, 0x40, 0xc2, 0xcc, 0xda, 0x53, 0xaf, 0x5b, 0xdb, 0xb3, 0xf5, 0xd8, 0x1b,
+	0x96, 0xaa, 0x17, 0x38, 0x23, 0x21, 0x71, 0x45, 0x1c, 0x11, 0x17, 0x04, 0x12, 0xdc, 0xf8, 0x07,
+	0x7a, 0xac, 0xe0, 0xc2, 0x09, 0xa1, 0x06, 0x89, 0x7f, 0x80, 0x3f, 0x00, 0x79, 0x66, 0xec, 0xf5,
+	0xee, 0x3a, 0x89, 0x8b, 0x82, 0xc4, 0xa5, 0xca, 0x3e, 0xf3, 0x3c, 0x7e, 0x9f, 0xf7, 0x9d, 0xd7,
+	0xef, 0x78, 0x0a, 0x15, 0x37, 0xf2, 0x48, 0x80, 0x35, 0xd2, 0xf2, 0x1c, 0x3b, 0xc0, 0xa1, 0x43,
+	0x7d, 0xed, 0x61, 0x44, 0x82, 0x76, 0xad, 0x19, 0xd0, 0x90, 0x22, 0x24, 0xd6, 0x6b, 0x99, 0xf5,
+	0xf2, 0x0c, 0xf6, 0x1c, 0x9f, 0x6a, 0xfc, 0x5f, 0x41, 0x2b, 0x5f, 0x32, 0x29, 0xf3, 0x28, 0xd3,
+	0xea, 0x98, 0x11, 0xa1, 0xd7, 0x5a, 0x6b, 0x75, 0x12, 0xe2, 0x35, 0xad, 0x89, 0x6d, 0xc7, 0xe7,
+	0x42, 0xc9, 0x3d, 0x2d, 0xb8, 0x06, 0xff, 0xa5, 0x89, 0x1f, 0x72, 0x69, 0xce, 0xa6, 0x36, 0x15,
+	0x78, 0xfc, 0x97, 0x44, 0x17, 0x6c, 0x4a, 0x6d, 0x97, 0x68, 0xb8, 0xe9, 0x68, 0xd8, 0xf7, 0x69,
+	0xc8, 0x9f, 0x96, 0x68, 0xce, 0xe4, 0x64, 0xd0, 0xc4, 0x01, 0xf6, 0x12, 0xc2, 0xc5, 0x1c, 0x42,
+	0xfa, 0x97, 0x11, 0x10, 0x93, 0x06, 0x96, 0xa0, 0x56, 0xe7, 0x00, 0xbd, 0x15, 0x9b, 0xbf, 0xcd,
+	0xf5, 0x3a, 0x79, 0x18, 0x11, 0x16, 0x56, 0xef, 0xc0, 0x6c, 0x17, 0xca, 0x9a, 0xd4, 0x67, 0x04,
+	0x5d, 0x83, 0x61, 0x11, 0x47, 0x55, 0xce, 0x2a, 0xcb, 0xe3, 0xeb, 0xe5, 0x5a, 0x7f, 0xad, 0x6a,
+	0x42, 0xb3, 0x39, 0xf6, 0xe4, 0xd7, 0x33, 0x03, 0x5f, 0xff, 0xf1, 0xdd, 0x25, 0x45, 0x97, 0xa2,
+	0xea, 0xfb, 0x30, 0xcf, 0x9f, 0xba, 0x9d, 0x70, 0x75, 0xee, 0x44, 0x06, 0x45, 0xd7, 0xa1, 0xe4,
+	0x12, 0x1b, 0x9b, 0x6d, 0x03, 0x5b, 0x56, 0x40, 0x98, 0x88, 0x32, 0xb6, 0xa9, 0xfe, 0xf4, 0xc3,
+	0xca, 0x9c, 0x2c, 0xda, 0x86, 0x58, 0xd9, 0x09, 0x03, 0xc7, 0xb7, 0xf5, 0x49, 0xc1, 0x97, 0x60,
+	0xf5, 0x3d, 0x58, 0xc8, 0x7f, 0xbe, 0xb4, 0x7f, 0x15, 0x86, 0x45, 0xee, 0xd2, 0xfe, 0xf9, 0x3c,
+	0xfb, 0xbd, 0x62, 0x29, 0xa9, 0x5a, 0xb0, 0x94, 0xf7, 0xf0, 0xcd, 0xf6, 0x9b, 0x64, 0x57, 0x1a,
+	0x48, 0x12, 0xb9, 0x02, 0xe3, 0x3e, 0xd9, 0x2d, 0x9c, 0x05, 0xf8, 0xe9, 0x13, 0xaa, 0x36, 0x2c,
+	0x1f, 0x1e, 0xe5, 0x28, 0xd2, 0xb9, 0x97, 0x5f, 0xab, 0x34, 0x87, 0xd7, 0x00, 0x3a, 0x6d, 0x2c,
+	0x03, 0x5c, 0xa8, 0x49, 0xff, 0x71, 0xcf, 0xd7, 0xc4, 0x3b, 0x23, 0x7b, 0xbe, 0x76, 0x1b, 0xdb,
+	0x44, 0x6a, 0xf5, 0x8c, 0xb2, 0xfa, 0x8d, 0x02, 0xff, 0xd9, 0x27, 0x90, 0x4c, 0x63, 0x0b, 0x46,
+	0x84, 0xa7, 0xb8, 0x52, 0xc7, 0x0a, 0xe6, 0xb1, 0x39, 0x14, 0xb7, 0x97, 0x9e, 0x28, 0xd1, 0xcd,
+	0x2e, 0xbb, 0x83, 0xdc, 0xee, 0xd2, 0xa1, 0x76, 0x85, 0x83, 0x2e, 0xbf, 0x1f, 0xf4, 0xda, 0x7d,
+	0x95, 0x85, 0x8e, 0x87, 0x43, 0x72, 0x64, 0x5d, 0xfa, 0xe9, 0x18, 0x54, 0xf6, 0x0b, 0x21, 0x4b,
+	0x72, 0x0e, 0x26, 0x1c, 0x66, 0xb4, 0xb0, 0xeb, 0x58, 0x38, 0xa4, 0x01, 0x8f, 0x30, 0xaa, 0x8f,
+	0x3b, 0xec, 0x6e, 0x02, 0xa1, 0x8b, 0x30, 0x6d, 0x91, 0xf8, 0xc1, 0xfc, 0x95, 0x36, 0x69, 0xe4,
+	0x87, 0x3c, 0xed, 0x21, 0x7d, 0xaa, 0x83, 0x6f, 0xc5, 0x30, 0x5a, 0x82, 0xa9, 0xc8, 0xaf, 0x53,
+	0xdf, 0x72, 0x7c, 0x5b, 0x32, 0x8f, 0x71, 0x66, 0x29, 0x85, 0x05, 0x71, 0x05, 0x50, 0x40, 0xfa,
+	0x9e, 0x3a, 0xc4, 0xb9, 0x33, 0xd9, 0x15, 0x41, 0xbf, 0x04, 0x33, 0x38, 0x0a, 0x1b, 0x1f, 0x19,
+	0x76, 0x80, 0xfd, 0x50, 0xb2, 0x8f, 0x0b, 0x0f, 0x7c, 0xe1, 0x66, 0x8c, 0x0b, 0xee, 0x22, 0x94,
+	0xee, 0x11, 0x92, 0x25, 0x0e, 0x73, 0xe2, 0x64, 0x82, 0x0a, 0xda, 0x79, 0x98, 0x0c, 0x69, 0x88,
+	0x5d, 0x23, 0xa4, 0x91, 0xd9, 0x20, 0x96, 0x3a, 0xc2, 0x59, 0x13, 0x1c, 0xbc, 0x23, 0xb0, 0x98,
+	0xb4, 0x4b, 0x23, 0xd7, 0x32, 0x58, 0x64, 0x9a, 0x84, 0x58, 0xea, 0x28, 0x2f, 0xcf, 0x04, 0x07,
+	0x77, 0x04, 0x16, 0xd7, 0x27, 0x20, 0xf7, 0x89, 0x29, 0x27, 0x1e, 0x66, 0xd4, 0x57, 0xc7, 0xe2,
+	0x8d, 0xd2, 0xa7, 0x52, 0x5c, 0xe7, 0x30, 0x5a, 0x85, 0xb9, 0x16, 0x76, 0x8d, 0xbe, 0xc4, 0x81,
+	0xc7, 0x46, 0x2d, 0xec, 0xde, 0xe8, 0xc9, 0xbc, 0x06, 0xb3, 0xb1, 0xa2, 0xb7, 0xaa, 0xe3, 0xa2,
+	0x52, 0x2d, 0xec, 0xbe, 0xdd, 0x5d, 0xd8, 0x17, 0xe0, 0x64, 0xcc, 0xcf, 0x29, 0xee, 0x04, 0x97,
+	0xc4, 0xf1, 0xf5, 0xbe, 0xfa, 0x9e, 0x83, 0x09, 0x6c, 0x66, 0xb8, 0x93, 0x9c, 0x3b, 0x8e, 0xcd,
+	0x0e, 0x65, 0x09, 0xa6, 0xea, 0xd8, 0xc5, 0xbe, 0x49, 0x0c, 0x16, 0x79, 0x1e, 0x0e, 0xda, 0x6a,
+	0x89, 0x27, 0x59, 0x92, 0xf0, 0x8e, 0x40, 0xe3, 0x9a, 0x35, 0x30, 0x33, 0x58, 0xd4, 0x24, 0x81,
+	0x4f, 0x2d, 0xa2, 0x4e, 0x89, 0x9a, 0x35, 0x30, 0xdb, 0x49, 0x30, 0x74, 0x06, 0xc6, 0x1d, 0x66,
+	0x78, 0x91, 0x1b, 0x3a, 0xcc, 0xb1, 0xd5, 0x69, 0x4e, 0x01, 0x87, 0x6d, 0x4b, 0x04, 0x2d, 0xc0,
+	0x58, 0xd8, 0x08, 0x08, 0x6b, 0x50, 0xd7, 0x52, 0x67, 0xce, 0x2a, 0xcb, 0x93, 0x7a, 0x07, 0x88,
+	0xe5, 0x7e, 0xe4, 0x19, 0xcc, 0xb1, 0x7d, 0x12, 0x30, 0x15, 0xf1, 0x75, 0xf0, 0x23, 0x6f, 0x47,
+	0x20, 0xf1, 0x9e, 0xa4, 0x3d, 0x6d, 0xb0, 0x10, 0x87, 0x11, 0x53, 0x67, 0xc5, 0x9e, 0xa4, 0xf8,
+	0x0e, 0x87, 0xbb, 0xa9, 0xf7, 0xb1, 0xe3, 0x12, 0x4b, 0x9d, 0xe3, 0x7e, 0x3a, 0xd4, 0xd7, 0x39,
+	0x8c, 0x96, 0x61, 0x7a, 0x17, 0x33, 0xcf, 0xc0, 0x96, 0xe7, 0x24, 0xa5, 0x3a, 0x21, 0xfa, 0x3b,
+	0xc6, 0x37, 0x62, 0x38, 0x6d, 0x58, 0x9b, 0xb6, 0x0c, 0x8b, 0x34, 0x29, 0x73, 0x92, 0x3e, 0x3c,
+	0x29, 0x1a, 0xd6, 0xa6, 0xad, 0x1b, 0x02, 0x17, 0xdc, 0xff, 0x42, 0x29, 0xe6, 0xb6, 0x68, 0x48,
+	0x24, 0xf1, 0x94, 0x68, 0x45, 0x9b, 0xb6, 0xee, 0xd2, 0x90, 0xa4, 0x1b, 0x6b, 0x07, 0x34, 0x6a,
+	0x1a, 0x1e, 0xf1, 0xea, 0x24, 0x60, 0x0d, 0xa7, 0x29, 0xd9, 0xaa, 0xd8, 0x58, 0xbe, 0xba, 0x9d,
+	0x2e, 0x76, 0x7c, 0x70, 0x55, 0xd6, 0xf2, 0x69, 0xe9, 0x23, 0x5e, 0xc8, 0x78, 0x5e, 0x85, 0x39,
+	0xc7, 0xc4, 0x86, 0x49, 0xfd, 0x30, 0xa0, 0xae, 0x4b, 0x02, 0x49, 0x2f, 0x8b, 0xe6, 0x74, 0x4c,
+	0xbc, 0x95, 0x2e, 0xa5, 0xcd, 0x49, 0x02, 0x73, 0x7d, 0xd5, 0x48, 0x3a, 0x43, 0x08, 0xe6, 0x45,
+	0x73, 0xf2, 0xa5, 0x4d, 0xb1, 0xc2, 0xf9, 0xd5, 0x05, 0x28, 0x77, 0x8f, 0xa3, 0x78, 0x0b, 0xd2,
+	0x2f, 0x81, 0x4f, 0x8e, 0xc1, 0x7c, 0xee, 0xb2, 0x1c, 0x55, 0x8b, 0x50, 0x12, 0x6f, 0xac, 0x18,
+	0xd5, 0x44, 0x1c, 0x46, 0x43, 0xba, 0x78, 0x8f, 0xb7, 0x25, 0x18, 0xf7, 0xb2, 0xa0, 0x89, 0x59,
+	0x28, 0x47, 0xd5, 0x38, 0xc7, 0xde, 0xe0, 0x50, 0xec, 0x3b, 0x4b, 0x89, 0x1b, 0xe4, 0x01, 0xb1,
+	0xe4, 0xa8, 0x9a, 0xc9, 0x30, 0x77, 0xf8, 0x02, 0x7a, 0x19, 0x4e, 0x0b, 0x7e, 0xda, 0x10, 0xac,
+	0x63, 0x42, 0x0c, 0xad, 0x53, 0x9c, 0x90, 0x0e, 0x4d, 0x96, 0xda, 0x79, 0x09, 0x4e, 0xf5, 0x69,
+	0xa5, 0x33, 0x31, 0xc0, 0x4e, 0xf4, 0x28, 0xa5, 0xc7, 0xcb, 0xa0, 0x76, 0x79, 0xdc, 0x75, 0xc2,
+	0x86, 0xd1, 0x8c, 0xea, 0x0f, 0x48, 0x5b, 0x1d, 0xce, 0x08, 0x05, 0xfd, 0x1d, 0x27, 0x6c, 0xdc,
+	0xe6, 0x8b, 0xe8, 0x1a, 0xcc, 0xf7, 0x09, 0x69, 0x14, 0x26, 0x5a, 0x31, 0xe6, 0xd4, 0x1e, 0x2d,
+	0x8d, 0x42, 0x21, 0xaf, 0x5a, 0x72, 0x8f, 0xc4, 0xda, 0x86, 0xc9, 0xb7, 0xf4, 0xc8, 0xcf, 0xea,
+	0x6f, 0x15, 0x98, 0xcf, 0x0d, 0x23, 0xf7, 0xfa, 0x26, 0x8c, 0x62, 0x89, 0xc9, 0xa3, 0x7a, 0x31,
+	0xef, 0xa8, 0xee, 0x52, 0xdf, 0xf2, 0xef, 0x51, 0x79, 0x58, 0xa7, 0xe2, 0xa3, 0x3b, 0xad, 0xbf,
+	0x18, 0x84, 0x99, 0xbe, 0x70, 0x68, 0x1d, 0x46, 0x8a, 0x9e, 0xcd, 0x09, 0x31, 0x6f, 0x92, 0x0e,
+	0xe6, 0x4e, 0xd2, 0x25, 0x98, 0x8a, 0x27, 0x69, 0x67, 0x58, 0x33, 0xde, 0xa2, 0xa3, 0x7a, 0xa9,
+	0x81, 0x59, 0xe7, 0xa0, 0x60, 0x7d, 0x87, 0xf8, 0x50, 0xff, 0x21, 0xde, 0x33, 0x70, 0x8f, 0x1f,
+	0x3c, 0x70, 0x87, 0x0f, 0x19, 0xb8, 0x23, 0xbd, 0x03, 0xb7, 0xe7, 0x23, 0x8f, 0x58, 0xff, 0x54,
+	0xe3, 0xf4, 0x7c, 0xe4, 0x65, 0x03, 0xfd, 0x1b, 0x3f, 0xf2, 0xd6, 0xff, 0x04, 0x38, 0xce, 0xfd,
+	0xa2, 0xc7, 0x30, 0x2c, 0xee, 0x2b, 0xe8, 0x42, 0x9e, 0xa1, 0xfe, 0xab, 0x51, 0x79, 0xe9, 0x50,
+	0x9e, 0x08, 0x58, 0xad, 0x7e, 0xfc, 0xf3, 0xef, 0x9f, 0x0f, 0x2e, 0xa0, 0xb2, 0xb6, 0xef, 0x75,
+	0x0d, 0x7d, 0xaf, 0xc0, 0x54, 0x4f, 0xd2, 0x48, 0xdb, 0x37, 0x40, 0xfe, 0xbd, 0xa9, 0xbc, 0x5a,
+	0x5c, 0x20, 0xad, 0x5d, 0xe3, 0xd6, 0x2e, 0xa3, 0x17, 0xb5, 0x02, 0x17, 0x45, 0xed, 0x51, 0xf7,
+	0xf7, 0xee, 0x63, 0xb4, 0xa7, 0xc0, 0xfc, 0x01, 0x17, 0x14, 0x74, 0xb5, 0xa8, 0xa1, 0x9c, 0xcb,
+	0x53, 0xf9, 0x95, 0xbf, 0x27, 0x96, 0x99, 0xdd, 0xe2, 0x99, 0x6d, 0xa1, 0x8d, 0x22, 0x99, 0x19,
+	0xf5, 0xb6, 0x91, 0xb9, 0xa8, 0x69, 0x8f, 0x32, 0x3f, 0x1e, 0xa3, 0xaf, 0x14, 0x98, 0xee, 0x09,
+	0xc9, 0x50, 0xe1, 0x5a, 0xa7, 0xf9, 0xac, 0x3d, 0x87, 0x42, 0x26, 0xb1, 0xc2, 0x93, 0x58, 0x42,
+	0x8b, 0x45, 0x92, 0x60, 0xe8, 0x47, 0x05, 0x66, 0xfa, 0xee, 0x12, 0xa8, 0x40, 0xdc, 0x9e, 0xab,
+	0x4d, 0x79, 0xfd, 0x79, 0x24, 0xd2, 0xeb, 0x75, 0xee, 0xf5, 0x0a, 0xba, 0x7c, 0xb0, 0x57, 0x22,
+	0x75, 0xfd, 0xcd, 0xf4, 0xa5, 0x02, 0xa5, 0xee, 0x6f, 0x0b, 0x54, 0x3b, 0xdc, 0x47, 0xf6, 0x1b,
+	0xa5, 0xac, 0x15, 0xe6, 0x4b, 0xd3, 0xff, 0xe3, 0xa6, 0x17, 0xd1, 0xf9, 0x83, 0x4d, 0x33, 0xee,
+	0x26, 0x36, 0xd8, 0x7d, 0x20, 0x1e, 0x60, 0x30, 0xf7, 0x80, 0x2e, 0x6b, 0x85, 0xf9, 0x45, 0x0c,
+	0x26, 0x15, 0x4c, 0xdc, 0x74, 0x1a, 0xb5, 0x33, 0x78, 0x0f, 0x6d, 0xd4, 0xbe, 0xc3, 0xa0, 0xbc,
+	0xf6, 0x1c, 0x8a, 0xe2, 0x8d, 0x4a, 0xac, 0xd4, 0xe8, 0xe6, 0xca, 0x93, 0x67, 0x15, 0xe5, 0xe9,
+	0xb3, 0x8a, 0xf2, 0xdb, 0xb3, 0x8a, 0xf2, 0xd9, 0x5e, 0x65, 0xe0, 0xe9, 0x5e, 0x65, 0xe0, 0x97,
+	0xbd, 0xca, 0xc0, 0xbb, 0xb3, 0x1f, 0x76, 0x49, 0xc3, 0x76, 0x93, 0xb0, 0xfa, 0x30, 0xff, 0x1f,
+	0xaa, 0xff, 0xff, 0x35, 0x00, 0x6a, 0x6f, 0x02, 0x58, 0xb1, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1917,6 +1993,55 @@ func (m *QueryMigrationEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Erc20BalanceCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Erc20BalanceCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.IcaControllerCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IcaControllerCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.GroupAdminCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupAdminCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.GroupMembershipCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupMembershipCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.GovVoteCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GovVoteCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.GovDepositCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GovDepositCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.WasmAdminCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WasmAdminCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.ValidatorJailed {
 		i--
 		if m.ValidatorJailed {
@@ -2584,6 +2709,27 @@ func (m *QueryMigrationEstimateResponse) Size() (n int) {
 	if m.ValidatorJailed {
 		n += 3
 	}
+	if m.WasmAdminCount != 0 {
+		n += 2 + sovQuery(uint64(m.WasmAdminCount))
+	}
+	if m.GovDepositCount != 0 {
+		n += 2 + sovQuery(uint64(m.GovDepositCount))
+	}
+	if m.GovVoteCount != 0 {
+		n += 2 + sovQuery(uint64(m.GovVoteCount))
+	}
+	if m.GroupMembershipCount != 0 {
+		n += 2 + sovQuery(uint64(m.GroupMembershipCount))
+	}
+	if m.GroupAdminCount != 0 {
+		n += 2 + sovQuery(uint64(m.GroupAdminCount))
+	}
+	if m.IcaControllerCount != 0 {
+		n += 2 + sovQuery(uint64(m.IcaControllerCount))
+	}
+	if m.Erc20BalanceCount != 0 {
+		n += 2 + sovQuery(uint64(m.Erc20BalanceCount))
+	}
 	return n
 }
 
@@ -3938,6 +4084,139 @@ func (m *QueryMigrationEstimateResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ValidatorJailed = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmAdminCount", wireType)
+			}
+			m.WasmAdminCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmAdminCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDepositCount", wireType)
+			}
+			m.GovDepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovDepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteCount", wireType)
+			}
+			m.GovVoteCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovVoteCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembershipCount", wireType)
+			}
+			m.GroupMembershipCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupMembershipCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAdminCount", wireType)
+			}
+			m.GroupAdminCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupAdminCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaControllerCount", wireType)
+			}
+			m.IcaControllerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcaControllerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20BalanceCount", wireType)
+			}
+			m.Erc20BalanceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Erc20BalanceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
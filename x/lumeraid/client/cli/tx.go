@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+const (
+	flagAlgo            = "algo"
+	flagKeyIndex        = "key-index"
+	flagCurrentKeyIndex = "current-key-index"
+)
+
+// GetTxCmd returns the custom tx commands for lumeraid.
+// The PQ key commands derive LegRoast keys from the signing key in the
+// keyring, so they cannot be autogenerated.
+func GetTxCmd() *cobra.Command {
+	lumeraidTxCmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "LumeraID transaction commands",
+		RunE:  client.ValidateCmd,
+	}
+
+	lumeraidTxCmd.AddCommand(
+		CmdRegisterPQKey(),
+		CmdRotatePQKey(),
+		CmdRevokePQKey(),
+	)
+
+	return lumeraidTxCmd
+}
+
+func CmdRegisterPQKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-pq-key --from [key] --algo [algorithm]",
+		Short: "Register a LegRoast post-quantum key for the signing account",
+		Long: `Register a LegRoast post-quantum key for the signing account.
+
+The key is derived from the --from key in the keyring. Its index defaults to
+the key version being registered minus one, so an account that revoked its
+key registers the next derived key.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			current, found, err := queryPQKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			version := uint64(1)
+			if found {
+				if !current.Revoked {
+					return errors.New("account already has an active PQ key; use rotate-pq-key")
+				}
+				version = current.Version + 1
+			}
+
+			pqKey, err := derivePQKey(cmd, clientCtx, flagKeyIndex, version-1)
+			if err != nil {
+				return err
+			}
+			payload, err := bindingPayload(clientCtx, pqKey.PublicKey(), version)
+			if err != nil {
+				return err
+			}
+			signature, err := pqKey.Sign(payload)
+			if err != nil {
+				return fmt.Errorf("failed to sign PQ key binding: %w", err)
+			}
+
+			msg := types.NewMsgRegisterPQKey(clientCtx.GetFromAddress().String(), pqKey.PublicKey(), signature)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAlgo, "", "The LegRoast algorithm to sign with (default: LegendreMiddle)")
+	cmd.Flags().Uint64(flagKeyIndex, 0, "Index of the derived LegRoast key to register (default: version - 1)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRotatePQKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-pq-key --from [key] --algo [algorithm]",
+		Short: "Replace the signing account's LegRoast post-quantum key",
+		Long: `Replace the signing account's LegRoast post-quantum key.
+
+Both the registered and the new key are derived from the --from key in the
+keyring and sign the binding of the new key version. The indexes default to
+the registered version minus one and the new version minus one.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			current, found, err := queryPQKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			if !found || current.Revoked {
+				return errors.New("account has no active PQ key; use register-pq-key")
+			}
+			version := current.Version + 1
+
+			currentKey, err := derivePQKey(cmd, clientCtx, flagCurrentKeyIndex, current.Version-1)
+			if err != nil {
+				return err
+			}
+			if string(currentKey.PublicKey()) != string(current.PublicKey) {
+				return errors.New("derived key does not match the registered PQ key; set --current-key-index")
+			}
+			newKey, err := derivePQKey(cmd, clientCtx, flagKeyIndex, version-1)
+			if err != nil {
+				return err
+			}
+
+			payload, err := bindingPayload(clientCtx, newKey.PublicKey(), version)
+			if err != nil {
+				return err
+			}
+			newSignature, err := newKey.Sign(payload)
+			if err != nil {
+				return fmt.Errorf("failed to sign PQ key binding with the new key: %w", err)
+			}
+			currentSignature, err := currentKey.Sign(payload)
+			if err != nil {
+				return fmt.Errorf("failed to sign PQ key binding with the current key: %w", err)
+			}
+
+			msg := types.NewMsgRotatePQKey(clientCtx.GetFromAddress().String(), newKey.PublicKey(), newSignature, currentSignature)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAlgo, "", "The LegRoast algorithm to sign with (default: LegendreMiddle)")
+	cmd.Flags().Uint64(flagKeyIndex, 0, "Index of the derived LegRoast key to rotate to (default: new version - 1)")
+	cmd.Flags().Uint64(flagCurrentKeyIndex, 0, "Index of the derived LegRoast key that is registered (default: version - 1)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRevokePQKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-pq-key --from [key] --algo [algorithm]",
+		Short: "Revoke the signing account's LegRoast post-quantum key",
+		Long: `Revoke the signing account's LegRoast post-quantum key.
+
+The registered key is derived from the --from key in the keyring and signs the
+revocation. Its index defaults to the registered version minus one.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			current, found, err := queryPQKey(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			if !found || current.Revoked {
+				return errors.New("account has no active PQ key")
+			}
+
+			currentKey, err := derivePQKey(cmd, clientCtx, flagCurrentKeyIndex, current.Version-1)
+			if err != nil {
+				return err
+			}
+			if string(currentKey.PublicKey()) != string(current.PublicKey) {
+				return errors.New("derived key does not match the registered PQ key; set --current-key-index")
+			}
+
+			payload := types.PQKeyRevocationPayload(clientCtx.ChainID, current.Address, current.PublicKey, current.Version)
+			signature, err := currentKey.Sign(payload)
+			if err != nil {
+				return fmt.Errorf("failed to sign PQ key revocation: %w", err)
+			}
+
+			msg := types.NewMsgRevokePQKey(clientCtx.GetFromAddress().String(), signature)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAlgo, "", "The LegRoast algorithm to sign with (default: LegendreMiddle)")
+	cmd.Flags().Uint64(flagCurrentKeyIndex, 0, "Index of the derived LegRoast key that is registered (default: version - 1)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryPQKey returns the PQ key registered by the --from account.
+func queryPQKey(cmd *cobra.Command, clientCtx client.Context) (types.PQKey, bool, error) {
+	res, err := types.NewQueryClient(clientCtx).PQKey(cmd.Context(), &types.QueryPQKeyRequest{
+		Address: clientCtx.GetFromAddress().String(),
+	})
+	if status.Code(err) == codes.NotFound {
+		return types.PQKey{}, false, nil
+	}
+	if err != nil {
+		return types.PQKey{}, false, err
+	}
+	return res.PqKey, true, nil
+}
+
+// derivePQKey derives the LegRoast key whose index is set by indexFlag, or
+// defaultIndex when the flag is unset.
+func derivePQKey(cmd *cobra.Command, clientCtx client.Context, indexFlag string, defaultIndex uint64) (legroast.LegRoastInterface, error) {
+	algoFlag, _ := cmd.Flags().GetString(flagAlgo)
+	alg, err := legroast.GetLegRoastAlgorithm(algoFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	index := defaultIndex
+	if cmd.Flags().Changed(indexFlag) {
+		if index, err = cmd.Flags().GetUint64(indexFlag); err != nil {
+			return nil, err
+		}
+	}
+
+	return legroast.NewKeyAt(clientCtx.GetFromAddress().String(), clientCtx.Keyring, alg, index)
+}
+
+// bindingPayload builds the payload binding pqPubKey to the --from account key.
+func bindingPayload(clientCtx client.Context, pqPubKey []byte, version uint64) ([]byte, error) {
+	record, err := clientCtx.Keyring.KeyByAddress(clientCtx.GetFromAddress())
+	if err != nil {
+		return nil, fmt.Errorf("address not found in keyring: %w", err)
+	}
+	accountPubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account public key: %w", err)
+	}
+
+	return types.PQKeyBindingPayload(
+		clientCtx.ChainID,
+		clientCtx.GetFromAddress().String(),
+		sdk.MsgTypeURL(accountPubKey),
+		accountPubKey.Bytes(),
+		pqPubKey,
+		version,
+	), nil
+}
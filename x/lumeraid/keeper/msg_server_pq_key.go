@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+// RegisterPQKey binds a LegRoast key to the creator's account. An account
+// whose key was revoked may register a different key under the next version.
+func (k msgServer) RegisterPQKey(goCtx context.Context, msg *types.MsgRegisterPQKey) (*types.MsgRegisterPQKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	existing, found, err := k.GetPQKey(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	version := uint64(1)
+	if found {
+		if !existing.Revoked {
+			return nil, errorsmod.Wrapf(types.ErrPQKeyExists, "%s must rotate its PQ key instead", msg.Creator)
+		}
+		if bytes.Equal(existing.PublicKey, msg.PublicKey) {
+			return nil, errorsmod.Wrap(types.ErrInvalidPQKey, "a revoked PQ key cannot be registered again")
+		}
+		version = existing.Version + 1
+	}
+
+	pqKey, err := k.newPQKey(ctx, msg.Creator, msg.PublicKey, version)
+	if err != nil {
+		return nil, err
+	}
+	payload := types.PQKeyBindingPayload(ctx.ChainID(), pqKey.Address, pqKey.AccountKeyType, pqKey.AccountPublicKey, pqKey.PublicKey, version)
+	if err := k.VerifyLegRoastSignature(ctx, payload, msg.PublicKey, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetPQKey(ctx, pqKey); err != nil {
+		return nil, err
+	}
+	emitPQKeyEvent(ctx, types.EventTypePQKeyRegistered, pqKey)
+
+	return &types.MsgRegisterPQKeyResponse{Version: version}, nil
+}
+
+// RotatePQKey replaces the creator's active LegRoast key. The current key
+// signs off on its successor, and the new key proves possession.
+func (k msgServer) RotatePQKey(goCtx context.Context, msg *types.MsgRotatePQKey) (*types.MsgRotatePQKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	current, err := k.ActivePQKey(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(current.PublicKey, msg.NewPublicKey) {
+		return nil, errorsmod.Wrap(types.ErrInvalidPQKey, "new PQ key equals the registered key")
+	}
+	version := current.Version + 1
+
+	pqKey, err := k.newPQKey(ctx, msg.Creator, msg.NewPublicKey, version)
+	if err != nil {
+		return nil, err
+	}
+	payload := types.PQKeyBindingPayload(ctx.ChainID(), pqKey.Address, pqKey.AccountKeyType, pqKey.AccountPublicKey, pqKey.PublicKey, version)
+	if err := k.VerifyLegRoastSignature(ctx, payload, msg.NewPublicKey, msg.NewSignature); err != nil {
+		return nil, errorsmod.Wrap(err, "new key")
+	}
+	if err := k.VerifyLegRoastSignature(ctx, payload, current.PublicKey, msg.CurrentSignature); err != nil {
+		return nil, errorsmod.Wrap(err, "current key")
+	}
+
+	if err := k.SetPQKey(ctx, pqKey); err != nil {
+		return nil, err
+	}
+	emitPQKeyEvent(ctx, types.EventTypePQKeyRotated, pqKey)
+
+	return &types.MsgRotatePQKeyResponse{Version: version}, nil
+}
+
+// RevokePQKey marks the creator's active LegRoast key as revoked. The key
+// itself must sign the revocation: the account signature alone is what a
+// quantum attacker could forge, and it would let them replace the PQ key.
+func (k msgServer) RevokePQKey(goCtx context.Context, msg *types.MsgRevokePQKey) (*types.MsgRevokePQKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pqKey, err := k.ActivePQKey(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	payload := types.PQKeyRevocationPayload(ctx.ChainID(), pqKey.Address, pqKey.PublicKey, pqKey.Version)
+	if err := k.VerifyLegRoastSignature(ctx, payload, pqKey.PublicKey, msg.Signature); err != nil {
+		return nil, err
+	}
+	pqKey.Revoked = true
+	pqKey.UpdatedHeight = ctx.BlockHeight()
+
+	if err := k.SetPQKey(ctx, pqKey); err != nil {
+		return nil, err
+	}
+	emitPQKeyEvent(ctx, types.EventTypePQKeyRevoked, pqKey)
+
+	return &types.MsgRevokePQKeyResponse{}, nil
+}
+
+// ActivePQKey returns the unrevoked PQ key of address, or ErrPQKeyNotFound.
+func (k Keeper) ActivePQKey(ctx sdk.Context, address string) (types.PQKey, error) {
+	pqKey, found, err := k.GetPQKey(ctx, address)
+	if err != nil {
+		return pqKey, err
+	}
+	if !found || pqKey.Revoked {
+		return pqKey, errorsmod.Wrap(types.ErrPQKeyNotFound, address)
+	}
+	return pqKey, nil
+}
+
+// newPQKey builds the record binding pubKey to the account key of address.
+// Only secp256k1 and eth_secp256k1 accounts can be bound: the binding relies
+// on the transaction signature, which multisig and module accounts do not
+// provide with a single key.
+func (k Keeper) newPQKey(ctx sdk.Context, address string, pubKey []byte, version uint64) (types.PQKey, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.PQKey{}, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil || acc.GetPubKey() == nil {
+		return types.PQKey{}, errorsmod.Wrapf(types.ErrUnsupportedAccountKey, "%s has no public key", address)
+	}
+
+	accountPubKey := acc.GetPubKey()
+	switch accountPubKey.(type) {
+	case *secp256k1.PubKey, *ethsecp256k1.PubKey:
+	default:
+		return types.PQKey{}, errorsmod.Wrapf(types.ErrUnsupportedAccountKey, "%s uses %s", address, accountPubKey.Type())
+	}
+
+	return types.PQKey{
+		Address:          address,
+		PublicKey:        pubKey,
+		AccountPublicKey: accountPubKey.Bytes(),
+		AccountKeyType:   accountKeyType(accountPubKey),
+		Version:          version,
+		UpdatedHeight:    ctx.BlockHeight(),
+	}, nil
+}
+
+// VerifyLegRoastSignature charges the verification gas before checking
+// signature, so failed verifications are paid for as well. Other modules
+// accepting LegRoast signatures use it to share the same gas calibration.
+func (k Keeper) VerifyLegRoastSignature(ctx sdk.Context, payload, pubKey, signature []byte) error {
+	ctx.GasMeter().ConsumeGas(k.GetParams(ctx).LegroastVerifyGas, "legroast signature verification")
+	if err := legroast.Verify(payload, pubKey, signature); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSignature, err.Error())
+	}
+	return nil
+}
+
+func accountKeyType(pubKey cryptotypes.PubKey) string {
+	return sdk.MsgTypeURL(pubKey)
+}
+
+func emitPQKeyEvent(ctx sdk.Context, eventType string, pqKey types.PQKey) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyAddress, pqKey.Address),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(pqKey.Version, 10)),
+		),
+	)
+}
@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/keeper"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+	lumeraidmocks "github.com/LumeraProtocol/lumera/x/lumeraid/mocks"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+const pqTestChainID = "lumera-pq-test"
+
+type pqKeyFixture struct {
+	keeper keeper.Keeper
+	ms     types.MsgServer
+	ctx    sdk.Context
+
+	addr          sdk.AccAddress
+	accountPubKey cryptotypes.PubKey
+}
+
+func setupPQKeyFixture(t *testing.T, accountPubKey cryptotypes.PubKey) pqKeyFixture {
+	t.Helper()
+	ctl := gomock.NewController(t)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	addr := sdk.AccAddress(accountPubKey.Address())
+	accountKeeper := lumeraidmocks.NewMockAccountKeeper(ctl)
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), addr).
+		Return(authtypes.NewBaseAccount(addr, accountPubKey, 1, 0)).AnyTimes()
+
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		lumeraidmocks.NewMockBankKeeper(ctl),
+		accountKeeper,
+	)
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{ChainID: pqTestChainID, Height: 10}, false, log.NewNopLogger())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	return pqKeyFixture{
+		keeper:        k,
+		ms:            keeper.NewMsgServerImpl(k),
+		ctx:           ctx,
+		addr:          addr,
+		accountPubKey: accountPubKey,
+	}
+}
+
+// newTestLegRoastKey returns a LegRoast key pair derived from seed using the
+// fastest variant.
+func newTestLegRoastKey(t *testing.T, seed string) legroast.LegRoastInterface {
+	t.Helper()
+	lr := legroast.NewLegRoast(legroast.PowerMiddle)
+	require.NoError(t, lr.Keygen([]byte(seed)))
+	return lr
+}
+
+func (f pqKeyFixture) sign(t *testing.T, signer legroast.LegRoastInterface, pqPubKey []byte, version uint64) []byte {
+	t.Helper()
+	payload := types.PQKeyBindingPayload(pqTestChainID, f.addr.String(), sdk.MsgTypeURL(f.accountPubKey), f.accountPubKey.Bytes(), pqPubKey, version)
+	sig, err := signer.Sign(payload)
+	require.NoError(t, err)
+	return sig
+}
+
+// signRevocation signs the revocation payload of signer's key at version.
+func (f pqKeyFixture) signRevocation(t *testing.T, signer legroast.LegRoastInterface, version uint64) []byte {
+	t.Helper()
+	sig, err := signer.Sign(types.PQKeyRevocationPayload(pqTestChainID, f.addr.String(), signer.PublicKey(), version))
+	require.NoError(t, err)
+	return sig
+}
+
+func (f pqKeyFixture) register(t *testing.T, lr legroast.LegRoastInterface, version uint64) (*types.MsgRegisterPQKeyResponse, error) {
+	t.Helper()
+	return f.ms.RegisterPQKey(f.ctx, types.NewMsgRegisterPQKey(f.addr.String(), lr.PublicKey(), f.sign(t, lr, lr.PublicKey(), version)))
+}
+
+func TestRegisterPQKey(t *testing.T) {
+	f := setupPQKeyFixture(t, secp256k1.GenPrivKey().PubKey())
+	lr := newTestLegRoastKey(t, "0000000000000001")
+
+	gasBefore := f.ctx.GasMeter().GasConsumed()
+	resp, err := f.register(t, lr, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Version)
+	require.GreaterOrEqual(t, f.ctx.GasMeter().GasConsumed()-gasBefore, types.DefaultLegroastVerifyGas)
+
+	pqKey, found, err := f.keeper.GetPQKey(f.ctx, f.addr.String())
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, lr.PublicKey(), pqKey.PublicKey)
+	require.Equal(t, f.accountPubKey.Bytes(), pqKey.AccountPublicKey)
+	require.Equal(t, "/cosmos.crypto.secp256k1.PubKey", pqKey.AccountKeyType)
+	require.Equal(t, int64(10), pqKey.UpdatedHeight)
+	require.False(t, pqKey.Revoked)
+
+	// An active key must be rotated, not registered again.
+	_, err = f.register(t, newTestLegRoastKey(t, "0000000000000002"), 1)
+	require.ErrorIs(t, err, types.ErrPQKeyExists)
+}
+
+func TestRegisterPQKey_RejectsUnboundSignatures(t *testing.T) {
+	f := setupPQKeyFixture(t, secp256k1.GenPrivKey().PubKey())
+	lr := newTestLegRoastKey(t, "0000000000000001")
+	other := newTestLegRoastKey(t, "0000000000000002")
+
+	testCases := []struct {
+		name string
+		sig  []byte
+	}{
+		{name: "signed by another key", sig: f.sign(t, other, lr.PublicKey(), 1)},
+		{name: "signed for another version", sig: f.sign(t, lr, lr.PublicKey(), 2)},
+		{
+			name: "signed for another chain",
+			sig: func() []byte {
+				payload := types.PQKeyBindingPayload("other-chain", f.addr.String(), sdk.MsgTypeURL(f.accountPubKey), f.accountPubKey.Bytes(), lr.PublicKey(), 1)
+				sig, err := lr.Sign(payload)
+				require.NoError(t, err)
+				return sig
+			}(),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.ms.RegisterPQKey(f.ctx, types.NewMsgRegisterPQKey(f.addr.String(), lr.PublicKey(), tc.sig))
+			require.ErrorIs(t, err, types.ErrInvalidSignature)
+		})
+	}
+
+	_, found, err := f.keeper.GetPQKey(f.ctx, f.addr.String())
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestRegisterPQKey_RejectsUnsupportedAccountKey(t *testing.T) {
+	f := setupPQKeyFixture(t, ed25519.GenPrivKey().PubKey())
+
+	_, err := f.register(t, newTestLegRoastKey(t, "0000000000000001"), 1)
+	require.ErrorIs(t, err, types.ErrUnsupportedAccountKey)
+}
+
+func TestRotatePQKey(t *testing.T) {
+	f := setupPQKeyFixture(t, secp256k1.GenPrivKey().PubKey())
+	current := newTestLegRoastKey(t, "0000000000000001")
+	next := newTestLegRoastKey(t, "0000000000000002")
+	_, err := f.register(t, current, 1)
+	require.NoError(t, err)
+
+	// The registered key must co-sign its successor.
+	_, err = f.ms.RotatePQKey(f.ctx, types.NewMsgRotatePQKey(f.addr.String(), next.PublicKey(),
+		f.sign(t, next, next.PublicKey(), 2), f.sign(t, next, next.PublicKey(), 2)))
+	require.Error(t, err)
+	_, err = f.ms.RotatePQKey(f.ctx, types.NewMsgRotatePQKey(f.addr.String(), next.PublicKey(),
+		f.sign(t, next, next.PublicKey(), 2), f.sign(t, newTestLegRoastKey(t, "0000000000000003"), next.PublicKey(), 2)))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	resp, err := f.ms.RotatePQKey(f.ctx, types.NewMsgRotatePQKey(f.addr.String(), next.PublicKey(),
+		f.sign(t, next, next.PublicKey(), 2), f.sign(t, current, next.PublicKey(), 2)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Version)
+
+	pqKey, _, err := f.keeper.GetPQKey(f.ctx, f.addr.String())
+	require.NoError(t, err)
+	require.Equal(t, next.PublicKey(), pqKey.PublicKey)
+	require.Equal(t, uint64(2), pqKey.Version)
+}
+
+func TestRevokePQKey(t *testing.T) {
+	f := setupPQKeyFixture(t, secp256k1.GenPrivKey().PubKey())
+	revoked := newTestLegRoastKey(t, "0000000000000001")
+
+	_, err := f.ms.RevokePQKey(f.ctx, types.NewMsgRevokePQKey(f.addr.String(), f.signRevocation(t, revoked, 1)))
+	require.ErrorIs(t, err, types.ErrPQKeyNotFound)
+
+	_, err = f.register(t, revoked, 1)
+	require.NoError(t, err)
+
+	// The account signature alone is not enough: only the registered key can
+	// revoke itself, and only for its own version.
+	other := newTestLegRoastKey(t, "0000000000000003")
+	_, err = f.ms.RevokePQKey(f.ctx, types.NewMsgRevokePQKey(f.addr.String(), f.signRevocation(t, other, 1)))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = f.ms.RevokePQKey(f.ctx, types.NewMsgRevokePQKey(f.addr.String(), f.signRevocation(t, revoked, 2)))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	_, err = f.ms.RevokePQKey(f.ctx, types.NewMsgRevokePQKey(f.addr.String(), f.sign(t, revoked, revoked.PublicKey(), 1)))
+	require.ErrorIs(t, err, types.ErrInvalidSignature, "a binding signature is not a revocation")
+
+	_, err = f.ms.RevokePQKey(f.ctx, types.NewMsgRevokePQKey(f.addr.String(), f.signRevocation(t, revoked, 1)))
+	require.NoError(t, err)
+
+	pqKey, found, err := f.keeper.GetPQKey(f.ctx, f.addr.String())
+	require.NoError(t, err)
+	require.True(t, found, "revoked keys stay queryable")
+	require.True(t, pqKey.Revoked)
+
+	// A revoked key cannot be rotated, and cannot come back.
+	next := newTestLegRoastKey(t, "0000000000000002")
+	_, err = f.ms.RotatePQKey(f.ctx, types.NewMsgRotatePQKey(f.addr.String(), next.PublicKey(),
+		f.sign(t, next, next.PublicKey(), 2), f.sign(t, revoked, next.PublicKey(), 2)))
+	require.ErrorIs(t, err, types.ErrPQKeyNotFound)
+	_, err = f.register(t, revoked, 2)
+	require.ErrorIs(t, err, types.ErrInvalidPQKey)
+
+	resp, err := f.register(t, next, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Version)
+}
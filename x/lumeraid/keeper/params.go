@@ -17,7 +17,7 @@ func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
 	}
 
 	k.cdc.MustUnmarshal(bz, &params)
-	return params
+	return params.WithDefaults()
 }
 
 // SetParams set the params
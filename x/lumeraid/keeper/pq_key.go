@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+// GetPQKey returns the PQ key registered by address, including a revoked one.
+func (k Keeper) GetPQKey(ctx context.Context, address string) (val types.PQKey, found bool, err error) {
+	store := k.pqKeyStore(ctx)
+
+	b := store.Get([]byte(address))
+	if b == nil {
+		return val, false, nil
+	}
+
+	if err := k.cdc.Unmarshal(b, &val); err != nil {
+		return val, true, err
+	}
+	return val, true, nil
+}
+
+// SetPQKey stores pqKey under its address.
+func (k Keeper) SetPQKey(ctx context.Context, pqKey types.PQKey) error {
+	b, err := k.cdc.Marshal(&pqKey)
+	if err != nil {
+		return err
+	}
+
+	k.pqKeyStore(ctx).Set([]byte(pqKey.Address), b)
+	return nil
+}
+
+// IteratePQKeys iterates all PQ keys in address order.
+// Returning stop=true from cb stops iteration early.
+func (k Keeper) IteratePQKeys(ctx context.Context, cb func(pqKey types.PQKey) (stop bool, err error)) error {
+	iterator := k.pqKeyStore(ctx).Iterator(nil, nil)
+	defer func() { _ = iterator.Close() }()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pqKey types.PQKey
+		if err := k.cdc.Unmarshal(iterator.Value(), &pqKey); err != nil {
+			return err
+		}
+		stop, err := cb(pqKey)
+		if err != nil {
+			return err
+		}
+		if stop {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) pqKeyStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.PQKeyKey))
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+func (q queryServer) PQKey(goCtx context.Context, req *types.QueryPQKeyRequest) (*types.QueryPQKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pqKey, found, err := q.k.GetPQKey(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no PQ key registered for %s", req.Address)
+	}
+
+	return &types.QueryPQKeyResponse{PqKey: pqKey}, nil
+}
+
+func (q queryServer) PQKeys(goCtx context.Context, req *types.QueryPQKeysRequest) (*types.QueryPQKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pqKeys []types.PQKey
+	pageRes, err := query.Paginate(q.k.pqKeyStore(ctx), req.Pagination, func(_, value []byte) error {
+		var pqKey types.PQKey
+		if err := q.k.cdc.Unmarshal(value, &pqKey); err != nil {
+			return err
+		}
+		pqKeys = append(pqKeys, pqKey)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPQKeysResponse{PqKeys: pqKeys, Pagination: pageRes}, nil
+}
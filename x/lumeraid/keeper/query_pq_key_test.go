@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/keeper"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+func TestPQKeyQueries(t *testing.T) {
+	f := setupPQKeyFixture(t, secp256k1.GenPrivKey().PubKey())
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.PQKey(f.ctx, &types.QueryPQKeyRequest{Address: f.addr.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.PQKey(f.ctx, &types.QueryPQKeyRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	lr := newTestLegRoastKey(t, "0000000000000001")
+	_, err = f.register(t, lr, 1)
+	require.NoError(t, err)
+
+	res, err := qs.PQKey(f.ctx, &types.QueryPQKeyRequest{Address: f.addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, lr.PublicKey(), res.PqKey.PublicKey)
+
+	list, err := qs.PQKeys(f.ctx, &types.QueryPQKeysRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, list.PqKeys, 1)
+	require.Equal(t, uint64(1), list.Pagination.Total)
+}
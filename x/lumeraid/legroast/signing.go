@@ -17,6 +17,34 @@ const (
 
 // GenerateLegRoastKeySeed generates a seed for the LegRoast key generation from address.
 var GenerateLegRoastKeySeed = func(address string, kr keyring.Keyring) ([]byte, error) {
+	return generateKeySeed(address, kr, LegRoastSeedPassphrase)
+}
+
+// GenerateLegRoastKeySeedAt generates the seed of the index-th LegRoast key of
+// address. Index 0 is the key used by Sign; higher indexes give the keys an
+// account rotates to.
+var GenerateLegRoastKeySeedAt = func(address string, kr keyring.Keyring, index uint64) ([]byte, error) {
+	if index == 0 {
+		return GenerateLegRoastKeySeed(address, kr)
+	}
+	return generateKeySeed(address, kr, fmt.Sprintf("%s/%d", LegRoastSeedPassphrase, index))
+}
+
+// NewKeyAt returns a LegRoast instance holding the index-th key pair of address.
+var NewKeyAt = func(address string, kr keyring.Keyring, algorithm LegRoastAlgorithm, index uint64) (LegRoastInterface, error) {
+	seed, err := GenerateLegRoastKeySeedAt(address, kr, index)
+	if err != nil {
+		return nil, err
+	}
+
+	lr := NewLegRoast(algorithm)
+	if err := lr.Keygen(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate LegRoast keys: %w", err)
+	}
+	return lr, nil
+}
+
+func generateKeySeed(address string, kr keyring.Keyring, passphrase string) ([]byte, error) {
 	// Convert address string to Cosmos SDK address
 	addr, err := types.AccAddressFromBech32(address)
 	if err != nil {
@@ -38,7 +66,7 @@ var GenerateLegRoastKeySeed = func(address string, kr keyring.Keyring) ([]byte,
 	}
 
 	// Concatenate the public key with the predefined phrase
-	dataToSign := append(pubKey.Bytes(), []byte(LegRoastSeedPassphrase)...)
+	dataToSign := append(pubKey.Bytes(), []byte(passphrase)...)
 
 	// Sign the concatenated data
 	sig, _, err := kr.SignByAddress(addr, dataToSign, signingtypes.SignMode_SIGN_MODE_DIRECT)
@@ -47,7 +75,7 @@ var GenerateLegRoastKeySeed = func(address string, kr keyring.Keyring) ([]byte,
 	}
 
 	// Hash the signature using Argon2
-	hashed := argon2.IDKey(sig, []byte(LegRoastSeedPassphrase), 1, 64*1024, 4, 32)
+	hashed := argon2.IDKey(sig, []byte(passphrase), 1, 64*1024, 4, 32)
 
 	// Extract the first 16 bytes as the seed
 	return hashed[:16], nil
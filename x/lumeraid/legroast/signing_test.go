@@ -35,6 +35,33 @@ func TestGenerateLegRoastKeySeed_InvalidAddress(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid Cosmos address")
 }
 
+// TestGenerateLegRoastKeySeedAt checks that index 0 is the Sign key and that
+// other indexes derive distinct, reproducible keys.
+func TestGenerateLegRoastKeySeedAt(t *testing.T) {
+	kr := accounts.CreateTestKeyring()
+	testAccounts := accounts.SetupTestAccounts(t, kr, []string{"test-account"})
+	address := testAccounts[0].Address
+
+	base, err := GenerateLegRoastKeySeed(address, kr)
+	require.NoError(t, err)
+	first, err := GenerateLegRoastKeySeedAt(address, kr, 0)
+	require.NoError(t, err)
+	require.Equal(t, base, first)
+
+	second, err := GenerateLegRoastKeySeedAt(address, kr, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, base, second)
+	again, err := GenerateLegRoastKeySeedAt(address, kr, 1)
+	require.NoError(t, err)
+	require.Equal(t, second, again)
+
+	lr, err := NewKeyAt(address, kr, PowerMiddle, 1)
+	require.NoError(t, err)
+	expected := NewLegRoast(PowerMiddle)
+	require.NoError(t, expected.Keygen(second))
+	require.Equal(t, expected.PublicKey(), lr.PublicKey())
+}
+
 // test Sign/Verify for different algorithms
 func TestSignAndVerify(t *testing.T) {
 	tests := []struct {
@@ -77,6 +77,22 @@ func (mr *MockKeyExchangerMockRecorder) CreateRequest(remoteAddress any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockKeyExchanger)(nil).CreateRequest), remoteAddress)
 }
 
+// CreateResponse mocks base method.
+func (m *MockKeyExchanger) CreateResponse(requestBytes, signature []byte) ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResponse", requestBytes, signature)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateResponse indicates an expected call of CreateResponse.
+func (mr *MockKeyExchangerMockRecorder) CreateResponse(requestBytes, signature any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResponse", reflect.TypeOf((*MockKeyExchanger)(nil).CreateResponse), requestBytes, signature)
+}
+
 // LocalAddress mocks base method.
 func (m *MockKeyExchanger) LocalAddress() string {
 	m.ctrl.T.Helper()
@@ -2,11 +2,15 @@ package lumeraidmocks
 
 import (
 	"crypto/ecdh"
+	"crypto/mlkem"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +21,7 @@ import (
 	"github.com/LumeraProtocol/lumera/testutil/accounts"
 	mocks "github.com/LumeraProtocol/lumera/testutil/mocks"
 	. "github.com/LumeraProtocol/lumera/x/lumeraid/securekeyx"
+	lumeraidtypes "github.com/LumeraProtocol/lumera/x/lumeraid/types"
 	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -268,3 +273,216 @@ func (suite *SecureKeyExchangeTestSuite) TestComputeSharedSecret() {
 	assert.NotEmpty(suite.T(), sharedSecret)
 	assert.Len(suite.T(), sharedSecret, 32)
 }
+
+func (suite *SecureKeyExchangeTestSuite) TestComputeSharedSecret_HybridInterop() {
+	peerTypes := []PeerType{Simplenode, Supernode}
+	modes := []struct {
+		name              string
+		initiatorHybrid   bool
+		responderHybrid   bool
+		responderResponds bool // responder answers with CreateResponse instead of CreateRequest
+		expectHybrid      bool
+	}{
+		{"hybrid to hybrid", true, true, true, true},
+		{"hybrid to legacy", true, false, false, false},
+		{"legacy to hybrid", false, true, true, false},
+		{"hybrid peers exchanging requests", true, true, false, false},
+	}
+
+	for _, initiatorType := range peerTypes {
+		for _, responderType := range peerTypes {
+			for _, mode := range modes {
+				name := fmt.Sprintf("%s/initiator=%d/responder=%d", mode.name, initiatorType, responderType)
+				suite.Run(name, func() {
+					// each peer is validated when created and by the other peer
+					suite.mockAccountClientCheck(initiatorType, 2)
+					suite.mockAccountServerCheck(responderType, 2)
+
+					var initiatorOpts, responderOpts []Option
+					if mode.initiatorHybrid {
+						initiatorOpts = append(initiatorOpts, WithHybridKeyAgreement())
+					}
+					if mode.responderHybrid {
+						responderOpts = append(responderOpts, WithHybridKeyAgreement())
+					}
+					initiator, err := NewSecureKeyExchange(suite.kr, suite.GetClientAddress(), initiatorType, nil, suite.mockValidator, initiatorOpts...)
+					require.NoError(suite.T(), err)
+					responder, err := NewSecureKeyExchange(suite.kr, suite.GetServerAddress(), responderType, nil, suite.mockValidator, responderOpts...)
+					require.NoError(suite.T(), err)
+
+					request, requestSig, err := initiator.CreateRequest(suite.GetServerAddress())
+					require.NoError(suite.T(), err)
+
+					var response, responseSig []byte
+					if mode.responderResponds {
+						response, responseSig, err = responder.CreateResponse(request, requestSig)
+					} else {
+						response, responseSig, err = responder.CreateRequest(suite.GetClientAddress())
+					}
+					require.NoError(suite.T(), err)
+
+					var responseInfo lumeraidtypes.HandshakeInfo
+					require.NoError(suite.T(), proto.Unmarshal(response, &responseInfo))
+					assert.Equal(suite.T(), mode.expectHybrid, len(responseInfo.MlkemCiphertext) > 0)
+
+					initiatorSecret, err := initiator.ComputeSharedSecret(response, responseSig)
+					require.NoError(suite.T(), err)
+					responderSecret, err := responder.ComputeSharedSecret(request, requestSig)
+					require.NoError(suite.T(), err)
+
+					assert.Len(suite.T(), initiatorSecret, 32)
+					assert.Equal(suite.T(), initiatorSecret, responderSecret)
+				})
+			}
+		}
+	}
+}
+
+func (suite *SecureKeyExchangeTestSuite) TestComputeSharedSecret_HybridCiphertextIsAuthenticated() {
+	// the responder validates the initiator in CreateResponse
+	suite.mockAccountClientCheck(Simplenode, 2)
+	suite.mockAccountServerCheck(Supernode, 1)
+
+	initiator, err := NewSecureKeyExchange(suite.kr, suite.GetClientAddress(), Simplenode, nil, suite.mockValidator, WithHybridKeyAgreement())
+	require.NoError(suite.T(), err)
+	responder, err := NewSecureKeyExchange(suite.kr, suite.GetServerAddress(), Supernode, nil, suite.mockValidator, WithHybridKeyAgreement())
+	require.NoError(suite.T(), err)
+
+	request, requestSig, err := initiator.CreateRequest(suite.GetServerAddress())
+	require.NoError(suite.T(), err)
+	response, responseSig, err := responder.CreateResponse(request, requestSig)
+	require.NoError(suite.T(), err)
+
+	// Replace the ciphertext with one encapsulated by a third party.
+	var responseInfo lumeraidtypes.HandshakeInfo
+	require.NoError(suite.T(), proto.Unmarshal(response, &responseInfo))
+	var requestInfo lumeraidtypes.HandshakeInfo
+	require.NoError(suite.T(), proto.Unmarshal(request, &requestInfo))
+	ek, err := mlkem.NewEncapsulationKey768(requestInfo.MlkemEncapsulationKey)
+	require.NoError(suite.T(), err)
+	_, responseInfo.MlkemCiphertext = ek.Encapsulate()
+	tampered, err := proto.Marshal(&responseInfo)
+	require.NoError(suite.T(), err)
+
+	_, err = initiator.ComputeSharedSecret(tampered, responseSig)
+	require.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "signature validation failed")
+}
+
+func (suite *SecureKeyExchangeTestSuite) TestComputeSharedSecret_ConcurrentHandshakes() {
+	const handshakes = 3
+	// each peer is validated when created and by the other peer once per handshake
+	suite.mockAccountClientCheck(Simplenode, 1+handshakes)
+	suite.mockAccountServerCheck(Supernode, 1+handshakes)
+
+	initiator, err := NewSecureKeyExchange(suite.kr, suite.GetClientAddress(), Simplenode, nil, suite.mockValidator)
+	require.NoError(suite.T(), err)
+	responder, err := NewSecureKeyExchange(suite.kr, suite.GetServerAddress(), Supernode, nil, suite.mockValidator)
+	require.NoError(suite.T(), err)
+
+	type exchange struct {
+		request, requestSig, response, responseSig []byte
+	}
+	exchanges := make([]exchange, handshakes)
+	for i := range exchanges {
+		exchanges[i].request, exchanges[i].requestSig, err = initiator.CreateRequest(suite.GetServerAddress())
+		require.NoError(suite.T(), err)
+	}
+	for i := range exchanges {
+		exchanges[i].response, exchanges[i].responseSig, err = responder.CreateResponse(exchanges[i].request, exchanges[i].requestSig)
+		require.NoError(suite.T(), err)
+	}
+
+	// complete the handshakes in reverse order
+	secrets := make(map[string]struct{})
+	for i := handshakes - 1; i >= 0; i-- {
+		initiatorSecret, err := initiator.ComputeSharedSecret(exchanges[i].response, exchanges[i].responseSig)
+		require.NoError(suite.T(), err)
+		responderSecret, err := responder.ComputeSharedSecret(exchanges[i].request, exchanges[i].requestSig)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), initiatorSecret, responderSecret)
+		secrets[string(initiatorSecret)] = struct{}{}
+	}
+	assert.Len(suite.T(), secrets, handshakes)
+
+	// every pending handshake was consumed
+	_, err = initiator.ComputeSharedSecret(exchanges[0].response, exchanges[0].responseSig)
+	require.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "ephemeral private key not found")
+}
+
+func (suite *SecureKeyExchangeTestSuite) TestComputeSharedSecret_SessionResumption() {
+	for _, hybrid := range []bool{false, true} {
+		suite.Run(fmt.Sprintf("hybrid=%t", hybrid), func() {
+			// Each peer is validated when created and by the other peer in the
+			// first handshake only; resumed handshakes skip the validator.
+			suite.mockAccountClientCheck(Simplenode, 2)
+			suite.mockAccountServerCheck(Supernode, 2)
+
+			opts := []Option{WithSessionTickets(time.Hour)}
+			if hybrid {
+				opts = append(opts, WithHybridKeyAgreement())
+			}
+			initiator, err := NewSecureKeyExchange(suite.kr, suite.GetClientAddress(), Simplenode, nil, suite.mockValidator, opts...)
+			require.NoError(suite.T(), err)
+			responder, err := NewSecureKeyExchange(suite.kr, suite.GetServerAddress(), Supernode, nil, suite.mockValidator, opts...)
+			require.NoError(suite.T(), err)
+			require.True(suite.T(), responder.SessionTicketsEnabled())
+
+			var previous []byte
+			for i := 0; i < 3; i++ {
+				request, requestSig, err := initiator.CreateRequest(suite.GetServerAddress())
+				require.NoError(suite.T(), err)
+				response, responseSig, err := responder.CreateResponse(request, requestSig)
+				require.NoError(suite.T(), err)
+
+				var requestInfo, responseInfo lumeraidtypes.HandshakeInfo
+				require.NoError(suite.T(), proto.Unmarshal(request, &requestInfo))
+				require.NoError(suite.T(), proto.Unmarshal(response, &responseInfo))
+				assert.Equal(suite.T(), i > 0, len(requestInfo.SessionTicket) > 0)
+				assert.Equal(suite.T(), i > 0, responseInfo.SessionResumed)
+				assert.NotEmpty(suite.T(), responseInfo.SessionTicket)
+				assert.Equal(suite.T(), requestInfo.Nonce, responseInfo.PeerNonce)
+
+				initiatorSecret, err := initiator.ComputeSharedSecret(response, responseSig)
+				require.NoError(suite.T(), err)
+				responderSecret, err := responder.ComputeSharedSecret(request, requestSig)
+				require.NoError(suite.T(), err)
+				assert.Len(suite.T(), initiatorSecret, 32)
+				assert.Equal(suite.T(), initiatorSecret, responderSecret)
+				assert.NotEqual(suite.T(), previous, initiatorSecret)
+				previous = initiatorSecret
+			}
+		})
+	}
+}
+
+func (suite *SecureKeyExchangeTestSuite) TestComputeSharedSecret_UnknownSessionTicket() {
+	// The responder restarted and lost its ticket keys, so the second handshake
+	// falls back to the full validation on both sides.
+	suite.mockAccountClientCheck(Simplenode, 3)
+	suite.mockAccountServerCheck(Supernode, 4)
+
+	initiator, err := NewSecureKeyExchange(suite.kr, suite.GetClientAddress(), Simplenode, nil, suite.mockValidator, WithSessionTickets(time.Hour))
+	require.NoError(suite.T(), err)
+
+	for i := 0; i < 2; i++ {
+		responder, err := NewSecureKeyExchange(suite.kr, suite.GetServerAddress(), Supernode, nil, suite.mockValidator, WithSessionTickets(time.Hour))
+		require.NoError(suite.T(), err)
+
+		request, requestSig, err := initiator.CreateRequest(suite.GetServerAddress())
+		require.NoError(suite.T(), err)
+		response, responseSig, err := responder.CreateResponse(request, requestSig)
+		require.NoError(suite.T(), err)
+
+		var responseInfo lumeraidtypes.HandshakeInfo
+		require.NoError(suite.T(), proto.Unmarshal(response, &responseInfo))
+		assert.False(suite.T(), responseInfo.SessionResumed)
+
+		initiatorSecret, err := initiator.ComputeSharedSecret(response, responseSig)
+		require.NoError(suite.T(), err)
+		responderSecret, err := responder.ComputeSharedSecret(request, requestSig)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), initiatorSecret, responderSecret)
+	}
+}
@@ -18,6 +18,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "PQKey",
+					Use:            "pq-key [address]",
+					Short:          "Shows the post-quantum key registered by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "PQKeys",
+					Use:       "pq-keys",
+					Short:     "Lists the registered post-quantum keys",
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -30,6 +41,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "RegisterPQKey",
+					Skip:      true, // custom hand-written command in x/lumeraid/client/cli/tx.go (signs with a derived LegRoast key)
+				},
+				{
+					RpcMethod: "RotatePQKey",
+					Skip:      true, // custom hand-written command in x/lumeraid/client/cli/tx.go (signs with derived LegRoast keys)
+				},
+				{
+					RpcMethod: "RevokePQKey",
+					Skip:      true, // custom hand-written command in x/lumeraid/client/cli/tx.go (signs with the registered LegRoast key)
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -13,12 +13,23 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	for _, pqKey := range genState.PqKeys {
+		if err := k.SetPQKey(ctx, pqKey); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	if err := k.IteratePQKeys(ctx, func(pqKey types.PQKey) (bool, error) {
+		genesis.PqKeys = append(genesis.PqKeys, pqKey)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
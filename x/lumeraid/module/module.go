@@ -35,6 +35,7 @@ var (
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
 
 	_ autocli.HasCustomQueryCommand = (*AppModule)(nil)
+	_ autocli.HasCustomTxCommand    = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -93,6 +94,12 @@ func (ab AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetCustomQueryCmd()
 }
 
+// GetTxCmd returns the custom tx commands for the lumeraid module.
+// This command will be enhanced by AutoCLI as defined in the AutoCLIOptions() method.
+func (ab AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
 // ----------------------------------------------------------------------------
 // AppModule
 // ----------------------------------------------------------------------------
@@ -0,0 +1,119 @@
+package securekeyx
+
+import (
+	"container/list"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultHandshakeTTL is how long a pending handshake waits for the remote peer's handshake.
+	DefaultHandshakeTTL = 30 * time.Second
+	// DefaultMaxPendingHandshakes bounds the number of pending handshakes and stored session tickets.
+	DefaultMaxPendingHandshakes = 4096
+)
+
+// pendingKey identifies a pending handshake by the remote address and the nonce
+// the remote peer's handshake refers to: the local nonce for requests, the
+// request nonce for responses.
+type pendingKey struct {
+	address string
+	nonce   string
+}
+
+type pendingEntry struct {
+	key       pendingKey
+	keys      *ephemeralKey
+	expiresAt time.Time
+}
+
+// handshakeCache holds pending handshakes in insertion order. All entries share
+// the same TTL, so the front of the list is always the next entry to expire.
+type handshakeCache struct {
+	ttl     time.Duration
+	maxSize int
+
+	order     *list.List
+	entries   map[pendingKey]*list.Element
+	byAddress map[string]map[string]*list.Element // address -> nonce -> entry
+}
+
+func newHandshakeCache(ttl time.Duration, maxSize int) *handshakeCache {
+	return &handshakeCache{
+		ttl:       ttl,
+		maxSize:   maxSize,
+		order:     list.New(),
+		entries:   make(map[pendingKey]*list.Element),
+		byAddress: make(map[string]map[string]*list.Element),
+	}
+}
+
+// put stores keys under key, evicting expired entries and, when the cache is
+// full, the oldest pending handshake.
+func (c *handshakeCache) put(now time.Time, key pendingKey, keys *ephemeralKey) {
+	c.evictExpired(now)
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	for c.order.Len() >= c.maxSize {
+		c.remove(c.order.Front())
+	}
+
+	el := c.order.PushBack(&pendingEntry{key: key, keys: keys, expiresAt: now.Add(c.ttl)})
+	c.entries[key] = el
+	nonces, ok := c.byAddress[key.address]
+	if !ok {
+		nonces = make(map[string]*list.Element)
+		c.byAddress[key.address] = nonces
+	}
+	nonces[key.nonce] = el
+}
+
+// take removes and returns the pending handshake stored under key.
+func (c *handshakeCache) take(now time.Time, key pendingKey) (*ephemeralKey, bool) {
+	c.evictExpired(now)
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.remove(el)
+	return el.Value.(*pendingEntry).keys, true
+}
+
+// takeOnly removes and returns the only pending handshake with address. It
+// serves peers that do not echo nonces, which cannot run concurrent handshakes.
+func (c *handshakeCache) takeOnly(now time.Time, address string) (*ephemeralKey, error) {
+	c.evictExpired(now)
+	nonces := c.byAddress[address]
+	switch len(nonces) {
+	case 0:
+		return nil, fmt.Errorf("ephemeral private key not found for address: %s", address)
+	case 1:
+		for _, el := range nonces {
+			c.remove(el)
+			return el.Value.(*pendingEntry).keys, nil
+		}
+	}
+	return nil, fmt.Errorf("%d handshakes with %s are pending and the remote handshake does not echo a nonce", len(nonces), address)
+}
+
+func (c *handshakeCache) len() int {
+	return c.order.Len()
+}
+
+func (c *handshakeCache) evictExpired(now time.Time) {
+	for el := c.order.Front(); el != nil && !now.Before(el.Value.(*pendingEntry).expiresAt); el = c.order.Front() {
+		c.remove(el)
+	}
+}
+
+func (c *handshakeCache) remove(el *list.Element) {
+	entry := c.order.Remove(el).(*pendingEntry)
+	delete(c.entries, entry.key)
+	if nonces := c.byAddress[entry.key.address]; nonces != nil {
+		delete(nonces, entry.key.nonce)
+		if len(nonces) == 0 {
+			delete(c.byAddress, entry.key.address)
+		}
+	}
+}
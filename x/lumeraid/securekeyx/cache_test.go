@@ -0,0 +1,72 @@
+package securekeyx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandshakeCache_Expiry(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	cache := newHandshakeCache(30*time.Second, 10)
+
+	keys := &ephemeralKey{}
+	cache.put(now, pendingKey{address: "peer", nonce: "a"}, keys)
+	cache.put(now.Add(10*time.Second), pendingKey{address: "peer", nonce: "b"}, &ephemeralKey{})
+
+	got, ok := cache.take(now.Add(29*time.Second), pendingKey{address: "peer", nonce: "a"})
+	require.True(t, ok)
+	assert.Same(t, keys, got)
+
+	// "b" expires 30s after it was stored
+	_, ok = cache.take(now.Add(40*time.Second), pendingKey{address: "peer", nonce: "b"})
+	assert.False(t, ok)
+	assert.Zero(t, cache.len())
+}
+
+func TestHandshakeCache_Bounded(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	cache := newHandshakeCache(time.Minute, 2)
+
+	cache.put(now, pendingKey{address: "peer1", nonce: "a"}, &ephemeralKey{})
+	cache.put(now, pendingKey{address: "peer2", nonce: "b"}, &ephemeralKey{})
+	cache.put(now, pendingKey{address: "peer3", nonce: "c"}, &ephemeralKey{})
+	assert.Equal(t, 2, cache.len())
+
+	// the oldest handshake was evicted
+	_, ok := cache.take(now, pendingKey{address: "peer1", nonce: "a"})
+	assert.False(t, ok)
+	_, ok = cache.take(now, pendingKey{address: "peer2", nonce: "b"})
+	assert.True(t, ok)
+
+	// storing a key again replaces the pending handshake
+	replacement := &ephemeralKey{}
+	cache.put(now, pendingKey{address: "peer3", nonce: "c"}, replacement)
+	assert.Equal(t, 1, cache.len())
+	got, ok := cache.take(now, pendingKey{address: "peer3", nonce: "c"})
+	require.True(t, ok)
+	assert.Same(t, replacement, got)
+}
+
+func TestHandshakeCache_TakeOnly(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	cache := newHandshakeCache(time.Minute, 10)
+
+	_, err := cache.takeOnly(now, "peer")
+	assert.ErrorContains(t, err, "ephemeral private key not found")
+
+	keys := &ephemeralKey{}
+	cache.put(now, pendingKey{address: "peer", nonce: "a"}, keys)
+	got, err := cache.takeOnly(now, "peer")
+	require.NoError(t, err)
+	assert.Same(t, keys, got)
+
+	// without a nonce there is no way to pick one of several pending handshakes
+	cache.put(now, pendingKey{address: "peer", nonce: "a"}, &ephemeralKey{})
+	cache.put(now, pendingKey{address: "peer", nonce: "b"}, &ephemeralKey{})
+	_, err = cache.takeOnly(now, "peer")
+	assert.ErrorContains(t, err, "2 handshakes with peer are pending")
+	assert.Equal(t, 2, cache.len())
+}
@@ -3,9 +3,13 @@
 package securekeyx
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/mlkem"
 	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"sync"
 	"time"
@@ -34,10 +38,28 @@ const (
 	Supernode
 )
 
+const (
+	// KeyAgreementX25519MLKEM768 is the hybrid key agreement combining X25519
+	// with ML-KEM-768. It is advertised in HandshakeInfo.key_agreement.
+	KeyAgreementX25519MLKEM768 = "X25519MLKEM768"
+
+	// hybridSecretLabel is the HKDF info prefix for hybrid shared secrets.
+	hybridSecretLabel = "lumera/securekeyx/X25519MLKEM768/v1"
+	// hybridSecretSize is the size of the derived hybrid shared secret.
+	hybridSecretSize = 32
+
+	// handshakeNonceSize is the size of the per-handshake nonce.
+	handshakeNonceSize = 16
+)
+
 // KeyExchanger defines the interface for secure key exchange between peers using Cosmos accounts.
 type KeyExchanger interface {
 	// CreateRequest generates handshake info and signs it with the specified Cosmos account.
 	CreateRequest(remoteAddress string) ([]byte, []byte, error)
+	// CreateResponse authenticates the remote peer's request and generates handshake info answering it.
+	// It completes the hybrid key agreement when the request offers it and resumes the session when
+	// the request carries a valid session ticket.
+	CreateResponse(requestBytes, signature []byte) ([]byte, []byte, error)
 	// ComputeSharedSecret computes the shared secret using the ephemeral private key and the remote public key.
 	ComputeSharedSecret(handshakeBytes, signature []byte) ([]byte, error)
 	// PeerType returns the type of the local peer
@@ -59,10 +81,80 @@ type SecureKeyExchange struct {
 	peerType   PeerType              // local peer type (Simplenode or Supernode)
 	curve      ecdh.Curve            // curve used for ECDH key exchange
 	validator  KeyExchangerValidator // validator to check if the account is a valid
+	hybrid     bool                  // offer and accept X25519+ML-KEM-768 key agreement
+
+	handshakeTTL         time.Duration // how long a pending handshake is kept
+	maxPendingHandshakes int           // bound on pending handshakes and stored session tickets
+	ticketLifetime       time.Duration // session ticket lifetime, 0 when tickets are disabled
+
+	mutex      sync.Mutex               // mutex to protect handshakes, tickets and ticketKeys
+	handshakes *handshakeCache          // pending handshakes by [remote_address, nonce]
+	tickets    map[string]*clientTicket // session tickets received, by responder address
+	ticketKeys *ticketKeys              // keys sealing the session tickets issued by this peer
+	now        func() time.Time         // clock, replaced in tests
+	codec      *sdkcodec.ProtoCodec     // codec for serialization/deserialization
+}
+
+// ephemeralKey holds the local secrets of a pending handshake.
+type ephemeralKey struct {
+	ecdh  *ecdh.PrivateKey // key on the configured curve, always set
+	nonce []byte           // nonce sent in the local handshake
 
-	mutex         sync.Mutex                  // mutex to protect ephemeralKeys
-	ephemeralKeys map[string]*ecdh.PrivateKey // map of [remote_address -> ephemeral private keys]
-	codec         *sdkcodec.ProtoCodec        // codec for serialization/deserialization
+	// Hybrid key agreement, set only when hybrid mode is enabled.
+	x25519 *ecdh.PrivateKey
+	// mlkem is set on the initiator, which offered its encapsulation key.
+	mlkem *mlkem.DecapsulationKey768
+
+	// ticket is the session ticket offered in a request.
+	ticket *clientTicket
+
+	// CreateResponse computes the shared secret up front; ComputeSharedSecret
+	// returns it for the request the response was created for.
+	sharedSecret []byte
+	requestHash  [sha256.Size]byte
+}
+
+// Option configures a SecureKeyExchange.
+type Option func(*SecureKeyExchange)
+
+// WithHybridKeyAgreement enables the hybrid X25519+ML-KEM-768 key agreement.
+// Requests offer it alongside the classic ECDH key, and CreateResponse accepts
+// it when offered, so peers without hybrid support still interoperate.
+func WithHybridKeyAgreement() Option {
+	return func(s *SecureKeyExchange) {
+		s.hybrid = true
+	}
+}
+
+// WithHandshakeTTL sets how long a pending handshake waits for the remote
+// peer's handshake before it is evicted. The default is DefaultHandshakeTTL.
+func WithHandshakeTTL(ttl time.Duration) Option {
+	return func(s *SecureKeyExchange) {
+		s.handshakeTTL = ttl
+	}
+}
+
+// WithMaxPendingHandshakes bounds the number of pending handshakes, and of
+// session tickets kept for resumption. When the bound is reached the oldest
+// entry is evicted. The default is DefaultMaxPendingHandshakes.
+func WithMaxPendingHandshakes(n int) Option {
+	return func(s *SecureKeyExchange) {
+		s.maxPendingHandshakes = n
+	}
+}
+
+// WithSessionTickets enables session resumption. Responses carry a session
+// ticket, and a request presenting a valid ticket skips the account validation
+// through KeyExchangerValidator. Tickets are valid for lifetime, or for
+// DefaultSessionTicketLifetime if lifetime is not positive, and the keys that
+// seal them are rotated every lifetime.
+func WithSessionTickets(lifetime time.Duration) Option {
+	return func(s *SecureKeyExchange) {
+		if lifetime <= 0 {
+			lifetime = DefaultSessionTicketLifetime
+		}
+		s.ticketLifetime = lifetime
+	}
 }
 
 /*
@@ -147,6 +239,8 @@ func (s *SecureKeyExchange) checkAccountExists(accAddress sdk.AccAddress) error
 //   - localAddress: the Cosmos address of the local peer
 //   - localPeerType: the type of the local peer (Simplenode or Supernode)
 //   - curve: the curve to be used for ECDH key exchange (default is P256)
+//   - validator: validator to check remote and local accounts
+//   - opts: optional settings, e.g. WithHybridKeyAgreement or WithSessionTickets
 //
 // Returns:
 //   - SecureKeyExchange: the instance of SecureKeyExchange
@@ -157,6 +251,7 @@ func NewSecureKeyExchange(
 	localPeerType PeerType,
 	curve ecdh.Curve,
 	validator KeyExchangerValidator,
+	opts ...Option,
 ) (*SecureKeyExchange, error) {
 	accAddress, err := sdk.AccAddressFromBech32(localAddress)
 	if err != nil {
@@ -177,13 +272,29 @@ func NewSecureKeyExchange(
 	protoCodec := sdkcodec.NewProtoCodec(interfaceRegistry)
 
 	ske := &SecureKeyExchange{
-		keyring:       kr,
-		accAddress:    accAddress,
-		peerType:      localPeerType,
-		curve:         curve,
-		ephemeralKeys: make(map[string]*ecdh.PrivateKey),
-		codec:         protoCodec,
-		validator:     validator,
+		keyring:              kr,
+		accAddress:           accAddress,
+		peerType:             localPeerType,
+		curve:                curve,
+		handshakeTTL:         DefaultHandshakeTTL,
+		maxPendingHandshakes: DefaultMaxPendingHandshakes,
+		tickets:              make(map[string]*clientTicket),
+		now:                  time.Now,
+		codec:                protoCodec,
+		validator:            validator,
+	}
+	for _, opt := range opts {
+		opt(ske)
+	}
+	if ske.handshakeTTL <= 0 {
+		return nil, fmt.Errorf("handshake TTL must be positive")
+	}
+	if ske.maxPendingHandshakes <= 0 {
+		return nil, fmt.Errorf("max pending handshakes must be positive")
+	}
+	ske.handshakes = newHandshakeCache(ske.handshakeTTL, ske.maxPendingHandshakes)
+	if ske.ticketLifetime > 0 {
+		ske.ticketKeys = &ticketKeys{lifetime: ske.ticketLifetime}
 	}
 
 	// validate local peer
@@ -260,7 +371,22 @@ func (s *SecureKeyExchange) getLocalPubKey() (cryptotypes.PubKey, error) {
 	return pubKey, nil
 }
 
+// HybridEnabled reports whether the hybrid X25519+ML-KEM-768 key agreement is enabled.
+func (s *SecureKeyExchange) HybridEnabled() bool {
+	return s.hybrid
+}
+
+// SessionTicketsEnabled reports whether session resumption with tickets is enabled.
+func (s *SecureKeyExchange) SessionTicketsEnabled() bool {
+	return s.ticketKeys != nil
+}
+
 // CreateRequest generates handshake info and signs it with the local address.
+// Every request carries a fresh nonce, so several handshakes with the same peer
+// can be pending at once. With hybrid mode enabled the request also offers an
+// X25519 key and an ML-KEM-768 encapsulation key; the remote peer accepts the
+// offer by answering with CreateResponse. With session tickets enabled the
+// request presents the ticket received in the last handshake with remoteAddress.
 //
 // Parameters:
 //   - remoteAddress: the address of the remote peer
@@ -270,6 +396,115 @@ func (s *SecureKeyExchange) getLocalPubKey() (cryptotypes.PubKey, error) {
 //   - signature: signature of the handshake info (signed with the s.accAddress)
 //   - error: if any error occurs
 func (s *SecureKeyExchange) CreateRequest(remoteAddress string) ([]byte, []byte, error) {
+	keys, handshakeInfo, err := s.newEphemeralKey(remoteAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if s.hybrid {
+		if keys.x25519, err = ecdh.X25519().GenerateKey(rand.Reader); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate X25519 key for %s: %w", remoteAddress, err)
+		}
+		if keys.mlkem, err = mlkem.GenerateKey768(); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate ML-KEM-768 key for %s: %w", remoteAddress, err)
+		}
+		handshakeInfo.KeyAgreement = KeyAgreementX25519MLKEM768
+		handshakeInfo.X25519PublicKey = keys.x25519.PublicKey().Bytes()
+		handshakeInfo.MlkemEncapsulationKey = keys.mlkem.EncapsulationKey().Bytes()
+	}
+
+	if s.ticketKeys != nil {
+		s.mutex.Lock()
+		keys.ticket = s.clientTicket(s.now(), remoteAddress)
+		s.mutex.Unlock()
+		if keys.ticket != nil {
+			handshakeInfo.SessionTicket = keys.ticket.ticket
+		}
+	}
+
+	return s.signHandshake(pendingKey{address: remoteAddress, nonce: string(keys.nonce)}, keys, handshakeInfo)
+}
+
+// CreateResponse authenticates the request received from the remote peer and
+// generates handshake info answering it. The response echoes the request nonce,
+// and the shared secret is computed here; ComputeSharedSecret returns it once
+// called with the same request.
+//
+// If both peers support the hybrid key agreement, the response carries an
+// X25519 key and the ML-KEM-768 ciphertext encapsulated to the initiator's key;
+// both are covered by the account signature. With session tickets enabled, a
+// request presenting a valid ticket for the same account skips the validation
+// through KeyExchangerValidator, and every response carries a new ticket.
+//
+// Parameters:
+//   - requestBytes: the serialized handshake info received from the remote peer
+//   - signature: signature for the requestBytes (signed with the remote peer's Cosmos account)
+//
+// Returns:
+//   - handshakeBytes: the serialized handshake info to be sent to the remote peer
+//   - signature: signature of the handshake info (signed with the s.accAddress)
+//   - error: if any error occurs
+func (s *SecureKeyExchange) CreateResponse(requestBytes, signature []byte) ([]byte, []byte, error) {
+	var request lumeraidtypes.HandshakeInfo
+	if err := proto.Unmarshal(requestBytes, &request); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize handshake info: %w", err)
+	}
+	if err := s.authenticateHandshake(&request, requestBytes, signature); err != nil {
+		return nil, nil, err
+	}
+
+	var ticketState *lumeraidtypes.SessionTicketState
+	if s.ticketKeys != nil && len(request.SessionTicket) > 0 && len(request.Nonce) > 0 {
+		s.mutex.Lock()
+		state, err := s.ticketKeys.open(s.now(), request.SessionTicket)
+		s.mutex.Unlock()
+		// An unusable ticket is not an error: the peer is validated in full instead.
+		if err == nil && state.Address == request.Address && state.PeerType == request.PeerType &&
+			bytes.Equal(state.AccountPublicKey, request.AccountPublicKey) {
+			ticketState = state
+		}
+	}
+	if ticketState == nil {
+		if err := s.validatePeer(&request); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	keys, response, err := s.newEphemeralKey(request.Address)
+	if err != nil {
+		return nil, nil, err
+	}
+	response.PeerNonce = request.Nonce
+
+	var sharedSecret []byte
+	if s.hybrid && offersHybrid(&request) {
+		sharedSecret, err = s.computeResponderHybridSecret(keys, &request, response)
+	} else {
+		sharedSecret, err = ecdhSharedSecret(s.curve, keys.ecdh, request.PublicKey)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ticketState != nil {
+		if sharedSecret, err = resumedSharedSecret(sharedSecret, ticketState.ResumptionSecret, request.Nonce, keys.nonce); err != nil {
+			return nil, nil, err
+		}
+		response.SessionResumed = true
+	}
+	if s.ticketKeys != nil && len(request.Nonce) > 0 {
+		if response.SessionTicket, err = s.issueTicket(&request, sharedSecret, keys.nonce); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	keys.sharedSecret = sharedSecret
+	keys.requestHash = sha256.Sum256(requestBytes)
+	return s.signHandshake(pendingKey{address: request.Address, nonce: string(request.Nonce)}, keys, response)
+}
+
+// newEphemeralKey generates the ephemeral key and nonce of a new handshake with remoteAddress.
+func (s *SecureKeyExchange) newEphemeralKey(remoteAddress string) (*ephemeralKey, *lumeraidtypes.HandshakeInfo, error) {
 	if s.curve == nil {
 		return nil, nil, fmt.Errorf("curve not set")
 	}
@@ -279,10 +514,40 @@ func (s *SecureKeyExchange) CreateRequest(remoteAddress string) ([]byte, []byte,
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to generate ephemeral key for %s: %w", remoteAddress, err)
 	}
+	nonce := make([]byte, handshakeNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate handshake nonce for %s: %w", remoteAddress, err)
+	}
+
+	return &ephemeralKey{ecdh: privKey, nonce: nonce}, &lumeraidtypes.HandshakeInfo{Nonce: nonce}, nil
+}
+
+// issueTicket seals a session ticket for the peer that sent request. The
+// ticket's resumption secret is derived from the handshake's shared secret.
+func (s *SecureKeyExchange) issueTicket(request *lumeraidtypes.HandshakeInfo, sharedSecret, responseNonce []byte) ([]byte, error) {
+	resumptionSecret, err := deriveResumptionSecret(sharedSecret, request.Nonce, responseNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	return s.ticketKeys.seal(now, &lumeraidtypes.SessionTicketState{
+		Address:          request.Address,
+		PeerType:         request.PeerType,
+		AccountPublicKey: request.AccountPublicKey,
+		ResumptionSecret: resumptionSecret,
+		ExpiresAt:        now.Add(s.ticketLifetime).Unix(),
+	})
+}
 
-	// store ephemeral private key temporarily until shared secret is computed
+// signHandshake stores keys under key until the shared secret is computed,
+// fills in the local fields of handshakeInfo and signs it.
+func (s *SecureKeyExchange) signHandshake(key pendingKey, keys *ephemeralKey, handshakeInfo *lumeraidtypes.HandshakeInfo) ([]byte, []byte, error) {
+	// store ephemeral keys temporarily until shared secret is computed
 	s.mutex.Lock()
-	s.ephemeralKeys[remoteAddress] = privKey
+	s.handshakes.put(s.now(), key, keys)
 	s.mutex.Unlock()
 
 	// Get public key for the local Cosmos account
@@ -296,14 +561,12 @@ func (s *SecureKeyExchange) CreateRequest(remoteAddress string) ([]byte, []byte,
 		return nil, nil, fmt.Errorf("failed to marshal local account public key: %w", err)
 	}
 
-	// Create handshake info
-	handshakeInfo := &lumeraidtypes.HandshakeInfo{
-		Address:          s.LocalAddress(),
-		PeerType:         int32(s.peerType),
-		PublicKey:        privKey.PublicKey().Bytes(),
-		AccountPublicKey: accountPubKeyBytes,
-		Curve:            s.GetCurveName(),
-	}
+	// Fill in handshake info
+	handshakeInfo.Address = s.LocalAddress()
+	handshakeInfo.PeerType = int32(s.peerType)
+	handshakeInfo.PublicKey = keys.ecdh.PublicKey().Bytes()
+	handshakeInfo.AccountPublicKey = accountPubKeyBytes
+	handshakeInfo.Curve = s.GetCurveName()
 
 	// Serialize HandshakeInfo
 	handshakeBytes, err := proto.Marshal(handshakeInfo)
@@ -320,9 +583,98 @@ func (s *SecureKeyExchange) CreateRequest(remoteAddress string) ([]byte, []byte,
 	return handshakeBytes, signature, nil
 }
 
+// offersHybrid reports whether a request offers the hybrid key agreement.
+func offersHybrid(handshake *lumeraidtypes.HandshakeInfo) bool {
+	return handshake.KeyAgreement == KeyAgreementX25519MLKEM768 &&
+		len(handshake.X25519PublicKey) > 0 && len(handshake.MlkemEncapsulationKey) > 0
+}
+
+// acceptsHybrid reports whether a response completes the hybrid key agreement.
+func acceptsHybrid(handshake *lumeraidtypes.HandshakeInfo) bool {
+	return handshake.KeyAgreement == KeyAgreementX25519MLKEM768 &&
+		len(handshake.X25519PublicKey) > 0 && len(handshake.MlkemCiphertext) > 0
+}
+
+// authenticateHandshake checks that handshake was signed by the account it names.
+func (s *SecureKeyExchange) authenticateHandshake(handshake *lumeraidtypes.HandshakeInfo, handshakeBytes, signature []byte) error {
+	if handshake.AccountPublicKey == nil {
+		return fmt.Errorf("account public key is nil")
+	}
+
+	var accountPubKey cryptotypes.PubKey
+	if err := s.codec.UnmarshalInterface(handshake.AccountPublicKey, &accountPubKey); err != nil {
+		return fmt.Errorf("failed to unmarshal remote account's public key: %w", err)
+	}
+
+	derivedAddr := sdk.AccAddress(accountPubKey.Address()).String()
+	if derivedAddr != handshake.Address {
+		return fmt.Errorf("address mismatch: expected %s, got %s", derivedAddr, handshake.Address)
+	}
+
+	// Validate signature for the handshake info from the remote peer
+	isValid, err := s.validateSignature(accountPubKey, handshakeBytes, signature)
+	if err != nil || !isValid {
+		return fmt.Errorf("signature validation failed: %w", err)
+	}
+	return nil
+}
+
+// validatePeer checks the remote peer's account through KeyExchangerValidator.
+func (s *SecureKeyExchange) validatePeer(handshake *lumeraidtypes.HandshakeInfo) error {
+	switch remotePeerType := PeerType(handshake.PeerType); remotePeerType {
+	case Simplenode, Supernode:
+		remoteAccAddress, err := sdk.AccAddressFromBech32(handshake.Address)
+		if err != nil {
+			return fmt.Errorf("invalid remote address: %w", err)
+		}
+		if err := s.checkAccount(remoteAccAddress, remotePeerType); err != nil {
+			return fmt.Errorf("invalid remote peer: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid remote peer type: %d", handshake.PeerType)
+	}
+	return nil
+}
+
+// takePendingHandshake removes and returns the pending handshake the remote
+// handshake refers to: the request a response echoes, the response created for
+// a request, or, for peers that do not echo nonces, the only handshake pending
+// with the remote address.
+func (s *SecureKeyExchange) takePendingHandshake(handshake *lumeraidtypes.HandshakeInfo) (*ephemeralKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if len(handshake.PeerNonce) > 0 {
+		keys, ok := s.handshakes.take(now, pendingKey{address: handshake.Address, nonce: string(handshake.PeerNonce)})
+		if !ok {
+			return nil, fmt.Errorf("ephemeral private key not found for address: %s", handshake.Address)
+		}
+		return keys, nil
+	}
+	if len(handshake.Nonce) > 0 {
+		if keys, ok := s.handshakes.take(now, pendingKey{address: handshake.Address, nonce: string(handshake.Nonce)}); ok {
+			return keys, nil
+		}
+	}
+	return s.handshakes.takeOnly(now, handshake.Address)
+}
+
 // ComputeSharedSecret computes the shared secret using the ephemeral private key and the remote public key.
 // It also validates the signature of the handshake info.
 //
+// The pending handshake is looked up by the nonce the remote handshake echoes,
+// so concurrent handshakes with the same peer can complete in any order. Called
+// with a request answered by CreateResponse, it returns the shared secret
+// computed there.
+//
+// The hybrid X25519+ML-KEM-768 secret is used when the local peer offered it and
+// the remote peer answered with a ciphertext, or when the local peer answered the
+// remote offer with CreateResponse. In every other case both peers fall back to
+// ECDH over the configured curve. When the responder resumed the session from
+// the ticket sent in the request, the remote peer is not validated again and the
+// ticket's resumption secret is mixed into the shared secret.
+//
 // Parameters:
 //   - handshakeBytes: the serialized handshake info received from the remote peer
 //   - signature: signature for the handshakeBytes (signed with the remote peer's Cosmos account)
@@ -340,55 +692,91 @@ func (s *SecureKeyExchange) ComputeSharedSecret(handshakeBytes, signature []byte
 		return nil, fmt.Errorf("failed to deserialize handshake info: %w", err)
 	}
 
-	// Retrieve ephemeral private key
-	s.mutex.Lock()
-	// Check if ephemeral private key exists
-	privKey, exists := s.ephemeralKeys[handshake.Address]
-	if exists {
-		// Remove ephemeral private key from the map after retrieving it
-		delete(s.ephemeralKeys, handshake.Address)
-	}
-	s.mutex.Unlock()
-	if !exists {
-		return nil, fmt.Errorf("ephemeral private key not found for address: %s", handshake.Address)
+	keys, err := s.takePendingHandshake(&handshake)
+	if err != nil {
+		return nil, err
 	}
 
-	if handshake.AccountPublicKey == nil {
-		return nil, fmt.Errorf("account public key is nil")
+	if keys.sharedSecret != nil {
+		// CreateResponse already authenticated the request and computed the secret.
+		if sha256.Sum256(handshakeBytes) != keys.requestHash {
+			return nil, fmt.Errorf("handshake does not match the request answered for %s", handshake.Address)
+		}
+		return keys.sharedSecret, nil
 	}
 
-	var accountPubKey cryptotypes.PubKey
-	if err := s.codec.UnmarshalInterface(handshake.AccountPublicKey, &accountPubKey); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal remote account's public key: %w", err)
+	if err := s.authenticateHandshake(&handshake, handshakeBytes, signature); err != nil {
+		return nil, err
 	}
 
-	derivedAddr := sdk.AccAddress(accountPubKey.Address()).String()
-	if derivedAddr != handshake.Address {
-		return nil, fmt.Errorf("address mismatch: expected %s, got %s", derivedAddr, handshake.Address)
+	// Only a response to this request can resume the session.
+	isResponse := len(handshake.PeerNonce) > 0 && bytes.Equal(handshake.PeerNonce, keys.nonce)
+	if handshake.SessionResumed {
+		if !isResponse || keys.ticket == nil {
+			return nil, fmt.Errorf("remote peer resumed a session without a session ticket")
+		}
+		if PeerType(handshake.PeerType) != keys.ticket.peerType ||
+			!bytes.Equal(handshake.AccountPublicKey, keys.ticket.accountPubKey) {
+			return nil, fmt.Errorf("resumed session does not match the session ticket of %s", handshake.Address)
+		}
+	} else if err := s.validatePeer(&handshake); err != nil {
+		return nil, err
 	}
 
-	// Validate signature for the handshake info from the remote peer
-	isValid, err := s.validateSignature(accountPubKey, handshakeBytes, signature)
-	if err != nil || !isValid {
-		return nil, fmt.Errorf("signature validation failed: %w", err)
+	var sharedSecret []byte
+	if keys.mlkem != nil && acceptsHybrid(&handshake) {
+		sharedSecret, err = s.computeInitiatorHybridSecret(keys, &handshake)
+	} else {
+		sharedSecret, err = ecdhSharedSecret(s.curve, keys.ecdh, handshake.PublicKey)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	// Validate remote peer
-	switch remotePeerType := PeerType(handshake.PeerType); remotePeerType {
-	case Simplenode, Supernode:
-		remoteAccAddress, err := sdk.AccAddressFromBech32(handshake.Address)
-		if err != nil {
-			return nil, fmt.Errorf("invalid remote address: %w", err)
+	if handshake.SessionResumed {
+		if sharedSecret, err = resumedSharedSecret(sharedSecret, keys.ticket.resumptionSecret, keys.nonce, handshake.Nonce); err != nil {
+			return nil, err
 		}
-		if err := s.checkAccount(remoteAccAddress, remotePeerType); err != nil {
-			return nil, fmt.Errorf("invalid remote peer: %w", err)
+	}
+	if s.ticketKeys != nil && isResponse {
+		if err := s.keepSessionTicket(&handshake, sharedSecret, keys.nonce); err != nil {
+			return nil, err
 		}
-	default:
-		return nil, fmt.Errorf("invalid remote peer type: %d", handshake.PeerType)
 	}
 
-	// Compute shared secret
-	remotePubKey, err := s.curve.NewPublicKey(handshake.PublicKey)
+	return sharedSecret, nil
+}
+
+// keepSessionTicket stores the session ticket carried by response for the next
+// handshake with the responder, replacing the previous one.
+func (s *SecureKeyExchange) keepSessionTicket(response *lumeraidtypes.HandshakeInfo, sharedSecret, requestNonce []byte) error {
+	if len(response.SessionTicket) == 0 {
+		s.mutex.Lock()
+		delete(s.tickets, response.Address)
+		s.mutex.Unlock()
+		return nil
+	}
+
+	resumptionSecret, err := deriveResumptionSecret(sharedSecret, requestNonce, response.Nonce)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.now()
+	s.storeClientTicket(now, response.Address, &clientTicket{
+		ticket:           response.SessionTicket,
+		resumptionSecret: resumptionSecret,
+		peerType:         PeerType(response.PeerType),
+		accountPubKey:    response.AccountPublicKey,
+		expiresAt:        now.Add(s.ticketLifetime),
+	})
+	return nil
+}
+
+func ecdhSharedSecret(curve ecdh.Curve, privKey *ecdh.PrivateKey, remotePubKeyBytes []byte) ([]byte, error) {
+	remotePubKey, err := curve.NewPublicKey(remotePubKeyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse remote public key: %w", err)
 	}
@@ -397,6 +785,82 @@ func (s *SecureKeyExchange) ComputeSharedSecret(handshakeBytes, signature []byte
 	if err != nil {
 		return nil, fmt.Errorf("failed to compute shared secret: %w", err)
 	}
+	return sharedSecret, nil
+}
+
+// computeInitiatorHybridSecret decapsulates the responder's ciphertext and
+// combines it with the X25519 secret.
+func (s *SecureKeyExchange) computeInitiatorHybridSecret(keys *ephemeralKey, response *lumeraidtypes.HandshakeInfo) ([]byte, error) {
+	kemSecret, err := keys.mlkem.Decapsulate(response.MlkemCiphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decapsulate ML-KEM-768 ciphertext: %w", err)
+	}
+	x25519Secret, err := x25519SharedSecret(keys.x25519, response.X25519PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return combineHybridSecrets(kemSecret, x25519Secret, response.MlkemCiphertext,
+		keys.x25519.PublicKey().Bytes(), response.X25519PublicKey)
+}
+
+// computeResponderHybridSecret encapsulates a secret to the initiator's
+// ML-KEM-768 key, adds the X25519 key and ciphertext to response and combines
+// the encapsulated secret with the X25519 secret.
+func (s *SecureKeyExchange) computeResponderHybridSecret(keys *ephemeralKey, request, response *lumeraidtypes.HandshakeInfo) ([]byte, error) {
+	var err error
+	if keys.x25519, err = ecdh.X25519().GenerateKey(rand.Reader); err != nil {
+		return nil, fmt.Errorf("failed to generate X25519 key for %s: %w", request.Address, err)
+	}
+	encapsulationKey, err := mlkem.NewEncapsulationKey768(request.MlkemEncapsulationKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ML-KEM-768 encapsulation key: %w", err)
+	}
+	kemSecret, ciphertext := encapsulationKey.Encapsulate()
+
+	response.KeyAgreement = KeyAgreementX25519MLKEM768
+	response.X25519PublicKey = keys.x25519.PublicKey().Bytes()
+	response.MlkemCiphertext = ciphertext
+
+	x25519Secret, err := x25519SharedSecret(keys.x25519, request.X25519PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return combineHybridSecrets(kemSecret, x25519Secret, ciphertext,
+		request.X25519PublicKey, response.X25519PublicKey)
+}
+
+func x25519SharedSecret(privKey *ecdh.PrivateKey, remotePubKeyBytes []byte) ([]byte, error) {
+	remotePubKey, err := ecdh.X25519().NewPublicKey(remotePubKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse remote X25519 public key: %w", err)
+	}
+	secret, err := privKey.ECDH(remotePubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute X25519 shared secret: %w", err)
+	}
+	return secret, nil
+}
 
+// combineHybridSecrets derives the hybrid shared secret with HKDF-SHA256 from
+// the ML-KEM-768 and X25519 secrets. The info binds the ciphertext and both
+// X25519 public keys, so the secret is only shared by the two peers that
+// exchanged these exact handshakes.
+func combineHybridSecrets(kemSecret, x25519Secret, ciphertext, initiatorX25519, responderX25519 []byte) ([]byte, error) {
+	secret := make([]byte, 0, len(kemSecret)+len(x25519Secret))
+	secret = append(secret, kemSecret...)
+	secret = append(secret, x25519Secret...)
+
+	info := make([]byte, 0, len(hybridSecretLabel)+len(ciphertext)+len(initiatorX25519)+len(responderX25519))
+	info = append(info, hybridSecretLabel...)
+	info = append(info, ciphertext...)
+	info = append(info, initiatorX25519...)
+	info = append(info, responderX25519...)
+
+	sharedSecret, err := hkdf.Key(sha256.New, secret, nil, string(info), hybridSecretSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive hybrid shared secret: %w", err)
+	}
 	return sharedSecret, nil
 }
@@ -109,7 +109,7 @@ func createTestHandshake(t *testing.T, ske *SecureKeyExchange, remoteAddress str
 	privKey, err := ske.curve.GenerateKey(rand.Reader)
 	require.NoError(t, err)
 	if hsType != hsMissingEphemeralKey {
-		ske.ephemeralKeys[remoteAddress] = privKey
+		ske.handshakes.put(ske.now(), pendingKey{address: remoteAddress}, &ephemeralKey{ecdh: privKey})
 	}
 
 	var accountPubKey []byte
@@ -0,0 +1,216 @@
+package securekeyx
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	proto "github.com/cosmos/gogoproto/proto"
+
+	lumeraidtypes "github.com/LumeraProtocol/lumera/x/lumeraid/types"
+)
+
+const (
+	// DefaultSessionTicketLifetime is how long a session ticket can be used to resume a session.
+	DefaultSessionTicketLifetime = 12 * time.Hour
+
+	// resumptionSecretLabel is the HKDF info prefix for the resumption secret sealed into a ticket.
+	resumptionSecretLabel = "lumera/securekeyx/resumption/v1"
+	// resumedSecretLabel is the HKDF info prefix for the shared secret of a resumed session.
+	resumedSecretLabel = "lumera/securekeyx/resumed/v1"
+
+	ticketKeyIDSize = 4
+	ticketKeySize   = 32
+)
+
+// ticketKey is an AES-256-GCM key that seals session tickets. Its id is
+// prepended to every ticket so the issuer can find the key after rotation.
+type ticketKey struct {
+	id   [ticketKeyIDSize]byte
+	aead cipher.AEAD
+}
+
+func newTicketKey() (*ticketKey, error) {
+	key := make([]byte, ticketKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate session ticket key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session ticket cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session ticket cipher: %w", err)
+	}
+	tk := &ticketKey{aead: aead}
+	if _, err := rand.Read(tk.id[:]); err != nil {
+		return nil, fmt.Errorf("failed to generate session ticket key id: %w", err)
+	}
+	return tk, nil
+}
+
+// ticketKeys holds the current ticket key and the one it replaced. Keys are
+// rotated every lifetime, so any unexpired ticket was sealed by one of them.
+type ticketKeys struct {
+	lifetime  time.Duration
+	current   *ticketKey
+	previous  *ticketKey
+	rotatedAt time.Time
+}
+
+// rotate replaces the current key once it is older than the ticket lifetime.
+func (t *ticketKeys) rotate(now time.Time) error {
+	if t.current != nil && now.Sub(t.rotatedAt) < t.lifetime {
+		return nil
+	}
+	key, err := newTicketKey()
+	if err != nil {
+		return err
+	}
+	// After a long idle period the current key may have sealed only expired tickets.
+	if t.current != nil && now.Sub(t.rotatedAt) < 2*t.lifetime {
+		t.previous = t.current
+	} else {
+		t.previous = nil
+	}
+	t.current = key
+	t.rotatedAt = now
+	return nil
+}
+
+// seal encrypts state into a session ticket: key id || nonce || ciphertext.
+func (t *ticketKeys) seal(now time.Time, state *lumeraidtypes.SessionTicketState) ([]byte, error) {
+	if err := t.rotate(now); err != nil {
+		return nil, err
+	}
+	plaintext, err := proto.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize session ticket: %w", err)
+	}
+
+	key := t.current
+	nonceSize := key.aead.NonceSize()
+	ticket := make([]byte, ticketKeyIDSize+nonceSize, ticketKeyIDSize+nonceSize+len(plaintext)+key.aead.Overhead())
+	copy(ticket, key.id[:])
+	if _, err := rand.Read(ticket[ticketKeyIDSize:]); err != nil {
+		return nil, fmt.Errorf("failed to generate session ticket nonce: %w", err)
+	}
+	return key.aead.Seal(ticket, ticket[ticketKeyIDSize:], plaintext, key.id[:]), nil
+}
+
+// open decrypts a session ticket sealed by the current or previous key and
+// checks that it has not expired.
+func (t *ticketKeys) open(now time.Time, ticket []byte) (*lumeraidtypes.SessionTicketState, error) {
+	if err := t.rotate(now); err != nil {
+		return nil, err
+	}
+	if len(ticket) < ticketKeyIDSize {
+		return nil, fmt.Errorf("session ticket is too short")
+	}
+
+	var key *ticketKey
+	for _, k := range []*ticketKey{t.current, t.previous} {
+		if k != nil && string(k.id[:]) == string(ticket[:ticketKeyIDSize]) {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		return nil, fmt.Errorf("session ticket key has been rotated out")
+	}
+
+	nonceSize := key.aead.NonceSize()
+	if len(ticket) < ticketKeyIDSize+nonceSize {
+		return nil, fmt.Errorf("session ticket is too short")
+	}
+	nonce := ticket[ticketKeyIDSize : ticketKeyIDSize+nonceSize]
+	plaintext, err := key.aead.Open(nil, nonce, ticket[ticketKeyIDSize+nonceSize:], key.id[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt session ticket: %w", err)
+	}
+
+	var state lumeraidtypes.SessionTicketState
+	if err := proto.Unmarshal(plaintext, &state); err != nil {
+		return nil, fmt.Errorf("failed to deserialize session ticket: %w", err)
+	}
+	if now.Unix() >= state.ExpiresAt {
+		return nil, fmt.Errorf("session ticket expired")
+	}
+	return &state, nil
+}
+
+// clientTicket is a session ticket received from a responder, with the state
+// needed to resume a session with it.
+type clientTicket struct {
+	ticket           []byte
+	resumptionSecret []byte
+	peerType         PeerType
+	accountPubKey    []byte // serialized account public key of the responder
+	expiresAt        time.Time
+}
+
+// storeClientTicket keeps ticket for address, making room in a full store by
+// dropping expired tickets and then the one closest to expiry.
+func (s *SecureKeyExchange) storeClientTicket(now time.Time, address string, ticket *clientTicket) {
+	if _, ok := s.tickets[address]; !ok && len(s.tickets) >= s.maxPendingHandshakes {
+		var soonest string
+		for addr, t := range s.tickets {
+			if !now.Before(t.expiresAt) {
+				delete(s.tickets, addr)
+			} else if soonest == "" || t.expiresAt.Before(s.tickets[soonest].expiresAt) {
+				soonest = addr
+			}
+		}
+		if len(s.tickets) >= s.maxPendingHandshakes {
+			delete(s.tickets, soonest)
+		}
+	}
+	s.tickets[address] = ticket
+}
+
+// clientTicket returns the unexpired session ticket received from address.
+func (s *SecureKeyExchange) clientTicket(now time.Time, address string) *clientTicket {
+	ticket, ok := s.tickets[address]
+	if !ok {
+		return nil
+	}
+	if !now.Before(ticket.expiresAt) {
+		delete(s.tickets, address)
+		return nil
+	}
+	return ticket
+}
+
+// deriveResumptionSecret derives the secret sealed into a new session ticket
+// from the shared secret of the handshake that issued it.
+func deriveResumptionSecret(sharedSecret, requestNonce, responseNonce []byte) ([]byte, error) {
+	secret, err := hkdf.Key(sha256.New, sharedSecret, nil, sessionInfo(resumptionSecretLabel, requestNonce, responseNonce), hybridSecretSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive resumption secret: %w", err)
+	}
+	return secret, nil
+}
+
+// resumedSharedSecret mixes the ticket's resumption secret into the fresh
+// shared secret of a resumed session, so only the ticket holder that completed
+// the earlier handshake can derive it.
+func resumedSharedSecret(sharedSecret, resumptionSecret, requestNonce, responseNonce []byte) ([]byte, error) {
+	secret, err := hkdf.Key(sha256.New, sharedSecret, resumptionSecret, sessionInfo(resumedSecretLabel, requestNonce, responseNonce), hybridSecretSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive resumed shared secret: %w", err)
+	}
+	return secret, nil
+}
+
+func sessionInfo(label string, requestNonce, responseNonce []byte) string {
+	info := make([]byte, 0, len(label)+len(requestNonce)+len(responseNonce))
+	info = append(info, label...)
+	info = append(info, requestNonce...)
+	info = append(info, responseNonce...)
+	return string(info)
+}
@@ -0,0 +1,132 @@
+package securekeyx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LumeraProtocol/lumera/testutil/accounts"
+	lumeraidtypes "github.com/LumeraProtocol/lumera/x/lumeraid/types"
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestTicketKeys_Rotation(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	keys := &ticketKeys{lifetime: time.Hour}
+	state := &lumeraidtypes.SessionTicketState{
+		Address:          accounts.TestAddress1,
+		ResumptionSecret: []byte("resumption-secret"),
+		ExpiresAt:        now.Add(2 * time.Hour).Unix(),
+	}
+
+	ticket, err := keys.seal(now, state)
+	require.NoError(t, err)
+
+	opened, err := keys.open(now.Add(time.Minute), ticket)
+	require.NoError(t, err)
+	assert.Equal(t, state.ResumptionSecret, opened.ResumptionSecret)
+
+	// tampered tickets are rejected
+	tampered := append([]byte{}, ticket...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = keys.open(now.Add(time.Minute), tampered)
+	assert.ErrorContains(t, err, "failed to decrypt session ticket")
+
+	// after one rotation the previous key still opens the ticket
+	_, err = keys.open(now.Add(90*time.Minute), ticket)
+	require.NoError(t, err)
+
+	// the sealed expiry is enforced
+	_, err = keys.open(now.Add(2*time.Hour), ticket)
+	assert.ErrorContains(t, err, "expired")
+
+	// after a second rotation the key is gone
+	_, err = keys.open(now.Add(3*time.Hour), ticket)
+	assert.ErrorContains(t, err, "rotated out")
+}
+
+// countingValidator counts the validation round trips.
+type countingValidator struct {
+	TestKeyExchangerValidator
+	calls int
+}
+
+func (v *countingValidator) AccountInfoByAddress(ctx context.Context, addr string) (*authtypes.QueryAccountInfoResponse, error) {
+	v.calls++
+	return v.TestKeyExchangerValidator.AccountInfoByAddress(ctx, addr)
+}
+
+func (v *countingValidator) GetSupernodeBySupernodeAddress(ctx context.Context, address string) (*sntypes.SuperNode, error) {
+	v.calls++
+	return v.TestKeyExchangerValidator.GetSupernodeBySupernodeAddress(ctx, address)
+}
+
+func TestSessionTicket_ExpiredFallsBackToValidation(t *testing.T) {
+	kr := accounts.CreateTestKeyring()
+	testAccounts := accounts.SetupTestAccounts(t, kr, []string{"test-client", "test-server"})
+	validator := &countingValidator{}
+
+	now := time.Unix(1_700_000_000, 0)
+	clock := func() time.Time { return now }
+	initiator, err := NewSecureKeyExchange(kr, testAccounts[0].Address, Simplenode, nil, validator, WithSessionTickets(time.Hour))
+	require.NoError(t, err)
+	responder, err := NewSecureKeyExchange(kr, testAccounts[1].Address, Supernode, nil, validator, WithSessionTickets(time.Hour))
+	require.NoError(t, err)
+	initiator.now, responder.now = clock, clock
+
+	handshake := func() bool {
+		request, requestSig, err := initiator.CreateRequest(testAccounts[1].Address)
+		require.NoError(t, err)
+		response, responseSig, err := responder.CreateResponse(request, requestSig)
+		require.NoError(t, err)
+		initiatorSecret, err := initiator.ComputeSharedSecret(response, responseSig)
+		require.NoError(t, err)
+		responderSecret, err := responder.ComputeSharedSecret(request, requestSig)
+		require.NoError(t, err)
+		require.Equal(t, initiatorSecret, responderSecret)
+
+		var responseInfo lumeraidtypes.HandshakeInfo
+		require.NoError(t, responseInfo.Unmarshal(response))
+		return responseInfo.SessionResumed
+	}
+
+	validator.calls = 0
+	assert.False(t, handshake())
+	assert.Equal(t, 2, validator.calls)
+
+	now = now.Add(30 * time.Minute)
+	assert.True(t, handshake())
+	assert.Equal(t, 2, validator.calls)
+
+	// the ticket issued by the resumed handshake expires after its lifetime
+	now = now.Add(time.Hour)
+	assert.False(t, handshake())
+	assert.Equal(t, 4, validator.calls)
+}
+
+func TestCreateRequest_PendingHandshakeExpires(t *testing.T) {
+	kr := accounts.CreateTestKeyring()
+	testAccounts := accounts.SetupTestAccounts(t, kr, []string{"test-client", "test-server"})
+	validator := &TestKeyExchangerValidator{}
+
+	now := time.Unix(1_700_000_000, 0)
+	initiator, err := NewSecureKeyExchange(kr, testAccounts[0].Address, Simplenode, nil, validator, WithHandshakeTTL(time.Minute))
+	require.NoError(t, err)
+	responder, err := NewSecureKeyExchange(kr, testAccounts[1].Address, Supernode, nil, validator)
+	require.NoError(t, err)
+	initiator.now = func() time.Time { return now }
+
+	request, requestSig, err := initiator.CreateRequest(testAccounts[1].Address)
+	require.NoError(t, err)
+	response, responseSig, err := responder.CreateResponse(request, requestSig)
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	_, err = initiator.ComputeSharedSecret(response, responseSig)
+	assert.ErrorContains(t, err, "ephemeral private key not found")
+	assert.Zero(t, initiator.handshakes.len())
+}
@@ -16,4 +16,8 @@ var (
 	ErrSecureContainerFailed = sdkerrors.Register(ModuleName, 3, "failed to create secure container")
 	ErrInvalidAddress        = sdkerrors.Register(ModuleName, 4, "invalid address")
 	ErrInsufficientFunds     = sdkerrors.Register(ModuleName, 5, "insufficient funds for LumeraID creation")
+	ErrPQKeyExists           = sdkerrors.Register(ModuleName, 6, "address already has an active PQ key")
+	ErrPQKeyNotFound         = sdkerrors.Register(ModuleName, 7, "address has no active PQ key")
+	ErrInvalidPQKey          = sdkerrors.Register(ModuleName, 8, "invalid PQ key")
+	ErrUnsupportedAccountKey = sdkerrors.Register(ModuleName, 9, "account key cannot be bound to a PQ key")
 )
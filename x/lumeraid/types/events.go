@@ -1,5 +1,10 @@
 package types
 
 const (
+	EventTypePQKeyRegistered = "pq_key_registered"
+	EventTypePQKeyRotated    = "pq_key_rotated"
+	EventTypePQKeyRevoked    = "pq_key_revoked"
+
 	AttributeKeyAddress = "address"
+	AttributeKeyVersion = "version"
 )
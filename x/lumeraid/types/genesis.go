@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -14,5 +16,16 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.PqKeys))
+	for _, pqKey := range gs.PqKeys {
+		if err := pqKey.Validate(); err != nil {
+			return err
+		}
+		if _, dup := seen[pqKey.Address]; dup {
+			return fmt.Errorf("duplicate PQ key for address %s", pqKey.Address)
+		}
+		seen[pqKey.Address] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pq_keys are the registered post-quantum keys.
+	PqKeys []PQKey `protobuf:"bytes,2,rep,name=pq_keys,json=pqKeys,proto3" json:"pq_keys"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPqKeys() []PQKey {
+	if m != nil {
+		return m.PqKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lumera.lumeraid.GenesisState")
 }
@@ -77,19 +86,21 @@ func init() {
 func init() { proto.RegisterFile("lumera/lumeraid/genesis.proto", fileDescriptor_f5f556b06f031940) }
 
 var fileDescriptor_f5f556b06f031940 = []byte{
-	// 182 bytes of a gzipped FileDescriptorProto
+	// 217 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x29, 0xcd, 0x4d,
 	0x2d, 0x4a, 0xd4, 0x87, 0x50, 0x99, 0x29, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a,
 	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xfc, 0x10, 0x71, 0x3d, 0x98, 0xb4, 0x94, 0x60, 0x62, 0x6e,
 	0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0xa8, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5,
-	0x41, 0x2c, 0xa8, 0xa8, 0x0c, 0xba, 0xc1, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x73, 0x95, 0xbc,
-	0xb8, 0x78, 0xdc, 0x21, 0x16, 0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x71, 0xb1, 0x41, 0xe4,
-	0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xc4, 0xf5, 0xd0, 0x2c, 0xd6, 0x0b, 0x00, 0x4b, 0x3b,
-	0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x0e, 0x27, 0xad,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0xa8, 0x40, 0x58, 0x5f, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xb6, 0xde, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x99,
-	0x37, 0x48, 0xf7, 0x00, 0x00, 0x00,
+	0x41, 0x2c, 0xa8, 0xa8, 0x0c, 0xba, 0xc1, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x38, 0x65, 0x0b,
+	0xe3, 0xb3, 0x53, 0x2b, 0x21, 0xb2, 0x4a, 0x6d, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x77, 0x04, 0x97,
+	0x24, 0x96, 0xa4, 0x0a, 0x59, 0x71, 0xb1, 0x41, 0xb4, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b,
+	0x89, 0xeb, 0xa1, 0xb9, 0x4b, 0x2f, 0x00, 0x2c, 0xed, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a,
+	0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0x3a, 0x84, 0xac, 0xb8, 0xd8, 0x21, 0x86, 0x17, 0x4b, 0x30,
+	0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0x61, 0x6a, 0x0e, 0xf4, 0x4e, 0xad, 0x44, 0xd5, 0x5b, 0xe8,
+	0x9d, 0x5a, 0x59, 0xec, 0xa4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
+	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
+	0x02, 0x15, 0x08, 0xb7, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x6e, 0x0c, 0x18,
+	0x00, 0x29, 0x83, 0x98, 0xaf, 0x52, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +123,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PqKeys) > 0 {
+		for iNdEx := len(m.PqKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PqKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +169,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PqKeys) > 0 {
+		for _, e := range m.PqKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +246,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PqKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PqKeys = append(m.PqKeys, PQKey{})
+			if err := m.PqKeys[len(m.PqKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
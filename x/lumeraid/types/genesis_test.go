@@ -3,11 +3,28 @@ package types_test
 import (
 	"testing"
 
-	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+	"github.com/LumeraProtocol/lumera/x/lumeraid/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	accountPubKey := secp256k1.GenPrivKey().PubKey()
+	pqKey := types.PQKey{
+		Address:          sdk.AccAddress(accountPubKey.Address()).String(),
+		PublicKey:        make([]byte, legroast.PkBytes),
+		AccountPublicKey: accountPubKey.Bytes(),
+		AccountKeyType:   sdk.MsgTypeURL(accountPubKey),
+		Version:          1,
+	}
+	shortKey := pqKey
+	shortKey.PublicKey = []byte{1}
+	unversioned := pqKey
+	unversioned.Version = 0
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -18,6 +35,26 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc:     "valid PQ key",
+			genState: &types.GenesisState{Params: types.DefaultParams(), PqKeys: []types.PQKey{pqKey}},
+			valid:    true,
+		},
+		{
+			desc:     "duplicate PQ key address",
+			genState: &types.GenesisState{Params: types.DefaultParams(), PqKeys: []types.PQKey{pqKey, pqKey}},
+			valid:    false,
+		},
+		{
+			desc:     "PQ key with wrong public key size",
+			genState: &types.GenesisState{Params: types.DefaultParams(), PqKeys: []types.PQKey{shortKey}},
+			valid:    false,
+		},
+		{
+			desc:     "PQ key without version",
+			genState: &types.GenesisState{Params: types.DefaultParams(), PqKeys: []types.PQKey{unversioned}},
+			valid:    false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
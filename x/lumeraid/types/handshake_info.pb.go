@@ -29,6 +29,21 @@ type HandshakeInfo struct {
 	PublicKey        []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	AccountPublicKey []byte `protobuf:"bytes,4,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
 	Curve            string `protobuf:"bytes,5,opt,name=curve,proto3" json:"curve,omitempty"`
+	// Optional hybrid post-quantum key agreement. Peers that do not support it
+	// ignore these fields and use public_key/curve.
+	KeyAgreement          string `protobuf:"bytes,6,opt,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	X25519PublicKey       []byte `protobuf:"bytes,7,opt,name=x25519_public_key,json=x25519PublicKey,proto3" json:"x25519_public_key,omitempty"`
+	MlkemEncapsulationKey []byte `protobuf:"bytes,8,opt,name=mlkem_encapsulation_key,json=mlkemEncapsulationKey,proto3" json:"mlkem_encapsulation_key,omitempty"`
+	MlkemCiphertext       []byte `protobuf:"bytes,9,opt,name=mlkem_ciphertext,json=mlkemCiphertext,proto3" json:"mlkem_ciphertext,omitempty"`
+	// Per-handshake nonces let concurrent handshakes between the same peers run
+	// side by side. A response echoes the nonce of the request it answers.
+	Nonce     []byte `protobuf:"bytes,10,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	PeerNonce []byte `protobuf:"bytes,11,opt,name=peer_nonce,json=peerNonce,proto3" json:"peer_nonce,omitempty"`
+	// Optional session resumption. A request carries a ticket issued by the
+	// responder in an earlier handshake; a response carries a new ticket and
+	// reports whether the request's ticket was accepted.
+	SessionTicket  []byte `protobuf:"bytes,12,opt,name=session_ticket,json=sessionTicket,proto3" json:"session_ticket,omitempty"`
+	SessionResumed bool   `protobuf:"varint,13,opt,name=session_resumed,json=sessionResumed,proto3" json:"session_resumed,omitempty"`
 }
 
 func (m *HandshakeInfo) Reset()         { *m = HandshakeInfo{} }
@@ -99,8 +114,142 @@ func (m *HandshakeInfo) GetCurve() string {
 	return ""
 }
 
+func (m *HandshakeInfo) GetKeyAgreement() string {
+	if m != nil {
+		return m.KeyAgreement
+	}
+	return ""
+}
+
+func (m *HandshakeInfo) GetX25519PublicKey() []byte {
+	if m != nil {
+		return m.X25519PublicKey
+	}
+	return nil
+}
+
+func (m *HandshakeInfo) GetMlkemEncapsulationKey() []byte {
+	if m != nil {
+		return m.MlkemEncapsulationKey
+	}
+	return nil
+}
+
+func (m *HandshakeInfo) GetMlkemCiphertext() []byte {
+	if m != nil {
+		return m.MlkemCiphertext
+	}
+	return nil
+}
+
+func (m *HandshakeInfo) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *HandshakeInfo) GetPeerNonce() []byte {
+	if m != nil {
+		return m.PeerNonce
+	}
+	return nil
+}
+
+func (m *HandshakeInfo) GetSessionTicket() []byte {
+	if m != nil {
+		return m.SessionTicket
+	}
+	return nil
+}
+
+func (m *HandshakeInfo) GetSessionResumed() bool {
+	if m != nil {
+		return m.SessionResumed
+	}
+	return false
+}
+
+// SessionTicketState is the state sealed into a session ticket by its issuer.
+type SessionTicketState struct {
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PeerType         int32  `protobuf:"varint,2,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	AccountPublicKey []byte `protobuf:"bytes,3,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
+	ResumptionSecret []byte `protobuf:"bytes,4,opt,name=resumption_secret,json=resumptionSecret,proto3" json:"resumption_secret,omitempty"`
+	ExpiresAt        int64  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *SessionTicketState) Reset()         { *m = SessionTicketState{} }
+func (m *SessionTicketState) String() string { return proto.CompactTextString(m) }
+func (*SessionTicketState) ProtoMessage()    {}
+func (*SessionTicketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af18cdb5b689e73b, []int{1}
+}
+func (m *SessionTicketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionTicketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionTicketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionTicketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionTicketState.Merge(m, src)
+}
+func (m *SessionTicketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionTicketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionTicketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionTicketState proto.InternalMessageInfo
+
+func (m *SessionTicketState) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SessionTicketState) GetPeerType() int32 {
+	if m != nil {
+		return m.PeerType
+	}
+	return 0
+}
+
+func (m *SessionTicketState) GetAccountPublicKey() []byte {
+	if m != nil {
+		return m.AccountPublicKey
+	}
+	return nil
+}
+
+func (m *SessionTicketState) GetResumptionSecret() []byte {
+	if m != nil {
+		return m.ResumptionSecret
+	}
+	return nil
+}
+
+func (m *SessionTicketState) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HandshakeInfo)(nil), "lumera.lumeraid.HandshakeInfo")
+	proto.RegisterType((*SessionTicketState)(nil), "lumera.lumeraid.SessionTicketState")
 }
 
 func init() {
@@ -108,22 +257,35 @@ func init() {
 }
 
 var fileDescriptor_af18cdb5b689e73b = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x29, 0xcd, 0x4d,
-	0x2d, 0x4a, 0xd4, 0x87, 0x50, 0x99, 0x29, 0xfa, 0x19, 0x89, 0x79, 0x29, 0xc5, 0x19, 0x89, 0xd9,
-	0xa9, 0xf1, 0x99, 0x79, 0x69, 0xf9, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xfc, 0x10, 0x69,
-	0x3d, 0x98, 0x2a, 0xa5, 0x95, 0x8c, 0x5c, 0xbc, 0x1e, 0x30, 0x95, 0x9e, 0x79, 0x69, 0xf9, 0x42,
-	0x12, 0x5c, 0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c,
-	0x41, 0x30, 0xae, 0x90, 0x34, 0x17, 0x67, 0x41, 0x6a, 0x6a, 0x51, 0x7c, 0x49, 0x65, 0x41, 0xaa,
-	0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x07, 0x48, 0x20, 0xa4, 0xb2, 0x20, 0x55, 0x48, 0x96,
-	0x8b, 0xab, 0xa0, 0x34, 0x29, 0x27, 0x33, 0x39, 0x3e, 0x3b, 0xb5, 0x52, 0x82, 0x59, 0x81, 0x51,
-	0x83, 0x27, 0x88, 0x13, 0x22, 0xe2, 0x9d, 0x5a, 0x29, 0xa4, 0xc3, 0x25, 0x94, 0x98, 0x9c, 0x9c,
-	0x5f, 0x9a, 0x57, 0x12, 0x8f, 0xa4, 0x8c, 0x05, 0xac, 0x4c, 0x00, 0x2a, 0x13, 0x00, 0x57, 0x2d,
-	0xc2, 0xc5, 0x9a, 0x5c, 0x5a, 0x54, 0x96, 0x2a, 0xc1, 0x0a, 0x76, 0x01, 0x84, 0xe3, 0xa4, 0x75,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x02, 0x15, 0x08, 0x7f, 0x83, 0x5c,
-	0x57, 0x9c, 0xc4, 0x06, 0xf6, 0xaf, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd8, 0x65, 0x83,
-	0x17, 0x01, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xd6, 0xee, 0x36, 0x63, 0x6b, 0xbb, 0x83, 0xe2, 0x80, 0x18, 0xca, 0xaa, 0x58,
+	0x57, 0xd9, 0x45, 0x65, 0x05, 0x8f, 0xab, 0x08, 0x8a, 0x20, 0x92, 0xee, 0xc9, 0x4b, 0x98, 0x9d,
+	0xbc, 0xb5, 0x21, 0xcd, 0x64, 0x98, 0x99, 0x48, 0xf3, 0x2d, 0xfc, 0x52, 0x82, 0xc7, 0x3d, 0x7a,
+	0x94, 0xf6, 0x8b, 0x48, 0xde, 0x34, 0x6d, 0x0e, 0x7a, 0xd9, 0x53, 0x78, 0xff, 0xdf, 0xff, 0xcf,
+	0x7b, 0x79, 0x6f, 0xe8, 0xa3, 0x45, 0x99, 0x83, 0x11, 0x27, 0xfe, 0x93, 0x26, 0x27, 0x73, 0xa1,
+	0x12, 0x3b, 0x17, 0x19, 0xc4, 0xa9, 0xba, 0x2c, 0x8e, 0xb5, 0x29, 0x5c, 0xc1, 0x46, 0x1e, 0x1f,
+	0x37, 0xae, 0xc3, 0x55, 0x97, 0x0e, 0x3f, 0x34, 0xce, 0x8f, 0xea, 0xb2, 0x60, 0x9c, 0xee, 0x8b,
+	0x24, 0x31, 0x60, 0x2d, 0x27, 0x13, 0x32, 0x0d, 0xa2, 0xa6, 0x64, 0xf7, 0x69, 0xa0, 0x01, 0x4c,
+	0xec, 0x2a, 0x0d, 0xfc, 0xc6, 0x84, 0x4c, 0x7b, 0x51, 0xbf, 0x16, 0xce, 0x2b, 0x0d, 0xec, 0x01,
+	0xa5, 0xba, 0xbc, 0x58, 0xa4, 0x32, 0xce, 0xa0, 0xe2, 0xdd, 0x09, 0x99, 0x0e, 0xa2, 0xc0, 0x2b,
+	0x9f, 0xa0, 0x62, 0xcf, 0x29, 0x13, 0x52, 0x16, 0xa5, 0x72, 0x71, 0xcb, 0x76, 0x13, 0x6d, 0xe3,
+	0x0d, 0xf9, 0xb2, 0x75, 0xdf, 0xa1, 0x3d, 0x59, 0x9a, 0xef, 0xc0, 0x7b, 0x38, 0x81, 0x2f, 0xd8,
+	0x43, 0x3a, 0xcc, 0xa0, 0x8a, 0xc5, 0x37, 0x03, 0x90, 0x83, 0x72, 0x7c, 0x0f, 0xe9, 0x20, 0x83,
+	0xea, 0xac, 0xd1, 0xd8, 0x11, 0x3d, 0x58, 0xbe, 0x3c, 0x3d, 0x7d, 0xf1, 0xa6, 0xdd, 0x67, 0x1f,
+	0xfb, 0x8c, 0x3c, 0xd8, 0xb5, 0x79, 0x4d, 0xef, 0xe5, 0x8b, 0x0c, 0xf2, 0x18, 0x94, 0x14, 0xda,
+	0x96, 0x0b, 0xe1, 0xd2, 0x42, 0x61, 0xa2, 0x8f, 0x89, 0xbb, 0x88, 0xdf, 0xb7, 0x69, 0x9d, 0x7b,
+	0x4a, 0xc7, 0x3e, 0x27, 0x53, 0x3d, 0x07, 0xe3, 0x60, 0xe9, 0x78, 0xe0, 0x5b, 0xa0, 0xfe, 0x6e,
+	0x2b, 0xd7, 0x7f, 0xa2, 0x0a, 0x25, 0x81, 0x53, 0xe4, 0xbe, 0xc0, 0x65, 0xd5, 0x9b, 0xf4, 0xe8,
+	0xd6, 0x66, 0x59, 0x00, 0xe6, 0x33, 0xe2, 0xc7, 0xf4, 0xb6, 0x05, 0x6b, 0xeb, 0x59, 0x5c, 0x2a,
+	0x33, 0x70, 0x7c, 0x80, 0x96, 0xe1, 0x46, 0x3d, 0x47, 0x91, 0x3d, 0xa1, 0xa3, 0xc6, 0x66, 0xc0,
+	0x96, 0x39, 0x24, 0x7c, 0x38, 0x21, 0xd3, 0x7e, 0xd4, 0xa4, 0x23, 0xaf, 0x1e, 0xfe, 0x24, 0x94,
+	0xcd, 0xda, 0xd1, 0x99, 0x13, 0x0e, 0xae, 0x7b, 0xe9, 0x7f, 0x9f, 0xb2, 0xfb, 0x9f, 0x53, 0x3e,
+	0xa3, 0x07, 0x38, 0x9c, 0xc6, 0xd5, 0x5a, 0x90, 0x06, 0x5c, 0x73, 0xf7, 0x1d, 0x98, 0xa1, 0x5e,
+	0xef, 0x05, 0x96, 0x3a, 0x35, 0x60, 0x63, 0xe1, 0xf0, 0xf8, 0xdd, 0x28, 0xd8, 0x28, 0x67, 0xee,
+	0xed, 0xd1, 0xaf, 0x55, 0x48, 0xae, 0x56, 0x21, 0xf9, 0xb3, 0x0a, 0xc9, 0x8f, 0x75, 0xd8, 0xb9,
+	0x5a, 0x87, 0x9d, 0xdf, 0xeb, 0xb0, 0xf3, 0x75, 0xbc, 0xdc, 0x3d, 0xfc, 0x7a, 0x68, 0x7b, 0xb1,
+	0x87, 0x0f, 0xfe, 0xd5, 0xdf, 0x01, 0x00, 0xb0, 0x62, 0x5b, 0xf1, 0x18, 0x03, 0x00, 0x00,
 }
 
 func (m *HandshakeInfo) Marshal() (dAtA []byte, err error) {
@@ -146,6 +308,65 @@ func (m *HandshakeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SessionResumed {
+		i--
+		if m.SessionResumed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SessionTicket) > 0 {
+		i -= len(m.SessionTicket)
+		copy(dAtA[i:], m.SessionTicket)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.SessionTicket)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PeerNonce) > 0 {
+		i -= len(m.PeerNonce)
+		copy(dAtA[i:], m.PeerNonce)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.PeerNonce)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MlkemCiphertext) > 0 {
+		i -= len(m.MlkemCiphertext)
+		copy(dAtA[i:], m.MlkemCiphertext)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.MlkemCiphertext)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MlkemEncapsulationKey) > 0 {
+		i -= len(m.MlkemEncapsulationKey)
+		copy(dAtA[i:], m.MlkemEncapsulationKey)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.MlkemEncapsulationKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.X25519PublicKey) > 0 {
+		i -= len(m.X25519PublicKey)
+		copy(dAtA[i:], m.X25519PublicKey)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.X25519PublicKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.KeyAgreement) > 0 {
+		i -= len(m.KeyAgreement)
+		copy(dAtA[i:], m.KeyAgreement)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.KeyAgreement)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Curve) > 0 {
 		i -= len(m.Curve)
 		copy(dAtA[i:], m.Curve)
@@ -182,6 +403,60 @@ func (m *HandshakeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SessionTicketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionTicketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionTicketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ResumptionSecret) > 0 {
+		i -= len(m.ResumptionSecret)
+		copy(dAtA[i:], m.ResumptionSecret)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.ResumptionSecret)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AccountPublicKey) > 0 {
+		i -= len(m.AccountPublicKey)
+		copy(dAtA[i:], m.AccountPublicKey)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.AccountPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PeerType != 0 {
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(m.PeerType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHandshakeInfo(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHandshakeInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHandshakeInfo(v)
 	base := offset
@@ -218,6 +493,64 @@ func (m *HandshakeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHandshakeInfo(uint64(l))
 	}
+	l = len(m.KeyAgreement)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.X25519PublicKey)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.MlkemEncapsulationKey)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.MlkemCiphertext)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.PeerNonce)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.SessionTicket)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	if m.SessionResumed {
+		n += 2
+	}
+	return n
+}
+
+func (m *SessionTicketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	if m.PeerType != 0 {
+		n += 1 + sovHandshakeInfo(uint64(m.PeerType))
+	}
+	l = len(m.AccountPublicKey)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	l = len(m.ResumptionSecret)
+	if l > 0 {
+		n += 1 + l + sovHandshakeInfo(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovHandshakeInfo(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -407,6 +740,450 @@ func (m *HandshakeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Curve = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAgreement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X25519PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.X25519PublicKey = append(m.X25519PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.X25519PublicKey == nil {
+				m.X25519PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MlkemEncapsulationKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MlkemEncapsulationKey = append(m.MlkemEncapsulationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.MlkemEncapsulationKey == nil {
+				m.MlkemEncapsulationKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MlkemCiphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MlkemCiphertext = append(m.MlkemCiphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.MlkemCiphertext == nil {
+				m.MlkemCiphertext = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerNonce = append(m.PeerNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.PeerNonce == nil {
+				m.PeerNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTicket", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionTicket = append(m.SessionTicket[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionTicket == nil {
+				m.SessionTicket = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionResumed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SessionResumed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandshakeInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionTicketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandshakeInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionTicketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionTicketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerType", wireType)
+			}
+			m.PeerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPublicKey = append(m.AccountPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountPublicKey == nil {
+				m.AccountPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumptionSecret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshakeInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumptionSecret = append(m.ResumptionSecret[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumptionSecret == nil {
+				m.ResumptionSecret = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshakeInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandshakeInfo(dAtA[iNdEx:])
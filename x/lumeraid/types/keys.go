@@ -11,6 +11,11 @@ const (
 	MemStoreKey = "mem_lumeraid"
 )
 
+const (
+	// PQKeyKey prefixes registered post-quantum keys, keyed by address.
+	PQKeyKey = "PQKey/value/"
+)
+
 var (
 	ParamsKey = []byte("p_lumeraid")
 )
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterPQKey{}
+	_ sdk.Msg = &MsgRotatePQKey{}
+	_ sdk.Msg = &MsgRevokePQKey{}
+)
+
+func NewMsgRegisterPQKey(creator string, publicKey, signature []byte) *MsgRegisterPQKey {
+	return &MsgRegisterPQKey{
+		Creator:   creator,
+		PublicKey: publicKey,
+		Signature: signature,
+	}
+}
+
+func (msg *MsgRegisterPQKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateLegRoastPublicKey(msg.PublicKey); err != nil {
+		return err
+	}
+	return validateLegRoastSignature(msg.Signature)
+}
+
+func NewMsgRotatePQKey(creator string, newPublicKey, newSignature, currentSignature []byte) *MsgRotatePQKey {
+	return &MsgRotatePQKey{
+		Creator:          creator,
+		NewPublicKey:     newPublicKey,
+		NewSignature:     newSignature,
+		CurrentSignature: currentSignature,
+	}
+}
+
+func (msg *MsgRotatePQKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validateLegRoastPublicKey(msg.NewPublicKey); err != nil {
+		return err
+	}
+	if err := validateLegRoastSignature(msg.NewSignature); err != nil {
+		return err
+	}
+	if err := validateLegRoastSignature(msg.CurrentSignature); err != nil {
+		return err
+	}
+	if bytes.Equal(msg.NewSignature, msg.CurrentSignature) {
+		return errorsmod.Wrap(ErrInvalidSignature, "new and current signatures must differ")
+	}
+	return nil
+}
+
+func NewMsgRevokePQKey(creator string, signature []byte) *MsgRevokePQKey {
+	return &MsgRevokePQKey{
+		Creator:   creator,
+		Signature: signature,
+	}
+}
+
+func (msg *MsgRevokePQKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return validateLegRoastSignature(msg.Signature)
+}
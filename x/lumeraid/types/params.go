@@ -1,32 +1,65 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyLegroastVerifyGas = []byte("LegroastVerifyGas")
+)
+
+// Default parameter values
+var (
+	// DefaultLegroastVerifyGas covers the slowest LegRoast variant
+	// (LegendreCompact), whose verification takes tens of milliseconds.
+	DefaultLegroastVerifyGas = uint64(300_000)
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(legroastVerifyGas uint64) Params {
+	return Params{
+		LegroastVerifyGas: legroastVerifyGas,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultLegroastVerifyGas)
+}
+
+// WithDefaults fills parameters that are unset in params stored before they
+// were introduced.
+func (p Params) WithDefaults() Params {
+	if p.LegroastVerifyGas == 0 {
+		p.LegroastVerifyGas = DefaultLegroastVerifyGas
+	}
+	return p
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyLegroastVerifyGas, &p.LegroastVerifyGas, validateLegroastVerifyGas),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	return validateLegroastVerifyGas(p.LegroastVerifyGas)
+}
+
+func validateLegroastVerifyGas(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
 	return nil
 }
@@ -27,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// legroast_verify_gas is the gas charged for each LegRoast signature
+	// verified by the PQ key registry.
+	LegroastVerifyGas uint64 `protobuf:"varint,1,opt,name=legroast_verify_gas,json=legroastVerifyGas,proto3" json:"legroast_verify_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,6 +65,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetLegroastVerifyGas() uint64 {
+	if m != nil {
+		return m.LegroastVerifyGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "lumera.lumeraid.Params")
 }
@@ -69,18 +79,21 @@ func init() {
 func init() { proto.RegisterFile("lumera/lumeraid/params.proto", fileDescriptor_2a35b72032090bfa) }
 
 var fileDescriptor_2a35b72032090bfa = []byte{
-	// 175 bytes of a gzipped FileDescriptorProto
+	// 211 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x29, 0xcd, 0x4d,
 	0x2d, 0x4a, 0xd4, 0x87, 0x50, 0x99, 0x29, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05,
 	0x45, 0xf9, 0x25, 0xf9, 0x42, 0xfc, 0x10, 0x61, 0x3d, 0x98, 0xac, 0x94, 0x60, 0x62, 0x6e, 0x66,
 	0x5e, 0xbe, 0x3e, 0x98, 0x84, 0xa8, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41,
 	0x2c, 0xa8, 0xa8, 0x5c, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x52, 0x62, 0x71, 0xaa, 0x7e,
-	0x99, 0x61, 0x52, 0x6a, 0x49, 0xa2, 0xa1, 0x7e, 0x72, 0x7e, 0x66, 0x1e, 0x44, 0x5e, 0x49, 0x9b,
-	0x8b, 0x2d, 0x00, 0x6c, 0x93, 0x95, 0xe2, 0x8b, 0x05, 0xf2, 0x8c, 0x5d, 0xcf, 0x37, 0x68, 0x49,
-	0x40, 0x9d, 0x52, 0x81, 0x70, 0x0c, 0x44, 0x89, 0x93, 0xd6, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x09, 0x20, 0x29, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x9b,
-	0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x32, 0xb3, 0xec, 0xd9, 0x00, 0x00, 0x00,
+	0x99, 0x61, 0x52, 0x6a, 0x49, 0xa2, 0xa1, 0x7e, 0x72, 0x7e, 0x66, 0x1e, 0x44, 0x5e, 0x29, 0x9a,
+	0x8b, 0x2d, 0x00, 0x6c, 0x93, 0x90, 0x1e, 0x97, 0x70, 0x4e, 0x6a, 0x7a, 0x51, 0x7e, 0x62, 0x71,
+	0x49, 0x7c, 0x59, 0x6a, 0x51, 0x66, 0x5a, 0x65, 0x7c, 0x7a, 0x62, 0xb1, 0x04, 0xa3, 0x02, 0xa3,
+	0x06, 0x4b, 0x90, 0x20, 0x4c, 0x2a, 0x0c, 0x2c, 0xe3, 0x9e, 0x58, 0x6c, 0xa5, 0xf8, 0x62, 0x81,
+	0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0x12, 0x50, 0xa7, 0x57, 0x20, 0x1c, 0x0f, 0x31, 0xd2, 0x49,
+	0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
+	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x04, 0x90, 0x14, 0x97, 0x54,
+	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x63, 0x0c, 0x18, 0x00, 0x69, 0xa1, 0x8a, 0xb0, 0x09,
+	0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +115,9 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.LegroastVerifyGas != that1.LegroastVerifyGas {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +140,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LegroastVerifyGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LegroastVerifyGas))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +165,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.LegroastVerifyGas != 0 {
+		n += 1 + sovParams(uint64(m.LegroastVerifyGas))
+	}
 	return n
 }
 
@@ -182,6 +206,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegroastVerifyGas", wireType)
+			}
+			m.LegroastVerifyGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegroastVerifyGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
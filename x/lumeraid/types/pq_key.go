@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/lumeraid/legroast"
+)
+
+// PQKeyBindingDomain separates PQ key binding payloads from any other message
+// a LegRoast key may sign.
+const PQKeyBindingDomain = "lumera/lumeraid/pq-key-binding/v1"
+
+// PQKeyRevocationDomain separates PQ key revocation payloads from binding
+// payloads and any other message a LegRoast key may sign.
+const PQKeyRevocationDomain = "lumera/lumeraid/pq-key-revocation/v1"
+
+// PQKeyBindingPayload returns the bytes LegRoast keys sign to be bound to an
+// account. It commits to the chain, the account and its secp256k1 or
+// eth_secp256k1 key, the LegRoast key and the key version, so a signature
+// cannot be replayed on another chain, for another account or to roll a
+// rotated key back.
+func PQKeyBindingPayload(chainID, address, accountKeyType string, accountPubKey, pqPubKey []byte, version uint64) []byte {
+	var payload []byte
+	for _, field := range [][]byte{
+		[]byte(PQKeyBindingDomain),
+		[]byte(chainID),
+		[]byte(address),
+		[]byte(accountKeyType),
+		accountPubKey,
+		pqPubKey,
+	} {
+		payload = binary.BigEndian.AppendUint32(payload, uint32(len(field)))
+		payload = append(payload, field...)
+	}
+	return binary.BigEndian.AppendUint64(payload, version)
+}
+
+// PQKeyRevocationPayload returns the bytes an active LegRoast key signs to be
+// revoked. It commits to the chain, the account, the key and its version, so
+// a revocation cannot be replayed on another chain or against a later key.
+func PQKeyRevocationPayload(chainID, address string, pqPubKey []byte, version uint64) []byte {
+	var payload []byte
+	for _, field := range [][]byte{
+		[]byte(PQKeyRevocationDomain),
+		[]byte(chainID),
+		[]byte(address),
+		pqPubKey,
+	} {
+		payload = binary.BigEndian.AppendUint32(payload, uint32(len(field)))
+		payload = append(payload, field...)
+	}
+	return binary.BigEndian.AppendUint64(payload, version)
+}
+
+// Validate checks the stored fields of a PQ key.
+func (k PQKey) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(k.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid PQ key address (%s)", err)
+	}
+	if err := validateLegRoastPublicKey(k.PublicKey); err != nil {
+		return err
+	}
+	if len(k.AccountPublicKey) == 0 || k.AccountKeyType == "" {
+		return errorsmod.Wrapf(ErrInvalidPQKey, "PQ key of %s is not bound to an account key", k.Address)
+	}
+	if k.Version == 0 {
+		return errorsmod.Wrapf(ErrInvalidPQKey, "PQ key of %s has version 0", k.Address)
+	}
+	return nil
+}
+
+func validateLegRoastPublicKey(pubKey []byte) error {
+	if len(pubKey) != legroast.PkBytes {
+		return errorsmod.Wrapf(ErrInvalidPQKey, "LegRoast public key must be %d bytes, got %d", legroast.PkBytes, len(pubKey))
+	}
+	return nil
+}
+
+func validateLegRoastSignature(signature []byte) error {
+	if _, err := legroast.GetAlgorithmBySigSize(len(signature)); err != nil {
+		return errorsmod.Wrap(ErrInvalidSignature, err.Error())
+	}
+	return nil
+}
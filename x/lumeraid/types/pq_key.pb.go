@@ -0,0 +1,604 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lumera/lumeraid/pq_key.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PQKey is a LegRoast post-quantum public key registered by an account and
+// bound to the account's secp256k1 or eth_secp256k1 key.
+type PQKey struct {
+	// address is the account that registered the key.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// public_key is the LegRoast public key.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// account_public_key is the account key the LegRoast key is bound to.
+	AccountPublicKey []byte `protobuf:"bytes,3,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
+	// account_key_type is the type URL of account_public_key.
+	AccountKeyType string `protobuf:"bytes,4,opt,name=account_key_type,json=accountKeyType,proto3" json:"account_key_type,omitempty"`
+	// version starts at 1 and increases with every rotation and with every
+	// registration after a revocation.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// updated_height is the block height of the last registration, rotation or
+	// revocation.
+	UpdatedHeight int64 `protobuf:"varint,6,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// revoked is set once the account revokes the key. Revoked keys are kept so
+	// that clients can tell a revoked identity from an unregistered one.
+	Revoked bool `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *PQKey) Reset()         { *m = PQKey{} }
+func (m *PQKey) String() string { return proto.CompactTextString(m) }
+func (*PQKey) ProtoMessage()    {}
+func (*PQKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14e339cc1fe6cc17, []int{0}
+}
+func (m *PQKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PQKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PQKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PQKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PQKey.Merge(m, src)
+}
+func (m *PQKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PQKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PQKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PQKey proto.InternalMessageInfo
+
+func (m *PQKey) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PQKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *PQKey) GetAccountPublicKey() []byte {
+	if m != nil {
+		return m.AccountPublicKey
+	}
+	return nil
+}
+
+func (m *PQKey) GetAccountKeyType() string {
+	if m != nil {
+		return m.AccountKeyType
+	}
+	return ""
+}
+
+func (m *PQKey) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PQKey) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *PQKey) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PQKey)(nil), "lumera.lumeraid.PQKey")
+}
+
+func init() { proto.RegisterFile("lumera/lumeraid/pq_key.proto", fileDescriptor_14e339cc1fe6cc17) }
+
+var fileDescriptor_14e339cc1fe6cc17 = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x29, 0xcd, 0x4d,
+	0x2d, 0x4a, 0xd4, 0x87, 0x50, 0x99, 0x29, 0xfa, 0x05, 0x85, 0xf1, 0xd9, 0xa9, 0x95, 0x7a, 0x05,
+	0x45, 0xf9, 0x25, 0xf9, 0x42, 0xfc, 0x10, 0x61, 0x3d, 0x98, 0xac, 0x94, 0x64, 0x72, 0x7e, 0x71,
+	0x6e, 0x7e, 0x71, 0x3c, 0x58, 0x5a, 0x1f, 0xc2, 0x81, 0xa8, 0x55, 0xea, 0x65, 0xe2, 0x62, 0x0d,
+	0x08, 0xf4, 0x4e, 0xad, 0x14, 0x32, 0xe2, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96,
+	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0xaa, 0xd8, 0x11, 0x22,
+	0x13, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04, 0x53, 0x28, 0x24, 0xcb, 0xc5, 0x55, 0x50, 0x9a,
+	0x94, 0x93, 0x99, 0x0c, 0xb2, 0x5d, 0x82, 0x49, 0x81, 0x51, 0x83, 0x27, 0x88, 0x13, 0x22, 0x02,
+	0x32, 0x52, 0x87, 0x4b, 0x28, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0x24, 0x1e, 0x49, 0x19, 0x33,
+	0x58, 0x99, 0x00, 0x54, 0x26, 0x00, 0xae, 0x5a, 0x83, 0x0b, 0x26, 0x06, 0x52, 0x16, 0x5f, 0x52,
+	0x59, 0x90, 0x2a, 0xc1, 0x02, 0x72, 0x49, 0x10, 0x1f, 0x54, 0xdc, 0x3b, 0xb5, 0x32, 0xa4, 0xb2,
+	0x20, 0x55, 0x48, 0x82, 0x8b, 0xbd, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f, 0x4f, 0x82, 0x55, 0x81,
+	0x51, 0x83, 0x25, 0x08, 0xc6, 0x15, 0x52, 0xe5, 0xe2, 0x2b, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x4d,
+	0x89, 0xcf, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x53, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x85,
+	0x8a, 0x7a, 0x80, 0x05, 0x41, 0x06, 0x14, 0xa5, 0x96, 0xe5, 0x67, 0xa7, 0xa6, 0x48, 0xb0, 0x2b,
+	0x30, 0x6a, 0x70, 0x04, 0xc1, 0xb8, 0x4e, 0x5a, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
+	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
+	0xc7, 0x10, 0x25, 0x50, 0x81, 0x08, 0x6e, 0x90, 0xdb, 0x8a, 0x93, 0xd8, 0xc0, 0x41, 0x68, 0x0c,
+	0x18, 0x00, 0xe2, 0x82, 0x57, 0x65, 0x8e, 0x01, 0x00, 0x00,
+}
+
+func (m *PQKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PQKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PQKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintPqKey(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Version != 0 {
+		i = encodeVarintPqKey(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AccountKeyType) > 0 {
+		i -= len(m.AccountKeyType)
+		copy(dAtA[i:], m.AccountKeyType)
+		i = encodeVarintPqKey(dAtA, i, uint64(len(m.AccountKeyType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AccountPublicKey) > 0 {
+		i -= len(m.AccountPublicKey)
+		copy(dAtA[i:], m.AccountPublicKey)
+		i = encodeVarintPqKey(dAtA, i, uint64(len(m.AccountPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintPqKey(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPqKey(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPqKey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPqKey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PQKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPqKey(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovPqKey(uint64(l))
+	}
+	l = len(m.AccountPublicKey)
+	if l > 0 {
+		n += 1 + l + sovPqKey(uint64(l))
+	}
+	l = len(m.AccountKeyType)
+	if l > 0 {
+		n += 1 + l + sovPqKey(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPqKey(uint64(m.Version))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovPqKey(uint64(m.UpdatedHeight))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func sovPqKey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPqKey(x uint64) (n int) {
+	return sovPqKey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PQKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPqKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PQKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PQKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPublicKey = append(m.AccountPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountPublicKey == nil {
+				m.AccountPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountKeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPqKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPqKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPqKey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPqKey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPqKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPqKey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPqKey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPqKey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPqKey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPqKey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPqKey = fmt.Errorf("proto: unexpected end of group")
+)
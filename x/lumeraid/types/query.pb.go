@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -113,32 +114,239 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPQKeyRequest is request type for the Query/PQKey RPC method.
+type QueryPQKeyRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPQKeyRequest) Reset()         { *m = QueryPQKeyRequest{} }
+func (m *QueryPQKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPQKeyRequest) ProtoMessage()    {}
+func (*QueryPQKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42af4eada4e25112, []int{2}
+}
+func (m *QueryPQKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPQKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPQKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPQKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPQKeyRequest.Merge(m, src)
+}
+func (m *QueryPQKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPQKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPQKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPQKeyRequest proto.InternalMessageInfo
+
+func (m *QueryPQKeyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryPQKeyResponse is response type for the Query/PQKey RPC method.
+type QueryPQKeyResponse struct {
+	PqKey PQKey `protobuf:"bytes,1,opt,name=pq_key,json=pqKey,proto3" json:"pq_key"`
+}
+
+func (m *QueryPQKeyResponse) Reset()         { *m = QueryPQKeyResponse{} }
+func (m *QueryPQKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPQKeyResponse) ProtoMessage()    {}
+func (*QueryPQKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42af4eada4e25112, []int{3}
+}
+func (m *QueryPQKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPQKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPQKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPQKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPQKeyResponse.Merge(m, src)
+}
+func (m *QueryPQKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPQKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPQKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPQKeyResponse proto.InternalMessageInfo
+
+func (m *QueryPQKeyResponse) GetPqKey() PQKey {
+	if m != nil {
+		return m.PqKey
+	}
+	return PQKey{}
+}
+
+// QueryPQKeysRequest is request type for the Query/PQKeys RPC method.
+type QueryPQKeysRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPQKeysRequest) Reset()         { *m = QueryPQKeysRequest{} }
+func (m *QueryPQKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPQKeysRequest) ProtoMessage()    {}
+func (*QueryPQKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42af4eada4e25112, []int{4}
+}
+func (m *QueryPQKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPQKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPQKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPQKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPQKeysRequest.Merge(m, src)
+}
+func (m *QueryPQKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPQKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPQKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPQKeysRequest proto.InternalMessageInfo
+
+func (m *QueryPQKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPQKeysResponse is response type for the Query/PQKeys RPC method.
+type QueryPQKeysResponse struct {
+	PqKeys     []PQKey             `protobuf:"bytes,1,rep,name=pq_keys,json=pqKeys,proto3" json:"pq_keys"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPQKeysResponse) Reset()         { *m = QueryPQKeysResponse{} }
+func (m *QueryPQKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPQKeysResponse) ProtoMessage()    {}
+func (*QueryPQKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42af4eada4e25112, []int{5}
+}
+func (m *QueryPQKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPQKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPQKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPQKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPQKeysResponse.Merge(m, src)
+}
+func (m *QueryPQKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPQKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPQKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPQKeysResponse proto.InternalMessageInfo
+
+func (m *QueryPQKeysResponse) GetPqKeys() []PQKey {
+	if m != nil {
+		return m.PqKeys
+	}
+	return nil
+}
+
+func (m *QueryPQKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lumera.lumeraid.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lumera.lumeraid.QueryParamsResponse")
+	proto.RegisterType((*QueryPQKeyRequest)(nil), "lumera.lumeraid.QueryPQKeyRequest")
+	proto.RegisterType((*QueryPQKeyResponse)(nil), "lumera.lumeraid.QueryPQKeyResponse")
+	proto.RegisterType((*QueryPQKeysRequest)(nil), "lumera.lumeraid.QueryPQKeysRequest")
+	proto.RegisterType((*QueryPQKeysResponse)(nil), "lumera.lumeraid.QueryPQKeysResponse")
 }
 
 func init() { proto.RegisterFile("lumera/lumeraid/query.proto", fileDescriptor_42af4eada4e25112) }
 
 var fileDescriptor_42af4eada4e25112 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x29, 0xcd, 0x4d,
-	0x2d, 0x4a, 0xd4, 0x87, 0x50, 0x99, 0x29, 0xfa, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0x7a, 0x05, 0x45,
-	0xf9, 0x25, 0xf9, 0x42, 0xfc, 0x10, 0x51, 0x3d, 0x98, 0xa4, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e,
-	0xbe, 0x3e, 0x98, 0x84, 0xa8, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c,
-	0xa8, 0xa8, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e,
-	0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x4c, 0x16, 0xdd, 0xd2, 0x82, 0xc4, 0xa2,
-	0xc4, 0x5c, 0xa8, 0xac, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0x11, 0x01, 0x60, 0xc1, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0xa5, 0x40, 0x2e, 0x61, 0x14, 0xd1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x2b, 0x2e, 0x36, 0x88, 0x66, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x71, 0x3d,
-	0x34, 0x37, 0xeb, 0x41, 0x34, 0x38, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d,
-	0xc6, 0x20, 0xa8, 0x0e, 0xa3, 0x5e, 0x46, 0x2e, 0x56, 0xb0, 0x99, 0x42, 0xcd, 0x8c, 0x5c, 0x6c,
-	0x10, 0x75, 0x42, 0xca, 0x18, 0x06, 0x60, 0x3a, 0x46, 0x4a, 0x05, 0xbf, 0x22, 0x88, 0xdb, 0x94,
-	0xf4, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x21, 0xa4, 0xa6, 0xef, 0x03, 0x56, 0x16, 0x00, 0xf2,
-	0x5e, 0x72, 0x7e, 0x8e, 0x3e, 0x76, 0xef, 0x3b, 0x69, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x40, 0x05, 0x42, 0x71, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38,
-	0xac, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x57, 0x3c, 0x0a, 0xf9, 0xc0, 0x01, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xc7, 0x33, 0xad, 0xd9, 0xd2, 0xf1, 0xa0, 0x7d, 0x5a, 0xb4, 0x44, 0x59, 0x65, 0x2a, 0x6d,
+	0x0d, 0x38, 0x63, 0x53, 0x04, 0xf1, 0x98, 0x83, 0x22, 0xf5, 0x90, 0xec, 0x51, 0x04, 0x99, 0x34,
+	0xc3, 0xb2, 0x98, 0xec, 0x6c, 0x76, 0x36, 0x62, 0x10, 0x2f, 0xea, 0xc9, 0x93, 0x20, 0x7e, 0x00,
+	0x6f, 0x1e, 0xfd, 0x18, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x49, 0x04, 0xbf, 0x86, 0xcc, 0xcb, 0xd2,
+	0xdd, 0xc6, 0x64, 0xbd, 0x24, 0x93, 0x99, 0xff, 0xf3, 0x7f, 0x7e, 0xcf, 0x4b, 0xf0, 0xb5, 0xc1,
+	0x78, 0x28, 0x52, 0xce, 0xec, 0x57, 0xd4, 0x67, 0xa3, 0xb1, 0x48, 0x27, 0x34, 0x49, 0x65, 0x26,
+	0xe1, 0x92, 0xbd, 0xa5, 0xf9, 0x63, 0x63, 0x83, 0x0f, 0xa3, 0x58, 0x32, 0xf3, 0x69, 0x35, 0x8d,
+	0xad, 0x50, 0x86, 0xd2, 0x1c, 0x99, 0x3e, 0xb9, 0xdb, 0xeb, 0xa1, 0x94, 0xe1, 0x40, 0x30, 0x9e,
+	0x44, 0x8c, 0xc7, 0xb1, 0xcc, 0x78, 0x16, 0xc9, 0x58, 0xb9, 0xd7, 0xe6, 0xb1, 0x54, 0x43, 0xa9,
+	0x58, 0x8f, 0x2b, 0x61, 0x13, 0xb2, 0x97, 0x07, 0x3d, 0x91, 0xf1, 0x03, 0x96, 0xf0, 0x30, 0x8a,
+	0x8d, 0x38, 0x77, 0x3a, 0x0f, 0x98, 0xf0, 0x94, 0x0f, 0xd5, 0xc2, 0xd7, 0xd1, 0xf3, 0x17, 0xc2,
+	0xf1, 0x93, 0x2d, 0x0c, 0x5d, 0xed, 0xde, 0x31, 0x21, 0x81, 0x18, 0x8d, 0x85, 0xca, 0x48, 0x17,
+	0x6f, 0x96, 0x6e, 0x55, 0x22, 0x63, 0x25, 0xe0, 0x01, 0xf6, 0xac, 0xf5, 0x36, 0xba, 0x89, 0xf6,
+	0x2f, 0xb6, 0xae, 0xd2, 0x73, 0xd5, 0x53, 0x1b, 0xd0, 0x5e, 0x3f, 0xf9, 0x79, 0xa3, 0xf6, 0xf5,
+	0xcf, 0xb7, 0x26, 0x0a, 0x5c, 0x04, 0xb9, 0x83, 0x37, 0xac, 0x65, 0xf7, 0x48, 0x4c, 0x5c, 0x1e,
+	0xd8, 0xc6, 0x6b, 0xbc, 0xdf, 0x4f, 0x85, 0xb2, 0x8e, 0xeb, 0x41, 0xfe, 0x93, 0x3c, 0xc6, 0x50,
+	0x94, 0x3b, 0x80, 0x43, 0xec, 0x59, 0x7a, 0x07, 0x70, 0x65, 0x1e, 0x40, 0xeb, 0xdb, 0x17, 0x74,
+	0xfe, 0xa0, 0x9e, 0x8c, 0x8e, 0xc4, 0x84, 0x3c, 0x2b, 0x5a, 0xe5, 0x25, 0xc2, 0x43, 0x8c, 0xcf,
+	0x1a, 0xe9, 0xec, 0x76, 0xa9, 0xed, 0x3a, 0xd5, 0x5d, 0xa7, 0x76, 0xcc, 0xae, 0xeb, 0xb4, 0xc3,
+	0x43, 0xe1, 0x62, 0x83, 0x42, 0x24, 0xf9, 0x8c, 0xf0, 0x66, 0xc9, 0xde, 0xa1, 0xde, 0xc3, 0x6b,
+	0x16, 0x55, 0x97, 0xb6, 0x5a, 0xc9, 0xea, 0x19, 0x56, 0x05, 0x8f, 0x4a, 0x58, 0x2b, 0x06, 0x6b,
+	0xaf, 0x12, 0xcb, 0xe6, 0x2c, 0x72, 0xb5, 0xbe, 0xac, 0xe2, 0xba, 0xe1, 0x82, 0x77, 0x08, 0x7b,
+	0x76, 0x2e, 0xb0, 0x33, 0xc7, 0x30, 0x3f, 0xfc, 0xc6, 0xad, 0xe5, 0x22, 0x9b, 0x8b, 0xd0, 0xb7,
+	0xdf, 0x7f, 0x7f, 0x5a, 0xd9, 0x87, 0x5d, 0xf6, 0xc4, 0xc8, 0x3a, 0x7a, 0x9d, 0x8e, 0xe5, 0x80,
+	0xfd, 0x7b, 0x19, 0xe1, 0x03, 0xc2, 0x75, 0x53, 0x30, 0x90, 0x05, 0xfe, 0x85, 0xc5, 0x68, 0xec,
+	0x2c, 0xd5, 0x38, 0x84, 0xfb, 0x06, 0xa1, 0x05, 0x77, 0x2b, 0x11, 0xcc, 0x20, 0xd8, 0x6b, 0xb7,
+	0x5c, 0x6f, 0xe0, 0xbd, 0x6e, 0x89, 0x99, 0x17, 0x2c, 0xcb, 0x54, 0xd9, 0x92, 0xd2, 0xc8, 0x09,
+	0x33, 0x3c, 0xb7, 0x61, 0xef, 0xff, 0x78, 0x54, 0xbb, 0x79, 0x32, 0xf5, 0xd1, 0xe9, 0xd4, 0x47,
+	0xbf, 0xa6, 0x3e, 0xfa, 0x38, 0xf3, 0x6b, 0xa7, 0x33, 0xbf, 0xf6, 0x63, 0xe6, 0xd7, 0x9e, 0x5e,
+	0x7e, 0x75, 0xa6, 0xce, 0x26, 0x89, 0x50, 0x3d, 0xcf, 0xfc, 0x5f, 0x0f, 0xff, 0x0e, 0x00, 0x36,
+	0x45, 0x74, 0x94, 0x8e, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +363,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PQKey resolves an address to its registered post-quantum key.
+	PQKey(ctx context.Context, in *QueryPQKeyRequest, opts ...grpc.CallOption) (*QueryPQKeyResponse, error)
+	// PQKeys lists the registered post-quantum keys.
+	PQKeys(ctx context.Context, in *QueryPQKeysRequest, opts ...grpc.CallOption) (*QueryPQKeysResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +386,32 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PQKey(ctx context.Context, in *QueryPQKeyRequest, opts ...grpc.CallOption) (*QueryPQKeyResponse, error) {
+	out := new(QueryPQKeyResponse)
+	err := c.cc.Invoke(ctx, "/lumera.lumeraid.Query/PQKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PQKeys(ctx context.Context, in *QueryPQKeysRequest, opts ...grpc.CallOption) (*QueryPQKeysResponse, error) {
+	out := new(QueryPQKeysResponse)
+	err := c.cc.Invoke(ctx, "/lumera.lumeraid.Query/PQKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PQKey resolves an address to its registered post-quantum key.
+	PQKey(context.Context, *QueryPQKeyRequest) (*QueryPQKeyResponse, error)
+	// PQKeys lists the registered post-quantum keys.
+	PQKeys(context.Context, *QueryPQKeysRequest) (*QueryPQKeysResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +421,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PQKey(ctx context.Context, req *QueryPQKeyRequest) (*QueryPQKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PQKey not implemented")
+}
+func (*UnimplementedQueryServer) PQKeys(ctx context.Context, req *QueryPQKeysRequest) (*QueryPQKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PQKeys not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +450,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PQKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPQKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PQKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.lumeraid.Query/PQKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PQKey(ctx, req.(*QueryPQKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PQKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPQKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PQKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.lumeraid.Query/PQKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PQKeys(ctx, req.(*QueryPQKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lumera.lumeraid.Query",
@@ -219,6 +495,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PQKey",
+			Handler:    _Query_PQKey_Handler,
+		},
+		{
+			MethodName: "PQKeys",
+			Handler:    _Query_PQKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lumera/lumeraid/query.proto",
@@ -280,6 +564,153 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPQKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPQKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPQKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPQKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPQKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPQKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PqKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPQKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPQKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPQKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPQKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPQKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPQKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PqKeys) > 0 {
+		for iNdEx := len(m.PqKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PqKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -311,7 +742,63 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryPQKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPQKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PqKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPQKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPQKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PqKeys) > 0 {
+		for _, e := range m.PqKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -450,6 +937,377 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPQKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPQKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPQKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPQKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPQKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPQKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PqKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PqKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPQKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPQKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPQKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPQKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPQKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPQKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PqKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PqKeys = append(m.PqKeys, PQKey{})
+			if err := m.PqKeys[len(m.PqKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
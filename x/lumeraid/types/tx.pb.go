@@ -124,35 +124,385 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterPQKey registers a LegRoast public key for the creator. The
+// transaction signature by the creator's secp256k1 or eth_secp256k1 key and
+// the LegRoast signature over the binding payload together form the dual
+// signature that binds the two keys.
+type MsgRegisterPQKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// public_key is the LegRoast public key to register.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// signature is the LegRoast signature by public_key over the binding
+	// payload for the next key version.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgRegisterPQKey) Reset()         { *m = MsgRegisterPQKey{} }
+func (m *MsgRegisterPQKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPQKey) ProtoMessage()    {}
+func (*MsgRegisterPQKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113576a92e897901, []int{2}
+}
+func (m *MsgRegisterPQKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPQKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPQKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPQKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPQKey.Merge(m, src)
+}
+func (m *MsgRegisterPQKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPQKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPQKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPQKey proto.InternalMessageInfo
+
+func (m *MsgRegisterPQKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRegisterPQKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *MsgRegisterPQKey) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgRegisterPQKeyResponse defines the response structure for executing a
+// MsgRegisterPQKey message.
+type MsgRegisterPQKeyResponse struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgRegisterPQKeyResponse) Reset()         { *m = MsgRegisterPQKeyResponse{} }
+func (m *MsgRegisterPQKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPQKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterPQKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113576a92e897901, []int{3}
+}
+func (m *MsgRegisterPQKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPQKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPQKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPQKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPQKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterPQKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPQKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPQKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPQKeyResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterPQKeyResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// MsgRotatePQKey replaces the creator's active LegRoast key. Both the current
+// and the new key sign the binding payload of the new key version.
+type MsgRotatePQKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// new_public_key is the LegRoast public key that replaces the current one.
+	NewPublicKey []byte `protobuf:"bytes,2,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	// new_signature is the LegRoast signature by new_public_key.
+	NewSignature []byte `protobuf:"bytes,3,opt,name=new_signature,json=newSignature,proto3" json:"new_signature,omitempty"`
+	// current_signature is the LegRoast signature by the registered key.
+	CurrentSignature []byte `protobuf:"bytes,4,opt,name=current_signature,json=currentSignature,proto3" json:"current_signature,omitempty"`
+}
+
+func (m *MsgRotatePQKey) Reset()         { *m = MsgRotatePQKey{} }
+func (m *MsgRotatePQKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePQKey) ProtoMessage()    {}
+func (*MsgRotatePQKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113576a92e897901, []int{4}
+}
+func (m *MsgRotatePQKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePQKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePQKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePQKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePQKey.Merge(m, src)
+}
+func (m *MsgRotatePQKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePQKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePQKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePQKey proto.InternalMessageInfo
+
+func (m *MsgRotatePQKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRotatePQKey) GetNewPublicKey() []byte {
+	if m != nil {
+		return m.NewPublicKey
+	}
+	return nil
+}
+
+func (m *MsgRotatePQKey) GetNewSignature() []byte {
+	if m != nil {
+		return m.NewSignature
+	}
+	return nil
+}
+
+func (m *MsgRotatePQKey) GetCurrentSignature() []byte {
+	if m != nil {
+		return m.CurrentSignature
+	}
+	return nil
+}
+
+// MsgRotatePQKeyResponse defines the response structure for executing a
+// MsgRotatePQKey message.
+type MsgRotatePQKeyResponse struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgRotatePQKeyResponse) Reset()         { *m = MsgRotatePQKeyResponse{} }
+func (m *MsgRotatePQKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePQKeyResponse) ProtoMessage()    {}
+func (*MsgRotatePQKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113576a92e897901, []int{5}
+}
+func (m *MsgRotatePQKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePQKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePQKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePQKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePQKeyResponse.Merge(m, src)
+}
+func (m *MsgRotatePQKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePQKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePQKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePQKeyResponse proto.InternalMessageInfo
+
+func (m *MsgRotatePQKeyResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// MsgRevokePQKey revokes the creator's active LegRoast key. The active key
+// must sign the revocation, so the account key alone, which a quantum attacker
+// could forge, cannot strip the account of its PQ key and register another.
+type MsgRevokePQKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// signature is the LegRoast signature by the active key over its
+	// revocation payload.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgRevokePQKey) Reset()         { *m = MsgRevokePQKey{} }
+func (m *MsgRevokePQKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePQKey) ProtoMessage()    {}
+func (*MsgRevokePQKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113576a92e897901, []int{6}
+}
+func (m *MsgRevokePQKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePQKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePQKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePQKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePQKey.Merge(m, src)
+}
+func (m *MsgRevokePQKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePQKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePQKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePQKey proto.InternalMessageInfo
+
+func (m *MsgRevokePQKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevokePQKey) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgRevokePQKeyResponse defines the response structure for executing a
+// MsgRevokePQKey message.
+type MsgRevokePQKeyResponse struct {
+}
+
+func (m *MsgRevokePQKeyResponse) Reset()         { *m = MsgRevokePQKeyResponse{} }
+func (m *MsgRevokePQKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePQKeyResponse) ProtoMessage()    {}
+func (*MsgRevokePQKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113576a92e897901, []int{7}
+}
+func (m *MsgRevokePQKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePQKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePQKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokePQKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePQKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokePQKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePQKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePQKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePQKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "lumera.lumeraid.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumera.lumeraid.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterPQKey)(nil), "lumera.lumeraid.MsgRegisterPQKey")
+	proto.RegisterType((*MsgRegisterPQKeyResponse)(nil), "lumera.lumeraid.MsgRegisterPQKeyResponse")
+	proto.RegisterType((*MsgRotatePQKey)(nil), "lumera.lumeraid.MsgRotatePQKey")
+	proto.RegisterType((*MsgRotatePQKeyResponse)(nil), "lumera.lumeraid.MsgRotatePQKeyResponse")
+	proto.RegisterType((*MsgRevokePQKey)(nil), "lumera.lumeraid.MsgRevokePQKey")
+	proto.RegisterType((*MsgRevokePQKeyResponse)(nil), "lumera.lumeraid.MsgRevokePQKeyResponse")
 }
 
 func init() { proto.RegisterFile("lumera/lumeraid/tx.proto", fileDescriptor_113576a92e897901) }
 
 var fileDescriptor_113576a92e897901 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0x29, 0xcd, 0x4d,
-	0x2d, 0x4a, 0xd4, 0x87, 0x50, 0x99, 0x29, 0xfa, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xfc, 0x10, 0x21, 0x3d, 0x98, 0x8c, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98,
-	0x84, 0xa8, 0x91, 0x12, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x2d, 0x4e, 0xd7, 0x2f,
-	0x33, 0x04, 0x51, 0x50, 0x09, 0x49, 0x88, 0x44, 0x3c, 0x98, 0xa7, 0x0f, 0xe1, 0x40, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0x21, 0xe2, 0x20, 0x16, 0x54, 0x54, 0x06, 0xdd, 0x1d, 0x05, 0x89, 0x45,
-	0x89, 0xb9, 0x50, 0x3d, 0x4a, 0xbb, 0x19, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12,
-	0x4b, 0x52, 0x03, 0xc0, 0x32, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99,
-	0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x2d,
-	0x73, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x42, 0x28,
-	0x15, 0xb2, 0xe2, 0x62, 0x83, 0x98, 0x2d, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xae, 0x87,
-	0xe6, 0x51, 0x3d, 0x88, 0x05, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b,
-	0x31, 0x08, 0xaa, 0xc3, 0xca, 0xa4, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x59, 0x5d, 0xcf, 0x37, 0x68,
-	0x29, 0x42, 0x1d, 0x5e, 0x81, 0x70, 0x3a, 0x9a, 0x4b, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x82,
-	0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0x32, 0xb8, 0x98, 0x7d, 0x8b, 0xd3, 0x85, 0xa2,
-	0xb8, 0x78, 0x50, 0xfc, 0xa6, 0x80, 0xe1, 0x26, 0x34, 0x03, 0xa4, 0x34, 0x08, 0xa9, 0x80, 0x59,
-	0x21, 0xc5, 0xda, 0x00, 0xf2, 0x82, 0x93, 0xd6, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0x09, 0x20, 0x39, 0xbd, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xea, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x00, 0xad, 0x4b, 0x1d, 0x02, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xb1, 0x6b, 0xdb, 0x4e,
+	0x14, 0xb6, 0x9c, 0xfc, 0x12, 0xfc, 0xe2, 0x24, 0x8e, 0x08, 0xb1, 0x22, 0xf2, 0x53, 0x1c, 0xb5,
+	0x10, 0xd7, 0xa5, 0x16, 0x71, 0x42, 0x07, 0x6f, 0xf5, 0x5a, 0x0c, 0xae, 0x42, 0x29, 0x04, 0x8a,
+	0x51, 0xec, 0x43, 0x15, 0x89, 0x75, 0xe2, 0xee, 0xec, 0xc4, 0x5b, 0xe9, 0xd8, 0xa9, 0x4b, 0xff,
+	0x87, 0x8e, 0x1e, 0x3a, 0x14, 0xfa, 0x0f, 0x64, 0x0c, 0x9d, 0x3a, 0x95, 0x62, 0x0f, 0x1e, 0x3b,
+	0x77, 0x2b, 0xa7, 0x93, 0x2c, 0x4b, 0x31, 0x28, 0xb4, 0x8b, 0xe5, 0xfb, 0xde, 0xf7, 0x3e, 0xbd,
+	0xef, 0x3d, 0xbd, 0x03, 0xe5, 0xb2, 0xdf, 0x43, 0xc4, 0x32, 0xc4, 0xc3, 0xe9, 0x1a, 0xec, 0xba,
+	0xea, 0x11, 0xcc, 0xb0, 0xbc, 0x29, 0xa0, 0x6a, 0x18, 0x51, 0xb7, 0xac, 0x9e, 0xe3, 0x62, 0xc3,
+	0xff, 0x15, 0x1c, 0xb5, 0xd8, 0xc1, 0xb4, 0x87, 0xa9, 0xd1, 0xa3, 0xb6, 0x31, 0x38, 0xe2, 0x8f,
+	0x20, 0xb0, 0x2b, 0x02, 0x6d, 0xff, 0x64, 0x88, 0x43, 0x10, 0xda, 0xb6, 0xb1, 0x8d, 0x05, 0xce,
+	0xff, 0x05, 0xe8, 0x5e, 0xb2, 0x0e, 0xcf, 0x22, 0x56, 0x2f, 0xc8, 0xd1, 0xbf, 0x4a, 0xb0, 0xd9,
+	0xa4, 0xf6, 0x4b, 0xaf, 0x6b, 0x31, 0xd4, 0xf2, 0x23, 0xf2, 0x53, 0xc8, 0x59, 0x7d, 0xf6, 0x06,
+	0x13, 0x87, 0x0d, 0x15, 0xa9, 0x24, 0x95, 0x73, 0x0d, 0xe5, 0xdb, 0xe7, 0x27, 0xdb, 0xc1, 0xcb,
+	0x9e, 0x75, 0xbb, 0x04, 0x51, 0x7a, 0xca, 0x88, 0xe3, 0xda, 0x66, 0x44, 0x95, 0xeb, 0xb0, 0x22,
+	0xb4, 0x95, 0x6c, 0x49, 0x2a, 0xaf, 0xd5, 0x8a, 0xd5, 0x84, 0xd1, 0xaa, 0x78, 0x41, 0x23, 0x77,
+	0xf3, 0x63, 0x3f, 0xf3, 0x69, 0x3a, 0xaa, 0x48, 0x66, 0x90, 0x51, 0x3f, 0x79, 0x37, 0x1d, 0x55,
+	0x22, 0xad, 0xf7, 0xd3, 0x51, 0xe5, 0x20, 0x28, 0xfc, 0x3a, 0x2a, 0x3d, 0x51, 0xa9, 0xbe, 0x0b,
+	0xc5, 0x04, 0x64, 0x22, 0xea, 0x61, 0x97, 0x22, 0xfd, 0x8b, 0x04, 0x85, 0x26, 0xb5, 0x4d, 0x64,
+	0x3b, 0x94, 0x21, 0xd2, 0x7a, 0xf1, 0x1c, 0x0d, 0xe5, 0x1a, 0xac, 0x76, 0x08, 0xb2, 0x18, 0x26,
+	0xa9, 0xbe, 0x42, 0xa2, 0xfc, 0x3f, 0x80, 0xd7, 0x3f, 0xbf, 0x74, 0x3a, 0xed, 0x0b, 0x34, 0xf4,
+	0x9d, 0xe5, 0xcd, 0x9c, 0x40, 0xb8, 0xe4, 0x1e, 0xe4, 0xa8, 0x63, 0xbb, 0x16, 0xeb, 0x13, 0xa4,
+	0x2c, 0x89, 0xe8, 0x0c, 0xa8, 0x1f, 0x73, 0x5b, 0xa1, 0x14, 0x37, 0xa5, 0x2f, 0x34, 0x15, 0xab,
+	0x52, 0x3f, 0x01, 0x25, 0x89, 0x85, 0xb6, 0x64, 0x05, 0x56, 0x07, 0x88, 0x50, 0x07, 0xbb, 0xbe,
+	0x83, 0x65, 0x33, 0x3c, 0xea, 0xbf, 0x24, 0xd8, 0xe0, 0x69, 0x98, 0xf1, 0x66, 0xfc, 0xb5, 0xdd,
+	0x87, 0xb0, 0xe1, 0xa2, 0xab, 0xf6, 0x1d, 0xcb, 0x79, 0x17, 0x5d, 0xb5, 0x66, 0xae, 0x1f, 0xc0,
+	0x3a, 0x67, 0x25, 0x9d, 0x73, 0xd2, 0x69, 0x88, 0xc9, 0x8f, 0x61, 0xab, 0xd3, 0x27, 0x04, 0xb9,
+	0x6c, 0x8e, 0xb8, 0xec, 0x13, 0x0b, 0x41, 0x60, 0x46, 0xae, 0x1f, 0x25, 0x3b, 0x55, 0x5a, 0xdc,
+	0xa9, 0xc8, 0x9e, 0x5e, 0x83, 0x9d, 0x38, 0x72, 0x8f, 0x2e, 0x7d, 0x0c, 0xba, 0x84, 0x06, 0xf8,
+	0xe2, 0x1f, 0xba, 0x14, 0x9b, 0x7a, 0x36, 0x39, 0xf5, 0xfb, 0x7a, 0x89, 0x8a, 0xd0, 0x15, 0xd8,
+	0x89, 0x23, 0xa1, 0x97, 0xda, 0xef, 0x2c, 0x2c, 0x35, 0xa9, 0x2d, 0x9f, 0x41, 0x3e, 0xb6, 0xa5,
+	0xa5, 0x3b, 0xdb, 0x95, 0x58, 0x05, 0xb5, 0x9c, 0xc6, 0x98, 0xf5, 0xeb, 0x35, 0xac, 0xc7, 0x17,
+	0xe5, 0x60, 0x51, 0x6a, 0x8c, 0xa2, 0x3e, 0x4a, 0xa5, 0xcc, 0xe4, 0x5f, 0xc1, 0xda, 0xfc, 0x67,
+	0xb9, 0xbf, 0x30, 0x33, 0x22, 0xa8, 0x87, 0x29, 0x84, 0x98, 0xf0, 0xdc, 0x24, 0x17, 0x0b, 0x47,
+	0x04, 0xf5, 0x30, 0x85, 0x10, 0x0a, 0xab, 0xff, 0xbd, 0xe5, 0xb7, 0x53, 0xa3, 0x72, 0x33, 0xd6,
+	0xa4, 0xdb, 0xb1, 0x26, 0xfd, 0x1c, 0x6b, 0xd2, 0x87, 0x89, 0x96, 0xb9, 0x9d, 0x68, 0x99, 0xef,
+	0x13, 0x2d, 0x73, 0x56, 0x98, 0x1b, 0x25, 0x1b, 0x7a, 0x88, 0x9e, 0xaf, 0xf8, 0x17, 0xea, 0xf1,
+	0x9f, 0x01, 0x00, 0x7e, 0x5c, 0x01, 0xd4, 0xf8, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +520,12 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterPQKey binds a LegRoast public key to the signer's account.
+	RegisterPQKey(ctx context.Context, in *MsgRegisterPQKey, opts ...grpc.CallOption) (*MsgRegisterPQKeyResponse, error)
+	// RotatePQKey replaces the signer's registered LegRoast key.
+	RotatePQKey(ctx context.Context, in *MsgRotatePQKey, opts ...grpc.CallOption) (*MsgRotatePQKeyResponse, error)
+	// RevokePQKey revokes the signer's registered LegRoast key.
+	RevokePQKey(ctx context.Context, in *MsgRevokePQKey, opts ...grpc.CallOption) (*MsgRevokePQKeyResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +545,44 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterPQKey(ctx context.Context, in *MsgRegisterPQKey, opts ...grpc.CallOption) (*MsgRegisterPQKeyResponse, error) {
+	out := new(MsgRegisterPQKeyResponse)
+	err := c.cc.Invoke(ctx, "/lumera.lumeraid.Msg/RegisterPQKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotatePQKey(ctx context.Context, in *MsgRotatePQKey, opts ...grpc.CallOption) (*MsgRotatePQKeyResponse, error) {
+	out := new(MsgRotatePQKeyResponse)
+	err := c.cc.Invoke(ctx, "/lumera.lumeraid.Msg/RotatePQKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokePQKey(ctx context.Context, in *MsgRevokePQKey, opts ...grpc.CallOption) (*MsgRevokePQKeyResponse, error) {
+	out := new(MsgRevokePQKeyResponse)
+	err := c.cc.Invoke(ctx, "/lumera.lumeraid.Msg/RevokePQKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterPQKey binds a LegRoast public key to the signer's account.
+	RegisterPQKey(context.Context, *MsgRegisterPQKey) (*MsgRegisterPQKeyResponse, error)
+	// RotatePQKey replaces the signer's registered LegRoast key.
+	RotatePQKey(context.Context, *MsgRotatePQKey) (*MsgRotatePQKeyResponse, error)
+	// RevokePQKey revokes the signer's registered LegRoast key.
+	RevokePQKey(context.Context, *MsgRevokePQKey) (*MsgRevokePQKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +592,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterPQKey(ctx context.Context, req *MsgRegisterPQKey) (*MsgRegisterPQKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPQKey not implemented")
+}
+func (*UnimplementedMsgServer) RotatePQKey(ctx context.Context, req *MsgRotatePQKey) (*MsgRotatePQKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePQKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokePQKey(ctx context.Context, req *MsgRevokePQKey) (*MsgRevokePQKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePQKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +624,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterPQKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPQKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPQKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.lumeraid.Msg/RegisterPQKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPQKey(ctx, req.(*MsgRegisterPQKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotatePQKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePQKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePQKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.lumeraid.Msg/RotatePQKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePQKey(ctx, req.(*MsgRotatePQKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokePQKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePQKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePQKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.lumeraid.Msg/RevokePQKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePQKey(ctx, req.(*MsgRevokePQKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lumera.lumeraid.Msg",
@@ -235,6 +687,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterPQKey",
+			Handler:    _Msg_RegisterPQKey_Handler,
+		},
+		{
+			MethodName: "RotatePQKey",
+			Handler:    _Msg_RotatePQKey_Handler,
+		},
+		{
+			MethodName: "RevokePQKey",
+			Handler:    _Msg_RevokePQKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lumera/lumeraid/tx.proto",
@@ -303,17 +767,228 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *MsgRegisterPQKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPQKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPQKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPQKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPQKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPQKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePQKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePQKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePQKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentSignature) > 0 {
+		i -= len(m.CurrentSignature)
+		copy(dAtA[i:], m.CurrentSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewSignature) > 0 {
+		i -= len(m.NewSignature)
+		copy(dAtA[i:], m.NewSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewPublicKey) > 0 {
+		i -= len(m.NewPublicKey)
+		copy(dAtA[i:], m.NewPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePQKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePQKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePQKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePQKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePQKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePQKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePQKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePQKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePQKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -338,6 +1013,102 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterPQKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPQKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRotatePQKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CurrentSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotatePQKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRevokePQKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokePQKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -509,6 +1280,644 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterPQKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPQKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPQKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPQKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPQKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPQKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePQKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePQKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePQKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPublicKey = append(m.NewPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewSignature = append(m.NewSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewSignature == nil {
+				m.NewSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentSignature = append(m.CurrentSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentSignature == nil {
+				m.CurrentSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePQKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePQKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePQKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePQKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePQKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePQKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePQKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePQKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePQKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
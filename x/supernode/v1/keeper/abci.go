@@ -7,15 +7,19 @@ import (
 )
 
 // BeginBlocker contains logic that runs at the beginning of each block.
-// Currently the supernode module has no begin-block behavior.
+// It records the block entropy used to seed supernode ranking.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
+	k.RecordBlockEntropy(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
 // EndBlocker contains logic that runs at the end of each block.
-// It delegates to HandleMetricsStaleness, which may transition ACTIVE
-// supernodes into POSTPONED when they fail to report metrics on time.
+// It returns matured self-stake unbondings, executes slashes whose appeal
+// window has closed and runs the Everlight distribution when a payment
+// period has elapsed.
 func (k Keeper) EndBlocker(ctx context.Context) error {
+	k.CompleteMatureSelfStakeUnbondings(sdk.UnwrapSDKContext(ctx))
+	k.ExecuteMatureSlashes(sdk.UnwrapSDKContext(ctx))
 	// Metrics staleness enforcement is handled by the audit module.
 	return k.distributeSuperNodeRewards(ctx)
 }
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"lukechampine.com/blake3"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// blockEntropySeedLen is the size of a recorded ranking seed.
+const blockEntropySeedLen = 32
+
+// RecordBlockEntropy stores the ranking seed for the current block and prunes
+// seeds that have left the retention window. The seed is
+// blake3(headerHash || bigEndian(height)), so it is only known once the block
+// has been proposed and cannot be precomputed from the height alone. Seeds are
+// keyed by height, so changing BlockEntropyRetentionBlocks only moves the
+// pruning cutoff and never invalidates seeds that are still retained.
+func (k Keeper) RecordBlockEntropy(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	if height <= 0 {
+		return
+	}
+	retention := k.GetParams(ctx).BlockEntropyRetentionBlocks
+	if retention == 0 {
+		return
+	}
+
+	var heightBz [8]byte
+	binary.BigEndian.PutUint64(heightBz[:], uint64(height))
+	hasher := blake3.New(blockEntropySeedLen, nil)
+	_, _ = hasher.Write(ctx.HeaderHash())
+	_, _ = hasher.Write(heightBz[:])
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.BlockEntropyKey(uint64(height)), hasher.Sum(nil))
+
+	if uint64(height) <= retention {
+		return
+	}
+	// Everything at or below height-retention has left the window. This is
+	// usually one entry, or more right after the retention was lowered.
+	iter := store.Iterator(types.BlockEntropyPrefix, types.BlockEntropyKey(uint64(height)-retention+1))
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// getRecordedBlockEntropy returns the seed recorded for height, if it is
+// still within the retention window.
+func (k Keeper) getRecordedBlockEntropy(ctx sdk.Context, height int64, retention uint64) ([]byte, bool) {
+	if retention == 0 || height > ctx.BlockHeight() || uint64(ctx.BlockHeight()-height) >= retention {
+		return nil, false
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.BlockEntropyKey(uint64(height)))
+	if len(bz) != blockEntropySeedLen {
+		return nil, false
+	}
+	return bz, true
+}
+
+// legacyBlockHashForHeight is the pre-switch ranking seed. It is fully
+// predictable and only kept so actions below the switch height keep verifying.
+func legacyBlockHashForHeight(height int64) []byte {
+	h := blake3.Sum256([]byte(fmt.Sprintf("%d", height)))
+	return h[:]
+}
+
+// GetBlockHashForHeight returns the seed used to rank supernodes for height.
+// Heights below Params.EntropySwitchHeight (or all heights while it is zero)
+// use the legacy blake3(height) derivation; later heights use the entropy
+// recorded in BeginBlock, which is only retained for the last
+// BlockEntropyRetentionBlocks heights.
+func (k Keeper) GetBlockHashForHeight(ctx sdk.Context, height int64) ([]byte, error) {
+	if height <= 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height %d", height)
+	}
+
+	params := k.GetParams(ctx)
+	if params.EntropySwitchHeight == 0 || height < params.EntropySwitchHeight {
+		return legacyBlockHashForHeight(height), nil
+	}
+
+	if seed, ok := k.getRecordedBlockEntropy(ctx, height, params.BlockEntropyRetentionBlocks); ok {
+		return seed, nil
+	}
+	if height > ctx.BlockHeight() {
+		return nil, errorsmod.Wrapf(types.ErrBlockEntropyUnavailable, "height %d is in the future (current %d)", height, ctx.BlockHeight())
+	}
+	return nil, errorsmod.Wrapf(types.ErrBlockEntropyUnavailable,
+		"height %d is outside the retained window of %d blocks", height, params.BlockEntropyRetentionBlocks)
+}
+
+// validateEntropySwitchUpdate rejects switch-height changes that would alter
+// the ranking of already produced blocks: an active switch is frozen, and a
+// new or rescheduled switch must lie strictly in the future.
+func validateEntropySwitchUpdate(currentHeight int64, oldSwitch, newSwitch int64) error {
+	if oldSwitch == newSwitch {
+		return nil
+	}
+	if oldSwitch != 0 && oldSwitch <= currentHeight {
+		return errorsmod.Wrapf(types.ErrInvalidEntropySwitch,
+			"entropy switch already active since height %d", oldSwitch)
+	}
+	if newSwitch <= currentHeight {
+		return errorsmod.Wrapf(types.ErrInvalidEntropySwitch,
+			"entropy switch height %d must be after current height %d", newSwitch, currentHeight)
+	}
+	return nil
+}
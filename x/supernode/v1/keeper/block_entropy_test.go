@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/stretchr/testify/require"
+
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func TestGetBlockHashForHeightLegacyBeforeSwitch(t *testing.T) {
+	k, ctx, _, _, _ := setupTestKeeper(t)
+	ctx = ctx.WithBlockHeight(50).WithHeaderHash([]byte("header-50"))
+	k.RecordBlockEntropy(ctx)
+
+	// Switch disabled: every height uses the legacy derivation.
+	seed, err := k.GetBlockHashForHeight(ctx, 50)
+	require.NoError(t, err)
+	require.Equal(t, legacyBlockHashForHeight(50), seed)
+
+	params := k.GetParams(ctx)
+	params.EntropySwitchHeight = 50
+	require.NoError(t, k.SetParams(ctx, params))
+
+	seed, err = k.GetBlockHashForHeight(ctx, 49)
+	require.NoError(t, err)
+	require.Equal(t, legacyBlockHashForHeight(49), seed)
+
+	seed, err = k.GetBlockHashForHeight(ctx, 50)
+	require.NoError(t, err)
+	require.NotEqual(t, legacyBlockHashForHeight(50), seed)
+	require.Len(t, seed, blockEntropySeedLen)
+}
+
+func TestRecordBlockEntropyDependsOnHeaderHash(t *testing.T) {
+	seedFor := func(headerHash string) []byte {
+		k, ctx, _, _, _ := setupTestKeeper(t)
+		params := k.GetParams(ctx)
+		params.EntropySwitchHeight = 1
+		require.NoError(t, k.SetParams(ctx, params))
+
+		ctx = ctx.WithBlockHeight(7).WithHeaderHash([]byte(headerHash))
+		k.RecordBlockEntropy(ctx)
+		seed, err := k.GetBlockHashForHeight(ctx, 7)
+		require.NoError(t, err)
+		return seed
+	}
+
+	require.Equal(t, seedFor("hash-a"), seedFor("hash-a"))
+	require.NotEqual(t, seedFor("hash-a"), seedFor("hash-b"))
+}
+
+func TestBlockEntropyRetention(t *testing.T) {
+	k, ctx, _, _, _ := setupTestKeeper(t)
+	params := k.GetParams(ctx)
+	params.EntropySwitchHeight = 1
+	params.BlockEntropyRetentionBlocks = 4
+	require.NoError(t, k.SetParams(ctx, params))
+
+	seeds := make(map[int64][]byte)
+	for h := int64(10); h <= 15; h++ {
+		ctx = ctx.WithBlockHeight(h).WithHeaderHash([]byte{byte(h)})
+		k.RecordBlockEntropy(ctx)
+		seed, err := k.GetBlockHashForHeight(ctx, h)
+		require.NoError(t, err)
+		seeds[h] = seed
+	}
+
+	// Heights 12..15 are still retained and resolve to the same seed.
+	for h := int64(12); h <= 15; h++ {
+		seed, err := k.GetBlockHashForHeight(ctx, h)
+		require.NoError(t, err)
+		require.Equal(t, seeds[h], seed)
+	}
+
+	// Heights 10 and 11 have left the window and were pruned.
+	_, err := k.GetBlockHashForHeight(ctx, 10)
+	require.ErrorIs(t, err, sntypes.ErrBlockEntropyUnavailable)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	require.Nil(t, store.Get(sntypes.BlockEntropyKey(11)))
+
+	// Future heights have no entropy yet.
+	_, err = k.GetBlockHashForHeight(ctx, 16)
+	require.ErrorIs(t, err, sntypes.ErrBlockEntropyUnavailable)
+}
+
+func TestValidateEntropySwitchUpdate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		current   int64
+		oldSwitch int64
+		newSwitch int64
+		wantErr   bool
+	}{
+		{name: "unchanged disabled", current: 100, oldSwitch: 0, newSwitch: 0},
+		{name: "schedule future switch", current: 100, oldSwitch: 0, newSwitch: 200},
+		{name: "schedule switch in the past", current: 100, oldSwitch: 0, newSwitch: 50, wantErr: true},
+		{name: "schedule switch at current height", current: 100, oldSwitch: 0, newSwitch: 100, wantErr: true},
+		{name: "reschedule pending switch", current: 100, oldSwitch: 150, newSwitch: 300},
+		{name: "reschedule active switch", current: 100, oldSwitch: 80, newSwitch: 300, wantErr: true},
+		{name: "unchanged active switch", current: 100, oldSwitch: 80, newSwitch: 80},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateEntropySwitchUpdate(tc.current, tc.oldSwitch, tc.newSwitch)
+			if tc.wantErr {
+				require.ErrorIs(t, err, sntypes.ErrInvalidEntropySwitch)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlockEntropySurvivesRetentionChange(t *testing.T) {
+	k, ctx, _, _, _ := setupTestKeeper(t)
+	params := k.GetParams(ctx)
+	params.EntropySwitchHeight = 1
+	params.BlockEntropyRetentionBlocks = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	seeds := make(map[int64][]byte)
+	for h := int64(1); h <= 8; h++ {
+		ctx = ctx.WithBlockHeight(h).WithHeaderHash([]byte{byte(h)})
+		k.RecordBlockEntropy(ctx)
+		seeds[h], _ = k.GetBlockHashForHeight(ctx, h)
+	}
+
+	// A larger window keeps every recorded seed resolvable.
+	params.BlockEntropyRetentionBlocks = 20
+	require.NoError(t, k.SetParams(ctx, params))
+	for h := int64(1); h <= 8; h++ {
+		seed, err := k.GetBlockHashForHeight(ctx, h)
+		require.NoError(t, err)
+		require.Equal(t, seeds[h], seed)
+	}
+
+	// A smaller window only drops the seeds that fall out of it.
+	params.BlockEntropyRetentionBlocks = 3
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(9).WithHeaderHash([]byte{9})
+	k.RecordBlockEntropy(ctx)
+	for h := int64(7); h <= 8; h++ {
+		seed, err := k.GetBlockHashForHeight(ctx, h)
+		require.NoError(t, err)
+		require.Equal(t, seeds[h], seed)
+	}
+	_, err := k.GetBlockHashForHeight(ctx, 6)
+	require.ErrorIs(t, err, sntypes.ErrBlockEntropyUnavailable)
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for h := uint64(1); h <= 6; h++ {
+		require.Nil(t, store.Get(sntypes.BlockEntropyKey(h)))
+	}
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// UpdateSupernodeCommission sets the share of Everlight payouts kept by the
+// supernode account of a validator-backed supernode. As with staking
+// commissions, max_rate and max_change_rate are fixed when the commission is
+// first set, and the rate may change at most once per payment period by no
+// more than max_change_rate.
+func (k Keeper) UpdateSupernodeCommission(ctx sdk.Context, valAddr sdk.ValAddress, rate sdkmath.LegacyDec, maxRate, maxChangeRate *sdkmath.LegacyDec) (types.SupernodeCommission, error) {
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found {
+		return types.SupernodeCommission{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no supernode found for validator %s", valAddr)
+	}
+	if sn.Independent {
+		return types.SupernodeCommission{}, errorsmod.Wrap(types.ErrInvalidCommission, "independent supernodes have no delegators to share payouts with")
+	}
+	if (maxRate == nil) != (maxChangeRate == nil) {
+		return types.SupernodeCommission{}, errorsmod.Wrap(types.ErrInvalidCommission, "max_rate and max_change_rate must be set together")
+	}
+
+	height := ctx.BlockHeight()
+	oldRate := sdkmath.LegacyOneDec()
+	var commission types.SupernodeCommission
+	if sn.Commission == nil {
+		if maxRate == nil {
+			return types.SupernodeCommission{}, errorsmod.Wrap(types.ErrInvalidCommission, "max_rate and max_change_rate are required when the commission is first set")
+		}
+		commission = types.SupernodeCommission{
+			Rate:          rate,
+			MaxRate:       *maxRate,
+			MaxChangeRate: *maxChangeRate,
+			UpdateHeight:  height,
+		}
+	} else {
+		current := *sn.Commission
+		oldRate = current.Rate
+		if maxRate != nil && (!maxRate.Equal(current.MaxRate) || !maxChangeRate.Equal(current.MaxChangeRate)) {
+			return types.SupernodeCommission{}, errorsmod.Wrap(types.ErrInvalidCommission, "max_rate and max_change_rate cannot be changed once set")
+		}
+		if rate.Sub(current.Rate).Abs().GT(current.MaxChangeRate) {
+			return types.SupernodeCommission{}, errorsmod.Wrapf(types.ErrInvalidCommission, "rate change from %s to %s exceeds max_change_rate %s", current.Rate, rate, current.MaxChangeRate)
+		}
+		params := k.GetParams(ctx)
+		if params.RewardDistribution != nil && params.RewardDistribution.PaymentPeriodBlocks > 0 &&
+			uint64(height-current.UpdateHeight) < params.RewardDistribution.PaymentPeriodBlocks {
+			return types.SupernodeCommission{}, errorsmod.Wrapf(types.ErrInvalidCommission, "commission was last changed at height %d and can change once every %d blocks", current.UpdateHeight, params.RewardDistribution.PaymentPeriodBlocks)
+		}
+		commission = current
+		commission.Rate = rate
+		commission.UpdateHeight = height
+	}
+	if err := commission.Validate(); err != nil {
+		return types.SupernodeCommission{}, err
+	}
+
+	sn.Commission = &commission
+	if err := k.SetSuperNode(ctx, sn); err != nil {
+		return types.SupernodeCommission{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommissionUpdated,
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, sn.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, commission.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyOldCommissionRate, oldRate.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxRate, commission.MaxRate.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxChangeRate, commission.MaxChangeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		),
+	)
+
+	return commission, nil
+}
+
+// payoutCommissionRate returns the share of a payout kept by the supernode
+// account. Independent supernodes and supernodes that never set a commission
+// keep their whole payout.
+func payoutCommissionRate(sn types.SuperNode) sdkmath.LegacyDec {
+	if sn.Independent || sn.Commission == nil {
+		return sdkmath.LegacyOneDec()
+	}
+	return sn.Commission.Rate
+}
+
+// splitPayout splits a payout into the commission kept by the supernode
+// account and the rewards shared with the delegators of its validator. The
+// whole payout goes to the supernode account when the validator cannot
+// receive rewards, so nothing is lost to the community pool.
+func (k Keeper) splitPayout(ctx sdk.Context, cand snCandidate, amount sdkmath.Int) (rate sdkmath.LegacyDec, commission, delegatorRewards sdkmath.Int) {
+	rate = cand.commissionRate
+	if rate.GTE(sdkmath.LegacyOneDec()) || !k.canRewardDelegators(ctx, cand.validatorAddr) {
+		return sdkmath.LegacyOneDec(), amount, sdkmath.ZeroInt()
+	}
+	delegatorRewards = sdkmath.LegacyNewDecFromInt(amount).Mul(sdkmath.LegacyOneDec().Sub(rate)).TruncateInt()
+	return rate, amount.Sub(delegatorRewards), delegatorRewards
+}
+
+func (k Keeper) canRewardDelegators(ctx sdk.Context, validatorAddr string) bool {
+	if k.distrKeeper == nil || k.stakingKeeper == nil {
+		return false
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return false
+	}
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil || validator == nil {
+		return false
+	}
+	return validator.GetTokens().IsPositive()
+}
+
+// creditDelegatorRewards moves coins to the distribution module and allocates
+// them to the validator through x/distribution, which takes the validator's
+// staking commission and leaves the rest to its delegators.
+func (k Keeper) creditDelegatorRewards(ctx sdk.Context, validatorAddr string, coins sdk.Coins) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(coins...))
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+type mockStakingKeeper struct {
+	validators  map[string]stakingtypes.Validator
+	delegations map[string]stakingtypes.Delegation // keyed by delegator then validator address
+}
+
+func (m *mockStakingKeeper) ConsensusAddressCodec() address.Codec { return nil }
+
+func (m *mockStakingKeeper) Validator(_ context.Context, valAddr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+	val, ok := m.validators[valAddr.String()]
+	if !ok {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+func (m *mockStakingKeeper) ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	return nil, stakingtypes.ErrNoValidatorFound
+}
+
+func (m *mockStakingKeeper) Delegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationI, error) {
+	delegation, ok := m.delegations[delAddr.String()+valAddr.String()]
+	if !ok {
+		return nil, stakingtypes.ErrNoDelegation
+	}
+	return delegation, nil
+}
+
+func (m *mockStakingKeeper) Unbond(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdkmath.LegacyDec) (sdkmath.Int, error) {
+	key := delAddr.String() + valAddr.String()
+	delegation, ok := m.delegations[key]
+	if !ok || delegation.Shares.LT(shares) {
+		return sdkmath.Int{}, stakingtypes.ErrNotEnoughDelegationShares
+	}
+	delegation.Shares = delegation.Shares.Sub(shares)
+	m.delegations[key] = delegation
+
+	validator, amount := m.validators[valAddr.String()].RemoveDelShares(shares)
+	m.validators[valAddr.String()] = validator
+	return amount, nil
+}
+
+type mockDistributionKeeper struct {
+	allocated map[string]sdk.DecCoins
+}
+
+func newMockDistributionKeeper() *mockDistributionKeeper {
+	return &mockDistributionKeeper{allocated: make(map[string]sdk.DecCoins)}
+}
+
+func (m *mockDistributionKeeper) AllocateTokensToValidator(_ context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
+	m.allocated[val.GetOperator()] = m.allocated[val.GetOperator()].Add(tokens...)
+	return nil
+}
+
+// setupCommissionKeeper returns a keeper with a bonded validator-backed
+// supernode that reports enough cascade bytes to be paid every period.
+func setupCommissionKeeper(t *testing.T) (Keeper, sdk.Context, *mockBankKeeper, *mockDistributionKeeper, sdk.ValAddress, sdk.AccAddress) {
+	t.Helper()
+
+	k, ctx, bankKeeper, snKeeper, auditKeeper := setupTestKeeper(t)
+	params := k.GetParams(ctx)
+	params.RewardDistribution.PaymentPeriodBlocks = 10
+	params.RewardDistribution.MinCascadeBytesForPayment = 1000
+	params.RewardDistribution.NewSnRampUpPeriods = 0
+	params.RewardDistribution.MeasurementSmoothingPeriods = 1
+	params.RewardDistribution.UsageGrowthCapBpsPerPeriod = 10000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	valAddr := makeValAddr(1)
+	accAddr := makeAccAddr(1)
+	addSupernode(snKeeper, auditKeeper, valAddr, accAddr, sntypes.SuperNodeStateActive, 5000)
+
+	stakingKeeper := &mockStakingKeeper{validators: map[string]stakingtypes.Validator{
+		valAddr.String(): {OperatorAddress: valAddr.String(), Tokens: sdkmath.NewInt(1_000_000)},
+	}}
+	distrKeeper := newMockDistributionKeeper()
+	k.stakingKeeper = stakingKeeper
+	k.distrKeeper = distrKeeper
+
+	return k, ctx, bankKeeper, distrKeeper, valAddr, accAddr
+}
+
+func decPtr(s string) *sdkmath.LegacyDec {
+	d := sdkmath.LegacyMustNewDecFromStr(s)
+	return &d
+}
+
+func TestUpdateSupernodeCommission(t *testing.T) {
+	k, ctx, _, _, valAddr, _ := setupCommissionKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	_, err := k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), nil, nil)
+	require.ErrorIs(t, err, sntypes.ErrInvalidCommission, "max rates are required the first time")
+
+	commission, err := k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), decPtr("0.2"), decPtr("0.05"))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), commission.UpdateHeight)
+
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	require.True(t, found)
+	require.NotNil(t, sn.Commission)
+	require.Equal(t, "0.100000000000000000", sn.Commission.Rate.String())
+
+	ctx = ctx.WithBlockHeight(105)
+	_, err = k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.12"), nil, nil)
+	require.ErrorIs(t, err, sntypes.ErrInvalidCommission, "only one change per payment period")
+
+	ctx = ctx.WithBlockHeight(110)
+	_, err = k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.16"), nil, nil)
+	require.ErrorIs(t, err, sntypes.ErrInvalidCommission, "change above max_change_rate")
+
+	_, err = k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.12"), decPtr("0.3"), decPtr("0.05"))
+	require.ErrorIs(t, err, sntypes.ErrInvalidCommission, "max_rate is fixed once set")
+
+	commission, err = k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.15"), decPtr("0.2"), decPtr("0.05"))
+	require.NoError(t, err)
+	require.Equal(t, "0.150000000000000000", commission.Rate.String())
+	require.Equal(t, int64(110), commission.UpdateHeight)
+
+	ctx = ctx.WithBlockHeight(120)
+	_, err = k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.2"), nil, nil)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(130)
+	_, err = k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.21"), nil, nil)
+	require.ErrorIs(t, err, sntypes.ErrInvalidCommission, "rate above max_rate")
+}
+
+func TestUpdateSupernodeCommissionRejectsIndependentSupernode(t *testing.T) {
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	valAddr := addIndependentSupernode(t, k, ctx, bankKeeper, makeAccAddr(2), testMinSelfStake)
+
+	_, err := k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), decPtr("0.2"), decPtr("0.05"))
+	require.ErrorIs(t, err, sntypes.ErrInvalidCommission)
+}
+
+func TestDistributePoolSharesPayoutWithDelegators(t *testing.T) {
+	k, ctx, bankKeeper, distrKeeper, valAddr, accAddr := setupCommissionKeeper(t)
+
+	_, err := k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), decPtr("0.2"), decPtr("0.05"))
+	require.NoError(t, err)
+
+	fundPool(bankKeeper, 10001)
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, k.distributePool(ctx))
+
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	var commission, delegatorRewards sdkmath.Int
+	for _, s := range bankKeeper.sent {
+		switch s.to {
+		case accAddr.String():
+			commission = s.amount.AmountOf("ulume")
+		case distrAddr:
+			delegatorRewards = s.amount.AmountOf("ulume")
+		}
+	}
+	// The supernode keeps the rounding remainder: 10001 * 0.9 = 9000.9 -> 9000.
+	require.Equal(t, sdkmath.NewInt(1001), commission)
+	require.Equal(t, sdkmath.NewInt(9000), delegatorRewards)
+
+	expected := sdk.NewDecCoins(sdk.NewDecCoin("ulume", sdkmath.NewInt(9000)))
+	require.Equal(t, expected, distrKeeper.allocated[valAddr.String()])
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get([]byte(fmt.Sprintf("%s%020d", sntypes.PayoutHistoryPrefixForValidator(valAddr.String()), ctx.BlockHeight())))
+	require.NotNil(t, bz)
+	var entry sntypes.PayoutHistoryEntry
+	require.NoError(t, k.cdc.Unmarshal(bz, &entry))
+	require.Equal(t, sdkmath.NewInt(10001), entry.Amount.AmountOf("ulume"))
+	require.Equal(t, "0.100000000000000000", entry.CommissionRate.String())
+	require.Equal(t, sdkmath.NewInt(1001), entry.Commission.AmountOf("ulume"))
+	require.Equal(t, sdkmath.NewInt(9000), entry.DelegatorRewards.AmountOf("ulume"))
+}
+
+func TestDistributePoolPaysSupernodeWhenValidatorCannotBeRewarded(t *testing.T) {
+	k, ctx, bankKeeper, distrKeeper, valAddr, accAddr := setupCommissionKeeper(t)
+
+	_, err := k.UpdateSupernodeCommission(ctx, valAddr, sdkmath.LegacyZeroDec(), decPtr("0.2"), decPtr("0.05"))
+	require.NoError(t, err)
+	k.stakingKeeper = &mockStakingKeeper{}
+
+	fundPool(bankKeeper, 10000)
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, k.distributePool(ctx))
+
+	require.Len(t, bankKeeper.sent, 1)
+	require.Equal(t, accAddr.String(), bankKeeper.sent[0].to)
+	require.Equal(t, sdkmath.NewInt(10000), bankKeeper.sent[0].amount.AmountOf("ulume"))
+	require.Empty(t, distrKeeper.allocated)
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 
 	sdkmath "cosmossdk.io/math"
@@ -38,7 +37,7 @@ func (k Keeper) CountEligibleSNs(ctx sdk.Context) uint64 {
 
 	var count uint64
 	for _, sn := range supernodes {
-		rawBytes, reportHeight, found := k.getLatestCascadeBytesFromAudit(ctx, sn.SupernodeAccount)
+		reportedBytes, reportHeight, found := k.getLatestCascadeBytesFromAudit(ctx, sn.SupernodeAccount)
 		if !found {
 			continue
 		}
@@ -46,6 +45,7 @@ func (k Keeper) CountEligibleSNs(ctx sdk.Context) uint64 {
 			continue
 		}
 
+		rawBytes := bytesToDec(reportedBytes)
 		distState, exists := k.GetSNDistState(ctx, sn.ValidatorAddress)
 		smoothedBytes := rawBytes
 		if exists {
@@ -53,7 +53,7 @@ func (k Keeper) CountEligibleSNs(ctx sdk.Context) uint64 {
 			smoothedBytes = applyEMA(distState.SmoothedBytes, cappedBytes, dist.MeasurementSmoothingPeriods)
 		}
 
-		if floatToUint64(smoothedBytes) >= dist.MinCascadeBytesForPayment {
+		if meetsMinCascadeBytes(smoothedBytes, dist.MinCascadeBytesForPayment) {
 			count++
 		}
 	}
@@ -65,11 +65,12 @@ func (k Keeper) CountEligibleSNs(ctx sdk.Context) uint64 {
 type snCandidate struct {
 	validatorAddr    string
 	supernodeAccount string
-	rawBytes         float64
-	cappedBytes      float64
-	smoothedBytes    float64
-	rampWeight       float64
-	effectiveWeight  float64
+	rawBytes         sdkmath.LegacyDec
+	cappedBytes      sdkmath.LegacyDec
+	smoothedBytes    sdkmath.LegacyDec
+	rampWeight       sdkmath.LegacyDec
+	effectiveWeight  sdkmath.LegacyDec
+	commissionRate   sdkmath.LegacyDec
 	distState        SNDistState
 }
 
@@ -111,7 +112,7 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 	// 3. Build candidates, applying anti-gaming rules.
 	candidates := make([]snCandidate, 0, len(supernodes))
 	for _, sn := range supernodes {
-		rawBytes, reportHeight, found := k.getLatestCascadeBytesFromAudit(ctx, sn.SupernodeAccount)
+		reportedBytes, reportHeight, found := k.getLatestCascadeBytesFromAudit(ctx, sn.SupernodeAccount)
 		if !found {
 			// SN has no usable audit report yet; skip.
 			continue
@@ -120,6 +121,7 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 			// Report is stale by block-height freshness rule; skip.
 			continue
 		}
+		rawBytes := bytesToDec(reportedBytes)
 
 		// Load existing per-SN distribution state.
 		distState, exists := k.GetSNDistState(ctx, sn.ValidatorAddress)
@@ -127,8 +129,8 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 			distState = SNDistState{
 				EligibilityStartHeight: currentHeight,
 				PeriodsActive:          0,
-				SmoothedBytes:          0,
-				PrevRawBytes:           0,
+				SmoothedBytes:          sdkmath.LegacyZeroDec(),
+				PrevRawBytes:           sdkmath.LegacyZeroDec(),
 			}
 		}
 
@@ -139,7 +141,7 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 		smoothedBytes := applyEMA(distState.SmoothedBytes, cappedBytes, dist.MeasurementSmoothingPeriods)
 
 		// Check minimum threshold (AT36).
-		if floatToUint64(smoothedBytes) < dist.MinCascadeBytesForPayment {
+		if !meetsMinCascadeBytes(smoothedBytes, dist.MinCascadeBytesForPayment) {
 			// Update state but don't include in distribution.
 			distState.SmoothedBytes = smoothedBytes
 			distState.PrevRawBytes = rawBytes
@@ -152,7 +154,7 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 		rampWeight := computeRampUpWeight(distState.PeriodsActive, dist.NewSnRampUpPeriods)
 
 		// Effective weight = smoothed bytes * ramp-up weight.
-		effectiveWeight := smoothedBytes * rampWeight
+		effectiveWeight := smoothedBytes.Mul(rampWeight)
 
 		candidates = append(candidates, snCandidate{
 			validatorAddr:    sn.ValidatorAddress,
@@ -162,6 +164,7 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 			smoothedBytes:    smoothedBytes,
 			rampWeight:       rampWeight,
 			effectiveWeight:  effectiveWeight,
+			commissionRate:   payoutCommissionRate(sn),
 			distState:        distState,
 		})
 	}
@@ -181,12 +184,12 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 	}
 
 	// 4. Compute total effective weight.
-	var totalWeight float64
+	totalWeight := sdkmath.LegacyZeroDec()
 	for _, c := range candidates {
-		totalWeight += c.effectiveWeight
+		totalWeight = totalWeight.Add(c.effectiveWeight)
 	}
 
-	if totalWeight <= 0 {
+	if !totalWeight.IsPositive() {
 		k.Logger().Info("everlight distribution skipped: total weight is zero")
 		k.SetLastDistributionHeight(ctx, currentHeight)
 		return nil
@@ -194,10 +197,6 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 
 	// 5. Distribute pool balance proportionally.
 	poolBalanceDec := sdkmath.LegacyNewDecFromInt(poolUlume)
-	totalWeightDec, err := legacyDecFromFloat64(totalWeight)
-	if err != nil {
-		return fmt.Errorf("invalid total distribution weight: %w", err)
-	}
 	totalDistributed := sdkmath.ZeroInt()
 	payouts := make([]struct {
 		addr   sdk.AccAddress
@@ -206,14 +205,8 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 	}, 0, len(candidates))
 
 	for _, c := range candidates {
-		weightDec, err := legacyDecFromFloat64(c.effectiveWeight)
-		if err != nil {
-			k.Logger().Error("invalid candidate distribution weight", "validator", c.validatorAddr, "err", err)
-			continue
-		}
-		shareDec := weightDec.Quo(totalWeightDec)
-		// Calculate integer amount; truncate fractions (dust stays in pool).
-		payoutAmount := poolBalanceDec.MulTruncate(shareDec).TruncateInt()
+		// payout = pool * weight / totalWeight; truncate fractions (dust stays in pool).
+		payoutAmount := poolBalanceDec.Mul(c.effectiveWeight).QuoTruncate(totalWeight).TruncateInt()
 
 		if payoutAmount.IsPositive() {
 			recipientAddr, err := sdk.AccAddressFromBech32(c.supernodeAccount)
@@ -230,11 +223,20 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 		}
 	}
 
-	// 6. Execute payouts via bank module.
+	// 6. Execute payouts via bank module, sharing the part above the supernode's
+	// commission with the delegators of its validator.
 	for _, p := range payouts {
 		coins := sdk.NewCoins(sdk.NewCoin(everlightDenom, p.amount))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, sntypes.ModuleName, p.addr, coins); err != nil {
-			return fmt.Errorf("failed to send distribution to %s: %w", p.addr, err)
+		commissionRate, commission, delegatorRewards := k.splitPayout(ctx, p.cand, p.amount)
+		if commission.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, sntypes.ModuleName, p.addr, sdk.NewCoins(sdk.NewCoin(everlightDenom, commission))); err != nil {
+				return fmt.Errorf("failed to send distribution to %s: %w", p.addr, err)
+			}
+		}
+		if delegatorRewards.IsPositive() {
+			if err := k.creditDelegatorRewards(ctx, p.cand.validatorAddr, sdk.NewCoins(sdk.NewCoin(everlightDenom, delegatorRewards))); err != nil {
+				return fmt.Errorf("failed to credit delegator rewards of %s: %w", p.cand.validatorAddr, err)
+			}
 		}
 
 		k.AppendPayoutHistoryEntry(ctx, &sntypes.PayoutHistoryEntry{
@@ -246,6 +248,9 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 			SmoothedBytes:    p.cand.smoothedBytes,
 			EffectiveWeight:  p.cand.effectiveWeight,
 			RampWeight:       p.cand.rampWeight,
+			CommissionRate:   commissionRate,
+			Commission:       sdk.NewCoins(sdk.NewCoin(everlightDenom, commission)),
+			DelegatorRewards: sdk.NewCoins(sdk.NewCoin(everlightDenom, delegatorRewards)),
 		})
 
 		// Emit per-SN distribution event.
@@ -255,8 +260,10 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 				sdk.NewAttribute(sntypes.AttributeKeyRewardRecipient, p.addr.String()),
 				sdk.NewAttribute(sntypes.AttributeKeyRewardValidator, p.cand.validatorAddr),
 				sdk.NewAttribute(sntypes.AttributeKeyRewardAmount, p.amount.String()),
-				sdk.NewAttribute(sntypes.AttributeKeyRewardSmoothedBytes, strconv.FormatFloat(p.cand.smoothedBytes, 'f', 0, 64)),
-				sdk.NewAttribute(sntypes.AttributeKeyRewardRawBytes, strconv.FormatFloat(p.cand.rawBytes, 'f', 0, 64)),
+				sdk.NewAttribute(sntypes.AttributeKeyRewardCommission, commission.String()),
+				sdk.NewAttribute(sntypes.AttributeKeyRewardDelegators, delegatorRewards.String()),
+				sdk.NewAttribute(sntypes.AttributeKeyRewardSmoothedBytes, p.cand.smoothedBytes.TruncateInt().String()),
+				sdk.NewAttribute(sntypes.AttributeKeyRewardRawBytes, p.cand.rawBytes.TruncateInt().String()),
 			),
 		)
 	}
@@ -294,10 +301,3 @@ func (k Keeper) distributePool(ctx sdk.Context) error {
 
 	return nil
 }
-
-func legacyDecFromFloat64(value float64) (sdkmath.LegacyDec, error) {
-	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
-		return sdkmath.LegacyZeroDec(), fmt.Errorf("invalid float value %v", value)
-	}
-	return sdkmath.LegacyNewDecFromStr(strconv.FormatFloat(value, 'f', -1, 64))
-}
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	lcfg "github.com/LumeraProtocol/lumera/config"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// goldenScenario drives several Everlight payment periods with fixed inputs.
+// Reported bytes are indexed [supernode][period]; pool balances are indexed by period.
+type goldenScenario struct {
+	name          string
+	smoothing     uint64
+	rampUp        uint64
+	growthCapBps  uint64
+	minBytes      uint64
+	reportedBytes [][]float64
+	poolBalances  []int64
+}
+
+// goldenPeriod is the observable outcome of a single distribution period.
+type goldenPeriod struct {
+	Payouts []string `json:"payouts"` // "<sn index>:<amount>" in payout order
+}
+
+// goldenResult captures payouts and the final per-SN state of a scenario run.
+type goldenResult struct {
+	Periods    []goldenPeriod `json:"periods"`
+	DistStates []string       `json:"dist_states"` // raw stored JSON per SN, "" if absent
+	History    []string       `json:"history"`     // payout history decimals per SN for the last period
+}
+
+func runGoldenScenario(t *testing.T, sc goldenScenario) goldenResult {
+	t.Helper()
+	k, ctx, bankKeeper, snKeeper, auditKeeper := setupTestKeeper(t)
+
+	params := sntypes.DefaultParams()
+	params.RewardDistribution.PaymentPeriodBlocks = 10
+	params.RewardDistribution.MinCascadeBytesForPayment = sc.minBytes
+	params.RewardDistribution.NewSnRampUpPeriods = sc.rampUp
+	params.RewardDistribution.MeasurementSmoothingPeriods = sc.smoothing
+	params.RewardDistribution.UsageGrowthCapBpsPerPeriod = sc.growthCapBps
+	params.MetricsFreshnessMaxBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	accIndex := make(map[string]int)
+	valAddrs := make([]string, len(sc.reportedBytes))
+	for i := range sc.reportedBytes {
+		val := makeValAddr(i + 1)
+		acc := makeAccAddr(i + 1)
+		addSupernode(snKeeper, auditKeeper, val, acc, sntypes.SuperNodeStateActive, 0)
+		accIndex[acc.String()] = i
+		valAddrs[i] = sdk.MustBech32ifyAddressBytes(lcfg.Bech32ValidatorAddressPrefix, val)
+	}
+
+	var result goldenResult
+	for period, pool := range sc.poolBalances {
+		height := int64(100 + period*10)
+		ctx = ctx.WithBlockHeight(height)
+		for i, series := range sc.reportedBytes {
+			require.NoError(t, k.SetMetricsState(ctx, sntypes.SupernodeMetricsState{
+				ValidatorAddress: valAddrs[i],
+				Metrics:          &sntypes.SupernodeMetrics{CascadeKademliaDbBytes: series[period]},
+				Height:           height,
+			}))
+		}
+		fundPool(bankKeeper, pool)
+		bankKeeper.sent = nil
+
+		require.NoError(t, k.distributePool(ctx))
+
+		var gp goldenPeriod
+		for _, s := range bankKeeper.sent {
+			gp.Payouts = append(gp.Payouts, fmt.Sprintf("%d:%s", accIndex[s.to], s.amount.AmountOf("ulume")))
+		}
+		result.Periods = append(result.Periods, gp)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, val := range valAddrs {
+		result.DistStates = append(result.DistStates, string(store.Get(sntypes.SNDistStateKey(val))))
+
+		bz := store.Get([]byte(fmt.Sprintf("%s%020d", sntypes.PayoutHistoryPrefixForValidator(val), ctx.BlockHeight())))
+		if bz == nil {
+			result.History = append(result.History, "")
+			continue
+		}
+		var entry sntypes.PayoutHistoryEntry
+		require.NoError(t, k.cdc.Unmarshal(bz, &entry))
+		result.History = append(result.History, fmt.Sprintf("raw=%s smoothed=%s ramp=%s weight=%s",
+			entry.RawBytes, entry.SmoothedBytes, entry.RampWeight, entry.EffectiveWeight))
+	}
+	return result
+}
+
+var goldenScenarios = []goldenScenario{
+	{
+		name:         "ema_ramp_and_growth_cap",
+		smoothing:    3,
+		rampUp:       3,
+		growthCapBps: 2500,
+		minBytes:     1000,
+		reportedBytes: [][]float64{
+			{1_000_000, 1_500_000, 1_200_000, 3_000_000},
+			{333_333.7, 333_334, 999_999, 1_000_001},
+			{500, 800, 2_000, 5_000},
+			{7_777_777_777, 7_777_777_777, 8_000_000_000, 8_000_000_000},
+		},
+		poolBalances: []int64{1_000_003, 999_999_937, 7, 123_456_789},
+	},
+	{
+		name:         "odd_smoothing_uneven_pool",
+		smoothing:    7,
+		rampUp:       0,
+		growthCapBps: 333,
+		minBytes:     1,
+		reportedBytes: [][]float64{
+			{1, 2, 3, 5, 8},
+			{13, 21, 34, 55, 89},
+			{144, 233, 377, 610, 987},
+		},
+		poolBalances: []int64{101, 1_000_000_007, 999_983, 3, 2_147_483_647},
+	},
+}
+
+// goldenPayouts are the expected per-period payouts for goldenScenarios, in
+// the same order. Any change here is a consensus-breaking change.
+var goldenPayouts = map[string][][]string{
+	"ema_ramp_and_growth_cap": {
+		{"0:128", "1:42", "3:999831"},
+		{"0:144615", "1:42849", "3:999812472"},
+		{"3:6"},
+		{"0:20682", "1:10681", "2:25", "3:123425400"},
+	},
+	"odd_smoothing_uneven_pool": {
+		{"1:8", "2:92"},
+		{"0:6329113", "1:82278481", "2:911392411"},
+		{"0:6857", "1:82189", "2:910936"},
+		{"2:2"},
+		{"0:15542547", "1:176366546", "2:1955574553"},
+	},
+}
+
+// goldenSmoothedBytes are the expected final smoothed byte counts per SN.
+var goldenSmoothedBytes = map[string][]string{
+	"ema_ramp_and_growth_cap": {
+		"1331250.000000000000000000",
+		"687500.750000000000000000",
+		"1640.625000000000000000",
+		"7944444444.250000000000000000",
+	},
+	"odd_smoothing_uneven_pool": {
+		"2.588858984375000000",
+		"29.376658984375000000",
+		"325.732107812500000000",
+	},
+}
+
+func TestEverlightGoldenVectors(t *testing.T) {
+	for _, sc := range goldenScenarios {
+		t.Run(sc.name, func(t *testing.T) {
+			result := runGoldenScenario(t, sc)
+
+			expected := goldenPayouts[sc.name]
+			require.Len(t, result.Periods, len(expected))
+			for i, period := range result.Periods {
+				require.Equal(t, expected[i], period.Payouts, "period %d", i)
+			}
+
+			for i, raw := range result.DistStates {
+				var state sntypes.SNDistState
+				require.NoError(t, json.Unmarshal([]byte(raw), &state))
+				require.Equal(t, goldenSmoothedBytes[sc.name][i], state.SmoothedBytes.String(), "sn %d", i)
+			}
+		})
+	}
+}
+
+func TestEverlightDistributionDeterministic(t *testing.T) {
+	for _, sc := range goldenScenarios {
+		t.Run(sc.name, func(t *testing.T) {
+			first := runGoldenScenario(t, sc)
+			second := runGoldenScenario(t, sc)
+
+			firstBz, err := json.Marshal(first)
+			require.NoError(t, err)
+			secondBz, err := json.Marshal(second)
+			require.NoError(t, err)
+			require.Equal(t, string(firstBz), string(secondBz))
+		})
+	}
+}
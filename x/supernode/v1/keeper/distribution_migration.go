@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// legacySNDistState is the float64 encoding of SNDistState written before the
+// fixed-point distribution pipeline.
+type legacySNDistState struct {
+	SmoothedBytes          float64 `json:"smoothed_bytes"`
+	PrevRawBytes           float64 `json:"prev_raw_bytes"`
+	EligibilityStartHeight int64   `json:"eligibility_start_height"`
+	PeriodsActive          uint64  `json:"periods_active"`
+}
+
+// MigrateDistributionStateToFixedPoint rewrites float-encoded Everlight state
+// (per-SN distribution state and payout history rows) as LegacyDec values.
+// Entries that are already decimal-encoded are left untouched, so the
+// migration is idempotent.
+func (k Keeper) MigrateDistributionStateToFixedPoint(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// 1. Per-SN distribution state (JSON encoded).
+	type distRow struct {
+		key   []byte
+		state SNDistState
+	}
+	var distRows []distRow
+	distIter := storetypes.KVStorePrefixIterator(store, types.SNDistStatePrefix)
+	for ; distIter.Valid(); distIter.Next() {
+		var current SNDistState
+		if err := json.Unmarshal(distIter.Value(), &current); err == nil {
+			continue // already fixed-point
+		}
+		var legacy legacySNDistState
+		if err := json.Unmarshal(distIter.Value(), &legacy); err != nil {
+			_ = distIter.Close()
+			return fmt.Errorf("failed to decode legacy distribution state %q: %w", distIter.Key(), err)
+		}
+		distRows = append(distRows, distRow{
+			key: distIter.Key(),
+			state: SNDistState{
+				SmoothedBytes:          k.migrateFloat(legacy.SmoothedBytes, "smoothed_bytes"),
+				PrevRawBytes:           k.migrateFloat(legacy.PrevRawBytes, "prev_raw_bytes"),
+				EligibilityStartHeight: legacy.EligibilityStartHeight,
+				PeriodsActive:          legacy.PeriodsActive,
+			},
+		})
+	}
+	if err := distIter.Close(); err != nil {
+		return err
+	}
+	for _, row := range distRows {
+		bz, err := json.Marshal(row.state)
+		if err != nil {
+			return fmt.Errorf("failed to encode distribution state %q: %w", row.key, err)
+		}
+		store.Set(row.key, bz)
+	}
+
+	// 2. Payout history rows (proto encoded).
+	type historyRow struct {
+		key   []byte
+		entry types.PayoutHistoryEntry
+	}
+	var historyRows []historyRow
+	histIter := storetypes.KVStorePrefixIterator(store, types.PayoutHistoryPrefix)
+	for ; histIter.Valid(); histIter.Next() {
+		var entry types.PayoutHistoryEntry
+		if err := k.cdc.Unmarshal(histIter.Value(), &entry); err != nil {
+			_ = histIter.Close()
+			return fmt.Errorf("failed to decode payout history entry %q: %w", histIter.Key(), err)
+		}
+		if !entry.RawBytes.IsNil() {
+			continue // already fixed-point
+		}
+		entry.RawBytes = k.migrateFloat(entry.LegacyRawBytes, "raw_bytes")
+		entry.SmoothedBytes = k.migrateFloat(entry.LegacySmoothedBytes, "smoothed_bytes")
+		entry.EffectiveWeight = k.migrateFloat(entry.LegacyEffectiveWeight, "effective_weight")
+		entry.RampWeight = k.migrateFloat(entry.LegacyRampWeight, "ramp_weight")
+		entry.LegacyRawBytes = 0
+		entry.LegacySmoothedBytes = 0
+		entry.LegacyEffectiveWeight = 0
+		entry.LegacyRampWeight = 0
+		historyRows = append(historyRows, historyRow{key: histIter.Key(), entry: entry})
+	}
+	if err := histIter.Close(); err != nil {
+		return err
+	}
+	for _, row := range historyRows {
+		store.Set(row.key, k.cdc.MustMarshal(&row.entry))
+	}
+
+	k.Logger().Info("migrated everlight distribution state to fixed-point",
+		"dist_states", len(distRows),
+		"payout_history_entries", len(historyRows),
+	)
+	return nil
+}
+
+// migrateFloat converts a stored float64 to LegacyDec, rounded to
+// LegacyPrecision decimal places. Invalid values (NaN, Inf, negative) migrate to zero.
+func (k Keeper) migrateFloat(value float64, field string) sdkmath.LegacyDec {
+	dec, err := legacyDecFromFloat64(value)
+	if err != nil {
+		k.Logger().Error("invalid legacy everlight value; migrating as zero", "field", field, "value", value, "err", err)
+		return sdkmath.LegacyZeroDec()
+	}
+	return dec
+}
+
+func legacyDecFromFloat64(value float64) (sdkmath.LegacyDec, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return sdkmath.LegacyZeroDec(), fmt.Errorf("invalid float value %v", value)
+	}
+	return sdkmath.LegacyNewDecFromStr(strconv.FormatFloat(value, 'f', sdkmath.LegacyPrecision, 64))
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// legacyPayoutHistoryBytes encodes a payout history row the way the float-based
+// pipeline wrote it (fields 5-8 as doubles, no decimal fields).
+func legacyPayoutHistoryBytes(height int64, valAddr string, raw, smoothed, weight, ramp float64) []byte {
+	var bz []byte
+	bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, uint64(height))
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendString(bz, valAddr)
+	for i, v := range []float64{raw, smoothed, weight, ramp} {
+		bz = protowire.AppendTag(bz, protowire.Number(5+i), protowire.Fixed64Type)
+		bz = protowire.AppendFixed64(bz, math.Float64bits(v))
+	}
+	return bz
+}
+
+func TestMigrateDistributionStateToFixedPoint(t *testing.T) {
+	k, ctx, _, _, _ := setupTestKeeper(t)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	legacyVal := "lumeravaloper1legacy"
+	currentVal := "lumeravaloper1current"
+	store.Set(sntypes.SNDistStateKey(legacyVal),
+		[]byte(`{"smoothed_bytes":1234567.25,"prev_raw_bytes":2000000,"eligibility_start_height":42,"periods_active":3}`))
+	current := SNDistState{
+		SmoothedBytes:          sdkmath.LegacyMustNewDecFromStr("10.5"),
+		PrevRawBytes:           sdkmath.LegacyNewDec(11),
+		EligibilityStartHeight: 7,
+		PeriodsActive:          1,
+	}
+	k.SetSNDistState(ctx, currentVal, current)
+
+	historyKey := []byte(fmt.Sprintf("%s%020d", sntypes.PayoutHistoryPrefixForValidator(legacyVal), 50))
+	store.Set(historyKey, legacyPayoutHistoryBytes(50, legacyVal, 2000000, 1234567.25, 411522.416666666666, 1.0/3.0))
+
+	require.NoError(t, k.MigrateDistributionStateToFixedPoint(ctx))
+
+	migrated, found := k.GetSNDistState(ctx, legacyVal)
+	require.True(t, found)
+	require.Equal(t, "1234567.250000000000000000", migrated.SmoothedBytes.String())
+	require.Equal(t, "2000000.000000000000000000", migrated.PrevRawBytes.String())
+	require.Equal(t, int64(42), migrated.EligibilityStartHeight)
+	require.Equal(t, uint64(3), migrated.PeriodsActive)
+
+	untouched, found := k.GetSNDistState(ctx, currentVal)
+	require.True(t, found)
+	require.True(t, current.SmoothedBytes.Equal(untouched.SmoothedBytes))
+	require.True(t, current.PrevRawBytes.Equal(untouched.PrevRawBytes))
+
+	var entry sntypes.PayoutHistoryEntry
+	require.NoError(t, k.cdc.Unmarshal(store.Get(historyKey), &entry))
+	require.Equal(t, "2000000.000000000000000000", entry.RawBytes.String())
+	require.Equal(t, "1234567.250000000000000000", entry.SmoothedBytes.String())
+	require.Equal(t, "0.333333333333333315", entry.RampWeight.String())
+	require.Zero(t, entry.LegacyRawBytes)
+	require.Zero(t, entry.LegacyRampWeight)
+
+	// Running the migration again is a no-op.
+	migratedBz := store.Get(historyKey)
+	require.NoError(t, k.MigrateDistributionStateToFixedPoint(ctx))
+	require.Equal(t, migratedBz, store.Get(historyKey))
+	again, _ := k.GetSNDistState(ctx, legacyVal)
+	require.True(t, migrated.SmoothedBytes.Equal(again.SmoothedBytes))
+}
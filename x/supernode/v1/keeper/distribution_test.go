@@ -71,10 +71,23 @@ func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderM
 }
 
 func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[senderAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", m.balances[senderAddr.String()], amt)
+	}
+	moduleAddr := authtypes.NewModuleAddress(recipientModule)
+	m.sent = append(m.sent, sendRecord{from: senderAddr.String(), to: moduleAddr.String(), amount: amt})
+	m.balances[senderAddr.String()] = balance
+	m.balances[moduleAddr.String()] = m.balances[moduleAddr.String()].Add(amt...)
 	return nil
 }
 
 func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	senderAddr := authtypes.NewModuleAddress(senderModule)
+	recipientAddr := authtypes.NewModuleAddress(recipientModule)
+	m.sent = append(m.sent, sendRecord{from: senderAddr.String(), to: recipientAddr.String(), amount: amt})
+	m.balances[senderAddr.String()] = m.balances[senderAddr.String()].Sub(amt...)
+	m.balances[recipientAddr.String()] = m.balances[recipientAddr.String()].Add(amt...)
 	return nil
 }
 
@@ -172,6 +185,7 @@ func setupTestKeeper(t *testing.T) (Keeper, sdk.Context, *mockBankKeeper, *mockS
 		accountKeeper,
 		nil,
 		auditKeeper,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{Height: 1}, false, log.NewNopLogger())
@@ -376,8 +390,8 @@ func TestNewSNRampUp(t *testing.T) {
 
 	// Set SN1 as established (4 periods active).
 	k.SetSNDistState(ctx, val1.String(), SNDistState{
-		SmoothedBytes:          10000,
-		PrevRawBytes:           10000,
+		SmoothedBytes:          sdkmath.LegacyNewDec(10000),
+		PrevRawBytes:           sdkmath.LegacyNewDec(10000),
 		PeriodsActive:          4,
 		EligibilityStartHeight: 1,
 	})
@@ -429,8 +443,8 @@ func TestUsageGrowthCap(t *testing.T) {
 
 	// Previous period had 10000 bytes.
 	k.SetSNDistState(ctx, val1.String(), SNDistState{
-		SmoothedBytes:          10000,
-		PrevRawBytes:           10000,
+		SmoothedBytes:          sdkmath.LegacyNewDec(10000),
+		PrevRawBytes:           sdkmath.LegacyNewDec(10000),
 		PeriodsActive:          5,
 		EligibilityStartHeight: 1,
 	})
@@ -450,9 +464,9 @@ func TestUsageGrowthCap(t *testing.T) {
 	distState, found := k.GetSNDistState(ctx, val1.String())
 	require.True(t, found)
 	// Smoothed bytes should be 11000 (capped), not 20000.
-	require.InDelta(t, 11000.0, distState.SmoothedBytes, 1.0)
+	require.Equal(t, sdkmath.LegacyNewDec(11000), distState.SmoothedBytes)
 	// PrevRawBytes should be the actual raw value (20000), not the capped one.
-	require.InDelta(t, 20000.0, distState.PrevRawBytes, 1.0)
+	require.Equal(t, sdkmath.LegacyNewDec(20000), distState.PrevRawBytes)
 }
 
 // AT44: Pool with zero balance produces no distribution and no panic.
@@ -584,47 +598,56 @@ func TestEMASmoothing(t *testing.T) {
 	// alpha = 2/(4+1) = 0.4
 	// prevSmoothed = 10000, newValue = 20000
 	// EMA = 0.4 * 20000 + 0.6 * 10000 = 8000 + 6000 = 14000
-	result := applyEMA(10000, 20000, 4)
-	require.InDelta(t, 14000.0, result, 0.01)
+	result := applyEMA(sdkmath.LegacyNewDec(10000), sdkmath.LegacyNewDec(20000), 4)
+	require.Equal(t, sdkmath.LegacyNewDec(14000), result)
 
 	// First observation (prev = 0): should return new value.
-	result = applyEMA(0, 5000, 4)
-	require.InDelta(t, 5000.0, result, 0.01)
+	result = applyEMA(sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDec(5000), 4)
+	require.Equal(t, sdkmath.LegacyNewDec(5000), result)
 
 	// Zero smoothing periods: return new value directly.
-	result = applyEMA(10000, 20000, 0)
-	require.InDelta(t, 20000.0, result, 0.01)
+	result = applyEMA(sdkmath.LegacyNewDec(10000), sdkmath.LegacyNewDec(20000), 0)
+	require.Equal(t, sdkmath.LegacyNewDec(20000), result)
+
+	// alpha = 2/3: (2*10 + 1*1) / 3 = 7 exactly.
+	result = applyEMA(sdkmath.LegacyNewDec(1), sdkmath.LegacyNewDec(10), 2)
+	require.Equal(t, sdkmath.LegacyNewDec(7), result)
+	// alpha = 2/6: (2*2 + 4*1) / 6 = 1.333..., rounded once at 18 decimals.
+	result = applyEMA(sdkmath.LegacyMustNewDecFromStr("1"), sdkmath.LegacyMustNewDecFromStr("2"), 5)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("1.333333333333333333"), result)
 }
 
 // Test growth cap function.
 func TestApplyGrowthCap(t *testing.T) {
 	// 10% cap: prev = 10000, raw = 12000 -> capped to 11000.
-	result := applyGrowthCap(12000, 10000, 1000)
-	require.InDelta(t, 11000.0, result, 0.01)
+	result := applyGrowthCap(sdkmath.LegacyNewDec(12000), sdkmath.LegacyNewDec(10000), 1000)
+	require.Equal(t, sdkmath.LegacyNewDec(11000), result)
 
 	// 10% cap: prev = 10000, raw = 10500 -> not capped (within limit).
-	result = applyGrowthCap(10500, 10000, 1000)
-	require.InDelta(t, 10500.0, result, 0.01)
+	result = applyGrowthCap(sdkmath.LegacyNewDec(10500), sdkmath.LegacyNewDec(10000), 1000)
+	require.Equal(t, sdkmath.LegacyNewDec(10500), result)
 
 	// First observation (prev = 0): no cap.
-	result = applyGrowthCap(50000, 0, 1000)
-	require.InDelta(t, 50000.0, result, 0.01)
+	result = applyGrowthCap(sdkmath.LegacyNewDec(50000), sdkmath.LegacyZeroDec(), 1000)
+	require.Equal(t, sdkmath.LegacyNewDec(50000), result)
 }
 
 // Test ramp-up weight function.
 func TestComputeRampUpWeight(t *testing.T) {
 	// 0 out of 4 periods: weight = 1/4 = 0.25.
-	require.InDelta(t, 0.25, computeRampUpWeight(0, 4), 0.001)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.25"), computeRampUpWeight(0, 4))
 	// 1 out of 4 periods: weight = 2/4 = 0.5.
-	require.InDelta(t, 0.50, computeRampUpWeight(1, 4), 0.001)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5"), computeRampUpWeight(1, 4))
 	// 3 out of 4 periods: weight = 4/4 = 1.0.
-	require.InDelta(t, 1.0, computeRampUpWeight(3, 4), 0.001)
+	require.Equal(t, sdkmath.LegacyOneDec(), computeRampUpWeight(3, 4))
 	// 4 out of 4 periods (past ramp-up): weight = 1.0.
-	require.InDelta(t, 1.0, computeRampUpWeight(4, 4), 0.001)
+	require.Equal(t, sdkmath.LegacyOneDec(), computeRampUpWeight(4, 4))
 	// 10 out of 4 periods: weight = 1.0.
-	require.InDelta(t, 1.0, computeRampUpWeight(10, 4), 0.001)
+	require.Equal(t, sdkmath.LegacyOneDec(), computeRampUpWeight(10, 4))
 	// Zero ramp-up periods: always 1.0.
-	require.InDelta(t, 1.0, computeRampUpWeight(0, 0), 0.001)
+	require.Equal(t, sdkmath.LegacyOneDec(), computeRampUpWeight(0, 0))
+	// 0 out of 3 periods: weight = 1/3, rounded at 18 decimals.
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.333333333333333333"), computeRampUpWeight(0, 3))
 }
 
 // Test SNDistState persistence.
@@ -639,8 +662,8 @@ func TestSNDistStatePersistence(t *testing.T) {
 
 	// Set state.
 	state := SNDistState{
-		SmoothedBytes:          12345.678,
-		PrevRawBytes:           10000.0,
+		SmoothedBytes:          sdkmath.LegacyMustNewDecFromStr("12345.678"),
+		PrevRawBytes:           sdkmath.LegacyNewDec(10000),
 		EligibilityStartHeight: 42,
 		PeriodsActive:          3,
 	}
@@ -649,8 +672,8 @@ func TestSNDistStatePersistence(t *testing.T) {
 	// Read back.
 	got, found := k.GetSNDistState(ctx, valAddr)
 	require.True(t, found)
-	require.InDelta(t, state.SmoothedBytes, got.SmoothedBytes, 0.001)
-	require.InDelta(t, state.PrevRawBytes, got.PrevRawBytes, 0.001)
+	require.Equal(t, state.SmoothedBytes, got.SmoothedBytes)
+	require.Equal(t, state.PrevRawBytes, got.PrevRawBytes)
 	require.Equal(t, state.EligibilityStartHeight, got.EligibilityStartHeight)
 	require.Equal(t, state.PeriodsActive, got.PeriodsActive)
 }
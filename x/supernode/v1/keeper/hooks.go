@@ -27,9 +27,9 @@ func (k Keeper) Hooks() Hooks {
 func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	//early return if super node is not registered
-	_, found := h.k.QuerySuperNode(sdkCtx, valAddr)
-	if !found {
+	//early return if super node is not registered or not validator-backed
+	sn, found := h.k.QuerySuperNode(sdkCtx, valAddr)
+	if !found || sn.Independent {
 		return nil
 	}
 
@@ -57,9 +57,9 @@ func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddres
 func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	//early return if super node is not registered
-	_, found := h.k.QuerySuperNode(sdkCtx, valAddr)
-	if !found {
+	//early return if super node is not registered or not validator-backed
+	sn, found := h.k.QuerySuperNode(sdkCtx, valAddr)
+	if !found || sn.Independent {
 		return nil
 	}
 
@@ -76,9 +76,9 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.Co
 func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	//early return if super node is not registered
-	_, found := h.k.QuerySuperNode(sdkCtx, valAddr)
-	if !found {
+	//early return if super node is not registered or not validator-backed
+	sn, found := h.k.QuerySuperNode(sdkCtx, valAddr)
+	if !found || sn.Independent {
 		return nil
 	}
 
@@ -105,9 +105,9 @@ func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddre
 func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	//early return if super node is not registered
-	_, found := h.k.QuerySuperNode(sdkCtx, valAddr)
-	if !found {
+	//early return if super node is not registered or not validator-backed
+	sn, found := h.k.QuerySuperNode(sdkCtx, valAddr)
+	if !found || sn.Independent {
 		return nil
 	}
 
@@ -120,12 +120,33 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddre
 	return nil
 }
 
-// Hooks we do NOT use: no-ops
-
+// AfterValidatorCreated: called AFTER a validator is created. Independent
+// supernodes are keyed by their operator's account bytes, so a validator
+// created by that operator would share the key. It is allowed only once the
+// independent supernode is disabled and holds no stake, in which case the
+// record becomes validator-backed.
 func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
-	return nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sn, found := h.k.QuerySuperNode(sdkCtx, valAddr)
+	if !found || !sn.Independent {
+		return nil
+	}
+
+	disabled := len(sn.States) > 0 && sn.States[len(sn.States)-1].State == types.SuperNodeStateDisabled
+	bonded := sn.SelfStake != nil && !sn.SelfStake.IsNil() && sn.SelfStake.IsPositive()
+	if !disabled || bonded || len(h.k.GetSelfStakeUnbondings(sdkCtx, sdk.AccAddress(valAddr))) > 0 {
+		return errorsmod.Wrapf(types.ErrInvalidSuperNodeState,
+			"operator runs independent supernode %s; deregister it and unbond its self-stake first", sn.ValidatorAddress)
+	}
+
+	sn.Independent = false
+	sn.SelfStake = nil
+	return h.k.SetSuperNode(sdkCtx, sn)
 }
 
+// Hooks we do NOT use: no-ops
+
 func (h Hooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
 	return nil
 }
@@ -26,6 +26,7 @@ type (
 		accountKeeper  types.AccountKeeper
 		slashingKeeper types.SlashingKeeper
 		auditKeeper    types.AuditKeeper
+		distrKeeper    types.DistributionKeeper
 	}
 )
 
@@ -40,6 +41,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	slashingKeeper types.SlashingKeeper,
 	auditKeeper types.AuditKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -56,6 +58,7 @@ func NewKeeper(
 		accountKeeper:  accountKeeper,
 		slashingKeeper: slashingKeeper,
 		auditKeeper:    auditKeeper,
+		distrKeeper:    distrKeeper,
 	}
 }
 
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// AppealSlash lets the operator of a slashed supernode contest a pending
+// slash. The slash is held until governance resolves the appeal.
+func (k msgServer) AppealSlash(goCtx context.Context, msg *types.MsgAppealSlash) (*types.MsgAppealSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetSlashRecord(ctx, msg.SlashId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSlashNotFound, "slash %d", msg.SlashId)
+	}
+
+	valOperAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	if err := VerifyValidatorOperator(valOperAddr, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.AppealSlashRecord(ctx, msg.SlashId, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppealSlashResponse{}, nil
+}
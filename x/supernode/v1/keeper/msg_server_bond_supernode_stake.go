@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// BondSupernodeStake adds to the self-stake of the creator's independent supernode.
+func (k msgServer) BondSupernodeStake(goCtx context.Context, msg *types.MsgBondSupernodeStake) (*types.MsgBondSupernodeStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if err := k.BondSelfStake(ctx, operator, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBondSupernodeStakeResponse{}, nil
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// RegisterIndependentSupernode registers a supernode for an operator that does
+// not run a validator. The supernode is keyed by the validator-format address
+// of the creator account, so the existing Start/Stop/Update/Deregister
+// messages apply to it unchanged. Eligibility comes from the self-stake bonded
+// in the supernode module rather than from staking delegations.
+func (k msgServer) RegisterIndependentSupernode(goCtx context.Context, msg *types.MsgRegisterIndependentSupernode) (*types.MsgRegisterIndependentSupernodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	valOperAddr := types.IndependentValAddress(operator)
+
+	// An operator that runs a validator must register through MsgRegisterSupernode.
+	if validator, err := k.GetStakingKeeper().Validator(ctx, valOperAddr); err == nil && validator != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"account %s operates validator %s; use MsgRegisterSupernode", msg.Creator, valOperAddr)
+	}
+
+	minStake := k.GetParams(ctx).MinimumStakeForSn
+	if msg.SelfStake.Denom != minStake.Denom {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "self-stake must be denominated in %s, got %s", minStake.Denom, msg.SelfStake.Denom)
+	}
+
+	existingSupernode, found := k.QuerySuperNode(ctx, valOperAddr)
+	if found {
+		if !existingSupernode.Independent {
+			return nil, errorsmod.Wrapf(types.ErrNotIndependentSupernode, "supernode %s is validator-backed", existingSupernode.ValidatorAddress)
+		}
+		if len(existingSupernode.States) == 0 ||
+			existingSupernode.States[len(existingSupernode.States)-1].State != types.SuperNodeStateDisabled {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "supernode already exists for operator %s", msg.Creator)
+		}
+
+		// Re-registration tops up the stake still bonded and flips Disabled to Active.
+		total := msg.SelfStake
+		if existingSupernode.SelfStake != nil && !existingSupernode.SelfStake.IsNil() {
+			total = existingSupernode.SelfStake.Add(msg.SelfStake)
+		}
+		if total.Amount.LT(minStake.Amount) {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientSelfStake,
+				"self-stake %s is below the minimum %s", total, minStake)
+		}
+		if msg.SelfStake.IsPositive() {
+			if err := k.BondSelfStake(ctx, operator, msg.SelfStake); err != nil {
+				return nil, err
+			}
+			existingSupernode, _ = k.QuerySuperNode(ctx, valOperAddr)
+		}
+
+		prevState := existingSupernode.States[len(existingSupernode.States)-1].State
+		existingSupernode.States = append(existingSupernode.States, &types.SuperNodeStateRecord{
+			State:  types.SuperNodeStateActive,
+			Height: ctx.BlockHeight(),
+		})
+		if err := k.SetSuperNode(ctx, existingSupernode); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrIO, "error updating supernode: %s", err)
+		}
+
+		currentIP := ""
+		if len(existingSupernode.PrevIpAddresses) > 0 {
+			currentIP = existingSupernode.PrevIpAddresses[len(existingSupernode.PrevIpAddresses)-1].Address
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSupernodeRegistered,
+				sdk.NewAttribute(types.AttributeKeyValidatorAddress, existingSupernode.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyIPAddress, currentIP),
+				sdk.NewAttribute(types.AttributeKeySupernodeAccount, existingSupernode.SupernodeAccount),
+				sdk.NewAttribute(types.AttributeKeyReRegistered, "true"),
+				sdk.NewAttribute(types.AttributeKeyOldState, prevState.String()),
+				sdk.NewAttribute(types.AttributeKeyP2PPort, existingSupernode.P2PPort),
+				sdk.NewAttribute(types.AttributeKeyIndependent, "true"),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		)
+
+		return &types.MsgRegisterIndependentSupernodeResponse{ValidatorAddress: existingSupernode.ValidatorAddress}, nil
+	}
+
+	if msg.SelfStake.Amount.LT(minStake.Amount) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientSelfStake,
+			"self-stake %s is below the minimum %s", msg.SelfStake, minStake)
+	}
+
+	// The stake is bonded once the record exists so that BondSelfStake
+	// accounts for it; start from an explicit zero.
+	zeroStake := sdk.NewCoin(minStake.Denom, sdkmath.ZeroInt())
+	supernode := types.SuperNode{
+		ValidatorAddress: valOperAddr.String(),
+		SupernodeAccount: msg.SupernodeAccount,
+		Evidence:         []*types.Evidence{},
+		States: []*types.SuperNodeStateRecord{
+			{
+				State:  types.SuperNodeStateActive,
+				Height: ctx.BlockHeight(),
+			},
+		},
+		PrevIpAddresses: []*types.IPAddressHistory{
+			{
+				Address: msg.IpAddress,
+				Height:  ctx.BlockHeight(),
+			},
+		},
+		PrevSupernodeAccounts: []*types.SupernodeAccountHistory{
+			{
+				Account: msg.SupernodeAccount,
+				Height:  ctx.BlockHeight(),
+			},
+		},
+		P2PPort:     msg.P2PPort,
+		Independent: true,
+		SelfStake:   &zeroStake,
+	}
+	supernode.ApplyEndpoints(msg.Endpoints, ctx.BlockHeight())
+
+	if err := supernode.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetSuperNode(ctx, supernode); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrIO, "error setting supernode: %s", err)
+	}
+
+	if err := k.BondSelfStake(ctx, operator, msg.SelfStake); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSupernodeRegistered,
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, supernode.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyIPAddress, msg.IpAddress),
+			sdk.NewAttribute(types.AttributeKeySupernodeAccount, msg.SupernodeAccount),
+			sdk.NewAttribute(types.AttributeKeyP2PPort, msg.P2PPort),
+			sdk.NewAttribute(types.AttributeKeyIndependent, "true"),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return &types.MsgRegisterIndependentSupernodeResponse{ValidatorAddress: supernode.ValidatorAddress}, nil
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/keeper"
+	supernodemocks "github.com/LumeraProtocol/lumera/x/supernode/v1/mocks"
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func TestMsgServer_RegisterIndependentSupernode(t *testing.T) {
+	operator := sdk.AccAddress([]byte("independent-operator"))
+	valAddr := types.IndependentValAddress(operator)
+	snAccount := sdk.AccAddress([]byte("independent-sn-acct"))
+	stake := sdk.NewInt64Coin("ulume", 1_000_000)
+
+	validMsg := func() *types.MsgRegisterIndependentSupernode {
+		return types.NewMsgRegisterIndependentSupernode(operator.String(), "192.168.1.1", snAccount.String(), "26657", stake)
+	}
+	noValidator := func(sk *supernodemocks.MockStakingKeeper) {
+		sk.EXPECT().Validator(gomock.Any(), valAddr).Return(nil, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	}
+
+	testCases := []struct {
+		name          string
+		msg           *types.MsgRegisterIndependentSupernode
+		mockSetup     func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper)
+		setupState    func(t *testing.T, k keeper.Keeper, ctx sdk.Context)
+		expectedError error
+		checkResult   func(t *testing.T, k keeper.Keeper, ctx sdk.Context, resp *types.MsgRegisterIndependentSupernodeResponse)
+	}{
+		{
+			name: "successful registration bonds self-stake",
+			msg:  validMsg(),
+			mockSetup: func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper) {
+				noValidator(sk)
+				bk.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), operator, types.SelfStakePoolName, sdk.NewCoins(stake)).
+					Return(nil).
+					Times(1)
+			},
+			checkResult: func(t *testing.T, k keeper.Keeper, ctx sdk.Context, resp *types.MsgRegisterIndependentSupernodeResponse) {
+				require.Equal(t, valAddr.String(), resp.ValidatorAddress)
+				sn, found := k.QuerySuperNode(ctx, valAddr)
+				require.True(t, found)
+				require.True(t, sn.Independent)
+				require.Equal(t, stake, *sn.SelfStake)
+				require.Equal(t, snAccount.String(), sn.SupernodeAccount)
+				require.Equal(t, types.SuperNodeStateActive, sn.States[len(sn.States)-1].State)
+				require.True(t, k.IsSuperNodeActive(ctx, valAddr))
+			},
+		},
+		{
+			name: "self-stake below minimum",
+			msg: types.NewMsgRegisterIndependentSupernode(operator.String(), "192.168.1.1", snAccount.String(), "26657",
+				sdk.NewInt64Coin("ulume", 999_999)),
+			mockSetup: func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper) {
+				noValidator(sk)
+			},
+			expectedError: types.ErrInsufficientSelfStake,
+		},
+		{
+			name: "wrong self-stake denom",
+			msg: types.NewMsgRegisterIndependentSupernode(operator.String(), "192.168.1.1", snAccount.String(), "26657",
+				sdk.NewInt64Coin("uatom", 1_000_000)),
+			mockSetup: func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper) {
+				noValidator(sk)
+			},
+			expectedError: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "operator runs a validator",
+			msg:  validMsg(),
+			mockSetup: func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper) {
+				sk.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingtypes.Validator{OperatorAddress: valAddr.String()}, nil)
+			},
+			expectedError: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "validator-backed supernode already registered",
+			msg:  validMsg(),
+			mockSetup: func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper) {
+				noValidator(sk)
+			},
+			setupState: func(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+				require.NoError(t, k.SetSuperNode(ctx, types.SuperNode{
+					ValidatorAddress: valAddr.String(),
+					SupernodeAccount: operator.String(),
+					PrevIpAddresses:  []*types.IPAddressHistory{{Address: "10.0.0.1"}},
+					States:           []*types.SuperNodeStateRecord{{State: types.SuperNodeStateDisabled}},
+				}))
+			},
+			expectedError: types.ErrNotIndependentSupernode,
+		},
+		{
+			name: "re-registration of a disabled independent supernode",
+			msg: types.NewMsgRegisterIndependentSupernode(operator.String(), "192.168.1.1", snAccount.String(), "26657",
+				sdk.NewInt64Coin("ulume", 0)),
+			mockSetup: func(sk *supernodemocks.MockStakingKeeper, bk *supernodemocks.MockBankKeeper) {
+				noValidator(sk)
+			},
+			setupState: func(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+				require.NoError(t, k.SetSuperNode(ctx, types.SuperNode{
+					ValidatorAddress: valAddr.String(),
+					SupernodeAccount: snAccount.String(),
+					PrevIpAddresses:  []*types.IPAddressHistory{{Address: "10.0.0.1"}},
+					States:           []*types.SuperNodeStateRecord{{State: types.SuperNodeStateDisabled}},
+					Independent:      true,
+					SelfStake:        &stake,
+				}))
+			},
+			checkResult: func(t *testing.T, k keeper.Keeper, ctx sdk.Context, resp *types.MsgRegisterIndependentSupernodeResponse) {
+				require.True(t, k.IsSuperNodeActive(ctx, valAddr))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stakingKeeper := supernodemocks.NewMockStakingKeeper(ctrl)
+			slashingKeeper := supernodemocks.NewMockSlashingKeeper(ctrl)
+			bankKeeper := supernodemocks.NewMockBankKeeper(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(stakingKeeper, bankKeeper)
+			}
+
+			k, ctx := setupKeeperForTest(t, stakingKeeper, slashingKeeper, bankKeeper)
+			if tc.setupState != nil {
+				tc.setupState(t, k, ctx)
+			}
+
+			msgServer := keeper.NewMsgServerImpl(k)
+			resp, err := msgServer.RegisterIndependentSupernode(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.checkResult != nil {
+				tc.checkResult(t, k, ctx, resp)
+			}
+		})
+	}
+}
+
+func TestMsgServer_StartIndependentSupernodeRequiresSelfStake(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	k, ctx := setupKeeperForTest(t,
+		supernodemocks.NewMockStakingKeeper(ctrl),
+		supernodemocks.NewMockSlashingKeeper(ctrl),
+		supernodemocks.NewMockBankKeeper(ctrl),
+	)
+
+	operator := sdk.AccAddress([]byte("independent-operator"))
+	valAddr := types.IndependentValAddress(operator)
+	stake := sdk.NewInt64Coin("ulume", 500_000)
+	require.NoError(t, k.SetSuperNode(ctx, types.SuperNode{
+		ValidatorAddress: valAddr.String(),
+		SupernodeAccount: operator.String(),
+		PrevIpAddresses:  []*types.IPAddressHistory{{Address: "10.0.0.1"}},
+		States:           []*types.SuperNodeStateRecord{{State: types.SuperNodeStateStopped}},
+		Independent:      true,
+		SelfStake:        &stake,
+	}))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.StartSupernode(ctx, types.NewMsgStartSupernode(operator.String(), valAddr.String()))
+	require.ErrorIs(t, err, types.ErrInsufficientSelfStake)
+}
@@ -140,6 +140,7 @@ func (k msgServer) RegisterSupernode(goCtx context.Context, msg *types.MsgRegist
 		},
 		P2PPort: msg.P2PPort,
 	}
+	supernode.ApplyEndpoints(msg.Endpoints, ctx.BlockHeight())
 
 	// Validate the SuperNode struct
 	if err := supernode.Validate(); err != nil {
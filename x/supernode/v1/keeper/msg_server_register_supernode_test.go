@@ -687,6 +687,7 @@ func setupKeeperForTest(
 		nil,
 		slashingKeeper,
 		nil,
+		nil,
 	)
 
 	sdkCtx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
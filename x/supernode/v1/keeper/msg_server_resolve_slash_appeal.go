@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// ResolveSlashAppeal upholds or overturns an appealed slash. Only the module
+// authority may resolve appeals.
+func (k msgServer) ResolveSlashAppeal(goCtx context.Context, msg *types.MsgResolveSlashAppeal) (*types.MsgResolveSlashAppealResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ResolveSlashRecordAppeal(ctx, msg.SlashId, msg.Uphold); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveSlashAppealResponse{}, nil
+}
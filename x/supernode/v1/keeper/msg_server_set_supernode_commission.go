@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// SetSupernodeCommission lets the validator operator of a supernode set the
+// share of Everlight payouts kept by the supernode account. The remainder is
+// paid to the validator's delegators.
+func (k msgServer) SetSupernodeCommission(goCtx context.Context, msg *types.MsgSetSupernodeCommission) (*types.MsgSetSupernodeCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valOperAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	if err := VerifyValidatorOperator(valOperAddr, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.UpdateSupernodeCommission(ctx, valOperAddr, msg.Rate, msg.MaxRate, msg.MaxChangeRate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSupernodeCommissionResponse{}, nil
+}
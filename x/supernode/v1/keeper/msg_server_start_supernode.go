@@ -11,7 +11,8 @@ import (
 	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
 )
 
-// StartSupernode activates a stopped supernode (transitions from Stopped to Active state)
+// StartSupernode activates a stopped supernode, or a penalized one whose jail
+// has expired (transitions to Active state)
 func (k msgServer) StartSupernode(goCtx context.Context, msg *types.MsgStartSupernode) (*types.MsgStartSupernodeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -44,11 +45,34 @@ func (k msgServer) StartSupernode(goCtx context.Context, msg *types.MsgStartSupe
 	case types.SuperNodeStateStopped:
 		// OK to proceed
 	case types.SuperNodeStatePenalized:
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "supernode is penalized and cannot be started")
+		// A supernode penalized by a slash may restart once its jail expires.
+		jailedUntil, jailed := k.GetSupernodeJailedUntil(ctx, valOperAddr)
+		if !jailed {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "supernode is penalized and cannot be started")
+		}
+		if ctx.BlockHeight() < jailedUntil {
+			return nil, errorsmod.Wrapf(types.ErrSupernodeJailed, "supernode is jailed until height %d", jailedUntil)
+		}
 	default:
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot start supernode from state=%s", currentState.String())
 	}
 
+	if supernode.Independent && !k.IsSelfStakeEligible(ctx, supernode) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientSelfStake,
+			"self-stake %s is below the minimum required to start", supernode.SelfStake)
+	}
+	// A slash may have taken a validator-backed supernode below the minimum
+	// stake, so it must qualify again before leaving PENALIZED.
+	if currentState == types.SuperNodeStatePenalized && !supernode.Independent {
+		validator, err := k.GetStakingKeeper().Validator(ctx, valOperAddr)
+		if err != nil || validator == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "validator not found for operator address %s", msg.ValidatorAddress)
+		}
+		if err := k.CheckValidatorSupernodeEligibility(ctx, validator, msg.ValidatorAddress, supernode.SupernodeAccount); err != nil {
+			return nil, err
+		}
+	}
+
 	supernode.States = append(supernode.States, &types.SuperNodeStateRecord{
 		State:  types.SuperNodeStateActive,
 		Height: ctx.BlockHeight(),
@@ -57,6 +81,9 @@ func (k msgServer) StartSupernode(goCtx context.Context, msg *types.MsgStartSupe
 	if err := k.SetSuperNode(ctx, supernode); err != nil {
 		return nil, err
 	}
+	if currentState == types.SuperNodeStatePenalized {
+		k.ClearSupernodeJail(ctx, valOperAddr)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// UnbondSupernodeStake starts unbonding part of the self-stake of the
+// creator's independent supernode. The funds are returned once the
+// unbonding period has elapsed.
+func (k msgServer) UnbondSupernodeStake(goCtx context.Context, msg *types.MsgUnbondSupernodeStake) (*types.MsgUnbondSupernodeStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	completionHeight, err := k.UnbondSelfStake(ctx, operator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondSupernodeStakeResponse{CompletionHeight: completionHeight}, nil
+}
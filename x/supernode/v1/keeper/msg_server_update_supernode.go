@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"net"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,7 @@ func (k msgServer) UpdateSupernode(goCtx context.Context, msg *types.MsgUpdateSu
 	changedAccount := false
 	changedNote := false
 	changedP2P := false
+	changedEndpoints := false
 	oldIP := ""
 	newIP := ""
 	oldAccount := ""
@@ -99,6 +101,19 @@ func (k msgServer) UpdateSupernode(goCtx context.Context, msg *types.MsgUpdateSu
 		}
 	}
 
+	// Replace the advertised endpoint set if provided, or clear it on request
+	if msg.ClearEndpoints {
+		if len(msg.Endpoints) > 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidEndpoint, "clear_endpoints cannot be combined with endpoints")
+		}
+		changedEndpoints = supernode.ApplyEndpoints(nil, ctx.BlockHeight())
+	} else if len(msg.Endpoints) > 0 {
+		if err := types.ValidateEndpoints(msg.Endpoints); err != nil {
+			return nil, err
+		}
+		changedEndpoints = supernode.ApplyEndpoints(msg.Endpoints, ctx.BlockHeight())
+	}
+
 	// Re-save
 	if err := k.SetSuperNode(ctx, supernode); err != nil {
 		return nil, err
@@ -117,6 +132,9 @@ func (k msgServer) UpdateSupernode(goCtx context.Context, msg *types.MsgUpdateSu
 	if changedP2P {
 		fieldsUpdated = append(fieldsUpdated, types.AttributeKeyP2PPort)
 	}
+	if changedEndpoints {
+		fieldsUpdated = append(fieldsUpdated, types.AttributeKeyEndpoints)
+	}
 
 	// Always emit an update event, even if no fields changed, for observability
 	attrs := []sdk.Attribute{
@@ -136,6 +154,13 @@ func (k msgServer) UpdateSupernode(goCtx context.Context, msg *types.MsgUpdateSu
 			sdk.NewAttribute(types.AttributeKeyP2PPort, supernode.P2PPort),
 		)
 	}
+	if changedEndpoints {
+		advertised := make([]string, 0, len(supernode.Endpoints))
+		for _, e := range supernode.Endpoints {
+			advertised = append(advertised, net.JoinHostPort(e.Address, strconv.FormatUint(uint64(e.Port), 10)))
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEndpoints, strings.Join(advertised, ",")))
+	}
 	if changedIP {
 		attrs = append(attrs,
 			sdk.NewAttribute(types.AttributeKeyOldIPAddress, oldIP),
@@ -200,6 +200,119 @@ func TestMsgServer_UpdateSupernode(t *testing.T) {
 				require.Equal(t, "10.0.0.5", sn.PrevIpAddresses[1].Address)
 			},
 		},
+		{
+			name: "successful endpoint set replacement",
+			msg: &types.MsgUpdateSupernode{
+				Creator:          creatorAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Endpoints: []*types.SupernodeEndpoint{
+					{
+						AddressType: types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6,
+						Address:     "2001:db8::1",
+						Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+						Port:        4445,
+						Purpose:     types.EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+					},
+					{
+						AddressType: types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS,
+						Address:     "sn.example.org",
+						Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+						Port:        8002,
+						Purpose:     types.EndpointPurpose_ENDPOINT_PURPOSE_HTTP_GATEWAY,
+					},
+				},
+			},
+			setupState: func(k keeper.Keeper, ctx sdk.Context) {
+				sn := existingSupernode
+				sn.ApplyEndpoints([]*types.SupernodeEndpoint{{
+					AddressType: types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4,
+					Address:     "10.0.0.5",
+					Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+					Port:        4445,
+					Purpose:     types.EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+				}}, 1)
+				require.NoError(t, k.SetSuperNode(ctx, sn))
+			},
+			checkResult: func(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+				resp, err := keeper.NewQueryServerImpl(k).SuperNodeEndpoints(ctx, &types.QuerySuperNodeEndpointsRequest{ValidatorAddress: valAddr.String()})
+				require.NoError(t, err)
+				require.Len(t, resp.Endpoints, 2)
+				require.Equal(t, "2001:db8::1", resp.Endpoints[0].Address)
+				require.Equal(t, ctx.BlockHeight(), resp.Endpoints[0].AddedHeight)
+				require.Len(t, resp.History, 1)
+				require.Equal(t, "10.0.0.5", resp.History[0].Endpoint.Address)
+				require.Equal(t, ctx.BlockHeight(), resp.History[0].RemovedHeight)
+
+				// The legacy IP history is left untouched.
+				sn, found := k.QuerySuperNode(ctx, valAddr)
+				require.True(t, found)
+				require.Len(t, sn.PrevIpAddresses, 1)
+			},
+		},
+		{
+			name: "successful endpoint set clear",
+			msg: &types.MsgUpdateSupernode{
+				Creator:          creatorAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				ClearEndpoints:   true,
+			},
+			setupState: func(k keeper.Keeper, ctx sdk.Context) {
+				sn := existingSupernode
+				sn.ApplyEndpoints([]*types.SupernodeEndpoint{{
+					AddressType: types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4,
+					Address:     "10.0.0.5",
+					Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+					Port:        4445,
+					Purpose:     types.EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+				}}, 1)
+				require.NoError(t, k.SetSuperNode(ctx, sn))
+			},
+			checkResult: func(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+				resp, err := keeper.NewQueryServerImpl(k).SuperNodeEndpoints(ctx, &types.QuerySuperNodeEndpointsRequest{ValidatorAddress: valAddr.String()})
+				require.NoError(t, err)
+				require.Empty(t, resp.Endpoints)
+				require.Len(t, resp.History, 1)
+				require.Equal(t, "10.0.0.5", resp.History[0].Endpoint.Address)
+				require.Equal(t, ctx.BlockHeight(), resp.History[0].RemovedHeight)
+			},
+		},
+		{
+			name: "endpoint clear combined with endpoints rejected",
+			msg: &types.MsgUpdateSupernode{
+				Creator:          creatorAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				ClearEndpoints:   true,
+				Endpoints: []*types.SupernodeEndpoint{{
+					AddressType: types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6,
+					Address:     "2001:db8::1",
+					Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+					Port:        4445,
+					Purpose:     types.EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+				}},
+			},
+			setupState: func(k keeper.Keeper, ctx sdk.Context) {
+				require.NoError(t, k.SetSuperNode(ctx, existingSupernode))
+			},
+			expectedError: types.ErrInvalidEndpoint,
+		},
+		{
+			name: "invalid endpoint rejected",
+			msg: &types.MsgUpdateSupernode{
+				Creator:          creatorAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Endpoints: []*types.SupernodeEndpoint{{
+					AddressType: types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4,
+					Address:     "2001:db8::1",
+					Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+					Port:        4445,
+					Purpose:     types.EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+				}},
+			},
+			setupState: func(k keeper.Keeper, ctx sdk.Context) {
+				require.NoError(t, k.SetSuperNode(ctx, existingSupernode))
+			},
+			expectedError: types.ErrInvalidEndpoint,
+		},
 		{
 			name: "invalid validator address",
 			msg: &types.MsgUpdateSupernode{
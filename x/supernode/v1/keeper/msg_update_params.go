@@ -21,10 +21,18 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	if err := merged.Validate(); err != nil {
 		return nil, err
 	}
+	if err := validateEntropySwitchUpdate(ctx.BlockHeight(), current.EntropySwitchHeight, merged.EntropySwitchHeight); err != nil {
+		return nil, err
+	}
 
 	if err := k.SetParams(ctx, merged); err != nil {
 		return nil, err
 	}
+	if !merged.MinimumStakeForSn.Equal(current.MinimumStakeForSn) {
+		if err := k.StopIneligibleSelfStakedSupernodes(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -104,6 +112,38 @@ func mergeParams(base, incoming types.Params) types.Params {
 		merged.RequiredOpenPorts = incoming.RequiredOpenPorts
 	}
 
+	if incoming.EntropySwitchHeight != 0 {
+		merged.EntropySwitchHeight = incoming.EntropySwitchHeight
+	}
+
+	if incoming.BlockEntropyRetentionBlocks != 0 {
+		merged.BlockEntropyRetentionBlocks = incoming.BlockEntropyRetentionBlocks
+	}
+
+	if incoming.SelfStakeUnbondingBlocks != 0 {
+		merged.SelfStakeUnbondingBlocks = incoming.SelfStakeUnbondingBlocks
+	}
+
+	if incoming.SlashAppealWindowBlocks != 0 {
+		merged.SlashAppealWindowBlocks = incoming.SlashAppealWindowBlocks
+	}
+
+	if incoming.SlashJailBaseBlocks != 0 {
+		merged.SlashJailBaseBlocks = incoming.SlashJailBaseBlocks
+	}
+
+	if incoming.SlashJailMaxBlocks != 0 {
+		merged.SlashJailMaxBlocks = incoming.SlashJailMaxBlocks
+	}
+
+	if incoming.SlashOffenseWindowBlocks != 0 {
+		merged.SlashOffenseWindowBlocks = incoming.SlashOffenseWindowBlocks
+	}
+
+	if incoming.SlashMaxFraction != "" {
+		merged.SlashMaxFraction = incoming.SlashMaxFraction
+	}
+
 	if incoming.RewardDistribution != nil {
 		// RewardDistribution is treated as a full nested update when present.
 		// This preserves explicit zero values for fields where zero is valid
@@ -38,13 +38,14 @@ func (q queryServer) SNEligibility(goCtx context.Context, req *types.QuerySNElig
 		return &types.QuerySNEligibilityResponse{Eligible: false, Reason: "supernode state is not eligible"}, nil
 	}
 
-	rawBytes, reportHeight, ok := q.k.GetLatestCascadeBytesForPayout(ctx, sn.SupernodeAccount)
+	reportedBytes, reportHeight, ok := q.k.GetLatestCascadeBytesForPayout(ctx, sn.SupernodeAccount)
 	if !ok {
 		return &types.QuerySNEligibilityResponse{Eligible: false, Reason: "no audit epoch report found"}, nil
 	}
 	if !isFreshByBlockHeight(ctx.BlockHeight(), reportHeight, params.MetricsFreshnessMaxBlocks) {
-		return &types.QuerySNEligibilityResponse{Eligible: false, Reason: "audit report is stale", CascadeKademliaDbBytes: rawBytes}, nil
+		return &types.QuerySNEligibilityResponse{Eligible: false, Reason: "audit report is stale", CascadeKademliaDbBytes: reportedBytes}, nil
 	}
+	rawBytes := bytesToDec(reportedBytes)
 
 	// Load distribution state.
 	distState, exists := q.k.GetSNDistState(ctx, req.ValidatorAddress)
@@ -54,19 +55,22 @@ func (q queryServer) SNEligibility(goCtx context.Context, req *types.QuerySNElig
 		smoothedBytes = applyEMA(distState.SmoothedBytes, cappedBytes, dist.MeasurementSmoothingPeriods)
 	}
 
-	if floatToUint64(smoothedBytes) < dist.MinCascadeBytesForPayment {
+	// The response carries float64 for client convenience only; eligibility
+	// itself is decided on the fixed-point value.
+	smoothedWeight, _ := smoothedBytes.Float64()
+	if !meetsMinCascadeBytes(smoothedBytes, dist.MinCascadeBytesForPayment) {
 		return &types.QuerySNEligibilityResponse{
 			Eligible:               false,
 			Reason:                 "cascade bytes below minimum threshold",
-			CascadeKademliaDbBytes: rawBytes,
-			SmoothedWeight:         smoothedBytes,
+			CascadeKademliaDbBytes: reportedBytes,
+			SmoothedWeight:         smoothedWeight,
 		}, nil
 	}
 
 	return &types.QuerySNEligibilityResponse{
 		Eligible:               true,
 		Reason:                 "eligible",
-		CascadeKademliaDbBytes: rawBytes,
-		SmoothedWeight:         smoothedBytes,
+		CascadeKademliaDbBytes: reportedBytes,
+		SmoothedWeight:         smoothedWeight,
 	}, nil
 }
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// SelfStakeUnbondings returns the pending self-stake unbondings of an operator.
+func (q queryServer) SelfStakeUnbondings(goCtx context.Context, req *types.QuerySelfStakeUnbondingsRequest) (*types.QuerySelfStakeUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.OperatorAccount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operator account: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	unbondings := q.k.GetSelfStakeUnbondings(ctx, operator)
+	if unbondings == nil {
+		unbondings = []types.SelfStakeUnbonding{}
+	}
+
+	return &types.QuerySelfStakeUnbondingsResponse{Unbondings: unbondings}, nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// SlashRecord returns a single slash record by id.
+func (q queryServer) SlashRecord(goCtx context.Context, req *types.QuerySlashRecordRequest) (*types.QuerySlashRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, found := q.k.GetSlashRecord(ctx, req.SlashId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "slash %d not found", req.SlashId)
+	}
+
+	return &types.QuerySlashRecordResponse{Record: record}, nil
+}
+
+// SlashRecords returns the slash records of a supernode, oldest first.
+func (q queryServer) SlashRecords(goCtx context.Context, req *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var keeperImpl Keeper
+	switch k := q.k.(type) {
+	case Keeper:
+		keeperImpl = k
+	case *Keeper:
+		if k == nil {
+			return nil, status.Error(codes.Internal, "unexpected keeper implementation")
+		}
+		keeperImpl = *k
+	default:
+		return nil, status.Error(codes.Internal, "unexpected keeper implementation")
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(keeperImpl.storeService.OpenKVStore(ctx)), types.SlashByValidatorIndexPrefix(valAddr))
+
+	records := make([]types.SlashRecord, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		if record, found := keeperImpl.GetSlashRecord(ctx, binary.BigEndian.Uint64(key)); found {
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// JailStatus returns whether a supernode is jailed after a slash.
+func (q queryServer) JailStatus(goCtx context.Context, req *types.QueryJailStatusRequest) (*types.QueryJailStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	jailedUntil, found := q.k.GetSupernodeJailedUntil(ctx, valAddr)
+
+	return &types.QueryJailStatusResponse{
+		Jailed:            found && ctx.BlockHeight() < jailedUntil,
+		JailedUntilHeight: jailedUntil,
+	}, nil
+}
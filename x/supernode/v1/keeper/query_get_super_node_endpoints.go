@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// SuperNodeEndpoints returns the effective endpoint set of a supernode and the
+// endpoints it advertised previously.
+func (q queryServer) SuperNodeEndpoints(goCtx context.Context, req *types.QuerySuperNodeEndpointsRequest) (*types.QuerySuperNodeEndpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valOperAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sn, found := q.k.QuerySuperNode(ctx, valOperAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no supernode found for validator %s", req.ValidatorAddress)
+	}
+
+	endpoints := sn.EffectiveEndpoints()
+	if endpoints == nil {
+		endpoints = []types.SupernodeEndpoint{}
+	}
+	history := make([]types.EndpointHistory, 0, len(sn.EndpointHistory))
+	for _, h := range sn.EndpointHistory {
+		if h != nil {
+			history = append(history, *h)
+		}
+	}
+
+	return &types.QuerySuperNodeEndpointsResponse{Endpoints: endpoints, History: history}, nil
+}
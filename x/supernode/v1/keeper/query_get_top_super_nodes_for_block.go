@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 	"math/big"
 	"sort"
 	"strings"
@@ -245,11 +244,3 @@ func xorDistance(a, b []byte) *big.Int {
 	}
 	return new(big.Int).SetBytes(xorBytes)
 }
-
-func (k Keeper) GetBlockHashForHeight(ctx sdk.Context, height int64) ([]byte, error) {
-	if height <= 0 {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height %d", height)
-	}
-	h := blake3.Sum256([]byte(fmt.Sprintf("%d", height)))
-	return h[:], nil
-}
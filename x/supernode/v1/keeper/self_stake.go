@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// IsSelfStakeEligible reports whether an independent supernode has at least
+// the minimum stake bonded. Validator-backed supernodes are never eligible
+// through self-stake.
+func (k Keeper) IsSelfStakeEligible(ctx sdk.Context, sn types.SuperNode) bool {
+	if !sn.Independent || sn.SelfStake == nil {
+		return false
+	}
+	minStake := k.GetParams(ctx).MinimumStakeForSn
+	return sn.SelfStake.Denom == minStake.Denom && sn.SelfStake.Amount.GTE(minStake.Amount)
+}
+
+// StopIneligibleSelfStakedSupernodes stops every ACTIVE independent
+// supernode whose self-stake no longer meets the minimum stake, e.g. after
+// governance raised minimum_stake_for_sn.
+func (k Keeper) StopIneligibleSelfStakedSupernodes(ctx sdk.Context) error {
+	supernodes, err := k.GetAllSuperNodes(ctx, types.SuperNodeStateActive)
+	if err != nil {
+		return err
+	}
+	for _, sn := range supernodes {
+		if !sn.Independent || k.IsSelfStakeEligible(ctx, sn) {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(sn.ValidatorAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid supernode validator %s: %s", sn.ValidatorAddress, err)
+		}
+		if err := k.SetSuperNodeStopped(ctx, valAddr, "self_stake_below_minimum"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BondSelfStake moves amount from the operator into the self-stake pool and
+// adds it to the operator's independent supernode. A STOPPED supernode that
+// becomes eligible again is re-activated, as the staking hooks do for
+// validator-backed supernodes.
+func (k Keeper) BondSelfStake(ctx sdk.Context, operator sdk.AccAddress, amount sdk.Coin) error {
+	valAddr := types.IndependentValAddress(operator)
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no supernode found for operator %s", operator)
+	}
+	if !sn.Independent {
+		return errorsmod.Wrapf(types.ErrNotIndependentSupernode, "supernode %s is validator-backed", sn.ValidatorAddress)
+	}
+
+	minStake := k.GetParams(ctx).MinimumStakeForSn
+	if amount.Denom != minStake.Denom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "self-stake must be denominated in %s, got %s", minStake.Denom, amount.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, operator, types.SelfStakePoolName, sdk.NewCoins(amount)); err != nil {
+		return errorsmod.Wrapf(err, "failed to bond self-stake")
+	}
+
+	selfStake := amount
+	if sn.SelfStake != nil && !sn.SelfStake.IsNil() {
+		selfStake = sn.SelfStake.Add(amount)
+	}
+	sn.SelfStake = &selfStake
+	if err := k.SetSuperNode(ctx, sn); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrIO, "error updating supernode: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfStakeBonded,
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, sn.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeySelfStake, selfStake.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	if k.IsSelfStakeEligible(ctx, sn) {
+		return k.SetSuperNodeActive(ctx, valAddr, "self_stake_bonded_eligible")
+	}
+	return nil
+}
+
+// UnbondSelfStake removes amount from the operator's bonded self-stake and
+// queues it for return after Params.SelfStakeUnbondingBlocks. The queued
+// amount stays slashable until then. An ACTIVE supernode left below the
+// minimum stake is stopped. It returns the completion height.
+func (k Keeper) UnbondSelfStake(ctx sdk.Context, operator sdk.AccAddress, amount sdk.Coin) (int64, error) {
+	valAddr := types.IndependentValAddress(operator)
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no supernode found for operator %s", operator)
+	}
+	if !sn.Independent {
+		return 0, errorsmod.Wrapf(types.ErrNotIndependentSupernode, "supernode %s is validator-backed", sn.ValidatorAddress)
+	}
+	if sn.SelfStake == nil || sn.SelfStake.Denom != amount.Denom || sn.SelfStake.Amount.LT(amount.Amount) {
+		return 0, errorsmod.Wrapf(types.ErrInsufficientSelfStake, "cannot unbond %s from self-stake %s", amount, sn.SelfStake)
+	}
+
+	remaining := sn.SelfStake.Sub(amount)
+	sn.SelfStake = &remaining
+	if err := k.SetSuperNode(ctx, sn); err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrIO, "error updating supernode: %s", err)
+	}
+
+	completionHeight := ctx.BlockHeight() + int64(k.GetParams(ctx).SelfStakeUnbondingBlocks)
+	unbonding, found := k.getSelfStakeUnbonding(ctx, completionHeight, operator)
+	if found {
+		unbonding.Amount = unbonding.Amount.Add(amount)
+	} else {
+		unbonding = types.SelfStakeUnbonding{
+			OperatorAccount:  operator.String(),
+			Amount:           amount,
+			CreationHeight:   ctx.BlockHeight(),
+			CompletionHeight: completionHeight,
+		}
+	}
+	if err := k.setSelfStakeUnbonding(ctx, operator, unbonding); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfStakeUnbonding,
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, sn.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeySelfStake, remaining.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, strconv.FormatInt(completionHeight, 10)),
+		),
+	)
+
+	if !k.IsSelfStakeEligible(ctx, sn) {
+		if err := k.SetSuperNodeStopped(ctx, valAddr, "self_stake_below_minimum"); err != nil {
+			return 0, err
+		}
+	}
+
+	return completionHeight, nil
+}
+
+// SlashSelfStake slashes fraction of an independent supernode's bonded
+// self-stake and of every unbonding still pending for its operator, moving the
+// slashed amount into the Everlight pool. An ACTIVE supernode left below the
+// minimum stake is stopped. It returns the amount slashed.
+func (k Keeper) SlashSelfStake(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec, reason string) (sdk.Coin, error) {
+	if fraction.IsNegative() || fraction.GT(sdkmath.LegacyOneDec()) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "slash fraction must be within [0, 1], got %s", fraction)
+	}
+
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no supernode found for validator %s", valAddr)
+	}
+	if !sn.Independent || sn.SelfStake == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNotIndependentSupernode, "supernode %s is validator-backed", sn.ValidatorAddress)
+	}
+
+	operator := sdk.AccAddress(valAddr)
+	slashed := sdk.NewCoin(sn.SelfStake.Denom, sdkmath.LegacyNewDecFromInt(sn.SelfStake.Amount).Mul(fraction).TruncateInt())
+	remaining := sn.SelfStake.Sub(slashed)
+	sn.SelfStake = &remaining
+
+	for _, unbonding := range k.GetSelfStakeUnbondings(ctx, operator) {
+		cut := sdkmath.LegacyNewDecFromInt(unbonding.Amount.Amount).Mul(fraction).TruncateInt()
+		if cut.IsZero() {
+			continue
+		}
+		unbonding.Amount = unbonding.Amount.SubAmount(cut)
+		slashed = slashed.AddAmount(cut)
+		if unbonding.Amount.IsZero() {
+			k.deleteSelfStakeUnbonding(ctx, unbonding.CompletionHeight, operator)
+			continue
+		}
+		if err := k.setSelfStakeUnbonding(ctx, operator, unbonding); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if slashed.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.SelfStakePoolName, types.ModuleName, sdk.NewCoins(slashed)); err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(err, "failed to route slashed self-stake to the Everlight pool")
+		}
+	}
+	if err := k.SetSuperNode(ctx, sn); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrIO, "error updating supernode: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSelfStakeSlashed,
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, sn.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeySelfStake, remaining.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	if !k.IsSelfStakeEligible(ctx, sn) {
+		if err := k.SetSuperNodeStopped(ctx, valAddr, "self_stake_below_minimum"); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return slashed, nil
+}
+
+// GetSelfStakeUnbondings returns the pending unbondings of an operator,
+// ordered by completion height.
+func (k Keeper) GetSelfStakeUnbondings(ctx sdk.Context, operator sdk.AccAddress) []types.SelfStakeUnbonding {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SelfStakeUnbondingByOperatorIndexPrefix(operator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var unbondings []types.SelfStakeUnbonding
+	for ; iterator.Valid(); iterator.Next() {
+		if unbonding, found := k.getSelfStakeUnbonding(ctx, int64(binary.BigEndian.Uint64(iterator.Key())), operator); found {
+			unbondings = append(unbondings, unbonding)
+		}
+	}
+	return unbondings
+}
+
+// CompleteMatureSelfStakeUnbondings returns every unbonding whose completion
+// height has been reached to its operator. Only the matured part of the queue
+// is read. An unbonding that cannot be returned is logged and left queued so
+// it is retried in a later block; it never halts the chain.
+func (k Keeper) CompleteMatureSelfStakeUnbondings(ctx sdk.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := store.Iterator(types.SelfStakeUnbondingPrefix, types.SelfStakeUnbondingQueuePrefix(ctx.BlockHeight()+1))
+
+	var matured [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		matured = append(matured, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range matured {
+		cacheCtx, write := ctx.CacheContext()
+		unbonding, err := k.completeSelfStakeUnbonding(cacheCtx, key)
+		if err != nil {
+			k.Logger().Error("failed to complete self-stake unbonding", "key", hex.EncodeToString(key), "err", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSelfStakeUnbonded,
+				sdk.NewAttribute(types.AttributeKeyOperator, unbonding.OperatorAccount),
+				sdk.NewAttribute(types.AttributeKeyAmount, unbonding.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		)
+	}
+}
+
+// completeSelfStakeUnbonding returns the unbonding stored under key to its
+// operator and removes it from the queue.
+func (k Keeper) completeSelfStakeUnbonding(ctx sdk.Context, key []byte) (types.SelfStakeUnbonding, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var unbonding types.SelfStakeUnbonding
+	if err := k.cdc.Unmarshal(store.Get(key), &unbonding); err != nil {
+		return unbonding, errorsmod.Wrapf(sdkerrors.ErrIO, "error unmarshalling self-stake unbonding: %s", err)
+	}
+	operator, err := sdk.AccAddressFromBech32(unbonding.OperatorAccount)
+	if err != nil {
+		return unbonding, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid unbonding operator %s: %s", unbonding.OperatorAccount, err)
+	}
+	if unbonding.Amount.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.SelfStakePoolName, operator, sdk.NewCoins(unbonding.Amount)); err != nil {
+			return unbonding, errorsmod.Wrapf(err, "failed to return unbonded self-stake to %s", unbonding.OperatorAccount)
+		}
+	}
+	k.deleteSelfStakeUnbonding(ctx, unbonding.CompletionHeight, operator)
+
+	return unbonding, nil
+}
+
+func (k Keeper) getSelfStakeUnbonding(ctx sdk.Context, completionHeight int64, operator sdk.AccAddress) (types.SelfStakeUnbonding, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.SelfStakeUnbondingKey(completionHeight, operator))
+	if bz == nil {
+		return types.SelfStakeUnbonding{}, false
+	}
+	var unbonding types.SelfStakeUnbonding
+	k.cdc.MustUnmarshal(bz, &unbonding)
+	return unbonding, true
+}
+
+func (k Keeper) setSelfStakeUnbonding(ctx sdk.Context, operator sdk.AccAddress, unbonding types.SelfStakeUnbonding) error {
+	bz, err := k.cdc.Marshal(&unbonding)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrIO, "error marshalling self-stake unbonding: %s", err)
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.SelfStakeUnbondingKey(unbonding.CompletionHeight, operator), bz)
+	store.Set(types.SelfStakeUnbondingByOperatorIndexKey(operator, unbonding.CompletionHeight), []byte{})
+	return nil
+}
+
+func (k Keeper) deleteSelfStakeUnbonding(ctx sdk.Context, completionHeight int64, operator sdk.AccAddress) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.SelfStakeUnbondingKey(completionHeight, operator))
+	store.Delete(types.SelfStakeUnbondingByOperatorIndexKey(operator, completionHeight))
+}
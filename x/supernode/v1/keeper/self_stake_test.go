@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+const testMinSelfStake = 1_000_000
+
+func setupSelfStakeKeeper(t *testing.T) (Keeper, sdk.Context, *mockBankKeeper) {
+	t.Helper()
+
+	k, ctx, bankKeeper, _, _ := setupTestKeeper(t)
+	params := k.GetParams(ctx)
+	params.MinimumStakeForSn = sdk.NewInt64Coin("ulume", testMinSelfStake)
+	params.SelfStakeUnbondingBlocks = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, ctx, bankKeeper
+}
+
+// addIndependentSupernode stores an ACTIVE independent supernode with no
+// stake bonded and funds its operator.
+func addIndependentSupernode(t *testing.T, k Keeper, ctx sdk.Context, bankKeeper *mockBankKeeper, operator sdk.AccAddress, funds int64) sdk.ValAddress {
+	t.Helper()
+
+	valAddr := sntypes.IndependentValAddress(operator)
+	zero := sdk.NewInt64Coin("ulume", 0)
+	require.NoError(t, k.SetSuperNode(ctx, sntypes.SuperNode{
+		ValidatorAddress: valAddr.String(),
+		SupernodeAccount: operator.String(),
+		PrevIpAddresses:  []*sntypes.IPAddressHistory{{Address: "10.0.0.1", Height: ctx.BlockHeight()}},
+		States:           []*sntypes.SuperNodeStateRecord{{State: sntypes.SuperNodeStateActive, Height: ctx.BlockHeight()}},
+		Independent:      true,
+		SelfStake:        &zero,
+	}))
+	bankKeeper.balances[operator.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulume", funds))
+
+	return valAddr
+}
+
+func lastState(t *testing.T, k Keeper, ctx sdk.Context, valAddr sdk.ValAddress) sntypes.SuperNodeState {
+	t.Helper()
+
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	require.True(t, found)
+	return sn.States[len(sn.States)-1].State
+}
+
+func TestSelfStakeBondUnbondLifecycle(t *testing.T) {
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	operator := makeAccAddr(1)
+	valAddr := addIndependentSupernode(t, k, ctx, bankKeeper, operator, 3*testMinSelfStake)
+	poolAddr := authtypes.NewModuleAddress(sntypes.SelfStakePoolName).String()
+
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 2*testMinSelfStake)))
+	require.Equal(t, int64(2*testMinSelfStake), bankKeeper.balances[poolAddr].AmountOf("ulume").Int64())
+	require.ErrorIs(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("uatom", 1)), sdkerrors.ErrInvalidCoins)
+
+	// Dropping below the minimum stops the supernode and queues the withdrawal.
+	completion, err := k.UnbondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 1_500_000))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+10, completion)
+	require.Equal(t, sntypes.SuperNodeStateStopped, lastState(t, k, ctx, valAddr))
+
+	_, err = k.UnbondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", testMinSelfStake))
+	require.ErrorIs(t, err, sntypes.ErrInsufficientSelfStake)
+
+	unbondings := k.GetSelfStakeUnbondings(ctx, operator)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, int64(1_500_000), unbondings[0].Amount.Amount.Int64())
+
+	// Topping the stake back up re-activates it.
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 500_000)))
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, ctx, valAddr))
+
+	// Nothing is returned before the completion height.
+	k.CompleteMatureSelfStakeUnbondings(ctx.WithBlockHeight(completion - 1))
+	require.Len(t, k.GetSelfStakeUnbondings(ctx, operator), 1)
+
+	k.CompleteMatureSelfStakeUnbondings(ctx.WithBlockHeight(completion))
+	require.Empty(t, k.GetSelfStakeUnbondings(ctx, operator))
+	last := bankKeeper.sent[len(bankKeeper.sent)-1]
+	require.Equal(t, poolAddr, last.from)
+	require.Equal(t, operator.String(), last.to)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ulume", 1_500_000)), last.amount)
+	require.Equal(t, int64(testMinSelfStake), bankKeeper.balances[poolAddr].AmountOf("ulume").Int64())
+}
+
+func TestCompleteMatureSelfStakeUnbondingsSkipsBadEntries(t *testing.T) {
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	operator := makeAccAddr(5)
+	addIndependentSupernode(t, k, ctx, bankKeeper, operator, 2*testMinSelfStake)
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 2*testMinSelfStake)))
+	completion, err := k.UnbondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", testMinSelfStake))
+	require.NoError(t, err)
+
+	// An entry that cannot be paid out is queued ahead of the valid one.
+	broken := makeAccAddr(6)
+	require.NoError(t, k.setSelfStakeUnbonding(ctx, broken, sntypes.SelfStakeUnbonding{
+		OperatorAccount:  "not-an-address",
+		Amount:           sdk.NewInt64Coin("ulume", 1),
+		CompletionHeight: completion - 1,
+	}))
+
+	k.CompleteMatureSelfStakeUnbondings(ctx.WithBlockHeight(completion))
+	require.Empty(t, k.GetSelfStakeUnbondings(ctx, operator))
+	require.Len(t, k.GetSelfStakeUnbondings(ctx, broken), 1)
+	last := bankKeeper.sent[len(bankKeeper.sent)-1]
+	require.Equal(t, operator.String(), last.to)
+}
+
+func TestRaisingMinimumStakeStopsIndependentSupernodes(t *testing.T) {
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	operator := makeAccAddr(7)
+	valAddr := addIndependentSupernode(t, k, ctx, bankKeeper, operator, testMinSelfStake)
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", testMinSelfStake)))
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, ctx, valAddr))
+
+	params := k.GetParams(ctx)
+	params.MinimumStakeForSn = sdk.NewInt64Coin("ulume", 2*testMinSelfStake)
+	_, err := NewMsgServerImpl(k).UpdateParams(ctx, &sntypes.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, sntypes.SuperNodeStateStopped, lastState(t, k, ctx, valAddr))
+}
+
+func TestSlashSelfStake(t *testing.T) {
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	operator := makeAccAddr(2)
+	valAddr := addIndependentSupernode(t, k, ctx, bankKeeper, operator, 3*testMinSelfStake)
+	poolAddr := authtypes.NewModuleAddress(sntypes.SelfStakePoolName).String()
+
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 2*testMinSelfStake)))
+	_, err := k.UnbondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 900_000))
+	require.NoError(t, err)
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, ctx, valAddr))
+
+	// Both the bonded stake and the pending unbonding are slashed.
+	burned, err := k.SlashSelfStake(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), "test")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulume", 200_000), burned)
+	require.Equal(t, int64(1_800_000), bankKeeper.balances[poolAddr].AmountOf("ulume").Int64())
+
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, int64(990_000), sn.SelfStake.Amount.Int64())
+	require.Equal(t, sntypes.SuperNodeStateStopped, lastState(t, k, ctx, valAddr))
+
+	unbondings := k.GetSelfStakeUnbondings(ctx, operator)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, int64(810_000), unbondings[0].Amount.Amount.Int64())
+
+	_, err = k.SlashSelfStake(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("1.5"), "test")
+	require.Error(t, err)
+}
+
+func TestSlashSelfStakeRejectsValidatorBackedSupernode(t *testing.T) {
+	k, ctx, _, snKeeper, auditKeeper := setupTestKeeper(t)
+	valAddr := makeValAddr(3)
+	addSupernode(snKeeper, auditKeeper, valAddr, makeAccAddr(3), sntypes.SuperNodeStateActive, 0)
+
+	_, err := k.SlashSelfStake(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), "test")
+	require.ErrorIs(t, err, sntypes.ErrNotIndependentSupernode)
+}
+
+func TestHooksIgnoreIndependentSupernodes(t *testing.T) {
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	operator := makeAccAddr(4)
+	valAddr := addIndependentSupernode(t, k, ctx, bankKeeper, operator, testMinSelfStake)
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", testMinSelfStake)))
+
+	// The keeper has no staking keeper; reaching validator eligibility checks would panic.
+	hooks := k.Hooks()
+	require.NoError(t, hooks.AfterDelegationModified(ctx, operator, valAddr))
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, nil, valAddr))
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, nil, valAddr))
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, ctx, valAddr))
+
+	// The operator cannot create a validator sharing the supernode key while it is in use.
+	require.ErrorIs(t, hooks.AfterValidatorCreated(ctx, valAddr), sntypes.ErrInvalidSuperNodeState)
+
+	// Once disabled and fully withdrawn the record becomes validator-backed.
+	_, err := k.UnbondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", testMinSelfStake))
+	require.NoError(t, err)
+	sn, _ := k.QuerySuperNode(ctx, valAddr)
+	sn.States = append(sn.States, &sntypes.SuperNodeStateRecord{State: sntypes.SuperNodeStateDisabled, Height: ctx.BlockHeight()})
+	require.NoError(t, k.SetSuperNode(ctx, sn))
+	require.ErrorIs(t, hooks.AfterValidatorCreated(ctx, valAddr), sntypes.ErrInvalidSuperNodeState)
+
+	k.CompleteMatureSelfStakeUnbondings(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	require.NoError(t, hooks.AfterValidatorCreated(ctx, valAddr))
+	sn, _ = k.QuerySuperNode(ctx, valAddr)
+	require.False(t, sn.Independent)
+	require.Nil(t, sn.SelfStake)
+}
@@ -0,0 +1,480 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// ReportSlashableOffense records a confirmed audit offense against a
+// supernode. The penalty is graduated by the number of offenses the supernode
+// committed within the offense window and fixed at report time; it executes
+// once the appeal window closes unless the operator appeals first. Reporting
+// the same offense twice for one evidence reference returns the existing
+// record id.
+func (k Keeper) ReportSlashableOffense(ctx sdk.Context, valAddr sdk.ValAddress, offense types.SlashOffense, evidenceRef string) (uint64, error) {
+	if _, ok := types.SlashOffense_name[int32(offense)]; !ok || offense == types.SlashOffense_SLASH_OFFENSE_UNSPECIFIED {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid slash offense %s", offense)
+	}
+
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no supernode found for validator %s", valAddr)
+	}
+
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+	windowStart := height - int64(params.SlashOffenseWindowBlocks)
+
+	var recentOffenses uint64
+	for _, record := range k.getSlashRecordsByValidator(ctx, valAddr) {
+		if record.Offense == offense && record.EvidenceRef == evidenceRef {
+			return record.Id, nil
+		}
+		if record.Status != types.SlashStatus_SLASH_STATUS_OVERTURNED && record.ReportedHeight > windowStart {
+			recentOffenses++
+		}
+	}
+
+	offenseCount := recentOffenses + 1
+	fraction, jailBlocks := slashPenalty(params, offenseCount)
+
+	record := types.SlashRecord{
+		Id:                   k.nextSlashID(ctx),
+		ValidatorAddress:     sn.ValidatorAddress,
+		SupernodeAccount:     sn.SupernodeAccount,
+		Offense:              offense,
+		EvidenceRef:          evidenceRef,
+		Status:               types.SlashStatus_SLASH_STATUS_PENDING,
+		ReportedHeight:       height,
+		AppealDeadlineHeight: height + int64(params.SlashAppealWindowBlocks),
+		OffenseCount:         offenseCount,
+		Fraction:             fraction,
+		JailBlocks:           jailBlocks,
+		SlashedAmount:        sdk.NewInt64Coin(params.MinimumStakeForSn.Denom, 0),
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	k.setSlashRecord(ctx, record)
+	store.Set(types.SlashByValidatorIndexKey(valAddr, record.Id), []byte{})
+	store.Set(types.SlashQueueKey(record.AppealDeadlineHeight, record.Id), []byte{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashReported,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOffense, offense.String()),
+			sdk.NewAttribute(types.AttributeKeyEvidenceRef, evidenceRef),
+			sdk.NewAttribute(types.AttributeKeyOffenseCount, strconv.FormatUint(offenseCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyAppealDeadlineHeight, strconv.FormatInt(record.AppealDeadlineHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		),
+	)
+
+	return record.Id, nil
+}
+
+// AppealSlashRecord moves a pending slash out of the execution queue until
+// governance resolves the appeal. It must be called before the appeal
+// deadline.
+func (k Keeper) AppealSlashRecord(ctx sdk.Context, id uint64, reason string) error {
+	record, found := k.GetSlashRecord(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSlashNotFound, "slash %d", id)
+	}
+	if record.Status != types.SlashStatus_SLASH_STATUS_PENDING {
+		return errorsmod.Wrapf(types.ErrInvalidSlashState, "slash %d is %s", id, record.Status)
+	}
+	if ctx.BlockHeight() >= record.AppealDeadlineHeight {
+		return errorsmod.Wrapf(types.ErrInvalidSlashState, "appeal window of slash %d closed at height %d", id, record.AppealDeadlineHeight)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.SlashQueueKey(record.AppealDeadlineHeight, record.Id))
+
+	record.Status = types.SlashStatus_SLASH_STATUS_APPEALED
+	record.AppealReason = reason
+	k.setSlashRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashAppealed,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// ResolveSlashRecordAppeal settles an appealed slash: an upheld slash executes
+// immediately, otherwise it is overturned and no longer counts towards the
+// supernode's offense history.
+func (k Keeper) ResolveSlashRecordAppeal(ctx sdk.Context, id uint64, uphold bool) error {
+	record, found := k.GetSlashRecord(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSlashNotFound, "slash %d", id)
+	}
+	if record.Status != types.SlashStatus_SLASH_STATUS_APPEALED {
+		return errorsmod.Wrapf(types.ErrInvalidSlashState, "slash %d is %s", id, record.Status)
+	}
+
+	if uphold {
+		return k.executeSlash(ctx, record)
+	}
+
+	record.Status = types.SlashStatus_SLASH_STATUS_OVERTURNED
+	record.ResolvedHeight = ctx.BlockHeight()
+	k.setSlashRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashOverturned,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteMatureSlashes executes every pending slash whose appeal window has
+// closed. A slash that fails to execute is logged, dropped from the queue and
+// marked FAILED, so it is never retried. It never halts the chain.
+func (k Keeper) ExecuteMatureSlashes(ctx sdk.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := store.Iterator(types.SlashQueuePrefix, types.SlashQueueHeightPrefix(ctx.BlockHeight()+1))
+
+	var due [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		due = append(due, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range due {
+		store.Delete(key)
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		record, found := k.GetSlashRecord(ctx, id)
+		if !found || record.Status != types.SlashStatus_SLASH_STATUS_PENDING {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.executeSlash(cacheCtx, record); err != nil {
+			k.Logger().Error("failed to execute slash", "slash_id", record.Id, "validator", record.ValidatorAddress, "err", err)
+			k.failSlash(ctx, record, err)
+			continue
+		}
+		write()
+	}
+}
+
+// failSlash marks a slash whose execution failed as FAILED.
+func (k Keeper) failSlash(ctx sdk.Context, record types.SlashRecord, cause error) {
+	record.Status = types.SlashStatus_SLASH_STATUS_FAILED
+	record.ResolvedHeight = ctx.BlockHeight()
+	k.setSlashRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashFailed,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyReason, cause.Error()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+}
+
+// executeSlash applies the economic penalty of a slash record, routes the
+// slashed funds to the Everlight pool and jails the supernode.
+func (k Keeper) executeSlash(ctx sdk.Context, record types.SlashRecord) error {
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid slash validator %s: %s", record.ValidatorAddress, err)
+	}
+
+	height := ctx.BlockHeight()
+	reason := "slash:" + record.Offense.String()
+
+	if record.Fraction.IsPositive() {
+		// A failure to move stake must not halt the chain; the supernode is
+		// still jailed and the record shows nothing was slashed.
+		cacheCtx, write := ctx.CacheContext()
+		slashed, err := k.slashSupernodeStake(cacheCtx, valAddr, record.Fraction, reason)
+		if err != nil {
+			k.Logger().Error("failed to slash supernode stake", "slash_id", record.Id, "validator", record.ValidatorAddress, "err", err)
+		} else {
+			write()
+			record.SlashedAmount = slashed
+		}
+	}
+
+	jailedUntil := height + int64(record.JailBlocks)
+	if current, jailed := k.GetSupernodeJailedUntil(ctx, valAddr); jailed && current > jailedUntil {
+		jailedUntil = current
+	}
+	k.setSupernodeJailedUntil(ctx, valAddr, jailedUntil)
+	if err := k.setSuperNodePenalized(ctx, valAddr, reason); err != nil {
+		return err
+	}
+
+	record.Status = types.SlashStatus_SLASH_STATUS_EXECUTED
+	record.ResolvedHeight = height
+	record.JailedUntilHeight = jailedUntil
+	k.setSlashRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashExecuted,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOffense, record.Offense.String()),
+			sdk.NewAttribute(types.AttributeKeyFraction, record.Fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, record.SlashedAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyJailedUntilHeight, strconv.FormatInt(jailedUntil, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		),
+	)
+
+	return nil
+}
+
+// slashSupernodeStake slashes the stake backing a supernode and moves the
+// slashed amount into the Everlight pool. Independent supernodes lose part of
+// their self-stake. Validator-backed supernodes lose the same fraction of the
+// delegations that make them eligible: the operator's self-delegation and the
+// supernode account's delegation. Other delegators of the validator are not
+// affected; the unbonded tokens move from the staking pool into the Everlight
+// pool, so supply is unchanged. The operator's self-delegation is never cut
+// below the validator's min_self_delegation, since x/staking would jail the
+// consensus validator for it.
+func (k Keeper) slashSupernodeStake(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec, reason string) (sdk.Coin, error) {
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no supernode found for validator %s", valAddr)
+	}
+	if sn.Independent {
+		return k.SlashSelfStake(ctx, valAddr, fraction, reason)
+	}
+
+	if k.stakingKeeper == nil {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrLogic, "staking keeper is required to slash validator-backed supernodes")
+	}
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil || validator == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "validator %s not found", valAddr)
+	}
+	// Unbonded tokens stay in the pool matching the validator's status.
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+
+	delegators := []sdk.AccAddress{sdk.AccAddress(valAddr)}
+	if snAccount, err := sdk.AccAddressFromBech32(sn.SupernodeAccount); err == nil && !snAccount.Equals(delegators[0]) {
+		delegators = append(delegators, snAccount)
+	}
+
+	slashed := sdkmath.ZeroInt()
+	for _, delAddr := range delegators {
+		delegation, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+		if err != nil || delegation == nil {
+			continue
+		}
+		shares := delegation.GetShares().Mul(fraction)
+		if delAddr.Equals(sdk.AccAddress(valAddr)) {
+			shares = capSelfDelegationSlash(validator, delegation.GetShares(), shares)
+		}
+		if !shares.IsPositive() {
+			continue
+		}
+		amount, err := k.stakingKeeper.Unbond(ctx, delAddr, valAddr, shares)
+		if err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(err, "failed to slash delegation of %s to %s", delAddr, valAddr)
+		}
+		slashed = slashed.Add(amount)
+	}
+
+	coin := sdk.NewCoin(k.GetParams(ctx).MinimumStakeForSn.Denom, slashed)
+	if coin.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(err, "failed to route slashed stake to the Everlight pool")
+		}
+	}
+
+	return coin, nil
+}
+
+// capSelfDelegationSlash reduces the shares slashed from the operator's
+// self-delegation so that the remaining self-delegation still covers the
+// validator's min_self_delegation. It returns zero when no slash fits.
+func capSelfDelegationSlash(validator stakingtypes.ValidatorI, delShares, shares sdkmath.LegacyDec) sdkmath.LegacyDec {
+	minSelf := validator.GetMinSelfDelegation()
+	if minSelf.IsNil() || !minSelf.IsPositive() {
+		return shares
+	}
+	keeps := func(slashed sdkmath.LegacyDec) bool {
+		return !validator.TokensFromShares(delShares.Sub(slashed)).TruncateInt().LT(minSelf)
+	}
+	if keeps(shares) {
+		return shares
+	}
+	minShares, err := validator.SharesFromTokens(minSelf)
+	if err != nil {
+		return sdkmath.LegacyZeroDec()
+	}
+	capped := delShares.Sub(minShares)
+	if !capped.IsPositive() || !keeps(capped) {
+		return sdkmath.LegacyZeroDec()
+	}
+	return capped
+}
+
+// setSuperNodePenalized moves a supernode into PENALIZED. Disabled supernodes
+// keep their state.
+func (k Keeper) setSuperNodePenalized(ctx sdk.Context, valAddr sdk.ValAddress, reason string) error {
+	sn, found := k.QuerySuperNode(ctx, valAddr)
+	if !found || len(sn.States) == 0 {
+		return nil
+	}
+	last := sn.States[len(sn.States)-1]
+	if last.State == types.SuperNodeStateDisabled || last.State == types.SuperNodeStatePenalized {
+		return nil
+	}
+
+	sn.States = append(sn.States, &types.SuperNodeStateRecord{State: types.SuperNodeStatePenalized, Height: ctx.BlockHeight(), Reason: reason})
+	if err := k.SetSuperNode(ctx, sn); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrIO, "error updating supernode: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSupernodePenalized,
+			sdk.NewAttribute(types.AttributeKeyValidatorAddress, sn.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldState, last.State.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// slashPenalty returns the slash fraction and jail duration of the n-th
+// offense within the offense window. Both double with every offense: the
+// fraction from slashing_threshold onwards, capped at slash_max_fraction, and
+// the jail duration from the first offense, capped at slash_jail_max_blocks.
+func slashPenalty(params types.Params, offenseCount uint64) (sdkmath.LegacyDec, uint64) {
+	jailBlocks := params.SlashJailBaseBlocks
+	for i := uint64(1); i < offenseCount && jailBlocks < params.SlashJailMaxBlocks; i++ {
+		jailBlocks *= 2
+	}
+	if jailBlocks > params.SlashJailMaxBlocks {
+		jailBlocks = params.SlashJailMaxBlocks
+	}
+
+	fraction := sdkmath.LegacyZeroDec()
+	threshold := params.SlashingThreshold
+	if threshold == 0 {
+		threshold = 1
+	}
+	if params.SlashingFraction == "" || offenseCount < threshold {
+		return fraction, jailBlocks
+	}
+	base, err := sdkmath.LegacyNewDecFromStr(params.SlashingFraction)
+	if err != nil {
+		return fraction, jailBlocks
+	}
+	maxFraction, err := sdkmath.LegacyNewDecFromStr(params.SlashMaxFraction)
+	if err != nil {
+		return fraction, jailBlocks
+	}
+
+	fraction = base
+	for i := threshold; i < offenseCount && fraction.LT(maxFraction); i++ {
+		fraction = fraction.MulInt64(2)
+	}
+	if fraction.GT(maxFraction) {
+		fraction = maxFraction
+	}
+
+	return fraction, jailBlocks
+}
+
+// GetSlashRecord returns a slash record by id.
+func (k Keeper) GetSlashRecord(ctx sdk.Context, id uint64) (types.SlashRecord, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.SlashRecordKey(id))
+	if bz == nil {
+		return types.SlashRecord{}, false
+	}
+	var record types.SlashRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+func (k Keeper) setSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.SlashRecordKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+// getSlashRecordsByValidator returns the slash records of a validator, oldest first.
+func (k Keeper) getSlashRecordsByValidator(ctx sdk.Context, valAddr sdk.ValAddress) []types.SlashRecord {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SlashByValidatorIndexPrefix(valAddr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var records []types.SlashRecord
+	for ; iterator.Valid(); iterator.Next() {
+		if record, found := k.GetSlashRecord(ctx, binary.BigEndian.Uint64(iterator.Key())); found {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (k Keeper) nextSlashID(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	id := uint64(1)
+	if bz := store.Get(types.NextSlashIDKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	store.Set(types.NextSlashIDKey, binary.BigEndian.AppendUint64(nil, id+1))
+	return id
+}
+
+// GetSupernodeJailedUntil returns the height until which a supernode is
+// jailed after a slash, and whether a jail record exists.
+func (k Keeper) GetSupernodeJailedUntil(ctx sdk.Context, valAddr sdk.ValAddress) (int64, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.SupernodeJailKey(valAddr))
+	if len(bz) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+func (k Keeper) setSupernodeJailedUntil(ctx sdk.Context, valAddr sdk.ValAddress, height int64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.SupernodeJailKey(valAddr), binary.BigEndian.AppendUint64(nil, uint64(height)))
+}
+
+// ClearSupernodeJail removes a supernode's jail record.
+func (k Keeper) ClearSupernodeJail(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.SupernodeJailKey(valAddr))
+}
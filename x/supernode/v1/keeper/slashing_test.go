@@ -0,0 +1,370 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	sntypes "github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+// setupSlashingKeeper returns a keeper with economic slashing enabled from the
+// second offense onwards and a bonded independent supernode.
+func setupSlashingKeeper(t *testing.T) (Keeper, sdk.Context, *mockBankKeeper, sdk.AccAddress, sdk.ValAddress) {
+	t.Helper()
+
+	k, ctx, bankKeeper := setupSelfStakeKeeper(t)
+	params := k.GetParams(ctx)
+	params.SlashingThreshold = 2
+	params.SlashingFraction = "0.1"
+	params.SlashMaxFraction = "0.3"
+	params.SlashAppealWindowBlocks = 100
+	params.SlashJailBaseBlocks = 50
+	params.SlashJailMaxBlocks = 150
+	params.SlashOffenseWindowBlocks = 1000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	operator := makeAccAddr(7)
+	valAddr := addIndependentSupernode(t, k, ctx, bankKeeper, operator, 2*testMinSelfStake)
+	require.NoError(t, k.BondSelfStake(ctx, operator, sdk.NewInt64Coin("ulume", 2*testMinSelfStake)))
+
+	return k, ctx, bankKeeper, operator, valAddr
+}
+
+func TestSlashPenalty(t *testing.T) {
+	params := sntypes.DefaultParams()
+	params.SlashJailBaseBlocks = 100
+	params.SlashJailMaxBlocks = 350
+	params.SlashingThreshold = 2
+	params.SlashingFraction = "0.01"
+	params.SlashMaxFraction = "0.03"
+
+	tests := []struct {
+		offenseCount uint64
+		fraction     string
+		jailBlocks   uint64
+	}{
+		{offenseCount: 1, fraction: "0", jailBlocks: 100},
+		{offenseCount: 2, fraction: "0.01", jailBlocks: 200},
+		{offenseCount: 3, fraction: "0.02", jailBlocks: 350},
+		{offenseCount: 4, fraction: "0.03", jailBlocks: 350},
+		{offenseCount: 10, fraction: "0.03", jailBlocks: 350},
+	}
+	for _, tc := range tests {
+		fraction, jailBlocks := slashPenalty(params, tc.offenseCount)
+		require.Equal(t, sdkmath.LegacyMustNewDecFromStr(tc.fraction), fraction, "offense %d", tc.offenseCount)
+		require.Equal(t, tc.jailBlocks, jailBlocks, "offense %d", tc.offenseCount)
+	}
+
+	// Without a base fraction offenses only jail.
+	params.SlashingFraction = ""
+	fraction, jailBlocks := slashPenalty(params, 5)
+	require.True(t, fraction.IsZero())
+	require.Equal(t, uint64(350), jailBlocks)
+}
+
+func TestReportSlashableOffense(t *testing.T) {
+	k, ctx, _, _, valAddr := setupSlashingKeeper(t)
+	offense := sntypes.SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE
+
+	first, err := k.ReportSlashableOffense(ctx, valAddr, offense, "heal_op:1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first)
+
+	// The same evidence is only reported once.
+	dup, err := k.ReportSlashableOffense(ctx, valAddr, offense, "heal_op:1")
+	require.NoError(t, err)
+	require.Equal(t, first, dup)
+
+	second, err := k.ReportSlashableOffense(ctx, valAddr, offense, "heal_op:2")
+	require.NoError(t, err)
+
+	record, found := k.GetSlashRecord(ctx, first)
+	require.True(t, found)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_PENDING, record.Status)
+	require.Equal(t, uint64(1), record.OffenseCount)
+	require.True(t, record.Fraction.IsZero())
+	require.Equal(t, uint64(50), record.JailBlocks)
+	require.Equal(t, ctx.BlockHeight()+100, record.AppealDeadlineHeight)
+
+	record, found = k.GetSlashRecord(ctx, second)
+	require.True(t, found)
+	require.Equal(t, uint64(2), record.OffenseCount)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.1"), record.Fraction)
+	require.Equal(t, uint64(100), record.JailBlocks)
+
+	// Offenses outside the window no longer count.
+	later := ctx.WithBlockHeight(ctx.BlockHeight() + 1000)
+	third, err := k.ReportSlashableOffense(later, valAddr, offense, "heal_op:3")
+	require.NoError(t, err)
+	record, _ = k.GetSlashRecord(later, third)
+	require.Equal(t, uint64(1), record.OffenseCount)
+
+	_, err = k.ReportSlashableOffense(ctx, valAddr, sntypes.SlashOffense_SLASH_OFFENSE_UNSPECIFIED, "x")
+	require.Error(t, err)
+	_, err = k.ReportSlashableOffense(ctx, makeValAddr(99), offense, "x")
+	require.Error(t, err)
+}
+
+func TestExecuteMatureSlashesSlashesSelfStakeAndJails(t *testing.T) {
+	k, ctx, bankKeeper, operator, valAddr := setupSlashingKeeper(t)
+	offense := sntypes.SlashOffense_SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE
+	everlightAddr := authtypes.NewModuleAddress(sntypes.ModuleName).String()
+
+	_, err := k.ReportSlashableOffense(ctx, valAddr, offense, "epoch:1")
+	require.NoError(t, err)
+	id, err := k.ReportSlashableOffense(ctx, valAddr, offense, "epoch:2")
+	require.NoError(t, err)
+
+	// Nothing executes before the appeal deadline.
+	deadline := ctx.BlockHeight() + 100
+	k.ExecuteMatureSlashes(ctx.WithBlockHeight(deadline - 1))
+	record, _ := k.GetSlashRecord(ctx, id)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_PENDING, record.Status)
+
+	execCtx := ctx.WithBlockHeight(deadline)
+	k.ExecuteMatureSlashes(execCtx)
+
+	record, _ = k.GetSlashRecord(execCtx, id)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_EXECUTED, record.Status)
+	require.Equal(t, sdk.NewInt64Coin("ulume", 200_000), record.SlashedAmount)
+	require.Equal(t, deadline+100, record.JailedUntilHeight)
+	require.Equal(t, int64(200_000), bankKeeper.balances[everlightAddr].AmountOf("ulume").Int64())
+	require.Equal(t, sntypes.SuperNodeStatePenalized, lastState(t, k, execCtx, valAddr))
+
+	jailedUntil, jailed := k.GetSupernodeJailedUntil(execCtx, valAddr)
+	require.True(t, jailed)
+	require.Equal(t, deadline+100, jailedUntil)
+
+	res, err := NewQueryServerImpl(k).JailStatus(execCtx, &sntypes.QueryJailStatusRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.True(t, res.Jailed)
+	require.Equal(t, deadline+100, res.JailedUntilHeight)
+
+	records, err := NewQueryServerImpl(k).SlashRecords(execCtx, &sntypes.QuerySlashRecordsRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, records.Records, 2)
+
+	// The supernode cannot start until the jail expires; starting clears it.
+	ms := NewMsgServerImpl(k)
+	_, err = ms.StartSupernode(execCtx.WithBlockHeight(jailedUntil-1), &sntypes.MsgStartSupernode{Creator: operator.String(), ValidatorAddress: valAddr.String()})
+	require.ErrorIs(t, err, sntypes.ErrSupernodeJailed)
+
+	freeCtx := execCtx.WithBlockHeight(jailedUntil)
+	_, err = ms.StartSupernode(freeCtx, &sntypes.MsgStartSupernode{Creator: operator.String(), ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, freeCtx, valAddr))
+	_, jailed = k.GetSupernodeJailedUntil(freeCtx, valAddr)
+	require.False(t, jailed)
+}
+
+func TestSlashValidatorBackedSupernodeStake(t *testing.T) {
+	k, ctx, bankKeeper, snKeeper, auditKeeper := setupTestKeeper(t)
+	valAddr := makeValAddr(9)
+	snAccount := makeAccAddr(10)
+	otherDelegator := makeAccAddr(11)
+	addSupernode(snKeeper, auditKeeper, valAddr, snAccount, sntypes.SuperNodeStateActive, 0)
+
+	delegation := func(delAddr sdk.AccAddress, shares int64) stakingtypes.Delegation {
+		return stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), sdkmath.LegacyNewDec(shares))
+	}
+	stakingKeeper := &mockStakingKeeper{
+		validators: map[string]stakingtypes.Validator{valAddr.String(): {
+			OperatorAddress: valAddr.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          sdkmath.NewInt(10_000_000),
+			DelegatorShares: sdkmath.LegacyNewDec(10_000_000),
+		}},
+		delegations: map[string]stakingtypes.Delegation{
+			sdk.AccAddress(valAddr).String() + valAddr.String(): delegation(sdk.AccAddress(valAddr), 2_000_000),
+			snAccount.String() + valAddr.String():               delegation(snAccount, 1_000_000),
+			otherDelegator.String() + valAddr.String():          delegation(otherDelegator, 7_000_000),
+		},
+	}
+	k.stakingKeeper = stakingKeeper
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	bankKeeper.balances[bondedPool] = sdk.NewCoins(sdk.NewInt64Coin("ulume", 10_000_000))
+
+	slashed, err := k.slashSupernodeStake(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), "test")
+	require.NoError(t, err)
+
+	// Only the operator's and the supernode account's delegations are cut, and
+	// the tokens move from the bonded pool rather than being minted.
+	require.Equal(t, sdk.NewInt64Coin("ulume", 300_000), slashed)
+	require.Equal(t, int64(9_700_000), bankKeeper.balances[bondedPool].AmountOf("ulume").Int64())
+	require.Equal(t, int64(300_000), bankKeeper.balances[authtypes.NewModuleAddress(sntypes.ModuleName).String()].AmountOf("ulume").Int64())
+	require.Equal(t, int64(9_700_000), stakingKeeper.validators[valAddr.String()].Tokens.Int64())
+	require.Equal(t, sdkmath.LegacyNewDec(1_800_000), stakingKeeper.delegations[sdk.AccAddress(valAddr).String()+valAddr.String()].Shares)
+	require.Equal(t, sdkmath.LegacyNewDec(900_000), stakingKeeper.delegations[snAccount.String()+valAddr.String()].Shares)
+	require.Equal(t, sdkmath.LegacyNewDec(7_000_000), stakingKeeper.delegations[otherDelegator.String()+valAddr.String()].Shares)
+}
+
+func TestSlashValidatorBackedSupernodeStakeKeepsMinSelfDelegation(t *testing.T) {
+	k, ctx, bankKeeper, snKeeper, auditKeeper := setupTestKeeper(t)
+	valAddr := makeValAddr(9)
+	snAccount := makeAccAddr(10)
+	addSupernode(snKeeper, auditKeeper, valAddr, snAccount, sntypes.SuperNodeStateActive, 0)
+
+	stakingKeeper := &mockStakingKeeper{
+		validators: map[string]stakingtypes.Validator{valAddr.String(): {
+			OperatorAddress:   valAddr.String(),
+			Status:            stakingtypes.Bonded,
+			Tokens:            sdkmath.NewInt(3_000_000),
+			DelegatorShares:   sdkmath.LegacyNewDec(3_000_000),
+			MinSelfDelegation: sdkmath.NewInt(1_900_000),
+		}},
+		delegations: map[string]stakingtypes.Delegation{
+			sdk.AccAddress(valAddr).String() + valAddr.String(): stakingtypes.NewDelegation(sdk.AccAddress(valAddr).String(), valAddr.String(), sdkmath.LegacyNewDec(2_000_000)),
+			snAccount.String() + valAddr.String():               stakingtypes.NewDelegation(snAccount.String(), valAddr.String(), sdkmath.LegacyNewDec(1_000_000)),
+		},
+	}
+	k.stakingKeeper = stakingKeeper
+	bankKeeper.balances[authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()] = sdk.NewCoins(sdk.NewInt64Coin("ulume", 3_000_000))
+
+	slashed, err := k.slashSupernodeStake(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), "test")
+	require.NoError(t, err)
+
+	// The self-delegation is cut only down to min_self_delegation.
+	require.Equal(t, sdk.NewInt64Coin("ulume", 200_000), slashed)
+	require.Equal(t, sdkmath.LegacyNewDec(1_900_000), stakingKeeper.delegations[sdk.AccAddress(valAddr).String()+valAddr.String()].Shares)
+	require.Equal(t, sdkmath.LegacyNewDec(900_000), stakingKeeper.delegations[snAccount.String()+valAddr.String()].Shares)
+
+	// At the minimum, the self-delegation is not cut at all.
+	slashed, err = k.slashSupernodeStake(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.1"), "test")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ulume", 90_000), slashed)
+	require.Equal(t, sdkmath.LegacyNewDec(1_900_000), stakingKeeper.delegations[sdk.AccAddress(valAddr).String()+valAddr.String()].Shares)
+}
+
+func TestExecuteMatureSlashesMarksFailedSlash(t *testing.T) {
+	k, ctx, _, _, _ := setupSlashingKeeper(t)
+
+	// A record whose validator address is invalid can never execute.
+	record := sntypes.SlashRecord{
+		Id:                   k.nextSlashID(ctx),
+		ValidatorAddress:     "invalid",
+		Offense:              sntypes.SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE,
+		Status:               sntypes.SlashStatus_SLASH_STATUS_PENDING,
+		AppealDeadlineHeight: ctx.BlockHeight(),
+	}
+	k.setSlashRecord(ctx, record)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(sntypes.SlashQueueKey(record.AppealDeadlineHeight, record.Id), []byte{})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.ExecuteMatureSlashes(ctx)
+
+	stored, found := k.GetSlashRecord(ctx, record.Id)
+	require.True(t, found)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_FAILED, stored.Status)
+	require.Equal(t, ctx.BlockHeight(), stored.ResolvedHeight)
+	require.False(t, store.Has(sntypes.SlashQueueKey(record.AppealDeadlineHeight, record.Id)))
+
+	var failed bool
+	for _, ev := range ctx.EventManager().Events() {
+		failed = failed || ev.Type == sntypes.EventTypeSlashFailed
+	}
+	require.True(t, failed)
+}
+
+func TestStartPenalizedValidatorBackedSupernodeRequiresMinimumStake(t *testing.T) {
+	k, ctx, _, snKeeper, auditKeeper := setupTestKeeper(t)
+	params := k.GetParams(ctx)
+	params.MinimumStakeForSn = sdk.NewInt64Coin("ulume", testMinSelfStake)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	valAddr := makeValAddr(12)
+	operator := sdk.AccAddress(valAddr)
+	addSupernode(snKeeper, auditKeeper, valAddr, makeAccAddr(13), sntypes.SuperNodeStatePenalized, 0)
+	k.setSupernodeJailedUntil(ctx, valAddr, ctx.BlockHeight())
+
+	selfDelegation := func(shares int64) stakingtypes.Delegation {
+		return stakingtypes.NewDelegation(operator.String(), valAddr.String(), sdkmath.LegacyNewDec(shares))
+	}
+	stakingKeeper := &mockStakingKeeper{
+		validators: map[string]stakingtypes.Validator{valAddr.String(): {
+			OperatorAddress: valAddr.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          sdkmath.NewInt(500_000),
+			DelegatorShares: sdkmath.LegacyNewDec(500_000),
+		}},
+		delegations: map[string]stakingtypes.Delegation{operator.String() + valAddr.String(): selfDelegation(500_000)},
+	}
+	k.stakingKeeper = stakingKeeper
+
+	ms := NewMsgServerImpl(k)
+	msg := &sntypes.MsgStartSupernode{Creator: operator.String(), ValidatorAddress: valAddr.String()}
+	_, err := ms.StartSupernode(ctx, msg)
+	require.Error(t, err)
+	require.Equal(t, sntypes.SuperNodeStatePenalized, lastState(t, k, ctx, valAddr))
+
+	stakingKeeper.validators[valAddr.String()] = stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(2 * testMinSelfStake),
+		DelegatorShares: sdkmath.LegacyNewDec(2 * testMinSelfStake),
+	}
+	stakingKeeper.delegations[operator.String()+valAddr.String()] = selfDelegation(2 * testMinSelfStake)
+	_, err = ms.StartSupernode(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, ctx, valAddr))
+}
+
+func TestSlashAppeal(t *testing.T) {
+	k, ctx, bankKeeper, operator, valAddr := setupSlashingKeeper(t)
+	offense := sntypes.SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE
+	ms := NewMsgServerImpl(k)
+	everlightAddr := authtypes.NewModuleAddress(sntypes.ModuleName).String()
+
+	overturned, err := k.ReportSlashableOffense(ctx, valAddr, offense, "heal_op:1")
+	require.NoError(t, err)
+	upheld, err := k.ReportSlashableOffense(ctx, valAddr, offense, "heal_op:2")
+	require.NoError(t, err)
+
+	_, err = ms.AppealSlash(ctx, &sntypes.MsgAppealSlash{Creator: makeAccAddr(8).String(), SlashId: overturned, Reason: "not me"})
+	require.Error(t, err)
+	_, err = ms.AppealSlash(ctx, &sntypes.MsgAppealSlash{Creator: operator.String(), SlashId: overturned, Reason: "healer was offline for maintenance"})
+	require.NoError(t, err)
+	_, err = ms.AppealSlash(ctx, &sntypes.MsgAppealSlash{Creator: operator.String(), SlashId: upheld, Reason: "disputed"})
+	require.NoError(t, err)
+	_, err = ms.AppealSlash(ctx, &sntypes.MsgAppealSlash{Creator: operator.String(), SlashId: upheld, Reason: "again"})
+	require.ErrorIs(t, err, sntypes.ErrInvalidSlashState)
+
+	// Appealed slashes do not execute when the appeal window closes.
+	k.ExecuteMatureSlashes(ctx.WithBlockHeight(ctx.BlockHeight() + 100))
+	record, _ := k.GetSlashRecord(ctx, upheld)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_APPEALED, record.Status)
+	require.Equal(t, "disputed", record.AppealReason)
+
+	_, err = ms.ResolveSlashAppeal(ctx, &sntypes.MsgResolveSlashAppeal{Authority: operator.String(), SlashId: upheld, Uphold: true})
+	require.Error(t, err)
+
+	authority := k.GetAuthority()
+	_, err = ms.ResolveSlashAppeal(ctx, &sntypes.MsgResolveSlashAppeal{Authority: authority, SlashId: overturned})
+	require.NoError(t, err)
+	record, _ = k.GetSlashRecord(ctx, overturned)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_OVERTURNED, record.Status)
+	require.Equal(t, sntypes.SuperNodeStateActive, lastState(t, k, ctx, valAddr))
+
+	_, err = ms.ResolveSlashAppeal(ctx, &sntypes.MsgResolveSlashAppeal{Authority: authority, SlashId: upheld, Uphold: true})
+	require.NoError(t, err)
+	record, _ = k.GetSlashRecord(ctx, upheld)
+	require.Equal(t, sntypes.SlashStatus_SLASH_STATUS_EXECUTED, record.Status)
+	require.Equal(t, int64(200_000), bankKeeper.balances[everlightAddr].AmountOf("ulume").Int64())
+	require.Equal(t, sntypes.SuperNodeStatePenalized, lastState(t, k, ctx, valAddr))
+
+	// Overturned slashes no longer count towards the offense history.
+	next, err := k.ReportSlashableOffense(ctx, valAddr, offense, "heal_op:3")
+	require.NoError(t, err)
+	record, _ = k.GetSlashRecord(ctx, next)
+	require.Equal(t, uint64(2), record.OffenseCount)
+
+	// The appeal window is closed at the deadline.
+	_, err = ms.AppealSlash(ctx.WithBlockHeight(record.AppealDeadlineHeight), &sntypes.MsgAppealSlash{Creator: operator.String(), SlashId: next, Reason: "late"})
+	require.ErrorIs(t, err, sntypes.ErrInvalidSlashState)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -41,12 +42,14 @@ func (k Keeper) SetLastDistributionHeight(ctx sdk.Context, height int64) {
 	store.Set(types.LastDistributionHeightKey, bz)
 }
 
-// EnsureModuleAccount materialises the supernode ModuleAccount in the account
-// store. Must be called during InitGenesis so that later bank sends to the
-// module address do not silently create a BaseAccount instead.
+// EnsureModuleAccount materialises the supernode and self-stake pool
+// ModuleAccounts in the account store. Must be called during InitGenesis so
+// that later bank sends to the module addresses do not silently create a
+// BaseAccount instead.
 func (k Keeper) EnsureModuleAccount(ctx sdk.Context) {
 	if k.accountKeeper != nil {
 		k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+		k.accountKeeper.GetModuleAccount(ctx, types.SelfStakePoolName)
 	}
 }
 
@@ -122,46 +125,66 @@ func (k Keeper) AppendPayoutHistoryEntry(ctx sdk.Context, entry *types.PayoutHis
 	store.Set(key, k.cdc.MustMarshal(entry))
 }
 
+// bpsDenominator is the basis-point scale used by distribution params.
+const bpsDenominator = 10000
+
 // applyGrowthCap limits the reported bytes growth to the configured cap per period.
 // Returns the capped raw bytes value.
-func applyGrowthCap(rawBytes, prevRawBytes float64, growthCapBps uint64) float64 {
-	if prevRawBytes <= 0 {
+func applyGrowthCap(rawBytes, prevRawBytes sdkmath.LegacyDec, growthCapBps uint64) sdkmath.LegacyDec {
+	if !prevRawBytes.IsPositive() {
 		// First observation or previous was zero: no cap to apply.
 		return rawBytes
 	}
-	maxGrowthFraction := float64(growthCapBps) / 10000.0
-	maxAllowed := prevRawBytes * (1.0 + maxGrowthFraction)
-	if rawBytes > maxAllowed {
+	// maxAllowed = prev * (10000 + capBps) / 10000
+	maxAllowed := prevRawBytes.
+		Mul(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(growthCapBps).AddRaw(bpsDenominator))).
+		QuoInt64(bpsDenominator)
+	if rawBytes.GT(maxAllowed) {
 		return maxAllowed
 	}
 	return rawBytes
 }
 
 // applyEMA computes an exponential moving average for the smoothed bytes.
-// alpha = 2 / (periods + 1), which is the standard EMA formula.
-func applyEMA(prevSmoothed, newValue float64, smoothingPeriods uint64) float64 {
+// alpha = 2 / (periods + 1), which is the standard EMA formula; it is applied as
+// (2*new + (periods-1)*prev) / (periods+1) so the result is rounded only once.
+func applyEMA(prevSmoothed, newValue sdkmath.LegacyDec, smoothingPeriods uint64) sdkmath.LegacyDec {
 	if smoothingPeriods == 0 {
 		return newValue
 	}
-	alpha := 2.0 / (float64(smoothingPeriods) + 1.0)
-	if prevSmoothed <= 0 {
+	if !prevSmoothed.IsPositive() {
 		// First observation: use the new value directly.
 		return newValue
 	}
-	return alpha*newValue + (1.0-alpha)*prevSmoothed
+	periods := sdkmath.NewIntFromUint64(smoothingPeriods)
+	numerator := newValue.MulInt64(2).Add(prevSmoothed.MulInt(periods.SubRaw(1)))
+	return numerator.QuoInt(periods.AddRaw(1))
 }
 
-// computeRampUpWeight returns a fractional weight [0.0, 1.0] for new supernodes
+// computeRampUpWeight returns a fractional weight [0, 1] for new supernodes
 // during their ramp-up period.
-func computeRampUpWeight(periodsActive, rampUpPeriods uint64) float64 {
+func computeRampUpWeight(periodsActive, rampUpPeriods uint64) sdkmath.LegacyDec {
 	if rampUpPeriods == 0 {
-		return 1.0
+		return sdkmath.LegacyOneDec()
 	}
 	if periodsActive >= rampUpPeriods {
-		return 1.0
+		return sdkmath.LegacyOneDec()
 	}
 	// Linear ramp: fraction of completed periods.
-	return float64(periodsActive+1) / float64(rampUpPeriods)
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(periodsActive + 1)).
+		QuoInt(sdkmath.NewIntFromUint64(rampUpPeriods))
+}
+
+// bytesToDec converts a reported cascade byte count to a whole-byte decimal.
+// Reports arrive as float64 in SupernodeMetrics; truncating to an integer at the
+// boundary keeps the rest of the distribution pipeline float-free.
+func bytesToDec(reportedBytes float64) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(floatToUint64(reportedBytes)))
+}
+
+// meetsMinCascadeBytes reports whether smoothed bytes reach the payment threshold.
+func meetsMinCascadeBytes(smoothedBytes sdkmath.LegacyDec, minBytes uint64) bool {
+	return smoothedBytes.GTE(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(minBytes)))
 }
 
 // floatToUint64 safely converts a float64 to uint64, clamping negative values to 0.
@@ -169,7 +192,7 @@ func floatToUint64(f float64) uint64 {
 	if f <= 0 || math.IsNaN(f) || math.IsInf(f, -1) {
 		return 0
 	}
-	if math.IsInf(f, 1) || f > float64(math.MaxUint64) {
+	if math.IsInf(f, 1) || f >= float64(math.MaxUint64) {
 		return math.MaxUint64
 	}
 	return uint64(f)
@@ -52,6 +52,34 @@ func (m *MockSupernodeKeeper) EXPECT() *MockSupernodeKeeperMockRecorder {
 	return m.recorder
 }
 
+// AppealSlashRecord mocks base method.
+func (m *MockSupernodeKeeper) AppealSlashRecord(ctx types1.Context, id uint64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppealSlashRecord", ctx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppealSlashRecord indicates an expected call of AppealSlashRecord.
+func (mr *MockSupernodeKeeperMockRecorder) AppealSlashRecord(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppealSlashRecord", reflect.TypeOf((*MockSupernodeKeeper)(nil).AppealSlashRecord), ctx, id, reason)
+}
+
+// BondSelfStake mocks base method.
+func (m *MockSupernodeKeeper) BondSelfStake(ctx types1.Context, operator types1.AccAddress, amount types1.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondSelfStake", ctx, operator, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BondSelfStake indicates an expected call of BondSelfStake.
+func (mr *MockSupernodeKeeperMockRecorder) BondSelfStake(ctx, operator, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondSelfStake", reflect.TypeOf((*MockSupernodeKeeper)(nil).BondSelfStake), ctx, operator, amount)
+}
+
 // CheckValidatorSupernodeEligibility mocks base method.
 func (m *MockSupernodeKeeper) CheckValidatorSupernodeEligibility(ctx types1.Context, validator types2.ValidatorI, valAddr, supernodeAccount string) error {
 	m.ctrl.T.Helper()
@@ -66,6 +94,18 @@ func (mr *MockSupernodeKeeperMockRecorder) CheckValidatorSupernodeEligibility(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckValidatorSupernodeEligibility", reflect.TypeOf((*MockSupernodeKeeper)(nil).CheckValidatorSupernodeEligibility), ctx, validator, valAddr, supernodeAccount)
 }
 
+// ClearSupernodeJail mocks base method.
+func (m *MockSupernodeKeeper) ClearSupernodeJail(ctx types1.Context, valAddr types1.ValAddress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearSupernodeJail", ctx, valAddr)
+}
+
+// ClearSupernodeJail indicates an expected call of ClearSupernodeJail.
+func (mr *MockSupernodeKeeperMockRecorder) ClearSupernodeJail(ctx, valAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSupernodeJail", reflect.TypeOf((*MockSupernodeKeeper)(nil).ClearSupernodeJail), ctx, valAddr)
+}
+
 // CountEligibleSNs mocks base method.
 func (m *MockSupernodeKeeper) CountEligibleSNs(ctx types1.Context) uint64 {
 	m.ctrl.T.Helper()
@@ -267,6 +307,35 @@ func (mr *MockSupernodeKeeperMockRecorder) GetSNDistState(ctx, valAddr any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSNDistState", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetSNDistState), ctx, valAddr)
 }
 
+// GetSelfStakeUnbondings mocks base method.
+func (m *MockSupernodeKeeper) GetSelfStakeUnbondings(ctx types1.Context, operator types1.AccAddress) []types0.SelfStakeUnbonding {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelfStakeUnbondings", ctx, operator)
+	ret0, _ := ret[0].([]types0.SelfStakeUnbonding)
+	return ret0
+}
+
+// GetSelfStakeUnbondings indicates an expected call of GetSelfStakeUnbondings.
+func (mr *MockSupernodeKeeperMockRecorder) GetSelfStakeUnbondings(ctx, operator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelfStakeUnbondings", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetSelfStakeUnbondings), ctx, operator)
+}
+
+// GetSlashRecord mocks base method.
+func (m *MockSupernodeKeeper) GetSlashRecord(ctx types1.Context, id uint64) (types0.SlashRecord, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlashRecord", ctx, id)
+	ret0, _ := ret[0].(types0.SlashRecord)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSlashRecord indicates an expected call of GetSlashRecord.
+func (mr *MockSupernodeKeeperMockRecorder) GetSlashRecord(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlashRecord", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetSlashRecord), ctx, id)
+}
+
 // GetStakingKeeper mocks base method.
 func (m *MockSupernodeKeeper) GetStakingKeeper() types0.StakingKeeper {
 	m.ctrl.T.Helper()
@@ -318,6 +387,21 @@ func (mr *MockSupernodeKeeperMockRecorder) GetSuperNodesPaginated(ctx, paginatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuperNodesPaginated", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetSuperNodesPaginated), varargs...)
 }
 
+// GetSupernodeJailedUntil mocks base method.
+func (m *MockSupernodeKeeper) GetSupernodeJailedUntil(ctx types1.Context, valAddr types1.ValAddress) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupernodeJailedUntil", ctx, valAddr)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSupernodeJailedUntil indicates an expected call of GetSupernodeJailedUntil.
+func (mr *MockSupernodeKeeperMockRecorder) GetSupernodeJailedUntil(ctx, valAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupernodeJailedUntil", reflect.TypeOf((*MockSupernodeKeeper)(nil).GetSupernodeJailedUntil), ctx, valAddr)
+}
+
 // GetTotalDistributed mocks base method.
 func (m *MockSupernodeKeeper) GetTotalDistributed(ctx types1.Context) types1.Coins {
 	m.ctrl.T.Helper()
@@ -346,32 +430,32 @@ func (mr *MockSupernodeKeeperMockRecorder) IsEligibleAndNotJailedValidator(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEligibleAndNotJailedValidator", reflect.TypeOf((*MockSupernodeKeeper)(nil).IsEligibleAndNotJailedValidator), ctx, valAddr)
 }
 
-// IsSuperNodeActive mocks base method.
-func (m *MockSupernodeKeeper) IsSuperNodeActive(ctx types1.Context, valAddr types1.ValAddress) bool {
+// IsSelfStakeEligible mocks base method.
+func (m *MockSupernodeKeeper) IsSelfStakeEligible(ctx types1.Context, sn types0.SuperNode) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsSuperNodeActive", ctx, valAddr)
+	ret := m.ctrl.Call(m, "IsSelfStakeEligible", ctx, sn)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// IsSuperNodeActive indicates an expected call of IsSuperNodeActive.
-func (mr *MockSupernodeKeeperMockRecorder) IsSuperNodeActive(ctx, valAddr any) *gomock.Call {
+// IsSelfStakeEligible indicates an expected call of IsSelfStakeEligible.
+func (mr *MockSupernodeKeeperMockRecorder) IsSelfStakeEligible(ctx, sn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuperNodeActive", reflect.TypeOf((*MockSupernodeKeeper)(nil).IsSuperNodeActive), ctx, valAddr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSelfStakeEligible", reflect.TypeOf((*MockSupernodeKeeper)(nil).IsSelfStakeEligible), ctx, sn)
 }
 
-// MarkSuperNodeStorageFull mocks base method.
-func (m *MockSupernodeKeeper) MarkSuperNodeStorageFull(ctx types1.Context, valAddr types1.ValAddress) error {
+// IsSuperNodeActive mocks base method.
+func (m *MockSupernodeKeeper) IsSuperNodeActive(ctx types1.Context, valAddr types1.ValAddress) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkSuperNodeStorageFull", ctx, valAddr)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "IsSuperNodeActive", ctx, valAddr)
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// MarkSuperNodeStorageFull indicates an expected call of MarkSuperNodeStorageFull.
-func (mr *MockSupernodeKeeperMockRecorder) MarkSuperNodeStorageFull(ctx, valAddr any) *gomock.Call {
+// IsSuperNodeActive indicates an expected call of IsSuperNodeActive.
+func (mr *MockSupernodeKeeperMockRecorder) IsSuperNodeActive(ctx, valAddr any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSuperNodeStorageFull", reflect.TypeOf((*MockSupernodeKeeper)(nil).MarkSuperNodeStorageFull), ctx, valAddr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuperNodeActive", reflect.TypeOf((*MockSupernodeKeeper)(nil).IsSuperNodeActive), ctx, valAddr)
 }
 
 // Logger mocks base method.
@@ -388,6 +472,20 @@ func (mr *MockSupernodeKeeperMockRecorder) Logger() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logger", reflect.TypeOf((*MockSupernodeKeeper)(nil).Logger))
 }
 
+// MarkSuperNodeStorageFull mocks base method.
+func (m *MockSupernodeKeeper) MarkSuperNodeStorageFull(ctx types1.Context, valAddr types1.ValAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSuperNodeStorageFull", ctx, valAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSuperNodeStorageFull indicates an expected call of MarkSuperNodeStorageFull.
+func (mr *MockSupernodeKeeperMockRecorder) MarkSuperNodeStorageFull(ctx, valAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSuperNodeStorageFull", reflect.TypeOf((*MockSupernodeKeeper)(nil).MarkSuperNodeStorageFull), ctx, valAddr)
+}
+
 // QuerySuperNode mocks base method.
 func (m *MockSupernodeKeeper) QuerySuperNode(ctx types1.Context, valOperAddr types1.ValAddress) (types0.SuperNode, bool) {
 	m.ctrl.T.Helper()
@@ -431,6 +529,35 @@ func (mr *MockSupernodeKeeperMockRecorder) RecoverSuperNodeFromPostponed(ctx, va
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverSuperNodeFromPostponed", reflect.TypeOf((*MockSupernodeKeeper)(nil).RecoverSuperNodeFromPostponed), ctx, valAddr)
 }
 
+// ReportSlashableOffense mocks base method.
+func (m *MockSupernodeKeeper) ReportSlashableOffense(ctx types1.Context, valAddr types1.ValAddress, offense types0.SlashOffense, evidenceRef string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportSlashableOffense", ctx, valAddr, offense, evidenceRef)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSlashableOffense indicates an expected call of ReportSlashableOffense.
+func (mr *MockSupernodeKeeperMockRecorder) ReportSlashableOffense(ctx, valAddr, offense, evidenceRef any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSlashableOffense", reflect.TypeOf((*MockSupernodeKeeper)(nil).ReportSlashableOffense), ctx, valAddr, offense, evidenceRef)
+}
+
+// ResolveSlashRecordAppeal mocks base method.
+func (m *MockSupernodeKeeper) ResolveSlashRecordAppeal(ctx types1.Context, id uint64, uphold bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSlashRecordAppeal", ctx, id, uphold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveSlashRecordAppeal indicates an expected call of ResolveSlashRecordAppeal.
+func (mr *MockSupernodeKeeperMockRecorder) ResolveSlashRecordAppeal(ctx, id, uphold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSlashRecordAppeal", reflect.TypeOf((*MockSupernodeKeeper)(nil).ResolveSlashRecordAppeal), ctx, id, uphold)
+}
+
 // SetLastDistributionHeight mocks base method.
 func (m *MockSupernodeKeeper) SetLastDistributionHeight(ctx types1.Context, height int64) {
 	m.ctrl.T.Helper()
@@ -527,6 +654,65 @@ func (mr *MockSupernodeKeeperMockRecorder) SetSuperNodeStopped(ctx, valAddr, rea
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSuperNodeStopped", reflect.TypeOf((*MockSupernodeKeeper)(nil).SetSuperNodeStopped), ctx, valAddr, reason)
 }
 
+// SlashSelfStake mocks base method.
+func (m *MockSupernodeKeeper) SlashSelfStake(ctx types1.Context, valAddr types1.ValAddress, fraction math.LegacyDec, reason string) (types1.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashSelfStake", ctx, valAddr, fraction, reason)
+	ret0, _ := ret[0].(types1.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashSelfStake indicates an expected call of SlashSelfStake.
+func (mr *MockSupernodeKeeperMockRecorder) SlashSelfStake(ctx, valAddr, fraction, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashSelfStake", reflect.TypeOf((*MockSupernodeKeeper)(nil).SlashSelfStake), ctx, valAddr, fraction, reason)
+}
+
+// StopIneligibleSelfStakedSupernodes mocks base method.
+func (m *MockSupernodeKeeper) StopIneligibleSelfStakedSupernodes(ctx types1.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopIneligibleSelfStakedSupernodes", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopIneligibleSelfStakedSupernodes indicates an expected call of StopIneligibleSelfStakedSupernodes.
+func (mr *MockSupernodeKeeperMockRecorder) StopIneligibleSelfStakedSupernodes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopIneligibleSelfStakedSupernodes", reflect.TypeOf((*MockSupernodeKeeper)(nil).StopIneligibleSelfStakedSupernodes), ctx)
+}
+
+// UnbondSelfStake mocks base method.
+func (m *MockSupernodeKeeper) UnbondSelfStake(ctx types1.Context, operator types1.AccAddress, amount types1.Coin) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondSelfStake", ctx, operator, amount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbondSelfStake indicates an expected call of UnbondSelfStake.
+func (mr *MockSupernodeKeeperMockRecorder) UnbondSelfStake(ctx, operator, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondSelfStake", reflect.TypeOf((*MockSupernodeKeeper)(nil).UnbondSelfStake), ctx, operator, amount)
+}
+
+// UpdateSupernodeCommission mocks base method.
+func (m *MockSupernodeKeeper) UpdateSupernodeCommission(ctx types1.Context, valAddr types1.ValAddress, rate math.LegacyDec, maxRate, maxChangeRate *math.LegacyDec) (types0.SupernodeCommission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSupernodeCommission", ctx, valAddr, rate, maxRate, maxChangeRate)
+	ret0, _ := ret[0].(types0.SupernodeCommission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSupernodeCommission indicates an expected call of UpdateSupernodeCommission.
+func (mr *MockSupernodeKeeperMockRecorder) UpdateSupernodeCommission(ctx, valAddr, rate, maxRate, maxChangeRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSupernodeCommission", reflect.TypeOf((*MockSupernodeKeeper)(nil).UpdateSupernodeCommission), ctx, valAddr, rate, maxRate, maxChangeRate)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -580,6 +766,21 @@ func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, delAddr, valAddr any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), ctx, delAddr, valAddr)
 }
 
+// Unbond mocks base method.
+func (m *MockStakingKeeper) Unbond(ctx context.Context, delAddr types1.AccAddress, valAddr types1.ValAddress, shares math.LegacyDec) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unbond", ctx, delAddr, valAddr, shares)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unbond indicates an expected call of Unbond.
+func (mr *MockStakingKeeperMockRecorder) Unbond(ctx, delAddr, valAddr, shares any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unbond", reflect.TypeOf((*MockStakingKeeper)(nil).Unbond), ctx, delAddr, valAddr, shares)
+}
+
 // Validator mocks base method.
 func (m *MockStakingKeeper) Validator(arg0 context.Context, arg1 types1.ValAddress) (types2.ValidatorI, error) {
 	m.ctrl.T.Helper()
@@ -610,6 +811,44 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// AllocateTokensToValidator mocks base method.
+func (m *MockDistributionKeeper) AllocateTokensToValidator(ctx context.Context, val types2.ValidatorI, tokens types1.DecCoins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateTokensToValidator", ctx, val, tokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllocateTokensToValidator indicates an expected call of AllocateTokensToValidator.
+func (mr *MockDistributionKeeperMockRecorder) AllocateTokensToValidator(ctx, val, tokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateTokensToValidator", reflect.TypeOf((*MockDistributionKeeper)(nil).AllocateTokensToValidator), ctx, val, tokens)
+}
+
 // MockAuditKeeper is a mock of AuditKeeper interface.
 type MockAuditKeeper struct {
 	ctrl     *gomock.Controller
@@ -864,6 +1103,20 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
 }
 
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types1.AccAddress, recipientModule string, amt types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
 // SendCoinsFromModuleToAccount mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types1.AccAddress, amt types1.Coins) error {
 	m.ctrl.T.Helper()
@@ -878,6 +1131,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(arg0 context.Context, arg1 types1.AccAddress) types1.Coins {
 	m.ctrl.T.Helper()
@@ -125,6 +125,26 @@ func (mr *MockQueryClientMockRecorder) GetTopSuperNodesForBlock(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopSuperNodesForBlock", reflect.TypeOf((*MockQueryClient)(nil).GetTopSuperNodesForBlock), varargs...)
 }
 
+// JailStatus mocks base method.
+func (m *MockQueryClient) JailStatus(ctx context.Context, in *types.QueryJailStatusRequest, opts ...grpc.CallOption) (*types.QueryJailStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JailStatus", varargs...)
+	ret0, _ := ret[0].(*types.QueryJailStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JailStatus indicates an expected call of JailStatus.
+func (mr *MockQueryClientMockRecorder) JailStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailStatus", reflect.TypeOf((*MockQueryClient)(nil).JailStatus), varargs...)
+}
+
 // ListSuperNodes mocks base method.
 func (m *MockQueryClient) ListSuperNodes(ctx context.Context, in *types.QueryListSuperNodesRequest, opts ...grpc.CallOption) (*types.QueryListSuperNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -225,6 +245,86 @@ func (mr *MockQueryClientMockRecorder) SNEligibility(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SNEligibility", reflect.TypeOf((*MockQueryClient)(nil).SNEligibility), varargs...)
 }
 
+// SelfStakeUnbondings mocks base method.
+func (m *MockQueryClient) SelfStakeUnbondings(ctx context.Context, in *types.QuerySelfStakeUnbondingsRequest, opts ...grpc.CallOption) (*types.QuerySelfStakeUnbondingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelfStakeUnbondings", varargs...)
+	ret0, _ := ret[0].(*types.QuerySelfStakeUnbondingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelfStakeUnbondings indicates an expected call of SelfStakeUnbondings.
+func (mr *MockQueryClientMockRecorder) SelfStakeUnbondings(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfStakeUnbondings", reflect.TypeOf((*MockQueryClient)(nil).SelfStakeUnbondings), varargs...)
+}
+
+// SlashRecord mocks base method.
+func (m *MockQueryClient) SlashRecord(ctx context.Context, in *types.QuerySlashRecordRequest, opts ...grpc.CallOption) (*types.QuerySlashRecordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SlashRecord", varargs...)
+	ret0, _ := ret[0].(*types.QuerySlashRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashRecord indicates an expected call of SlashRecord.
+func (mr *MockQueryClientMockRecorder) SlashRecord(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashRecord", reflect.TypeOf((*MockQueryClient)(nil).SlashRecord), varargs...)
+}
+
+// SlashRecords mocks base method.
+func (m *MockQueryClient) SlashRecords(ctx context.Context, in *types.QuerySlashRecordsRequest, opts ...grpc.CallOption) (*types.QuerySlashRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SlashRecords", varargs...)
+	ret0, _ := ret[0].(*types.QuerySlashRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashRecords indicates an expected call of SlashRecords.
+func (mr *MockQueryClientMockRecorder) SlashRecords(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashRecords", reflect.TypeOf((*MockQueryClient)(nil).SlashRecords), varargs...)
+}
+
+// SuperNodeEndpoints mocks base method.
+func (m *MockQueryClient) SuperNodeEndpoints(ctx context.Context, in *types.QuerySuperNodeEndpointsRequest, opts ...grpc.CallOption) (*types.QuerySuperNodeEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuperNodeEndpoints", varargs...)
+	ret0, _ := ret[0].(*types.QuerySuperNodeEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuperNodeEndpoints indicates an expected call of SuperNodeEndpoints.
+func (mr *MockQueryClientMockRecorder) SuperNodeEndpoints(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuperNodeEndpoints", reflect.TypeOf((*MockQueryClient)(nil).SuperNodeEndpoints), varargs...)
+}
+
 // MockQueryServer is a mock of QueryServer interface.
 type MockQueryServer struct {
 	ctrl     *gomock.Controller
@@ -309,6 +409,21 @@ func (mr *MockQueryServerMockRecorder) GetTopSuperNodesForBlock(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopSuperNodesForBlock", reflect.TypeOf((*MockQueryServer)(nil).GetTopSuperNodesForBlock), arg0, arg1)
 }
 
+// JailStatus mocks base method.
+func (m *MockQueryServer) JailStatus(arg0 context.Context, arg1 *types.QueryJailStatusRequest) (*types.QueryJailStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryJailStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JailStatus indicates an expected call of JailStatus.
+func (mr *MockQueryServerMockRecorder) JailStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailStatus", reflect.TypeOf((*MockQueryServer)(nil).JailStatus), arg0, arg1)
+}
+
 // ListSuperNodes mocks base method.
 func (m *MockQueryServer) ListSuperNodes(arg0 context.Context, arg1 *types.QueryListSuperNodesRequest) (*types.QueryListSuperNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,3 +498,63 @@ func (mr *MockQueryServerMockRecorder) SNEligibility(arg0, arg1 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SNEligibility", reflect.TypeOf((*MockQueryServer)(nil).SNEligibility), arg0, arg1)
 }
+
+// SelfStakeUnbondings mocks base method.
+func (m *MockQueryServer) SelfStakeUnbondings(arg0 context.Context, arg1 *types.QuerySelfStakeUnbondingsRequest) (*types.QuerySelfStakeUnbondingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfStakeUnbondings", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySelfStakeUnbondingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelfStakeUnbondings indicates an expected call of SelfStakeUnbondings.
+func (mr *MockQueryServerMockRecorder) SelfStakeUnbondings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfStakeUnbondings", reflect.TypeOf((*MockQueryServer)(nil).SelfStakeUnbondings), arg0, arg1)
+}
+
+// SlashRecord mocks base method.
+func (m *MockQueryServer) SlashRecord(arg0 context.Context, arg1 *types.QuerySlashRecordRequest) (*types.QuerySlashRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashRecord", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySlashRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashRecord indicates an expected call of SlashRecord.
+func (mr *MockQueryServerMockRecorder) SlashRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashRecord", reflect.TypeOf((*MockQueryServer)(nil).SlashRecord), arg0, arg1)
+}
+
+// SlashRecords mocks base method.
+func (m *MockQueryServer) SlashRecords(arg0 context.Context, arg1 *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashRecords", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySlashRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashRecords indicates an expected call of SlashRecords.
+func (mr *MockQueryServerMockRecorder) SlashRecords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashRecords", reflect.TypeOf((*MockQueryServer)(nil).SlashRecords), arg0, arg1)
+}
+
+// SuperNodeEndpoints mocks base method.
+func (m *MockQueryServer) SuperNodeEndpoints(arg0 context.Context, arg1 *types.QuerySuperNodeEndpointsRequest) (*types.QuerySuperNodeEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuperNodeEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySuperNodeEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuperNodeEndpoints indicates an expected call of SuperNodeEndpoints.
+func (mr *MockQueryServerMockRecorder) SuperNodeEndpoints(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuperNodeEndpoints", reflect.TypeOf((*MockQueryServer)(nil).SuperNodeEndpoints), arg0, arg1)
+}
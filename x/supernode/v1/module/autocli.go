@@ -91,6 +91,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "GetMetrics",
 					Skip:      true, // custom command to avoid AutoCLI aminojson float64 marshal bug
 				},
+				{
+					RpcMethod:      "SelfStakeUnbondings",
+					Use:            "self-stake-unbondings [operator-account]",
+					Short:          "Query pending self-stake unbondings of an independent supernode operator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operator_account"}},
+				},
+				{
+					RpcMethod:      "SuperNodeEndpoints",
+					Use:            "endpoints [validator-address]",
+					Short:          "Query the advertised endpoints and endpoint history of a supernode",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "SlashRecord",
+					Use:            "slash-record [slash-id]",
+					Short:          "Query a supernode slash record by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "slash_id"}},
+				},
+				{
+					RpcMethod:      "SlashRecords",
+					Use:            "slash-records [validator-address]",
+					Short:          "Query slash records of a supernode validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "JailStatus",
+					Use:            "jail-status [validator-address]",
+					Short:          "Query whether a supernode is jailed and until which height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -149,6 +179,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Usage:        "Optional P2P port for supernode communication",
 							DefaultValue: types.DefaultP2PPort,
 						},
+						"endpoints": {
+							Name:  "endpoints",
+							Usage: "Optional JSON endpoint, e.g. '{\"address_type\":\"ENDPOINT_ADDRESS_TYPE_IPV6\",\"address\":\"2001:db8::1\",\"protocol\":\"ENDPOINT_PROTOCOL_TCP\",\"port\":4445,\"purpose\":\"ENDPOINT_PURPOSE_P2P\"}'; repeat to replace the full endpoint set",
+						},
+						"clear_endpoints": {
+							Name:  "clear-endpoints",
+							Usage: "Remove the advertised endpoint set; cannot be combined with --endpoints",
+						},
 					},
 				},
 				{
@@ -157,6 +195,52 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Report structured metrics for a supernode",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
 				},
+				{
+					RpcMethod: "RegisterIndependentSupernode",
+					Use:       "register-independent-supernode [ip-address] [supernode-account] [self-stake]",
+					Short:     "Register a supernode backed by self-stake instead of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ipAddress"},
+						{ProtoField: "supernodeAccount"},
+						{ProtoField: "self_stake"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"p2p_port": {
+							Name:         "p2p-port",
+							Usage:        "Optional P2P port for the supernode communication",
+							DefaultValue: types.DefaultP2PPort,
+						},
+					},
+				},
+				{
+					RpcMethod:      "BondSupernodeStake",
+					Use:            "bond-supernode-stake [amount]",
+					Short:          "Add self-stake to an independent supernode",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "UnbondSupernodeStake",
+					Use:            "unbond-supernode-stake [amount]",
+					Short:          "Start unbonding self-stake from an independent supernode",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "AppealSlash",
+					Use:            "appeal-slash [slash-id] [reason]",
+					Short:          "Appeal a pending supernode slash before its appeal deadline",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "slash_id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod: "ResolveSlashAppeal",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "SetSupernodeCommission",
+					Use:            "set-supernode-commission [validator-address] [rate]",
+					Short:          "Set the share of Everlight payouts a supernode keeps before sharing with delegators",
+					Long:           "Set the commission rate of a validator-backed supernode. --max-rate and --max-change-rate are required the first time and cannot be changed afterwards.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "rate"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
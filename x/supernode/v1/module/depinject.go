@@ -39,10 +39,11 @@ type ModuleInputs struct {
 	Config       *Module
 	Logger       log.Logger
 
-	AccountKeeper  types.AccountKeeper
-	BankKeeper     types.BankKeeper
-	StakingKeeper  types.StakingKeeper
-	SlashingKeeper types.SlashingKeeper
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	StakingKeeper      types.StakingKeeper
+	SlashingKeeper     types.SlashingKeeper
+	DistributionKeeper types.DistributionKeeper
 }
 
 type ModuleOutputs struct {
@@ -69,6 +70,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountKeeper,
 		in.SlashingKeeper,
 		nil,
+		in.DistributionKeeper,
 	)
 
 	m := NewAppModule(
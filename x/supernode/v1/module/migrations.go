@@ -0,0 +1,17 @@
+package supernode
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/keeper"
+)
+
+// NewMigrateV1ToV2 returns the v1→v2 module migration handler.
+// v2 moves the Everlight distribution pipeline to fixed-point arithmetic; the
+// handler converts stored float64 distribution state and payout history rows
+// to LegacyDec.
+func NewMigrateV1ToV2(k keeper.Keeper) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		return k.MigrateDistributionStateToFixedPoint(ctx)
+	}
+}
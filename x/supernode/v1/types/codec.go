@@ -33,6 +33,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgReportSupernodeMetrics{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterIndependentSupernode{},
+		&MsgBondSupernodeStake{},
+		&MsgUnbondSupernodeStake{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAppealSlash{},
+		&MsgResolveSlashAppeal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetSupernodeCommission{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,237 @@
+package types
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxSupernodeEndpoints bounds the number of endpoints a supernode may advertise.
+	MaxSupernodeEndpoints = 16
+	// MaxEndpointHistory bounds the number of removed endpoints kept per supernode.
+	MaxEndpointHistory = 64
+
+	maxDNSNameLength  = 253
+	maxDNSLabelLength = 63
+)
+
+// DetectEndpointAddressType classifies an address as an IPv4 literal, an IPv6
+// literal or a DNS name. It returns ENDPOINT_ADDRESS_TYPE_UNSPECIFIED when the
+// address is none of those.
+func DetectEndpointAddressType(address string) EndpointAddressType {
+	if addr, err := netip.ParseAddr(address); err == nil {
+		if addr.Is4() {
+			return EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4
+		}
+		return EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6
+	}
+	if validateDNSName(address) == nil {
+		return EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS
+	}
+	return EndpointAddressType_ENDPOINT_ADDRESS_TYPE_UNSPECIFIED
+}
+
+// Validate checks a single endpoint against its declared address type.
+func (e *SupernodeEndpoint) Validate() error {
+	if e == nil {
+		return errorsmod.Wrap(ErrInvalidEndpoint, "nil endpoint")
+	}
+
+	switch e.AddressType {
+	case EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4, EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6:
+		addr, err := netip.ParseAddr(e.Address)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "invalid IP address %q", e.Address)
+		}
+		if e.AddressType == EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4 && !addr.Is4() {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "%q is not an IPv4 address", e.Address)
+		}
+		if e.AddressType == EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6 && (!addr.Is6() || addr.Is4In6()) {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "%q is not an IPv6 address", e.Address)
+		}
+		if addr.Zone() != "" {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "zoned address %q is not routable", e.Address)
+		}
+		if addr.IsUnspecified() || addr.IsMulticast() {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "address %q cannot be advertised", e.Address)
+		}
+	case EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS:
+		if err := validateDNSName(e.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "invalid DNS name %q: %s", e.Address, err)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "unsupported address type %s", e.AddressType)
+	}
+
+	if _, ok := EndpointProtocol_name[int32(e.Protocol)]; !ok || e.Protocol == EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "unsupported protocol %s", e.Protocol)
+	}
+	if _, ok := EndpointPurpose_name[int32(e.Purpose)]; !ok || e.Purpose == EndpointPurpose_ENDPOINT_PURPOSE_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "unsupported purpose %s", e.Purpose)
+	}
+	if e.Port == 0 || e.Port > 65535 {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "port %d out of range", e.Port)
+	}
+
+	return nil
+}
+
+// Key identifies an endpoint within a supernode's endpoint set. Two endpoints
+// with the same key are considered the same endpoint across updates.
+func (e SupernodeEndpoint) Key() string {
+	return fmt.Sprintf("%s|%s|%d|%s", canonicalEndpointAddress(e.Address), e.Protocol, e.Port, e.Purpose)
+}
+
+// ValidateEndpoints validates an advertised endpoint set: every endpoint must
+// be valid, the set is bounded, and no endpoint may be listed twice.
+func ValidateEndpoints(endpoints []*SupernodeEndpoint) error {
+	if len(endpoints) > MaxSupernodeEndpoints {
+		return errorsmod.Wrapf(ErrInvalidEndpoint, "too many endpoints: %d > %d", len(endpoints), MaxSupernodeEndpoints)
+	}
+
+	seen := make(map[string]struct{}, len(endpoints))
+	for _, e := range endpoints {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		key := e.Key()
+		if _, dup := seen[key]; dup {
+			return errorsmod.Wrapf(ErrInvalidEndpoint, "duplicate endpoint %s:%d", e.Address, e.Port)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// EffectiveEndpoints returns the endpoints a supernode can be reached at. A
+// supernode that has never advertised endpoints is represented by a single
+// P2P endpoint built from its latest IP address and p2p port.
+func (s *SuperNode) EffectiveEndpoints() []SupernodeEndpoint {
+	if len(s.Endpoints) > 0 {
+		out := make([]SupernodeEndpoint, 0, len(s.Endpoints))
+		for _, e := range s.Endpoints {
+			if e != nil {
+				out = append(out, *e)
+			}
+		}
+		return out
+	}
+
+	if len(s.PrevIpAddresses) == 0 {
+		return nil
+	}
+	latest := s.PrevIpAddresses[len(s.PrevIpAddresses)-1]
+	addrType := DetectEndpointAddressType(latest.Address)
+	if addrType == EndpointAddressType_ENDPOINT_ADDRESS_TYPE_UNSPECIFIED {
+		return nil
+	}
+
+	p2pPort := s.P2PPort
+	if p2pPort == "" {
+		p2pPort = DefaultP2PPort
+	}
+	port, err := strconv.ParseUint(p2pPort, 10, 16)
+	if err != nil || port == 0 {
+		return nil
+	}
+
+	return []SupernodeEndpoint{{
+		AddressType: addrType,
+		Address:     latest.Address,
+		Protocol:    EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+		Port:        uint32(port),
+		Purpose:     EndpointPurpose_ENDPOINT_PURPOSE_P2P,
+		AddedHeight: latest.Height,
+	}}
+}
+
+// ApplyEndpoints replaces the advertised endpoint set. Endpoints kept across
+// the update retain their added_height, new ones are stamped with height, and
+// dropped ones are appended to the endpoint history. It reports whether the
+// set changed. The endpoints must already have passed ValidateEndpoints.
+func (s *SuperNode) ApplyEndpoints(endpoints []*SupernodeEndpoint, height int64) bool {
+	current := make(map[string]*SupernodeEndpoint, len(s.Endpoints))
+	for _, e := range s.Endpoints {
+		current[e.Key()] = e
+	}
+
+	next := make([]*SupernodeEndpoint, 0, len(endpoints))
+	kept := make(map[string]struct{}, len(endpoints))
+	changed := len(endpoints) != len(s.Endpoints)
+	for _, e := range endpoints {
+		ep := *e
+		ep.Address = canonicalEndpointAddress(ep.Address)
+		key := ep.Key()
+		if existing, ok := current[key]; ok {
+			ep.AddedHeight = existing.AddedHeight
+		} else {
+			ep.AddedHeight = height
+			changed = true
+		}
+		kept[key] = struct{}{}
+		next = append(next, &ep)
+	}
+
+	for _, e := range s.Endpoints {
+		if _, ok := kept[e.Key()]; ok {
+			continue
+		}
+		changed = true
+		s.EndpointHistory = append(s.EndpointHistory, &EndpointHistory{
+			Endpoint:      e,
+			RemovedHeight: height,
+		})
+	}
+	if over := len(s.EndpointHistory) - MaxEndpointHistory; over > 0 {
+		s.EndpointHistory = append([]*EndpointHistory(nil), s.EndpointHistory[over:]...)
+	}
+
+	s.Endpoints = next
+	return changed
+}
+
+// canonicalEndpointAddress lower-cases DNS names and rewrites IP literals in
+// their canonical textual form so equal endpoints compare equal.
+func canonicalEndpointAddress(address string) string {
+	if addr, err := netip.ParseAddr(address); err == nil {
+		return addr.String()
+	}
+	return strings.ToLower(address)
+}
+
+func validateDNSName(name string) error {
+	if name == "" || len(name) > maxDNSNameLength {
+		return fmt.Errorf("length must be between 1 and %d", maxDNSNameLength)
+	}
+	if _, err := netip.ParseAddr(name); err == nil {
+		return fmt.Errorf("IP literal is not a DNS name")
+	}
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("must be a fully qualified name")
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > maxDNSLabelLength {
+			return fmt.Errorf("label length must be between 1 and %d", maxDNSLabelLength)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("label %q cannot start or end with a hyphen", label)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return fmt.Errorf("label %q contains invalid character %q", label, c)
+			}
+		}
+	}
+	if _, err := strconv.Atoi(labels[len(labels)-1]); err == nil {
+		return fmt.Errorf("top-level label cannot be numeric")
+	}
+
+	return nil
+}
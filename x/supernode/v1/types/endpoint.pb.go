@@ -0,0 +1,826 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lumera/supernode/v1/endpoint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EndpointAddressType identifies how an endpoint address is encoded.
+type EndpointAddressType int32
+
+const (
+	EndpointAddressType_ENDPOINT_ADDRESS_TYPE_UNSPECIFIED EndpointAddressType = 0
+	EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4        EndpointAddressType = 1
+	EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6        EndpointAddressType = 2
+	EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS         EndpointAddressType = 3
+)
+
+var EndpointAddressType_name = map[int32]string{
+	0: "ENDPOINT_ADDRESS_TYPE_UNSPECIFIED",
+	1: "ENDPOINT_ADDRESS_TYPE_IPV4",
+	2: "ENDPOINT_ADDRESS_TYPE_IPV6",
+	3: "ENDPOINT_ADDRESS_TYPE_DNS",
+}
+
+var EndpointAddressType_value = map[string]int32{
+	"ENDPOINT_ADDRESS_TYPE_UNSPECIFIED": 0,
+	"ENDPOINT_ADDRESS_TYPE_IPV4":        1,
+	"ENDPOINT_ADDRESS_TYPE_IPV6":        2,
+	"ENDPOINT_ADDRESS_TYPE_DNS":         3,
+}
+
+func (x EndpointAddressType) String() string {
+	return proto.EnumName(EndpointAddressType_name, int32(x))
+}
+
+func (EndpointAddressType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49fec2f200d9fd87, []int{0}
+}
+
+// EndpointProtocol is the transport protocol an endpoint listens on.
+type EndpointProtocol int32
+
+const (
+	EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED EndpointProtocol = 0
+	EndpointProtocol_ENDPOINT_PROTOCOL_TCP         EndpointProtocol = 1
+	EndpointProtocol_ENDPOINT_PROTOCOL_UDP         EndpointProtocol = 2
+)
+
+var EndpointProtocol_name = map[int32]string{
+	0: "ENDPOINT_PROTOCOL_UNSPECIFIED",
+	1: "ENDPOINT_PROTOCOL_TCP",
+	2: "ENDPOINT_PROTOCOL_UDP",
+}
+
+var EndpointProtocol_value = map[string]int32{
+	"ENDPOINT_PROTOCOL_UNSPECIFIED": 0,
+	"ENDPOINT_PROTOCOL_TCP":         1,
+	"ENDPOINT_PROTOCOL_UDP":         2,
+}
+
+func (x EndpointProtocol) String() string {
+	return proto.EnumName(EndpointProtocol_name, int32(x))
+}
+
+func (EndpointProtocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49fec2f200d9fd87, []int{1}
+}
+
+// EndpointPurpose is the service a supernode exposes on an endpoint.
+type EndpointPurpose int32
+
+const (
+	EndpointPurpose_ENDPOINT_PURPOSE_UNSPECIFIED  EndpointPurpose = 0
+	EndpointPurpose_ENDPOINT_PURPOSE_P2P          EndpointPurpose = 1
+	EndpointPurpose_ENDPOINT_PURPOSE_GRPC         EndpointPurpose = 2
+	EndpointPurpose_ENDPOINT_PURPOSE_HTTP_GATEWAY EndpointPurpose = 3
+)
+
+var EndpointPurpose_name = map[int32]string{
+	0: "ENDPOINT_PURPOSE_UNSPECIFIED",
+	1: "ENDPOINT_PURPOSE_P2P",
+	2: "ENDPOINT_PURPOSE_GRPC",
+	3: "ENDPOINT_PURPOSE_HTTP_GATEWAY",
+}
+
+var EndpointPurpose_value = map[string]int32{
+	"ENDPOINT_PURPOSE_UNSPECIFIED":  0,
+	"ENDPOINT_PURPOSE_P2P":          1,
+	"ENDPOINT_PURPOSE_GRPC":         2,
+	"ENDPOINT_PURPOSE_HTTP_GATEWAY": 3,
+}
+
+func (x EndpointPurpose) String() string {
+	return proto.EnumName(EndpointPurpose_name, int32(x))
+}
+
+func (EndpointPurpose) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49fec2f200d9fd87, []int{2}
+}
+
+// SupernodeEndpoint is one network endpoint advertised by a supernode.
+type SupernodeEndpoint struct {
+	AddressType EndpointAddressType `protobuf:"varint,1,opt,name=address_type,json=addressType,proto3,enum=lumera.supernode.v1.EndpointAddressType" json:"address_type,omitempty"`
+	// address is an IPv4 literal, an IPv6 literal (without brackets) or a DNS
+	// name, according to address_type.
+	Address  string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Protocol EndpointProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=lumera.supernode.v1.EndpointProtocol" json:"protocol,omitempty"`
+	Port     uint32           `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Purpose  EndpointPurpose  `protobuf:"varint,5,opt,name=purpose,proto3,enum=lumera.supernode.v1.EndpointPurpose" json:"purpose,omitempty"`
+	// added_height is the block height at which the endpoint was first advertised.
+	AddedHeight int64 `protobuf:"varint,6,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+}
+
+func (m *SupernodeEndpoint) Reset()         { *m = SupernodeEndpoint{} }
+func (m *SupernodeEndpoint) String() string { return proto.CompactTextString(m) }
+func (*SupernodeEndpoint) ProtoMessage()    {}
+func (*SupernodeEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49fec2f200d9fd87, []int{0}
+}
+func (m *SupernodeEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupernodeEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupernodeEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupernodeEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupernodeEndpoint.Merge(m, src)
+}
+func (m *SupernodeEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupernodeEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupernodeEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupernodeEndpoint proto.InternalMessageInfo
+
+func (m *SupernodeEndpoint) GetAddressType() EndpointAddressType {
+	if m != nil {
+		return m.AddressType
+	}
+	return EndpointAddressType_ENDPOINT_ADDRESS_TYPE_UNSPECIFIED
+}
+
+func (m *SupernodeEndpoint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SupernodeEndpoint) GetProtocol() EndpointProtocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED
+}
+
+func (m *SupernodeEndpoint) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *SupernodeEndpoint) GetPurpose() EndpointPurpose {
+	if m != nil {
+		return m.Purpose
+	}
+	return EndpointPurpose_ENDPOINT_PURPOSE_UNSPECIFIED
+}
+
+func (m *SupernodeEndpoint) GetAddedHeight() int64 {
+	if m != nil {
+		return m.AddedHeight
+	}
+	return 0
+}
+
+// EndpointHistory records an endpoint that is no longer advertised.
+type EndpointHistory struct {
+	Endpoint      *SupernodeEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	RemovedHeight int64              `protobuf:"varint,2,opt,name=removed_height,json=removedHeight,proto3" json:"removed_height,omitempty"`
+}
+
+func (m *EndpointHistory) Reset()         { *m = EndpointHistory{} }
+func (m *EndpointHistory) String() string { return proto.CompactTextString(m) }
+func (*EndpointHistory) ProtoMessage()    {}
+func (*EndpointHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49fec2f200d9fd87, []int{1}
+}
+func (m *EndpointHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndpointHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndpointHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndpointHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointHistory.Merge(m, src)
+}
+func (m *EndpointHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndpointHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointHistory proto.InternalMessageInfo
+
+func (m *EndpointHistory) GetEndpoint() *SupernodeEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (m *EndpointHistory) GetRemovedHeight() int64 {
+	if m != nil {
+		return m.RemovedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("lumera.supernode.v1.EndpointAddressType", EndpointAddressType_name, EndpointAddressType_value)
+	proto.RegisterEnum("lumera.supernode.v1.EndpointProtocol", EndpointProtocol_name, EndpointProtocol_value)
+	proto.RegisterEnum("lumera.supernode.v1.EndpointPurpose", EndpointPurpose_name, EndpointPurpose_value)
+	proto.RegisterType((*SupernodeEndpoint)(nil), "lumera.supernode.v1.SupernodeEndpoint")
+	proto.RegisterType((*EndpointHistory)(nil), "lumera.supernode.v1.EndpointHistory")
+}
+
+func init() {
+	proto.RegisterFile("lumera/supernode/v1/endpoint.proto", fileDescriptor_49fec2f200d9fd87)
+}
+
+var fileDescriptor_49fec2f200d9fd87 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0x3a, 0xa5, 0x2d, 0x53, 0xda, 0x2e, 0xdb, 0x22, 0xb9, 0x88, 0x5a, 0x4e, 0x44, 0x90,
+	0x95, 0x83, 0xa3, 0x06, 0xc4, 0x11, 0xc9, 0x8d, 0x4d, 0x13, 0x81, 0xe2, 0xd5, 0xda, 0x01, 0x95,
+	0x8b, 0x15, 0xf0, 0x8a, 0x46, 0xb4, 0x59, 0xcb, 0x76, 0x22, 0x22, 0xf1, 0x07, 0x5c, 0xb8, 0xf3,
+	0x1b, 0x7c, 0x04, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0x47, 0x10, 0x1b, 0x3b, 0x51, 0x13, 0x2b, 0x37,
+	0xcf, 0xbc, 0xf7, 0xe6, 0xed, 0xbc, 0xf5, 0x42, 0xf5, 0x7a, 0x74, 0xc3, 0xe3, 0x7e, 0x23, 0x19,
+	0x45, 0x3c, 0x1e, 0x8a, 0x90, 0x37, 0xc6, 0x67, 0x0d, 0x3e, 0x0c, 0x23, 0x31, 0x18, 0xa6, 0x66,
+	0x14, 0x8b, 0x54, 0x90, 0xa3, 0x39, 0xc7, 0x5c, 0x70, 0xcc, 0xf1, 0x59, 0xf5, 0x97, 0x02, 0x0f,
+	0xbd, 0xbc, 0xe1, 0x64, 0x02, 0xf2, 0x06, 0x1e, 0xf4, 0xc3, 0x30, 0xe6, 0x49, 0x12, 0xa4, 0x93,
+	0x88, 0xab, 0x48, 0x47, 0xc6, 0x41, 0xd3, 0x30, 0x0b, 0x26, 0x98, 0xb9, 0xc8, 0x9a, 0x0b, 0xfc,
+	0x49, 0xc4, 0xd9, 0x5e, 0x7f, 0x59, 0x10, 0x15, 0x76, 0xb2, 0x52, 0x55, 0x74, 0x64, 0xdc, 0x67,
+	0x79, 0x49, 0x2c, 0xd8, 0x95, 0x47, 0xfb, 0x24, 0xae, 0xd5, 0xb2, 0xb4, 0xa8, 0x6d, 0xb4, 0xa0,
+	0x19, 0x99, 0x2d, 0x64, 0x84, 0xc0, 0x56, 0x24, 0xe2, 0x54, 0xdd, 0xd2, 0x91, 0xb1, 0xcf, 0xe4,
+	0x37, 0x79, 0x05, 0x3b, 0xd1, 0x28, 0x8e, 0x44, 0xc2, 0xd5, 0x7b, 0x72, 0xea, 0xd3, 0xcd, 0x53,
+	0xe7, 0x5c, 0x96, 0x8b, 0x48, 0x45, 0x6e, 0xcf, 0xc3, 0xe0, 0x8a, 0x0f, 0x3e, 0x5f, 0xa5, 0xea,
+	0xb6, 0x8e, 0x8c, 0xb2, 0xdc, 0x89, 0x87, 0x6d, 0xd9, 0xaa, 0x7e, 0x83, 0xc3, 0x5c, 0xde, 0x1e,
+	0x24, 0xa9, 0x88, 0x27, 0xe4, 0x1c, 0x76, 0xf3, 0xc0, 0x65, 0x5e, 0x7b, 0xcd, 0x67, 0x85, 0xb6,
+	0x6b, 0x69, 0xb3, 0x85, 0x8e, 0xd4, 0xe0, 0x20, 0xe6, 0x37, 0x62, 0xbc, 0xf4, 0x56, 0xa4, 0xf7,
+	0x7e, 0xd6, 0x9d, 0xbb, 0xd7, 0x7f, 0x22, 0x38, 0x2a, 0x88, 0x9d, 0xd4, 0xa0, 0xe2, 0x74, 0x6d,
+	0xea, 0x76, 0xba, 0x7e, 0x60, 0xd9, 0x36, 0x73, 0x3c, 0x2f, 0xf0, 0x2f, 0xa9, 0x13, 0xf4, 0xba,
+	0x1e, 0x75, 0x5a, 0x9d, 0xd7, 0x1d, 0xc7, 0xc6, 0x25, 0xa2, 0xc1, 0xe3, 0x62, 0x5a, 0x87, 0xbe,
+	0x7b, 0x81, 0xd1, 0x46, 0xfc, 0x25, 0x56, 0xc8, 0x29, 0x9c, 0x14, 0xe3, 0x76, 0xd7, 0xc3, 0xe5,
+	0xfa, 0x17, 0xc0, 0xab, 0x17, 0x46, 0x2a, 0x70, 0xba, 0x90, 0x50, 0xe6, 0xfa, 0x6e, 0xcb, 0x7d,
+	0xbb, 0x72, 0xaa, 0x13, 0x78, 0xb4, 0x4e, 0xf1, 0x5b, 0x14, 0xa3, 0x62, 0xa8, 0x67, 0x53, 0xac,
+	0xd4, 0xbf, 0x23, 0x38, 0x5c, 0xb9, 0x48, 0xa2, 0xc3, 0x93, 0x25, 0xbd, 0xc7, 0xa8, 0xeb, 0xad,
+	0x26, 0xa0, 0xc2, 0xf1, 0x1a, 0x83, 0x36, 0xd7, 0xac, 0x32, 0xe4, 0x82, 0xd1, 0x16, 0x56, 0xee,
+	0xee, 0x90, 0x41, 0x6d, 0xdf, 0xa7, 0xc1, 0x85, 0xe5, 0x3b, 0xef, 0xad, 0x4b, 0x5c, 0x3e, 0x37,
+	0x7f, 0x4f, 0x35, 0x74, 0x3b, 0xd5, 0xd0, 0xdf, 0xa9, 0x86, 0x7e, 0xcc, 0xb4, 0xd2, 0xed, 0x4c,
+	0x2b, 0xfd, 0x99, 0x69, 0xa5, 0x0f, 0xc7, 0x5f, 0xef, 0xbe, 0xcd, 0xff, 0xcf, 0x2a, 0xf9, 0xb8,
+	0x2d, 0xff, 0xe3, 0xe7, 0xff, 0x06, 0x00, 0x35, 0xeb, 0x63, 0x7c, 0xbf, 0x03, 0x00, 0x00,
+}
+
+func (m *SupernodeEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupernodeEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupernodeEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedHeight != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.AddedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Purpose != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.Purpose))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Port != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Protocol != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.Protocol))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEndpoint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AddressType != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.AddressType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndpointHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndpointHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndpointHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemovedHeight != 0 {
+		i = encodeVarintEndpoint(dAtA, i, uint64(m.RemovedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Endpoint != nil {
+		{
+			size, err := m.Endpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEndpoint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEndpoint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SupernodeEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddressType != 0 {
+		n += 1 + sovEndpoint(uint64(m.AddressType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEndpoint(uint64(l))
+	}
+	if m.Protocol != 0 {
+		n += 1 + sovEndpoint(uint64(m.Protocol))
+	}
+	if m.Port != 0 {
+		n += 1 + sovEndpoint(uint64(m.Port))
+	}
+	if m.Purpose != 0 {
+		n += 1 + sovEndpoint(uint64(m.Purpose))
+	}
+	if m.AddedHeight != 0 {
+		n += 1 + sovEndpoint(uint64(m.AddedHeight))
+	}
+	return n
+}
+
+func (m *EndpointHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Endpoint != nil {
+		l = m.Endpoint.Size()
+		n += 1 + l + sovEndpoint(uint64(l))
+	}
+	if m.RemovedHeight != 0 {
+		n += 1 + sovEndpoint(uint64(m.RemovedHeight))
+	}
+	return n
+}
+
+func sovEndpoint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEndpoint(x uint64) (n int) {
+	return sovEndpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SupernodeEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupernodeEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupernodeEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressType", wireType)
+			}
+			m.AddressType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressType |= EndpointAddressType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= EndpointProtocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			m.Purpose = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Purpose |= EndpointPurpose(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedHeight", wireType)
+			}
+			m.AddedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndpointHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndpointHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndpointHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Endpoint == nil {
+				m.Endpoint = &SupernodeEndpoint{}
+			}
+			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedHeight", wireType)
+			}
+			m.RemovedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEndpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEndpoint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEndpoint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEndpoint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEndpoint
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEndpoint
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEndpoint
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEndpoint        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEndpoint          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEndpoint = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
+)
+
+func tcpEndpoint(addrType types.EndpointAddressType, address string, port uint32, purpose types.EndpointPurpose) *types.SupernodeEndpoint {
+	return &types.SupernodeEndpoint{
+		AddressType: addrType,
+		Address:     address,
+		Protocol:    types.EndpointProtocol_ENDPOINT_PROTOCOL_TCP,
+		Port:        port,
+		Purpose:     purpose,
+	}
+}
+
+func TestSupernodeEndpointValidate(t *testing.T) {
+	const (
+		v4  = types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4
+		v6  = types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6
+		dns = types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS
+		p2p = types.EndpointPurpose_ENDPOINT_PURPOSE_P2P
+	)
+
+	testCases := []struct {
+		name     string
+		endpoint *types.SupernodeEndpoint
+		valid    bool
+	}{
+		{"ipv4", tcpEndpoint(v4, "192.168.1.10", 4445, p2p), true},
+		{"ipv6", tcpEndpoint(v6, "2001:db8::1", 4445, p2p), true},
+		{"dns", tcpEndpoint(dns, "sn1.example.org", 4445, p2p), true},
+		{"ipv6 declared as ipv4", tcpEndpoint(v4, "2001:db8::1", 4445, p2p), false},
+		{"ipv4 declared as ipv6", tcpEndpoint(v6, "192.168.1.10", 4445, p2p), false},
+		{"ipv4-mapped ipv6", tcpEndpoint(v6, "::ffff:192.168.1.10", 4445, p2p), false},
+		{"zoned ipv6", tcpEndpoint(v6, "fe80::1%eth0", 4445, p2p), false},
+		{"unspecified address", tcpEndpoint(v4, "0.0.0.0", 4445, p2p), false},
+		{"multicast address", tcpEndpoint(v6, "ff02::1", 4445, p2p), false},
+		{"dns ip literal", tcpEndpoint(dns, "10.0.0.1", 4445, p2p), false},
+		{"dns single label", tcpEndpoint(dns, "localhost", 4445, p2p), false},
+		{"dns bad label", tcpEndpoint(dns, "-sn.example.org", 4445, p2p), false},
+		{"dns numeric tld", tcpEndpoint(dns, "sn.example.123", 4445, p2p), false},
+		{"zero port", tcpEndpoint(v4, "192.168.1.10", 0, p2p), false},
+		{"port out of range", tcpEndpoint(v4, "192.168.1.10", 70000, p2p), false},
+		{"unspecified address type", tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_UNSPECIFIED, "192.168.1.10", 4445, p2p), false},
+		{"unspecified purpose", tcpEndpoint(v4, "192.168.1.10", 4445, types.EndpointPurpose_ENDPOINT_PURPOSE_UNSPECIFIED), false},
+		{"unspecified protocol", &types.SupernodeEndpoint{AddressType: v4, Address: "192.168.1.10", Port: 4445, Purpose: p2p}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.endpoint.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidEndpoint)
+			}
+		})
+	}
+}
+
+func TestValidateEndpoints(t *testing.T) {
+	v4 := tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4, "192.168.1.10", 4445, types.EndpointPurpose_ENDPOINT_PURPOSE_P2P)
+	v6 := tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6, "2001:db8::1", 4445, types.EndpointPurpose_ENDPOINT_PURPOSE_P2P)
+	v6Expanded := tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6, "2001:0db8:0:0:0:0:0:1", 4445, types.EndpointPurpose_ENDPOINT_PURPOSE_P2P)
+
+	require.NoError(t, types.ValidateEndpoints(nil))
+	require.NoError(t, types.ValidateEndpoints([]*types.SupernodeEndpoint{v4, v6}))
+	require.ErrorIs(t, types.ValidateEndpoints([]*types.SupernodeEndpoint{v6, v6Expanded}), types.ErrInvalidEndpoint)
+
+	tooMany := make([]*types.SupernodeEndpoint, 0, types.MaxSupernodeEndpoints+1)
+	for i := 0; i <= types.MaxSupernodeEndpoints; i++ {
+		tooMany = append(tooMany, tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4, "192.168.1.10", uint32(4000+i), types.EndpointPurpose_ENDPOINT_PURPOSE_GRPC))
+	}
+	require.ErrorIs(t, types.ValidateEndpoints(tooMany), types.ErrInvalidEndpoint)
+}
+
+func TestSuperNodeApplyEndpoints(t *testing.T) {
+	v4 := tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV4, "192.168.1.10", 4445, types.EndpointPurpose_ENDPOINT_PURPOSE_P2P)
+	v6 := tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6, "2001:0db8::0001", 4445, types.EndpointPurpose_ENDPOINT_PURPOSE_P2P)
+	grpc := tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS, "SN1.Example.org", 4444, types.EndpointPurpose_ENDPOINT_PURPOSE_GRPC)
+
+	var sn types.SuperNode
+	require.True(t, sn.ApplyEndpoints([]*types.SupernodeEndpoint{v4, v6}, 10))
+	require.Len(t, sn.Endpoints, 2)
+	require.Equal(t, "2001:db8::1", sn.Endpoints[1].Address)
+	require.Equal(t, int64(10), sn.Endpoints[1].AddedHeight)
+
+	require.False(t, sn.ApplyEndpoints([]*types.SupernodeEndpoint{v4, v6}, 20))
+
+	// Dropping IPv4 moves it to the history; the kept IPv6 endpoint retains its height.
+	require.True(t, sn.ApplyEndpoints([]*types.SupernodeEndpoint{v6, grpc}, 30))
+	require.Len(t, sn.Endpoints, 2)
+	require.Equal(t, int64(10), sn.Endpoints[0].AddedHeight)
+	require.Equal(t, "sn1.example.org", sn.Endpoints[1].Address)
+	require.Equal(t, int64(30), sn.Endpoints[1].AddedHeight)
+	require.Len(t, sn.EndpointHistory, 1)
+	require.Equal(t, "192.168.1.10", sn.EndpointHistory[0].Endpoint.Address)
+	require.Equal(t, int64(10), sn.EndpointHistory[0].Endpoint.AddedHeight)
+	require.Equal(t, int64(30), sn.EndpointHistory[0].RemovedHeight)
+}
+
+func TestSuperNodeEffectiveEndpoints(t *testing.T) {
+	sn := types.SuperNode{
+		PrevIpAddresses: []*types.IPAddressHistory{{Address: "10.0.0.1", Height: 1}, {Address: "2001:db8::5", Height: 7}},
+		P2PPort:         "26657",
+	}
+
+	legacy := sn.EffectiveEndpoints()
+	require.Len(t, legacy, 1)
+	require.Equal(t, types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_IPV6, legacy[0].AddressType)
+	require.Equal(t, "2001:db8::5", legacy[0].Address)
+	require.Equal(t, uint32(26657), legacy[0].Port)
+	require.Equal(t, types.EndpointPurpose_ENDPOINT_PURPOSE_P2P, legacy[0].Purpose)
+	require.Equal(t, int64(7), legacy[0].AddedHeight)
+
+	sn.ApplyEndpoints([]*types.SupernodeEndpoint{
+		tcpEndpoint(types.EndpointAddressType_ENDPOINT_ADDRESS_TYPE_DNS, "sn1.example.org", 8002, types.EndpointPurpose_ENDPOINT_PURPOSE_HTTP_GATEWAY),
+	}, 9)
+	advertised := sn.EffectiveEndpoints()
+	require.Len(t, advertised, 1)
+	require.Equal(t, "sn1.example.org", advertised[0].Address)
+}
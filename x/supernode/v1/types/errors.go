@@ -22,4 +22,18 @@ var (
 	ErrInvalidSupernodeAddress     = sdkerrors.Register(ModuleName, 1109, "invalid supernode address")
 
 	ErrEmptyP2PPort = sdkerrors.Register(ModuleName, 1110, "p2p port cannot be empty")
+
+	ErrBlockEntropyUnavailable = sdkerrors.Register(ModuleName, 1111, "block entropy unavailable for height")
+	ErrInvalidEntropySwitch    = sdkerrors.Register(ModuleName, 1112, "invalid entropy switch height")
+
+	ErrNotIndependentSupernode = sdkerrors.Register(ModuleName, 1113, "supernode is not operated independently")
+	ErrInsufficientSelfStake   = sdkerrors.Register(ModuleName, 1114, "insufficient supernode self-stake")
+
+	ErrInvalidEndpoint = sdkerrors.Register(ModuleName, 1115, "invalid supernode endpoint")
+
+	ErrSupernodeJailed   = sdkerrors.Register(ModuleName, 1116, "supernode is jailed")
+	ErrSlashNotFound     = sdkerrors.Register(ModuleName, 1117, "slash record not found")
+	ErrInvalidSlashState = sdkerrors.Register(ModuleName, 1118, "invalid slash record state")
+
+	ErrInvalidCommission = sdkerrors.Register(ModuleName, 1119, "invalid supernode commission")
 )
@@ -13,6 +13,17 @@ const (
 	EventTypeSupernodeStorageFull      = "supernode_storage_full"
 	EventTypeSupernodeStorageRecovered = "supernode_storage_recovered"
 	EventTypeDistribution              = "reward_distribution"
+	EventTypeSelfStakeBonded           = "supernode_self_stake_bonded"
+	EventTypeSelfStakeUnbonding        = "supernode_self_stake_unbonding"
+	EventTypeSelfStakeUnbonded         = "supernode_self_stake_unbonded"
+	EventTypeSelfStakeSlashed          = "supernode_self_stake_slashed"
+	EventTypeSlashReported             = "supernode_slash_reported"
+	EventTypeSlashAppealed             = "supernode_slash_appealed"
+	EventTypeSlashExecuted             = "supernode_slash_executed"
+	EventTypeSlashOverturned           = "supernode_slash_overturned"
+	EventTypeSlashFailed               = "supernode_slash_failed"
+	EventTypeSupernodePenalized        = "supernode_penalized"
+	EventTypeCommissionUpdated         = "supernode_commission_updated"
 
 	AttributeKeyValidatorAddress = "validator_address"
 	AttributeKeyIPAddress        = "ip_address"
@@ -22,6 +33,7 @@ const (
 	AttributeKeySupernodeAccount = "supernode_account"
 	AttributeKeyOldP2PPort       = "old_p2p_port"
 	AttributeKeyP2PPort          = "p2p_port"
+	AttributeKeyEndpoints        = "endpoints"
 	AttributeKeyReRegistered     = "re_registered"
 	AttributeKeyOldState         = "old_state"
 	AttributeKeyOldIPAddress     = "old_ip_address"
@@ -29,10 +41,30 @@ const (
 	AttributeKeyFieldsUpdated    = "fields_updated"
 	AttributeKeyCompliant        = "compliant"
 	AttributeKeyIssues           = "issues"
+	AttributeKeyIndependent      = "independent"
+	AttributeKeyOperator         = "operator"
+	AttributeKeyAmount           = "amount"
+	AttributeKeySelfStake        = "self_stake"
+	AttributeKeyCompletionHeight = "completion_height"
+
+	AttributeKeySlashID              = "slash_id"
+	AttributeKeyOffense              = "offense"
+	AttributeKeyEvidenceRef          = "evidence_ref"
+	AttributeKeyOffenseCount         = "offense_count"
+	AttributeKeyFraction             = "fraction"
+	AttributeKeyAppealDeadlineHeight = "appeal_deadline_height"
+	AttributeKeyJailedUntilHeight    = "jailed_until_height"
+
+	AttributeKeyCommissionRate    = "commission_rate"
+	AttributeKeyMaxRate           = "max_rate"
+	AttributeKeyMaxChangeRate     = "max_change_rate"
+	AttributeKeyOldCommissionRate = "old_commission_rate"
 
 	AttributeKeyRewardRecipient     = "reward_recipient"
 	AttributeKeyRewardValidator     = "reward_validator"
 	AttributeKeyRewardAmount        = "reward_amount"
+	AttributeKeyRewardCommission    = "reward_commission"
+	AttributeKeyRewardDelegators    = "reward_delegators"
 	AttributeKeyRewardSmoothedBytes = "reward_smoothed_bytes"
 	AttributeKeyRewardRawBytes      = "reward_raw_bytes"
 	AttributeKeyRewardEligibleCount = "reward_eligible_count"
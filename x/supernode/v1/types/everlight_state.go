@@ -1,11 +1,16 @@
 package types
 
+import sdkmath "cosmossdk.io/math"
+
 // SNDistState holds per-supernode distribution tracking state.
+//
+// Byte counters are fixed-point decimals so that every validator computes the
+// same payout regardless of platform float rounding.
 type SNDistState struct {
 	// SmoothedBytes is the EMA-smoothed cascade bytes value used for weight calculation.
-	SmoothedBytes float64 `json:"smoothed_bytes"`
+	SmoothedBytes sdkmath.LegacyDec `json:"smoothed_bytes"`
 	// PrevRawBytes is the raw cascade bytes from the previous period (for growth cap).
-	PrevRawBytes float64 `json:"prev_raw_bytes"`
+	PrevRawBytes sdkmath.LegacyDec `json:"prev_raw_bytes"`
 	// EligibilityStartHeight is the block height when this SN first became eligible.
 	EligibilityStartHeight int64 `json:"eligibility_start_height"`
 	// PeriodsActive is the number of distribution periods this SN has been active.
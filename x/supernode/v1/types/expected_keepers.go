@@ -53,6 +53,19 @@ type SupernodeKeeper interface {
 	CountEligibleSNs(ctx sdk.Context) uint64
 	GetLatestCascadeBytesForPayout(ctx sdk.Context, supernodeAccount string) (float64, int64, bool)
 	EnsureModuleAccount(ctx sdk.Context)
+	IsSelfStakeEligible(ctx sdk.Context, sn SuperNode) bool
+	BondSelfStake(ctx sdk.Context, operator sdk.AccAddress, amount sdk.Coin) error
+	UnbondSelfStake(ctx sdk.Context, operator sdk.AccAddress, amount sdk.Coin) (int64, error)
+	StopIneligibleSelfStakedSupernodes(ctx sdk.Context) error
+	SlashSelfStake(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec, reason string) (sdk.Coin, error)
+	GetSelfStakeUnbondings(ctx sdk.Context, operator sdk.AccAddress) []SelfStakeUnbonding
+	ReportSlashableOffense(ctx sdk.Context, valAddr sdk.ValAddress, offense SlashOffense, evidenceRef string) (uint64, error)
+	GetSlashRecord(ctx sdk.Context, id uint64) (SlashRecord, bool)
+	AppealSlashRecord(ctx sdk.Context, id uint64, reason string) error
+	ResolveSlashRecordAppeal(ctx sdk.Context, id uint64, uphold bool) error
+	GetSupernodeJailedUntil(ctx sdk.Context, valAddr sdk.ValAddress) (int64, bool)
+	ClearSupernodeJail(ctx sdk.Context, valAddr sdk.ValAddress)
+	UpdateSupernodeCommission(ctx sdk.Context, valAddr sdk.ValAddress, rate sdkmath.LegacyDec, maxRate, maxChangeRate *sdkmath.LegacyDec) (SupernodeCommission, error)
 }
 
 // StakingKeeper defines the expected interface for the Staking module.
@@ -61,6 +74,13 @@ type StakingKeeper interface {
 	Validator(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error)            // get a particular validator by operator address
 	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error) // get a particular validator by consensus address
 	Delegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationI, error)
+	Unbond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdkmath.LegacyDec) (sdkmath.Int, error) // remove delegation shares and the matching validator tokens
+}
+
+// DistributionKeeper defines the x/distribution methods used to credit the
+// delegator share of Everlight payouts to a validator's rewards pool.
+type DistributionKeeper interface {
+	AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error
 }
 
 // AuditKeeper defines the audit-source methods used by Everlight payout logic.
@@ -90,6 +110,8 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
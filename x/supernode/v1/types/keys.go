@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -11,6 +16,11 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_supernode"
+
+	// SelfStakePoolName is the module account holding the bonded and unbonding
+	// self-stake of independent supernode operators. It is kept apart from the
+	// supernode module account, whose balance is the Everlight reward pool.
+	SelfStakePoolName = "supernode_self_stake"
 )
 
 var (
@@ -41,6 +51,33 @@ var (
 
 	// PayoutHistoryPrefix stores per-validator payout history entries.
 	PayoutHistoryPrefix = []byte("rhist/")
+
+	// BlockEntropyPrefix stores the recorded block entropy used to seed
+	// supernode ranking, keyed by height and pruned past the retention window.
+	BlockEntropyPrefix = []byte("bent/")
+
+	// SelfStakeUnbondingPrefix stores pending self-stake unbondings ordered by
+	// completion height, then operator account.
+	SelfStakeUnbondingPrefix = []byte("ssub/")
+
+	// SelfStakeUnbondingByOperatorPrefix indexes pending self-stake unbondings
+	// by operator account, then completion height.
+	SelfStakeUnbondingByOperatorPrefix = []byte("ssubo/")
+
+	// SlashRecordPrefix stores slash records keyed by id.
+	SlashRecordPrefix = []byte("slash/")
+
+	// SlashByValidatorPrefix indexes slash ids by validator address.
+	SlashByValidatorPrefix = []byte("slashv/")
+
+	// SlashQueuePrefix orders pending slashes by appeal deadline height, then id.
+	SlashQueuePrefix = []byte("slashq/")
+
+	// NextSlashIDKey stores the id assigned to the next slash record.
+	NextSlashIDKey = []byte("slashid")
+
+	// SupernodeJailPrefix stores the height until which a slashed supernode is jailed.
+	SupernodeJailPrefix = []byte("sjail/")
 )
 
 func KeyPrefix(p string) []byte {
@@ -74,3 +111,85 @@ func PayoutHistoryPrefixForValidator(valAddr string) []byte {
 	key[len(PayoutHistoryPrefix)+len(valAddr)] = '/'
 	return key
 }
+
+// BlockEntropyKey returns the store key for the block entropy recorded at height.
+func BlockEntropyKey(height uint64) []byte {
+	key := make([]byte, len(BlockEntropyPrefix)+8)
+	copy(key, BlockEntropyPrefix)
+	binary.BigEndian.PutUint64(key[len(BlockEntropyPrefix):], height)
+	return key
+}
+
+// IndependentValAddress returns the validator-format address an independent
+// operator's supernode is keyed by. It shares the operator account's bytes, so
+// VerifyValidatorOperator accepts the operator for the lifecycle messages.
+func IndependentValAddress(operator sdk.AccAddress) sdk.ValAddress {
+	return sdk.ValAddress(operator)
+}
+
+// SelfStakeUnbondingQueuePrefix returns the prefix of all unbondings that
+// complete at or before the given height when used as an iterator end bound.
+func SelfStakeUnbondingQueuePrefix(completionHeight int64) []byte {
+	key := make([]byte, len(SelfStakeUnbondingPrefix)+8)
+	copy(key, SelfStakeUnbondingPrefix)
+	binary.BigEndian.PutUint64(key[len(SelfStakeUnbondingPrefix):], uint64(completionHeight))
+	return key
+}
+
+// SelfStakeUnbondingKey returns the store key of an operator's unbonding that
+// completes at the given height.
+func SelfStakeUnbondingKey(completionHeight int64, operator sdk.AccAddress) []byte {
+	return append(SelfStakeUnbondingQueuePrefix(completionHeight), operator.Bytes()...)
+}
+
+// SelfStakeUnbondingByOperatorIndexPrefix returns the prefix of an operator's
+// unbonding index entries. The address is length-prefixed so no operator
+// prefixes another.
+func SelfStakeUnbondingByOperatorIndexPrefix(operator sdk.AccAddress) []byte {
+	return append(append([]byte(nil), SelfStakeUnbondingByOperatorPrefix...), address.MustLengthPrefix(operator)...)
+}
+
+// SelfStakeUnbondingByOperatorIndexKey returns the index key of an operator's
+// unbonding that completes at the given height.
+func SelfStakeUnbondingByOperatorIndexKey(operator sdk.AccAddress, completionHeight int64) []byte {
+	return binary.BigEndian.AppendUint64(SelfStakeUnbondingByOperatorIndexPrefix(operator), uint64(completionHeight))
+}
+
+// SlashRecordKey returns the store key of a slash record.
+func SlashRecordKey(id uint64) []byte {
+	key := make([]byte, len(SlashRecordPrefix)+8)
+	copy(key, SlashRecordPrefix)
+	binary.BigEndian.PutUint64(key[len(SlashRecordPrefix):], id)
+	return key
+}
+
+// SlashByValidatorIndexPrefix returns the prefix of a validator's slash index
+// entries. The address is length-prefixed so no validator prefixes another.
+func SlashByValidatorIndexPrefix(valAddr sdk.ValAddress) []byte {
+	return append(append([]byte(nil), SlashByValidatorPrefix...), address.MustLengthPrefix(valAddr)...)
+}
+
+// SlashByValidatorIndexKey returns the index key of one of a validator's slashes.
+func SlashByValidatorIndexKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(SlashByValidatorIndexPrefix(valAddr), id)
+}
+
+// SlashQueueHeightPrefix returns the prefix of all queued slashes whose appeal
+// deadline is the given height; used as an iterator end bound it selects every
+// slash due before that height.
+func SlashQueueHeightPrefix(deadlineHeight int64) []byte {
+	key := make([]byte, len(SlashQueuePrefix)+8)
+	copy(key, SlashQueuePrefix)
+	binary.BigEndian.PutUint64(key[len(SlashQueuePrefix):], uint64(deadlineHeight))
+	return key
+}
+
+// SlashQueueKey returns the queue key of a pending slash.
+func SlashQueueKey(deadlineHeight int64, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(SlashQueueHeightPrefix(deadlineHeight), id)
+}
+
+// SupernodeJailKey returns the store key of a supernode's jail record.
+func SupernodeJailKey(valAddr sdk.ValAddress) []byte {
+	return append(append([]byte(nil), SupernodeJailPrefix...), valAddr.Bytes()...)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetSupernodeCommission{}
+
+func NewMsgSetSupernodeCommission(creator string, validatorAddress string, rate sdkmath.LegacyDec, maxRate, maxChangeRate *sdkmath.LegacyDec) *MsgSetSupernodeCommission {
+	return &MsgSetSupernodeCommission{
+		Creator:          creator,
+		ValidatorAddress: validatorAddress,
+		Rate:             rate,
+		MaxRate:          maxRate,
+		MaxChangeRate:    maxChangeRate,
+	}
+}
+
+func (msg *MsgSetSupernodeCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if (msg.MaxRate == nil) != (msg.MaxChangeRate == nil) {
+		return errorsmod.Wrap(ErrInvalidCommission, "max_rate and max_change_rate must be set together")
+	}
+
+	if msg.MaxRate == nil {
+		return validateCommissionRate("rate", msg.Rate)
+	}
+	return (&SupernodeCommission{
+		Rate:          msg.Rate,
+		MaxRate:       *msg.MaxRate,
+		MaxChangeRate: *msg.MaxChangeRate,
+	}).Validate()
+}
+
+// Validate checks that the commission rates are consistent, as staking does
+// for validator commissions.
+func (c *SupernodeCommission) Validate() error {
+	if err := validateCommissionRate("rate", c.Rate); err != nil {
+		return err
+	}
+	if err := validateCommissionRate("max_rate", c.MaxRate); err != nil {
+		return err
+	}
+	if err := validateCommissionRate("max_change_rate", c.MaxChangeRate); err != nil {
+		return err
+	}
+	if c.Rate.GT(c.MaxRate) {
+		return errorsmod.Wrapf(ErrInvalidCommission, "rate %s exceeds max_rate %s", c.Rate, c.MaxRate)
+	}
+	if c.MaxChangeRate.GT(c.MaxRate) {
+		return errorsmod.Wrapf(ErrInvalidCommission, "max_change_rate %s exceeds max_rate %s", c.MaxChangeRate, c.MaxRate)
+	}
+	return nil
+}
+
+func validateCommissionRate(name string, rate sdkmath.LegacyDec) error {
+	if rate.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidCommission, "%s must be set", name)
+	}
+	if rate.IsNegative() || rate.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidCommission, "%s %s must be between 0 and 1", name, rate)
+	}
+	return nil
+}
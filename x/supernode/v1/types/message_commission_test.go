@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/LumeraProtocol/lumera/testutil/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetSupernodeCommission_ValidateBasic(t *testing.T) {
+	creator := cryptotestutils.AccAddress()
+	valAddr := sdk.ValAddress([]byte("validator")).String()
+	dec := func(s string) *sdkmath.LegacyDec {
+		d := sdkmath.LegacyMustNewDecFromStr(s)
+		return &d
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgSetSupernodeCommission
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  NewMsgSetSupernodeCommission("invalid_address", valAddr, *dec("0.1"), dec("0.2"), dec("0.01")),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid validator",
+			msg:  NewMsgSetSupernodeCommission(creator, "invalid_address", *dec("0.1"), dec("0.2"), dec("0.01")),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "rate above one",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("1.1"), nil, nil),
+			err:  ErrInvalidCommission,
+		},
+		{
+			name: "negative rate",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("-0.1"), nil, nil),
+			err:  ErrInvalidCommission,
+		},
+		{
+			name: "max rate without max change rate",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("0.1"), dec("0.2"), nil),
+			err:  ErrInvalidCommission,
+		},
+		{
+			name: "rate above max rate",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("0.3"), dec("0.2"), dec("0.01")),
+			err:  ErrInvalidCommission,
+		},
+		{
+			name: "max change rate above max rate",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("0.1"), dec("0.2"), dec("0.3")),
+			err:  ErrInvalidCommission,
+		},
+		{
+			name: "initial commission",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("0.1"), dec("0.2"), dec("0.01")),
+		},
+		{
+			name: "rate change",
+			msg:  NewMsgSetSupernodeCommission(creator, valAddr, *dec("0.11"), nil, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
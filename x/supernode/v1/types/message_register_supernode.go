@@ -33,5 +33,9 @@ func (msg *MsgRegisterSupernode) ValidateBasic() error {
 		return errorsmod.Wrap(ErrEmptyIPAddress, "ip address cannot be empty")
 	}
 
+	if err := ValidateEndpoints(msg.Endpoints); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,86 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterIndependentSupernode{}
+	_ sdk.Msg = &MsgBondSupernodeStake{}
+	_ sdk.Msg = &MsgUnbondSupernodeStake{}
+)
+
+func NewMsgRegisterIndependentSupernode(creator string, ipAddress string, supernodeAccount string, p2pPort string, selfStake sdk.Coin) *MsgRegisterIndependentSupernode {
+	return &MsgRegisterIndependentSupernode{
+		Creator:          creator,
+		IpAddress:        ipAddress,
+		SupernodeAccount: supernodeAccount,
+		P2PPort:          p2pPort,
+		SelfStake:        selfStake,
+	}
+}
+
+func (msg *MsgRegisterIndependentSupernode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.IpAddress == "" {
+		return errorsmod.Wrap(ErrEmptyIPAddress, "ip address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SupernodeAccount); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSupernodeAddress, "invalid supernode account (%s)", err)
+	}
+
+	// A zero self-stake is allowed when re-registering with stake still bonded.
+	if !msg.SelfStake.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid self-stake %s", msg.SelfStake)
+	}
+
+	if err := ValidateEndpoints(msg.Endpoints); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgBondSupernodeStake(creator string, amount sdk.Coin) *MsgBondSupernodeStake {
+	return &MsgBondSupernodeStake{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgBondSupernodeStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive, got %s", msg.Amount)
+	}
+
+	return nil
+}
+
+func NewMsgUnbondSupernodeStake(creator string, amount sdk.Coin) *MsgUnbondSupernodeStake {
+	return &MsgUnbondSupernodeStake{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgUnbondSupernodeStake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive, got %s", msg.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/LumeraProtocol/lumera/testutil/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterIndependentSupernode_ValidateBasic(t *testing.T) {
+	stake := sdk.NewInt64Coin("ulume", 1_000_000)
+	tests := []struct {
+		name string
+		msg  MsgRegisterIndependentSupernode
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  MsgRegisterIndependentSupernode{Creator: "invalid_address", IpAddress: "192.168.1.1", SupernodeAccount: cryptotestutils.AccAddress(), SelfStake: stake},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty ip address",
+			msg:  MsgRegisterIndependentSupernode{Creator: cryptotestutils.AccAddress(), SupernodeAccount: cryptotestutils.AccAddress(), SelfStake: stake},
+			err:  ErrEmptyIPAddress,
+		}, {
+			name: "invalid supernode account",
+			msg:  MsgRegisterIndependentSupernode{Creator: cryptotestutils.AccAddress(), IpAddress: "192.168.1.1", SupernodeAccount: "invalid", SelfStake: stake},
+			err:  ErrInvalidSupernodeAddress,
+		}, {
+			name: "invalid self-stake",
+			msg:  MsgRegisterIndependentSupernode{Creator: cryptotestutils.AccAddress(), IpAddress: "192.168.1.1", SupernodeAccount: cryptotestutils.AccAddress()},
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg:  MsgRegisterIndependentSupernode{Creator: cryptotestutils.AccAddress(), IpAddress: "192.168.1.1", SupernodeAccount: cryptotestutils.AccAddress(), SelfStake: stake},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBondAndUnbondSupernodeStake_ValidateBasic(t *testing.T) {
+	creator := cryptotestutils.AccAddress()
+
+	require.ErrorIs(t, NewMsgBondSupernodeStake("invalid_address", sdk.NewInt64Coin("ulume", 1)).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgBondSupernodeStake(creator, sdk.NewInt64Coin("ulume", 0)).ValidateBasic(), sdkerrors.ErrInvalidCoins)
+	require.NoError(t, NewMsgBondSupernodeStake(creator, sdk.NewInt64Coin("ulume", 1)).ValidateBasic())
+
+	require.ErrorIs(t, NewMsgUnbondSupernodeStake("invalid_address", sdk.NewInt64Coin("ulume", 1)).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgUnbondSupernodeStake(creator, sdk.NewInt64Coin("ulume", 0)).ValidateBasic(), sdkerrors.ErrInvalidCoins)
+	require.NoError(t, NewMsgUnbondSupernodeStake(creator, sdk.NewInt64Coin("ulume", 1)).ValidateBasic())
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxSlashAppealReasonLength bounds the free-form reason attached to an appeal.
+const MaxSlashAppealReasonLength = 1024
+
+var (
+	_ sdk.Msg = &MsgAppealSlash{}
+	_ sdk.Msg = &MsgResolveSlashAppeal{}
+)
+
+func NewMsgAppealSlash(creator string, slashID uint64, reason string) *MsgAppealSlash {
+	return &MsgAppealSlash{
+		Creator: creator,
+		SlashId: slashID,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgAppealSlash) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.SlashId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "slash id cannot be zero")
+	}
+
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "appeal reason cannot be empty")
+	}
+
+	if len(msg.Reason) > MaxSlashAppealReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "appeal reason exceeds %d bytes", MaxSlashAppealReasonLength)
+	}
+
+	return nil
+}
+
+func NewMsgResolveSlashAppeal(authority string, slashID uint64, uphold bool) *MsgResolveSlashAppeal {
+	return &MsgResolveSlashAppeal{
+		Authority: authority,
+		SlashId:   slashID,
+		Uphold:    uphold,
+	}
+}
+
+func (msg *MsgResolveSlashAppeal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if msg.SlashId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "slash id cannot be zero")
+	}
+
+	return nil
+}
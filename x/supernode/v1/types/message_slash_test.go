@@ -0,0 +1,26 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/LumeraProtocol/lumera/testutil/crypto"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAppealSlash_ValidateBasic(t *testing.T) {
+	creator := cryptotestutils.AccAddress()
+
+	require.ErrorIs(t, NewMsgAppealSlash("invalid_address", 1, "reason").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgAppealSlash(creator, 0, "reason").ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgAppealSlash(creator, 1, "").ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgAppealSlash(creator, 1, strings.Repeat("x", MaxSlashAppealReasonLength+1)).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgAppealSlash(creator, 1, "probes were misrouted").ValidateBasic())
+}
+
+func TestMsgResolveSlashAppeal_ValidateBasic(t *testing.T) {
+	require.Error(t, NewMsgResolveSlashAppeal("invalid_address", 1, true).ValidateBasic())
+	require.ErrorIs(t, NewMsgResolveSlashAppeal(cryptotestutils.AccAddress(), 0, true).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgResolveSlashAppeal(cryptotestutils.AccAddress(), 1, false).ValidateBasic())
+}
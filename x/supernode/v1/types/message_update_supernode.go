@@ -22,5 +22,12 @@ func (msg *MsgUpdateSupernode) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+
+	if err := ValidateEndpoints(msg.Endpoints); err != nil {
+		return err
+	}
+	if msg.ClearEndpoints && len(msg.Endpoints) > 0 {
+		return errorsmod.Wrap(ErrInvalidEndpoint, "clear_endpoints cannot be combined with endpoints")
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/Masterminds/semver/v3"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -64,6 +65,14 @@ var (
 	KeyMinStorageGB                = []byte("MinStorageGB")
 	KeyMaxStorageUsagePercent      = []byte("MaxStorageUsagePercent")
 	KeyRequiredOpenPorts           = []byte("RequiredOpenPorts")
+	KeyEntropySwitchHeight         = []byte("EntropySwitchHeight")
+	KeyBlockEntropyRetentionBlocks = []byte("BlockEntropyRetentionBlocks")
+	KeySelfStakeUnbondingBlocks    = []byte("SelfStakeUnbondingBlocks")
+	KeySlashAppealWindowBlocks     = []byte("SlashAppealWindowBlocks")
+	KeySlashJailBaseBlocks         = []byte("SlashJailBaseBlocks")
+	KeySlashJailMaxBlocks          = []byte("SlashJailMaxBlocks")
+	KeySlashOffenseWindowBlocks    = []byte("SlashOffenseWindowBlocks")
+	KeySlashMaxFraction            = []byte("SlashMaxFraction")
 )
 
 const (
@@ -77,6 +86,14 @@ const (
 	DefaultMaxMemUsagePercent          uint64 = 90
 	DefaultMinStorageGB                uint64 = 1000
 	DefaultMaxStorageUsagePercent      uint64 = 90
+	DefaultEntropySwitchHeight         int64  = 0      // legacy blake3(height) seed until governance opts in
+	DefaultBlockEntropyRetentionBlocks uint64 = 100800 // ~7 days at 6s blocks
+	DefaultSelfStakeUnbondingBlocks    uint64 = 302400 // ~21 days at 6s blocks
+	DefaultSlashAppealWindowBlocks     uint64 = 14400  // ~1 day at 6s blocks
+	DefaultSlashJailBaseBlocks         uint64 = 14400  // ~1 day at 6s blocks
+	DefaultSlashJailMaxBlocks          uint64 = 201600 // ~14 days at 6s blocks
+	DefaultSlashOffenseWindowBlocks    uint64 = 403200 // ~28 days at 6s blocks
+	DefaultSlashMaxFraction                   = "0.05"
 )
 
 var DefaultRequiredOpenPorts = []uint32{4444, 4445, 8002}
@@ -129,6 +146,27 @@ func (p Params) WithDefaults() Params {
 	if out.RequiredOpenPorts == nil {
 		out.RequiredOpenPorts = append([]uint32(nil), DefaultRequiredOpenPorts...)
 	}
+	if out.BlockEntropyRetentionBlocks == 0 {
+		out.BlockEntropyRetentionBlocks = DefaultBlockEntropyRetentionBlocks
+	}
+	if out.SelfStakeUnbondingBlocks == 0 {
+		out.SelfStakeUnbondingBlocks = DefaultSelfStakeUnbondingBlocks
+	}
+	if out.SlashAppealWindowBlocks == 0 {
+		out.SlashAppealWindowBlocks = DefaultSlashAppealWindowBlocks
+	}
+	if out.SlashJailBaseBlocks == 0 {
+		out.SlashJailBaseBlocks = DefaultSlashJailBaseBlocks
+	}
+	if out.SlashJailMaxBlocks == 0 {
+		out.SlashJailMaxBlocks = DefaultSlashJailMaxBlocks
+	}
+	if out.SlashOffenseWindowBlocks == 0 {
+		out.SlashOffenseWindowBlocks = DefaultSlashOffenseWindowBlocks
+	}
+	if out.SlashMaxFraction == "" {
+		out.SlashMaxFraction = DefaultSlashMaxFraction
+	}
 	if out.RewardDistribution == nil {
 		dist := *DefaultRewardDistribution
 		out.RewardDistribution = &dist
@@ -175,6 +213,14 @@ func NewParams(
 	minStorageGB uint64,
 	maxStorageUsagePercent uint64,
 	requiredOpenPorts []uint32,
+	entropySwitchHeight int64,
+	blockEntropyRetentionBlocks uint64,
+	selfStakeUnbondingBlocks uint64,
+	slashAppealWindowBlocks uint64,
+	slashJailBaseBlocks uint64,
+	slashJailMaxBlocks uint64,
+	slashOffenseWindowBlocks uint64,
+	slashMaxFraction string,
 ) Params {
 	return Params{
 		MinimumStakeForSn:           minimumStakeForSn,
@@ -195,6 +241,14 @@ func NewParams(
 		MinStorageGb:                minStorageGB,
 		MaxStorageUsagePercent:      maxStorageUsagePercent,
 		RequiredOpenPorts:           requiredOpenPorts,
+		EntropySwitchHeight:         entropySwitchHeight,
+		BlockEntropyRetentionBlocks: blockEntropyRetentionBlocks,
+		SelfStakeUnbondingBlocks:    selfStakeUnbondingBlocks,
+		SlashAppealWindowBlocks:     slashAppealWindowBlocks,
+		SlashJailBaseBlocks:         slashJailBaseBlocks,
+		SlashJailMaxBlocks:          slashJailMaxBlocks,
+		SlashOffenseWindowBlocks:    slashOffenseWindowBlocks,
+		SlashMaxFraction:            slashMaxFraction,
 	}
 }
 
@@ -219,6 +273,14 @@ func DefaultParams() Params {
 		DefaultMinStorageGB,
 		DefaultMaxStorageUsagePercent,
 		DefaultRequiredOpenPorts,
+		DefaultEntropySwitchHeight,
+		DefaultBlockEntropyRetentionBlocks,
+		DefaultSelfStakeUnbondingBlocks,
+		DefaultSlashAppealWindowBlocks,
+		DefaultSlashJailBaseBlocks,
+		DefaultSlashJailMaxBlocks,
+		DefaultSlashOffenseWindowBlocks,
+		DefaultSlashMaxFraction,
 	).WithDefaults()
 }
 
@@ -243,6 +305,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMinStorageGB, &p.MinStorageGb, validateNonNegativeUint64("min storage gb")),
 		paramtypes.NewParamSetPair(KeyMaxStorageUsagePercent, &p.MaxStorageUsagePercent, validatePercentUint64("max storage usage percent")),
 		paramtypes.NewParamSetPair(KeyRequiredOpenPorts, &p.RequiredOpenPorts, validateRequiredPorts),
+		paramtypes.NewParamSetPair(KeyEntropySwitchHeight, &p.EntropySwitchHeight, validateEntropySwitchHeight),
+		paramtypes.NewParamSetPair(KeyBlockEntropyRetentionBlocks, &p.BlockEntropyRetentionBlocks, validatePositiveUint64("block entropy retention blocks")),
+		paramtypes.NewParamSetPair(KeySelfStakeUnbondingBlocks, &p.SelfStakeUnbondingBlocks, validatePositiveUint64("self-stake unbonding blocks")),
+		paramtypes.NewParamSetPair(KeySlashAppealWindowBlocks, &p.SlashAppealWindowBlocks, validatePositiveUint64("slash appeal window blocks")),
+		paramtypes.NewParamSetPair(KeySlashJailBaseBlocks, &p.SlashJailBaseBlocks, validatePositiveUint64("slash jail base blocks")),
+		paramtypes.NewParamSetPair(KeySlashJailMaxBlocks, &p.SlashJailMaxBlocks, validatePositiveUint64("slash jail max blocks")),
+		paramtypes.NewParamSetPair(KeySlashOffenseWindowBlocks, &p.SlashOffenseWindowBlocks, validatePositiveUint64("slash offense window blocks")),
+		paramtypes.NewParamSetPair(KeySlashMaxFraction, &p.SlashMaxFraction, validateSlashMaxFraction),
 	}
 }
 
@@ -309,6 +379,33 @@ func (p Params) Validate() error {
 	if err := validateRequiredPorts(p.RequiredOpenPorts); err != nil {
 		return err
 	}
+	if err := validateEntropySwitchHeight(p.EntropySwitchHeight); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64("block entropy retention blocks")(p.BlockEntropyRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64("self-stake unbonding blocks")(p.SelfStakeUnbondingBlocks); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64("slash appeal window blocks")(p.SlashAppealWindowBlocks); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64("slash jail base blocks")(p.SlashJailBaseBlocks); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64("slash jail max blocks")(p.SlashJailMaxBlocks); err != nil {
+		return err
+	}
+	if p.SlashJailMaxBlocks < p.SlashJailBaseBlocks {
+		return fmt.Errorf("slash jail max blocks must be >= slash jail base blocks")
+	}
+	if err := validatePositiveUint64("slash offense window blocks")(p.SlashOffenseWindowBlocks); err != nil {
+		return err
+	}
+	if err := validateSlashMaxFraction(p.SlashMaxFraction); err != nil {
+		return err
+	}
 	if p.RewardDistribution == nil {
 		return fmt.Errorf("reward_distribution must be present")
 	}
@@ -397,9 +494,30 @@ func validateSlashingFraction(v interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", v)
 	}
 
-	// TODO implement validation
-	_ = slashingFraction
+	// An empty fraction disables economic slashing; offenses only jail.
+	if slashingFraction == "" {
+		return nil
+	}
+	return validateFractionString("slashing fraction", slashingFraction)
+}
+
+// validateSlashMaxFraction validates the SlashMaxFraction param
+func validateSlashMaxFraction(v interface{}) error {
+	maxFraction, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return validateFractionString("slash max fraction", maxFraction)
+}
 
+func validateFractionString(field, value string) error {
+	fraction, err := sdkmath.LegacyNewDecFromStr(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %w", field, value, err)
+	}
+	if fraction.IsNegative() || fraction.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1", field)
+	}
 	return nil
 }
 
@@ -477,3 +595,14 @@ func validateRequiredPorts(v interface{}) error {
 	}
 	return nil
 }
+
+func validateEntropySwitchHeight(v interface{}) error {
+	height, ok := v.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for entropy switch height: %T", v)
+	}
+	if height < 0 {
+		return fmt.Errorf("entropy switch height cannot be negative")
+	}
+	return nil
+}
@@ -145,6 +145,26 @@ type Params struct {
 	MaxStorageUsagePercent    uint64              `protobuf:"varint,17,opt,name=max_storage_usage_percent,json=maxStorageUsagePercent,proto3" json:"max_storage_usage_percent,omitempty" yaml:"max_storage_usage_percent"`
 	RequiredOpenPorts         []uint32            `protobuf:"varint,18,rep,packed,name=required_open_ports,json=requiredOpenPorts,proto3" json:"required_open_ports,omitempty" yaml:"required_open_ports"`
 	RewardDistribution        *RewardDistribution `protobuf:"bytes,19,opt,name=reward_distribution,json=rewardDistribution,proto3" json:"reward_distribution,omitempty" yaml:"reward_distribution"`
+	// Block height from which GetTopSuperNodesForBlock ranks supernodes against
+	// recorded block entropy instead of the legacy blake3(height) seed. Zero keeps
+	// the legacy derivation. Once set it can only be moved to a future height.
+	EntropySwitchHeight int64 `protobuf:"varint,20,opt,name=entropy_switch_height,json=entropySwitchHeight,proto3" json:"entropy_switch_height,omitempty" yaml:"entropy_switch_height"`
+	// Number of recent heights whose block entropy is retained for ranking.
+	BlockEntropyRetentionBlocks uint64 `protobuf:"varint,21,opt,name=block_entropy_retention_blocks,json=blockEntropyRetentionBlocks,proto3" json:"block_entropy_retention_blocks,omitempty" yaml:"block_entropy_retention_blocks"`
+	// Number of blocks an independent operator's self-stake stays locked (and
+	// slashable) after MsgUnbondSupernodeStake before it is returned.
+	SelfStakeUnbondingBlocks uint64 `protobuf:"varint,22,opt,name=self_stake_unbonding_blocks,json=selfStakeUnbondingBlocks,proto3" json:"self_stake_unbonding_blocks,omitempty" yaml:"self_stake_unbonding_blocks"`
+	// Economic slashing of audit offenses. slashing_fraction is the fraction
+	// slashed once an operator reaches slashing_threshold offenses within
+	// slash_offense_window_blocks; it doubles with every further offense, capped
+	// at slash_max_fraction. The jail duration starts at slash_jail_base_blocks
+	// and doubles per offense, capped at slash_jail_max_blocks. Every slash can
+	// be appealed for slash_appeal_window_blocks before it executes.
+	SlashAppealWindowBlocks  uint64 `protobuf:"varint,23,opt,name=slash_appeal_window_blocks,json=slashAppealWindowBlocks,proto3" json:"slash_appeal_window_blocks,omitempty" yaml:"slash_appeal_window_blocks"`
+	SlashJailBaseBlocks      uint64 `protobuf:"varint,24,opt,name=slash_jail_base_blocks,json=slashJailBaseBlocks,proto3" json:"slash_jail_base_blocks,omitempty" yaml:"slash_jail_base_blocks"`
+	SlashJailMaxBlocks       uint64 `protobuf:"varint,25,opt,name=slash_jail_max_blocks,json=slashJailMaxBlocks,proto3" json:"slash_jail_max_blocks,omitempty" yaml:"slash_jail_max_blocks"`
+	SlashOffenseWindowBlocks uint64 `protobuf:"varint,26,opt,name=slash_offense_window_blocks,json=slashOffenseWindowBlocks,proto3" json:"slash_offense_window_blocks,omitempty" yaml:"slash_offense_window_blocks"`
+	SlashMaxFraction         string `protobuf:"bytes,27,opt,name=slash_max_fraction,json=slashMaxFraction,proto3" json:"slash_max_fraction,omitempty" yaml:"slash_max_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -313,6 +333,62 @@ func (m *Params) GetRewardDistribution() *RewardDistribution {
 	return nil
 }
 
+func (m *Params) GetEntropySwitchHeight() int64 {
+	if m != nil {
+		return m.EntropySwitchHeight
+	}
+	return 0
+}
+
+func (m *Params) GetBlockEntropyRetentionBlocks() uint64 {
+	if m != nil {
+		return m.BlockEntropyRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSelfStakeUnbondingBlocks() uint64 {
+	if m != nil {
+		return m.SelfStakeUnbondingBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashAppealWindowBlocks() uint64 {
+	if m != nil {
+		return m.SlashAppealWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashJailBaseBlocks() uint64 {
+	if m != nil {
+		return m.SlashJailBaseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashJailMaxBlocks() uint64 {
+	if m != nil {
+		return m.SlashJailMaxBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashOffenseWindowBlocks() uint64 {
+	if m != nil {
+		return m.SlashOffenseWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashMaxFraction() string {
+	if m != nil {
+		return m.SlashMaxFraction
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RewardDistribution)(nil), "lumera.supernode.v1.RewardDistribution")
 	proto.RegisterType((*Params)(nil), "lumera.supernode.v1.Params")
@@ -321,78 +397,91 @@ func init() {
 func init() { proto.RegisterFile("lumera/supernode/v1/params.proto", fileDescriptor_9b01fd81f69ab95e) }
 
 var fileDescriptor_9b01fd81f69ab95e = []byte{
-	// 1123 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x3f, 0x73, 0xdb, 0xb6,
-	0x1b, 0xb6, 0x7e, 0x71, 0xf2, 0x4b, 0x90, 0x38, 0x8d, 0x68, 0x3b, 0xa1, 0x1c, 0x5b, 0x74, 0xd0,
-	0xfc, 0x71, 0x33, 0x48, 0x97, 0x66, 0xcb, 0xf5, 0xae, 0x77, 0x72, 0xcf, 0xbe, 0xdc, 0x35, 0x8d,
-	0x0e, 0x8a, 0x3b, 0x74, 0x41, 0x41, 0x0a, 0x96, 0xd8, 0x08, 0x7f, 0x0a, 0x90, 0xb2, 0xfc, 0x15,
-	0x3a, 0xf5, 0x23, 0x74, 0xec, 0x98, 0xaf, 0xd0, 0x2d, 0x63, 0xc6, 0x4e, 0xbc, 0x9e, 0x3d, 0xa4,
-	0x33, 0x87, 0xce, 0x3d, 0x00, 0xa4, 0x2c, 0x4a, 0x72, 0xba, 0xd8, 0xd4, 0xfb, 0x3c, 0x78, 0x9e,
-	0x97, 0x2f, 0x5e, 0xbe, 0x00, 0xd8, 0x1d, 0xa5, 0x8c, 0x2a, 0xd2, 0xd6, 0xa9, 0xa4, 0x8a, 0x8b,
-	0x3e, 0x6d, 0x8f, 0x9f, 0xb5, 0x25, 0x51, 0x84, 0xe9, 0x96, 0x54, 0x22, 0x11, 0xde, 0xba, 0x63,
-	0xb4, 0xa6, 0x8c, 0xd6, 0xf8, 0xd9, 0x56, 0x9d, 0xb0, 0x98, 0x8b, 0xb6, 0xfd, 0xeb, 0x78, 0x5b,
-	0x1b, 0x03, 0x31, 0x10, 0xf6, 0xb1, 0x6d, 0x9e, 0x8a, 0x68, 0x33, 0x12, 0x9a, 0x09, 0xdd, 0x0e,
-	0x89, 0x36, 0xd2, 0x21, 0x4d, 0xc8, 0xb3, 0x76, 0x24, 0x62, 0xee, 0x70, 0xf8, 0xcf, 0x2a, 0xf0,
-	0x10, 0x3d, 0x21, 0xaa, 0xff, 0x4d, 0xac, 0x13, 0x15, 0x87, 0x69, 0x12, 0x0b, 0xee, 0xbd, 0x01,
-	0x9b, 0x92, 0x9c, 0x32, 0xca, 0x13, 0x2c, 0xa9, 0x8a, 0x45, 0x1f, 0x87, 0x23, 0x11, 0xbd, 0xd5,
-	0x7e, 0x6d, 0xb7, 0xb6, 0xb7, 0xda, 0xd9, 0xcd, 0xb3, 0x60, 0xfb, 0x94, 0xb0, 0xd1, 0x0b, 0xb8,
-	0x94, 0x06, 0xd1, 0x7a, 0x11, 0xef, 0xda, 0x70, 0xc7, 0x46, 0xbd, 0x10, 0x6c, 0x29, 0x3a, 0x30,
-	0x3e, 0xc4, 0xb8, 0xe0, 0x63, 0x4a, 0xb1, 0x1e, 0x12, 0x45, 0x71, 0x28, 0xb5, 0xff, 0x3f, 0x2b,
-	0xfd, 0x28, 0xcf, 0x82, 0x07, 0x4e, 0xfa, 0x72, 0x2e, 0x44, 0xf7, 0x66, 0xc1, 0x03, 0x4a, 0x7b,
-	0x06, 0xea, 0x48, 0xed, 0xfd, 0x04, 0x76, 0x58, 0xcc, 0x71, 0x44, 0x74, 0x44, 0xfa, 0x14, 0x87,
-	0xa7, 0x09, 0xd5, 0xf8, 0x58, 0x28, 0x5c, 0x24, 0xe4, 0x5f, 0xb1, 0x36, 0x7b, 0x79, 0x16, 0x3c,
-	0x74, 0x36, 0x9f, 0xa4, 0x43, 0xd4, 0x60, 0x31, 0xdf, 0x77, 0x70, 0xc7, 0xa0, 0x07, 0x42, 0x75,
-	0x1d, 0xe6, 0x1d, 0x81, 0xbb, 0x9c, 0x9e, 0x60, 0xcd, 0xb1, 0x22, 0x4c, 0xe2, 0x54, 0x16, 0x55,
-	0xd0, 0xfe, 0xaa, 0x35, 0x79, 0x90, 0x67, 0xc1, 0x8e, 0x33, 0x59, 0xce, 0x83, 0xc8, 0xe3, 0xf4,
-	0xa4, 0xc7, 0x11, 0x61, 0xf2, 0x48, 0xba, 0x5a, 0x69, 0x6f, 0x04, 0x76, 0x18, 0x25, 0x3a, 0x55,
-	0xd4, 0x56, 0x56, 0x33, 0x21, 0x92, 0x61, 0xcc, 0x07, 0x53, 0xf5, 0xab, 0x0b, 0xaf, 0xf0, 0x29,
-	0x3a, 0x44, 0xf7, 0x67, 0xf0, 0x5e, 0x09, 0x97, 0x6e, 0x02, 0x04, 0xa9, 0x26, 0x03, 0x8a, 0x07,
-	0x4a, 0x9c, 0x24, 0x43, 0x1c, 0x11, 0x69, 0x4a, 0x6c, 0x56, 0x17, 0x0a, 0xfe, 0x35, 0xeb, 0xf7,
-	0x34, 0xcf, 0x82, 0xc7, 0xce, 0xef, 0x3f, 0x16, 0x40, 0xb4, 0x65, 0x19, 0x87, 0x96, 0xb0, 0x4f,
-	0x64, 0x47, 0xea, 0x2e, 0x55, 0xce, 0xf1, 0xc5, 0xea, 0xdf, 0xbf, 0x05, 0x35, 0xf8, 0xc7, 0x1a,
-	0xb8, 0xd6, 0xb5, 0x7d, 0xee, 0x25, 0x60, 0x83, 0xc5, 0x3c, 0x66, 0x29, 0xc3, 0x3a, 0x21, 0x6f,
-	0xa9, 0xad, 0xbf, 0xe6, 0xb6, 0xd7, 0x6e, 0x7e, 0xd9, 0x68, 0xb9, 0x16, 0x6e, 0x99, 0x16, 0x6e,
-	0x15, 0x2d, 0xdc, 0xda, 0x17, 0x31, 0xef, 0xec, 0xbd, 0xcf, 0x82, 0x95, 0x3c, 0x0b, 0xee, 0x4f,
-	0x37, 0x72, 0x41, 0x04, 0xfe, 0xfe, 0xf1, 0xdd, 0xd3, 0x1a, 0xaa, 0x17, 0x58, 0xcf, 0x40, 0x07,
-	0x42, 0xf5, 0xb8, 0xf7, 0x1a, 0xac, 0x2b, 0x2a, 0x85, 0x4a, 0x4c, 0xa9, 0x92, 0xa1, 0xa2, 0x7a,
-	0x28, 0x46, 0xfd, 0xa2, 0x0b, 0x9b, 0x79, 0x16, 0x6c, 0x95, 0x5d, 0xb8, 0x40, 0x82, 0xc8, 0x9b,
-	0x46, 0xdf, 0x94, 0x41, 0xef, 0x5b, 0xe0, 0xe9, 0x11, 0xd1, 0xc3, 0xaa, 0x9e, 0x6b, 0xb7, 0x9d,
-	0x3c, 0x0b, 0x1a, 0x4e, 0x6f, 0x91, 0x03, 0x51, 0xbd, 0x0c, 0x56, 0xd4, 0x18, 0x4d, 0x54, 0x1c,
-	0xe9, 0x0b, 0xa2, 0xeb, 0xab, 0x1b, 0xb3, 0x6a, 0x8b, 0x1c, 0x88, 0xea, 0x45, 0x70, 0x2a, 0xa6,
-	0xbd, 0x1f, 0x41, 0x83, 0x8e, 0xe3, 0x3e, 0xe5, 0x11, 0xc5, 0x8a, 0x26, 0x94, 0xdb, 0x6f, 0xaa,
-	0xd8, 0xde, 0xab, 0x56, 0xf4, 0x61, 0x9e, 0x05, 0xbb, 0x4e, 0xf4, 0x52, 0x2a, 0x44, 0xf7, 0x4a,
-	0x0c, 0x95, 0x90, 0xdb, 0x55, 0xef, 0x25, 0x98, 0xbe, 0x04, 0x3e, 0x56, 0x24, 0x32, 0x90, 0x6d,
-	0x9c, 0x1b, 0x9d, 0xed, 0x3c, 0x0b, 0xfc, 0xb9, 0x97, 0x2f, 0x29, 0x10, 0xdd, 0x29, 0x63, 0x07,
-	0x45, 0xc8, 0x24, 0x1b, 0x73, 0xf3, 0x3c, 0x8e, 0x93, 0x53, 0x2c, 0x29, 0x27, 0x23, 0xfb, 0xdf,
-	0x26, 0xfb, 0xff, 0xf9, 0x64, 0x2f, 0xa5, 0x42, 0x74, 0xef, 0x02, 0xeb, 0x3a, 0xa8, 0x48, 0x96,
-	0x83, 0x66, 0x59, 0xb8, 0x54, 0xf6, 0x49, 0x42, 0x71, 0xcc, 0x13, 0xaa, 0xc6, 0x64, 0x54, 0xce,
-	0xb9, 0xeb, 0x76, 0xdb, 0xbe, 0xc8, 0xb3, 0xe0, 0x51, 0xb5, 0xd0, 0xcb, 0xf9, 0xf6, 0x1b, 0xb3,
-	0x84, 0x23, 0x8b, 0xbf, 0x2c, 0xe0, 0x62, 0xf0, 0x51, 0x50, 0xc2, 0x78, 0xa0, 0x48, 0x44, 0xe7,
-	0x86, 0xea, 0x0d, 0x6b, 0xf6, 0x38, 0xcf, 0x02, 0x58, 0x35, 0x5b, 0x42, 0x86, 0xc8, 0x2f, 0xd0,
-	0x43, 0x03, 0x56, 0xe6, 0xeb, 0x10, 0x6c, 0x97, 0x2b, 0x8f, 0xcd, 0xd6, 0x73, 0xaa, 0x35, 0x66,
-	0x64, 0x52, 0xfa, 0x00, 0xeb, 0xf3, 0x24, 0xcf, 0x82, 0xcf, 0xab, 0x3e, 0xcb, 0xd8, 0x66, 0xf2,
-	0x39, 0xf8, 0xa0, 0x44, 0x5f, 0x91, 0x49, 0xe1, 0xf4, 0x06, 0x6c, 0x9a, 0xb1, 0x39, 0x3d, 0x93,
-	0xf0, 0x98, 0x2a, 0x6d, 0x76, 0xfc, 0xa6, 0xdd, 0x9e, 0x99, 0xf3, 0x61, 0x29, 0x0d, 0xa2, 0x75,
-	0x16, 0xf3, 0x5e, 0x19, 0xfe, 0xde, 0x45, 0xbd, 0xaf, 0xc0, 0x9a, 0x1d, 0xc6, 0x32, 0xc5, 0x91,
-	0x50, 0x54, 0xfb, 0xb7, 0x6c, 0xc2, 0x7e, 0x9e, 0x05, 0x1b, 0x33, 0xb3, 0xba, 0x84, 0x21, 0xba,
-	0x69, 0x66, 0xb3, 0x4c, 0xf7, 0xcd, 0x2f, 0xaf, 0x07, 0x36, 0x4d, 0xf6, 0x06, 0x76, 0xf3, 0x49,
-	0x52, 0x15, 0x99, 0x89, 0xbf, 0x36, 0x7f, 0x66, 0x2d, 0xa5, 0x41, 0xe4, 0x31, 0x32, 0xd9, 0x97,
-	0xe9, 0x91, 0x89, 0x76, 0x5d, 0xd0, 0x7b, 0x0e, 0x80, 0xf1, 0x64, 0x94, 0xe1, 0x41, 0xe8, 0xdf,
-	0xb6, 0x4a, 0x9b, 0x79, 0x16, 0xd4, 0x2f, 0xf2, 0x71, 0x18, 0x44, 0xd7, 0x59, 0xcc, 0x5f, 0x51,
-	0x76, 0x18, 0x96, 0x99, 0x18, 0xa0, 0x9a, 0xc9, 0x67, 0xcb, 0x32, 0x59, 0xa0, 0xb9, 0x4c, 0x5e,
-	0x51, 0x56, 0xc9, 0xe4, 0x6b, 0x70, 0xdb, 0xd6, 0x32, 0x11, 0xca, 0x0e, 0xdf, 0xd0, 0xbf, 0x63,
-	0xd5, 0x1a, 0x79, 0x16, 0x6c, 0xce, 0xd4, 0x7a, 0x8a, 0x43, 0x74, 0xcb, 0x14, 0xd9, 0xfd, 0x3e,
-	0x0c, 0x3d, 0x0c, 0x1a, 0xc6, 0xae, 0x24, 0x54, 0x33, 0xab, 0x5b, 0xad, 0x99, 0xcf, 0xea, 0x52,
-	0x2a, 0x44, 0x77, 0x19, 0x99, 0x14, 0xb2, 0x95, 0x0c, 0xbf, 0x33, 0x13, 0xf5, 0xe7, 0x34, 0x56,
-	0xb4, 0x8f, 0x85, 0xa4, 0x1c, 0x9b, 0x11, 0xa9, 0x7d, 0x6f, 0xf7, 0xca, 0xde, 0x5a, 0x75, 0xa2,
-	0x2e, 0x90, 0x20, 0xaa, 0x97, 0xd1, 0xd7, 0x92, 0xf2, 0xae, 0x89, 0x79, 0x13, 0xa3, 0x67, 0xae,
-	0x26, 0xb8, 0x3f, 0x73, 0x37, 0xf1, 0xd7, 0xed, 0xb1, 0xf0, 0xa4, 0xb5, 0xe4, 0x5e, 0xd4, 0x5a,
-	0xbc, 0xca, 0x54, 0x8d, 0x17, 0xd4, 0xec, 0x28, 0x9f, 0x5f, 0xf3, 0xe2, 0x91, 0x39, 0xa2, 0x7e,
-	0xf9, 0xf8, 0xee, 0xe9, 0x76, 0x71, 0x3d, 0x9b, 0x54, 0x2f, 0x68, 0xee, 0xe0, 0xea, 0xb4, 0xde,
-	0x9f, 0x35, 0x6b, 0x1f, 0xce, 0x9a, 0xb5, 0xbf, 0xce, 0x9a, 0xb5, 0x5f, 0xcf, 0x9b, 0x2b, 0x1f,
-	0xce, 0x9b, 0x2b, 0x7f, 0x9e, 0x37, 0x57, 0x7e, 0xd8, 0x98, 0x5b, 0x90, 0x9c, 0x4a, 0xaa, 0xc3,
-	0x6b, 0xf6, 0xce, 0xf5, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0x5a, 0x54, 0xa9, 0xf5,
-	0x09, 0x00, 0x00,
+	// 1343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x57, 0xcd, 0x72, 0xdb, 0x36,
+	0x17, 0xb5, 0xbe, 0x38, 0xf9, 0x12, 0xe4, 0xa7, 0x31, 0x65, 0xd9, 0x94, 0x7f, 0x44, 0x07, 0xcd,
+	0x8f, 0x9b, 0x85, 0x34, 0x69, 0x76, 0x99, 0xce, 0x74, 0x2a, 0xb7, 0x76, 0xd3, 0xd6, 0x8d, 0x06,
+	0x8a, 0xd3, 0x99, 0x6e, 0x50, 0x88, 0x82, 0x24, 0x26, 0x22, 0x80, 0x02, 0x94, 0x25, 0xbf, 0x42,
+	0x57, 0x7d, 0x84, 0x2e, 0xbb, 0xcc, 0x4c, 0x5f, 0x22, 0xcb, 0x2c, 0xbb, 0xe2, 0x74, 0x92, 0x45,
+	0xba, 0xe6, 0xa2, 0xeb, 0x0e, 0x00, 0x52, 0x12, 0x25, 0xda, 0xdd, 0xd8, 0xd4, 0x3d, 0x87, 0xe7,
+	0x5e, 0x5d, 0x00, 0x07, 0x57, 0x60, 0x6f, 0x38, 0x0a, 0xa9, 0x24, 0x0d, 0x35, 0x12, 0x54, 0x32,
+	0xde, 0xa5, 0x8d, 0xd3, 0x47, 0x0d, 0x41, 0x24, 0x09, 0x55, 0x5d, 0x48, 0x1e, 0x71, 0xa7, 0x6c,
+	0x19, 0xf5, 0x29, 0xa3, 0x7e, 0xfa, 0x68, 0x6b, 0x8d, 0x84, 0x01, 0xe3, 0x0d, 0xf3, 0xd7, 0xf2,
+	0xb6, 0xd6, 0xfb, 0xbc, 0xcf, 0xcd, 0x63, 0x43, 0x3f, 0xa5, 0xd1, 0x9a, 0xcf, 0x55, 0xc8, 0x55,
+	0xa3, 0x43, 0x94, 0x96, 0xee, 0xd0, 0x88, 0x3c, 0x6a, 0xf8, 0x3c, 0x60, 0x16, 0x87, 0xff, 0xac,
+	0x02, 0x07, 0xd1, 0x31, 0x91, 0xdd, 0x2f, 0x03, 0x15, 0xc9, 0xa0, 0x33, 0x8a, 0x02, 0xce, 0x9c,
+	0xe7, 0xa0, 0x22, 0xc8, 0x59, 0x48, 0x59, 0x84, 0x05, 0x95, 0x01, 0xef, 0xe2, 0xce, 0x90, 0xfb,
+	0xaf, 0x94, 0x5b, 0xda, 0x2b, 0xed, 0xaf, 0x36, 0xf7, 0x92, 0xd8, 0xdb, 0x39, 0x23, 0xe1, 0xf0,
+	0x09, 0x2c, 0xa4, 0x41, 0x54, 0x4e, 0xe3, 0x2d, 0x13, 0x6e, 0x9a, 0xa8, 0xd3, 0x01, 0x5b, 0x92,
+	0xf6, 0x75, 0x1e, 0xa2, 0xb3, 0xe0, 0x1e, 0xa5, 0x58, 0x0d, 0x88, 0xa4, 0xb8, 0x23, 0x94, 0xfb,
+	0x3f, 0x23, 0x7d, 0x2f, 0x89, 0xbd, 0x3b, 0x56, 0xfa, 0x7c, 0x2e, 0x44, 0x9b, 0xf3, 0xe0, 0x21,
+	0xa5, 0x6d, 0x0d, 0x35, 0x85, 0x72, 0x5e, 0x82, 0xdd, 0x30, 0x60, 0xd8, 0x27, 0xca, 0x27, 0x5d,
+	0x8a, 0x3b, 0x67, 0x11, 0x55, 0xb8, 0xc7, 0x25, 0x4e, 0x0b, 0x72, 0x2f, 0x99, 0x34, 0xfb, 0x49,
+	0xec, 0xdd, 0xb5, 0x69, 0x2e, 0xa4, 0x43, 0x54, 0x0d, 0x03, 0x76, 0x60, 0xe1, 0xa6, 0x46, 0x0f,
+	0xb9, 0x6c, 0x59, 0xcc, 0x39, 0x01, 0x1b, 0x8c, 0x8e, 0xb1, 0x62, 0x58, 0x92, 0x50, 0xe0, 0x91,
+	0x48, 0xbb, 0xa0, 0xdc, 0x55, 0x93, 0xe4, 0x4e, 0x12, 0x7b, 0xbb, 0x36, 0x49, 0x31, 0x0f, 0x22,
+	0x87, 0xd1, 0x71, 0x9b, 0x21, 0x12, 0x8a, 0x13, 0x61, 0x7b, 0xa5, 0x9c, 0x21, 0xd8, 0x0d, 0x29,
+	0x51, 0x23, 0x49, 0x4d, 0x67, 0x55, 0xc8, 0x79, 0x34, 0x08, 0x58, 0x7f, 0xaa, 0x7e, 0x79, 0xe9,
+	0x2b, 0x5c, 0x44, 0x87, 0x68, 0x7b, 0x0e, 0x6f, 0x67, 0x70, 0x96, 0x8d, 0x03, 0x6f, 0xa4, 0x48,
+	0x9f, 0xe2, 0xbe, 0xe4, 0xe3, 0x68, 0x80, 0x7d, 0x22, 0x74, 0x8b, 0xf5, 0xdb, 0xa9, 0x82, 0x7b,
+	0xc5, 0xe4, 0x7b, 0x98, 0xc4, 0xde, 0x7d, 0x9b, 0xef, 0x3f, 0x5e, 0x80, 0x68, 0xcb, 0x30, 0x8e,
+	0x0c, 0xe1, 0x80, 0x88, 0xa6, 0x50, 0x2d, 0x2a, 0x6d, 0xc6, 0x27, 0xab, 0x7f, 0xff, 0xe6, 0x95,
+	0xe0, 0x1f, 0x65, 0x70, 0xa5, 0x65, 0xf6, 0xb9, 0x13, 0x81, 0xf5, 0x30, 0x60, 0x41, 0x38, 0x0a,
+	0xb1, 0x8a, 0xc8, 0x2b, 0x6a, 0xfa, 0xaf, 0x98, 0xd9, 0x6b, 0xd7, 0x3f, 0xad, 0xd6, 0xed, 0x16,
+	0xae, 0xeb, 0x2d, 0x5c, 0x4f, 0xb7, 0x70, 0xfd, 0x80, 0x07, 0xac, 0xb9, 0xff, 0x26, 0xf6, 0x56,
+	0x92, 0xd8, 0xdb, 0x9e, 0x2e, 0xe4, 0x92, 0x08, 0xfc, 0xfd, 0xc3, 0xeb, 0x87, 0x25, 0xb4, 0x96,
+	0x62, 0x6d, 0x0d, 0x1d, 0x72, 0xd9, 0x66, 0xce, 0x33, 0x50, 0x96, 0x54, 0x70, 0x19, 0xe9, 0x56,
+	0x45, 0x03, 0x49, 0xd5, 0x80, 0x0f, 0xbb, 0xe9, 0x2e, 0xac, 0x25, 0xb1, 0xb7, 0x95, 0xed, 0xc2,
+	0x25, 0x12, 0x44, 0xce, 0x34, 0xfa, 0x3c, 0x0b, 0x3a, 0xdf, 0x01, 0x47, 0x0d, 0x89, 0x1a, 0xe4,
+	0xf5, 0xec, 0x76, 0xdb, 0x4d, 0x62, 0xaf, 0x6a, 0xf5, 0x96, 0x39, 0x10, 0xad, 0x65, 0xc1, 0x9c,
+	0x5a, 0x48, 0x23, 0x19, 0xf8, 0x6a, 0x46, 0xb4, 0xfb, 0xea, 0xda, 0xbc, 0xda, 0x32, 0x07, 0xa2,
+	0xb5, 0x34, 0x38, 0x15, 0x53, 0xce, 0x4f, 0xa0, 0x4a, 0x4f, 0x83, 0x2e, 0x65, 0x3e, 0xc5, 0x92,
+	0x46, 0x94, 0x99, 0x33, 0x95, 0x2e, 0xef, 0x65, 0x23, 0x7a, 0x37, 0x89, 0xbd, 0x3d, 0x2b, 0x7a,
+	0x2e, 0x15, 0xa2, 0xcd, 0x0c, 0x43, 0x19, 0x64, 0x57, 0xd5, 0x79, 0x0a, 0xa6, 0x5f, 0x02, 0xf7,
+	0x24, 0xf1, 0x35, 0x64, 0x36, 0xce, 0xb5, 0xe6, 0x4e, 0x12, 0x7b, 0xee, 0xc2, 0x97, 0xcf, 0x28,
+	0x10, 0xdd, 0xce, 0x62, 0x87, 0x69, 0x48, 0x17, 0x1b, 0x30, 0xfd, 0x7c, 0x1a, 0x44, 0x67, 0x58,
+	0x50, 0x46, 0x86, 0xe6, 0xbf, 0x29, 0xf6, 0xff, 0x8b, 0xc5, 0x9e, 0x4b, 0x85, 0x68, 0x73, 0x86,
+	0xb5, 0x2c, 0x94, 0x16, 0xcb, 0x40, 0x2d, 0x6b, 0xdc, 0x48, 0x74, 0x49, 0x44, 0x71, 0xc0, 0x22,
+	0x2a, 0x4f, 0xc9, 0x30, 0xf3, 0xb9, 0xab, 0x66, 0xd9, 0x3e, 0x49, 0x62, 0xef, 0x5e, 0xbe, 0xd1,
+	0xc5, 0x7c, 0x73, 0xc6, 0x0c, 0xe1, 0xc4, 0xe0, 0x4f, 0x53, 0x38, 0x35, 0x3e, 0x0a, 0x32, 0x18,
+	0xf7, 0x25, 0xf1, 0xe9, 0x82, 0xa9, 0x5e, 0x33, 0xc9, 0xee, 0x27, 0xb1, 0x07, 0xf3, 0xc9, 0x0a,
+	0xc8, 0x10, 0xb9, 0x29, 0x7a, 0xa4, 0xc1, 0x9c, 0xbf, 0x0e, 0xc0, 0x4e, 0xf6, 0x66, 0x4f, 0x2f,
+	0x3d, 0xa3, 0x4a, 0xe1, 0x90, 0x4c, 0xb2, 0x3c, 0xc0, 0xe4, 0x79, 0x90, 0xc4, 0xde, 0xc7, 0xf9,
+	0x3c, 0x45, 0x6c, 0xed, 0x7c, 0x16, 0x3e, 0xcc, 0xd0, 0x63, 0x32, 0x49, 0x33, 0x3d, 0x07, 0x15,
+	0x6d, 0x9b, 0xd3, 0x3b, 0x09, 0x9f, 0x52, 0xa9, 0xf4, 0x8a, 0x5f, 0x37, 0xcb, 0x33, 0x77, 0x3f,
+	0x14, 0xd2, 0x20, 0x2a, 0x87, 0x01, 0x6b, 0x67, 0xe1, 0x17, 0x36, 0xea, 0x7c, 0x06, 0x6e, 0x6a,
+	0xba, 0x2f, 0x46, 0xd8, 0xe7, 0x92, 0x2a, 0xf7, 0x86, 0x29, 0xd8, 0x4d, 0x62, 0x6f, 0x7d, 0xa6,
+	0x36, 0x85, 0x21, 0xba, 0xae, 0xbd, 0x59, 0x8c, 0x0e, 0xf4, 0x27, 0xa7, 0x0d, 0x2a, 0xba, 0x7a,
+	0x0d, 0x5b, 0x7f, 0x12, 0x54, 0xfa, 0xda, 0xf1, 0x6f, 0x2e, 0xde, 0x59, 0x85, 0x34, 0x88, 0x9c,
+	0x90, 0x4c, 0x0e, 0xc4, 0xe8, 0x44, 0x47, 0x5b, 0x36, 0xe8, 0x3c, 0x06, 0x40, 0xe7, 0x0c, 0x69,
+	0x88, 0xfb, 0x1d, 0xf7, 0x96, 0x51, 0xaa, 0x24, 0xb1, 0xb7, 0x36, 0xab, 0xc7, 0x62, 0x10, 0x5d,
+	0x0d, 0x03, 0x76, 0x4c, 0xc3, 0xa3, 0x4e, 0x56, 0x89, 0x06, 0xf2, 0x95, 0x7c, 0x54, 0x54, 0xc9,
+	0x12, 0xcd, 0x56, 0x72, 0x4c, 0xc3, 0x5c, 0x25, 0x9f, 0x83, 0x5b, 0xa6, 0x97, 0x11, 0x97, 0xc6,
+	0x7c, 0x3b, 0xee, 0x6d, 0xa3, 0x56, 0x4d, 0x62, 0xaf, 0x32, 0xd7, 0xeb, 0x29, 0x0e, 0xd1, 0x0d,
+	0xdd, 0x64, 0xfb, 0xf9, 0xa8, 0xe3, 0x60, 0x50, 0xd5, 0xe9, 0x32, 0x42, 0xbe, 0xb2, 0x35, 0xa3,
+	0x35, 0x77, 0xac, 0xce, 0xa5, 0x42, 0xb4, 0x11, 0x92, 0x49, 0x2a, 0x9b, 0xab, 0xf0, 0x7b, 0xed,
+	0xa8, 0x3f, 0x8f, 0x02, 0x49, 0xbb, 0x98, 0x0b, 0xca, 0xb0, 0xb6, 0x48, 0xe5, 0x3a, 0x7b, 0x97,
+	0xf6, 0x6f, 0xe6, 0x1d, 0x75, 0x89, 0x04, 0xd1, 0x5a, 0x16, 0x7d, 0x26, 0x28, 0x6b, 0xe9, 0x98,
+	0x33, 0xd1, 0x7a, 0x7a, 0x34, 0xc1, 0xdd, 0xb9, 0xd9, 0xc4, 0x2d, 0x9b, 0x6b, 0xe1, 0x41, 0xbd,
+	0x60, 0x2e, 0xaa, 0x2f, 0x8f, 0x32, 0xf9, 0xc4, 0x4b, 0x6a, 0xc6, 0xca, 0x8b, 0xc6, 0x1f, 0xca,
+	0x22, 0xc9, 0xc5, 0x19, 0x56, 0xe3, 0x20, 0xf2, 0x07, 0x78, 0x40, 0x83, 0xfe, 0x20, 0x72, 0xd7,
+	0xf7, 0x4a, 0xfb, 0x97, 0xe6, 0x17, 0xb0, 0x90, 0x06, 0x51, 0x39, 0x8d, 0xb7, 0x4d, 0xf8, 0x6b,
+	0x13, 0xd5, 0xae, 0x63, 0x8e, 0x16, 0xce, 0x5e, 0x9a, 0xd9, 0x6b, 0x7a, 0x40, 0x2b, 0x8b, 0xae,
+	0x73, 0x31, 0x1f, 0xa2, 0x6d, 0xf3, 0xf0, 0x95, 0xc5, 0xa7, 0x96, 0x3c, 0x73, 0x1d, 0x45, 0x87,
+	0xbd, 0xf4, 0x3e, 0x1c, 0xb1, 0x0e, 0x67, 0x5d, 0xed, 0xbd, 0x69, 0xb2, 0x8d, 0x45, 0xd7, 0xb9,
+	0x80, 0x0c, 0x91, 0xab, 0x51, 0x73, 0x7d, 0x9e, 0x64, 0xd8, 0x6c, 0xaa, 0x33, 0x16, 0x8e, 0x89,
+	0x10, 0x94, 0x0c, 0xf1, 0x38, 0x60, 0x5d, 0x3e, 0xce, 0xb2, 0x6c, 0x2e, 0x4e, 0x75, 0xe7, 0x73,
+	0x21, 0xda, 0x34, 0xe0, 0x17, 0x06, 0xfb, 0xc1, 0x40, 0x69, 0x8e, 0x17, 0x60, 0xc3, 0xbe, 0xf7,
+	0x92, 0x04, 0x43, 0xac, 0x27, 0x81, 0x4c, 0xdf, 0x5d, 0x9c, 0xb4, 0x8a, 0x79, 0x10, 0x95, 0x0d,
+	0xf0, 0x0d, 0x09, 0x86, 0x4d, 0xa2, 0x68, 0xaa, 0xdb, 0x06, 0x95, 0x39, 0xfe, 0x9c, 0x55, 0x56,
+	0x17, 0x4f, 0x6a, 0x21, 0x0d, 0x22, 0x67, 0xaa, 0x3a, 0x33, 0x47, 0xdd, 0x77, 0xc3, 0xe6, 0xbd,
+	0x1e, 0x65, 0x8a, 0x2e, 0x74, 0x64, 0x6b, 0xa9, 0xef, 0xe7, 0x93, 0x75, 0xdf, 0x35, 0xfa, 0xcc,
+	0x82, 0xb9, 0x9e, 0x7c, 0x9b, 0xce, 0x1b, 0xa6, 0x9e, 0xe9, 0x95, 0xbb, 0xbd, 0x38, 0x21, 0x2c,
+	0x73, 0xb2, 0x3b, 0xf7, 0x98, 0x4c, 0xb2, 0x3b, 0xf7, 0xc9, 0x3d, 0x3d, 0x94, 0xfd, 0xf2, 0xe1,
+	0xf5, 0xc3, 0x9d, 0xf4, 0x07, 0xc9, 0x24, 0xff, 0x93, 0xc4, 0x8e, 0x6a, 0xcd, 0xfa, 0x9b, 0x77,
+	0xb5, 0xd2, 0xdb, 0x77, 0xb5, 0xd2, 0x5f, 0xef, 0x6a, 0xa5, 0x5f, 0xdf, 0xd7, 0x56, 0xde, 0xbe,
+	0xaf, 0xad, 0xfc, 0xf9, 0xbe, 0xb6, 0xf2, 0xe3, 0xfa, 0xc2, 0x0b, 0xd1, 0x99, 0xa0, 0xaa, 0x73,
+	0xc5, 0xfc, 0xca, 0x78, 0xfc, 0xef, 0x00, 0xf7, 0xbf, 0x8c, 0x5a, 0xe7, 0x0c, 0x00, 0x00,
 }
 
 func (this *RewardDistribution) Equal(that interface{}) bool {
@@ -515,6 +604,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RewardDistribution.Equal(that1.RewardDistribution) {
 		return false
 	}
+	if this.EntropySwitchHeight != that1.EntropySwitchHeight {
+		return false
+	}
+	if this.BlockEntropyRetentionBlocks != that1.BlockEntropyRetentionBlocks {
+		return false
+	}
+	if this.SelfStakeUnbondingBlocks != that1.SelfStakeUnbondingBlocks {
+		return false
+	}
+	if this.SlashAppealWindowBlocks != that1.SlashAppealWindowBlocks {
+		return false
+	}
+	if this.SlashJailBaseBlocks != that1.SlashJailBaseBlocks {
+		return false
+	}
+	if this.SlashJailMaxBlocks != that1.SlashJailMaxBlocks {
+		return false
+	}
+	if this.SlashOffenseWindowBlocks != that1.SlashOffenseWindowBlocks {
+		return false
+	}
+	if this.SlashMaxFraction != that1.SlashMaxFraction {
+		return false
+	}
 	return true
 }
 func (m *RewardDistribution) Marshal() (dAtA []byte, err error) {
@@ -590,6 +703,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashMaxFraction) > 0 {
+		i -= len(m.SlashMaxFraction)
+		copy(dAtA[i:], m.SlashMaxFraction)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SlashMaxFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.SlashOffenseWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashOffenseWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.SlashJailMaxBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashJailMaxBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.SlashJailBaseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashJailBaseBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.SlashAppealWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashAppealWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SelfStakeUnbondingBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SelfStakeUnbondingBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.BlockEntropyRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockEntropyRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.EntropySwitchHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EntropySwitchHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.RewardDistribution != nil {
 		{
 			size, err := m.RewardDistribution.MarshalToSizedBuffer(dAtA[:i])
@@ -841,6 +1012,31 @@ func (m *Params) Size() (n int) {
 		l = m.RewardDistribution.Size()
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.EntropySwitchHeight != 0 {
+		n += 2 + sovParams(uint64(m.EntropySwitchHeight))
+	}
+	if m.BlockEntropyRetentionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.BlockEntropyRetentionBlocks))
+	}
+	if m.SelfStakeUnbondingBlocks != 0 {
+		n += 2 + sovParams(uint64(m.SelfStakeUnbondingBlocks))
+	}
+	if m.SlashAppealWindowBlocks != 0 {
+		n += 2 + sovParams(uint64(m.SlashAppealWindowBlocks))
+	}
+	if m.SlashJailBaseBlocks != 0 {
+		n += 2 + sovParams(uint64(m.SlashJailBaseBlocks))
+	}
+	if m.SlashJailMaxBlocks != 0 {
+		n += 2 + sovParams(uint64(m.SlashJailMaxBlocks))
+	}
+	if m.SlashOffenseWindowBlocks != 0 {
+		n += 2 + sovParams(uint64(m.SlashOffenseWindowBlocks))
+	}
+	l = len(m.SlashMaxFraction)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1557,6 +1753,171 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntropySwitchHeight", wireType)
+			}
+			m.EntropySwitchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntropySwitchHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEntropyRetentionBlocks", wireType)
+			}
+			m.BlockEntropyRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockEntropyRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfStakeUnbondingBlocks", wireType)
+			}
+			m.SelfStakeUnbondingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfStakeUnbondingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAppealWindowBlocks", wireType)
+			}
+			m.SlashAppealWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashAppealWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashJailBaseBlocks", wireType)
+			}
+			m.SlashJailBaseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashJailBaseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashJailMaxBlocks", wireType)
+			}
+			m.SlashJailMaxBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashJailMaxBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashOffenseWindowBlocks", wireType)
+			}
+			m.SlashOffenseWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashOffenseWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMaxFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashMaxFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -828,10 +830,24 @@ type PayoutHistoryEntry struct {
 	ValidatorAddress string                                   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	SupernodeAccount string                                   `protobuf:"bytes,3,opt,name=supernode_account,json=supernodeAccount,proto3" json:"supernode_account,omitempty"`
 	Amount           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	RawBytes         float64                                  `protobuf:"fixed64,5,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
-	SmoothedBytes    float64                                  `protobuf:"fixed64,6,opt,name=smoothed_bytes,json=smoothedBytes,proto3" json:"smoothed_bytes,omitempty"`
-	EffectiveWeight  float64                                  `protobuf:"fixed64,7,opt,name=effective_weight,json=effectiveWeight,proto3" json:"effective_weight,omitempty"`
-	RampWeight       float64                                  `protobuf:"fixed64,8,opt,name=ramp_weight,json=rampWeight,proto3" json:"ramp_weight,omitempty"`
+	// Float-encoded values written before the fixed-point distribution pipeline.
+	// The v1->v2 migration moves them into the decimal fields below and clears them.
+	LegacyRawBytes        float64                     `protobuf:"fixed64,5,opt,name=legacy_raw_bytes,json=legacyRawBytes,proto3" json:"legacy_raw_bytes,omitempty"`
+	LegacySmoothedBytes   float64                     `protobuf:"fixed64,6,opt,name=legacy_smoothed_bytes,json=legacySmoothedBytes,proto3" json:"legacy_smoothed_bytes,omitempty"`
+	LegacyEffectiveWeight float64                     `protobuf:"fixed64,7,opt,name=legacy_effective_weight,json=legacyEffectiveWeight,proto3" json:"legacy_effective_weight,omitempty"`
+	LegacyRampWeight      float64                     `protobuf:"fixed64,8,opt,name=legacy_ramp_weight,json=legacyRampWeight,proto3" json:"legacy_ramp_weight,omitempty"`
+	RawBytes              cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=raw_bytes,json=rawBytes,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"raw_bytes"`
+	SmoothedBytes         cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=smoothed_bytes,json=smoothedBytes,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"smoothed_bytes"`
+	EffectiveWeight       cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=effective_weight,json=effectiveWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_weight"`
+	RampWeight            cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=ramp_weight,json=rampWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ramp_weight"`
+	// Split of amount between the supernode account (commission) and the
+	// delegators of the backing validator. delegator_rewards is the amount
+	// allocated to the validator, before its staking commission is taken.
+	// Entries written before supernode commissions existed leave these fields
+	// empty.
+	CommissionRate   cosmossdk_io_math.LegacyDec              `protobuf:"bytes,13,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	Commission       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission"`
+	DelegatorRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=delegator_rewards,json=delegatorRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegator_rewards"`
 }
 
 func (m *PayoutHistoryEntry) Reset()         { *m = PayoutHistoryEntry{} }
@@ -895,34 +911,48 @@ func (m *PayoutHistoryEntry) GetAmount() github_com_cosmos_cosmos_sdk_types.Coin
 	return nil
 }
 
-func (m *PayoutHistoryEntry) GetRawBytes() float64 {
+func (m *PayoutHistoryEntry) GetLegacyRawBytes() float64 {
 	if m != nil {
-		return m.RawBytes
+		return m.LegacyRawBytes
 	}
 	return 0
 }
 
-func (m *PayoutHistoryEntry) GetSmoothedBytes() float64 {
+func (m *PayoutHistoryEntry) GetLegacySmoothedBytes() float64 {
 	if m != nil {
-		return m.SmoothedBytes
+		return m.LegacySmoothedBytes
 	}
 	return 0
 }
 
-func (m *PayoutHistoryEntry) GetEffectiveWeight() float64 {
+func (m *PayoutHistoryEntry) GetLegacyEffectiveWeight() float64 {
 	if m != nil {
-		return m.EffectiveWeight
+		return m.LegacyEffectiveWeight
 	}
 	return 0
 }
 
-func (m *PayoutHistoryEntry) GetRampWeight() float64 {
+func (m *PayoutHistoryEntry) GetLegacyRampWeight() float64 {
 	if m != nil {
-		return m.RampWeight
+		return m.LegacyRampWeight
 	}
 	return 0
 }
 
+func (m *PayoutHistoryEntry) GetCommission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *PayoutHistoryEntry) GetDelegatorRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatorRewards
+	}
+	return nil
+}
+
 type QueryPayoutHistoryRequest struct {
 	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1027,1035 +1057,1253 @@ func (m *QueryPayoutHistoryResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "lumera.supernode.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "lumera.supernode.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetSuperNodeRequest)(nil), "lumera.supernode.v1.QueryGetSuperNodeRequest")
-	proto.RegisterType((*QueryGetSuperNodeResponse)(nil), "lumera.supernode.v1.QueryGetSuperNodeResponse")
-	proto.RegisterType((*QueryGetSuperNodeBySuperNodeAddressRequest)(nil), "lumera.supernode.v1.QueryGetSuperNodeBySuperNodeAddressRequest")
-	proto.RegisterType((*QueryGetSuperNodeBySuperNodeAddressResponse)(nil), "lumera.supernode.v1.QueryGetSuperNodeBySuperNodeAddressResponse")
-	proto.RegisterType((*QueryListSuperNodesRequest)(nil), "lumera.supernode.v1.QueryListSuperNodesRequest")
-	proto.RegisterType((*QueryListSuperNodesResponse)(nil), "lumera.supernode.v1.QueryListSuperNodesResponse")
-	proto.RegisterType((*QueryGetTopSuperNodesForBlockRequest)(nil), "lumera.supernode.v1.QueryGetTopSuperNodesForBlockRequest")
-	proto.RegisterType((*QueryGetTopSuperNodesForBlockResponse)(nil), "lumera.supernode.v1.QueryGetTopSuperNodesForBlockResponse")
-	proto.RegisterType((*QueryGetMetricsRequest)(nil), "lumera.supernode.v1.QueryGetMetricsRequest")
-	proto.RegisterType((*QueryGetMetricsResponse)(nil), "lumera.supernode.v1.QueryGetMetricsResponse")
-	proto.RegisterType((*QueryPoolStateRequest)(nil), "lumera.supernode.v1.QueryPoolStateRequest")
-	proto.RegisterType((*QueryPoolStateResponse)(nil), "lumera.supernode.v1.QueryPoolStateResponse")
-	proto.RegisterType((*QuerySNEligibilityRequest)(nil), "lumera.supernode.v1.QuerySNEligibilityRequest")
-	proto.RegisterType((*QuerySNEligibilityResponse)(nil), "lumera.supernode.v1.QuerySNEligibilityResponse")
-	proto.RegisterType((*PayoutHistoryEntry)(nil), "lumera.supernode.v1.PayoutHistoryEntry")
-	proto.RegisterType((*QueryPayoutHistoryRequest)(nil), "lumera.supernode.v1.QueryPayoutHistoryRequest")
-	proto.RegisterType((*QueryPayoutHistoryResponse)(nil), "lumera.supernode.v1.QueryPayoutHistoryResponse")
+type QuerySelfStakeUnbondingsRequest struct {
+	OperatorAccount string `protobuf:"bytes,1,opt,name=operator_account,json=operatorAccount,proto3" json:"operator_account,omitempty"`
 }
 
-func init() { proto.RegisterFile("lumera/supernode/v1/query.proto", fileDescriptor_8a55c130d1e51715) }
-
-var fileDescriptor_8a55c130d1e51715 = []byte{
-	// 1391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6c, 0xd4, 0xc6,
-	0x17, 0x8f, 0xf3, 0x45, 0xf2, 0x20, 0x90, 0x0c, 0xf9, 0x87, 0x65, 0xf9, 0x6b, 0x93, 0x5a, 0x7c,
-	0x84, 0x0d, 0xd8, 0x84, 0x56, 0xa8, 0x20, 0x44, 0xe9, 0x12, 0x92, 0x48, 0xe5, 0x23, 0x38, 0xad,
-	0xa0, 0xbd, 0x58, 0xb3, 0xf6, 0xb0, 0xeb, 0xc6, 0xf6, 0x2c, 0xf6, 0x6c, 0x60, 0x85, 0x72, 0xe9,
-	0xa1, 0xd7, 0x22, 0xf5, 0xda, 0x73, 0x55, 0xf5, 0xd0, 0x0f, 0xa9, 0xc7, 0x1e, 0x7a, 0xe4, 0x88,
-	0xd4, 0x4b, 0x4f, 0x50, 0x91, 0x4a, 0x95, 0x7a, 0xec, 0xb5, 0xaa, 0x54, 0x79, 0x66, 0xec, 0xf5,
-	0x6e, 0xbc, 0x1b, 0x13, 0xe5, 0x92, 0x78, 0xde, 0xbc, 0x8f, 0xdf, 0x7b, 0xf3, 0xe6, 0xcd, 0x4f,
-	0x0b, 0xb3, 0x6e, 0xd3, 0x23, 0x01, 0xd6, 0xc3, 0x66, 0x83, 0x04, 0x3e, 0xb5, 0x89, 0xbe, 0xb9,
-	0xa8, 0x3f, 0x6a, 0x92, 0xa0, 0xa5, 0x35, 0x02, 0xca, 0x28, 0x3a, 0x2a, 0x14, 0xb4, 0x44, 0x41,
-	0xdb, 0x5c, 0x2c, 0x4e, 0x61, 0xcf, 0xf1, 0xa9, 0xce, 0xff, 0x0a, 0xbd, 0xe2, 0x74, 0x8d, 0xd6,
-	0x28, 0xff, 0xd4, 0xa3, 0x2f, 0x29, 0xfd, 0x7f, 0x8d, 0xd2, 0x9a, 0x4b, 0x74, 0xdc, 0x70, 0x74,
-	0xec, 0xfb, 0x94, 0x61, 0xe6, 0x50, 0x3f, 0x94, 0xbb, 0x65, 0x8b, 0x86, 0x1e, 0x0d, 0xf5, 0x2a,
-	0x0e, 0x89, 0x08, 0xaa, 0x6f, 0x2e, 0x56, 0x09, 0xc3, 0x8b, 0x7a, 0x03, 0xd7, 0x1c, 0x9f, 0x2b,
-	0x4b, 0xdd, 0x52, 0x5a, 0x37, 0xd6, 0xb2, 0xa8, 0x13, 0xef, 0xcf, 0x65, 0x25, 0xd2, 0xc0, 0x01,
-	0xf6, 0xe2, 0x68, 0x27, 0xb3, 0x34, 0xf8, 0xc2, 0xe4, 0x79, 0x09, 0xad, 0xb3, 0x3d, 0xb5, 0xa2,
-	0x85, 0x19, 0x32, 0xcc, 0x62, 0xd5, 0xb7, 0xb2, 0x54, 0x3d, 0xc2, 0x02, 0xc7, 0x92, 0x31, 0xd5,
-	0x69, 0x40, 0xf7, 0xa2, 0xbc, 0xd6, 0x38, 0x10, 0x83, 0x3c, 0x6a, 0x92, 0x90, 0xa9, 0x1f, 0xc1,
-	0xd1, 0x0e, 0x69, 0xd8, 0xa0, 0x7e, 0x48, 0xd0, 0x35, 0x18, 0x15, 0x80, 0x0b, 0xca, 0x9c, 0x32,
-	0x7f, 0xf0, 0xe2, 0x09, 0x2d, 0xa3, 0xf6, 0x9a, 0x30, 0xaa, 0x8c, 0x3f, 0x7f, 0x39, 0x3b, 0xf0,
-	0xcd, 0x9f, 0x3f, 0x94, 0x15, 0x43, 0x5a, 0xa9, 0xcb, 0x50, 0xe0, 0x6e, 0x57, 0x08, 0x5b, 0x8f,
-	0x2c, 0xee, 0x50, 0x9b, 0xc8, 0x90, 0xa8, 0x0c, 0x93, 0x9b, 0xd8, 0x75, 0x6c, 0xcc, 0x68, 0xf0,
-	0xbe, 0x6d, 0x07, 0x24, 0x14, 0x51, 0xc6, 0x8d, 0x1d, 0x72, 0xf5, 0x63, 0x38, 0x9e, 0xe1, 0x47,
-	0x82, 0xbc, 0x0a, 0xe3, 0x09, 0x1c, 0x89, 0xb3, 0x94, 0x89, 0xb3, 0x6d, 0xda, 0x36, 0x50, 0x1f,
-	0x40, 0x79, 0x87, 0xeb, 0x4a, 0x2b, 0xf9, 0x94, 0x08, 0x52, 0xa0, 0x13, 0xd3, 0x2e, 0xd0, 0xdd,
-	0x72, 0x75, 0x03, 0x16, 0x72, 0x79, 0xde, 0x97, 0x34, 0x6c, 0x28, 0xf2, 0x60, 0xb7, 0x9c, 0xb0,
-	0x1d, 0x2d, 0x81, 0xbd, 0x0c, 0xd0, 0x6e, 0x5f, 0xe9, 0xfc, 0xb4, 0x26, 0xfa, 0x57, 0x8b, 0xfa,
-	0x57, 0x13, 0x17, 0x4c, 0x76, 0xb1, 0xb6, 0x86, 0x6b, 0xf1, 0x39, 0x19, 0x29, 0x4b, 0xf5, 0x6b,
-	0x05, 0x4e, 0x64, 0x86, 0x49, 0xfa, 0x05, 0x12, 0x48, 0x51, 0x61, 0x86, 0x72, 0x24, 0x91, 0xb2,
-	0x40, 0x2b, 0x1d, 0x38, 0x07, 0x39, 0xce, 0x33, 0xbb, 0xe2, 0x14, 0xc1, 0x3b, 0x80, 0x7e, 0xae,
-	0xc0, 0xc9, 0xb8, 0xf8, 0x1f, 0xd2, 0x46, 0x1b, 0xea, 0x32, 0x0d, 0x2a, 0x2e, 0xb5, 0x36, 0xe2,
-	0xca, 0xcc, 0xc1, 0xc1, 0x6a, 0xb4, 0x5e, 0x25, 0x4e, 0xad, 0xce, 0x78, 0x69, 0x46, 0x8c, 0xb4,
-	0x08, 0x4d, 0xc3, 0x88, 0xeb, 0x78, 0x0e, 0xe3, 0x70, 0x46, 0x0c, 0xb1, 0x40, 0xa7, 0x61, 0x84,
-	0x5f, 0xbc, 0xc2, 0x50, 0x74, 0xfa, 0x95, 0xc9, 0xbf, 0x5f, 0xce, 0x1e, 0x6a, 0x61, 0xcf, 0xbd,
-	0xa2, 0x72, 0xb1, 0x6a, 0x88, 0x6d, 0xb5, 0x06, 0xa7, 0x76, 0xc1, 0xb1, 0x3f, 0xa5, 0x53, 0x97,
-	0x60, 0x26, 0x0e, 0x74, 0x5b, 0x5c, 0xf8, 0xbd, 0x5c, 0xb4, 0x4f, 0xe1, 0xd8, 0x0e, 0x2f, 0x12,
-	0xe0, 0x5d, 0x98, 0x90, 0x93, 0x44, 0x8c, 0x1c, 0xd9, 0x46, 0xe5, 0xde, 0x18, 0xa3, 0x85, 0xf4,
-	0xb2, 0x1e, 0x59, 0x18, 0x87, 0xbc, 0xd4, 0x4a, 0x3d, 0x06, 0xff, 0x13, 0x33, 0x87, 0x52, 0x57,
-	0xec, 0xcb, 0x61, 0xf4, 0x6a, 0x50, 0xe6, 0x92, 0xda, 0x91, 0x20, 0x08, 0x1c, 0xa8, 0x62, 0x17,
-	0xfb, 0x16, 0x91, 0x25, 0x3a, 0xde, 0xd1, 0x1d, 0x71, 0x5f, 0xdc, 0xa0, 0x8e, 0x5f, 0xb9, 0x10,
-	0xcd, 0xa3, 0x6f, 0x5f, 0xcd, 0xce, 0xd7, 0x1c, 0x56, 0x6f, 0x56, 0x35, 0x8b, 0x7a, 0xba, 0x1c,
-	0xd9, 0xe2, 0xdf, 0xf9, 0xd0, 0xde, 0xd0, 0x59, 0xab, 0x41, 0x42, 0x6e, 0x10, 0x1a, 0xb1, 0x6f,
-	0xf4, 0x2e, 0x14, 0x5c, 0x1c, 0x32, 0xd3, 0x76, 0x42, 0x16, 0x38, 0xd5, 0x66, 0xd4, 0x53, 0x66,
-	0x5d, 0xb4, 0x48, 0xd4, 0x06, 0x43, 0xc6, 0x4c, 0xb4, 0xbf, 0x94, 0xda, 0x96, 0xdd, 0xf2, 0x04,
-	0xa6, 0x18, 0x65, 0xd8, 0x6d, 0x9b, 0x12, 0xbb, 0x30, 0xb4, 0xff, 0x50, 0x27, 0x79, 0x94, 0xa5,
-	0x76, 0x10, 0x54, 0x86, 0x29, 0xe2, 0x3a, 0x35, 0xa7, 0xea, 0x12, 0x33, 0xf4, 0x4d, 0x8b, 0x36,
-	0x7d, 0x56, 0x18, 0x9e, 0x53, 0xe6, 0x87, 0x8d, 0x23, 0xf1, 0xc6, 0xba, 0x7f, 0x23, 0x12, 0xab,
-	0xab, 0x72, 0x9e, 0xae, 0xdf, 0xb9, 0xc9, 0x77, 0x1c, 0xd7, 0x61, 0xad, 0xb8, 0x5f, 0x16, 0x60,
-	0x2a, 0xe9, 0x0b, 0x13, 0xef, 0xd2, 0x30, 0x3f, 0x29, 0x72, 0xf0, 0x74, 0xb9, 0x92, 0xe7, 0x55,
-	0x84, 0xb1, 0x38, 0x36, 0x77, 0x31, 0x66, 0x24, 0x6b, 0x34, 0x03, 0xa3, 0x01, 0xc1, 0xa1, 0xbc,
-	0xe8, 0xe3, 0x86, 0x5c, 0xa1, 0xcb, 0x70, 0xdc, 0xc2, 0xa1, 0x85, 0x6d, 0x62, 0x6e, 0x60, 0x9b,
-	0x78, 0xae, 0x83, 0x4d, 0xbb, 0x6a, 0x56, 0x5b, 0x8c, 0x84, 0xfc, 0xba, 0x29, 0xc6, 0x8c, 0x54,
-	0xf8, 0x40, 0xee, 0x2f, 0x55, 0x2b, 0xd1, 0x2e, 0x3a, 0x03, 0x47, 0x42, 0x8f, 0x52, 0x56, 0x27,
-	0xb6, 0xf9, 0x58, 0x1c, 0xd7, 0x30, 0x37, 0x38, 0x1c, 0x8b, 0xef, 0x73, 0xa9, 0xfa, 0xcf, 0x20,
-	0xa0, 0x35, 0xdc, 0xa2, 0x4d, 0xb6, 0xea, 0x84, 0x8c, 0x06, 0xad, 0x9b, 0x3e, 0x0b, 0x5a, 0x11,
-	0xa4, 0x7a, 0x7b, 0x10, 0x0c, 0x19, 0x72, 0x95, 0x5d, 0x92, 0xc1, 0xec, 0x92, 0x44, 0xca, 0xed,
-	0xd7, 0x19, 0x5b, 0xe2, 0x20, 0x86, 0xba, 0x1f, 0x09, 0x21, 0x47, 0x16, 0x8c, 0x62, 0x4f, 0x1e,
-	0xd5, 0xbe, 0x37, 0x89, 0x74, 0x8d, 0x4e, 0xc0, 0x78, 0x80, 0x1f, 0xcb, 0x0a, 0x8e, 0xf0, 0x82,
-	0x8c, 0x05, 0xf8, 0xb1, 0xa8, 0xd9, 0x29, 0x48, 0x8a, 0x23, 0x35, 0x46, 0xb9, 0xc6, 0x44, 0x2c,
-	0x15, 0x6a, 0x67, 0x61, 0x92, 0x3c, 0x7c, 0x48, 0x2c, 0xe6, 0x6c, 0x92, 0xb8, 0xb6, 0x07, 0xb8,
-	0xe2, 0x91, 0x44, 0x2e, 0x8a, 0x8b, 0x66, 0xe1, 0x60, 0x80, 0xbd, 0x46, 0xac, 0x35, 0xc6, 0xb5,
-	0x20, 0x12, 0xc9, 0xea, 0x3f, 0x53, 0x64, 0xff, 0x75, 0x1c, 0xc1, 0x5e, 0xfa, 0xaf, 0xeb, 0x65,
-	0x1b, 0xdc, 0xf3, 0xcb, 0xf6, 0x5d, 0xdc, 0xc7, 0x5d, 0x90, 0x64, 0x1f, 0xaf, 0xc0, 0x01, 0xe2,
-	0xb3, 0xc0, 0x49, 0x46, 0xf3, 0x99, 0x1e, 0x4c, 0xa8, 0xbb, 0xa5, 0x2a, 0xc3, 0xd1, 0xa9, 0x19,
-	0xb1, 0xf5, 0xbe, 0xbd, 0x70, 0x17, 0x5f, 0x4c, 0xc0, 0x08, 0x07, 0x8c, 0xbe, 0x50, 0x60, 0x54,
-	0x50, 0x30, 0x94, 0x8d, 0x6a, 0x27, 0xdf, 0x2b, 0xce, 0xef, 0xae, 0x28, 0x62, 0xaa, 0x17, 0x3f,
-	0xfb, 0xf5, 0x8f, 0x2f, 0x07, 0xcf, 0xa1, 0xb2, 0x7e, 0x8b, 0x5b, 0xac, 0x45, 0x2c, 0xd2, 0xa2,
-	0xae, 0xde, 0x9b, 0xdd, 0xa2, 0x9f, 0x15, 0x38, 0x94, 0x66, 0x3d, 0xe8, 0x7c, 0xef, 0x70, 0x19,
-	0xd4, 0xb0, 0xa8, 0xe5, 0x55, 0x97, 0x18, 0x6f, 0x73, 0x8c, 0x2b, 0xe8, 0x66, 0x1e, 0x8c, 0x35,
-	0xc2, 0xcc, 0x36, 0xc7, 0xd6, 0x9f, 0x76, 0xb7, 0xd4, 0x16, 0xfa, 0x57, 0x81, 0x52, 0x7f, 0xd2,
-	0x86, 0xde, 0xcb, 0x87, 0xb0, 0x27, 0x91, 0x2c, 0x5e, 0xdf, 0xbb, 0x03, 0x99, 0xf4, 0x03, 0x9e,
-	0xb4, 0x81, 0xd6, 0xde, 0x3c, 0x69, 0xb3, 0xda, 0x8a, 0x2f, 0x96, 0xfe, 0xb4, 0x9b, 0xb7, 0x6e,
-	0xa1, 0x1f, 0x15, 0x38, 0xdc, 0x49, 0xf0, 0x90, 0xde, 0x1b, 0x6e, 0x26, 0xe3, 0x2c, 0x5e, 0xc8,
-	0x6f, 0x20, 0xf3, 0xb9, 0xca, 0xf3, 0xb9, 0x84, 0xde, 0xc9, 0x93, 0x8f, 0xeb, 0x84, 0xe9, 0x84,
-	0x42, 0xf4, 0x97, 0x02, 0x85, 0x5e, 0x1c, 0x0b, 0x5d, 0xee, 0x5b, 0xec, 0x7e, 0xfc, 0xb0, 0x78,
-	0x65, 0x2f, 0xa6, 0x32, 0xa3, 0xfb, 0x3c, 0xa3, 0x7b, 0xe8, 0x6e, 0xde, 0x13, 0x62, 0xb4, 0x91,
-	0x4e, 0xca, 0x7c, 0x48, 0x03, 0x93, 0x53, 0x51, 0xfd, 0x69, 0x8a, 0x91, 0x6e, 0xa1, 0xef, 0x15,
-	0x80, 0x36, 0x43, 0x43, 0x0b, 0x7d, 0x31, 0x76, 0xb2, 0xc1, 0xe2, 0xb9, 0x7c, 0xca, 0x32, 0x85,
-	0x65, 0x9e, 0xc2, 0x75, 0x74, 0x2d, 0x4f, 0x0a, 0x92, 0xdd, 0x65, 0x5d, 0xa9, 0xaf, 0x14, 0x18,
-	0x4f, 0xd8, 0x1c, 0x2a, 0xf7, 0x99, 0x3e, 0x5d, 0x64, 0xb0, 0xb8, 0x90, 0x4b, 0x57, 0xc2, 0xbd,
-	0xc4, 0xe1, 0x5e, 0x40, 0x5a, 0xae, 0x61, 0x45, 0xa9, 0x2b, 0xa8, 0x2c, 0xfa, 0x45, 0x81, 0x89,
-	0x0e, 0x02, 0x83, 0xfa, 0x8c, 0xa0, 0x2c, 0xd2, 0x54, 0xd4, 0x73, 0xeb, 0x4b, 0xa8, 0x77, 0x38,
-	0xd4, 0x55, 0xb4, 0x9c, 0x07, 0x6a, 0xe8, 0x9b, 0xa4, 0xed, 0x23, 0x55, 0xe0, 0xf8, 0x1a, 0x6f,
-	0xf1, 0x14, 0x3a, 0x9e, 0x9f, 0x7e, 0x29, 0x64, 0xbd, 0xbb, 0xfd, 0x52, 0xc8, 0x7c, 0x14, 0xdf,
-	0x2c, 0x85, 0x06, 0x77, 0x61, 0xd6, 0x85, 0x8f, 0xac, 0x14, 0x2a, 0xda, 0xf3, 0xd7, 0x25, 0xe5,
-	0xc5, 0xeb, 0x92, 0xf2, 0xfb, 0xeb, 0x92, 0xf2, 0x6c, 0xbb, 0x34, 0xf0, 0x62, 0xbb, 0x34, 0xf0,
-	0xdb, 0x76, 0x69, 0xe0, 0x93, 0xe9, 0x27, 0x9d, 0xce, 0x38, 0xc9, 0xa9, 0x8e, 0xf2, 0x5f, 0x34,
-	0xde, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x04, 0x19, 0x8a, 0x95, 0x32, 0x12, 0x00, 0x00,
+func (m *QuerySelfStakeUnbondingsRequest) Reset()         { *m = QuerySelfStakeUnbondingsRequest{} }
+func (m *QuerySelfStakeUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySelfStakeUnbondingsRequest) ProtoMessage()    {}
+func (*QuerySelfStakeUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{19}
+}
+func (m *QuerySelfStakeUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelfStakeUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelfStakeUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySelfStakeUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelfStakeUnbondingsRequest.Merge(m, src)
+}
+func (m *QuerySelfStakeUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelfStakeUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelfStakeUnbondingsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySelfStakeUnbondingsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a SuperNode by validatorAddress.
-	GetSuperNode(ctx context.Context, in *QueryGetSuperNodeRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeResponse, error)
-	// Queries a SuperNode by supernodeAddress.
-	GetSuperNodeBySuperNodeAddress(ctx context.Context, in *QueryGetSuperNodeBySuperNodeAddressRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeBySuperNodeAddressResponse, error)
-	// Queries a list of SuperNodes.
-	ListSuperNodes(ctx context.Context, in *QueryListSuperNodesRequest, opts ...grpc.CallOption) (*QueryListSuperNodesResponse, error)
-	// Queries a list of GetTopSuperNodesForBlock items.
-	GetTopSuperNodesForBlock(ctx context.Context, in *QueryGetTopSuperNodesForBlockRequest, opts ...grpc.CallOption) (*QueryGetTopSuperNodesForBlockResponse, error)
-	// Queries the latest metrics state for a validator.
-	GetMetrics(ctx context.Context, in *QueryGetMetricsRequest, opts ...grpc.CallOption) (*QueryGetMetricsResponse, error)
-	// PoolState queries the current state of the Everlight pool.
-	PoolState(ctx context.Context, in *QueryPoolStateRequest, opts ...grpc.CallOption) (*QueryPoolStateResponse, error)
-	// SNEligibility queries whether a specific SuperNode is eligible for payouts.
-	SNEligibility(ctx context.Context, in *QuerySNEligibilityRequest, opts ...grpc.CallOption) (*QuerySNEligibilityResponse, error)
-	// PayoutHistory returns distribution payout history for a validator.
-	PayoutHistory(ctx context.Context, in *QueryPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryPayoutHistoryResponse, error)
+func (m *QuerySelfStakeUnbondingsRequest) GetOperatorAccount() string {
+	if m != nil {
+		return m.OperatorAccount
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QuerySelfStakeUnbondingsResponse struct {
+	Unbondings []SelfStakeUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySelfStakeUnbondingsResponse) Reset()         { *m = QuerySelfStakeUnbondingsResponse{} }
+func (m *QuerySelfStakeUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySelfStakeUnbondingsResponse) ProtoMessage()    {}
+func (*QuerySelfStakeUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{20}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySelfStakeUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySelfStakeUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySelfStakeUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySelfStakeUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySelfStakeUnbondingsResponse.Merge(m, src)
+}
+func (m *QuerySelfStakeUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySelfStakeUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySelfStakeUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetSuperNode(ctx context.Context, in *QueryGetSuperNodeRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeResponse, error) {
-	out := new(QueryGetSuperNodeResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetSuperNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySelfStakeUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QuerySelfStakeUnbondingsResponse) GetUnbondings() []SelfStakeUnbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetSuperNodeBySuperNodeAddress(ctx context.Context, in *QueryGetSuperNodeBySuperNodeAddressRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeBySuperNodeAddressResponse, error) {
-	out := new(QueryGetSuperNodeBySuperNodeAddressResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetSuperNodeBySuperNodeAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySuperNodeEndpointsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (c *queryClient) ListSuperNodes(ctx context.Context, in *QueryListSuperNodesRequest, opts ...grpc.CallOption) (*QueryListSuperNodesResponse, error) {
-	out := new(QueryListSuperNodesResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/ListSuperNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySuperNodeEndpointsRequest) Reset()         { *m = QuerySuperNodeEndpointsRequest{} }
+func (m *QuerySuperNodeEndpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperNodeEndpointsRequest) ProtoMessage()    {}
+func (*QuerySuperNodeEndpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{21}
+}
+func (m *QuerySuperNodeEndpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperNodeEndpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperNodeEndpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySuperNodeEndpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperNodeEndpointsRequest.Merge(m, src)
+}
+func (m *QuerySuperNodeEndpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperNodeEndpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperNodeEndpointsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTopSuperNodesForBlock(ctx context.Context, in *QueryGetTopSuperNodesForBlockRequest, opts ...grpc.CallOption) (*QueryGetTopSuperNodesForBlockResponse, error) {
-	out := new(QueryGetTopSuperNodesForBlockResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetTopSuperNodesForBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySuperNodeEndpointsRequest proto.InternalMessageInfo
+
+func (m *QuerySuperNodeEndpointsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetMetrics(ctx context.Context, in *QueryGetMetricsRequest, opts ...grpc.CallOption) (*QueryGetMetricsResponse, error) {
-	out := new(QueryGetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySuperNodeEndpointsResponse struct {
+	// endpoints is the effective endpoint set. For supernodes that never
+	// advertised endpoints it is derived from the latest IP address and p2p port.
+	Endpoints []SupernodeEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints"`
+	History   []EndpointHistory   `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
 }
 
-func (c *queryClient) PoolState(ctx context.Context, in *QueryPoolStateRequest, opts ...grpc.CallOption) (*QueryPoolStateResponse, error) {
-	out := new(QueryPoolStateResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/PoolState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySuperNodeEndpointsResponse) Reset()         { *m = QuerySuperNodeEndpointsResponse{} }
+func (m *QuerySuperNodeEndpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperNodeEndpointsResponse) ProtoMessage()    {}
+func (*QuerySuperNodeEndpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{22}
+}
+func (m *QuerySuperNodeEndpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperNodeEndpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperNodeEndpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySuperNodeEndpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperNodeEndpointsResponse.Merge(m, src)
+}
+func (m *QuerySuperNodeEndpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperNodeEndpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperNodeEndpointsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SNEligibility(ctx context.Context, in *QuerySNEligibilityRequest, opts ...grpc.CallOption) (*QuerySNEligibilityResponse, error) {
-	out := new(QuerySNEligibilityResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/SNEligibility", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySuperNodeEndpointsResponse proto.InternalMessageInfo
+
+func (m *QuerySuperNodeEndpointsResponse) GetEndpoints() []SupernodeEndpoint {
+	if m != nil {
+		return m.Endpoints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PayoutHistory(ctx context.Context, in *QueryPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryPayoutHistoryResponse, error) {
-	out := new(QueryPayoutHistoryResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/PayoutHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySuperNodeEndpointsResponse) GetHistory() []EndpointHistory {
+	if m != nil {
+		return m.History
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a SuperNode by validatorAddress.
-	GetSuperNode(context.Context, *QueryGetSuperNodeRequest) (*QueryGetSuperNodeResponse, error)
-	// Queries a SuperNode by supernodeAddress.
-	GetSuperNodeBySuperNodeAddress(context.Context, *QueryGetSuperNodeBySuperNodeAddressRequest) (*QueryGetSuperNodeBySuperNodeAddressResponse, error)
-	// Queries a list of SuperNodes.
-	ListSuperNodes(context.Context, *QueryListSuperNodesRequest) (*QueryListSuperNodesResponse, error)
-	// Queries a list of GetTopSuperNodesForBlock items.
-	GetTopSuperNodesForBlock(context.Context, *QueryGetTopSuperNodesForBlockRequest) (*QueryGetTopSuperNodesForBlockResponse, error)
-	// Queries the latest metrics state for a validator.
-	GetMetrics(context.Context, *QueryGetMetricsRequest) (*QueryGetMetricsResponse, error)
-	// PoolState queries the current state of the Everlight pool.
-	PoolState(context.Context, *QueryPoolStateRequest) (*QueryPoolStateResponse, error)
-	// SNEligibility queries whether a specific SuperNode is eligible for payouts.
-	SNEligibility(context.Context, *QuerySNEligibilityRequest) (*QuerySNEligibilityResponse, error)
-	// PayoutHistory returns distribution payout history for a validator.
-	PayoutHistory(context.Context, *QueryPayoutHistoryRequest) (*QueryPayoutHistoryResponse, error)
+type QuerySlashRecordRequest struct {
+	SlashId uint64 `protobuf:"varint,1,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySlashRecordRequest) Reset()         { *m = QuerySlashRecordRequest{} }
+func (m *QuerySlashRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordRequest) ProtoMessage()    {}
+func (*QuerySlashRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{23}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySlashRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetSuperNode(ctx context.Context, req *QueryGetSuperNodeRequest) (*QueryGetSuperNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSuperNode not implemented")
+func (m *QuerySlashRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetSuperNodeBySuperNodeAddress(ctx context.Context, req *QueryGetSuperNodeBySuperNodeAddressRequest) (*QueryGetSuperNodeBySuperNodeAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSuperNodeBySuperNodeAddress not implemented")
+func (m *QuerySlashRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListSuperNodes(ctx context.Context, req *QueryListSuperNodesRequest) (*QueryListSuperNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSuperNodes not implemented")
+func (m *QuerySlashRecordRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetTopSuperNodesForBlock(ctx context.Context, req *QueryGetTopSuperNodesForBlockRequest) (*QueryGetTopSuperNodesForBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopSuperNodesForBlock not implemented")
+func (m *QuerySlashRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetMetrics(ctx context.Context, req *QueryGetMetricsRequest) (*QueryGetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+
+var xxx_messageInfo_QuerySlashRecordRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRecordRequest) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) PoolState(ctx context.Context, req *QueryPoolStateRequest) (*QueryPoolStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolState not implemented")
+
+type QuerySlashRecordResponse struct {
+	Record SlashRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
-func (*UnimplementedQueryServer) SNEligibility(ctx context.Context, req *QuerySNEligibilityRequest) (*QuerySNEligibilityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SNEligibility not implemented")
+
+func (m *QuerySlashRecordResponse) Reset()         { *m = QuerySlashRecordResponse{} }
+func (m *QuerySlashRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordResponse) ProtoMessage()    {}
+func (*QuerySlashRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{24}
 }
-func (*UnimplementedQueryServer) PayoutHistory(ctx context.Context, req *QueryPayoutHistoryRequest) (*QueryPayoutHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayoutHistory not implemented")
+func (m *QuerySlashRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySlashRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordResponse.Merge(m, src)
+}
+func (m *QuerySlashRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QuerySlashRecordResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRecordResponse) GetRecord() SlashRecord {
+	if m != nil {
+		return m.Record
 	}
-	return interceptor(ctx, in, info, handler)
+	return SlashRecord{}
 }
 
-func _Query_GetSuperNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSuperNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSuperNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/GetSuperNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSuperNode(ctx, req.(*QueryGetSuperNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySlashRecordsRequest struct {
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetSuperNodeBySuperNodeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSuperNodeBySuperNodeAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSuperNodeBySuperNodeAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/GetSuperNodeBySuperNodeAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSuperNodeBySuperNodeAddress(ctx, req.(*QueryGetSuperNodeBySuperNodeAddressRequest))
+func (m *QuerySlashRecordsRequest) Reset()         { *m = QuerySlashRecordsRequest{} }
+func (m *QuerySlashRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordsRequest) ProtoMessage()    {}
+func (*QuerySlashRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{25}
+}
+func (m *QuerySlashRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySlashRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordsRequest.Merge(m, src)
+}
+func (m *QuerySlashRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordsRequest.DiscardUnknown(m)
 }
 
-func _Query_ListSuperNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListSuperNodesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListSuperNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/ListSuperNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListSuperNodes(ctx, req.(*QueryListSuperNodesRequest))
+var xxx_messageInfo_QuerySlashRecordsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashRecordsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetTopSuperNodesForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTopSuperNodesForBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTopSuperNodesForBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/GetTopSuperNodesForBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTopSuperNodesForBlock(ctx, req.(*QueryGetTopSuperNodesForBlockRequest))
+func (m *QuerySlashRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMetricsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMetrics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/GetMetrics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMetrics(ctx, req.(*QueryGetMetricsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySlashRecordsResponse struct {
+	Records    []SlashRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PoolState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/PoolState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolState(ctx, req.(*QueryPoolStateRequest))
+func (m *QuerySlashRecordsResponse) Reset()         { *m = QuerySlashRecordsResponse{} }
+func (m *QuerySlashRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordsResponse) ProtoMessage()    {}
+func (*QuerySlashRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{26}
+}
+func (m *QuerySlashRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySlashRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordsResponse.Merge(m, src)
+}
+func (m *QuerySlashRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordsResponse.DiscardUnknown(m)
 }
 
-func _Query_SNEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySNEligibilityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SNEligibility(ctx, in)
+var xxx_messageInfo_QuerySlashRecordsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRecordsResponse) GetRecords() []SlashRecord {
+	if m != nil {
+		return m.Records
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/SNEligibility",
+	return nil
+}
+
+func (m *QuerySlashRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SNEligibility(ctx, req.(*QuerySNEligibilityRequest))
+	return nil
+}
+
+type QueryJailStatusRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryJailStatusRequest) Reset()         { *m = QueryJailStatusRequest{} }
+func (m *QueryJailStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJailStatusRequest) ProtoMessage()    {}
+func (*QueryJailStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{27}
+}
+func (m *QueryJailStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryJailStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailStatusRequest.Merge(m, src)
+}
+func (m *QueryJailStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailStatusRequest.DiscardUnknown(m)
 }
 
-func _Query_PayoutHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPayoutHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryJailStatusRequest proto.InternalMessageInfo
+
+func (m *QueryJailStatusRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PayoutHistory(ctx, in)
+	return ""
+}
+
+type QueryJailStatusResponse struct {
+	Jailed bool `protobuf:"varint,1,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// Height from which the supernode may be restarted. Zero when it was never jailed.
+	JailedUntilHeight int64 `protobuf:"varint,2,opt,name=jailed_until_height,json=jailedUntilHeight,proto3" json:"jailed_until_height,omitempty"`
+}
+
+func (m *QueryJailStatusResponse) Reset()         { *m = QueryJailStatusResponse{} }
+func (m *QueryJailStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJailStatusResponse) ProtoMessage()    {}
+func (*QueryJailStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a55c130d1e51715, []int{28}
+}
+func (m *QueryJailStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Query/PayoutHistory",
+}
+func (m *QueryJailStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailStatusResponse.Merge(m, src)
+}
+func (m *QueryJailStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailStatusResponse proto.InternalMessageInfo
+
+func (m *QueryJailStatusResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PayoutHistory(ctx, req.(*QueryPayoutHistoryRequest))
+	return false
+}
+
+func (m *QueryJailStatusResponse) GetJailedUntilHeight() int64 {
+	if m != nil {
+		return m.JailedUntilHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lumera.supernode.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetSuperNode",
-			Handler:    _Query_GetSuperNode_Handler,
-		},
-		{
-			MethodName: "GetSuperNodeBySuperNodeAddress",
-			Handler:    _Query_GetSuperNodeBySuperNodeAddress_Handler,
-		},
-		{
-			MethodName: "ListSuperNodes",
-			Handler:    _Query_ListSuperNodes_Handler,
-		},
-		{
-			MethodName: "GetTopSuperNodesForBlock",
-			Handler:    _Query_GetTopSuperNodesForBlock_Handler,
-		},
-		{
-			MethodName: "GetMetrics",
-			Handler:    _Query_GetMetrics_Handler,
-		},
-		{
-			MethodName: "PoolState",
-			Handler:    _Query_PoolState_Handler,
-		},
-		{
-			MethodName: "SNEligibility",
-			Handler:    _Query_SNEligibility_Handler,
-		},
-		{
-			MethodName: "PayoutHistory",
-			Handler:    _Query_PayoutHistory_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lumera/supernode/v1/query.proto",
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "lumera.supernode.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "lumera.supernode.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetSuperNodeRequest)(nil), "lumera.supernode.v1.QueryGetSuperNodeRequest")
+	proto.RegisterType((*QueryGetSuperNodeResponse)(nil), "lumera.supernode.v1.QueryGetSuperNodeResponse")
+	proto.RegisterType((*QueryGetSuperNodeBySuperNodeAddressRequest)(nil), "lumera.supernode.v1.QueryGetSuperNodeBySuperNodeAddressRequest")
+	proto.RegisterType((*QueryGetSuperNodeBySuperNodeAddressResponse)(nil), "lumera.supernode.v1.QueryGetSuperNodeBySuperNodeAddressResponse")
+	proto.RegisterType((*QueryListSuperNodesRequest)(nil), "lumera.supernode.v1.QueryListSuperNodesRequest")
+	proto.RegisterType((*QueryListSuperNodesResponse)(nil), "lumera.supernode.v1.QueryListSuperNodesResponse")
+	proto.RegisterType((*QueryGetTopSuperNodesForBlockRequest)(nil), "lumera.supernode.v1.QueryGetTopSuperNodesForBlockRequest")
+	proto.RegisterType((*QueryGetTopSuperNodesForBlockResponse)(nil), "lumera.supernode.v1.QueryGetTopSuperNodesForBlockResponse")
+	proto.RegisterType((*QueryGetMetricsRequest)(nil), "lumera.supernode.v1.QueryGetMetricsRequest")
+	proto.RegisterType((*QueryGetMetricsResponse)(nil), "lumera.supernode.v1.QueryGetMetricsResponse")
+	proto.RegisterType((*QueryPoolStateRequest)(nil), "lumera.supernode.v1.QueryPoolStateRequest")
+	proto.RegisterType((*QueryPoolStateResponse)(nil), "lumera.supernode.v1.QueryPoolStateResponse")
+	proto.RegisterType((*QuerySNEligibilityRequest)(nil), "lumera.supernode.v1.QuerySNEligibilityRequest")
+	proto.RegisterType((*QuerySNEligibilityResponse)(nil), "lumera.supernode.v1.QuerySNEligibilityResponse")
+	proto.RegisterType((*PayoutHistoryEntry)(nil), "lumera.supernode.v1.PayoutHistoryEntry")
+	proto.RegisterType((*QueryPayoutHistoryRequest)(nil), "lumera.supernode.v1.QueryPayoutHistoryRequest")
+	proto.RegisterType((*QueryPayoutHistoryResponse)(nil), "lumera.supernode.v1.QueryPayoutHistoryResponse")
+	proto.RegisterType((*QuerySelfStakeUnbondingsRequest)(nil), "lumera.supernode.v1.QuerySelfStakeUnbondingsRequest")
+	proto.RegisterType((*QuerySelfStakeUnbondingsResponse)(nil), "lumera.supernode.v1.QuerySelfStakeUnbondingsResponse")
+	proto.RegisterType((*QuerySuperNodeEndpointsRequest)(nil), "lumera.supernode.v1.QuerySuperNodeEndpointsRequest")
+	proto.RegisterType((*QuerySuperNodeEndpointsResponse)(nil), "lumera.supernode.v1.QuerySuperNodeEndpointsResponse")
+	proto.RegisterType((*QuerySlashRecordRequest)(nil), "lumera.supernode.v1.QuerySlashRecordRequest")
+	proto.RegisterType((*QuerySlashRecordResponse)(nil), "lumera.supernode.v1.QuerySlashRecordResponse")
+	proto.RegisterType((*QuerySlashRecordsRequest)(nil), "lumera.supernode.v1.QuerySlashRecordsRequest")
+	proto.RegisterType((*QuerySlashRecordsResponse)(nil), "lumera.supernode.v1.QuerySlashRecordsResponse")
+	proto.RegisterType((*QueryJailStatusRequest)(nil), "lumera.supernode.v1.QueryJailStatusRequest")
+	proto.RegisterType((*QueryJailStatusResponse)(nil), "lumera.supernode.v1.QueryJailStatusResponse")
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("lumera/supernode/v1/query.proto", fileDescriptor_8a55c130d1e51715) }
+
+var fileDescriptor_8a55c130d1e51715 = []byte{
+	// 2051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0xdc, 0xc6,
+	0x15, 0x36, 0x25, 0xeb, 0xef, 0xc9, 0xd6, 0xcf, 0xc8, 0x96, 0x56, 0x74, 0xbb, 0x52, 0x08, 0xd7,
+	0x56, 0x65, 0x6b, 0x69, 0x39, 0xae, 0xd1, 0xa4, 0x69, 0x1a, 0xaf, 0x7e, 0x6c, 0x37, 0x8e, 0xa2,
+	0x50, 0x71, 0xe3, 0x06, 0x05, 0x88, 0x59, 0x72, 0xb4, 0x62, 0xc4, 0xe5, 0x6c, 0x48, 0xae, 0x9c,
+	0x85, 0xa0, 0x4b, 0x0f, 0xbd, 0x36, 0x40, 0xaf, 0x3d, 0xf4, 0x54, 0x14, 0x2d, 0xd0, 0xb4, 0x85,
+	0x8e, 0x2d, 0xd0, 0x63, 0xd0, 0x43, 0x11, 0xb8, 0x97, 0xa0, 0x07, 0xa7, 0xb0, 0x0b, 0x14, 0xe8,
+	0xb1, 0xf7, 0x02, 0x01, 0xe7, 0x87, 0xe4, 0xee, 0x72, 0xd7, 0xd4, 0x42, 0xbe, 0xd8, 0x3b, 0x33,
+	0xef, 0xe7, 0xfb, 0xde, 0xcc, 0x3c, 0xbe, 0x79, 0x82, 0x05, 0xb7, 0x51, 0x23, 0x3e, 0xd6, 0x83,
+	0x46, 0x9d, 0xf8, 0x1e, 0xb5, 0x89, 0x7e, 0xb0, 0xaa, 0x7f, 0xdc, 0x20, 0x7e, 0xb3, 0x54, 0xf7,
+	0x69, 0x48, 0xd1, 0x0c, 0x17, 0x28, 0xc5, 0x02, 0xa5, 0x83, 0x55, 0x75, 0x1a, 0xd7, 0x1c, 0x8f,
+	0xea, 0xec, 0x5f, 0x2e, 0xa7, 0xce, 0x5b, 0x34, 0xa8, 0xd1, 0xc0, 0x64, 0x23, 0x9d, 0x0f, 0xc4,
+	0xd2, 0x85, 0x2a, 0xad, 0x52, 0x3e, 0x1f, 0xfd, 0x12, 0xb3, 0xdf, 0xa8, 0x52, 0x5a, 0x75, 0x89,
+	0x8e, 0xeb, 0x8e, 0x8e, 0x3d, 0x8f, 0x86, 0x38, 0x74, 0xa8, 0x27, 0x75, 0x96, 0xb9, 0x05, 0xbd,
+	0x82, 0x03, 0xc2, 0xf1, 0xe8, 0x07, 0xab, 0x15, 0x12, 0xe2, 0x55, 0xbd, 0x8e, 0xab, 0x8e, 0xc7,
+	0x84, 0x85, 0x6c, 0x31, 0x2d, 0x2b, 0xa5, 0x2c, 0xea, 0xc8, 0xf5, 0xc5, 0x2c, 0x8e, 0x75, 0xec,
+	0xe3, 0x9a, 0xf4, 0x76, 0x39, 0x4b, 0x82, 0x0d, 0x4c, 0x46, 0x99, 0x4b, 0x7d, 0xbb, 0xab, 0x54,
+	0x34, 0x30, 0x83, 0x10, 0x87, 0x52, 0xf4, 0x95, 0x2c, 0xd1, 0x1a, 0x09, 0x7d, 0xc7, 0xea, 0xed,
+	0x93, 0xb8, 0xbb, 0x91, 0xa1, 0x7d, 0x69, 0x48, 0xcb, 0x92, 0x22, 0x9e, 0x5d, 0xa7, 0x8e, 0x17,
+	0xf6, 0x92, 0x09, 0x5c, 0x1c, 0xec, 0x39, 0x5e, 0x95, 0xcb, 0x68, 0x17, 0x00, 0xbd, 0x17, 0x45,
+	0x71, 0x9b, 0xd1, 0x36, 0xc8, 0xc7, 0x0d, 0x12, 0x84, 0xda, 0x43, 0x98, 0x69, 0x99, 0x0d, 0xea,
+	0xd4, 0x0b, 0x08, 0x7a, 0x13, 0x86, 0x79, 0x78, 0x0a, 0xca, 0xa2, 0xb2, 0x34, 0x7e, 0xf3, 0x52,
+	0x29, 0xe3, 0x10, 0x94, 0xb8, 0x52, 0x79, 0xec, 0xf3, 0xa7, 0x0b, 0x67, 0x7e, 0xf3, 0x9f, 0x3f,
+	0x2c, 0x2b, 0x86, 0xd0, 0xd2, 0x36, 0xa1, 0xc0, 0xcc, 0xde, 0x25, 0xe1, 0x4e, 0xa4, 0xb1, 0x45,
+	0x6d, 0x22, 0x5c, 0xa2, 0x65, 0x98, 0x3a, 0xc0, 0xae, 0x63, 0xe3, 0x90, 0xfa, 0x77, 0x6c, 0xdb,
+	0x27, 0x01, 0xf7, 0x32, 0x66, 0x74, 0xcc, 0x6b, 0x3f, 0x86, 0xf9, 0x0c, 0x3b, 0x02, 0xe4, 0x1b,
+	0x30, 0x16, 0xc3, 0x11, 0x38, 0x8b, 0x99, 0x38, 0x13, 0xd5, 0x44, 0x41, 0x7b, 0x04, 0xcb, 0x1d,
+	0xa6, 0xcb, 0xcd, 0xf8, 0xa7, 0x40, 0x90, 0x02, 0x1d, 0xab, 0xb6, 0x81, 0x6e, 0x9f, 0xd7, 0xf6,
+	0xe1, 0x5a, 0x2e, 0xcb, 0xa7, 0x42, 0xc3, 0x06, 0x95, 0x39, 0x7b, 0xe0, 0x04, 0x89, 0xb7, 0x18,
+	0xf6, 0x26, 0x40, 0x72, 0x59, 0x84, 0xf1, 0x2b, 0x25, 0x71, 0x37, 0xa3, 0xdb, 0x52, 0xe2, 0x37,
+	0x5d, 0xdc, 0x99, 0xd2, 0x36, 0xae, 0xca, 0x7d, 0x32, 0x52, 0x9a, 0xda, 0xaf, 0x15, 0xb8, 0x94,
+	0xe9, 0x26, 0x3e, 0x2f, 0x10, 0x43, 0x8a, 0x02, 0x33, 0x98, 0x83, 0x44, 0x4a, 0x03, 0xdd, 0x6d,
+	0xc1, 0x39, 0xc0, 0x70, 0x5e, 0x7d, 0x21, 0x4e, 0xee, 0xbc, 0x05, 0xe8, 0xcf, 0x14, 0xb8, 0x2c,
+	0x83, 0xff, 0x3e, 0xad, 0x27, 0x50, 0x37, 0xa9, 0x5f, 0x76, 0xa9, 0xb5, 0x2f, 0x23, 0xb3, 0x08,
+	0xe3, 0x95, 0x68, 0x7c, 0x8f, 0x38, 0xd5, 0xbd, 0x90, 0x85, 0x66, 0xc8, 0x48, 0x4f, 0xa1, 0x0b,
+	0x30, 0xe4, 0x3a, 0x35, 0x27, 0x64, 0x70, 0x86, 0x0c, 0x3e, 0x40, 0x57, 0x60, 0x88, 0x5d, 0xf3,
+	0xc2, 0x60, 0xb4, 0xfb, 0xe5, 0xa9, 0xff, 0x3d, 0x5d, 0x38, 0xd7, 0xc4, 0x35, 0xf7, 0x75, 0x8d,
+	0x4d, 0x6b, 0x06, 0x5f, 0xd6, 0xaa, 0xf0, 0xad, 0x17, 0xe0, 0x38, 0x9d, 0xd0, 0x69, 0xeb, 0x30,
+	0x2b, 0x1d, 0xbd, 0xc3, 0xd3, 0x4b, 0x3f, 0x17, 0xed, 0x23, 0x98, 0xeb, 0xb0, 0x22, 0x00, 0xbe,
+	0x0b, 0xe7, 0x45, 0xde, 0xe2, 0x09, 0x4e, 0x1c, 0xa3, 0xe5, 0xee, 0x18, 0xa3, 0x81, 0xb0, 0xb2,
+	0x13, 0x69, 0x18, 0xe7, 0x6a, 0xa9, 0x91, 0x36, 0x07, 0x17, 0x79, 0xce, 0xa1, 0xd4, 0xe5, 0xeb,
+	0x22, 0x19, 0x7d, 0x35, 0x00, 0xb3, 0xed, 0x2b, 0x02, 0x04, 0x81, 0x91, 0x0a, 0x76, 0xb1, 0x67,
+	0x11, 0x11, 0xa2, 0xf9, 0x96, 0xd3, 0x21, 0xcf, 0xc5, 0x1a, 0x75, 0xbc, 0xf2, 0x8d, 0x28, 0x1f,
+	0xfd, 0xf6, 0xab, 0x85, 0xa5, 0xaa, 0x13, 0xee, 0x35, 0x2a, 0x25, 0x8b, 0xd6, 0xc4, 0xe7, 0x48,
+	0xfc, 0xb7, 0x12, 0xd8, 0xfb, 0x7a, 0xd8, 0xac, 0x93, 0x80, 0x29, 0x04, 0x86, 0xb4, 0x8d, 0xbe,
+	0x0b, 0x05, 0x17, 0x07, 0xa1, 0x69, 0x3b, 0x41, 0xe8, 0x3b, 0x95, 0x46, 0x74, 0xa6, 0xcc, 0x3d,
+	0x7e, 0x44, 0xa2, 0x63, 0x30, 0x68, 0xcc, 0x46, 0xeb, 0xeb, 0xa9, 0x65, 0x71, 0x5a, 0x3e, 0x81,
+	0xe9, 0x90, 0x86, 0xd8, 0x4d, 0x54, 0x89, 0x5d, 0x18, 0x3c, 0x7d, 0xa8, 0x53, 0xcc, 0xcb, 0x7a,
+	0xe2, 0x04, 0x2d, 0xc3, 0x34, 0x71, 0x9d, 0xaa, 0x53, 0x71, 0x89, 0x19, 0x78, 0xa6, 0x45, 0x1b,
+	0x5e, 0x58, 0x38, 0xbb, 0xa8, 0x2c, 0x9d, 0x35, 0x26, 0xe5, 0xc2, 0x8e, 0xb7, 0x16, 0x4d, 0x6b,
+	0xf7, 0x44, 0x3e, 0xdd, 0xd9, 0xda, 0x60, 0x2b, 0x8e, 0xeb, 0x84, 0x4d, 0x79, 0x5e, 0xae, 0xc1,
+	0x74, 0x7c, 0x2e, 0x4c, 0xfc, 0x82, 0x03, 0x73, 0xac, 0x80, 0x9a, 0x65, 0x4a, 0xec, 0x97, 0x0a,
+	0xa3, 0xd2, 0x37, 0x33, 0x31, 0x6a, 0xc4, 0x63, 0x34, 0x0b, 0xc3, 0x3e, 0xc1, 0x81, 0xb8, 0xe8,
+	0x63, 0x86, 0x18, 0xa1, 0xd7, 0x60, 0xde, 0xc2, 0x81, 0x85, 0x6d, 0x62, 0xee, 0x63, 0x9b, 0xd4,
+	0x5c, 0x07, 0x9b, 0x76, 0xc5, 0xac, 0x34, 0x43, 0x12, 0xb0, 0xeb, 0xa6, 0x18, 0xb3, 0x42, 0xe0,
+	0x6d, 0xb1, 0xbe, 0x5e, 0x29, 0x47, 0xab, 0xe8, 0x2a, 0x4c, 0x06, 0x35, 0x4a, 0xc3, 0x3d, 0x62,
+	0x9b, 0x8f, 0xf9, 0x76, 0x9d, 0x65, 0x0a, 0x13, 0x72, 0xfa, 0x03, 0x36, 0xab, 0xfd, 0x7d, 0x14,
+	0xd0, 0x36, 0x6e, 0xd2, 0x46, 0x78, 0xcf, 0x09, 0x42, 0xea, 0x37, 0x37, 0xbc, 0xd0, 0x6f, 0x46,
+	0x90, 0xf6, 0x92, 0x44, 0x30, 0x68, 0x88, 0x51, 0x76, 0x48, 0x06, 0xb2, 0x43, 0x12, 0x09, 0x27,
+	0xb5, 0x00, 0xb6, 0xf8, 0x46, 0x0c, 0xb6, 0x7f, 0x24, 0xf8, 0x3c, 0xb2, 0x60, 0x18, 0xd7, 0xc4,
+	0x56, 0x9d, 0xfa, 0x21, 0x11, 0xa6, 0xd1, 0x12, 0x4c, 0xb9, 0xa4, 0x8a, 0xad, 0xa6, 0xe9, 0xe3,
+	0xc7, 0x22, 0x90, 0x43, 0x3c, 0x2e, 0x7c, 0xde, 0xc0, 0x8f, 0x79, 0x00, 0x6f, 0xc2, 0x45, 0x21,
+	0x19, 0xc7, 0x91, 0x8b, 0x0f, 0x33, 0xf1, 0x19, 0xbe, 0xb8, 0x23, 0xd6, 0xb8, 0xce, 0x6d, 0x98,
+	0x13, 0x3a, 0x64, 0x77, 0x97, 0x58, 0xa1, 0x73, 0x40, 0x64, 0xf0, 0x47, 0x98, 0x96, 0x30, 0xb9,
+	0x21, 0x57, 0xf9, 0x1e, 0xa0, 0xeb, 0x80, 0x62, 0x54, 0xb5, 0xba, 0x54, 0x19, 0x65, 0x2a, 0x53,
+	0x12, 0x57, 0xad, 0x2e, 0xa4, 0xb7, 0x60, 0x2c, 0x01, 0x3f, 0xc6, 0x92, 0xee, 0x6a, 0x14, 0x90,
+	0x7f, 0x3e, 0x5d, 0xb8, 0xc4, 0xe9, 0x07, 0xf6, 0x7e, 0xc9, 0xa1, 0x7a, 0x0d, 0x87, 0x7b, 0xa5,
+	0x07, 0x4c, 0x7d, 0x9d, 0x58, 0x4f, 0x8e, 0x57, 0x40, 0x44, 0x74, 0x9d, 0x58, 0xc6, 0xa8, 0x2f,
+	0x99, 0x3e, 0x82, 0x89, 0x36, 0x8a, 0xd0, 0xaf, 0xd1, 0xf3, 0x41, 0x4b, 0x3c, 0x7e, 0x02, 0x53,
+	0x1d, 0x81, 0x18, 0xef, 0xd7, 0xf6, 0x24, 0x69, 0x8b, 0x9a, 0x01, 0xe3, 0xe9, 0x70, 0x9d, 0xeb,
+	0xd7, 0x30, 0xf8, 0x49, 0x6c, 0x3f, 0x84, 0x49, 0x8b, 0xd6, 0x6a, 0x4e, 0x10, 0x44, 0x79, 0xce,
+	0x8f, 0x92, 0xfb, 0xf9, 0x7e, 0xed, 0x4e, 0x24, 0x96, 0x0c, 0x1c, 0x12, 0xb4, 0x0f, 0x90, 0xcc,
+	0x14, 0x26, 0x4e, 0xff, 0x90, 0xa7, 0xcc, 0x47, 0xd9, 0xd7, 0x26, 0xd1, 0xd1, 0x89, 0xee, 0xa9,
+	0x4f, 0x1e, 0x63, 0xdf, 0x0e, 0x0a, 0x93, 0x2f, 0x21, 0xfb, 0xc6, 0x5e, 0x0c, 0xee, 0x44, 0xfb,
+	0x54, 0x11, 0x29, 0xb5, 0x25, 0xab, 0xf4, 0x93, 0x52, 0xdb, 0x8a, 0xb5, 0x81, 0xbe, 0x8b, 0xb5,
+	0xdf, 0xcb, 0xd4, 0xdc, 0x06, 0x49, 0xa4, 0xe6, 0xbb, 0x30, 0x42, 0xbc, 0xd0, 0x77, 0xe2, 0x6a,
+	0xe3, 0x6a, 0x97, 0xe2, 0xbe, 0x3d, 0x4b, 0x96, 0xcf, 0x46, 0xf1, 0x32, 0xa4, 0xf6, 0xe9, 0x15,
+	0x6d, 0x0e, 0x2c, 0xf0, 0x4f, 0x09, 0x71, 0x77, 0x77, 0xa2, 0xa7, 0xcf, 0x43, 0xaf, 0x42, 0x3d,
+	0xdb, 0xf1, 0xaa, 0xa9, 0x42, 0x76, 0x8a, 0xd6, 0x89, 0xcf, 0xe3, 0x28, 0x52, 0x2b, 0x8b, 0x63,
+	0xf9, 0xd2, 0x93, 0xe3, 0x95, 0x39, 0xe1, 0xf4, 0x8e, 0x65, 0x89, 0x60, 0xee, 0x84, 0xbe, 0xe3,
+	0x55, 0x8d, 0x49, 0xa9, 0x24, 0xd2, 0xae, 0x76, 0x00, 0x8b, 0xdd, 0x5d, 0x89, 0x00, 0x19, 0x00,
+	0x8d, 0x78, 0xb6, 0x67, 0x8c, 0x3a, 0xad, 0xa4, 0x1f, 0x43, 0x29, 0x2b, 0x5a, 0x1d, 0x8a, 0xdc,
+	0xaf, 0xac, 0xe1, 0x36, 0xc4, 0x0b, 0x2e, 0x66, 0xb8, 0xd5, 0xf5, 0xa8, 0x94, 0x5f, 0x79, 0x72,
+	0xbc, 0xf2, 0x4d, 0x41, 0xf1, 0x47, 0x6d, 0xa7, 0x46, 0x10, 0xed, 0xfc, 0x40, 0xff, 0x45, 0x81,
+	0x85, 0xae, 0x2e, 0xe3, 0xd2, 0x6e, 0x4c, 0xbe, 0x24, 0x25, 0xd1, 0x2b, 0xbd, 0xcb, 0x3a, 0x69,
+	0x23, 0xcd, 0x33, 0xb1, 0x81, 0xee, 0xc3, 0xc8, 0x1e, 0x3f, 0x31, 0x85, 0x01, 0x66, 0xee, 0x72,
+	0xa6, 0x39, 0x69, 0x45, 0x9c, 0xae, 0xb4, 0x31, 0xa9, 0xaf, 0xdd, 0x12, 0x15, 0xe9, 0x4e, 0xf4,
+	0x8c, 0x35, 0x88, 0x45, 0x7d, 0x5b, 0x86, 0x6a, 0x1e, 0x46, 0xd9, 0xe3, 0xd6, 0x74, 0x6c, 0x16,
+	0xa1, 0xb3, 0xc6, 0x08, 0x1b, 0xdf, 0xb7, 0x35, 0x13, 0x0a, 0x9d, 0x5a, 0x82, 0xed, 0x5a, 0x54,
+	0x77, 0x44, 0x33, 0xa2, 0x82, 0x5d, 0xcc, 0xa6, 0x9a, 0x68, 0xb6, 0xbc, 0x6c, 0xb9, 0xaa, 0xf6,
+	0x27, 0xa5, 0xd3, 0xc3, 0xcb, 0xda, 0xc3, 0x53, 0xcb, 0x08, 0xbf, 0x93, 0x49, 0xaa, 0x15, 0xb4,
+	0x88, 0xcb, 0x06, 0x8c, 0x70, 0x72, 0xf2, 0x0c, 0x9c, 0x28, 0x30, 0x52, 0xf7, 0xf4, 0xd2, 0xc1,
+	0x9e, 0x78, 0x05, 0xfc, 0x10, 0x3b, 0xec, 0x15, 0xd0, 0x78, 0x69, 0x77, 0x04, 0xc3, 0x5c, 0x87,
+	0x27, 0x11, 0x94, 0x59, 0x18, 0xfe, 0x08, 0x3b, 0x2e, 0xb1, 0x45, 0xf9, 0x2a, 0x46, 0xa8, 0x04,
+	0x33, 0xfc, 0x97, 0xd9, 0xf0, 0x42, 0xc7, 0x6d, 0x7d, 0x1c, 0x4c, 0xf3, 0xa5, 0x87, 0xd1, 0x0a,
+	0x7f, 0x17, 0xdc, 0xfc, 0xd5, 0x45, 0x18, 0x62, 0x3e, 0xd0, 0xcf, 0x15, 0x18, 0xe6, 0x1d, 0x13,
+	0x94, 0x9d, 0x4d, 0x3a, 0xdb, 0x33, 0xea, 0xd2, 0x8b, 0x05, 0x39, 0x5e, 0xed, 0xe6, 0x4f, 0xff,
+	0xf1, 0xef, 0x5f, 0x0c, 0x5c, 0x47, 0xcb, 0xfa, 0x03, 0xa6, 0xb1, 0xed, 0xd3, 0x90, 0x5a, 0xd4,
+	0xd5, 0xbb, 0xb7, 0xbe, 0xd0, 0x9f, 0x15, 0x38, 0x97, 0x6e, 0x52, 0xa0, 0x95, 0xee, 0xee, 0x32,
+	0x3a, 0x39, 0x6a, 0x29, 0xaf, 0xb8, 0xc0, 0xf8, 0x0e, 0xc3, 0x78, 0x17, 0x6d, 0xe4, 0xc1, 0x58,
+	0x25, 0xa1, 0x99, 0x34, 0xe0, 0xf4, 0xc3, 0xf6, 0xcd, 0x3b, 0x42, 0xff, 0x57, 0xa0, 0xd8, 0xbb,
+	0xc7, 0x82, 0x7e, 0x90, 0x0f, 0x61, 0xd7, 0xbe, 0x8f, 0xfa, 0x56, 0xff, 0x06, 0x04, 0xe9, 0x47,
+	0x8c, 0xb4, 0x81, 0xb6, 0x4f, 0x4e, 0xda, 0xac, 0x34, 0xe5, 0x29, 0xd7, 0x0f, 0xdb, 0xdb, 0x4c,
+	0x47, 0xe8, 0x8f, 0x0a, 0x4c, 0xb4, 0xf6, 0x63, 0x90, 0xde, 0x1d, 0x6e, 0x66, 0x83, 0x48, 0xbd,
+	0x91, 0x5f, 0x41, 0xf0, 0x79, 0x83, 0xf1, 0xb9, 0x8d, 0x6e, 0xe5, 0xe1, 0xe3, 0x3a, 0x41, 0x9a,
+	0x50, 0x80, 0xfe, 0xab, 0x40, 0xa1, 0x5b, 0x4b, 0x04, 0xbd, 0xd6, 0x33, 0xd8, 0xbd, 0xda, 0x39,
+	0xea, 0xeb, 0xfd, 0xa8, 0x0a, 0x46, 0x1f, 0x30, 0x46, 0xef, 0xa1, 0x77, 0xf3, 0xee, 0x50, 0x48,
+	0xeb, 0x69, 0x52, 0xe6, 0x2e, 0xf5, 0x4d, 0xd6, 0x39, 0xd2, 0x0f, 0x53, 0x0d, 0xa4, 0x23, 0xf4,
+	0x99, 0x02, 0x90, 0x34, 0x54, 0xd0, 0xb5, 0x9e, 0x18, 0x5b, 0x9b, 0x37, 0xea, 0xf5, 0x7c, 0xc2,
+	0x82, 0xc2, 0x26, 0xa3, 0xf0, 0x16, 0x7a, 0x33, 0x0f, 0x05, 0xd1, 0x8c, 0xc9, 0xba, 0x52, 0xbf,
+	0x54, 0x60, 0x2c, 0x6e, 0xbe, 0xa0, 0xe5, 0x1e, 0xd9, 0xa7, 0xad, 0x77, 0xa3, 0x5e, 0xcb, 0x25,
+	0x2b, 0xe0, 0xde, 0x66, 0x70, 0x6f, 0xa0, 0x52, 0xae, 0x64, 0x45, 0xa9, 0xcb, 0x3b, 0x4f, 0xe8,
+	0xaf, 0x0a, 0x9c, 0x6f, 0xe9, 0x37, 0xa0, 0x1e, 0x29, 0x28, 0xab, 0xc7, 0xa1, 0xea, 0xb9, 0xe5,
+	0x05, 0xd4, 0x2d, 0x06, 0xf5, 0x1e, 0xda, 0xcc, 0x03, 0x35, 0xf0, 0x4c, 0x92, 0xd8, 0x48, 0x05,
+	0x58, 0x5e, 0xe3, 0x23, 0x46, 0xa1, 0xa5, 0xb4, 0xee, 0x45, 0x21, 0xeb, 0x4d, 0xa1, 0xea, 0xb9,
+	0xe5, 0xfb, 0xa1, 0x50, 0x67, 0x26, 0x4c, 0x51, 0x85, 0x65, 0x52, 0xf8, 0x52, 0x81, 0x99, 0x8c,
+	0xfa, 0x19, 0xdd, 0xea, 0x11, 0xdb, 0xae, 0x95, 0xbd, 0xfa, 0x9d, 0x13, 0x6a, 0x09, 0x52, 0xef,
+	0x33, 0x52, 0x5b, 0xe8, 0x41, 0xae, 0x7d, 0x89, 0xff, 0xa8, 0x62, 0x26, 0x35, 0xb9, 0x7e, 0xd8,
+	0xfe, 0xa2, 0x38, 0x42, 0x7f, 0x53, 0x00, 0x75, 0xd6, 0xcb, 0xe8, 0xd5, 0x1e, 0x18, 0xbb, 0x15,
+	0xf4, 0xea, 0xad, 0x93, 0x29, 0x09, 0x5e, 0xf7, 0x19, 0xaf, 0x35, 0x74, 0x27, 0x0f, 0xaf, 0xb8,
+	0xf0, 0xce, 0xdc, 0xa7, 0xcf, 0x14, 0x18, 0x4f, 0x15, 0x6d, 0xa8, 0x47, 0x4a, 0xe9, 0x2c, 0xb2,
+	0xd5, 0x95, 0x9c, 0xd2, 0x02, 0xf7, 0x1a, 0xc3, 0xfd, 0x7d, 0xf4, 0xbd, 0x5c, 0xfb, 0xc1, 0xaa,
+	0x77, 0x5e, 0x38, 0xea, 0x87, 0xb2, 0x96, 0x3f, 0x62, 0x05, 0x49, 0xca, 0x78, 0x80, 0xf2, 0x81,
+	0x08, 0x72, 0x14, 0x24, 0x59, 0x95, 0xef, 0xc9, 0x0a, 0x92, 0x34, 0xe8, 0xec, 0x80, 0x1f, 0x2b,
+	0x00, 0x49, 0x29, 0xd9, 0x2b, 0xdf, 0x77, 0x94, 0xb6, 0xea, 0xf5, 0x7c, 0xc2, 0x02, 0xf8, 0xdb,
+	0x0c, 0xf8, 0x06, 0x5a, 0xcb, 0x03, 0x3c, 0x2a, 0x4a, 0x59, 0x02, 0x6d, 0x64, 0xc2, 0x2e, 0x97,
+	0x3e, 0x7f, 0x56, 0x54, 0xbe, 0x78, 0x56, 0x54, 0xfe, 0xf5, 0xac, 0xa8, 0x7c, 0xfa, 0xbc, 0x78,
+	0xe6, 0x8b, 0xe7, 0xc5, 0x33, 0x5f, 0x3e, 0x2f, 0x9e, 0xf9, 0xf0, 0xc2, 0x27, 0xad, 0x96, 0x58,
+	0x2b, 0xa4, 0x32, 0xcc, 0xfe, 0xa0, 0xf8, 0xea, 0xd7, 0x03, 0x00, 0x7e, 0xf8, 0x6f, 0x2f, 0x3a,
+	0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a SuperNode by validatorAddress.
+	GetSuperNode(ctx context.Context, in *QueryGetSuperNodeRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeResponse, error)
+	// Queries a SuperNode by supernodeAddress.
+	GetSuperNodeBySuperNodeAddress(ctx context.Context, in *QueryGetSuperNodeBySuperNodeAddressRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeBySuperNodeAddressResponse, error)
+	// Queries a list of SuperNodes.
+	ListSuperNodes(ctx context.Context, in *QueryListSuperNodesRequest, opts ...grpc.CallOption) (*QueryListSuperNodesResponse, error)
+	// Queries a list of GetTopSuperNodesForBlock items.
+	GetTopSuperNodesForBlock(ctx context.Context, in *QueryGetTopSuperNodesForBlockRequest, opts ...grpc.CallOption) (*QueryGetTopSuperNodesForBlockResponse, error)
+	// Queries the latest metrics state for a validator.
+	GetMetrics(ctx context.Context, in *QueryGetMetricsRequest, opts ...grpc.CallOption) (*QueryGetMetricsResponse, error)
+	// PoolState queries the current state of the Everlight pool.
+	PoolState(ctx context.Context, in *QueryPoolStateRequest, opts ...grpc.CallOption) (*QueryPoolStateResponse, error)
+	// SNEligibility queries whether a specific SuperNode is eligible for payouts.
+	SNEligibility(ctx context.Context, in *QuerySNEligibilityRequest, opts ...grpc.CallOption) (*QuerySNEligibilityResponse, error)
+	// PayoutHistory returns distribution payout history for a validator.
+	PayoutHistory(ctx context.Context, in *QueryPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryPayoutHistoryResponse, error)
+	// SelfStakeUnbondings returns the pending self-stake unbondings of an
+	// independent supernode operator.
+	SelfStakeUnbondings(ctx context.Context, in *QuerySelfStakeUnbondingsRequest, opts ...grpc.CallOption) (*QuerySelfStakeUnbondingsResponse, error)
+	// SuperNodeEndpoints returns the endpoints currently advertised by a
+	// supernode together with its endpoint history.
+	SuperNodeEndpoints(ctx context.Context, in *QuerySuperNodeEndpointsRequest, opts ...grpc.CallOption) (*QuerySuperNodeEndpointsResponse, error)
+	// SlashRecord returns a single slash record by id.
+	SlashRecord(ctx context.Context, in *QuerySlashRecordRequest, opts ...grpc.CallOption) (*QuerySlashRecordResponse, error)
+	// SlashRecords returns the slash records of a supernode, oldest first.
+	SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error)
+	// JailStatus returns whether a supernode is jailed after a slash and until when.
+	JailStatus(ctx context.Context, in *QueryJailStatusRequest, opts ...grpc.CallOption) (*QueryJailStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetSuperNode(ctx context.Context, in *QueryGetSuperNodeRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeResponse, error) {
+	out := new(QueryGetSuperNodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetSuperNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetSuperNodeBySuperNodeAddress(ctx context.Context, in *QueryGetSuperNodeBySuperNodeAddressRequest, opts ...grpc.CallOption) (*QueryGetSuperNodeBySuperNodeAddressResponse, error) {
+	out := new(QueryGetSuperNodeBySuperNodeAddressResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetSuperNodeBySuperNodeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListSuperNodes(ctx context.Context, in *QueryListSuperNodesRequest, opts ...grpc.CallOption) (*QueryListSuperNodesResponse, error) {
+	out := new(QueryListSuperNodesResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/ListSuperNodes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTopSuperNodesForBlock(ctx context.Context, in *QueryGetTopSuperNodesForBlockRequest, opts ...grpc.CallOption) (*QueryGetTopSuperNodesForBlockResponse, error) {
+	out := new(QueryGetTopSuperNodesForBlockResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetTopSuperNodesForBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetMetrics(ctx context.Context, in *QueryGetMetricsRequest, opts ...grpc.CallOption) (*QueryGetMetricsResponse, error) {
+	out := new(QueryGetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolState(ctx context.Context, in *QueryPoolStateRequest, opts ...grpc.CallOption) (*QueryPoolStateResponse, error) {
+	out := new(QueryPoolStateResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/PoolState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SNEligibility(ctx context.Context, in *QuerySNEligibilityRequest, opts ...grpc.CallOption) (*QuerySNEligibilityResponse, error) {
+	out := new(QuerySNEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/SNEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PayoutHistory(ctx context.Context, in *QueryPayoutHistoryRequest, opts ...grpc.CallOption) (*QueryPayoutHistoryResponse, error) {
+	out := new(QueryPayoutHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/PayoutHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SelfStakeUnbondings(ctx context.Context, in *QuerySelfStakeUnbondingsRequest, opts ...grpc.CallOption) (*QuerySelfStakeUnbondingsResponse, error) {
+	out := new(QuerySelfStakeUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/SelfStakeUnbondings", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SuperNodeEndpoints(ctx context.Context, in *QuerySuperNodeEndpointsRequest, opts ...grpc.CallOption) (*QuerySuperNodeEndpointsResponse, error) {
+	out := new(QuerySuperNodeEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/SuperNodeEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Supernode != nil {
-		{
-			size, err := m.Supernode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SlashRecord(ctx context.Context, in *QuerySlashRecordRequest, opts ...grpc.CallOption) (*QuerySlashRecordResponse, error) {
+	out := new(QuerySlashRecordResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/SlashRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error) {
+	out := new(QuerySlashRecordsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/SlashRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeBySuperNodeAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetSuperNodeBySuperNodeAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SupernodeAddress) > 0 {
-		i -= len(m.SupernodeAddress)
-		copy(dAtA[i:], m.SupernodeAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupernodeAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) JailStatus(ctx context.Context, in *QueryJailStatusRequest, opts ...grpc.CallOption) (*QueryJailStatusResponse, error) {
+	out := new(QueryJailStatusResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Query/JailStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetSuperNodeBySuperNodeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a SuperNode by validatorAddress.
+	GetSuperNode(context.Context, *QueryGetSuperNodeRequest) (*QueryGetSuperNodeResponse, error)
+	// Queries a SuperNode by supernodeAddress.
+	GetSuperNodeBySuperNodeAddress(context.Context, *QueryGetSuperNodeBySuperNodeAddressRequest) (*QueryGetSuperNodeBySuperNodeAddressResponse, error)
+	// Queries a list of SuperNodes.
+	ListSuperNodes(context.Context, *QueryListSuperNodesRequest) (*QueryListSuperNodesResponse, error)
+	// Queries a list of GetTopSuperNodesForBlock items.
+	GetTopSuperNodesForBlock(context.Context, *QueryGetTopSuperNodesForBlockRequest) (*QueryGetTopSuperNodesForBlockResponse, error)
+	// Queries the latest metrics state for a validator.
+	GetMetrics(context.Context, *QueryGetMetricsRequest) (*QueryGetMetricsResponse, error)
+	// PoolState queries the current state of the Everlight pool.
+	PoolState(context.Context, *QueryPoolStateRequest) (*QueryPoolStateResponse, error)
+	// SNEligibility queries whether a specific SuperNode is eligible for payouts.
+	SNEligibility(context.Context, *QuerySNEligibilityRequest) (*QuerySNEligibilityResponse, error)
+	// PayoutHistory returns distribution payout history for a validator.
+	PayoutHistory(context.Context, *QueryPayoutHistoryRequest) (*QueryPayoutHistoryResponse, error)
+	// SelfStakeUnbondings returns the pending self-stake unbondings of an
+	// independent supernode operator.
+	SelfStakeUnbondings(context.Context, *QuerySelfStakeUnbondingsRequest) (*QuerySelfStakeUnbondingsResponse, error)
+	// SuperNodeEndpoints returns the endpoints currently advertised by a
+	// supernode together with its endpoint history.
+	SuperNodeEndpoints(context.Context, *QuerySuperNodeEndpointsRequest) (*QuerySuperNodeEndpointsResponse, error)
+	// SlashRecord returns a single slash record by id.
+	SlashRecord(context.Context, *QuerySlashRecordRequest) (*QuerySlashRecordResponse, error)
+	// SlashRecords returns the slash records of a supernode, oldest first.
+	SlashRecords(context.Context, *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error)
+	// JailStatus returns whether a supernode is jailed after a slash and until when.
+	JailStatus(context.Context, *QueryJailStatusRequest) (*QueryJailStatusResponse, error)
 }
 
-func (m *QueryGetSuperNodeBySuperNodeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Supernode != nil {
-		{
-			size, err := m.Supernode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryListSuperNodesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryListSuperNodesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetSuperNode(ctx context.Context, req *QueryGetSuperNodeRequest) (*QueryGetSuperNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuperNode not implemented")
 }
-
-func (m *QueryListSuperNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetSuperNodeBySuperNodeAddress(ctx context.Context, req *QueryGetSuperNodeBySuperNodeAddressRequest) (*QueryGetSuperNodeBySuperNodeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuperNodeBySuperNodeAddress not implemented")
 }
-
-func (m *QueryListSuperNodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ListSuperNodes(ctx context.Context, req *QueryListSuperNodesRequest) (*QueryListSuperNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuperNodes not implemented")
+}
+func (*UnimplementedQueryServer) GetTopSuperNodesForBlock(ctx context.Context, req *QueryGetTopSuperNodesForBlockRequest) (*QueryGetTopSuperNodesForBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopSuperNodesForBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetMetrics(ctx context.Context, req *QueryGetMetricsRequest) (*QueryGetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
+}
+func (*UnimplementedQueryServer) PoolState(ctx context.Context, req *QueryPoolStateRequest) (*QueryPoolStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolState not implemented")
+}
+func (*UnimplementedQueryServer) SNEligibility(ctx context.Context, req *QuerySNEligibilityRequest) (*QuerySNEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SNEligibility not implemented")
+}
+func (*UnimplementedQueryServer) PayoutHistory(ctx context.Context, req *QueryPayoutHistoryRequest) (*QueryPayoutHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutHistory not implemented")
+}
+func (*UnimplementedQueryServer) SelfStakeUnbondings(ctx context.Context, req *QuerySelfStakeUnbondingsRequest) (*QuerySelfStakeUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfStakeUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) SuperNodeEndpoints(ctx context.Context, req *QuerySuperNodeEndpointsRequest) (*QuerySuperNodeEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperNodeEndpoints not implemented")
+}
+func (*UnimplementedQueryServer) SlashRecord(ctx context.Context, req *QuerySlashRecordRequest) (*QuerySlashRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecord not implemented")
+}
+func (*UnimplementedQueryServer) SlashRecords(ctx context.Context, req *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecords not implemented")
+}
+func (*UnimplementedQueryServer) JailStatus(ctx context.Context, req *QueryJailStatusRequest) (*QueryJailStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailStatus not implemented")
 }
 
-func (m *QueryListSuperNodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryListSuperNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Supernodes) > 0 {
-		for iNdEx := len(m.Supernodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Supernodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTopSuperNodesForBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetSuperNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSuperNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTopSuperNodesForBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTopSuperNodesForBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetSuperNode(ctx, in)
 	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/GetSuperNode",
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSuperNode(ctx, req.(*QueryGetSuperNodeRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTopSuperNodesForBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetSuperNodeBySuperNodeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSuperNodeBySuperNodeAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTopSuperNodesForBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetSuperNodeBySuperNodeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/GetSuperNodeBySuperNodeAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSuperNodeBySuperNodeAddress(ctx, req.(*QueryGetSuperNodeBySuperNodeAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTopSuperNodesForBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Supernodes) > 0 {
-		for iNdEx := len(m.Supernodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Supernodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_ListSuperNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSuperNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListSuperNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/ListSuperNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSuperNodes(ctx, req.(*QueryListSuperNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMetricsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTopSuperNodesForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTopSuperNodesForBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopSuperNodesForBlock(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/GetTopSuperNodesForBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopSuperNodesForBlock(ctx, req.(*QueryGetTopSuperNodesForBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMetricsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMetricsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MetricsState != nil {
-		{
-			size, err := m.MetricsState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetMetrics(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/GetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMetrics(ctx, req.(*QueryGetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).PoolState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/PoolState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolState(ctx, req.(*QueryPoolStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_SNEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySNEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SNEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/SNEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SNEligibility(ctx, req.(*QuerySNEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PayoutHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/PayoutHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutHistory(ctx, req.(*QueryPayoutHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SelfStakeUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySelfStakeUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SelfStakeUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/SelfStakeUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SelfStakeUnbondings(ctx, req.(*QuerySelfStakeUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EligibleSnCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EligibleSnCount))
-		i--
-		dAtA[i] = 0x20
+func _Query_SuperNodeEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuperNodeEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TotalDistributed) > 0 {
-		for iNdEx := len(m.TotalDistributed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperNodeEndpoints(ctx, in)
 	}
-	if m.LastDistributionHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastDistributionHeight))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/SuperNodeEndpoints",
 	}
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperNodeEndpoints(ctx, req.(*QuerySuperNodeEndpointsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySNEligibilityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SlashRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/SlashRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecord(ctx, req.(*QuerySlashRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySNEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+func _Query_SlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/SlashRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecords(ctx, req.(*QuerySlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Query/JailStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailStatus(ctx, req.(*QueryJailStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lumera.supernode.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetSuperNode",
+			Handler:    _Query_GetSuperNode_Handler,
+		},
+		{
+			MethodName: "GetSuperNodeBySuperNodeAddress",
+			Handler:    _Query_GetSuperNodeBySuperNodeAddress_Handler,
+		},
+		{
+			MethodName: "ListSuperNodes",
+			Handler:    _Query_ListSuperNodes_Handler,
+		},
+		{
+			MethodName: "GetTopSuperNodesForBlock",
+			Handler:    _Query_GetTopSuperNodesForBlock_Handler,
+		},
+		{
+			MethodName: "GetMetrics",
+			Handler:    _Query_GetMetrics_Handler,
+		},
+		{
+			MethodName: "PoolState",
+			Handler:    _Query_PoolState_Handler,
+		},
+		{
+			MethodName: "SNEligibility",
+			Handler:    _Query_SNEligibility_Handler,
+		},
+		{
+			MethodName: "PayoutHistory",
+			Handler:    _Query_PayoutHistory_Handler,
+		},
+		{
+			MethodName: "SelfStakeUnbondings",
+			Handler:    _Query_SelfStakeUnbondings_Handler,
+		},
+		{
+			MethodName: "SuperNodeEndpoints",
+			Handler:    _Query_SuperNodeEndpoints_Handler,
+		},
+		{
+			MethodName: "SlashRecord",
+			Handler:    _Query_SlashRecord_Handler,
+		},
+		{
+			MethodName: "SlashRecords",
+			Handler:    _Query_SlashRecords_Handler,
+		},
+		{
+			MethodName: "JailStatus",
+			Handler:    _Query_JailStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lumera/supernode/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySNEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySNEligibilityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2065,49 +2313,30 @@ func (m *QuerySNEligibilityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySNEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySNEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SmoothedWeight != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SmoothedWeight))))
-		i--
-		dAtA[i] = 0x21
-	}
-	if m.CascadeKademliaDbBytes != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CascadeKademliaDbBytes))))
-		i--
-		dAtA[i] = 0x19
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Eligible {
-		i--
-		if m.Eligible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PayoutHistoryEntry) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetSuperNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2117,77 +2346,27 @@ func (m *PayoutHistoryEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PayoutHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetSuperNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PayoutHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetSuperNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RampWeight != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RampWeight))))
-		i--
-		dAtA[i] = 0x41
-	}
-	if m.EffectiveWeight != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EffectiveWeight))))
-		i--
-		dAtA[i] = 0x39
-	}
-	if m.SmoothedBytes != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SmoothedBytes))))
-		i--
-		dAtA[i] = 0x31
-	}
-	if m.RawBytes != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RawBytes))))
-		i--
-		dAtA[i] = 0x29
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.SupernodeAccount) > 0 {
-		i -= len(m.SupernodeAccount)
-		copy(dAtA[i:], m.SupernodeAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupernodeAccount)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPayoutHistoryRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetSuperNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2197,19 +2376,19 @@ func (m *QueryPayoutHistoryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPayoutHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetSuperNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPayoutHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetSuperNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Supernode != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Supernode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2217,19 +2396,42 @@ func (m *QueryPayoutHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSuperNodeBySuperNodeAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSuperNodeBySuperNodeAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupernodeAddress) > 0 {
+		i -= len(m.SupernodeAddress)
+		copy(dAtA[i:], m.SupernodeAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupernodeAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPayoutHistoryResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2239,19 +2441,19 @@ func (m *QueryPayoutHistoryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPayoutHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetSuperNodeBySuperNodeAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPayoutHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetSuperNodeBySuperNodeAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Supernode != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Supernode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2259,352 +2461,2771 @@ func (m *QueryPayoutHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListSuperNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetSuperNodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryListSuperNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetSuperNodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListSuperNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Supernode != nil {
-		l = m.Supernode.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SupernodeAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListSuperNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Supernode != nil {
-		l = m.Supernode.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryListSuperNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListSuperNodesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListSuperNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryListSuperNodesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if len(m.Supernodes) > 0 {
-		for _, e := range m.Supernodes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Supernodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supernodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTopSuperNodesForBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetTopSuperNodesForBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTopSuperNodesForBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTopSuperNodesForBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTopSuperNodesForBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetTopSuperNodesForBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTopSuperNodesForBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTopSuperNodesForBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Supernodes) > 0 {
-		for _, e := range m.Supernodes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Supernodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supernodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMetricsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetMetricsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryGetMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
 	if m.MetricsState != nil {
-		l = m.MetricsState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.MetricsState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.EligibleSnCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EligibleSnCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TotalDistributed) > 0 {
+		for iNdEx := len(m.TotalDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
 	if m.LastDistributionHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastDistributionHeight))
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastDistributionHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TotalDistributed) > 0 {
-		for _, e := range m.TotalDistributed {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.EligibleSnCount != 0 {
-		n += 1 + sovQuery(uint64(m.EligibleSnCount))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySNEligibilityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySNEligibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySNEligibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySNEligibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySNEligibilityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySNEligibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySNEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySNEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Eligible {
-		n += 2
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SmoothedWeight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SmoothedWeight))))
+		i--
+		dAtA[i] = 0x21
 	}
 	if m.CascadeKademliaDbBytes != 0 {
-		n += 9
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CascadeKademliaDbBytes))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if m.SmoothedWeight != 0 {
-		n += 9
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PayoutHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PayoutHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SupernodeAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorRewards) > 0 {
+		for iNdEx := len(m.DelegatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.RampWeight.Size()
+		i -= size
+		if _, err := m.RampWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.EffectiveWeight.Size()
+		i -= size
+		if _, err := m.EffectiveWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.SmoothedBytes.Size()
+		i -= size
+		if _, err := m.SmoothedBytes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RawBytes.Size()
+		i -= size
+		if _, err := m.RawBytes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.LegacyRampWeight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LegacyRampWeight))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.LegacyEffectiveWeight != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LegacyEffectiveWeight))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.LegacySmoothedBytes != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LegacySmoothedBytes))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.LegacyRawBytes != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LegacyRawBytes))))
+		i--
+		dAtA[i] = 0x29
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SupernodeAccount) > 0 {
+		i -= len(m.SupernodeAccount)
+		copy(dAtA[i:], m.SupernodeAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupernodeAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelfStakeUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelfStakeUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelfStakeUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAccount) > 0 {
+		i -= len(m.OperatorAccount)
+		copy(dAtA[i:], m.OperatorAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySelfStakeUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySelfStakeUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySelfStakeUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperNodeEndpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperNodeEndpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperNodeEndpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperNodeEndpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperNodeEndpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperNodeEndpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntilHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailedUntilHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetSuperNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSuperNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supernode != nil {
+		l = m.Supernode.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SupernodeAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supernode != nil {
+		l = m.Supernode.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListSuperNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListSuperNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supernodes) > 0 {
+		for _, e := range m.Supernodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTopSuperNodesForBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTopSuperNodesForBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supernodes) > 0 {
+		for _, e := range m.Supernodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetricsState != nil {
+		l = m.MetricsState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LastDistributionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastDistributionHeight))
+	}
+	if len(m.TotalDistributed) > 0 {
+		for _, e := range m.TotalDistributed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EligibleSnCount != 0 {
+		n += 1 + sovQuery(uint64(m.EligibleSnCount))
+	}
+	return n
+}
+
+func (m *QuerySNEligibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySNEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CascadeKademliaDbBytes != 0 {
+		n += 9
+	}
+	if m.SmoothedWeight != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *PayoutHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LegacyRawBytes != 0 {
+		n += 9
+	}
+	if m.LegacySmoothedBytes != 0 {
+		n += 9
+	}
+	if m.LegacyEffectiveWeight != 0 {
+		n += 9
+	}
+	if m.LegacyRampWeight != 0 {
+		n += 9
+	}
+	l = m.RawBytes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SmoothedBytes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RampWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewards) > 0 {
+		for _, e := range m.DelegatorRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPayoutHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySelfStakeUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySelfStakeUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySuperNodeEndpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperNodeEndpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashId != 0 {
+		n += 1 + sovQuery(uint64(m.SlashId))
+	}
+	return n
+}
+
+func (m *QuerySlashRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntilHeight != 0 {
+		n += 1 + sovQuery(uint64(m.JailedUntilHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSuperNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSuperNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSuperNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSuperNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSuperNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSuperNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supernode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supernode == nil {
+				m.Supernode = &SuperNode{}
+			}
+			if err := m.Supernode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupernodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supernode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supernode == nil {
+				m.Supernode = &SuperNode{}
+			}
+			if err := m.Supernode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSuperNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSuperNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSuperNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSuperNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSuperNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSuperNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supernodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supernodes = append(m.Supernodes, &SuperNode{})
+			if err := m.Supernodes[len(m.Supernodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTopSuperNodesForBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTopSuperNodesForBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supernodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supernodes = append(m.Supernodes, &SuperNode{})
+			if err := m.Supernodes[len(m.Supernodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetricsState == nil {
+				m.MetricsState = &SupernodeMetricsState{}
+			}
+			if err := m.MetricsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.RawBytes != 0 {
-		n += 9
-	}
-	if m.SmoothedBytes != 0 {
-		n += 9
-	}
-	if m.EffectiveWeight != 0 {
-		n += 9
-	}
-	if m.RampWeight != 0 {
-		n += 9
-	}
-	return n
-}
 
-func (m *QueryPayoutHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryPayoutHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionHeight", wireType)
+			}
+			m.LastDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDistributionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDistributed = append(m.TotalDistributed, types.Coin{})
+			if err := m.TotalDistributed[len(m.TotalDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleSnCount", wireType)
+			}
+			m.EligibleSnCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleSnCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySNEligibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,12 +5248,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySNEligibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySNEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2654,7 +5307,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySNEligibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2677,17 +5330,37 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySNEligibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySNEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2697,25 +5370,46 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CascadeKademliaDbBytes", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CascadeKademliaDbBytes = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothedWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SmoothedWeight = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2737,7 +5431,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSuperNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *PayoutHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2760,13 +5454,32 @@ func (m *QueryGetSuperNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSuperNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayoutHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSuperNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayoutHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -2798,61 +5511,155 @@ func (m *QueryGetSuperNodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetSuperNodeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSuperNodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSuperNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyRawBytes", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LegacyRawBytes = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacySmoothedBytes", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LegacySmoothedBytes = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyEffectiveWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LegacyEffectiveWeight = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyRampWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LegacyRampWeight = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supernode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothedBytes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2862,81 +5669,29 @@ func (m *QueryGetSuperNodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supernode == nil {
-				m.Supernode = &SuperNode{}
-			}
-			if err := m.Supernode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SmoothedBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2964,63 +5719,15 @@ func (m *QueryGetSuperNodeBySuperNodeAddressRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SupernodeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.EffectiveWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSuperNodeBySuperNodeAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supernode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RampWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3030,83 +5737,31 @@ func (m *QueryGetSuperNodeBySuperNodeAddressResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supernode == nil {
-				m.Supernode = &SuperNode{}
-			}
-			if err := m.Supernode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RampWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListSuperNodesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListSuperNodesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListSuperNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3116,81 +5771,29 @@ func (m *QueryListSuperNodesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListSuperNodesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListSuperNodesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListSuperNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supernodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3217,14 +5820,14 @@ func (m *QueryListSuperNodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supernodes = append(m.Supernodes, &SuperNode{})
-			if err := m.Supernodes[len(m.Supernodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types.Coin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3251,10 +5854,8 @@ func (m *QueryListSuperNodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorRewards = append(m.DelegatorRewards, types.Coin{})
+			if err := m.DelegatorRewards[len(m.DelegatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3279,7 +5880,7 @@ func (m *QueryListSuperNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTopSuperNodesForBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3302,17 +5903,17 @@ func (m *QueryGetTopSuperNodesForBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3322,35 +5923,29 @@ func (m *QueryGetTopSuperNodesForBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3360,23 +5955,27 @@ func (m *QueryGetTopSuperNodesForBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3399,7 +5998,7 @@ func (m *QueryGetTopSuperNodesForBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTopSuperNodesForBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3422,15 +6021,15 @@ func (m *QueryGetTopSuperNodesForBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTopSuperNodesForBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supernodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3457,8 +6056,44 @@ func (m *QueryGetTopSuperNodesForBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supernodes = append(m.Supernodes, &SuperNode{})
-			if err := m.Supernodes[len(m.Supernodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, PayoutHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3483,7 +6118,7 @@ func (m *QueryGetTopSuperNodesForBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMetricsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySelfStakeUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3506,15 +6141,15 @@ func (m *QueryGetMetricsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMetricsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySelfStakeUnbondingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySelfStakeUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3542,7 +6177,7 @@ func (m *QueryGetMetricsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OperatorAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3565,7 +6200,7 @@ func (m *QueryGetMetricsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMetricsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySelfStakeUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3588,15 +6223,15 @@ func (m *QueryGetMetricsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMetricsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySelfStakeUnbondingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySelfStakeUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3623,10 +6258,8 @@ func (m *QueryGetMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsState == nil {
-				m.MetricsState = &SupernodeMetricsState{}
-			}
-			if err := m.MetricsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, SelfStakeUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3651,7 +6284,7 @@ func (m *QueryGetMetricsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySuperNodeEndpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3674,12 +6307,44 @@ func (m *QueryPoolStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySuperNodeEndpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySuperNodeEndpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3701,7 +6366,7 @@ func (m *QueryPoolStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySuperNodeEndpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3724,15 +6389,15 @@ func (m *QueryPoolStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySuperNodeEndpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySuperNodeEndpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3759,33 +6424,14 @@ func (m *QueryPoolStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Endpoints = append(m.Endpoints, SupernodeEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionHeight", wireType)
-			}
-			m.LastDistributionHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastDistributionHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3812,30 +6458,11 @@ func (m *QueryPoolStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDistributed = append(m.TotalDistributed, types.Coin{})
-			if err := m.TotalDistributed[len(m.TotalDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, EndpointHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EligibleSnCount", wireType)
-			}
-			m.EligibleSnCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EligibleSnCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3857,7 +6484,7 @@ func (m *QueryPoolStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySNEligibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3880,17 +6507,17 @@ func (m *QuerySNEligibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySNEligibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySNEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
 			}
-			var stringLen uint64
+			m.SlashId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3900,24 +6527,11 @@ func (m *QuerySNEligibilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3939,7 +6553,7 @@ func (m *QuerySNEligibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySNEligibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3962,37 +6576,17 @@ func (m *QuerySNEligibilityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySNEligibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySNEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Eligible = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4002,46 +6596,25 @@ func (m *QuerySNEligibilityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CascadeKademliaDbBytes", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CascadeKademliaDbBytes = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmoothedWeight", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.SmoothedWeight = float64(math.Float64frombits(v))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4063,7 +6636,7 @@ func (m *QuerySNEligibilityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PayoutHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4086,32 +6659,13 @@ func (m *PayoutHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PayoutHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PayoutHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -4143,41 +6697,9 @@ func (m *PayoutHistoryEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4204,55 +6726,13 @@ func (m *PayoutHistoryEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RawBytes = float64(math.Float64frombits(v))
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmoothedBytes", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.SmoothedBytes = float64(math.Float64frombits(v))
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeight", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.EffectiveWeight = float64(math.Float64frombits(v))
-		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RampWeight", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RampWeight = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4274,7 +6754,7 @@ func (m *PayoutHistoryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4297,17 +6777,17 @@ func (m *QueryPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPayoutHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPayoutHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4317,23 +6797,25 @@ func (m *QueryPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, SlashRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4365,7 +6847,7 @@ func (m *QueryPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4392,7 +6874,7 @@ func (m *QueryPayoutHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryJailStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,17 +6897,17 @@ func (m *QueryPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPayoutHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryJailStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPayoutHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryJailStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4435,31 +6917,79 @@ func (m *QueryPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, PayoutHistoryEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJailStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJailStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJailStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4469,28 +6999,31 @@ func (m *QueryPayoutHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Jailed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntilHeight", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.JailedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
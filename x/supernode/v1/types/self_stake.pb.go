@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lumera/supernode/v1/self_stake.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SelfStakeUnbonding is a pending withdrawal of an independent operator's
+// self-stake. The amount remains slashable until completion_height.
+type SelfStakeUnbonding struct {
+	OperatorAccount  string     `protobuf:"bytes,1,opt,name=operator_account,json=operatorAccount,proto3" json:"operator_account,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	CreationHeight   int64      `protobuf:"varint,3,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	CompletionHeight int64      `protobuf:"varint,4,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *SelfStakeUnbonding) Reset()         { *m = SelfStakeUnbonding{} }
+func (m *SelfStakeUnbonding) String() string { return proto.CompactTextString(m) }
+func (*SelfStakeUnbonding) ProtoMessage()    {}
+func (*SelfStakeUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2673ee10fea52780, []int{0}
+}
+func (m *SelfStakeUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelfStakeUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelfStakeUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SelfStakeUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelfStakeUnbonding.Merge(m, src)
+}
+func (m *SelfStakeUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelfStakeUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelfStakeUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelfStakeUnbonding proto.InternalMessageInfo
+
+func (m *SelfStakeUnbonding) GetOperatorAccount() string {
+	if m != nil {
+		return m.OperatorAccount
+	}
+	return ""
+}
+
+func (m *SelfStakeUnbonding) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SelfStakeUnbonding) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *SelfStakeUnbonding) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SelfStakeUnbonding)(nil), "lumera.supernode.v1.SelfStakeUnbonding")
+}
+
+func init() {
+	proto.RegisterFile("lumera/supernode/v1/self_stake.proto", fileDescriptor_2673ee10fea52780)
+}
+
+var fileDescriptor_2673ee10fea52780 = []byte{
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x33, 0x56, 0x0a, 0x8d, 0x60, 0xdb, 0x58, 0xb0, 0xad, 0x10, 0x8b, 0x08, 0x16, 0xc5,
+	0x0c, 0xd5, 0xad, 0x9b, 0x56, 0x10, 0xd7, 0x2d, 0x6e, 0xdc, 0x84, 0xc9, 0x64, 0x9a, 0x0e, 0x26,
+	0x73, 0xc3, 0xcc, 0xb4, 0xe8, 0x5b, 0xf8, 0x18, 0x2e, 0x5d, 0xf8, 0x10, 0x5d, 0x16, 0x57, 0xae,
+	0x44, 0xda, 0x85, 0x5b, 0x1f, 0x41, 0x92, 0x49, 0xfd, 0xd9, 0x84, 0x7b, 0xcf, 0xf9, 0x0e, 0xf7,
+	0x90, 0xb1, 0x0f, 0xe3, 0x69, 0xc2, 0x24, 0xc1, 0x6a, 0x9a, 0x32, 0x29, 0x20, 0x64, 0x78, 0xd6,
+	0xc3, 0x8a, 0xc5, 0x63, 0x5f, 0x69, 0x72, 0xc7, 0xbc, 0x54, 0x82, 0x06, 0x67, 0xc7, 0x50, 0xde,
+	0x0f, 0xe5, 0xcd, 0x7a, 0xed, 0x3a, 0x49, 0xb8, 0x00, 0x9c, 0x7f, 0x0d, 0xd7, 0x6e, 0x44, 0x10,
+	0x41, 0x3e, 0xe2, 0x6c, 0x2a, 0xd4, 0x16, 0x05, 0x95, 0x80, 0xf2, 0x8d, 0x61, 0x96, 0xc2, 0x72,
+	0xcd, 0x86, 0x03, 0xa2, 0xb2, 0xcb, 0x01, 0xd3, 0xa4, 0x87, 0x29, 0x70, 0x61, 0xfc, 0x83, 0x2f,
+	0x64, 0x3b, 0x23, 0x16, 0x8f, 0x47, 0x59, 0x99, 0x1b, 0x11, 0x80, 0x08, 0xb9, 0x88, 0x9c, 0x2b,
+	0xbb, 0x06, 0x29, 0x93, 0x44, 0x83, 0xf4, 0x09, 0xa5, 0x30, 0x15, 0xba, 0x89, 0x3a, 0xa8, 0x5b,
+	0x19, 0xec, 0xbd, 0xbe, 0x9c, 0xee, 0x16, 0x27, 0xfa, 0x94, 0xf6, 0xc3, 0x50, 0x32, 0xa5, 0x46,
+	0x5a, 0x72, 0x11, 0x0d, 0xab, 0xeb, 0x50, 0xdf, 0x64, 0x9c, 0x0b, 0xbb, 0x4c, 0x92, 0x3c, 0xbd,
+	0xd1, 0x41, 0xdd, 0xad, 0xb3, 0x96, 0x57, 0x44, 0xb3, 0x3e, 0x5e, 0xd1, 0xc7, 0xbb, 0x04, 0x2e,
+	0x06, 0x95, 0xf9, 0xfb, 0xbe, 0xf5, 0xf4, 0xf9, 0x7c, 0x8c, 0x86, 0x45, 0xc6, 0x39, 0xb2, 0xab,
+	0x54, 0x32, 0xa2, 0x39, 0x08, 0x7f, 0xc2, 0x78, 0x34, 0xd1, 0xcd, 0x52, 0x07, 0x75, 0x4b, 0xc3,
+	0xed, 0xb5, 0x7c, 0x9d, 0xab, 0xce, 0x89, 0x5d, 0xa7, 0x90, 0xa4, 0x31, 0xfb, 0x8b, 0x6e, 0xe6,
+	0x68, 0xed, 0xd7, 0x30, 0xf0, 0xc0, 0x9b, 0x2f, 0x5d, 0xb4, 0x58, 0xba, 0xe8, 0x63, 0xe9, 0xa2,
+	0xc7, 0x95, 0x6b, 0x2d, 0x56, 0xae, 0xf5, 0xb6, 0x72, 0xad, 0xdb, 0xc6, 0xfd, 0xff, 0x67, 0xd2,
+	0x0f, 0x29, 0x53, 0x41, 0x39, 0xff, 0x53, 0xe7, 0xdf, 0x03, 0x00, 0xb7, 0x31, 0x19, 0xc6, 0xca,
+	0x01, 0x00, 0x00,
+}
+
+func (m *SelfStakeUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelfStakeUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelfStakeUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintSelfStake(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintSelfStake(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSelfStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAccount) > 0 {
+		i -= len(m.OperatorAccount)
+		copy(dAtA[i:], m.OperatorAccount)
+		i = encodeVarintSelfStake(dAtA, i, uint64(len(m.OperatorAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSelfStake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSelfStake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SelfStakeUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAccount)
+	if l > 0 {
+		n += 1 + l + sovSelfStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSelfStake(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovSelfStake(uint64(m.CreationHeight))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovSelfStake(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func sovSelfStake(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSelfStake(x uint64) (n int) {
+	return sovSelfStake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SelfStakeUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSelfStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelfStakeUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelfStakeUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSelfStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSelfStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSelfStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSelfStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSelfStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSelfStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSelfStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSelfStake(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSelfStake
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSelfStake
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSelfStake
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSelfStake
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSelfStake
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSelfStake
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSelfStake        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSelfStake          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSelfStake = fmt.Errorf("proto: unexpected end of group")
+)
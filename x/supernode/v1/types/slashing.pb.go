@@ -0,0 +1,1019 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lumera/supernode/v1/slashing.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashOffense enumerates the audit outcomes that can lead to an economic slash.
+type SlashOffense int32
+
+const (
+	SlashOffense_SLASH_OFFENSE_UNSPECIFIED SlashOffense = 0
+	// The storage-truth score of the supernode fell into the strong-postpone band.
+	SlashOffense_SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE SlashOffense = 1
+	// A heal operation assigned to the supernode failed verification or expired.
+	SlashOffense_SLASH_OFFENSE_HEAL_OP_FAILURE SlashOffense = 2
+	// Peers repeatedly reported the supernode for action finalization signature failures.
+	SlashOffense_SLASH_OFFENSE_ACTION_FINALIZATION_SIGNATURE_FAILURE SlashOffense = 3
+)
+
+var SlashOffense_name = map[int32]string{
+	0: "SLASH_OFFENSE_UNSPECIFIED",
+	1: "SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE",
+	2: "SLASH_OFFENSE_HEAL_OP_FAILURE",
+	3: "SLASH_OFFENSE_ACTION_FINALIZATION_SIGNATURE_FAILURE",
+}
+
+var SlashOffense_value = map[string]int32{
+	"SLASH_OFFENSE_UNSPECIFIED":                           0,
+	"SLASH_OFFENSE_STORAGE_TRUTH_STRONG_POSTPONE":         1,
+	"SLASH_OFFENSE_HEAL_OP_FAILURE":                       2,
+	"SLASH_OFFENSE_ACTION_FINALIZATION_SIGNATURE_FAILURE": 3,
+}
+
+func (x SlashOffense) String() string {
+	return proto.EnumName(SlashOffense_name, int32(x))
+}
+
+func (SlashOffense) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2044bd6f82465279, []int{0}
+}
+
+// SlashStatus is the lifecycle state of a slash record.
+type SlashStatus int32
+
+const (
+	SlashStatus_SLASH_STATUS_UNSPECIFIED SlashStatus = 0
+	// Reported and waiting for its appeal window to close.
+	SlashStatus_SLASH_STATUS_PENDING SlashStatus = 1
+	// Appealed by the operator and waiting for a governance decision.
+	SlashStatus_SLASH_STATUS_APPEALED SlashStatus = 2
+	// Executed: stake was slashed (if any) and the supernode jailed.
+	SlashStatus_SLASH_STATUS_EXECUTED SlashStatus = 3
+	// Overturned on appeal; no penalty was applied.
+	SlashStatus_SLASH_STATUS_OVERTURNED SlashStatus = 4
+	// Execution failed after the appeal window closed; no penalty was applied.
+	SlashStatus_SLASH_STATUS_FAILED SlashStatus = 5
+)
+
+var SlashStatus_name = map[int32]string{
+	0: "SLASH_STATUS_UNSPECIFIED",
+	1: "SLASH_STATUS_PENDING",
+	2: "SLASH_STATUS_APPEALED",
+	3: "SLASH_STATUS_EXECUTED",
+	4: "SLASH_STATUS_OVERTURNED",
+	5: "SLASH_STATUS_FAILED",
+}
+
+var SlashStatus_value = map[string]int32{
+	"SLASH_STATUS_UNSPECIFIED": 0,
+	"SLASH_STATUS_PENDING":     1,
+	"SLASH_STATUS_APPEALED":    2,
+	"SLASH_STATUS_EXECUTED":    3,
+	"SLASH_STATUS_OVERTURNED":  4,
+	"SLASH_STATUS_FAILED":      5,
+}
+
+func (x SlashStatus) String() string {
+	return proto.EnumName(SlashStatus_name, int32(x))
+}
+
+func (SlashStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2044bd6f82465279, []int{1}
+}
+
+// SlashRecord tracks one slashable offense from report to resolution.
+type SlashRecord struct {
+	Id               uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress string       `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	SupernodeAccount string       `protobuf:"bytes,3,opt,name=supernode_account,json=supernodeAccount,proto3" json:"supernode_account,omitempty"`
+	Offense          SlashOffense `protobuf:"varint,4,opt,name=offense,proto3,enum=lumera.supernode.v1.SlashOffense" json:"offense,omitempty"`
+	// evidence_ref identifies the audit outcome the offense was derived from
+	// (e.g. "epoch:12" or "heal_op:7"). The same offense is never reported twice
+	// for one reference.
+	EvidenceRef    string      `protobuf:"bytes,5,opt,name=evidence_ref,json=evidenceRef,proto3" json:"evidence_ref,omitempty"`
+	Status         SlashStatus `protobuf:"varint,6,opt,name=status,proto3,enum=lumera.supernode.v1.SlashStatus" json:"status,omitempty"`
+	ReportedHeight int64       `protobuf:"varint,7,opt,name=reported_height,json=reportedHeight,proto3" json:"reported_height,omitempty"`
+	// The slash executes at this height unless it is appealed first.
+	AppealDeadlineHeight int64 `protobuf:"varint,8,opt,name=appeal_deadline_height,json=appealDeadlineHeight,proto3" json:"appeal_deadline_height,omitempty"`
+	// Number of non-overturned offenses within the offense window, including this one.
+	OffenseCount uint64 `protobuf:"varint,9,opt,name=offense_count,json=offenseCount,proto3" json:"offense_count,omitempty"`
+	// Fraction of stake slashed on execution. Zero means jailing only.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// Number of blocks the supernode stays jailed after execution.
+	JailBlocks uint64 `protobuf:"varint,11,opt,name=jail_blocks,json=jailBlocks,proto3" json:"jail_blocks,omitempty"`
+	// Amount routed to the Everlight pool on execution.
+	SlashedAmount     types.Coin `protobuf:"bytes,12,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount"`
+	JailedUntilHeight int64      `protobuf:"varint,13,opt,name=jailed_until_height,json=jailedUntilHeight,proto3" json:"jailed_until_height,omitempty"`
+	AppealReason      string     `protobuf:"bytes,14,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	ResolvedHeight    int64      `protobuf:"varint,15,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2044bd6f82465279, []int{0}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetSupernodeAccount() string {
+	if m != nil {
+		return m.SupernodeAccount
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetOffense() SlashOffense {
+	if m != nil {
+		return m.Offense
+	}
+	return SlashOffense_SLASH_OFFENSE_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetEvidenceRef() string {
+	if m != nil {
+		return m.EvidenceRef
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetStatus() SlashStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashStatus_SLASH_STATUS_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetReportedHeight() int64 {
+	if m != nil {
+		return m.ReportedHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetAppealDeadlineHeight() int64 {
+	if m != nil {
+		return m.AppealDeadlineHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetOffenseCount() uint64 {
+	if m != nil {
+		return m.OffenseCount
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetJailBlocks() uint64 {
+	if m != nil {
+		return m.JailBlocks
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetSlashedAmount() types.Coin {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *SlashRecord) GetJailedUntilHeight() int64 {
+	if m != nil {
+		return m.JailedUntilHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetAppealReason() string {
+	if m != nil {
+		return m.AppealReason
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("lumera.supernode.v1.SlashOffense", SlashOffense_name, SlashOffense_value)
+	proto.RegisterEnum("lumera.supernode.v1.SlashStatus", SlashStatus_name, SlashStatus_value)
+	proto.RegisterType((*SlashRecord)(nil), "lumera.supernode.v1.SlashRecord")
+}
+
+func init() {
+	proto.RegisterFile("lumera/supernode/v1/slashing.proto", fileDescriptor_2044bd6f82465279)
+}
+
+var fileDescriptor_2044bd6f82465279 = []byte{
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x41, 0x6f, 0xdb, 0x36,
+	0x18, 0xb5, 0x9c, 0x34, 0x6d, 0x68, 0xc7, 0x55, 0x98, 0x6c, 0x51, 0x9a, 0xc5, 0x71, 0xba, 0xc3,
+	0x8c, 0x0c, 0x91, 0x90, 0x76, 0xc0, 0x06, 0xec, 0xa4, 0x58, 0x74, 0x6c, 0xcc, 0x93, 0x0c, 0x4a,
+	0x2e, 0x86, 0x5e, 0x08, 0x46, 0xa2, 0x1d, 0xad, 0xb2, 0x68, 0x48, 0xb2, 0xb1, 0xfe, 0x8b, 0xfe,
+	0x8c, 0x1d, 0x37, 0x20, 0xa7, 0xfd, 0x82, 0x1e, 0x8b, 0x9c, 0x86, 0x1d, 0x8a, 0x21, 0x39, 0xec,
+	0x6f, 0x0c, 0x22, 0x65, 0xb7, 0xca, 0xd6, 0x8b, 0x61, 0xbe, 0xf7, 0xbe, 0xa7, 0x8f, 0xef, 0xfb,
+	0x40, 0xf0, 0x34, 0x9a, 0x4f, 0x59, 0x42, 0x8d, 0x74, 0x3e, 0x63, 0x49, 0xcc, 0x03, 0x66, 0x2c,
+	0xce, 0x8c, 0x34, 0xa2, 0xe9, 0x55, 0x18, 0x4f, 0xf4, 0x59, 0xc2, 0x33, 0x0e, 0x77, 0xa4, 0x46,
+	0x5f, 0x69, 0xf4, 0xc5, 0xd9, 0x93, 0x6d, 0x3a, 0x0d, 0x63, 0x6e, 0x88, 0x5f, 0xa9, 0x7b, 0xb2,
+	0xef, 0xf3, 0x74, 0xca, 0x53, 0x22, 0x4e, 0x86, 0x3c, 0x14, 0xd4, 0xee, 0x84, 0x4f, 0xb8, 0xc4,
+	0xf3, 0x7f, 0x05, 0xda, 0x94, 0x1a, 0xe3, 0x92, 0xa6, 0xf9, 0x77, 0x2f, 0x59, 0x46, 0xcf, 0x0c,
+	0x9f, 0x87, 0xb1, 0xe4, 0x9f, 0xbe, 0xd9, 0x00, 0x35, 0x37, 0xef, 0x05, 0x33, 0x9f, 0x27, 0x01,
+	0x6c, 0x80, 0x6a, 0x18, 0x68, 0x4a, 0x4b, 0x69, 0xaf, 0xe3, 0x6a, 0x18, 0x40, 0x1b, 0x6c, 0x2f,
+	0x68, 0x14, 0x06, 0x34, 0xe3, 0x09, 0xa1, 0x41, 0x90, 0xb0, 0x34, 0xd5, 0xaa, 0x2d, 0xa5, 0xbd,
+	0x79, 0x7e, 0x7c, 0x73, 0x7d, 0x7a, 0x58, 0xb4, 0xf0, 0x62, 0xa9, 0x31, 0xa5, 0xc4, 0xcd, 0x92,
+	0x30, 0x9e, 0x60, 0x75, 0x71, 0x0f, 0x87, 0x3d, 0xb0, 0xbd, 0xba, 0x23, 0xa1, 0xbe, 0xcf, 0xe7,
+	0x71, 0xa6, 0xad, 0x09, 0xbf, 0x83, 0x9b, 0xeb, 0xd3, 0xbd, 0xc2, 0xcf, 0xf4, 0xfd, 0x7b, 0x4e,
+	0xab, 0x2a, 0x53, 0x16, 0xc1, 0xef, 0xc1, 0x43, 0x3e, 0x1e, 0xb3, 0x38, 0x65, 0xda, 0x7a, 0x4b,
+	0x69, 0x37, 0x9e, 0x1d, 0xeb, 0xff, 0x13, 0xa2, 0x2e, 0x2e, 0xe7, 0x48, 0x21, 0x5e, 0x56, 0xc0,
+	0x63, 0x50, 0x67, 0x8b, 0x30, 0x60, 0xb1, 0xcf, 0x48, 0xc2, 0xc6, 0xda, 0x83, 0xbc, 0x03, 0x5c,
+	0x5b, 0x62, 0x98, 0x8d, 0xe1, 0x77, 0x60, 0x23, 0xcd, 0x68, 0x36, 0x4f, 0xb5, 0x0d, 0x61, 0xdf,
+	0xfa, 0xb4, 0xbd, 0x2b, 0x74, 0xb8, 0xd0, 0xc3, 0xaf, 0xc0, 0xe3, 0x84, 0xcd, 0x78, 0x92, 0xb1,
+	0x80, 0x5c, 0xb1, 0x70, 0x72, 0x95, 0x69, 0x0f, 0x5b, 0x4a, 0x7b, 0x0d, 0x37, 0x96, 0x70, 0x4f,
+	0xa0, 0xf0, 0x1b, 0xf0, 0x39, 0x9d, 0xcd, 0x18, 0x8d, 0x48, 0xc0, 0x68, 0x10, 0x85, 0x31, 0x5b,
+	0xea, 0x1f, 0x09, 0xfd, 0xae, 0x64, 0xad, 0x82, 0x2c, 0xaa, 0xbe, 0x04, 0x5b, 0xc5, 0x35, 0x88,
+	0x8c, 0x6f, 0x53, 0x4c, 0xab, 0x5e, 0x80, 0x1d, 0x91, 0xce, 0x8f, 0xe0, 0xd1, 0x38, 0xa1, 0x7e,
+	0x16, 0xf2, 0x58, 0x03, 0x22, 0xde, 0xb3, 0xb7, 0xef, 0x8f, 0x2a, 0x7f, 0xbd, 0x3f, 0x3a, 0x90,
+	0x11, 0xa7, 0xc1, 0x2b, 0x3d, 0xe4, 0xc6, 0x94, 0x66, 0x57, 0xfa, 0x80, 0x4d, 0xa8, 0xff, 0xda,
+	0x62, 0xfe, 0xcd, 0xf5, 0x29, 0x28, 0x26, 0x60, 0x31, 0x1f, 0xaf, 0x2c, 0xe0, 0x11, 0xa8, 0xfd,
+	0x4c, 0xc3, 0x88, 0x5c, 0x46, 0xdc, 0x7f, 0x95, 0x6a, 0x35, 0xf1, 0x45, 0x90, 0x43, 0xe7, 0x02,
+	0x81, 0x3f, 0x80, 0x86, 0x58, 0x69, 0x16, 0x10, 0x3a, 0x15, 0x5d, 0xd5, 0x5b, 0x4a, 0xbb, 0xf6,
+	0x6c, 0x5f, 0x2f, 0xfc, 0xf2, 0x05, 0xd4, 0x8b, 0x05, 0xd4, 0x3b, 0x3c, 0x8c, 0xcf, 0x37, 0xf3,
+	0x86, 0x7e, 0xfd, 0xe7, 0xb7, 0x13, 0x05, 0x6f, 0x15, 0xb5, 0xa6, 0x28, 0x85, 0x3a, 0xd8, 0xc9,
+	0xad, 0x59, 0x40, 0xe6, 0x71, 0x16, 0x46, 0xcb, 0x50, 0xb6, 0x44, 0x28, 0xdb, 0x92, 0x1a, 0xe5,
+	0xcc, 0x87, 0x44, 0x8a, 0x1c, 0x13, 0x46, 0x53, 0x1e, 0x6b, 0x0d, 0x31, 0xce, 0xba, 0x04, 0xb1,
+	0xc0, 0xe4, 0x54, 0x52, 0x1e, 0x2d, 0x3e, 0x4c, 0xe5, 0xf1, 0x72, 0x2a, 0x12, 0x96, 0x6e, 0x27,
+	0x7f, 0x28, 0xa0, 0xfe, 0xf1, 0xd6, 0xc0, 0x43, 0xb0, 0xef, 0x0e, 0x4c, 0xb7, 0x47, 0x9c, 0x6e,
+	0x17, 0xd9, 0x2e, 0x22, 0x23, 0xdb, 0x1d, 0xa2, 0x4e, 0xbf, 0xdb, 0x47, 0x96, 0x5a, 0x81, 0x06,
+	0xf8, 0xba, 0x4c, 0xbb, 0x9e, 0x83, 0xcd, 0x0b, 0x44, 0x3c, 0x3c, 0xf2, 0x7a, 0xc4, 0xf5, 0xb0,
+	0x63, 0x5f, 0x90, 0xa1, 0xe3, 0x7a, 0x43, 0xc7, 0x46, 0xaa, 0x02, 0x8f, 0xc1, 0x61, 0xb9, 0xa0,
+	0x87, 0xcc, 0x01, 0x71, 0x86, 0xa4, 0x6b, 0xf6, 0x07, 0x23, 0x8c, 0xd4, 0x2a, 0xfc, 0x16, 0x3c,
+	0x2f, 0x4b, 0xcc, 0x8e, 0xd7, 0x77, 0x6c, 0xd2, 0xed, 0xdb, 0xe6, 0xa0, 0xff, 0xd2, 0x14, 0x07,
+	0xb7, 0x7f, 0x61, 0x9b, 0xde, 0x08, 0xa3, 0x55, 0xe1, 0xda, 0xc9, 0xef, 0x0a, 0xa8, 0x7d, 0xb4,
+	0x93, 0xf0, 0x0b, 0xa0, 0x49, 0x23, 0xd7, 0x33, 0xbd, 0x91, 0x7b, 0xaf, 0x75, 0x0d, 0xec, 0x96,
+	0xd8, 0x21, 0xb2, 0xad, 0xbe, 0x7d, 0xa1, 0x2a, 0x70, 0x1f, 0x7c, 0x56, 0x62, 0xcc, 0xe1, 0x10,
+	0x99, 0x03, 0x64, 0xa9, 0xd5, 0xff, 0x50, 0xe8, 0x27, 0xd4, 0x19, 0x79, 0xc8, 0x52, 0xd7, 0xe0,
+	0x01, 0xd8, 0x2b, 0x51, 0xce, 0x0b, 0x84, 0xbd, 0x11, 0xb6, 0x91, 0xa5, 0xae, 0xc3, 0x3d, 0xb0,
+	0x53, 0x22, 0xf3, 0xa6, 0x91, 0xa5, 0x3e, 0x38, 0xd7, 0xdf, 0xde, 0x36, 0x95, 0x77, 0xb7, 0x4d,
+	0xe5, 0xef, 0xdb, 0xa6, 0xf2, 0xe6, 0xae, 0x59, 0x79, 0x77, 0xd7, 0xac, 0xfc, 0x79, 0xd7, 0xac,
+	0xbc, 0xdc, 0xfd, 0xa5, 0xfc, 0x6a, 0x66, 0xaf, 0x67, 0x2c, 0xbd, 0xdc, 0x10, 0x4f, 0xd7, 0xf3,
+	0x7f, 0x07, 0x00, 0xc2, 0x11, 0x6c, 0xcf, 0x59, 0x05, 0x00, 0x00,
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AppealReason) > 0 {
+		i -= len(m.AppealReason)
+		copy(dAtA[i:], m.AppealReason)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.AppealReason)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.JailedUntilHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.JailedUntilHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.SlashedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.JailBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.JailBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.OffenseCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.OffenseCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AppealDeadlineHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.AppealDeadlineHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReportedHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ReportedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EvidenceRef) > 0 {
+		i -= len(m.EvidenceRef)
+		copy(dAtA[i:], m.EvidenceRef)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EvidenceRef)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Offense != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Offense))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SupernodeAccount) > 0 {
+		i -= len(m.SupernodeAccount)
+		copy(dAtA[i:], m.SupernodeAccount)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.SupernodeAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSlashing(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.SupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Offense != 0 {
+		n += 1 + sovSlashing(uint64(m.Offense))
+	}
+	l = len(m.EvidenceRef)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	if m.ReportedHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.ReportedHeight))
+	}
+	if m.AppealDeadlineHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.AppealDeadlineHeight))
+	}
+	if m.OffenseCount != 0 {
+		n += 1 + sovSlashing(uint64(m.OffenseCount))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.JailBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.JailBlocks))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.JailedUntilHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.JailedUntilHeight))
+	}
+	l = len(m.AppealReason)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.ResolvedHeight))
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offense", wireType)
+			}
+			m.Offense = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offense |= SlashOffense(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedHeight", wireType)
+			}
+			m.ReportedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealDeadlineHeight", wireType)
+			}
+			m.AppealDeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealDeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenseCount", wireType)
+			}
+			m.OffenseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailBlocks", wireType)
+			}
+			m.JailBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntilHeight", wireType)
+			}
+			m.JailedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppealReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -54,6 +55,23 @@ func (s *SuperNode) Validate() error {
 		s.P2PPort = DefaultP2PPort
 	}
 
+	if err := ValidateEndpoints(s.Endpoints); err != nil {
+		return err
+	}
+
+	if s.Independent && (s.SelfStake == nil || !s.SelfStake.IsValid()) {
+		return ErrInsufficientSelfStake
+	}
+
+	if s.Commission != nil {
+		if s.Independent {
+			return errorsmod.Wrap(ErrInvalidCommission, "independent supernodes cannot set a commission")
+		}
+		if err := s.Commission.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// Note: timestamps are validated by protobuf (non-nullable)
 
 	return nil
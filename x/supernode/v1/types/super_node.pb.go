@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -34,6 +35,22 @@ type SuperNode struct {
 	SupernodeAccount      string                     `protobuf:"bytes,7,opt,name=supernode_account,json=supernodeAccount,proto3" json:"supernode_account,omitempty"`
 	P2PPort               string                     `protobuf:"bytes,8,opt,name=p2p_port,json=p2pPort,proto3" json:"p2p_port,omitempty"`
 	PrevSupernodeAccounts []*SupernodeAccountHistory `protobuf:"bytes,9,rep,name=prev_supernode_accounts,json=prevSupernodeAccounts,proto3" json:"prev_supernode_accounts,omitempty"`
+	// independent marks a supernode whose operator does not run a consensus
+	// validator. Its validator_address is derived from the operator account and
+	// eligibility is backed by self_stake instead of staking delegations.
+	Independent bool `protobuf:"varint,10,opt,name=independent,proto3" json:"independent,omitempty"`
+	// self_stake is the stake currently bonded in the supernode module by an
+	// independent operator. Unset for validator-backed supernodes.
+	SelfStake *types.Coin `protobuf:"bytes,11,opt,name=self_stake,json=selfStake,proto3" json:"self_stake,omitempty"`
+	// endpoints is the set of endpoints currently advertised by the supernode.
+	// When empty, the supernode is reachable only at its latest prev_ip_addresses
+	// entry and p2p_port.
+	Endpoints []*SupernodeEndpoint `protobuf:"bytes,12,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// endpoint_history lists previously advertised endpoints in removal order.
+	EndpointHistory []*EndpointHistory `protobuf:"bytes,13,rep,name=endpoint_history,json=endpointHistory,proto3" json:"endpoint_history,omitempty"`
+	// commission is the share of Everlight payouts kept by supernode_account.
+	// When unset the supernode keeps its whole payout.
+	Commission *SupernodeCommission `protobuf:"bytes,14,opt,name=commission,proto3" json:"commission,omitempty"`
 }
 
 func (m *SuperNode) Reset()         { *m = SuperNode{} }
@@ -132,6 +149,41 @@ func (m *SuperNode) GetPrevSupernodeAccounts() []*SupernodeAccountHistory {
 	return nil
 }
 
+func (m *SuperNode) GetIndependent() bool {
+	if m != nil {
+		return m.Independent
+	}
+	return false
+}
+
+func (m *SuperNode) GetSelfStake() *types.Coin {
+	if m != nil {
+		return m.SelfStake
+	}
+	return nil
+}
+
+func (m *SuperNode) GetEndpoints() []*SupernodeEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *SuperNode) GetEndpointHistory() []*EndpointHistory {
+	if m != nil {
+		return m.EndpointHistory
+	}
+	return nil
+}
+
+func (m *SuperNode) GetCommission() *SupernodeCommission {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SuperNode)(nil), "lumera.supernode.v1.SuperNode")
 }
@@ -141,37 +193,45 @@ func init() {
 }
 
 var fileDescriptor_2710e454eaef0d25 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x1a, 0xf2, 0xb1, 0x1c, 0x20, 0x4b, 0x51, 0xb7, 0x41, 0xb5, 0x42, 0x05, 0x52,
-	0x2b, 0x5a, 0x47, 0x4d, 0x4f, 0x9c, 0x90, 0x2b, 0x21, 0xb5, 0x07, 0xaa, 0xe2, 0x48, 0x1c, 0xb8,
-	0x58, 0x66, 0x77, 0x65, 0x2c, 0x35, 0xde, 0xd5, 0xee, 0xc6, 0xa2, 0x6f, 0xc1, 0xc3, 0xf4, 0x21,
-	0x38, 0x70, 0xa8, 0x7a, 0xe2, 0x88, 0x92, 0x17, 0x41, 0xfb, 0xe5, 0xd2, 0xd4, 0xe1, 0xb6, 0xe3,
-	0xf9, 0xcd, 0x7f, 0xc6, 0xff, 0x19, 0xf0, 0xfa, 0x72, 0x3e, 0xa3, 0x22, 0x1b, 0xcb, 0x39, 0xa7,
-	0xa2, 0x64, 0x84, 0x8e, 0xab, 0x23, 0x1b, 0xa4, 0x3a, 0x8a, 0xb8, 0x60, 0x8a, 0xc1, 0xe7, 0x96,
-	0x8a, 0x6a, 0x2a, 0xaa, 0x8e, 0x86, 0x9b, 0x39, 0xcb, 0x99, 0xc9, 0x8f, 0xf5, 0xcb, 0xa2, 0xc3,
-	0x6d, 0xcc, 0xe4, 0x8c, 0xc9, 0xd4, 0x26, 0x6c, 0xe0, 0x52, 0xbb, 0x4d, 0xbd, 0x68, 0x55, 0x10,
-	0x5a, 0x62, 0xd7, 0x69, 0xf8, 0xb6, 0x89, 0x99, 0x51, 0x25, 0x0a, 0x2c, 0xd3, 0x2c, 0xcf, 0x05,
-	0xcd, 0x33, 0xe5, 0xe1, 0x83, 0x26, 0xb8, 0xe0, 0x69, 0x46, 0x88, 0xa0, 0x52, 0xa6, 0xdf, 0x0a,
-	0xa9, 0x98, 0xb8, 0x72, 0xf4, 0xfe, 0xda, 0x5f, 0xd5, 0x41, 0x2a, 0xd5, 0x9d, 0xf0, 0xf1, 0xff,
-	0xd1, 0x0c, 0x63, 0x36, 0x2f, 0xd5, 0x7d, 0xfd, 0xdd, 0x5f, 0x6d, 0xd0, 0x9f, 0x6a, 0xe6, 0x9c,
-	0x11, 0x0a, 0xcf, 0xc1, 0xa0, 0xca, 0x2e, 0x0b, 0x92, 0x29, 0x26, 0xfc, 0x40, 0x28, 0x18, 0x05,
-	0x7b, 0xfd, 0x93, 0x57, 0xb7, 0xd7, 0x87, 0x3b, 0xce, 0x99, 0xcf, 0x9e, 0x89, 0x2d, 0x32, 0x55,
-	0xa2, 0x28, 0xf3, 0xe4, 0x59, 0xb5, 0xf2, 0x1d, 0xc6, 0xa0, 0x63, 0x26, 0x94, 0xe8, 0xd1, 0x68,
-	0x63, 0xef, 0xc9, 0x64, 0x3f, 0x6a, 0xd8, 0x49, 0x54, 0xf7, 0x9f, 0x6a, 0x36, 0xa1, 0x98, 0x09,
-	0x92, 0xb8, 0x42, 0xf8, 0x0e, 0xf4, 0xbc, 0xdb, 0x68, 0xc3, 0x88, 0xec, 0x34, 0x8a, 0x7c, 0x70,
-	0x50, 0x52, 0xe3, 0xf0, 0x13, 0x18, 0x70, 0x41, 0xab, 0xf4, 0xce, 0x5c, 0x2a, 0x51, 0xdb, 0x68,
-	0xbc, 0x69, 0xd4, 0x38, 0xbb, 0x70, 0x83, 0x9f, 0x5a, 0x8f, 0x92, 0xa7, 0xba, 0xfe, 0x8c, 0xc7,
-	0xbe, 0x1a, 0x42, 0xd0, 0x2e, 0x99, 0xa2, 0xe8, 0xb1, 0xf6, 0x24, 0x31, 0x6f, 0xf8, 0x1e, 0x74,
-	0xdd, 0xae, 0x51, 0x67, 0x14, 0xac, 0x15, 0xff, 0x68, 0x99, 0xd8, 0x9f, 0x43, 0xe2, 0xab, 0xe0,
-	0x29, 0x18, 0x3c, 0x58, 0x13, 0xea, 0x1a, 0xd7, 0x5f, 0xde, 0x5e, 0x1f, 0x6e, 0x39, 0xd7, 0x63,
-	0x8c, 0x57, 0xfc, 0xae, 0xab, 0x62, 0x5b, 0x04, 0xb7, 0x41, 0x8f, 0x4f, 0x78, 0xca, 0x99, 0x50,
-	0xa8, 0x67, 0x46, 0xec, 0xf2, 0x09, 0xbf, 0x60, 0x42, 0x41, 0x02, 0xb6, 0x8c, 0x19, 0x0f, 0x3a,
-	0x49, 0xd4, 0x37, 0x96, 0x1c, 0xac, 0xdf, 0xcd, 0x3f, 0x2d, 0xbc, 0x33, 0x2f, 0xb4, 0xd8, 0x6a,
-	0x52, 0x9e, 0x44, 0x3f, 0x17, 0x61, 0x70, 0xb3, 0x08, 0x83, 0x3f, 0x8b, 0x30, 0xf8, 0xb1, 0x0c,
-	0x5b, 0x37, 0xcb, 0xb0, 0xf5, 0x7b, 0x19, 0xb6, 0xbe, 0x6c, 0x7e, 0xbf, 0x7f, 0x98, 0xea, 0x8a,
-	0x53, 0xf9, 0xb5, 0x63, 0xae, 0xf0, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xb7, 0x92,
-	0x76, 0xd2, 0x03, 0x00, 0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x4e, 0xdc, 0x30,
+	0x10, 0xc6, 0x49, 0xa1, 0xec, 0xae, 0xe9, 0x1f, 0x70, 0xa9, 0x30, 0x54, 0x44, 0x5b, 0x44, 0xab,
+	0x45, 0x85, 0xac, 0x80, 0x4b, 0x7b, 0xaa, 0x02, 0x45, 0x82, 0x43, 0x29, 0xcd, 0x4a, 0x3d, 0xf4,
+	0x12, 0x85, 0xc4, 0x4d, 0xad, 0xb2, 0xb6, 0x65, 0x9b, 0xa8, 0x3c, 0x42, 0x6f, 0x7d, 0x18, 0x1e,
+	0xa2, 0x47, 0xc4, 0xa9, 0xc7, 0x0a, 0x5e, 0xa4, 0xb2, 0x63, 0x67, 0xd9, 0x25, 0xbb, 0xb7, 0x8c,
+	0xe7, 0x37, 0x9f, 0xc7, 0x9f, 0x3d, 0x01, 0xeb, 0x67, 0xe7, 0x7d, 0x2c, 0x92, 0xae, 0x3c, 0xe7,
+	0x58, 0x50, 0x96, 0xe1, 0x6e, 0xb1, 0x5d, 0x06, 0xb1, 0x8e, 0x02, 0x2e, 0x98, 0x62, 0xf0, 0x59,
+	0x49, 0x05, 0x15, 0x15, 0x14, 0xdb, 0x2b, 0x8b, 0x39, 0xcb, 0x99, 0xc9, 0x77, 0xf5, 0x57, 0x89,
+	0xae, 0xf8, 0x29, 0x93, 0x7d, 0x26, 0xbb, 0xa7, 0x89, 0xd4, 0x5a, 0xa7, 0x58, 0x25, 0xdb, 0xdd,
+	0x94, 0x11, 0x6a, 0xf3, 0xcb, 0x65, 0x3e, 0x2e, 0x0b, 0xcb, 0xc0, 0xa6, 0x6a, 0x7b, 0x49, 0x59,
+	0xbf, 0x4f, 0xa4, 0x24, 0xcc, 0x09, 0xac, 0xd5, 0x51, 0x98, 0x66, 0x9c, 0x11, 0xaa, 0x26, 0x32,
+	0x05, 0xc9, 0x30, 0x4d, 0xed, 0x99, 0x56, 0xde, 0xd4, 0x31, 0x7d, 0xac, 0x04, 0x49, 0x65, 0x9c,
+	0xe4, 0xb9, 0xc0, 0x79, 0xa2, 0x1c, 0xbc, 0x59, 0x07, 0x13, 0x1e, 0x27, 0x59, 0x26, 0xb0, 0x94,
+	0xf1, 0x77, 0x22, 0x15, 0x13, 0x17, 0x96, 0xde, 0x18, 0x6b, 0xaa, 0x0e, 0x62, 0xa9, 0x06, 0xc2,
+	0xbb, 0x93, 0xd1, 0x24, 0x4d, 0xd9, 0x39, 0x55, 0xc3, 0xfa, 0x6b, 0xbf, 0x1a, 0xa0, 0xd5, 0xd3,
+	0xcc, 0x31, 0xcb, 0x30, 0x3c, 0x06, 0x0b, 0x45, 0x72, 0x46, 0xb2, 0x44, 0x31, 0xe1, 0x1a, 0x42,
+	0x5e, 0xdb, 0xeb, 0xb4, 0xf6, 0x5e, 0x5e, 0x5f, 0x6e, 0xad, 0x5a, 0x8f, 0xbf, 0x38, 0x26, 0x2c,
+	0x91, 0x9e, 0x12, 0x84, 0xe6, 0xd1, 0x7c, 0x31, 0xb2, 0x0e, 0x43, 0x30, 0x6b, 0x3a, 0x94, 0xe8,
+	0x41, 0x7b, 0xba, 0x33, 0xb7, 0xb3, 0x11, 0xd4, 0xdc, 0x7e, 0x50, 0xed, 0xdf, 0xd3, 0x6c, 0x84,
+	0x53, 0x26, 0xb2, 0xc8, 0x16, 0xc2, 0x77, 0xa0, 0xe9, 0xdc, 0x46, 0xd3, 0x46, 0x64, 0xb5, 0x56,
+	0xe4, 0xc0, 0x42, 0x51, 0x85, 0xc3, 0xcf, 0x60, 0x81, 0x0b, 0x5c, 0xc4, 0x03, 0x73, 0xb1, 0x44,
+	0x33, 0x46, 0xe3, 0x55, 0xad, 0xc6, 0xd1, 0x89, 0x6d, 0xfc, 0xb0, 0xf4, 0x28, 0x7a, 0xaa, 0xeb,
+	0x8f, 0x78, 0xe8, 0xaa, 0x21, 0x04, 0x33, 0x94, 0x29, 0x8c, 0x1e, 0x6a, 0x4f, 0x22, 0xf3, 0x0d,
+	0xdf, 0x83, 0x86, 0xbd, 0x6b, 0x34, 0xdb, 0xf6, 0xc6, 0x8a, 0x7f, 0x2c, 0x99, 0xd0, 0x3d, 0x87,
+	0xc8, 0x55, 0xc1, 0x43, 0xb0, 0x70, 0xef, 0x9a, 0x50, 0xc3, 0xb8, 0xfe, 0xe2, 0xfa, 0x72, 0x6b,
+	0xc9, 0xba, 0x1e, 0xa6, 0xe9, 0x88, 0xdf, 0x55, 0x55, 0x58, 0x16, 0xc1, 0x65, 0xd0, 0xe4, 0x3b,
+	0x3c, 0xe6, 0x4c, 0x28, 0xd4, 0x34, 0x2d, 0x36, 0xf8, 0x0e, 0x3f, 0x61, 0x42, 0xc1, 0x0c, 0x2c,
+	0x19, 0x33, 0xee, 0xed, 0x24, 0x51, 0xcb, 0x58, 0xb2, 0x39, 0xfe, 0x6e, 0xee, 0x6c, 0xe1, 0x9c,
+	0x79, 0xae, 0xc5, 0x46, 0x93, 0x12, 0xb6, 0xc1, 0x1c, 0xa1, 0x19, 0xe6, 0x98, 0x66, 0x98, 0x2a,
+	0x04, 0xda, 0x5e, 0xa7, 0x19, 0xdd, 0x5d, 0x82, 0x6f, 0x01, 0x90, 0xf8, 0xec, 0x9b, 0x7e, 0xb9,
+	0x3f, 0x30, 0x9a, 0x33, 0x86, 0x2d, 0x07, 0xf6, 0x88, 0x7a, 0xd2, 0x03, 0x3b, 0xe9, 0xc1, 0x3e,
+	0x23, 0x34, 0x6a, 0x69, 0xb8, 0xa7, 0x59, 0xf8, 0x01, 0xb4, 0xdc, 0x6c, 0x4a, 0xf4, 0xc8, 0xf4,
+	0xfc, 0x7a, 0x72, 0xcf, 0x07, 0x16, 0x8f, 0x06, 0x85, 0xf0, 0x13, 0x98, 0x77, 0x81, 0x1b, 0x05,
+	0xf4, 0xd8, 0x88, 0xad, 0xd7, 0xbf, 0x2b, 0x0b, 0x57, 0x4f, 0x02, 0x0f, 0x2f, 0xc0, 0x43, 0x00,
+	0x06, 0x3f, 0x16, 0xf4, 0xc4, 0x1c, 0xa8, 0x33, 0xb9, 0xaf, 0xfd, 0x8a, 0x8f, 0xee, 0xd4, 0xee,
+	0x05, 0x7f, 0x6e, 0x7c, 0xef, 0xea, 0xc6, 0xf7, 0xfe, 0xdd, 0xf8, 0xde, 0xef, 0x5b, 0x7f, 0xea,
+	0xea, 0xd6, 0x9f, 0xfa, 0x7b, 0xeb, 0x4f, 0x7d, 0x5d, 0xfc, 0x39, 0x3c, 0xd5, 0xea, 0x82, 0x63,
+	0x79, 0x3a, 0x6b, 0x46, 0x78, 0xf7, 0xff, 0x00, 0x78, 0x33, 0x47, 0x57, 0x79, 0x05, 0x00, 0x00,
 }
 
 func (m *SuperNode) Marshal() (dAtA []byte, err error) {
@@ -194,6 +254,68 @@ func (m *SuperNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Commission != nil {
+		{
+			size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSuperNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.EndpointHistory) > 0 {
+		for iNdEx := len(m.EndpointHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndpointHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SelfStake != nil {
+		{
+			size, err := m.SelfStake.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSuperNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Independent {
+		i--
+		if m.Independent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.PrevSupernodeAccounts) > 0 {
 		for iNdEx := len(m.PrevSupernodeAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -354,6 +476,29 @@ func (m *SuperNode) Size() (n int) {
 			n += 1 + l + sovSuperNode(uint64(l))
 		}
 	}
+	if m.Independent {
+		n += 2
+	}
+	if m.SelfStake != nil {
+		l = m.SelfStake.Size()
+		n += 1 + l + sovSuperNode(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovSuperNode(uint64(l))
+		}
+	}
+	if len(m.EndpointHistory) > 0 {
+		for _, e := range m.EndpointHistory {
+			l = e.Size()
+			n += 1 + l + sovSuperNode(uint64(l))
+		}
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovSuperNode(uint64(l))
+	}
 	return n
 }
 
@@ -692,6 +837,166 @@ func (m *SuperNode) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Independent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Independent = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelfStake == nil {
+				m.SelfStake = &types.Coin{}
+			}
+			if err := m.SelfStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &SupernodeEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointHistory = append(m.EndpointHistory, &EndpointHistory{})
+			if err := m.EndpointHistory[len(m.EndpointHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commission == nil {
+				m.Commission = &SupernodeCommission{}
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperNode(dAtA[iNdEx:])
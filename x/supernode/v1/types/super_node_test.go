@@ -4,6 +4,7 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/LumeraProtocol/lumera/x/supernode/v1/types"
@@ -130,6 +131,33 @@ func TestSuperNodeValidation(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "commission above max rate",
+			supernode: types.SuperNode{
+				ValidatorAddress: valAddr.String(),
+				SupernodeAccount: accAddr.String(),
+				Note:             "1.0.0",
+				States: []*types.SuperNodeStateRecord{
+					{
+						State:  types.SuperNodeStateActive,
+						Height: 1,
+					},
+				},
+				PrevIpAddresses: []*types.IPAddressHistory{
+					{
+						Address: "192.168.1.1",
+						Height:  1,
+					},
+				},
+				Commission: &types.SupernodeCommission{
+					Rate:          sdkmath.LegacyNewDecWithPrec(3, 1),
+					MaxRate:       sdkmath.LegacyNewDecWithPrec(2, 1),
+					MaxChangeRate: sdkmath.LegacyNewDecWithPrec(1, 2),
+				},
+			},
+			expectError: true,
+			errorType:   types.ErrInvalidCommission,
+		},
 	}
 
 	for _, tc := range testCases {
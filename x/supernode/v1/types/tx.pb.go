@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -130,6 +132,8 @@ type MsgRegisterSupernode struct {
 	IpAddress        string `protobuf:"bytes,3,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
 	SupernodeAccount string `protobuf:"bytes,4,opt,name=supernodeAccount,proto3" json:"supernodeAccount,omitempty"`
 	P2PPort          string `protobuf:"bytes,5,opt,name=p2p_port,json=p2pPort,proto3" json:"p2p_port,omitempty"`
+	// endpoints optionally advertises the full endpoint set of the supernode.
+	Endpoints []*SupernodeEndpoint `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 }
 
 func (m *MsgRegisterSupernode) Reset()         { *m = MsgRegisterSupernode{} }
@@ -200,6 +204,13 @@ func (m *MsgRegisterSupernode) GetP2PPort() string {
 	return ""
 }
 
+func (m *MsgRegisterSupernode) GetEndpoints() []*SupernodeEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
 type MsgRegisterSupernodeResponse struct {
 }
 
@@ -515,6 +526,13 @@ type MsgUpdateSupernode struct {
 	Note             string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
 	SupernodeAccount string `protobuf:"bytes,5,opt,name=supernodeAccount,proto3" json:"supernodeAccount,omitempty"`
 	P2PPort          string `protobuf:"bytes,6,opt,name=p2p_port,json=p2pPort,proto3" json:"p2p_port,omitempty"`
+	// endpoints, when non-empty, replaces the advertised endpoint set.
+	// Endpoints that are dropped are moved to the supernode's endpoint history.
+	Endpoints []*SupernodeEndpoint `protobuf:"bytes,7,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// clear_endpoints removes the whole advertised endpoint set, moving it to the
+	// endpoint history; the supernode is then probed at its latest IP address.
+	// It cannot be combined with endpoints.
+	ClearEndpoints bool `protobuf:"varint,8,opt,name=clear_endpoints,json=clearEndpoints,proto3" json:"clear_endpoints,omitempty"`
 }
 
 func (m *MsgUpdateSupernode) Reset()         { *m = MsgUpdateSupernode{} }
@@ -592,6 +610,20 @@ func (m *MsgUpdateSupernode) GetP2PPort() string {
 	return ""
 }
 
+func (m *MsgUpdateSupernode) GetEndpoints() []*SupernodeEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *MsgUpdateSupernode) GetClearEndpoints() bool {
+	if m != nil {
+		return m.ClearEndpoints
+	}
+	return false
+}
+
 type MsgUpdateSupernodeResponse struct {
 }
 
@@ -740,1129 +772,3715 @@ func (m *MsgReportSupernodeMetricsResponse) GetIssues() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "lumera.supernode.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumera.supernode.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRegisterSupernode)(nil), "lumera.supernode.v1.MsgRegisterSupernode")
-	proto.RegisterType((*MsgRegisterSupernodeResponse)(nil), "lumera.supernode.v1.MsgRegisterSupernodeResponse")
-	proto.RegisterType((*MsgDeregisterSupernode)(nil), "lumera.supernode.v1.MsgDeregisterSupernode")
-	proto.RegisterType((*MsgDeregisterSupernodeResponse)(nil), "lumera.supernode.v1.MsgDeregisterSupernodeResponse")
-	proto.RegisterType((*MsgStartSupernode)(nil), "lumera.supernode.v1.MsgStartSupernode")
-	proto.RegisterType((*MsgStartSupernodeResponse)(nil), "lumera.supernode.v1.MsgStartSupernodeResponse")
-	proto.RegisterType((*MsgStopSupernode)(nil), "lumera.supernode.v1.MsgStopSupernode")
-	proto.RegisterType((*MsgStopSupernodeResponse)(nil), "lumera.supernode.v1.MsgStopSupernodeResponse")
-	proto.RegisterType((*MsgUpdateSupernode)(nil), "lumera.supernode.v1.MsgUpdateSupernode")
-	proto.RegisterType((*MsgUpdateSupernodeResponse)(nil), "lumera.supernode.v1.MsgUpdateSupernodeResponse")
-	proto.RegisterType((*MsgReportSupernodeMetrics)(nil), "lumera.supernode.v1.MsgReportSupernodeMetrics")
-	proto.RegisterType((*MsgReportSupernodeMetricsResponse)(nil), "lumera.supernode.v1.MsgReportSupernodeMetricsResponse")
+// MsgRegisterIndependentSupernode registers a supernode whose operator does not
+// run a validator. The supernode is keyed by the validator-format address of
+// the creator account and self_stake is moved into the module.
+type MsgRegisterIndependentSupernode struct {
+	Creator          string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	IpAddress        string     `protobuf:"bytes,2,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	SupernodeAccount string     `protobuf:"bytes,3,opt,name=supernodeAccount,proto3" json:"supernodeAccount,omitempty"`
+	P2PPort          string     `protobuf:"bytes,4,opt,name=p2p_port,json=p2pPort,proto3" json:"p2p_port,omitempty"`
+	SelfStake        types.Coin `protobuf:"bytes,5,opt,name=self_stake,json=selfStake,proto3" json:"self_stake"`
+	// endpoints optionally advertises the full endpoint set of the supernode.
+	Endpoints []*SupernodeEndpoint `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 }
 
-func init() { proto.RegisterFile("lumera/supernode/v1/tx.proto", fileDescriptor_f37d1e42a1fd3ecf) }
-
-var fileDescriptor_f37d1e42a1fd3ecf = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0xf9, 0x11, 0xc8, 0x83, 0x16, 0x62, 0xa2, 0x90, 0x98, 0xd4, 0x0d, 0x51, 0x69, 0x69,
-	0xda, 0x38, 0x22, 0x48, 0xa8, 0xe2, 0x50, 0x89, 0xa8, 0x95, 0x7a, 0x49, 0x85, 0x8c, 0x5a, 0xa9,
-	0xbd, 0x44, 0xc6, 0x19, 0x19, 0xab, 0xb1, 0xc7, 0x9d, 0x99, 0x50, 0xb8, 0x54, 0x68, 0x8f, 0x7b,
-	0xda, 0xc3, 0x5e, 0xf7, 0xbe, 0x47, 0x0e, 0xfc, 0x07, 0x7b, 0xe1, 0xb6, 0x88, 0xd3, 0x9e, 0x56,
-	0xbb, 0x70, 0xe0, 0xdf, 0x58, 0xd9, 0x1e, 0x4f, 0x36, 0xb6, 0x83, 0x82, 0xb4, 0xec, 0x25, 0xca,
-	0xbc, 0xf7, 0xcd, 0x7b, 0xdf, 0xf7, 0xe6, 0xe9, 0x93, 0xa1, 0xd2, 0x1f, 0x38, 0x88, 0x18, 0x4d,
-	0x3a, 0xf0, 0x10, 0x71, 0x71, 0x0f, 0x35, 0x8f, 0xb7, 0x9a, 0xec, 0x44, 0xf3, 0x08, 0x66, 0x58,
-	0x5e, 0x09, 0xb3, 0x9a, 0xc8, 0x6a, 0xc7, 0x5b, 0x4a, 0xde, 0x70, 0x6c, 0x17, 0x37, 0x83, 0xdf,
-	0x10, 0xa7, 0xac, 0x9a, 0x98, 0x3a, 0x98, 0x36, 0x1d, 0x6a, 0xf9, 0xf7, 0x1d, 0x6a, 0xf1, 0x44,
-	0x39, 0x4c, 0x74, 0x83, 0x53, 0x33, 0x3c, 0xf0, 0x54, 0xc1, 0xc2, 0x16, 0x0e, 0xe3, 0xfe, 0x3f,
-	0x1e, 0xad, 0xa6, 0xf1, 0xf1, 0x0c, 0x62, 0x38, 0xd1, 0xbd, 0xf5, 0x34, 0x84, 0x83, 0x18, 0xb1,
-	0x4d, 0x0e, 0xa9, 0xbd, 0x92, 0x60, 0xa9, 0x43, 0xad, 0x3f, 0xbc, 0x9e, 0xc1, 0xd0, 0x7e, 0x70,
-	0x59, 0xde, 0x81, 0x9c, 0x31, 0x60, 0x47, 0x98, 0xd8, 0xec, 0xb4, 0x24, 0x55, 0xa5, 0xcd, 0x5c,
-	0xbb, 0x74, 0x7d, 0xd1, 0x28, 0x70, 0x4e, 0x7b, 0xbd, 0x1e, 0x41, 0x94, 0x1e, 0x30, 0x62, 0xbb,
-	0x96, 0x3e, 0x84, 0xca, 0x3f, 0x43, 0x36, 0x6c, 0x5f, 0x9a, 0xaa, 0x4a, 0x9b, 0x0b, 0xad, 0x35,
-	0x2d, 0x65, 0x26, 0x5a, 0xd8, 0xa4, 0x9d, 0xbb, 0x7c, 0xfb, 0x75, 0xe6, 0xe5, 0xdd, 0x79, 0x5d,
-	0xd2, 0xf9, 0xad, 0xdd, 0x9f, 0x9e, 0xdc, 0x9d, 0xd7, 0x87, 0xf5, 0x9e, 0xde, 0x9d, 0xd7, 0x37,
-	0xb8, 0x82, 0x93, 0x51, 0x0d, 0x31, 0xc6, 0xb5, 0x32, 0xac, 0xc6, 0x42, 0x3a, 0xa2, 0x1e, 0x76,
-	0x29, 0xaa, 0xbd, 0x96, 0xa0, 0xd0, 0xa1, 0x96, 0x8e, 0x2c, 0x9b, 0x32, 0x44, 0x0e, 0xa2, 0x32,
-	0x72, 0x09, 0xe6, 0x4c, 0x82, 0x0c, 0x86, 0x49, 0xa8, 0x51, 0x8f, 0x8e, 0x72, 0x1d, 0x96, 0x8f,
-	0x8d, 0xbe, 0xdd, 0xf3, 0x0f, 0x5c, 0x6c, 0xa0, 0x28, 0xa7, 0x27, 0xe2, 0x72, 0x05, 0x72, 0xb6,
-	0x17, 0x81, 0xa6, 0x03, 0xd0, 0x30, 0xe0, 0x57, 0x12, 0xbc, 0xf7, 0x4c, 0x13, 0x0f, 0x5c, 0x56,
-	0x9a, 0x09, 0x2b, 0xc5, 0xe3, 0x72, 0x19, 0xe6, 0xbd, 0x96, 0xd7, 0xf5, 0x30, 0x61, 0xa5, 0xd9,
-	0x90, 0x90, 0xd7, 0xf2, 0xf6, 0x31, 0x61, 0xbb, 0x8b, 0xfe, 0x60, 0x22, 0x7a, 0x35, 0x15, 0x2a,
-	0x69, 0x82, 0x84, 0xe2, 0x3e, 0x14, 0x3b, 0xd4, 0xfa, 0x05, 0x91, 0xc7, 0x91, 0x1c, 0x63, 0x53,
-	0x05, 0x35, 0xbd, 0x9b, 0xe0, 0x63, 0x41, 0xbe, 0x43, 0xad, 0x03, 0x66, 0x10, 0xf6, 0xb8, 0x54,
-	0xd6, 0xa0, 0x9c, 0x68, 0x24, 0x58, 0xfc, 0x0f, 0xcb, 0x41, 0x12, 0x7b, 0x9f, 0x7a, 0x05, 0x8a,
-	0x90, 0x25, 0xc8, 0xa0, 0xd8, 0xe5, 0xef, 0xcf, 0x4f, 0x31, 0x72, 0x0a, 0x94, 0xe2, 0xfd, 0x05,
-	0xb7, 0xf7, 0x12, 0xc8, 0x62, 0x7f, 0x3f, 0xef, 0x86, 0xca, 0x30, 0xe3, 0x62, 0x86, 0xf8, 0x56,
-	0x06, 0xff, 0x53, 0xb7, 0x76, 0x76, 0x82, 0xad, 0xcd, 0xde, 0xb7, 0xb5, 0x15, 0x50, 0x92, 0x12,
-	0xc5, 0x04, 0x9e, 0x4f, 0x05, 0x6f, 0xa7, 0x23, 0xbf, 0x90, 0x48, 0x77, 0x42, 0xab, 0x92, 0x7f,
-	0x87, 0xbc, 0x90, 0xd5, 0x35, 0xb8, 0x94, 0xd0, 0x98, 0xd6, 0xaf, 0x2f, 0x1a, 0x5f, 0x71, 0x63,
-	0xfa, 0x33, 0x26, 0x9d, 0x3b, 0x54, 0x72, 0x24, 0xbf, 0x41, 0x5e, 0x08, 0xe9, 0x1a, 0x5c, 0x61,
-	0x30, 0xbf, 0xf6, 0xda, 0xf5, 0x45, 0x63, 0x35, 0x32, 0x3a, 0xd3, 0x8c, 0x55, 0x4a, 0xc8, 0xff,
-	0x15, 0xe6, 0xb8, 0x9f, 0x06, 0xa3, 0x5d, 0x68, 0x6d, 0xa4, 0x7a, 0x5e, 0x5c, 0x51, 0x7b, 0xc6,
-	0x77, 0x3f, 0x3d, 0xba, 0xbb, 0x5b, 0xf4, 0x47, 0x95, 0xe4, 0x54, 0xfb, 0x0b, 0xd6, 0xc7, 0x4e,
-	0x25, 0x9a, 0x9d, 0xff, 0xc0, 0x26, 0x76, 0xbc, 0xbe, 0x6d, 0xb8, 0x2c, 0x98, 0xca, 0xbc, 0x3e,
-	0x0c, 0xf8, 0xdb, 0x69, 0x53, 0x3a, 0x40, 0xfe, 0x82, 0x4c, 0xfb, 0xdb, 0x19, 0x9e, 0x5a, 0x2f,
-	0xb2, 0x30, 0xdd, 0xa1, 0x96, 0x7c, 0x08, 0x8b, 0x23, 0xe6, 0xff, 0x4d, 0xaa, 0x80, 0x98, 0xbb,
-	0x2a, 0x3f, 0x4e, 0x82, 0x12, 0x0c, 0xff, 0x85, 0x7c, 0xd2, 0x7f, 0xbf, 0x1f, 0x57, 0x22, 0x01,
-	0x55, 0xb6, 0x26, 0x86, 0x8a, 0x96, 0xff, 0xc1, 0x4a, 0x9a, 0x03, 0xfe, 0x30, 0xae, 0x52, 0x0a,
-	0x58, 0xd9, 0x7e, 0x00, 0x58, 0x34, 0x3e, 0x82, 0x2f, 0x63, 0x56, 0xf7, 0xed, 0xb8, 0x32, 0xa3,
-	0x38, 0x45, 0x9b, 0x0c, 0x27, 0x3a, 0x21, 0xf8, 0x62, 0xd4, 0xce, 0x36, 0xc6, 0x17, 0xf8, 0x08,
-	0xa6, 0x34, 0x26, 0x82, 0x89, 0x36, 0xff, 0xc0, 0x52, 0xdc, 0x98, 0xbe, 0xbb, 0xff, 0xf5, 0x87,
-	0xad, 0x9a, 0x13, 0x02, 0x45, 0xb3, 0x33, 0x09, 0x8a, 0x63, 0x4c, 0x40, 0x1b, 0xbf, 0x04, 0x69,
-	0x78, 0x65, 0xe7, 0x61, 0xf8, 0x88, 0x82, 0x32, 0x7b, 0xe6, 0x7f, 0x94, 0xb4, 0xb5, 0xcb, 0x1b,
-	0x55, 0xba, 0xba, 0x51, 0xa5, 0x77, 0x37, 0xaa, 0xf4, 0xec, 0x56, 0xcd, 0x5c, 0xdd, 0xaa, 0x99,
-	0x37, 0xb7, 0x6a, 0xe6, 0xef, 0x42, 0xec, 0x63, 0x84, 0x9d, 0x7a, 0x88, 0x1e, 0x66, 0x83, 0xef,
-	0xa9, 0xed, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xf1, 0x24, 0x57, 0x26, 0x0a, 0x00, 0x00,
+func (m *MsgRegisterIndependentSupernode) Reset()         { *m = MsgRegisterIndependentSupernode{} }
+func (m *MsgRegisterIndependentSupernode) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIndependentSupernode) ProtoMessage()    {}
+func (*MsgRegisterIndependentSupernode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{14}
+}
+func (m *MsgRegisterIndependentSupernode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIndependentSupernode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIndependentSupernode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIndependentSupernode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIndependentSupernode.Merge(m, src)
+}
+func (m *MsgRegisterIndependentSupernode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIndependentSupernode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIndependentSupernode.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterIndependentSupernode proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	RegisterSupernode(ctx context.Context, in *MsgRegisterSupernode, opts ...grpc.CallOption) (*MsgRegisterSupernodeResponse, error)
-	DeregisterSupernode(ctx context.Context, in *MsgDeregisterSupernode, opts ...grpc.CallOption) (*MsgDeregisterSupernodeResponse, error)
-	StartSupernode(ctx context.Context, in *MsgStartSupernode, opts ...grpc.CallOption) (*MsgStartSupernodeResponse, error)
-	StopSupernode(ctx context.Context, in *MsgStopSupernode, opts ...grpc.CallOption) (*MsgStopSupernodeResponse, error)
-	UpdateSupernode(ctx context.Context, in *MsgUpdateSupernode, opts ...grpc.CallOption) (*MsgUpdateSupernodeResponse, error)
-	ReportSupernodeMetrics(ctx context.Context, in *MsgReportSupernodeMetrics, opts ...grpc.CallOption) (*MsgReportSupernodeMetricsResponse, error)
+func (m *MsgRegisterIndependentSupernode) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterIndependentSupernode) GetIpAddress() string {
+	if m != nil {
+		return m.IpAddress
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterIndependentSupernode) GetSupernodeAccount() string {
+	if m != nil {
+		return m.SupernodeAccount
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterIndependentSupernode) GetP2PPort() string {
+	if m != nil {
+		return m.P2PPort
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RegisterSupernode(ctx context.Context, in *MsgRegisterSupernode, opts ...grpc.CallOption) (*MsgRegisterSupernodeResponse, error) {
-	out := new(MsgRegisterSupernodeResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/RegisterSupernode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterIndependentSupernode) GetSelfStake() types.Coin {
+	if m != nil {
+		return m.SelfStake
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) DeregisterSupernode(ctx context.Context, in *MsgDeregisterSupernode, opts ...grpc.CallOption) (*MsgDeregisterSupernodeResponse, error) {
-	out := new(MsgDeregisterSupernodeResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/DeregisterSupernode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterIndependentSupernode) GetEndpoints() []*SupernodeEndpoint {
+	if m != nil {
+		return m.Endpoints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) StartSupernode(ctx context.Context, in *MsgStartSupernode, opts ...grpc.CallOption) (*MsgStartSupernodeResponse, error) {
-	out := new(MsgStartSupernodeResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/StartSupernode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRegisterIndependentSupernodeResponse struct {
+	// validator_address is the derived address the supernode is keyed by.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (c *msgClient) StopSupernode(ctx context.Context, in *MsgStopSupernode, opts ...grpc.CallOption) (*MsgStopSupernodeResponse, error) {
-	out := new(MsgStopSupernodeResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/StopSupernode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterIndependentSupernodeResponse) Reset() {
+	*m = MsgRegisterIndependentSupernodeResponse{}
+}
+func (m *MsgRegisterIndependentSupernodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIndependentSupernodeResponse) ProtoMessage()    {}
+func (*MsgRegisterIndependentSupernodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{15}
+}
+func (m *MsgRegisterIndependentSupernodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIndependentSupernodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIndependentSupernodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterIndependentSupernodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIndependentSupernodeResponse.Merge(m, src)
+}
+func (m *MsgRegisterIndependentSupernodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIndependentSupernodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIndependentSupernodeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateSupernode(ctx context.Context, in *MsgUpdateSupernode, opts ...grpc.CallOption) (*MsgUpdateSupernodeResponse, error) {
-	out := new(MsgUpdateSupernodeResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/UpdateSupernode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterIndependentSupernodeResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterIndependentSupernodeResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ReportSupernodeMetrics(ctx context.Context, in *MsgReportSupernodeMetrics, opts ...grpc.CallOption) (*MsgReportSupernodeMetricsResponse, error) {
-	out := new(MsgReportSupernodeMetricsResponse)
-	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/ReportSupernodeMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgBondSupernodeStake struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgBondSupernodeStake) Reset()         { *m = MsgBondSupernodeStake{} }
+func (m *MsgBondSupernodeStake) String() string { return proto.CompactTextString(m) }
+func (*MsgBondSupernodeStake) ProtoMessage()    {}
+func (*MsgBondSupernodeStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{16}
+}
+func (m *MsgBondSupernodeStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondSupernodeStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondSupernodeStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgBondSupernodeStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondSupernodeStake.Merge(m, src)
+}
+func (m *MsgBondSupernodeStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondSupernodeStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondSupernodeStake.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	RegisterSupernode(context.Context, *MsgRegisterSupernode) (*MsgRegisterSupernodeResponse, error)
-	DeregisterSupernode(context.Context, *MsgDeregisterSupernode) (*MsgDeregisterSupernodeResponse, error)
-	StartSupernode(context.Context, *MsgStartSupernode) (*MsgStartSupernodeResponse, error)
-	StopSupernode(context.Context, *MsgStopSupernode) (*MsgStopSupernodeResponse, error)
-	UpdateSupernode(context.Context, *MsgUpdateSupernode) (*MsgUpdateSupernodeResponse, error)
-	ReportSupernodeMetrics(context.Context, *MsgReportSupernodeMetrics) (*MsgReportSupernodeMetricsResponse, error)
+var xxx_messageInfo_MsgBondSupernodeStake proto.InternalMessageInfo
+
+func (m *MsgBondSupernodeStake) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgBondSupernodeStake) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+type MsgBondSupernodeStakeResponse struct {
 }
-func (*UnimplementedMsgServer) RegisterSupernode(ctx context.Context, req *MsgRegisterSupernode) (*MsgRegisterSupernodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterSupernode not implemented")
+
+func (m *MsgBondSupernodeStakeResponse) Reset()         { *m = MsgBondSupernodeStakeResponse{} }
+func (m *MsgBondSupernodeStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondSupernodeStakeResponse) ProtoMessage()    {}
+func (*MsgBondSupernodeStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{17}
 }
-func (*UnimplementedMsgServer) DeregisterSupernode(ctx context.Context, req *MsgDeregisterSupernode) (*MsgDeregisterSupernodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeregisterSupernode not implemented")
+func (m *MsgBondSupernodeStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) StartSupernode(ctx context.Context, req *MsgStartSupernode) (*MsgStartSupernodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartSupernode not implemented")
+func (m *MsgBondSupernodeStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondSupernodeStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) StopSupernode(ctx context.Context, req *MsgStopSupernode) (*MsgStopSupernodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopSupernode not implemented")
+func (m *MsgBondSupernodeStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondSupernodeStakeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateSupernode(ctx context.Context, req *MsgUpdateSupernode) (*MsgUpdateSupernodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupernode not implemented")
+func (m *MsgBondSupernodeStakeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ReportSupernodeMetrics(ctx context.Context, req *MsgReportSupernodeMetrics) (*MsgReportSupernodeMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportSupernodeMetrics not implemented")
+func (m *MsgBondSupernodeStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondSupernodeStakeResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgBondSupernodeStakeResponse proto.InternalMessageInfo
+
+type MsgUnbondSupernodeStake struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/UpdateParams",
+func (m *MsgUnbondSupernodeStake) Reset()         { *m = MsgUnbondSupernodeStake{} }
+func (m *MsgUnbondSupernodeStake) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondSupernodeStake) ProtoMessage()    {}
+func (*MsgUnbondSupernodeStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{18}
+}
+func (m *MsgUnbondSupernodeStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondSupernodeStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondSupernodeStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgUnbondSupernodeStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondSupernodeStake.Merge(m, src)
+}
+func (m *MsgUnbondSupernodeStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondSupernodeStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondSupernodeStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondSupernodeStake proto.InternalMessageInfo
+
+func (m *MsgUnbondSupernodeStake) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RegisterSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterSupernode)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterSupernode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/RegisterSupernode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterSupernode(ctx, req.(*MsgRegisterSupernode))
+func (m *MsgUnbondSupernodeStake) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_DeregisterSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeregisterSupernode)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeregisterSupernode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/DeregisterSupernode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeregisterSupernode(ctx, req.(*MsgDeregisterSupernode))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUnbondSupernodeStakeResponse struct {
+	CompletionHeight int64 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
 }
 
-func _Msg_StartSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartSupernode)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartSupernode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/StartSupernode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartSupernode(ctx, req.(*MsgStartSupernode))
+func (m *MsgUnbondSupernodeStakeResponse) Reset()         { *m = MsgUnbondSupernodeStakeResponse{} }
+func (m *MsgUnbondSupernodeStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondSupernodeStakeResponse) ProtoMessage()    {}
+func (*MsgUnbondSupernodeStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{19}
+}
+func (m *MsgUnbondSupernodeStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondSupernodeStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondSupernodeStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnbondSupernodeStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondSupernodeStakeResponse.Merge(m, src)
+}
+func (m *MsgUnbondSupernodeStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondSupernodeStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondSupernodeStakeResponse.DiscardUnknown(m)
 }
 
-func _Msg_StopSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStopSupernode)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StopSupernode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/StopSupernode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StopSupernode(ctx, req.(*MsgStopSupernode))
+var xxx_messageInfo_MsgUnbondSupernodeStakeResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondSupernodeStakeResponse) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateSupernode)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateSupernode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/UpdateSupernode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateSupernode(ctx, req.(*MsgUpdateSupernode))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAppealSlash is submitted by the validator operator of a slashed supernode.
+type MsgAppealSlash struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SlashId uint64 `protobuf:"varint,2,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Msg_ReportSupernodeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReportSupernodeMetrics)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgAppealSlash) Reset()         { *m = MsgAppealSlash{} }
+func (m *MsgAppealSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlash) ProtoMessage()    {}
+func (*MsgAppealSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{20}
+}
+func (m *MsgAppealSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReportSupernodeMetrics(ctx, in)
+}
+func (m *MsgAppealSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlash.Merge(m, src)
+}
+func (m *MsgAppealSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealSlash proto.InternalMessageInfo
+
+func (m *MsgAppealSlash) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lumera.supernode.v1.Msg/ReportSupernodeMetrics",
+	return ""
+}
+
+func (m *MsgAppealSlash) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReportSupernodeMetrics(ctx, req.(*MsgReportSupernodeMetrics))
+	return 0
+}
+
+func (m *MsgAppealSlash) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lumera.supernode.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterSupernode",
-			Handler:    _Msg_RegisterSupernode_Handler,
-		},
-		{
-			MethodName: "DeregisterSupernode",
-			Handler:    _Msg_DeregisterSupernode_Handler,
-		},
-		{
-			MethodName: "StartSupernode",
-			Handler:    _Msg_StartSupernode_Handler,
-		},
-		{
-			MethodName: "StopSupernode",
-			Handler:    _Msg_StopSupernode_Handler,
-		},
-		{
-			MethodName: "UpdateSupernode",
-			Handler:    _Msg_UpdateSupernode_Handler,
-		},
-		{
-			MethodName: "ReportSupernodeMetrics",
-			Handler:    _Msg_ReportSupernodeMetrics_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lumera/supernode/v1/tx.proto",
+type MsgAppealSlashResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAppealSlashResponse) Reset()         { *m = MsgAppealSlashResponse{} }
+func (m *MsgAppealSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlashResponse) ProtoMessage()    {}
+func (*MsgAppealSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{21}
+}
+func (m *MsgAppealSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAppealSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlashResponse.Merge(m, src)
+}
+func (m *MsgAppealSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlashResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAppealSlashResponse proto.InternalMessageInfo
+
+// MsgResolveSlashAppeal resolves an appealed slash. When uphold is true the
+// slash executes immediately, otherwise it is overturned.
+type MsgResolveSlashAppeal struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SlashId   uint64 `protobuf:"varint,2,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+	Uphold    bool   `protobuf:"varint,3,opt,name=uphold,proto3" json:"uphold,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgResolveSlashAppeal) Reset()         { *m = MsgResolveSlashAppeal{} }
+func (m *MsgResolveSlashAppeal) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveSlashAppeal) ProtoMessage()    {}
+func (*MsgResolveSlashAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{22}
+}
+func (m *MsgResolveSlashAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveSlashAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveSlashAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgResolveSlashAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveSlashAppeal.Merge(m, src)
+}
+func (m *MsgResolveSlashAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveSlashAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveSlashAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveSlashAppeal proto.InternalMessageInfo
+
+func (m *MsgResolveSlashAppeal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveSlashAppeal) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResolveSlashAppeal) GetUphold() bool {
+	if m != nil {
+		return m.Uphold
+	}
+	return false
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgResolveSlashAppealResponse struct {
 }
 
-func (m *MsgRegisterSupernode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResolveSlashAppealResponse) Reset()         { *m = MsgResolveSlashAppealResponse{} }
+func (m *MsgResolveSlashAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveSlashAppealResponse) ProtoMessage()    {}
+func (*MsgResolveSlashAppealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{23}
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveSlashAppealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterSupernode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResolveSlashAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveSlashAppealResponse.Merge(m, src)
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveSlashAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveSlashAppealResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.P2PPort) > 0 {
-		i -= len(m.P2PPort)
-		copy(dAtA[i:], m.P2PPort)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.P2PPort)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SupernodeAccount) > 0 {
-		i -= len(m.SupernodeAccount)
-		copy(dAtA[i:], m.SupernodeAccount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IpAddress) > 0 {
-		i -= len(m.IpAddress)
-		copy(dAtA[i:], m.IpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpAddress)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_MsgResolveSlashAppealResponse proto.InternalMessageInfo
+
+// MsgSetSupernodeCommission is submitted by the validator operator of a
+// supernode. max_rate and max_change_rate are required the first time a
+// commission is set and cannot be changed afterwards.
+//
+// The delegator share of a payout is allocated to the validator like block
+// rewards, so the validator's staking commission is also taken from it:
+// delegators receive (1 - rate) * (1 - staking commission rate) of a payout.
+type MsgSetSupernodeCommission struct {
+	Creator          string                       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ValidatorAddress string                       `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rate             cosmossdk_io_math.LegacyDec  `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	MaxRate          *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate,omitempty"`
+	MaxChangeRate    *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate,omitempty"`
+}
+
+func (m *MsgSetSupernodeCommission) Reset()         { *m = MsgSetSupernodeCommission{} }
+func (m *MsgSetSupernodeCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSupernodeCommission) ProtoMessage()    {}
+func (*MsgSetSupernodeCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{24}
+}
+func (m *MsgSetSupernodeCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSupernodeCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSupernodeCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSetSupernodeCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSupernodeCommission.Merge(m, src)
+}
+func (m *MsgSetSupernodeCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSupernodeCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSupernodeCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSupernodeCommission proto.InternalMessageInfo
+
+func (m *MsgSetSupernodeCommission) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetSupernodeCommission) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterSupernodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgSetSupernodeCommissionResponse struct {
+}
+
+func (m *MsgSetSupernodeCommissionResponse) Reset()         { *m = MsgSetSupernodeCommissionResponse{} }
+func (m *MsgSetSupernodeCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSupernodeCommissionResponse) ProtoMessage()    {}
+func (*MsgSetSupernodeCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f37d1e42a1fd3ecf, []int{25}
+}
+func (m *MsgSetSupernodeCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSupernodeCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSupernodeCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetSupernodeCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSupernodeCommissionResponse.Merge(m, src)
+}
+func (m *MsgSetSupernodeCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSupernodeCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSupernodeCommissionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetSupernodeCommissionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "lumera.supernode.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lumera.supernode.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterSupernode)(nil), "lumera.supernode.v1.MsgRegisterSupernode")
+	proto.RegisterType((*MsgRegisterSupernodeResponse)(nil), "lumera.supernode.v1.MsgRegisterSupernodeResponse")
+	proto.RegisterType((*MsgDeregisterSupernode)(nil), "lumera.supernode.v1.MsgDeregisterSupernode")
+	proto.RegisterType((*MsgDeregisterSupernodeResponse)(nil), "lumera.supernode.v1.MsgDeregisterSupernodeResponse")
+	proto.RegisterType((*MsgStartSupernode)(nil), "lumera.supernode.v1.MsgStartSupernode")
+	proto.RegisterType((*MsgStartSupernodeResponse)(nil), "lumera.supernode.v1.MsgStartSupernodeResponse")
+	proto.RegisterType((*MsgStopSupernode)(nil), "lumera.supernode.v1.MsgStopSupernode")
+	proto.RegisterType((*MsgStopSupernodeResponse)(nil), "lumera.supernode.v1.MsgStopSupernodeResponse")
+	proto.RegisterType((*MsgUpdateSupernode)(nil), "lumera.supernode.v1.MsgUpdateSupernode")
+	proto.RegisterType((*MsgUpdateSupernodeResponse)(nil), "lumera.supernode.v1.MsgUpdateSupernodeResponse")
+	proto.RegisterType((*MsgReportSupernodeMetrics)(nil), "lumera.supernode.v1.MsgReportSupernodeMetrics")
+	proto.RegisterType((*MsgReportSupernodeMetricsResponse)(nil), "lumera.supernode.v1.MsgReportSupernodeMetricsResponse")
+	proto.RegisterType((*MsgRegisterIndependentSupernode)(nil), "lumera.supernode.v1.MsgRegisterIndependentSupernode")
+	proto.RegisterType((*MsgRegisterIndependentSupernodeResponse)(nil), "lumera.supernode.v1.MsgRegisterIndependentSupernodeResponse")
+	proto.RegisterType((*MsgBondSupernodeStake)(nil), "lumera.supernode.v1.MsgBondSupernodeStake")
+	proto.RegisterType((*MsgBondSupernodeStakeResponse)(nil), "lumera.supernode.v1.MsgBondSupernodeStakeResponse")
+	proto.RegisterType((*MsgUnbondSupernodeStake)(nil), "lumera.supernode.v1.MsgUnbondSupernodeStake")
+	proto.RegisterType((*MsgUnbondSupernodeStakeResponse)(nil), "lumera.supernode.v1.MsgUnbondSupernodeStakeResponse")
+	proto.RegisterType((*MsgAppealSlash)(nil), "lumera.supernode.v1.MsgAppealSlash")
+	proto.RegisterType((*MsgAppealSlashResponse)(nil), "lumera.supernode.v1.MsgAppealSlashResponse")
+	proto.RegisterType((*MsgResolveSlashAppeal)(nil), "lumera.supernode.v1.MsgResolveSlashAppeal")
+	proto.RegisterType((*MsgResolveSlashAppealResponse)(nil), "lumera.supernode.v1.MsgResolveSlashAppealResponse")
+	proto.RegisterType((*MsgSetSupernodeCommission)(nil), "lumera.supernode.v1.MsgSetSupernodeCommission")
+	proto.RegisterType((*MsgSetSupernodeCommissionResponse)(nil), "lumera.supernode.v1.MsgSetSupernodeCommissionResponse")
 }
 
-func (m *MsgRegisterSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("lumera/supernode/v1/tx.proto", fileDescriptor_f37d1e42a1fd3ecf) }
+
+var fileDescriptor_f37d1e42a1fd3ecf = []byte{
+	// 1409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x36, 0x2d, 0x3f, 0xa4, 0x71, 0x12, 0xc7, 0x8c, 0xeb, 0xc8, 0xb4, 0x23, 0xdb, 0xcc, 0xcb,
+	0xb5, 0x2b, 0x0a, 0x56, 0x1e, 0x28, 0x8c, 0x20, 0x68, 0x64, 0xbb, 0x48, 0x80, 0x2a, 0x08, 0x68,
+	0xa4, 0x40, 0x7b, 0x11, 0xd6, 0xe4, 0x96, 0x22, 0x22, 0x72, 0x59, 0x2e, 0xe5, 0xda, 0x3d, 0x14,
+	0x41, 0x8f, 0x3d, 0x05, 0x45, 0xfb, 0x1f, 0x7a, 0x4c, 0x01, 0xff, 0x82, 0xf6, 0x12, 0x14, 0x45,
+	0x11, 0xf8, 0x54, 0xf4, 0x10, 0x14, 0xc9, 0x21, 0xff, 0xa2, 0x28, 0xb8, 0x5c, 0xad, 0x24, 0x8a,
+	0x54, 0x64, 0x21, 0x29, 0x7a, 0x31, 0xb4, 0x3b, 0xdf, 0xce, 0xe3, 0x9b, 0xd9, 0xd9, 0xa1, 0x61,
+	0xb1, 0xd1, 0x74, 0xb0, 0x8f, 0x4a, 0xb4, 0xe9, 0x61, 0xdf, 0x25, 0x26, 0x2e, 0xed, 0x6f, 0x94,
+	0x82, 0x03, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x73, 0x91, 0x54, 0x13, 0x52, 0x6d, 0x7f, 0x43, 0x99,
+	0x41, 0x8e, 0xed, 0x92, 0x12, 0xfb, 0x1b, 0xe1, 0x94, 0xf3, 0x06, 0xa1, 0x0e, 0xa1, 0x25, 0x87,
+	0x5a, 0xe1, 0x79, 0x87, 0x5a, 0x5c, 0x30, 0x1f, 0x09, 0x6a, 0x6c, 0x55, 0x8a, 0x16, 0x5c, 0x34,
+	0x6b, 0x11, 0x8b, 0x44, 0xfb, 0xe1, 0x2f, 0xbe, 0x5b, 0xe0, 0x9a, 0xf6, 0x10, 0x0d, 0x5d, 0xd9,
+	0xc3, 0x01, 0xda, 0x28, 0x19, 0xc4, 0x76, 0xb9, 0x5c, 0x4d, 0xf2, 0x17, 0xbb, 0xa6, 0x47, 0x6c,
+	0x37, 0xe0, 0x98, 0xe5, 0x24, 0x8c, 0x87, 0x7c, 0xe4, 0xb4, 0x6c, 0xaf, 0x24, 0x21, 0x1c, 0x1c,
+	0xf8, 0xb6, 0xc1, 0x21, 0xea, 0xaf, 0x12, 0x4c, 0x57, 0xa9, 0xf5, 0xd0, 0x33, 0x51, 0x80, 0x1f,
+	0xb0, 0xc3, 0xf2, 0x4d, 0xc8, 0xa1, 0x66, 0x50, 0x27, 0xbe, 0x1d, 0x1c, 0xe6, 0xa5, 0x65, 0x69,
+	0x35, 0x57, 0xc9, 0x1f, 0x1f, 0x15, 0x67, 0x79, 0x5c, 0x77, 0x4c, 0xd3, 0xc7, 0x94, 0xee, 0x06,
+	0xbe, 0xed, 0x5a, 0x7a, 0x1b, 0x2a, 0xdf, 0x86, 0x89, 0xc8, 0x7c, 0x7e, 0x74, 0x59, 0x5a, 0x9d,
+	0x2a, 0x2f, 0x68, 0x09, 0xbc, 0x6a, 0x91, 0x91, 0x4a, 0xee, 0xd9, 0x8b, 0xa5, 0x91, 0x9f, 0x5e,
+	0x3f, 0x5d, 0x93, 0x74, 0x7e, 0x6a, 0xf3, 0xc3, 0x6f, 0x5f, 0x3f, 0x5d, 0x6b, 0xeb, 0xfb, 0xee,
+	0xf5, 0xd3, 0xb5, 0xcb, 0x3c, 0x82, 0x83, 0xee, 0x18, 0x62, 0x1e, 0xab, 0xf3, 0x70, 0x3e, 0xb6,
+	0xa5, 0x63, 0xea, 0x11, 0x97, 0x62, 0xf5, 0xc7, 0x51, 0x98, 0xad, 0x52, 0x4b, 0xc7, 0x96, 0x4d,
+	0x03, 0xec, 0xef, 0xb6, 0xd4, 0xc8, 0x79, 0x98, 0x34, 0x7c, 0x8c, 0x02, 0xe2, 0x47, 0x31, 0xea,
+	0xad, 0xa5, 0xbc, 0x06, 0x67, 0xf7, 0x51, 0xc3, 0x36, 0xc3, 0x05, 0x0f, 0x96, 0x45, 0x94, 0xd3,
+	0x7b, 0xf6, 0xe5, 0x45, 0xc8, 0xd9, 0x5e, 0x0b, 0x94, 0x61, 0xa0, 0xf6, 0x46, 0xa8, 0x49, 0xf8,
+	0x7d, 0xc7, 0x30, 0x48, 0xd3, 0x0d, 0xf2, 0x63, 0x91, 0xa6, 0xf8, 0xbe, 0x3c, 0x0f, 0x59, 0xaf,
+	0xec, 0xd5, 0x3c, 0xe2, 0x07, 0xf9, 0xf1, 0xc8, 0x21, 0xaf, 0xec, 0x3d, 0x20, 0x7e, 0x20, 0x6f,
+	0x43, 0xae, 0x95, 0x7b, 0x9a, 0x9f, 0x58, 0xce, 0xac, 0x4e, 0x95, 0xaf, 0x24, 0x72, 0x2b, 0xa2,
+	0xdb, 0xe1, 0x70, 0xbd, 0x7d, 0x70, 0xf3, 0x54, 0x48, 0x6f, 0x2b, 0x48, 0xb5, 0x00, 0x8b, 0x49,
+	0xb4, 0x08, 0xde, 0x1a, 0x30, 0x57, 0xa5, 0xd6, 0x36, 0xf6, 0xdf, 0x0d, 0x71, 0x31, 0x6f, 0x96,
+	0xa1, 0x90, 0x6c, 0x4d, 0xf8, 0x63, 0xc1, 0x4c, 0x95, 0x5a, 0xbb, 0x01, 0xf2, 0x83, 0x77, 0xeb,
+	0xca, 0x02, 0xcc, 0xf7, 0x18, 0x12, 0x5e, 0x7c, 0x03, 0x67, 0x99, 0x90, 0x78, 0x6f, 0xbb, 0x90,
+	0xe6, 0x60, 0xc2, 0xc7, 0x88, 0x12, 0x97, 0x57, 0x11, 0x5f, 0xc5, 0x9c, 0x53, 0x20, 0x1f, 0xb7,
+	0x2f, 0x7c, 0x7b, 0x3e, 0x0a, 0xb2, 0xb8, 0x05, 0xff, 0x6d, 0x9d, 0xcb, 0x30, 0xe6, 0x92, 0x00,
+	0xf3, 0xda, 0x66, 0xbf, 0x13, 0x6b, 0x7f, 0x7c, 0x80, 0xda, 0x9f, 0xe8, 0x53, 0xfb, 0x93, 0x43,
+	0xd6, 0xbe, 0x7c, 0x15, 0xa6, 0x8d, 0x06, 0x46, 0x7e, 0xad, 0xad, 0x2b, 0xbb, 0x2c, 0xad, 0x66,
+	0xf5, 0x33, 0x6c, 0x7b, 0x27, 0xe5, 0x92, 0x2c, 0x82, 0xd2, 0xcb, 0xa8, 0x20, 0xfc, 0x87, 0x51,
+	0x56, 0x2a, 0x3a, 0x0e, 0xfd, 0x16, 0xe2, 0x6a, 0xd4, 0x5f, 0xe5, 0xfb, 0x30, 0x23, 0x58, 0xac,
+	0x21, 0xce, 0x5c, 0xd4, 0x4d, 0x57, 0x8e, 0x8f, 0x8a, 0x17, 0x78, 0x37, 0xfd, 0x34, 0xc6, 0x34,
+	0x6f, 0xab, 0xbd, 0x19, 0xb8, 0x0b, 0x33, 0x22, 0xde, 0x1a, 0xe2, 0x84, 0xb2, 0x74, 0x55, 0x16,
+	0x8e, 0x8f, 0x8a, 0xe7, 0x5b, 0xdd, 0xd9, 0x30, 0x62, 0x9a, 0x7a, 0xd8, 0xde, 0x81, 0x49, 0xfe,
+	0x08, 0xb0, 0x4c, 0x4e, 0x95, 0x2f, 0xf7, 0x27, 0x94, 0x47, 0x54, 0x19, 0x0b, 0x5b, 0xb6, 0xde,
+	0x3a, 0xbb, 0x39, 0x17, 0x52, 0xd5, 0xeb, 0x93, 0xfa, 0x19, 0xac, 0xa4, 0xb2, 0xd2, 0xe2, 0x2e,
+	0xac, 0x27, 0x83, 0x38, 0x5e, 0xc3, 0x46, 0x6e, 0xc0, 0x58, 0xc9, 0xea, 0xed, 0x8d, 0xf0, 0x32,
+	0xd8, 0x94, 0x36, 0x71, 0x58, 0x8f, 0x99, 0xf0, 0x32, 0x44, 0x2b, 0xf5, 0x49, 0x06, 0x96, 0x3a,
+	0xba, 0xd6, 0x3d, 0xd7, 0xc4, 0x1e, 0x76, 0x4d, 0xec, 0x76, 0xf4, 0x84, 0x1b, 0xb1, 0x7a, 0xef,
+	0xcf, 0x8e, 0xb8, 0x0c, 0x5d, 0x05, 0x3e, 0x3a, 0x48, 0x23, 0xcf, 0x0c, 0x50, 0xcc, 0x63, 0xdd,
+	0xc5, 0xbc, 0x05, 0x40, 0x71, 0xe3, 0x8b, 0x1a, 0x0d, 0xd0, 0x23, 0xcc, 0x6e, 0xc3, 0x54, 0x79,
+	0x5e, 0xe3, 0xbe, 0x85, 0xb3, 0x80, 0xc6, 0x67, 0x01, 0x6d, 0x8b, 0xd8, 0x6e, 0xe7, 0x1b, 0x99,
+	0x0b, 0xcf, 0xed, 0x86, 0xc7, 0xde, 0xd2, 0x6b, 0xf0, 0x71, 0x67, 0xa1, 0x87, 0x4f, 0xed, 0x8d,
+	0xd4, 0xa7, 0xb6, 0x1f, 0xdd, 0xea, 0x21, 0x5c, 0x7d, 0x03, 0x44, 0xe4, 0xfc, 0x2d, 0xdf, 0x08,
+	0xf5, 0x37, 0x09, 0xde, 0xab, 0x52, 0xab, 0x42, 0x5c, 0x53, 0x18, 0x8b, 0x28, 0x1a, 0xb2, 0x06,
+	0x6e, 0xc1, 0x04, 0x72, 0xc4, 0xbd, 0x1a, 0x34, 0x35, 0xfc, 0xcc, 0xe6, 0xad, 0x38, 0xa3, 0xeb,
+	0xa9, 0x8c, 0xf6, 0xba, 0xac, 0x2e, 0xc1, 0x85, 0x44, 0x81, 0xe8, 0x36, 0x7f, 0x48, 0xd1, 0x90,
+	0xe3, 0xee, 0xfd, 0x4f, 0xe2, 0xbd, 0x1d, 0x8f, 0xb7, 0x98, 0x3e, 0xac, 0x25, 0x38, 0xad, 0xde,
+	0x87, 0xa5, 0x14, 0x91, 0xa8, 0x98, 0x75, 0x98, 0x61, 0x4d, 0x01, 0x07, 0x36, 0x71, 0x6b, 0x75,
+	0x6c, 0x5b, 0xf5, 0xa8, 0x5b, 0x64, 0xf4, 0xb3, 0x6d, 0xc1, 0x5d, 0xb6, 0xaf, 0xfe, 0x2c, 0xc1,
+	0x99, 0x2a, 0xb5, 0xee, 0x78, 0x1e, 0x46, 0x8d, 0xdd, 0x06, 0xa2, 0xf5, 0x61, 0x79, 0x99, 0x87,
+	0x2c, 0x0d, 0xcf, 0xd7, 0x6c, 0x93, 0x31, 0x33, 0xa6, 0x4f, 0xb2, 0xf5, 0x3d, 0x33, 0xf5, 0x99,
+	0xbe, 0x11, 0x27, 0xe3, 0x52, 0x2a, 0x19, 0x1d, 0x0e, 0xaa, 0x79, 0x98, 0xeb, 0xde, 0x11, 0xe9,
+	0xfe, 0x25, 0x2a, 0x6e, 0x1d, 0x53, 0xd2, 0xd8, 0xc7, 0x4c, 0x16, 0xc1, 0x86, 0x1e, 0xcf, 0xfb,
+	0x47, 0xd5, 0xf4, 0xea, 0xa4, 0x61, 0xb2, 0xa8, 0xb2, 0x3a, 0x5f, 0x45, 0x29, 0xee, 0x9e, 0xc8,
+	0xd7, 0xfb, 0xb4, 0x89, 0xb8, 0xab, 0xbc, 0xa8, 0x7b, 0x05, 0x22, 0xca, 0xdf, 0x33, 0xd1, 0xb4,
+	0x85, 0xdb, 0xed, 0x62, 0x8b, 0x38, 0x8e, 0x4d, 0xa9, 0x4d, 0xdc, 0x61, 0xd3, 0x97, 0xd8, 0x67,
+	0x46, 0x87, 0x7f, 0x79, 0x77, 0x60, 0xcc, 0x47, 0x01, 0x8e, 0x32, 0x5e, 0xd9, 0x08, 0x6f, 0xc2,
+	0x5f, 0x2f, 0x96, 0x16, 0x22, 0x35, 0xd4, 0x7c, 0xa4, 0xd9, 0xa4, 0xe4, 0xa0, 0xa0, 0xae, 0x7d,
+	0x82, 0x2d, 0x64, 0x1c, 0x6e, 0x63, 0xe3, 0xf8, 0xa8, 0x08, 0xdc, 0xca, 0x36, 0x36, 0x74, 0x76,
+	0x5c, 0xbe, 0x0b, 0x59, 0x07, 0x1d, 0xd4, 0x98, 0x2a, 0xf6, 0x2e, 0x54, 0x8a, 0x27, 0x53, 0x33,
+	0xe9, 0xa0, 0x03, 0x3d, 0xd4, 0xf4, 0x10, 0xa6, 0x43, 0x4d, 0x46, 0x1d, 0xb9, 0x16, 0x8e, 0x14,
+	0x8e, 0x0f, 0xa3, 0xf0, 0xb4, 0x83, 0x0e, 0xb6, 0x98, 0x92, 0x50, 0xed, 0xe6, 0x47, 0xf1, 0x1a,
+	0x2e, 0xa5, 0xe6, 0x3a, 0x39, 0x61, 0xea, 0x45, 0x58, 0x49, 0x15, 0xb6, 0x72, 0x5e, 0xfe, 0x67,
+	0x0a, 0x32, 0x55, 0x6a, 0xc9, 0x7b, 0x70, 0xaa, 0xeb, 0xb3, 0xf3, 0x52, 0xe2, 0x23, 0x16, 0xfb,
+	0xae, 0x53, 0x3e, 0x18, 0x04, 0x25, 0x1a, 0xc8, 0x97, 0x30, 0xd3, 0xfb, 0xe5, 0xf7, 0x7e, 0x9a,
+	0x8a, 0x1e, 0xa8, 0xb2, 0x31, 0x30, 0x54, 0x98, 0xfc, 0x0a, 0xce, 0x25, 0x7d, 0x35, 0xad, 0xa7,
+	0x69, 0x4a, 0x00, 0x2b, 0xd7, 0x4e, 0x00, 0x16, 0x86, 0xeb, 0x70, 0x26, 0xf6, 0x79, 0x74, 0x25,
+	0x4d, 0x4d, 0x37, 0x4e, 0xd1, 0x06, 0xc3, 0x09, 0x4b, 0x18, 0x4e, 0x77, 0x7f, 0x02, 0x5d, 0x4e,
+	0x57, 0xd0, 0x01, 0x53, 0x8a, 0x03, 0xc1, 0x84, 0x99, 0x47, 0x30, 0x1d, 0xff, 0x98, 0xb9, 0xda,
+	0x3f, 0xfb, 0x6d, 0x53, 0xa5, 0x01, 0x81, 0xc2, 0xd8, 0x63, 0x09, 0xe6, 0x52, 0x26, 0x79, 0x2d,
+	0xbd, 0x08, 0x92, 0xf0, 0xca, 0xcd, 0x93, 0xe1, 0x85, 0x0b, 0xdf, 0x4b, 0xb0, 0xd8, 0x77, 0xb4,
+	0xbd, 0xfe, 0xa6, 0x6a, 0x4c, 0x3a, 0xa5, 0xdc, 0x1a, 0xe6, 0x94, 0x70, 0x2a, 0x00, 0x39, 0x61,
+	0xc0, 0x5a, 0x4b, 0xd3, 0xd9, 0x8b, 0x55, 0xca, 0x83, 0x63, 0x85, 0xd5, 0xaf, 0x61, 0x36, 0x71,
+	0xd0, 0x49, 0xbf, 0xfd, 0x09, 0x68, 0xe5, 0xfa, 0x49, 0xd0, 0xc2, 0x76, 0x0d, 0xa6, 0x3a, 0x67,
+	0x88, 0x8b, 0x69, 0x4a, 0x3a, 0x40, 0xca, 0xfa, 0x00, 0xa0, 0x4e, 0x4a, 0x13, 0x9e, 0xf5, 0xb5,
+	0xf4, 0x34, 0xc5, 0xb1, 0x4a, 0x79, 0x70, 0x6c, 0x57, 0x81, 0xa7, 0xbc, 0xb3, 0xe9, 0xf7, 0x3f,
+	0x11, 0xaf, 0xdc, 0x3c, 0x19, 0xbe, 0xe5, 0x82, 0x32, 0xfe, 0x38, 0x1c, 0x20, 0x2b, 0xda, 0xb3,
+	0x97, 0x05, 0xe9, 0xf9, 0xcb, 0x82, 0xf4, 0xf7, 0xcb, 0x82, 0xf4, 0xe4, 0x55, 0x61, 0xe4, 0xf9,
+	0xab, 0xc2, 0xc8, 0x9f, 0xaf, 0x0a, 0x23, 0x9f, 0xcf, 0xc6, 0x5e, 0x9a, 0xe0, 0xd0, 0xc3, 0x74,
+	0x6f, 0x82, 0xfd, 0xab, 0xf2, 0xda, 0xbf, 0x03, 0x00, 0xae, 0x47, 0x59, 0xe2, 0xc5, 0x15, 0x00,
+	0x00,
 }
 
-func (m *MsgDeregisterSupernode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RegisterSupernode(ctx context.Context, in *MsgRegisterSupernode, opts ...grpc.CallOption) (*MsgRegisterSupernodeResponse, error)
+	DeregisterSupernode(ctx context.Context, in *MsgDeregisterSupernode, opts ...grpc.CallOption) (*MsgDeregisterSupernodeResponse, error)
+	StartSupernode(ctx context.Context, in *MsgStartSupernode, opts ...grpc.CallOption) (*MsgStartSupernodeResponse, error)
+	StopSupernode(ctx context.Context, in *MsgStopSupernode, opts ...grpc.CallOption) (*MsgStopSupernodeResponse, error)
+	UpdateSupernode(ctx context.Context, in *MsgUpdateSupernode, opts ...grpc.CallOption) (*MsgUpdateSupernodeResponse, error)
+	ReportSupernodeMetrics(ctx context.Context, in *MsgReportSupernodeMetrics, opts ...grpc.CallOption) (*MsgReportSupernodeMetricsResponse, error)
+	// RegisterIndependentSupernode registers a supernode backed by self-stake
+	// bonded in the supernode module instead of a consensus validator.
+	RegisterIndependentSupernode(ctx context.Context, in *MsgRegisterIndependentSupernode, opts ...grpc.CallOption) (*MsgRegisterIndependentSupernodeResponse, error)
+	// BondSupernodeStake adds to an independent operator's self-stake.
+	BondSupernodeStake(ctx context.Context, in *MsgBondSupernodeStake, opts ...grpc.CallOption) (*MsgBondSupernodeStakeResponse, error)
+	// UnbondSupernodeStake starts unbonding part of an independent operator's self-stake.
+	UnbondSupernodeStake(ctx context.Context, in *MsgUnbondSupernodeStake, opts ...grpc.CallOption) (*MsgUnbondSupernodeStakeResponse, error)
+	// AppealSlash contests a pending slash before its appeal window closes.
+	AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error)
+	// ResolveSlashAppeal defines a (governance) operation that upholds or
+	// overturns an appealed slash.
+	ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error)
+	// SetSupernodeCommission sets the share of Everlight payouts a
+	// validator-backed supernode keeps before sharing with delegators.
+	SetSupernodeCommission(ctx context.Context, in *MsgSetSupernodeCommission, opts ...grpc.CallOption) (*MsgSetSupernodeCommissionResponse, error)
 }
 
-func (m *MsgDeregisterSupernode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgDeregisterSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeregisterSupernodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterSupernode(ctx context.Context, in *MsgRegisterSupernode, opts ...grpc.CallOption) (*MsgRegisterSupernodeResponse, error) {
+	out := new(MsgRegisterSupernodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/RegisterSupernode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeregisterSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeregisterSupernode(ctx context.Context, in *MsgDeregisterSupernode, opts ...grpc.CallOption) (*MsgDeregisterSupernodeResponse, error) {
+	out := new(MsgDeregisterSupernodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/DeregisterSupernode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeregisterSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) StartSupernode(ctx context.Context, in *MsgStartSupernode, opts ...grpc.CallOption) (*MsgStartSupernodeResponse, error) {
+	out := new(MsgStartSupernodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/StartSupernode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartSupernode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StopSupernode(ctx context.Context, in *MsgStopSupernode, opts ...grpc.CallOption) (*MsgStopSupernodeResponse, error) {
+	out := new(MsgStopSupernodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/StopSupernode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStartSupernode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateSupernode(ctx context.Context, in *MsgUpdateSupernode, opts ...grpc.CallOption) (*MsgUpdateSupernodeResponse, error) {
+	out := new(MsgUpdateSupernodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/UpdateSupernode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ReportSupernodeMetrics(ctx context.Context, in *MsgReportSupernodeMetrics, opts ...grpc.CallOption) (*MsgReportSupernodeMetricsResponse, error) {
+	out := new(MsgReportSupernodeMetricsResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/ReportSupernodeMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStartSupernodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterIndependentSupernode(ctx context.Context, in *MsgRegisterIndependentSupernode, opts ...grpc.CallOption) (*MsgRegisterIndependentSupernodeResponse, error) {
+	out := new(MsgRegisterIndependentSupernodeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/RegisterIndependentSupernode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgStartSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgStartSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) BondSupernodeStake(ctx context.Context, in *MsgBondSupernodeStake, opts ...grpc.CallOption) (*MsgBondSupernodeStakeResponse, error) {
+	out := new(MsgBondSupernodeStakeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/BondSupernodeStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStopSupernode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnbondSupernodeStake(ctx context.Context, in *MsgUnbondSupernodeStake, opts ...grpc.CallOption) (*MsgUnbondSupernodeStakeResponse, error) {
+	out := new(MsgUnbondSupernodeStakeResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/UnbondSupernodeStake", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStopSupernode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error) {
+	out := new(MsgAppealSlashResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/AppealSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStopSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error) {
+	out := new(MsgResolveSlashAppealResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/ResolveSlashAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStopSupernodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetSupernodeCommission(ctx context.Context, in *MsgSetSupernodeCommission, opts ...grpc.CallOption) (*MsgSetSupernodeCommissionResponse, error) {
+	out := new(MsgSetSupernodeCommissionResponse)
+	err := c.cc.Invoke(ctx, "/lumera.supernode.v1.Msg/SetSupernodeCommission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStopSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterSupernode(context.Context, *MsgRegisterSupernode) (*MsgRegisterSupernodeResponse, error)
+	DeregisterSupernode(context.Context, *MsgDeregisterSupernode) (*MsgDeregisterSupernodeResponse, error)
+	StartSupernode(context.Context, *MsgStartSupernode) (*MsgStartSupernodeResponse, error)
+	StopSupernode(context.Context, *MsgStopSupernode) (*MsgStopSupernodeResponse, error)
+	UpdateSupernode(context.Context, *MsgUpdateSupernode) (*MsgUpdateSupernodeResponse, error)
+	ReportSupernodeMetrics(context.Context, *MsgReportSupernodeMetrics) (*MsgReportSupernodeMetricsResponse, error)
+	// RegisterIndependentSupernode registers a supernode backed by self-stake
+	// bonded in the supernode module instead of a consensus validator.
+	RegisterIndependentSupernode(context.Context, *MsgRegisterIndependentSupernode) (*MsgRegisterIndependentSupernodeResponse, error)
+	// BondSupernodeStake adds to an independent operator's self-stake.
+	BondSupernodeStake(context.Context, *MsgBondSupernodeStake) (*MsgBondSupernodeStakeResponse, error)
+	// UnbondSupernodeStake starts unbonding part of an independent operator's self-stake.
+	UnbondSupernodeStake(context.Context, *MsgUnbondSupernodeStake) (*MsgUnbondSupernodeStakeResponse, error)
+	// AppealSlash contests a pending slash before its appeal window closes.
+	AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error)
+	// ResolveSlashAppeal defines a (governance) operation that upholds or
+	// overturns an appealed slash.
+	ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error)
+	// SetSupernodeCommission sets the share of Everlight payouts a
+	// validator-backed supernode keeps before sharing with delegators.
+	SetSupernodeCommission(context.Context, *MsgSetSupernodeCommission) (*MsgSetSupernodeCommissionResponse, error)
 }
 
-func (m *MsgStopSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateSupernode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSupernode(ctx context.Context, req *MsgRegisterSupernode) (*MsgRegisterSupernodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSupernode not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterSupernode(ctx context.Context, req *MsgDeregisterSupernode) (*MsgDeregisterSupernodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterSupernode not implemented")
+}
+func (*UnimplementedMsgServer) StartSupernode(ctx context.Context, req *MsgStartSupernode) (*MsgStartSupernodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSupernode not implemented")
+}
+func (*UnimplementedMsgServer) StopSupernode(ctx context.Context, req *MsgStopSupernode) (*MsgStopSupernodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopSupernode not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSupernode(ctx context.Context, req *MsgUpdateSupernode) (*MsgUpdateSupernodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupernode not implemented")
+}
+func (*UnimplementedMsgServer) ReportSupernodeMetrics(ctx context.Context, req *MsgReportSupernodeMetrics) (*MsgReportSupernodeMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSupernodeMetrics not implemented")
+}
+func (*UnimplementedMsgServer) RegisterIndependentSupernode(ctx context.Context, req *MsgRegisterIndependentSupernode) (*MsgRegisterIndependentSupernodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterIndependentSupernode not implemented")
+}
+func (*UnimplementedMsgServer) BondSupernodeStake(ctx context.Context, req *MsgBondSupernodeStake) (*MsgBondSupernodeStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondSupernodeStake not implemented")
+}
+func (*UnimplementedMsgServer) UnbondSupernodeStake(ctx context.Context, req *MsgUnbondSupernodeStake) (*MsgUnbondSupernodeStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondSupernodeStake not implemented")
+}
+func (*UnimplementedMsgServer) AppealSlash(ctx context.Context, req *MsgAppealSlash) (*MsgAppealSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSlash not implemented")
+}
+func (*UnimplementedMsgServer) ResolveSlashAppeal(ctx context.Context, req *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlashAppeal not implemented")
+}
+func (*UnimplementedMsgServer) SetSupernodeCommission(ctx context.Context, req *MsgSetSupernodeCommission) (*MsgSetSupernodeCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupernodeCommission not implemented")
 }
 
-func (m *MsgUpdateSupernode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.P2PPort) > 0 {
-		i -= len(m.P2PPort)
-		copy(dAtA[i:], m.P2PPort)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.P2PPort)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SupernodeAccount) > 0 {
-		i -= len(m.SupernodeAccount)
-		copy(dAtA[i:], m.SupernodeAccount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Note) > 0 {
-		i -= len(m.Note)
-		copy(dAtA[i:], m.Note)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.IpAddress) > 0 {
-		i -= len(m.IpAddress)
-		copy(dAtA[i:], m.IpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/UpdateParams",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateSupernodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSupernode)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSupernode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/RegisterSupernode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSupernode(ctx, req.(*MsgRegisterSupernode))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReportSupernodeMetrics) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeregisterSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterSupernode)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgReportSupernodeMetrics) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgReportSupernodeMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterSupernode(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SupernodeAccount) > 0 {
-		i -= len(m.SupernodeAccount)
-		copy(dAtA[i:], m.SupernodeAccount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/DeregisterSupernode",
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterSupernode(ctx, req.(*MsgDeregisterSupernode))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReportSupernodeMetricsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StartSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartSupernode)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgReportSupernodeMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgReportSupernodeMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issues) > 0 {
-		for iNdEx := len(m.Issues) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Issues[iNdEx])
-			copy(dAtA[i:], m.Issues[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Issues[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).StartSupernode(ctx, in)
 	}
-	if m.Compliant {
-		i--
-		if m.Compliant {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/StartSupernode",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartSupernode(ctx, req.(*MsgStartSupernode))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_StopSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStopSupernode)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).StopSupernode(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/StopSupernode",
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StopSupernode(ctx, req.(*MsgStopSupernode))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterSupernode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSupernode)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.IpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSupernode(ctx, in)
 	}
-	l = len(m.SupernodeAccount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/UpdateSupernode",
 	}
-	l = len(m.P2PPort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSupernode(ctx, req.(*MsgUpdateSupernode))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterSupernodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReportSupernodeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportSupernodeMetrics)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ReportSupernodeMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/ReportSupernodeMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportSupernodeMetrics(ctx, req.(*MsgReportSupernodeMetrics))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeregisterSupernode) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterIndependentSupernode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterIndependentSupernode)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterIndependentSupernode(ctx, in)
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/RegisterIndependentSupernode",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterIndependentSupernode(ctx, req.(*MsgRegisterIndependentSupernode))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeregisterSupernodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BondSupernodeStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondSupernodeStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).BondSupernodeStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/BondSupernodeStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondSupernodeStake(ctx, req.(*MsgBondSupernodeStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartSupernode) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnbondSupernodeStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondSupernodeStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondSupernodeStake(ctx, in)
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/UnbondSupernodeStake",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondSupernodeStake(ctx, req.(*MsgUnbondSupernodeStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartSupernodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AppealSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealSlash)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AppealSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/AppealSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealSlash(ctx, req.(*MsgAppealSlash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStopSupernode) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResolveSlashAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveSlashAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/ResolveSlashAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, req.(*MsgResolveSlashAppeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSupernodeCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSupernodeCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSupernodeCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lumera.supernode.v1.Msg/SetSupernodeCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSupernodeCommission(ctx, req.(*MsgSetSupernodeCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lumera.supernode.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterSupernode",
+			Handler:    _Msg_RegisterSupernode_Handler,
+		},
+		{
+			MethodName: "DeregisterSupernode",
+			Handler:    _Msg_DeregisterSupernode_Handler,
+		},
+		{
+			MethodName: "StartSupernode",
+			Handler:    _Msg_StartSupernode_Handler,
+		},
+		{
+			MethodName: "StopSupernode",
+			Handler:    _Msg_StopSupernode_Handler,
+		},
+		{
+			MethodName: "UpdateSupernode",
+			Handler:    _Msg_UpdateSupernode_Handler,
+		},
+		{
+			MethodName: "ReportSupernodeMetrics",
+			Handler:    _Msg_ReportSupernodeMetrics_Handler,
+		},
+		{
+			MethodName: "RegisterIndependentSupernode",
+			Handler:    _Msg_RegisterIndependentSupernode_Handler,
+		},
+		{
+			MethodName: "BondSupernodeStake",
+			Handler:    _Msg_BondSupernodeStake_Handler,
+		},
+		{
+			MethodName: "UnbondSupernodeStake",
+			Handler:    _Msg_UnbondSupernodeStake_Handler,
+		},
+		{
+			MethodName: "AppealSlash",
+			Handler:    _Msg_AppealSlash_Handler,
+		},
+		{
+			MethodName: "ResolveSlashAppeal",
+			Handler:    _Msg_ResolveSlashAppeal_Handler,
+		},
+		{
+			MethodName: "SetSupernodeCommission",
+			Handler:    _Msg_SetSupernodeCommission_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lumera/supernode/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStopSupernodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSupernode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterSupernode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSupernode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.P2PPort) > 0 {
+		i -= len(m.P2PPort)
+		copy(dAtA[i:], m.P2PPort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.P2PPort)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.IpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SupernodeAccount) > 0 {
+		i -= len(m.SupernodeAccount)
+		copy(dAtA[i:], m.SupernodeAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Note)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.IpAddress) > 0 {
+		i -= len(m.IpAddress)
+		copy(dAtA[i:], m.IpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SupernodeAccount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.P2PPort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateSupernodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterSupernodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterSupernode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterSupernode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterSupernodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartSupernode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartSupernode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartSupernodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStopSupernode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStopSupernode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStopSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStopSupernodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStopSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStopSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSupernode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSupernode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearEndpoints {
+		i--
+		if m.ClearEndpoints {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.P2PPort) > 0 {
+		i -= len(m.P2PPort)
+		copy(dAtA[i:], m.P2PPort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.P2PPort)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SupernodeAccount) > 0 {
+		i -= len(m.SupernodeAccount)
+		copy(dAtA[i:], m.SupernodeAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IpAddress) > 0 {
+		i -= len(m.IpAddress)
+		copy(dAtA[i:], m.IpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSupernodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportSupernodeMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportSupernodeMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportSupernodeMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SupernodeAccount) > 0 {
+		i -= len(m.SupernodeAccount)
+		copy(dAtA[i:], m.SupernodeAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportSupernodeMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportSupernodeMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportSupernodeMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issues) > 0 {
+		for iNdEx := len(m.Issues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Issues[iNdEx])
+			copy(dAtA[i:], m.Issues[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Issues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Compliant {
+		i--
+		if m.Compliant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterIndependentSupernode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIndependentSupernode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIndependentSupernode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.SelfStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.P2PPort) > 0 {
+		i -= len(m.P2PPort)
+		copy(dAtA[i:], m.P2PPort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.P2PPort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SupernodeAccount) > 0 {
+		i -= len(m.SupernodeAccount)
+		copy(dAtA[i:], m.SupernodeAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupernodeAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IpAddress) > 0 {
+		i -= len(m.IpAddress)
+		copy(dAtA[i:], m.IpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterIndependentSupernodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIndependentSupernodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIndependentSupernodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondSupernodeStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondSupernodeStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondSupernodeStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondSupernodeStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondSupernodeStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondSupernodeStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondSupernodeStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondSupernodeStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondSupernodeStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondSupernodeStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondSupernodeStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondSupernodeStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SlashId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveSlashAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveSlashAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveSlashAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Uphold {
+		i--
+		if m.Uphold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlashId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveSlashAppealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveSlashAppealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveSlashAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSupernodeCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSupernodeCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSupernodeCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxChangeRate != nil {
+		{
+			size := m.MaxChangeRate.Size()
+			i -= size
+			if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxRate != nil {
+		{
+			size := m.MaxRate.Size()
+			i -= size
+			if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSupernodeCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSupernodeCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSupernodeCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSupernode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.P2PPort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterSupernodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterSupernode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterSupernodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartSupernode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStartSupernodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStopSupernode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStopSupernodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSupernode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.P2PPort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ClearEndpoints {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateSupernodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportSupernodeMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metrics.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReportSupernodeMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compliant {
+		n += 2
+	}
+	if len(m.Issues) > 0 {
+		for _, s := range m.Issues {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterIndependentSupernode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupernodeAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.P2PPort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SelfStake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterIndependentSupernodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBondSupernodeStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondSupernodeStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondSupernodeStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnbondSupernodeStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgAppealSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashId != 0 {
+		n += 1 + sovTx(uint64(m.SlashId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveSlashAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashId != 0 {
+		n += 1 + sovTx(uint64(m.SlashId))
+	}
+	if m.Uphold {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveSlashAppealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSupernodeCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxRate != nil {
+		l = m.MaxRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxChangeRate != nil {
+		l = m.MaxChangeRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSupernodeCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSupernode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P2PPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.P2PPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &SupernodeEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSupernodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSupernodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterSupernode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterSupernode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterSupernodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterSupernodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartSupernode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartSupernode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartSupernodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartSupernodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStopSupernode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStopSupernode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStopSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgReportSupernodeMetrics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SupernodeAccount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Metrics.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgReportSupernodeMetricsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Compliant {
-		n += 2
-	}
-	if len(m.Issues) > 0 {
-		for _, s := range m.Issues {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgStopSupernodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStopSupernodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStopSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1885,15 +4503,175 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSupernode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P2PPort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,11 +4699,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.P2PPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1952,10 +4730,31 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Endpoints = append(m.Endpoints, &SupernodeEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearEndpoints", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearEndpoints = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1977,7 +4776,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSupernodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,10 +4799,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSupernodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2027,7 +4826,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
+func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,15 +4849,15 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterSupernode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportSupernodeMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportSupernodeMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2086,11 +4885,11 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2118,13 +4917,13 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2134,29 +4933,80 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportSupernodeMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportSupernodeMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportSupernodeMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compliant", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2166,27 +5016,15 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Compliant = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P2PPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2214,7 +5052,7 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.P2PPort = string(dAtA[iNdEx:postIndex])
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2237,7 +5075,7 @@ func (m *MsgRegisterSupernode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterSupernodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterIndependentSupernode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2260,65 +5098,111 @@ func (m *MsgRegisterSupernodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterSupernodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterIndependentSupernode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterIndependentSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeregisterSupernode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpAddress", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeregisterSupernode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeregisterSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P2PPort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2346,13 +5230,13 @@ func (m *MsgDeregisterSupernode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.P2PPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfStake", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2362,23 +5246,58 @@ func (m *MsgDeregisterSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SelfStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &SupernodeEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2401,7 +5320,7 @@ func (m *MsgDeregisterSupernode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeregisterSupernodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterIndependentSupernodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2424,12 +5343,44 @@ func (m *MsgDeregisterSupernodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeregisterSupernodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterIndependentSupernodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeregisterSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterIndependentSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2451,7 +5402,7 @@ func (m *MsgDeregisterSupernodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartSupernode) Unmarshal(dAtA []byte) error {
+func (m *MsgBondSupernodeStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2474,10 +5425,10 @@ func (m *MsgStartSupernode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartSupernode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBondSupernodeStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBondSupernodeStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2514,9 +5465,9 @@ func (m *MsgStartSupernode) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2526,23 +5477,24 @@ func (m *MsgStartSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2565,7 +5517,7 @@ func (m *MsgStartSupernode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartSupernodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBondSupernodeStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2588,10 +5540,10 @@ func (m *MsgStartSupernodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartSupernodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBondSupernodeStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBondSupernodeStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2615,7 +5567,7 @@ func (m *MsgStartSupernodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStopSupernode) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondSupernodeStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2638,10 +5590,10 @@ func (m *MsgStopSupernode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStopSupernode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondSupernodeStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStopSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondSupernodeStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2678,9 +5630,9 @@ func (m *MsgStopSupernode) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2690,55 +5642,24 @@ func (m *MsgStopSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2761,7 +5682,7 @@ func (m *MsgStopSupernode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStopSupernodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondSupernodeStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2784,12 +5705,31 @@ func (m *MsgStopSupernodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStopSupernodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondSupernodeStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStopSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondSupernodeStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2811,7 +5751,7 @@ func (m *MsgStopSupernodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,10 +5774,10 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSupernode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSupernode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2873,10 +5813,10 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
 			}
-			var stringLen uint64
+			m.SlashId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2886,27 +5826,14 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2923,22 +5850,122 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppealSlashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealSlashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveSlashAppeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveSlashAppeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveSlashAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2966,13 +5993,13 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Note = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
 			}
-			var stringLen uint64
+			m.SlashId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2982,29 +6009,16 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P2PPort", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uphold", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3014,24 +6028,12 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.P2PPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Uphold = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3053,7 +6055,7 @@ func (m *MsgUpdateSupernode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSupernodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveSlashAppealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3076,10 +6078,10 @@ func (m *MsgUpdateSupernodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSupernodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveSlashAppealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSupernodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveSlashAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3103,7 +6105,7 @@ func (m *MsgUpdateSupernodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSupernodeCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,15 +6128,15 @@ func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportSupernodeMetrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSupernodeCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportSupernodeMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSupernodeCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3162,11 +6164,11 @@ func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupernodeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3194,13 +6196,13 @@ func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SupernodeAccount = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3210,22 +6212,95 @@ func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxRate = &v
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxChangeRate = &v
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3250,7 +6325,7 @@ func (m *MsgReportSupernodeMetrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReportSupernodeMetricsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSupernodeCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,64 +6348,12 @@ func (m *MsgReportSupernodeMetricsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReportSupernodeMetricsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSupernodeCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReportSupernodeMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSupernodeCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compliant", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Compliant = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])